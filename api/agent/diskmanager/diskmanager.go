@@ -6,10 +6,12 @@ package diskmanager
 import (
 	"context"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -53,6 +55,61 @@ func (st *State) SetMachineBlockDevices(ctx context.Context, devices []blockdevi
 	return results.OneError()
 }
 
+// FilesystemMountPoints returns the mount points of the filesystems
+// attached to the machine identified by the authenticated machine tag.
+func (st *State) FilesystemMountPoints(ctx context.Context) ([]string, error) {
+	if st.facade.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("filesystem usage reporting by this controller")
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: st.tag.String()}},
+	}
+	var results params.StringsResults
+	err := st.facade.FacadeCall(ctx, "FilesystemMountPoints", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	if err := results.Results[0].Error; err != nil {
+		return nil, err
+	}
+	return results.Results[0].Result, nil
+}
+
+// SetFilesystemUsage records the usage of the filesystems attached to the
+// machine identified by the authenticated machine tag.
+func (st *State) SetFilesystemUsage(ctx context.Context, usage []storage.FilesystemUsage) error {
+	if st.facade.BestAPIVersion() < 3 {
+		return errors.NotSupportedf("filesystem usage reporting by this controller")
+	}
+	filesystems := make([]params.MountedFilesystemUsage, len(usage))
+	for i, u := range usage {
+		filesystems[i] = params.MountedFilesystemUsage{
+			MountPoint: u.Path,
+			Usage: params.FilesystemUsage{
+				UsedBytes:       u.UsedBytes,
+				AvailableBytes:  u.AvailableBytes,
+				UsedInodes:      u.UsedInodes,
+				AvailableInodes: u.AvailableInodes,
+			},
+		}
+	}
+	args := params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine:     st.tag.String(),
+			Filesystems: filesystems,
+		}},
+	}
+	var results params.ErrorResults
+	err := st.facade.FacadeCall(ctx, "SetMachineFilesystemUsage", args, &results)
+	if err != nil {
+		return err
+	}
+	return results.OneError()
+}
+
 func blockDevicesToParams(in []blockdevice.BlockDevice) []params.BlockDevice {
 	if len(in) == 0 {
 		return nil
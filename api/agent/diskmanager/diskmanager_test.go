@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -15,6 +16,7 @@ import (
 	"github.com/juju/juju/api/agent/diskmanager"
 	"github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/internal/storage"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -131,3 +133,79 @@ func (s *DiskManagerSuite) TestSetMachineBlockDevicesResultCountInvalid(c *gc.C)
 		c.Check(err, gc.ErrorMatches, fmt.Sprintf("expected 1 result, got %d", n))
 	}
 }
+
+func (s *DiskManagerSuite) TestFilesystemMountPoints(c *gc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "DiskManager")
+			c.Check(request, gc.Equals, "FilesystemMountPoints")
+			c.Check(arg, gc.DeepEquals, params.Entities{
+				Entities: []params.Entity{{Tag: "machine-123"}},
+			})
+			*(result.(*params.StringsResults)) = params.StringsResults{
+				Results: []params.StringsResult{{Result: []string{"/srv/data"}}},
+			}
+			return nil
+		}),
+		BestVersion: 3,
+	}
+	st := diskmanager.NewState(apiCaller, names.NewMachineTag("123"))
+	mountPoints, err := st.FilesystemMountPoints(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(mountPoints, jc.DeepEquals, []string{"/srv/data"})
+}
+
+func (s *DiskManagerSuite) TestSetFilesystemUsage(c *gc.C) {
+	var callCount int
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "DiskManager")
+			c.Check(request, gc.Equals, "SetMachineFilesystemUsage")
+			c.Check(arg, gc.DeepEquals, params.SetMachineFilesystemUsage{
+				MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+					Machine: "machine-123",
+					Filesystems: []params.MountedFilesystemUsage{{
+						MountPoint: "/srv/data",
+						Usage: params.FilesystemUsage{
+							UsedBytes:       1,
+							AvailableBytes:  2,
+							UsedInodes:      3,
+							AvailableInodes: 4,
+						},
+					}},
+				}},
+			})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				Results: []params.ErrorResult{{}},
+			}
+			callCount++
+			return nil
+		}),
+		BestVersion: 3,
+	}
+	st := diskmanager.NewState(apiCaller, names.NewMachineTag("123"))
+	err := st.SetFilesystemUsage(context.Background(), []storage.FilesystemUsage{{
+		Path:            "/srv/data",
+		UsedBytes:       1,
+		AvailableBytes:  2,
+		UsedInodes:      3,
+		AvailableInodes: 4,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(callCount, gc.Equals, 1)
+}
+
+func (s *DiskManagerSuite) TestFilesystemUsageNotSupported(c *gc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Fatalf("unexpected call to %q", request)
+			return nil
+		}),
+		BestVersion: 2,
+	}
+	st := diskmanager.NewState(apiCaller, names.NewMachineTag("123"))
+	_, err := st.FilesystemMountPoints(context.Background())
+	c.Assert(err, jc.ErrorIs, jujuerrors.NotSupported)
+	err = st.SetFilesystemUsage(context.Background(), nil)
+	c.Assert(err, jc.ErrorIs, jujuerrors.NotSupported)
+}
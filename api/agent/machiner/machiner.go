@@ -5,6 +5,7 @@ package machiner
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -12,6 +13,7 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/common"
 	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/rpc/params"
 )
 
 // Option is a function that can be used to configure a Client.
@@ -55,3 +57,26 @@ func (c *Client) Machine(ctx context.Context, tag names.MachineTag) (*Machine, e
 		client: c,
 	}, nil
 }
+
+// RecordMachineUtilisation reports the CPU and memory utilisation of the
+// machine, as percentages, sampled at the given time.
+func (c *Client) RecordMachineUtilisation(
+	ctx context.Context, tag names.MachineTag, sampledAt time.Time, cpuPercent, memoryPercent float64,
+) error {
+	if c.facade.BestAPIVersion() < 7 {
+		return errors.NotSupportedf("recording machine utilisation on this version of Juju")
+	}
+	var result params.ErrorResults
+	args := params.MachineUtilisationSamples{
+		Samples: []params.MachineUtilisationSample{{
+			Tag:           tag.String(),
+			SampledAt:     sampledAt,
+			CPUPercent:    cpuPercent,
+			MemoryPercent: memoryPercent,
+		}},
+	}
+	if err := c.facade.FacadeCall(ctx, "RecordMachineUtilisation", args, &result); err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
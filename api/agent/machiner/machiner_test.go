@@ -6,7 +6,9 @@ package machiner_test
 import (
 	"context"
 	stdtesting "testing"
+	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -287,3 +289,43 @@ func (s *machinerSuite) TestRecordAgentStartInformation(c *gc.C) {
 	err = m.RecordAgentStartInformation(context.Background(), "hostname")
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *machinerSuite) TestRecordMachineUtilisation(c *gc.C) {
+	now := time.Now()
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, gc.Equals, "Machiner")
+			c.Check(request, gc.Equals, "RecordMachineUtilisation")
+			c.Assert(arg, jc.DeepEquals, params.MachineUtilisationSamples{
+				Samples: []params.MachineUtilisationSample{{
+					Tag:           "machine-666",
+					SampledAt:     now,
+					CPUPercent:    12.5,
+					MemoryPercent: 40,
+				}},
+			})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				Results: []params.ErrorResult{{Error: &params.Error{Message: "boom"}}},
+			}
+			return nil
+		}),
+		BestVersion: 7,
+	}
+	client := machiner.NewClient(apiCaller)
+	err := client.RecordMachineUtilisation(context.Background(), names.NewMachineTag("666"), now, 12.5, 40)
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *machinerSuite) TestRecordMachineUtilisationNotSupported(c *gc.C) {
+	apiCaller := basetesting.BestVersionCaller{
+		APICallerFunc: basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		}),
+		BestVersion: 6,
+	}
+	client := machiner.NewClient(apiCaller)
+	err := client.RecordMachineUtilisation(context.Background(), names.NewMachineTag("666"), time.Now(), 12.5, 40)
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
@@ -71,6 +71,10 @@ type MachineProvisioner interface {
 	// provider-level resources cleaned up and be removed.
 	MarkForRemoval(ctx context.Context) error
 
+	// ResetInterruptedInstance forgets the machine's interrupted spot or
+	// preemptible instance, so that the machine can be provisioned again.
+	ResetInterruptedInstance(ctx context.Context) error
+
 	// AvailabilityZone returns an underlying provider's availability zone
 	// for a machine.
 	AvailabilityZone(ctx context.Context) (string, error)
@@ -298,6 +302,22 @@ func (m *Machine) MarkForRemoval(ctx context.Context) error {
 	return result.OneError()
 }
 
+// ResetInterruptedInstance implements MachineProvisioner.ResetInterruptedInstance.
+func (m *Machine) ResetInterruptedInstance(ctx context.Context) error {
+	if m.st.facade.BestAPIVersion() < 13 {
+		return errors.NotSupportedf("replacing interrupted instances on this controller")
+	}
+	var result params.ErrorResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: m.tag.String()}},
+	}
+	err := m.st.facade.FacadeCall(ctx, "ResetInterruptedInstances", args, &result)
+	if err != nil {
+		return err
+	}
+	return result.OneError()
+}
+
 // AvailabilityZone implements MachineProvisioner.AvailabilityZone.
 func (m *Machine) AvailabilityZone(ctx context.Context) (string, error) {
 	var results params.StringResults
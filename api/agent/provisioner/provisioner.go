@@ -151,6 +151,25 @@ func (st *Client) WatchMachineErrorRetry(ctx context.Context) (watcher.NotifyWat
 	return w, nil
 }
 
+// WatchInterruptedInstances returns a NotifyWatcher that fires whenever
+// the instance status of a machine changes, so that machines with
+// interrupted instances can be replaced.
+func (st *Client) WatchInterruptedInstances(ctx context.Context) (watcher.NotifyWatcher, error) {
+	if st.facade.BestAPIVersion() < 13 {
+		return nil, errors.NotSupportedf("replacing interrupted instances on this controller")
+	}
+	var result params.NotifyWatchResult
+	err := st.facade.FacadeCall(ctx, "WatchInterruptedInstances", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if err := result.Error; err != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewNotifyWatcher(st.facade.RawAPICaller(), result)
+	return w, nil
+}
+
 // ContainerManagerConfig returns information from the model config that is
 // needed for configuring the container manager.
 func (st *Client) ContainerManagerConfig(ctx context.Context, args params.ContainerManagerConfigParams) (result params.ContainerManagerConfig, err error) {
@@ -188,6 +207,33 @@ func (st *Client) MachinesWithTransientErrors(ctx context.Context) ([]MachineSta
 	return machines, nil
 }
 
+// MachinesWithInterruptedInstances returns a slice of machines and
+// corresponding status information for those machines whose spot or
+// preemptible instance has been interrupted by the cloud.
+func (st *Client) MachinesWithInterruptedInstances(ctx context.Context) ([]MachineStatusResult, error) {
+	if st.facade.BestAPIVersion() < 13 {
+		return nil, errors.NotSupportedf("replacing interrupted instances on this controller")
+	}
+	var results params.StatusResults
+	err := st.facade.FacadeCall(ctx, "MachinesWithInterruptedInstances", nil, &results)
+	if err != nil {
+		return nil, err
+	}
+	machines := make([]MachineStatusResult, len(results.Results))
+	for i, status := range results.Results {
+		if status.Error != nil {
+			continue
+		}
+		machines[i].Machine = &Machine{
+			tag:  names.NewMachineTag(status.Id),
+			life: status.Life,
+			st:   st,
+		}
+		machines[i].Status = status
+	}
+	return machines, nil
+}
+
 // FindTools returns al ist of tools matching the specified version number and
 // series, and, arch. If arch is blank, a default will be used.
 func (st *Client) FindTools(ctx context.Context, v semversion.Number, os string, arch string) (tools.List, error) {
@@ -302,6 +348,18 @@ func (st *Client) DistributionGroupByMachineId(ctx context.Context, tags ...name
 	return results, nil
 }
 
+// EnsureWarmPool asks the controller to add machines to, or remove surplus
+// machines from, the model's warm pool so that it matches the model's
+// warm-pool config.
+func (st *Client) EnsureWarmPool(ctx context.Context) (params.WarmPoolResult, error) {
+	if st.facade.BestAPIVersion() < 12 {
+		return params.WarmPoolResult{}, errors.NotSupportedf("warm pools on this controller")
+	}
+	var result params.WarmPoolResult
+	err := st.facade.FacadeCall(ctx, "EnsureWarmPool", nil, &result)
+	return result, err
+}
+
 // CACert returns the certificate used to validate the API and state connections.
 func (st *Client) CACert(ctx context.Context) (string, error) {
 	var result params.BytesResult
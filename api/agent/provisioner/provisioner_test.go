@@ -6,6 +6,7 @@ package provisioner_test
 import (
 	"context"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -699,6 +700,122 @@ func (s *provisionerSuite) TestSupportedContainers(c *gc.C) {
 	c.Assert(determined, jc.IsTrue)
 }
 
+func (s *provisionerSuite) TestEnsureWarmPool(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	caller := s.setupCaller(ctrl)
+	s.expectCall(caller, "EnsureWarmPool", nil, params.WarmPoolResult{
+		Added:   []string{"3", "4"},
+		Removed: []string{"1"},
+	})
+	client := provisioner.NewClient(caller)
+
+	result, err := client.EnsureWarmPool(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.WarmPoolResult{
+		Added:   []string{"3", "4"},
+		Removed: []string{"1"},
+	})
+}
+
+func (s *provisionerSuite) TestEnsureWarmPoolNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	caller := mocks.NewMockAPICaller(ctrl)
+	caller.EXPECT().BestFacadeVersion("Provisioner").Return(11)
+	client := provisioner.NewClient(caller)
+
+	_, err := client.EnsureWarmPool(context.Background())
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *provisionerSuite) TestMachinesWithInterruptedInstances(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	results := params.StatusResults{
+		Results: []params.StatusResult{{
+			Id:     "666",
+			Life:   "alive",
+			Status: "interrupted",
+			Info:   "marked for termination",
+		}},
+	}
+
+	caller := s.setupCaller(ctrl)
+	s.expectCall(caller, "MachinesWithInterruptedInstances", nil, results)
+
+	client := provisioner.NewClient(caller)
+	result, err := client.MachinesWithInterruptedInstances(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 1)
+	machine := result[0].Machine
+	c.Assert(machine.Tag(), gc.Equals, names.NewMachineTag("666"))
+	c.Assert(machine.Life(), gc.Equals, life.Alive)
+	c.Assert(result[0].Status, jc.DeepEquals, results.Results[0])
+}
+
+func (s *provisionerSuite) TestMachinesWithInterruptedInstancesNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	caller := mocks.NewMockAPICaller(ctrl)
+	caller.EXPECT().BestFacadeVersion("Provisioner").Return(12)
+	client := provisioner.NewClient(caller)
+
+	_, err := client.MachinesWithInterruptedInstances(context.Background())
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *provisionerSuite) TestWatchInterruptedInstances(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	results := params.NotifyWatchResult{
+		Error: &params.Error{Message: "FAIL"},
+	}
+
+	caller := s.setupCaller(ctrl)
+	s.expectCall(caller, "WatchInterruptedInstances", nil, results)
+
+	client := provisioner.NewClient(caller)
+	_, err := client.WatchInterruptedInstances(context.Background())
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+}
+
+func (s *provisionerSuite) TestWatchInterruptedInstancesNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	caller := mocks.NewMockAPICaller(ctrl)
+	caller.EXPECT().BestFacadeVersion("Provisioner").Return(12)
+	client := provisioner.NewClient(caller)
+
+	_, err := client.WatchInterruptedInstances(context.Background())
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *provisionerSuite) TestResetInterruptedInstance(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	caller, machine := s.setupMachines(c, ctrl)
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: "machine-666"}},
+	}
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	}
+
+	s.expectCall(caller, "ResetInterruptedInstances", args, results)
+
+	err := machine.ResetInterruptedInstance(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 var _ = gc.Suite(&provisionerContainerSuite{})
 
 type provisionerContainerSuite struct {
@@ -103,6 +103,24 @@ func (st *Client) WatchFilesystems(ctx context.Context, scope names.Tag) (watche
 	return st.watchStorageEntities(ctx, "WatchFilesystems", scope)
 }
 
+// WatchVolumeResizes watches for changes to volumes scoped to the entity
+// with the specified tag, which may have resizes requested.
+func (st *Client) WatchVolumeResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("resizing storage on this controller")
+	}
+	return st.watchStorageEntities(ctx, "WatchVolumeResizes", scope)
+}
+
+// WatchFilesystemResizes watches for changes to filesystems managed by the
+// entity with the specified tag, which may have resizes requested.
+func (st *Client) WatchFilesystemResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("resizing storage on this controller")
+	}
+	return st.watchStorageEntities(ctx, "WatchFilesystemResizes", scope)
+}
+
 func (st *Client) watchStorageEntities(ctx context.Context, method string, scope names.Tag) (watcher.StringsWatcher, error) {
 	var results params.StringsWatchResults
 	args := params.Entities{
@@ -311,6 +329,29 @@ func (st *Client) RemoveVolumeParams(ctx context.Context, tags []names.VolumeTag
 	return results.Results, nil
 }
 
+// VolumeResizeParams returns the parameters for resizing the volumes with
+// the specified tags.
+func (st *Client) VolumeResizeParams(ctx context.Context, tags []names.VolumeTag) ([]params.VolumeResizeParamsResult, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("resizing storage on this controller")
+	}
+	args := params.Entities{
+		Entities: make([]params.Entity, len(tags)),
+	}
+	for i, tag := range tags {
+		args.Entities[i].Tag = tag.String()
+	}
+	var results params.VolumeResizeParamsResults
+	err := st.facade.FacadeCall(ctx, "VolumeResizeParams", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(tags) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(tags), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // FilesystemParams returns the parameters for creating the filesystems
 // with the specified tags.
 func (st *Client) FilesystemParams(ctx context.Context, tags []names.FilesystemTag) ([]params.FilesystemParamsResult, error) {
@@ -351,6 +392,29 @@ func (st *Client) RemoveFilesystemParams(ctx context.Context, tags []names.Files
 	return results.Results, nil
 }
 
+// FilesystemResizeParams returns the parameters for resizing the
+// filesystems with the specified tags.
+func (st *Client) FilesystemResizeParams(ctx context.Context, tags []names.FilesystemTag) ([]params.FilesystemResizeParamsResult, error) {
+	if st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("resizing storage on this controller")
+	}
+	args := params.Entities{
+		Entities: make([]params.Entity, len(tags)),
+	}
+	for i, tag := range tags {
+		args.Entities[i].Tag = tag.String()
+	}
+	var results params.FilesystemResizeParamsResults
+	err := st.facade.FacadeCall(ctx, "FilesystemResizeParams", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != len(tags) {
+		return nil, errors.Errorf("expected %d result(s), got %d", len(tags), len(results.Results))
+	}
+	return results.Results, nil
+}
+
 // VolumeAttachmentParams returns the parameters for creating the volume
 // attachments with the specified tags.
 func (st *Client) VolumeAttachmentParams(ctx context.Context, ids []params.MachineStorageId) ([]params.VolumeAttachmentParamsResult, error) {
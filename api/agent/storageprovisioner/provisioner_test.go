@@ -5,8 +5,8 @@ package storageprovisioner_test
 
 import (
 	"context"
-	"errors"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -518,6 +518,94 @@ func (s *provisionerSuite) TestRemoveVolumeParams(c *gc.C) {
 	}})
 }
 
+func (s *provisionerSuite) TestVolumeResizeParams(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "StorageProvisioner")
+		c.Check(version, gc.Equals, 5)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "VolumeResizeParams")
+		c.Check(arg, gc.DeepEquals, params.Entities{Entities: []params.Entity{{"volume-100"}}})
+		c.Assert(result, gc.FitsTypeOf, &params.VolumeResizeParamsResults{})
+		*(result.(*params.VolumeResizeParamsResults)) = params.VolumeResizeParamsResults{
+			Results: []params.VolumeResizeParamsResult{{
+				Result: params.VolumeResizeParams{
+					VolumeTag: "volume-100",
+					Provider:  "foo",
+					VolumeId:  "bar",
+					Size:      2048,
+				},
+			}},
+		}
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{apiCaller, 5})
+	c.Assert(err, jc.ErrorIsNil)
+	resizeParams, err := st.VolumeResizeParams(context.Background(), []names.VolumeTag{names.NewVolumeTag("100")})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(resizeParams, jc.DeepEquals, []params.VolumeResizeParamsResult{{
+		Result: params.VolumeResizeParams{
+			VolumeTag: "volume-100",
+			Provider:  "foo",
+			VolumeId:  "bar",
+			Size:      2048,
+		},
+	}})
+}
+
+func (s *provisionerSuite) TestFilesystemResizeParams(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "StorageProvisioner")
+		c.Check(version, gc.Equals, 5)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "FilesystemResizeParams")
+		c.Check(arg, gc.DeepEquals, params.Entities{Entities: []params.Entity{{"filesystem-100"}}})
+		c.Assert(result, gc.FitsTypeOf, &params.FilesystemResizeParamsResults{})
+		*(result.(*params.FilesystemResizeParamsResults)) = params.FilesystemResizeParamsResults{
+			Results: []params.FilesystemResizeParamsResult{{
+				Result: params.FilesystemResizeParams{
+					FilesystemTag: "filesystem-100",
+					Provider:      "foo",
+					FilesystemId:  "bar",
+					Size:          2048,
+				},
+			}},
+		}
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{apiCaller, 5})
+	c.Assert(err, jc.ErrorIsNil)
+	resizeParams, err := st.FilesystemResizeParams(context.Background(), []names.FilesystemTag{names.NewFilesystemTag("100")})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(resizeParams, jc.DeepEquals, []params.FilesystemResizeParamsResult{{
+		Result: params.FilesystemResizeParams{
+			FilesystemTag: "filesystem-100",
+			Provider:      "foo",
+			FilesystemId:  "bar",
+			Size:          2048,
+		},
+	}})
+}
+
+func (s *provisionerSuite) TestResizeNotSupported(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected call to %s", request)
+		return nil
+	})
+
+	st, err := storageprovisioner.NewClient(testing.BestVersionCaller{apiCaller, 4})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = st.WatchVolumeResizes(context.Background(), coretesting.ModelTag)
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+	_, err = st.WatchFilesystemResizes(context.Background(), coretesting.ModelTag)
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+	_, err = st.VolumeResizeParams(context.Background(), []names.VolumeTag{names.NewVolumeTag("100")})
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+	_, err = st.FilesystemResizeParams(context.Background(), []names.FilesystemTag{names.NewFilesystemTag("100")})
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
 func (s *provisionerSuite) TestFilesystemParams(c *gc.C) {
 	var callCount int
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
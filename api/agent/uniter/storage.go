@@ -178,3 +178,34 @@ func (sa *StorageAccessor) RemoveStorageAttachment(ctx context.Context, storageT
 	}
 	return nil
 }
+
+// ClearStorageAttachmentResized records that the unit has run its
+// storage-resized hook for the given resize generation of the storage
+// attachment with the specified unit and storage tags.
+func (sa *StorageAccessor) ClearStorageAttachmentResized(
+	ctx context.Context, storageTag names.StorageTag, unitTag names.UnitTag, generation uint64,
+) error {
+	if sa.facade.BestAPIVersion() < 22 {
+		return errors.NotSupportedf("resizing storage on this controller")
+	}
+	var results params.ErrorResults
+	args := params.StorageAttachmentsResized{
+		Attachments: []params.StorageAttachmentResized{{
+			StorageTag: storageTag.String(),
+			UnitTag:    unitTag.String(),
+			Generation: generation,
+		}},
+	}
+	err := sa.facade.FacadeCall(ctx, "ClearStorageAttachmentsResized", args, &results)
+	if err != nil {
+		return err
+	}
+	if len(results.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
@@ -258,3 +258,43 @@ func (s *storageSuite) TestRemoveStorageAttachment(c *gc.C) {
 	err := client.RemoveStorageAttachment(context.Background(), names.NewStorageTag("data/0"), names.NewUnitTag("mysql/0"))
 	c.Check(err, gc.ErrorMatches, "yoink")
 }
+
+func (s *storageSuite) TestClearStorageAttachmentResized(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "Uniter")
+		c.Check(version, gc.Equals, 22)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "ClearStorageAttachmentsResized")
+		c.Check(arg, gc.DeepEquals, params.StorageAttachmentsResized{
+			Attachments: []params.StorageAttachmentResized{{
+				StorageTag: "storage-data-0",
+				UnitTag:    "unit-mysql-0",
+				Generation: 3,
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{
+				Error: &params.Error{Message: "yoink"},
+			}},
+		}
+		return nil
+	})
+
+	caller := testing.BestVersionCaller{apiCaller, 22}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+	err := client.ClearStorageAttachmentResized(context.Background(), names.NewStorageTag("data/0"), names.NewUnitTag("mysql/0"), 3)
+	c.Check(err, gc.ErrorMatches, "yoink")
+}
+
+func (s *storageSuite) TestClearStorageAttachmentResizedNotSupported(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Fatalf("unexpected call to %s", request)
+		return nil
+	})
+
+	caller := testing.BestVersionCaller{apiCaller, 21}
+	client := uniter.NewClient(caller, names.NewUnitTag("mysql/0"))
+	err := client.ClearStorageAttachmentResized(context.Background(), names.NewStorageTag("data/0"), names.NewUnitTag("mysql/0"), 3)
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
@@ -1163,12 +1163,25 @@ func isX509Error(err error) bool {
 // object id, and the specific RPC method. It marshalls the Arguments, and will
 // unmarshall the result into the response object that is supplied.
 func (c *conn) APICall(ctx context.Context, facade string, vers int, id, method string, args, response interface{}) error {
-	err := c.client.Call(ctx, rpc.Request{
-		Type:    facade,
-		Version: vers,
-		Id:      id,
-		Action:  method,
-	}, args, response)
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = c.client.Call(ctx, rpc.Request{
+			Type:    facade,
+			Version: vers,
+			Id:      id,
+			Action:  method,
+		}, args, response)
+		retryAfter, ok := rateLimitRetryAfter(err)
+		if !ok || attempt >= maxRateLimitRetries {
+			break
+		}
+		logger.Debugf(ctx, "%s.%s rate limited, retrying after %v", facade, method, retryAfter)
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-c.clockOrDefault().After(retryAfter):
+		}
+	}
 
 	if code := params.ErrCode(err); code == params.CodeNotImplemented {
 		return errors.NewNotImplemented(fmt.Errorf("%w\nre-install your juju client to match the version running on the controller", err), "\njuju client not compatible with server")
@@ -1176,6 +1189,45 @@ func (c *conn) APICall(ctx context.Context, facade string, vers int, id, method
 	return errors.Trace(err)
 }
 
+const (
+	// maxRateLimitRetries is the number of times an API call rejected by
+	// the controller's rate limits is made before the error is returned.
+	maxRateLimitRetries = 3
+
+	// maxRateLimitRetryAfter is the longest an API call rejected by the
+	// controller's rate limits is delayed before it is retried.
+	maxRateLimitRetryAfter = time.Minute
+)
+
+// rateLimitRetryAfter returns how long to wait before retrying an API call
+// which failed with the error, and whether it should be retried at all.
+func rateLimitRetryAfter(err error) (time.Duration, bool) {
+	if !params.IsCodeRateLimitExceeded(err) {
+		return 0, false
+	}
+	infoErr, ok := errors.Cause(err).(interface {
+		UnmarshalInfo(to interface{}) error
+	})
+	if !ok {
+		return 0, false
+	}
+	var info params.RateLimitExceededErrorInfo
+	if err := infoErr.UnmarshalInfo(&info); err != nil {
+		return 0, false
+	}
+	if info.RetryAfter <= 0 || info.RetryAfter > maxRateLimitRetryAfter {
+		return 0, false
+	}
+	return info.RetryAfter, true
+}
+
+func (c *conn) clockOrDefault() clock.Clock {
+	if c.clock == nil {
+		return clock.WallClock
+	}
+	return c.clock
+}
+
 func (c *conn) Close() error {
 	err := c.client.Close()
 	select {
@@ -1220,6 +1220,33 @@ func (s *apiclientSuite) TestAPICallErrorNotImplemented(c *gc.C) {
 	c.Check(clock.waits, gc.HasLen, 0)
 }
 
+func (s *apiclientSuite) TestAPICallRateLimitedRetries(c *gc.C) {
+	clock := &fakeClock{}
+	rpcConn := newRPCConnection(apiservererrors.ServerError(apiservererrors.NewRateLimitExceededError(time.Second)))
+	conn := api.NewTestingConnection(c, api.TestingConnectionParams{
+		RPCConnection: rpcConn,
+		Clock:         clock,
+	})
+
+	err := conn.APICall(context.Background(), "facade", 1, "id", "method", nil, nil)
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(clock.waits, jc.DeepEquals, []time.Duration{time.Second})
+	rpcConn.stub.CheckCallNames(c, "facade.method", "facade.method")
+}
+
+func (s *apiclientSuite) TestAPICallRateLimitedGivesUp(c *gc.C) {
+	clock := &fakeClock{}
+	rateLimited := apiservererrors.ServerError(apiservererrors.NewRateLimitExceededError(time.Second))
+	conn := api.NewTestingConnection(c, api.TestingConnectionParams{
+		RPCConnection: newRPCConnection(rateLimited, rateLimited, rateLimited),
+		Clock:         clock,
+	})
+
+	err := conn.APICall(context.Background(), "facade", 1, "id", "method", nil, nil)
+	c.Check(params.IsCodeRateLimitExceeded(err), jc.IsTrue)
+	c.Check(clock.waits, jc.DeepEquals, []time.Duration{time.Second, time.Second})
+}
+
 func (s *apiclientSuite) TestIsBrokenOk(c *gc.C) {
 	conn := api.NewTestingConnection(c, api.TestingConnectionParams{
 		RPCConnection: newRPCConnection(),
@@ -0,0 +1,166 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admissionpolicy
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Policy is an admission policy, which denies the model-changing operations
+// described by its rule.
+type Policy struct {
+	Name string
+	// ModelUUID is the model the policy applies to, or empty if the policy
+	// applies to every model of the controller.
+	ModelUUID string
+	Rule      string
+	// Message explains to users why an operation was denied.
+	Message string
+	// CreatedBy is the user who added the policy.
+	CreatedBy string
+}
+
+// Client allows access to the AdmissionPolicy API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the AdmissionPolicy API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "AdmissionPolicy", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// AddPolicy adds the policy to its model, or to the controller if it has no
+// model.
+func (c *Client) AddPolicy(ctx context.Context, policy Policy) error {
+	in := params.AdmissionPolicies{
+		Policies: []params.AdmissionPolicy{policyToParams(policy)},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "AddAdmissionPolicies", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// RemovePolicy removes the policy with the name from the model, or from the
+// controller if the model is empty.
+func (c *Client) RemovePolicy(ctx context.Context, modelUUID, name string) error {
+	in := params.AdmissionPolicyIDs{
+		Policies: []params.AdmissionPolicyID{{
+			Name:     name,
+			ModelTag: modelTag(modelUUID),
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RemoveAdmissionPolicies", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// ListPolicies returns the policies of the model, or of the controller if
+// the model is empty, ordered by name.
+func (c *Client) ListPolicies(ctx context.Context, modelUUID string) ([]Policy, error) {
+	in := params.AdmissionPoliciesFilter{ModelTag: modelTag(modelUUID)}
+	var out params.AdmissionPoliciesResult
+	if err := c.facade.FacadeCall(ctx, "ListAdmissionPolicies", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return policiesFromParams(out.Policies)
+}
+
+// TestAdmission evaluates the policies of the model, along with the
+// candidate policies, against the operation with the fields. It returns the
+// policies which deny the operation; the operation is admitted if there are
+// none. Neither the operation nor the candidate policies are applied.
+func (c *Client) TestAdmission(
+	ctx context.Context, modelUUID, operation string, fields map[string][]string, candidates []Policy,
+) ([]Policy, error) {
+	in := params.AdmissionTest{
+		ModelTag:  modelTag(modelUUID),
+		Operation: operation,
+		Fields:    fields,
+	}
+	for _, candidate := range candidates {
+		in.Policies = append(in.Policies, policyToParams(candidate))
+	}
+	var out params.AdmissionTestResult
+	if err := c.facade.FacadeCall(ctx, "TestAdmission", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return policiesFromParams(out.Denied)
+}
+
+// modelTag returns the tag of the model, or an empty tag for the controller.
+func modelTag(modelUUID string) string {
+	if modelUUID == "" {
+		return ""
+	}
+	return names.NewModelTag(modelUUID).String()
+}
+
+func policyToParams(policy Policy) params.AdmissionPolicy {
+	return params.AdmissionPolicy{
+		Name:     policy.Name,
+		ModelTag: modelTag(policy.ModelUUID),
+		Rule:     policy.Rule,
+		Message:  policy.Message,
+	}
+}
+
+func policiesFromParams(in []params.AdmissionPolicy) ([]Policy, error) {
+	policies := make([]Policy, len(in))
+	for i, policy := range in {
+		var modelUUID string
+		if policy.ModelTag != "" {
+			tag, err := names.ParseModelTag(policy.ModelTag)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			modelUUID = tag.Id()
+		}
+		policies[i] = Policy{
+			Name:      policy.Name,
+			ModelUUID: modelUUID,
+			Rule:      policy.Rule,
+			Message:   policy.Message,
+			CreatedBy: policy.CreatedBy,
+		}
+	}
+	return policies, nil
+}
+
+// oneError returns the error of the single result.
+func oneError(out params.ErrorResults) error {
+	if len(out.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(out.Results))
+	}
+	if out.Results[0].Error != nil {
+		return apiservererrors.RestoreError(out.Results[0].Error)
+	}
+	return nil
+}
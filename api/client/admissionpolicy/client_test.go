@@ -0,0 +1,148 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admissionpolicy_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/admissionpolicy"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+var _ = gc.Suite(&clientSuite{})
+
+func (s *clientSuite) TestAddPolicy(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AdmissionPolicies{
+		Policies: []params.AdmissionPolicy{{
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+			Rule:     `constraints.zones is empty`,
+			Message:  "zones must be set",
+		}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Code: params.CodeAlreadyExists, Message: `admission policy already exists`},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddAdmissionPolicies", args, res).SetArg(3, ress).Return(nil)
+
+	client := admissionpolicy.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddPolicy(context.Background(), admissionpolicy.Policy{
+		Name:      "zones",
+		ModelUUID: coretesting.ModelTag.Id(),
+		Rule:      `constraints.zones is empty`,
+		Message:   "zones must be set",
+	})
+	c.Assert(err, jc.ErrorIs, errors.AlreadyExists)
+}
+
+func (s *clientSuite) TestRemovePolicy(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AdmissionPolicyIDs{
+		Policies: []params.AdmissionPolicyID{{Name: "stable-only"}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveAdmissionPolicies", args, res).SetArg(3, ress).Return(nil)
+
+	client := admissionpolicy.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemovePolicy(context.Background(), "", "stable-only")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestListPolicies(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AdmissionPoliciesFilter{ModelTag: coretesting.ModelTag.String()}
+	res := new(params.AdmissionPoliciesResult)
+	ress := params.AdmissionPoliciesResult{
+		Policies: []params.AdmissionPolicy{{
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+			Rule:     `constraints.zones is empty`,
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListAdmissionPolicies", args, res).SetArg(3, ress).Return(nil)
+
+	client := admissionpolicy.NewClientFromCaller(mockFacadeCaller)
+	policies, err := client.ListPolicies(context.Background(), coretesting.ModelTag.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(policies, jc.DeepEquals, []admissionpolicy.Policy{{
+		Name:      "zones",
+		ModelUUID: coretesting.ModelTag.Id(),
+		Rule:      `constraints.zones is empty`,
+	}})
+}
+
+func (s *clientSuite) TestListPoliciesError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AdmissionPoliciesFilter{}
+	res := new(params.AdmissionPoliciesResult)
+	ress := params.AdmissionPoliciesResult{
+		Error: &params.Error{Message: "boom"},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListAdmissionPolicies", args, res).SetArg(3, ress).Return(nil)
+
+	client := admissionpolicy.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.ListPolicies(context.Background(), "")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *clientSuite) TestTestAdmission(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AdmissionTest{
+		ModelTag:  coretesting.ModelTag.String(),
+		Operation: "deploy",
+		Fields:    map[string][]string{"charm.risk": {"edge"}},
+		Policies: []params.AdmissionPolicy{{
+			Name: "edge",
+			Rule: `charm.risk == "edge"`,
+		}},
+	}
+	res := new(params.AdmissionTestResult)
+	ress := params.AdmissionTestResult{
+		Denied: []params.AdmissionPolicy{{
+			Name:    "stable-only",
+			Rule:    `charm.risk != "stable"`,
+			Message: "only stable charms may be deployed",
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "TestAdmission", args, res).SetArg(3, ress).Return(nil)
+
+	client := admissionpolicy.NewClientFromCaller(mockFacadeCaller)
+	denied, err := client.TestAdmission(context.Background(), coretesting.ModelTag.Id(), "deploy",
+		map[string][]string{"charm.risk": {"edge"}},
+		[]admissionpolicy.Policy{{Name: "edge", Rule: `charm.risk == "edge"`}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(denied, jc.DeepEquals, []admissionpolicy.Policy{{
+		Name:    "stable-only",
+		Rule:    `charm.risk != "stable"`,
+		Message: "only stable charms may be deployed",
+	}})
+}
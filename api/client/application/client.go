@@ -101,6 +101,12 @@ type DeployArgs struct {
 	// may be non-empty only if NumUnits is 1.
 	AttachStorage []string
 
+	// StorageSnapshots maps storage names to the provider IDs of
+	// snapshots from which the new storage of the application unit
+	// that will be deployed is restored. This may be non-empty only
+	// if NumUnits is 1.
+	StorageSnapshots map[string]string
+
 	// EndpointBindings
 	EndpointBindings map[string]string
 
@@ -137,6 +143,14 @@ func (c *Client) Deploy(ctx context.Context, args DeployArgs) error {
 			return errors.New("cannot attach existing storage when more than one unit is requested")
 		}
 	}
+	if len(args.StorageSnapshots) > 0 {
+		if c.facade.BestAPIVersion() < 22 {
+			return errors.NotSupportedf("restoring storage from snapshots on deploy by this controller")
+		}
+		if args.NumUnits != 1 {
+			return errors.New("cannot restore storage from snapshots when more than one unit is requested")
+		}
+	}
 	attachStorage := make([]string, len(args.AttachStorage))
 	for i, id := range args.AttachStorage {
 		if !names.IsValidStorage(id) {
@@ -159,6 +173,7 @@ func (c *Client) Deploy(ctx context.Context, args DeployArgs) error {
 			Storage:          args.Storage,
 			Devices:          args.Devices,
 			AttachStorage:    attachStorage,
+			StorageSnapshots: args.StorageSnapshots,
 			EndpointBindings: args.EndpointBindings,
 			Resources:        args.Resources,
 			Force:            args.Force,
@@ -621,6 +636,111 @@ func (c *Client) ScaleApplication(ctx context.Context, in ScaleApplicationParams
 	return results.Results[0], nil
 }
 
+// AutoscalePolicy holds the autoscaling policy of a k8s application.
+type AutoscalePolicy struct {
+	// MinUnits is the lowest number of units the application is scaled to.
+	MinUnits int
+
+	// MaxUnits is the highest number of units the application is scaled to.
+	MaxUnits int
+
+	// TargetCPUPercent is the target average CPU utilisation of the units,
+	// as a percentage of the CPU they request.
+	TargetCPUPercent int
+
+	// TargetMemoryPercent is the target average memory utilisation of the
+	// units, as a percentage of the memory they request.
+	TargetMemoryPercent int
+
+	// CustomMetric is the name of a pod metric served by the custom metrics
+	// API.
+	CustomMetric string
+
+	// CustomMetricTarget is the target average value of the custom metric.
+	CustomMetricTarget float64
+}
+
+// SetAutoscalePolicy sets the autoscaling policy of the specified
+// application, replacing any existing policy.
+func (c *Client) SetAutoscalePolicy(ctx context.Context, appName string, policy AutoscalePolicy) error {
+	if c.facade.BestAPIVersion() < 21 {
+		return errors.NotSupportedf("autoscaling applications by this controller")
+	}
+	if !names.IsValidApplication(appName) {
+		return errors.NotValidf("application %q", appName)
+	}
+	args := params.SetAutoscalePoliciesArgs{
+		Args: []params.SetAutoscalePolicyArg{{
+			ApplicationTag: names.NewApplicationTag(appName).String(),
+			Policy: params.AutoscalePolicy{
+				MinUnits:            policy.MinUnits,
+				MaxUnits:            policy.MaxUnits,
+				TargetCPUPercent:    policy.TargetCPUPercent,
+				TargetMemoryPercent: policy.TargetMemoryPercent,
+				CustomMetric:        policy.CustomMetric,
+				CustomMetricTarget:  policy.CustomMetricTarget,
+			},
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "SetAutoscalePolicies", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// GetAutoscalePolicy returns the autoscaling policy of the specified
+// application.
+func (c *Client) GetAutoscalePolicy(ctx context.Context, appName string) (AutoscalePolicy, error) {
+	if c.facade.BestAPIVersion() < 21 {
+		return AutoscalePolicy{}, errors.NotSupportedf("autoscaling applications by this controller")
+	}
+	if !names.IsValidApplication(appName) {
+		return AutoscalePolicy{}, errors.NotValidf("application %q", appName)
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(appName).String()}},
+	}
+	var results params.AutoscalePolicyResults
+	if err := c.facade.FacadeCall(ctx, "GetAutoscalePolicies", args, &results); err != nil {
+		return AutoscalePolicy{}, errors.Trace(err)
+	}
+	if n := len(results.Results); n != 1 {
+		return AutoscalePolicy{}, errors.Errorf("expected 1 result, got %d", n)
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return AutoscalePolicy{}, result.Error
+	}
+	return AutoscalePolicy{
+		MinUnits:            result.Policy.MinUnits,
+		MaxUnits:            result.Policy.MaxUnits,
+		TargetCPUPercent:    result.Policy.TargetCPUPercent,
+		TargetMemoryPercent: result.Policy.TargetMemoryPercent,
+		CustomMetric:        result.Policy.CustomMetric,
+		CustomMetricTarget:  result.Policy.CustomMetricTarget,
+	}, nil
+}
+
+// UnsetAutoscalePolicy removes the autoscaling policy of the specified
+// application, leaving its scale as it is.
+func (c *Client) UnsetAutoscalePolicy(ctx context.Context, appName string) error {
+	if c.facade.BestAPIVersion() < 21 {
+		return errors.NotSupportedf("autoscaling applications by this controller")
+	}
+	if !names.IsValidApplication(appName) {
+		return errors.NotValidf("application %q", appName)
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(appName).String()}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "UnsetAutoscalePolicies", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // GetConstraints returns the constraints for the given applications.
 func (c *Client) GetConstraints(ctx context.Context, applications ...string) ([]constraints.Value, error) {
 	var allConstraints []constraints.Value
@@ -1046,6 +1166,11 @@ type DeployFromRepositoryArg struct {
 	// attached to the application unit that will be deployed. This
 	// may be non-empty only if NumUnits is 1.
 	AttachStorage []string
+	// StorageSnapshots maps storage names to the provider IDs of
+	// snapshots from which the new storage of the application unit
+	// that will be deployed is restored. This may be non-empty only
+	// if NumUnits is 1.
+	StorageSnapshots map[string]string
 	// Base describes the OS base intended to be used by the charm.
 	Base *corebase.Base
 	// Channel is the channel in the repository to deploy from.
@@ -1096,6 +1221,9 @@ type DeployFromRepositoryArg struct {
 // Where possible, more than all errors regarding argument validation
 // are returned.
 func (c *Client) DeployFromRepository(ctx context.Context, arg DeployFromRepositoryArg) (DeployInfo, []PendingResourceUpload, []error) {
+	if len(arg.StorageSnapshots) > 0 && c.facade.BestAPIVersion() < 22 {
+		return DeployInfo{}, nil, []error{errors.NotSupportedf("restoring storage from snapshots on deploy by this controller")}
+	}
 	var result params.DeployFromRepositoryResults
 	args := params.DeployFromRepositoryArgs{
 		Args: []params.DeployFromRepositoryArg{paramsFromDeployFromRepositoryArg(arg)},
@@ -1161,6 +1289,7 @@ func paramsFromDeployFromRepositoryArg(arg DeployFromRepositoryArg) params.Deplo
 		CharmName:        arg.CharmName,
 		ApplicationName:  arg.ApplicationName,
 		AttachStorage:    arg.AttachStorage,
+		StorageSnapshots: arg.StorageSnapshots,
 		Base:             b,
 		Channel:          arg.Channel,
 		ConfigYAML:       arg.ConfigYAML,
@@ -154,6 +154,77 @@ func (s *applicationSuite) TestDeployAttachStorageMultipleUnits(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "cannot attach existing storage when more than one unit is requested")
 }
 
+func (s *applicationSuite) TestDeployStorageSnapshots(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: make([]params.ErrorResult, 1)}
+
+	deployArgs := params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{{
+			CharmURL:         "ch:a-charm-1",
+			CharmOrigin:      &params.CharmOrigin{Source: "charm-hub"},
+			ApplicationName:  "applicationA",
+			NumUnits:         1,
+			AttachStorage:    []string{},
+			StorageSnapshots: map[string]string{"data": "snap-0"},
+		}},
+	}
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(22)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "Deploy", deployArgs, result).SetArg(3, results).Return(nil)
+
+	args := application.DeployArgs{
+		CharmID: application.CharmID{
+			URL: "ch:a-charm-1",
+		},
+		CharmOrigin: apicharm.Origin{
+			Source: apicharm.OriginCharmHub,
+		},
+		ApplicationName:  "applicationA",
+		NumUnits:         1,
+		StorageSnapshots: map[string]string{"data": "snap-0"},
+	}
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.Deploy(context.Background(), args)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestDeployStorageSnapshotsNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+
+	args := application.DeployArgs{
+		NumUnits:         1,
+		StorageSnapshots: map[string]string{"data": "snap-0"},
+	}
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.Deploy(context.Background(), args)
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *applicationSuite) TestDeployStorageSnapshotsMultipleUnits(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(22)
+
+	args := application.DeployArgs{
+		NumUnits:         2,
+		StorageSnapshots: map[string]string{"data": "snap-0"},
+	}
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.Deploy(context.Background(), args)
+	c.Assert(err, gc.ErrorMatches, "cannot restore storage from snapshots when more than one unit is requested")
+}
+
 func (s *applicationSuite) TestAddUnits(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -977,6 +1048,94 @@ func (s *applicationSuite) TestScaleApplication(c *gc.C) {
 	})
 }
 
+func (s *applicationSuite) TestSetAutoscalePolicy(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.SetAutoscalePoliciesArgs{
+		Args: []params.SetAutoscalePolicyArg{{
+			ApplicationTag: "application-foo",
+			Policy: params.AutoscalePolicy{
+				MinUnits:         1,
+				MaxUnits:         5,
+				TargetCPUPercent: 70,
+			},
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetAutoscalePolicies", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetAutoscalePolicy(context.Background(), "foo", application.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         5,
+		TargetCPUPercent: 70,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestSetAutoscalePolicyNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(20)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetAutoscalePolicy(context.Background(), "foo", application.AutoscalePolicy{})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *applicationSuite) TestGetAutoscalePolicy(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}}
+	result := new(params.AutoscalePolicyResults)
+	results := params.AutoscalePolicyResults{
+		Results: []params.AutoscalePolicyResult{{
+			Policy: &params.AutoscalePolicy{
+				MinUnits:           2,
+				MaxUnits:           4,
+				CustomMetric:       "rps",
+				CustomMetricTarget: 100,
+			},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetAutoscalePolicies", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	policy, err := client.GetAutoscalePolicy(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(policy, jc.DeepEquals, application.AutoscalePolicy{
+		MinUnits:           2,
+		MaxUnits:           4,
+		CustomMetric:       "rps",
+		CustomMetricTarget: 100,
+	})
+}
+
+func (s *applicationSuite) TestUnsetAutoscalePolicy(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UnsetAutoscalePolicies", args, result).SetArg(3, results).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.UnsetAutoscalePolicy(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *applicationSuite) TestChangeScaleApplication(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -1390,6 +1549,23 @@ func (s *applicationSuite) TestLeader(c *gc.C) {
 	c.Assert(obtainedUnit, gc.Equals, "ubuntu/42")
 }
 
+func (s *applicationSuite) TestDeployFromRepositoryStorageSnapshotsNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(21)
+
+	arg := application.DeployFromRepositoryArg{
+		CharmName:        "ubuntu",
+		StorageSnapshots: map[string]string{"data": "snap-0"},
+	}
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	_, _, errs := client.DeployFromRepository(context.Background(), arg)
+	c.Assert(errs, gc.HasLen, 1)
+	c.Assert(errs[0], jc.ErrorIs, errors.NotSupported)
+}
+
 func (s *applicationSuite) TestDeployFromRepository(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -0,0 +1,245 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewallrules
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// CIDRSet is a named set of CIDRs which firewall rules may allow ingress
+// from.
+type CIDRSet struct {
+	Name  string
+	CIDRs []string
+}
+
+// Rule allows ingress to the ports of an application endpoint from a set of
+// CIDRs and CIDR sets, whether or not the application is exposed.
+type Rule struct {
+	Application string
+	// Endpoint is the endpoint the rule applies to, or empty if the rule
+	// applies to all the endpoints of the application.
+	Endpoint string
+	// PortRange restricts the rule to the port range. If nil, the rule
+	// applies to the ports opened by the application's units.
+	PortRange *network.PortRange
+	CIDRs     []string
+	CIDRSets  []string
+	// SourceCIDRs is the effective list of CIDRs allowed ingress by the
+	// rule, including the CIDRs of its CIDR sets. It is ignored when
+	// setting rules.
+	SourceCIDRs []string
+}
+
+// MachineIngressRule is an ingress rule applied to a machine.
+type MachineIngressRule struct {
+	Application string
+	// Source is either "expose" or "firewall-rule".
+	Source      string
+	PortRange   network.PortRange
+	SourceCIDRs []string
+}
+
+// MachineRules holds the effective ingress rules of a machine, or the
+// error which occurred getting them.
+type MachineRules struct {
+	Machine string
+	Rules   []MachineIngressRule
+	Error   error
+}
+
+// Client allows access to the FirewallRules API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the FirewallRules API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "FirewallRules", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// SetCIDRSet creates the named CIDR set, or replaces the CIDRs of an
+// existing set.
+func (c *Client) SetCIDRSet(ctx context.Context, name string, cidrs []string) error {
+	in := params.CIDRSets{
+		Sets: []params.CIDRSet{{Name: name, CIDRs: cidrs}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "SetCIDRSets", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// RemoveCIDRSet removes the named CIDR set.
+func (c *Client) RemoveCIDRSet(ctx context.Context, name string) error {
+	in := params.CIDRSetNames{Names: []string{name}}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RemoveCIDRSets", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// CIDRSets returns the CIDR sets of the model, ordered by name.
+func (c *Client) CIDRSets(ctx context.Context) ([]CIDRSet, error) {
+	var out params.CIDRSetsResult
+	if err := c.facade.FacadeCall(ctx, "CIDRSets", nil, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	sets := make([]CIDRSet, len(out.Sets))
+	for i, set := range out.Sets {
+		sets[i] = CIDRSet{
+			Name:  set.Name,
+			CIDRs: set.CIDRs,
+		}
+	}
+	return sets, nil
+}
+
+// SetFirewallRule adds the rule to its application, replacing any rule
+// with the same endpoint and port range.
+func (c *Client) SetFirewallRule(ctx context.Context, rule Rule) error {
+	in := params.ApplicationFirewallRules{
+		Rules: []params.ApplicationFirewallRule{{
+			ApplicationTag: names.NewApplicationTag(rule.Application).String(),
+			Endpoint:       rule.Endpoint,
+			PortRange:      portRangeToParams(rule.PortRange),
+			CIDRs:          rule.CIDRs,
+			CIDRSets:       rule.CIDRSets,
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "SetApplicationFirewallRules", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// RemoveFirewallRule removes the rule of the application for the endpoint
+// and port range.
+func (c *Client) RemoveFirewallRule(ctx context.Context, application, endpoint string, portRange *network.PortRange) error {
+	in := params.ApplicationFirewallRuleIDs{
+		Rules: []params.ApplicationFirewallRuleID{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			Endpoint:       endpoint,
+			PortRange:      portRangeToParams(portRange),
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RemoveApplicationFirewallRules", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// FirewallRules returns the firewall rules of all applications in the
+// model, ordered by application.
+func (c *Client) FirewallRules(ctx context.Context) ([]Rule, error) {
+	var out params.ApplicationFirewallRulesResult
+	if err := c.facade.FacadeCall(ctx, "ApplicationFirewallRules", nil, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	rules := make([]Rule, len(out.Rules))
+	for i, rule := range out.Rules {
+		appTag, err := names.ParseApplicationTag(rule.ApplicationTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		rules[i] = Rule{
+			Application: appTag.Name,
+			Endpoint:    rule.Endpoint,
+			CIDRs:       rule.CIDRs,
+			CIDRSets:    rule.CIDRSets,
+			SourceCIDRs: rule.SourceCIDRs,
+		}
+		if rule.PortRange != nil {
+			portRange := rule.PortRange.NetworkPortRange()
+			rules[i].PortRange = &portRange
+		}
+	}
+	return rules, nil
+}
+
+// MachineFirewallRules returns the effective ingress rules of the
+// machines, or of all machines in the model if none are given.
+func (c *Client) MachineFirewallRules(ctx context.Context, machines ...string) ([]MachineRules, error) {
+	in := params.Entities{
+		Entities: make([]params.Entity, len(machines)),
+	}
+	for i, machine := range machines {
+		if !names.IsValidMachine(machine) {
+			return nil, errors.NotValidf("machine %q", machine)
+		}
+		in.Entities[i].Tag = names.NewMachineTag(machine).String()
+	}
+	var out params.MachineFirewallRulesResults
+	if err := c.facade.FacadeCall(ctx, "MachineFirewallRules", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]MachineRules, len(out.Results))
+	for i, machineResult := range out.Results {
+		machineTag, err := names.ParseMachineTag(machineResult.MachineTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[i].Machine = machineTag.Id()
+		if machineResult.Error != nil {
+			result[i].Error = apiservererrors.RestoreError(machineResult.Error)
+			continue
+		}
+		result[i].Rules = make([]MachineIngressRule, len(machineResult.Rules))
+		for j, rule := range machineResult.Rules {
+			result[i].Rules[j] = MachineIngressRule{
+				Application: rule.Application,
+				Source:      rule.Source,
+				PortRange:   rule.PortRange.NetworkPortRange(),
+				SourceCIDRs: rule.SourceCIDRs,
+			}
+		}
+	}
+	return result, nil
+}
+
+func portRangeToParams(portRange *network.PortRange) *params.PortRange {
+	if portRange == nil {
+		return nil
+	}
+	arg := params.FromNetworkPortRange(*portRange)
+	return &arg
+}
+
+// oneError returns the error of the single result.
+func oneError(out params.ErrorResults) error {
+	if len(out.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(out.Results))
+	}
+	if out.Results[0].Error != nil {
+		return apiservererrors.RestoreError(out.Results[0].Error)
+	}
+	return nil
+}
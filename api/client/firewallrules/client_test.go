@@ -0,0 +1,200 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewallrules_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/firewallrules"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+var _ = gc.Suite(&clientSuite{})
+
+func (s *clientSuite) TestSetCIDRSet(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.CIDRSets{
+		Sets: []params.CIDRSet{{Name: "office", CIDRs: []string{"10.0.0.0/8"}}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Code: params.CodeNotValid, Message: `cidr "10.0.0.0" not valid`},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetCIDRSets", args, res).SetArg(3, ress).Return(nil)
+
+	client := firewallrules.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetCIDRSet(context.Background(), "office", []string{"10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *clientSuite) TestRemoveCIDRSet(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.CIDRSetNames{Names: []string{"office"}}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveCIDRSets", args, res).SetArg(3, ress).Return(nil)
+
+	client := firewallrules.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveCIDRSet(context.Background(), "office")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestCIDRSets(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.CIDRSetsResult)
+	ress := params.CIDRSetsResult{Sets: []params.CIDRSet{{
+		Name:  "office",
+		CIDRs: []string{"10.0.0.0/8"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CIDRSets", nil, res).SetArg(3, ress).Return(nil)
+
+	client := firewallrules.NewClientFromCaller(mockFacadeCaller)
+	sets, err := client.CIDRSets(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sets, jc.DeepEquals, []firewallrules.CIDRSet{{
+		Name:  "office",
+		CIDRs: []string{"10.0.0.0/8"},
+	}})
+}
+
+func (s *clientSuite) TestSetFirewallRule(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ApplicationFirewallRules{
+		Rules: []params.ApplicationFirewallRule{{
+			ApplicationTag: "application-postgresql",
+			Endpoint:       "db",
+			PortRange:      &params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+			CIDRs:          []string{"10.0.0.0/8"},
+			CIDRSets:       []string{"office"},
+		}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetApplicationFirewallRules", args, res).SetArg(3, ress).Return(nil)
+
+	portRange := network.MustParsePortRange("5432/tcp")
+	client := firewallrules.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetFirewallRule(context.Background(), firewallrules.Rule{
+		Application: "postgresql",
+		Endpoint:    "db",
+		PortRange:   &portRange,
+		CIDRs:       []string{"10.0.0.0/8"},
+		CIDRSets:    []string{"office"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestRemoveFirewallRule(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ApplicationFirewallRuleIDs{
+		Rules: []params.ApplicationFirewallRuleID{{
+			ApplicationTag: "application-wordpress",
+		}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Code: params.CodeNotFound, Message: `firewall rule not found`},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveApplicationFirewallRules", args, res).SetArg(3, ress).Return(nil)
+
+	client := firewallrules.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveFirewallRule(context.Background(), "wordpress", "", nil)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *clientSuite) TestFirewallRules(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.ApplicationFirewallRulesResult)
+	ress := params.ApplicationFirewallRulesResult{Rules: []params.ApplicationFirewallRule{{
+		ApplicationTag: "application-postgresql",
+		Endpoint:       "db",
+		PortRange:      &params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+		CIDRSets:       []string{"office"},
+		SourceCIDRs:    []string{"10.0.0.0/8"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApplicationFirewallRules", nil, res).SetArg(3, ress).Return(nil)
+
+	portRange := network.MustParsePortRange("5432/tcp")
+	client := firewallrules.NewClientFromCaller(mockFacadeCaller)
+	rules, err := client.FirewallRules(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(rules, jc.DeepEquals, []firewallrules.Rule{{
+		Application: "postgresql",
+		Endpoint:    "db",
+		PortRange:   &portRange,
+		CIDRSets:    []string{"office"},
+		SourceCIDRs: []string{"10.0.0.0/8"},
+	}})
+}
+
+func (s *clientSuite) TestMachineFirewallRules(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}}}
+	res := new(params.MachineFirewallRulesResults)
+	ress := params.MachineFirewallRulesResults{Results: []params.MachineFirewallRulesResult{{
+		MachineTag: "machine-0",
+		Rules: []params.MachineIngressRule{{
+			Application: "wordpress",
+			Source:      "expose",
+			PortRange:   params.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+			SourceCIDRs: []string{"0.0.0.0/0"},
+		}},
+	}, {
+		MachineTag: "machine-1",
+		Error:      &params.Error{Code: params.CodeNotFound, Message: `machine not found`},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "MachineFirewallRules", args, res).SetArg(3, ress).Return(nil)
+
+	client := firewallrules.NewClientFromCaller(mockFacadeCaller)
+	result, err := client.MachineFirewallRules(context.Background(), "0", "1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.HasLen, 2)
+	c.Check(result[0], jc.DeepEquals, firewallrules.MachineRules{
+		Machine: "0",
+		Rules: []firewallrules.MachineIngressRule{{
+			Application: "wordpress",
+			Source:      "expose",
+			PortRange:   network.MustParsePortRange("80/tcp"),
+			SourceCIDRs: []string{"0.0.0.0/0"},
+		}},
+	})
+	c.Check(result[1].Machine, gc.Equals, "1")
+	c.Check(result[1].Error, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *clientSuite) TestMachineFirewallRulesInvalidMachine(c *gc.C) {
+	client := firewallrules.NewClientFromCaller(nil)
+	_, err := client.MachineFirewallRules(context.Background(), "wordpress/0")
+	c.Assert(err, gc.ErrorMatches, `machine "wordpress/0" not valid`)
+}
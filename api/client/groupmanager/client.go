@@ -0,0 +1,101 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package groupmanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// GroupGrant is access granted to a group of the controller's OpenID
+// Connect identity provider on a model or the controller.
+type GroupGrant struct {
+	Group  string
+	Access string
+	Target names.Tag
+}
+
+// Client allows access to the GroupManager API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the GroupManager API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "GroupManager", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// GrantGroup grants the access to the group on each of the targets, which
+// are models or the controller.
+func (c *Client) GrantGroup(ctx context.Context, group, access string, targets ...names.Tag) error {
+	return c.changeGroupGrants(ctx, "GrantGroups", group, access, targets)
+}
+
+// RevokeGroup revokes the access of the group on each of the targets,
+// which are models or the controller.
+func (c *Client) RevokeGroup(ctx context.Context, group string, targets ...names.Tag) error {
+	return c.changeGroupGrants(ctx, "RevokeGroups", group, "", targets)
+}
+
+func (c *Client) changeGroupGrants(ctx context.Context, method, group, access string, targets []names.Tag) error {
+	in := params.GroupGrants{
+		Grants: make([]params.GroupGrant, len(targets)),
+	}
+	for i, target := range targets {
+		in.Grants[i] = params.GroupGrant{
+			Group:     group,
+			Access:    access,
+			TargetTag: target.String(),
+		}
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, method, in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	if len(out.Results) != len(targets) {
+		return errors.Errorf("expected %d results, got %d", len(targets), len(out.Results))
+	}
+	return errors.Trace(out.Combine())
+}
+
+// ListGroupGrants returns the grants of the group, or of every group if it
+// is empty.
+func (c *Client) ListGroupGrants(ctx context.Context, group string) ([]GroupGrant, error) {
+	in := params.GroupGrantsFilter{Group: group}
+	var out params.GroupGrantsResult
+	if err := c.facade.FacadeCall(ctx, "ListGroupGrants", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	grants := make([]GroupGrant, len(out.Grants))
+	for i, grant := range out.Grants {
+		target, err := names.ParseTag(grant.TargetTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		grants[i] = GroupGrant{
+			Group:  grant.Group,
+			Access: grant.Access,
+			Target: target,
+		}
+	}
+	return grants, nil
+}
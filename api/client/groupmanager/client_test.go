@@ -0,0 +1,118 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package groupmanager_test
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/groupmanager"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+var _ = gc.Suite(&clientSuite{})
+
+const modelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+func (s *clientSuite) TestGrantGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.GroupGrants{
+		Grants: []params.GroupGrant{{
+			Group:     "devs",
+			Access:    "write",
+			TargetTag: names.NewModelTag(modelUUID).String(),
+		}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GrantGroups", args, res).SetArg(3, ress).Return(nil)
+
+	client := groupmanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.GrantGroup(context.Background(), "devs", "write", names.NewModelTag(modelUUID))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestRevokeGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.GroupGrants{
+		Grants: []params.GroupGrant{{
+			Group:     "devs",
+			TargetTag: names.NewControllerTag(modelUUID).String(),
+		}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Code: params.CodeNotFound, Message: "group grant not found"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RevokeGroups", args, res).SetArg(3, ress).Return(nil)
+
+	client := groupmanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RevokeGroup(context.Background(), "devs", names.NewControllerTag(modelUUID))
+	c.Assert(err, gc.ErrorMatches, "group grant not found")
+}
+
+func (s *clientSuite) TestGrantGroupResultCount(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GrantGroups", gomock.Any(), res).SetArg(3, ress).Return(nil)
+
+	client := groupmanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.GrantGroup(context.Background(), "devs", "read", names.NewModelTag(modelUUID))
+	c.Assert(err, gc.ErrorMatches, "expected 1 results, got 0")
+}
+
+func (s *clientSuite) TestListGroupGrants(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.GroupGrantsFilter{Group: "devs"}
+	res := new(params.GroupGrantsResult)
+	ress := params.GroupGrantsResult{Grants: []params.GroupGrant{{
+		Group:     "devs",
+		Access:    "write",
+		TargetTag: names.NewModelTag(modelUUID).String(),
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListGroupGrants", args, res).SetArg(3, ress).Return(nil)
+
+	client := groupmanager.NewClientFromCaller(mockFacadeCaller)
+	grants, err := client.ListGroupGrants(context.Background(), "devs")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, jc.DeepEquals, []groupmanager.GroupGrant{{
+		Group:  "devs",
+		Access: "write",
+		Target: names.NewModelTag(modelUUID),
+	}})
+}
+
+func (s *clientSuite) TestListGroupGrantsError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.GroupGrantsResult)
+	ress := params.GroupGrantsResult{Error: &params.Error{Code: params.CodeUnauthorized, Message: "permission denied"}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListGroupGrants", params.GroupGrantsFilter{}, res).SetArg(3, ress).Return(nil)
+
+	client := groupmanager.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.ListGroupGrants(context.Background(), "")
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -126,3 +128,75 @@ func (c *Client) RetryProvisioning(ctx context.Context, all bool, machines ...na
 	err := c.facade.FacadeCall(ctx, "RetryProvisioning", p, &results)
 	return results.Results, err
 }
+
+// InstanceTypes returns the instance types available in the model's cloud
+// region that satisfy each of the input constraints.
+func (c *Client) InstanceTypes(ctx context.Context, cons []constraints.Value) ([]params.InstanceTypesResult, error) {
+	args := params.ModelInstanceTypesConstraints{
+		Constraints: make([]params.ModelInstanceTypesConstraint, len(cons)),
+	}
+	for i, value := range cons {
+		args.Constraints[i].Value = &value
+	}
+	var results params.InstanceTypesResults
+	if err := c.facade.FacadeCall(ctx, "InstanceTypes", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(cons) {
+		return nil, errors.Errorf("expected %d result, got %d", len(cons), len(results.Results))
+	}
+	return results.Results, nil
+}
+
+// PriceCatalogue returns the prices of instance types and volumes
+// published by the pricing API of the model's cloud provider. A
+// NotSupported error is returned if the provider has no pricing API.
+func (c *Client) PriceCatalogue(ctx context.Context) (*pricing.Catalogue, error) {
+	if c.facade.BestAPIVersion() < 12 {
+		return nil, errors.NotSupportedf("price catalogue on this version of Juju")
+	}
+	var result params.PriceCatalogueResult
+	if err := c.facade.FacadeCall(ctx, "PriceCatalogue", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		if params.IsCodeNotSupported(result.Error) {
+			return nil, errors.NotSupportedf("price catalogue")
+		}
+		return nil, errors.Trace(result.Error)
+	}
+	catalogue := &pricing.Catalogue{
+		Currency: result.Result.Currency,
+		Regions:  make(map[string]pricing.RegionPrices, len(result.Result.Regions)),
+	}
+	for region, prices := range result.Result.Regions {
+		catalogue.Regions[region] = pricing.RegionPrices{
+			InstanceTypes: prices.InstanceTypes,
+			Volumes:       prices.Volumes,
+		}
+	}
+	return catalogue, nil
+}
+
+// MachineUtilisation returns a summary of the CPU and memory utilisation
+// reported by the agents of the given machines over the model's
+// rightsizing window.
+func (c *Client) MachineUtilisation(ctx context.Context, machines []names.MachineTag) ([]params.MachineUtilisationResult, error) {
+	if c.facade.BestAPIVersion() < 13 {
+		return nil, errors.NotSupportedf("machine utilisation on this version of Juju")
+	}
+	args := params.Entities{
+		Entities: make([]params.Entity, len(machines)),
+	}
+	for i, machine := range machines {
+		args.Entities[i].Tag = machine.String()
+	}
+	var results params.MachineUtilisationResults
+	if err := c.facade.FacadeCall(ctx, "MachineUtilisation", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(machines) {
+		return nil, errors.Errorf("expected %d result, got %d", len(machines), len(results.Results))
+	}
+	return results.Results, nil
+}
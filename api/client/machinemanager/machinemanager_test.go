@@ -16,6 +16,8 @@ import (
 
 	basemocks "github.com/juju/juju/api/base/mocks"
 	"github.com/juju/juju/api/client/machinemanager"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/rpc/params"
 )
@@ -250,3 +252,124 @@ func (s *MachinemanagerSuite) TestDestroyMachinesWithParamsNilWait(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(results, jc.DeepEquals, expected)
 }
+
+func (s *MachinemanagerSuite) TestInstanceTypes(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cons := constraints.MustParse("cores=2 mem=8G")
+	args := params.ModelInstanceTypesConstraints{
+		Constraints: []params.ModelInstanceTypesConstraint{{Value: &cons}},
+	}
+	results := params.InstanceTypesResults{
+		Results: []params.InstanceTypesResult{{
+			InstanceTypes: []params.InstanceType{{Name: "m5.large", CPUCores: 2, Memory: 8192}},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "InstanceTypes", args, gomock.Any()).SetArg(3, results).Return(nil)
+	client := machinemanager.NewClientFromCaller(mockFacadeCaller)
+
+	result, err := client.InstanceTypes(context.Background(), []constraints.Value{cons})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, results.Results)
+}
+
+func (s *MachinemanagerSuite) TestPriceCatalogue(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := params.PriceCatalogueResult{
+		Result: &params.PriceCatalogue{
+			Currency: "USD",
+			Regions: map[string]params.RegionPrices{
+				"westus": {
+					InstanceTypes: map[string]float64{"Standard_D2s_v3": 0.096},
+					Volumes:       map[string]float64{"azure": 0.05},
+				},
+			},
+		},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(12)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "PriceCatalogue", nil, gomock.Any()).SetArg(3, result).Return(nil)
+	client := machinemanager.NewClientFromCaller(mockFacadeCaller)
+
+	catalogue, err := client.PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(catalogue, jc.DeepEquals, &pricing.Catalogue{
+		Currency: "USD",
+		Regions: map[string]pricing.RegionPrices{
+			"westus": {
+				InstanceTypes: map[string]float64{"Standard_D2s_v3": 0.096},
+				Volumes:       map[string]float64{"azure": 0.05},
+			},
+		},
+	})
+}
+
+func (s *MachinemanagerSuite) TestPriceCatalogueProviderNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	result := params.PriceCatalogueResult{
+		Error: &params.Error{Message: "price catalogue not supported", Code: params.CodeNotSupported},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(12)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "PriceCatalogue", nil, gomock.Any()).SetArg(3, result).Return(nil)
+	client := machinemanager.NewClientFromCaller(mockFacadeCaller)
+
+	_, err := client.PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *MachinemanagerSuite) TestPriceCatalogueVersionNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(11)
+	client := machinemanager.NewClientFromCaller(mockFacadeCaller)
+
+	_, err := client.PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *MachinemanagerSuite) TestMachineUtilisation(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}},
+	}
+	result := params.MachineUtilisationResults{
+		Results: []params.MachineUtilisationResult{{
+			Result: &params.MachineUtilisation{Samples: 20, PeakCPU: 35, PeakMemory: 60},
+		}, {
+			Error: &params.Error{Message: "machine 1 not found", Code: params.CodeNotFound},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(13)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "MachineUtilisation", args, gomock.Any()).SetArg(3, result).Return(nil)
+	client := machinemanager.NewClientFromCaller(mockFacadeCaller)
+
+	results, err := client.MachineUtilisation(context.Background(), []names.MachineTag{
+		names.NewMachineTag("0"), names.NewMachineTag("1"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, result.Results)
+}
+
+func (s *MachinemanagerSuite) TestMachineUtilisationNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(12)
+	client := machinemanager.NewClientFromCaller(mockFacadeCaller)
+
+	_, err := client.MachineUtilisation(context.Background(), []names.MachineTag{names.NewMachineTag("0")})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
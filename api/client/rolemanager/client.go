@@ -0,0 +1,173 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rolemanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Role is a named set of API methods which may be granted to users on
+// models or the controller.
+type Role struct {
+	Name        string
+	Description string
+	// Methods are given as "Facade.Method", or "Facade.*" for every method
+	// of the facade.
+	Methods []string
+}
+
+// RoleGrant is a role granted to a user on a model or the controller.
+type RoleGrant struct {
+	Role   string
+	User   names.UserTag
+	Target names.Tag
+}
+
+// Client allows access to the RoleManager API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the RoleManager API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "RoleManager", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// AddRole adds the role.
+func (c *Client) AddRole(ctx context.Context, role Role) error {
+	in := params.AddRoles{
+		Roles: []params.Role{{
+			Name:        role.Name,
+			Description: role.Description,
+			Methods:     role.Methods,
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "AddRoles", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// RemoveRole removes the role, revoking it from every user it was granted
+// to.
+func (c *Client) RemoveRole(ctx context.Context, name string) error {
+	in := params.RoleNames{Names: []string{name}}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RemoveRoles", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(oneError(out))
+}
+
+// ListRoles returns every role, ordered by name.
+func (c *Client) ListRoles(ctx context.Context) ([]Role, error) {
+	var out params.RolesResult
+	if err := c.facade.FacadeCall(ctx, "ListRoles", nil, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	roles := make([]Role, len(out.Roles))
+	for i, role := range out.Roles {
+		roles[i] = Role{
+			Name:        role.Name,
+			Description: role.Description,
+			Methods:     role.Methods,
+		}
+	}
+	return roles, nil
+}
+
+// GrantRole grants the role to the user on each of the targets, which are
+// models or the controller.
+func (c *Client) GrantRole(ctx context.Context, role string, user names.UserTag, targets ...names.Tag) error {
+	return c.changeRoleGrants(ctx, "GrantRoles", role, user, targets)
+}
+
+// RevokeRole revokes the role from the user on each of the targets, which
+// are models or the controller.
+func (c *Client) RevokeRole(ctx context.Context, role string, user names.UserTag, targets ...names.Tag) error {
+	return c.changeRoleGrants(ctx, "RevokeRoles", role, user, targets)
+}
+
+func (c *Client) changeRoleGrants(ctx context.Context, method, role string, user names.UserTag, targets []names.Tag) error {
+	in := params.RoleGrants{
+		Grants: make([]params.RoleGrant, len(targets)),
+	}
+	for i, target := range targets {
+		in.Grants[i] = params.RoleGrant{
+			Role:      role,
+			UserTag:   user.String(),
+			TargetTag: target.String(),
+		}
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, method, in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	if len(out.Results) != len(targets) {
+		return errors.Errorf("expected %d results, got %d", len(targets), len(out.Results))
+	}
+	return errors.Trace(out.Combine())
+}
+
+// ListRoleGrants returns the grants of the role, or of every role if it is
+// empty. Controller superusers see every grant, other users see the grants
+// made to them.
+func (c *Client) ListRoleGrants(ctx context.Context, role string) ([]RoleGrant, error) {
+	in := params.RoleGrantsFilter{Role: role}
+	var out params.RoleGrantsResult
+	if err := c.facade.FacadeCall(ctx, "ListRoleGrants", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	grants := make([]RoleGrant, len(out.Grants))
+	for i, grant := range out.Grants {
+		user, err := names.ParseUserTag(grant.UserTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		target, err := names.ParseTag(grant.TargetTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		grants[i] = RoleGrant{
+			Role:   grant.Role,
+			User:   user,
+			Target: target,
+		}
+	}
+	return grants, nil
+}
+
+// oneError returns the error of the single result.
+func oneError(out params.ErrorResults) error {
+	if len(out.Results) != 1 {
+		return errors.Errorf("expected 1 result, got %d", len(out.Results))
+	}
+	if out.Results[0].Error != nil {
+		return apiservererrors.RestoreError(out.Results[0].Error)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rolemanager_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/rolemanager"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+var _ = gc.Suite(&clientSuite{})
+
+func (s *clientSuite) TestAddRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.AddRoles{
+		Roles: []params.Role{{
+			Name:        "operator",
+			Description: "Operates applications",
+			Methods:     []string{"Application.Get"},
+		}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Code: params.CodeAlreadyExists, Message: `role "operator" already exists`},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddRoles", args, res).SetArg(3, ress).Return(nil)
+
+	client := rolemanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddRole(context.Background(), rolemanager.Role{
+		Name:        "operator",
+		Description: "Operates applications",
+		Methods:     []string{"Application.Get"},
+	})
+	c.Assert(err, jc.ErrorIs, errors.AlreadyExists)
+}
+
+func (s *clientSuite) TestRemoveRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RoleNames{Names: []string{"operator"}}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveRoles", args, res).SetArg(3, ress).Return(nil)
+
+	client := rolemanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RemoveRole(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestListRoles(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.RolesResult)
+	ress := params.RolesResult{Roles: []params.Role{{
+		Name:    "operator",
+		Methods: []string{"Action.*"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListRoles", nil, res).SetArg(3, ress).Return(nil)
+
+	client := rolemanager.NewClientFromCaller(mockFacadeCaller)
+	roles, err := client.ListRoles(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(roles, jc.DeepEquals, []rolemanager.Role{{
+		Name:    "operator",
+		Methods: []string{"Action.*"},
+	}})
+}
+
+func (s *clientSuite) TestGrantRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	modelTag := names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d")
+	controllerTag := names.NewControllerTag("deadbeef-1bad-500d-9000-4b1d0d06f00d")
+	args := params.RoleGrants{Grants: []params.RoleGrant{{
+		Role:      "operator",
+		UserTag:   "user-bob",
+		TargetTag: modelTag.String(),
+	}, {
+		Role:      "operator",
+		UserTag:   "user-bob",
+		TargetTag: controllerTag.String(),
+	}}}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}, {
+		Error: &params.Error{Message: "permission denied", Code: params.CodeUnauthorized},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GrantRoles", args, res).SetArg(3, ress).Return(nil)
+
+	client := rolemanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.GrantRole(context.Background(), "operator", names.NewUserTag("bob"), modelTag, controllerTag)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *clientSuite) TestRevokeRole(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	modelTag := names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d")
+	args := params.RoleGrants{Grants: []params.RoleGrant{{
+		Role:      "operator",
+		UserTag:   "user-bob",
+		TargetTag: modelTag.String(),
+	}}}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RevokeRoles", args, res).SetArg(3, ress).Return(nil)
+
+	client := rolemanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RevokeRole(context.Background(), "operator", names.NewUserTag("bob"), modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestListRoleGrants(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RoleGrantsFilter{Role: "operator"}
+	res := new(params.RoleGrantsResult)
+	ress := params.RoleGrantsResult{Grants: []params.RoleGrant{{
+		Role:      "operator",
+		UserTag:   "user-bob",
+		TargetTag: "model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListRoleGrants", args, res).SetArg(3, ress).Return(nil)
+
+	client := rolemanager.NewClientFromCaller(mockFacadeCaller)
+	grants, err := client.ListRoleGrants(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, jc.DeepEquals, []rolemanager.RoleGrant{{
+		Role:   "operator",
+		User:   names.NewUserTag("bob"),
+		Target: names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"),
+	}})
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccountmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the ServiceAccountManager API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the ServiceAccountManager API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "ServiceAccountManager", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// AddServiceAccount adds a service account with the given name.
+func (c *Client) AddServiceAccount(ctx context.Context, name string) error {
+	if !names.IsValidUser(name) {
+		return errors.NotValidf("service account name %q", name)
+	}
+	in := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewUserTag(name).String()}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "AddServiceAccounts", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(out.OneError())
+}
+
+// IssueToken issues a named API token to the service account, granting
+// the access on each of the targets, which are models or the controller.
+// The token never expires when expiresIn is zero. The token is returned
+// only once, as the controller only keeps a hash of it.
+func (c *Client) IssueToken(
+	ctx context.Context, account, name, access string, expiresIn time.Duration, targets ...names.Tag,
+) (string, error) {
+	if !names.IsValidUser(account) {
+		return "", errors.NotValidf("service account name %q", account)
+	}
+	arg := params.IssueServiceAccountToken{
+		ServiceAccountTag: names.NewUserTag(account).String(),
+		Name:              name,
+		Scopes:            make([]params.ServiceAccountTokenScope, len(targets)),
+		ExpiresIn:         expiresIn,
+	}
+	for i, target := range targets {
+		arg.Scopes[i] = params.ServiceAccountTokenScope{
+			TargetTag: target.String(),
+			Access:    access,
+		}
+	}
+	in := params.IssueServiceAccountTokens{
+		Tokens: []params.IssueServiceAccountToken{arg},
+	}
+	var out params.IssueServiceAccountTokenResults
+	if err := c.facade.FacadeCall(ctx, "IssueTokens", in, &out); err != nil {
+		return "", errors.Trace(err)
+	}
+	if len(out.Results) != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", len(out.Results))
+	}
+	if err := out.Results[0].Error; err != nil {
+		return "", errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return out.Results[0].Token, nil
+}
+
+// RevokeToken revokes the named API token of the service account.
+func (c *Client) RevokeToken(ctx context.Context, account, name string) error {
+	if !names.IsValidUser(account) {
+		return errors.NotValidf("service account name %q", account)
+	}
+	in := params.RevokeServiceAccountTokens{
+		Tokens: []params.ServiceAccountTokenName{{
+			ServiceAccountTag: names.NewUserTag(account).String(),
+			Name:              name,
+		}},
+	}
+	var out params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "RevokeTokens", in, &out); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(out.OneError())
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccountmanager_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/serviceaccountmanager"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+var _ = gc.Suite(&clientSuite{})
+
+const modelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+func (s *clientSuite) TestAddServiceAccount(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: "user-ci"}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "AddServiceAccounts", args, res).SetArg(3, ress).Return(nil)
+
+	client := serviceaccountmanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.AddServiceAccount(context.Background(), "ci")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *clientSuite) TestAddServiceAccountInvalidName(c *gc.C) {
+	client := serviceaccountmanager.NewClientFromCaller(nil)
+	err := client.AddServiceAccount(context.Background(), "not valid")
+	c.Assert(err, gc.ErrorMatches, `service account name "not valid" not valid`)
+}
+
+func (s *clientSuite) TestIssueToken(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.IssueServiceAccountTokens{
+		Tokens: []params.IssueServiceAccountToken{{
+			ServiceAccountTag: "user-ci",
+			Name:              "pipeline",
+			Scopes: []params.ServiceAccountTokenScope{{
+				TargetTag: names.NewModelTag(modelUUID).String(),
+				Access:    "write",
+			}},
+			ExpiresIn: time.Hour,
+		}},
+	}
+	res := new(params.IssueServiceAccountTokenResults)
+	ress := params.IssueServiceAccountTokenResults{
+		Results: []params.IssueServiceAccountTokenResult{{Token: "juju-sa-token"}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "IssueTokens", args, res).SetArg(3, ress).Return(nil)
+
+	client := serviceaccountmanager.NewClientFromCaller(mockFacadeCaller)
+	token, err := client.IssueToken(context.Background(), "ci", "pipeline", "write", time.Hour, names.NewModelTag(modelUUID))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(token, gc.Equals, "juju-sa-token")
+}
+
+func (s *clientSuite) TestIssueTokenError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.IssueServiceAccountTokenResults)
+	ress := params.IssueServiceAccountTokenResults{
+		Results: []params.IssueServiceAccountTokenResult{{
+			Error: &params.Error{Code: params.CodeAlreadyExists, Message: "service account token already exists"},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "IssueTokens", gomock.Any(), res).SetArg(3, ress).Return(nil)
+
+	client := serviceaccountmanager.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.IssueToken(context.Background(), "ci", "pipeline", "write", 0, names.NewModelTag(modelUUID))
+	c.Assert(err, gc.ErrorMatches, "service account token already exists")
+}
+
+func (s *clientSuite) TestRevokeToken(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RevokeServiceAccountTokens{
+		Tokens: []params.ServiceAccountTokenName{{
+			ServiceAccountTag: "user-ci",
+			Name:              "pipeline",
+		}},
+	}
+	res := new(params.ErrorResults)
+	ress := params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{Code: params.CodeNotFound, Message: "service account token not found"},
+	}}}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RevokeTokens", args, res).SetArg(3, ress).Return(nil)
+
+	client := serviceaccountmanager.NewClientFromCaller(mockFacadeCaller)
+	err := client.RevokeToken(context.Background(), "ci", "pipeline")
+	c.Assert(err, jc.Satisfies, params.IsCodeNotFound)
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshcertificates
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// Option is a function that can be used to configure a Client.
+type Option = base.Option
+
+// WithTracer returns an Option that configures the Client to use the
+// supplied tracer.
+var WithTracer = base.WithTracer
+
+// Client allows access to the SSHCertificates API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the SSHCertificates API.
+func NewClient(st base.APICallCloser, options ...Option) *Client {
+	frontend, backend := base.NewClientFacade(st, "SSHCertificates", options...)
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// SSHCertificateAuthority returns the public key of the controller's SSH
+// user certificate authority, in authorized keys format.
+func (c *Client) SSHCertificateAuthority(ctx context.Context) (string, error) {
+	var out params.StringResult
+	if err := c.facade.FacadeCall(ctx, "SSHCertificateAuthority", nil, &out); err != nil {
+		return "", errors.Trace(err)
+	}
+	if out.Error != nil {
+		return "", errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out.Result, nil
+}
+
+// IssueSSHCertificate asks the controller to sign the public key, which must
+// be in authorized keys format, with its SSH user certificate authority. The
+// certificate is issued to the current user.
+func (c *Client) IssueSSHCertificate(ctx context.Context, publicKey string) (params.SSHCertificateResult, error) {
+	in := params.SSHCertificateRequest{PublicKey: publicKey}
+	var out params.SSHCertificateResult
+	if err := c.facade.FacadeCall(ctx, "IssueSSHCertificate", in, &out); err != nil {
+		return params.SSHCertificateResult{}, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return params.SSHCertificateResult{}, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out, nil
+}
+
+// ListSSHCertificates returns the SSH user certificates issued by the
+// controller. With no user and all false, the certificates of the current
+// user are returned.
+func (c *Client) ListSSHCertificates(ctx context.Context, user string, all bool) ([]params.SSHCertificate, error) {
+	in := params.SSHCertificatesFilter{User: user, All: all}
+	var out params.SSHCertificatesResult
+	if err := c.facade.FacadeCall(ctx, "ListSSHCertificates", in, &out); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out.Certificates, nil
+}
+
+// RevokeSSHCertificate revokes the SSH user certificate with the given
+// serial.
+func (c *Client) RevokeSSHCertificate(ctx context.Context, serial uint64) error {
+	_, err := c.revoke(ctx, params.RevokeSSHCertificatesArg{Serial: serial})
+	return errors.Trace(err)
+}
+
+// RevokeUserSSHCertificates revokes every unexpired SSH user certificate
+// issued to the user, returning how many were revoked.
+func (c *Client) RevokeUserSSHCertificates(ctx context.Context, user string) (int, error) {
+	revoked, err := c.revoke(ctx, params.RevokeSSHCertificatesArg{User: user})
+	return revoked, errors.Trace(err)
+}
+
+func (c *Client) revoke(ctx context.Context, in params.RevokeSSHCertificatesArg) (int, error) {
+	var out params.RevokeSSHCertificatesResult
+	if err := c.facade.FacadeCall(ctx, "RevokeSSHCertificates", in, &out); err != nil {
+		return 0, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return 0, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out.Revoked, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshcertificates_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	basemocks "github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/sshcertificates"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type clientSuite struct{}
+
+var _ = gc.Suite(&clientSuite{})
+
+func (s *clientSuite) TestSSHCertificateAuthority(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.StringResult)
+	ress := params.StringResult{Result: "ssh-ed25519 AAAA"}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SSHCertificateAuthority", nil, res).SetArg(3, ress).Return(nil)
+
+	client := sshcertificates.NewClientFromCaller(mockFacadeCaller)
+	publicKey, err := client.SSHCertificateAuthority(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(publicKey, gc.Equals, "ssh-ed25519 AAAA")
+}
+
+func (s *clientSuite) TestIssueSSHCertificate(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	validAfter := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	args := params.SSHCertificateRequest{PublicKey: "ssh-ed25519 BBBB"}
+	res := new(params.SSHCertificateResult)
+	ress := params.SSHCertificateResult{
+		Certificate: params.SSHCertificate{
+			Serial:      42,
+			User:        "bob",
+			ValidAfter:  validAfter,
+			ValidBefore: validAfter.Add(8 * time.Hour),
+		},
+		SignedCertificate: "ssh-ed25519-cert-v01@openssh.com CCCC",
+		CAPublicKey:       "ssh-ed25519 AAAA",
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "IssueSSHCertificate", args, res).SetArg(3, ress).Return(nil)
+
+	client := sshcertificates.NewClientFromCaller(mockFacadeCaller)
+	result, err := client.IssueSSHCertificate(context.Background(), "ssh-ed25519 BBBB")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, ress)
+}
+
+func (s *clientSuite) TestIssueSSHCertificateError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.SSHCertificateRequest{PublicKey: "junk"}
+	res := new(params.SSHCertificateResult)
+	ress := params.SSHCertificateResult{
+		Error: apiservererrors.ServerError(errors.NotValidf("public key")),
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "IssueSSHCertificate", args, res).SetArg(3, ress).Return(nil)
+
+	client := sshcertificates.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.IssueSSHCertificate(context.Background(), "junk")
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *clientSuite) TestListSSHCertificates(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.SSHCertificatesFilter{User: "bob"}
+	res := new(params.SSHCertificatesResult)
+	ress := params.SSHCertificatesResult{
+		Certificates: []params.SSHCertificate{{Serial: 42, User: "bob"}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListSSHCertificates", args, res).SetArg(3, ress).Return(nil)
+
+	client := sshcertificates.NewClientFromCaller(mockFacadeCaller)
+	certs, err := client.ListSSHCertificates(context.Background(), "bob", false)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(certs, jc.DeepEquals, ress.Certificates)
+}
+
+func (s *clientSuite) TestRevokeSSHCertificate(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RevokeSSHCertificatesArg{Serial: 42}
+	res := new(params.RevokeSSHCertificatesResult)
+	ress := params.RevokeSSHCertificatesResult{
+		Error: apiservererrors.ServerError(errors.NotFoundf("ssh certificate 42")),
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RevokeSSHCertificates", args, res).SetArg(3, ress).Return(nil)
+
+	client := sshcertificates.NewClientFromCaller(mockFacadeCaller)
+	err := client.RevokeSSHCertificate(context.Background(), 42)
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *clientSuite) TestRevokeUserSSHCertificates(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.RevokeSSHCertificatesArg{User: "bob"}
+	res := new(params.RevokeSSHCertificatesResult)
+	ress := params.RevokeSSHCertificatesResult{Revoked: 3}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RevokeSSHCertificates", args, res).SetArg(3, ress).Return(nil)
+
+	client := sshcertificates.NewClientFromCaller(mockFacadeCaller)
+	revoked, err := client.RevokeUserSSHCertificates(context.Background(), "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(revoked, gc.Equals, 3)
+}
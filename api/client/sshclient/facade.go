@@ -5,9 +5,13 @@ package sshclient
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
+	"gopkg.in/httprequest.v1"
 
 	"github.com/juju/juju/api/base"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
@@ -93,6 +97,148 @@ func (facade *Facade) VirtualHostname(ctx context.Context, target string, contai
 	return out.Address, nil
 }
 
+// ListSSHSessions returns the recorded SSH sessions to units and machines
+// in the model.
+func (facade *Facade) ListSSHSessions(ctx context.Context) ([]params.SSHSession, error) {
+	if facade.caller.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("recording ssh sessions on this controller")
+	}
+	var out params.SSHSessionsResult
+	err := facade.caller.FacadeCall(ctx, "ListSSHSessions", nil, &out)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out.Sessions, nil
+}
+
+type sshSessionRecordingParams struct {
+	httprequest.Route `httprequest:"GET /ssh-sessions/:UUID/recording"`
+	UUID              string `httprequest:",path"`
+}
+
+// SSHSessionRecording returns a reader of the asciicast recording of the SSH
+// session with the specified UUID. The recording is streamed from the
+// controller, and the caller is responsible for closing the reader.
+func (facade *Facade) SSHSessionRecording(ctx context.Context, uuid string) (io.ReadCloser, error) {
+	if facade.caller.BestAPIVersion() < 6 {
+		return nil, errors.NotSupportedf("recording ssh sessions on this controller")
+	}
+	httpClient, err := facade.caller.RawAPICaller().HTTPClient()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var resp *http.Response
+	err = httpClient.Call(ctx, &sshSessionRecordingParams{UUID: uuid}, &resp)
+	if err != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(err))
+	}
+	return resp.Body, nil
+}
+
+// SSHServerPort returns the port of the controller's embedded SSH server,
+// through which sessions and forwarded connections to the units and machines
+// of the model are proxied.
+func (facade *Facade) SSHServerPort(ctx context.Context) (int, error) {
+	if facade.caller.BestAPIVersion() < 8 {
+		return 0, errors.NotSupportedf("proxying through the controller's ssh server")
+	}
+	var out params.IntResult
+	err := facade.caller.FacadeCall(ctx, "SSHServerPort", nil, &out)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return 0, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out.Result, nil
+}
+
+// RequestSSHAccess requests time-bounded SSH access to the target unit or
+// machine for the current user. The returned grant is approved straight away
+// if the controller's policy allows it, otherwise it is pending until a model
+// admin approves it.
+func (facade *Facade) RequestSSHAccess(
+	ctx context.Context, target string, container *string, reason string, duration time.Duration,
+) (params.SSHAccessGrant, error) {
+	if facade.caller.BestAPIVersion() < 7 {
+		return params.SSHAccessGrant{}, errors.NotSupportedf("ssh access grants on this controller")
+	}
+	tag, err := targetToTag(target)
+	if err != nil {
+		return params.SSHAccessGrant{}, errors.Trace(err)
+	}
+	in := params.SSHAccessRequestArg{
+		Tag:       tag.String(),
+		Container: container,
+		Reason:    reason,
+		Duration:  duration,
+	}
+	var out params.SSHAccessGrantResult
+	err = facade.caller.FacadeCall(ctx, "RequestSSHAccess", in, &out)
+	if err != nil {
+		return params.SSHAccessGrant{}, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return params.SSHAccessGrant{}, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out.Grant, nil
+}
+
+// ListSSHAccessGrants returns the SSH access grants in the model which the
+// current user may see.
+func (facade *Facade) ListSSHAccessGrants(ctx context.Context) ([]params.SSHAccessGrant, error) {
+	if facade.caller.BestAPIVersion() < 7 {
+		return nil, errors.NotSupportedf("ssh access grants on this controller")
+	}
+	var out params.SSHAccessGrantsResult
+	err := facade.caller.FacadeCall(ctx, "ListSSHAccessGrants", nil, &out)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Error != nil {
+		return nil, errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return out.Grants, nil
+}
+
+// ApproveSSHAccess approves the pending SSH access grant with the specified
+// UUID.
+func (facade *Facade) ApproveSSHAccess(ctx context.Context, uuid string) error {
+	return facade.decideSSHAccess(ctx, "ApproveSSHAccess", uuid)
+}
+
+// RejectSSHAccess rejects the pending SSH access grant with the specified
+// UUID.
+func (facade *Facade) RejectSSHAccess(ctx context.Context, uuid string) error {
+	return facade.decideSSHAccess(ctx, "RejectSSHAccess", uuid)
+}
+
+// RevokeSSHAccess revokes the SSH access grant with the specified UUID,
+// terminating any sessions using it.
+func (facade *Facade) RevokeSSHAccess(ctx context.Context, uuid string) error {
+	return facade.decideSSHAccess(ctx, "RevokeSSHAccess", uuid)
+}
+
+func (facade *Facade) decideSSHAccess(ctx context.Context, callName, uuid string) error {
+	if facade.caller.BestAPIVersion() < 7 {
+		return errors.NotSupportedf("ssh access grants on this controller")
+	}
+	in := params.SSHAccessGrantArg{UUID: uuid}
+	var out params.ErrorResult
+	err := facade.caller.FacadeCall(ctx, callName, in, &out)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if out.Error != nil {
+		return errors.Trace(apiservererrors.RestoreError(out.Error))
+	}
+	return nil
+}
+
 func (facade *Facade) addressCall(ctx context.Context, callName, target string) (string, error) {
 	entities, err := targetToEntities(target)
 	if err != nil {
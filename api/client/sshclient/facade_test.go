@@ -5,12 +5,18 @@ package sshclient_test
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
+	"gopkg.in/httprequest.v1"
 
 	basemocks "github.com/juju/juju/api/base/mocks"
 	"github.com/juju/juju/api/client/sshclient"
@@ -452,3 +458,229 @@ func (s *FacadeSuite) TestVirtualHostnameError(c *gc.C) {
 	_, err := facade.VirtualHostname(context.Background(), "foo/0", nil)
 	c.Check(err, gc.ErrorMatches, "boom")
 }
+
+func (s *FacadeSuite) TestListSSHSessions(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	sessions := []params.SSHSession{{
+		UUID:   "session-uuid",
+		User:   "alice",
+		Target: "0.8419cd78-4993-4c3a-928e-c646226beeee.juju.local",
+	}}
+
+	res := new(params.SSHSessionsResult)
+	ress := params.SSHSessionsResult{Sessions: sessions}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListSSHSessions", nil, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	result, err := facade.ListSSHSessions(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, sessions)
+}
+
+func (s *FacadeSuite) TestListSSHSessionsNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(5)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.ListSSHSessions(context.Background())
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *FacadeSuite) TestSSHSessionRecording(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Method, gc.Equals, "GET")
+		c.Check(r.URL.Path, gc.Equals, "/ssh-sessions/session-uuid/recording")
+		w.Header().Set("Content-Type", "application/x-asciicast")
+		_, _ = w.Write([]byte("recording"))
+	}))
+	defer srv.Close()
+
+	mockFacadeCaller := s.expectHTTPClient(ctrl, srv.URL)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	reader, err := facade.SSHSessionRecording(context.Background(), "session-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	defer reader.Close()
+	recording, err := io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(recording), gc.Equals, "recording")
+}
+
+func (s *FacadeSuite) TestSSHSessionRecordingNotFound(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(params.ErrorResult{
+			Error: apiservererrors.ServerError(errors.NotFoundf("ssh session %q", "session-uuid")),
+		})
+	}))
+	defer srv.Close()
+
+	mockFacadeCaller := s.expectHTTPClient(ctrl, srv.URL)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.SSHSessionRecording(context.Background(), "session-uuid")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *FacadeSuite) TestSSHSessionRecordingNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(5)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.SSHSessionRecording(context.Background(), "session-uuid")
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+// expectHTTPClient returns a facade caller whose HTTP client talks to the
+// server at the given URL, decoding errors the way the API connection does.
+func (s *FacadeSuite) expectHTTPClient(ctrl *gomock.Controller, url string) *basemocks.MockFacadeCaller {
+	mockAPICaller := basemocks.NewMockAPICaller(ctrl)
+	mockAPICaller.EXPECT().HTTPClient().Return(&httprequest.Client{
+		BaseURL: url,
+		UnmarshalError: func(resp *http.Response) error {
+			var result params.ErrorResult
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				return err
+			}
+			return result.Error
+		},
+	}, nil)
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	mockFacadeCaller.EXPECT().RawAPICaller().Return(mockAPICaller)
+	return mockFacadeCaller
+}
+
+func (s *FacadeSuite) TestRequestSSHAccess(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expectedArg := params.SSHAccessRequestArg{
+		Tag:      names.NewUnitTag("postgresql/0").String(),
+		Reason:   "incident",
+		Duration: time.Hour,
+	}
+	grant := params.SSHAccessGrant{UUID: "grant-uuid", Status: "pending"}
+	res := new(params.SSHAccessGrantResult)
+	ress := params.SSHAccessGrantResult{Grant: grant}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RequestSSHAccess", expectedArg, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	result, err := facade.RequestSSHAccess(context.Background(), "postgresql/0", nil, "incident", time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, grant)
+}
+
+func (s *FacadeSuite) TestRequestSSHAccessNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.RequestSSHAccess(context.Background(), "0", nil, "", time.Hour)
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *FacadeSuite) TestListSSHAccessGrants(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	grants := []params.SSHAccessGrant{{UUID: "grant-uuid", User: "bob"}}
+	res := new(params.SSHAccessGrantsResult)
+	ress := params.SSHAccessGrantsResult{Grants: grants}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListSSHAccessGrants", nil, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	result, err := facade.ListSSHAccessGrants(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, grants)
+}
+
+func (s *FacadeSuite) TestApproveSSHAccess(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expectedArg := params.SSHAccessGrantArg{UUID: "grant-uuid"}
+	res := new(params.ErrorResult)
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ApproveSSHAccess", expectedArg, res).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	err := facade.ApproveSSHAccess(context.Background(), "grant-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *FacadeSuite) TestRevokeSSHAccessError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	expectedArg := params.SSHAccessGrantArg{UUID: "grant-uuid"}
+	res := new(params.ErrorResult)
+	ress := params.ErrorResult{Error: &params.Error{Code: params.CodeNotFound, Message: `ssh access grant "grant-uuid" not found`}}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RevokeSSHAccess", expectedArg, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	err := facade.RevokeSSHAccess(context.Background(), "grant-uuid")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *FacadeSuite) TestSSHServerPort(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	res := new(params.IntResult)
+	ress := params.IntResult{Result: 17022}
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SSHServerPort", nil, res).SetArg(3, ress).Return(nil)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	port, err := facade.SSHServerPort(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(port, gc.Equals, 17022)
+}
+
+func (s *FacadeSuite) TestSSHServerPortNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	facade := sshclient.NewFacadeFromCaller(mockFacadeCaller)
+
+	_, err := facade.SSHServerPort(context.Background())
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
@@ -191,6 +191,11 @@ func (c *Client) ListFilesystems(ctx context.Context, machines []string) ([]para
 // NOTE(axw) for old controllers, the results will only
 // contain errors.
 func (c *Client) AddToUnit(ctx context.Context, storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+	for _, s := range storages {
+		if s.SnapshotId != "" && c.facade.BestAPIVersion() < 8 {
+			return nil, errors.NotSupportedf("adding storage from a snapshot by this controller")
+		}
+	}
 	out := params.AddStorageResults{}
 	in := params.StoragesAddParams{Storages: storages}
 	err := c.facade.FacadeCall(ctx, "AddToUnit", in, &out)
@@ -292,6 +297,125 @@ func (c *Client) Detach(ctx context.Context, storageIds []string, force *bool, m
 	return results.Results, nil
 }
 
+// Resize requests that the specified storage instances be grown to the
+// given size in MiB.
+func (c *Client) Resize(ctx context.Context, storageIds []string, size uint64) ([]params.ErrorResult, error) {
+	if c.facade.BestAPIVersion() < 7 {
+		return nil, errors.NotSupportedf("resizing storage by this controller")
+	}
+	args := params.ResizeStorage{
+		Storage: make([]params.ResizeStorageInstance, len(storageIds)),
+	}
+	for i, id := range storageIds {
+		if !names.IsValidStorage(id) {
+			return nil, errors.NotValidf("storage ID %q", id)
+		}
+		args.Storage[i] = params.ResizeStorageInstance{
+			Tag:  names.NewStorageTag(id).String(),
+			Size: size,
+		}
+	}
+	results := params.ErrorResults{}
+	if err := c.facade.FacadeCall(ctx, "ResizeStorage", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+// CreateSnapshots takes a snapshot of each of the specified storage
+// instances.
+func (c *Client) CreateSnapshots(ctx context.Context, storageIds []string) ([]params.StorageSnapshotResult, error) {
+	if c.facade.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("storage snapshots by this controller")
+	}
+	args, err := storageIdsToEntities(storageIds)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := params.StorageSnapshotResults{}
+	if err := c.facade.FacadeCall(ctx, "CreateStorageSnapshots", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+// ListSnapshots lists the snapshots of each of the specified storage
+// instances.
+func (c *Client) ListSnapshots(ctx context.Context, storageIds []string) ([]params.StorageSnapshotsResult, error) {
+	if c.facade.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("storage snapshots by this controller")
+	}
+	args, err := storageIdsToEntities(storageIds)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := params.StorageSnapshotsResults{}
+	if err := c.facade.FacadeCall(ctx, "ListStorageSnapshots", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+// RemoveSnapshots removes the specified snapshots, which were taken from
+// the given storage instance.
+func (c *Client) RemoveSnapshots(ctx context.Context, storageId string, snapshotIds []string) ([]params.ErrorResult, error) {
+	if c.facade.BestAPIVersion() < 8 {
+		return nil, errors.NotSupportedf("storage snapshots by this controller")
+	}
+	if !names.IsValidStorage(storageId) {
+		return nil, errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.RemoveStorageSnapshots{
+		Snapshots: make([]params.RemoveStorageSnapshot, len(snapshotIds)),
+	}
+	for i, snapshotId := range snapshotIds {
+		args.Snapshots[i] = params.RemoveStorageSnapshot{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			SnapshotId: snapshotId,
+		}
+	}
+	results := params.ErrorResults{}
+	if err := c.facade.FacadeCall(ctx, "RemoveStorageSnapshots", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(snapshotIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(snapshotIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+func storageIdsToEntities(storageIds []string) (params.Entities, error) {
+	args := params.Entities{Entities: make([]params.Entity, len(storageIds))}
+	for i, id := range storageIds {
+		if !names.IsValidStorage(id) {
+			return params.Entities{}, errors.NotValidf("storage ID %q", id)
+		}
+		args.Entities[i].Tag = names.NewStorageTag(id).String()
+	}
+	return args, nil
+}
+
 // Import imports storage into the model.
 func (c *Client) Import(
 	ctx context.Context,
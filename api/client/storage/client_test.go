@@ -605,6 +605,137 @@ func (s *storageMockSuite) TestDetachArityMismatch(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, `expected 2 result\(s\), got 3`)
 }
 
+func (s *storageMockSuite) TestResize(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.ResizeStorage{Storage: []params.ResizeStorageInstance{
+		{Tag: "storage-foo-0", Size: 2048},
+		{Tag: "storage-bar-1", Size: 2048},
+	}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{
+			{},
+			{Error: &params.Error{Message: "baz"}},
+		},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ResizeStorage", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := storageClient.Resize(context.Background(), []string{"foo/0", "bar/1"}, 2048)
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(obtained, gc.HasLen, 2)
+	c.Assert(obtained[0].Error, gc.IsNil)
+	c.Assert(obtained[1].Error, jc.DeepEquals, &params.Error{Message: "baz"})
+}
+
+func (s *storageMockSuite) TestResizeNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(6)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	_, err := storageClient.Resize(context.Background(), []string{"foo/0"}, 2048)
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestCreateSnapshots(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.Entities{Entities: []params.Entity{
+		{Tag: "storage-foo-0"},
+		{Tag: "storage-bar-1"},
+	}}
+	result := new(params.StorageSnapshotResults)
+	results := params.StorageSnapshotResults{
+		Results: []params.StorageSnapshotResult{
+			{Result: &params.StorageSnapshot{StorageTag: "storage-foo-0", SnapshotId: "snap-1"}},
+			{Error: &params.Error{Message: "baz"}},
+		},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "CreateStorageSnapshots", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := storageClient.CreateSnapshots(context.Background(), []string{"foo/0", "bar/1"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(obtained, jc.DeepEquals, results.Results)
+}
+
+func (s *storageMockSuite) TestCreateSnapshotsNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	_, err := storageClient.CreateSnapshots(context.Background(), []string{"foo/0"})
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageMockSuite) TestListSnapshots(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.Entities{Entities: []params.Entity{{Tag: "storage-foo-0"}}}
+	result := new(params.StorageSnapshotsResults)
+	results := params.StorageSnapshotsResults{
+		Results: []params.StorageSnapshotsResult{{
+			Result: []params.StorageSnapshot{{StorageTag: "storage-foo-0", SnapshotId: "snap-1"}},
+		}},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "ListStorageSnapshots", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := storageClient.ListSnapshots(context.Background(), []string{"foo/0"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(obtained, jc.DeepEquals, results.Results)
+}
+
+func (s *storageMockSuite) TestRemoveSnapshots(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	expectedArgs := params.RemoveStorageSnapshots{Snapshots: []params.RemoveStorageSnapshot{
+		{StorageTag: "storage-foo-0", SnapshotId: "snap-1"},
+		{StorageTag: "storage-foo-0", SnapshotId: "snap-2"},
+	}}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{
+			{},
+			{Error: &params.Error{Message: "baz"}},
+		},
+	}
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(8)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "RemoveStorageSnapshots", expectedArgs, result).SetArg(3, results).Return(nil)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	obtained, err := storageClient.RemoveSnapshots(context.Background(), "foo/0", []string{"snap-1", "snap-2"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(obtained, jc.DeepEquals, results.Results)
+}
+
+func (s *storageMockSuite) TestAddToUnitFromSnapshotNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	mockFacadeCaller := basemocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(7)
+
+	storageClient := storage.NewClientFromCaller(mockFacadeCaller)
+	_, err := storageClient.AddToUnit(context.Background(), []params.StorageAddParams{{
+		UnitTag:     "unit-foo-0",
+		StorageName: "data",
+		SnapshotId:  "snap-1",
+	}})
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
 func (s *storageMockSuite) TestAttach(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -239,3 +239,33 @@ func (c *Client) ResetPassword(ctx context.Context, username string) ([]byte, er
 	}
 	return result.SecretKey, nil
 }
+
+// LoginHistory returns the recent password logins of the specified user,
+// the most recent first.
+func (c *Client) LoginHistory(ctx context.Context, username string) ([]params.LoginAttempt, error) {
+	if c.facade.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("login history by this controller")
+	}
+	if !names.IsValidUser(username) {
+		return nil, errors.Errorf("%q is not a valid username", username)
+	}
+
+	in := params.Entities{
+		Entities: []params.Entity{{
+			Tag: names.NewUserTag(username).String(),
+		}},
+	}
+	var out params.LoginHistoryResults
+	err := c.facade.FacadeCall(ctx, "LoginHistory", in, &out)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if count := len(out.Results); count != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", count)
+	}
+	result := out.Results[0]
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+	return result.Attempts, nil
+}
@@ -5,6 +5,7 @@ package usermanager_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -387,3 +388,54 @@ func (s *usermanagerSuite) TestResetPasswordResultCount(c *gc.C) {
 	_, err := client.ResetPassword(context.Background(), "foobar")
 	c.Assert(err, gc.ErrorMatches, "expected 1 result, got 2")
 }
+
+func (s *usermanagerSuite) TestLoginHistory(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	attempts := []params.LoginAttempt{{
+		Time:    now,
+		Success: true,
+		Address: "10.0.0.1:4567",
+	}}
+	args := params.Entities{Entities: []params.Entity{{Tag: "user-foobar"}}}
+	result := new(params.LoginHistoryResults)
+	results := params.LoginHistoryResults{Results: []params.LoginHistoryResult{{Attempts: attempts}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "LoginHistory", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	res, err := client.LoginHistory(context.Background(), "foobar")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res, jc.DeepEquals, attempts)
+}
+
+func (s *usermanagerSuite) TestLoginHistoryResponseError(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "user-foobar"}}}
+	result := new(params.LoginHistoryResults)
+	results := params.LoginHistoryResults{Results: []params.LoginHistoryResult{{Error: &params.Error{Message: "boom"}}}}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "LoginHistory", args, result).SetArg(3, results).Return(nil)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.LoginHistory(context.Background(), "foobar")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *usermanagerSuite) TestLoginHistoryNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(3)
+
+	client := usermanager.NewClientFromCaller(mockFacadeCaller)
+	_, err := client.LoginHistory(context.Background(), "foobar")
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
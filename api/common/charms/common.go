@@ -131,6 +131,7 @@ func convertCharmMeta(meta *params.CharmMeta) (*charm.Meta, error) {
 		Containers:     containers,
 		Assumes:        meta.AssumesExpr,
 		CharmUser:      charm.RunAs(meta.CharmUser),
+		DeploymentType: charm.DeploymentType(meta.DeploymentType),
 	}
 	return result, nil
 }
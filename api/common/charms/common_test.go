@@ -80,7 +80,8 @@ func (s *suite) TestCharmInfo(c *gc.C) {
 					Type: "filesystem",
 				},
 			},
-			CharmUser: "root",
+			CharmUser:      "root",
+			DeploymentType: "stateless",
 		},
 		Manifest: &params.CharmManifest{
 			Bases: []params.CharmBase{
@@ -147,7 +148,8 @@ func (s *suite) TestCharmInfo(c *gc.C) {
 					Type: "filesystem",
 				},
 			},
-			CharmUser: charm.RunAsRoot,
+			CharmUser:      charm.RunAsRoot,
+			DeploymentType: charm.DeploymentStateless,
 		},
 		Manifest: &charm.Manifest{
 			Bases: []charm.Base{
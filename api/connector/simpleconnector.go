@@ -36,6 +36,10 @@ type SimpleConfig struct {
 	// ClientSecret holds the client secret part of client
 	// credentials used for authentication.
 	ClientSecret string
+
+	// ServiceAccountToken holds an API token issued to a service account
+	// used for authentication.
+	ServiceAccountToken string
 }
 
 // A SimpleConnector can provide connections from a simple set of options.
@@ -50,10 +54,16 @@ var _ Connector = (*SimpleConnector)(nil)
 // connect according to the specified options.  If some options are invalid an
 // error is returned.
 func NewSimple(opts SimpleConfig, dialOptions ...api.DialOption) (*SimpleConnector, error) {
-	if opts.Username == "" && opts.ClientID == "" {
-		return nil, errors.New("one of Username or ClientID must be set")
-	} else if opts.Username != "" && opts.ClientID != "" {
-		return nil, errors.New("only one of Username or ClientID should be set")
+	var set int
+	for _, v := range []string{opts.Username, opts.ClientID, opts.ServiceAccountToken} {
+		if v != "" {
+			set++
+		}
+	}
+	if set == 0 {
+		return nil, errors.New("one of Username, ClientID or ServiceAccountToken must be set")
+	} else if set > 1 {
+		return nil, errors.New("only one of Username, ClientID or ServiceAccountToken should be set")
 	}
 
 	info := api.Info{
@@ -82,6 +92,10 @@ func NewSimple(opts SimpleConfig, dialOptions ...api.DialOption) (*SimpleConnect
 			opts.ClientID,
 			opts.ClientSecret,
 		)
+	} else if opts.ServiceAccountToken != "" {
+		dialOpts.LoginProvider = api.NewServiceAccountTokenLoginProvider(
+			opts.ServiceAccountToken,
+		)
 	}
 
 	conn := &SimpleConnector{
@@ -62,6 +62,34 @@ func (s *simpleConnectorSuite) TestNewSimpleRespectsClientCredentials(c *gc.C) {
 				return expected
 			},
 		},
+		{
+			name: "with service account token",
+			opts: SimpleConfig{
+				ControllerAddresses: []string{"some.host:9999"},
+				ModelUUID:           "some-uuid",
+				ServiceAccountToken: "juju-sa-some-token",
+			},
+			expectedAPIInfo: api.Info{
+				Addrs:    []string{"some.host:9999"},
+				ModelTag: names.NewModelTag("some-uuid"),
+			},
+			expectedDefaultDialOpts: func() api.DialOpts {
+				expected := api.DefaultDialOpts()
+				expected.LoginProvider = api.NewServiceAccountTokenLoginProvider("juju-sa-some-token")
+				return expected
+			},
+		},
+		{
+			name: "with both client ID and service account token",
+			opts: SimpleConfig{
+				ControllerAddresses: []string{"some.host:9999"},
+				ModelUUID:           "some-uuid",
+				ClientID:            "some-client-id",
+				ClientSecret:        "some-client-secret",
+				ServiceAccountToken: "juju-sa-some-token",
+			},
+			expectedError: "only one of Username, ClientID or ServiceAccountToken should be set",
+		},
 		{
 			name: "with both username and client ID",
 			opts: SimpleConfig{
@@ -72,7 +100,7 @@ func (s *simpleConnectorSuite) TestNewSimpleRespectsClientCredentials(c *gc.C) {
 				ClientID:            "some-client-id",
 				ClientSecret:        "some-client-secret",
 			},
-			expectedError: "only one of Username or ClientID should be set",
+			expectedError: "only one of Username, ClientID or ServiceAccountToken should be set",
 		},
 		{
 			name: "with neither username nor client ID",
@@ -80,7 +108,7 @@ func (s *simpleConnectorSuite) TestNewSimpleRespectsClientCredentials(c *gc.C) {
 				ControllerAddresses: []string{"some.host:9999"},
 				ModelUUID:           "some-uuid",
 			},
-			expectedError: "one of Username or ClientID must be set",
+			expectedError: "one of Username, ClientID or ServiceAccountToken must be set",
 		},
 	}
 
@@ -462,6 +462,29 @@ func (c *Client) ProvisioningState(ctx context.Context, appName string) (*params
 	return result.ProvisioningState, nil
 }
 
+// ApplicationDeploymentType returns the deployment type of the application,
+// taking any override in the application config into account.
+func (c *Client) ApplicationDeploymentType(ctx context.Context, appName string) (charm.DeploymentType, error) {
+	if !names.IsValidApplication(appName) {
+		return "", errors.NotValidf("application name %q", appName)
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(appName).String()}},
+	}
+
+	var results params.StringResults
+	if err := c.facade.FacadeCall(ctx, "ApplicationDeploymentTypes", args, &results); err != nil {
+		return "", err
+	}
+	if n := len(results.Results); n != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", n)
+	}
+	if err := results.Results[0].Error; err != nil {
+		return "", params.TranslateWellKnownError(err)
+	}
+	return charm.DeploymentType(results.Results[0].Result), nil
+}
+
 // SetProvisioningState sets the provisioning state for the CAAS application.
 func (c *Client) SetProvisioningState(ctx context.Context, appName string, state params.CAASApplicationProvisioningState) error {
 	var result params.ErrorResult
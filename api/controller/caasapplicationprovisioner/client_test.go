@@ -497,6 +497,26 @@ func (s *provisionerSuite) TestProvisioningState(c *gc.C) {
 	})
 }
 
+func (s *provisionerSuite) TestApplicationDeploymentType(c *gc.C) {
+	var called bool
+	client := newClient(func(objType string, version int, id, request string, a, result interface{}) error {
+		called = true
+		c.Check(objType, gc.Equals, "CAASApplicationProvisioner")
+		c.Check(id, gc.Equals, "")
+		c.Assert(request, gc.Equals, "ApplicationDeploymentTypes")
+		c.Assert(a, jc.DeepEquals, params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}})
+		c.Assert(result, gc.FitsTypeOf, &params.StringResults{})
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{{Result: "stateless"}},
+		}
+		return nil
+	})
+	deploymentType, err := client.ApplicationDeploymentType(context.Background(), "foo")
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(called, jc.IsTrue)
+	c.Check(deploymentType, gc.Equals, charm.DeploymentStateless)
+}
+
 func (s *provisionerSuite) TestSetProvisioningState(c *gc.C) {
 	var called bool
 	client := newClient(func(objType string, version int, id, request string, a, result interface{}) error {
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -124,6 +125,28 @@ func (c *Client) ApplicationConfig(ctx context.Context, applicationName string)
 	return config.ConfigAttributes(results.Results[0].Config), nil
 }
 
+// ApplicationDeploymentType returns the deployment type of the application,
+// taking any override in the application config into account.
+func (c *Client) ApplicationDeploymentType(ctx context.Context, appName string) (charm.DeploymentType, error) {
+	appTag, err := applicationTag(appName)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	args := entities(appTag)
+
+	var results params.StringResults
+	if err := c.facade.FacadeCall(ctx, "ApplicationDeploymentTypes", args, &results); err != nil {
+		return "", err
+	}
+	if n := len(results.Results); n != 1 {
+		return "", errors.Errorf("expected 1 result, got %d", n)
+	}
+	if err := results.Results[0].Error; err != nil {
+		return "", params.TranslateWellKnownError(err)
+	}
+	return charm.DeploymentType(results.Results[0].Result), nil
+}
+
 // IsExposed returns whether the specified CAAS application
 // in the current model is exposed.
 func (c *Client) IsExposed(ctx context.Context, appName string) (bool, error) {
@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/core/config"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -25,6 +26,7 @@ type clientCommmon interface {
 	WatchApplications(context.Context) (watcher.StringsWatcher, error)
 	WatchApplication(context.Context, string) (watcher.NotifyWatcher, error)
 	IsExposed(context.Context, string) (bool, error)
+	ApplicationDeploymentType(context.Context, string) (charm.DeploymentType, error)
 	ApplicationConfig(context.Context, string) (config.ConfigAttributes, error)
 	Life(context.Context, string) (life.Value, error)
 }
@@ -94,6 +96,32 @@ func (s *firewallerSuite) TestIsExposedInvalidEntityame(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `application name "" not valid`)
 }
 
+func (s *firewallerSuite) TestApplicationDeploymentType(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, s.objType)
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "ApplicationDeploymentTypes")
+		c.Check(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{
+				Tag: "application-gitlab",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.StringResults{})
+		*(result.(*params.StringResults)) = params.StringResults{
+			Results: []params.StringResult{{
+				Result: "daemon",
+			}},
+		}
+		return nil
+	})
+
+	client := s.newFunc(apiCaller)
+	deploymentType, err := client.ApplicationDeploymentType(context.Background(), "gitlab")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(deploymentType, gc.Equals, charm.DeploymentDaemon)
+}
+
 func (s *firewallerSuite) TestLife(c *gc.C) {
 	tag := names.NewApplicationTag("gitlab")
 	apiCaller := basetesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
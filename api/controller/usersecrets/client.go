@@ -6,8 +6,11 @@ package usersecrets
 import (
 	"context"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/api/base"
 	apiwatcher "github.com/juju/juju/api/watcher"
+	coresecrets "github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/rpc/params"
 )
@@ -50,3 +53,60 @@ func (c *Client) WatchRevisionsToPrune(ctx context.Context) (watcher.NotifyWatch
 func (c *Client) DeleteObsoleteUserSecretRevisions(ctx context.Context) error {
 	return c.facade.FacadeCall(ctx, "DeleteObsoleteUserSecretRevisions", nil, nil)
 }
+
+// WatchUserSecretsRotationChanges returns a watcher that triggers on
+// changes to the rotation time of user secrets.
+func (c *Client) WatchUserSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error) {
+	var result params.SecretTriggerWatchResult
+	err := c.facade.FacadeCall(ctx, "WatchUserSecretsRotationChanges", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	w := apiwatcher.NewSecretsTriggerWatcher(c.facade.RawAPICaller(), result)
+	return w, nil
+}
+
+// GetUserSecretGenerator returns the generator used to create new
+// content for the specified user secret when it is rotated.
+func (c *Client) GetUserSecretGenerator(ctx context.Context, uri *coresecrets.URI) (*coresecrets.GeneratorConfig, error) {
+	var results params.SecretGeneratorResults
+	args := params.Entities{Entities: []params.Entity{{Tag: uri.String()}}}
+	err := c.facade.FacadeCall(ctx, "GetUserSecretGenerators", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	return &coresecrets.GeneratorConfig{
+		Kind:   coresecrets.GeneratorKind(result.Kind),
+		Params: result.Params,
+	}, nil
+}
+
+// RotateUserSecret saves the newly generated content for the
+// specified user secret and schedules its next rotation.
+func (c *Client) RotateUserSecret(ctx context.Context, uri *coresecrets.URI, data coresecrets.SecretData) error {
+	var results params.ErrorResults
+	args := params.RotateUserSecretArgs{
+		Args: []params.RotateUserSecretArg{{
+			URI:     uri.String(),
+			Content: params.SecretContentParams{Data: data},
+		}},
+	}
+	err := c.facade.FacadeCall(ctx, "RotateUserSecrets", args, &results)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := results.OneError(); err != nil {
+		return params.TranslateWellKnownError(err)
+	}
+	return nil
+}
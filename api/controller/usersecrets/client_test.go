@@ -7,10 +7,12 @@ import (
 	"context"
 
 	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/api/controller/usersecrets"
+	coresecrets "github.com/juju/juju/core/secrets"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -61,3 +63,73 @@ func (s *secretSuite) TestDeleteObsoleteUserSecretRevisions(c *gc.C) {
 	err := client.DeleteObsoleteUserSecretRevisions(context.Background())
 	c.Assert(err, gc.ErrorMatches, "boom")
 }
+
+func (s *secretSuite) TestWatchUserSecretsRotationChanges(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "UserSecretsManager")
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "WatchUserSecretsRotationChanges")
+		c.Check(arg, gc.IsNil)
+		c.Assert(result, gc.FitsTypeOf, &params.SecretTriggerWatchResult{})
+		*(result.(*params.SecretTriggerWatchResult)) = params.SecretTriggerWatchResult{
+			Error: &params.Error{Message: "FAIL"},
+		}
+		return nil
+	})
+	client := usersecrets.NewClient(apiCaller)
+	_, err := client.WatchUserSecretsRotationChanges(context.Background())
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+}
+
+func (s *secretSuite) TestGetUserSecretGenerator(c *gc.C) {
+	uri := coresecrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "UserSecretsManager")
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "GetUserSecretGenerators")
+		c.Check(arg, jc.DeepEquals, params.Entities{
+			Entities: []params.Entity{{Tag: uri.String()}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.SecretGeneratorResults{})
+		*(result.(*params.SecretGeneratorResults)) = params.SecretGeneratorResults{
+			Results: []params.SecretGeneratorResult{{
+				Kind:   "password",
+				Params: map[string]string{"length": "32"},
+			}},
+		}
+		return nil
+	})
+	client := usersecrets.NewClient(apiCaller)
+	cfg, err := client.GetUserSecretGenerator(context.Background(), uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg, jc.DeepEquals, &coresecrets.GeneratorConfig{
+		Kind:   coresecrets.GeneratorPassword,
+		Params: map[string]string{"length": "32"},
+	})
+}
+
+func (s *secretSuite) TestRotateUserSecret(c *gc.C) {
+	uri := coresecrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "UserSecretsManager")
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "RotateUserSecrets")
+		c.Check(arg, jc.DeepEquals, params.RotateUserSecretArgs{
+			Args: []params.RotateUserSecretArg{{
+				URI:     uri.String(),
+				Content: params.SecretContentParams{Data: map[string]string{"password": "c2VjcmV0"}},
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "boom"}}},
+		}
+		return nil
+	})
+	client := usersecrets.NewClient(apiCaller)
+	err := client.RotateUserSecret(context.Background(), uri, coresecrets.SecretData{"password": "c2VjcmV0"})
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
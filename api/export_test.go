@@ -22,10 +22,13 @@ var (
 	SlideAddressToFront = slideAddressToFront
 	FacadeVersions      = &facadeVersions
 
-	LoginDeviceAPICall                = &loginDeviceAPICall
-	GetDeviceSessionTokenAPICall      = &getDeviceSessionTokenAPICall
-	LoginWithSessionTokenAPICall      = &loginWithSessionTokenAPICall
-	LoginWithClientCredentialsAPICall = &loginWithClientCredentialsAPICall
+	LoginDeviceAPICall                  = &loginDeviceAPICall
+	GetDeviceSessionTokenAPICall        = &getDeviceSessionTokenAPICall
+	LoginWithSessionTokenAPICall        = &loginWithSessionTokenAPICall
+	LoginWithClientCredentialsAPICall   = &loginWithClientCredentialsAPICall
+	OIDCConfigAPICall                   = &oidcConfigAPICall
+	LoginWithIDTokenAPICall             = &loginWithIDTokenAPICall
+	LoginWithServiceAccountTokenAPICall = &loginWithServiceAccountTokenAPICall
 )
 
 func DialAPI(info *Info, opts DialOpts) (jsoncodec.JSONConn, string, error) {
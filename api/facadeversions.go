@@ -22,11 +22,12 @@ func SupportedFacadeVersions() facades.FacadeVersions {
 // We no longer support facade versions at 0.
 var facadeVersions = facades.FacadeVersions{
 	"Action":                       {7},
+	"AdmissionPolicy":              {1},
 	"Agent":                        {3},
 	"AgentLifeFlag":                {1},
 	"AgentTools":                   {1},
 	"Annotations":                  {2},
-	"Application":                  {19, 20},
+	"Application":                  {19, 20, 21, 22},
 	"ApplicationOffers":            {5},
 	"Backups":                      {3},
 	"Block":                        {2},
@@ -51,11 +52,13 @@ var facadeVersions = facades.FacadeVersions{
 	"CrossModelRelations":          {3},
 	"CrossModelSecrets":            {1, 2},
 	"Deployer":                     {1},
-	"DiskManager":                  {2},
+	"DiskManager":                  {2, 3},
 	"EntityWatcher":                {2},
 	"ExternalControllerUpdater":    {1},
 	"FilesystemAttachmentsWatcher": {2},
 	"Firewaller":                   {7},
+	"FirewallRules":                {1},
+	"GroupManager":                 {1},
 	"HighAvailability":             {2, 3},
 	"HostKeyReporter":              {1},
 	"ImageMetadata":                {3},
@@ -68,9 +71,9 @@ var facadeVersions = facades.FacadeVersions{
 	"LifeFlag":                     {1},
 	"Logger":                       {1},
 	"MachineActions":               {1},
-	"MachineManager":               {11},
+	"MachineManager":               {11, 12, 13},
 	"MachineUndertaker":            {1},
-	"Machiner":                     {5, 6},
+	"Machiner":                     {5, 6, 7},
 	"MigrationFlag":                {1},
 	"MigrationMaster":              {4},
 	"MigrationMinion":              {1},
@@ -83,7 +86,7 @@ var facadeVersions = facades.FacadeVersions{
 	"NotifyWatcher":                {1},
 	"OfferStatusWatcher":           {1},
 	"Pinger":                       {1},
-	"Provisioner":                  {11},
+	"Provisioner":                  {11, 12, 13},
 	"ProxyUpdater":                 {2},
 	"Reboot":                       {2},
 	"RelationStatusWatcher":        {1},
@@ -93,6 +96,7 @@ var facadeVersions = facades.FacadeVersions{
 	"Resources":                    {3},
 	"ResourcesHookContext":         {1},
 	"RetryStrategy":                {1},
+	"RoleManager":                  {1},
 	"SecretsTriggerWatcher":        {1},
 	"SecretBackends":               {1},
 	"SecretBackendsManager":        {1},
@@ -101,19 +105,21 @@ var facadeVersions = facades.FacadeVersions{
 	"Secrets":                      {1, 2},
 	"SecretsManager":               {3},
 	"SecretsDrain":                 {1},
+	"ServiceAccountManager":        {1},
 	"UserSecretsDrain":             {1},
-	"UserSecretsManager":           {1},
+	"UserSecretsManager":           {2},
 	"Spaces":                       {6},
-	"SSHClient":                    {4, 5},
-	"Storage":                      {6},
-	"StorageProvisioner":           {4},
+	"SSHClient":                    {4, 5, 6, 7, 8},
+	"SSHCertificates":              {1},
+	"Storage":                      {6, 7, 8},
+	"StorageProvisioner":           {4, 5},
 	"StringsWatcher":               {1},
 	"Subnets":                      {5},
 	"Undertaker":                   {1},
 	"UnitAssigner":                 {1},
-	"Uniter":                       {19, 20, 21},
+	"Uniter":                       {19, 20, 21, 22},
 	"Upgrader":                     {1},
-	"UserManager":                  {3},
+	"UserManager":                  {3, 4},
 	"VolumeAttachmentsWatcher":     {2},
 	"VolumeAttachmentPlansWatcher": {1},
 
@@ -0,0 +1,169 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/api/base"
+	jujuversion "github.com/juju/juju/core/version"
+	jujuhttp "github.com/juju/juju/internal/http"
+	"github.com/juju/juju/internal/oidc"
+	"github.com/juju/juju/rpc/params"
+)
+
+var (
+	oidcConfigAPICall = func(ctx context.Context, caller base.APICaller, response interface{}) error {
+		return caller.APICall(ctx, "Admin", 3, "", "OIDCConfig", nil, response)
+	}
+	loginWithIDTokenAPICall = func(ctx context.Context, caller base.APICaller, request interface{}, response interface{}) error {
+		return caller.APICall(ctx, "Admin", 3, "", "Login", request, response)
+	}
+)
+
+// NewOIDCLoginProvider returns a LoginProvider implementation that
+// authenticates the user with an ID token issued by the OpenID Connect
+// identity provider of the controller. The token is used while it has not
+// expired; after that it is refreshed, and if that fails the user logs in
+// with the identity provider again, using the device authorization flow.
+// The tokenCallback is called with any new token, so it can be persisted.
+func NewOIDCLoginProvider(
+	token oidc.Token,
+	output io.Writer,
+	tokenCallback func(token oidc.Token),
+) *oidcLoginProvider {
+	return &oidcLoginProvider{
+		token:         token,
+		output:        output,
+		tokenCallback: tokenCallback,
+		httpClient:    jujuhttp.NewClient().Client(),
+		now:           time.Now,
+	}
+}
+
+type oidcLoginProvider struct {
+	token oidc.Token
+	// output is used by the login provider to print the user code
+	// and verification URL.
+	output io.Writer
+	// tokenCallback function is used to provide new tokens for
+	// persisting after they are issued.
+	tokenCallback func(token oidc.Token)
+	httpClient    *http.Client
+	now           func() time.Time
+}
+
+// AuthHeader implements the [LoginProvider.AuthHeader] method.
+// Returns an HTTP header with the ID token as the bearer token.
+// Returns an ErrorLoginFirst error if no token is available.
+func (p *oidcLoginProvider) AuthHeader() (http.Header, error) {
+	if p.token.IDToken == "" {
+		return nil, ErrorLoginFirst
+	}
+	header := make(http.Header)
+	header.Set("Authorization", "Bearer "+p.token.IDToken)
+	return header, nil
+}
+
+// Login implements the LoginProvider.Login method.
+//
+// It authenticates as the user the ID token was issued to, obtaining a new
+// token from the identity provider first if needed. Subsequent requests on
+// the state will act as that user.
+func (p *oidcLoginProvider) Login(ctx context.Context, caller base.APICaller) (*LoginResultParams, error) {
+	var config params.OIDCConfigResult
+	if err := oidcConfigAPICall(ctx, caller, &config); err != nil {
+		return nil, errors.Annotate(err, "getting controller OIDC config")
+	}
+
+	cached := p.usable(config.IssuerURL)
+	if !cached {
+		if err := p.obtainToken(ctx, config); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	result, err := p.login(ctx, caller)
+	if err == nil || !cached || !params.IsCodeUnauthorized(err) {
+		return result, errors.Trace(err)
+	}
+
+	// The controller may have rejected the token as expired before we
+	// expected it to; obtain a new one and try once more.
+	if err := p.obtainToken(ctx, config); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return p.login(ctx, caller)
+}
+
+// usable returns whether the token was issued by the identity provider at
+// the issuer URL and has not expired.
+func (p *oidcLoginProvider) usable(issuerURL string) bool {
+	if p.token.Expired(p.now()) {
+		return false
+	}
+	issuer, err := oidc.Issuer(p.token.IDToken)
+	return err == nil && strings.TrimSuffix(issuer, "/") == strings.TrimSuffix(issuerURL, "/")
+}
+
+// obtainToken refreshes the token, or if it cannot be refreshed, logs the
+// user in with the identity provider.
+func (p *oidcLoginProvider) obtainToken(ctx context.Context, config params.OIDCConfigResult) error {
+	metadata, err := oidc.Discover(ctx, p.httpClient, config.IssuerURL)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	var token oidc.Token
+	if p.token.RefreshToken != "" {
+		token, err = oidc.Refresh(ctx, p.httpClient, metadata, config.ClientID, p.token)
+	}
+	if p.token.RefreshToken == "" || err != nil {
+		token, err = oidc.DeviceLogin(ctx, p.httpClient, metadata, config.ClientID, func(verificationURI, userCode string) error {
+			return p.printOutput("Please visit %s and enter code %s to log in.", verificationURI, userCode)
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	p.token = token
+	if p.tokenCallback != nil {
+		p.tokenCallback(token)
+	}
+	return nil
+}
+
+func (p *oidcLoginProvider) printOutput(format string, params ...any) error {
+	if p.output == nil {
+		return errors.New("cannot present login details")
+	}
+	message := fmt.Sprintf(format, params...)
+	if len(message) > 0 && message[len(message)-1] != '\n' {
+		message += "\n"
+	}
+	_, err := fmt.Fprint(p.output, message)
+	return err
+}
+
+func (p *oidcLoginProvider) login(ctx context.Context, caller base.APICaller) (*LoginResultParams, error) {
+	request := &params.LoginRequest{
+		Token:         p.token.IDToken,
+		CLIArgs:       utils.CommandString(os.Args...),
+		ClientVersion: jujuversion.Current.String(),
+	}
+	var result params.LoginResult
+	if err := loginWithIDTokenAPICall(ctx, caller, request, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return NewLoginResultParams(result)
+}
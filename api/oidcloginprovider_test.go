@@ -0,0 +1,174 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/internal/oidc"
+	"github.com/juju/juju/internal/oidc/oidctest"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type oidcLoginProviderSuite struct {
+	testing.BaseSuite
+
+	idp  *oidctest.IdentityProvider
+	info *api.Info
+
+	// loginTokens are the ID tokens presented to the controller.
+	loginTokens []string
+	// rejectFirst makes the controller reject the first token it is
+	// presented.
+	rejectFirst bool
+}
+
+var _ = gc.Suite(&oidcLoginProviderSuite{})
+
+func (s *oidcLoginProviderSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.idp = oidctest.NewIdentityProvider("juju")
+	s.AddCleanup(func(_ *gc.C) { s.idp.Close() })
+	s.loginTokens = nil
+	s.rejectFirst = false
+
+	srv := apiservertesting.NewAPIServer(func(modelUUID string) (interface{}, error) {
+		var err error
+		if modelUUID != "" && modelUUID != testing.ModelTag.Id() {
+			err = fmt.Errorf("%w: %q", apiservererrors.UnknownModelError, modelUUID)
+		}
+		return &testRootAPI{}, err
+	})
+	s.AddCleanup(func(_ *gc.C) { srv.Close() })
+	s.info = &api.Info{
+		Addrs:          srv.Addrs,
+		CACert:         testing.CACert,
+		ControllerUUID: testing.ControllerTag.Id(),
+	}
+
+	s.PatchValue(api.OIDCConfigAPICall, func(_ context.Context, _ base.APICaller, response interface{}) error {
+		*response.(*params.OIDCConfigResult) = params.OIDCConfigResult{
+			IssuerURL: s.idp.URL(),
+			ClientID:  "juju",
+		}
+		return nil
+	})
+	s.PatchValue(api.LoginWithIDTokenAPICall, func(_ context.Context, _ base.APICaller, request interface{}, response interface{}) error {
+		token := request.(*params.LoginRequest).Token
+		s.loginTokens = append(s.loginTokens, token)
+		if s.rejectFirst && len(s.loginTokens) == 1 {
+			return &params.Error{Message: "token expired", Code: params.CodeUnauthorized}
+		}
+		*response.(*params.LoginResult) = params.LoginResult{
+			ControllerTag: names.NewControllerTag(s.info.ControllerUUID).String(),
+			ServerVersion: "4.0.0",
+			UserInfo: &params.AuthUserInfo{
+				Identity:         names.NewUserTag("bob@example.com").String(),
+				ControllerAccess: "login",
+			},
+		}
+		return nil
+	})
+}
+
+func (s *oidcLoginProviderSuite) open(c *gc.C, lp api.LoginProvider) {
+	conn, err := api.Open(context.Background(), s.info, api.DialOpts{LoginProvider: lp})
+	c.Assert(err, jc.ErrorIsNil)
+	_ = conn.Close()
+}
+
+func (s *oidcLoginProviderSuite) TestDeviceLogin(c *gc.C) {
+	var output bytes.Buffer
+	var obtained oidc.Token
+	s.open(c, api.NewOIDCLoginProvider(oidc.Token{}, &output, func(token oidc.Token) {
+		obtained = token
+	}))
+
+	c.Check(output.String(), gc.Equals, fmt.Sprintf("Please visit %s/device and enter code USER-1 to log in.\n", s.idp.URL()))
+	c.Check(s.idp.DeviceLogins(), gc.Equals, 1)
+	c.Check(obtained.IDToken, gc.Not(gc.Equals), "")
+	c.Check(obtained.RefreshToken, gc.Not(gc.Equals), "")
+	c.Check(s.loginTokens, jc.DeepEquals, []string{obtained.IDToken})
+}
+
+func (s *oidcLoginProviderSuite) TestCachedToken(c *gc.C) {
+	token := oidc.Token{
+		IDToken: s.idp.IDToken(),
+		Expiry:  time.Now().Add(time.Hour),
+	}
+	called := false
+	s.open(c, api.NewOIDCLoginProvider(token, nil, func(oidc.Token) { called = true }))
+
+	c.Check(called, jc.IsFalse)
+	c.Check(s.idp.DeviceLogins(), gc.Equals, 0)
+	c.Check(s.loginTokens, jc.DeepEquals, []string{token.IDToken})
+}
+
+func (s *oidcLoginProviderSuite) TestRefreshExpiredToken(c *gc.C) {
+	var output bytes.Buffer
+	var first oidc.Token
+	s.open(c, api.NewOIDCLoginProvider(oidc.Token{}, &output, func(token oidc.Token) {
+		first = token
+	}))
+	expired := first
+	expired.Expiry = time.Now().Add(-time.Minute)
+
+	var refreshed oidc.Token
+	s.open(c, api.NewOIDCLoginProvider(expired, nil, func(token oidc.Token) {
+		refreshed = token
+	}))
+
+	c.Check(s.idp.DeviceLogins(), gc.Equals, 1)
+	c.Check(s.idp.Refreshes(), gc.Equals, 1)
+	c.Check(refreshed.RefreshToken, gc.Not(gc.Equals), first.RefreshToken)
+}
+
+func (s *oidcLoginProviderSuite) TestRefreshFailsFallsBackToDeviceLogin(c *gc.C) {
+	token := oidc.Token{
+		IDToken:      s.idp.IDToken(),
+		RefreshToken: "revoked",
+		Expiry:       time.Now().Add(-time.Minute),
+	}
+	var output bytes.Buffer
+	s.open(c, api.NewOIDCLoginProvider(token, &output, nil))
+
+	c.Check(s.idp.Refreshes(), gc.Equals, 0)
+	c.Check(s.idp.DeviceLogins(), gc.Equals, 1)
+	c.Check(output.String(), gc.Matches, "Please visit .* and enter code .* to log in.\n")
+}
+
+func (s *oidcLoginProviderSuite) TestCachedTokenRejected(c *gc.C) {
+	s.rejectFirst = true
+	token := oidc.Token{
+		IDToken: s.idp.IDToken(),
+		Expiry:  time.Now().Add(time.Hour),
+	}
+	var output bytes.Buffer
+	s.open(c, api.NewOIDCLoginProvider(token, &output, nil))
+
+	c.Check(s.idp.DeviceLogins(), gc.Equals, 1)
+	c.Check(s.loginTokens, gc.HasLen, 2)
+}
+
+func (s *oidcLoginProviderSuite) TestAuthHeader(c *gc.C) {
+	_, err := api.NewOIDCLoginProvider(oidc.Token{}, nil, nil).AuthHeader()
+	c.Assert(err, gc.ErrorMatches, "login provider needs to be logged in")
+
+	header, err := api.NewOIDCLoginProvider(oidc.Token{IDToken: "id-token"}, nil, nil).AuthHeader()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(header, jc.DeepEquals, http.Header{"Authorization": {"Bearer id-token"}})
+}
@@ -67,6 +67,7 @@ func (*ImportSuite) TestImports(c *gc.C) {
 		"internal/http",
 		"internal/logger",
 		"internal/macaroon",
+		"internal/oidc",
 		"internal/proxy",
 		"internal/proxy/config",
 		"internal/rpcreflect",
@@ -0,0 +1,61 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/api/base"
+	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/rpc/params"
+)
+
+var (
+	loginWithServiceAccountTokenAPICall = func(ctx context.Context, caller base.APICaller, request interface{}, response interface{}) error {
+		return caller.APICall(ctx, "Admin", 3, "", "Login", request, response)
+	}
+)
+
+// NewServiceAccountTokenLoginProvider returns a LoginProvider implementation
+// that authenticates as a service account using the given API token.
+func NewServiceAccountTokenLoginProvider(token string) *serviceAccountTokenLoginProvider {
+	return &serviceAccountTokenLoginProvider{
+		token: token,
+	}
+}
+
+type serviceAccountTokenLoginProvider struct {
+	token string
+}
+
+// AuthHeader implements the [LoginProvider.AuthHeader] method.
+// It returns an HTTP header with the token set as a bearer token.
+func (p *serviceAccountTokenLoginProvider) AuthHeader() (http.Header, error) {
+	header := make(http.Header)
+	header.Set("Authorization", "Bearer "+p.token)
+	return header, nil
+}
+
+// Login implements the LoginProvider.Login method.
+//
+// It authenticates as the service account owning the token.
+// Subsequent requests on the state will act as that service account,
+// limited to the scopes of the token.
+func (p *serviceAccountTokenLoginProvider) Login(ctx context.Context, caller base.APICaller) (*LoginResultParams, error) {
+	request := &params.LoginRequest{
+		Token:         p.token,
+		CLIArgs:       utils.CommandString(os.Args...),
+		ClientVersion: jujuversion.Current.String(),
+	}
+	var result params.LoginResult
+	if err := loginWithServiceAccountTokenAPICall(ctx, caller, request, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return NewLoginResultParams(result)
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package api_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/base"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type serviceAccountTokenLoginProviderSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&serviceAccountTokenLoginProviderSuite{})
+
+func (s *serviceAccountTokenLoginProviderSuite) APIInfo() *api.Info {
+	srv := apiservertesting.NewAPIServer(func(modelUUID string) (interface{}, error) {
+		var err error
+		if modelUUID != "" && modelUUID != testing.ModelTag.Id() {
+			err = fmt.Errorf("%w: %q", apiservererrors.UnknownModelError, modelUUID)
+		}
+		return &testRootAPI{}, err
+	})
+	s.AddCleanup(func(_ *gc.C) { srv.Close() })
+	info := &api.Info{
+		Addrs:          srv.Addrs,
+		CACert:         testing.CACert,
+		ControllerUUID: testing.ControllerTag.Id(),
+		ModelTag:       testing.ModelTag,
+	}
+	return info
+}
+
+func (s *serviceAccountTokenLoginProviderSuite) TestServiceAccountTokenLogin(c *gc.C) {
+	info := s.APIInfo()
+
+	token := "juju-sa-test-token"
+
+	s.PatchValue(api.LoginWithServiceAccountTokenAPICall, func(ctx context.Context, _ base.APICaller, request interface{}, response interface{}) error {
+		lr, ok := request.(*params.LoginRequest)
+		if !ok {
+			return errors.Errorf("expected %T, received %T for request type", lr, request)
+		}
+		if lr.Token != token {
+			return errors.Unauthorized
+		}
+
+		loginResult, ok := response.(*params.LoginResult)
+		if !ok {
+			return errors.Errorf("expected %T, received %T for response type", loginResult, response)
+		}
+		loginResult.ControllerTag = names.NewControllerTag(info.ControllerUUID).String()
+		loginResult.ServerVersion = "4.0.0"
+		loginResult.UserInfo = &params.AuthUserInfo{
+			DisplayName:      "ci",
+			Identity:         names.NewUserTag("ci").String(),
+			ControllerAccess: "login",
+		}
+		return nil
+	})
+
+	lp := api.NewServiceAccountTokenLoginProvider(token)
+	apiState, err := api.Open(context.Background(), &api.Info{
+		Addrs:          info.Addrs,
+		ControllerUUID: info.ControllerUUID,
+		CACert:         info.CACert,
+	}, api.DialOpts{
+		LoginProvider: lp,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = apiState.Close() }()
+
+	tag, ok := apiState.AuthTag().(names.UserTag)
+	c.Assert(ok, jc.IsTrue)
+	c.Check(tag.Name(), gc.Equals, "ci")
+}
+
+func (s *serviceAccountTokenLoginProviderSuite) TestServiceAccountTokenAuthHeader(c *gc.C) {
+	lp := api.NewServiceAccountTokenLoginProvider("juju-sa-test-token")
+	got, err := lp.AuthHeader()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got.Get("Authorization"), gc.Equals, "Bearer juju-sa-test-token")
+}
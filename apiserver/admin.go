@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/httpcontext"
 	"github.com/juju/juju/apiserver/observer"
 	"github.com/juju/juju/core/auditlog"
 	"github.com/juju/juju/core/network"
@@ -76,6 +77,20 @@ func (a *admin) RedirectInfo() (params.RedirectInfoResult, error) {
 	return params.RedirectInfoResult{}, fmt.Errorf("not redirected")
 }
 
+// OIDCConfig returns the OpenID Connect identity provider users log in with,
+// which clients log in with before presenting the ID token they are issued
+// to Login. A NotSupported error is returned if OIDC login is not configured.
+func (a *admin) OIDCConfig() (params.OIDCConfigResult, error) {
+	issuerURL, clientID := a.srv.shared.oidcConfig()
+	if issuerURL == "" {
+		return params.OIDCConfigResult{}, errors.NotSupportedf("OIDC login")
+	}
+	return params.OIDCConfigResult{
+		IssuerURL: issuerURL,
+		ClientID:  clientID,
+	}, nil
+}
+
 var MaintenanceNoLoginError = errors.New("login failed - maintenance in progress")
 var errAlreadyLoggedIn = errors.New("already logged in")
 
@@ -169,6 +184,17 @@ func (a *admin) login(ctx context.Context, req params.LoginRequest, loginVersion
 		return fail, errors.Trace(err)
 	}
 
+	// Users are rate limited so that a single user can not degrade the
+	// controller for everyone else. Agents are only limited when they
+	// connect.
+	if userTag, ok := authResult.tag.(names.UserTag); ok {
+		var modelUUID string
+		if !authResult.controllerOnlyLogin {
+			modelUUID = a.root.modelUUID.String()
+		}
+		apiRoot = rateLimitRoot(apiRoot, a.srv.apiRateLimiter, userTag, modelUUID)
+	}
+
 	var facadeFilters []facadeFilterFunc
 	var modelTag string
 	if authResult.anonymousLogin {
@@ -298,6 +324,7 @@ func (a *admin) authenticate(ctx context.Context, modelExists bool, req params.L
 			Macaroons:     req.Macaroons,
 			BakeryVersion: req.BakeryVersion,
 		}
+		authParams.RemoteAddress, _ = httpcontext.RequestRemoteAddress(ctx)
 
 		authenticated := false
 		for _, authenticator := range a.srv.loginAuthenticators {
@@ -37,6 +37,7 @@ import (
 	"github.com/juju/juju/apiserver/facades/agent/uniter"
 	"github.com/juju/juju/apiserver/facades/agent/upgrader"
 	"github.com/juju/juju/apiserver/facades/client/action"
+	"github.com/juju/juju/apiserver/facades/client/admissionpolicy"
 	"github.com/juju/juju/apiserver/facades/client/annotations" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/application"
 	"github.com/juju/juju/apiserver/facades/client/applicationoffers" // ModelUser Write
@@ -48,6 +49,8 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/cloud"      // ModelUser Read
 	"github.com/juju/juju/apiserver/facades/client/controller" // ModelUser Admin (although some methods check for read only)
 	"github.com/juju/juju/apiserver/facades/client/credentialmanager"
+	"github.com/juju/juju/apiserver/facades/client/firewallrules"
+	"github.com/juju/juju/apiserver/facades/client/groupmanager"
 	"github.com/juju/juju/apiserver/facades/client/highavailability" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/imagemetadatamanager"
 	"github.com/juju/juju/apiserver/facades/client/keymanager"     // ModelUser Write
@@ -57,9 +60,12 @@ import (
 	"github.com/juju/juju/apiserver/facades/client/modelupgrader"
 	"github.com/juju/juju/apiserver/facades/client/pinger"
 	"github.com/juju/juju/apiserver/facades/client/resources"
+	"github.com/juju/juju/apiserver/facades/client/rolemanager"
 	"github.com/juju/juju/apiserver/facades/client/secretbackends"
 	"github.com/juju/juju/apiserver/facades/client/secrets"
-	"github.com/juju/juju/apiserver/facades/client/spaces"    // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/serviceaccountmanager"
+	"github.com/juju/juju/apiserver/facades/client/spaces" // ModelUser Write
+	"github.com/juju/juju/apiserver/facades/client/sshcertificates"
 	"github.com/juju/juju/apiserver/facades/client/sshclient" // ModelUser Write
 	"github.com/juju/juju/apiserver/facades/client/storage"
 	"github.com/juju/juju/apiserver/facades/client/subnets"
@@ -159,6 +165,7 @@ func AllFacades() *facade.Registry {
 	registry := new(facade.Registry)
 
 	action.Register(registry)
+	admissionpolicy.Register(registry)
 	agent.Register(registry)
 	agenttools.Register(registry)
 	annotations.Register(registry)
@@ -194,6 +201,8 @@ func AllFacades() *facade.Registry {
 	deployer.Register(registry)
 	diskmanager.Register(registry)
 	firewaller.Register(registry)
+	firewallrules.Register(registry)
+	groupmanager.Register(registry)
 	highavailability.Register(registry)
 	hostkeyreporter.Register(registry)
 	imagemetadata.Register(registry)
@@ -223,6 +232,7 @@ func AllFacades() *facade.Registry {
 	reboot.Register(registry)
 	remoterelations.Register(registry)
 	resources.Register(registry)
+	rolemanager.Register(registry)
 	resourceshookcontext.Register(registry)
 	retrystrategy.Register(registry)
 	secrets.Register(registry)
@@ -230,9 +240,11 @@ func AllFacades() *facade.Registry {
 	secretbackendmanager.Register(registry)
 	secretsmanager.Register(registry)
 	secretsdrain.Register(registry)
+	serviceaccountmanager.Register(registry)
 	usersecrets.Register(registry)
 	usersecretsdrain.Register(registry)
 	sshclient.Register(registry)
+	sshcertificates.Register(registry)
 	spaces.Register(registry)
 	storage.Register(registry)
 	storageprovisioner.Register(registry)
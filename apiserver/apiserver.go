@@ -27,6 +27,8 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/authentication/jwt"
 	"github.com/juju/juju/apiserver/authentication/macaroon"
+	oidcauthentication "github.com/juju/juju/apiserver/authentication/oidc"
+	serviceaccountauthentication "github.com/juju/juju/apiserver/authentication/serviceaccount"
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/common/apihttp"
 	"github.com/juju/juju/apiserver/common/crossmodel"
@@ -37,6 +39,8 @@ import (
 	handlerspubsub "github.com/juju/juju/apiserver/internal/handlers/pubsub"
 	handlersresources "github.com/juju/juju/apiserver/internal/handlers/resources"
 	resourcesdownload "github.com/juju/juju/apiserver/internal/handlers/resources/download"
+	"github.com/juju/juju/apiserver/internal/handlers/sshsessions"
+	"github.com/juju/juju/apiserver/internal/ratelimiter"
 	"github.com/juju/juju/apiserver/logsink"
 	"github.com/juju/juju/apiserver/observer"
 	"github.com/juju/juju/apiserver/stateauthenticator"
@@ -53,7 +57,9 @@ import (
 	coretrace "github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
 	internalerrors "github.com/juju/juju/internal/errors"
+	jujuhttp "github.com/juju/juju/internal/http"
 	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/oidc"
 	controllermsg "github.com/juju/juju/internal/pubsub/controller"
 	"github.com/juju/juju/internal/resource"
 	resourcecharmhub "github.com/juju/juju/internal/resource/charmhub"
@@ -102,6 +108,10 @@ type Server struct {
 	metricsCollector       *Collector
 	execEmbeddedCommand    ExecEmbeddedCommandFunc
 
+	// apiRateLimiter rate limits the API requests of users. Its limits
+	// come from controller config, and are updated on the fly.
+	apiRateLimiter *ratelimiter.Limiter
+
 	// mu guards the fields below it.
 	mu sync.Mutex
 
@@ -333,8 +343,21 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		return nil, errors.Annotate(err, "unable to get controller config")
 	}
 
-	httpAuthenticators := []authentication.HTTPAuthenticator{cfg.LocalMacaroonAuthenticator, cfg.JWTAuthenticator}
-	loginAuthenticators := []authentication.LoginAuthenticator{cfg.LocalMacaroonAuthenticator, cfg.JWTAuthenticator}
+	// The OIDC and service account authenticators must be tried before the
+	// JWT authenticator, as they all accept tokens, but only they can tell
+	// their own tokens from JAAS tokens.
+	oidcAuthenticator := oidcauthentication.NewAuthenticator(
+		controllerConfigService,
+		controllerDomainServices.Access(),
+		oidc.NewVerifier(jujuhttp.NewClient().Client(), cfg.Clock),
+	)
+	serviceAccountAuthenticator := serviceaccountauthentication.NewAuthenticator(controllerDomainServices.Access())
+	httpAuthenticators := []authentication.HTTPAuthenticator{
+		cfg.LocalMacaroonAuthenticator, oidcAuthenticator, serviceAccountAuthenticator, cfg.JWTAuthenticator,
+	}
+	loginAuthenticators := []authentication.LoginAuthenticator{
+		cfg.LocalMacaroonAuthenticator, oidcAuthenticator, serviceAccountAuthenticator, cfg.JWTAuthenticator,
+	}
 
 	shared, err := newSharedServerContext(sharedServerConfig{
 		statePool:            cfg.StatePool,
@@ -385,10 +408,12 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		logSink:             cfg.LogSink,
 		metricsCollector:    cfg.MetricsCollector,
 		execEmbeddedCommand: cfg.ExecEmbeddedCommand,
+		apiRateLimiter:      ratelimiter.New(cfg.Clock),
 
 		healthStatus: "starting",
 	}
 	srv.updateAgentRateLimiter(controllerConfig)
+	srv.updateAPIRateLimiter(controllerConfig)
 	if err := srv.updateResourceDownloadLimiters(controllerConfig); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -405,6 +430,7 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 			}
 
 			srv.updateAgentRateLimiter(data.Config)
+			srv.updateAPIRateLimiter(data.Config)
 
 			// If the update fails, there is nothing else we can do but log the
 			// error. The server will continue to run with the old limits.
@@ -500,6 +526,17 @@ func (srv *Server) updateAgentRateLimiter(cfg controller.Config) {
 	}
 }
 
+func (srv *Server) updateAPIRateLimiter(cfg controller.Config) {
+	srv.apiRateLimiter.Update(ratelimiter.Config{
+		UserMax:       cfg.UserRateLimitMax(),
+		UserRate:      cfg.UserRateLimitRate(),
+		ModelMax:      cfg.ModelRateLimitMax(),
+		ModelRate:     cfg.ModelRateLimitRate(),
+		ExpensiveMax:  cfg.ExpensiveCallRateLimitMax(),
+		ExpensiveRate: cfg.ExpensiveCallRateLimitRate(),
+	})
+}
+
 func (srv *Server) updateResourceDownloadLimiters(cfg controller.Config) error {
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
@@ -831,6 +868,13 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 		controllerTag: systemState.ControllerTag(),
 	}
 
+	sshSessionRecordingHandler := srv.monitoredHandler(sshsessions.NewRecordingHTTPHandler(
+		&sshSessionServiceGetter{ctxt: httpCtxt},
+	), "sshsessions")
+	modelAdminAuthorizer := modelAdminAuthorizer{
+		controllerTag: systemState.ControllerTag(),
+	}
+
 	migrateObjectsCharmsHTTPHandler := srv.monitoredHandler(objects.NewObjectsCharmHTTPHandler(
 		&stateGetter{authFunc: httpCtxt.stateForMigrationImporting},
 		&migratingObjectsApplicationServiceGetter{ctxt: httpCtxt},
@@ -897,6 +941,11 @@ func (srv *Server) endpoints() ([]apihttp.Endpoint, error) {
 	}, {
 		pattern: modelRoutePrefix + "/units/:unit/resources/:resource",
 		handler: unitResourcesHandler,
+	}, {
+		pattern:    modelRoutePrefix + "/ssh-sessions/:session/recording",
+		methods:    []string{"GET"},
+		handler:    sshSessionRecordingHandler,
+		authorizer: modelAdminAuthorizer,
 	}, {
 		pattern:    "/migrate/charms/:object",
 		handler:    migrateObjectsCharmsHTTPHandler,
@@ -1062,6 +1111,7 @@ func (srv *Server) apiHandler(w http.ResponseWriter, req *http.Request) {
 		// allow the peeling of the modelUUID from the request to be
 		// deferred to the facade methods.
 		ctx := coremodel.WithContextModelUUID(req.Context(), resolvedModelUUID)
+		ctx = httpcontext.SetContextRemoteAddress(ctx, req.RemoteAddr)
 
 		logger.Tracef(context.TODO(), "got a request for model %q", modelUUID)
 		if err := srv.serveConn(
@@ -1268,6 +1318,19 @@ func (a *objectStoreServiceGetter) ObjectStore(r *http.Request) (objects.ObjectS
 	return objectStore, nil
 }
 
+type sshSessionServiceGetter struct {
+	ctxt httpContext
+}
+
+func (a *sshSessionServiceGetter) SSHSession(r *http.Request) (sshsessions.SSHSessionService, error) {
+	domainServices, err := a.ctxt.domainServicesForRequest(r.Context())
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	return domainServices.SSHSession(), nil
+}
+
 type resourceServiceGetter struct {
 	ctxt httpContext
 }
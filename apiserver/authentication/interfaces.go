@@ -49,6 +49,10 @@ type AuthParams struct {
 	// Nonce is used for agent auth.
 	Nonce string
 
+	// RemoteAddress is the address the request came from, recorded in the
+	// login history of local users.
+	RemoteAddress string
+
 	// These are used for macaroon auth.
 	Macaroons     []macaroon.Slice
 	BakeryVersion bakery.Version
@@ -70,6 +74,18 @@ type PermissionDelegator interface {
 	PermissionError(subject names.Tag, permission permission.Access) error
 }
 
+// RoleDelegator is implemented by the permission delegators of users whose
+// custom roles may allow API calls their access level does not. Delegators
+// which narrow the access of the user, such as those of scoped tokens, must
+// not implement it, as roles would widen the access they are limited to.
+type RoleDelegator interface {
+	PermissionDelegator
+
+	// RolesApply reports whether the roles granted to the authenticated
+	// user apply.
+	RolesApply() bool
+}
+
 // EntityAuthenticator is the interface all entity authenticators need to
 // implement to authenticate juju entities.
 type EntityAuthenticator interface {
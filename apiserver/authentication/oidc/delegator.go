@@ -0,0 +1,86 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+)
+
+// PermissionDelegator implements authentication.PermissionDelegator for
+// users authenticated with an ID token, adding the access granted to the
+// groups claimed by the token to the access granted to the user.
+type PermissionDelegator struct {
+	AccessService AccessService
+
+	// User is the user the token was issued to.
+	User user.Name
+
+	// Groups are the groups the token claims the user belongs to.
+	Groups []string
+}
+
+// SubjectPermissions returns the greatest of the access the user has on the
+// target directly and through their groups. The groups are only known for
+// the user the token was issued to; other users only have their own access.
+func (p *PermissionDelegator) SubjectPermissions(
+	ctx context.Context, userName string, target permission.ID,
+) (permission.Access, error) {
+	name, err := user.NewName(userName)
+	if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	var groups []string
+	if name == p.User {
+		groups = p.Groups
+	}
+	if !name.IsLocal() {
+		if err := p.AccessService.EnsureExternalUserIfAuthorized(ctx, name, target); err != nil {
+			return permission.NoAccess, errors.Trace(err)
+		}
+		if err := p.AccessService.EnsureExternalUserIfGroupAuthorized(ctx, name, groups); err != nil {
+			return permission.NoAccess, errors.Trace(err)
+		}
+	}
+
+	access, err := p.AccessService.ReadUserAccessLevelForTarget(ctx, name, target)
+	if errors.Is(err, accesserrors.AccessNotFound) || errors.Is(err, accesserrors.UserNotFound) {
+		access = permission.NoAccess
+	} else if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	groupAccess, err := p.AccessService.ReadGroupAccessLevelForTarget(ctx, groups, target)
+	if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+	spec := permission.AccessSpec{Target: target, Access: groupAccess}
+	if spec.EqualOrGreaterThan(access) {
+		access = groupAccess
+	}
+
+	if access == permission.NoAccess {
+		return permission.NoAccess, accesserrors.PermissionNotFound
+	}
+	return access, nil
+}
+
+// PermissionError implements authentication.PermissionDelegator.
+func (p *PermissionDelegator) PermissionError(_ names.Tag, _ permission.Access) error {
+	return apiservererrors.ErrPerm
+}
+
+// RolesApply returns true, as the roles granted to the user extend their
+// access.
+func (p *PermissionDelegator) RolesApply() bool {
+	return true
+}
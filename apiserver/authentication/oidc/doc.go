@@ -0,0 +1,27 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package oidc provides an authentication and authorisation mechanism whereby
+// a Juju controller accepts the ID tokens issued by an OpenID Connect
+// identity provider as the means to declare a user and the groups they
+// belong to.
+//
+// This mechanism kicks in when the 'oidc-issuer-url' and 'oidc-client-id'
+// controller config are set. Clients log in with the provider themselves,
+// using the device authorization flow, and present the ID token they are
+// issued to the controller.
+//
+// # Authentication
+//
+// The ID token is used to authenticate Juju login requests against the API
+// endpoint, and raw HTTP requests as a bearer token. Tokens from any other
+// issuer are left for the other authenticators. The user is named by the
+// 'oidc-username-claim' of the token; names without a domain are given the
+// "external" domain, and local user names are rejected.
+//
+// # Authorisation
+//
+// The user has the greatest of the access granted to them directly, as any
+// external user, and the access granted to the groups named by the
+// 'oidc-groups-claim' of their token.
+package oidc
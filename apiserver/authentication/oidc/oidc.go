@@ -0,0 +1,192 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/internal/oidc"
+)
+
+// ControllerConfigService provides the controller config, which holds the
+// identity provider settings.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller config.
+	ControllerConfig(context.Context) (controller.Config, error)
+}
+
+// AccessService provides the access granted to users and to groups.
+type AccessService interface {
+	// ReadUserAccessLevelForTarget returns the access the user has on the
+	// target.
+	ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error)
+
+	// EnsureExternalUserIfAuthorized adds the external user if they are
+	// missing and everyone@external has access on the target.
+	EnsureExternalUserIfAuthorized(ctx context.Context, subject user.Name, target permission.ID) error
+
+	// ReadGroupAccessLevelForTarget returns the greatest access any of the
+	// groups have on the target.
+	ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target permission.ID) (permission.Access, error)
+
+	// EnsureExternalUserIfGroupAuthorized adds the external user if they are
+	// missing and any of their groups have been granted access.
+	EnsureExternalUserIfGroupAuthorized(ctx context.Context, subject user.Name, groups []string) error
+}
+
+// TokenVerifier verifies the ID tokens issued by identity providers.
+type TokenVerifier interface {
+	// Verify returns the ID token if it was signed by the identity provider
+	// at the issuer URL, was issued to the client, and has not expired.
+	Verify(ctx context.Context, issuerURL, clientID, idToken string) (jwt.Token, error)
+}
+
+// Authenticator authenticates users with the ID tokens issued to them by the
+// identity provider configured for the controller.
+type Authenticator struct {
+	controllerConfigService ControllerConfigService
+	accessService           AccessService
+	verifier                TokenVerifier
+}
+
+// NewAuthenticator returns a new Authenticator.
+func NewAuthenticator(
+	controllerConfigService ControllerConfigService,
+	accessService AccessService,
+	verifier TokenVerifier,
+) *Authenticator {
+	return &Authenticator{
+		controllerConfigService: controllerConfigService,
+		accessService:           accessService,
+		verifier:                verifier,
+	}
+}
+
+// Authenticate implements authentication.HTTPAuthenticator. A NotFound error
+// is returned if the request does not carry a bearer token issued by the
+// identity provider.
+func (a *Authenticator) Authenticate(req *http.Request) (authentication.AuthInfo, error) {
+	authHeader := req.Header.Get("Authorization")
+	if authHeader == "" {
+		return authentication.AuthInfo{}, errors.NotFoundf("authorization header missing")
+	}
+	authScheme, rawToken, spaceFound := strings.Cut(authHeader, " ")
+	if !spaceFound || authScheme != "Bearer" || strings.Contains(rawToken, " ") {
+		return authentication.AuthInfo{}, errors.NotFoundf("authorization header format")
+	}
+
+	authInfo, err := a.authenticate(req.Context(), rawToken)
+	if errors.Is(err, errors.NotSupported) {
+		return authentication.AuthInfo{}, errors.NotFoundf("OIDC token")
+	} else if err != nil {
+		return authentication.AuthInfo{}, errors.Trace(err)
+	}
+	return authInfo, nil
+}
+
+// AuthenticateLoginRequest implements authentication.LoginAuthenticator. A
+// NotSupported error is returned if the request does not carry a token
+// issued by the identity provider.
+func (a *Authenticator) AuthenticateLoginRequest(
+	ctx context.Context,
+	_ string,
+	_ model.UUID,
+	authParams authentication.AuthParams,
+) (authentication.AuthInfo, error) {
+	if authParams.Token == "" {
+		return authentication.AuthInfo{}, fmt.Errorf("auth token %w", errors.NotSupported)
+	}
+	authInfo, err := a.authenticate(ctx, authParams.Token)
+	return authInfo, errors.Trace(err)
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, idToken string) (authentication.AuthInfo, error) {
+	cfg, err := a.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return authentication.AuthInfo{}, errors.Trace(err)
+	}
+	issuerURL := strings.TrimSuffix(cfg.OIDCIssuerURL(), "/")
+	if issuerURL == "" {
+		return authentication.AuthInfo{}, fmt.Errorf("OIDC login %w", errors.NotSupported)
+	}
+	// Tokens which are not JWTs, or were issued by someone else, are for
+	// the other authenticators.
+	if issuer, err := oidc.Issuer(idToken); err != nil || strings.TrimSuffix(issuer, "/") != issuerURL {
+		return authentication.AuthInfo{}, fmt.Errorf("token from issuer %q %w", issuer, errors.NotSupported)
+	}
+
+	token, err := a.verifier.Verify(ctx, issuerURL, cfg.OIDCClientID(), idToken)
+	if err != nil {
+		return authentication.AuthInfo{}, errors.WithType(err, errors.Unauthorized)
+	}
+	name, err := userName(token, cfg.OIDCUsernameClaim())
+	if err != nil {
+		return authentication.AuthInfo{}, errors.WithType(err, errors.Unauthorized)
+	}
+	return authentication.AuthInfo{
+		Entity: authentication.TagToEntity(names.NewUserTag(name.Name())),
+		Delegator: &PermissionDelegator{
+			AccessService: a.accessService,
+			User:          name,
+			Groups:        groups(token, cfg.OIDCGroupsClaim()),
+		},
+	}, nil
+}
+
+// userName returns the name of the user the token was issued to, read from
+// the claim. Names without a domain are given the "external" domain.
+func userName(token jwt.Token, claim string) (user.Name, error) {
+	value, _ := token.Get(claim)
+	raw, _ := value.(string)
+	if raw == "" {
+		return user.Name{}, errors.Errorf("ID token has no %q claim", claim)
+	}
+	if !strings.Contains(raw, "@") {
+		raw += "@external"
+	}
+	name, err := user.NewName(raw)
+	if err != nil {
+		return user.Name{}, errors.Annotatef(err, "ID token %q claim", claim)
+	}
+	if name.IsLocal() {
+		return user.Name{}, errors.Errorf("ID token %q claim names local user %q", claim, name)
+	}
+	return name, nil
+}
+
+// groups returns the groups read from the claim, which identity providers
+// give as a list of names or, for a single group, a name.
+func groups(token jwt.Token, claim string) []string {
+	value, ok := token.Get(claim)
+	if !ok {
+		return nil
+	}
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []string:
+		return value
+	case []any:
+		result := make([]string, 0, len(value))
+		for _, v := range value {
+			if group, ok := v.(string); ok && group != "" {
+				result = append(result, group)
+			}
+		}
+		return result
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc_test
+
+import (
+	"testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package oidc_test -destination service_mock_test.go github.com/juju/juju/apiserver/authentication/oidc ControllerConfigService,AccessService
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
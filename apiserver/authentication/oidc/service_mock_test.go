@@ -0,0 +1,259 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/authentication/oidc (interfaces: ControllerConfigService,AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package oidc_test -destination service_mock_test.go github.com/juju/juju/apiserver/authentication/oidc ControllerConfigService,AccessService
+//
+
+// Package oidc_test is a generated GoMock package.
+package oidc_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controller "github.com/juju/juju/controller"
+	permission "github.com/juju/juju/core/permission"
+	user "github.com/juju/juju/core/user"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+	return &MockControllerConfigServiceControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceControllerConfigCall) Return(arg0 controller.Config, arg1 error) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceControllerConfigCall) Do(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock *MockAccessService
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// EnsureExternalUserIfAuthorized mocks base method.
+func (m *MockAccessService) EnsureExternalUserIfAuthorized(arg0 context.Context, arg1 user.Name, arg2 permission.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureExternalUserIfAuthorized", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureExternalUserIfAuthorized indicates an expected call of EnsureExternalUserIfAuthorized.
+func (mr *MockAccessServiceMockRecorder) EnsureExternalUserIfAuthorized(arg0, arg1, arg2 any) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureExternalUserIfAuthorized", reflect.TypeOf((*MockAccessService)(nil).EnsureExternalUserIfAuthorized), arg0, arg1, arg2)
+	return &MockAccessServiceEnsureExternalUserIfAuthorizedCall{Call: call}
+}
+
+// MockAccessServiceEnsureExternalUserIfAuthorizedCall wrap *gomock.Call
+type MockAccessServiceEnsureExternalUserIfAuthorizedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) Return(arg0 error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) Do(f func(context.Context, user.Name, permission.ID) error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnsureExternalUserIfGroupAuthorized mocks base method.
+func (m *MockAccessService) EnsureExternalUserIfGroupAuthorized(arg0 context.Context, arg1 user.Name, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureExternalUserIfGroupAuthorized", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureExternalUserIfGroupAuthorized indicates an expected call of EnsureExternalUserIfGroupAuthorized.
+func (mr *MockAccessServiceMockRecorder) EnsureExternalUserIfGroupAuthorized(arg0, arg1, arg2 any) *MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureExternalUserIfGroupAuthorized", reflect.TypeOf((*MockAccessService)(nil).EnsureExternalUserIfGroupAuthorized), arg0, arg1, arg2)
+	return &MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall{Call: call}
+}
+
+// MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall wrap *gomock.Call
+type MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall) Return(arg0 error) *MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall) Do(f func(context.Context, user.Name, []string) error) *MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall) DoAndReturn(f func(context.Context, user.Name, []string) error) *MockAccessServiceEnsureExternalUserIfGroupAuthorizedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadGroupAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadGroupAccessLevelForTarget(arg0 context.Context, arg1 []string, arg2 permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGroupAccessLevelForTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(permission.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGroupAccessLevelForTarget indicates an expected call of ReadGroupAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadGroupAccessLevelForTarget(arg0, arg1, arg2 any) *MockAccessServiceReadGroupAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGroupAccessLevelForTarget", reflect.TypeOf((*MockAccessService)(nil).ReadGroupAccessLevelForTarget), arg0, arg1, arg2)
+	return &MockAccessServiceReadGroupAccessLevelForTargetCall{Call: call}
+}
+
+// MockAccessServiceReadGroupAccessLevelForTargetCall wrap *gomock.Call
+type MockAccessServiceReadGroupAccessLevelForTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceReadGroupAccessLevelForTargetCall) Return(arg0 permission.Access, arg1 error) *MockAccessServiceReadGroupAccessLevelForTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceReadGroupAccessLevelForTargetCall) Do(f func(context.Context, []string, permission.ID) (permission.Access, error)) *MockAccessServiceReadGroupAccessLevelForTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceReadGroupAccessLevelForTargetCall) DoAndReturn(f func(context.Context, []string, permission.ID) (permission.Access, error)) *MockAccessServiceReadGroupAccessLevelForTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUserAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadUserAccessLevelForTarget(arg0 context.Context, arg1 user.Name, arg2 permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUserAccessLevelForTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(permission.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUserAccessLevelForTarget indicates an expected call of ReadUserAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadUserAccessLevelForTarget(arg0, arg1, arg2 any) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserAccessLevelForTarget", reflect.TypeOf((*MockAccessService)(nil).ReadUserAccessLevelForTarget), arg0, arg1, arg2)
+	return &MockAccessServiceReadUserAccessLevelForTargetCall{Call: call}
+}
+
+// MockAccessServiceReadUserAccessLevelForTargetCall wrap *gomock.Call
+type MockAccessServiceReadUserAccessLevelForTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceReadUserAccessLevelForTargetCall) Return(arg0 permission.Access, arg1 error) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceReadUserAccessLevelForTargetCall) Do(f func(context.Context, user.Name, permission.ID) (permission.Access, error)) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceReadUserAccessLevelForTargetCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) (permission.Access, error)) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccount
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+)
+
+// PermissionDelegator implements authentication.PermissionDelegator for
+// service accounts authenticated with a token, limiting the access granted
+// to the service account to that its token is scoped to.
+type PermissionDelegator struct {
+	AccessService AccessService
+
+	// Token is the token the service account authenticated with.
+	Token access.ServiceAccountToken
+}
+
+// SubjectPermissions returns the lesser of the access the user has on the
+// target and the access the token is scoped to. The token only limits the
+// service account it was issued to; other users have their own access.
+func (p *PermissionDelegator) SubjectPermissions(
+	ctx context.Context, userName string, target permission.ID,
+) (permission.Access, error) {
+	name, err := user.NewName(userName)
+	if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	access, err := p.AccessService.ReadUserAccessLevelForTarget(ctx, name, target)
+	if errors.Is(err, accesserrors.AccessNotFound) || errors.Is(err, accesserrors.UserNotFound) {
+		access = permission.NoAccess
+	} else if err != nil {
+		return permission.NoAccess, errors.Trace(err)
+	}
+
+	if name == p.Token.ServiceAccount {
+		scope := p.Token.Scope(target)
+		spec := permission.AccessSpec{Target: target, Access: access}
+		if spec.EqualOrGreaterThan(scope) {
+			access = scope
+		}
+	}
+
+	if access == permission.NoAccess {
+		return permission.NoAccess, accesserrors.PermissionNotFound
+	}
+	return access, nil
+}
+
+// PermissionError implements authentication.PermissionDelegator.
+func (p *PermissionDelegator) PermissionError(_ names.Tag, _ permission.Access) error {
+	return apiservererrors.ErrPerm
+}
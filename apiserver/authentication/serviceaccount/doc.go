@@ -0,0 +1,22 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package serviceaccount provides an authentication and authorisation
+// mechanism whereby a Juju controller accepts the API tokens it issued to
+// service accounts, the identities of automation such as CI pipelines.
+//
+// # Authentication
+//
+// The token is used to authenticate Juju login requests against the API
+// endpoint, and raw HTTP requests as a bearer token in the Authorization
+// header. Service account tokens start with a fixed prefix; any other token
+// is left for the other authenticators. Tokens which have expired or been
+// revoked, or whose service account has been disabled or removed, are
+// rejected.
+//
+// # Authorisation
+//
+// The service account has the lesser of the access it has been granted and
+// the access its token is scoped to. Every token allows login access to the
+// controller, as that is needed to connect to the models it is scoped to.
+package serviceaccount
@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/authentication/serviceaccount (interfaces: AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package serviceaccount_test -destination service_mock_test.go github.com/juju/juju/apiserver/authentication/serviceaccount AccessService
+//
+
+// Package serviceaccount_test is a generated GoMock package.
+package serviceaccount_test
+
+import (
+	context "context"
+	reflect "reflect"
+
+	permission "github.com/juju/juju/core/permission"
+	user "github.com/juju/juju/core/user"
+	access "github.com/juju/juju/domain/access"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock *MockAccessService
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateServiceAccountToken mocks base method.
+func (m *MockAccessService) AuthenticateServiceAccountToken(arg0 context.Context, arg1 string) (access.ServiceAccountToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateServiceAccountToken", arg0, arg1)
+	ret0, _ := ret[0].(access.ServiceAccountToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateServiceAccountToken indicates an expected call of AuthenticateServiceAccountToken.
+func (mr *MockAccessServiceMockRecorder) AuthenticateServiceAccountToken(arg0, arg1 any) *MockAccessServiceAuthenticateServiceAccountTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateServiceAccountToken", reflect.TypeOf((*MockAccessService)(nil).AuthenticateServiceAccountToken), arg0, arg1)
+	return &MockAccessServiceAuthenticateServiceAccountTokenCall{Call: call}
+}
+
+// MockAccessServiceAuthenticateServiceAccountTokenCall wrap *gomock.Call
+type MockAccessServiceAuthenticateServiceAccountTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceAuthenticateServiceAccountTokenCall) Return(arg0 access.ServiceAccountToken, arg1 error) *MockAccessServiceAuthenticateServiceAccountTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceAuthenticateServiceAccountTokenCall) Do(f func(context.Context, string) (access.ServiceAccountToken, error)) *MockAccessServiceAuthenticateServiceAccountTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceAuthenticateServiceAccountTokenCall) DoAndReturn(f func(context.Context, string) (access.ServiceAccountToken, error)) *MockAccessServiceAuthenticateServiceAccountTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUserAccessLevelForTarget mocks base method.
+func (m *MockAccessService) ReadUserAccessLevelForTarget(arg0 context.Context, arg1 user.Name, arg2 permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUserAccessLevelForTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(permission.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUserAccessLevelForTarget indicates an expected call of ReadUserAccessLevelForTarget.
+func (mr *MockAccessServiceMockRecorder) ReadUserAccessLevelForTarget(arg0, arg1, arg2 any) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserAccessLevelForTarget", reflect.TypeOf((*MockAccessService)(nil).ReadUserAccessLevelForTarget), arg0, arg1, arg2)
+	return &MockAccessServiceReadUserAccessLevelForTargetCall{Call: call}
+}
+
+// MockAccessServiceReadUserAccessLevelForTargetCall wrap *gomock.Call
+type MockAccessServiceReadUserAccessLevelForTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceReadUserAccessLevelForTargetCall) Return(arg0 permission.Access, arg1 error) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceReadUserAccessLevelForTargetCall) Do(f func(context.Context, user.Name, permission.ID) (permission.Access, error)) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceReadUserAccessLevelForTargetCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) (permission.Access, error)) *MockAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+)
+
+// AccessService provides the service account tokens and the access granted
+// to service accounts.
+type AccessService interface {
+	// AuthenticateServiceAccountToken returns the service account token, if
+	// it is one issued by the controller and has not expired or been
+	// revoked.
+	AuthenticateServiceAccountToken(ctx context.Context, token string) (access.ServiceAccountToken, error)
+
+	// ReadUserAccessLevelForTarget returns the access the user has on the
+	// target.
+	ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error)
+}
+
+// Authenticator authenticates service accounts with the API tokens issued to
+// them by the controller.
+type Authenticator struct {
+	accessService AccessService
+}
+
+// NewAuthenticator returns a new Authenticator.
+func NewAuthenticator(accessService AccessService) *Authenticator {
+	return &Authenticator{
+		accessService: accessService,
+	}
+}
+
+// Authenticate implements authentication.HTTPAuthenticator. A NotFound error
+// is returned if the request does not carry a service account token as a
+// bearer token.
+func (a *Authenticator) Authenticate(req *http.Request) (authentication.AuthInfo, error) {
+	authHeader := req.Header.Get("Authorization")
+	if authHeader == "" {
+		return authentication.AuthInfo{}, errors.NotFoundf("authorization header missing")
+	}
+	authScheme, rawToken, spaceFound := strings.Cut(authHeader, " ")
+	if !spaceFound || authScheme != "Bearer" || !access.IsServiceAccountToken(rawToken) {
+		return authentication.AuthInfo{}, errors.NotFoundf("service account token")
+	}
+
+	authInfo, err := a.authenticate(req.Context(), rawToken)
+	return authInfo, errors.Trace(err)
+}
+
+// AuthenticateLoginRequest implements authentication.LoginAuthenticator. A
+// NotSupported error is returned if the request does not carry a service
+// account token.
+func (a *Authenticator) AuthenticateLoginRequest(
+	ctx context.Context,
+	_ string,
+	_ model.UUID,
+	authParams authentication.AuthParams,
+) (authentication.AuthInfo, error) {
+	if !access.IsServiceAccountToken(authParams.Token) {
+		return authentication.AuthInfo{}, fmt.Errorf("service account token %w", errors.NotSupported)
+	}
+	authInfo, err := a.authenticate(ctx, authParams.Token)
+	return authInfo, errors.Trace(err)
+}
+
+func (a *Authenticator) authenticate(ctx context.Context, rawToken string) (authentication.AuthInfo, error) {
+	token, err := a.accessService.AuthenticateServiceAccountToken(ctx, rawToken)
+	if err != nil {
+		return authentication.AuthInfo{}, errors.WithType(err, errors.Unauthorized)
+	}
+	return authentication.AuthInfo{
+		Entity: authentication.TagToEntity(names.NewUserTag(token.ServiceAccount.Name())),
+		Delegator: &PermissionDelegator{
+			AccessService: a.accessService,
+			Token:         token,
+		},
+	}, nil
+}
@@ -39,8 +39,9 @@ var logger = internallogger.GetLogger("juju.apiserver.authentication")
 // UserService is the interface that wraps the methods required to
 // authenticate a user.
 type UserService interface {
-	// GetUserByAuth returns the user with the given name and password.
-	GetUserByAuth(ctx context.Context, name coreuser.Name, password auth.Password) (coreuser.User, error)
+	// AuthenticateUser returns the user with the given name and password,
+	// recording the login attempt from the address.
+	AuthenticateUser(ctx context.Context, name coreuser.Name, password auth.Password, address string) (coreuser.User, error)
 	// GetUserByName returns the user with the given name.
 	GetUserByName(ctx context.Context, name coreuser.Name) (coreuser.User, error)
 }
@@ -133,10 +134,17 @@ func (u *LocalUserAuthenticator) Authenticate(
 	// We believe we've got a password, so we'll try to authenticate with it.
 	// This will check the user service for the user, ensuring that the user
 	// isn't disabled or deleted.
-	user, err := u.UserService.GetUserByAuth(ctx, coreuser.NameFromTag(userTag), auth.NewPassword(authParams.Credentials))
+	// Failed logins are recorded against the user, who is locked out after
+	// too many of them.
+	user, err := u.UserService.AuthenticateUser(
+		ctx, coreuser.NameFromTag(userTag), auth.NewPassword(authParams.Credentials), authParams.RemoteAddress,
+	)
 	if errors.Is(err, usererrors.UserNotFound) || errors.Is(err, usererrors.UserUnauthorized) {
 		logger.Debugf(context.TODO(), "user %s not found", userTag.String())
 		return nil, errors.Trace(apiservererrors.ErrUnauthorized)
+	} else if errors.Is(err, usererrors.UserLockedOut) {
+		logger.Infof(context.TODO(), "user %s login from %q rejected: %v", userTag.String(), authParams.RemoteAddress, err)
+		return nil, errors.NewUnauthorized(err, "")
 	} else if err != nil {
 		return nil, errors.Trace(err)
 	} else if user.Disabled {
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/common (interfaces: BlockCommandService,CloudService,ControllerConfigState,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ToolsStorageGetter,ModelAgentService,MachineRebootService,EnsureDeadMachineService,WatchableMachineService,UnitStateService,MachineService,StatusService,LeadershipPinningBackend,LeadershipMachine,AgentPasswordService,AgentBinaryService)
+// Source: github.com/juju/juju/apiserver/common (interfaces: BlockCommandService,CloudService,ControllerConfigState,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ToolsStorageGetter,ModelAgentService,MachineRebootService,EnsureDeadMachineService,WatchableMachineService,UnitStateService,MachineService,StatusService,LeadershipPinningBackend,LeadershipMachine,AgentPasswordService,AgentBinaryService,WarmPoolApplicationService,WarmPoolMachineService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/common_mock.go github.com/juju/juju/apiserver/common BlockCommandService,CloudService,ControllerConfigState,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ToolsStorageGetter,ModelAgentService,MachineRebootService,EnsureDeadMachineService,WatchableMachineService,UnitStateService,MachineService,StatusService,LeadershipPinningBackend,LeadershipMachine,AgentPasswordService,AgentBinaryService
+//	mockgen -typed -package mocks -destination mocks/common_mock.go github.com/juju/juju/apiserver/common BlockCommandService,CloudService,ControllerConfigState,ControllerConfigService,ExternalControllerService,ToolsFinder,ToolsURLGetter,APIHostPortsForAgentsGetter,ToolsStorageGetter,ModelAgentService,MachineRebootService,EnsureDeadMachineService,WatchableMachineService,UnitStateService,MachineService,StatusService,LeadershipPinningBackend,LeadershipMachine,AgentPasswordService,AgentBinaryService,WarmPoolApplicationService,WarmPoolMachineService
 //
 
 // Package mocks is a generated GoMock package.
@@ -17,6 +17,8 @@ import (
 	cloud "github.com/juju/juju/cloud"
 	controller "github.com/juju/juju/controller"
 	agentbinary "github.com/juju/juju/core/agentbinary"
+	base "github.com/juju/juju/core/base"
+	constraints "github.com/juju/juju/core/constraints"
 	crossmodel "github.com/juju/juju/core/crossmodel"
 	instance "github.com/juju/juju/core/instance"
 	machine "github.com/juju/juju/core/machine"
@@ -1773,3 +1775,126 @@ func (c *MockAgentBinaryServiceGetEnvironAgentBinariesFinderCall) DoAndReturn(f
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockWarmPoolApplicationService is a mock of WarmPoolApplicationService interface.
+type MockWarmPoolApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarmPoolApplicationServiceMockRecorder
+}
+
+// MockWarmPoolApplicationServiceMockRecorder is the mock recorder for MockWarmPoolApplicationService.
+type MockWarmPoolApplicationServiceMockRecorder struct {
+	mock *MockWarmPoolApplicationService
+}
+
+// NewMockWarmPoolApplicationService creates a new mock instance.
+func NewMockWarmPoolApplicationService(ctrl *gomock.Controller) *MockWarmPoolApplicationService {
+	mock := &MockWarmPoolApplicationService{ctrl: ctrl}
+	mock.recorder = &MockWarmPoolApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarmPoolApplicationService) EXPECT() *MockWarmPoolApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// ClaimWarmPoolMachine mocks base method.
+func (m *MockWarmPoolApplicationService) ClaimWarmPoolMachine(arg0 context.Context, arg1 constraints.Value, arg2 base.Base) (machine.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWarmPoolMachine", arg0, arg1, arg2)
+	ret0, _ := ret[0].(machine.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWarmPoolMachine indicates an expected call of ClaimWarmPoolMachine.
+func (mr *MockWarmPoolApplicationServiceMockRecorder) ClaimWarmPoolMachine(arg0, arg1, arg2 any) *MockWarmPoolApplicationServiceClaimWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWarmPoolMachine", reflect.TypeOf((*MockWarmPoolApplicationService)(nil).ClaimWarmPoolMachine), arg0, arg1, arg2)
+	return &MockWarmPoolApplicationServiceClaimWarmPoolMachineCall{Call: call}
+}
+
+// MockWarmPoolApplicationServiceClaimWarmPoolMachineCall wrap *gomock.Call
+type MockWarmPoolApplicationServiceClaimWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWarmPoolApplicationServiceClaimWarmPoolMachineCall) Return(arg0 machine.Name, arg1 error) *MockWarmPoolApplicationServiceClaimWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWarmPoolApplicationServiceClaimWarmPoolMachineCall) Do(f func(context.Context, constraints.Value, base.Base) (machine.Name, error)) *MockWarmPoolApplicationServiceClaimWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWarmPoolApplicationServiceClaimWarmPoolMachineCall) DoAndReturn(f func(context.Context, constraints.Value, base.Base) (machine.Name, error)) *MockWarmPoolApplicationServiceClaimWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockWarmPoolMachineService is a mock of WarmPoolMachineService interface.
+type MockWarmPoolMachineService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarmPoolMachineServiceMockRecorder
+}
+
+// MockWarmPoolMachineServiceMockRecorder is the mock recorder for MockWarmPoolMachineService.
+type MockWarmPoolMachineServiceMockRecorder struct {
+	mock *MockWarmPoolMachineService
+}
+
+// NewMockWarmPoolMachineService creates a new mock instance.
+func NewMockWarmPoolMachineService(ctrl *gomock.Controller) *MockWarmPoolMachineService {
+	mock := &MockWarmPoolMachineService{ctrl: ctrl}
+	mock.recorder = &MockWarmPoolMachineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarmPoolMachineService) EXPECT() *MockWarmPoolMachineServiceMockRecorder {
+	return m.recorder
+}
+
+// AddWarmPoolMachine mocks base method.
+func (m *MockWarmPoolMachineService) AddWarmPoolMachine(arg0 context.Context, arg1 machine.Name, arg2 constraints.Value, arg3 base.Base) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWarmPoolMachine", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWarmPoolMachine indicates an expected call of AddWarmPoolMachine.
+func (mr *MockWarmPoolMachineServiceMockRecorder) AddWarmPoolMachine(arg0, arg1, arg2, arg3 any) *MockWarmPoolMachineServiceAddWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWarmPoolMachine", reflect.TypeOf((*MockWarmPoolMachineService)(nil).AddWarmPoolMachine), arg0, arg1, arg2, arg3)
+	return &MockWarmPoolMachineServiceAddWarmPoolMachineCall{Call: call}
+}
+
+// MockWarmPoolMachineServiceAddWarmPoolMachineCall wrap *gomock.Call
+type MockWarmPoolMachineServiceAddWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWarmPoolMachineServiceAddWarmPoolMachineCall) Return(arg0 error) *MockWarmPoolMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWarmPoolMachineServiceAddWarmPoolMachineCall) Do(f func(context.Context, machine.Name, constraints.Value, base.Base) error) *MockWarmPoolMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWarmPoolMachineServiceAddWarmPoolMachineCall) DoAndReturn(f func(context.Context, machine.Name, constraints.Value, base.Base) error) *MockWarmPoolMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
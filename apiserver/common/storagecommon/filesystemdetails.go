@@ -49,6 +49,10 @@ func FilesystemDetails(
 					stateInfo,
 				)
 			}
+			if usage, ok := attachment.Usage(); ok {
+				paramsUsage := FilesystemUsageFromState(usage)
+				attDetails.Usage = &paramsUsage
+			}
 			if attachment.Host().Kind() == names.MachineTagKind {
 				details.MachineAttachments[attachment.Host().String()] = attDetails
 			} else {
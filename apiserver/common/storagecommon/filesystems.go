@@ -27,11 +27,12 @@ func FilesystemParams(
 	registry storage.ProviderRegistry,
 ) (params.FilesystemParams, error) {
 
-	var pool string
+	var pool, snapshotId string
 	var size uint64
 	if stateFilesystemParams, ok := f.Params(); ok {
 		pool = stateFilesystemParams.Pool
 		size = stateFilesystemParams.Size
+		snapshotId = stateFilesystemParams.SnapshotId
 	} else {
 		filesystemInfo, err := f.Info()
 		if err != nil {
@@ -58,6 +59,7 @@ func FilesystemParams(
 		cfg.Attrs(),
 		filesystemTags,
 		nil, // attachment params set by the caller
+		snapshotId,
 	}
 
 	volumeTag, err := f.Volume()
@@ -153,6 +155,17 @@ func FilesystemAttachmentInfoFromState(info state.FilesystemAttachmentInfo) para
 	}
 }
 
+// FilesystemUsageFromState converts a state.FilesystemUsage to
+// params.FilesystemUsage.
+func FilesystemUsageFromState(usage state.FilesystemUsage) params.FilesystemUsage {
+	return params.FilesystemUsage{
+		UsedBytes:       usage.UsedBytes,
+		AvailableBytes:  usage.AvailableBytes,
+		UsedInodes:      usage.UsedInodes,
+		AvailableInodes: usage.AvailableInodes,
+	}
+}
+
 // ParseFilesystemAttachmentIds parses the strings, returning machine storage IDs.
 func ParseFilesystemAttachmentIds(stringIds []string) ([]params.MachineStorageId, error) {
 	ids := make([]params.MachineStorageId, len(stringIds))
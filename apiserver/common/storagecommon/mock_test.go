@@ -202,3 +202,7 @@ func (v *fakeFilesystemAttachment) Info() (state.FilesystemAttachmentInfo, error
 	}
 	return *v.info, nil
 }
+
+func (v *fakeFilesystemAttachment) Usage() (state.FilesystemUsage, bool) {
+	return state.FilesystemUsage{}, false
+}
@@ -35,11 +35,12 @@ func VolumeParams(
 	registry storage.ProviderRegistry,
 ) (params.VolumeParams, error) {
 
-	var pool string
+	var pool, snapshotId string
 	var size uint64
 	if stateVolumeParams, ok := v.Params(); ok {
 		pool = stateVolumeParams.Pool
 		size = stateVolumeParams.Size
+		snapshotId = stateVolumeParams.SnapshotId
 	} else {
 		volumeInfo, err := v.Info()
 		if err != nil {
@@ -65,6 +66,7 @@ func VolumeParams(
 		cfg.Attrs(),
 		volumeTags,
 		nil, // attachment params set by the caller
+		snapshotId,
 	}, nil
 }
 
@@ -0,0 +1,74 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/machine"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/state"
+)
+
+// WarmPoolApplicationService claims machines from the model's warm pool
+// for units.
+type WarmPoolApplicationService interface {
+	// ClaimWarmPoolMachine takes a machine out of the model's warm pool
+	// for a unit with the input constraints and base.
+	ClaimWarmPoolMachine(context.Context, constraints.Value, corebase.Base) (machine.Name, error)
+}
+
+// WarmPoolMachineService puts machines in the model's warm pool.
+type WarmPoolMachineService interface {
+	// AddWarmPoolMachine puts the specified machine, started with the
+	// input constraints and base, in the model's warm pool.
+	AddWarmPoolMachine(context.Context, machine.Name, constraints.Value, corebase.Base) error
+}
+
+// NewWarmPool returns a state.WarmPool that gives the machines of the
+// model's warm pool to units, using the input services with the context
+// of the assignment.
+func NewWarmPool(
+	ctx context.Context, applicationService WarmPoolApplicationService, machineService WarmPoolMachineService,
+) state.WarmPool {
+	return &warmPool{
+		ctx:                ctx,
+		applicationService: applicationService,
+		machineService:     machineService,
+	}
+}
+
+type warmPool struct {
+	ctx                context.Context
+	applicationService WarmPoolApplicationService
+	machineService     WarmPoolMachineService
+}
+
+// ClaimMachine is part of the state.WarmPool interface.
+func (p *warmPool) ClaimMachine(cons constraints.Value, base state.Base) (string, bool, error) {
+	b, err := corebase.ParseBase(base.OS, base.Channel)
+	if err != nil {
+		return "", false, errors.Trace(err)
+	}
+	name, err := p.applicationService.ClaimWarmPoolMachine(p.ctx, cons, b)
+	if errors.Is(err, applicationerrors.WarmPoolMachineNotFound) {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, errors.Trace(err)
+	}
+	return name.String(), true, nil
+}
+
+// ReturnMachine is part of the state.WarmPool interface.
+func (p *warmPool) ReturnMachine(id string, cons constraints.Value, base state.Base) error {
+	b, err := corebase.ParseBase(base.OS, base.Channel)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(p.machineService.AddWarmPoolMachine(p.ctx, machine.Name(id), cons, b))
+}
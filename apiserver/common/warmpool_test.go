@@ -0,0 +1,84 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/common/mocks"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/machine"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/state"
+)
+
+type warmPoolSuite struct {
+	applicationService *mocks.MockWarmPoolApplicationService
+	machineService     *mocks.MockWarmPoolMachineService
+}
+
+var _ = gc.Suite(&warmPoolSuite{})
+
+func (s *warmPoolSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.applicationService = mocks.NewMockWarmPoolApplicationService(ctrl)
+	s.machineService = mocks.NewMockWarmPoolMachineService(ctrl)
+	return ctrl
+}
+
+func (s *warmPoolSuite) TestClaimMachine(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cons := constraints.MustParse("mem=4G")
+	s.applicationService.EXPECT().ClaimWarmPoolMachine(gomock.Any(), cons, corebase.MustParseBaseFromString("ubuntu@24.04/stable")).
+		Return(machine.Name("3"), nil)
+
+	pool := common.NewWarmPool(context.Background(), s.applicationService, s.machineService)
+	id, ok, err := pool.ClaimMachine(cons, state.Base{OS: "ubuntu", Channel: "24.04/stable"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ok, jc.IsTrue)
+	c.Check(id, gc.Equals, "3")
+}
+
+func (s *warmPoolSuite) TestClaimMachineNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.applicationService.EXPECT().ClaimWarmPoolMachine(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", applicationerrors.WarmPoolMachineNotFound)
+
+	pool := common.NewWarmPool(context.Background(), s.applicationService, s.machineService)
+	_, ok, err := pool.ClaimMachine(constraints.Value{}, state.Base{OS: "ubuntu", Channel: "24.04"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *warmPoolSuite) TestClaimMachineError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.applicationService.EXPECT().ClaimWarmPoolMachine(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", errors.New("boom"))
+
+	pool := common.NewWarmPool(context.Background(), s.applicationService, s.machineService)
+	_, _, err := pool.ClaimMachine(constraints.Value{}, state.Base{OS: "ubuntu", Channel: "24.04"})
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *warmPoolSuite) TestReturnMachine(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cons := constraints.MustParse("mem=4G")
+	s.machineService.EXPECT().AddWarmPoolMachine(gomock.Any(), machine.Name("3"), cons, corebase.MustParseBaseFromString("ubuntu@24.04/stable")).
+		Return(nil)
+
+	pool := common.NewWarmPool(context.Background(), s.applicationService, s.machineService)
+	err := pool.ReturnMachine("3", cons, state.Base{OS: "ubuntu", Channel: "24.04/stable"})
+	c.Assert(err, jc.ErrorIsNil)
+}
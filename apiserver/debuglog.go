@@ -19,6 +19,7 @@ import (
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/loggo/v2"
+	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/websocket"
@@ -119,6 +120,14 @@ func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		}
 		defer st.Release()
 
+		// Debug-log streams of users are rate limited as expensive calls.
+		if userTag, ok := authInfo.Entity.Tag().(names.UserTag); ok {
+			if err := h.ctxt.srv.apiRateLimiter.Take(userTag.Id(), st.ModelUUID(), true); err != nil {
+				socket.sendError(err)
+				return
+			}
+		}
+
 		params, err := readDebugLogParams(req.URL.Query())
 		if err != nil {
 			socket.sendError(err)
@@ -7,6 +7,7 @@ package apiserver
 // are permissible to run embedded on a controller.
 var allowedEmbeddedCommands = []string{
 	"actions",
+	"admission-policies",
 	"add-machine",
 	"add-space",
 	"add-storage",
@@ -17,14 +18,17 @@ var allowedEmbeddedCommands = []string{
 	"attach",
 	"attach-resource",
 	"attach-storage",
+	"autoscale-application",
 	"bind",
 	"cancel-task",
 	"charm-resources",
+	"cidr-sets",
 	"clouds",
 	"config",
 	"consume",
 	"controller-config",
 	"create-storage-pool",
+	"create-storage-snapshot",
 	"credentials",
 	"deploy",
 	"detach-storage",
@@ -33,6 +37,7 @@ var allowedEmbeddedCommands = []string{
 	"expose",
 	"find-offers",
 	"firewall-rules",
+	"groups",
 	"constraints",
 	"model-constraints",
 	"help",
@@ -49,7 +54,9 @@ var allowedEmbeddedCommands = []string{
 	"relate",
 	"reload-spaces",
 	"remove-application",
+	"remove-cidr-set",
 	"remove-credential",
+	"remove-firewall-rule",
 	"remove-machine",
 	"remove-offer",
 	"remove-relation",
@@ -57,17 +64,21 @@ var allowedEmbeddedCommands = []string{
 	"remove-space",
 	"remove-storage",
 	"remove-storage-pool",
+	"remove-storage-snapshot",
 	"remove-unit",
 	"remove-user",
 	"rename-space",
+	"resize-storage",
 	"resolved",
 	"resolve",
 	"resources",
 	"resume-relation",
 	"retry-provisioning",
+	"roles",
 	"run",
 	"scale-application",
 	"set-application-base",
+	"set-cidr-set",
 	"set-constraints",
 	"set-firewall-rule",
 	"set-meter-status",
@@ -88,9 +99,13 @@ var allowedEmbeddedCommands = []string{
 	"show-user",
 	"sla",
 	"spaces",
+	"ssh-access-grants",
+	"ssh-certificates",
+	"ssh-sessions",
 	"status",
 	"storage",
 	"storage-pools",
+	"storage-snapshots",
 	"subnets",
 	"suspend-relation",
 	"trust",
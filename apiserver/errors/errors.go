@@ -150,6 +150,8 @@ func ServerErrorAndStatus(err error) (*params.Error, int) {
 		status = http.StatusConflict
 	case params.CodeNotLeader:
 		status = http.StatusTemporaryRedirect
+	case params.CodeRateLimitExceeded:
+		status = http.StatusTooManyRequests
 	}
 	return err1, status
 }
@@ -175,6 +177,7 @@ func ServerError(err error) *params.Error {
 		notLeaderError         *NotLeaderError
 		redirectError          *RedirectError
 		accessRequiredError    *AccessRequiredError
+		rateLimitExceededError *RateLimitExceededError
 	)
 	// Skip past annotations when looking for the code.
 	err = errors.Cause(err)
@@ -287,6 +290,11 @@ func ServerError(err error) *params.Error {
 	case errors.As(err, &accessRequiredError):
 		code = params.CodeAccessRequired
 		info = accessRequiredError.AsMap()
+	case errors.As(err, &rateLimitExceededError):
+		code = params.CodeRateLimitExceeded
+		info = params.RateLimitExceededErrorInfo{
+			RetryAfter: rateLimitExceededError.RetryAfter,
+		}.AsMap()
 	default:
 		code = params.ErrCode(err)
 	}
@@ -391,6 +399,16 @@ func RestoreError(err error) error {
 		return fmt.Errorf(msg+"%w", errors.Hide(DeadlineExceededError))
 	case params.IsCodeTryAgain(err):
 		return ErrTryAgain
+	case params.IsCodeRateLimitExceeded(err):
+		e, ok := err.(*params.Error)
+		if !ok {
+			return err
+		}
+		var info params.RateLimitExceededErrorInfo
+		if err := e.UnmarshalInfo(&info); err != nil {
+			return err
+		}
+		return NewRateLimitExceededError(info.RetryAfter)
 	default:
 		// Handle all other codes here.
 		return params.TranslateWellKnownError(err)
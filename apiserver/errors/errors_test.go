@@ -8,6 +8,7 @@ import (
 	stderrors "errors"
 	"net/http"
 	"reflect"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -262,6 +263,15 @@ var errorTransformTests = []struct {
 	targetTester: func(e error) bool {
 		return errors.HasType[*apiservererrors.NotLeaderError](e)
 	},
+}, {
+	err:        apiservererrors.NewRateLimitExceededError(1500 * time.Millisecond),
+	code:       params.CodeRateLimitExceeded,
+	status:     http.StatusTooManyRequests,
+	helperFunc: params.IsCodeRateLimitExceeded,
+	targetTester: func(e error) bool {
+		rateLimitErr, ok := errors.AsType[*apiservererrors.RateLimitExceededError](e)
+		return ok && rateLimitErr.RetryAfter == 1500*time.Millisecond
+	},
 }, {
 	err:    apiservererrors.DeadlineExceededError,
 	code:   params.CodeDeadlineExceeded,
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/collections/transform"
@@ -164,6 +165,26 @@ func NewNotLeaderError(serverAddress, serverID string) error {
 	}
 }
 
+// RateLimitExceededError is the error returned when an API request is
+// rejected because the caller has exceeded a rate limit. The request may
+// be retried once RetryAfter has passed.
+type RateLimitExceededError struct {
+	RetryAfter time.Duration
+}
+
+// NewRateLimitExceededError returns a new RateLimitExceededError for a
+// request which may be retried after the given duration.
+func NewRateLimitExceededError(retryAfter time.Duration) error {
+	return &RateLimitExceededError{
+		RetryAfter: retryAfter,
+	}
+}
+
+// Error implements the error interface.
+func (e *RateLimitExceededError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %v", e.RetryAfter)
+}
+
 // AccessRequiredError is the error returned when an api
 // request needs a login token with specified permissions.
 type AccessRequiredError struct {
@@ -17,6 +17,7 @@ import (
 	authjwt "github.com/juju/juju/apiserver/authentication/jwt"
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal/ratelimiter"
 	"github.com/juju/juju/apiserver/stateauthenticator"
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/model"
@@ -263,6 +264,13 @@ func TestingRestrictedRoot(check func(string, string) error) rpc.Root {
 	return restrictRoot(r, check)
 }
 
+// TestingRateLimitedRoot returns a srvRoot rate limited for the user on
+// the model.
+func TestingRateLimitedRoot(limiter *ratelimiter.Limiter, user names.UserTag, modelUUID string) rpc.Root {
+	r := TestingAPIRoot(AllFacades())
+	return rateLimitRoot(r, limiter, user, modelUUID)
+}
+
 // PatchGetMigrationBackend overrides the getMigrationBackend function
 // to support testing.
 func PatchGetMigrationBackend(p Patcher, ctrlSt controllerBackend, st migrationBackend) {
@@ -318,3 +326,36 @@ func (c *sharedServerContext) featureEnabled(flag string) bool {
 	defer c.configMutex.RUnlock()
 	return c.features.Contains(flag)
 }
+
+// APICallMethod returns the API method of the call being served by the
+// context, as "Facade.Method".
+func APICallMethod(ctx context.Context) string {
+	call, ok := ctx.Value(apiCallKey{}).(*apiCall)
+	if !ok {
+		return ""
+	}
+	return call.method
+}
+
+// ElevateAPICall records that a role allowed the call being served by the
+// context.
+func ElevateAPICall(ctx context.Context) {
+	if call, ok := ctx.Value(apiCallKey{}).(*apiCall); ok {
+		call.elevated.Store(true)
+	}
+}
+
+// APIHandlerWithAuthInfo returns an API handler for the authenticated
+// entity, using the domain services of its model.
+func APIHandlerWithAuthInfo(authInfo authentication.AuthInfo, domainServices services.DomainServices) *apiHandler {
+	return &apiHandler{
+		authInfo:       authInfo,
+		domainServices: domainServices,
+	}
+}
+
+// WithAPICall returns a context serving a call of the API method, given as
+// "Facade.Method".
+func WithAPICall(ctx context.Context, method string) context.Context {
+	return context.WithValue(ctx, apiCallKey{}, &apiCall{method: method})
+}
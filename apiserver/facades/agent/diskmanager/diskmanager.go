@@ -5,23 +5,69 @@ package diskmanager
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"strings"
 
+	"github.com/juju/clock"
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/unit"
+	statuserrors "github.com/juju/juju/domain/status/errors"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
 )
 
+// usageWarningPrefix prefixes the status message of a filesystem, and of
+// the units using it, whose usage has crossed one of the model's warning
+// thresholds.
+const usageWarningPrefix = "nearly full: "
+
 type blockDeviceUpdater interface {
 	UpdateBlockDevices(ctx context.Context, machineId string, devices ...blockdevice.BlockDevice) error
 }
 
+type storageBackend interface {
+	MachineFilesystemAttachments(names.MachineTag) ([]state.FilesystemAttachment, error)
+	SetFilesystemAttachmentUsage(names.Tag, names.FilesystemTag, state.FilesystemUsage) error
+	Filesystem(names.FilesystemTag) (state.Filesystem, error)
+	StorageAttachments(names.StorageTag) ([]state.StorageAttachment, error)
+}
+
+type statusService interface {
+	GetUnitWorkloadStatus(context.Context, unit.Name) (status.StatusInfo, error)
+	SetUnitWorkloadStatus(context.Context, unit.Name, status.StatusInfo) error
+}
+
+type modelConfigService interface {
+	ModelConfig(context.Context) (*config.Config, error)
+}
+
+// DiskManagerAPIV2 implements version 2 of the DiskManager API.
+type DiskManagerAPIV2 struct {
+	*DiskManagerAPI
+}
+
+// FilesystemMountPoints isn't on the v2 API.
+func (*DiskManagerAPIV2) FilesystemMountPoints(_, _ struct{}) {}
+
+// SetMachineFilesystemUsage isn't on the v2 API.
+func (*DiskManagerAPIV2) SetMachineFilesystemUsage(_, _ struct{}) {}
+
 // DiskManagerAPI provides access to the DiskManager API facade.
 type DiskManagerAPI struct {
 	blockDeviceUpdater blockDeviceUpdater
+	storage            storageBackend
+	modelConfigService modelConfigService
+	statusService      statusService
+	clock              clock.Clock
 	authorizer         facade.Authorizer
 	getAuthFunc        common.GetAuthFunc
 }
@@ -63,6 +109,257 @@ func (d *DiskManagerAPI) SetMachineBlockDevices(ctx context.Context, args params
 	return result, nil
 }
 
+// FilesystemMountPoints returns the mount points of the filesystems
+// attached to each of the specified machines.
+func (d *DiskManagerAPI) FilesystemMountPoints(ctx context.Context, args params.Entities) (params.StringsResults, error) {
+	result := params.StringsResults{
+		Results: make([]params.StringsResult, len(args.Entities)),
+	}
+	canAccess, err := d.getAuthFunc()
+	if err != nil {
+		return result, err
+	}
+	for i, arg := range args.Entities {
+		tag, err := names.ParseMachineTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		attachments, err := d.mountedFilesystemAttachments(tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		mountPoints := make([]string, 0, len(attachments))
+		for mountPoint := range attachments {
+			mountPoints = append(mountPoints, mountPoint)
+		}
+		result.Results[i].Result = mountPoints
+	}
+	return result, nil
+}
+
+// SetMachineFilesystemUsage records the usage of the filesystems attached
+// to each of the specified machines, and flags those filesystems whose
+// usage has crossed one of the model's warning thresholds.
+func (d *DiskManagerAPI) SetMachineFilesystemUsage(ctx context.Context, args params.SetMachineFilesystemUsage) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.MachineFilesystemUsage)),
+	}
+	canAccess, err := d.getAuthFunc()
+	if err != nil {
+		return result, err
+	}
+	modelConfig, err := d.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.MachineFilesystemUsage {
+		tag, err := names.ParseMachineTag(arg.Machine)
+		if err != nil || !canAccess(tag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		err = d.setMachineFilesystemUsage(ctx, tag, arg.Filesystems, modelConfig)
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (d *DiskManagerAPI) setMachineFilesystemUsage(
+	ctx context.Context, tag names.MachineTag, usage []params.MountedFilesystemUsage, modelConfig *config.Config,
+) error {
+	attachments, err := d.mountedFilesystemAttachments(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	now := d.clock.Now()
+	for _, u := range usage {
+		attachment, ok := attachments[u.MountPoint]
+		if !ok {
+			// The filesystem may have been detached since the
+			// machine listed its mount points.
+			continue
+		}
+		stateUsage := state.FilesystemUsage{
+			UsedBytes:       u.Usage.UsedBytes,
+			AvailableBytes:  u.Usage.AvailableBytes,
+			UsedInodes:      u.Usage.UsedInodes,
+			AvailableInodes: u.Usage.AvailableInodes,
+			Updated:         now,
+		}
+		// Only write the usage when it has changed, as most
+		// filesystems' usage is steady between reports.
+		if !usageUnchanged(attachment, stateUsage) {
+			if err := d.storage.SetFilesystemAttachmentUsage(tag, attachment.Filesystem(), stateUsage); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		warning := usageWarning(
+			stateUsage,
+			modelConfig.StorageUsageWarningThreshold(),
+			modelConfig.StorageInodeWarningThreshold(),
+		)
+		if err := d.updateFilesystemStatus(ctx, attachment.Filesystem(), warning); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// mountedFilesystemAttachments returns the provisioned filesystem
+// attachments of the machine, keyed by mount point.
+func (d *DiskManagerAPI) mountedFilesystemAttachments(tag names.MachineTag) (map[string]state.FilesystemAttachment, error) {
+	attachments, err := d.storage.MachineFilesystemAttachments(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make(map[string]state.FilesystemAttachment)
+	for _, attachment := range attachments {
+		if attachment.Life() != state.Alive {
+			continue
+		}
+		info, err := attachment.Info()
+		if errors.Is(err, errors.NotProvisioned) {
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if info.MountPoint != "" {
+			result[info.MountPoint] = attachment
+		}
+	}
+	return result, nil
+}
+
+// usageUnchanged reports whether the usage recorded on the filesystem
+// attachment is the same as the given usage, ignoring when it was reported.
+func usageUnchanged(attachment state.FilesystemAttachment, usage state.FilesystemUsage) bool {
+	current, ok := attachment.Usage()
+	if !ok {
+		return false
+	}
+	current.Updated = usage.Updated
+	return current == usage
+}
+
+// updateFilesystemStatus raises or clears the usage warning status of an
+// attached filesystem, and of the units using its storage. A filesystem
+// with a warning has the Warning status, and is returned to Attached
+// when its usage drops. Statuses other than our own are left alone.
+func (d *DiskManagerAPI) updateFilesystemStatus(ctx context.Context, tag names.FilesystemTag, warning string) error {
+	f, err := d.storage.Filesystem(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	current, err := f.Status()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var newStatus status.Status
+	switch {
+	case current.Status == status.Attached && current.Message == "" && warning != "":
+		newStatus = status.Warning
+	case current.Status == status.Warning && strings.HasPrefix(current.Message, usageWarningPrefix):
+		if current.Message == warning {
+			return nil
+		}
+		newStatus = status.Warning
+		if warning == "" {
+			newStatus = status.Attached
+		}
+	default:
+		return nil
+	}
+	now := d.clock.Now()
+	if err := f.SetStatus(status.StatusInfo{
+		Status:  newStatus,
+		Message: warning,
+		Since:   &now,
+	}); err != nil {
+		return errors.Trace(err)
+	}
+
+	storageTag, err := f.Storage()
+	if errors.Is(err, errors.NotAssigned) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	storageAttachments, err := d.storage.StorageAttachments(storageTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, sa := range storageAttachments {
+		unitName, err := unit.NewName(sa.Unit().Id())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := d.updateUnitStatus(ctx, unitName, storageTag, warning); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// updateUnitStatus blocks an active unit whose storage is nearly full,
+// and makes it active again once the usage drops. The workload status
+// set by the unit's charm is left alone otherwise, and replaces ours
+// when the charm next sets it.
+func (d *DiskManagerAPI) updateUnitStatus(ctx context.Context, unitName unit.Name, storageTag names.StorageTag, warning string) error {
+	current, err := d.statusService.GetUnitWorkloadStatus(ctx, unitName)
+	if errors.Is(err, statuserrors.UnitNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	ourPrefix := fmt.Sprintf("%sstorage %s ", usageWarningPrefix, storageTag.Id())
+	ours := current.Status == status.Blocked && strings.HasPrefix(current.Message, ourPrefix)
+	var newStatus status.StatusInfo
+	switch {
+	case warning != "" && (ours || current.Status == status.Active && current.Message == ""):
+		newStatus = status.StatusInfo{
+			Status:  status.Blocked,
+			Message: ourPrefix + "has " + strings.TrimPrefix(warning, usageWarningPrefix),
+		}
+		if newStatus.Message == current.Message {
+			return nil
+		}
+	case warning == "" && ours:
+		newStatus = status.StatusInfo{Status: status.Active}
+	default:
+		return nil
+	}
+	now := d.clock.Now()
+	newStatus.Since = &now
+	return d.statusService.SetUnitWorkloadStatus(ctx, unitName, newStatus)
+}
+
+// usageWarning returns a status message describing the usage of a
+// filesystem if it has crossed either of the given percentage thresholds,
+// and the empty string otherwise. A zero threshold is disabled.
+func usageWarning(usage state.FilesystemUsage, spaceThreshold, inodeThreshold int) string {
+	var parts []string
+	if pct, ok := usedPercent(usage.UsedBytes, usage.AvailableBytes); ok && spaceThreshold > 0 && pct >= spaceThreshold {
+		parts = append(parts, fmt.Sprintf("%d%% of space used", pct))
+	}
+	if pct, ok := usedPercent(usage.UsedInodes, usage.AvailableInodes); ok && inodeThreshold > 0 && pct >= inodeThreshold {
+		parts = append(parts, fmt.Sprintf("%d%% of inodes used", pct))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return usageWarningPrefix + strings.Join(parts, ", ")
+}
+
+func usedPercent(used, available uint64) (int, bool) {
+	total := used + available
+	if total == 0 {
+		return 0, false
+	}
+	return int(math.Floor(float64(used) * 100 / float64(total))), true
+}
+
 func blockDevicesFromParams(in []params.BlockDevice) []blockdevice.BlockDevice {
 	out := make([]blockdevice.BlockDevice, len(in))
 	for i, d := range in {
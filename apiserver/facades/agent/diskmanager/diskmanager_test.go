@@ -6,7 +6,10 @@ package diskmanager_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/juju/clock/testclock"
+	jujuerrors "github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -16,8 +19,12 @@ import (
 	"github.com/juju/juju/apiserver/facades/agent/diskmanager"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/environs/config"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
 )
 
 var _ = gc.Suite(&DiskManagerSuite{})
@@ -27,6 +34,10 @@ type DiskManagerSuite struct {
 	resources          *common.Resources
 	authorizer         *apiservertesting.FakeAuthorizer
 	blockDeviceUpdater *mockBlockDeviceUpdater
+	storage            *mockStorageBackend
+	modelConfig        *config.Config
+	unitStatus         map[unit.Name]status.StatusInfo
+	clock              *testclock.Clock
 	api                *diskmanager.DiskManagerAPI
 }
 
@@ -36,7 +47,64 @@ func (s *DiskManagerSuite) SetUpTest(c *gc.C) {
 	tag := names.NewMachineTag("0")
 	s.authorizer = &apiservertesting.FakeAuthorizer{Tag: tag}
 	s.blockDeviceUpdater = &mockBlockDeviceUpdater{}
-	s.api = diskmanager.NewDiskManagerAPIForTest(s.authorizer, s.blockDeviceUpdater)
+	s.storage = &mockStorageBackend{
+		attachments: map[string][]state.FilesystemAttachment{
+			"0": {
+				&mockFilesystemAttachment{
+					filesystem: names.NewFilesystemTag("0"),
+					info:       &state.FilesystemAttachmentInfo{MountPoint: "/srv/data"},
+				},
+				&mockFilesystemAttachment{
+					filesystem: names.NewFilesystemTag("1"),
+				},
+			},
+		},
+		filesystems: map[string]*mockFilesystem{
+			"0": {
+				status:  status.StatusInfo{Status: status.Attached},
+				storage: names.NewStorageTag("data/0"),
+			},
+		},
+		storageAttachments: map[string][]state.StorageAttachment{
+			"data/0": {&mockStorageAttachment{unit: names.NewUnitTag("mysql/0")}},
+		},
+		usage: make(map[string]state.FilesystemUsage),
+	}
+	s.unitStatus = map[unit.Name]status.StatusInfo{
+		"mysql/0": {Status: status.Active},
+	}
+	s.modelConfig = coretesting.ModelConfig(c)
+	s.clock = testclock.NewClock(time.Now())
+	s.api = diskmanager.NewDiskManagerAPIForTest(
+		s.authorizer, s.blockDeviceUpdater, s.storage, s, s, s.clock,
+	)
+}
+
+func (s *DiskManagerSuite) ModelConfig(context.Context) (*config.Config, error) {
+	return s.modelConfig, nil
+}
+
+func (s *DiskManagerSuite) GetUnitWorkloadStatus(_ context.Context, name unit.Name) (status.StatusInfo, error) {
+	return s.unitStatus[name], nil
+}
+
+func (s *DiskManagerSuite) SetUnitWorkloadStatus(_ context.Context, name unit.Name, info status.StatusInfo) error {
+	s.unitStatus[name] = info
+	return nil
+}
+
+func (s *DiskManagerSuite) setFilesystemUsage(c *gc.C, usage params.FilesystemUsage) {
+	results, err := s.api.SetMachineFilesystemUsage(context.Background(), params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine: "machine-0",
+			Filesystems: []params.MountedFilesystemUsage{{
+				MountPoint: "/srv/data",
+				Usage:      usage,
+			}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.OneError(), jc.ErrorIsNil)
 }
 
 func (s *DiskManagerSuite) TestSetMachineBlockDevices(c *gc.C) {
@@ -106,6 +174,252 @@ func (s *DiskManagerSuite) TestSetMachineBlockDevicesStateError(c *gc.C) {
 	})
 }
 
+func (s *DiskManagerSuite) TestFilesystemMountPoints(c *gc.C) {
+	results, err := s.api.FilesystemMountPoints(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.StringsResults{
+		Results: []params.StringsResult{{
+			Result: []string{"/srv/data"},
+		}, {
+			Error: &params.Error{Message: "permission denied", Code: "unauthorized access"},
+		}},
+	})
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsage(c *gc.C) {
+	results, err := s.api.SetMachineFilesystemUsage(context.Background(), params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine: "machine-0",
+			Filesystems: []params.MountedFilesystemUsage{{
+				MountPoint: "/srv/data",
+				Usage: params.FilesystemUsage{
+					UsedBytes:       40,
+					AvailableBytes:  60,
+					UsedInodes:      1,
+					AvailableInodes: 99,
+				},
+			}, {
+				MountPoint: "/srv/gone",
+				Usage:      params.FilesystemUsage{UsedBytes: 1},
+			}},
+		}, {
+			Machine: "machine-1",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{
+			Error: nil,
+		}, {
+			Error: &params.Error{Message: "permission denied", Code: "unauthorized access"},
+		}},
+	})
+	c.Assert(s.storage.usage, jc.DeepEquals, map[string]state.FilesystemUsage{
+		"0:0": {
+			UsedBytes:       40,
+			AvailableBytes:  60,
+			UsedInodes:      1,
+			AvailableInodes: 99,
+			Updated:         s.clock.Now(),
+		},
+	})
+	c.Assert(s.storage.filesystems["0"].status.Message, gc.Equals, "")
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsageWarning(c *gc.C) {
+	s.setFilesystemUsage(c, params.FilesystemUsage{
+		UsedBytes: 95, AvailableBytes: 5, UsedInodes: 97, AvailableInodes: 3,
+	})
+	fsStatus := s.storage.filesystems["0"].status
+	c.Assert(fsStatus.Status, gc.Equals, status.Warning)
+	c.Assert(fsStatus.Message, gc.Equals, "nearly full: 95% of space used, 97% of inodes used")
+	unitStatus := s.unitStatus["mysql/0"]
+	c.Assert(unitStatus.Status, gc.Equals, status.Blocked)
+	c.Assert(unitStatus.Message, gc.Equals, "nearly full: storage data/0 has 95% of space used, 97% of inodes used")
+
+	// The warning is updated as usage grows.
+	s.setFilesystemUsage(c, params.FilesystemUsage{
+		UsedBytes: 99, AvailableBytes: 1, UsedInodes: 1, AvailableInodes: 99,
+	})
+	fsStatus = s.storage.filesystems["0"].status
+	c.Assert(fsStatus.Status, gc.Equals, status.Warning)
+	c.Assert(fsStatus.Message, gc.Equals, "nearly full: 99% of space used")
+	c.Assert(s.unitStatus["mysql/0"].Message, gc.Equals, "nearly full: storage data/0 has 99% of space used")
+
+	// The warning is cleared once usage drops below the thresholds.
+	s.setFilesystemUsage(c, params.FilesystemUsage{
+		UsedBytes: 50, AvailableBytes: 50, UsedInodes: 1, AvailableInodes: 99,
+	})
+	fsStatus = s.storage.filesystems["0"].status
+	c.Assert(fsStatus.Status, gc.Equals, status.Attached)
+	c.Assert(fsStatus.Message, gc.Equals, "")
+	unitStatus = s.unitStatus["mysql/0"]
+	c.Assert(unitStatus.Status, gc.Equals, status.Active)
+	c.Assert(unitStatus.Message, gc.Equals, "")
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsageKeepsCharmUnitStatus(c *gc.C) {
+	s.unitStatus["mysql/0"] = status.StatusInfo{Status: status.Waiting, Message: "waiting for db"}
+
+	s.setFilesystemUsage(c, params.FilesystemUsage{UsedBytes: 99, AvailableBytes: 1})
+	c.Assert(s.storage.filesystems["0"].status.Status, gc.Equals, status.Warning)
+	c.Assert(s.unitStatus["mysql/0"], jc.DeepEquals, status.StatusInfo{
+		Status: status.Waiting, Message: "waiting for db",
+	})
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsageUnchanged(c *gc.C) {
+	usage := state.FilesystemUsage{
+		UsedBytes: 40, AvailableBytes: 60, UsedInodes: 1, AvailableInodes: 99,
+		Updated: s.clock.Now().Add(-time.Hour),
+	}
+	attachment := s.storage.attachments["0"][0].(*mockFilesystemAttachment)
+	attachment.usage = &usage
+
+	s.setFilesystemUsage(c, params.FilesystemUsage{
+		UsedBytes: 40, AvailableBytes: 60, UsedInodes: 1, AvailableInodes: 99,
+	})
+	c.Assert(s.storage.usage, gc.HasLen, 0)
+
+	s.setFilesystemUsage(c, params.FilesystemUsage{
+		UsedBytes: 41, AvailableBytes: 59, UsedInodes: 1, AvailableInodes: 99,
+	})
+	c.Assert(s.storage.usage, gc.HasLen, 1)
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsageThresholds(c *gc.C) {
+	var err error
+	s.modelConfig, err = s.modelConfig.Apply(map[string]any{
+		config.StorageUsageWarningThresholdKey: 0,
+		config.StorageInodeWarningThresholdKey: 50,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.SetMachineFilesystemUsage(context.Background(), params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine: "machine-0",
+			Filesystems: []params.MountedFilesystemUsage{{
+				MountPoint: "/srv/data",
+				Usage: params.FilesystemUsage{
+					UsedBytes: 99, AvailableBytes: 1, UsedInodes: 60, AvailableInodes: 40,
+				},
+			}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.OneError(), jc.ErrorIsNil)
+	c.Assert(s.storage.filesystems["0"].status.Message, gc.Equals, "nearly full: 60% of inodes used")
+	c.Assert(s.unitStatus["mysql/0"].Message, gc.Equals, "nearly full: storage data/0 has 60% of inodes used")
+}
+
+func (s *DiskManagerSuite) TestSetMachineFilesystemUsageKeepsOtherMessages(c *gc.C) {
+	s.storage.filesystems["0"].status.Message = "resized"
+
+	results, err := s.api.SetMachineFilesystemUsage(context.Background(), params.SetMachineFilesystemUsage{
+		MachineFilesystemUsage: []params.MachineFilesystemUsage{{
+			Machine: "machine-0",
+			Filesystems: []params.MountedFilesystemUsage{{
+				MountPoint: "/srv/data",
+				Usage:      params.FilesystemUsage{UsedBytes: 99, AvailableBytes: 1},
+			}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.OneError(), jc.ErrorIsNil)
+	c.Assert(s.storage.filesystems["0"].status.Message, gc.Equals, "resized")
+	c.Assert(s.unitStatus["mysql/0"].Status, gc.Equals, status.Active)
+}
+
+type mockStorageBackend struct {
+	attachments        map[string][]state.FilesystemAttachment
+	filesystems        map[string]*mockFilesystem
+	storageAttachments map[string][]state.StorageAttachment
+	usage              map[string]state.FilesystemUsage
+}
+
+func (sb *mockStorageBackend) MachineFilesystemAttachments(tag names.MachineTag) ([]state.FilesystemAttachment, error) {
+	return sb.attachments[tag.Id()], nil
+}
+
+func (sb *mockStorageBackend) SetFilesystemAttachmentUsage(host names.Tag, fs names.FilesystemTag, usage state.FilesystemUsage) error {
+	sb.usage[host.Id()+":"+fs.Id()] = usage
+	return nil
+}
+
+func (sb *mockStorageBackend) Filesystem(tag names.FilesystemTag) (state.Filesystem, error) {
+	f, ok := sb.filesystems[tag.Id()]
+	if !ok {
+		return nil, errors.New("filesystem not found")
+	}
+	return f, nil
+}
+
+func (sb *mockStorageBackend) StorageAttachments(tag names.StorageTag) ([]state.StorageAttachment, error) {
+	return sb.storageAttachments[tag.Id()], nil
+}
+
+type mockFilesystem struct {
+	state.Filesystem
+	status  status.StatusInfo
+	storage names.StorageTag
+}
+
+func (f *mockFilesystem) Storage() (names.StorageTag, error) {
+	if f.storage.Id() == "" {
+		return names.StorageTag{}, jujuerrors.NotAssignedf("filesystem")
+	}
+	return f.storage, nil
+}
+
+func (f *mockFilesystem) Status() (status.StatusInfo, error) {
+	return f.status, nil
+}
+
+func (f *mockFilesystem) SetStatus(info status.StatusInfo) error {
+	f.status = info
+	return nil
+}
+
+type mockFilesystemAttachment struct {
+	state.FilesystemAttachment
+	filesystem names.FilesystemTag
+	info       *state.FilesystemAttachmentInfo
+	usage      *state.FilesystemUsage
+}
+
+func (a *mockFilesystemAttachment) Usage() (state.FilesystemUsage, bool) {
+	if a.usage == nil {
+		return state.FilesystemUsage{}, false
+	}
+	return *a.usage, true
+}
+
+func (a *mockFilesystemAttachment) Filesystem() names.FilesystemTag {
+	return a.filesystem
+}
+
+func (a *mockFilesystemAttachment) Life() state.Life {
+	return state.Alive
+}
+
+func (a *mockFilesystemAttachment) Info() (state.FilesystemAttachmentInfo, error) {
+	if a.info == nil {
+		return state.FilesystemAttachmentInfo{}, jujuerrors.NotProvisionedf("filesystem attachment")
+	}
+	return *a.info, nil
+}
+
+type mockStorageAttachment struct {
+	state.StorageAttachment
+	unit names.UnitTag
+}
+
+func (a *mockStorageAttachment) Unit() names.UnitTag {
+	return a.unit
+}
+
 type mockBlockDeviceUpdater struct {
 	calls   int
 	devices map[string][]blockdevice.BlockDevice
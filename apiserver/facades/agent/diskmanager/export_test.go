@@ -4,15 +4,27 @@
 package diskmanager
 
 import (
+	"github.com/juju/clock"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facade"
 )
 
-func NewDiskManagerAPIForTest(auth facade.Authorizer, blockDeviceUpdater blockDeviceUpdater) *DiskManagerAPI {
+func NewDiskManagerAPIForTest(
+	auth facade.Authorizer,
+	blockDeviceUpdater blockDeviceUpdater,
+	storage storageBackend,
+	modelConfigService modelConfigService,
+	statusService statusService,
+	clock clock.Clock,
+) *DiskManagerAPI {
 	return &DiskManagerAPI{
 		blockDeviceUpdater: blockDeviceUpdater,
+		storage:            storage,
+		modelConfigService: modelConfigService,
+		statusService:      statusService,
+		clock:              clock,
 		authorizer:         auth,
 		getAuthFunc: func() (common.AuthFunc, error) {
 			return func(tag names.Tag) bool {
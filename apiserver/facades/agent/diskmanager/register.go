@@ -7,17 +7,26 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/state"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("DiskManager", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newDiskManagerAPI(ctx)
+		api, err := newDiskManagerAPI(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &DiskManagerAPIV2{DiskManagerAPI: api}, nil
+	}, reflect.TypeOf((*DiskManagerAPIV2)(nil)))
+	registry.MustRegister("DiskManager", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newDiskManagerAPI(ctx) // Adds filesystem usage reporting.
 	}, reflect.TypeOf((*DiskManagerAPI)(nil)))
 }
 
@@ -36,8 +45,17 @@ func newDiskManagerAPI(ctx facade.ModelContext) (*DiskManagerAPI, error) {
 		}, nil
 	}
 
+	sb, err := state.NewStorageBackend(ctx.State())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	return &DiskManagerAPI{
 		blockDeviceUpdater: ctx.DomainServices().BlockDevice(),
+		storage:            sb,
+		modelConfigService: ctx.DomainServices().Config(),
+		statusService:      ctx.DomainServices().Status(),
+		clock:              ctx.Clock(),
 		authorizer:         authorizer,
 		getAuthFunc:        getAuthFunc,
 	}, nil
@@ -5,6 +5,7 @@ package machine
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
@@ -15,8 +16,12 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 )
@@ -48,6 +53,16 @@ type MachineService interface {
 	// IsMachineController returns whether the machine is a controller machine.
 	// It returns a NotFound if the given machine doesn't exist.
 	IsMachineController(context.Context, machine.Name) (bool, error)
+	// RecordMachineUtilisation records a sample of the CPU and memory
+	// utilisation of the machine, keeping the samples taken in the window
+	// before it.
+	RecordMachineUtilisation(context.Context, machine.Name, domainmachine.UtilisationSample, time.Duration) error
+}
+
+// ModelConfigService is the interface that is used to read the model config.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(context.Context) (*config.Config, error)
 }
 
 // ModelInfoService is the interface that is used to ask questions about the
@@ -68,6 +83,7 @@ type MachinerAPI struct {
 
 	networkService          NetworkService
 	machineService          MachineService
+	modelConfigService      ModelConfigService
 	st                      *state.State
 	controllerConfigService ControllerConfigService
 	auth                    facade.Authorizer
@@ -75,9 +91,14 @@ type MachinerAPI struct {
 	getCanRead              common.GetAuthFunc
 }
 
+// MachinerAPIv6 hides the RecordMachineUtilisation method.
+type MachinerAPIv6 struct {
+	*MachinerAPI
+}
+
 // MachinerAPI5 stubs out the Jobs() and SetMachineAddresses() methods.
 type MachinerAPIv5 struct {
-	*MachinerAPI
+	*MachinerAPIv6
 }
 
 // NewMachinerAPIForState creates a new instance of the Machiner API.
@@ -89,6 +110,7 @@ func NewMachinerAPIForState(
 	modelInfoService ModelInfoService,
 	networkService NetworkService,
 	machineService MachineService,
+	modelConfigService ModelConfigService,
 	watcherRegistry facade.WatcherRegistry,
 	resources facade.Resources,
 	authorizer facade.Authorizer,
@@ -115,6 +137,7 @@ func NewMachinerAPIForState(
 		NetworkConfigAPI:        netConfigAPI,
 		networkService:          networkService,
 		machineService:          machineService,
+		modelConfigService:      modelConfigService,
 		st:                      st,
 		controllerConfigService: controllerConfigService,
 		auth:                    authorizer,
@@ -290,6 +313,47 @@ func (api *MachinerAPI) RecordAgentStartInformation(ctx context.Context, args pa
 	return results, nil
 }
 
+// RecordMachineUtilisation records the samples of CPU and memory
+// utilisation reported by machine agents. Samples older than the model's
+// rightsizing window are discarded.
+func (api *MachinerAPI) RecordMachineUtilisation(ctx context.Context, args params.MachineUtilisationSamples) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Samples)),
+	}
+	canModify, err := api.getCanModify()
+	if err != nil {
+		return results, err
+	}
+	modelConfig, err := api.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	window := modelConfig.RightsizingWindow()
+
+	for i, sample := range args.Samples {
+		machineTag, err := names.ParseMachineTag(sample.Tag)
+		if err != nil || !canModify(machineTag) {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		err = api.machineService.RecordMachineUtilisation(ctx, machine.Name(machineTag.Id()), domainmachine.UtilisationSample{
+			Time:   sample.SampledAt,
+			CPU:    sample.CPUPercent,
+			Memory: sample.MemoryPercent,
+		}, window)
+		if errors.Is(err, machineerrors.MachineNotFound) {
+			err = errors.NotFoundf("machine %q", machineTag.Id())
+		} else if errors.Is(err, coreerrors.NotValid) {
+			err = errors.NotValidf("utilisation sample for machine %q", machineTag.Id())
+		}
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
+
+// RecordMachineUtilisation is not supported in MachinerAPI at version 6.
+func (*MachinerAPIv6) RecordMachineUtilisation(_, _ struct{}) {}
+
 // APIHostPorts returns the API server addresses.
 func (api *MachinerAPI) APIHostPorts(ctx context.Context) (result params.APIHostPortsResult, err error) {
 	controllerConfig, err := api.controllerConfigService.ControllerConfig(ctx)
@@ -20,6 +20,9 @@ import (
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
+	domainmachine "github.com/juju/juju/domain/machine"
+	"github.com/juju/juju/environs/config"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state"
 )
@@ -27,10 +30,11 @@ import (
 type machinerSuite struct {
 	commonSuite
 
-	machiner        *machine.MachinerAPI
-	networkService  *MockNetworkService
-	machineService  *MockMachineService
-	watcherRegistry *MockWatcherRegistry
+	machiner           *machine.MachinerAPI
+	networkService     *MockNetworkService
+	machineService     *MockMachineService
+	modelConfigService *MockModelConfigService
+	watcherRegistry    *MockWatcherRegistry
 }
 
 var _ = gc.Suite(&machinerSuite{})
@@ -41,6 +45,7 @@ func (s *machinerSuite) setupMocks(c *gc.C) *gomock.Controller {
 	s.watcherRegistry = NewMockWatcherRegistry(ctrl)
 	s.networkService = NewMockNetworkService(ctrl)
 	s.machineService = NewMockMachineService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
 	return ctrl
 }
 
@@ -56,6 +61,7 @@ func (s *machinerSuite) makeAPI(c *gc.C) {
 		s.ControllerDomainServices(c).ModelInfo(),
 		s.networkService,
 		s.machineService,
+		s.modelConfigService,
 		s.watcherRegistry,
 		common.NewResources(),
 		s.authorizer,
@@ -79,6 +85,7 @@ func (s *machinerSuite) TestMachinerFailsWithNonMachineAgentUser(c *gc.C) {
 		nil,
 		s.networkService,
 		s.machineService,
+		s.modelConfigService,
 		s.watcherRegistry,
 		common.NewResources(),
 		anAuthorizer,
@@ -335,3 +342,34 @@ func (s *machinerSuite) TestRecordAgentStartInformation(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(s.machine1.Hostname(), gc.Equals, "thundering-herds", gc.Commentf("expected the machine hostname to be updated"))
 }
+
+func (s *machinerSuite) TestRecordMachineUtilisation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	s.makeAPI(c)
+
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"rightsizing-window": "24h",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	now := time.Now()
+	s.machineService.EXPECT().RecordMachineUtilisation(gomock.Any(), coremachine.Name("1"), domainmachine.UtilisationSample{
+		Time: now, CPU: 12.5, Memory: 40,
+	}, 24*time.Hour).Return(nil)
+
+	args := params.MachineUtilisationSamples{Samples: []params.MachineUtilisationSample{
+		{Tag: "machine-1", SampledAt: now, CPUPercent: 12.5, MemoryPercent: 40},
+		{Tag: "machine-0", SampledAt: now, CPUPercent: 12.5, MemoryPercent: 40},
+		{Tag: "application-foo", SampledAt: now},
+	}}
+	result, err := s.machiner.RecordMachineUtilisation(context.Background(), args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, gc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{Error: nil},
+			{Error: apiservertesting.ErrUnauthorized},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/machine (interfaces: NetworkService,MachineService)
+// Source: github.com/juju/juju/apiserver/facades/agent/machine (interfaces: NetworkService,MachineService,ModelConfigService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package machine_test -destination package_mock_test.go github.com/juju/juju/apiserver/facades/agent/machine NetworkService,MachineService
+//	mockgen -typed -package machine_test -destination package_mock_test.go github.com/juju/juju/apiserver/facades/agent/machine NetworkService,MachineService,ModelConfigService
 //
 
 // Package machine_test is a generated GoMock package.
@@ -12,9 +12,12 @@ package machine_test
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	machine "github.com/juju/juju/core/machine"
 	network "github.com/juju/juju/core/network"
+	machine0 "github.com/juju/juju/domain/machine"
+	config "github.com/juju/juju/environs/config"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -257,3 +260,103 @@ func (c *MockMachineServiceIsMachineControllerCall) DoAndReturn(f func(context.C
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// RecordMachineUtilisation mocks base method.
+func (m *MockMachineService) RecordMachineUtilisation(arg0 context.Context, arg1 machine.Name, arg2 machine0.UtilisationSample, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMachineUtilisation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordMachineUtilisation indicates an expected call of RecordMachineUtilisation.
+func (mr *MockMachineServiceMockRecorder) RecordMachineUtilisation(arg0, arg1, arg2, arg3 any) *MockMachineServiceRecordMachineUtilisationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMachineUtilisation", reflect.TypeOf((*MockMachineService)(nil).RecordMachineUtilisation), arg0, arg1, arg2, arg3)
+	return &MockMachineServiceRecordMachineUtilisationCall{Call: call}
+}
+
+// MockMachineServiceRecordMachineUtilisationCall wrap *gomock.Call
+type MockMachineServiceRecordMachineUtilisationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceRecordMachineUtilisationCall) Return(arg0 error) *MockMachineServiceRecordMachineUtilisationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceRecordMachineUtilisationCall) Do(f func(context.Context, machine.Name, machine0.UtilisationSample, time.Duration) error) *MockMachineServiceRecordMachineUtilisationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceRecordMachineUtilisationCall) DoAndReturn(f func(context.Context, machine.Name, machine0.UtilisationSample, time.Duration) error) *MockMachineServiceRecordMachineUtilisationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -14,12 +14,15 @@ import (
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("Machiner", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newMachinerAPI(stdCtx, ctx) // Adds RecordMachineUtilisation.
+	}, reflect.TypeOf((*MachinerAPI)(nil)))
 	// Register the Machiner facade at version 6, which relies on the dqlite
 	// backend. SetMachineAddresses is removed (to be handled by the network
 	// api).
 	registry.MustRegister("Machiner", 6, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newMachinerAPI(stdCtx, ctx)
-	}, reflect.TypeOf((*MachinerAPI)(nil)))
+		return newMachinerAPIV6(stdCtx, ctx)
+	}, reflect.TypeOf((*MachinerAPIv6)(nil)))
 	// Register the Machiner facade at version 5, which, on Juju 4.0, stubs out
 	// the Jobs() and SetMachineAddresses() methods.
 	registry.MustRegister("Machiner", 5, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
@@ -43,12 +46,24 @@ func newMachinerAPI(stdCtx context.Context, ctx facade.ModelContext) (*MachinerA
 		domainServices.ModelInfo(),
 		domainServices.Network(),
 		domainServices.Machine(),
+		domainServices.Config(),
 		ctx.WatcherRegistry(),
 		ctx.Resources(),
 		ctx.Auth(),
 	)
 }
 
+// newMachinerAPIV6 creates a new instance of the Machiner API at version 6.
+func newMachinerAPIV6(stdCtx context.Context, ctx facade.ModelContext) (*MachinerAPIv6, error) {
+	api, err := newMachinerAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &MachinerAPIv6{
+		MachinerAPI: api,
+	}, nil
+}
+
 // newMachinerAPIV5 creates a new instance of the Machiner API at version 5.
 func newMachinerAPIV5(stdCtx context.Context, ctx facade.ModelContext) (*MachinerAPIv5, error) {
 	api, err := newMachinerAPI(stdCtx, ctx)
@@ -56,6 +71,8 @@ func newMachinerAPIV5(stdCtx context.Context, ctx facade.ModelContext) (*Machine
 		return nil, err
 	}
 	return &MachinerAPIv5{
-		MachinerAPI: api,
+		MachinerAPIv6: &MachinerAPIv6{
+			MachinerAPI: api,
+		},
 	}, nil
 }
@@ -6,9 +6,12 @@ package provisioner
 import (
 	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/core/constraints"
 	corenetwork "github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/internal/network"
 	"github.com/juju/juju/internal/network/containerizer"
+	"github.com/juju/juju/state"
 )
 
 // Machine is an indirection for use in container provisioning.
@@ -47,3 +50,25 @@ type Unit interface {
 type Application interface {
 	Name() string
 }
+
+// InterruptibleMachine is an indirection for the state.Machine methods
+// used to replace a machine's interrupted instance.
+type InterruptibleMachine interface {
+	Id() string
+	InstanceStatus() (status.StatusInfo, error)
+	ResetProvisioned() error
+}
+
+// WarmPoolState is an indirection for the state methods used to maintain
+// the model's warm pool.
+type WarmPoolState interface {
+	// ResolveConstraints combines the input constraints with the model's.
+	ResolveConstraints(constraints.Value) (constraints.Value, error)
+
+	// AddMachine adds a machine hosting units, with the input base and
+	// constraints, returning its id.
+	AddMachine(state.Base, constraints.Value) (string, error)
+
+	// DestroyMachine destroys the machine with the input id.
+	DestroyMachine(string) error
+}
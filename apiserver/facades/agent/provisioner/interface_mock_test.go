@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/provisioner (interfaces: Machine,BridgePolicy,Unit,Application)
+// Source: github.com/juju/juju/apiserver/facades/agent/provisioner (interfaces: Machine,BridgePolicy,Unit,Application,WarmPoolState,InterruptibleMachine)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package provisioner -destination interface_mock_test.go github.com/juju/juju/apiserver/facades/agent/provisioner Machine,BridgePolicy,Unit,Application
+//	mockgen -typed -package provisioner -destination interface_mock_test.go github.com/juju/juju/apiserver/facades/agent/provisioner Machine,BridgePolicy,Unit,Application,WarmPoolState,InterruptibleMachine
 //
 
 // Package provisioner is a generated GoMock package.
@@ -16,6 +16,7 @@ import (
 	constraints "github.com/juju/juju/core/constraints"
 	instance "github.com/juju/juju/core/instance"
 	network "github.com/juju/juju/core/network"
+	status "github.com/juju/juju/core/status"
 	network0 "github.com/juju/juju/internal/network"
 	containerizer "github.com/juju/juju/internal/network/containerizer"
 	state "github.com/juju/juju/state"
@@ -853,3 +854,280 @@ func (c *MockApplicationNameCall) DoAndReturn(f func() string) *MockApplicationN
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockWarmPoolState is a mock of WarmPoolState interface.
+type MockWarmPoolState struct {
+	ctrl     *gomock.Controller
+	recorder *MockWarmPoolStateMockRecorder
+}
+
+// MockWarmPoolStateMockRecorder is the mock recorder for MockWarmPoolState.
+type MockWarmPoolStateMockRecorder struct {
+	mock *MockWarmPoolState
+}
+
+// NewMockWarmPoolState creates a new mock instance.
+func NewMockWarmPoolState(ctrl *gomock.Controller) *MockWarmPoolState {
+	mock := &MockWarmPoolState{ctrl: ctrl}
+	mock.recorder = &MockWarmPoolStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWarmPoolState) EXPECT() *MockWarmPoolStateMockRecorder {
+	return m.recorder
+}
+
+// AddMachine mocks base method.
+func (m *MockWarmPoolState) AddMachine(arg0 state.Base, arg1 constraints.Value) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMachine", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMachine indicates an expected call of AddMachine.
+func (mr *MockWarmPoolStateMockRecorder) AddMachine(arg0, arg1 any) *MockWarmPoolStateAddMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMachine", reflect.TypeOf((*MockWarmPoolState)(nil).AddMachine), arg0, arg1)
+	return &MockWarmPoolStateAddMachineCall{Call: call}
+}
+
+// MockWarmPoolStateAddMachineCall wrap *gomock.Call
+type MockWarmPoolStateAddMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWarmPoolStateAddMachineCall) Return(arg0 string, arg1 error) *MockWarmPoolStateAddMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWarmPoolStateAddMachineCall) Do(f func(state.Base, constraints.Value) (string, error)) *MockWarmPoolStateAddMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWarmPoolStateAddMachineCall) DoAndReturn(f func(state.Base, constraints.Value) (string, error)) *MockWarmPoolStateAddMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DestroyMachine mocks base method.
+func (m *MockWarmPoolState) DestroyMachine(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyMachine", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DestroyMachine indicates an expected call of DestroyMachine.
+func (mr *MockWarmPoolStateMockRecorder) DestroyMachine(arg0 any) *MockWarmPoolStateDestroyMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyMachine", reflect.TypeOf((*MockWarmPoolState)(nil).DestroyMachine), arg0)
+	return &MockWarmPoolStateDestroyMachineCall{Call: call}
+}
+
+// MockWarmPoolStateDestroyMachineCall wrap *gomock.Call
+type MockWarmPoolStateDestroyMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWarmPoolStateDestroyMachineCall) Return(arg0 error) *MockWarmPoolStateDestroyMachineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWarmPoolStateDestroyMachineCall) Do(f func(string) error) *MockWarmPoolStateDestroyMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWarmPoolStateDestroyMachineCall) DoAndReturn(f func(string) error) *MockWarmPoolStateDestroyMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResolveConstraints mocks base method.
+func (m *MockWarmPoolState) ResolveConstraints(arg0 constraints.Value) (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveConstraints", arg0)
+	ret0, _ := ret[0].(constraints.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveConstraints indicates an expected call of ResolveConstraints.
+func (mr *MockWarmPoolStateMockRecorder) ResolveConstraints(arg0 any) *MockWarmPoolStateResolveConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveConstraints", reflect.TypeOf((*MockWarmPoolState)(nil).ResolveConstraints), arg0)
+	return &MockWarmPoolStateResolveConstraintsCall{Call: call}
+}
+
+// MockWarmPoolStateResolveConstraintsCall wrap *gomock.Call
+type MockWarmPoolStateResolveConstraintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWarmPoolStateResolveConstraintsCall) Return(arg0 constraints.Value, arg1 error) *MockWarmPoolStateResolveConstraintsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWarmPoolStateResolveConstraintsCall) Do(f func(constraints.Value) (constraints.Value, error)) *MockWarmPoolStateResolveConstraintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWarmPoolStateResolveConstraintsCall) DoAndReturn(f func(constraints.Value) (constraints.Value, error)) *MockWarmPoolStateResolveConstraintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockInterruptibleMachine is a mock of InterruptibleMachine interface.
+type MockInterruptibleMachine struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterruptibleMachineMockRecorder
+}
+
+// MockInterruptibleMachineMockRecorder is the mock recorder for MockInterruptibleMachine.
+type MockInterruptibleMachineMockRecorder struct {
+	mock *MockInterruptibleMachine
+}
+
+// NewMockInterruptibleMachine creates a new mock instance.
+func NewMockInterruptibleMachine(ctrl *gomock.Controller) *MockInterruptibleMachine {
+	mock := &MockInterruptibleMachine{ctrl: ctrl}
+	mock.recorder = &MockInterruptibleMachineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterruptibleMachine) EXPECT() *MockInterruptibleMachineMockRecorder {
+	return m.recorder
+}
+
+// Id mocks base method.
+func (m *MockInterruptibleMachine) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockInterruptibleMachineMockRecorder) Id() *MockInterruptibleMachineIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockInterruptibleMachine)(nil).Id))
+	return &MockInterruptibleMachineIdCall{Call: call}
+}
+
+// MockInterruptibleMachineIdCall wrap *gomock.Call
+type MockInterruptibleMachineIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterruptibleMachineIdCall) Return(arg0 string) *MockInterruptibleMachineIdCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterruptibleMachineIdCall) Do(f func() string) *MockInterruptibleMachineIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterruptibleMachineIdCall) DoAndReturn(f func() string) *MockInterruptibleMachineIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InstanceStatus mocks base method.
+func (m *MockInterruptibleMachine) InstanceStatus() (status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceStatus")
+	ret0, _ := ret[0].(status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceStatus indicates an expected call of InstanceStatus.
+func (mr *MockInterruptibleMachineMockRecorder) InstanceStatus() *MockInterruptibleMachineInstanceStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceStatus", reflect.TypeOf((*MockInterruptibleMachine)(nil).InstanceStatus))
+	return &MockInterruptibleMachineInstanceStatusCall{Call: call}
+}
+
+// MockInterruptibleMachineInstanceStatusCall wrap *gomock.Call
+type MockInterruptibleMachineInstanceStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterruptibleMachineInstanceStatusCall) Return(arg0 status.StatusInfo, arg1 error) *MockInterruptibleMachineInstanceStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterruptibleMachineInstanceStatusCall) Do(f func() (status.StatusInfo, error)) *MockInterruptibleMachineInstanceStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterruptibleMachineInstanceStatusCall) DoAndReturn(f func() (status.StatusInfo, error)) *MockInterruptibleMachineInstanceStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResetProvisioned mocks base method.
+func (m *MockInterruptibleMachine) ResetProvisioned() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetProvisioned")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetProvisioned indicates an expected call of ResetProvisioned.
+func (mr *MockInterruptibleMachineMockRecorder) ResetProvisioned() *MockInterruptibleMachineResetProvisionedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetProvisioned", reflect.TypeOf((*MockInterruptibleMachine)(nil).ResetProvisioned))
+	return &MockInterruptibleMachineResetProvisionedCall{Call: call}
+}
+
+// MockInterruptibleMachineResetProvisionedCall wrap *gomock.Call
+type MockInterruptibleMachineResetProvisionedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInterruptibleMachineResetProvisionedCall) Return(arg0 error) *MockInterruptibleMachineResetProvisionedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInterruptibleMachineResetProvisionedCall) Do(f func() error) *MockInterruptibleMachineResetProvisionedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInterruptibleMachineResetProvisionedCall) DoAndReturn(f func() error) *MockInterruptibleMachineResetProvisionedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
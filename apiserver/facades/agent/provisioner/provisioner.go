@@ -19,6 +19,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/caas"
+	corebase "github.com/juju/juju/core/base"
 	"github.com/juju/juju/core/constraints"
 	corecontainer "github.com/juju/juju/core/container"
 	"github.com/juju/juju/core/instance"
@@ -29,6 +30,8 @@ import (
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
+	jujuversion "github.com/juju/juju/core/version"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
@@ -60,6 +63,7 @@ type ProvisionerAPI struct {
 
 	networkService            NetworkService
 	st                        *state.State
+	warmPoolState             WarmPoolState
 	controllerConfigService   ControllerConfigService
 	cloudImageMetadataService CloudImageMetadataService
 	agentProvisionerService   AgentProvisionerService
@@ -68,6 +72,7 @@ type ProvisionerAPI struct {
 	modelInfoService          ModelInfoService
 	machineService            MachineService
 	applicationService        ApplicationService
+	unitStateService          UnitStateService
 	resources                 facade.Resources
 	authorizer                facade.Authorizer
 	storageProviderRegistry   storage.ProviderRegistry
@@ -189,6 +194,7 @@ func MakeProvisionerAPI(stdCtx context.Context, ctx facade.ModelContext) (*Provi
 		NetworkConfigAPI:          netConfigAPI,
 		networkService:            ctx.DomainServices().Network(),
 		st:                        st,
+		warmPoolState:             warmPoolStateShim{st},
 		controllerConfigService:   domainServices.ControllerConfig(),
 		agentProvisionerService:   domainServices.AgentProvisioner(),
 		cloudImageMetadataService: domainServices.CloudImageMetadata(),
@@ -197,6 +203,7 @@ func MakeProvisionerAPI(stdCtx context.Context, ctx facade.ModelContext) (*Provi
 		modelInfoService:          modelInfoService,
 		machineService:            domainServices.Machine(),
 		applicationService:        domainServices.Application(),
+		unitStateService:          domainServices.UnitState(),
 		resources:                 resources,
 		authorizer:                authorizer,
 		configGetter:              configGetter,
@@ -226,9 +233,34 @@ func MakeProvisionerAPI(stdCtx context.Context, ctx facade.ModelContext) (*Provi
 // ProvisionerAPIV11 provides v10 of the provisioner facade.
 // It relies on agent-set origin when calling SetHostMachineNetworkConfig.
 type ProvisionerAPIV11 struct {
+	*ProvisionerAPIV12
+}
+
+// ProvisionerAPIV12 provides v12 of the provisioner facade, which adds
+// EnsureWarmPool.
+type ProvisionerAPIV12 struct {
+	*ProvisionerAPIV13
+}
+
+// ProvisionerAPIV13 provides v13 of the provisioner facade, which adds
+// MachinesWithInterruptedInstances, ResetInterruptedInstances and
+// WatchInterruptedInstances.
+type ProvisionerAPIV13 struct {
 	*ProvisionerAPI
 }
 
+// EnsureWarmPool isn't on the v11 API.
+func (*ProvisionerAPIV11) EnsureWarmPool(_, _ struct{}) {}
+
+// MachinesWithInterruptedInstances isn't on the v12 API.
+func (*ProvisionerAPIV12) MachinesWithInterruptedInstances(_, _ struct{}) {}
+
+// ResetInterruptedInstances isn't on the v12 API.
+func (*ProvisionerAPIV12) ResetInterruptedInstances(_, _ struct{}) {}
+
+// WatchInterruptedInstances isn't on the v12 API.
+func (*ProvisionerAPIV12) WatchInterruptedInstances(_, _ struct{}) {}
+
 func (api *ProvisionerAPI) getMachine(canAccess common.AuthFunc, tag names.MachineTag) (*state.Machine, error) {
 	if !canAccess(tag) {
 		return nil, apiservererrors.ErrPerm
@@ -484,6 +516,98 @@ func (api *ProvisionerAPI) MachinesWithTransientErrors(ctx context.Context) (par
 	return results, nil
 }
 
+// MachinesWithInterruptedInstances returns status data for alive machines
+// whose spot or preemptible instance has been reclaimed by the cloud, or
+// is about to be.
+func (api *ProvisionerAPI) MachinesWithInterruptedInstances(ctx context.Context) (params.StatusResults, error) {
+	var results params.StatusResults
+	canAccessFunc, err := api.getAuthFunc()
+	if err != nil {
+		return results, err
+	}
+	machines, err := api.st.AllMachines()
+	if err != nil {
+		return results, err
+	}
+	for _, machine := range machines {
+		if !canAccessFunc(machine.Tag()) || machine.Life() != state.Alive {
+			continue
+		}
+		statusInfo, err := machine.InstanceStatus()
+		if err != nil || statusInfo.Status != status.Interrupted {
+			continue
+		}
+		results.Results = append(results.Results, params.StatusResult{
+			Id:     machine.Id(),
+			Life:   life.Value(machine.Life().String()),
+			Status: statusInfo.Status.String(),
+			Info:   statusInfo.Message,
+			Data:   statusInfo.Data,
+		})
+	}
+	return results, nil
+}
+
+// ResetInterruptedInstances forgets the interrupted instance of each
+// given machine, so that the machine can be provisioned again on a new
+// instance. It is an error to reset a machine whose instance has not
+// been interrupted.
+func (api *ProvisionerAPI) ResetInterruptedInstances(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Entities)),
+	}
+	canAccess, err := api.getAuthFunc()
+	if err != nil {
+		return result, err
+	}
+	for i, entity := range args.Entities {
+		result.Results[i].Error = apiservererrors.ServerError(api.resetInterruptedInstance(ctx, canAccess, entity.Tag))
+	}
+	return result, nil
+}
+
+func (api *ProvisionerAPI) resetInterruptedInstance(ctx context.Context, canAccess common.AuthFunc, machineTag string) error {
+	tag, err := names.ParseMachineTag(machineTag)
+	if err != nil {
+		return apiservererrors.ErrPerm
+	}
+	machine, err := api.getMachine(canAccess, tag)
+	if err != nil {
+		return err
+	}
+	return api.replaceInterruptedInstance(ctx, machine)
+}
+
+// replaceInterruptedInstance forgets the interrupted instance of the
+// machine, and the state of the unit agents it hosted, so that the machine
+// and its units start afresh on a new instance.
+func (api *ProvisionerAPI) replaceInterruptedInstance(ctx context.Context, machine InterruptibleMachine) error {
+	statusInfo, err := machine.InstanceStatus()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if statusInfo.Status != status.Interrupted {
+		return errors.Errorf("instance of machine %q has not been interrupted", machine.Id())
+	}
+	if err := machine.ResetProvisioned(); err != nil {
+		return errors.Trace(err)
+	}
+	machineName := coremachine.Name(machine.Id())
+	machineUUID, err := api.machineService.GetMachineUUID(ctx, machineName)
+	if err != nil {
+		return errors.Annotatef(err, "getting machine UUID for %q", machine.Id())
+	}
+	if err := api.machineService.DeleteMachineCloudInstance(ctx, machineUUID); err != nil {
+		return errors.Annotatef(err, "removing instance data for machine %q", machine.Id())
+	}
+	// The unit agents on the new instance have none of the old one's
+	// local state, so they must run their hooks from the start again.
+	if err := api.unitStateService.ResetMachineUnitStates(ctx, machineName); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 // AvailabilityZone returns a provider-specific availability zone for each given machine entity
 func (api *ProvisionerAPI) AvailabilityZone(ctx context.Context, args params.Entities) (params.StringResults, error) {
 	result := params.StringResults{
@@ -831,6 +955,24 @@ func (api *ProvisionerAPI) WatchMachineErrorRetry(ctx context.Context) (params.N
 	return result, nil
 }
 
+// WatchInterruptedInstances returns a NotifyWatcher that fires whenever
+// the status of a machine's instance changes, after which the provisioner
+// looks for machines with interrupted instances to replace.
+func (api *ProvisionerAPI) WatchInterruptedInstances(ctx context.Context) (params.NotifyWatchResult, error) {
+	result := params.NotifyWatchResult{}
+	if !api.authorizer.AuthController() {
+		return result, apiservererrors.ErrPerm
+	}
+	watch := api.st.WatchMachineInstanceStatuses()
+	// Consume any initial event and forward it to the result.
+	if _, ok := <-watch.Changes(); ok {
+		result.NotifyWatcherId = api.resources.Register(watch)
+	} else {
+		return result, watcher.EnsureErr(watch)
+	}
+	return result, nil
+}
+
 // ReleaseContainerAddresses finds addresses allocated to a container and marks
 // them as Dead, to be released and removed. It accepts container tags as
 // arguments.
@@ -1539,3 +1681,65 @@ func (api *ProvisionerAPI) Remove(ctx context.Context, args params.Entities) (pa
 	}
 	return result, nil
 }
+
+// EnsureWarmPool adds machines to, or removes surplus machines from, the
+// model's warm pool so that it matches the model's warm-pool config.
+// Only the controller may maintain the warm pool.
+func (api *ProvisionerAPI) EnsureWarmPool(ctx context.Context) (params.WarmPoolResult, error) {
+	if !api.authorizer.AuthController() {
+		return params.WarmPoolResult{}, apiservererrors.ErrPerm
+	}
+	cfg, err := api.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return params.WarmPoolResult{}, errors.Trace(err)
+	}
+	base := jujuversion.DefaultSupportedLTSBase()
+	if defaultBase, ok := cfg.DefaultBase(); ok {
+		if base, err = corebase.ParseBaseFromString(defaultBase); err != nil {
+			return params.WarmPoolResult{}, errors.Trace(err)
+		}
+	}
+
+	pool := cfg.WarmPool()
+	entries := make([]domainmachine.WarmPoolEntry, len(pool))
+	for i, entry := range pool {
+		cons, err := api.warmPoolState.ResolveConstraints(entry.Constraints)
+		if err != nil {
+			return params.WarmPoolResult{}, errors.Annotatef(err, "resolving warm pool constraints %q", entry.Constraints)
+		}
+		entries[i] = domainmachine.WarmPoolEntry{Constraints: cons, Size: entry.Size}
+	}
+	reconciliation, err := api.machineService.ReconcileWarmPool(ctx, entries, base)
+	if err != nil {
+		return params.WarmPoolResult{}, apiservererrors.ServerError(err)
+	}
+
+	var changes params.WarmPoolResult
+	stateBase := state.Base{OS: base.OS, Channel: base.Channel.String()}
+	for i, missing := range reconciliation.Missing {
+		for n := 0; n < missing; n++ {
+			id, err := api.warmPoolState.AddMachine(stateBase, pool[i].Constraints)
+			if err != nil {
+				return params.WarmPoolResult{}, errors.Annotate(err, "adding warm pool machine")
+			}
+			name := coremachine.Name(id)
+			if _, err := api.machineService.CreateMachine(ctx, name); err != nil && !errors.Is(err, machineerrors.MachineAlreadyExists) {
+				return params.WarmPoolResult{}, errors.Annotatef(err, "saving info for machine %q", id)
+			}
+			if err := api.machineService.AddWarmPoolMachine(ctx, name, entries[i].Constraints, base); err != nil {
+				return params.WarmPoolResult{}, errors.Trace(err)
+			}
+			changes.Added = append(changes.Added, id)
+		}
+	}
+	for _, name := range reconciliation.Surplus {
+		if err := api.warmPoolState.DestroyMachine(name.String()); err != nil {
+			// The machine is out of the pool, so no unit can be
+			// given to it; it is left for the operator to remove.
+			api.logger.Warningf(ctx, "cannot destroy surplus warm pool machine %q: %v", name, err)
+			continue
+		}
+		changes.Removed = append(changes.Removed, name.String())
+	}
+	return changes, nil
+}
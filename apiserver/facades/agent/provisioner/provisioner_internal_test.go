@@ -5,16 +5,31 @@ package provisioner
 
 import (
 	"context"
+	"errors"
+	"reflect"
 
+	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/container"
 	"github.com/juju/juju/core/containermanager"
 	"github.com/juju/juju/core/instance"
+	coremachine "github.com/juju/juju/core/machine"
 	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/core/status"
+	domainmachine "github.com/juju/juju/domain/machine"
+	"github.com/juju/juju/environs/config"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/rpcreflect"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
 )
 
 // This file contains new provisioner tests which use mocked dependencies, and
@@ -26,6 +41,11 @@ import (
 type provisionerSuite struct {
 	agentProvisionerService *MockAgentProvisionerService
 	keyUpdaterService       *MockKeyUpdaterService
+	machineService          *MockMachineService
+	modelConfigService      *MockModelConfigService
+	warmPoolState           *MockWarmPoolState
+	unitStateService        *MockUnitStateService
+	machine                 *MockInterruptibleMachine
 }
 
 var _ = gc.Suite(&provisionerSuite{})
@@ -34,6 +54,11 @@ func (s *provisionerSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.agentProvisionerService = NewMockAgentProvisionerService(ctrl)
 	s.keyUpdaterService = NewMockKeyUpdaterService(ctrl)
+	s.machineService = NewMockMachineService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.warmPoolState = NewMockWarmPoolState(ctrl)
+	s.unitStateService = NewMockUnitStateService(ctrl)
+	s.machine = NewMockInterruptibleMachine(ctrl)
 	return ctrl
 }
 
@@ -116,3 +141,134 @@ ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIN8h8XBpjS9aBUG5cdoSWubs7wT2Lc/BEZIUQCqoaOZR
 `[1:],
 	})
 }
+
+func (s *provisionerSuite) TestEnsureWarmPool(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := &ProvisionerAPI{
+		authorizer:         apiservertesting.FakeAuthorizer{Controller: true},
+		machineService:     s.machineService,
+		modelConfigService: s.modelConfigService,
+		warmPoolState:      s.warmPoolState,
+		logger:             loggertesting.WrapCheckLog(c),
+	}
+
+	cfg := coretesting.CustomModelConfig(c, coretesting.Attrs{
+		config.WarmPoolKey:    "2:mem=4G;1:cores=8",
+		config.DefaultBaseKey: "ubuntu@24.04",
+	})
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.warmPoolState.EXPECT().ResolveConstraints(constraints.MustParse("mem=4G")).Return(constraints.MustParse("arch=amd64 mem=4G"), nil)
+	s.warmPoolState.EXPECT().ResolveConstraints(constraints.MustParse("cores=8")).Return(constraints.MustParse("arch=amd64 cores=8"), nil)
+
+	base := corebase.MustParseBaseFromString("ubuntu@24.04")
+	s.machineService.EXPECT().ReconcileWarmPool(gomock.Any(), []domainmachine.WarmPoolEntry{
+		{Constraints: constraints.MustParse("arch=amd64 mem=4G"), Size: 2},
+		{Constraints: constraints.MustParse("arch=amd64 cores=8"), Size: 1},
+	}, base).Return(domainmachine.WarmPoolReconciliation{
+		Missing: []int{1, 0},
+		Surplus: []coremachine.Name{"3", "4"},
+	}, nil)
+
+	s.warmPoolState.EXPECT().AddMachine(state.Base{OS: "ubuntu", Channel: "24.04/stable"}, constraints.MustParse("mem=4G")).Return("5", nil)
+	s.machineService.EXPECT().CreateMachine(gomock.Any(), coremachine.Name("5")).Return("uuid-5", nil)
+	s.machineService.EXPECT().AddWarmPoolMachine(gomock.Any(), coremachine.Name("5"), constraints.MustParse("arch=amd64 mem=4G"), base).Return(nil)
+
+	// A surplus machine that cannot be destroyed is left alone.
+	s.warmPoolState.EXPECT().DestroyMachine("3").Return(nil)
+	s.warmPoolState.EXPECT().DestroyMachine("4").Return(errors.New("machine 4 has unit \"foo/0\" assigned"))
+
+	result, err := api.EnsureWarmPool(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.WarmPoolResult{
+		Added:   []string{"5"},
+		Removed: []string{"3"},
+	})
+}
+
+func (s *provisionerSuite) TestEnsureWarmPoolAddMachineError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := &ProvisionerAPI{
+		authorizer:         apiservertesting.FakeAuthorizer{Controller: true},
+		machineService:     s.machineService,
+		modelConfigService: s.modelConfigService,
+		warmPoolState:      s.warmPoolState,
+	}
+
+	cfg := coretesting.CustomModelConfig(c, coretesting.Attrs{
+		config.WarmPoolKey: "1",
+	})
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.warmPoolState.EXPECT().ResolveConstraints(constraints.Value{}).Return(constraints.MustParse("arch=amd64"), nil)
+	s.machineService.EXPECT().ReconcileWarmPool(gomock.Any(), gomock.Any(), gomock.Any()).Return(domainmachine.WarmPoolReconciliation{
+		Missing: []int{1},
+	}, nil)
+	s.warmPoolState.EXPECT().AddMachine(gomock.Any(), constraints.Value{}).Return("", errors.New("boom"))
+
+	_, err := api.EnsureWarmPool(context.Background())
+	c.Assert(err, gc.ErrorMatches, "adding warm pool machine: boom")
+}
+
+func (s *provisionerSuite) TestEnsureWarmPoolNotController(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := &ProvisionerAPI{
+		authorizer: apiservertesting.FakeAuthorizer{Tag: names.NewMachineTag("0")},
+	}
+
+	_, err := api.EnsureWarmPool(context.Background())
+	c.Assert(err, gc.Equals, apiservererrors.ErrPerm)
+}
+
+func (s *provisionerSuite) TestEnsureWarmPoolNotOnV11(c *gc.C) {
+	objType := rpcreflect.ObjTypeOf(reflect.TypeOf(&ProvisionerAPIV11{}))
+	_, err := objType.Method("EnsureWarmPool")
+	c.Assert(err, gc.NotNil)
+	_, err = rpcreflect.ObjTypeOf(reflect.TypeOf(&ProvisionerAPI{})).Method("EnsureWarmPool")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *provisionerSuite) TestReplaceInterruptedInstance(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := &ProvisionerAPI{
+		machineService:   s.machineService,
+		unitStateService: s.unitStateService,
+	}
+
+	s.machine.EXPECT().Id().Return("0").AnyTimes()
+	s.machine.EXPECT().InstanceStatus().Return(status.StatusInfo{Status: status.Interrupted}, nil)
+	s.machine.EXPECT().ResetProvisioned().Return(nil)
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), coremachine.Name("0")).Return("uuid-0", nil)
+	s.machineService.EXPECT().DeleteMachineCloudInstance(gomock.Any(), "uuid-0").Return(nil)
+	s.unitStateService.EXPECT().ResetMachineUnitStates(gomock.Any(), coremachine.Name("0")).Return(nil)
+
+	err := api.replaceInterruptedInstance(context.Background(), s.machine)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *provisionerSuite) TestReplaceInterruptedInstanceNotInterrupted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := &ProvisionerAPI{
+		machineService:   s.machineService,
+		unitStateService: s.unitStateService,
+	}
+
+	s.machine.EXPECT().Id().Return("0").AnyTimes()
+	s.machine.EXPECT().InstanceStatus().Return(status.StatusInfo{Status: status.Running}, nil)
+
+	err := api.replaceInterruptedInstance(context.Background(), s.machine)
+	c.Assert(err, gc.ErrorMatches, `instance of machine "0" has not been interrupted`)
+}
+
+func (s *provisionerSuite) TestInterruptedInstancesNotOnV12(c *gc.C) {
+	v12 := rpcreflect.ObjTypeOf(reflect.TypeOf(&ProvisionerAPIV12{}))
+	v13 := rpcreflect.ObjTypeOf(reflect.TypeOf(&ProvisionerAPIV13{}))
+	for _, name := range []string{
+		"MachinesWithInterruptedInstances",
+		"ResetInterruptedInstances",
+		"WatchInterruptedInstances",
+	} {
+		_, err := v12.Method(name)
+		c.Check(err, gc.NotNil, gc.Commentf("%s", name))
+		_, err = v13.Method(name)
+		c.Check(err, jc.ErrorIsNil, gc.Commentf("%s", name))
+	}
+}
@@ -17,6 +17,12 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Provisioner", 11, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newProvisionerAPIV11(stdCtx, ctx) // Relies on agent-set origin in SetHostMachineNetworkConfig.
 	}, reflect.TypeOf((*ProvisionerAPIV11)(nil)))
+	registry.MustRegister("Provisioner", 12, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newProvisionerAPIV12(stdCtx, ctx) // Adds EnsureWarmPool.
+	}, reflect.TypeOf((*ProvisionerAPIV12)(nil)))
+	registry.MustRegister("Provisioner", 13, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newProvisionerAPIV13(stdCtx, ctx) // Adds MachinesWithInterruptedInstances, ResetInterruptedInstances and WatchInterruptedInstances.
+	}, reflect.TypeOf((*ProvisionerAPIV13)(nil)))
 }
 
 // newProvisionerAPIV11 creates a new server-side Provisioner API facade.
@@ -25,5 +31,23 @@ func newProvisionerAPIV11(stdCtx context.Context, ctx facade.ModelContext) (*Pro
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &ProvisionerAPIV11{ProvisionerAPI: provisionerAPI}, nil
+	return &ProvisionerAPIV11{ProvisionerAPIV12: &ProvisionerAPIV12{ProvisionerAPIV13: &ProvisionerAPIV13{ProvisionerAPI: provisionerAPI}}}, nil
+}
+
+// newProvisionerAPIV12 creates a new server-side Provisioner API facade.
+func newProvisionerAPIV12(stdCtx context.Context, ctx facade.ModelContext) (*ProvisionerAPIV12, error) {
+	provisionerAPI, err := MakeProvisionerAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ProvisionerAPIV12{ProvisionerAPIV13: &ProvisionerAPIV13{ProvisionerAPI: provisionerAPI}}, nil
+}
+
+// newProvisionerAPIV13 creates a new server-side Provisioner API facade.
+func newProvisionerAPIV13(stdCtx context.Context, ctx facade.ModelContext) (*ProvisionerAPIV13, error) {
+	provisionerAPI, err := MakeProvisionerAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &ProvisionerAPIV13{ProvisionerAPI: provisionerAPI}, nil
 }
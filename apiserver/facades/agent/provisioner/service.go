@@ -7,6 +7,8 @@ import (
 	"context"
 
 	"github.com/juju/juju/controller"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/container"
 	"github.com/juju/juju/core/containermanager"
 	"github.com/juju/juju/core/instance"
@@ -15,6 +17,7 @@ import (
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/cloudimagemetadata"
+	domainmachine "github.com/juju/juju/domain/machine"
 	"github.com/juju/juju/environs/config"
 	internalcharm "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/storage"
@@ -78,6 +81,17 @@ type MachineService interface {
 	HardwareCharacteristics(ctx context.Context, machineUUID string) (*instance.HardwareCharacteristics, error)
 	// InstanceID returns the cloud specific instance id for this machine.
 	InstanceID(ctx context.Context, mUUID string) (instance.Id, error)
+	// CreateMachine creates the specified machine.
+	CreateMachine(ctx context.Context, machineName coremachine.Name) (string, error)
+	// DeleteMachineCloudInstance removes the cloud instance data of the
+	// machine with the given UUID.
+	DeleteMachineCloudInstance(ctx context.Context, machineUUID string) error
+	// AddWarmPoolMachine puts the specified machine, started with the input
+	// constraints and base, in the model's warm pool.
+	AddWarmPoolMachine(ctx context.Context, machineName coremachine.Name, cons constraints.Value, base corebase.Base) error
+	// ReconcileWarmPool returns the number of machines to add to the warm
+	// pool for each entry, and takes the surplus machines out of it.
+	ReconcileWarmPool(ctx context.Context, entries []domainmachine.WarmPoolEntry, base corebase.Base) (domainmachine.WarmPoolReconciliation, error)
 }
 
 // StoragePoolGetter instances get a storage pool by name.
@@ -119,6 +133,14 @@ type ApplicationService interface {
 	GetCharmLXDProfile(ctx context.Context, locator charm.CharmLocator) (internalcharm.LXDProfile, charm.Revision, error)
 }
 
+// UnitStateService describes the service for the state of unit agents.
+type UnitStateService interface {
+	// ResetMachineUnitStates removes the state of the units on the input
+	// machine, other than their charm state, so that their agents start
+	// afresh on a new instance.
+	ResetMachineUnitStates(ctx context.Context, machineName coremachine.Name) error
+}
+
 // CloudImageMetadataService manages cloud image metadata for provisionning
 type CloudImageMetadataService interface {
 
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/provisioner (interfaces: AgentProvisionerService,KeyUpdaterService,ApplicationService)
+// Source: github.com/juju/juju/apiserver/facades/agent/provisioner (interfaces: AgentProvisionerService,KeyUpdaterService,ApplicationService,MachineService,ModelConfigService,UnitStateService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package provisioner -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/provisioner AgentProvisionerService,KeyUpdaterService,ApplicationService
+//	mockgen -typed -package provisioner -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/provisioner AgentProvisionerService,KeyUpdaterService,ApplicationService,MachineService,ModelConfigService,UnitStateService
 //
 
 // Package provisioner is a generated GoMock package.
@@ -13,10 +13,15 @@ import (
 	context "context"
 	reflect "reflect"
 
+	base "github.com/juju/juju/core/base"
+	constraints "github.com/juju/juju/core/constraints"
 	container "github.com/juju/juju/core/container"
 	containermanager "github.com/juju/juju/core/containermanager"
 	instance "github.com/juju/juju/core/instance"
+	machine "github.com/juju/juju/core/machine"
 	charm "github.com/juju/juju/domain/application/charm"
+	machine0 "github.com/juju/juju/domain/machine"
+	config "github.com/juju/juju/environs/config"
 	charm0 "github.com/juju/juju/internal/charm"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -324,3 +329,573 @@ func (c *MockApplicationServiceGetCharmLocatorByApplicationNameCall) DoAndReturn
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockMachineService is a mock of MachineService interface.
+type MockMachineService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMachineServiceMockRecorder
+}
+
+// MockMachineServiceMockRecorder is the mock recorder for MockMachineService.
+type MockMachineServiceMockRecorder struct {
+	mock *MockMachineService
+}
+
+// NewMockMachineService creates a new mock instance.
+func NewMockMachineService(ctrl *gomock.Controller) *MockMachineService {
+	mock := &MockMachineService{ctrl: ctrl}
+	mock.recorder = &MockMachineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMachineService) EXPECT() *MockMachineServiceMockRecorder {
+	return m.recorder
+}
+
+// AddWarmPoolMachine mocks base method.
+func (m *MockMachineService) AddWarmPoolMachine(arg0 context.Context, arg1 machine.Name, arg2 constraints.Value, arg3 base.Base) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWarmPoolMachine", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWarmPoolMachine indicates an expected call of AddWarmPoolMachine.
+func (mr *MockMachineServiceMockRecorder) AddWarmPoolMachine(arg0, arg1, arg2, arg3 any) *MockMachineServiceAddWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWarmPoolMachine", reflect.TypeOf((*MockMachineService)(nil).AddWarmPoolMachine), arg0, arg1, arg2, arg3)
+	return &MockMachineServiceAddWarmPoolMachineCall{Call: call}
+}
+
+// MockMachineServiceAddWarmPoolMachineCall wrap *gomock.Call
+type MockMachineServiceAddWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceAddWarmPoolMachineCall) Return(arg0 error) *MockMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceAddWarmPoolMachineCall) Do(f func(context.Context, machine.Name, constraints.Value, base.Base) error) *MockMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceAddWarmPoolMachineCall) DoAndReturn(f func(context.Context, machine.Name, constraints.Value, base.Base) error) *MockMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateMachine mocks base method.
+func (m *MockMachineService) CreateMachine(arg0 context.Context, arg1 machine.Name) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMachine", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMachine indicates an expected call of CreateMachine.
+func (mr *MockMachineServiceMockRecorder) CreateMachine(arg0, arg1 any) *MockMachineServiceCreateMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMachine", reflect.TypeOf((*MockMachineService)(nil).CreateMachine), arg0, arg1)
+	return &MockMachineServiceCreateMachineCall{Call: call}
+}
+
+// MockMachineServiceCreateMachineCall wrap *gomock.Call
+type MockMachineServiceCreateMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceCreateMachineCall) Return(arg0 string, arg1 error) *MockMachineServiceCreateMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceCreateMachineCall) Do(f func(context.Context, machine.Name) (string, error)) *MockMachineServiceCreateMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceCreateMachineCall) DoAndReturn(f func(context.Context, machine.Name) (string, error)) *MockMachineServiceCreateMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteMachineCloudInstance mocks base method.
+func (m *MockMachineService) DeleteMachineCloudInstance(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMachineCloudInstance", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMachineCloudInstance indicates an expected call of DeleteMachineCloudInstance.
+func (mr *MockMachineServiceMockRecorder) DeleteMachineCloudInstance(arg0, arg1 any) *MockMachineServiceDeleteMachineCloudInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMachineCloudInstance", reflect.TypeOf((*MockMachineService)(nil).DeleteMachineCloudInstance), arg0, arg1)
+	return &MockMachineServiceDeleteMachineCloudInstanceCall{Call: call}
+}
+
+// MockMachineServiceDeleteMachineCloudInstanceCall wrap *gomock.Call
+type MockMachineServiceDeleteMachineCloudInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceDeleteMachineCloudInstanceCall) Return(arg0 error) *MockMachineServiceDeleteMachineCloudInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceDeleteMachineCloudInstanceCall) Do(f func(context.Context, string) error) *MockMachineServiceDeleteMachineCloudInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceDeleteMachineCloudInstanceCall) DoAndReturn(f func(context.Context, string) error) *MockMachineServiceDeleteMachineCloudInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineUUID mocks base method.
+func (m *MockMachineService) GetMachineUUID(arg0 context.Context, arg1 machine.Name) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineUUID", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineUUID indicates an expected call of GetMachineUUID.
+func (mr *MockMachineServiceMockRecorder) GetMachineUUID(arg0, arg1 any) *MockMachineServiceGetMachineUUIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineUUID", reflect.TypeOf((*MockMachineService)(nil).GetMachineUUID), arg0, arg1)
+	return &MockMachineServiceGetMachineUUIDCall{Call: call}
+}
+
+// MockMachineServiceGetMachineUUIDCall wrap *gomock.Call
+type MockMachineServiceGetMachineUUIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceGetMachineUUIDCall) Return(arg0 string, arg1 error) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceGetMachineUUIDCall) Do(f func(context.Context, machine.Name) (string, error)) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceGetMachineUUIDCall) DoAndReturn(f func(context.Context, machine.Name) (string, error)) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// HardwareCharacteristics mocks base method.
+func (m *MockMachineService) HardwareCharacteristics(arg0 context.Context, arg1 string) (*instance.HardwareCharacteristics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardwareCharacteristics", arg0, arg1)
+	ret0, _ := ret[0].(*instance.HardwareCharacteristics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HardwareCharacteristics indicates an expected call of HardwareCharacteristics.
+func (mr *MockMachineServiceMockRecorder) HardwareCharacteristics(arg0, arg1 any) *MockMachineServiceHardwareCharacteristicsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardwareCharacteristics", reflect.TypeOf((*MockMachineService)(nil).HardwareCharacteristics), arg0, arg1)
+	return &MockMachineServiceHardwareCharacteristicsCall{Call: call}
+}
+
+// MockMachineServiceHardwareCharacteristicsCall wrap *gomock.Call
+type MockMachineServiceHardwareCharacteristicsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceHardwareCharacteristicsCall) Return(arg0 *instance.HardwareCharacteristics, arg1 error) *MockMachineServiceHardwareCharacteristicsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceHardwareCharacteristicsCall) Do(f func(context.Context, string) (*instance.HardwareCharacteristics, error)) *MockMachineServiceHardwareCharacteristicsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceHardwareCharacteristicsCall) DoAndReturn(f func(context.Context, string) (*instance.HardwareCharacteristics, error)) *MockMachineServiceHardwareCharacteristicsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InstanceID mocks base method.
+func (m *MockMachineService) InstanceID(arg0 context.Context, arg1 string) (instance.Id, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceID", arg0, arg1)
+	ret0, _ := ret[0].(instance.Id)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceID indicates an expected call of InstanceID.
+func (mr *MockMachineServiceMockRecorder) InstanceID(arg0, arg1 any) *MockMachineServiceInstanceIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceID", reflect.TypeOf((*MockMachineService)(nil).InstanceID), arg0, arg1)
+	return &MockMachineServiceInstanceIDCall{Call: call}
+}
+
+// MockMachineServiceInstanceIDCall wrap *gomock.Call
+type MockMachineServiceInstanceIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceInstanceIDCall) Return(arg0 instance.Id, arg1 error) *MockMachineServiceInstanceIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceInstanceIDCall) Do(f func(context.Context, string) (instance.Id, error)) *MockMachineServiceInstanceIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceInstanceIDCall) DoAndReturn(f func(context.Context, string) (instance.Id, error)) *MockMachineServiceInstanceIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReconcileWarmPool mocks base method.
+func (m *MockMachineService) ReconcileWarmPool(arg0 context.Context, arg1 []machine0.WarmPoolEntry, arg2 base.Base) (machine0.WarmPoolReconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWarmPool", arg0, arg1, arg2)
+	ret0, _ := ret[0].(machine0.WarmPoolReconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileWarmPool indicates an expected call of ReconcileWarmPool.
+func (mr *MockMachineServiceMockRecorder) ReconcileWarmPool(arg0, arg1, arg2 any) *MockMachineServiceReconcileWarmPoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWarmPool", reflect.TypeOf((*MockMachineService)(nil).ReconcileWarmPool), arg0, arg1, arg2)
+	return &MockMachineServiceReconcileWarmPoolCall{Call: call}
+}
+
+// MockMachineServiceReconcileWarmPoolCall wrap *gomock.Call
+type MockMachineServiceReconcileWarmPoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceReconcileWarmPoolCall) Return(arg0 machine0.WarmPoolReconciliation, arg1 error) *MockMachineServiceReconcileWarmPoolCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceReconcileWarmPoolCall) Do(f func(context.Context, []machine0.WarmPoolEntry, base.Base) (machine0.WarmPoolReconciliation, error)) *MockMachineServiceReconcileWarmPoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceReconcileWarmPoolCall) DoAndReturn(f func(context.Context, []machine0.WarmPoolEntry, base.Base) (machine0.WarmPoolReconciliation, error)) *MockMachineServiceReconcileWarmPoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetAppliedLXDProfileNames mocks base method.
+func (m *MockMachineService) SetAppliedLXDProfileNames(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAppliedLXDProfileNames", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAppliedLXDProfileNames indicates an expected call of SetAppliedLXDProfileNames.
+func (mr *MockMachineServiceMockRecorder) SetAppliedLXDProfileNames(arg0, arg1, arg2 any) *MockMachineServiceSetAppliedLXDProfileNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAppliedLXDProfileNames", reflect.TypeOf((*MockMachineService)(nil).SetAppliedLXDProfileNames), arg0, arg1, arg2)
+	return &MockMachineServiceSetAppliedLXDProfileNamesCall{Call: call}
+}
+
+// MockMachineServiceSetAppliedLXDProfileNamesCall wrap *gomock.Call
+type MockMachineServiceSetAppliedLXDProfileNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceSetAppliedLXDProfileNamesCall) Return(arg0 error) *MockMachineServiceSetAppliedLXDProfileNamesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceSetAppliedLXDProfileNamesCall) Do(f func(context.Context, string, []string) error) *MockMachineServiceSetAppliedLXDProfileNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceSetAppliedLXDProfileNamesCall) DoAndReturn(f func(context.Context, string, []string) error) *MockMachineServiceSetAppliedLXDProfileNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetKeepInstance mocks base method.
+func (m *MockMachineService) SetKeepInstance(arg0 context.Context, arg1 machine.Name, arg2 bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKeepInstance", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKeepInstance indicates an expected call of SetKeepInstance.
+func (mr *MockMachineServiceMockRecorder) SetKeepInstance(arg0, arg1, arg2 any) *MockMachineServiceSetKeepInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKeepInstance", reflect.TypeOf((*MockMachineService)(nil).SetKeepInstance), arg0, arg1, arg2)
+	return &MockMachineServiceSetKeepInstanceCall{Call: call}
+}
+
+// MockMachineServiceSetKeepInstanceCall wrap *gomock.Call
+type MockMachineServiceSetKeepInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceSetKeepInstanceCall) Return(arg0 error) *MockMachineServiceSetKeepInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceSetKeepInstanceCall) Do(f func(context.Context, machine.Name, bool) error) *MockMachineServiceSetKeepInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceSetKeepInstanceCall) DoAndReturn(f func(context.Context, machine.Name, bool) error) *MockMachineServiceSetKeepInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetMachineCloudInstance mocks base method.
+func (m *MockMachineService) SetMachineCloudInstance(arg0 context.Context, arg1 string, arg2 instance.Id, arg3 string, arg4 *instance.HardwareCharacteristics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMachineCloudInstance", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMachineCloudInstance indicates an expected call of SetMachineCloudInstance.
+func (mr *MockMachineServiceMockRecorder) SetMachineCloudInstance(arg0, arg1, arg2, arg3, arg4 any) *MockMachineServiceSetMachineCloudInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMachineCloudInstance", reflect.TypeOf((*MockMachineService)(nil).SetMachineCloudInstance), arg0, arg1, arg2, arg3, arg4)
+	return &MockMachineServiceSetMachineCloudInstanceCall{Call: call}
+}
+
+// MockMachineServiceSetMachineCloudInstanceCall wrap *gomock.Call
+type MockMachineServiceSetMachineCloudInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceSetMachineCloudInstanceCall) Return(arg0 error) *MockMachineServiceSetMachineCloudInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceSetMachineCloudInstanceCall) Do(f func(context.Context, string, instance.Id, string, *instance.HardwareCharacteristics) error) *MockMachineServiceSetMachineCloudInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceSetMachineCloudInstanceCall) DoAndReturn(f func(context.Context, string, instance.Id, string, *instance.HardwareCharacteristics) error) *MockMachineServiceSetMachineCloudInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShouldKeepInstance mocks base method.
+func (m *MockMachineService) ShouldKeepInstance(arg0 context.Context, arg1 machine.Name) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShouldKeepInstance", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShouldKeepInstance indicates an expected call of ShouldKeepInstance.
+func (mr *MockMachineServiceMockRecorder) ShouldKeepInstance(arg0, arg1 any) *MockMachineServiceShouldKeepInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShouldKeepInstance", reflect.TypeOf((*MockMachineService)(nil).ShouldKeepInstance), arg0, arg1)
+	return &MockMachineServiceShouldKeepInstanceCall{Call: call}
+}
+
+// MockMachineServiceShouldKeepInstanceCall wrap *gomock.Call
+type MockMachineServiceShouldKeepInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceShouldKeepInstanceCall) Return(arg0 bool, arg1 error) *MockMachineServiceShouldKeepInstanceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceShouldKeepInstanceCall) Do(f func(context.Context, machine.Name) (bool, error)) *MockMachineServiceShouldKeepInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceShouldKeepInstanceCall) DoAndReturn(f func(context.Context, machine.Name) (bool, error)) *MockMachineServiceShouldKeepInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockUnitStateService is a mock of UnitStateService interface.
+type MockUnitStateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitStateServiceMockRecorder
+}
+
+// MockUnitStateServiceMockRecorder is the mock recorder for MockUnitStateService.
+type MockUnitStateServiceMockRecorder struct {
+	mock *MockUnitStateService
+}
+
+// NewMockUnitStateService creates a new mock instance.
+func NewMockUnitStateService(ctrl *gomock.Controller) *MockUnitStateService {
+	mock := &MockUnitStateService{ctrl: ctrl}
+	mock.recorder = &MockUnitStateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitStateService) EXPECT() *MockUnitStateServiceMockRecorder {
+	return m.recorder
+}
+
+// ResetMachineUnitStates mocks base method.
+func (m *MockUnitStateService) ResetMachineUnitStates(arg0 context.Context, arg1 machine.Name) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetMachineUnitStates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetMachineUnitStates indicates an expected call of ResetMachineUnitStates.
+func (mr *MockUnitStateServiceMockRecorder) ResetMachineUnitStates(arg0, arg1 any) *MockUnitStateServiceResetMachineUnitStatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetMachineUnitStates", reflect.TypeOf((*MockUnitStateService)(nil).ResetMachineUnitStates), arg0, arg1)
+	return &MockUnitStateServiceResetMachineUnitStatesCall{Call: call}
+}
+
+// MockUnitStateServiceResetMachineUnitStatesCall wrap *gomock.Call
+type MockUnitStateServiceResetMachineUnitStatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUnitStateServiceResetMachineUnitStatesCall) Return(arg0 error) *MockUnitStateServiceResetMachineUnitStatesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUnitStateServiceResetMachineUnitStatesCall) Do(f func(context.Context, machine.Name) error) *MockUnitStateServiceResetMachineUnitStatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUnitStateServiceResetMachineUnitStatesCall) DoAndReturn(f func(context.Context, machine.Name) error) *MockUnitStateServiceResetMachineUnitStatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -6,6 +6,7 @@ package provisioner
 import (
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/internal/network/containerizer"
 	"github.com/juju/juju/state"
 )
@@ -100,3 +101,32 @@ type applicationShim struct {
 }
 
 var _ Application = (*applicationShim)(nil)
+
+// warmPoolStateShim implements WarmPoolState.
+type warmPoolStateShim struct {
+	*state.State
+}
+
+var _ WarmPoolState = warmPoolStateShim{}
+
+// AddMachine implements WarmPoolState.
+func (st warmPoolStateShim) AddMachine(base state.Base, cons constraints.Value) (string, error) {
+	m, err := st.AddOneMachine(state.MachineTemplate{
+		Base:        base,
+		Constraints: cons,
+		Jobs:        []state.MachineJob{state.JobHostUnits},
+	})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return m.Id(), nil
+}
+
+// DestroyMachine implements WarmPoolState.
+func (st warmPoolStateShim) DestroyMachine(id string) error {
+	m, err := st.Machine(id)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(m.Destroy(nil))
+}
@@ -19,6 +19,7 @@ type Backend interface {
 	WatchMachineFilesystemAttachments(names.MachineTag) state.StringsWatcher
 	WatchUnitFilesystemAttachments(names.ApplicationTag) state.StringsWatcher
 	WatchModelFilesystems() state.StringsWatcher
+	WatchModelFilesystemResizes() state.StringsWatcher
 	WatchModelFilesystemAttachments() state.StringsWatcher
 	WatchModelVolumeAttachments() state.StringsWatcher
 }
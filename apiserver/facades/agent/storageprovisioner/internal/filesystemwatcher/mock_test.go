@@ -17,6 +17,7 @@ type mockBackend struct {
 	machineFilesystemAttachmentsW *watchertest.StringsWatcher
 	unitFilesystemAttachmentsW    *watchertest.StringsWatcher
 	modelFilesystemsW             *watchertest.StringsWatcher
+	modelFilesystemResizesW       *watchertest.StringsWatcher
 	modelFilesystemAttachmentsW   *watchertest.StringsWatcher
 	modelVolumeAttachmentsW       *watchertest.StringsWatcher
 
@@ -74,6 +75,10 @@ func (b *mockBackend) WatchModelFilesystems() state.StringsWatcher {
 	return b.modelFilesystemsW
 }
 
+func (b *mockBackend) WatchModelFilesystemResizes() state.StringsWatcher {
+	return b.modelFilesystemResizesW
+}
+
 func (b *mockBackend) WatchModelFilesystemAttachments() state.StringsWatcher {
 	return b.modelFilesystemAttachmentsW
 }
@@ -27,18 +27,58 @@ type Watchers struct {
 // model-scoped filesystems that have no backing volume. Volume-backed
 // filesystems are always managed by the host to which they are attached.
 func (fw Watchers) WatchModelManagedFilesystems() state.StringsWatcher {
-	return newFilteredStringsWatcher(fw.Backend.WatchModelFilesystems(), func(id string) (bool, error) {
+	return newFilteredStringsWatcher(fw.Backend.WatchModelFilesystems(), fw.modelManagedFilesystem)
+}
+
+// WatchModelManagedFilesystemResizes returns a strings watcher that reports
+// changes to model-scoped filesystems that have no backing volume, which
+// may have resizes requested.
+func (fw Watchers) WatchModelManagedFilesystemResizes() state.StringsWatcher {
+	return newFilteredStringsWatcher(fw.Backend.WatchModelFilesystemResizes(), fw.modelManagedFilesystem)
+}
+
+// WatchMachineManagedFilesystemResizes returns a strings watcher that
+// reports changes to model-scoped, volume-backed filesystems whose backing
+// volumes are attached to the specified machine, which may have resizes
+// requested. The filesystem on a volume is grown by the machine once the
+// volume has been resized.
+func (fw Watchers) WatchMachineManagedFilesystemResizes(m names.MachineTag) state.StringsWatcher {
+	return newFilteredStringsWatcher(fw.Backend.WatchModelFilesystemResizes(), func(id string) (bool, error) {
 		f, err := fw.Backend.Filesystem(names.NewFilesystemTag(id))
 		if errors.Is(err, errors.NotFound) {
 			return false, nil
 		} else if err != nil {
 			return false, errors.Trace(err)
 		}
-		_, err = f.Volume()
-		return err == state.ErrNoBackingVolume, nil
+		volumeTag, err := f.Volume()
+		if err == state.ErrNoBackingVolume {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Trace(err)
+		}
+		va, err := fw.Backend.VolumeAttachment(m, volumeTag)
+		if errors.Is(err, errors.NotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, errors.Trace(err)
+		}
+		return va.Life() != state.Dead, nil
 	})
 }
 
+// modelManagedFilesystem reports whether the model-scoped filesystem with
+// the specified ID exists and has no backing volume.
+func (fw Watchers) modelManagedFilesystem(id string) (bool, error) {
+	f, err := fw.Backend.Filesystem(names.NewFilesystemTag(id))
+	if errors.Is(err, errors.NotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	_, err = f.Volume()
+	return err == state.ErrNoBackingVolume, nil
+}
+
 // WatchUnitManagedFilesystems returns a strings watcher that reports both
 // unit-scoped filesystems, and model-scoped, volume-backed filesystems
 // that are attached to units of the specified application.
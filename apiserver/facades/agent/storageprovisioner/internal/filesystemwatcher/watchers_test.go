@@ -32,6 +32,7 @@ func (s *WatchersSuite) SetUpTest(c *gc.C) {
 		machineFilesystemAttachmentsW: newStringsWatcher(),
 		unitFilesystemAttachmentsW:    newStringsWatcher(),
 		modelFilesystemsW:             newStringsWatcher(),
+		modelFilesystemResizesW:       newStringsWatcher(),
 		modelFilesystemAttachmentsW:   newStringsWatcher(),
 		modelVolumeAttachmentsW:       newStringsWatcher(),
 		filesystems: map[string]*mockFilesystem{
@@ -52,6 +53,7 @@ func (s *WatchersSuite) SetUpTest(c *gc.C) {
 		s.backend.machineFilesystemsW.Stop()
 		s.backend.machineFilesystemAttachmentsW.Stop()
 		s.backend.modelFilesystemsW.Stop()
+		s.backend.modelFilesystemResizesW.Stop()
 		s.backend.modelFilesystemAttachmentsW.Stop()
 		s.backend.modelVolumeAttachmentsW.Stop()
 	})
@@ -75,6 +77,36 @@ func (s *WatchersSuite) TestWatchModelManagedFilesystemsWatcherErrorsPropagate(c
 	c.Assert(w.Wait(), gc.ErrorMatches, "rah")
 }
 
+func (s *WatchersSuite) TestWatchModelManagedFilesystemResizes(c *gc.C) {
+	w := s.watchers.WatchModelManagedFilesystemResizes()
+	defer workertest.CleanKill(c, w)
+	s.backend.modelFilesystemResizesW.C <- []string{"0", "1"}
+
+	// Filesystem 1 has a backing volume, so should not be reported.
+	wc := watchertest.NewStringsWatcherC(c, w)
+	wc.AssertChangeInSingleEvent("0")
+	wc.AssertNoChange()
+}
+
+func (s *WatchersSuite) TestWatchMachineManagedFilesystemResizes(c *gc.C) {
+	s.backend.volumeAttachments["2"].life = state.Dead
+	w := s.watchers.WatchMachineManagedFilesystemResizes(names.NewMachineTag("0"))
+	defer workertest.CleanKill(c, w)
+	s.backend.modelFilesystemResizesW.C <- []string{"0", "1", "2"}
+
+	// Filesystem 0 has no backing volume, and the attachment of the
+	// volume backing filesystem 2 is dead, so neither is reported.
+	wc := watchertest.NewStringsWatcherC(c, w)
+	wc.AssertChangeInSingleEvent("1")
+	wc.AssertNoChange()
+}
+
+func (s *WatchersSuite) TestWatchMachineManagedFilesystemResizesErrorsPropagate(c *gc.C) {
+	w := s.watchers.WatchMachineManagedFilesystemResizes(names.NewMachineTag("0"))
+	s.backend.modelFilesystemResizesW.T.Kill(errors.New("rah"))
+	c.Assert(w.Wait(), gc.ErrorMatches, "rah")
+}
+
 func (s *WatchersSuite) TestWatchModelManagedFilesystemAttachments(c *gc.C) {
 	w := s.watchers.WatchModelManagedFilesystemAttachments()
 	defer workertest.CleanKill(c, w)
@@ -17,10 +17,22 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("StorageProvisioner", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV4(stdCtx, ctx)
 	}, reflect.TypeOf((*StorageProvisionerAPIv4)(nil)))
+	registry.MustRegister("StorageProvisioner", 5, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacade(stdCtx, ctx)
+	}, reflect.TypeOf((*StorageProvisionerAPI)(nil)))
 }
 
 // newFacadeV4 provides the signature required for facade registration.
 func newFacadeV4(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvisionerAPIv4, error) {
+	api, err := newFacade(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageProvisionerAPIv4{StorageProvisionerAPI: api}, nil
+}
+
+// newFacade provides the signature required for facade registration.
+func newFacade(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvisionerAPI, error) {
 	st := ctx.State()
 
 	domainServices := ctx.DomainServices()
@@ -41,7 +53,7 @@ func newFacadeV4(stdCtx context.Context, ctx facade.ModelContext) (*StorageProvi
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return NewStorageProvisionerAPIv4(
+	return NewStorageProvisionerAPI(
 		stdCtx,
 		ctx.WatcherRegistry(),
 		ctx.Clock(),
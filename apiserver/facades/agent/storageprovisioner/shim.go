@@ -38,6 +38,8 @@ type StorageBackend interface {
 	WatchUnitVolumeAttachments(tag names.ApplicationTag) state.StringsWatcher
 	WatchVolumeAttachment(names.Tag, names.VolumeTag) state.NotifyWatcher
 	WatchMachineAttachmentsPlans(names.MachineTag) state.StringsWatcher
+	WatchModelVolumeResizes() state.StringsWatcher
+	WatchModelFilesystemResizes() state.StringsWatcher
 
 	StorageInstance(names.StorageTag) (state.StorageInstance, error)
 	AllStorageInstances() ([]state.StorageInstance, error)
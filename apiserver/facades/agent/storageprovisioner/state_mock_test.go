@@ -232,6 +232,45 @@ func (c *MockFilesystemAttachmentParamsCall) DoAndReturn(f func() (state.Filesys
 	return c
 }
 
+// Usage mocks base method.
+func (m *MockFilesystemAttachment) Usage() (state.FilesystemUsage, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Usage")
+	ret0, _ := ret[0].(state.FilesystemUsage)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Usage indicates an expected call of Usage.
+func (mr *MockFilesystemAttachmentMockRecorder) Usage() *MockFilesystemAttachmentUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Usage", reflect.TypeOf((*MockFilesystemAttachment)(nil).Usage))
+	return &MockFilesystemAttachmentUsageCall{Call: call}
+}
+
+// MockFilesystemAttachmentUsageCall wrap *gomock.Call
+type MockFilesystemAttachmentUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFilesystemAttachmentUsageCall) Return(arg0 state.FilesystemUsage, arg1 bool) *MockFilesystemAttachmentUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFilesystemAttachmentUsageCall) Do(f func() (state.FilesystemUsage, bool)) *MockFilesystemAttachmentUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFilesystemAttachmentUsageCall) DoAndReturn(f func() (state.FilesystemUsage, bool)) *MockFilesystemAttachmentUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockVolumeAttachment is a mock of VolumeAttachment interface.
 type MockVolumeAttachment struct {
 	ctrl     *gomock.Controller
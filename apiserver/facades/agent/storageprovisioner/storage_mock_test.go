@@ -1344,6 +1344,44 @@ func (c *MockStorageBackendWatchModelFilesystemAttachmentsCall) DoAndReturn(f fu
 	return c
 }
 
+// WatchModelFilesystemResizes mocks base method.
+func (m *MockStorageBackend) WatchModelFilesystemResizes() state.StringsWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModelFilesystemResizes")
+	ret0, _ := ret[0].(state.StringsWatcher)
+	return ret0
+}
+
+// WatchModelFilesystemResizes indicates an expected call of WatchModelFilesystemResizes.
+func (mr *MockStorageBackendMockRecorder) WatchModelFilesystemResizes() *MockStorageBackendWatchModelFilesystemResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModelFilesystemResizes", reflect.TypeOf((*MockStorageBackend)(nil).WatchModelFilesystemResizes))
+	return &MockStorageBackendWatchModelFilesystemResizesCall{Call: call}
+}
+
+// MockStorageBackendWatchModelFilesystemResizesCall wrap *gomock.Call
+type MockStorageBackendWatchModelFilesystemResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageBackendWatchModelFilesystemResizesCall) Return(arg0 state.StringsWatcher) *MockStorageBackendWatchModelFilesystemResizesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageBackendWatchModelFilesystemResizesCall) Do(f func() state.StringsWatcher) *MockStorageBackendWatchModelFilesystemResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageBackendWatchModelFilesystemResizesCall) DoAndReturn(f func() state.StringsWatcher) *MockStorageBackendWatchModelFilesystemResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchModelFilesystems mocks base method.
 func (m *MockStorageBackend) WatchModelFilesystems() state.StringsWatcher {
 	m.ctrl.T.Helper()
@@ -1420,6 +1458,44 @@ func (c *MockStorageBackendWatchModelVolumeAttachmentsCall) DoAndReturn(f func()
 	return c
 }
 
+// WatchModelVolumeResizes mocks base method.
+func (m *MockStorageBackend) WatchModelVolumeResizes() state.StringsWatcher {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModelVolumeResizes")
+	ret0, _ := ret[0].(state.StringsWatcher)
+	return ret0
+}
+
+// WatchModelVolumeResizes indicates an expected call of WatchModelVolumeResizes.
+func (mr *MockStorageBackendMockRecorder) WatchModelVolumeResizes() *MockStorageBackendWatchModelVolumeResizesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModelVolumeResizes", reflect.TypeOf((*MockStorageBackend)(nil).WatchModelVolumeResizes))
+	return &MockStorageBackendWatchModelVolumeResizesCall{Call: call}
+}
+
+// MockStorageBackendWatchModelVolumeResizesCall wrap *gomock.Call
+type MockStorageBackendWatchModelVolumeResizesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageBackendWatchModelVolumeResizesCall) Return(arg0 state.StringsWatcher) *MockStorageBackendWatchModelVolumeResizesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageBackendWatchModelVolumeResizesCall) Do(f func() state.StringsWatcher) *MockStorageBackendWatchModelVolumeResizesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageBackendWatchModelVolumeResizesCall) DoAndReturn(f func() state.StringsWatcher) *MockStorageBackendWatchModelVolumeResizesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchModelVolumes mocks base method.
 func (m *MockStorageBackend) WatchModelVolumes() state.StringsWatcher {
 	m.ctrl.T.Helper()
@@ -31,6 +31,11 @@ import (
 
 // StorageProvisionerAPIv4 provides the StorageProvisioner API v4 facade.
 type StorageProvisionerAPIv4 struct {
+	*StorageProvisionerAPI
+}
+
+// StorageProvisionerAPI provides the StorageProvisioner API v5 facade.
+type StorageProvisionerAPI struct {
 	*common.LifeGetter
 	*common.DeadEnsurer
 	*common.InstanceIdGetter
@@ -58,8 +63,8 @@ type StorageProvisionerAPIv4 struct {
 	modelUUID      model.UUID
 }
 
-// NewStorageProvisionerAPIv4 creates a new server-side StorageProvisioner v3 facade.
-func NewStorageProvisionerAPIv4(
+// NewStorageProvisionerAPI creates a new server-side StorageProvisioner facade.
+func NewStorageProvisionerAPI(
 	ctx context.Context,
 	watcherRegistry facade.WatcherRegistry,
 	clock clock.Clock,
@@ -75,7 +80,7 @@ func NewStorageProvisionerAPIv4(
 	logger logger.Logger,
 	modelUUID model.UUID,
 	controllerUUID string,
-) (*StorageProvisionerAPIv4, error) {
+) (*StorageProvisionerAPI, error) {
 	if !authorizer.AuthMachineAgent() {
 		return nil, apiservererrors.ErrPerm
 	}
@@ -223,7 +228,7 @@ func NewStorageProvisionerAPIv4(
 			return false
 		}, nil
 	}
-	return &StorageProvisionerAPIv4{
+	return &StorageProvisionerAPI{
 		LifeGetter:       common.NewLifeGetter(st, getLifeAuthFunc),
 		DeadEnsurer:      common.NewDeadEnsurer(st, getStorageEntityAuthFunc, machineService),
 		InstanceIdGetter: common.NewInstanceIdGetter(machineService, getMachineAuthFunc),
@@ -254,7 +259,7 @@ func NewStorageProvisionerAPIv4(
 
 // WatchApplications starts a StringsWatcher to watch CAAS applications
 // deployed to this model.
-func (s *StorageProvisionerAPIv4) WatchApplications(ctx context.Context) (params.StringsWatchResult, error) {
+func (s *StorageProvisionerAPI) WatchApplications(ctx context.Context) (params.StringsWatchResult, error) {
 	watch := s.st.WatchApplications()
 	if changes, ok := <-watch.Changes(); ok {
 		return params.StringsWatchResult{
@@ -266,7 +271,7 @@ func (s *StorageProvisionerAPIv4) WatchApplications(ctx context.Context) (params
 }
 
 // WatchBlockDevices watches for changes to the specified machines' block devices.
-func (s *StorageProvisionerAPIv4) WatchBlockDevices(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
+func (s *StorageProvisionerAPI) WatchBlockDevices(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
 	canAccess, err := s.getBlockDevicesAuthFunc()
 	if err != nil {
 		return params.NotifyWatchResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -303,7 +308,7 @@ func (s *StorageProvisionerAPIv4) WatchBlockDevices(ctx context.Context, args pa
 }
 
 // WatchMachines watches for changes to the specified machines.
-func (s *StorageProvisionerAPIv4) WatchMachines(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
+func (s *StorageProvisionerAPI) WatchMachines(ctx context.Context, args params.Entities) (params.NotifyWatchResults, error) {
 	canAccess, err := s.getMachineAuthFunc()
 	if err != nil {
 		return params.NotifyWatchResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -343,13 +348,13 @@ func (s *StorageProvisionerAPIv4) WatchMachines(ctx context.Context, args params
 
 // WatchVolumes watches for changes to volumes scoped to the
 // entity with the tag passed to NewState.
-func (s *StorageProvisionerAPIv4) WatchVolumes(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
+func (s *StorageProvisionerAPI) WatchVolumes(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
 	return s.watchStorageEntities(args, s.sb.WatchModelVolumes, s.sb.WatchMachineVolumes, nil)
 }
 
 // WatchFilesystems watches for changes to filesystems scoped
 // to the entity with the tag passed to NewState.
-func (s *StorageProvisionerAPIv4) WatchFilesystems(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
+func (s *StorageProvisionerAPI) WatchFilesystems(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
 	w := filesystemwatcher.Watchers{Backend: s.sb}
 	return s.watchStorageEntities(args,
 		w.WatchModelManagedFilesystems,
@@ -357,7 +362,25 @@ func (s *StorageProvisionerAPIv4) WatchFilesystems(ctx context.Context, args par
 		w.WatchUnitManagedFilesystems)
 }
 
-func (s *StorageProvisionerAPIv4) watchStorageEntities(
+// WatchVolumeResizes watches for changes to volumes scoped to the model,
+// which may have resizes requested. Only the model may be watched.
+func (s *StorageProvisionerAPI) WatchVolumeResizes(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
+	return s.watchStorageEntities(args, s.sb.WatchModelVolumeResizes, nil, nil)
+}
+
+// WatchFilesystemResizes watches for changes to filesystems managed by the
+// entity with the tag passed to NewState, which may have resizes requested.
+// The model manages filesystems without a backing volume, and a machine
+// grows the filesystems on the volumes attached to it.
+func (s *StorageProvisionerAPI) WatchFilesystemResizes(ctx context.Context, args params.Entities) (params.StringsWatchResults, error) {
+	w := filesystemwatcher.Watchers{Backend: s.sb}
+	return s.watchStorageEntities(args,
+		w.WatchModelManagedFilesystemResizes,
+		w.WatchMachineManagedFilesystemResizes,
+		nil)
+}
+
+func (s *StorageProvisionerAPI) watchStorageEntities(
 	args params.Entities,
 	watchEnvironStorage func() state.StringsWatcher,
 	watchMachineStorage func(names.MachineTag) state.StringsWatcher,
@@ -378,10 +401,16 @@ func (s *StorageProvisionerAPIv4) watchStorageEntities(
 		var w state.StringsWatcher
 		switch tag := tag.(type) {
 		case names.MachineTag:
+			if watchMachineStorage == nil {
+				return "", nil, errors.NotSupportedf("watching storage for %v", tag)
+			}
 			w = watchMachineStorage(tag)
 		case names.ModelTag:
 			w = watchEnvironStorage()
 		case names.ApplicationTag:
+			if watchApplicationStorage == nil {
+				return "", nil, errors.NotSupportedf("watching storage for %v", tag)
+			}
 			w = watchApplicationStorage(tag)
 		default:
 			return "", nil, apiservererrors.ServerError(errors.NotSupportedf("watching storage for %v", tag))
@@ -408,7 +437,7 @@ func (s *StorageProvisionerAPIv4) watchStorageEntities(
 
 // WatchVolumeAttachments watches for changes to volume attachments scoped to
 // the entity with the tag passed to NewState.
-func (s *StorageProvisionerAPIv4) WatchVolumeAttachments(ctx context.Context, args params.Entities) (params.MachineStorageIdsWatchResults, error) {
+func (s *StorageProvisionerAPI) WatchVolumeAttachments(ctx context.Context, args params.Entities) (params.MachineStorageIdsWatchResults, error) {
 	return s.watchAttachments(
 		args,
 		s.sb.WatchModelVolumeAttachments,
@@ -420,7 +449,7 @@ func (s *StorageProvisionerAPIv4) WatchVolumeAttachments(ctx context.Context, ar
 
 // WatchFilesystemAttachments watches for changes to filesystem attachments
 // scoped to the entity with the tag passed to NewState.
-func (s *StorageProvisionerAPIv4) WatchFilesystemAttachments(ctx context.Context, args params.Entities) (params.MachineStorageIdsWatchResults, error) {
+func (s *StorageProvisionerAPI) WatchFilesystemAttachments(ctx context.Context, args params.Entities) (params.MachineStorageIdsWatchResults, error) {
 	w := filesystemwatcher.Watchers{Backend: s.sb}
 	return s.watchAttachments(
 		args,
@@ -433,7 +462,7 @@ func (s *StorageProvisionerAPIv4) WatchFilesystemAttachments(ctx context.Context
 
 // WatchVolumeAttachmentPlans watches for changes to volume attachments for a machine for the purpose of allowing
 // that machine to run any initialization needed, for that volume to actually appear as a block device (ie: iSCSI)
-func (s *StorageProvisionerAPIv4) WatchVolumeAttachmentPlans(ctx context.Context, args params.Entities) (params.MachineStorageIdsWatchResults, error) {
+func (s *StorageProvisionerAPI) WatchVolumeAttachmentPlans(ctx context.Context, args params.Entities) (params.MachineStorageIdsWatchResults, error) {
 	canAccess, err := s.getMachineAuthFunc()
 	if err != nil {
 		return params.MachineStorageIdsWatchResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -476,7 +505,7 @@ func (s *StorageProvisionerAPIv4) WatchVolumeAttachmentPlans(ctx context.Context
 	return results, nil
 }
 
-func (s *StorageProvisionerAPIv4) RemoveVolumeAttachmentPlan(ctx context.Context, args params.MachineStorageIds) (params.ErrorResults, error) {
+func (s *StorageProvisionerAPI) RemoveVolumeAttachmentPlan(ctx context.Context, args params.MachineStorageIds) (params.ErrorResults, error) {
 	canAccess, err := s.getMachineAuthFunc()
 	if err != nil {
 		return params.ErrorResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -508,7 +537,7 @@ func (s *StorageProvisionerAPIv4) RemoveVolumeAttachmentPlan(ctx context.Context
 	return results, nil
 }
 
-func (s *StorageProvisionerAPIv4) watchAttachments(
+func (s *StorageProvisionerAPI) watchAttachments(
 	args params.Entities,
 	watchEnvironAttachments func() state.StringsWatcher,
 	watchMachineAttachments func(names.MachineTag) state.StringsWatcher,
@@ -561,7 +590,7 @@ func (s *StorageProvisionerAPIv4) watchAttachments(
 }
 
 // Volumes returns details of volumes with the specified tags.
-func (s *StorageProvisionerAPIv4) Volumes(ctx context.Context, args params.Entities) (params.VolumeResults, error) {
+func (s *StorageProvisionerAPI) Volumes(ctx context.Context, args params.Entities) (params.VolumeResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.VolumeResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -596,7 +625,7 @@ func (s *StorageProvisionerAPIv4) Volumes(ctx context.Context, args params.Entit
 }
 
 // Filesystems returns details of filesystems with the specified tags.
-func (s *StorageProvisionerAPIv4) Filesystems(ctx context.Context, args params.Entities) (params.FilesystemResults, error) {
+func (s *StorageProvisionerAPI) Filesystems(ctx context.Context, args params.Entities) (params.FilesystemResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.FilesystemResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -631,7 +660,7 @@ func (s *StorageProvisionerAPIv4) Filesystems(ctx context.Context, args params.E
 }
 
 // VolumeAttachmentPlans returns details of volume attachment plans with the specified IDs.
-func (s *StorageProvisionerAPIv4) VolumeAttachmentPlans(ctx context.Context, args params.MachineStorageIds) (params.VolumeAttachmentPlanResults, error) {
+func (s *StorageProvisionerAPI) VolumeAttachmentPlans(ctx context.Context, args params.MachineStorageIds) (params.VolumeAttachmentPlanResults, error) {
 	// NOTE(gsamfira): Containers will probably not be a concern for this at the moment
 	// revisit this if containers should be treated
 	canAccess, err := s.getMachineAuthFunc()
@@ -662,7 +691,7 @@ func (s *StorageProvisionerAPIv4) VolumeAttachmentPlans(ctx context.Context, arg
 }
 
 // VolumeAttachments returns details of volume attachments with the specified IDs.
-func (s *StorageProvisionerAPIv4) VolumeAttachments(ctx context.Context, args params.MachineStorageIds) (params.VolumeAttachmentResults, error) {
+func (s *StorageProvisionerAPI) VolumeAttachments(ctx context.Context, args params.MachineStorageIds) (params.VolumeAttachmentResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc()
 	if err != nil {
 		return params.VolumeAttachmentResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -692,7 +721,7 @@ func (s *StorageProvisionerAPIv4) VolumeAttachments(ctx context.Context, args pa
 
 // VolumeBlockDevices returns details of the block devices corresponding to the
 // volume attachments with the specified IDs.
-func (s *StorageProvisionerAPIv4) VolumeBlockDevices(ctx context.Context, args params.MachineStorageIds) (params.BlockDeviceResults, error) {
+func (s *StorageProvisionerAPI) VolumeBlockDevices(ctx context.Context, args params.MachineStorageIds) (params.BlockDeviceResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc()
 	if err != nil {
 		return params.BlockDeviceResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -714,7 +743,7 @@ func (s *StorageProvisionerAPIv4) VolumeBlockDevices(ctx context.Context, args p
 }
 
 // FilesystemAttachments returns details of filesystem attachments with the specified IDs.
-func (s *StorageProvisionerAPIv4) FilesystemAttachments(ctx context.Context, args params.MachineStorageIds) (params.FilesystemAttachmentResults, error) {
+func (s *StorageProvisionerAPI) FilesystemAttachments(ctx context.Context, args params.MachineStorageIds) (params.FilesystemAttachmentResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc()
 	if err != nil {
 		return params.FilesystemAttachmentResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -744,7 +773,7 @@ func (s *StorageProvisionerAPIv4) FilesystemAttachments(ctx context.Context, arg
 
 // VolumeParams returns the parameters for creating or destroying
 // the volumes with the specified tags.
-func (s *StorageProvisionerAPIv4) VolumeParams(ctx context.Context, args params.Entities) (params.VolumeParamsResults, error) {
+func (s *StorageProvisionerAPI) VolumeParams(ctx context.Context, args params.Entities) (params.VolumeParamsResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.VolumeParamsResults{}, err
@@ -838,7 +867,7 @@ func (s *StorageProvisionerAPIv4) VolumeParams(ctx context.Context, args params.
 
 // RemoveVolumeParams returns the parameters for destroying
 // or releasing the volumes with the specified tags.
-func (s *StorageProvisionerAPIv4) RemoveVolumeParams(ctx context.Context, args params.Entities) (params.RemoveVolumeParamsResults, error) {
+func (s *StorageProvisionerAPI) RemoveVolumeParams(ctx context.Context, args params.Entities) (params.RemoveVolumeParamsResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.RemoveVolumeParamsResults{}, err
@@ -892,9 +921,65 @@ func (s *StorageProvisionerAPIv4) RemoveVolumeParams(ctx context.Context, args p
 	return results, nil
 }
 
+// VolumeResizeParams returns the parameters for resizing the volumes with
+// the specified tags. A NotFound error is returned for volumes which have
+// no resize requested.
+func (s *StorageProvisionerAPI) VolumeResizeParams(ctx context.Context, args params.Entities) (params.VolumeResizeParamsResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.VolumeResizeParamsResults{}, err
+	}
+	results := params.VolumeResizeParamsResults{
+		Results: make([]params.VolumeResizeParamsResult, len(args.Entities)),
+	}
+	one := func(arg params.Entity) (params.VolumeResizeParams, error) {
+		tag, err := names.ParseVolumeTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			return params.VolumeResizeParams{}, apiservererrors.ErrPerm
+		}
+		volume, err := s.sb.Volume(tag)
+		if errors.Is(err, errors.NotFound) {
+			return params.VolumeResizeParams{}, apiservererrors.ErrPerm
+		} else if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		size, ok := volume.ResizeRequested()
+		if !ok {
+			return params.VolumeResizeParams{}, errors.NotFoundf("resize of %s", names.ReadableString(tag))
+		}
+		volumeInfo, err := volume.Info()
+		if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		provider, _, err := storagecommon.StoragePoolConfig(
+			ctx, volumeInfo.Pool, s.storagePoolGetter, s.registry,
+		)
+		if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		return params.VolumeResizeParams{
+			VolumeTag: tag.String(),
+			Provider:  string(provider),
+			VolumeId:  volumeInfo.VolumeId,
+			Size:      size,
+		}, nil
+	}
+	for i, arg := range args.Entities {
+		var result params.VolumeResizeParamsResult
+		resizeParams, err := one(arg)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+		} else {
+			result.Result = resizeParams
+		}
+		results.Results[i] = result
+	}
+	return results, nil
+}
+
 // FilesystemParams returns the parameters for creating the filesystems
 // with the specified tags.
-func (s *StorageProvisionerAPIv4) FilesystemParams(ctx context.Context, args params.Entities) (params.FilesystemParamsResults, error) {
+func (s *StorageProvisionerAPI) FilesystemParams(ctx context.Context, args params.Entities) (params.FilesystemParamsResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.FilesystemParamsResults{}, err
@@ -948,7 +1033,7 @@ func (s *StorageProvisionerAPIv4) FilesystemParams(ctx context.Context, args par
 
 // RemoveFilesystemParams returns the parameters for destroying or
 // releasing the filesystems with the specified tags.
-func (s *StorageProvisionerAPIv4) RemoveFilesystemParams(ctx context.Context, args params.Entities) (params.RemoveFilesystemParamsResults, error) {
+func (s *StorageProvisionerAPI) RemoveFilesystemParams(ctx context.Context, args params.Entities) (params.RemoveFilesystemParamsResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.RemoveFilesystemParamsResults{}, err
@@ -1002,9 +1087,72 @@ func (s *StorageProvisionerAPIv4) RemoveFilesystemParams(ctx context.Context, ar
 	return results, nil
 }
 
+// FilesystemResizeParams returns the parameters for resizing the
+// filesystems with the specified tags. A NotFound error is returned for
+// filesystems which have no resize requested.
+func (s *StorageProvisionerAPI) FilesystemResizeParams(ctx context.Context, args params.Entities) (params.FilesystemResizeParamsResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.FilesystemResizeParamsResults{}, err
+	}
+	results := params.FilesystemResizeParamsResults{
+		Results: make([]params.FilesystemResizeParamsResult, len(args.Entities)),
+	}
+	one := func(arg params.Entity) (params.FilesystemResizeParams, error) {
+		tag, err := names.ParseFilesystemTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			return params.FilesystemResizeParams{}, apiservererrors.ErrPerm
+		}
+		filesystem, err := s.sb.Filesystem(tag)
+		if errors.Is(err, errors.NotFound) {
+			return params.FilesystemResizeParams{}, apiservererrors.ErrPerm
+		} else if err != nil {
+			return params.FilesystemResizeParams{}, err
+		}
+		size, ok := filesystem.ResizeRequested()
+		if !ok {
+			return params.FilesystemResizeParams{}, errors.NotFoundf("resize of %s", names.ReadableString(tag))
+		}
+		filesystemInfo, err := filesystem.Info()
+		if err != nil {
+			return params.FilesystemResizeParams{}, err
+		}
+		provider, _, err := storagecommon.StoragePoolConfig(
+			ctx, filesystemInfo.Pool, s.storagePoolGetter, s.registry,
+		)
+		if err != nil {
+			return params.FilesystemResizeParams{}, err
+		}
+		result := params.FilesystemResizeParams{
+			FilesystemTag: tag.String(),
+			Provider:      string(provider),
+			FilesystemId:  filesystemInfo.FilesystemId,
+			Size:          size,
+		}
+		volumeTag, err := filesystem.Volume()
+		if err == nil {
+			result.VolumeTag = volumeTag.String()
+		} else if err != state.ErrNoBackingVolume {
+			return params.FilesystemResizeParams{}, err
+		}
+		return result, nil
+	}
+	for i, arg := range args.Entities {
+		var result params.FilesystemResizeParamsResult
+		resizeParams, err := one(arg)
+		if err != nil {
+			result.Error = apiservererrors.ServerError(err)
+		} else {
+			result.Result = resizeParams
+		}
+		results.Results[i] = result
+	}
+	return results, nil
+}
+
 // VolumeAttachmentParams returns the parameters for creating the volume
 // attachments with the specified IDs.
-func (s *StorageProvisionerAPIv4) VolumeAttachmentParams(
+func (s *StorageProvisionerAPI) VolumeAttachmentParams(
 	ctx context.Context,
 	args params.MachineStorageIds,
 ) (params.VolumeAttachmentParamsResults, error) {
@@ -1089,7 +1237,7 @@ func (s *StorageProvisionerAPIv4) VolumeAttachmentParams(
 
 // FilesystemAttachmentParams returns the parameters for creating the filesystem
 // attachments with the specified IDs.
-func (s *StorageProvisionerAPIv4) FilesystemAttachmentParams(
+func (s *StorageProvisionerAPI) FilesystemAttachmentParams(
 	ctx context.Context,
 	args params.MachineStorageIds,
 ) (params.FilesystemAttachmentParamsResults, error) {
@@ -1180,7 +1328,7 @@ func (s *StorageProvisionerAPIv4) FilesystemAttachmentParams(
 	return results, nil
 }
 
-func (s *StorageProvisionerAPIv4) oneVolumeAttachmentPlan(
+func (s *StorageProvisionerAPI) oneVolumeAttachmentPlan(
 	id params.MachineStorageId, canAccess common.AuthFunc,
 ) (state.VolumeAttachmentPlan, error) {
 	machineTag, err := names.ParseMachineTag(id.MachineTag)
@@ -1201,7 +1349,7 @@ func (s *StorageProvisionerAPIv4) oneVolumeAttachmentPlan(
 	return volumeAttachmentPlan, nil
 }
 
-func (s *StorageProvisionerAPIv4) oneVolumeAttachment(
+func (s *StorageProvisionerAPI) oneVolumeAttachment(
 	id params.MachineStorageId, canAccess func(names.Tag, names.Tag) bool,
 ) (state.VolumeAttachment, error) {
 	hostTag, err := names.ParseTag(id.MachineTag)
@@ -1227,7 +1375,7 @@ func (s *StorageProvisionerAPIv4) oneVolumeAttachment(
 	return volumeAttachment, nil
 }
 
-func (s *StorageProvisionerAPIv4) oneVolumeBlockDevice(
+func (s *StorageProvisionerAPI) oneVolumeBlockDevice(
 	ctx context.Context,
 	id params.MachineStorageId, canAccess func(names.Tag, names.Tag) bool,
 ) (params.BlockDevice, error) {
@@ -1307,7 +1455,7 @@ func (s *StorageProvisionerAPIv4) oneVolumeBlockDevice(
 	}, nil
 }
 
-func (s *StorageProvisionerAPIv4) oneFilesystemAttachment(
+func (s *StorageProvisionerAPI) oneFilesystemAttachment(
 	id params.MachineStorageId, canAccess func(names.Tag, names.Tag) bool,
 ) (state.FilesystemAttachment, error) {
 	hostTag, err := names.ParseTag(id.MachineTag)
@@ -1334,7 +1482,7 @@ func (s *StorageProvisionerAPIv4) oneFilesystemAttachment(
 }
 
 // SetVolumeInfo records the details of newly provisioned volumes.
-func (s *StorageProvisionerAPIv4) SetVolumeInfo(args params.Volumes) (params.ErrorResults, error) {
+func (s *StorageProvisionerAPI) SetVolumeInfo(args params.Volumes) (params.ErrorResults, error) {
 	canAccessVolume, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.ErrorResults{}, err
@@ -1363,7 +1511,7 @@ func (s *StorageProvisionerAPIv4) SetVolumeInfo(args params.Volumes) (params.Err
 }
 
 // SetFilesystemInfo records the details of newly provisioned filesystems.
-func (s *StorageProvisionerAPIv4) SetFilesystemInfo(ctx context.Context, args params.Filesystems) (params.ErrorResults, error) {
+func (s *StorageProvisionerAPI) SetFilesystemInfo(ctx context.Context, args params.Filesystems) (params.ErrorResults, error) {
 	canAccessFilesystem, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.ErrorResults{}, err
@@ -1391,7 +1539,7 @@ func (s *StorageProvisionerAPIv4) SetFilesystemInfo(ctx context.Context, args pa
 	return results, nil
 }
 
-func (s *StorageProvisionerAPIv4) CreateVolumeAttachmentPlans(ctx context.Context, args params.VolumeAttachmentPlans) (params.ErrorResults, error) {
+func (s *StorageProvisionerAPI) CreateVolumeAttachmentPlans(ctx context.Context, args params.VolumeAttachmentPlans) (params.ErrorResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc()
 	if err != nil {
 		return params.ErrorResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -1428,7 +1576,7 @@ func (s *StorageProvisionerAPIv4) CreateVolumeAttachmentPlans(ctx context.Contex
 	return results, nil
 }
 
-func (s *StorageProvisionerAPIv4) SetVolumeAttachmentPlanBlockInfo(ctx context.Context, args params.VolumeAttachmentPlans) (params.ErrorResults, error) {
+func (s *StorageProvisionerAPI) SetVolumeAttachmentPlanBlockInfo(ctx context.Context, args params.VolumeAttachmentPlans) (params.ErrorResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc()
 	if err != nil {
 		return params.ErrorResults{}, apiservererrors.ServerError(apiservererrors.ErrPerm)
@@ -1467,7 +1615,7 @@ func (s *StorageProvisionerAPIv4) SetVolumeAttachmentPlanBlockInfo(ctx context.C
 
 // SetVolumeAttachmentInfo records the details of newly provisioned volume
 // attachments.
-func (s *StorageProvisionerAPIv4) SetVolumeAttachmentInfo(
+func (s *StorageProvisionerAPI) SetVolumeAttachmentInfo(
 	ctx context.Context,
 	args params.VolumeAttachments,
 ) (params.ErrorResults, error) {
@@ -1513,7 +1661,7 @@ func (s *StorageProvisionerAPIv4) SetVolumeAttachmentInfo(
 
 // SetFilesystemAttachmentInfo records the details of newly provisioned filesystem
 // attachments.
-func (s *StorageProvisionerAPIv4) SetFilesystemAttachmentInfo(
+func (s *StorageProvisionerAPI) SetFilesystemAttachmentInfo(
 	ctx context.Context,
 	args params.FilesystemAttachments,
 ) (params.ErrorResults, error) {
@@ -1559,7 +1707,7 @@ func (s *StorageProvisionerAPIv4) SetFilesystemAttachmentInfo(
 
 // AttachmentLife returns the lifecycle state of each specified machine
 // storage attachment.
-func (s *StorageProvisionerAPIv4) AttachmentLife(ctx context.Context, args params.MachineStorageIds) (params.LifeResults, error) {
+func (s *StorageProvisionerAPI) AttachmentLife(ctx context.Context, args params.MachineStorageIds) (params.LifeResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc()
 	if err != nil {
 		return params.LifeResults{}, err
@@ -1606,7 +1754,7 @@ func (s *StorageProvisionerAPIv4) AttachmentLife(ctx context.Context, args param
 }
 
 // Remove removes volumes and filesystems from state.
-func (s *StorageProvisionerAPIv4) Remove(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
+func (s *StorageProvisionerAPI) Remove(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
 	canAccess, err := s.getStorageEntityAuthFunc()
 	if err != nil {
 		return params.ErrorResults{}, err
@@ -1642,7 +1790,7 @@ func (s *StorageProvisionerAPIv4) Remove(ctx context.Context, args params.Entiti
 
 // RemoveAttachment removes the specified machine storage attachments
 // from state.
-func (s *StorageProvisionerAPIv4) RemoveAttachment(ctx context.Context, args params.MachineStorageIds) (params.ErrorResults, error) {
+func (s *StorageProvisionerAPI) RemoveAttachment(ctx context.Context, args params.MachineStorageIds) (params.ErrorResults, error) {
 	canAccess, err := s.getAttachmentAuthFunc()
 	if err != nil {
 		return params.ErrorResults{}, err
@@ -1681,3 +1829,19 @@ func (s *StorageProvisionerAPIv4) RemoveAttachment(ctx context.Context, args par
 	}
 	return results, nil
 }
+
+// WatchVolumeResizes is not implemented in version 4 of the storage
+// provisioner.
+func (*StorageProvisionerAPIv4) WatchVolumeResizes(_, _ struct{}) {}
+
+// WatchFilesystemResizes is not implemented in version 4 of the storage
+// provisioner.
+func (*StorageProvisionerAPIv4) WatchFilesystemResizes(_, _ struct{}) {}
+
+// VolumeResizeParams is not implemented in version 4 of the storage
+// provisioner.
+func (*StorageProvisionerAPIv4) VolumeResizeParams(_, _ struct{}) {}
+
+// FilesystemResizeParams is not implemented in version 4 of the storage
+// provisioner.
+func (*StorageProvisionerAPIv4) FilesystemResizeParams(_, _ struct{}) {}
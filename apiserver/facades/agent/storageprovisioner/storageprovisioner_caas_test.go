@@ -24,7 +24,7 @@ import (
 type caasProvisionerSuite struct {
 	testing.IsolationSuite
 
-	api *StorageProvisionerAPIv4
+	api *StorageProvisionerAPI
 
 	storageBackend       *MockStorageBackend
 	filesystemAttachment *MockFilesystemAttachment
@@ -288,7 +288,7 @@ func (s *caasProvisionerSuite) setupMocks(c *gc.C) *gomock.Controller {
 	s.backend = NewMockBackend(ctrl)
 	s.resources = NewMockResources(ctrl)
 
-	s.api = &StorageProvisionerAPIv4{
+	s.api = &StorageProvisionerAPI{
 		LifeGetter: common.NewLifeGetter(s.entityFinder, func() (common.AuthFunc, error) {
 			return func(names.Tag) bool {
 				return true
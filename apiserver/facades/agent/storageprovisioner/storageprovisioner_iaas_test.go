@@ -73,7 +73,7 @@ func (s *iaasProvisionerSuite) SetUpTest(c *gc.C) {
 	s.store = jujutesting.NewObjectStore(c, s.ControllerModelUUID())
 }
 
-func (s *iaasProvisionerSuite) newApi(c *gc.C, blockDeviceService storageprovisioner.BlockDeviceService, watcherRegistry facade.WatcherRegistry) *storageprovisioner.StorageProvisionerAPIv4 {
+func (s *iaasProvisionerSuite) newApi(c *gc.C, blockDeviceService storageprovisioner.BlockDeviceService, watcherRegistry facade.WatcherRegistry) *storageprovisioner.StorageProvisionerAPI {
 	domainServices := s.ControllerDomainServices(c)
 	modelInfo, err := domainServices.ModelInfo().GetModelInfo(context.Background())
 	c.Assert(err, jc.ErrorIsNil)
@@ -94,7 +94,7 @@ func (s *iaasProvisionerSuite) newApi(c *gc.C, blockDeviceService storageprovisi
 	backend, storageBackend, err := storageprovisioner.NewStateBackends(s.st)
 	c.Assert(err, jc.ErrorIsNil)
 	s.storageBackend = storageBackend
-	api, err := storageprovisioner.NewStorageProvisionerAPIv4(
+	api, err := storageprovisioner.NewStorageProvisionerAPI(
 		context.Background(),
 		watcherRegistry,
 		clock.WallClock,
@@ -29,7 +29,7 @@ type provisionerSuite struct {
 	st             *state.State
 	resources      *common.Resources
 	authorizer     *apiservertesting.FakeAuthorizer
-	api            *storageprovisioner.StorageProvisionerAPIv4
+	api            *storageprovisioner.StorageProvisionerAPI
 	storageBackend storageprovisioner.StorageBackend
 }
 
@@ -45,7 +45,7 @@ func (s *provisionerSuite) TestNewStorageProvisionerAPINonMachine(c *gc.C) {
 
 	modelInfo, err := s.ControllerDomainServices(c).ModelInfo().GetModelInfo(context.Background())
 	c.Assert(err, jc.ErrorIsNil)
-	_, err = storageprovisioner.NewStorageProvisionerAPIv4(
+	_, err = storageprovisioner.NewStorageProvisionerAPI(
 		context.Background(),
 		nil,
 		clock.WallClock,
@@ -75,3 +75,15 @@ func (s *provisionerSuite) TestVolumeParamsEmptyArgs(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(results.Results, gc.HasLen, 0)
 }
+
+func (s *provisionerSuite) TestVolumeResizeParamsEmptyArgs(c *gc.C) {
+	results, err := s.api.VolumeResizeParams(context.Background(), params.Entities{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 0)
+}
+
+func (s *provisionerSuite) TestFilesystemResizeParamsEmptyArgs(c *gc.C) {
+	results, err := s.api.FilesystemResizeParams(context.Background(), params.Entities{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 0)
+}
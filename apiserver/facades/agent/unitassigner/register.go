@@ -24,11 +24,12 @@ func newFacade(ctx facade.ModelContext) (*API, error) {
 	domainServices := ctx.DomainServices()
 
 	return &API{
-		st:             stateShim{State: st},
-		machineService: domainServices.Machine(),
-		networkService: domainServices.Network(),
-		statusService:  domainServices.Status(),
-		clock:          ctx.Clock(),
-		res:            ctx.Resources(),
+		st:                 stateShim{State: st},
+		applicationService: domainServices.Application(),
+		machineService:     domainServices.Machine(),
+		networkService:     domainServices.Network(),
+		statusService:      domainServices.Status(),
+		clock:              ctx.Clock(),
+		res:                ctx.Resources(),
 	}, nil
 }
@@ -14,8 +14,8 @@ type stateShim struct {
 	*state.State
 }
 
-func (s stateShim) AssignStagedUnits(allSpaces network.SpaceInfos, ids []string) ([]state.UnitAssignmentResult, error) {
-	return s.State.AssignStagedUnits(allSpaces, ids)
+func (s stateShim) AssignStagedUnits(allSpaces network.SpaceInfos, ids []string, pool state.WarmPool) ([]state.UnitAssignmentResult, error) {
+	return s.State.AssignStagedUnits(allSpaces, ids, pool)
 }
 
 func (s stateShim) AssignedMachineId(unit string) (string, error) {
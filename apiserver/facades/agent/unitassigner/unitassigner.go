@@ -10,6 +10,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/machine"
@@ -27,7 +28,7 @@ import (
 // for testing.
 type assignerState interface {
 	WatchForUnitAssignment() state.StringsWatcher
-	AssignStagedUnits(allSpaces network.SpaceInfos, ids []string) ([]state.UnitAssignmentResult, error)
+	AssignStagedUnits(allSpaces network.SpaceInfos, ids []string, pool state.WarmPool) ([]state.UnitAssignmentResult, error)
 	AssignedMachineId(unit string) (string, error)
 }
 
@@ -35,6 +36,13 @@ type assignerState interface {
 // domain.
 type MachineService interface {
 	CreateMachine(context.Context, machine.Name) (string, error)
+	common.WarmPoolMachineService
+}
+
+// ApplicationService is the interface that is used to interact with the
+// application domain.
+type ApplicationService interface {
+	common.WarmPoolApplicationService
 }
 
 // NetworkService is the interface that is used to interact with the
@@ -53,12 +61,13 @@ type StatusService interface {
 
 // API implements the functionality for assigning units to machines.
 type API struct {
-	st             assignerState
-	machineService MachineService
-	networkService NetworkService
-	statusService  StatusService
-	clock          clock.Clock
-	res            facade.Resources
+	st                 assignerState
+	applicationService ApplicationService
+	machineService     MachineService
+	networkService     NetworkService
+	statusService      StatusService
+	clock              clock.Clock
+	res                facade.Resources
 }
 
 // AssignUnits assigns the units with the given ids to the correct machine. The
@@ -83,7 +92,8 @@ func (a *API) AssignUnits(ctx context.Context, args params.Entities) (params.Err
 		return result, errors.Trace(err)
 	}
 
-	res, err := a.st.AssignStagedUnits(allSpaces, ids)
+	pool := common.NewWarmPool(ctx, a.applicationService, a.machineService)
+	res, err := a.st.AssignStagedUnits(allSpaces, ids, pool)
 	if err != nil {
 		return result, apiservererrors.ServerError(err)
 	}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
@@ -47,6 +49,7 @@ func (s *testsuite) TestAssignUnits(c *gc.C) {
 	res, err := api.AssignUnits(context.Background(), args)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(f.ids, gc.DeepEquals, []string{"foo/0", "bar/1"})
+	c.Assert(f.pool, gc.NotNil)
 	c.Assert(res.Results, gc.HasLen, 2)
 	c.Check(res.Results[0].Error, gc.IsNil)
 	c.Check(res.Results[1].Error, gc.ErrorMatches, `unit "unit-bar-1" not found`)
@@ -121,6 +124,10 @@ func (f *fakeMachineService) CreateMachine(_ context.Context, machineName machin
 	return "", nil
 }
 
+func (f *fakeMachineService) AddWarmPoolMachine(context.Context, machine.Name, constraints.Value, corebase.Base) error {
+	return errors.NotImplementedf("AddWarmPoolMachine")
+}
+
 type fakeNetworkService struct {
 }
 
@@ -133,6 +140,7 @@ type fakeState struct {
 	ids          []string
 	unitMachines map[string]string
 	results      []state.UnitAssignmentResult
+	pool         state.WarmPool
 	err          error
 }
 
@@ -141,8 +149,9 @@ func (f *fakeState) WatchForUnitAssignment() state.StringsWatcher {
 	return fakeWatcher{f.ids}
 }
 
-func (f *fakeState) AssignStagedUnits(_ network.SpaceInfos, ids []string) ([]state.UnitAssignmentResult, error) {
+func (f *fakeState) AssignStagedUnits(_ network.SpaceInfos, ids []string, pool state.WarmPool) ([]state.UnitAssignmentResult, error) {
 	f.ids = ids
+	f.pool = pool
 	return f.results, f.err
 }
 
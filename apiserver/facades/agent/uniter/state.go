@@ -30,6 +30,7 @@ type storageInterface interface {
 	StorageInstance(names.StorageTag) (state.StorageInstance, error)
 	UnitStorageAttachments(names.UnitTag) ([]state.StorageAttachment, error)
 	RemoveStorageAttachment(names.StorageTag, names.UnitTag, bool) error
+	ClearStorageAttachmentResized(names.StorageTag, names.UnitTag, uint64) error
 	DestroyUnitStorageAttachments(names.UnitTag) error
 	StorageAttachment(names.StorageTag, names.UnitTag) (state.StorageAttachment, error)
 	AddStorageForUnitOperation(names.UnitTag, string, state.StorageConstraints) (state.ModelOperation, error)
@@ -215,6 +215,8 @@ func (s *StorageAPI) fromStateStorageAttachment(ctx context.Context, stateStorag
 		params.StorageKind(stateStorageInstance.Kind()),
 		info.Location,
 		life.Value(stateStorageAttachment.Life().String()),
+		stateStorageAttachment.Resized(),
+		stateStorageAttachment.ResizeGeneration(),
 	}, nil
 }
 
@@ -359,6 +361,41 @@ func (s *StorageAPI) removeOneStorageAttachment(id params.StorageAttachmentId, c
 	return err
 }
 
+// ClearStorageAttachmentsResized records the resize generations for which
+// the units of the specified storage attachments have run the
+// storage-resized hook.
+func (s *StorageAPI) ClearStorageAttachmentsResized(args params.StorageAttachmentsResized) (params.ErrorResults, error) {
+	canAccess, err := s.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Attachments)),
+	}
+	for i, arg := range args.Attachments {
+		err := s.clearOneStorageAttachmentResized(arg, canAccess)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+func (s *StorageAPI) clearOneStorageAttachmentResized(arg params.StorageAttachmentResized, canAccess func(names.Tag) bool) error {
+	unitTag, err := names.ParseUnitTag(arg.UnitTag)
+	if err != nil {
+		return err
+	}
+	if !canAccess(unitTag) {
+		return apiservererrors.ErrPerm
+	}
+	storageTag, err := names.ParseStorageTag(arg.StorageTag)
+	if err != nil {
+		return err
+	}
+	return s.storage.ClearStorageAttachmentResized(storageTag, unitTag, arg.Generation)
+}
+
 // addStorageToOneUnitOperation returns a ModelOperation for adding storage to
 // the specified unit.
 func (s *StorageAPI) addStorageToOneUnitOperation(unitTag names.UnitTag, addParams params.StorageAddParams, curCons map[string]state.StorageConstraints) (state.ModelOperation, error) {
@@ -319,6 +319,60 @@ func (s *storageSuite) TestRemoveStorageAttachments(c *gc.C) {
 	})
 }
 
+func (s *storageSuite) TestClearStorageAttachmentsResized(c *gc.C) {
+	unitTag0 := names.NewUnitTag("mysql/0")
+	unitTag1 := names.NewUnitTag("mysql/1")
+	storageTag0 := names.NewStorageTag("data/0")
+	storageTag1 := names.NewStorageTag("data/1")
+
+	resources := common.NewResources()
+	getCanAccess := func() (common.AuthFunc, error) {
+		return func(tag names.Tag) bool {
+			return tag == unitTag0
+		}, nil
+	}
+
+	var cleared []uint64
+	st := &mockStorageState{
+		clearResized: func(s names.StorageTag, u names.UnitTag, generation uint64) error {
+			c.Assert(u, gc.DeepEquals, unitTag0)
+			if s == storageTag1 {
+				return errors.New("badness")
+			}
+			cleared = append(cleared, generation)
+			return nil
+		},
+	}
+	blockDeviceService := &mockBlockDeviceService{}
+
+	storage, err := uniter.NewStorageAPI(st, st, blockDeviceService, resources, getCanAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	clearErrors, err := storage.ClearStorageAttachmentsResized(params.StorageAttachmentsResized{
+		Attachments: []params.StorageAttachmentResized{{
+			StorageTag: storageTag0.String(),
+			UnitTag:    unitTag0.String(),
+			Generation: 2,
+		}, {
+			StorageTag: storageTag1.String(),
+			UnitTag:    unitTag0.String(),
+			Generation: 1,
+		}, {
+			StorageTag: storageTag0.String(),
+			UnitTag:    unitTag1.String(),
+			Generation: 1,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cleared, jc.DeepEquals, []uint64{2})
+	c.Assert(clearErrors, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{
+			{nil},
+			{&params.Error{Message: "badness"}},
+			{&params.Error{Code: params.CodeUnauthorized, Message: "permission denied"}},
+		},
+	})
+}
+
 type mockUnit struct {
 	assignedMachine    string
 	storageConstraints map[string]state.StorageConstraints
@@ -358,6 +412,7 @@ type mockStorageState struct {
 	uniter.StorageFilesystemInterface
 	destroyUnitStorageAttachments func(names.UnitTag) error
 	remove                        func(names.StorageTag, names.UnitTag, bool) error
+	clearResized                  func(names.StorageTag, names.UnitTag, uint64) error
 	storageInstance               func(names.StorageTag) (state.StorageInstance, error)
 	storageInstanceFilesystem     func(names.StorageTag) (state.Filesystem, error)
 	storageInstanceVolume         func(names.StorageTag) (state.Volume, error)
@@ -390,6 +445,10 @@ func (m *mockStorageState) RemoveStorageAttachment(s names.StorageTag, u names.U
 	return m.remove(s, u, force)
 }
 
+func (m *mockStorageState) ClearStorageAttachmentResized(s names.StorageTag, u names.UnitTag, generation uint64) error {
+	return m.clearResized(s, u, generation)
+}
+
 func (m *mockStorageState) StorageInstance(s names.StorageTag) (state.StorageInstance, error) {
 	return m.storageInstance(s)
 }
@@ -109,6 +109,10 @@ type UniterAPIv19 struct {
 }
 
 type UniterAPIv20 struct {
+	*UniterAPIv21
+}
+
+type UniterAPIv21 struct {
 	*UniterAPI
 }
 
@@ -2976,6 +2980,10 @@ func (u *UniterAPI) Read(ctx context.Context, _, _ struct{}) {}
 // WatchLeadershipSettings is not implemented in version 21 of the uniter.
 func (u *UniterAPI) WatchLeadershipSettings(ctx context.Context, _, _ struct{}) {}
 
+// ClearStorageAttachmentsResized is not implemented in version 21 of the
+// uniter.
+func (u *UniterAPIv21) ClearStorageAttachmentsResized(ctx context.Context, _, _ struct{}) {}
+
 func ptr[T any](v T) *T {
 	return &v
 }
@@ -572,8 +572,10 @@ func (s *uniterv19Suite) SetUpTest(c *gc.C) {
 
 		s.uniter = &UniterAPIv19{
 			UniterAPIv20: &UniterAPIv20{
-				UniterAPI: &UniterAPI{
-					watcherRegistry: s.watcherRegistry,
+				UniterAPIv21: &UniterAPIv21{
+					UniterAPI: &UniterAPI{
+						watcherRegistry: s.watcherRegistry,
+					},
 				},
 			},
 		}
@@ -596,10 +598,12 @@ func (s *uniterv20Suite) SetUpTest(c *gc.C) {
 		s.watcherRegistry.EXPECT().Register(gomock.Any()).Return("watcher1", nil).AnyTimes()
 
 		s.uniter = &UniterAPIv20{
-			UniterAPI: &UniterAPI{
-				modelUUID:       model.UUID(coretesting.ModelTag.Id()),
-				modelType:       model.IAAS,
-				watcherRegistry: s.watcherRegistry,
+			UniterAPIv21: &UniterAPIv21{
+				UniterAPI: &UniterAPI{
+					modelUUID:       model.UUID(coretesting.ModelTag.Id()),
+					modelType:       model.IAAS,
+					watcherRegistry: s.watcherRegistry,
+				},
 			},
 		}
 
@@ -0,0 +1,307 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admissionpolicy
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/admission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	internaladmission "github.com/juju/juju/internal/admission"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API manages admission policies, which deny model-changing operations such
+// as deploying applications before they are made.
+type API struct {
+	admissionService AdmissionService
+	authorizer       facade.Authorizer
+	controllerTag    names.ControllerTag
+}
+
+// NewAPI returns a new AdmissionPolicy API facade.
+func NewAPI(
+	admissionService AdmissionService,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+) *API {
+	return &API{
+		admissionService: admissionService,
+		authorizer:       authorizer,
+		controllerTag:    controllerTag,
+	}
+}
+
+// AddAdmissionPolicies adds the policies. Controller superusers may add
+// policies to the controller or any model, and model admins to their
+// models. The user adding a policy is recorded as its creator.
+func (api *API) AddAdmissionPolicies(ctx context.Context, args params.AdmissionPolicies) (params.ErrorResults, error) {
+	isSuperuser, err := api.isSuperuser(ctx)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	creator := api.authorizer.GetAuthTag().Id()
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Policies)),
+	}
+	for i, arg := range args.Policies {
+		policy, err := policyFromParams(arg)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !isSuperuser {
+			if err := api.checkCanAdminModel(ctx, policy.ModelUUID); err != nil {
+				result.Results[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+		}
+		policy.CreatedBy = creator
+		policy.CreatedBySuperuser = isSuperuser
+		err = api.admissionService.AddPolicy(ctx, policy)
+		result.Results[i].Error = apiservererrors.ServerError(policyError(err))
+	}
+	return result, nil
+}
+
+// RemoveAdmissionPolicies removes the policies. Controller superusers may
+// remove policies from the controller or any model, and model admins from
+// their models, except for those added by a superuser.
+func (api *API) RemoveAdmissionPolicies(ctx context.Context, args params.AdmissionPolicyIDs) (params.ErrorResults, error) {
+	isSuperuser, err := api.isSuperuser(ctx)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Policies)),
+	}
+	for i, arg := range args.Policies {
+		modelUUID, err := modelUUIDFromTag(arg.ModelTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !isSuperuser {
+			if err := api.checkCanRemove(ctx, modelUUID, arg.Name); err != nil {
+				result.Results[i].Error = apiservererrors.ServerError(policyError(err))
+				continue
+			}
+		}
+		err = api.admissionService.RemovePolicy(ctx, modelUUID, arg.Name)
+		result.Results[i].Error = apiservererrors.ServerError(policyError(err))
+	}
+	return result, nil
+}
+
+// ListAdmissionPolicies returns the policies of the model, or of the
+// controller if no model is given, ordered by name. Every user may see the
+// policies of the controller, as they apply to every model, but only the
+// users with access to a model may see its policies.
+func (api *API) ListAdmissionPolicies(ctx context.Context, arg params.AdmissionPoliciesFilter) (params.AdmissionPoliciesResult, error) {
+	modelUUID, err := modelUUIDFromTag(arg.ModelTag)
+	if err != nil {
+		return params.AdmissionPoliciesResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	if err := api.checkCanReadModel(ctx, modelUUID); err != nil {
+		return params.AdmissionPoliciesResult{}, errors.Trace(err)
+	}
+
+	policies, err := api.admissionService.ListPolicies(ctx, modelUUID)
+	if err != nil {
+		return params.AdmissionPoliciesResult{Error: apiservererrors.ServerError(policyError(err))}, nil
+	}
+	result := params.AdmissionPoliciesResult{
+		Policies: make([]params.AdmissionPolicy, len(policies)),
+	}
+	for i, policy := range policies {
+		result.Policies[i] = policyToParams(policy)
+	}
+	return result, nil
+}
+
+// TestAdmission evaluates the admission policies of the model, along with
+// the candidate policies of the test, against the operation of the test.
+// It returns the policies which deny the operation, without the operation
+// or the candidate policies being applied. Users with access to the model
+// may test its admission.
+func (api *API) TestAdmission(ctx context.Context, arg params.AdmissionTest) (params.AdmissionTestResult, error) {
+	modelTag, err := names.ParseModelTag(arg.ModelTag)
+	if err != nil {
+		return params.AdmissionTestResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	if err := api.checkCanReadModel(ctx, modelTag.Id()); err != nil {
+		return params.AdmissionTestResult{}, errors.Trace(err)
+	}
+
+	req, err := requestFromParams(arg)
+	if err != nil {
+		return params.AdmissionTestResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	candidates := make([]admission.Policy, len(arg.Policies))
+	for i, candidate := range arg.Policies {
+		candidates[i] = admission.Policy{
+			Name:      candidate.Name,
+			ModelUUID: modelTag.Id(),
+			Rule:      candidate.Rule,
+			Message:   candidate.Message,
+		}
+	}
+
+	denied, err := api.admissionService.EvaluatePolicies(ctx, modelTag.Id(), req, candidates)
+	if err != nil {
+		return params.AdmissionTestResult{Error: apiservererrors.ServerError(policyError(err))}, nil
+	}
+	result := params.AdmissionTestResult{
+		Denied: make([]params.AdmissionPolicy, len(denied)),
+	}
+	for i, policy := range denied {
+		result.Denied[i] = policyToParams(policy)
+	}
+	return result, nil
+}
+
+// checkCanAdminModel returns an error if the authenticated user is not an
+// admin of the model, which must be given as only superusers may manage the
+// policies of the controller.
+func (api *API) checkCanAdminModel(ctx context.Context, modelUUID string) error {
+	if modelUUID == "" {
+		return apiservererrors.ErrPerm
+	}
+	err := api.authorizer.HasPermission(ctx, permission.AdminAccess, names.NewModelTag(modelUUID))
+	if errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return apiservererrors.ErrPerm
+	}
+	return err
+}
+
+// checkCanRemove returns an error if the authenticated user, who is not a
+// superuser, cannot remove the named policy of the model. Model admins may
+// remove the policies of their models, except for those added by a
+// superuser.
+func (api *API) checkCanRemove(ctx context.Context, modelUUID, name string) error {
+	if err := api.checkCanAdminModel(ctx, modelUUID); err != nil {
+		return errors.Trace(err)
+	}
+	policies, err := api.admissionService.ListPolicies(ctx, modelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, policy := range policies {
+		if policy.Name == name && policy.CreatedBySuperuser {
+			return apiservererrors.ErrPerm
+		}
+	}
+	return nil
+}
+
+// checkCanReadModel returns an error if the authenticated user cannot read
+// the model. Every user may read the controller, given as an empty model.
+func (api *API) checkCanReadModel(ctx context.Context, modelUUID string) error {
+	if modelUUID == "" {
+		return nil
+	}
+	isSuperuser, err := api.isSuperuser(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	} else if isSuperuser {
+		return nil
+	}
+	err = api.authorizer.HasPermission(ctx, permission.ReadAccess, names.NewModelTag(modelUUID))
+	if errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return apiservererrors.ErrPerm
+	}
+	return err
+}
+
+// isSuperuser reports whether the authenticated user is a controller
+// superuser.
+func (api *API) isSuperuser(ctx context.Context) (bool, error) {
+	err := api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+	if errors.Is(err, apiservererrors.ErrPerm) || errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// policyError converts the errors of the admission service into errors
+// which are understood by the API.
+func policyError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, admissionerrors.PolicyNotFound),
+		errors.Is(err, admissionerrors.ModelNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, admissionerrors.PolicyAlreadyExists):
+		return errors.NewAlreadyExists(err, "")
+	case errors.Is(err, admissionerrors.PolicyNotValid):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
+
+// modelUUIDFromTag returns the UUID of the model with the tag, or an empty
+// UUID for the controller if the tag is empty.
+func modelUUIDFromTag(tag string) (string, error) {
+	if tag == "" {
+		return "", nil
+	}
+	modelTag, err := names.ParseModelTag(tag)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return modelTag.Id(), nil
+}
+
+// requestFromParams returns the admission request of the test.
+func requestFromParams(arg params.AdmissionTest) (*internaladmission.Request, error) {
+	op := internaladmission.Operation(arg.Operation)
+	if !op.IsValid() {
+		return nil, errors.NotValidf("operation %q", arg.Operation)
+	}
+	req := internaladmission.NewRequest(op)
+	for field, values := range arg.Fields {
+		if field == internaladmission.FieldOperation || !internaladmission.IsField(field) {
+			return nil, errors.NotValidf("field %q", field)
+		}
+		req.Set(field, values...)
+	}
+	return req, nil
+}
+
+func policyFromParams(arg params.AdmissionPolicy) (admission.Policy, error) {
+	modelUUID, err := modelUUIDFromTag(arg.ModelTag)
+	if err != nil {
+		return admission.Policy{}, errors.Trace(err)
+	}
+	return admission.Policy{
+		Name:      arg.Name,
+		ModelUUID: modelUUID,
+		Rule:      arg.Rule,
+		Message:   arg.Message,
+	}, nil
+}
+
+func policyToParams(policy admission.Policy) params.AdmissionPolicy {
+	var modelTag string
+	if policy.ModelUUID != "" {
+		modelTag = names.NewModelTag(policy.ModelUUID).String()
+	}
+	return params.AdmissionPolicy{
+		Name:      policy.Name,
+		ModelTag:  modelTag,
+		Rule:      policy.Rule,
+		Message:   policy.Message,
+		CreatedBy: policy.CreatedBy,
+	}
+}
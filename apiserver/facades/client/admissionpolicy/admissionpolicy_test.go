@@ -0,0 +1,363 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admissionpolicy
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/admission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	internaladmission "github.com/juju/juju/internal/admission"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type apiSuite struct {
+	admissionService *MockAdmissionService
+	authorizer       *MockAuthorizer
+}
+
+var _ = gc.Suite(&apiSuite{})
+
+func (s *apiSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.admissionService = NewMockAdmissionService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	return ctrl
+}
+
+func (s *apiSuite) newAPI() *API {
+	return NewAPI(s.admissionService, s.authorizer, coretesting.ControllerTag)
+}
+
+func (s *apiSuite) expectSuperuser(isSuperuser bool) {
+	var err error
+	if !isSuperuser {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(err)
+}
+
+func (s *apiSuite) expectAuthTag(userName string) {
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag(userName))
+}
+
+func (s *apiSuite) expectModelAccess(access permission.Access, hasAccess bool) {
+	var err error
+	if !hasAccess {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), access, coretesting.ModelTag).Return(err)
+}
+
+func (s *apiSuite) TestAddAdmissionPolicies(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.expectAuthTag("admin")
+	s.admissionService.EXPECT().AddPolicy(gomock.Any(), admission.Policy{
+		Name:               "stable-only",
+		Rule:               `operation == "deploy" and charm.risk != "stable"`,
+		Message:            "only stable charms may be deployed",
+		CreatedBy:          "admin",
+		CreatedBySuperuser: true,
+	}).Return(nil)
+	s.admissionService.EXPECT().AddPolicy(gomock.Any(), admission.Policy{
+		Name:               "zones",
+		ModelUUID:          coretesting.ModelTag.Id(),
+		Rule:               `operation == "deploy" and constraints.zones is empty`,
+		CreatedBy:          "admin",
+		CreatedBySuperuser: true,
+	}).Return(admissionerrors.PolicyAlreadyExists)
+
+	result, err := s.newAPI().AddAdmissionPolicies(context.Background(), params.AdmissionPolicies{
+		Policies: []params.AdmissionPolicy{{
+			Name:    "stable-only",
+			Rule:    `operation == "deploy" and charm.risk != "stable"`,
+			Message: "only stable charms may be deployed",
+		}, {
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+			Rule:     `operation == "deploy" and constraints.zones is empty`,
+		}, {
+			Name:     "bad",
+			ModelTag: "machine-0",
+			Rule:     `operation == "deploy"`,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeAlreadyExists)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `"machine-0" is not a valid model tag`)
+}
+
+func (s *apiSuite) TestAddAdmissionPoliciesModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectAuthTag("bob")
+	s.expectModelAccess(permission.AdminAccess, true)
+	s.admissionService.EXPECT().AddPolicy(gomock.Any(), admission.Policy{
+		Name:      "zones",
+		ModelUUID: coretesting.ModelTag.Id(),
+		Rule:      `constraints.zones is empty`,
+		CreatedBy: "bob",
+	}).Return(nil)
+
+	result, err := s.newAPI().AddAdmissionPolicies(context.Background(), params.AdmissionPolicies{
+		Policies: []params.AdmissionPolicy{{
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+			Rule:     `constraints.zones is empty`,
+		}, {
+			// Only superusers may add policies to the controller.
+			Name: "stable-only",
+			Rule: `charm.risk != "stable"`,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *apiSuite) TestAddAdmissionPoliciesNotModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectAuthTag("bob")
+	s.expectModelAccess(permission.AdminAccess, false)
+
+	result, err := s.newAPI().AddAdmissionPolicies(context.Background(), params.AdmissionPolicies{
+		Policies: []params.AdmissionPolicy{{
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+			Rule:     `constraints.zones is empty`,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *apiSuite) TestAddAdmissionPoliciesNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.expectAuthTag("admin")
+	s.admissionService.EXPECT().AddPolicy(gomock.Any(), gomock.Any()).Return(
+		errors.Annotate(admissionerrors.PolicyNotValid, `policy "bad"`),
+	)
+
+	result, err := s.newAPI().AddAdmissionPolicies(context.Background(), params.AdmissionPolicies{
+		Policies: []params.AdmissionPolicy{{Name: "bad", Rule: "colour"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Error, jc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *apiSuite) TestRemoveAdmissionPolicies(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.admissionService.EXPECT().RemovePolicy(gomock.Any(), "", "stable-only").Return(nil)
+	s.admissionService.EXPECT().RemovePolicy(gomock.Any(), coretesting.ModelTag.Id(), "zones").Return(admissionerrors.PolicyNotFound)
+
+	result, err := s.newAPI().RemoveAdmissionPolicies(context.Background(), params.AdmissionPolicyIDs{
+		Policies: []params.AdmissionPolicyID{{
+			Name: "stable-only",
+		}, {
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) TestRemoveAdmissionPoliciesNotSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+
+	result, err := s.newAPI().RemoveAdmissionPolicies(context.Background(), params.AdmissionPolicyIDs{
+		Policies: []params.AdmissionPolicyID{{Name: "stable-only"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *apiSuite) TestRemoveAdmissionPoliciesModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(nil).Times(2)
+	s.admissionService.EXPECT().ListPolicies(gomock.Any(), coretesting.ModelTag.Id()).Return([]admission.Policy{{
+		Name:      "zones",
+		ModelUUID: coretesting.ModelTag.Id(),
+		CreatedBy: "bob",
+	}, {
+		Name:               "stable-only",
+		ModelUUID:          coretesting.ModelTag.Id(),
+		CreatedBy:          "admin",
+		CreatedBySuperuser: true,
+	}}, nil).Times(2)
+	s.admissionService.EXPECT().RemovePolicy(gomock.Any(), coretesting.ModelTag.Id(), "zones").Return(nil)
+
+	result, err := s.newAPI().RemoveAdmissionPolicies(context.Background(), params.AdmissionPolicyIDs{
+		Policies: []params.AdmissionPolicyID{{
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+		}, {
+			// Only superusers may remove the policies they added.
+			Name:     "stable-only",
+			ModelTag: coretesting.ModelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *apiSuite) TestListAdmissionPoliciesController(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.admissionService.EXPECT().ListPolicies(gomock.Any(), "").Return([]admission.Policy{{
+		Name:    "stable-only",
+		Rule:    `charm.risk != "stable"`,
+		Message: "only stable charms may be deployed",
+	}}, nil)
+
+	result, err := s.newAPI().ListAdmissionPolicies(context.Background(), params.AdmissionPoliciesFilter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.AdmissionPoliciesResult{
+		Policies: []params.AdmissionPolicy{{
+			Name:    "stable-only",
+			Rule:    `charm.risk != "stable"`,
+			Message: "only stable charms may be deployed",
+		}},
+	})
+}
+
+func (s *apiSuite) TestListAdmissionPoliciesModel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectModelAccess(permission.ReadAccess, true)
+	s.admissionService.EXPECT().ListPolicies(gomock.Any(), coretesting.ModelTag.Id()).Return([]admission.Policy{{
+		Name:      "zones",
+		ModelUUID: coretesting.ModelTag.Id(),
+		Rule:      `constraints.zones is empty`,
+	}}, nil)
+
+	result, err := s.newAPI().ListAdmissionPolicies(context.Background(), params.AdmissionPoliciesFilter{
+		ModelTag: coretesting.ModelTag.String(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.AdmissionPoliciesResult{
+		Policies: []params.AdmissionPolicy{{
+			Name:     "zones",
+			ModelTag: coretesting.ModelTag.String(),
+			Rule:     `constraints.zones is empty`,
+		}},
+	})
+}
+
+func (s *apiSuite) TestListAdmissionPoliciesModelNoAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectModelAccess(permission.ReadAccess, false)
+
+	_, err := s.newAPI().ListAdmissionPolicies(context.Background(), params.AdmissionPoliciesFilter{
+		ModelTag: coretesting.ModelTag.String(),
+	})
+	c.Check(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *apiSuite) TestTestAdmission(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	candidate := admission.Policy{
+		Name:      "edge",
+		ModelUUID: coretesting.ModelTag.Id(),
+		Rule:      `charm.risk == "edge"`,
+	}
+	s.admissionService.EXPECT().EvaluatePolicies(gomock.Any(), coretesting.ModelTag.Id(), gomock.Any(), []admission.Policy{candidate}).
+		DoAndReturn(func(_ context.Context, _ string, req *internaladmission.Request, _ []admission.Policy) ([]admission.Policy, error) {
+			c.Check(req.Fields(), jc.DeepEquals, map[string][]string{
+				internaladmission.FieldOperation: {"deploy"},
+				internaladmission.FieldCharm:     {"mysql"},
+				internaladmission.FieldCharmRisk: {"edge"},
+			})
+			return []admission.Policy{{
+				Name:    "stable-only",
+				Rule:    `charm.risk != "stable"`,
+				Message: "only stable charms may be deployed",
+			}, candidate}, nil
+		})
+
+	result, err := s.newAPI().TestAdmission(context.Background(), params.AdmissionTest{
+		ModelTag:  coretesting.ModelTag.String(),
+		Operation: "deploy",
+		Fields: map[string][]string{
+			internaladmission.FieldCharm:     {"mysql"},
+			internaladmission.FieldCharmRisk: {"edge"},
+		},
+		Policies: []params.AdmissionPolicy{{
+			Name: "edge",
+			Rule: `charm.risk == "edge"`,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.AdmissionTestResult{
+		Denied: []params.AdmissionPolicy{{
+			Name:    "stable-only",
+			Rule:    `charm.risk != "stable"`,
+			Message: "only stable charms may be deployed",
+		}, {
+			Name:     "edge",
+			ModelTag: coretesting.ModelTag.String(),
+			Rule:     `charm.risk == "edge"`,
+		}},
+	})
+}
+
+func (s *apiSuite) TestTestAdmissionNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.expectSuperuser(true)
+
+	api := s.newAPI()
+	result, err := api.TestAdmission(context.Background(), params.AdmissionTest{
+		ModelTag:  coretesting.ModelTag.String(),
+		Operation: "destroy",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.ErrorMatches, `operation "destroy" not valid`)
+
+	result, err = api.TestAdmission(context.Background(), params.AdmissionTest{
+		ModelTag:  coretesting.ModelTag.String(),
+		Operation: "deploy",
+		Fields:    map[string][]string{"colour": {"blue"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.ErrorMatches, `field "colour" not valid`)
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admissionpolicy
+
+import (
+	"context"
+
+	"github.com/juju/juju/domain/admission"
+	internaladmission "github.com/juju/juju/internal/admission"
+)
+
+// AdmissionService provides access to the admission policies of the
+// controller and its models.
+type AdmissionService interface {
+	// AddPolicy adds the admission policy to its model, or to the
+	// controller if it has no model.
+	AddPolicy(ctx context.Context, policy admission.Policy) error
+	// RemovePolicy removes the admission policy with the name from the
+	// model, or from the controller if the model is empty.
+	RemovePolicy(ctx context.Context, modelUUID, name string) error
+	// ListPolicies returns the admission policies of the model, or of the
+	// controller if the model is empty, ordered by name.
+	ListPolicies(ctx context.Context, modelUUID string) ([]admission.Policy, error)
+	// EvaluatePolicies returns the policies which deny the request, of the
+	// admission policies of the controller and the model along with the
+	// candidate policies, which are not stored.
+	EvaluatePolicies(
+		ctx context.Context, modelUUID string, req *internaladmission.Request, candidates []admission.Policy,
+	) ([]admission.Policy, error)
+}
@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/admissionpolicy (interfaces: AdmissionService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package admissionpolicy -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/admissionpolicy AdmissionService
+//
+
+// Package admissionpolicy is a generated GoMock package.
+package admissionpolicy
+
+import (
+	context "context"
+	reflect "reflect"
+
+	admission "github.com/juju/juju/domain/admission"
+	admission0 "github.com/juju/juju/internal/admission"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAdmissionService is a mock of AdmissionService interface.
+type MockAdmissionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionServiceMockRecorder
+}
+
+// MockAdmissionServiceMockRecorder is the mock recorder for MockAdmissionService.
+type MockAdmissionServiceMockRecorder struct {
+	mock *MockAdmissionService
+}
+
+// NewMockAdmissionService creates a new mock instance.
+func NewMockAdmissionService(ctrl *gomock.Controller) *MockAdmissionService {
+	mock := &MockAdmissionService{ctrl: ctrl}
+	mock.recorder = &MockAdmissionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionService) EXPECT() *MockAdmissionServiceMockRecorder {
+	return m.recorder
+}
+
+// AddPolicy mocks base method.
+func (m *MockAdmissionService) AddPolicy(arg0 context.Context, arg1 admission.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPolicy indicates an expected call of AddPolicy.
+func (mr *MockAdmissionServiceMockRecorder) AddPolicy(arg0, arg1 any) *MockAdmissionServiceAddPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicy", reflect.TypeOf((*MockAdmissionService)(nil).AddPolicy), arg0, arg1)
+	return &MockAdmissionServiceAddPolicyCall{Call: call}
+}
+
+// MockAdmissionServiceAddPolicyCall wrap *gomock.Call
+type MockAdmissionServiceAddPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionServiceAddPolicyCall) Return(arg0 error) *MockAdmissionServiceAddPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionServiceAddPolicyCall) Do(f func(context.Context, admission.Policy) error) *MockAdmissionServiceAddPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionServiceAddPolicyCall) DoAndReturn(f func(context.Context, admission.Policy) error) *MockAdmissionServiceAddPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EvaluatePolicies mocks base method.
+func (m *MockAdmissionService) EvaluatePolicies(arg0 context.Context, arg1 string, arg2 *admission0.Request, arg3 []admission.Policy) ([]admission.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluatePolicies", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]admission.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluatePolicies indicates an expected call of EvaluatePolicies.
+func (mr *MockAdmissionServiceMockRecorder) EvaluatePolicies(arg0, arg1, arg2, arg3 any) *MockAdmissionServiceEvaluatePoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluatePolicies", reflect.TypeOf((*MockAdmissionService)(nil).EvaluatePolicies), arg0, arg1, arg2, arg3)
+	return &MockAdmissionServiceEvaluatePoliciesCall{Call: call}
+}
+
+// MockAdmissionServiceEvaluatePoliciesCall wrap *gomock.Call
+type MockAdmissionServiceEvaluatePoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionServiceEvaluatePoliciesCall) Return(arg0 []admission.Policy, arg1 error) *MockAdmissionServiceEvaluatePoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionServiceEvaluatePoliciesCall) Do(f func(context.Context, string, *admission0.Request, []admission.Policy) ([]admission.Policy, error)) *MockAdmissionServiceEvaluatePoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionServiceEvaluatePoliciesCall) DoAndReturn(f func(context.Context, string, *admission0.Request, []admission.Policy) ([]admission.Policy, error)) *MockAdmissionServiceEvaluatePoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPolicies mocks base method.
+func (m *MockAdmissionService) ListPolicies(arg0 context.Context, arg1 string) ([]admission.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]admission.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockAdmissionServiceMockRecorder) ListPolicies(arg0, arg1 any) *MockAdmissionServiceListPoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockAdmissionService)(nil).ListPolicies), arg0, arg1)
+	return &MockAdmissionServiceListPoliciesCall{Call: call}
+}
+
+// MockAdmissionServiceListPoliciesCall wrap *gomock.Call
+type MockAdmissionServiceListPoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionServiceListPoliciesCall) Return(arg0 []admission.Policy, arg1 error) *MockAdmissionServiceListPoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionServiceListPoliciesCall) Do(f func(context.Context, string) ([]admission.Policy, error)) *MockAdmissionServiceListPoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionServiceListPoliciesCall) DoAndReturn(f func(context.Context, string) ([]admission.Policy, error)) *MockAdmissionServiceListPoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemovePolicy mocks base method.
+func (m *MockAdmissionService) RemovePolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePolicy indicates an expected call of RemovePolicy.
+func (mr *MockAdmissionServiceMockRecorder) RemovePolicy(arg0, arg1, arg2 any) *MockAdmissionServiceRemovePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePolicy", reflect.TypeOf((*MockAdmissionService)(nil).RemovePolicy), arg0, arg1, arg2)
+	return &MockAdmissionServiceRemovePolicyCall{Call: call}
+}
+
+// MockAdmissionServiceRemovePolicyCall wrap *gomock.Call
+type MockAdmissionServiceRemovePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionServiceRemovePolicyCall) Return(arg0 error) *MockAdmissionServiceRemovePolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionServiceRemovePolicyCall) Do(f func(context.Context, string, string) error) *MockAdmissionServiceRemovePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionServiceRemovePolicyCall) DoAndReturn(f func(context.Context, string, string) error) *MockAdmissionServiceRemovePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
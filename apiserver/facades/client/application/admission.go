@@ -0,0 +1,178 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	goyaml "gopkg.in/yaml.v2"
+
+	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/constraints"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	"github.com/juju/juju/internal/admission"
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/rpc/params"
+)
+
+// exposeToAll holds the CIDRs of an endpoint that is exposed without
+// spaces or CIDRs.
+var exposeToAll = []string{"0.0.0.0/0", "::/0"}
+
+// checkAdmission evaluates the admission policies against the request. A
+// denial is returned as a forbidden error, holding the reasons for it.
+func (api *APIBase) checkAdmission(ctx context.Context, req *admission.Request) error {
+	err := api.admissionService.CheckAdmission(ctx, api.modelUUID.String(), req)
+	if errors.Is(err, admissionerrors.Denied) {
+		return errors.NewForbidden(nil, err.Error())
+	} else if err != nil {
+		return errors.Annotate(err, "checking admission policies")
+	}
+	return nil
+}
+
+// deployAdmissionRequest returns the admission request for deploying the
+// application.
+func deployAdmissionRequest(arg params.ApplicationDeploy) *admission.Request {
+	req := admission.NewRequest(admission.Deploy).
+		Set(admission.FieldApplication, arg.ApplicationName).
+		Set(admission.FieldConfigKeys, configKeys(arg.Config, arg.ConfigYAML, arg.ApplicationName)...)
+	setCharm(req, arg.CharmURL, arg.CharmOrigin)
+	setConstraintsZones(req, arg.Constraints)
+	return req
+}
+
+// setCharmAdmissionRequest returns the admission request for refreshing
+// the application to the charm.
+func setCharmAdmissionRequest(arg params.ApplicationSetCharmV2) *admission.Request {
+	req := admission.NewRequest(admission.Refresh).
+		Set(admission.FieldApplication, arg.ApplicationName).
+		Set(admission.FieldConfigKeys, configKeys(arg.ConfigSettings, arg.ConfigSettingsYAML, arg.ApplicationName)...)
+	setCharm(req, arg.CharmURL, arg.CharmOrigin)
+	return req
+}
+
+// setCharm sets the name, source and channel of the charm on the request.
+func setCharm(req *admission.Request, charmURL string, origin *params.CharmOrigin) {
+	if curl, err := charm.ParseURL(charmURL); err == nil {
+		req.Set(admission.FieldCharm, curl.Name)
+	}
+	if origin == nil {
+		return
+	}
+	req.Set(admission.FieldCharmSource, origin.Source)
+	if origin.Risk != "" {
+		var track, branch string
+		if origin.Track != nil {
+			track = *origin.Track
+		}
+		if origin.Branch != nil {
+			branch = *origin.Branch
+		}
+		channel := charm.MakePermissiveChannel(track, origin.Risk, branch)
+		req.Set(admission.FieldCharmChannel, channel.String())
+		req.Set(admission.FieldCharmRisk, string(channel.Risk))
+	}
+}
+
+// deployFromRepositoryAdmissionRequest returns the admission request for
+// deploying the application from a charm repository. A charm deployed
+// without a channel is deployed from the stable risk.
+func deployFromRepositoryAdmissionRequest(arg params.DeployFromRepositoryArg) *admission.Request {
+	appName := arg.ApplicationName
+	if appName == "" {
+		appName = arg.CharmName
+	}
+	req := admission.NewRequest(admission.Deploy).
+		Set(admission.FieldApplication, appName).
+		Set(admission.FieldCharm, arg.CharmName).
+		Set(admission.FieldCharmSource, corecharm.CharmHub.String())
+	risk := string(charm.Stable)
+	if arg.Channel != nil {
+		if channel, err := charm.ParseChannelNormalize(*arg.Channel); err == nil {
+			req.Set(admission.FieldCharmChannel, channel.String())
+			risk = string(channel.Risk)
+		}
+	}
+	req.Set(admission.FieldCharmRisk, risk)
+	setConstraintsZones(req, arg.Cons)
+	return req
+}
+
+// exposeAdmissionRequest returns the admission request for exposing the
+// application. Endpoints exposed without spaces or CIDRs are exposed to
+// every address.
+func exposeAdmissionRequest(arg params.ApplicationExpose) *admission.Request {
+	req := admission.NewRequest(admission.Expose).
+		Set(admission.FieldApplication, arg.ApplicationName)
+	if len(arg.ExposedEndpoints) == 0 {
+		return req.Set(admission.FieldExposeCIDRs, exposeToAll...)
+	}
+	endpoints := make([]string, 0, len(arg.ExposedEndpoints))
+	for endpoint := range arg.ExposedEndpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	var spaces, cidrs []string
+	for _, endpoint := range endpoints {
+		details := arg.ExposedEndpoints[endpoint]
+		spaces = append(spaces, details.ExposeToSpaces...)
+		cidrs = append(cidrs, details.ExposeToCIDRs...)
+		if len(details.ExposeToSpaces) == 0 && len(details.ExposeToCIDRs) == 0 {
+			cidrs = append(cidrs, exposeToAll...)
+		}
+	}
+	return req.
+		Set(admission.FieldExposeEndpoints, endpoints...).
+		Set(admission.FieldExposeSpaces, spaces...).
+		Set(admission.FieldExposeCIDRs, cidrs...)
+}
+
+// integrateAdmissionRequest returns the admission request for integrating
+// the endpoints, which are of the form "application[:endpoint]".
+func integrateAdmissionRequest(endpoints []string) *admission.Request {
+	req := admission.NewRequest(admission.Integrate).
+		Set(admission.FieldEndpoints, endpoints...)
+	for _, endpoint := range endpoints {
+		appName, _, _ := strings.Cut(endpoint, ":")
+		req.Set(admission.FieldApplication, appName)
+	}
+	return req
+}
+
+// setConstraintsZones sets the zones of the constraints on the request.
+func setConstraintsZones(req *admission.Request, cons constraints.Value) {
+	if cons.Zones != nil {
+		req.Set(admission.FieldConstraintsZones, *cons.Zones...)
+	}
+}
+
+// configKeys returns the sorted keys of the configuration, and of the
+// settings of the application in the YAML configuration. The YAML is
+// either keyed by application, or the output of "juju config".
+func configKeys(config map[string]string, configYAML, appName string) []string {
+	keys := set.NewStrings()
+	for key := range config {
+		keys.Add(key)
+	}
+	if configYAML != "" {
+		var all map[string]interface{}
+		// Invalid YAML is rejected when the configuration is applied.
+		_ = goyaml.Unmarshal([]byte(configYAML), &all)
+		settings, ok := all[appName].(map[interface{}]interface{})
+		if !ok {
+			settings, _ = all["settings"].(map[interface{}]interface{})
+		}
+		for key := range settings {
+			if name, ok := key.(string); ok {
+				keys.Add(name)
+			}
+		}
+	}
+	return keys.SortedValues()
+}
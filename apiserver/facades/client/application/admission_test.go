@@ -0,0 +1,278 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gomock "go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/constraints"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	"github.com/juju/juju/internal/admission"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+type admissionSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&admissionSuite{})
+
+func (s *admissionSuite) TestDeployDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for deploy by policy "stable-only"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	results, err := s.api.Deploy(context.Background(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{{
+			ApplicationName: "foo",
+			CharmURL:        "ch:amd64/foo-42",
+			CharmOrigin: &params.CharmOrigin{
+				Type:         "charm",
+				Source:       "charm-hub",
+				Base:         params.Base{Name: "ubuntu", Channel: "24.04"},
+				Architecture: "amd64",
+				Track:        ptr("1.0"),
+				Risk:         "edge",
+			},
+			Config:      map[string]string{"b": "1", "a": "2"},
+			Constraints: constraints.MustParse("zones=az1,az2"),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Check(results.Results[0].Error, jc.Satisfies, params.IsCodeForbidden)
+	c.Check(results.Results[0].Error, gc.ErrorMatches, `admission denied for deploy by policy "stable-only"`)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:        {"deploy"},
+		admission.FieldApplication:      {"foo"},
+		admission.FieldCharm:            {"foo"},
+		admission.FieldCharmSource:      {"charm-hub"},
+		admission.FieldCharmChannel:     {"1.0/edge"},
+		admission.FieldCharmRisk:        {"edge"},
+		admission.FieldConfigKeys:       {"a", "b"},
+		admission.FieldConstraintsZones: {"az1", "az2"},
+	})
+}
+
+func (s *admissionSuite) TestDeployFromRepositoryDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for deploy by policy "zones"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	results, err := s.api.DeployFromRepository(context.Background(), params.DeployFromRepositoryArgs{
+		Args: []params.DeployFromRepositoryArg{{
+			CharmName: "foo",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Errors, gc.HasLen, 1)
+	c.Check(results.Results[0].Errors[0], jc.Satisfies, params.IsCodeForbidden)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:   {"deploy"},
+		admission.FieldApplication: {"foo"},
+		admission.FieldCharm:       {"foo"},
+		admission.FieldCharmSource: {"charm-hub"},
+		admission.FieldCharmRisk:   {"stable"},
+	})
+}
+
+func (s *admissionSuite) TestExposeDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for expose by policy "internal-only"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	err := s.api.Expose(context.Background(), params.ApplicationExpose{
+		ApplicationName: "foo",
+		ExposedEndpoints: map[string]params.ExposedEndpoint{
+			"db":      {ExposeToCIDRs: []string{"10.0.0.0/8"}},
+			"website": {},
+			"admin":   {ExposeToSpaces: []string{"internal"}},
+		},
+	})
+	c.Check(err, jc.Satisfies, params.IsCodeForbidden)
+	c.Check(err, gc.ErrorMatches, `admission denied for expose by policy "internal-only"`)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:       {"expose"},
+		admission.FieldApplication:     {"foo"},
+		admission.FieldExposeEndpoints: {"admin", "db", "website"},
+		admission.FieldExposeSpaces:    {"internal"},
+		admission.FieldExposeCIDRs:     {"10.0.0.0/8", "0.0.0.0/0", "::/0"},
+	})
+}
+
+func (s *admissionSuite) TestExposeAllEndpointsDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for expose by policy "internal-only"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	err := s.api.Expose(context.Background(), params.ApplicationExpose{
+		ApplicationName: "foo",
+	})
+	c.Check(err, jc.Satisfies, params.IsCodeForbidden)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:   {"expose"},
+		admission.FieldApplication: {"foo"},
+		admission.FieldExposeCIDRs: {"0.0.0.0/0", "::/0"},
+	})
+}
+
+func (s *admissionSuite) TestAddRelationDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for integrate by policy "no-db"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	_, err := s.api.AddRelation(context.Background(), params.AddRelation{
+		Endpoints: []string{"mattermost", "postgresql:db"},
+	})
+	c.Check(err, jc.ErrorIs, errors.Forbidden)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:   {"integrate"},
+		admission.FieldApplication: {"mattermost", "postgresql"},
+		admission.FieldEndpoints:   {"mattermost", "postgresql:db"},
+	})
+}
+
+func (s *admissionSuite) TestSetConfigsDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for set-config by policy "no-debug"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	res, err := s.api.SetConfigs(context.Background(), params.ConfigSetArgs{
+		Args: []params.ConfigSet{{
+			ApplicationName: "foo",
+			Config:          map[string]string{"debug": "true"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 1)
+	c.Check(res.Results[0].Error, jc.Satisfies, params.IsCodeForbidden)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:   {"set-config"},
+		admission.FieldApplication: {"foo"},
+		admission.FieldConfigKeys:  {"debug"},
+	})
+}
+
+func (s *admissionSuite) TestSetConstraintsDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for set-constraints by policy "zones"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	err := s.api.SetConstraints(context.Background(), params.SetConstraints{
+		ApplicationName: "foo",
+		Constraints:     constraints.MustParse("mem=4G"),
+	})
+	c.Check(err, jc.Satisfies, errors.IsForbidden)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:   {"set-constraints"},
+		admission.FieldApplication: {"foo"},
+	})
+}
+
+func (s *admissionSuite) TestSetCharmDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var got map[string][]string
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for refresh by policy "stable-only"`, admissionerrors.Denied)
+		})
+	s.setupAPI(c)
+
+	err := s.api.SetCharm(context.Background(), params.ApplicationSetCharmV2{
+		ApplicationName: "foo",
+		CharmURL:        "ch:amd64/foo-43",
+		CharmOrigin: &params.CharmOrigin{
+			Type:         "charm",
+			Source:       "charm-hub",
+			Base:         params.Base{Name: "ubuntu", Channel: "24.04"},
+			Architecture: "amd64",
+			Risk:         "edge",
+		},
+		ConfigSettings:     map[string]string{"b": "1"},
+		ConfigSettingsYAML: "foo:\n  a: 2\n",
+	})
+	c.Check(err, jc.Satisfies, errors.IsForbidden)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:    {"refresh"},
+		admission.FieldApplication:  {"foo"},
+		admission.FieldCharm:        {"foo"},
+		admission.FieldCharmSource:  {"charm-hub"},
+		admission.FieldCharmChannel: {"edge"},
+		admission.FieldCharmRisk:    {"edge"},
+		admission.FieldConfigKeys:   {"a", "b"},
+	})
+}
+
+func (s *admissionSuite) TestCheckAdmissionError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		Return(errors.New("boom"))
+	s.setupAPI(c)
+
+	err := s.api.Expose(context.Background(), params.ApplicationExpose{
+		ApplicationName: "foo",
+	})
+	c.Check(err, gc.ErrorMatches, "checking admission policies: boom")
+	c.Check(err, gc.Not(jc.Satisfies), params.IsCodeForbidden)
+}
+
+func (s *admissionSuite) setupAPI(c *gc.C) {
+	s.expectAuthClient()
+	s.expectAnyPermissions()
+	s.expectAnyChangeOrRemoval()
+
+	s.newIAASAPI(c)
+}
@@ -44,6 +44,7 @@ import (
 	resolveerrors "github.com/juju/juju/domain/resolve/errors"
 	"github.com/juju/juju/environs/bootstrap"
 	environsconfig "github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/admission"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/configschema"
@@ -56,11 +57,30 @@ import (
 
 var ClassifyDetachedStorage = storagecommon.ClassifyDetachedStorage
 
+// APIv22 provides the Application API facade for version 22.
+type APIv22 struct {
+	*APIBase
+}
+
+// APIv21 provides the Application API facade for version 21.
+type APIv21 struct {
+	*APIv22
+}
+
 // APIv20 provides the Application API facade for version 20.
 type APIv20 struct {
-	*APIBase
+	*APIv21
 }
 
+// SetAutoscalePolicies isn't on the v20 API.
+func (api *APIv20) SetAutoscalePolicies(_, _ struct{}) {}
+
+// GetAutoscalePolicies isn't on the v20 API.
+func (api *APIv20) GetAutoscalePolicies(_, _ struct{}) {}
+
+// UnsetAutoscalePolicies isn't on the v20 API.
+func (api *APIv20) UnsetAutoscalePolicies(_, _ struct{}) {}
+
 // APIv19 provides the Application API facade for version 19.
 type APIv19 struct {
 	*APIv20
@@ -79,6 +99,7 @@ type APIBase struct {
 
 	modelUUID          model.UUID
 	modelType          model.ModelType
+	admissionService   AdmissionService
 	modelConfigService ModelConfigService
 	machineService     MachineService
 	applicationService ApplicationService
@@ -170,6 +191,7 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 	return NewAPIBase(
 		state,
 		Services{
+			AdmissionService:   domainServices.Admission(),
 			NetworkService:     domainServices.Network(),
 			ModelConfigService: domainServices.Config(),
 			MachineService:     domainServices.Machine(),
@@ -248,6 +270,7 @@ func NewAPIBase(
 		caasBroker:            caasBroker,
 		store:                 store,
 
+		admissionService:   services.AdmissionService,
 		applicationService: services.ApplicationService,
 		resolveService:     services.ResolveService,
 		machineService:     services.MachineService,
@@ -294,6 +317,10 @@ func (api *APIBase) Deploy(ctx context.Context, args params.ApplicationsDeploy)
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
 		}
+		if err := api.checkAdmission(ctx, deployAdmissionRequest(arg)); err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
 		// Fill in the charm origin revision from the charm url if it's absent
 		if arg.CharmOrigin.Revision == nil {
 			curl, err := charm.ParseURL(arg.CharmURL)
@@ -418,12 +445,13 @@ func splitApplicationAndCharmConfigFromYAML(inYaml, appName string) (
 // caasDeployParams contains deploy configuration requiring prechecks
 // specific to a caas.
 type caasDeployParams struct {
-	applicationName string
-	attachStorage   []string
-	charm           CharmMeta
-	config          map[string]string
-	placement       []*instance.Placement
-	storage         map[string]storage.Directive
+	applicationName  string
+	attachStorage    []string
+	storageSnapshots map[string]string
+	charm            CharmMeta
+	config           map[string]string
+	placement        []*instance.Placement
+	storage          map[string]storage.Directive
 }
 
 // precheck, checks the deploy config based on caas specific
@@ -440,6 +468,11 @@ func (c caasDeployParams) precheck(
 			"AttachStorage may not be specified for container models",
 		)
 	}
+	if len(c.storageSnapshots) > 0 {
+		return errors.Errorf(
+			"StorageSnapshots may not be specified for container models",
+		)
+	}
 	if len(c.placement) > 1 {
 		return errors.Errorf(
 			"only 1 placement directive is supported for container models, got %d",
@@ -525,12 +558,13 @@ func (api *APIBase) deployApplication(
 
 	if api.modelType == model.CAAS {
 		caas := caasDeployParams{
-			applicationName: args.ApplicationName,
-			attachStorage:   args.AttachStorage,
-			charm:           ch,
-			config:          args.Config,
-			placement:       args.Placement,
-			storage:         args.Storage,
+			applicationName:  args.ApplicationName,
+			attachStorage:    args.AttachStorage,
+			storageSnapshots: args.StorageSnapshots,
+			charm:            ch,
+			config:           args.Config,
+			placement:        args.Placement,
+			storage:          args.Storage,
 		}
 		if err := caas.precheck(ctx, api.modelConfigService, api.storageService, api.registry, api.caasBroker); err != nil {
 			return errors.Trace(err)
@@ -554,6 +588,9 @@ func (api *APIBase) deployApplication(
 		}
 		attachStorage[i] = tag
 	}
+	if len(args.StorageSnapshots) > 0 && args.NumUnits != 1 {
+		return errors.Errorf("StorageSnapshots is non-empty, but NumUnits is %d", args.NumUnits)
+	}
 
 	bindingsWithSpaceIDs, err := api.convertSpacesToIDInBindings(ctx, args.EndpointBindings)
 	if err != nil {
@@ -581,6 +618,7 @@ func (api *APIBase) deployApplication(
 		Storage:           args.Storage,
 		Devices:           args.Devices,
 		AttachStorage:     attachStorage,
+		StorageSnapshots:  args.StorageSnapshots,
 		EndpointBindings:  bindings.Map(),
 		Resources:         args.Resources,
 		Force:             args.Force,
@@ -857,6 +895,9 @@ func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetChar
 	if err := apiservercharms.ValidateCharmOrigin(args.CharmOrigin); err != nil {
 		return err
 	}
+	if err := api.checkAdmission(ctx, setCharmAdmissionRequest(args)); err != nil {
+		return errors.Trace(err)
+	}
 
 	oneApplication, err := api.backend.Application(args.ApplicationName)
 	if err != nil {
@@ -1180,6 +1221,10 @@ func (api *APIBase) Expose(ctx context.Context, args params.ApplicationExpose) e
 		return errors.Trace(err)
 	}
 
+	if err := api.checkAdmission(ctx, exposeAdmissionRequest(args)); err != nil {
+		return apiservererrors.ServerError(err)
+	}
+
 	// Map space names to space IDs before calling SetExposed
 	mappedExposeParams, err := api.mapExposedEndpointParams(ctx, args.ExposedEndpoints)
 	if err != nil {
@@ -1270,6 +1315,12 @@ func (api *APIBase) AddUnits(ctx context.Context, args params.AddApplicationUnit
 	} else if charmName == bootstrap.ControllerCharmName {
 		return params.AddApplicationUnitsResults{}, errors.NotSupportedf("adding units to the controller application")
 	}
+	req := admission.NewRequest(admission.AddUnit).
+		Set(admission.FieldApplication, args.ApplicationName).
+		Set(admission.FieldCharm, charmName)
+	if err := api.checkAdmission(ctx, req); err != nil {
+		return params.AddApplicationUnitsResults{}, errors.Trace(err)
+	}
 	charm, err := api.getCharm(ctx, locator)
 	if err != nil {
 		return params.AddApplicationUnitsResults{}, errors.Trace(err)
@@ -1643,6 +1694,104 @@ func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleAppl
 	}, nil
 }
 
+// SetAutoscalePolicies sets the autoscale policies of the specified
+// applications, replacing any existing policies.
+func (api *APIBase) SetAutoscalePolicies(ctx context.Context, args params.SetAutoscalePoliciesArgs) (params.ErrorResults, error) {
+	if api.modelType != model.CAAS {
+		return params.ErrorResults{}, errors.NotSupportedf("autoscaling applications on a non-container model")
+	}
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := make([]params.ErrorResult, len(args.Args))
+	for i, arg := range args.Args {
+		appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = api.applicationService.SetApplicationAutoscalePolicy(ctx, appTag.Id(), application.AutoscalePolicy{
+			MinUnits:            arg.Policy.MinUnits,
+			MaxUnits:            arg.Policy.MaxUnits,
+			TargetCPUPercent:    arg.Policy.TargetCPUPercent,
+			TargetMemoryPercent: arg.Policy.TargetMemoryPercent,
+			CustomMetric:        arg.Policy.CustomMetric,
+			CustomMetricTarget:  arg.Policy.CustomMetricTarget,
+		})
+		results[i].Error = apiservererrors.ServerError(autoscaleError(appTag.Id(), err))
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+// GetAutoscalePolicies returns the autoscale policies of the specified
+// applications.
+func (api *APIBase) GetAutoscalePolicies(ctx context.Context, args params.Entities) (params.AutoscalePolicyResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.AutoscalePolicyResults{}, errors.Trace(err)
+	}
+	results := make([]params.AutoscalePolicyResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		appTag, err := names.ParseApplicationTag(arg.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		policy, err := api.applicationService.GetApplicationAutoscalePolicy(ctx, appTag.Id())
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(autoscaleError(appTag.Id(), err))
+			continue
+		}
+		results[i].Policy = &params.AutoscalePolicy{
+			MinUnits:            policy.MinUnits,
+			MaxUnits:            policy.MaxUnits,
+			TargetCPUPercent:    policy.TargetCPUPercent,
+			TargetMemoryPercent: policy.TargetMemoryPercent,
+			CustomMetric:        policy.CustomMetric,
+			CustomMetricTarget:  policy.CustomMetricTarget,
+		}
+	}
+	return params.AutoscalePolicyResults{Results: results}, nil
+}
+
+// UnsetAutoscalePolicies removes the autoscale policies of the specified
+// applications, leaving their scale as it is.
+func (api *APIBase) UnsetAutoscalePolicies(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	results := make([]params.ErrorResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		appTag, err := names.ParseApplicationTag(arg.Tag)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = api.applicationService.RemoveApplicationAutoscalePolicy(ctx, appTag.Id())
+		results[i].Error = apiservererrors.ServerError(autoscaleError(appTag.Id(), err))
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+func autoscaleError(appName string, err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, applicationerrors.ApplicationNotFound):
+		return errors.NotFoundf("application %s", appName)
+	case errors.Is(err, applicationerrors.AutoscalePolicyNotFound):
+		return errors.NotFoundf("autoscale policy for application %s", appName)
+	case errors.Is(err, applicationerrors.AutoscalePolicyNotValid):
+		return errors.NewNotValid(err, "autoscale policy")
+	}
+	return errors.Trace(err)
+}
+
 // GetConstraints returns the constraints for a given application.
 func (api *APIBase) GetConstraints(ctx context.Context, args params.Entities) (params.ApplicationGetConstraintsResults, error) {
 	if err := api.checkCanRead(ctx); err != nil {
@@ -1693,6 +1842,13 @@ func (api *APIBase) SetConstraints(ctx context.Context, args params.SetConstrain
 		return errors.Trace(err)
 	}
 
+	req := admission.NewRequest(admission.SetConstraints).
+		Set(admission.FieldApplication, args.ApplicationName)
+	setConstraintsZones(req, args.Constraints)
+	if err := api.checkAdmission(ctx, req); err != nil {
+		return errors.Trace(err)
+	}
+
 	appID, err := api.applicationService.GetApplicationIDByName(ctx, args.ApplicationName)
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
 		return errors.NotFoundf("application %s", args.ApplicationName)
@@ -1735,6 +1891,9 @@ func (api *APIBase) AddRelation(ctx context.Context, args params.AddRelation) (_
 	if len(args.Endpoints) != 2 {
 		return params.AddRelationResults{}, errors.BadRequestf("a relation should have exactly two endpoints")
 	}
+	if err := api.checkAdmission(ctx, integrateAdmissionRequest(args.Endpoints)); err != nil {
+		return params.AddRelationResults{}, internalerrors.Capture(err)
+	}
 	ep1, ep2, err := api.relationService.AddRelation(
 		ctx, args.Endpoints[0], args.Endpoints[1],
 	)
@@ -1865,6 +2024,13 @@ func (api *APIBase) setConfig(ctx context.Context, arg params.ConfigSet) params.
 		return params.ErrorResult{Error: apiservererrors.ServerError(errors.NotImplementedf("config yaml not supported"))}
 	}
 
+	req := admission.NewRequest(admission.SetConfig).
+		Set(admission.FieldApplication, arg.ApplicationName).
+		Set(admission.FieldConfigKeys, configKeys(arg.Config, "", arg.ApplicationName)...)
+	if err := api.checkAdmission(ctx, req); err != nil {
+		return params.ErrorResult{Error: apiservererrors.ServerError(err)}
+	}
+
 	appID, err := api.applicationService.GetApplicationIDByName(ctx, arg.ApplicationName)
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
 		return params.ErrorResult{Error: apiservererrors.ServerError(errors.NotFoundf("application %q", arg.ApplicationName))}
@@ -1877,7 +2043,8 @@ func (api *APIBase) setConfig(ctx context.Context, arg params.ConfigSet) params.
 	err = api.applicationService.UpdateApplicationConfig(ctx, appID, arg.Config)
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
 		return params.ErrorResult{Error: apiservererrors.ServerError(errors.NotFoundf("application %q", arg.ApplicationName))}
-	} else if errors.Is(err, applicationerrors.InvalidApplicationConfig) {
+	} else if errors.Is(err, applicationerrors.InvalidApplicationConfig) ||
+		errors.Is(err, applicationerrors.DeploymentTypeNotChangeable) {
 		return params.ErrorResult{Error: apiservererrors.ServerError(internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid)))}
 	} else if err != nil {
 		return params.ErrorResult{Error: apiservererrors.ServerError(err)}
@@ -1896,13 +2063,13 @@ func (api *APIBase) UnsetApplicationsConfig(ctx context.Context, args params.App
 	}
 	result.Results = make([]params.ErrorResult, len(args.Args))
 	for i, arg := range args.Args {
-		err := api.unsetApplicationConfig(arg)
+		err := api.unsetApplicationConfig(ctx, arg)
 		result.Results[i].Error = apiservererrors.ServerError(err)
 	}
 	return result, nil
 }
 
-func (api *APIBase) unsetApplicationConfig(arg params.ApplicationUnset) error {
+func (api *APIBase) unsetApplicationConfig(ctx context.Context, arg params.ApplicationUnset) error {
 	app, err := api.backend.Application(arg.ApplicationName)
 	if err != nil {
 		return errors.Trace(err)
@@ -1928,6 +2095,21 @@ func (api *APIBase) unsetApplicationConfig(arg params.ApplicationUnset) error {
 		if err := app.UpdateApplicationConfig(nil, appConfigKeys, configSchema, defaults); err != nil {
 			return errors.Annotate(err, "updating application config values")
 		}
+
+		appID, err := api.applicationService.GetApplicationIDByName(ctx, arg.ApplicationName)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			return errors.NotFoundf("application %q", arg.ApplicationName)
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		err = api.applicationService.UnsetApplicationConfigKeys(ctx, appID, appConfigKeys)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			return errors.NotFoundf("application %q", arg.ApplicationName)
+		} else if errors.Is(err, applicationerrors.DeploymentTypeNotChangeable) {
+			return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid))
+		} else if err != nil {
+			return errors.Annotate(err, "updating application config values")
+		}
 	}
 
 	if len(charmSettings) > 0 {
@@ -2438,6 +2620,10 @@ func (api *APIBase) DeployFromRepository(ctx context.Context, args params.Deploy
 			results[i].Errors = []*params.Error{apiservererrors.ServerError(err)}
 			continue
 		}
+		if err := api.checkAdmission(ctx, deployFromRepositoryAdmissionRequest(entity)); err != nil {
+			results[i].Errors = []*params.Error{apiservererrors.ServerError(err)}
+			continue
+		}
 		entity.EndpointBindings = bindingsWithSpaceIDs
 		info, pending, errs := api.repoDeploy.DeployFromRepository(ctx, entity)
 		if len(errs) > 0 {
@@ -54,6 +54,9 @@ func (api *APIBase) getConfig(
 	appSettings := config.ConfigAttributes{
 		coreapplication.TrustConfigOptionName: appInfo.Trust,
 	}
+	if appInfo.DeploymentType != "" {
+		appSettings[coreapplication.KubernetesDeploymentTypeConfigOptionName] = string(appInfo.DeploymentType)
+	}
 
 	providerSchema, providerDefaults, err := ConfigSchema()
 	if err != nil {
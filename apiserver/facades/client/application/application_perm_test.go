@@ -651,6 +651,17 @@ func (s *permSuiteIAAS) TestScaleApplicationsInvalidForIAAS(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, errors.NotSupported)
 }
 
+func (s *permSuiteIAAS) TestSetAutoscalePoliciesInvalidForIAAS(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+
+	s.newAPI(c)
+
+	_, err := s.api.SetAutoscalePolicies(context.Background(), params.SetAutoscalePoliciesArgs{})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
 type permSuiteCAAS struct {
 	permBaseSuite
 }
@@ -709,3 +720,28 @@ func (s *permSuiteCAAS) TestScaleApplicationsBlocked(c *gc.C) {
 	_, err := s.api.ScaleApplications(context.Background(), params.ScaleApplicationsParams{})
 	c.Assert(err, gc.ErrorMatches, "blocked")
 }
+
+func (s *permSuiteCAAS) TestSetAutoscalePoliciesPermission(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasIncorrectPermission()
+
+	s.newAPI(c)
+
+	_, err := s.api.SetAutoscalePolicies(context.Background(), params.SetAutoscalePoliciesArgs{})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *permSuiteCAAS) TestSetAutoscalePoliciesBlocked(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectAuthClient()
+	s.expectHasWritePermission()
+	s.expectDisallowBlockChange()
+
+	s.newAPI(c)
+
+	_, err := s.api.SetAutoscalePolicies(context.Background(), params.SetAutoscalePoliciesArgs{})
+	c.Assert(err, gc.ErrorMatches, "blocked")
+}
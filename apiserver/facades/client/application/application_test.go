@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/resource/testing"
 	coreunit "github.com/juju/juju/core/unit"
+	applicationdomain "github.com/juju/juju/domain/application"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	applicationservice "github.com/juju/juju/domain/application/service"
@@ -425,6 +426,91 @@ func (s *applicationSuite) TestDeploy(c *gc.C) {
 	c.Assert(errorResults.Results[0].Error, gc.IsNil)
 }
 
+func (s *applicationSuite) TestDeployStorageSnapshots(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectCharm(c, "foo")
+	s.expectCharmConfig(c, 2)
+	s.expectCharmMeta("foo", nil, 8)
+	s.expectReadSequence("foo", 1)
+	var addArgs state.AddApplicationArgs
+	s.backend.EXPECT().AddApplication(gomock.Any(), s.objectStore).DoAndReturn(
+		func(args state.AddApplicationArgs, _ objectstore.ObjectStore) (Application, error) {
+			addArgs = args
+			return s.application, nil
+		})
+	s.applicationService.EXPECT().CreateApplication(gomock.Any(),
+		"foo",
+		gomock.Any(),
+		gomock.Any(),
+		gomock.AssignableToTypeOf(applicationservice.AddApplicationArgs{}),
+		gomock.Any(),
+	).Return(application.ID("app-foo"), nil)
+
+	errorResults, err := s.api.Deploy(context.Background(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{
+			{
+				ApplicationName: "foo",
+				CharmURL:        "local:foo-42",
+				CharmOrigin: &params.CharmOrigin{
+					Type:   "charm",
+					Source: "local",
+					Base: params.Base{
+						Name:    "ubuntu",
+						Channel: "24.04",
+					},
+					Architecture: "amd64",
+					Revision:     ptr(42),
+					Track:        ptr("1.0"),
+					Risk:         "stable",
+				},
+				NumUnits:         1,
+				StorageSnapshots: map[string]string{"data": "snap-0"},
+			},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errorResults.Results, gc.HasLen, 1)
+	c.Assert(errorResults.Results[0].Error, gc.IsNil)
+	c.Check(addArgs.StorageSnapshots, jc.DeepEquals, map[string]string{"data": "snap-0"})
+}
+
+func (s *applicationSuite) TestDeployStorageSnapshotsMultipleUnits(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	s.expectCharm(c, "foo")
+	s.expectCharmConfig(c, 1)
+	s.charm.EXPECT().Meta().Return(&internalcharm.Meta{Name: "foo"}).AnyTimes()
+
+	errorResults, err := s.api.Deploy(context.Background(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{
+			{
+				ApplicationName: "foo",
+				CharmURL:        "local:foo-42",
+				CharmOrigin: &params.CharmOrigin{
+					Type:   "charm",
+					Source: "local",
+					Base: params.Base{
+						Name:    "ubuntu",
+						Channel: "24.04",
+					},
+					Architecture: "amd64",
+					Revision:     ptr(42),
+					Track:        ptr("1.0"),
+					Risk:         "stable",
+				},
+				NumUnits:         2,
+				StorageSnapshots: map[string]string{"data": "snap-0"},
+			},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errorResults.Results, gc.HasLen, 1)
+	c.Assert(errorResults.Results[0].Error, gc.ErrorMatches, `cannot deploy "foo": StorageSnapshots is non-empty, but NumUnits is 2`)
+}
+
 // TestDeployWithResources test the scenario of deploying
 // local charms, or charms via bundles that have resources.
 // Deploy rather than DeployFromRepository is called by the
@@ -1055,6 +1141,87 @@ func (s *applicationSuite) TestResolveUnitErrorsUnitNotFound(c *gc.C) {
 	c.Assert(res.Results[0].Error, jc.Satisfies, params.IsCodeNotFound)
 }
 
+func (s *applicationSuite) TestSetAutoscalePolicies(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupCAASAPI(c)
+
+	s.applicationService.EXPECT().SetApplicationAutoscalePolicy(gomock.Any(), "foo", applicationdomain.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         5,
+		TargetCPUPercent: 70,
+	}).Return(nil)
+	s.applicationService.EXPECT().SetApplicationAutoscalePolicy(gomock.Any(), "bar", applicationdomain.AutoscalePolicy{
+		MinUnits: 1,
+		MaxUnits: 0,
+	}).Return(applicationerrors.AutoscalePolicyNotValid)
+
+	res, err := s.api.SetAutoscalePolicies(context.Background(), params.SetAutoscalePoliciesArgs{
+		Args: []params.SetAutoscalePolicyArg{{
+			ApplicationTag: "application-foo",
+			Policy: params.AutoscalePolicy{
+				MinUnits:         1,
+				MaxUnits:         5,
+				TargetCPUPercent: 70,
+			},
+		}, {
+			ApplicationTag: "application-bar",
+			Policy: params.AutoscalePolicy{
+				MinUnits: 1,
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 2)
+	c.Check(res.Results[0].Error, gc.IsNil)
+	c.Check(res.Results[1].Error, jc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *applicationSuite) TestGetAutoscalePolicies(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupCAASAPI(c)
+
+	s.applicationService.EXPECT().GetApplicationAutoscalePolicy(gomock.Any(), "foo").Return(applicationdomain.AutoscalePolicy{
+		MinUnits:           1,
+		MaxUnits:           5,
+		CustomMetric:       "rps",
+		CustomMetricTarget: 100,
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationAutoscalePolicy(gomock.Any(), "bar").Return(
+		applicationdomain.AutoscalePolicy{}, applicationerrors.AutoscalePolicyNotFound)
+
+	res, err := s.api.GetAutoscalePolicies(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "application-foo"}, {Tag: "application-bar"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 2)
+	c.Check(res.Results[0], jc.DeepEquals, params.AutoscalePolicyResult{
+		Policy: &params.AutoscalePolicy{
+			MinUnits:           1,
+			MaxUnits:           5,
+			CustomMetric:       "rps",
+			CustomMetricTarget: 100,
+		},
+	})
+	c.Check(res.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *applicationSuite) TestUnsetAutoscalePolicies(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupCAASAPI(c)
+
+	s.applicationService.EXPECT().RemoveApplicationAutoscalePolicy(gomock.Any(), "foo").Return(nil)
+
+	res, err := s.api.UnsetAutoscalePolicies(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "application-foo"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 1)
+	c.Check(res.Results[0].Error, gc.IsNil)
+}
+
 func (s *applicationSuite) TestDestroyRelationStub(c *gc.C) {
 	c.Skip("Destroy relation isn't implemented yet.\n" +
 		"Once it will be implemented, the following tests should be added:\n" +
@@ -1091,6 +1258,14 @@ func (s *applicationSuite) setupAPI(c *gc.C) {
 	s.newIAASAPI(c)
 }
 
+func (s *applicationSuite) setupCAASAPI(c *gc.C) {
+	s.expectAuthClient()
+	s.expectAnyPermissions()
+	s.expectAnyChangeOrRemoval()
+
+	s.newCAASAPI(c)
+}
+
 func (s *applicationSuite) expectApplication(c *gc.C, name string) {
 	s.backend.EXPECT().Application(name).Return(s.application, nil)
 }
@@ -108,7 +108,7 @@ type Unit interface {
 	IsPrincipal() bool
 
 	AssignedMachineId() (string, error)
-	AssignUnit() error
+	AssignUnit(state.WarmPool) error
 	AssignWithPlacement(*instance.Placement, network.SpaceInfos) error
 	ContainerInfo() (state.CloudContainer, error)
 }
@@ -264,8 +264,8 @@ type stateUnitShim struct {
 	st *state.State
 }
 
-func (u stateUnitShim) AssignUnit() error {
-	return u.st.AssignUnit(u.Unit)
+func (u stateUnitShim) AssignUnit(pool state.WarmPool) error {
+	return u.st.AssignUnit(u.Unit, pool)
 }
 
 func (u stateUnitShim) AssignWithPlacement(placement *instance.Placement, allSpaces network.SpaceInfos) error {
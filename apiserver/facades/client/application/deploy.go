@@ -11,7 +11,9 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/internal/charms"
+	coreapplication "github.com/juju/juju/core/application"
 	coreassumes "github.com/juju/juju/core/assumes"
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/config"
@@ -68,6 +70,10 @@ type DeployApplicationParams struct {
 	// If set to true, any charm-specific requirements ("assumes" section)
 	// will be ignored.
 	Force bool
+
+	// StorageSnapshots maps storage names to the provider IDs of
+	// snapshots from which the unit's new storage is restored.
+	StorageSnapshots map[string]string
 }
 
 type ApplicationDeployer interface {
@@ -137,6 +143,7 @@ func DeployApplication(
 		CharmOrigin:       origin,
 		Storage:           stateStorageDirectives(args.Storage),
 		AttachStorage:     args.AttachStorage,
+		StorageSnapshots:  args.StorageSnapshots,
 		ApplicationConfig: args.ApplicationConfig,
 		CharmConfig:       charmConfig,
 		NumUnits:          args.NumUnits,
@@ -185,12 +192,13 @@ func DeployApplication(
 			args.Charm,
 			args.CharmOrigin,
 			applicationservice.AddApplicationArgs{
-				ReferenceName:    chURL.Name,
-				Storage:          args.Storage,
-				DownloadInfo:     downloadInfo,
-				PendingResources: pendingResources,
-				EndpointBindings: transformBindings(args.EndpointBindings),
-				Devices:          args.Devices,
+				ReferenceName:       chURL.Name,
+				Storage:             args.Storage,
+				DownloadInfo:        downloadInfo,
+				PendingResources:    pendingResources,
+				EndpointBindings:    transformBindings(args.EndpointBindings),
+				Devices:             args.Devices,
+				ApplicationSettings: applicationSettings(args.ApplicationConfig),
 				ApplicationStatus: &status.StatusInfo{
 					Status: status.Unset,
 					Since:  ptr(clock.Now()),
@@ -205,6 +213,16 @@ func DeployApplication(
 	return app, errors.Trace(err)
 }
 
+// applicationSettings returns the application settings held in the
+// application config passed at deploy time.
+func applicationSettings(cfg *config.Config) domainapplication.ApplicationSettings {
+	attrs := cfg.Attributes()
+	return domainapplication.ApplicationSettings{
+		Trust:          attrs.GetBool(coreapplication.TrustConfigOptionName, false),
+		DeploymentType: applicationcharm.DeploymentType(attrs.GetString(coreapplication.KubernetesDeploymentTypeConfigOptionName, "")),
+	}
+}
+
 func makeUnitArgs(st ApplicationDeployer, args DeployApplicationParams) ([]applicationservice.AddUnitArg, error) {
 	// TODO(dqlite) - remove mongo AddApplication call.
 	// To ensure dqlite unit names match those created in mongo, grab the next unit
@@ -308,7 +326,8 @@ func (api *APIBase) addUnits(
 
 		// Are there still placement directives to use?
 		if i > len(placement)-1 {
-			if err := unit.AssignUnit(); err != nil {
+			pool := common.NewWarmPool(ctx, api.applicationService, api.machineService)
+			if err := unit.AssignUnit(pool); err != nil {
 				return nil, internalerrors.Errorf("acquiring new machine to host unit %q: %w", unitName, err)
 			}
 		} else {
@@ -132,6 +132,7 @@ func (api *DeployFromRepositoryAPI) DeployFromRepository(ctx context.Context, ar
 	_, err = api.state.AddApplication(state.AddApplicationArgs{
 		ApplicationConfig: dt.applicationConfig,
 		AttachStorage:     dt.attachStorage,
+		StorageSnapshots:  dt.storageSnapshots,
 		Charm:             dt.charm,
 		CharmURL:          dt.charmURL.String(),
 		CharmConfig:       dt.charmSettings,
@@ -262,6 +263,7 @@ type deployTemplate struct {
 	applicationConfig *config.Config
 	applicationName   string
 	attachStorage     []names.StorageTag
+	storageSnapshots  map[string]string
 	charm             charm.Charm
 	charmSettings     charm.Settings
 	charmURL          *charm.URL
@@ -320,12 +322,13 @@ func makeDeployFromRepositoryValidator(ctx context.Context, cfg validatorConfig)
 					attachStorage[i] = tag.Id()
 				}
 				cdp := caasDeployParams{
-					applicationName: dt.applicationName,
-					attachStorage:   attachStorage,
-					charm:           dt.charm,
-					config:          nil,
-					placement:       dt.placement,
-					storage:         dt.storage,
+					applicationName:  dt.applicationName,
+					attachStorage:    attachStorage,
+					storageSnapshots: dt.storageSnapshots,
+					charm:            dt.charm,
+					config:           nil,
+					placement:        dt.placement,
+					storage:          dt.storage,
 				}
 				return cdp.precheck(ctx, v.modelConfigService, cfg.storageService, cfg.registry, cfg.caasBroker)
 			},
@@ -409,6 +412,7 @@ func (v *deployFromRepositoryValidator) validate(ctx context.Context, arg params
 	dt.origin = charmResult.Origin
 	dt.placement = arg.Placement
 	dt.storage = arg.Storage
+	dt.storageSnapshots = arg.StorageSnapshots
 	if len(arg.EndpointBindings) > 0 {
 		bindings, err := v.newStateBindings(v.state, arg.EndpointBindings)
 		if err != nil {
@@ -580,6 +584,9 @@ func (v iaasDeployFromRepositoryValidator) ValidateArg(ctx context.Context, arg
 		errs = append(errs, attachStorageErrs...)
 	}
 	dt.attachStorage = attachStorage
+	if len(dt.storageSnapshots) > 0 && dt.numUnits != 1 {
+		errs = append(errs, errors.Errorf("StorageSnapshots is non-empty, but NumUnits is %d", dt.numUnits))
+	}
 	return dt, errs
 }
 
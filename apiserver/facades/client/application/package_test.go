@@ -21,7 +21,7 @@ import (
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService
+//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application AdmissionService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination legacy_mock_test.go github.com/juju/juju/apiserver/facades/client/application Backend,Application,CaasBrokerInterface
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStore
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry
@@ -38,6 +38,7 @@ type baseSuite struct {
 
 	api *APIBase
 
+	admissionService   *MockAdmissionService
 	applicationService *MockApplicationService
 	resolveService     *MockResolveService
 	machineService     *MockMachineService
@@ -71,6 +72,7 @@ type baseSuite struct {
 func (s *baseSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
+	s.admissionService = NewMockAdmissionService(ctrl)
 	s.applicationService = NewMockApplicationService(ctrl)
 	s.resolveService = NewMockResolveService(ctrl)
 	s.machineService = NewMockMachineService(ctrl)
@@ -142,10 +144,16 @@ func (s *baseSuite) newCAASAPI(c *gc.C) {
 func (s *baseSuite) newAPI(c *gc.C, modelType model.ModelType) {
 	s.deployApplication = DeployApplication
 	s.modelType = modelType
+
+	// Requests are admitted unless a test expects otherwise before creating
+	// the API.
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).Return(nil).AnyTimes()
+
 	var err error
 	s.api, err = NewAPIBase(
 		s.backend,
 		Services{
+			AdmissionService:   s.admissionService,
 			NetworkService:     s.networkService,
 			ModelConfigService: s.modelConfigService,
 			MachineService:     s.machineService,
@@ -21,6 +21,14 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Application", 20, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV20(stdCtx, ctx) // Remove remote space, rename storage constraint to storage directive
 	}, reflect.TypeOf((*APIv20)(nil)))
+
+	registry.MustRegister("Application", 21, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV21(stdCtx, ctx) // Added autoscale policies
+	}, reflect.TypeOf((*APIv21)(nil)))
+
+	registry.MustRegister("Application", 22, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV22(stdCtx, ctx) // Added storage snapshots to deploy
+	}, reflect.TypeOf((*APIv22)(nil)))
 }
 
 func newFacadeV19(stdCtx context.Context, ctx facade.ModelContext) (*APIv19, error) {
@@ -32,9 +40,25 @@ func newFacadeV19(stdCtx context.Context, ctx facade.ModelContext) (*APIv19, err
 }
 
 func newFacadeV20(stdCtx context.Context, ctx facade.ModelContext) (*APIv20, error) {
+	api, err := newFacadeV21(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv20{APIv21: api}, nil
+}
+
+func newFacadeV21(stdCtx context.Context, ctx facade.ModelContext) (*APIv21, error) {
+	api, err := newFacadeV22(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIv21{APIv22: api}, nil
+}
+
+func newFacadeV22(stdCtx context.Context, ctx facade.ModelContext) (*APIv22, error) {
 	api, err := newFacadeBase(stdCtx, ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &APIv20{APIBase: api}, nil
+	return &APIv22{APIBase: api}, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/cloud"
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/assumes"
+	corebase "github.com/juju/juju/core/base"
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/credential"
@@ -28,12 +29,14 @@ import (
 	"github.com/juju/juju/domain/relation"
 	"github.com/juju/juju/domain/resolve"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/admission"
 	internalcharm "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/storage"
 )
 
 // Services represents all the services that the application facade requires.
 type Services struct {
+	AdmissionService   AdmissionService
 	ApplicationService ApplicationService
 	ResolveService     ResolveService
 	MachineService     MachineService
@@ -74,9 +77,21 @@ func (s Services) Validate() error {
 	if s.RelationService == nil {
 		return errors.NotValidf("empty RelationService")
 	}
+	if s.AdmissionService == nil {
+		return errors.NotValidf("empty AdmissionService")
+	}
 	return nil
 }
 
+// AdmissionService defines the methods that the facade assumes from the
+// Admission service.
+type AdmissionService interface {
+	// CheckAdmission evaluates the admission policies of the controller and
+	// of the model against the request. An error satisfying
+	// admissionerrors.Denied is returned if a policy denies it.
+	CheckAdmission(ctx context.Context, modelUUID string, req *admission.Request) error
+}
+
 // CredentialService provides access to credentials.
 type CredentialService interface {
 	// CloudCredential returns the cloud credential for the given tag.
@@ -111,12 +126,18 @@ type MachineService interface {
 	// HardwareCharacteristics returns the hardware characteristics of the
 	// specified machine.
 	HardwareCharacteristics(ctx context.Context, machineUUID string) (*instance.HardwareCharacteristics, error)
+	// AddWarmPoolMachine puts the specified machine, started with the
+	// input constraints and base, in the model's warm pool.
+	AddWarmPoolMachine(context.Context, machine.Name, constraints.Value, corebase.Base) error
 }
 
 // ApplicationService instances save an application to dqlite state.
 type ApplicationService interface {
 	// CreateApplication creates the specified application and units if required.
 	CreateApplication(ctx context.Context, name string, charm internalcharm.Charm, origin corecharm.Origin, params applicationservice.AddApplicationArgs, units ...applicationservice.AddUnitArg) (coreapplication.ID, error)
+	// ClaimWarmPoolMachine takes a machine out of the model's warm pool for
+	// a unit with the input constraints and base.
+	ClaimWarmPoolMachine(context.Context, constraints.Value, corebase.Base) (machine.Name, error)
 	// AddUnits adds units to the application.
 	AddUnits(ctx context.Context, storageParentDir, name string, units ...applicationservice.AddUnitArg) error
 	// SetApplicationCharm sets a new charm for the application, validating that aspects such
@@ -128,6 +149,16 @@ type ApplicationService interface {
 	// ChangeApplicationScale alters the existing scale by the provided change amount, returning the new amount.
 	// This is used on CAAS models.
 	ChangeApplicationScale(ctx context.Context, name string, scaleChange int) (int, error)
+	// SetApplicationAutoscalePolicy sets the autoscale policy of the
+	// specified application, replacing any existing policy.
+	// This is used on CAAS models.
+	SetApplicationAutoscalePolicy(ctx context.Context, name string, policy application.AutoscalePolicy) error
+	// GetApplicationAutoscalePolicy returns the autoscale policy of the
+	// specified application.
+	GetApplicationAutoscalePolicy(ctx context.Context, name string) (application.AutoscalePolicy, error)
+	// RemoveApplicationAutoscalePolicy removes the autoscale policy of the
+	// specified application.
+	RemoveApplicationAutoscalePolicy(ctx context.Context, name string) error
 
 	// DestroyApplication prepares an application for removal from the model.
 	DestroyApplication(ctx context.Context, name string) error
@@ -222,6 +253,12 @@ type ApplicationService interface {
 	// [applicationerrors.InvalidApplicationConfig] is returned.
 	UpdateApplicationConfig(context.Context, coreapplication.ID, map[string]string) error
 
+	// UnsetApplicationConfigKeys removes the specified keys from the application
+	// config. If the key does not exist, it is ignored.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	UnsetApplicationConfigKeys(ctx context.Context, appID coreapplication.ID, keys []string) error
+
 	// IsApplicationExposed returns whether the provided application is exposed or not.
 	//
 	// If no application is found, an error satisfying
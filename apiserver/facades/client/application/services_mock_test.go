@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService)
+// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: AdmissionService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService
+//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application AdmissionService,NetworkService,StorageInterface,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService
 //
 
 // Package application is a generated GoMock package.
@@ -17,6 +17,7 @@ import (
 	storagecommon "github.com/juju/juju/apiserver/common/storagecommon"
 	application "github.com/juju/juju/core/application"
 	assumes "github.com/juju/juju/core/assumes"
+	base "github.com/juju/juju/core/base"
 	charm "github.com/juju/juju/core/charm"
 	constraints "github.com/juju/juju/core/constraints"
 	instance "github.com/juju/juju/core/instance"
@@ -31,6 +32,7 @@ import (
 	relation "github.com/juju/juju/domain/relation"
 	resolve "github.com/juju/juju/domain/resolve"
 	config "github.com/juju/juju/environs/config"
+	admission "github.com/juju/juju/internal/admission"
 	charm1 "github.com/juju/juju/internal/charm"
 	storage "github.com/juju/juju/internal/storage"
 	params "github.com/juju/juju/rpc/params"
@@ -39,6 +41,67 @@ import (
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockAdmissionService is a mock of AdmissionService interface.
+type MockAdmissionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionServiceMockRecorder
+}
+
+// MockAdmissionServiceMockRecorder is the mock recorder for MockAdmissionService.
+type MockAdmissionServiceMockRecorder struct {
+	mock *MockAdmissionService
+}
+
+// NewMockAdmissionService creates a new mock instance.
+func NewMockAdmissionService(ctrl *gomock.Controller) *MockAdmissionService {
+	mock := &MockAdmissionService{ctrl: ctrl}
+	mock.recorder = &MockAdmissionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionService) EXPECT() *MockAdmissionServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckAdmission mocks base method.
+func (m *MockAdmissionService) CheckAdmission(arg0 context.Context, arg1 string, arg2 *admission.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAdmission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAdmission indicates an expected call of CheckAdmission.
+func (mr *MockAdmissionServiceMockRecorder) CheckAdmission(arg0, arg1, arg2 any) *MockAdmissionServiceCheckAdmissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdmission", reflect.TypeOf((*MockAdmissionService)(nil).CheckAdmission), arg0, arg1, arg2)
+	return &MockAdmissionServiceCheckAdmissionCall{Call: call}
+}
+
+// MockAdmissionServiceCheckAdmissionCall wrap *gomock.Call
+type MockAdmissionServiceCheckAdmissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionServiceCheckAdmissionCall) Return(arg0 error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionServiceCheckAdmissionCall) Do(f func(context.Context, string, *admission.Request) error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionServiceCheckAdmissionCall) DoAndReturn(f func(context.Context, string, *admission.Request) error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockNetworkService is a mock of NetworkService interface.
 type MockNetworkService struct {
 	ctrl     *gomock.Controller
@@ -603,6 +666,44 @@ func (m *MockMachineService) EXPECT() *MockMachineServiceMockRecorder {
 	return m.recorder
 }
 
+// AddWarmPoolMachine mocks base method.
+func (m *MockMachineService) AddWarmPoolMachine(arg0 context.Context, arg1 machine.Name, arg2 constraints.Value, arg3 base.Base) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWarmPoolMachine", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWarmPoolMachine indicates an expected call of AddWarmPoolMachine.
+func (mr *MockMachineServiceMockRecorder) AddWarmPoolMachine(arg0, arg1, arg2, arg3 any) *MockMachineServiceAddWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWarmPoolMachine", reflect.TypeOf((*MockMachineService)(nil).AddWarmPoolMachine), arg0, arg1, arg2, arg3)
+	return &MockMachineServiceAddWarmPoolMachineCall{Call: call}
+}
+
+// MockMachineServiceAddWarmPoolMachineCall wrap *gomock.Call
+type MockMachineServiceAddWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceAddWarmPoolMachineCall) Return(arg0 error) *MockMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceAddWarmPoolMachineCall) Do(f func(context.Context, machine.Name, constraints.Value, base.Base) error) *MockMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceAddWarmPoolMachineCall) DoAndReturn(f func(context.Context, machine.Name, constraints.Value, base.Base) error) *MockMachineServiceAddWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateMachine mocks base method.
 func (m *MockMachineService) CreateMachine(arg0 context.Context, arg1 machine.Name) (string, error) {
 	m.ctrl.T.Helper()
@@ -825,6 +926,45 @@ func (c *MockApplicationServiceChangeApplicationScaleCall) DoAndReturn(f func(co
 	return c
 }
 
+// ClaimWarmPoolMachine mocks base method.
+func (m *MockApplicationService) ClaimWarmPoolMachine(arg0 context.Context, arg1 constraints.Value, arg2 base.Base) (machine.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWarmPoolMachine", arg0, arg1, arg2)
+	ret0, _ := ret[0].(machine.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWarmPoolMachine indicates an expected call of ClaimWarmPoolMachine.
+func (mr *MockApplicationServiceMockRecorder) ClaimWarmPoolMachine(arg0, arg1, arg2 any) *MockApplicationServiceClaimWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWarmPoolMachine", reflect.TypeOf((*MockApplicationService)(nil).ClaimWarmPoolMachine), arg0, arg1, arg2)
+	return &MockApplicationServiceClaimWarmPoolMachineCall{Call: call}
+}
+
+// MockApplicationServiceClaimWarmPoolMachineCall wrap *gomock.Call
+type MockApplicationServiceClaimWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceClaimWarmPoolMachineCall) Return(arg0 machine.Name, arg1 error) *MockApplicationServiceClaimWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceClaimWarmPoolMachineCall) Do(f func(context.Context, constraints.Value, base.Base) (machine.Name, error)) *MockApplicationServiceClaimWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceClaimWarmPoolMachineCall) DoAndReturn(f func(context.Context, constraints.Value, base.Base) (machine.Name, error)) *MockApplicationServiceClaimWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateApplication mocks base method.
 func (m *MockApplicationService) CreateApplication(arg0 context.Context, arg1 string, arg2 charm1.Charm, arg3 charm.Origin, arg4 service.AddApplicationArgs, arg5 ...service.AddUnitArg) (application.ID, error) {
 	m.ctrl.T.Helper()
@@ -1022,6 +1162,45 @@ func (c *MockApplicationServiceGetApplicationAndCharmConfigCall) DoAndReturn(f f
 	return c
 }
 
+// GetApplicationAutoscalePolicy mocks base method.
+func (m *MockApplicationService) GetApplicationAutoscalePolicy(arg0 context.Context, arg1 string) (application0.AutoscalePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAutoscalePolicy", arg0, arg1)
+	ret0, _ := ret[0].(application0.AutoscalePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAutoscalePolicy indicates an expected call of GetApplicationAutoscalePolicy.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationAutoscalePolicy(arg0, arg1 any) *MockApplicationServiceGetApplicationAutoscalePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAutoscalePolicy", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationAutoscalePolicy), arg0, arg1)
+	return &MockApplicationServiceGetApplicationAutoscalePolicyCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationAutoscalePolicyCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationAutoscalePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationAutoscalePolicyCall) Return(arg0 application0.AutoscalePolicy, arg1 error) *MockApplicationServiceGetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationAutoscalePolicyCall) Do(f func(context.Context, string) (application0.AutoscalePolicy, error)) *MockApplicationServiceGetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationAutoscalePolicyCall) DoAndReturn(f func(context.Context, string) (application0.AutoscalePolicy, error)) *MockApplicationServiceGetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationConstraints mocks base method.
 func (m *MockApplicationService) GetApplicationConstraints(arg0 context.Context, arg1 application.ID) (constraints.Value, error) {
 	m.ctrl.T.Helper()
@@ -1647,6 +1826,82 @@ func (c *MockApplicationServiceMergeExposeSettingsCall) DoAndReturn(f func(conte
 	return c
 }
 
+// RemoveApplicationAutoscalePolicy mocks base method.
+func (m *MockApplicationService) RemoveApplicationAutoscalePolicy(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveApplicationAutoscalePolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveApplicationAutoscalePolicy indicates an expected call of RemoveApplicationAutoscalePolicy.
+func (mr *MockApplicationServiceMockRecorder) RemoveApplicationAutoscalePolicy(arg0, arg1 any) *MockApplicationServiceRemoveApplicationAutoscalePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveApplicationAutoscalePolicy", reflect.TypeOf((*MockApplicationService)(nil).RemoveApplicationAutoscalePolicy), arg0, arg1)
+	return &MockApplicationServiceRemoveApplicationAutoscalePolicyCall{Call: call}
+}
+
+// MockApplicationServiceRemoveApplicationAutoscalePolicyCall wrap *gomock.Call
+type MockApplicationServiceRemoveApplicationAutoscalePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceRemoveApplicationAutoscalePolicyCall) Return(arg0 error) *MockApplicationServiceRemoveApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceRemoveApplicationAutoscalePolicyCall) Do(f func(context.Context, string) error) *MockApplicationServiceRemoveApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceRemoveApplicationAutoscalePolicyCall) DoAndReturn(f func(context.Context, string) error) *MockApplicationServiceRemoveApplicationAutoscalePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetApplicationAutoscalePolicy mocks base method.
+func (m *MockApplicationService) SetApplicationAutoscalePolicy(arg0 context.Context, arg1 string, arg2 application0.AutoscalePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationAutoscalePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationAutoscalePolicy indicates an expected call of SetApplicationAutoscalePolicy.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationAutoscalePolicy(arg0, arg1, arg2 any) *MockApplicationServiceSetApplicationAutoscalePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationAutoscalePolicy", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationAutoscalePolicy), arg0, arg1, arg2)
+	return &MockApplicationServiceSetApplicationAutoscalePolicyCall{Call: call}
+}
+
+// MockApplicationServiceSetApplicationAutoscalePolicyCall wrap *gomock.Call
+type MockApplicationServiceSetApplicationAutoscalePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetApplicationAutoscalePolicyCall) Return(arg0 error) *MockApplicationServiceSetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetApplicationAutoscalePolicyCall) Do(f func(context.Context, string, application0.AutoscalePolicy) error) *MockApplicationServiceSetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetApplicationAutoscalePolicyCall) DoAndReturn(f func(context.Context, string, application0.AutoscalePolicy) error) *MockApplicationServiceSetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationCharm mocks base method.
 func (m *MockApplicationService) SetApplicationCharm(arg0 context.Context, arg1 string, arg2 service.UpdateCharmParams) error {
 	m.ctrl.T.Helper()
@@ -1761,6 +2016,44 @@ func (c *MockApplicationServiceSetApplicationScaleCall) DoAndReturn(f func(conte
 	return c
 }
 
+// UnsetApplicationConfigKeys mocks base method.
+func (m *MockApplicationService) UnsetApplicationConfigKeys(arg0 context.Context, arg1 application.ID, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsetApplicationConfigKeys", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsetApplicationConfigKeys indicates an expected call of UnsetApplicationConfigKeys.
+func (mr *MockApplicationServiceMockRecorder) UnsetApplicationConfigKeys(arg0, arg1, arg2 any) *MockApplicationServiceUnsetApplicationConfigKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetApplicationConfigKeys", reflect.TypeOf((*MockApplicationService)(nil).UnsetApplicationConfigKeys), arg0, arg1, arg2)
+	return &MockApplicationServiceUnsetApplicationConfigKeysCall{Call: call}
+}
+
+// MockApplicationServiceUnsetApplicationConfigKeysCall wrap *gomock.Call
+type MockApplicationServiceUnsetApplicationConfigKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceUnsetApplicationConfigKeysCall) Return(arg0 error) *MockApplicationServiceUnsetApplicationConfigKeysCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceUnsetApplicationConfigKeysCall) Do(f func(context.Context, application.ID, []string) error) *MockApplicationServiceUnsetApplicationConfigKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceUnsetApplicationConfigKeysCall) DoAndReturn(f func(context.Context, application.ID, []string) error) *MockApplicationServiceUnsetApplicationConfigKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UnsetExposeSettings mocks base method.
 func (m *MockApplicationService) UnsetExposeSettings(arg0 context.Context, arg1 string, arg2 set.Strings) error {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ import (
 	"github.com/juju/schema"
 
 	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/configschema"
 )
 
@@ -18,6 +19,16 @@ var trustFields = configschema.Fields{
 		Type:        configschema.Tbool,
 		Group:       configschema.JujuGroup,
 	},
+	application.KubernetesDeploymentTypeConfigOptionName: {
+		Description: "The deployment type of a kubernetes application, overriding the one declared by the charm. It can't be changed once the application's workload exists",
+		Type:        configschema.Tstring,
+		Group:       configschema.JujuGroup,
+		Values: []interface{}{
+			string(charm.DeploymentStateful),
+			string(charm.DeploymentStateless),
+			string(charm.DeploymentDaemon),
+		},
+	},
 }
 
 var trustDefaults = schema.Defaults{
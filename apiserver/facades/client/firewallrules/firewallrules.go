@@ -0,0 +1,462 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewallrules
+
+import (
+	"context"
+	"sort"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/naturalsort"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	// sourceExpose is the source of ingress rules which result from
+	// exposing an application.
+	sourceExpose = "expose"
+	// sourceFirewallRule is the source of ingress rules which result from
+	// application firewall rules.
+	sourceFirewallRule = "firewall-rule"
+)
+
+// API manages the firewall rules of applications and the named CIDR sets
+// they may allow ingress from.
+type API struct {
+	networkService     NetworkService
+	applicationService ApplicationService
+	machineService     MachineService
+	portService        PortService
+	authorizer         facade.Authorizer
+	modelTag           names.ModelTag
+	modelType          model.ModelType
+}
+
+// NewAPI returns a new FirewallRules API facade.
+func NewAPI(
+	networkService NetworkService,
+	applicationService ApplicationService,
+	machineService MachineService,
+	portService PortService,
+	authorizer facade.Authorizer,
+	modelTag names.ModelTag,
+	modelType model.ModelType,
+) *API {
+	return &API{
+		networkService:     networkService,
+		applicationService: applicationService,
+		machineService:     machineService,
+		portService:        portService,
+		authorizer:         authorizer,
+		modelTag:           modelTag,
+		modelType:          modelType,
+	}
+}
+
+func (api *API) checkCanRead(ctx context.Context) error {
+	return api.authorizer.HasPermission(ctx, permission.ReadAccess, api.modelTag)
+}
+
+func (api *API) checkCanAdmin(ctx context.Context) error {
+	return api.authorizer.HasPermission(ctx, permission.AdminAccess, api.modelTag)
+}
+
+// SetCIDRSets creates the CIDR sets, or replaces the CIDRs of existing
+// sets. Only model admins may manage CIDR sets.
+func (api *API) SetCIDRSets(ctx context.Context, args params.CIDRSets) (params.ErrorResults, error) {
+	if err := api.checkCanAdmin(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Sets)),
+	}
+	for i, arg := range args.Sets {
+		err := api.networkService.SetCIDRSet(ctx, arg.Name, arg.CIDRs)
+		result.Results[i].Error = apiservererrors.ServerError(firewallRuleError(err))
+	}
+	return result, nil
+}
+
+// RemoveCIDRSets removes the CIDR sets. Sets which are referenced by
+// application firewall rules cannot be removed.
+func (api *API) RemoveCIDRSets(ctx context.Context, args params.CIDRSetNames) (params.ErrorResults, error) {
+	if err := api.checkCanAdmin(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		err := api.networkService.RemoveCIDRSet(ctx, name)
+		result.Results[i].Error = apiservererrors.ServerError(firewallRuleError(err))
+	}
+	return result, nil
+}
+
+// CIDRSets returns the CIDR sets of the model, ordered by name.
+func (api *API) CIDRSets(ctx context.Context) (params.CIDRSetsResult, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.CIDRSetsResult{}, errors.Trace(err)
+	}
+	sets, err := api.networkService.GetCIDRSets(ctx)
+	if err != nil {
+		return params.CIDRSetsResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	result := params.CIDRSetsResult{
+		Sets: make([]params.CIDRSet, len(sets)),
+	}
+	for i, set := range sets {
+		result.Sets[i] = params.CIDRSet{
+			Name:  set.Name,
+			CIDRs: set.CIDRs,
+		}
+	}
+	return result, nil
+}
+
+// SetApplicationFirewallRules adds the firewall rules to their
+// applications, replacing any rule with the same endpoint and port range.
+// Only model admins may manage firewall rules. The firewaller of
+// Kubernetes models does not apply firewall rules, so they cannot be set
+// there.
+func (api *API) SetApplicationFirewallRules(ctx context.Context, args params.ApplicationFirewallRules) (params.ErrorResults, error) {
+	if err := api.checkCanAdmin(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	if api.modelType == model.CAAS {
+		return params.ErrorResults{}, errors.NotSupportedf("application firewall rules on kubernetes models")
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Rules)),
+	}
+	for i, arg := range args.Rules {
+		appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		rule := application.FirewallRule{
+			Endpoint:  arg.Endpoint,
+			PortRange: portRangeFromParams(arg.PortRange),
+			CIDRs:     set.NewStrings(arg.CIDRs...),
+			CIDRSets:  set.NewStrings(arg.CIDRSets...),
+		}
+		err = api.applicationService.SetFirewallRule(ctx, appTag.Name, rule)
+		result.Results[i].Error = apiservererrors.ServerError(firewallRuleError(err))
+	}
+	return result, nil
+}
+
+// RemoveApplicationFirewallRules removes the firewall rules of the
+// applications for the endpoints and port ranges.
+func (api *API) RemoveApplicationFirewallRules(ctx context.Context, args params.ApplicationFirewallRuleIDs) (params.ErrorResults, error) {
+	if err := api.checkCanAdmin(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Rules)),
+	}
+	for i, arg := range args.Rules {
+		appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = api.applicationService.RemoveFirewallRule(ctx, appTag.Name, arg.Endpoint, portRangeFromParams(arg.PortRange))
+		result.Results[i].Error = apiservererrors.ServerError(firewallRuleError(err))
+	}
+	return result, nil
+}
+
+// ApplicationFirewallRules returns the firewall rules of all applications
+// in the model, ordered by application.
+func (api *API) ApplicationFirewallRules(ctx context.Context) (params.ApplicationFirewallRulesResult, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.ApplicationFirewallRulesResult{}, errors.Trace(err)
+	}
+	appRules, err := api.applicationService.GetAllFirewallRules(ctx)
+	if err != nil {
+		return params.ApplicationFirewallRulesResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	appNames := make([]string, 0, len(appRules))
+	for appName := range appRules {
+		appNames = append(appNames, appName)
+	}
+	sort.Strings(appNames)
+
+	result := params.ApplicationFirewallRulesResult{
+		Rules: []params.ApplicationFirewallRule{},
+	}
+	for _, appName := range appNames {
+		for _, rule := range appRules[appName] {
+			result.Rules = append(result.Rules, params.ApplicationFirewallRule{
+				ApplicationTag: names.NewApplicationTag(appName).String(),
+				Endpoint:       rule.Endpoint,
+				PortRange:      portRangeToParams(rule.PortRange),
+				CIDRs:          rule.CIDRs.SortedValues(),
+				CIDRSets:       rule.CIDRSets.SortedValues(),
+				SourceCIDRs:    rule.SourceCIDRs.SortedValues(),
+			})
+		}
+	}
+	return result, nil
+}
+
+// MachineFirewallRules returns the effective ingress rules of the machines,
+// or of all machines in the model if none are given. The rules result from
+// exposing the applications of the machine's units, and from their
+// firewall rules.
+func (api *API) MachineFirewallRules(ctx context.Context, args params.Entities) (params.MachineFirewallRulesResults, error) {
+	if err := api.checkCanRead(ctx); err != nil {
+		return params.MachineFirewallRulesResults{}, errors.Trace(err)
+	}
+
+	entities := args.Entities
+	if len(entities) == 0 {
+		machineNames, err := api.machineService.AllMachineNames(ctx)
+		if err != nil {
+			return params.MachineFirewallRulesResults{}, errors.Trace(err)
+		}
+		ids := make([]string, len(machineNames))
+		for i, name := range machineNames {
+			ids[i] = name.String()
+		}
+		naturalsort.Sort(ids)
+		for _, id := range ids {
+			entities = append(entities, params.Entity{Tag: names.NewMachineTag(id).String()})
+		}
+	}
+
+	rules := &machineRules{api: api}
+	result := params.MachineFirewallRulesResults{
+		Results: make([]params.MachineFirewallRulesResult, len(entities)),
+	}
+	for i, entity := range entities {
+		result.Results[i].MachineTag = entity.Tag
+		machineTag, err := names.ParseMachineTag(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		machineRules, err := rules.forMachine(ctx, machine.Name(machineTag.Id()))
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(firewallRuleError(err))
+			continue
+		}
+		result.Results[i].Rules = machineRules
+	}
+	return result, nil
+}
+
+// machineRules computes the effective ingress rules of machines, caching
+// the details of the applications and spaces they depend on.
+type machineRules struct {
+	api *API
+
+	spaceInfos network.SpaceInfos
+	apps       map[string]*appRules
+}
+
+// appRules holds the details of an application which determine the
+// ingress rules of its units.
+type appRules struct {
+	exposedEndpoints map[string]application.ExposedEndpoint
+	firewallRules    []application.FirewallRule
+}
+
+func (m *machineRules) forMachine(ctx context.Context, name machine.Name) ([]params.MachineIngressRule, error) {
+	machineUUID, err := m.api.machineService.GetMachineUUID(ctx, name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	unitNames, err := m.api.applicationService.GetUnitNamesOnMachine(ctx, name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	openedPorts, err := m.api.portService.GetMachineOpenedPorts(ctx, machineUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	type ruleKey struct {
+		application string
+		source      string
+	}
+	ingress := make(map[ruleKey]firewall.IngressRules)
+	for _, unitName := range unitNames {
+		appName := unitName.Application()
+		app, err := m.application(ctx, appName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+
+		// Firewall rules with a port range apply to every unit, while
+		// the other rules only apply to the ports opened by the unit.
+		unitPortRanges := openedPorts[unitName]
+		exposeKey := ruleKey{application: appName, source: sourceExpose}
+		ingress[exposeKey] = append(ingress[exposeKey], exposeIngressRules(app.exposedEndpoints, unitPortRanges)...)
+		firewallRuleKey := ruleKey{application: appName, source: sourceFirewallRule}
+		for _, rule := range app.firewallRules {
+			ingress[firewallRuleKey] = append(ingress[firewallRuleKey], rule.IngressRules(unitPortRanges)...)
+		}
+	}
+
+	result := []params.MachineIngressRule{}
+	for key, rules := range ingress {
+		for _, rule := range rules.UniqueRules() {
+			result = append(result, params.MachineIngressRule{
+				Application: key.application,
+				Source:      key.source,
+				PortRange:   params.FromNetworkPortRange(rule.PortRange),
+				SourceCIDRs: rule.SourceCIDRs.SortedValues(),
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Application != result[j].Application {
+			return result[i].Application < result[j].Application
+		}
+		if result[i].Source != result[j].Source {
+			return result[i].Source < result[j].Source
+		}
+		return result[i].PortRange.NetworkPortRange().LessThan(result[j].PortRange.NetworkPortRange())
+	})
+	return result, nil
+}
+
+// application returns the details of the application which determine the
+// ingress rules of its units. The CIDRs of the spaces the application is
+// exposed to are resolved into the CIDRs of its exposed endpoints.
+func (m *machineRules) application(ctx context.Context, appName string) (*appRules, error) {
+	if app, ok := m.apps[appName]; ok {
+		return app, nil
+	}
+
+	app := &appRules{}
+	exposed, err := m.api.applicationService.IsApplicationExposed(ctx, appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if exposed {
+		if app.exposedEndpoints, err = m.api.applicationService.GetExposedEndpoints(ctx, appName); err != nil {
+			return nil, errors.Trace(err)
+		}
+		for endpoint, exposeDetails := range app.exposedEndpoints {
+			if exposeDetails.ExposeToSpaceIDs.Size() == 0 {
+				continue
+			}
+			if m.spaceInfos == nil {
+				if m.spaceInfos, err = m.api.networkService.GetAllSpaces(ctx); err != nil {
+					return nil, errors.Trace(err)
+				}
+			}
+			cidrs := set.NewStrings(exposeDetails.ExposeToCIDRs.Values()...)
+			for _, spaceID := range exposeDetails.ExposeToSpaceIDs.Values() {
+				if space := m.spaceInfos.GetByID(spaceID); space != nil {
+					for _, subnet := range space.Subnets {
+						cidrs.Add(subnet.CIDR)
+					}
+				}
+			}
+			exposeDetails.ExposeToCIDRs = cidrs
+			app.exposedEndpoints[endpoint] = exposeDetails
+		}
+	}
+	if app.firewallRules, err = m.api.applicationService.GetFirewallRules(ctx, appName); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if m.apps == nil {
+		m.apps = make(map[string]*appRules)
+	}
+	m.apps[appName] = app
+	return app, nil
+}
+
+// exposeIngressRules returns the ingress rules for the port ranges opened
+// by a unit which result from exposing its application. As in the
+// firewaller, a named endpoint's expose settings apply to the ports opened
+// for the endpoint and for all endpoints, and supersede those of the
+// wildcard endpoint.
+func exposeIngressRules(
+	exposedEndpoints map[string]application.ExposedEndpoint, openedPorts network.GroupedPortRanges,
+) firewall.IngressRules {
+	var rules firewall.IngressRules
+	for exposedEndpoint, exposeDetails := range exposedEndpoints {
+		if exposeDetails.ExposeToCIDRs.Size() == 0 {
+			continue
+		}
+		cidrs := exposeDetails.ExposeToCIDRs.Values()
+		if exposedEndpoint != network.WildcardEndpoint {
+			for _, portRange := range openedPorts[exposedEndpoint] {
+				rules = append(rules, firewall.NewIngressRule(portRange, cidrs...))
+			}
+			for _, portRange := range openedPorts[network.WildcardEndpoint] {
+				rules = append(rules, firewall.NewIngressRule(portRange, cidrs...))
+			}
+			continue
+		}
+		for endpoint, portRanges := range openedPorts {
+			if _, hasExposeOverride := exposedEndpoints[endpoint]; hasExposeOverride && endpoint != network.WildcardEndpoint {
+				continue
+			}
+			for _, portRange := range portRanges {
+				rules = append(rules, firewall.NewIngressRule(portRange, cidrs...))
+			}
+		}
+	}
+	return rules
+}
+
+// firewallRuleError converts the errors of the network and application
+// services into errors which are understood by the API.
+func firewallRuleError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, applicationerrors.ApplicationNotFound),
+		errors.Is(err, applicationerrors.EndpointNotFound),
+		errors.Is(err, applicationerrors.FirewallRuleNotFound),
+		errors.Is(err, applicationerrors.MachineNotFound),
+		errors.Is(err, machineerrors.MachineNotFound),
+		errors.Is(err, networkerrors.CIDRSetNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, applicationerrors.FirewallRuleNotValid),
+		errors.Is(err, networkerrors.CIDRSetNameNotValid):
+		return errors.NewNotValid(err, "")
+	case errors.Is(err, networkerrors.CIDRSetInUse):
+		return errors.NewNotSupported(err, "")
+	}
+	return err
+}
+
+func portRangeFromParams(arg *params.PortRange) *network.PortRange {
+	if arg == nil {
+		return nil
+	}
+	portRange := arg.NetworkPortRange()
+	return &portRange
+}
+
+func portRangeToParams(portRange *network.PortRange) *params.PortRange {
+	if portRange == nil {
+		return nil
+	}
+	arg := params.FromNetworkPortRange(*portRange)
+	return &arg
+}
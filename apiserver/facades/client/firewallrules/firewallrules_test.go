@@ -0,0 +1,352 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewallrules
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	domainnetwork "github.com/juju/juju/domain/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type apiSuite struct {
+	networkService     *MockNetworkService
+	applicationService *MockApplicationService
+	machineService     *MockMachineService
+	portService        *MockPortService
+	authorizer         *MockAuthorizer
+
+	modelType model.ModelType
+}
+
+var _ = gc.Suite(&apiSuite{})
+
+func (s *apiSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.networkService = NewMockNetworkService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.machineService = NewMockMachineService(ctrl)
+	s.portService = NewMockPortService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.modelType = model.IAAS
+	return ctrl
+}
+
+func (s *apiSuite) newAPI() *API {
+	return NewAPI(
+		s.networkService,
+		s.applicationService,
+		s.machineService,
+		s.portService,
+		s.authorizer,
+		coretesting.ModelTag,
+		s.modelType,
+	)
+}
+
+func (s *apiSuite) expectModelAccess(access permission.Access, hasAccess bool) {
+	var err error
+	if !hasAccess {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), access, coretesting.ModelTag).Return(err)
+}
+
+func (s *apiSuite) TestSetCIDRSets(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelAccess(permission.AdminAccess, true)
+	s.networkService.EXPECT().SetCIDRSet(gomock.Any(), "office", []string{"10.0.0.0/8"}).Return(nil)
+	s.networkService.EXPECT().SetCIDRSet(gomock.Any(), "bad name", []string{"10.0.0.0/8"}).Return(
+		errors.Annotate(networkerrors.CIDRSetNameNotValid, `"bad name"`),
+	)
+
+	result, err := s.newAPI().SetCIDRSets(context.Background(), params.CIDRSets{
+		Sets: []params.CIDRSet{{
+			Name:  "office",
+			CIDRs: []string{"10.0.0.0/8"},
+		}, {
+			Name:  "bad name",
+			CIDRs: []string{"10.0.0.0/8"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *apiSuite) TestSetCIDRSetsNotModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelAccess(permission.AdminAccess, false)
+
+	_, err := s.newAPI().SetCIDRSets(context.Background(), params.CIDRSets{
+		Sets: []params.CIDRSet{{Name: "office", CIDRs: []string{"10.0.0.0/8"}}},
+	})
+	c.Assert(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
+func (s *apiSuite) TestRemoveCIDRSets(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelAccess(permission.AdminAccess, true)
+	s.networkService.EXPECT().RemoveCIDRSet(gomock.Any(), "office").Return(nil)
+	s.networkService.EXPECT().RemoveCIDRSet(gomock.Any(), "missing").Return(networkerrors.CIDRSetNotFound)
+	s.networkService.EXPECT().RemoveCIDRSet(gomock.Any(), "vpn").Return(networkerrors.CIDRSetInUse)
+
+	result, err := s.newAPI().RemoveCIDRSets(context.Background(), params.CIDRSetNames{
+		Names: []string{"office", "missing", "vpn"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(result.Results[2].Error, jc.Satisfies, params.IsCodeNotSupported)
+}
+
+func (s *apiSuite) TestCIDRSets(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelAccess(permission.ReadAccess, true)
+	s.networkService.EXPECT().GetCIDRSets(gomock.Any()).Return([]domainnetwork.CIDRSet{{
+		Name:  "office",
+		CIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+	}}, nil)
+
+	result, err := s.newAPI().CIDRSets(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.CIDRSetsResult{
+		Sets: []params.CIDRSet{{
+			Name:  "office",
+			CIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		}},
+	})
+}
+
+func (s *apiSuite) TestSetApplicationFirewallRules(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	portRange := network.MustParsePortRange("5432/tcp")
+	s.expectModelAccess(permission.AdminAccess, true)
+	s.applicationService.EXPECT().SetFirewallRule(gomock.Any(), "postgresql", application.FirewallRule{
+		Endpoint:  "db",
+		PortRange: &portRange,
+		CIDRs:     set.NewStrings("10.0.0.0/8"),
+		CIDRSets:  set.NewStrings("office"),
+	}).Return(nil)
+	s.applicationService.EXPECT().SetFirewallRule(gomock.Any(), "wordpress", application.FirewallRule{
+		Endpoint: "missing",
+		CIDRs:    set.NewStrings("10.0.0.0/8"),
+		CIDRSets: set.NewStrings(),
+	}).Return(applicationerrors.EndpointNotFound)
+
+	result, err := s.newAPI().SetApplicationFirewallRules(context.Background(), params.ApplicationFirewallRules{
+		Rules: []params.ApplicationFirewallRule{{
+			ApplicationTag: "application-postgresql",
+			Endpoint:       "db",
+			PortRange:      &params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+			CIDRs:          []string{"10.0.0.0/8"},
+			CIDRSets:       []string{"office"},
+		}, {
+			ApplicationTag: "application-wordpress",
+			Endpoint:       "missing",
+			CIDRs:          []string{"10.0.0.0/8"},
+		}, {
+			ApplicationTag: "machine-0",
+			CIDRs:          []string{"10.0.0.0/8"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `"machine-0" is not a valid application tag`)
+}
+
+func (s *apiSuite) TestSetApplicationFirewallRulesCAAS(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelAccess(permission.AdminAccess, true)
+	s.modelType = model.CAAS
+
+	_, err := s.newAPI().SetApplicationFirewallRules(context.Background(), params.ApplicationFirewallRules{
+		Rules: []params.ApplicationFirewallRule{{
+			ApplicationTag: "application-postgresql",
+			CIDRs:          []string{"10.0.0.0/8"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *apiSuite) TestRemoveApplicationFirewallRules(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	portRange := network.MustParsePortRange("5432/tcp")
+	s.expectModelAccess(permission.AdminAccess, true)
+	s.applicationService.EXPECT().RemoveFirewallRule(gomock.Any(), "postgresql", "db", &portRange).Return(nil)
+	s.applicationService.EXPECT().RemoveFirewallRule(gomock.Any(), "wordpress", "", nil).Return(applicationerrors.FirewallRuleNotFound)
+
+	result, err := s.newAPI().RemoveApplicationFirewallRules(context.Background(), params.ApplicationFirewallRuleIDs{
+		Rules: []params.ApplicationFirewallRuleID{{
+			ApplicationTag: "application-postgresql",
+			Endpoint:       "db",
+			PortRange:      &params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+		}, {
+			ApplicationTag: "application-wordpress",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) TestApplicationFirewallRules(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	portRange := network.MustParsePortRange("5432/tcp")
+	s.expectModelAccess(permission.ReadAccess, true)
+	s.applicationService.EXPECT().GetAllFirewallRules(gomock.Any()).Return(map[string][]application.FirewallRule{
+		"wordpress": {{
+			CIDRs:       set.NewStrings("203.0.113.0/24"),
+			CIDRSets:    set.NewStrings(),
+			SourceCIDRs: set.NewStrings("203.0.113.0/24"),
+		}},
+		"postgresql": {{
+			Endpoint:    "db",
+			PortRange:   &portRange,
+			CIDRs:       set.NewStrings(),
+			CIDRSets:    set.NewStrings("office"),
+			SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+		}},
+	}, nil)
+
+	result, err := s.newAPI().ApplicationFirewallRules(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.ApplicationFirewallRulesResult{
+		Rules: []params.ApplicationFirewallRule{{
+			ApplicationTag: "application-postgresql",
+			Endpoint:       "db",
+			PortRange:      &params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+			CIDRs:          []string{},
+			CIDRSets:       []string{"office"},
+			SourceCIDRs:    []string{"10.0.0.0/8"},
+		}, {
+			ApplicationTag: "application-wordpress",
+			CIDRs:          []string{"203.0.113.0/24"},
+			CIDRSets:       []string{},
+			SourceCIDRs:    []string{"203.0.113.0/24"},
+		}},
+	})
+}
+
+func (s *apiSuite) TestMachineFirewallRules(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelAccess(permission.ReadAccess, true)
+	s.machineService.EXPECT().AllMachineNames(gomock.Any()).Return([]machine.Name{"10", "2"}, nil)
+
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("2")).Return("machine-2-uuid", nil)
+	s.applicationService.EXPECT().GetUnitNamesOnMachine(gomock.Any(), machine.Name("2")).Return(
+		[]unit.Name{"wordpress/0", "postgresql/0"}, nil,
+	)
+	s.portService.EXPECT().GetMachineOpenedPorts(gomock.Any(), "machine-2-uuid").Return(map[unit.Name]network.GroupedPortRanges{
+		"wordpress/0": {
+			"":    {network.MustParsePortRange("80/tcp")},
+			"url": {network.MustParsePortRange("8080/tcp")},
+		},
+	}, nil)
+
+	// wordpress is exposed on all its endpoints to a space, and has a
+	// firewall rule for its "url" endpoint.
+	s.applicationService.EXPECT().IsApplicationExposed(gomock.Any(), "wordpress").Return(true, nil)
+	s.applicationService.EXPECT().GetExposedEndpoints(gomock.Any(), "wordpress").Return(map[string]application.ExposedEndpoint{
+		"": {ExposeToSpaceIDs: set.NewStrings("sp-1")},
+	}, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{{
+		ID:      "sp-1",
+		Subnets: network.SubnetInfos{{CIDR: "42.42.0.0/16"}},
+	}}, nil)
+	s.applicationService.EXPECT().GetFirewallRules(gomock.Any(), "wordpress").Return([]application.FirewallRule{{
+		Endpoint:    "url",
+		SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+	}}, nil)
+
+	// postgresql is not exposed and has opened no ports, but has a
+	// firewall rule with a port range.
+	portRange := network.MustParsePortRange("5432/tcp")
+	s.applicationService.EXPECT().IsApplicationExposed(gomock.Any(), "postgresql").Return(false, nil)
+	s.applicationService.EXPECT().GetFirewallRules(gomock.Any(), "postgresql").Return([]application.FirewallRule{{
+		PortRange:   &portRange,
+		SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+	}}, nil)
+
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("10")).Return("", machineerrors.MachineNotFound)
+
+	result, err := s.newAPI().MachineFirewallRules(context.Background(), params.Entities{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0], jc.DeepEquals, params.MachineFirewallRulesResult{
+		MachineTag: "machine-2",
+		Rules: []params.MachineIngressRule{{
+			Application: "postgresql",
+			Source:      "firewall-rule",
+			PortRange:   params.PortRange{FromPort: 5432, ToPort: 5432, Protocol: "tcp"},
+			SourceCIDRs: []string{"10.0.0.0/8"},
+		}, {
+			Application: "wordpress",
+			Source:      "expose",
+			PortRange:   params.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+			SourceCIDRs: []string{"42.42.0.0/16"},
+		}, {
+			Application: "wordpress",
+			Source:      "expose",
+			PortRange:   params.PortRange{FromPort: 8080, ToPort: 8080, Protocol: "tcp"},
+			SourceCIDRs: []string{"42.42.0.0/16"},
+		}, {
+			Application: "wordpress",
+			Source:      "firewall-rule",
+			PortRange:   params.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+			SourceCIDRs: []string{"10.0.0.0/8"},
+		}, {
+			Application: "wordpress",
+			Source:      "firewall-rule",
+			PortRange:   params.PortRange{FromPort: 8080, ToPort: 8080, Protocol: "tcp"},
+			SourceCIDRs: []string{"10.0.0.0/8"},
+		}},
+	})
+	c.Check(result.Results[1].MachineTag, gc.Equals, "machine-10")
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) TestMachineFirewallRulesInvalidTag(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelAccess(permission.ReadAccess, true)
+
+	result, err := s.newAPI().MachineFirewallRules(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "unit-wordpress-0"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Error, gc.ErrorMatches, `"unit-wordpress-0" is not a valid machine tag`)
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewallrules
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("FirewallRules", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newAPI(stdCtx, ctx)
+	}, reflect.TypeOf((*API)(nil)))
+}
+
+func newAPI(stdCtx context.Context, ctx facade.ModelContext) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	domainServices := ctx.DomainServices()
+	modelInfo, err := domainServices.ModelInfo().GetModelInfo(stdCtx)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting model info")
+	}
+	return NewAPI(
+		domainServices.Network(),
+		domainServices.Application(),
+		domainServices.Machine(),
+		domainServices.Port(),
+		authorizer,
+		names.NewModelTag(ctx.ModelUUID().String()),
+		modelInfo.Type,
+	), nil
+}
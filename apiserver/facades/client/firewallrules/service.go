@@ -0,0 +1,68 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewallrules
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	domainnetwork "github.com/juju/juju/domain/network"
+)
+
+// NetworkService provides access to the CIDR sets and spaces of the model.
+type NetworkService interface {
+	// SetCIDRSet creates the named CIDR set, or replaces the CIDRs of an
+	// existing set.
+	SetCIDRSet(ctx context.Context, name string, cidrs []string) error
+	// RemoveCIDRSet removes the named CIDR set.
+	RemoveCIDRSet(ctx context.Context, name string) error
+	// GetCIDRSets returns all the CIDR sets in the model.
+	GetCIDRSets(ctx context.Context) ([]domainnetwork.CIDRSet, error)
+	// GetAllSpaces returns all spaces for the model.
+	GetAllSpaces(ctx context.Context) (network.SpaceInfos, error)
+}
+
+// ApplicationService provides access to the firewall rules and expose
+// settings of applications.
+type ApplicationService interface {
+	// GetAllFirewallRules returns the firewall rules of all applications,
+	// keyed by application name.
+	GetAllFirewallRules(ctx context.Context) (map[string][]application.FirewallRule, error)
+	// GetFirewallRules returns the firewall rules of the application.
+	GetFirewallRules(ctx context.Context, appName string) ([]application.FirewallRule, error)
+	// SetFirewallRule adds the firewall rule to the application, replacing
+	// any rule with the same endpoint and port range.
+	SetFirewallRule(ctx context.Context, appName string, rule application.FirewallRule) error
+	// RemoveFirewallRule removes the firewall rule of the application for
+	// the endpoint and port range.
+	RemoveFirewallRule(ctx context.Context, appName, endpoint string, portRange *network.PortRange) error
+	// GetUnitNamesOnMachine returns the names of the units on the machine.
+	GetUnitNamesOnMachine(ctx context.Context, machineName machine.Name) ([]unit.Name, error)
+	// IsApplicationExposed returns whether the application is exposed.
+	IsApplicationExposed(ctx context.Context, appName string) (bool, error)
+	// GetExposedEndpoints returns map where keys are endpoint names (or the
+	// "" value which represents all endpoints) and values are
+	// ExposedEndpoint instances that specify which sources (spaces or
+	// CIDRs) can access the opened ports for each endpoint once the
+	// application is exposed.
+	GetExposedEndpoints(ctx context.Context, appName string) (map[string]application.ExposedEndpoint, error)
+}
+
+// MachineService provides access to the machines of the model.
+type MachineService interface {
+	// AllMachineNames returns the names of all machines in the model.
+	AllMachineNames(ctx context.Context) ([]machine.Name, error)
+	// GetMachineUUID returns the UUID of a machine identified by its name.
+	GetMachineUUID(ctx context.Context, name machine.Name) (string, error)
+}
+
+// PortService provides access to the ports opened by units.
+type PortService interface {
+	// GetMachineOpenedPorts returns the opened ports for all the units on
+	// the machine, grouped by unit name and endpoint.
+	GetMachineOpenedPorts(ctx context.Context, machineUUID string) (map[unit.Name]network.GroupedPortRanges, error)
+}
@@ -0,0 +1,656 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/firewallrules (interfaces: NetworkService,ApplicationService,MachineService,PortService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package firewallrules -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/firewallrules NetworkService,ApplicationService,MachineService,PortService
+//
+
+// Package firewallrules is a generated GoMock package.
+package firewallrules
+
+import (
+	context "context"
+	reflect "reflect"
+
+	machine "github.com/juju/juju/core/machine"
+	network "github.com/juju/juju/core/network"
+	unit "github.com/juju/juju/core/unit"
+	application "github.com/juju/juju/domain/application"
+	network0 "github.com/juju/juju/domain/network"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNetworkService is a mock of NetworkService interface.
+type MockNetworkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkServiceMockRecorder
+}
+
+// MockNetworkServiceMockRecorder is the mock recorder for MockNetworkService.
+type MockNetworkServiceMockRecorder struct {
+	mock *MockNetworkService
+}
+
+// NewMockNetworkService creates a new mock instance.
+func NewMockNetworkService(ctrl *gomock.Controller) *MockNetworkService {
+	mock := &MockNetworkService{ctrl: ctrl}
+	mock.recorder = &MockNetworkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkService) EXPECT() *MockNetworkServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllSpaces mocks base method.
+func (m *MockNetworkService) GetAllSpaces(arg0 context.Context) (network.SpaceInfos, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSpaces", arg0)
+	ret0, _ := ret[0].(network.SpaceInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSpaces indicates an expected call of GetAllSpaces.
+func (mr *MockNetworkServiceMockRecorder) GetAllSpaces(arg0 any) *MockNetworkServiceGetAllSpacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSpaces", reflect.TypeOf((*MockNetworkService)(nil).GetAllSpaces), arg0)
+	return &MockNetworkServiceGetAllSpacesCall{Call: call}
+}
+
+// MockNetworkServiceGetAllSpacesCall wrap *gomock.Call
+type MockNetworkServiceGetAllSpacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetAllSpacesCall) Return(arg0 network.SpaceInfos, arg1 error) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetAllSpacesCall) Do(f func(context.Context) (network.SpaceInfos, error)) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetAllSpacesCall) DoAndReturn(f func(context.Context) (network.SpaceInfos, error)) *MockNetworkServiceGetAllSpacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCIDRSets mocks base method.
+func (m *MockNetworkService) GetCIDRSets(arg0 context.Context) ([]network0.CIDRSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCIDRSets", arg0)
+	ret0, _ := ret[0].([]network0.CIDRSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCIDRSets indicates an expected call of GetCIDRSets.
+func (mr *MockNetworkServiceMockRecorder) GetCIDRSets(arg0 any) *MockNetworkServiceGetCIDRSetsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIDRSets", reflect.TypeOf((*MockNetworkService)(nil).GetCIDRSets), arg0)
+	return &MockNetworkServiceGetCIDRSetsCall{Call: call}
+}
+
+// MockNetworkServiceGetCIDRSetsCall wrap *gomock.Call
+type MockNetworkServiceGetCIDRSetsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceGetCIDRSetsCall) Return(arg0 []network0.CIDRSet, arg1 error) *MockNetworkServiceGetCIDRSetsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceGetCIDRSetsCall) Do(f func(context.Context) ([]network0.CIDRSet, error)) *MockNetworkServiceGetCIDRSetsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceGetCIDRSetsCall) DoAndReturn(f func(context.Context) ([]network0.CIDRSet, error)) *MockNetworkServiceGetCIDRSetsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveCIDRSet mocks base method.
+func (m *MockNetworkService) RemoveCIDRSet(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCIDRSet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCIDRSet indicates an expected call of RemoveCIDRSet.
+func (mr *MockNetworkServiceMockRecorder) RemoveCIDRSet(arg0, arg1 any) *MockNetworkServiceRemoveCIDRSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCIDRSet", reflect.TypeOf((*MockNetworkService)(nil).RemoveCIDRSet), arg0, arg1)
+	return &MockNetworkServiceRemoveCIDRSetCall{Call: call}
+}
+
+// MockNetworkServiceRemoveCIDRSetCall wrap *gomock.Call
+type MockNetworkServiceRemoveCIDRSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceRemoveCIDRSetCall) Return(arg0 error) *MockNetworkServiceRemoveCIDRSetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceRemoveCIDRSetCall) Do(f func(context.Context, string) error) *MockNetworkServiceRemoveCIDRSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceRemoveCIDRSetCall) DoAndReturn(f func(context.Context, string) error) *MockNetworkServiceRemoveCIDRSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetCIDRSet mocks base method.
+func (m *MockNetworkService) SetCIDRSet(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCIDRSet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCIDRSet indicates an expected call of SetCIDRSet.
+func (mr *MockNetworkServiceMockRecorder) SetCIDRSet(arg0, arg1, arg2 any) *MockNetworkServiceSetCIDRSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCIDRSet", reflect.TypeOf((*MockNetworkService)(nil).SetCIDRSet), arg0, arg1, arg2)
+	return &MockNetworkServiceSetCIDRSetCall{Call: call}
+}
+
+// MockNetworkServiceSetCIDRSetCall wrap *gomock.Call
+type MockNetworkServiceSetCIDRSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceSetCIDRSetCall) Return(arg0 error) *MockNetworkServiceSetCIDRSetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceSetCIDRSetCall) Do(f func(context.Context, string, []string) error) *MockNetworkServiceSetCIDRSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceSetCIDRSetCall) DoAndReturn(f func(context.Context, string, []string) error) *MockNetworkServiceSetCIDRSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllFirewallRules mocks base method.
+func (m *MockApplicationService) GetAllFirewallRules(arg0 context.Context) (map[string][]application.FirewallRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFirewallRules", arg0)
+	ret0, _ := ret[0].(map[string][]application.FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFirewallRules indicates an expected call of GetAllFirewallRules.
+func (mr *MockApplicationServiceMockRecorder) GetAllFirewallRules(arg0 any) *MockApplicationServiceGetAllFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFirewallRules", reflect.TypeOf((*MockApplicationService)(nil).GetAllFirewallRules), arg0)
+	return &MockApplicationServiceGetAllFirewallRulesCall{Call: call}
+}
+
+// MockApplicationServiceGetAllFirewallRulesCall wrap *gomock.Call
+type MockApplicationServiceGetAllFirewallRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetAllFirewallRulesCall) Return(arg0 map[string][]application.FirewallRule, arg1 error) *MockApplicationServiceGetAllFirewallRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetAllFirewallRulesCall) Do(f func(context.Context) (map[string][]application.FirewallRule, error)) *MockApplicationServiceGetAllFirewallRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetAllFirewallRulesCall) DoAndReturn(f func(context.Context) (map[string][]application.FirewallRule, error)) *MockApplicationServiceGetAllFirewallRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetExposedEndpoints mocks base method.
+func (m *MockApplicationService) GetExposedEndpoints(arg0 context.Context, arg1 string) (map[string]application.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExposedEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(map[string]application.ExposedEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExposedEndpoints indicates an expected call of GetExposedEndpoints.
+func (mr *MockApplicationServiceMockRecorder) GetExposedEndpoints(arg0, arg1 any) *MockApplicationServiceGetExposedEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedEndpoints", reflect.TypeOf((*MockApplicationService)(nil).GetExposedEndpoints), arg0, arg1)
+	return &MockApplicationServiceGetExposedEndpointsCall{Call: call}
+}
+
+// MockApplicationServiceGetExposedEndpointsCall wrap *gomock.Call
+type MockApplicationServiceGetExposedEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetExposedEndpointsCall) Return(arg0 map[string]application.ExposedEndpoint, arg1 error) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetExposedEndpointsCall) Do(f func(context.Context, string) (map[string]application.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetExposedEndpointsCall) DoAndReturn(f func(context.Context, string) (map[string]application.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetFirewallRules mocks base method.
+func (m *MockApplicationService) GetFirewallRules(arg0 context.Context, arg1 string) ([]application.FirewallRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirewallRules", arg0, arg1)
+	ret0, _ := ret[0].([]application.FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirewallRules indicates an expected call of GetFirewallRules.
+func (mr *MockApplicationServiceMockRecorder) GetFirewallRules(arg0, arg1 any) *MockApplicationServiceGetFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRules", reflect.TypeOf((*MockApplicationService)(nil).GetFirewallRules), arg0, arg1)
+	return &MockApplicationServiceGetFirewallRulesCall{Call: call}
+}
+
+// MockApplicationServiceGetFirewallRulesCall wrap *gomock.Call
+type MockApplicationServiceGetFirewallRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetFirewallRulesCall) Return(arg0 []application.FirewallRule, arg1 error) *MockApplicationServiceGetFirewallRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetFirewallRulesCall) Do(f func(context.Context, string) ([]application.FirewallRule, error)) *MockApplicationServiceGetFirewallRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetFirewallRulesCall) DoAndReturn(f func(context.Context, string) ([]application.FirewallRule, error)) *MockApplicationServiceGetFirewallRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUnitNamesOnMachine mocks base method.
+func (m *MockApplicationService) GetUnitNamesOnMachine(arg0 context.Context, arg1 machine.Name) ([]unit.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitNamesOnMachine", arg0, arg1)
+	ret0, _ := ret[0].([]unit.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitNamesOnMachine indicates an expected call of GetUnitNamesOnMachine.
+func (mr *MockApplicationServiceMockRecorder) GetUnitNamesOnMachine(arg0, arg1 any) *MockApplicationServiceGetUnitNamesOnMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitNamesOnMachine", reflect.TypeOf((*MockApplicationService)(nil).GetUnitNamesOnMachine), arg0, arg1)
+	return &MockApplicationServiceGetUnitNamesOnMachineCall{Call: call}
+}
+
+// MockApplicationServiceGetUnitNamesOnMachineCall wrap *gomock.Call
+type MockApplicationServiceGetUnitNamesOnMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetUnitNamesOnMachineCall) Return(arg0 []unit.Name, arg1 error) *MockApplicationServiceGetUnitNamesOnMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetUnitNamesOnMachineCall) Do(f func(context.Context, machine.Name) ([]unit.Name, error)) *MockApplicationServiceGetUnitNamesOnMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetUnitNamesOnMachineCall) DoAndReturn(f func(context.Context, machine.Name) ([]unit.Name, error)) *MockApplicationServiceGetUnitNamesOnMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsApplicationExposed mocks base method.
+func (m *MockApplicationService) IsApplicationExposed(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsApplicationExposed", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsApplicationExposed indicates an expected call of IsApplicationExposed.
+func (mr *MockApplicationServiceMockRecorder) IsApplicationExposed(arg0, arg1 any) *MockApplicationServiceIsApplicationExposedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsApplicationExposed", reflect.TypeOf((*MockApplicationService)(nil).IsApplicationExposed), arg0, arg1)
+	return &MockApplicationServiceIsApplicationExposedCall{Call: call}
+}
+
+// MockApplicationServiceIsApplicationExposedCall wrap *gomock.Call
+type MockApplicationServiceIsApplicationExposedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceIsApplicationExposedCall) Return(arg0 bool, arg1 error) *MockApplicationServiceIsApplicationExposedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceIsApplicationExposedCall) Do(f func(context.Context, string) (bool, error)) *MockApplicationServiceIsApplicationExposedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceIsApplicationExposedCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockApplicationServiceIsApplicationExposedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveFirewallRule mocks base method.
+func (m *MockApplicationService) RemoveFirewallRule(arg0 context.Context, arg1, arg2 string, arg3 *network.PortRange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFirewallRule", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFirewallRule indicates an expected call of RemoveFirewallRule.
+func (mr *MockApplicationServiceMockRecorder) RemoveFirewallRule(arg0, arg1, arg2, arg3 any) *MockApplicationServiceRemoveFirewallRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFirewallRule", reflect.TypeOf((*MockApplicationService)(nil).RemoveFirewallRule), arg0, arg1, arg2, arg3)
+	return &MockApplicationServiceRemoveFirewallRuleCall{Call: call}
+}
+
+// MockApplicationServiceRemoveFirewallRuleCall wrap *gomock.Call
+type MockApplicationServiceRemoveFirewallRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceRemoveFirewallRuleCall) Return(arg0 error) *MockApplicationServiceRemoveFirewallRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceRemoveFirewallRuleCall) Do(f func(context.Context, string, string, *network.PortRange) error) *MockApplicationServiceRemoveFirewallRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceRemoveFirewallRuleCall) DoAndReturn(f func(context.Context, string, string, *network.PortRange) error) *MockApplicationServiceRemoveFirewallRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetFirewallRule mocks base method.
+func (m *MockApplicationService) SetFirewallRule(arg0 context.Context, arg1 string, arg2 application.FirewallRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFirewallRule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFirewallRule indicates an expected call of SetFirewallRule.
+func (mr *MockApplicationServiceMockRecorder) SetFirewallRule(arg0, arg1, arg2 any) *MockApplicationServiceSetFirewallRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFirewallRule", reflect.TypeOf((*MockApplicationService)(nil).SetFirewallRule), arg0, arg1, arg2)
+	return &MockApplicationServiceSetFirewallRuleCall{Call: call}
+}
+
+// MockApplicationServiceSetFirewallRuleCall wrap *gomock.Call
+type MockApplicationServiceSetFirewallRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetFirewallRuleCall) Return(arg0 error) *MockApplicationServiceSetFirewallRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetFirewallRuleCall) Do(f func(context.Context, string, application.FirewallRule) error) *MockApplicationServiceSetFirewallRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetFirewallRuleCall) DoAndReturn(f func(context.Context, string, application.FirewallRule) error) *MockApplicationServiceSetFirewallRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockMachineService is a mock of MachineService interface.
+type MockMachineService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMachineServiceMockRecorder
+}
+
+// MockMachineServiceMockRecorder is the mock recorder for MockMachineService.
+type MockMachineServiceMockRecorder struct {
+	mock *MockMachineService
+}
+
+// NewMockMachineService creates a new mock instance.
+func NewMockMachineService(ctrl *gomock.Controller) *MockMachineService {
+	mock := &MockMachineService{ctrl: ctrl}
+	mock.recorder = &MockMachineServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMachineService) EXPECT() *MockMachineServiceMockRecorder {
+	return m.recorder
+}
+
+// AllMachineNames mocks base method.
+func (m *MockMachineService) AllMachineNames(arg0 context.Context) ([]machine.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllMachineNames", arg0)
+	ret0, _ := ret[0].([]machine.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllMachineNames indicates an expected call of AllMachineNames.
+func (mr *MockMachineServiceMockRecorder) AllMachineNames(arg0 any) *MockMachineServiceAllMachineNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllMachineNames", reflect.TypeOf((*MockMachineService)(nil).AllMachineNames), arg0)
+	return &MockMachineServiceAllMachineNamesCall{Call: call}
+}
+
+// MockMachineServiceAllMachineNamesCall wrap *gomock.Call
+type MockMachineServiceAllMachineNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceAllMachineNamesCall) Return(arg0 []machine.Name, arg1 error) *MockMachineServiceAllMachineNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceAllMachineNamesCall) Do(f func(context.Context) ([]machine.Name, error)) *MockMachineServiceAllMachineNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceAllMachineNamesCall) DoAndReturn(f func(context.Context) ([]machine.Name, error)) *MockMachineServiceAllMachineNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineUUID mocks base method.
+func (m *MockMachineService) GetMachineUUID(arg0 context.Context, arg1 machine.Name) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineUUID", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineUUID indicates an expected call of GetMachineUUID.
+func (mr *MockMachineServiceMockRecorder) GetMachineUUID(arg0, arg1 any) *MockMachineServiceGetMachineUUIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineUUID", reflect.TypeOf((*MockMachineService)(nil).GetMachineUUID), arg0, arg1)
+	return &MockMachineServiceGetMachineUUIDCall{Call: call}
+}
+
+// MockMachineServiceGetMachineUUIDCall wrap *gomock.Call
+type MockMachineServiceGetMachineUUIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceGetMachineUUIDCall) Return(arg0 string, arg1 error) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceGetMachineUUIDCall) Do(f func(context.Context, machine.Name) (string, error)) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceGetMachineUUIDCall) DoAndReturn(f func(context.Context, machine.Name) (string, error)) *MockMachineServiceGetMachineUUIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPortService is a mock of PortService interface.
+type MockPortService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPortServiceMockRecorder
+}
+
+// MockPortServiceMockRecorder is the mock recorder for MockPortService.
+type MockPortServiceMockRecorder struct {
+	mock *MockPortService
+}
+
+// NewMockPortService creates a new mock instance.
+func NewMockPortService(ctrl *gomock.Controller) *MockPortService {
+	mock := &MockPortService{ctrl: ctrl}
+	mock.recorder = &MockPortServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPortService) EXPECT() *MockPortServiceMockRecorder {
+	return m.recorder
+}
+
+// GetMachineOpenedPorts mocks base method.
+func (m *MockPortService) GetMachineOpenedPorts(arg0 context.Context, arg1 string) (map[unit.Name]network.GroupedPortRanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineOpenedPorts", arg0, arg1)
+	ret0, _ := ret[0].(map[unit.Name]network.GroupedPortRanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineOpenedPorts indicates an expected call of GetMachineOpenedPorts.
+func (mr *MockPortServiceMockRecorder) GetMachineOpenedPorts(arg0, arg1 any) *MockPortServiceGetMachineOpenedPortsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineOpenedPorts", reflect.TypeOf((*MockPortService)(nil).GetMachineOpenedPorts), arg0, arg1)
+	return &MockPortServiceGetMachineOpenedPortsCall{Call: call}
+}
+
+// MockPortServiceGetMachineOpenedPortsCall wrap *gomock.Call
+type MockPortServiceGetMachineOpenedPortsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPortServiceGetMachineOpenedPortsCall) Return(arg0 map[unit.Name]network.GroupedPortRanges, arg1 error) *MockPortServiceGetMachineOpenedPortsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPortServiceGetMachineOpenedPortsCall) Do(f func(context.Context, string) (map[unit.Name]network.GroupedPortRanges, error)) *MockPortServiceGetMachineOpenedPortsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPortServiceGetMachineOpenedPortsCall) DoAndReturn(f func(context.Context, string) (map[unit.Name]network.GroupedPortRanges, error)) *MockPortServiceGetMachineOpenedPortsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
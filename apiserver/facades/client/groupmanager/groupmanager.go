@@ -0,0 +1,183 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package groupmanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API manages the access granted to groups of users of the OpenID Connect
+// identity provider of the controller. Users logging in with the provider
+// have the access granted to the groups it claims they belong to.
+type API struct {
+	accessService AccessService
+	authorizer    facade.Authorizer
+	controllerTag names.ControllerTag
+}
+
+// NewAPI returns a new GroupManager API facade.
+func NewAPI(
+	accessService AccessService,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+) *API {
+	return &API{
+		accessService: accessService,
+		authorizer:    authorizer,
+		controllerTag: controllerTag,
+	}
+}
+
+// GrantGroups grants access to groups on models or the controller,
+// replacing any access the groups had on them. Controller superusers may
+// grant access on any target, and model admins on their models.
+func (api *API) GrantGroups(ctx context.Context, args params.GroupGrants) (params.ErrorResults, error) {
+	return api.changeGroupGrants(ctx, args, api.accessService.GrantGroupAccess)
+}
+
+// RevokeGroups revokes the access of groups on models or the controller.
+// The access in the grants is ignored. Controller superusers may revoke
+// access on any target, and model admins on their models.
+func (api *API) RevokeGroups(ctx context.Context, args params.GroupGrants) (params.ErrorResults, error) {
+	return api.changeGroupGrants(ctx, args, func(ctx context.Context, grant access.GroupGrant) error {
+		return api.accessService.RevokeGroupAccess(ctx, grant.Group, grant.Target)
+	})
+}
+
+func (api *API) changeGroupGrants(
+	ctx context.Context, args params.GroupGrants, change func(context.Context, access.GroupGrant) error,
+) (params.ErrorResults, error) {
+	isSuperuser, err := api.isSuperuser(ctx)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Grants)),
+	}
+	for i, arg := range args.Grants {
+		grant, err := groupGrantFromParams(arg)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !isSuperuser {
+			if err := api.checkCanAdminTarget(ctx, grant.Target); err != nil {
+				result.Results[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+		}
+		err = change(ctx, grant)
+		result.Results[i].Error = apiservererrors.ServerError(groupError(err))
+	}
+	return result, nil
+}
+
+// ListGroupGrants returns the access granted to the group, or to every
+// group. Only controller superusers may list group grants.
+func (api *API) ListGroupGrants(ctx context.Context, arg params.GroupGrantsFilter) (params.GroupGrantsResult, error) {
+	if err := api.checkIsSuperuser(ctx); err != nil {
+		return params.GroupGrantsResult{}, errors.Trace(err)
+	}
+	grants, err := api.accessService.ListGroupGrants(ctx, arg.Group)
+	if err != nil {
+		return params.GroupGrantsResult{Error: apiservererrors.ServerError(groupError(err))}, nil
+	}
+	result := params.GroupGrantsResult{
+		Grants: make([]params.GroupGrant, len(grants)),
+	}
+	for i, grant := range grants {
+		result.Grants[i] = groupGrantToParams(grant)
+	}
+	return result, nil
+}
+
+// checkCanAdminTarget returns an error if the authenticated user is not an
+// admin of the target, which must be a model as only superusers may grant
+// access on the controller.
+func (api *API) checkCanAdminTarget(ctx context.Context, target permission.ID) error {
+	if target.ObjectType != permission.Model {
+		return apiservererrors.ErrPerm
+	}
+	err := api.authorizer.HasPermission(ctx, permission.AdminAccess, names.NewModelTag(target.Key))
+	if errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return apiservererrors.ErrPerm
+	}
+	return err
+}
+
+// checkIsSuperuser returns an error if the authenticated user is not a
+// controller superuser.
+func (api *API) checkIsSuperuser(ctx context.Context) error {
+	return api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+}
+
+// isSuperuser reports whether the authenticated user is a controller
+// superuser.
+func (api *API) isSuperuser(ctx context.Context) (bool, error) {
+	err := api.checkIsSuperuser(ctx)
+	if errors.Is(err, apiservererrors.ErrPerm) || errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// groupError converts the errors of the access service into errors which
+// are understood by the API.
+func groupError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, accesserrors.GroupGrantNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, accesserrors.GroupNotValid),
+		errors.Is(err, accesserrors.PermissionTargetInvalid),
+		errors.Is(err, coreerrors.NotValid):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
+
+func groupGrantFromParams(arg params.GroupGrant) (access.GroupGrant, error) {
+	targetTag, err := names.ParseTag(arg.TargetTag)
+	if err != nil {
+		return access.GroupGrant{}, errors.Trace(err)
+	}
+	target, err := permission.ParseTagForID(targetTag)
+	if err != nil || (target.ObjectType != permission.Model && target.ObjectType != permission.Controller) {
+		return access.GroupGrant{}, errors.NotValidf("target %q", arg.TargetTag)
+	}
+	return access.GroupGrant{
+		Group:  arg.Group,
+		Access: permission.Access(arg.Access),
+		Target: target,
+	}, nil
+}
+
+func groupGrantToParams(grant access.GroupGrant) params.GroupGrant {
+	var targetTag names.Tag
+	if grant.Target.ObjectType == permission.Controller {
+		targetTag = names.NewControllerTag(grant.Target.Key)
+	} else {
+		targetTag = names.NewModelTag(grant.Target.Key)
+	}
+	return params.GroupGrant{
+		Group:     grant.Group,
+		Access:    string(grant.Access),
+		TargetTag: targetTag.String(),
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package groupmanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type apiSuite struct {
+	accessService *MockAccessService
+	authorizer    *MockAuthorizer
+}
+
+var _ = gc.Suite(&apiSuite{})
+
+func (s *apiSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.accessService = NewMockAccessService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	return ctrl
+}
+
+func (s *apiSuite) newAPI() *API {
+	return NewAPI(s.accessService, s.authorizer, coretesting.ControllerTag)
+}
+
+func (s *apiSuite) expectSuperuser(isSuperuser bool) {
+	var err error
+	if !isSuperuser {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(err)
+}
+
+func (s *apiSuite) expectModelAdmin(isAdmin bool) {
+	var err error
+	if !isAdmin {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(err)
+}
+
+func modelTarget() permission.ID {
+	return permission.ID{ObjectType: permission.Model, Key: coretesting.ModelTag.Id()}
+}
+
+func controllerTarget() permission.ID {
+	return permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()}
+}
+
+func (s *apiSuite) TestGrantGroups(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().GrantGroupAccess(gomock.Any(), access.GroupGrant{
+		Group:  "devs",
+		Access: permission.WriteAccess,
+		Target: modelTarget(),
+	}).Return(nil)
+	s.accessService.EXPECT().GrantGroupAccess(gomock.Any(), access.GroupGrant{
+		Group:  "ops",
+		Access: permission.SuperuserAccess,
+		Target: controllerTarget(),
+	}).Return(nil)
+
+	result, err := s.newAPI().GrantGroups(context.Background(), params.GroupGrants{
+		Grants: []params.GroupGrant{{
+			Group:     "devs",
+			Access:    "write",
+			TargetTag: coretesting.ModelTag.String(),
+		}, {
+			Group:     "ops",
+			Access:    "superuser",
+			TargetTag: coretesting.ControllerTag.String(),
+		}, {
+			Group:     "ops",
+			Access:    "read",
+			TargetTag: "cloud-aws",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, gc.IsNil)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `target "cloud-aws" not valid`)
+}
+
+func (s *apiSuite) TestGrantGroupsModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectModelAdmin(true)
+	s.accessService.EXPECT().GrantGroupAccess(gomock.Any(), access.GroupGrant{
+		Group:  "devs",
+		Access: permission.ReadAccess,
+		Target: modelTarget(),
+	}).Return(accesserrors.GroupNotValid)
+
+	result, err := s.newAPI().GrantGroups(context.Background(), params.GroupGrants{
+		Grants: []params.GroupGrant{{
+			Group:     "devs",
+			Access:    "read",
+			TargetTag: coretesting.ModelTag.String(),
+		}, {
+			Group:     "devs",
+			Access:    "login",
+			TargetTag: coretesting.ControllerTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, jc.Satisfies, params.IsCodeNotValid)
+	c.Check(result.Results[1].Error, gc.DeepEquals, apiservererrors.ServerError(apiservererrors.ErrPerm))
+}
+
+func (s *apiSuite) TestGrantGroupsNotModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectModelAdmin(false)
+
+	result, err := s.newAPI().GrantGroups(context.Background(), params.GroupGrants{
+		Grants: []params.GroupGrant{{
+			Group:     "devs",
+			Access:    "admin",
+			TargetTag: coretesting.ModelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Results[0].Error, gc.DeepEquals, apiservererrors.ServerError(apiservererrors.ErrPerm))
+}
+
+func (s *apiSuite) TestRevokeGroups(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().RevokeGroupAccess(gomock.Any(), "devs", modelTarget()).Return(nil)
+	s.accessService.EXPECT().RevokeGroupAccess(gomock.Any(), "ops", modelTarget()).Return(accesserrors.GroupGrantNotFound)
+
+	result, err := s.newAPI().RevokeGroups(context.Background(), params.GroupGrants{
+		Grants: []params.GroupGrant{{
+			Group:     "devs",
+			TargetTag: coretesting.ModelTag.String(),
+		}, {
+			Group:     "ops",
+			TargetTag: coretesting.ModelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) TestListGroupGrants(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().ListGroupGrants(gomock.Any(), "").Return([]access.GroupGrant{{
+		Group:  "devs",
+		Access: permission.WriteAccess,
+		Target: modelTarget(),
+	}, {
+		Group:  "ops",
+		Access: permission.SuperuserAccess,
+		Target: controllerTarget(),
+	}}, nil)
+
+	result, err := s.newAPI().ListGroupGrants(context.Background(), params.GroupGrantsFilter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.GroupGrantsResult{
+		Grants: []params.GroupGrant{{
+			Group:     "devs",
+			Access:    "write",
+			TargetTag: coretesting.ModelTag.String(),
+		}, {
+			Group:     "ops",
+			Access:    "superuser",
+			TargetTag: coretesting.ControllerTag.String(),
+		}},
+	})
+}
+
+func (s *apiSuite) TestListGroupGrantsNotSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.newAPI().ListGroupGrants(context.Background(), params.GroupGrantsFilter{Group: "devs"})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
@@ -0,0 +1,25 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package groupmanager
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/access"
+)
+
+// AccessService provides access to the access granted to groups of users of
+// the identity provider of the controller.
+type AccessService interface {
+	// GrantGroupAccess grants the access to the group on the target model
+	// or controller, replacing any access the group had on it.
+	GrantGroupAccess(ctx context.Context, grant access.GroupGrant) error
+	// RevokeGroupAccess revokes the access of the group on the target model
+	// or controller.
+	RevokeGroupAccess(ctx context.Context, group string, target permission.ID) error
+	// ListGroupGrants returns the access granted to the group, or to every
+	// group if the name is empty.
+	ListGroupGrants(ctx context.Context, group string) ([]access.GroupGrant, error)
+}
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/groupmanager (interfaces: AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package groupmanager -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/groupmanager AccessService
+//
+
+// Package groupmanager is a generated GoMock package.
+package groupmanager
+
+import (
+	context "context"
+	reflect "reflect"
+
+	permission "github.com/juju/juju/core/permission"
+	access "github.com/juju/juju/domain/access"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock *MockAccessService
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// GrantGroupAccess mocks base method.
+func (m *MockAccessService) GrantGroupAccess(arg0 context.Context, arg1 access.GroupGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantGroupAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantGroupAccess indicates an expected call of GrantGroupAccess.
+func (mr *MockAccessServiceMockRecorder) GrantGroupAccess(arg0, arg1 any) *MockAccessServiceGrantGroupAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantGroupAccess", reflect.TypeOf((*MockAccessService)(nil).GrantGroupAccess), arg0, arg1)
+	return &MockAccessServiceGrantGroupAccessCall{Call: call}
+}
+
+// MockAccessServiceGrantGroupAccessCall wrap *gomock.Call
+type MockAccessServiceGrantGroupAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceGrantGroupAccessCall) Return(arg0 error) *MockAccessServiceGrantGroupAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceGrantGroupAccessCall) Do(f func(context.Context, access.GroupGrant) error) *MockAccessServiceGrantGroupAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceGrantGroupAccessCall) DoAndReturn(f func(context.Context, access.GroupGrant) error) *MockAccessServiceGrantGroupAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListGroupGrants mocks base method.
+func (m *MockAccessService) ListGroupGrants(arg0 context.Context, arg1 string) ([]access.GroupGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupGrants", arg0, arg1)
+	ret0, _ := ret[0].([]access.GroupGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupGrants indicates an expected call of ListGroupGrants.
+func (mr *MockAccessServiceMockRecorder) ListGroupGrants(arg0, arg1 any) *MockAccessServiceListGroupGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupGrants", reflect.TypeOf((*MockAccessService)(nil).ListGroupGrants), arg0, arg1)
+	return &MockAccessServiceListGroupGrantsCall{Call: call}
+}
+
+// MockAccessServiceListGroupGrantsCall wrap *gomock.Call
+type MockAccessServiceListGroupGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceListGroupGrantsCall) Return(arg0 []access.GroupGrant, arg1 error) *MockAccessServiceListGroupGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceListGroupGrantsCall) Do(f func(context.Context, string) ([]access.GroupGrant, error)) *MockAccessServiceListGroupGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceListGroupGrantsCall) DoAndReturn(f func(context.Context, string) ([]access.GroupGrant, error)) *MockAccessServiceListGroupGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeGroupAccess mocks base method.
+func (m *MockAccessService) RevokeGroupAccess(arg0 context.Context, arg1 string, arg2 permission.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeGroupAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeGroupAccess indicates an expected call of RevokeGroupAccess.
+func (mr *MockAccessServiceMockRecorder) RevokeGroupAccess(arg0, arg1, arg2 any) *MockAccessServiceRevokeGroupAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGroupAccess", reflect.TypeOf((*MockAccessService)(nil).RevokeGroupAccess), arg0, arg1, arg2)
+	return &MockAccessServiceRevokeGroupAccessCall{Call: call}
+}
+
+// MockAccessServiceRevokeGroupAccessCall wrap *gomock.Call
+type MockAccessServiceRevokeGroupAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceRevokeGroupAccessCall) Return(arg0 error) *MockAccessServiceRevokeGroupAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceRevokeGroupAccessCall) Do(f func(context.Context, string, permission.ID) error) *MockAccessServiceRevokeGroupAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceRevokeGroupAccessCall) DoAndReturn(f func(context.Context, string, permission.ID) error) *MockAccessServiceRevokeGroupAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
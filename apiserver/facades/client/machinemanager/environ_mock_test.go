@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/environs (interfaces: Environ,InstanceTypesFetcher,BootstrapEnviron)
+// Source: github.com/juju/juju/environs (interfaces: Environ,InstanceTypesFetcher,PriceCatalogueFetcher,BootstrapEnviron)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package machinemanager -destination environ_mock_test.go github.com/juju/juju/environs Environ,InstanceTypesFetcher,BootstrapEnviron
+//	mockgen -typed -package machinemanager -destination environ_mock_test.go github.com/juju/juju/environs Environ,InstanceTypesFetcher,PriceCatalogueFetcher,BootstrapEnviron
 //
 
 // Package machinemanager is a generated GoMock package.
@@ -19,6 +19,7 @@ import (
 	environs "github.com/juju/juju/environs"
 	config "github.com/juju/juju/environs/config"
 	instances "github.com/juju/juju/environs/instances"
+	pricing "github.com/juju/juju/environs/pricing"
 	storage "github.com/juju/juju/internal/storage"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -845,6 +846,68 @@ func (c *MockInstanceTypesFetcherInstanceTypesCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// MockPriceCatalogueFetcher is a mock of PriceCatalogueFetcher interface.
+type MockPriceCatalogueFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPriceCatalogueFetcherMockRecorder
+}
+
+// MockPriceCatalogueFetcherMockRecorder is the mock recorder for MockPriceCatalogueFetcher.
+type MockPriceCatalogueFetcherMockRecorder struct {
+	mock *MockPriceCatalogueFetcher
+}
+
+// NewMockPriceCatalogueFetcher creates a new mock instance.
+func NewMockPriceCatalogueFetcher(ctrl *gomock.Controller) *MockPriceCatalogueFetcher {
+	mock := &MockPriceCatalogueFetcher{ctrl: ctrl}
+	mock.recorder = &MockPriceCatalogueFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPriceCatalogueFetcher) EXPECT() *MockPriceCatalogueFetcherMockRecorder {
+	return m.recorder
+}
+
+// PriceCatalogue mocks base method.
+func (m *MockPriceCatalogueFetcher) PriceCatalogue(arg0 context.Context) (*pricing.Catalogue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceCatalogue", arg0)
+	ret0, _ := ret[0].(*pricing.Catalogue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PriceCatalogue indicates an expected call of PriceCatalogue.
+func (mr *MockPriceCatalogueFetcherMockRecorder) PriceCatalogue(arg0 any) *MockPriceCatalogueFetcherPriceCatalogueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceCatalogue", reflect.TypeOf((*MockPriceCatalogueFetcher)(nil).PriceCatalogue), arg0)
+	return &MockPriceCatalogueFetcherPriceCatalogueCall{Call: call}
+}
+
+// MockPriceCatalogueFetcherPriceCatalogueCall wrap *gomock.Call
+type MockPriceCatalogueFetcherPriceCatalogueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPriceCatalogueFetcherPriceCatalogueCall) Return(arg0 *pricing.Catalogue, arg1 error) *MockPriceCatalogueFetcherPriceCatalogueCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPriceCatalogueFetcherPriceCatalogueCall) Do(f func(context.Context) (*pricing.Catalogue, error)) *MockPriceCatalogueFetcherPriceCatalogueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPriceCatalogueFetcherPriceCatalogueCall) DoAndReturn(f func(context.Context) (*pricing.Catalogue, error)) *MockPriceCatalogueFetcherPriceCatalogueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockBootstrapEnviron is a mock of BootstrapEnviron interface.
 type MockBootstrapEnviron struct {
 	ctrl     *gomock.Controller
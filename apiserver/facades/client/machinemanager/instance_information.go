@@ -11,6 +11,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -50,3 +51,48 @@ func instanceTypes(
 
 	return params.InstanceTypesResults{Results: result}, nil
 }
+
+// PriceCatalogue returns the prices of instance types and volumes in the
+// region in which the current model is deployed, as published by the
+// provider's pricing API. A NotSupported error is returned in the result if
+// the provider has no pricing API.
+func (mm *MachineManagerAPI) PriceCatalogue(ctx context.Context) (params.PriceCatalogueResult, error) {
+	if err := mm.authorizer.CanRead(ctx); err != nil {
+		return params.PriceCatalogueResult{}, err
+	}
+	fetcher, err := mm.machineService.GetInstanceTypesFetcher(ctx)
+	if err != nil {
+		return params.PriceCatalogueResult{}, errors.Trace(err)
+	}
+	return priceCatalogue(ctx, fetcher), nil
+}
+
+// priceCatalogue reports back the prices published by the provider, if it
+// implements [environs.PriceCatalogueFetcher].
+func priceCatalogue(ctx context.Context, fetcher environs.InstanceTypesFetcher) params.PriceCatalogueResult {
+	pricer, ok := fetcher.(environs.PriceCatalogueFetcher)
+	if !ok {
+		return params.PriceCatalogueResult{
+			Error: apiservererrors.ServerError(errors.NotSupportedf("price catalogue")),
+		}
+	}
+	catalogue, err := pricer.PriceCatalogue(ctx)
+	if err != nil {
+		return params.PriceCatalogueResult{Error: apiservererrors.ServerError(err)}
+	}
+	return params.PriceCatalogueResult{Result: toParamsPriceCatalogue(catalogue)}
+}
+
+func toParamsPriceCatalogue(catalogue *pricing.Catalogue) *params.PriceCatalogue {
+	result := &params.PriceCatalogue{
+		Currency: catalogue.Currency,
+		Regions:  make(map[string]params.RegionPrices, len(catalogue.Regions)),
+	}
+	for region, prices := range catalogue.Regions {
+		result.Regions[region] = params.RegionPrices{
+			InstanceTypes: prices.InstanceTypes,
+			Volumes:       prices.Volumes,
+		}
+	}
+	return result
+}
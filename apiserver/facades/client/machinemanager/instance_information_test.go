@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -78,3 +79,58 @@ func (s *instanceTypesSuite) TestInstanceTypes(c *gc.C) {
 	}
 	c.Assert(r.Results, gc.DeepEquals, expected)
 }
+
+type priceCatalogueFetcher struct {
+	*MockInstanceTypesFetcher
+	*MockPriceCatalogueFetcher
+}
+
+func (s *instanceTypesSuite) TestPriceCatalogue(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	pricer := NewMockPriceCatalogueFetcher(ctrl)
+	pricer.EXPECT().PriceCatalogue(gomock.Any()).Return(&pricing.Catalogue{
+		Currency: "USD",
+		Regions: map[string]pricing.RegionPrices{
+			"westus": {
+				InstanceTypes: map[string]float64{"Standard_D2s_v3": 0.096},
+				Volumes:       map[string]float64{"azure": 0.05},
+			},
+		},
+	}, nil)
+
+	r := priceCatalogue(context.Background(), priceCatalogueFetcher{s.instanceTypesFetcher, pricer})
+	c.Assert(r, gc.DeepEquals, params.PriceCatalogueResult{
+		Result: &params.PriceCatalogue{
+			Currency: "USD",
+			Regions: map[string]params.RegionPrices{
+				"westus": {
+					InstanceTypes: map[string]float64{"Standard_D2s_v3": 0.096},
+					Volumes:       map[string]float64{"azure": 0.05},
+				},
+			},
+		},
+	})
+}
+
+func (s *instanceTypesSuite) TestPriceCatalogueError(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	pricer := NewMockPriceCatalogueFetcher(ctrl)
+	pricer.EXPECT().PriceCatalogue(gomock.Any()).Return(nil, errors.New("boom"))
+
+	r := priceCatalogue(context.Background(), priceCatalogueFetcher{s.instanceTypesFetcher, pricer})
+	c.Assert(r.Result, gc.IsNil)
+	c.Assert(r.Error, gc.ErrorMatches, "boom")
+}
+
+func (s *instanceTypesSuite) TestPriceCatalogueNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	r := priceCatalogue(context.Background(), s.instanceTypesFetcher)
+	c.Assert(r.Result, gc.IsNil)
+	c.Assert(r.Error, gc.ErrorMatches, "price catalogue not supported")
+	c.Assert(r.Error.Code, gc.Equals, params.CodeNotSupported)
+}
@@ -27,11 +27,14 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
 	"github.com/juju/juju/domain/blockcommand"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/manual/sshprovisioner"
+	"github.com/juju/juju/internal/admission"
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/charmhub/transport"
 	"github.com/juju/juju/rpc/params"
@@ -110,6 +113,9 @@ type MachineService interface {
 	// HardwareCharacteristics returns the hardware characteristics of the
 	// specified machine.
 	HardwareCharacteristics(ctx context.Context, machineUUID string) (*instance.HardwareCharacteristics, error)
+	// GetMachineUtilisation returns a summary of the CPU and memory
+	// utilisation samples recorded for the machine since the given time.
+	GetMachineUtilisation(ctx context.Context, name coremachine.Name, since time.Time) (domainmachine.Utilisation, error)
 }
 
 // CharmhubClient represents a way for querying the charmhub api for information
@@ -135,6 +141,14 @@ type BlockCommandService interface {
 	GetBlocks(ctx context.Context) ([]blockcommand.Block, error)
 }
 
+// AdmissionService evaluates the admission policies of the model.
+type AdmissionService interface {
+	// CheckAdmission evaluates the admission policies of the controller and
+	// of the model against the request. An error satisfying
+	// admissionerrors.Denied is returned if a policy denies it.
+	CheckAdmission(ctx context.Context, modelUUID string, req *admission.Request) error
+}
+
 // CloudService provides access to clouds.
 type CloudService interface {
 	// Cloud returns the named cloud.
@@ -161,10 +175,27 @@ type MachineManagerAPI struct {
 	networkService     NetworkService
 	modelConfigService ModelConfigService
 	agentBinaryService AgentBinaryService
+	admissionService   AdmissionService
 
 	logger corelogger.Logger
 }
 
+// MachineManagerAPIV11 implements version 11 of the MachineManager API.
+type MachineManagerAPIV11 struct {
+	*MachineManagerAPIV12
+}
+
+// MachineManagerAPIV12 implements version 12 of the MachineManager API.
+type MachineManagerAPIV12 struct {
+	*MachineManagerAPI
+}
+
+// PriceCatalogue isn't on the v11 API.
+func (*MachineManagerAPIV11) PriceCatalogue(_, _ struct{}) {}
+
+// MachineUtilisation isn't on the v12 API.
+func (*MachineManagerAPIV12) MachineUtilisation(_, _ struct{}) {}
+
 // NewMachineManagerAPI creates a new server-side MachineManager API facade.
 func NewMachineManagerAPI(
 	model coremodel.ModelInfo,
@@ -184,6 +215,7 @@ func NewMachineManagerAPI(
 	modelConfigService ModelConfigService,
 	blockCommandService BlockCommandService,
 	agentBinaryService AgentBinaryService,
+	admissionService AdmissionService,
 ) *MachineManagerAPI {
 	api := &MachineManagerAPI{
 		model:                   model,
@@ -204,6 +236,7 @@ func NewMachineManagerAPI(
 		keyUpdaterService:       keyUpdaterService,
 		modelConfigService:      modelConfigService,
 		agentBinaryService:      agentBinaryService,
+		admissionService:        admissionService,
 	}
 	return api
 }
@@ -226,6 +259,10 @@ func (mm *MachineManagerAPI) AddMachines(ctx context.Context, args params.AddMac
 		return results, errors.Trace(err)
 	}
 	for i, p := range args.MachineParams {
+		if err := mm.checkAdmission(ctx, addMachineAdmissionRequest(p)); err != nil {
+			results.Machines[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
 		m, err := mm.addOneMachine(ctx, p, allSpaces)
 		results.Machines[i].Error = apiservererrors.ServerError(err)
 		if err == nil {
@@ -235,6 +272,28 @@ func (mm *MachineManagerAPI) AddMachines(ctx context.Context, args params.AddMac
 	return results, nil
 }
 
+// checkAdmission evaluates the admission policies against the request. A
+// denial is returned as a forbidden error, holding the reasons for it.
+func (mm *MachineManagerAPI) checkAdmission(ctx context.Context, req *admission.Request) error {
+	err := mm.admissionService.CheckAdmission(ctx, mm.model.UUID.String(), req)
+	if errors.Is(err, admissionerrors.Denied) {
+		return errors.NewForbidden(nil, err.Error())
+	} else if err != nil {
+		return errors.Annotate(err, "checking admission policies")
+	}
+	return nil
+}
+
+// addMachineAdmissionRequest returns the admission request for adding the
+// machine.
+func addMachineAdmissionRequest(p params.AddMachineParams) *admission.Request {
+	req := admission.NewRequest(admission.AddMachine)
+	if p.Constraints.Zones != nil {
+		req.Set(admission.FieldConstraintsZones, *p.Constraints.Zones...)
+	}
+	return req
+}
+
 func (mm *MachineManagerAPI) addOneMachine(ctx context.Context, p params.AddMachineParams, allSpaces network.SpaceInfos) (result Machine, err error) {
 	if p.ParentId != "" && p.ContainerType == "" {
 		return nil, fmt.Errorf("parent machine specified without container type")
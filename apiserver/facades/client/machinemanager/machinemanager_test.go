@@ -20,14 +20,18 @@ import (
 	"github.com/juju/juju/apiserver/common/storagecommon"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/instance"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/admission"
+	internalerrors "github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/storage"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -53,6 +57,7 @@ type AddMachineManagerSuite struct {
 	keyUpdaterService       *MockKeyUpdaterService
 	blockCommandService     *MockBlockCommandService
 	agentBinaryService      *MockAgentBinaryService
+	admissionService        *MockAdmissionService
 }
 
 var _ = gc.Suite(&AddMachineManagerSuite{})
@@ -82,6 +87,7 @@ func (s *AddMachineManagerSuite) setup(c *gc.C) *gomock.Controller {
 	s.blockCommandService = NewMockBlockCommandService(ctrl)
 	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound).AnyTimes()
 	s.agentBinaryService = NewMockAgentBinaryService(ctrl)
+	s.admissionService = NewMockAdmissionService(ctrl)
 
 	s.api = NewMachineManagerAPI(
 		s.model,
@@ -104,6 +110,7 @@ func (s *AddMachineManagerSuite) setup(c *gc.C) *gomock.Controller {
 		nil,
 		s.blockCommandService,
 		s.agentBinaryService,
+		s.admissionService,
 	)
 
 	return ctrl
@@ -164,6 +171,7 @@ func (s *AddMachineManagerSuite) TestAddMachines(c *gc.C) {
 		},
 	}).Return(m2, nil)
 	s.networkService.EXPECT().GetAllSpaces(gomock.Any())
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.model.UUID.String(), gomock.Any()).Times(2)
 
 	machines, err := s.api.AddMachines(context.Background(), params.AddMachines{MachineParams: apiParams})
 	c.Assert(err, jc.ErrorIsNil)
@@ -175,6 +183,7 @@ func (s *AddMachineManagerSuite) TestAddMachinesStateError(c *gc.C) {
 
 	s.st.EXPECT().AddOneMachine(gomock.Any()).Return(nil, errors.New("boom"))
 	s.networkService.EXPECT().GetAllSpaces(gomock.Any())
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.model.UUID.String(), gomock.Any())
 
 	results, err := s.api.AddMachines(context.Background(), params.AddMachines{
 		MachineParams: []params.AddMachineParams{{
@@ -189,6 +198,32 @@ func (s *AddMachineManagerSuite) TestAddMachinesStateError(c *gc.C) {
 	})
 }
 
+func (s *AddMachineManagerSuite) TestAddMachinesDenied(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	var got map[string][]string
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any())
+	s.admissionService.EXPECT().CheckAdmission(gomock.Any(), s.model.UUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			got = req.Fields()
+			return internalerrors.Errorf(`%w for add-machine by policy "zones"`, admissionerrors.Denied)
+		})
+
+	results, err := s.api.AddMachines(context.Background(), params.AddMachines{
+		MachineParams: []params.AddMachineParams{{
+			Base:        &params.Base{Name: "ubuntu", Channel: "22.04"},
+			Constraints: constraints.MustParse("zones=az1"),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Machines, gc.HasLen, 1)
+	c.Check(results.Machines[0].Error, jc.Satisfies, params.IsCodeForbidden)
+	c.Check(got, jc.DeepEquals, map[string][]string{
+		admission.FieldOperation:        {"add-machine"},
+		admission.FieldConstraintsZones: {"az1"},
+	})
+}
+
 type DestroyMachineManagerSuite struct {
 	testing.CleanupSuite
 	authorizer    *apiservertesting.FakeAuthorizer
@@ -262,6 +297,7 @@ func (s *DestroyMachineManagerSuite) setupMocks(c *gc.C) *gomock.Controller {
 		nil,
 		s.blockCommandService,
 		s.agentBinaryService,
+		nil,
 	)
 
 	return ctrl
@@ -797,6 +833,7 @@ func (s *ProvisioningMachineManagerSuite) setupMocks(c *gc.C) *gomock.Controller
 		s.modelConfigService,
 		s.blockCommandService,
 		s.agentBinaryService,
+		nil,
 	)
 	return ctrl
 }
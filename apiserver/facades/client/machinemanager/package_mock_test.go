@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/machinemanager (interfaces: Leadership,Authorizer,ControllerBackend,InstanceConfigBackend,Backend,StorageInterface,Pool,Machine,Unit,CharmhubClient,ControllerConfigService,MachineService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService)
+// Source: github.com/juju/juju/apiserver/facades/client/machinemanager (interfaces: Leadership,Authorizer,ControllerBackend,InstanceConfigBackend,Backend,StorageInterface,Pool,Machine,Unit,CharmhubClient,ControllerConfigService,MachineService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService,AdmissionService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package machinemanager -destination package_mock_test.go github.com/juju/juju/apiserver/facades/client/machinemanager Leadership,Authorizer,ControllerBackend,InstanceConfigBackend,Backend,StorageInterface,Pool,Machine,Unit,CharmhubClient,ControllerConfigService,MachineService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService
+//	mockgen -typed -package machinemanager -destination package_mock_test.go github.com/juju/juju/apiserver/facades/client/machinemanager Leadership,Authorizer,ControllerBackend,InstanceConfigBackend,Backend,StorageInterface,Pool,Machine,Unit,CharmhubClient,ControllerConfigService,MachineService,NetworkService,KeyUpdaterService,ModelConfigService,BlockCommandService,AgentBinaryService,AdmissionService
 //
 
 // Package machinemanager is a generated GoMock package.
@@ -23,8 +23,10 @@ import (
 	status "github.com/juju/juju/core/status"
 	service "github.com/juju/juju/domain/agentbinary/service"
 	blockcommand "github.com/juju/juju/domain/blockcommand"
+	machine0 "github.com/juju/juju/domain/machine"
 	environs "github.com/juju/juju/environs"
 	config "github.com/juju/juju/environs/config"
+	admission "github.com/juju/juju/internal/admission"
 	charmhub "github.com/juju/juju/internal/charmhub"
 	transport "github.com/juju/juju/internal/charmhub/transport"
 	params "github.com/juju/juju/rpc/params"
@@ -1930,6 +1932,45 @@ func (c *MockMachineServiceGetMachineUUIDCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetMachineUtilisation mocks base method.
+func (m *MockMachineService) GetMachineUtilisation(arg0 context.Context, arg1 machine.Name, arg2 time.Time) (machine0.Utilisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineUtilisation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(machine0.Utilisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineUtilisation indicates an expected call of GetMachineUtilisation.
+func (mr *MockMachineServiceMockRecorder) GetMachineUtilisation(arg0, arg1, arg2 any) *MockMachineServiceGetMachineUtilisationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineUtilisation", reflect.TypeOf((*MockMachineService)(nil).GetMachineUtilisation), arg0, arg1, arg2)
+	return &MockMachineServiceGetMachineUtilisationCall{Call: call}
+}
+
+// MockMachineServiceGetMachineUtilisationCall wrap *gomock.Call
+type MockMachineServiceGetMachineUtilisationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceGetMachineUtilisationCall) Return(arg0 machine0.Utilisation, arg1 error) *MockMachineServiceGetMachineUtilisationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceGetMachineUtilisationCall) Do(f func(context.Context, machine.Name, time.Time) (machine0.Utilisation, error)) *MockMachineServiceGetMachineUtilisationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceGetMachineUtilisationCall) DoAndReturn(f func(context.Context, machine.Name, time.Time) (machine0.Utilisation, error)) *MockMachineServiceGetMachineUtilisationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // HardwareCharacteristics mocks base method.
 func (m *MockMachineService) HardwareCharacteristics(arg0 context.Context, arg1 string) (*instance.HardwareCharacteristics, error) {
 	m.ctrl.T.Helper()
@@ -2393,3 +2434,64 @@ func (c *MockAgentBinaryServiceGetEnvironAgentBinariesFinderCall) DoAndReturn(f
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockAdmissionService is a mock of AdmissionService interface.
+type MockAdmissionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionServiceMockRecorder
+}
+
+// MockAdmissionServiceMockRecorder is the mock recorder for MockAdmissionService.
+type MockAdmissionServiceMockRecorder struct {
+	mock *MockAdmissionService
+}
+
+// NewMockAdmissionService creates a new mock instance.
+func NewMockAdmissionService(ctrl *gomock.Controller) *MockAdmissionService {
+	mock := &MockAdmissionService{ctrl: ctrl}
+	mock.recorder = &MockAdmissionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionService) EXPECT() *MockAdmissionServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckAdmission mocks base method.
+func (m *MockAdmissionService) CheckAdmission(arg0 context.Context, arg1 string, arg2 *admission.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAdmission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAdmission indicates an expected call of CheckAdmission.
+func (mr *MockAdmissionServiceMockRecorder) CheckAdmission(arg0, arg1, arg2 any) *MockAdmissionServiceCheckAdmissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdmission", reflect.TypeOf((*MockAdmissionService)(nil).CheckAdmission), arg0, arg1, arg2)
+	return &MockAdmissionServiceCheckAdmissionCall{Call: call}
+}
+
+// MockAdmissionServiceCheckAdmissionCall wrap *gomock.Call
+type MockAdmissionServiceCheckAdmissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionServiceCheckAdmissionCall) Return(arg0 error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionServiceCheckAdmissionCall) Do(f func(context.Context, string, *admission.Request) error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionServiceCheckAdmissionCall) DoAndReturn(f func(context.Context, string, *admission.Request) error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -24,12 +24,46 @@ func Register(registry facade.FacadeRegistry) {
 			return nil, fmt.Errorf("cannot register machine manager facade: %w", err)
 		}
 		return api, nil
+	}, reflect.TypeOf((*MachineManagerAPIV11)(nil)))
+	registry.MustRegister("MachineManager", 12, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		api, err := makeFacadeV12(stdCtx, ctx) // Adds PriceCatalogue.
+		if err != nil {
+			return nil, fmt.Errorf("cannot register machine manager facade: %w", err)
+		}
+		return api, nil
+	}, reflect.TypeOf((*MachineManagerAPIV12)(nil)))
+	registry.MustRegister("MachineManager", 13, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		api, err := makeFacadeV13(stdCtx, ctx) // Adds MachineUtilisation.
+		if err != nil {
+			return nil, fmt.Errorf("cannot register machine manager facade: %w", err)
+		}
+		return api, nil
 	}, reflect.TypeOf((*MachineManagerAPI)(nil)))
 }
 
-// makeMachineManagerFacadeV11 create a new server-side MachineManager API
+// makeFacadeV11 creates a new server-side MachineManager API facade of
+// version 11.
+func makeFacadeV11(stdCtx context.Context, ctx facade.ModelContext) (*MachineManagerAPIV11, error) {
+	api, err := makeFacadeV12(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &MachineManagerAPIV11{MachineManagerAPIV12: api}, nil
+}
+
+// makeFacadeV12 creates a new server-side MachineManager API facade of
+// version 12.
+func makeFacadeV12(stdCtx context.Context, ctx facade.ModelContext) (*MachineManagerAPIV12, error) {
+	api, err := makeFacadeV13(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &MachineManagerAPIV12{MachineManagerAPI: api}, nil
+}
+
+// makeFacadeV13 create a new server-side MachineManager API
 // facade. This is used for facade registration.
-func makeFacadeV11(stdCtx context.Context, ctx facade.ModelContext) (*MachineManagerAPI, error) {
+func makeFacadeV13(stdCtx context.Context, ctx facade.ModelContext) (*MachineManagerAPI, error) {
 	// Check the the user is authenticated for this API before creating.
 	if !ctx.Auth().AuthClient() {
 		return nil, apiservererrors.ErrPerm
@@ -82,5 +116,6 @@ func makeFacadeV11(stdCtx context.Context, ctx facade.ModelContext) (*MachineMan
 		domainServices.Config(),
 		domainServices.BlockCommand(),
 		domainServices.AgentBinary(),
+		domainServices.Admission(),
 	), nil
 }
@@ -78,6 +78,82 @@ func (c *MockStorageAttachmentLifeCall) DoAndReturn(f func() state.Life) *MockSt
 	return c
 }
 
+// ResizeGeneration mocks base method.
+func (m *MockStorageAttachment) ResizeGeneration() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeGeneration")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// ResizeGeneration indicates an expected call of ResizeGeneration.
+func (mr *MockStorageAttachmentMockRecorder) ResizeGeneration() *MockStorageAttachmentResizeGenerationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeGeneration", reflect.TypeOf((*MockStorageAttachment)(nil).ResizeGeneration))
+	return &MockStorageAttachmentResizeGenerationCall{Call: call}
+}
+
+// MockStorageAttachmentResizeGenerationCall wrap *gomock.Call
+type MockStorageAttachmentResizeGenerationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageAttachmentResizeGenerationCall) Return(arg0 uint64) *MockStorageAttachmentResizeGenerationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageAttachmentResizeGenerationCall) Do(f func() uint64) *MockStorageAttachmentResizeGenerationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageAttachmentResizeGenerationCall) DoAndReturn(f func() uint64) *MockStorageAttachmentResizeGenerationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Resized mocks base method.
+func (m *MockStorageAttachment) Resized() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resized")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Resized indicates an expected call of Resized.
+func (mr *MockStorageAttachmentMockRecorder) Resized() *MockStorageAttachmentResizedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resized", reflect.TypeOf((*MockStorageAttachment)(nil).Resized))
+	return &MockStorageAttachmentResizedCall{Call: call}
+}
+
+// MockStorageAttachmentResizedCall wrap *gomock.Call
+type MockStorageAttachmentResizedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageAttachmentResizedCall) Return(arg0 bool) *MockStorageAttachmentResizedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageAttachmentResizedCall) Do(f func() bool) *MockStorageAttachmentResizedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageAttachmentResizedCall) DoAndReturn(f func() bool) *MockStorageAttachmentResizedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StorageInstance mocks base method.
 func (m *MockStorageAttachment) StorageInstance() names.StorageTag {
 	m.ctrl.T.Helper()
@@ -0,0 +1,68 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	coremachine "github.com/juju/juju/core/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// MachineUtilisation returns a summary of the CPU and memory utilisation
+// reported by the agents of the given machines over the model's
+// rightsizing window.
+func (mm *MachineManagerAPI) MachineUtilisation(ctx context.Context, args params.Entities) (params.MachineUtilisationResults, error) {
+	results := params.MachineUtilisationResults{
+		Results: make([]params.MachineUtilisationResult, len(args.Entities)),
+	}
+	if err := mm.authorizer.CanRead(ctx); err != nil {
+		return results, err
+	}
+	cfg, err := mm.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	window := cfg.RightsizingWindow()
+	since := time.Now().Add(-window)
+
+	for i, entity := range args.Entities {
+		utilisation, err := mm.machineUtilisation(ctx, entity.Tag, since)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		utilisation.WindowSeconds = int64(window / time.Second)
+		results.Results[i].Result = utilisation
+	}
+	return results, nil
+}
+
+func (mm *MachineManagerAPI) machineUtilisation(ctx context.Context, tag string, since time.Time) (*params.MachineUtilisation, error) {
+	machineTag, err := names.ParseMachineTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	utilisation, err := mm.machineService.GetMachineUtilisation(ctx, coremachine.Name(machineTag.Id()), since)
+	if errors.Is(err, machineerrors.MachineNotFound) {
+		return nil, errors.NotFoundf("machine %q", machineTag.Id())
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &params.MachineUtilisation{
+		Samples:    utilisation.Samples,
+		From:       utilisation.From,
+		To:         utilisation.To,
+		MeanCPU:    utilisation.MeanCPU,
+		PeakCPU:    utilisation.PeakCPU,
+		MeanMemory: utilisation.MeanMemory,
+		PeakMemory: utilisation.PeakMemory,
+	}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machinemanager
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	coremachine "github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/environs/config"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type utilisationSuite struct {
+	authorizer         *MockAuthorizer
+	machineService     *MockMachineService
+	modelConfigService *MockModelConfigService
+}
+
+var _ = gc.Suite(&utilisationSuite{})
+
+func (s *utilisationSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.machineService = NewMockMachineService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	return ctrl
+}
+
+func (s *utilisationSuite) api() *MachineManagerAPI {
+	return &MachineManagerAPI{
+		authorizer:         s.authorizer,
+		machineService:     s.machineService,
+		modelConfigService: s.modelConfigService,
+	}
+}
+
+func (s *utilisationSuite) TestMachineUtilisation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg, err := config.New(config.NoDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"rightsizing-window": "24h",
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.authorizer.EXPECT().CanRead(gomock.Any()).Return(nil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+
+	from := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	before := time.Now()
+	s.machineService.EXPECT().GetMachineUtilisation(gomock.Any(), coremachine.Name("0"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coremachine.Name, since time.Time) (domainmachine.Utilisation, error) {
+			c.Check(since.Before(before.Add(-23*time.Hour)), jc.IsTrue)
+			c.Check(since.After(before.Add(-25*time.Hour)), jc.IsTrue)
+			return domainmachine.Utilisation{
+				Samples:    13,
+				From:       from,
+				To:         to,
+				MeanCPU:    10,
+				PeakCPU:    20,
+				MeanMemory: 30,
+				PeakMemory: 40,
+			}, nil
+		})
+	s.machineService.EXPECT().GetMachineUtilisation(gomock.Any(), coremachine.Name("1"), gomock.Any()).Return(
+		domainmachine.Utilisation{}, machineerrors.MachineNotFound)
+
+	results, err := s.api().MachineUtilisation(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}, {Tag: "machine-1"}, {Tag: "unit-foo-0"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 3)
+	c.Check(results.Results[0], jc.DeepEquals, params.MachineUtilisationResult{
+		Result: &params.MachineUtilisation{
+			Samples:       13,
+			From:          from,
+			To:            to,
+			MeanCPU:       10,
+			PeakCPU:       20,
+			MeanMemory:    30,
+			PeakMemory:    40,
+			WindowSeconds: 24 * 60 * 60,
+		},
+	})
+	c.Check(results.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(results.Results[2].Error, gc.ErrorMatches, `"unit-foo-0" is not a valid machine tag`)
+}
+
+func (s *utilisationSuite) TestMachineUtilisationPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().CanRead(gomock.Any()).Return(apiservererrors.ErrPerm)
+
+	_, err := s.api().MachineUtilisation(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: "machine-0"}},
+	})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
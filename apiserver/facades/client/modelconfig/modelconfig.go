@@ -6,6 +6,7 @@ package modelconfig
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo/v2"
@@ -20,10 +21,12 @@ import (
 	corelogger "github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/admission"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
 )
@@ -42,6 +45,7 @@ type ModelConfigAPI struct {
 	modelConfigService        ModelConfigService
 	modelSecretBackendService ModelSecretBackendService
 	modelSericve              ModelService
+	admissionService          AdmissionService
 }
 
 // ModelConfigAPIV3 is currently the latest.
@@ -60,6 +64,7 @@ func NewModelConfigAPI(
 	modelConfigService ModelConfigService,
 	modelSecretBackendService ModelSecretBackendService,
 	modelSericve ModelService,
+	admissionService AdmissionService,
 	logger corelogger.Logger,
 ) *ModelConfigAPI {
 	return &ModelConfigAPI{
@@ -75,9 +80,34 @@ func NewModelConfigAPI(
 		modelConfigService:        modelConfigService,
 		modelSecretBackendService: modelSecretBackendService,
 		modelSericve:              modelSericve,
+		admissionService:          admissionService,
 	}
 }
 
+// checkAdmission evaluates the admission policies against the request. A
+// denial is returned as a forbidden error, holding the reasons for it.
+func (c *ModelConfigAPI) checkAdmission(ctx context.Context, req *admission.Request) error {
+	err := c.admissionService.CheckAdmission(ctx, c.modelUUID.String(), req)
+	if errors.Is(err, admissionerrors.Denied) {
+		return errors.NewForbidden(nil, err.Error())
+	} else if err != nil {
+		return errors.Annotate(err, "checking admission policies")
+	}
+	return nil
+}
+
+// setModelConfigAdmissionRequest returns the admission request for setting
+// the model configuration.
+func setModelConfigAdmissionRequest(config map[string]interface{}) *admission.Request {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return admission.NewRequest(admission.SetModelConfig).
+		Set(admission.FieldConfigKeys, keys...)
+}
+
 func (c *ModelConfigAPI) checkCanWrite(ctx context.Context) error {
 	return c.auth.HasPermission(ctx, permission.WriteAccess, names.NewModelTag(c.modelUUID.String()))
 }
@@ -180,6 +210,9 @@ func (c *ModelConfigAPI) ModelSet(ctx context.Context, args params.ModelSet) err
 			return apiservererrors.ErrPerm
 		}
 	}
+	if err := c.checkAdmission(ctx, setModelConfigAdmissionRequest(args.Config)); err != nil {
+		return errors.Trace(err)
+	}
 
 	isLoggingAdmin := true
 	err = c.isControllerAdmin(ctx)
@@ -295,6 +328,11 @@ func (c *ModelConfigAPI) ModelUnset(ctx context.Context, args params.ModelUnset)
 	if err := c.check.ChangeAllowed(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	req := admission.NewRequest(admission.SetModelConfig).
+		Set(admission.FieldConfigKeys, args.Keys...)
+	if err := c.checkAdmission(ctx, req); err != nil {
+		return errors.Trace(err)
+	}
 
 	var validationError config.ValidationError
 	err := c.modelConfigService.UpdateModelConfig(ctx, nil, args.Keys)
@@ -336,6 +374,13 @@ func (c *ModelConfigAPI) SetModelConstraints(ctx context.Context, args params.Se
 	if err := c.check.ChangeAllowed(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	req := admission.NewRequest(admission.SetConstraints)
+	if args.Constraints.Zones != nil {
+		req.Set(admission.FieldConstraintsZones, *args.Constraints.Zones...)
+	}
+	if err := c.checkAdmission(ctx, req); err != nil {
+		return errors.Trace(err)
+	}
 	err := c.modelSericve.SetModelConstraints(ctx, args.Constraints)
 	if errors.Is(err, modelerrors.NotFound) {
 		return apiservererrors.ParamsErrorf(
@@ -21,12 +21,15 @@ import (
 	coremodel "github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/permission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/admission"
+	internalerrors "github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/uuid"
 	"github.com/juju/juju/rpc/params"
@@ -40,6 +43,12 @@ type modelconfigSuite struct {
 	mockModelSecretBackendService *MockModelSecretBackendService
 	mockModelService              *MockModelService
 	mockBlockCommandService       *MockBlockCommandService
+	mockAdmissionService          *MockAdmissionService
+
+	// admissionErr is returned for every admission request, which are
+	// recorded in admissionRequests.
+	admissionErr      error
+	admissionRequests []map[string][]string
 
 	modelUUID      coremodel.UUID
 	controllerUUID string
@@ -50,6 +59,8 @@ var _ = gc.Suite(&modelconfigSuite{})
 func (s *modelconfigSuite) SetUpTest(c *gc.C) {
 	s.controllerUUID = uuid.MustNewUUID().String()
 	s.modelUUID = modeltesting.GenModelUUID(c)
+	s.admissionErr = nil
+	s.admissionRequests = nil
 }
 
 func (s *modelconfigSuite) setupMocks(c *gc.C) *gomock.Controller {
@@ -60,6 +71,12 @@ func (s *modelconfigSuite) setupMocks(c *gc.C) *gomock.Controller {
 	s.mockModelSecretBackendService = NewMockModelSecretBackendService(ctrl)
 	s.mockModelService = NewMockModelService(ctrl)
 	s.mockBlockCommandService = NewMockBlockCommandService(ctrl)
+	s.mockAdmissionService = NewMockAdmissionService(ctrl)
+	s.mockAdmissionService.EXPECT().CheckAdmission(gomock.Any(), s.modelUUID.String(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, req *admission.Request) error {
+			s.admissionRequests = append(s.admissionRequests, req.Fields())
+			return s.admissionErr
+		}).AnyTimes()
 	return ctrl
 }
 
@@ -83,6 +100,7 @@ func (s *modelconfigSuite) getAPI(c *gc.C) *ModelConfigAPI {
 		s.mockModelConfigService,
 		s.mockModelSecretBackendService,
 		s.mockModelService,
+		s.mockAdmissionService,
 		loggertesting.WrapCheckLog(c),
 	)
 	return api
@@ -402,6 +420,42 @@ func (s *modelconfigSuite) TestBlockModelUnset(c *gc.C) {
 	s.assertBlocked(c, err, "TestBlockModelUnset")
 }
 
+func (s *modelconfigSuite) TestModelSetDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.getAPI(c)
+
+	s.expectModelWriteAccess()
+	s.expectNoBlocks()
+	s.expectModelAdminAccess()
+	s.admissionErr = internalerrors.Errorf(`%w for set-model-config by policy "no-proxies"`, admissionerrors.Denied)
+
+	err := api.ModelSet(context.Background(), params.ModelSet{
+		Config: map[string]interface{}{"http-proxy": "http://proxy", "ftp-proxy": "http://proxy"},
+	})
+	c.Assert(err, jc.ErrorIs, errors.Forbidden)
+	c.Check(err, gc.ErrorMatches, `admission denied for set-model-config by policy "no-proxies"`)
+	c.Check(s.admissionRequests, jc.DeepEquals, []map[string][]string{{
+		admission.FieldOperation:  {"set-model-config"},
+		admission.FieldConfigKeys: {"ftp-proxy", "http-proxy"},
+	}})
+}
+
+func (s *modelconfigSuite) TestModelUnsetDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.getAPI(c)
+
+	s.expectModelWriteAccess()
+	s.expectNoBlocks()
+	s.admissionErr = internalerrors.Errorf(`%w for set-model-config by policy "no-proxies"`, admissionerrors.Denied)
+
+	err := api.ModelUnset(context.Background(), params.ModelUnset{Keys: []string{"http-proxy"}})
+	c.Assert(err, jc.ErrorIs, errors.Forbidden)
+	c.Check(s.admissionRequests, jc.DeepEquals, []map[string][]string{{
+		admission.FieldOperation:  {"set-model-config"},
+		admission.FieldConfigKeys: {"http-proxy"},
+	}})
+}
+
 func (s *modelconfigSuite) TestModelUnsetMissing(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	api := s.getAPI(c)
@@ -440,6 +494,24 @@ func (s *modelconfigSuite) TestClientSetModelConstraints(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *modelconfigSuite) TestClientSetModelConstraintsDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	api := s.getAPI(c)
+
+	s.expectModelWriteAccess()
+	s.expectNoBlocks()
+	s.admissionErr = internalerrors.Errorf(`%w for set-constraints by policy "zones"`, admissionerrors.Denied)
+
+	err := api.SetModelConstraints(context.Background(), params.SetConstraints{
+		Constraints: constraints.MustParse("zones=az1"),
+	})
+	c.Assert(err, jc.ErrorIs, errors.Forbidden)
+	c.Check(s.admissionRequests, jc.DeepEquals, []map[string][]string{{
+		admission.FieldOperation:        {"set-constraints"},
+		admission.FieldConstraintsZones: {"az1"},
+	}})
+}
+
 func (s *modelconfigSuite) TestClientSetModelConstraintsFailedModelNotFound(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	api := s.getAPI(c)
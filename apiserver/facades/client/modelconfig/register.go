@@ -50,6 +50,7 @@ func makeFacade(ctx facade.ModelContext) (*ModelConfigAPI, error) {
 		domainServices.Config(),
 		domainServices.ModelSecretBackend(),
 		domainServices.ModelInfo(),
+		domainServices.Admission(),
 		ctx.Logger().Child("modelconfig"),
 	), nil
 }
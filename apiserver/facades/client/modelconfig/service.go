@@ -10,8 +10,17 @@ import (
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/domain/blockcommand"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/admission"
 )
 
+// AdmissionService evaluates the admission policies of the model.
+type AdmissionService interface {
+	// CheckAdmission evaluates the admission policies of the controller and
+	// of the model against the request. An error satisfying
+	// admissionerrors.Denied is returned if a policy denies it.
+	CheckAdmission(ctx context.Context, modelUUID string, req *admission.Request) error
+}
+
 // ModelAgentService is the controller service for interacting with agent
 // information that runs on behalf of a model.
 type ModelAgentService interface {
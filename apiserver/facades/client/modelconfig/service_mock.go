@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/modelconfig (interfaces: BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService)
+// Source: github.com/juju/juju/apiserver/facades/client/modelconfig (interfaces: BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService,AdmissionService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package modelconfig -destination service_mock.go github.com/juju/juju/apiserver/facades/client/modelconfig BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService
+//	mockgen -typed -package modelconfig -destination service_mock.go github.com/juju/juju/apiserver/facades/client/modelconfig BlockCommandService,ModelAgentService,ModelConfigService,ModelSecretBackendService,ModelService,AdmissionService
 //
 
 // Package modelconfig is a generated GoMock package.
@@ -17,6 +17,7 @@ import (
 	constraints "github.com/juju/juju/core/constraints"
 	blockcommand "github.com/juju/juju/domain/blockcommand"
 	config "github.com/juju/juju/environs/config"
+	admission "github.com/juju/juju/internal/admission"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -486,3 +487,64 @@ func (c *MockModelServiceSetModelConstraintsCall) DoAndReturn(f func(context.Con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockAdmissionService is a mock of AdmissionService interface.
+type MockAdmissionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdmissionServiceMockRecorder
+}
+
+// MockAdmissionServiceMockRecorder is the mock recorder for MockAdmissionService.
+type MockAdmissionServiceMockRecorder struct {
+	mock *MockAdmissionService
+}
+
+// NewMockAdmissionService creates a new mock instance.
+func NewMockAdmissionService(ctrl *gomock.Controller) *MockAdmissionService {
+	mock := &MockAdmissionService{ctrl: ctrl}
+	mock.recorder = &MockAdmissionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdmissionService) EXPECT() *MockAdmissionServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckAdmission mocks base method.
+func (m *MockAdmissionService) CheckAdmission(arg0 context.Context, arg1 string, arg2 *admission.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAdmission", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAdmission indicates an expected call of CheckAdmission.
+func (mr *MockAdmissionServiceMockRecorder) CheckAdmission(arg0, arg1, arg2 any) *MockAdmissionServiceCheckAdmissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAdmission", reflect.TypeOf((*MockAdmissionService)(nil).CheckAdmission), arg0, arg1, arg2)
+	return &MockAdmissionServiceCheckAdmissionCall{Call: call}
+}
+
+// MockAdmissionServiceCheckAdmissionCall wrap *gomock.Call
+type MockAdmissionServiceCheckAdmissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAdmissionServiceCheckAdmissionCall) Return(arg0 error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAdmissionServiceCheckAdmissionCall) Do(f func(context.Context, string, *admission.Request) error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAdmissionServiceCheckAdmissionCall) DoAndReturn(f func(context.Context, string, *admission.Request) error) *MockAdmissionServiceCheckAdmissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
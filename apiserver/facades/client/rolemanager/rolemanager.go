@@ -0,0 +1,254 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rolemanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API manages custom roles, which allow the users they are granted to on a
+// model or the controller to call API methods their access level does not.
+type API struct {
+	accessService AccessService
+	authorizer    facade.Authorizer
+	controllerTag names.ControllerTag
+}
+
+// NewAPI returns a new RoleManager API facade.
+func NewAPI(
+	accessService AccessService,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+) *API {
+	return &API{
+		accessService: accessService,
+		authorizer:    authorizer,
+		controllerTag: controllerTag,
+	}
+}
+
+// AddRoles adds the roles. Only controller superusers may add roles.
+func (api *API) AddRoles(ctx context.Context, args params.AddRoles) (params.ErrorResults, error) {
+	if err := api.checkIsSuperuser(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Roles)),
+	}
+	for i, arg := range args.Roles {
+		err := api.accessService.AddRole(ctx, access.Role{
+			Name:        arg.Name,
+			Description: arg.Description,
+			Methods:     arg.Methods,
+		})
+		result.Results[i].Error = apiservererrors.ServerError(roleError(err))
+	}
+	return result, nil
+}
+
+// RemoveRoles removes the roles, revoking them from every user they were
+// granted to. Only controller superusers may remove roles.
+func (api *API) RemoveRoles(ctx context.Context, args params.RoleNames) (params.ErrorResults, error) {
+	if err := api.checkIsSuperuser(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Names)),
+	}
+	for i, name := range args.Names {
+		err := api.accessService.RemoveRole(ctx, name)
+		result.Results[i].Error = apiservererrors.ServerError(roleError(err))
+	}
+	return result, nil
+}
+
+// ListRoles returns every role, ordered by name.
+func (api *API) ListRoles(ctx context.Context) (params.RolesResult, error) {
+	roles, err := api.accessService.ListRoles(ctx)
+	if err != nil {
+		return params.RolesResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	result := params.RolesResult{
+		Roles: make([]params.Role, len(roles)),
+	}
+	for i, role := range roles {
+		result.Roles[i] = params.Role{
+			Name:        role.Name,
+			Description: role.Description,
+			Methods:     role.Methods,
+		}
+	}
+	return result, nil
+}
+
+// GrantRoles grants roles to users on models or the controller. Controller
+// superusers may grant roles on any target, and model admins on their
+// models.
+func (api *API) GrantRoles(ctx context.Context, args params.RoleGrants) (params.ErrorResults, error) {
+	return api.changeRoleGrants(ctx, args, api.accessService.GrantRole)
+}
+
+// RevokeRoles revokes roles from users on models or the controller.
+// Controller superusers may revoke roles on any target, and model admins on
+// their models.
+func (api *API) RevokeRoles(ctx context.Context, args params.RoleGrants) (params.ErrorResults, error) {
+	return api.changeRoleGrants(ctx, args, api.accessService.RevokeRole)
+}
+
+func (api *API) changeRoleGrants(
+	ctx context.Context, args params.RoleGrants, change func(context.Context, access.RoleGrant) error,
+) (params.ErrorResults, error) {
+	isSuperuser, err := api.isSuperuser(ctx)
+	if err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Grants)),
+	}
+	for i, arg := range args.Grants {
+		grant, err := roleGrantFromParams(arg)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !isSuperuser {
+			if err := api.checkCanAdminTarget(ctx, grant.Target); err != nil {
+				result.Results[i].Error = apiservererrors.ServerError(err)
+				continue
+			}
+		}
+		err = change(ctx, grant)
+		result.Results[i].Error = apiservererrors.ServerError(roleError(err))
+	}
+	return result, nil
+}
+
+// ListRoleGrants returns the grants of the role, or of every role. Controller
+// superusers see every grant; other users see the grants made to them.
+func (api *API) ListRoleGrants(ctx context.Context, arg params.RoleGrantsFilter) (params.RoleGrantsResult, error) {
+	authTag, ok := api.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return params.RoleGrantsResult{}, apiservererrors.ErrPerm
+	}
+	isSuperuser, err := api.isSuperuser(ctx)
+	if err != nil {
+		return params.RoleGrantsResult{}, errors.Trace(err)
+	}
+
+	grants, err := api.accessService.ListRoleGrants(ctx, arg.Role)
+	if err != nil {
+		return params.RoleGrantsResult{Error: apiservererrors.ServerError(roleError(err))}, nil
+	}
+	authUser := user.NameFromTag(authTag)
+	result := params.RoleGrantsResult{
+		Grants: []params.RoleGrant{},
+	}
+	for _, grant := range grants {
+		if !isSuperuser && grant.Subject != authUser {
+			continue
+		}
+		result.Grants = append(result.Grants, roleGrantToParams(grant))
+	}
+	return result, nil
+}
+
+// checkCanAdminTarget returns an error if the authenticated user is not an
+// admin of the target, which must be a model as only superusers may grant
+// roles on the controller.
+func (api *API) checkCanAdminTarget(ctx context.Context, target permission.ID) error {
+	if target.ObjectType != permission.Model {
+		return apiservererrors.ErrPerm
+	}
+	err := api.authorizer.HasPermission(ctx, permission.AdminAccess, names.NewModelTag(target.Key))
+	if errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return apiservererrors.ErrPerm
+	}
+	return err
+}
+
+// checkIsSuperuser returns an error if the authenticated user is not a
+// controller superuser.
+func (api *API) checkIsSuperuser(ctx context.Context) error {
+	return api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+}
+
+// isSuperuser reports whether the authenticated user is a controller
+// superuser.
+func (api *API) isSuperuser(ctx context.Context) (bool, error) {
+	err := api.checkIsSuperuser(ctx)
+	if errors.Is(err, apiservererrors.ErrPerm) || errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// roleError converts the errors of the access service into errors which are
+// understood by the API.
+func roleError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, accesserrors.RoleNotFound),
+		errors.Is(err, accesserrors.RoleGrantNotFound),
+		errors.Is(err, accesserrors.UserNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, accesserrors.RoleAlreadyExists),
+		errors.Is(err, accesserrors.RoleGrantAlreadyExists):
+		return errors.NewAlreadyExists(err, "")
+	case errors.Is(err, accesserrors.RoleNotValid),
+		errors.Is(err, accesserrors.PermissionTargetInvalid),
+		errors.Is(err, coreerrors.NotValid):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
+
+func roleGrantFromParams(arg params.RoleGrant) (access.RoleGrant, error) {
+	userTag, err := names.ParseUserTag(arg.UserTag)
+	if err != nil {
+		return access.RoleGrant{}, errors.Trace(err)
+	}
+	targetTag, err := names.ParseTag(arg.TargetTag)
+	if err != nil {
+		return access.RoleGrant{}, errors.Trace(err)
+	}
+	target, err := permission.ParseTagForID(targetTag)
+	if err != nil || (target.ObjectType != permission.Model && target.ObjectType != permission.Controller) {
+		return access.RoleGrant{}, errors.NotValidf("target %q", arg.TargetTag)
+	}
+	return access.RoleGrant{
+		Role:    arg.Role,
+		Subject: user.NameFromTag(userTag),
+		Target:  target,
+	}, nil
+}
+
+func roleGrantToParams(grant access.RoleGrant) params.RoleGrant {
+	var targetTag names.Tag
+	if grant.Target.ObjectType == permission.Controller {
+		targetTag = names.NewControllerTag(grant.Target.Key)
+	} else {
+		targetTag = names.NewModelTag(grant.Target.Key)
+	}
+	return params.RoleGrant{
+		Role:      grant.Role,
+		UserTag:   names.NewUserTag(grant.Subject.Name()).String(),
+		TargetTag: targetTag.String(),
+	}
+}
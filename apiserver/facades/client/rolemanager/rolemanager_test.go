@@ -0,0 +1,305 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rolemanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type apiSuite struct {
+	accessService *MockAccessService
+	authorizer    *MockAuthorizer
+}
+
+var _ = gc.Suite(&apiSuite{})
+
+func (s *apiSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.accessService = NewMockAccessService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	return ctrl
+}
+
+func (s *apiSuite) newAPI() *API {
+	return NewAPI(s.accessService, s.authorizer, coretesting.ControllerTag)
+}
+
+func (s *apiSuite) expectSuperuser(isSuperuser bool) {
+	var err error
+	if !isSuperuser {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(err)
+}
+
+func (s *apiSuite) expectModelAdmin(isAdmin bool) {
+	var err error
+	if !isAdmin {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(err)
+}
+
+func (s *apiSuite) TestAddRoles(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().AddRole(gomock.Any(), access.Role{
+		Name:        "operator",
+		Description: "Operates applications",
+		Methods:     []string{"Application.Get"},
+	}).Return(nil)
+	s.accessService.EXPECT().AddRole(gomock.Any(), access.Role{
+		Name:    "viewer",
+		Methods: []string{"Client.FullStatus"},
+	}).Return(accesserrors.RoleAlreadyExists)
+
+	result, err := s.newAPI().AddRoles(context.Background(), params.AddRoles{
+		Roles: []params.Role{{
+			Name:        "operator",
+			Description: "Operates applications",
+			Methods:     []string{"Application.Get"},
+		}, {
+			Name:    "viewer",
+			Methods: []string{"Client.FullStatus"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeAlreadyExists)
+}
+
+func (s *apiSuite) TestAddRolesNotSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(apiservererrors.ErrPerm)
+
+	_, err := s.newAPI().AddRoles(context.Background(), params.AddRoles{})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *apiSuite) TestRemoveRoles(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().RemoveRole(gomock.Any(), "operator").Return(nil)
+	s.accessService.EXPECT().RemoveRole(gomock.Any(), "missing").Return(accesserrors.RoleNotFound)
+
+	result, err := s.newAPI().RemoveRoles(context.Background(), params.RoleNames{
+		Names: []string{"operator", "missing"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) TestListRoles(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.accessService.EXPECT().ListRoles(gomock.Any()).Return([]access.Role{{
+		Name:        "operator",
+		Description: "Operates applications",
+		Methods:     []string{"Action.*", "Application.Get"},
+	}}, nil)
+
+	result, err := s.newAPI().ListRoles(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.RolesResult{
+		Roles: []params.Role{{
+			Name:        "operator",
+			Description: "Operates applications",
+			Methods:     []string{"Action.*", "Application.Get"},
+		}},
+	})
+}
+
+func (s *apiSuite) TestGrantRolesSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().GrantRole(gomock.Any(), access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target: permission.ID{
+			ObjectType: permission.Controller,
+			Key:        coretesting.ControllerTag.Id(),
+		},
+	}).Return(nil)
+
+	result, err := s.newAPI().GrantRoles(context.Background(), params.RoleGrants{
+		Grants: []params.RoleGrant{{
+			Role:      "operator",
+			UserTag:   "user-bob",
+			TargetTag: coretesting.ControllerTag.String(),
+		}, {
+			Role:      "operator",
+			UserTag:   "user-bob",
+			TargetTag: "cloud-aws",
+		}, {
+			Role:      "operator",
+			UserTag:   "bob",
+			TargetTag: coretesting.ControllerTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, gc.ErrorMatches, `target "cloud-aws" not valid`)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `"bob" is not a valid tag`)
+}
+
+func (s *apiSuite) TestGrantRolesModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectModelAdmin(true)
+	s.accessService.EXPECT().GrantRole(gomock.Any(), access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "sue"),
+		Target: permission.ID{
+			ObjectType: permission.Model,
+			Key:        coretesting.ModelTag.Id(),
+		},
+	}).Return(accesserrors.RoleGrantAlreadyExists)
+
+	result, err := s.newAPI().GrantRoles(context.Background(), params.RoleGrants{
+		Grants: []params.RoleGrant{{
+			Role:      "operator",
+			UserTag:   "user-sue",
+			TargetTag: coretesting.ModelTag.String(),
+		}, {
+			Role:      "operator",
+			UserTag:   "user-sue",
+			TargetTag: coretesting.ControllerTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, jc.Satisfies, params.IsCodeAlreadyExists)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *apiSuite) TestRevokeRolesNotModelAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+	s.expectModelAdmin(false)
+
+	result, err := s.newAPI().RevokeRoles(context.Background(), params.RoleGrants{
+		Grants: []params.RoleGrant{{
+			Role:      "operator",
+			UserTag:   "user-sue",
+			TargetTag: coretesting.ModelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Error, jc.Satisfies, params.IsCodeUnauthorized)
+}
+
+func (s *apiSuite) TestRevokeRoles(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().RevokeRole(gomock.Any(), access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "sue"),
+		Target: permission.ID{
+			ObjectType: permission.Model,
+			Key:        coretesting.ModelTag.Id(),
+		},
+	}).Return(accesserrors.RoleGrantNotFound)
+
+	result, err := s.newAPI().RevokeRoles(context.Background(), params.RoleGrants{
+		Grants: []params.RoleGrant{{
+			Role:      "operator",
+			UserTag:   "user-sue",
+			TargetTag: coretesting.ModelTag.String(),
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Check(result.Results[0].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) grants(c *gc.C) []access.RoleGrant {
+	return []access.RoleGrant{{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  permission.ID{ObjectType: permission.Model, Key: coretesting.ModelTag.Id()},
+	}, {
+		Role:    "viewer",
+		Subject: usertesting.GenNewName(c, "sue"),
+		Target:  permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()},
+	}}
+}
+
+func (s *apiSuite) TestListRoleGrantsSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("admin"))
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().ListRoleGrants(gomock.Any(), "").Return(s.grants(c), nil)
+
+	result, err := s.newAPI().ListRoleGrants(context.Background(), params.RoleGrantsFilter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.RoleGrantsResult{
+		Grants: []params.RoleGrant{{
+			Role:      "operator",
+			UserTag:   "user-bob",
+			TargetTag: coretesting.ModelTag.String(),
+		}, {
+			Role:      "viewer",
+			UserTag:   "user-sue",
+			TargetTag: coretesting.ControllerTag.String(),
+		}},
+	})
+}
+
+func (s *apiSuite) TestListRoleGrantsOwnGrants(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("sue"))
+	s.expectSuperuser(false)
+	s.accessService.EXPECT().ListRoleGrants(gomock.Any(), "").Return(s.grants(c), nil)
+
+	result, err := s.newAPI().ListRoleGrants(context.Background(), params.RoleGrantsFilter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.RoleGrantsResult{
+		Grants: []params.RoleGrant{{
+			Role:      "viewer",
+			UserTag:   "user-sue",
+			TargetTag: coretesting.ControllerTag.String(),
+		}},
+	})
+}
+
+func (s *apiSuite) TestListRoleGrantsRoleNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("admin"))
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().ListRoleGrants(gomock.Any(), "missing").Return(nil, accesserrors.RoleNotFound)
+
+	result, err := s.newAPI().ListRoleGrants(context.Background(), params.RoleGrantsFilter{Role: "missing"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, jc.Satisfies, params.IsCodeNotFound)
+}
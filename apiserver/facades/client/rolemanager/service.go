@@ -0,0 +1,30 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rolemanager
+
+import (
+	"context"
+
+	"github.com/juju/juju/domain/access"
+)
+
+// AccessService provides access to the custom roles of the controller.
+type AccessService interface {
+	// AddRole adds the role.
+	AddRole(ctx context.Context, role access.Role) error
+	// ListRoles returns every role, ordered by name.
+	ListRoles(ctx context.Context) ([]access.Role, error)
+	// RemoveRole removes the role, revoking it from every user it was
+	// granted to.
+	RemoveRole(ctx context.Context, name string) error
+	// GrantRole grants the role to the user on the target model or
+	// controller.
+	GrantRole(ctx context.Context, grant access.RoleGrant) error
+	// RevokeRole revokes the role from the user on the target model or
+	// controller.
+	RevokeRole(ctx context.Context, grant access.RoleGrant) error
+	// ListRoleGrants returns the grants of the role with the name, or of
+	// every role if the name is empty.
+	ListRoleGrants(ctx context.Context, roleName string) ([]access.RoleGrant, error)
+}
@@ -0,0 +1,271 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/rolemanager (interfaces: AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package rolemanager -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/rolemanager AccessService
+//
+
+// Package rolemanager is a generated GoMock package.
+package rolemanager
+
+import (
+	context "context"
+	reflect "reflect"
+
+	access "github.com/juju/juju/domain/access"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock *MockAccessService
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// AddRole mocks base method.
+func (m *MockAccessService) AddRole(arg0 context.Context, arg1 access.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRole indicates an expected call of AddRole.
+func (mr *MockAccessServiceMockRecorder) AddRole(arg0, arg1 any) *MockAccessServiceAddRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRole", reflect.TypeOf((*MockAccessService)(nil).AddRole), arg0, arg1)
+	return &MockAccessServiceAddRoleCall{Call: call}
+}
+
+// MockAccessServiceAddRoleCall wrap *gomock.Call
+type MockAccessServiceAddRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceAddRoleCall) Return(arg0 error) *MockAccessServiceAddRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceAddRoleCall) Do(f func(context.Context, access.Role) error) *MockAccessServiceAddRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceAddRoleCall) DoAndReturn(f func(context.Context, access.Role) error) *MockAccessServiceAddRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GrantRole mocks base method.
+func (m *MockAccessService) GrantRole(arg0 context.Context, arg1 access.RoleGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantRole indicates an expected call of GrantRole.
+func (mr *MockAccessServiceMockRecorder) GrantRole(arg0, arg1 any) *MockAccessServiceGrantRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantRole", reflect.TypeOf((*MockAccessService)(nil).GrantRole), arg0, arg1)
+	return &MockAccessServiceGrantRoleCall{Call: call}
+}
+
+// MockAccessServiceGrantRoleCall wrap *gomock.Call
+type MockAccessServiceGrantRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceGrantRoleCall) Return(arg0 error) *MockAccessServiceGrantRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceGrantRoleCall) Do(f func(context.Context, access.RoleGrant) error) *MockAccessServiceGrantRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceGrantRoleCall) DoAndReturn(f func(context.Context, access.RoleGrant) error) *MockAccessServiceGrantRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRoleGrants mocks base method.
+func (m *MockAccessService) ListRoleGrants(arg0 context.Context, arg1 string) ([]access.RoleGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleGrants", arg0, arg1)
+	ret0, _ := ret[0].([]access.RoleGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleGrants indicates an expected call of ListRoleGrants.
+func (mr *MockAccessServiceMockRecorder) ListRoleGrants(arg0, arg1 any) *MockAccessServiceListRoleGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleGrants", reflect.TypeOf((*MockAccessService)(nil).ListRoleGrants), arg0, arg1)
+	return &MockAccessServiceListRoleGrantsCall{Call: call}
+}
+
+// MockAccessServiceListRoleGrantsCall wrap *gomock.Call
+type MockAccessServiceListRoleGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceListRoleGrantsCall) Return(arg0 []access.RoleGrant, arg1 error) *MockAccessServiceListRoleGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceListRoleGrantsCall) Do(f func(context.Context, string) ([]access.RoleGrant, error)) *MockAccessServiceListRoleGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceListRoleGrantsCall) DoAndReturn(f func(context.Context, string) ([]access.RoleGrant, error)) *MockAccessServiceListRoleGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRoles mocks base method.
+func (m *MockAccessService) ListRoles(arg0 context.Context) ([]access.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	ret0, _ := ret[0].([]access.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockAccessServiceMockRecorder) ListRoles(arg0 any) *MockAccessServiceListRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockAccessService)(nil).ListRoles), arg0)
+	return &MockAccessServiceListRolesCall{Call: call}
+}
+
+// MockAccessServiceListRolesCall wrap *gomock.Call
+type MockAccessServiceListRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceListRolesCall) Return(arg0 []access.Role, arg1 error) *MockAccessServiceListRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceListRolesCall) Do(f func(context.Context) ([]access.Role, error)) *MockAccessServiceListRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceListRolesCall) DoAndReturn(f func(context.Context) ([]access.Role, error)) *MockAccessServiceListRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveRole mocks base method.
+func (m *MockAccessService) RemoveRole(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRole indicates an expected call of RemoveRole.
+func (mr *MockAccessServiceMockRecorder) RemoveRole(arg0, arg1 any) *MockAccessServiceRemoveRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRole", reflect.TypeOf((*MockAccessService)(nil).RemoveRole), arg0, arg1)
+	return &MockAccessServiceRemoveRoleCall{Call: call}
+}
+
+// MockAccessServiceRemoveRoleCall wrap *gomock.Call
+type MockAccessServiceRemoveRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceRemoveRoleCall) Return(arg0 error) *MockAccessServiceRemoveRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceRemoveRoleCall) Do(f func(context.Context, string) error) *MockAccessServiceRemoveRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceRemoveRoleCall) DoAndReturn(f func(context.Context, string) error) *MockAccessServiceRemoveRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeRole mocks base method.
+func (m *MockAccessService) RevokeRole(arg0 context.Context, arg1 access.RoleGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRole indicates an expected call of RevokeRole.
+func (mr *MockAccessServiceMockRecorder) RevokeRole(arg0, arg1 any) *MockAccessServiceRevokeRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockAccessService)(nil).RevokeRole), arg0, arg1)
+	return &MockAccessServiceRevokeRoleCall{Call: call}
+}
+
+// MockAccessServiceRevokeRoleCall wrap *gomock.Call
+type MockAccessServiceRevokeRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceRevokeRoleCall) Return(arg0 error) *MockAccessServiceRevokeRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceRevokeRoleCall) Do(f func(context.Context, access.RoleGrant) error) *MockAccessServiceRevokeRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceRevokeRoleCall) DoAndReturn(f func(context.Context, access.RoleGrant) error) *MockAccessServiceRevokeRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
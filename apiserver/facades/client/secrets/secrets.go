@@ -289,13 +289,14 @@ func (s *SecretsAPI) createSecret(ctx context.Context, arg params.CreateSecretAr
 
 func fromUpsertParams(modelUUID string, autoPrune *bool, p params.UpsertSecretArg) secretservice.UpdateUserSecretParams {
 	return secretservice.UpdateUserSecretParams{
-		Accessor:    secretservice.SecretAccessor{Kind: secretservice.ModelAccessor, ID: modelUUID},
-		AutoPrune:   autoPrune,
-		Description: p.Description,
-		Label:       p.Label,
-		Params:      p.Params,
-		Data:        p.Content.Data,
-		Checksum:    p.Content.Checksum,
+		Accessor:     secretservice.SecretAccessor{Kind: secretservice.ModelAccessor, ID: modelUUID},
+		AutoPrune:    autoPrune,
+		RotatePolicy: p.RotatePolicy,
+		Description:  p.Description,
+		Label:        p.Label,
+		Params:       p.Params,
+		Data:         p.Content.Data,
+		Checksum:     p.Content.Checksum,
 	}
 }
 
@@ -0,0 +1,33 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccountmanager
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+)
+
+// Register is called to expose a package of facades onto a given registry.
+func Register(registry facade.FacadeRegistry) {
+	registry.MustRegister("ServiceAccountManager", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newAPI(ctx)
+	}, reflect.TypeOf((*API)(nil)))
+}
+
+func newAPI(ctx facade.ModelContext) (*API, error) {
+	authorizer := ctx.Auth()
+	if !authorizer.AuthClient() {
+		return nil, apiservererrors.ErrPerm
+	}
+	return NewAPI(
+		ctx.DomainServices().Access(),
+		authorizer,
+		names.NewControllerTag(ctx.ControllerUUID()),
+	), nil
+}
@@ -0,0 +1,26 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccountmanager
+
+import (
+	"context"
+
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+)
+
+// AccessService provides access to the service accounts of the controller
+// and their API tokens.
+type AccessService interface {
+	// AddServiceAccount adds a service account with the given name, created
+	// by the creator.
+	AddServiceAccount(ctx context.Context, name, creator user.Name) (user.UUID, error)
+	// IssueServiceAccountToken issues a new named API token to a service
+	// account, returning the token. Only a hash of the token is kept, so it
+	// can not be retrieved again.
+	IssueServiceAccountToken(ctx context.Context, args access.IssueServiceAccountTokenArgs) (string, error)
+	// RevokeServiceAccountToken revokes the named API token of the service
+	// account.
+	RevokeServiceAccountToken(ctx context.Context, account user.Name, name string) error
+}
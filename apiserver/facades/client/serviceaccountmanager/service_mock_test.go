@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/serviceaccountmanager (interfaces: AccessService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package serviceaccountmanager -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/serviceaccountmanager AccessService
+//
+
+// Package serviceaccountmanager is a generated GoMock package.
+package serviceaccountmanager
+
+import (
+	context "context"
+	reflect "reflect"
+
+	user "github.com/juju/juju/core/user"
+	access "github.com/juju/juju/domain/access"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessService is a mock of AccessService interface.
+type MockAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessServiceMockRecorder
+}
+
+// MockAccessServiceMockRecorder is the mock recorder for MockAccessService.
+type MockAccessServiceMockRecorder struct {
+	mock *MockAccessService
+}
+
+// NewMockAccessService creates a new mock instance.
+func NewMockAccessService(ctrl *gomock.Controller) *MockAccessService {
+	mock := &MockAccessService{ctrl: ctrl}
+	mock.recorder = &MockAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// AddServiceAccount mocks base method.
+func (m *MockAccessService) AddServiceAccount(arg0 context.Context, arg1, arg2 user.Name) (user.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceAccount", arg0, arg1, arg2)
+	ret0, _ := ret[0].(user.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddServiceAccount indicates an expected call of AddServiceAccount.
+func (mr *MockAccessServiceMockRecorder) AddServiceAccount(arg0, arg1, arg2 any) *MockAccessServiceAddServiceAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceAccount", reflect.TypeOf((*MockAccessService)(nil).AddServiceAccount), arg0, arg1, arg2)
+	return &MockAccessServiceAddServiceAccountCall{Call: call}
+}
+
+// MockAccessServiceAddServiceAccountCall wrap *gomock.Call
+type MockAccessServiceAddServiceAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceAddServiceAccountCall) Return(arg0 user.UUID, arg1 error) *MockAccessServiceAddServiceAccountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceAddServiceAccountCall) Do(f func(context.Context, user.Name, user.Name) (user.UUID, error)) *MockAccessServiceAddServiceAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceAddServiceAccountCall) DoAndReturn(f func(context.Context, user.Name, user.Name) (user.UUID, error)) *MockAccessServiceAddServiceAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IssueServiceAccountToken mocks base method.
+func (m *MockAccessService) IssueServiceAccountToken(arg0 context.Context, arg1 access.IssueServiceAccountTokenArgs) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueServiceAccountToken", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueServiceAccountToken indicates an expected call of IssueServiceAccountToken.
+func (mr *MockAccessServiceMockRecorder) IssueServiceAccountToken(arg0, arg1 any) *MockAccessServiceIssueServiceAccountTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueServiceAccountToken", reflect.TypeOf((*MockAccessService)(nil).IssueServiceAccountToken), arg0, arg1)
+	return &MockAccessServiceIssueServiceAccountTokenCall{Call: call}
+}
+
+// MockAccessServiceIssueServiceAccountTokenCall wrap *gomock.Call
+type MockAccessServiceIssueServiceAccountTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceIssueServiceAccountTokenCall) Return(arg0 string, arg1 error) *MockAccessServiceIssueServiceAccountTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceIssueServiceAccountTokenCall) Do(f func(context.Context, access.IssueServiceAccountTokenArgs) (string, error)) *MockAccessServiceIssueServiceAccountTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceIssueServiceAccountTokenCall) DoAndReturn(f func(context.Context, access.IssueServiceAccountTokenArgs) (string, error)) *MockAccessServiceIssueServiceAccountTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeServiceAccountToken mocks base method.
+func (m *MockAccessService) RevokeServiceAccountToken(arg0 context.Context, arg1 user.Name, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeServiceAccountToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeServiceAccountToken indicates an expected call of RevokeServiceAccountToken.
+func (mr *MockAccessServiceMockRecorder) RevokeServiceAccountToken(arg0, arg1, arg2 any) *MockAccessServiceRevokeServiceAccountTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeServiceAccountToken", reflect.TypeOf((*MockAccessService)(nil).RevokeServiceAccountToken), arg0, arg1, arg2)
+	return &MockAccessServiceRevokeServiceAccountTokenCall{Call: call}
+}
+
+// MockAccessServiceRevokeServiceAccountTokenCall wrap *gomock.Call
+type MockAccessServiceRevokeServiceAccountTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceRevokeServiceAccountTokenCall) Return(arg0 error) *MockAccessServiceRevokeServiceAccountTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceRevokeServiceAccountTokenCall) Do(f func(context.Context, user.Name, string) error) *MockAccessServiceRevokeServiceAccountTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceRevokeServiceAccountTokenCall) DoAndReturn(f func(context.Context, user.Name, string) error) *MockAccessServiceRevokeServiceAccountTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccountmanager
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API manages service accounts, which are non-human users that
+// authenticate with named, revocable and expiring API tokens. Only
+// controller superusers may use it.
+type API struct {
+	accessService AccessService
+	authorizer    facade.Authorizer
+	controllerTag names.ControllerTag
+}
+
+// NewAPI returns a new ServiceAccountManager API facade.
+func NewAPI(
+	accessService AccessService,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+) *API {
+	return &API{
+		accessService: accessService,
+		authorizer:    authorizer,
+		controllerTag: controllerTag,
+	}
+}
+
+// AddServiceAccounts adds service accounts, which are identified by user
+// tags. Service accounts are granted access like any other user.
+func (api *API) AddServiceAccounts(ctx context.Context, args params.Entities) (params.ErrorResults, error) {
+	if err := api.checkIsSuperuser(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	creator, ok := api.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return params.ErrorResults{}, apiservererrors.ErrPerm
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		account, err := parseServiceAccountTag(arg.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		_, err = api.accessService.AddServiceAccount(ctx, account, user.NameFromTag(creator))
+		result.Results[i].Error = apiservererrors.ServerError(serviceAccountError(err))
+	}
+	return result, nil
+}
+
+// IssueTokens issues named API tokens to service accounts. Each token is
+// only returned here, as the controller keeps a hash of it.
+func (api *API) IssueTokens(ctx context.Context, args params.IssueServiceAccountTokens) (params.IssueServiceAccountTokenResults, error) {
+	if err := api.checkIsSuperuser(ctx); err != nil {
+		return params.IssueServiceAccountTokenResults{}, errors.Trace(err)
+	}
+	result := params.IssueServiceAccountTokenResults{
+		Results: make([]params.IssueServiceAccountTokenResult, len(args.Tokens)),
+	}
+	for i, arg := range args.Tokens {
+		issueArgs, err := issueArgsFromParams(arg)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		token, err := api.accessService.IssueServiceAccountToken(ctx, issueArgs)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(serviceAccountError(err))
+			continue
+		}
+		result.Results[i].Token = token
+	}
+	return result, nil
+}
+
+// RevokeTokens revokes named API tokens of service accounts. Connections
+// already authenticated with a token are not closed.
+func (api *API) RevokeTokens(ctx context.Context, args params.RevokeServiceAccountTokens) (params.ErrorResults, error) {
+	if err := api.checkIsSuperuser(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Tokens)),
+	}
+	for i, arg := range args.Tokens {
+		account, err := parseServiceAccountTag(arg.ServiceAccountTag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = api.accessService.RevokeServiceAccountToken(ctx, account, arg.Name)
+		result.Results[i].Error = apiservererrors.ServerError(serviceAccountError(err))
+	}
+	return result, nil
+}
+
+// checkIsSuperuser returns an error if the authenticated user is not a
+// controller superuser.
+func (api *API) checkIsSuperuser(ctx context.Context) error {
+	return api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+}
+
+// serviceAccountError converts the errors of the access service into
+// errors which are understood by the API.
+func serviceAccountError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, accesserrors.ServiceAccountNotFound),
+		errors.Is(err, accesserrors.ServiceAccountTokenNotFound),
+		errors.Is(err, accesserrors.UserCreatorUUIDNotFound):
+		return errors.NewNotFound(err, "")
+	case errors.Is(err, accesserrors.UserAlreadyExists),
+		errors.Is(err, accesserrors.ServiceAccountTokenAlreadyExists):
+		return errors.NewAlreadyExists(err, "")
+	case errors.Is(err, accesserrors.UserNameNotValid),
+		errors.Is(err, accesserrors.ServiceAccountTokenNotValid),
+		errors.Is(err, accesserrors.PermissionTargetInvalid),
+		errors.Is(err, coreerrors.NotValid):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
+
+func parseServiceAccountTag(tag string) (user.Name, error) {
+	userTag, err := names.ParseUserTag(tag)
+	if err != nil {
+		return user.Name{}, errors.Trace(err)
+	}
+	return user.NameFromTag(userTag), nil
+}
+
+func issueArgsFromParams(arg params.IssueServiceAccountToken) (access.IssueServiceAccountTokenArgs, error) {
+	account, err := parseServiceAccountTag(arg.ServiceAccountTag)
+	if err != nil {
+		return access.IssueServiceAccountTokenArgs{}, errors.Trace(err)
+	}
+	scopes := make([]permission.AccessSpec, len(arg.Scopes))
+	for i, scope := range arg.Scopes {
+		targetTag, err := names.ParseTag(scope.TargetTag)
+		if err != nil {
+			return access.IssueServiceAccountTokenArgs{}, errors.Trace(err)
+		}
+		target, err := permission.ParseTagForID(targetTag)
+		if err != nil || (target.ObjectType != permission.Model && target.ObjectType != permission.Controller) {
+			return access.IssueServiceAccountTokenArgs{}, errors.NotValidf("target %q", scope.TargetTag)
+		}
+		scopes[i] = permission.AccessSpec{
+			Target: target,
+			Access: permission.Access(scope.Access),
+		}
+	}
+	return access.IssueServiceAccountTokenArgs{
+		ServiceAccount: account,
+		Name:           arg.Name,
+		Scopes:         scopes,
+		ExpiresIn:      arg.ExpiresIn,
+	}, nil
+}
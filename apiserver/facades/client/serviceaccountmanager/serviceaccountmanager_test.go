@@ -0,0 +1,178 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package serviceaccountmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type apiSuite struct {
+	accessService *MockAccessService
+	authorizer    *MockAuthorizer
+}
+
+var _ = gc.Suite(&apiSuite{})
+
+func (s *apiSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.accessService = NewMockAccessService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	return ctrl
+}
+
+func (s *apiSuite) newAPI() *API {
+	return NewAPI(s.accessService, s.authorizer, coretesting.ControllerTag)
+}
+
+func (s *apiSuite) expectSuperuser(isSuperuser bool) {
+	var err error
+	if !isSuperuser {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(err)
+}
+
+func (s *apiSuite) TestAddServiceAccounts(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("admin"))
+	s.accessService.EXPECT().AddServiceAccount(gomock.Any(), user.NameFromTag(names.NewUserTag("ci")), user.AdminUserName).
+		Return(usertesting.GenUserUUID(c), nil)
+	s.accessService.EXPECT().AddServiceAccount(gomock.Any(), user.NameFromTag(names.NewUserTag("deploy")), user.AdminUserName).
+		Return("", accesserrors.UserAlreadyExists)
+
+	result, err := s.newAPI().AddServiceAccounts(context.Background(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: names.NewUserTag("ci").String()},
+			{Tag: names.NewUserTag("deploy").String()},
+			{Tag: "machine-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeAlreadyExists)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `"machine-0" is not a valid user tag`)
+}
+
+func (s *apiSuite) TestAddServiceAccountsNotSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+
+	_, err := s.newAPI().AddServiceAccounts(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: names.NewUserTag("ci").String()}},
+	})
+	c.Assert(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
+func (s *apiSuite) TestIssueTokens(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().IssueServiceAccountToken(gomock.Any(), access.IssueServiceAccountTokenArgs{
+		ServiceAccount: user.NameFromTag(names.NewUserTag("ci")),
+		Name:           "pipeline",
+		Scopes: []permission.AccessSpec{{
+			Target: permission.ID{ObjectType: permission.Model, Key: coretesting.ModelTag.Id()},
+			Access: permission.WriteAccess,
+		}},
+		ExpiresIn: time.Hour,
+	}).Return("juju-sa-token", nil)
+	s.accessService.EXPECT().IssueServiceAccountToken(gomock.Any(), access.IssueServiceAccountTokenArgs{
+		ServiceAccount: user.NameFromTag(names.NewUserTag("ci")),
+		Name:           "pipeline",
+		Scopes: []permission.AccessSpec{{
+			Target: permission.ID{ObjectType: permission.Controller, Key: coretesting.ControllerTag.Id()},
+			Access: permission.SuperuserAccess,
+		}},
+	}).Return("", accesserrors.ServiceAccountTokenAlreadyExists)
+
+	result, err := s.newAPI().IssueTokens(context.Background(), params.IssueServiceAccountTokens{
+		Tokens: []params.IssueServiceAccountToken{{
+			ServiceAccountTag: names.NewUserTag("ci").String(),
+			Name:              "pipeline",
+			Scopes: []params.ServiceAccountTokenScope{{
+				TargetTag: coretesting.ModelTag.String(),
+				Access:    "write",
+			}},
+			ExpiresIn: time.Hour,
+		}, {
+			ServiceAccountTag: names.NewUserTag("ci").String(),
+			Name:              "pipeline",
+			Scopes: []params.ServiceAccountTokenScope{{
+				TargetTag: coretesting.ControllerTag.String(),
+				Access:    "superuser",
+			}},
+		}, {
+			ServiceAccountTag: names.NewUserTag("ci").String(),
+			Name:              "pipeline",
+			Scopes: []params.ServiceAccountTokenScope{{
+				TargetTag: names.NewApplicationTag("mysql").String(),
+				Access:    "read",
+			}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0], jc.DeepEquals, params.IssueServiceAccountTokenResult{Token: "juju-sa-token"})
+	c.Check(result.Results[1].Token, gc.Equals, "")
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeAlreadyExists)
+	c.Check(result.Results[2].Error, jc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *apiSuite) TestIssueTokensNotSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+
+	_, err := s.newAPI().IssueTokens(context.Background(), params.IssueServiceAccountTokens{})
+	c.Assert(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
+func (s *apiSuite) TestRevokeTokens(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(true)
+	s.accessService.EXPECT().RevokeServiceAccountToken(gomock.Any(), user.NameFromTag(names.NewUserTag("ci")), "pipeline").Return(nil)
+	s.accessService.EXPECT().RevokeServiceAccountToken(gomock.Any(), user.NameFromTag(names.NewUserTag("ci")), "other").
+		Return(accesserrors.ServiceAccountTokenNotFound)
+
+	result, err := s.newAPI().RevokeTokens(context.Background(), params.RevokeServiceAccountTokens{
+		Tokens: []params.ServiceAccountTokenName{
+			{ServiceAccountTag: names.NewUserTag("ci").String(), Name: "pipeline"},
+			{ServiceAccountTag: names.NewUserTag("ci").String(), Name: "other"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 2)
+	c.Check(result.Results[0].Error, gc.IsNil)
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) TestRevokeTokensNotSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectSuperuser(false)
+
+	_, err := s.newAPI().RevokeTokens(context.Background(), params.RevokeServiceAccountTokens{})
+	c.Assert(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
@@ -0,0 +1,17 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshcertificates
+
+import (
+	"testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshcertificates -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/sshcertificates SSHCAService,ControllerConfigService
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshcertificates -destination authorizer_mock_test.go github.com/juju/juju/apiserver/facade Authorizer
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
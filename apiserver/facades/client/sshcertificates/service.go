@@ -0,0 +1,39 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshcertificates
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/domain/sshca"
+)
+
+// ControllerConfigService provides access to the controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(ctx context.Context) (controller.Config, error)
+}
+
+// SSHCAService provides access to the controller's SSH user certificate
+// authority.
+type SSHCAService interface {
+	// CAPublicKey returns the public key of the certificate authority, in
+	// authorized keys format.
+	CAPublicKey(ctx context.Context) (string, error)
+	// IssueUserCertificate signs a certificate for the user's public key,
+	// valid for the ttl.
+	IssueUserCertificate(ctx context.Context, userName, publicKey string, ttl time.Duration) (sshca.IssuedCertificate, error)
+	// GetCertificate returns the certificate with the specified serial.
+	GetCertificate(ctx context.Context, serial uint64) (sshca.Certificate, error)
+	// ListCertificates returns the certificates issued to the specified
+	// user, or to all users if the user name is empty.
+	ListCertificates(ctx context.Context, userName string) ([]sshca.Certificate, error)
+	// RevokeCertificate revokes the certificate with the specified serial.
+	RevokeCertificate(ctx context.Context, serial uint64, revokedBy string) error
+	// RevokeUserCertificates revokes every unexpired certificate issued to
+	// the user, returning the number of certificates revoked.
+	RevokeUserCertificates(ctx context.Context, userName, revokedBy string) (int, error)
+}
@@ -0,0 +1,338 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/facades/client/sshcertificates (interfaces: SSHCAService,ControllerConfigService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package sshcertificates -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/sshcertificates SSHCAService,ControllerConfigService
+//
+
+// Package sshcertificates is a generated GoMock package.
+package sshcertificates
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	controller "github.com/juju/juju/controller"
+	sshca "github.com/juju/juju/domain/sshca"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSSHCAService is a mock of SSHCAService interface.
+type MockSSHCAService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHCAServiceMockRecorder
+}
+
+// MockSSHCAServiceMockRecorder is the mock recorder for MockSSHCAService.
+type MockSSHCAServiceMockRecorder struct {
+	mock *MockSSHCAService
+}
+
+// NewMockSSHCAService creates a new mock instance.
+func NewMockSSHCAService(ctrl *gomock.Controller) *MockSSHCAService {
+	mock := &MockSSHCAService{ctrl: ctrl}
+	mock.recorder = &MockSSHCAServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHCAService) EXPECT() *MockSSHCAServiceMockRecorder {
+	return m.recorder
+}
+
+// CAPublicKey mocks base method.
+func (m *MockSSHCAService) CAPublicKey(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CAPublicKey", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CAPublicKey indicates an expected call of CAPublicKey.
+func (mr *MockSSHCAServiceMockRecorder) CAPublicKey(arg0 any) *MockSSHCAServiceCAPublicKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CAPublicKey", reflect.TypeOf((*MockSSHCAService)(nil).CAPublicKey), arg0)
+	return &MockSSHCAServiceCAPublicKeyCall{Call: call}
+}
+
+// MockSSHCAServiceCAPublicKeyCall wrap *gomock.Call
+type MockSSHCAServiceCAPublicKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCAServiceCAPublicKeyCall) Return(arg0 string, arg1 error) *MockSSHCAServiceCAPublicKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCAServiceCAPublicKeyCall) Do(f func(context.Context) (string, error)) *MockSSHCAServiceCAPublicKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCAServiceCAPublicKeyCall) DoAndReturn(f func(context.Context) (string, error)) *MockSSHCAServiceCAPublicKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCertificate mocks base method.
+func (m *MockSSHCAService) GetCertificate(arg0 context.Context, arg1 uint64) (sshca.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificate", arg0, arg1)
+	ret0, _ := ret[0].(sshca.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificate indicates an expected call of GetCertificate.
+func (mr *MockSSHCAServiceMockRecorder) GetCertificate(arg0, arg1 any) *MockSSHCAServiceGetCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificate", reflect.TypeOf((*MockSSHCAService)(nil).GetCertificate), arg0, arg1)
+	return &MockSSHCAServiceGetCertificateCall{Call: call}
+}
+
+// MockSSHCAServiceGetCertificateCall wrap *gomock.Call
+type MockSSHCAServiceGetCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCAServiceGetCertificateCall) Return(arg0 sshca.Certificate, arg1 error) *MockSSHCAServiceGetCertificateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCAServiceGetCertificateCall) Do(f func(context.Context, uint64) (sshca.Certificate, error)) *MockSSHCAServiceGetCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCAServiceGetCertificateCall) DoAndReturn(f func(context.Context, uint64) (sshca.Certificate, error)) *MockSSHCAServiceGetCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IssueUserCertificate mocks base method.
+func (m *MockSSHCAService) IssueUserCertificate(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) (sshca.IssuedCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueUserCertificate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(sshca.IssuedCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueUserCertificate indicates an expected call of IssueUserCertificate.
+func (mr *MockSSHCAServiceMockRecorder) IssueUserCertificate(arg0, arg1, arg2, arg3 any) *MockSSHCAServiceIssueUserCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueUserCertificate", reflect.TypeOf((*MockSSHCAService)(nil).IssueUserCertificate), arg0, arg1, arg2, arg3)
+	return &MockSSHCAServiceIssueUserCertificateCall{Call: call}
+}
+
+// MockSSHCAServiceIssueUserCertificateCall wrap *gomock.Call
+type MockSSHCAServiceIssueUserCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCAServiceIssueUserCertificateCall) Return(arg0 sshca.IssuedCertificate, arg1 error) *MockSSHCAServiceIssueUserCertificateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCAServiceIssueUserCertificateCall) Do(f func(context.Context, string, string, time.Duration) (sshca.IssuedCertificate, error)) *MockSSHCAServiceIssueUserCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCAServiceIssueUserCertificateCall) DoAndReturn(f func(context.Context, string, string, time.Duration) (sshca.IssuedCertificate, error)) *MockSSHCAServiceIssueUserCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCertificates mocks base method.
+func (m *MockSSHCAService) ListCertificates(arg0 context.Context, arg1 string) ([]sshca.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificates", arg0, arg1)
+	ret0, _ := ret[0].([]sshca.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertificates indicates an expected call of ListCertificates.
+func (mr *MockSSHCAServiceMockRecorder) ListCertificates(arg0, arg1 any) *MockSSHCAServiceListCertificatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificates", reflect.TypeOf((*MockSSHCAService)(nil).ListCertificates), arg0, arg1)
+	return &MockSSHCAServiceListCertificatesCall{Call: call}
+}
+
+// MockSSHCAServiceListCertificatesCall wrap *gomock.Call
+type MockSSHCAServiceListCertificatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCAServiceListCertificatesCall) Return(arg0 []sshca.Certificate, arg1 error) *MockSSHCAServiceListCertificatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCAServiceListCertificatesCall) Do(f func(context.Context, string) ([]sshca.Certificate, error)) *MockSSHCAServiceListCertificatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCAServiceListCertificatesCall) DoAndReturn(f func(context.Context, string) ([]sshca.Certificate, error)) *MockSSHCAServiceListCertificatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeCertificate mocks base method.
+func (m *MockSSHCAService) RevokeCertificate(arg0 context.Context, arg1 uint64, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCertificate indicates an expected call of RevokeCertificate.
+func (mr *MockSSHCAServiceMockRecorder) RevokeCertificate(arg0, arg1, arg2 any) *MockSSHCAServiceRevokeCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificate", reflect.TypeOf((*MockSSHCAService)(nil).RevokeCertificate), arg0, arg1, arg2)
+	return &MockSSHCAServiceRevokeCertificateCall{Call: call}
+}
+
+// MockSSHCAServiceRevokeCertificateCall wrap *gomock.Call
+type MockSSHCAServiceRevokeCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCAServiceRevokeCertificateCall) Return(arg0 error) *MockSSHCAServiceRevokeCertificateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCAServiceRevokeCertificateCall) Do(f func(context.Context, uint64, string) error) *MockSSHCAServiceRevokeCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCAServiceRevokeCertificateCall) DoAndReturn(f func(context.Context, uint64, string) error) *MockSSHCAServiceRevokeCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeUserCertificates mocks base method.
+func (m *MockSSHCAService) RevokeUserCertificates(arg0 context.Context, arg1, arg2 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserCertificates", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUserCertificates indicates an expected call of RevokeUserCertificates.
+func (mr *MockSSHCAServiceMockRecorder) RevokeUserCertificates(arg0, arg1, arg2 any) *MockSSHCAServiceRevokeUserCertificatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserCertificates", reflect.TypeOf((*MockSSHCAService)(nil).RevokeUserCertificates), arg0, arg1, arg2)
+	return &MockSSHCAServiceRevokeUserCertificatesCall{Call: call}
+}
+
+// MockSSHCAServiceRevokeUserCertificatesCall wrap *gomock.Call
+type MockSSHCAServiceRevokeUserCertificatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCAServiceRevokeUserCertificatesCall) Return(arg0 int, arg1 error) *MockSSHCAServiceRevokeUserCertificatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCAServiceRevokeUserCertificatesCall) Do(f func(context.Context, string, string) (int, error)) *MockSSHCAServiceRevokeUserCertificatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCAServiceRevokeUserCertificatesCall) DoAndReturn(f func(context.Context, string, string) (int, error)) *MockSSHCAServiceRevokeUserCertificatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+	return &MockControllerConfigServiceControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceControllerConfigCall) Return(arg0 controller.Config, arg1 error) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceControllerConfigCall) Do(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
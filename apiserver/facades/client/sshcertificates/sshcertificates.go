@@ -0,0 +1,226 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshcertificates
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// API issues the SSH user certificates which users authenticate to the
+// controller's embedded SSH server with, and lists and revokes them.
+type API struct {
+	sshCAService            SSHCAService
+	controllerConfigService ControllerConfigService
+	authorizer              facade.Authorizer
+	controllerTag           names.ControllerTag
+}
+
+// NewAPI returns a new SSHCertificates API facade.
+func NewAPI(
+	sshCAService SSHCAService,
+	controllerConfigService ControllerConfigService,
+	authorizer facade.Authorizer,
+	controllerTag names.ControllerTag,
+) *API {
+	return &API{
+		sshCAService:            sshCAService,
+		controllerConfigService: controllerConfigService,
+		authorizer:              authorizer,
+		controllerTag:           controllerTag,
+	}
+}
+
+// SSHCertificateAuthority returns the public key of the controller's SSH
+// user certificate authority, in authorized keys format.
+func (api *API) SSHCertificateAuthority(ctx context.Context) (params.StringResult, error) {
+	publicKey, err := api.sshCAService.CAPublicKey(ctx)
+	if err != nil {
+		return params.StringResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.StringResult{Result: publicKey}, nil
+}
+
+// IssueSSHCertificate issues a certificate for the public key, bound to the
+// authenticated user, which lets them authenticate to the controller's
+// embedded SSH server until it expires. Certificates are valid for the
+// controller's ssh-user-certificate-ttl.
+func (api *API) IssueSSHCertificate(ctx context.Context, arg params.SSHCertificateRequest) (params.SSHCertificateResult, error) {
+	userName, err := api.authUserName()
+	if err != nil {
+		return params.SSHCertificateResult{}, errors.Trace(err)
+	}
+	controllerConfig, err := api.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return params.SSHCertificateResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+
+	issued, err := api.sshCAService.IssueUserCertificate(ctx, userName, arg.PublicKey, controllerConfig.SSHUserCertificateTTL())
+	if err != nil {
+		return params.SSHCertificateResult{Error: apiservererrors.ServerError(sshCAError(err, 0))}, nil
+	}
+	caPublicKey, err := api.sshCAService.CAPublicKey(ctx)
+	if err != nil {
+		return params.SSHCertificateResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.SSHCertificateResult{
+		Certificate:       certificateToParams(issued.Certificate),
+		SignedCertificate: issued.AuthorizedCertificate,
+		CAPublicKey:       caPublicKey,
+	}, nil
+}
+
+// ListSSHCertificates returns the SSH user certificates issued by the
+// controller, in the order they were issued. Users may list their own
+// certificates; controller superusers may list those of any user, or all.
+func (api *API) ListSSHCertificates(ctx context.Context, arg params.SSHCertificatesFilter) (params.SSHCertificatesResult, error) {
+	userName, err := api.authUserName()
+	if err != nil {
+		return params.SSHCertificatesResult{}, errors.Trace(err)
+	}
+	listUser := userName
+	switch {
+	case arg.All:
+		listUser = ""
+	case arg.User != "":
+		if !names.IsValidUser(arg.User) {
+			return params.SSHCertificatesResult{Error: apiservererrors.ServerError(errors.NotValidf("user name %q", arg.User))}, nil
+		}
+		listUser = arg.User
+	}
+	if listUser != userName {
+		if err := api.checkIsSuperuser(ctx); err != nil {
+			return params.SSHCertificatesResult{}, errors.Trace(err)
+		}
+	}
+
+	certs, err := api.sshCAService.ListCertificates(ctx, listUser)
+	if err != nil {
+		return params.SSHCertificatesResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	result := params.SSHCertificatesResult{
+		Certificates: make([]params.SSHCertificate, len(certs)),
+	}
+	for i, cert := range certs {
+		result.Certificates[i] = certificateToParams(cert)
+	}
+	return result, nil
+}
+
+// RevokeSSHCertificates revokes either the certificate with the given serial
+// or every unexpired certificate issued to the given user, so that they are
+// no longer accepted by the embedded SSH server. Users may revoke their own
+// certificates; controller superusers may revoke those of any user.
+func (api *API) RevokeSSHCertificates(ctx context.Context, arg params.RevokeSSHCertificatesArg) (params.RevokeSSHCertificatesResult, error) {
+	userName, err := api.authUserName()
+	if err != nil {
+		return params.RevokeSSHCertificatesResult{}, errors.Trace(err)
+	}
+	if (arg.Serial == 0) == (arg.User == "") {
+		err := errors.NotValidf("revoking by both or neither of serial and user")
+		return params.RevokeSSHCertificatesResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+
+	isSuperuser, err := api.isSuperuser(ctx)
+	if err != nil {
+		return params.RevokeSSHCertificatesResult{}, errors.Trace(err)
+	}
+
+	if arg.User != "" {
+		if !names.IsValidUser(arg.User) {
+			err := errors.NotValidf("user name %q", arg.User)
+			return params.RevokeSSHCertificatesResult{Error: apiservererrors.ServerError(err)}, nil
+		}
+		if !isSuperuser && arg.User != userName {
+			return params.RevokeSSHCertificatesResult{}, apiservererrors.ErrPerm
+		}
+		revoked, err := api.sshCAService.RevokeUserCertificates(ctx, arg.User, userName)
+		if err != nil {
+			return params.RevokeSSHCertificatesResult{Error: apiservererrors.ServerError(err)}, nil
+		}
+		return params.RevokeSSHCertificatesResult{Revoked: revoked}, nil
+	}
+
+	if !isSuperuser {
+		cert, err := api.sshCAService.GetCertificate(ctx, arg.Serial)
+		if err != nil {
+			return params.RevokeSSHCertificatesResult{Error: apiservererrors.ServerError(sshCAError(err, arg.Serial))}, nil
+		}
+		if cert.UserName != userName {
+			return params.RevokeSSHCertificatesResult{}, apiservererrors.ErrPerm
+		}
+	}
+	if err := api.sshCAService.RevokeCertificate(ctx, arg.Serial, userName); err != nil {
+		return params.RevokeSSHCertificatesResult{Error: apiservererrors.ServerError(sshCAError(err, arg.Serial))}, nil
+	}
+	return params.RevokeSSHCertificatesResult{Revoked: 1}, nil
+}
+
+// checkIsSuperuser returns an error if the authenticated user is not a
+// controller superuser.
+func (api *API) checkIsSuperuser(ctx context.Context) error {
+	return api.authorizer.HasPermission(ctx, permission.SuperuserAccess, api.controllerTag)
+}
+
+// isSuperuser reports whether the authenticated user is a controller
+// superuser.
+func (api *API) isSuperuser(ctx context.Context) (bool, error) {
+	err := api.checkIsSuperuser(ctx)
+	if errors.Is(err, apiservererrors.ErrPerm) || errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// authUserName returns the name of the authenticated user.
+func (api *API) authUserName() (string, error) {
+	tag, ok := api.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return "", apiservererrors.ErrPerm
+	}
+	return tag.Id(), nil
+}
+
+// sshCAError converts the errors of the SSH certificate authority service
+// into errors which are understood by the API.
+func sshCAError(err error, serial uint64) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, sshcaerrors.CertificateNotFound):
+		return errors.NotFoundf("ssh certificate %d", serial)
+	case errors.Is(err, sshcaerrors.CertificateRevoked),
+		errors.Is(err, sshcaerrors.InvalidPublicKey):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
+
+func certificateToParams(cert sshca.Certificate) params.SSHCertificate {
+	result := params.SSHCertificate{
+		Serial:         cert.Serial,
+		User:           cert.UserName,
+		KeyFingerprint: cert.KeyFingerprint,
+		ValidAfter:     cert.ValidAfter,
+		ValidBefore:    cert.ValidBefore,
+		RevokedBy:      cert.RevokedBy,
+	}
+	if cert.Revoked() {
+		revokedAt := cert.RevokedAt
+		result.RevokedAt = &revokedAt
+	}
+	return result
+}
@@ -0,0 +1,241 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshcertificates
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type apiSuite struct {
+	sshCAService            *MockSSHCAService
+	controllerConfigService *MockControllerConfigService
+	authorizer              *MockAuthorizer
+
+	validAfter time.Time
+}
+
+var _ = gc.Suite(&apiSuite{})
+
+func (s *apiSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.sshCAService = NewMockSSHCAService(ctrl)
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.validAfter = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	return ctrl
+}
+
+func (s *apiSuite) newAPI() *API {
+	return NewAPI(s.sshCAService, s.controllerConfigService, s.authorizer, coretesting.ControllerTag)
+}
+
+func (s *apiSuite) expectUser(userName string) {
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag(userName)).AnyTimes()
+}
+
+func (s *apiSuite) expectSuperuser(isSuperuser bool) {
+	var err error
+	if !isSuperuser {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(err)
+}
+
+func (s *apiSuite) certificate(serial uint64, userName string) sshca.Certificate {
+	return sshca.Certificate{
+		Serial:         serial,
+		UserName:       userName,
+		KeyFingerprint: "SHA256:fingerprint",
+		ValidAfter:     s.validAfter,
+		ValidBefore:    s.validAfter.Add(8 * time.Hour),
+	}
+}
+
+func (s *apiSuite) TestSSHCertificateAuthority(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.sshCAService.EXPECT().CAPublicKey(gomock.Any()).Return("ssh-ed25519 AAAA", nil)
+
+	result, err := s.newAPI().SSHCertificateAuthority(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.StringResult{Result: "ssh-ed25519 AAAA"})
+}
+
+func (s *apiSuite) TestIssueSSHCertificate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.SSHUserCertificateTTL: "2h",
+	}, nil)
+	s.sshCAService.EXPECT().IssueUserCertificate(gomock.Any(), "bob", "ssh-ed25519 BBBB", 2*time.Hour).
+		Return(sshca.IssuedCertificate{
+			Certificate:           s.certificate(42, "bob"),
+			AuthorizedCertificate: "ssh-ed25519-cert-v01@openssh.com CCCC",
+		}, nil)
+	s.sshCAService.EXPECT().CAPublicKey(gomock.Any()).Return("ssh-ed25519 AAAA", nil)
+
+	result, err := s.newAPI().IssueSSHCertificate(context.Background(), params.SSHCertificateRequest{
+		PublicKey: "ssh-ed25519 BBBB",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.SSHCertificateResult{
+		Certificate: params.SSHCertificate{
+			Serial:         42,
+			User:           "bob",
+			KeyFingerprint: "SHA256:fingerprint",
+			ValidAfter:     s.validAfter,
+			ValidBefore:    s.validAfter.Add(8 * time.Hour),
+		},
+		SignedCertificate: "ssh-ed25519-cert-v01@openssh.com CCCC",
+		CAPublicKey:       "ssh-ed25519 AAAA",
+	})
+}
+
+func (s *apiSuite) TestIssueSSHCertificateInvalidKey(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil)
+	s.sshCAService.EXPECT().IssueUserCertificate(gomock.Any(), "bob", "junk", controller.DefaultSSHUserCertificateTTL).
+		Return(sshca.IssuedCertificate{}, sshcaerrors.InvalidPublicKey)
+
+	result, err := s.newAPI().IssueSSHCertificate(context.Background(), params.SSHCertificateRequest{
+		PublicKey: "junk",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, jc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *apiSuite) TestListSSHCertificatesOwn(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+	revoked := s.certificate(43, "bob")
+	revoked.RevokedBy = "bob"
+	revoked.RevokedAt = s.validAfter.Add(time.Hour)
+	s.sshCAService.EXPECT().ListCertificates(gomock.Any(), "bob").Return([]sshca.Certificate{
+		s.certificate(42, "bob"), revoked,
+	}, nil)
+
+	result, err := s.newAPI().ListSSHCertificates(context.Background(), params.SSHCertificatesFilter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Certificates, gc.HasLen, 2)
+	c.Check(result.Certificates[0].Serial, gc.Equals, uint64(42))
+	c.Check(result.Certificates[0].RevokedAt, gc.IsNil)
+	c.Check(result.Certificates[1].RevokedBy, gc.Equals, "bob")
+	c.Check(*result.Certificates[1].RevokedAt, gc.Equals, s.validAfter.Add(time.Hour))
+}
+
+func (s *apiSuite) TestListSSHCertificatesAllSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("admin")
+	s.expectSuperuser(true)
+	s.sshCAService.EXPECT().ListCertificates(gomock.Any(), "").Return([]sshca.Certificate{
+		s.certificate(42, "bob"),
+	}, nil)
+
+	result, err := s.newAPI().ListSSHCertificates(context.Background(), params.SSHCertificatesFilter{All: true})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Certificates, gc.HasLen, 1)
+}
+
+func (s *apiSuite) TestListSSHCertificatesOtherUserPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+	s.expectSuperuser(false)
+
+	_, err := s.newAPI().ListSSHCertificates(context.Background(), params.SSHCertificatesFilter{User: "alice"})
+	c.Assert(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
+func (s *apiSuite) TestRevokeSSHCertificateOwn(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+	s.expectSuperuser(false)
+	s.sshCAService.EXPECT().GetCertificate(gomock.Any(), uint64(42)).Return(s.certificate(42, "bob"), nil)
+	s.sshCAService.EXPECT().RevokeCertificate(gomock.Any(), uint64(42), "bob").Return(nil)
+
+	result, err := s.newAPI().RevokeSSHCertificates(context.Background(), params.RevokeSSHCertificatesArg{Serial: 42})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.RevokeSSHCertificatesResult{Revoked: 1})
+}
+
+func (s *apiSuite) TestRevokeSSHCertificateOtherUserPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+	s.expectSuperuser(false)
+	s.sshCAService.EXPECT().GetCertificate(gomock.Any(), uint64(42)).Return(s.certificate(42, "alice"), nil)
+
+	_, err := s.newAPI().RevokeSSHCertificates(context.Background(), params.RevokeSSHCertificatesArg{Serial: 42})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *apiSuite) TestRevokeSSHCertificateNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("admin")
+	s.expectSuperuser(true)
+	s.sshCAService.EXPECT().RevokeCertificate(gomock.Any(), uint64(42), "admin").Return(sshcaerrors.CertificateNotFound)
+
+	result, err := s.newAPI().RevokeSSHCertificates(context.Background(), params.RevokeSSHCertificatesArg{Serial: 42})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *apiSuite) TestRevokeSSHCertificatesOfUserSuperuser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("admin")
+	s.expectSuperuser(true)
+	s.sshCAService.EXPECT().RevokeUserCertificates(gomock.Any(), "bob", "admin").Return(3, nil)
+
+	result, err := s.newAPI().RevokeSSHCertificates(context.Background(), params.RevokeSSHCertificatesArg{User: "bob"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.RevokeSSHCertificatesResult{Revoked: 3})
+}
+
+func (s *apiSuite) TestRevokeSSHCertificatesOfOtherUserPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+	s.expectSuperuser(false)
+
+	_, err := s.newAPI().RevokeSSHCertificates(context.Background(), params.RevokeSSHCertificatesArg{User: "alice"})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *apiSuite) TestRevokeSSHCertificatesNeedsSerialOrUser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectUser("bob")
+
+	result, err := s.newAPI().RevokeSSHCertificates(context.Background(), params.RevokeSSHCertificatesArg{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, jc.Satisfies, params.IsCodeNotValid)
+
+	result, err = s.newAPI().RevokeSSHCertificates(context.Background(), params.RevokeSSHCertificatesArg{Serial: 42, User: "bob"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, jc.Satisfies, params.IsCodeNotValid)
+}
@@ -15,9 +15,11 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/domain/sshsession"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -30,14 +32,35 @@ type Facade struct {
 
 	modelConfigService   ModelConfigService
 	modelProviderService ModelProviderService
+	sshSessionService    SSHSessionService
+	sshAccessService     SSHAccessService
+	controllerConfig     ControllerConfigService
 	modelTag             names.ModelTag
 	controllerTag        names.ControllerTag
 }
 
+// FacadeV8 provides the SSH Client API facade version 8
+// which adds SSHServerPort.
+type FacadeV8 struct {
+	*Facade
+}
+
+// FacadeV7 provides the SSH Client API facade version 7
+// which adds the SSH access grant methods.
+type FacadeV7 struct {
+	*FacadeV8
+}
+
+// FacadeV6 provides the SSH Client API facade version 6
+// which adds ListSSHSessions.
+type FacadeV6 struct {
+	*FacadeV7
+}
+
 // FacadeV5 provides the SSH Client API facade version 5
 // which adds VirtualHostname.
 type FacadeV5 struct {
-	*Facade
+	*FacadeV6
 }
 
 // FacadeV4 provides the SSH Client API facade version 4.
@@ -51,6 +74,9 @@ func internalFacade(
 	backend Backend,
 	modelConfigService ModelConfigService,
 	modelProviderService ModelProviderService,
+	sshSessionService SSHSessionService,
+	sshAccessService SSHAccessService,
+	controllerConfig ControllerConfigService,
 	leadershipReader leadership.Reader, auth facade.Authorizer,
 ) (*Facade, error) {
 	if !auth.AuthClient() {
@@ -61,6 +87,9 @@ func internalFacade(
 		backend:              backend,
 		modelConfigService:   modelConfigService,
 		modelProviderService: modelProviderService,
+		sshSessionService:    sshSessionService,
+		sshAccessService:     sshAccessService,
+		controllerConfig:     controllerConfig,
 		controllerTag:        controllerTag,
 		modelTag:             modelTag,
 		authorizer:           auth,
@@ -81,6 +110,27 @@ func (facade *Facade) checkIsModelAdmin(ctx context.Context) error {
 	return facade.authorizer.HasPermission(ctx, permission.AdminAccess, facade.modelTag)
 }
 
+// SSHServerPort is not implemented in v7.
+func (f *FacadeV7) SSHServerPort(_, _, _ struct{}) {}
+
+// RequestSSHAccess is not implemented in v6.
+func (f *FacadeV6) RequestSSHAccess(_, _, _ struct{}) {}
+
+// ListSSHAccessGrants is not implemented in v6.
+func (f *FacadeV6) ListSSHAccessGrants(_, _, _ struct{}) {}
+
+// ApproveSSHAccess is not implemented in v6.
+func (f *FacadeV6) ApproveSSHAccess(_, _, _ struct{}) {}
+
+// RejectSSHAccess is not implemented in v6.
+func (f *FacadeV6) RejectSSHAccess(_, _, _ struct{}) {}
+
+// RevokeSSHAccess is not implemented in v6.
+func (f *FacadeV6) RevokeSSHAccess(_, _, _ struct{}) {}
+
+// ListSSHSessions is not implemented in v5.
+func (f *FacadeV5) ListSSHSessions(_, _, _ struct{}) {}
+
 // VirtualHostname is not implemented in v4.
 func (f *FacadeV4) VirtualHostname(_, _, _ struct{}) {}
 
@@ -101,6 +151,20 @@ func (facade *Facade) VirtualHostname(ctx context.Context, arg params.VirtualHos
 	}, nil
 }
 
+// SSHServerPort returns the port of the controller's embedded SSH server,
+// through which sessions and forwarded connections to the units and
+// machines of the model are proxied.
+func (facade *Facade) SSHServerPort(ctx context.Context) (params.IntResult, error) {
+	if err := facade.authorizer.HasPermission(ctx, permission.ReadAccess, facade.modelTag); err != nil {
+		return params.IntResult{}, errors.Trace(err)
+	}
+	config, err := facade.controllerConfig.ControllerConfig(ctx)
+	if err != nil {
+		return params.IntResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.IntResult{Result: config.SSHServerPort()}, nil
+}
+
 // PublicAddress reports the preferred public network address for one
 // or more entities. Machines and units are supported.
 func (facade *Facade) PublicAddress(ctx context.Context, args params.Entities) (params.SSHAddressResults, error) {
@@ -274,6 +338,38 @@ func (facade *Facade) ModelCredentialForSSH(ctx context.Context) (params.CloudSp
 	return result, nil
 }
 
+// ListSSHSessions returns the recorded SSH sessions to units and machines in
+// the model.
+func (facade *Facade) ListSSHSessions(ctx context.Context) (params.SSHSessionsResult, error) {
+	if err := facade.checkIsModelAdmin(ctx); err != nil {
+		return params.SSHSessionsResult{}, errors.Trace(err)
+	}
+	sessions, err := facade.sshSessionService.ListSessions(ctx, model.UUID(facade.modelTag.Id()))
+	if err != nil {
+		return params.SSHSessionsResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	result := params.SSHSessionsResult{
+		Sessions: make([]params.SSHSession, len(sessions)),
+	}
+	for i, session := range sessions {
+		result.Sessions[i] = sshSessionToParams(session)
+	}
+	return result, nil
+}
+
+func sshSessionToParams(session sshsession.Session) params.SSHSession {
+	return params.SSHSession{
+		UUID:      session.UUID,
+		User:      session.UserName,
+		Target:    session.Target,
+		Command:   session.Command,
+		StartedAt: session.StartedAt,
+		EndedAt:   session.EndedAt,
+		ExitCode:  session.ExitCode,
+		Size:      session.Size,
+	}
+}
+
 // getVirtualHostnameForEntity returns the virtual hostname for the given entity. It parses the tag string to
 // evaluate if the entity is a machine or a unit. If the entity is a unit, it also takes an optional container
 // name which is used to construct the virtual hostname.
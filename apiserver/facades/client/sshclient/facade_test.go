@@ -6,6 +6,7 @@ package sshclient_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -19,11 +20,13 @@ import (
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	k8scloud "github.com/juju/juju/caas/kubernetes/cloud"
 	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/domain/sshsession"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/testing"
@@ -35,8 +38,11 @@ type facadeSuite struct {
 	backend    *MockBackend
 	authorizer *MockAuthorizer
 
-	modelConfigService   *MockModelConfigService
-	modelProviderService *MockModelProviderService
+	modelConfigService      *MockModelConfigService
+	modelProviderService    *MockModelProviderService
+	sshSessionService       *MockSSHSessionService
+	sshAccessService        *MockSSHAccessService
+	controllerConfigService *MockControllerConfigService
 
 	controllerUUID string
 	modelUUID      model.UUID
@@ -52,6 +58,9 @@ func (s *facadeSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	s.modelConfigService = NewMockModelConfigService(ctrl)
 	s.modelProviderService = NewMockModelProviderService(ctrl)
+	s.sshSessionService = NewMockSSHSessionService(ctrl)
+	s.sshAccessService = NewMockSSHAccessService(ctrl)
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
 
 	return ctrl
 }
@@ -73,6 +82,9 @@ func (s *facadeSuite) TestNonClientNotAllowed(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -98,6 +110,9 @@ func (s *facadeSuite) TestNonAuthUserDenied(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -134,6 +149,9 @@ func (s *facadeSuite) TestSuperUserAuth(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -176,6 +194,9 @@ func (s *facadeSuite) TestPublicAddress(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -218,6 +239,9 @@ func (s *facadeSuite) TestPrivateAddress(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -280,6 +304,9 @@ func (s *facadeSuite) TestAllAddresses(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -338,6 +365,9 @@ func (s *facadeSuite) TestPublicKeys(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -379,6 +409,9 @@ func (s *facadeSuite) TestProxyTrue(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -411,6 +444,9 @@ func (s *facadeSuite) TestProxyFalse(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -437,6 +473,9 @@ func (s *facadeSuite) TestModelCredentialForSSHFailedNotAuthorized(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -476,6 +515,9 @@ func (s *facadeSuite) TestModelCredentialForSSHFailedBadCredential(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -548,6 +590,9 @@ func (s *facadeSuite) assertModelCredentialForSSH(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -586,6 +631,9 @@ func (s *facadeSuite) TestGetVirtualHostnameForEntity(c *gc.C) {
 		s.backend,
 		s.modelConfigService,
 		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
 		nil,
 		s.authorizer,
 	)
@@ -638,3 +686,83 @@ func (s *facadeSuite) TestGetVirtualHostnameForEntity(c *gc.C) {
 
 	}
 }
+
+func (s *facadeSuite) TestListSSHSessions(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, names.NewControllerTag(s.controllerUUID)).Return(nil)
+
+	started := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	s.sshSessionService.EXPECT().ListSessions(gomock.Any(), s.modelUUID).Return([]sshsession.Session{{
+		UUID:      "session-uuid",
+		UserName:  "alice",
+		ModelUUID: s.modelUUID.String(),
+		Target:    "0.postgresql.juju.local",
+		Command:   "ls",
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+		ExitCode:  2,
+		Size:      42,
+	}}, nil)
+
+	facade := s.newFacade(c)
+	result, err := facade.ListSSHSessions(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.SSHSessionsResult{
+		Sessions: []params.SSHSession{{
+			UUID:      "session-uuid",
+			User:      "alice",
+			Target:    "0.postgresql.juju.local",
+			Command:   "ls",
+			StartedAt: started,
+			EndedAt:   started.Add(time.Minute),
+			ExitCode:  2,
+			Size:      42,
+		}},
+	})
+}
+
+func (s *facadeSuite) newFacade(c *gc.C) *sshclient.Facade {
+	facade, err := sshclient.InternalFacade(
+		names.NewControllerTag(s.controllerUUID),
+		names.NewModelTag(s.modelUUID.String()),
+		s.backend,
+		s.modelConfigService,
+		s.modelProviderService,
+		s.sshSessionService,
+		s.sshAccessService,
+		s.controllerConfigService,
+		nil,
+		s.authorizer,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	return facade
+}
+
+func (s *facadeSuite) TestSSHServerPort(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, names.NewModelTag(s.modelUUID.String())).Return(nil)
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.SSHServerPort: 17023,
+	}, nil)
+
+	facade := s.newFacade(c)
+	result, err := facade.SSHServerPort(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.IntResult{Result: 17023})
+}
+
+func (s *facadeSuite) TestSSHServerPortPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, names.NewModelTag(s.modelUUID.String())).
+		Return(apiservererrors.ErrPerm)
+
+	facade := s.newFacade(c)
+	_, err := facade.SSHServerPort(context.Background())
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
@@ -21,13 +21,46 @@ func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("SSHClient", 5, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV5(ctx)
 	}, reflect.TypeOf((*FacadeV5)(nil)))
+	registry.MustRegister("SSHClient", 6, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV6(ctx)
+	}, reflect.TypeOf((*FacadeV6)(nil)))
+	registry.MustRegister("SSHClient", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV7(ctx)
+	}, reflect.TypeOf((*FacadeV7)(nil)))
+	registry.MustRegister("SSHClient", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV8(ctx)
+	}, reflect.TypeOf((*FacadeV8)(nil)))
 }
 
-func newFacadeV5(ctx facade.ModelContext) (*FacadeV5, error) {
+func newFacadeV8(ctx facade.ModelContext) (*FacadeV8, error) {
 	facade, err := newFacadeBase(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	return &FacadeV8{facade}, nil
+}
+
+func newFacadeV7(ctx facade.ModelContext) (*FacadeV7, error) {
+	facade, err := newFacadeV8(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &FacadeV7{facade}, nil
+}
+
+func newFacadeV6(ctx facade.ModelContext) (*FacadeV6, error) {
+	facade, err := newFacadeV7(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &FacadeV6{facade}, nil
+}
+
+func newFacadeV5(ctx facade.ModelContext) (*FacadeV5, error) {
+	facade, err := newFacadeV6(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	return &FacadeV5{facade}, nil
 }
 
@@ -56,6 +89,9 @@ func newFacadeBase(ctx facade.ModelContext) (*Facade, error) {
 		&facadeBackend,
 		domainServices.Config(),
 		domainServices.ModelProvider(),
+		domainServices.SSHSession(),
+		domainServices.SSHAccess(),
+		domainServices.ControllerConfig(),
 		leadershipReader,
 		ctx.Auth(),
 	)
@@ -5,7 +5,12 @@ package sshclient
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/juju/controller"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/sshaccess"
+	"github.com/juju/juju/domain/sshsession"
 	"github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 )
@@ -21,3 +26,34 @@ type ModelProviderService interface {
 	// GetCloudSpecForSSH returns the cloud spec for sshing into a k8s pod.
 	GetCloudSpecForSSH(ctx context.Context) (cloudspec.CloudSpec, error)
 }
+
+// SSHSessionService provides access to the recorded SSH sessions.
+type SSHSessionService interface {
+	// ListSessions returns the recorded sessions to units and machines in
+	// the specified model.
+	ListSessions(ctx context.Context, modelUUID coremodel.UUID) ([]sshsession.Session, error)
+}
+
+// ControllerConfigService provides access to the controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(ctx context.Context) (controller.Config, error)
+}
+
+// SSHAccessService provides access to the grants of time-bounded SSH access
+// to units and machines.
+type SSHAccessService interface {
+	// RequestGrant records a request for SSH access, approving it by policy
+	// if it is for no longer than autoApproveUpTo.
+	RequestGrant(ctx context.Context, args sshaccess.RequestGrantArgs, autoApproveUpTo time.Duration) (sshaccess.Grant, error)
+	// GetGrant returns the specified grant in the model.
+	GetGrant(ctx context.Context, modelUUID, uuid string) (sshaccess.Grant, error)
+	// ListGrants returns the grants in the model.
+	ListGrants(ctx context.Context, modelUUID string) ([]sshaccess.Grant, error)
+	// ApproveGrant approves the specified pending grant.
+	ApproveGrant(ctx context.Context, modelUUID, uuid, approvedBy string) error
+	// RejectGrant rejects the specified pending grant.
+	RejectGrant(ctx context.Context, modelUUID, uuid, rejectedBy string) error
+	// RevokeGrant revokes the specified pending or approved grant.
+	RevokeGrant(ctx context.Context, modelUUID, uuid, revokedBy string) error
+}
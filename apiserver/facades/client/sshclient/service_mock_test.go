@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/sshclient (interfaces: ModelConfigService,ModelProviderService)
+// Source: github.com/juju/juju/apiserver/facades/client/sshclient (interfaces: ModelConfigService,ModelProviderService,SSHSessionService,ControllerConfigService,SSHAccessService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package sshclient_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/sshclient ModelConfigService,ModelProviderService
+//	mockgen -typed -package sshclient_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/sshclient ModelConfigService,ModelProviderService,SSHSessionService,ControllerConfigService,SSHAccessService
 //
 
 // Package sshclient_test is a generated GoMock package.
@@ -12,7 +12,12 @@ package sshclient_test
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	controller "github.com/juju/juju/controller"
+	model "github.com/juju/juju/core/model"
+	sshaccess "github.com/juju/juju/domain/sshaccess"
+	sshsession "github.com/juju/juju/domain/sshsession"
 	cloudspec "github.com/juju/juju/environs/cloudspec"
 	config "github.com/juju/juju/environs/config"
 	gomock "go.uber.org/mock/gomock"
@@ -141,3 +146,381 @@ func (c *MockModelProviderServiceGetCloudSpecForSSHCall) DoAndReturn(f func(cont
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSSHSessionService is a mock of SSHSessionService interface.
+type MockSSHSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHSessionServiceMockRecorder
+}
+
+// MockSSHSessionServiceMockRecorder is the mock recorder for MockSSHSessionService.
+type MockSSHSessionServiceMockRecorder struct {
+	mock *MockSSHSessionService
+}
+
+// NewMockSSHSessionService creates a new mock instance.
+func NewMockSSHSessionService(ctrl *gomock.Controller) *MockSSHSessionService {
+	mock := &MockSSHSessionService{ctrl: ctrl}
+	mock.recorder = &MockSSHSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHSessionService) EXPECT() *MockSSHSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// ListSessions mocks base method.
+func (m *MockSSHSessionService) ListSessions(arg0 context.Context, arg1 model.UUID) ([]sshsession.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].([]sshsession.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSSHSessionServiceMockRecorder) ListSessions(arg0, arg1 any) *MockSSHSessionServiceListSessionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSSHSessionService)(nil).ListSessions), arg0, arg1)
+	return &MockSSHSessionServiceListSessionsCall{Call: call}
+}
+
+// MockSSHSessionServiceListSessionsCall wrap *gomock.Call
+type MockSSHSessionServiceListSessionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHSessionServiceListSessionsCall) Return(arg0 []sshsession.Session, arg1 error) *MockSSHSessionServiceListSessionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHSessionServiceListSessionsCall) Do(f func(context.Context, model.UUID) ([]sshsession.Session, error)) *MockSSHSessionServiceListSessionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHSessionServiceListSessionsCall) DoAndReturn(f func(context.Context, model.UUID) ([]sshsession.Session, error)) *MockSSHSessionServiceListSessionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+	return &MockControllerConfigServiceControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceControllerConfigCall) Return(arg0 controller.Config, arg1 error) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceControllerConfigCall) Do(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSSHAccessService is a mock of SSHAccessService interface.
+type MockSSHAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHAccessServiceMockRecorder
+}
+
+// MockSSHAccessServiceMockRecorder is the mock recorder for MockSSHAccessService.
+type MockSSHAccessServiceMockRecorder struct {
+	mock *MockSSHAccessService
+}
+
+// NewMockSSHAccessService creates a new mock instance.
+func NewMockSSHAccessService(ctrl *gomock.Controller) *MockSSHAccessService {
+	mock := &MockSSHAccessService{ctrl: ctrl}
+	mock.recorder = &MockSSHAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHAccessService) EXPECT() *MockSSHAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// ApproveGrant mocks base method.
+func (m *MockSSHAccessService) ApproveGrant(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveGrant", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveGrant indicates an expected call of ApproveGrant.
+func (mr *MockSSHAccessServiceMockRecorder) ApproveGrant(arg0, arg1, arg2, arg3 any) *MockSSHAccessServiceApproveGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveGrant", reflect.TypeOf((*MockSSHAccessService)(nil).ApproveGrant), arg0, arg1, arg2, arg3)
+	return &MockSSHAccessServiceApproveGrantCall{Call: call}
+}
+
+// MockSSHAccessServiceApproveGrantCall wrap *gomock.Call
+type MockSSHAccessServiceApproveGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessServiceApproveGrantCall) Return(arg0 error) *MockSSHAccessServiceApproveGrantCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessServiceApproveGrantCall) Do(f func(context.Context, string, string, string) error) *MockSSHAccessServiceApproveGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessServiceApproveGrantCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockSSHAccessServiceApproveGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetGrant mocks base method.
+func (m *MockSSHAccessService) GetGrant(arg0 context.Context, arg1, arg2 string) (sshaccess.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(sshaccess.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrant indicates an expected call of GetGrant.
+func (mr *MockSSHAccessServiceMockRecorder) GetGrant(arg0, arg1, arg2 any) *MockSSHAccessServiceGetGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrant", reflect.TypeOf((*MockSSHAccessService)(nil).GetGrant), arg0, arg1, arg2)
+	return &MockSSHAccessServiceGetGrantCall{Call: call}
+}
+
+// MockSSHAccessServiceGetGrantCall wrap *gomock.Call
+type MockSSHAccessServiceGetGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessServiceGetGrantCall) Return(arg0 sshaccess.Grant, arg1 error) *MockSSHAccessServiceGetGrantCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessServiceGetGrantCall) Do(f func(context.Context, string, string) (sshaccess.Grant, error)) *MockSSHAccessServiceGetGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessServiceGetGrantCall) DoAndReturn(f func(context.Context, string, string) (sshaccess.Grant, error)) *MockSSHAccessServiceGetGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListGrants mocks base method.
+func (m *MockSSHAccessService) ListGrants(arg0 context.Context, arg1 string) ([]sshaccess.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGrants", arg0, arg1)
+	ret0, _ := ret[0].([]sshaccess.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrants indicates an expected call of ListGrants.
+func (mr *MockSSHAccessServiceMockRecorder) ListGrants(arg0, arg1 any) *MockSSHAccessServiceListGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrants", reflect.TypeOf((*MockSSHAccessService)(nil).ListGrants), arg0, arg1)
+	return &MockSSHAccessServiceListGrantsCall{Call: call}
+}
+
+// MockSSHAccessServiceListGrantsCall wrap *gomock.Call
+type MockSSHAccessServiceListGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessServiceListGrantsCall) Return(arg0 []sshaccess.Grant, arg1 error) *MockSSHAccessServiceListGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessServiceListGrantsCall) Do(f func(context.Context, string) ([]sshaccess.Grant, error)) *MockSSHAccessServiceListGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessServiceListGrantsCall) DoAndReturn(f func(context.Context, string) ([]sshaccess.Grant, error)) *MockSSHAccessServiceListGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RejectGrant mocks base method.
+func (m *MockSSHAccessService) RejectGrant(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectGrant", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectGrant indicates an expected call of RejectGrant.
+func (mr *MockSSHAccessServiceMockRecorder) RejectGrant(arg0, arg1, arg2, arg3 any) *MockSSHAccessServiceRejectGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectGrant", reflect.TypeOf((*MockSSHAccessService)(nil).RejectGrant), arg0, arg1, arg2, arg3)
+	return &MockSSHAccessServiceRejectGrantCall{Call: call}
+}
+
+// MockSSHAccessServiceRejectGrantCall wrap *gomock.Call
+type MockSSHAccessServiceRejectGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessServiceRejectGrantCall) Return(arg0 error) *MockSSHAccessServiceRejectGrantCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessServiceRejectGrantCall) Do(f func(context.Context, string, string, string) error) *MockSSHAccessServiceRejectGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessServiceRejectGrantCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockSSHAccessServiceRejectGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequestGrant mocks base method.
+func (m *MockSSHAccessService) RequestGrant(arg0 context.Context, arg1 sshaccess.RequestGrantArgs, arg2 time.Duration) (sshaccess.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestGrant", arg0, arg1, arg2)
+	ret0, _ := ret[0].(sshaccess.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestGrant indicates an expected call of RequestGrant.
+func (mr *MockSSHAccessServiceMockRecorder) RequestGrant(arg0, arg1, arg2 any) *MockSSHAccessServiceRequestGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestGrant", reflect.TypeOf((*MockSSHAccessService)(nil).RequestGrant), arg0, arg1, arg2)
+	return &MockSSHAccessServiceRequestGrantCall{Call: call}
+}
+
+// MockSSHAccessServiceRequestGrantCall wrap *gomock.Call
+type MockSSHAccessServiceRequestGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessServiceRequestGrantCall) Return(arg0 sshaccess.Grant, arg1 error) *MockSSHAccessServiceRequestGrantCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessServiceRequestGrantCall) Do(f func(context.Context, sshaccess.RequestGrantArgs, time.Duration) (sshaccess.Grant, error)) *MockSSHAccessServiceRequestGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessServiceRequestGrantCall) DoAndReturn(f func(context.Context, sshaccess.RequestGrantArgs, time.Duration) (sshaccess.Grant, error)) *MockSSHAccessServiceRequestGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeGrant mocks base method.
+func (m *MockSSHAccessService) RevokeGrant(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeGrant", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeGrant indicates an expected call of RevokeGrant.
+func (mr *MockSSHAccessServiceMockRecorder) RevokeGrant(arg0, arg1, arg2, arg3 any) *MockSSHAccessServiceRevokeGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGrant", reflect.TypeOf((*MockSSHAccessService)(nil).RevokeGrant), arg0, arg1, arg2, arg3)
+	return &MockSSHAccessServiceRevokeGrantCall{Call: call}
+}
+
+// MockSSHAccessServiceRevokeGrantCall wrap *gomock.Call
+type MockSSHAccessServiceRevokeGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessServiceRevokeGrantCall) Return(arg0 error) *MockSSHAccessServiceRevokeGrantCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessServiceRevokeGrantCall) Do(f func(context.Context, string, string, string) error) *MockSSHAccessServiceRevokeGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessServiceRevokeGrantCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockSSHAccessServiceRevokeGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshclient
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/sshaccess"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RequestSSHAccess requests time-bounded SSH access to a unit or machine in
+// the model for the authenticated user. Requests for no longer than the
+// controller's auto-approval duration are approved straight away; others
+// wait for a model admin to approve them.
+func (facade *Facade) RequestSSHAccess(ctx context.Context, arg params.SSHAccessRequestArg) (params.SSHAccessGrantResult, error) {
+	if err := facade.authorizer.HasPermission(ctx, permission.ReadAccess, facade.modelTag); err != nil {
+		return params.SSHAccessGrantResult{}, errors.Trace(err)
+	}
+	userName, err := facade.authUserName()
+	if err != nil {
+		return params.SSHAccessGrantResult{}, errors.Trace(err)
+	}
+
+	target, err := getVirtualHostnameForEntity(facade.modelTag.Id(), arg.Tag, arg.Container)
+	if err != nil {
+		return params.SSHAccessGrantResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	controllerConfig, err := facade.controllerConfig.ControllerConfig(ctx)
+	if err != nil {
+		return params.SSHAccessGrantResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+
+	grant, err := facade.sshAccessService.RequestGrant(ctx, sshaccess.RequestGrantArgs{
+		UserName:  userName,
+		ModelUUID: facade.modelTag.Id(),
+		Target:    target,
+		Reason:    arg.Reason,
+		Duration:  arg.Duration,
+	}, controllerConfig.SSHAccessGrantAutoApproveDuration())
+	if err != nil {
+		return params.SSHAccessGrantResult{Error: apiservererrors.ServerError(sshAccessError(err, ""))}, nil
+	}
+	return params.SSHAccessGrantResult{Grant: sshAccessGrantToParams(grant)}, nil
+}
+
+// ListSSHAccessGrants returns the SSH access grants in the model. Model
+// admins see every grant; other users see only their own.
+func (facade *Facade) ListSSHAccessGrants(ctx context.Context) (params.SSHAccessGrantsResult, error) {
+	isAdmin, err := facade.isModelAdmin(ctx)
+	if err != nil {
+		return params.SSHAccessGrantsResult{}, errors.Trace(err)
+	}
+	if !isAdmin {
+		if err := facade.authorizer.HasPermission(ctx, permission.ReadAccess, facade.modelTag); err != nil {
+			return params.SSHAccessGrantsResult{}, errors.Trace(err)
+		}
+	}
+	userName, err := facade.authUserName()
+	if err != nil {
+		return params.SSHAccessGrantsResult{}, errors.Trace(err)
+	}
+
+	grants, err := facade.sshAccessService.ListGrants(ctx, facade.modelTag.Id())
+	if err != nil {
+		return params.SSHAccessGrantsResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	result := params.SSHAccessGrantsResult{
+		Grants: make([]params.SSHAccessGrant, 0, len(grants)),
+	}
+	for _, grant := range grants {
+		if !isAdmin && grant.UserName != userName {
+			continue
+		}
+		result.Grants = append(result.Grants, sshAccessGrantToParams(grant))
+	}
+	return result, nil
+}
+
+// ApproveSSHAccess approves a pending request for SSH access. Only model
+// admins may approve requests, and not their own.
+func (facade *Facade) ApproveSSHAccess(ctx context.Context, arg params.SSHAccessGrantArg) (params.ErrorResult, error) {
+	if err := facade.checkIsModelAdmin(ctx); err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	userName, err := facade.authUserName()
+	if err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	err = facade.sshAccessService.ApproveGrant(ctx, facade.modelTag.Id(), arg.UUID, userName)
+	return params.ErrorResult{Error: apiservererrors.ServerError(sshAccessError(err, arg.UUID))}, nil
+}
+
+// RejectSSHAccess rejects a pending request for SSH access. Only model admins
+// may reject requests.
+func (facade *Facade) RejectSSHAccess(ctx context.Context, arg params.SSHAccessGrantArg) (params.ErrorResult, error) {
+	if err := facade.checkIsModelAdmin(ctx); err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	userName, err := facade.authUserName()
+	if err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	err = facade.sshAccessService.RejectGrant(ctx, facade.modelTag.Id(), arg.UUID, userName)
+	return params.ErrorResult{Error: apiservererrors.ServerError(sshAccessError(err, arg.UUID))}, nil
+}
+
+// RevokeSSHAccess revokes a pending or approved grant of SSH access, ending
+// any sessions using it. Model admins may revoke any grant; other users may
+// revoke only their own.
+func (facade *Facade) RevokeSSHAccess(ctx context.Context, arg params.SSHAccessGrantArg) (params.ErrorResult, error) {
+	isAdmin, err := facade.isModelAdmin(ctx)
+	if err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	userName, err := facade.authUserName()
+	if err != nil {
+		return params.ErrorResult{}, errors.Trace(err)
+	}
+	if !isAdmin {
+		grant, err := facade.sshAccessService.GetGrant(ctx, facade.modelTag.Id(), arg.UUID)
+		if err != nil {
+			return params.ErrorResult{Error: apiservererrors.ServerError(sshAccessError(err, arg.UUID))}, nil
+		}
+		if grant.UserName != userName {
+			return params.ErrorResult{}, apiservererrors.ErrPerm
+		}
+	}
+	err = facade.sshAccessService.RevokeGrant(ctx, facade.modelTag.Id(), arg.UUID, userName)
+	return params.ErrorResult{Error: apiservererrors.ServerError(sshAccessError(err, arg.UUID))}, nil
+}
+
+// isModelAdmin reports whether the authenticated user is a controller
+// superuser or an admin of the model.
+func (facade *Facade) isModelAdmin(ctx context.Context) (bool, error) {
+	err := facade.checkIsModelAdmin(ctx)
+	if errors.Is(err, apiservererrors.ErrPerm) || errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
+// authUserName returns the name of the authenticated user.
+func (facade *Facade) authUserName() (string, error) {
+	tag, ok := facade.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return "", apiservererrors.ErrPerm
+	}
+	return tag.Id(), nil
+}
+
+// sshAccessError converts the errors of the ssh access service into errors
+// which are understood by the API.
+func sshAccessError(err error, uuid string) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, sshaccesserrors.GrantNotFound):
+		return errors.NotFoundf("ssh access grant %q", uuid)
+	case errors.Is(err, sshaccesserrors.GrantNotPending),
+		errors.Is(err, sshaccesserrors.GrantNotActive),
+		errors.Is(err, sshaccesserrors.SelfApproval):
+		return errors.NewNotValid(err, "")
+	}
+	return err
+}
+
+func sshAccessGrantToParams(grant sshaccess.Grant) params.SSHAccessGrant {
+	result := params.SSHAccessGrant{
+		UUID:        grant.UUID,
+		User:        grant.UserName,
+		Target:      grant.Target,
+		Reason:      grant.Reason,
+		Duration:    grant.Duration,
+		Status:      string(grant.Status),
+		RequestedAt: grant.RequestedAt,
+		DecidedBy:   grant.DecidedBy,
+	}
+	if !grant.DecidedAt.IsZero() {
+		decidedAt := grant.DecidedAt
+		result.DecidedAt = &decidedAt
+	}
+	if !grant.ExpiresAt.IsZero() {
+		expiresAt := grant.ExpiresAt
+		result.ExpiresAt = &expiresAt
+	}
+	return result
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshclient_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/authentication"
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/sshaccess"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+func (s *facadeSuite) expectModelAdmin(isAdmin bool) {
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, names.NewControllerTag(s.controllerUUID)).
+		Return(authentication.ErrorEntityMissingPermission)
+	var err error
+	if !isAdmin {
+		err = errors.Annotate(authentication.ErrorEntityMissingPermission, "bob")
+	}
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, names.NewModelTag(s.modelUUID.String())).
+		Return(err)
+}
+
+func (s *facadeSuite) TestRequestSSHAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, names.NewModelTag(s.modelUUID.String())).Return(nil)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("bob"))
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.SSHAccessGrantAutoApproveDuration: "15m",
+	}, nil)
+
+	requested := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	target := "0.postgresql." + s.modelUUID.String() + ".juju.local"
+	s.sshAccessService.EXPECT().RequestGrant(gomock.Any(), sshaccess.RequestGrantArgs{
+		UserName:  "bob",
+		ModelUUID: s.modelUUID.String(),
+		Target:    target,
+		Reason:    "incident",
+		Duration:  time.Hour,
+	}, 15*time.Minute).Return(sshaccess.Grant{
+		UUID:        "grant-uuid",
+		UserName:    "bob",
+		ModelUUID:   s.modelUUID.String(),
+		Target:      target,
+		Reason:      "incident",
+		Duration:    time.Hour,
+		Status:      sshaccess.GrantPending,
+		RequestedAt: requested,
+	}, nil)
+
+	facade := s.newFacade(c)
+	result, err := facade.RequestSSHAccess(context.Background(), params.SSHAccessRequestArg{
+		Tag:      names.NewUnitTag("postgresql/0").String(),
+		Reason:   "incident",
+		Duration: time.Hour,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.SSHAccessGrantResult{
+		Grant: params.SSHAccessGrant{
+			UUID:        "grant-uuid",
+			User:        "bob",
+			Target:      target,
+			Reason:      "incident",
+			Duration:    time.Hour,
+			Status:      "pending",
+			RequestedAt: requested,
+		},
+	})
+}
+
+func (s *facadeSuite) TestRequestSSHAccessNoModelAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, names.NewModelTag(s.modelUUID.String())).
+		Return(apiservererrors.ErrPerm)
+
+	facade := s.newFacade(c)
+	_, err := facade.RequestSSHAccess(context.Background(), params.SSHAccessRequestArg{
+		Tag:      names.NewMachineTag("0").String(),
+		Duration: time.Hour,
+	})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *facadeSuite) TestListSSHAccessGrantsAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(true)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("admin"))
+	s.sshAccessService.EXPECT().ListGrants(gomock.Any(), s.modelUUID.String()).Return([]sshaccess.Grant{
+		{UUID: "grant-1", UserName: "bob"},
+		{UUID: "grant-2", UserName: "alice"},
+	}, nil)
+
+	facade := s.newFacade(c)
+	result, err := facade.ListSSHAccessGrants(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Grants, gc.HasLen, 2)
+}
+
+func (s *facadeSuite) TestListSSHAccessGrantsOwnOnly(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(false)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, names.NewModelTag(s.modelUUID.String())).Return(nil)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("bob"))
+	expiresAt := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+	s.sshAccessService.EXPECT().ListGrants(gomock.Any(), s.modelUUID.String()).Return([]sshaccess.Grant{
+		{UUID: "grant-1", UserName: "bob", Status: sshaccess.GrantApproved, DecidedBy: "admin", ExpiresAt: expiresAt},
+		{UUID: "grant-2", UserName: "alice"},
+	}, nil)
+
+	facade := s.newFacade(c)
+	result, err := facade.ListSSHAccessGrants(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Grants, jc.DeepEquals, []params.SSHAccessGrant{{
+		UUID:      "grant-1",
+		User:      "bob",
+		Status:    "approved",
+		DecidedBy: "admin",
+		ExpiresAt: &expiresAt,
+	}})
+}
+
+func (s *facadeSuite) TestApproveSSHAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(true)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("admin"))
+	s.sshAccessService.EXPECT().ApproveGrant(gomock.Any(), s.modelUUID.String(), "grant-uuid", "admin").Return(nil)
+
+	facade := s.newFacade(c)
+	result, err := facade.ApproveSSHAccess(context.Background(), params.SSHAccessGrantArg{UUID: "grant-uuid"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.IsNil)
+}
+
+func (s *facadeSuite) TestApproveSSHAccessNotAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(false)
+
+	facade := s.newFacade(c)
+	_, err := facade.ApproveSSHAccess(context.Background(), params.SSHAccessGrantArg{UUID: "grant-uuid"})
+	c.Assert(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
+func (s *facadeSuite) TestApproveSSHAccessSelfApproval(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(true)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("admin"))
+	s.sshAccessService.EXPECT().ApproveGrant(gomock.Any(), s.modelUUID.String(), "grant-uuid", "admin").
+		Return(sshaccesserrors.SelfApproval)
+
+	facade := s.newFacade(c)
+	result, err := facade.ApproveSSHAccess(context.Background(), params.SSHAccessGrantArg{UUID: "grant-uuid"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, jc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *facadeSuite) TestRejectSSHAccessNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(true)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("admin"))
+	s.sshAccessService.EXPECT().RejectGrant(gomock.Any(), s.modelUUID.String(), "grant-uuid", "admin").
+		Return(sshaccesserrors.GrantNotFound)
+
+	facade := s.newFacade(c)
+	result, err := facade.RejectSSHAccess(context.Background(), params.SSHAccessGrantArg{UUID: "grant-uuid"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, jc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *facadeSuite) TestRevokeOwnSSHAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(false)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("bob"))
+	s.sshAccessService.EXPECT().GetGrant(gomock.Any(), s.modelUUID.String(), "grant-uuid").
+		Return(sshaccess.Grant{UUID: "grant-uuid", UserName: "bob"}, nil)
+	s.sshAccessService.EXPECT().RevokeGrant(gomock.Any(), s.modelUUID.String(), "grant-uuid", "bob").Return(nil)
+
+	facade := s.newFacade(c)
+	result, err := facade.RevokeSSHAccess(context.Background(), params.SSHAccessGrantArg{UUID: "grant-uuid"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.IsNil)
+}
+
+func (s *facadeSuite) TestRevokeOthersSSHAccessNotAdmin(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer.EXPECT().AuthClient().Return(true)
+	s.expectModelAdmin(false)
+	s.authorizer.EXPECT().GetAuthTag().Return(names.NewUserTag("bob"))
+	s.sshAccessService.EXPECT().GetGrant(gomock.Any(), s.modelUUID.String(), "grant-uuid").
+		Return(sshaccess.Grant{UUID: "grant-uuid", UserName: "alice"}, nil)
+
+	facade := s.newFacade(c)
+	_, err := facade.RevokeSSHAccess(context.Background(), params.SSHAccessGrantArg{UUID: "grant-uuid"})
+	c.Assert(err, jc.ErrorIs, apiservererrors.ErrPerm)
+}
@@ -125,6 +125,7 @@ const (
 	detachStorageCall                       = "detachStorage"
 	destroyStorageInstanceCall              = "destroyStorageInstance"
 	releaseStorageInstanceCall              = "releaseStorageInstance"
+	resizeStorageInstanceCall               = "resizeStorageInstance"
 	addExistingFilesystemCall               = "addExistingFilesystem"
 )
 
@@ -315,6 +316,10 @@ func (s *baseStorageSuite) constructStorageAccessor() *mockStorageAccessor {
 			s.stub.AddCall(releaseStorageInstanceCall, tag, destroyAttached, force)
 			return errors.New("cannae do it")
 		},
+		resizeStorageInstance: func(tag names.StorageTag, size uint64) error {
+			s.stub.AddCall(resizeStorageInstanceCall, tag, size)
+			return s.stub.NextErr()
+		},
 		addExistingFilesystem: func(f state.FilesystemInfo, v *state.VolumeInfo, storageName string) (names.StorageTag, error) {
 			s.stub.AddCall(addExistingFilesystemCall, f, v, storageName)
 			return s.storageTag, s.stub.NextErr()
@@ -162,6 +162,34 @@ func (s *filesystemSuite) TestListFilesystemsAttachmentInfo(c *gc.C) {
 	c.Assert(found.Results[0].Result[0], jc.DeepEquals, expected)
 }
 
+func (s *filesystemSuite) TestListFilesystemsAttachmentUsage(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.filesystemAttachment.info = &state.FilesystemAttachmentInfo{
+		MountPoint: "/srv",
+	}
+	s.filesystemAttachment.usage = &state.FilesystemUsage{
+		UsedBytes:       3 * 1024 * 1024,
+		AvailableBytes:  1024 * 1024,
+		UsedInodes:      10,
+		AvailableInodes: 90,
+	}
+	s.state.assignedMachine = s.machineTag.Id()
+	found, err := s.api.ListFilesystems(context.Background(), params.FilesystemFilters{
+		[]params.FilesystemFilter{{}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(found.Results, gc.HasLen, 1)
+	c.Assert(found.Results[0].Result, gc.HasLen, 1)
+	attachment := found.Results[0].Result[0].MachineAttachments[s.machineTag.String()]
+	c.Assert(attachment.Usage, jc.DeepEquals, &params.FilesystemUsage{
+		UsedBytes:       3 * 1024 * 1024,
+		AvailableBytes:  1024 * 1024,
+		UsedInodes:      10,
+		AvailableInodes: 90,
+	})
+}
+
 func (s *filesystemSuite) TestListFilesystemsVolumeBacked(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
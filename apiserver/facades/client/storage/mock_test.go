@@ -49,6 +49,7 @@ type mockStorageAccessor struct {
 	addStorageForUnit                   func(u names.UnitTag, name string, cons state.StorageConstraints) ([]names.StorageTag, error)
 	destroyStorageInstance              func(names.StorageTag, bool, bool) error
 	releaseStorageInstance              func(names.StorageTag, bool, bool) error
+	resizeStorageInstance               func(names.StorageTag, uint64) error
 	attachStorage                       func(names.StorageTag, names.UnitTag) error
 	detachStorage                       func(names.StorageTag, names.UnitTag, bool) error
 	addExistingFilesystem               func(state.FilesystemInfo, *state.VolumeInfo, string) (names.StorageTag, error)
@@ -151,6 +152,10 @@ func (st *mockStorageAccessor) ReleaseStorageInstance(tag names.StorageTag, dest
 	return st.releaseStorageInstance(tag, destroyAttached, force)
 }
 
+func (st *mockStorageAccessor) ResizeStorageInstance(tag names.StorageTag, size uint64) error {
+	return st.resizeStorageInstance(tag, size)
+}
+
 func (st *mockStorageAccessor) UnitStorageAttachments(tag names.UnitTag) ([]state.StorageAttachment, error) {
 	panic("should not be called")
 }
@@ -247,6 +252,7 @@ type mockFilesystemAttachment struct {
 	filesystem names.FilesystemTag
 	machine    names.MachineTag
 	info       *state.FilesystemAttachmentInfo
+	usage      *state.FilesystemUsage
 	life       state.Life
 }
 
@@ -269,6 +275,13 @@ func (m *mockFilesystemAttachment) Life() state.Life {
 	return m.life
 }
 
+func (m *mockFilesystemAttachment) Usage() (state.FilesystemUsage, bool) {
+	if m.usage != nil {
+		return *m.usage, true
+	}
+	return state.FilesystemUsage{}, false
+}
+
 type mockStorageInstance struct {
 	state.StorageInstance
 	kind       state.StorageKind
@@ -16,10 +16,34 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Storage", 6, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newStorageAPI(stdCtx, ctx) // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+		return newStorageAPIV6(stdCtx, ctx) // modify Remove to support force and maxWait; add DetachStorage to support force and maxWait.
+	}, reflect.TypeOf((*StorageAPIV6)(nil)))
+	registry.MustRegister("Storage", 7, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newStorageAPIV7(stdCtx, ctx) // Adds ResizeStorage
+	}, reflect.TypeOf((*StorageAPIV7)(nil)))
+	registry.MustRegister("Storage", 8, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newStorageAPI(stdCtx, ctx) // Adds storage snapshots and restoring storage from a snapshot.
 	}, reflect.TypeOf((*StorageAPI)(nil)))
 }
 
+// newStorageAPIV6 returns a new storage API facade of version 6.
+func newStorageAPIV6(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIV6, error) {
+	api, err := newStorageAPIV7(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageAPIV6{StorageAPIV7: api}, nil
+}
+
+// newStorageAPIV7 returns a new storage API facade of version 7.
+func newStorageAPIV7(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPIV7, error) {
+	api, err := newStorageAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &StorageAPIV7{StorageAPI: api}, nil
+}
+
 // newStorageAPI returns a new storage API facade.
 func newStorageAPI(stdCtx context.Context, ctx facade.ModelContext) (*StorageAPI, error) {
 	st := ctx.State()
@@ -38,7 +38,29 @@ type StorageService interface {
 
 type storageRegistryGetter func(context.Context) (storage.ProviderRegistry, error)
 
-// StorageAPI implements the latest version (v6) of the Storage API.
+// StorageAPIV6 implements version 6 of the Storage API.
+type StorageAPIV6 struct {
+	*StorageAPIV7
+}
+
+// ResizeStorage isn't on the v6 API.
+func (*StorageAPIV6) ResizeStorage(_, _ struct{}) {}
+
+// StorageAPIV7 implements version 7 of the Storage API.
+type StorageAPIV7 struct {
+	*StorageAPI
+}
+
+// CreateStorageSnapshots isn't on the v7 API.
+func (*StorageAPIV7) CreateStorageSnapshots(_, _ struct{}) {}
+
+// ListStorageSnapshots isn't on the v7 API.
+func (*StorageAPIV7) ListStorageSnapshots(_, _ struct{}) {}
+
+// RemoveStorageSnapshots isn't on the v7 API.
+func (*StorageAPIV7) RemoveStorageSnapshots(_, _ struct{}) {}
+
+// StorageAPI implements the latest version (v8) of the Storage API.
 type StorageAPI struct {
 	backend               backend
 	storageAccess         storageAccess
@@ -457,8 +479,8 @@ func (a *StorageAPI) addToUnit(ctx context.Context, args params.StoragesAddParam
 		return params.AddStorageResults{}, errors.Trace(err)
 	}
 
-	paramsToState := func(p params.StorageDirectives) state.StorageConstraints {
-		s := state.StorageConstraints{Pool: p.Pool}
+	paramsToState := func(p params.StorageDirectives, snapshotId string) state.StorageConstraints {
+		s := state.StorageConstraints{Pool: p.Pool, SnapshotId: snapshotId}
 		if p.Size != nil {
 			s.Size = *p.Size
 		}
@@ -477,7 +499,7 @@ func (a *StorageAPI) addToUnit(ctx context.Context, args params.StoragesAddParam
 		}
 
 		storageTags, err := a.storageAccess.AddStorageForUnit(
-			u, one.StorageName, paramsToState(one.Directives),
+			u, one.StorageName, paramsToState(one.Directives, one.SnapshotId),
 		)
 		if err != nil {
 			result[i].Error = apiservererrors.ServerError(err)
@@ -631,6 +653,331 @@ func (a *StorageAPI) Attach(ctx context.Context, args params.StorageAttachmentId
 	return params.ErrorResults{Results: result}, nil
 }
 
+// ResizeStorage requests that storage instances be grown to the given
+// sizes. The storage provisioner performs the resize, and the charms of
+// units attached to the storage are notified with the storage-resized hook.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) ResizeStorage(ctx context.Context, args params.ResizeStorage) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.blockCommandService)
+	if err := blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	resizeOne := func(arg params.ResizeStorageInstance) error {
+		tag, err := names.ParseStorageTag(arg.Tag)
+		if err != nil {
+			return err
+		}
+		if arg.Size == 0 {
+			return errors.NotValidf("storage size 0")
+		}
+		return a.storageAccess.ResizeStorageInstance(tag, arg.Size)
+	}
+
+	result := make([]params.ErrorResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		result[i].Error = apiservererrors.ServerError(resizeOne(arg))
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+// CreateStorageSnapshots takes a snapshot of the volume or filesystem
+// backing each of the specified storage instances. Only storage
+// provisioned by a provider that supports snapshots can be snapshotted.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) CreateStorageSnapshots(ctx context.Context, args params.Entities) (params.StorageSnapshotResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.StorageSnapshotResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.blockCommandService)
+	if err := blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.StorageSnapshotResults{}, errors.Trace(err)
+	}
+
+	createOne := func(arg params.Entity) (*params.StorageSnapshot, error) {
+		tag, err := names.ParseStorageTag(arg.Tag)
+		if err != nil {
+			return nil, err
+		}
+		source, err := a.storageSnapshotSource(ctx, tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		resourceTags := map[string]string{
+			tags.JujuModel:           a.modelUUID.String(),
+			tags.JujuController:      a.controllerUUID,
+			tags.JujuStorageInstance: tag.Id(),
+		}
+		snapshot, err := source.create(ctx, resourceTags)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result := snapshotToParams(tag, *snapshot)
+		return &result, nil
+	}
+
+	results := make([]params.StorageSnapshotResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		snapshot, err := createOne(arg)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Result = snapshot
+	}
+	return params.StorageSnapshotResults{Results: results}, nil
+}
+
+// ListStorageSnapshots returns the snapshots of the volume or filesystem
+// backing each of the specified storage instances.
+func (a *StorageAPI) ListStorageSnapshots(ctx context.Context, args params.Entities) (params.StorageSnapshotsResults, error) {
+	if err := a.checkCanRead(ctx); err != nil {
+		return params.StorageSnapshotsResults{}, errors.Trace(err)
+	}
+
+	listOne := func(arg params.Entity) ([]params.StorageSnapshot, error) {
+		tag, err := names.ParseStorageTag(arg.Tag)
+		if err != nil {
+			return nil, err
+		}
+		source, err := a.storageSnapshotSource(ctx, tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		snapshots, err := source.list(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result := make([]params.StorageSnapshot, len(snapshots))
+		for i, snapshot := range snapshots {
+			result[i] = snapshotToParams(tag, snapshot)
+		}
+		return result, nil
+	}
+
+	results := make([]params.StorageSnapshotsResult, len(args.Entities))
+	for i, arg := range args.Entities {
+		snapshots, err := listOne(arg)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Result = snapshots
+	}
+	return params.StorageSnapshotsResults{Results: results}, nil
+}
+
+// RemoveStorageSnapshots deletes the specified snapshots from the
+// provider of the storage instances they were taken from.
+// A "CHANGE" block can block this operation.
+func (a *StorageAPI) RemoveStorageSnapshots(ctx context.Context, args params.RemoveStorageSnapshots) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.blockCommandService)
+	if err := blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	removeOne := func(arg params.RemoveStorageSnapshot) error {
+		tag, err := names.ParseStorageTag(arg.StorageTag)
+		if err != nil {
+			return err
+		}
+		if arg.SnapshotId == "" {
+			return errors.NotValidf("empty snapshot ID")
+		}
+		source, err := a.storageSnapshotSource(ctx, tag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return source.delete(ctx, arg.SnapshotId)
+	}
+
+	results := make([]params.ErrorResult, len(args.Snapshots))
+	for i, arg := range args.Snapshots {
+		results[i].Error = apiservererrors.ServerError(removeOne(arg))
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+// snapshotSource snapshots a single provisioned volume or filesystem.
+type snapshotSource struct {
+	create func(context.Context, map[string]string) (*storage.Snapshot, error)
+	list   func(context.Context) ([]storage.Snapshot, error)
+	delete func(context.Context, string) error
+}
+
+// storageSnapshotSource returns a snapshotSource for the volume or
+// filesystem backing the storage instance with the specified tag.
+// Volume-backed filesystems are snapshotted through their volume.
+func (a *StorageAPI) storageSnapshotSource(ctx context.Context, tag names.StorageTag) (*snapshotSource, error) {
+	si, err := a.storageAccess.StorageInstance(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var volume state.Volume
+	switch si.Kind() {
+	case state.StorageKindBlock:
+		volume, err = a.storageAccess.StorageInstanceVolume(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	case state.StorageKindFilesystem:
+		filesystem, err := a.storageAccess.StorageInstanceFilesystem(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		volumeTag, err := filesystem.Volume()
+		if err == state.ErrNoBackingVolume {
+			return a.filesystemSnapshotSource(ctx, filesystem)
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		volume, err = a.storageAccess.Volume(volumeTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	default:
+		return nil, errors.NotSupportedf("snapshotting %s storage", si.Kind())
+	}
+	return a.volumeSnapshotSource(ctx, volume)
+}
+
+func (a *StorageAPI) volumeSnapshotSource(ctx context.Context, volume state.Volume) (*snapshotSource, error) {
+	info, err := volume.Info()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	provider, cfg, err := a.storageProviderForPool(ctx, info.Pool)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	volumeSource, err := provider.VolumeSource(cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotter, ok := volumeSource.(storage.VolumeSnapshotter)
+	if !ok {
+		return nil, errors.NotSupportedf("snapshotting volumes with storage provider %q", cfg.Provider())
+	}
+	return &snapshotSource{
+		create: func(ctx context.Context, resourceTags map[string]string) (*storage.Snapshot, error) {
+			results, err := snapshotter.CreateVolumeSnapshots(ctx, []storage.VolumeSnapshotParams{{
+				Tag:          volume.VolumeTag(),
+				VolumeId:     info.VolumeId,
+				ResourceTags: resourceTags,
+			}})
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return results[0].Snapshot, results[0].Error
+		},
+		list: func(ctx context.Context) ([]storage.Snapshot, error) {
+			results, err := snapshotter.ListVolumeSnapshots(ctx, []string{info.VolumeId})
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return results[0].Snapshots, results[0].Error
+		},
+		delete: func(ctx context.Context, snapshotId string) error {
+			results, err := snapshotter.DeleteVolumeSnapshots(ctx, []string{snapshotId})
+			if err != nil {
+				return errors.Trace(err)
+			}
+			return results[0]
+		},
+	}, nil
+}
+
+func (a *StorageAPI) filesystemSnapshotSource(ctx context.Context, filesystem state.Filesystem) (*snapshotSource, error) {
+	info, err := filesystem.Info()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	provider, cfg, err := a.storageProviderForPool(ctx, info.Pool)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	filesystemSource, err := provider.FilesystemSource(cfg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotter, ok := filesystemSource.(storage.FilesystemSnapshotter)
+	if !ok {
+		return nil, errors.NotSupportedf("snapshotting filesystems with storage provider %q", cfg.Provider())
+	}
+	return &snapshotSource{
+		create: func(ctx context.Context, resourceTags map[string]string) (*storage.Snapshot, error) {
+			results, err := snapshotter.CreateFilesystemSnapshots(ctx, []storage.FilesystemSnapshotParams{{
+				Tag:          filesystem.FilesystemTag(),
+				FilesystemId: info.FilesystemId,
+				ResourceTags: resourceTags,
+			}})
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return results[0].Snapshot, results[0].Error
+		},
+		list: func(ctx context.Context) ([]storage.Snapshot, error) {
+			results, err := snapshotter.ListFilesystemSnapshots(ctx, []string{info.FilesystemId})
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return results[0].Snapshots, results[0].Error
+		},
+		delete: func(ctx context.Context, snapshotId string) error {
+			results, err := snapshotter.DeleteFilesystemSnapshots(ctx, []string{snapshotId})
+			if err != nil {
+				return errors.Trace(err)
+			}
+			return results[0]
+		},
+	}, nil
+}
+
+// storageProviderForPool returns the storage provider and configuration
+// for the named pool, or provider type.
+func (a *StorageAPI) storageProviderForPool(ctx context.Context, pool string) (storage.Provider, *storage.Config, error) {
+	registry, err := a.storageRegistryGetter(ctx)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	providerType, cfg, err := storagecommon.StoragePoolConfig(ctx, pool, a.storageService, registry)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if cfg.Provider() == "" {
+		// The pool is a bare provider type.
+		if cfg, err = storage.NewConfig(pool, providerType, map[string]interface{}{}); err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+	}
+	provider, err := registry.StorageProvider(providerType)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return provider, cfg, nil
+}
+
+func snapshotToParams(tag names.StorageTag, snapshot storage.Snapshot) params.StorageSnapshot {
+	return params.StorageSnapshot{
+		StorageTag: tag.String(),
+		SnapshotId: snapshot.SnapshotId,
+		SourceId:   snapshot.SourceId,
+		Size:       snapshot.Size,
+		Created:    snapshot.Created,
+		Status:     snapshot.Status,
+	}
+}
+
 // Import imports existing storage into the model.
 // A "CHANGE" block can block this operation.
 func (a *StorageAPI) Import(ctx context.Context, args params.BulkImportStorageParams) (params.ImportStorageResults, error) {
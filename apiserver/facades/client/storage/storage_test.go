@@ -428,6 +428,33 @@ func (s *storageSuite) TestDetach(c *gc.C) {
 	})
 }
 
+func (s *storageSuite) TestResizeStorage(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound)
+	s.stub.SetErrors(nil, errors.NotValidf("size 512MiB, not larger than 1024MiB,"))
+
+	results, err := s.api.ResizeStorage(
+		context.Background(),
+		params.ResizeStorage{Storage: []params.ResizeStorageInstance{
+			{Tag: "storage-data-0", Size: 2048},
+			{Tag: "storage-data-0", Size: 512},
+			{Tag: "storage-data-0", Size: 0},
+			{Tag: "volume-0", Size: 2048},
+		}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ErrorResult{
+		{Error: nil},
+		{Error: &params.Error{Code: params.CodeNotValid, Message: "size 512MiB, not larger than 1024MiB, not valid"}},
+		{Error: &params.Error{Code: params.CodeNotValid, Message: "storage size 0 not valid"}},
+		{Error: &params.Error{Message: `"volume-0" is not a valid storage tag`}},
+	})
+	s.stub.CheckCalls(c, []testing.StubCall{
+		{FuncName: resizeStorageInstanceCall, Args: []interface{}{s.storageTag, uint64(2048)}},
+		{FuncName: resizeStorageInstanceCall, Args: []interface{}{s.storageTag, uint64(512)}},
+	})
+}
+
 func (s *storageSuite) TestDetachSpecifiedNotFound(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
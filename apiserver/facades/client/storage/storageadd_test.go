@@ -58,6 +58,25 @@ func (s *storageAddSuite) TestStorageAddUnit(c *gc.C) {
 	s.assertCalls(c, []string{addStorageForUnitCall})
 }
 
+func (s *storageAddSuite) TestStorageAddUnitFromSnapshot(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageAccessor.addStorageForUnit = func(u names.UnitTag, name string, cons state.StorageConstraints) ([]names.StorageTag, error) {
+		s.stub.AddCall(addStorageForUnitCall, u, name, cons)
+		return nil, nil
+	}
+
+	args := params.StorageAddParams{
+		UnitTag:     s.unitTag.String(),
+		StorageName: "data",
+		SnapshotId:  "snap-1",
+	}
+	s.assertStorageAddedNoErrors(c, args)
+	s.stub.CheckCall(c, 0, addStorageForUnitCall, s.unitTag, "data", state.StorageConstraints{
+		SnapshotId: "snap-1",
+	})
+}
+
 func (s *storageAddSuite) TestStorageAddUnitBlocked(c *gc.C) {
 	defer s.baseStorageSuite.setupMocks(c).Finish()
 
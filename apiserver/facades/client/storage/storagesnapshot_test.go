@@ -0,0 +1,242 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/blockcommand"
+	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider/dummy"
+	"github.com/juju/juju/rpc/params"
+	"github.com/juju/juju/state"
+)
+
+type storageSnapshotSuite struct {
+	baseStorageSuite
+
+	created      time.Time
+	volumeSource volumeSnapshotter
+}
+
+var _ = gc.Suite(&storageSnapshotSuite{})
+
+func (s *storageSnapshotSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := s.baseStorageSuite.setupMocks(c)
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).Return("", blockcommanderrors.NotFound).AnyTimes()
+
+	p, err := storage.NewConfig("radiance", "radiance", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.storageService.EXPECT().GetStoragePoolByName(gomock.Any(), "radiance").Return(p, nil).AnyTimes()
+
+	s.created = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.volumeSource = volumeSnapshotter{VolumeSource: &dummy.VolumeSource{}, created: s.created}
+	s.registry.Providers["radiance"] = &dummy.StorageProvider{
+		StorageScope: storage.ScopeEnviron,
+		IsDynamic:    true,
+		SupportsFunc: func(kind storage.StorageKind) bool {
+			return kind == storage.StorageKindBlock
+		},
+		VolumeSourceFunc: func(*storage.Config) (storage.VolumeSource, error) {
+			return s.volumeSource, nil
+		},
+	}
+
+	s.storageInstance.kind = state.StorageKindBlock
+	s.volume.info = &state.VolumeInfo{Pool: "radiance", VolumeId: "vol-1"}
+	return ctrl
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshots(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	results, err := s.api.CreateStorageSnapshots(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: s.storageTag.String()}, {Tag: "volume-0"}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.StorageSnapshotResult{{
+		Result: &params.StorageSnapshot{
+			StorageTag: s.storageTag.String(),
+			SnapshotId: "snap-1",
+			SourceId:   "vol-1",
+			Size:       1024,
+			Created:    s.created,
+			Status:     "ready",
+		},
+	}, {
+		Error: &params.Error{Message: `"volume-0" is not a valid storage tag`},
+	}})
+	s.volumeSource.CheckCalls(c, []testing.StubCall{
+		{FuncName: "CreateVolumeSnapshots", Args: []interface{}{[]storage.VolumeSnapshotParams{{
+			Tag:      s.volumeTag,
+			VolumeId: "vol-1",
+			ResourceTags: map[string]string{
+				"juju-model-uuid":       s.modelUUID.String(),
+				"juju-controller-uuid":  s.controllerUUID,
+				"juju-storage-instance": s.storageTag.Id(),
+			},
+		}}}},
+	})
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsVolumeBackedFilesystem(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.storageInstance.kind = state.StorageKindFilesystem
+	s.filesystem.volume = &s.volumeTag
+
+	results, err := s.api.CreateStorageSnapshots(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: s.storageTag.String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	c.Assert(results.Results[0].Result.SourceId, gc.Equals, "vol-1")
+	s.volumeSource.CheckCallNames(c, "CreateVolumeSnapshots")
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsNotProvisioned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.volume.info = nil
+
+	results, err := s.api.CreateStorageSnapshots(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: s.storageTag.String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, jc.Satisfies, params.IsCodeNotProvisioned)
+	s.volumeSource.CheckNoCalls(c)
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.registry.Providers["radiance"].(*dummy.StorageProvider).VolumeSourceFunc = func(*storage.Config) (storage.VolumeSource, error) {
+		return &dummy.VolumeSource{}, nil
+	}
+
+	results, err := s.api.CreateStorageSnapshots(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: s.storageTag.String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.StorageSnapshotResult{{
+		Error: &params.Error{
+			Message: `snapshotting volumes with storage provider "radiance" not supported`,
+			Code:    params.CodeNotSupported,
+		},
+	}})
+}
+
+func (s *storageSnapshotSuite) TestCreateStorageSnapshotsBlocked(c *gc.C) {
+	defer s.baseStorageSuite.setupMocks(c).Finish()
+
+	s.blockAllChanges(c, "TestCreateStorageSnapshotsBlocked")
+
+	_, err := s.api.CreateStorageSnapshots(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: s.storageTag.String()}},
+	})
+	s.assertBlocked(c, err, "TestCreateStorageSnapshotsBlocked")
+}
+
+func (s *storageSnapshotSuite) TestListStorageSnapshots(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	results, err := s.api.ListStorageSnapshots(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: s.storageTag.String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.StorageSnapshotsResult{{
+		Result: []params.StorageSnapshot{{
+			StorageTag: s.storageTag.String(),
+			SnapshotId: "snap-1",
+			SourceId:   "vol-1",
+			Size:       1024,
+			Created:    s.created,
+			Status:     "ready",
+		}},
+	}})
+	s.volumeSource.CheckCalls(c, []testing.StubCall{
+		{FuncName: "ListVolumeSnapshots", Args: []interface{}{[]string{"vol-1"}}},
+	})
+}
+
+func (s *storageSnapshotSuite) TestRemoveStorageSnapshots(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.volumeSource.SetErrors(nil, errors.New("snapshot in use"))
+	results, err := s.api.RemoveStorageSnapshots(context.Background(), params.RemoveStorageSnapshots{
+		Snapshots: []params.RemoveStorageSnapshot{
+			{StorageTag: s.storageTag.String(), SnapshotId: "snap-1"},
+			{StorageTag: s.storageTag.String(), SnapshotId: "snap-2"},
+			{StorageTag: s.storageTag.String()},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: "snapshot in use"}},
+		{Error: &params.Error{Message: "empty snapshot ID not valid", Code: params.CodeNotValid}},
+	})
+	s.volumeSource.CheckCalls(c, []testing.StubCall{
+		{FuncName: "DeleteVolumeSnapshots", Args: []interface{}{[]string{"snap-1"}}},
+		{FuncName: "DeleteVolumeSnapshots", Args: []interface{}{[]string{"snap-2"}}},
+	})
+}
+
+type volumeSnapshotter struct {
+	*dummy.VolumeSource
+	created time.Time
+}
+
+// CreateVolumeSnapshots is part of the storage.VolumeSnapshotter interface.
+func (v volumeSnapshotter) CreateVolumeSnapshots(_ context.Context, args []storage.VolumeSnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	v.MethodCall(v, "CreateVolumeSnapshots", args)
+	results := make([]storage.CreateSnapshotsResult, len(args))
+	for i, arg := range args {
+		results[i].Snapshot = &storage.Snapshot{
+			SnapshotId: "snap-1",
+			SourceId:   arg.VolumeId,
+			Size:       1024,
+			Created:    v.created,
+			Status:     "ready",
+		}
+	}
+	return results, v.NextErr()
+}
+
+// ListVolumeSnapshots is part of the storage.VolumeSnapshotter interface.
+func (v volumeSnapshotter) ListVolumeSnapshots(_ context.Context, volumeIds []string) ([]storage.ListSnapshotsResult, error) {
+	v.MethodCall(v, "ListVolumeSnapshots", volumeIds)
+	results := make([]storage.ListSnapshotsResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		results[i].Snapshots = []storage.Snapshot{{
+			SnapshotId: "snap-1",
+			SourceId:   volumeId,
+			Size:       1024,
+			Created:    v.created,
+			Status:     "ready",
+		}}
+	}
+	return results, v.NextErr()
+}
+
+// DeleteVolumeSnapshots is part of the storage.VolumeSnapshotter interface.
+func (v volumeSnapshotter) DeleteVolumeSnapshots(_ context.Context, snapshotIds []string) ([]error, error) {
+	v.MethodCall(v, "DeleteVolumeSnapshots", snapshotIds)
+	results := make([]error, len(snapshotIds))
+	for i := range snapshotIds {
+		results[i] = v.NextErr()
+	}
+	return results, nil
+}
@@ -16,6 +16,7 @@ import (
 	model "github.com/juju/juju/core/model"
 	permission "github.com/juju/juju/core/permission"
 	user "github.com/juju/juju/core/user"
+	access "github.com/juju/juju/domain/access"
 	service "github.com/juju/juju/domain/access/service"
 	auth "github.com/juju/juju/internal/auth"
 	gomock "go.uber.org/mock/gomock"
@@ -199,6 +200,45 @@ func (c *MockAccessServiceGetAllUsersCall) DoAndReturn(f func(context.Context, b
 	return c
 }
 
+// GetLoginHistory mocks base method.
+func (m *MockAccessService) GetLoginHistory(arg0 context.Context, arg1 user.Name, arg2 int) ([]access.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]access.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginHistory indicates an expected call of GetLoginHistory.
+func (mr *MockAccessServiceMockRecorder) GetLoginHistory(arg0, arg1, arg2 any) *MockAccessServiceGetLoginHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginHistory", reflect.TypeOf((*MockAccessService)(nil).GetLoginHistory), arg0, arg1, arg2)
+	return &MockAccessServiceGetLoginHistoryCall{Call: call}
+}
+
+// MockAccessServiceGetLoginHistoryCall wrap *gomock.Call
+type MockAccessServiceGetLoginHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAccessServiceGetLoginHistoryCall) Return(arg0 []access.LoginAttempt, arg1 error) *MockAccessServiceGetLoginHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAccessServiceGetLoginHistoryCall) Do(f func(context.Context, user.Name, int) ([]access.LoginAttempt, error)) *MockAccessServiceGetLoginHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAccessServiceGetLoginHistoryCall) DoAndReturn(f func(context.Context, user.Name, int) ([]access.LoginAttempt, error)) *MockAccessServiceGetLoginHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUser mocks base method.
 func (m *MockAccessService) GetUser(arg0 context.Context, arg1 user.UUID) (user.User, error) {
 	m.ctrl.T.Helper()
@@ -21,10 +21,23 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("UserManager", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newUserManagerAPI(stdCtx, ctx) // Adds ModelUserInfo
+		return newUserManagerAPIV3(stdCtx, ctx) // Adds ModelUserInfo
+	}, reflect.TypeOf((*UserManagerAPIV3)(nil)))
+	registry.MustRegister("UserManager", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newUserManagerAPI(stdCtx, ctx) // Adds LoginHistory
 	}, reflect.TypeOf((*UserManagerAPI)(nil)))
 }
 
+// newUserManagerAPIV3 provides the signature required for facade
+// registration of version 3.
+func newUserManagerAPIV3(stdCtx context.Context, ctx facade.ModelContext) (*UserManagerAPIV3, error) {
+	api, err := newUserManagerAPI(stdCtx, ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &UserManagerAPIV3{UserManagerAPI: api}, nil
+}
+
 // newUserManagerAPI provides the signature required for facade registration.
 func newUserManagerAPI(stdCtx context.Context, ctx facade.ModelContext) (*UserManagerAPI, error) {
 	authorizer := ctx.Auth()
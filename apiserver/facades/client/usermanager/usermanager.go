@@ -21,6 +21,7 @@ import (
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	coreuser "github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
 	accesserrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/domain/access/service"
 	"github.com/juju/juju/internal/auth"
@@ -40,6 +41,11 @@ type AccessService interface {
 	ResetPassword(ctx context.Context, name coreuser.Name) ([]byte, error)
 	RemoveUser(ctx context.Context, name coreuser.Name) error
 
+	// GetLoginHistory returns the most recent password logins of the user,
+	// up to the limit, most recent first. A limit of 0 returns all the
+	// logins kept for the user.
+	GetLoginHistory(ctx context.Context, name coreuser.Name, limit int) ([]access.LoginAttempt, error)
+
 	// ReadUserAccessLevelForTarget returns the access level that the
 	// input user has been on the input target entity.
 	// If the access level of a user cannot be found then
@@ -83,6 +89,14 @@ type UserManagerAPI struct {
 	controllerUUID string
 }
 
+// UserManagerAPIV3 implements version 3 of the user manager facade.
+type UserManagerAPIV3 struct {
+	*UserManagerAPI
+}
+
+// LoginHistory isn't on the v3 API.
+func (api *UserManagerAPIV3) LoginHistory(_, _ struct{}) {}
+
 // NewAPI creates a new API endpoint for calling user manager functions.
 func NewAPI(
 	accessService AccessService,
@@ -546,6 +560,52 @@ func (api *UserManagerAPI) ResetPassword(ctx context.Context, args params.Entiti
 	return result, nil
 }
 
+// LoginHistory returns the recent password logins of the supplied users, the
+// most recent first. Users can only see their own logins unless they are
+// controller superusers.
+func (api *UserManagerAPI) LoginHistory(ctx context.Context, args params.Entities) (params.LoginHistoryResults, error) {
+	result := params.LoginHistoryResults{
+		Results: make([]params.LoginHistoryResult, len(args.Entities)),
+	}
+	if len(args.Entities) == 0 {
+		return result, nil
+	}
+
+	isSuperUser, err := api.hasControllerAdminAccess(ctx)
+	if err != nil && !errors.Is(err, authentication.ErrorEntityMissingPermission) {
+		return result, errors.Trace(err)
+	}
+
+	for i, arg := range args.Entities {
+		userTag, err := names.ParseUserTag(arg.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		if !isSuperUser && !api.authorizer.AuthOwner(userTag) {
+			result.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+
+		attempts, err := api.accessService.GetLoginHistory(ctx, coreuser.NameFromTag(userTag), 0)
+		if errors.Is(err, accesserrors.UserNotFound) {
+			err = interrors.Errorf("user %q not found", userTag.Name()).Add(coreerrors.UserNotFound)
+		}
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		for _, attempt := range attempts {
+			result.Results[i].Attempts = append(result.Results[i].Attempts, params.LoginAttempt{
+				Time:    attempt.Time,
+				Success: attempt.Success,
+				Address: attempt.Address,
+			})
+		}
+	}
+	return result, nil
+}
+
 // isModelAdmin checks if the user is a controller superuser or admin on the
 // model.
 func (api *UserManagerAPI) isModelAdmin(ctx context.Context, modelTag names.ModelTag) bool {
@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/core/permission"
 	coreuser "github.com/juju/juju/core/user"
 	coreusertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
 	usererrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/domain/access/service"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
@@ -949,6 +950,58 @@ func (s *userManagerSuite) TestResetPasswordEmpty(c *gc.C) {
 	c.Assert(results.Results, gc.HasLen, 0)
 }
 
+func (s *userManagerSuite) TestLoginHistory(c *gc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	now := time.Now()
+	s.accessService.EXPECT().GetLoginHistory(gomock.Any(), coreusertesting.GenNewName(c, "alex"), 0).Return([]access.LoginAttempt{{
+		Time:    now,
+		Success: true,
+		Address: "10.0.0.1:4567",
+	}, {
+		Time:    now.Add(-time.Minute),
+		Address: "10.0.0.2:4567",
+	}}, nil)
+	s.accessService.EXPECT().GetLoginHistory(gomock.Any(), coreusertesting.GenNewName(c, "barb"), 0).Return(nil, usererrors.UserNotFound)
+
+	results, err := s.api.LoginHistory(context.Background(), params.Entities{Entities: []params.Entity{
+		{Tag: "user-alex"},
+		{Tag: "user-barb"},
+		{Tag: "machine-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 3)
+	c.Check(results.Results[0], jc.DeepEquals, params.LoginHistoryResult{
+		Attempts: []params.LoginAttempt{{
+			Time:    now,
+			Success: true,
+			Address: "10.0.0.1:4567",
+		}, {
+			Time:    now.Add(-time.Minute),
+			Address: "10.0.0.2:4567",
+		}},
+	})
+	c.Check(results.Results[1].Error, gc.ErrorMatches, `user "barb" not found`)
+	c.Check(results.Results[2].Error, gc.ErrorMatches, `"machine-0" is not a valid user tag`)
+}
+
+func (s *userManagerSuite) TestLoginHistoryNotControllerAdmin(c *gc.C) {
+	s.setAPIUserAndAuth(c, "dope")
+	defer s.setUpAPI(c).Finish()
+
+	s.accessService.EXPECT().GetLoginHistory(gomock.Any(), coreusertesting.GenNewName(c, "dope"), 0).Return(nil, nil)
+
+	results, err := s.api.LoginHistory(context.Background(), params.Entities{Entities: []params.Entity{
+		{Tag: "user-dope"},
+		{Tag: "user-alex"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, jc.DeepEquals, []params.LoginHistoryResult{
+		{},
+		{Error: apiservererrors.ServerError(apiservererrors.ErrPerm)},
+	})
+}
+
 // setAPIUserAndAuth can be called prior to setUpAPI in order to simulate
 // calling the API as the input user. Any name other than "admin" indicates
 // that the caller is not an administrator of the controller.
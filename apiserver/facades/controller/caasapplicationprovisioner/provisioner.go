@@ -1671,6 +1671,31 @@ func (a *API) ProvisioningState(ctx context.Context, args params.Entity) (params
 	return result, nil
 }
 
+// ApplicationDeploymentTypes returns the deployment type of each of the
+// specified applications, taking the application config into account.
+func (a *API) ApplicationDeploymentTypes(ctx context.Context, args params.Entities) (params.StringResults, error) {
+	results := params.StringResults{
+		Results: make([]params.StringResult, len(args.Entities)),
+	}
+	for i, entity := range args.Entities {
+		appTag, err := names.ParseApplicationTag(entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		deploymentType, err := a.applicationService.GetApplicationDeploymentType(ctx, appTag.Id())
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			err = errors.NotFoundf("application %q", appTag.Id())
+		}
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = string(deploymentType)
+	}
+	return results, nil
+}
+
 // SetProvisioningState sets the provisioning state for the application.
 func (a *API) SetProvisioningState(ctx context.Context, args params.CAASApplicationProvisioningStateArg) (params.ErrorResult, error) {
 	result := params.ErrorResult{}
@@ -34,6 +34,7 @@ import (
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/core/watcher/watchertest"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/application/service"
 	envconfig "github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/charm"
@@ -760,6 +761,27 @@ func (s *CAASApplicationProvisionerSuite) TestProvisioningState(c *gc.C) {
 	})
 }
 
+func (s *CAASApplicationProvisionerSuite) TestApplicationDeploymentTypes(c *gc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	s.applicationService.EXPECT().GetApplicationDeploymentType(gomock.Any(), "gitlab").Return(applicationcharm.DeploymentDaemon, nil)
+	s.applicationService.EXPECT().GetApplicationDeploymentType(gomock.Any(), "mysql").Return(applicationcharm.DeploymentType(""), applicationerrors.ApplicationNotFound)
+
+	result, err := s.api.ApplicationDeploymentTypes(context.Background(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-gitlab"},
+			{Tag: "application-mysql"},
+			{Tag: "unit-gitlab-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Check(result.Results[0], jc.DeepEquals, params.StringResult{Result: "daemon"})
+	c.Check(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Check(result.Results[2].Error, gc.ErrorMatches, `"unit-gitlab-0" is not a valid application tag`)
+}
+
 func (s *CAASApplicationProvisionerSuite) TestProvisionerConfig(c *gc.C) {
 	ctrl := s.setupAPI(c)
 	defer ctrl.Finish()
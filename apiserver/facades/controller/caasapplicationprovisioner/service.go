@@ -93,6 +93,13 @@ type ApplicationService interface {
 	// WatchApplication returns a NotifyWatcher for changes to the application.
 	WatchApplication(ctx context.Context, name string) (watcher.NotifyWatcher, error)
 
+	// GetApplicationDeploymentType returns the deployment type of the named
+	// application. The deployment type set in the application config takes
+	// precedence over the one declared by the charm.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationDeploymentType(ctx context.Context, appName string) (applicationcharm.DeploymentType, error)
+
 	// GetDeviceConstraints returns the device constraints for an application.
 	//
 	// If the application is dead, [applicationerrors.ApplicationIsDead] is returned.
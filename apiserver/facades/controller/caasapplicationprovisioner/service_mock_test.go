@@ -227,6 +227,21 @@ func (mr *MockApplicationServiceMockRecorder) GetApplicationConstraints(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationConstraints", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationConstraints), arg0, arg1)
 }
 
+// GetApplicationDeploymentType mocks base method.
+func (m *MockApplicationService) GetApplicationDeploymentType(arg0 context.Context, arg1 string) (charm.DeploymentType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationDeploymentType", arg0, arg1)
+	ret0, _ := ret[0].(charm.DeploymentType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationDeploymentType indicates an expected call of GetApplicationDeploymentType.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationDeploymentType(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationDeploymentType", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationDeploymentType), arg0, arg1)
+}
+
 // GetApplicationIDByName mocks base method.
 func (m *MockApplicationService) GetApplicationIDByName(arg0 context.Context, arg1 string) (application.ID, error) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/unit"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/rpc/params"
 	"github.com/juju/juju/state/watcher"
@@ -38,6 +39,13 @@ type ApplicationService interface {
 	// If no application is found, an error satisfying
 	// [applicationerrors.ApplicationNotFound] is returned.
 	IsApplicationExposed(ctx context.Context, appName string) (bool, error)
+
+	// GetApplicationDeploymentType returns the deployment type of the named
+	// application. The deployment type set in the application config takes
+	// precedence over the one declared by the charm.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationDeploymentType(ctx context.Context, appName string) (applicationcharm.DeploymentType, error)
 }
 
 type Facade struct {
@@ -87,6 +95,37 @@ func (f *Facade) isExposed(ctx context.Context, tagString string) (bool, error)
 	return f.applicationService.IsApplicationExposed(ctx, tag.Id())
 }
 
+// ApplicationDeploymentTypes returns the deployment type of each of the
+// specified applications, taking the application config into account.
+func (f *Facade) ApplicationDeploymentTypes(ctx context.Context, args params.Entities) (params.StringResults, error) {
+	results := params.StringResults{
+		Results: make([]params.StringResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		deploymentType, err := f.deploymentType(ctx, arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = deploymentType
+	}
+	return results, nil
+}
+
+func (f *Facade) deploymentType(ctx context.Context, tagString string) (string, error) {
+	tag, err := names.ParseApplicationTag(tagString)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	deploymentType, err := f.applicationService.GetApplicationDeploymentType(ctx, tag.Id())
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return "", errors.NotFoundf("application %q", tag.Id())
+	} else if err != nil {
+		return "", errors.Trace(err)
+	}
+	return string(deploymentType), nil
+}
+
 // ApplicationsConfig returns the config for the specified applications.
 func (f *Facade) ApplicationsConfig(ctx context.Context, args params.Entities) (params.ApplicationGetConfigResults, error) {
 	results := params.ApplicationGetConfigResults{
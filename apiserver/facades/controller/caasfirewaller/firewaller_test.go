@@ -19,6 +19,7 @@ import (
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/watcher/watchertest"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
@@ -154,6 +155,29 @@ func (s *firewallerSuite) TestIsExposed(c *gc.C) {
 	})
 }
 
+func (s *firewallerSuite) TestApplicationDeploymentTypes(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.appService.EXPECT().GetApplicationDeploymentType(gomock.Any(), "gitlab").Return(applicationcharm.DeploymentStateless, nil)
+
+	results, err := s.facade.ApplicationDeploymentTypes(context.Background(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-gitlab"},
+			{Tag: "unit-gitlab-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.StringResults{
+		Results: []params.StringResult{{
+			Result: "stateless",
+		}, {
+			Error: &params.Error{
+				Message: `"unit-gitlab-0" is not a valid application tag`,
+			},
+		}},
+	})
+}
+
 func (s *firewallerSuite) TestLife(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -227,6 +251,7 @@ func (s *firewallerSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 type facadeSidecar interface {
 	IsExposed(ctx context.Context, args params.Entities) (params.BoolResults, error)
+	ApplicationDeploymentTypes(ctx context.Context, args params.Entities) (params.StringResults, error)
 	ApplicationsConfig(ctx context.Context, args params.Entities) (params.ApplicationGetConfigResults, error)
 	WatchApplications(ctx context.Context) (params.StringsWatchResult, error)
 	Life(ctx context.Context, args params.Entities) (params.LifeResults, error)
@@ -16,6 +16,7 @@ import (
 	application "github.com/juju/juju/core/application"
 	life "github.com/juju/juju/core/life"
 	unit "github.com/juju/juju/core/unit"
+	charm "github.com/juju/juju/domain/application/charm"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +43,45 @@ func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
 	return m.recorder
 }
 
+// GetApplicationDeploymentType mocks base method.
+func (m *MockApplicationService) GetApplicationDeploymentType(arg0 context.Context, arg1 string) (charm.DeploymentType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationDeploymentType", arg0, arg1)
+	ret0, _ := ret[0].(charm.DeploymentType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationDeploymentType indicates an expected call of GetApplicationDeploymentType.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationDeploymentType(arg0, arg1 any) *MockApplicationServiceGetApplicationDeploymentTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationDeploymentType", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationDeploymentType), arg0, arg1)
+	return &MockApplicationServiceGetApplicationDeploymentTypeCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationDeploymentTypeCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationDeploymentTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationDeploymentTypeCall) Return(arg0 charm.DeploymentType, arg1 error) *MockApplicationServiceGetApplicationDeploymentTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationDeploymentTypeCall) Do(f func(context.Context, string) (charm.DeploymentType, error)) *MockApplicationServiceGetApplicationDeploymentTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationDeploymentTypeCall) DoAndReturn(f func(context.Context, string) (charm.DeploymentType, error)) *MockApplicationServiceGetApplicationDeploymentTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationIDByName mocks base method.
 func (m *MockApplicationService) GetApplicationIDByName(arg0 context.Context, arg1 string) (application.ID, error) {
 	m.ctrl.T.Helper()
@@ -325,11 +325,20 @@ func (a *InstancePollerAPI) SetInstanceStatus(ctx context.Context, args params.S
 				Since:   &now,
 			}
 			err = machine.SetInstanceStatus(s)
-			if status.Status(arg.Status) == status.ProvisioningError {
+			switch status.Status(arg.Status) {
+			case status.ProvisioningError:
 				s.Status = status.Error
 				if err == nil {
 					err = machine.SetStatus(s)
 				}
+			case status.Interrupted:
+				// The provisioner will replace the interrupted
+				// instance, so the machine is pending again.
+				s.Status = status.Pending
+				s.Message = interruptedMessage(arg.Info)
+				if err == nil {
+					err = machine.SetStatus(s)
+				}
 			}
 		}
 		result.Results[i].Error = apiservererrors.ServerError(err)
@@ -337,6 +346,15 @@ func (a *InstancePollerAPI) SetInstanceStatus(ctx context.Context, args params.S
 	return result, nil
 }
 
+// interruptedMessage returns the machine status message for a machine
+// whose instance has been interrupted.
+func interruptedMessage(info string) string {
+	if info == "" {
+		return "instance interrupted"
+	}
+	return "instance interrupted: " + info
+}
+
 // AreManuallyProvisioned returns whether each given entity is
 // manually provisioned or not. Only machine tags are accepted.
 func (a *InstancePollerAPI) AreManuallyProvisioned(ctx context.Context, args params.Entities) (params.BoolResults, error) {
@@ -557,6 +557,36 @@ func (s *InstancePollerSuite) TestSetInstanceStatusSuccess(c *gc.C) {
 	c.Assert(setStatus, gc.DeepEquals, status.StatusInfo{Status: "new status"})
 }
 
+func (s *InstancePollerSuite) TestSetInstanceStatusInterrupted(c *gc.C) {
+	ctrl := s.setUpMocks(c)
+	defer ctrl.Finish()
+	err := s.setupAPI(c)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.st.SetMachineInfo(c, machineInfo{id: "1", instanceStatus: statusInfo("running")})
+
+	result, err := s.api.SetInstanceStatus(context.Background(), params.SetStatus{
+		Entities: []params.EntityStatusArgs{
+			{Tag: "machine-1", Status: status.Interrupted.String(), Info: "marked for termination"},
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{{}}})
+
+	now := s.clock.Now()
+	s.st.CheckMachineCall(c, 0, "1")
+	s.st.CheckCall(c, 1, "SetInstanceStatus", status.StatusInfo{
+		Status:  status.Interrupted,
+		Message: "marked for termination",
+		Since:   &now,
+	})
+	s.st.CheckCall(c, 2, "SetStatus", status.StatusInfo{
+		Status:  status.Pending,
+		Message: "instance interrupted: marked for termination",
+		Since:   &now,
+	})
+}
+
 func (s *InstancePollerSuite) TestSetInstanceStatusFailure(c *gc.C) {
 	ctrl := s.setUpMocks(c)
 	defer ctrl.Finish()
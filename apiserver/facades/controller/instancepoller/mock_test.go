@@ -348,6 +348,19 @@ func (m *mockMachine) SetInstanceStatus(instanceStatus status.StatusInfo) error
 	return nil
 }
 
+// SetStatus implements StateMachine.
+func (m *mockMachine) SetStatus(machineStatus status.StatusInfo) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.MethodCall(m, "SetStatus", machineStatus)
+	if err := m.NextErr(); err != nil {
+		return err
+	}
+	m.status = machineStatus
+	return nil
+}
+
 // Life implements StateMachine.
 func (m *mockMachine) Life() state.Life {
 	m.mu.Lock()
@@ -15,46 +15,50 @@ import (
 
 	model "github.com/juju/juju/core/model"
 	service "github.com/juju/juju/domain/access/service"
-	service0 "github.com/juju/juju/domain/agentbinary/service"
-	service1 "github.com/juju/juju/domain/agentpassword/service"
-	service2 "github.com/juju/juju/domain/agentprovisioner/service"
-	service3 "github.com/juju/juju/domain/annotation/service"
-	service4 "github.com/juju/juju/domain/application/service"
-	service5 "github.com/juju/juju/domain/autocert/service"
-	service6 "github.com/juju/juju/domain/blockcommand/service"
-	service7 "github.com/juju/juju/domain/blockdevice/service"
-	service8 "github.com/juju/juju/domain/cloud/service"
-	service9 "github.com/juju/juju/domain/cloudimagemetadata/service"
-	service10 "github.com/juju/juju/domain/controller/service"
-	service11 "github.com/juju/juju/domain/controllerconfig/service"
-	service12 "github.com/juju/juju/domain/controllernode/service"
-	service13 "github.com/juju/juju/domain/credential/service"
-	service14 "github.com/juju/juju/domain/externalcontroller/service"
-	service15 "github.com/juju/juju/domain/flag/service"
-	service16 "github.com/juju/juju/domain/keymanager/service"
-	service17 "github.com/juju/juju/domain/keyupdater/service"
-	service18 "github.com/juju/juju/domain/macaroon/service"
-	service19 "github.com/juju/juju/domain/machine/service"
-	service20 "github.com/juju/juju/domain/model/service"
-	service21 "github.com/juju/juju/domain/modelagent/service"
-	service22 "github.com/juju/juju/domain/modelconfig/service"
-	service23 "github.com/juju/juju/domain/modeldefaults/service"
-	service24 "github.com/juju/juju/domain/modelmigration/service"
-	service25 "github.com/juju/juju/domain/modelprovider/service"
-	service26 "github.com/juju/juju/domain/network/service"
-	service27 "github.com/juju/juju/domain/port/service"
-	service28 "github.com/juju/juju/domain/proxy/service"
-	service29 "github.com/juju/juju/domain/relation/service"
-	service30 "github.com/juju/juju/domain/removal/service"
-	service31 "github.com/juju/juju/domain/resolve/service"
-	service32 "github.com/juju/juju/domain/resource/service"
-	service33 "github.com/juju/juju/domain/secret/service"
-	service34 "github.com/juju/juju/domain/secretbackend/service"
-	service35 "github.com/juju/juju/domain/status/service"
-	service36 "github.com/juju/juju/domain/storage/service"
+	service0 "github.com/juju/juju/domain/admission/service"
+	service1 "github.com/juju/juju/domain/agentbinary/service"
+	service2 "github.com/juju/juju/domain/agentpassword/service"
+	service3 "github.com/juju/juju/domain/agentprovisioner/service"
+	service4 "github.com/juju/juju/domain/annotation/service"
+	service5 "github.com/juju/juju/domain/application/service"
+	service6 "github.com/juju/juju/domain/autocert/service"
+	service7 "github.com/juju/juju/domain/blockcommand/service"
+	service8 "github.com/juju/juju/domain/blockdevice/service"
+	service9 "github.com/juju/juju/domain/cloud/service"
+	service10 "github.com/juju/juju/domain/cloudimagemetadata/service"
+	service11 "github.com/juju/juju/domain/controller/service"
+	service12 "github.com/juju/juju/domain/controllerconfig/service"
+	service13 "github.com/juju/juju/domain/controllernode/service"
+	service14 "github.com/juju/juju/domain/credential/service"
+	service15 "github.com/juju/juju/domain/externalcontroller/service"
+	service16 "github.com/juju/juju/domain/flag/service"
+	service17 "github.com/juju/juju/domain/keymanager/service"
+	service18 "github.com/juju/juju/domain/keyupdater/service"
+	service19 "github.com/juju/juju/domain/macaroon/service"
+	service20 "github.com/juju/juju/domain/machine/service"
+	service21 "github.com/juju/juju/domain/model/service"
+	service22 "github.com/juju/juju/domain/modelagent/service"
+	service23 "github.com/juju/juju/domain/modelconfig/service"
+	service24 "github.com/juju/juju/domain/modeldefaults/service"
+	service25 "github.com/juju/juju/domain/modelmigration/service"
+	service26 "github.com/juju/juju/domain/modelprovider/service"
+	service27 "github.com/juju/juju/domain/network/service"
+	service28 "github.com/juju/juju/domain/port/service"
+	service29 "github.com/juju/juju/domain/proxy/service"
+	service30 "github.com/juju/juju/domain/relation/service"
+	service31 "github.com/juju/juju/domain/removal/service"
+	service32 "github.com/juju/juju/domain/resolve/service"
+	service33 "github.com/juju/juju/domain/resource/service"
+	service34 "github.com/juju/juju/domain/secret/service"
+	service35 "github.com/juju/juju/domain/secretbackend/service"
+	service36 "github.com/juju/juju/domain/sshaccess/service"
+	service37 "github.com/juju/juju/domain/sshca/service"
+	service38 "github.com/juju/juju/domain/sshsession/service"
+	service39 "github.com/juju/juju/domain/status/service"
+	service40 "github.com/juju/juju/domain/storage/service"
 	stub "github.com/juju/juju/domain/stub"
-	service37 "github.com/juju/juju/domain/unitstate/service"
-	service38 "github.com/juju/juju/domain/upgrade/service"
+	service41 "github.com/juju/juju/domain/unitstate/service"
+	service42 "github.com/juju/juju/domain/upgrade/service"
 	services "github.com/juju/juju/internal/services"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -182,11 +186,49 @@ func (c *MockDomainServicesAccessCall) DoAndReturn(f func() *service.Service) *M
 	return c
 }
 
+// Admission mocks base method.
+func (m *MockDomainServices) Admission() *service0.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admission")
+	ret0, _ := ret[0].(*service0.Service)
+	return ret0
+}
+
+// Admission indicates an expected call of Admission.
+func (mr *MockDomainServicesMockRecorder) Admission() *MockDomainServicesAdmissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admission", reflect.TypeOf((*MockDomainServices)(nil).Admission))
+	return &MockDomainServicesAdmissionCall{Call: call}
+}
+
+// MockDomainServicesAdmissionCall wrap *gomock.Call
+type MockDomainServicesAdmissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesAdmissionCall) Return(arg0 *service0.Service) *MockDomainServicesAdmissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesAdmissionCall) Do(f func() *service0.Service) *MockDomainServicesAdmissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesAdmissionCall) DoAndReturn(f func() *service0.Service) *MockDomainServicesAdmissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Agent mocks base method.
-func (m *MockDomainServices) Agent() *service21.WatchableService {
+func (m *MockDomainServices) Agent() *service22.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Agent")
-	ret0, _ := ret[0].(*service21.WatchableService)
+	ret0, _ := ret[0].(*service22.WatchableService)
 	return ret0
 }
 
@@ -203,28 +245,28 @@ type MockDomainServicesAgentCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesAgentCall) Return(arg0 *service21.WatchableService) *MockDomainServicesAgentCall {
+func (c *MockDomainServicesAgentCall) Return(arg0 *service22.WatchableService) *MockDomainServicesAgentCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesAgentCall) Do(f func() *service21.WatchableService) *MockDomainServicesAgentCall {
+func (c *MockDomainServicesAgentCall) Do(f func() *service22.WatchableService) *MockDomainServicesAgentCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesAgentCall) DoAndReturn(f func() *service21.WatchableService) *MockDomainServicesAgentCall {
+func (c *MockDomainServicesAgentCall) DoAndReturn(f func() *service22.WatchableService) *MockDomainServicesAgentCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AgentBinary mocks base method.
-func (m *MockDomainServices) AgentBinary() *service0.AgentBinaryService {
+func (m *MockDomainServices) AgentBinary() *service1.AgentBinaryService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AgentBinary")
-	ret0, _ := ret[0].(*service0.AgentBinaryService)
+	ret0, _ := ret[0].(*service1.AgentBinaryService)
 	return ret0
 }
 
@@ -241,28 +283,28 @@ type MockDomainServicesAgentBinaryCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesAgentBinaryCall) Return(arg0 *service0.AgentBinaryService) *MockDomainServicesAgentBinaryCall {
+func (c *MockDomainServicesAgentBinaryCall) Return(arg0 *service1.AgentBinaryService) *MockDomainServicesAgentBinaryCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesAgentBinaryCall) Do(f func() *service0.AgentBinaryService) *MockDomainServicesAgentBinaryCall {
+func (c *MockDomainServicesAgentBinaryCall) Do(f func() *service1.AgentBinaryService) *MockDomainServicesAgentBinaryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesAgentBinaryCall) DoAndReturn(f func() *service0.AgentBinaryService) *MockDomainServicesAgentBinaryCall {
+func (c *MockDomainServicesAgentBinaryCall) DoAndReturn(f func() *service1.AgentBinaryService) *MockDomainServicesAgentBinaryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AgentBinaryStore mocks base method.
-func (m *MockDomainServices) AgentBinaryStore() *service0.AgentBinaryStore {
+func (m *MockDomainServices) AgentBinaryStore() *service1.AgentBinaryStore {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AgentBinaryStore")
-	ret0, _ := ret[0].(*service0.AgentBinaryStore)
+	ret0, _ := ret[0].(*service1.AgentBinaryStore)
 	return ret0
 }
 
@@ -279,28 +321,28 @@ type MockDomainServicesAgentBinaryStoreCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesAgentBinaryStoreCall) Return(arg0 *service0.AgentBinaryStore) *MockDomainServicesAgentBinaryStoreCall {
+func (c *MockDomainServicesAgentBinaryStoreCall) Return(arg0 *service1.AgentBinaryStore) *MockDomainServicesAgentBinaryStoreCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesAgentBinaryStoreCall) Do(f func() *service0.AgentBinaryStore) *MockDomainServicesAgentBinaryStoreCall {
+func (c *MockDomainServicesAgentBinaryStoreCall) Do(f func() *service1.AgentBinaryStore) *MockDomainServicesAgentBinaryStoreCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesAgentBinaryStoreCall) DoAndReturn(f func() *service0.AgentBinaryStore) *MockDomainServicesAgentBinaryStoreCall {
+func (c *MockDomainServicesAgentBinaryStoreCall) DoAndReturn(f func() *service1.AgentBinaryStore) *MockDomainServicesAgentBinaryStoreCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AgentPassword mocks base method.
-func (m *MockDomainServices) AgentPassword() *service1.Service {
+func (m *MockDomainServices) AgentPassword() *service2.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AgentPassword")
-	ret0, _ := ret[0].(*service1.Service)
+	ret0, _ := ret[0].(*service2.Service)
 	return ret0
 }
 
@@ -317,28 +359,28 @@ type MockDomainServicesAgentPasswordCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesAgentPasswordCall) Return(arg0 *service1.Service) *MockDomainServicesAgentPasswordCall {
+func (c *MockDomainServicesAgentPasswordCall) Return(arg0 *service2.Service) *MockDomainServicesAgentPasswordCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesAgentPasswordCall) Do(f func() *service1.Service) *MockDomainServicesAgentPasswordCall {
+func (c *MockDomainServicesAgentPasswordCall) Do(f func() *service2.Service) *MockDomainServicesAgentPasswordCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesAgentPasswordCall) DoAndReturn(f func() *service1.Service) *MockDomainServicesAgentPasswordCall {
+func (c *MockDomainServicesAgentPasswordCall) DoAndReturn(f func() *service2.Service) *MockDomainServicesAgentPasswordCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AgentProvisioner mocks base method.
-func (m *MockDomainServices) AgentProvisioner() *service2.Service {
+func (m *MockDomainServices) AgentProvisioner() *service3.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AgentProvisioner")
-	ret0, _ := ret[0].(*service2.Service)
+	ret0, _ := ret[0].(*service3.Service)
 	return ret0
 }
 
@@ -355,28 +397,28 @@ type MockDomainServicesAgentProvisionerCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesAgentProvisionerCall) Return(arg0 *service2.Service) *MockDomainServicesAgentProvisionerCall {
+func (c *MockDomainServicesAgentProvisionerCall) Return(arg0 *service3.Service) *MockDomainServicesAgentProvisionerCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesAgentProvisionerCall) Do(f func() *service2.Service) *MockDomainServicesAgentProvisionerCall {
+func (c *MockDomainServicesAgentProvisionerCall) Do(f func() *service3.Service) *MockDomainServicesAgentProvisionerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesAgentProvisionerCall) DoAndReturn(f func() *service2.Service) *MockDomainServicesAgentProvisionerCall {
+func (c *MockDomainServicesAgentProvisionerCall) DoAndReturn(f func() *service3.Service) *MockDomainServicesAgentProvisionerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Annotation mocks base method.
-func (m *MockDomainServices) Annotation() *service3.Service {
+func (m *MockDomainServices) Annotation() *service4.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Annotation")
-	ret0, _ := ret[0].(*service3.Service)
+	ret0, _ := ret[0].(*service4.Service)
 	return ret0
 }
 
@@ -393,28 +435,28 @@ type MockDomainServicesAnnotationCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesAnnotationCall) Return(arg0 *service3.Service) *MockDomainServicesAnnotationCall {
+func (c *MockDomainServicesAnnotationCall) Return(arg0 *service4.Service) *MockDomainServicesAnnotationCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesAnnotationCall) Do(f func() *service3.Service) *MockDomainServicesAnnotationCall {
+func (c *MockDomainServicesAnnotationCall) Do(f func() *service4.Service) *MockDomainServicesAnnotationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesAnnotationCall) DoAndReturn(f func() *service3.Service) *MockDomainServicesAnnotationCall {
+func (c *MockDomainServicesAnnotationCall) DoAndReturn(f func() *service4.Service) *MockDomainServicesAnnotationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Application mocks base method.
-func (m *MockDomainServices) Application() *service4.WatchableService {
+func (m *MockDomainServices) Application() *service5.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Application")
-	ret0, _ := ret[0].(*service4.WatchableService)
+	ret0, _ := ret[0].(*service5.WatchableService)
 	return ret0
 }
 
@@ -431,28 +473,28 @@ type MockDomainServicesApplicationCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesApplicationCall) Return(arg0 *service4.WatchableService) *MockDomainServicesApplicationCall {
+func (c *MockDomainServicesApplicationCall) Return(arg0 *service5.WatchableService) *MockDomainServicesApplicationCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesApplicationCall) Do(f func() *service4.WatchableService) *MockDomainServicesApplicationCall {
+func (c *MockDomainServicesApplicationCall) Do(f func() *service5.WatchableService) *MockDomainServicesApplicationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesApplicationCall) DoAndReturn(f func() *service4.WatchableService) *MockDomainServicesApplicationCall {
+func (c *MockDomainServicesApplicationCall) DoAndReturn(f func() *service5.WatchableService) *MockDomainServicesApplicationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // AutocertCache mocks base method.
-func (m *MockDomainServices) AutocertCache() *service5.Service {
+func (m *MockDomainServices) AutocertCache() *service6.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AutocertCache")
-	ret0, _ := ret[0].(*service5.Service)
+	ret0, _ := ret[0].(*service6.Service)
 	return ret0
 }
 
@@ -469,28 +511,28 @@ type MockDomainServicesAutocertCacheCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesAutocertCacheCall) Return(arg0 *service5.Service) *MockDomainServicesAutocertCacheCall {
+func (c *MockDomainServicesAutocertCacheCall) Return(arg0 *service6.Service) *MockDomainServicesAutocertCacheCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesAutocertCacheCall) Do(f func() *service5.Service) *MockDomainServicesAutocertCacheCall {
+func (c *MockDomainServicesAutocertCacheCall) Do(f func() *service6.Service) *MockDomainServicesAutocertCacheCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesAutocertCacheCall) DoAndReturn(f func() *service5.Service) *MockDomainServicesAutocertCacheCall {
+func (c *MockDomainServicesAutocertCacheCall) DoAndReturn(f func() *service6.Service) *MockDomainServicesAutocertCacheCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // BlockCommand mocks base method.
-func (m *MockDomainServices) BlockCommand() *service6.Service {
+func (m *MockDomainServices) BlockCommand() *service7.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BlockCommand")
-	ret0, _ := ret[0].(*service6.Service)
+	ret0, _ := ret[0].(*service7.Service)
 	return ret0
 }
 
@@ -507,28 +549,28 @@ type MockDomainServicesBlockCommandCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesBlockCommandCall) Return(arg0 *service6.Service) *MockDomainServicesBlockCommandCall {
+func (c *MockDomainServicesBlockCommandCall) Return(arg0 *service7.Service) *MockDomainServicesBlockCommandCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesBlockCommandCall) Do(f func() *service6.Service) *MockDomainServicesBlockCommandCall {
+func (c *MockDomainServicesBlockCommandCall) Do(f func() *service7.Service) *MockDomainServicesBlockCommandCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesBlockCommandCall) DoAndReturn(f func() *service6.Service) *MockDomainServicesBlockCommandCall {
+func (c *MockDomainServicesBlockCommandCall) DoAndReturn(f func() *service7.Service) *MockDomainServicesBlockCommandCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // BlockDevice mocks base method.
-func (m *MockDomainServices) BlockDevice() *service7.WatchableService {
+func (m *MockDomainServices) BlockDevice() *service8.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BlockDevice")
-	ret0, _ := ret[0].(*service7.WatchableService)
+	ret0, _ := ret[0].(*service8.WatchableService)
 	return ret0
 }
 
@@ -545,28 +587,28 @@ type MockDomainServicesBlockDeviceCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesBlockDeviceCall) Return(arg0 *service7.WatchableService) *MockDomainServicesBlockDeviceCall {
+func (c *MockDomainServicesBlockDeviceCall) Return(arg0 *service8.WatchableService) *MockDomainServicesBlockDeviceCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesBlockDeviceCall) Do(f func() *service7.WatchableService) *MockDomainServicesBlockDeviceCall {
+func (c *MockDomainServicesBlockDeviceCall) Do(f func() *service8.WatchableService) *MockDomainServicesBlockDeviceCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesBlockDeviceCall) DoAndReturn(f func() *service7.WatchableService) *MockDomainServicesBlockDeviceCall {
+func (c *MockDomainServicesBlockDeviceCall) DoAndReturn(f func() *service8.WatchableService) *MockDomainServicesBlockDeviceCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Cloud mocks base method.
-func (m *MockDomainServices) Cloud() *service8.WatchableService {
+func (m *MockDomainServices) Cloud() *service9.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Cloud")
-	ret0, _ := ret[0].(*service8.WatchableService)
+	ret0, _ := ret[0].(*service9.WatchableService)
 	return ret0
 }
 
@@ -583,28 +625,28 @@ type MockDomainServicesCloudCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesCloudCall) Return(arg0 *service8.WatchableService) *MockDomainServicesCloudCall {
+func (c *MockDomainServicesCloudCall) Return(arg0 *service9.WatchableService) *MockDomainServicesCloudCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesCloudCall) Do(f func() *service8.WatchableService) *MockDomainServicesCloudCall {
+func (c *MockDomainServicesCloudCall) Do(f func() *service9.WatchableService) *MockDomainServicesCloudCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesCloudCall) DoAndReturn(f func() *service8.WatchableService) *MockDomainServicesCloudCall {
+func (c *MockDomainServicesCloudCall) DoAndReturn(f func() *service9.WatchableService) *MockDomainServicesCloudCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // CloudImageMetadata mocks base method.
-func (m *MockDomainServices) CloudImageMetadata() *service9.Service {
+func (m *MockDomainServices) CloudImageMetadata() *service10.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloudImageMetadata")
-	ret0, _ := ret[0].(*service9.Service)
+	ret0, _ := ret[0].(*service10.Service)
 	return ret0
 }
 
@@ -621,28 +663,28 @@ type MockDomainServicesCloudImageMetadataCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesCloudImageMetadataCall) Return(arg0 *service9.Service) *MockDomainServicesCloudImageMetadataCall {
+func (c *MockDomainServicesCloudImageMetadataCall) Return(arg0 *service10.Service) *MockDomainServicesCloudImageMetadataCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesCloudImageMetadataCall) Do(f func() *service9.Service) *MockDomainServicesCloudImageMetadataCall {
+func (c *MockDomainServicesCloudImageMetadataCall) Do(f func() *service10.Service) *MockDomainServicesCloudImageMetadataCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesCloudImageMetadataCall) DoAndReturn(f func() *service9.Service) *MockDomainServicesCloudImageMetadataCall {
+func (c *MockDomainServicesCloudImageMetadataCall) DoAndReturn(f func() *service10.Service) *MockDomainServicesCloudImageMetadataCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Config mocks base method.
-func (m *MockDomainServices) Config() *service22.WatchableService {
+func (m *MockDomainServices) Config() *service23.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Config")
-	ret0, _ := ret[0].(*service22.WatchableService)
+	ret0, _ := ret[0].(*service23.WatchableService)
 	return ret0
 }
 
@@ -659,28 +701,28 @@ type MockDomainServicesConfigCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesConfigCall) Return(arg0 *service22.WatchableService) *MockDomainServicesConfigCall {
+func (c *MockDomainServicesConfigCall) Return(arg0 *service23.WatchableService) *MockDomainServicesConfigCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesConfigCall) Do(f func() *service22.WatchableService) *MockDomainServicesConfigCall {
+func (c *MockDomainServicesConfigCall) Do(f func() *service23.WatchableService) *MockDomainServicesConfigCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesConfigCall) DoAndReturn(f func() *service22.WatchableService) *MockDomainServicesConfigCall {
+func (c *MockDomainServicesConfigCall) DoAndReturn(f func() *service23.WatchableService) *MockDomainServicesConfigCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Controller mocks base method.
-func (m *MockDomainServices) Controller() *service10.Service {
+func (m *MockDomainServices) Controller() *service11.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Controller")
-	ret0, _ := ret[0].(*service10.Service)
+	ret0, _ := ret[0].(*service11.Service)
 	return ret0
 }
 
@@ -697,28 +739,28 @@ type MockDomainServicesControllerCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesControllerCall) Return(arg0 *service10.Service) *MockDomainServicesControllerCall {
+func (c *MockDomainServicesControllerCall) Return(arg0 *service11.Service) *MockDomainServicesControllerCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesControllerCall) Do(f func() *service10.Service) *MockDomainServicesControllerCall {
+func (c *MockDomainServicesControllerCall) Do(f func() *service11.Service) *MockDomainServicesControllerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesControllerCall) DoAndReturn(f func() *service10.Service) *MockDomainServicesControllerCall {
+func (c *MockDomainServicesControllerCall) DoAndReturn(f func() *service11.Service) *MockDomainServicesControllerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ControllerAgentBinaryStore mocks base method.
-func (m *MockDomainServices) ControllerAgentBinaryStore() *service0.AgentBinaryStore {
+func (m *MockDomainServices) ControllerAgentBinaryStore() *service1.AgentBinaryStore {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ControllerAgentBinaryStore")
-	ret0, _ := ret[0].(*service0.AgentBinaryStore)
+	ret0, _ := ret[0].(*service1.AgentBinaryStore)
 	return ret0
 }
 
@@ -735,28 +777,28 @@ type MockDomainServicesControllerAgentBinaryStoreCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesControllerAgentBinaryStoreCall) Return(arg0 *service0.AgentBinaryStore) *MockDomainServicesControllerAgentBinaryStoreCall {
+func (c *MockDomainServicesControllerAgentBinaryStoreCall) Return(arg0 *service1.AgentBinaryStore) *MockDomainServicesControllerAgentBinaryStoreCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesControllerAgentBinaryStoreCall) Do(f func() *service0.AgentBinaryStore) *MockDomainServicesControllerAgentBinaryStoreCall {
+func (c *MockDomainServicesControllerAgentBinaryStoreCall) Do(f func() *service1.AgentBinaryStore) *MockDomainServicesControllerAgentBinaryStoreCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesControllerAgentBinaryStoreCall) DoAndReturn(f func() *service0.AgentBinaryStore) *MockDomainServicesControllerAgentBinaryStoreCall {
+func (c *MockDomainServicesControllerAgentBinaryStoreCall) DoAndReturn(f func() *service1.AgentBinaryStore) *MockDomainServicesControllerAgentBinaryStoreCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ControllerConfig mocks base method.
-func (m *MockDomainServices) ControllerConfig() *service11.WatchableService {
+func (m *MockDomainServices) ControllerConfig() *service12.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ControllerConfig")
-	ret0, _ := ret[0].(*service11.WatchableService)
+	ret0, _ := ret[0].(*service12.WatchableService)
 	return ret0
 }
 
@@ -773,28 +815,28 @@ type MockDomainServicesControllerConfigCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesControllerConfigCall) Return(arg0 *service11.WatchableService) *MockDomainServicesControllerConfigCall {
+func (c *MockDomainServicesControllerConfigCall) Return(arg0 *service12.WatchableService) *MockDomainServicesControllerConfigCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesControllerConfigCall) Do(f func() *service11.WatchableService) *MockDomainServicesControllerConfigCall {
+func (c *MockDomainServicesControllerConfigCall) Do(f func() *service12.WatchableService) *MockDomainServicesControllerConfigCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesControllerConfigCall) DoAndReturn(f func() *service11.WatchableService) *MockDomainServicesControllerConfigCall {
+func (c *MockDomainServicesControllerConfigCall) DoAndReturn(f func() *service12.WatchableService) *MockDomainServicesControllerConfigCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ControllerNode mocks base method.
-func (m *MockDomainServices) ControllerNode() *service12.Service {
+func (m *MockDomainServices) ControllerNode() *service13.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ControllerNode")
-	ret0, _ := ret[0].(*service12.Service)
+	ret0, _ := ret[0].(*service13.Service)
 	return ret0
 }
 
@@ -811,28 +853,28 @@ type MockDomainServicesControllerNodeCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesControllerNodeCall) Return(arg0 *service12.Service) *MockDomainServicesControllerNodeCall {
+func (c *MockDomainServicesControllerNodeCall) Return(arg0 *service13.Service) *MockDomainServicesControllerNodeCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesControllerNodeCall) Do(f func() *service12.Service) *MockDomainServicesControllerNodeCall {
+func (c *MockDomainServicesControllerNodeCall) Do(f func() *service13.Service) *MockDomainServicesControllerNodeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesControllerNodeCall) DoAndReturn(f func() *service12.Service) *MockDomainServicesControllerNodeCall {
+func (c *MockDomainServicesControllerNodeCall) DoAndReturn(f func() *service13.Service) *MockDomainServicesControllerNodeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Credential mocks base method.
-func (m *MockDomainServices) Credential() *service13.WatchableService {
+func (m *MockDomainServices) Credential() *service14.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Credential")
-	ret0, _ := ret[0].(*service13.WatchableService)
+	ret0, _ := ret[0].(*service14.WatchableService)
 	return ret0
 }
 
@@ -849,28 +891,28 @@ type MockDomainServicesCredentialCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesCredentialCall) Return(arg0 *service13.WatchableService) *MockDomainServicesCredentialCall {
+func (c *MockDomainServicesCredentialCall) Return(arg0 *service14.WatchableService) *MockDomainServicesCredentialCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesCredentialCall) Do(f func() *service13.WatchableService) *MockDomainServicesCredentialCall {
+func (c *MockDomainServicesCredentialCall) Do(f func() *service14.WatchableService) *MockDomainServicesCredentialCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesCredentialCall) DoAndReturn(f func() *service13.WatchableService) *MockDomainServicesCredentialCall {
+func (c *MockDomainServicesCredentialCall) DoAndReturn(f func() *service14.WatchableService) *MockDomainServicesCredentialCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ExternalController mocks base method.
-func (m *MockDomainServices) ExternalController() *service14.WatchableService {
+func (m *MockDomainServices) ExternalController() *service15.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ExternalController")
-	ret0, _ := ret[0].(*service14.WatchableService)
+	ret0, _ := ret[0].(*service15.WatchableService)
 	return ret0
 }
 
@@ -887,28 +929,28 @@ type MockDomainServicesExternalControllerCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesExternalControllerCall) Return(arg0 *service14.WatchableService) *MockDomainServicesExternalControllerCall {
+func (c *MockDomainServicesExternalControllerCall) Return(arg0 *service15.WatchableService) *MockDomainServicesExternalControllerCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesExternalControllerCall) Do(f func() *service14.WatchableService) *MockDomainServicesExternalControllerCall {
+func (c *MockDomainServicesExternalControllerCall) Do(f func() *service15.WatchableService) *MockDomainServicesExternalControllerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesExternalControllerCall) DoAndReturn(f func() *service14.WatchableService) *MockDomainServicesExternalControllerCall {
+func (c *MockDomainServicesExternalControllerCall) DoAndReturn(f func() *service15.WatchableService) *MockDomainServicesExternalControllerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Flag mocks base method.
-func (m *MockDomainServices) Flag() *service15.Service {
+func (m *MockDomainServices) Flag() *service16.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Flag")
-	ret0, _ := ret[0].(*service15.Service)
+	ret0, _ := ret[0].(*service16.Service)
 	return ret0
 }
 
@@ -925,28 +967,28 @@ type MockDomainServicesFlagCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesFlagCall) Return(arg0 *service15.Service) *MockDomainServicesFlagCall {
+func (c *MockDomainServicesFlagCall) Return(arg0 *service16.Service) *MockDomainServicesFlagCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesFlagCall) Do(f func() *service15.Service) *MockDomainServicesFlagCall {
+func (c *MockDomainServicesFlagCall) Do(f func() *service16.Service) *MockDomainServicesFlagCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesFlagCall) DoAndReturn(f func() *service15.Service) *MockDomainServicesFlagCall {
+func (c *MockDomainServicesFlagCall) DoAndReturn(f func() *service16.Service) *MockDomainServicesFlagCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // KeyManager mocks base method.
-func (m *MockDomainServices) KeyManager() *service16.Service {
+func (m *MockDomainServices) KeyManager() *service17.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "KeyManager")
-	ret0, _ := ret[0].(*service16.Service)
+	ret0, _ := ret[0].(*service17.Service)
 	return ret0
 }
 
@@ -963,28 +1005,28 @@ type MockDomainServicesKeyManagerCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesKeyManagerCall) Return(arg0 *service16.Service) *MockDomainServicesKeyManagerCall {
+func (c *MockDomainServicesKeyManagerCall) Return(arg0 *service17.Service) *MockDomainServicesKeyManagerCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesKeyManagerCall) Do(f func() *service16.Service) *MockDomainServicesKeyManagerCall {
+func (c *MockDomainServicesKeyManagerCall) Do(f func() *service17.Service) *MockDomainServicesKeyManagerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesKeyManagerCall) DoAndReturn(f func() *service16.Service) *MockDomainServicesKeyManagerCall {
+func (c *MockDomainServicesKeyManagerCall) DoAndReturn(f func() *service17.Service) *MockDomainServicesKeyManagerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // KeyManagerWithImporter mocks base method.
-func (m *MockDomainServices) KeyManagerWithImporter() *service16.ImporterService {
+func (m *MockDomainServices) KeyManagerWithImporter() *service17.ImporterService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "KeyManagerWithImporter")
-	ret0, _ := ret[0].(*service16.ImporterService)
+	ret0, _ := ret[0].(*service17.ImporterService)
 	return ret0
 }
 
@@ -1001,28 +1043,28 @@ type MockDomainServicesKeyManagerWithImporterCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesKeyManagerWithImporterCall) Return(arg0 *service16.ImporterService) *MockDomainServicesKeyManagerWithImporterCall {
+func (c *MockDomainServicesKeyManagerWithImporterCall) Return(arg0 *service17.ImporterService) *MockDomainServicesKeyManagerWithImporterCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesKeyManagerWithImporterCall) Do(f func() *service16.ImporterService) *MockDomainServicesKeyManagerWithImporterCall {
+func (c *MockDomainServicesKeyManagerWithImporterCall) Do(f func() *service17.ImporterService) *MockDomainServicesKeyManagerWithImporterCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesKeyManagerWithImporterCall) DoAndReturn(f func() *service16.ImporterService) *MockDomainServicesKeyManagerWithImporterCall {
+func (c *MockDomainServicesKeyManagerWithImporterCall) DoAndReturn(f func() *service17.ImporterService) *MockDomainServicesKeyManagerWithImporterCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // KeyUpdater mocks base method.
-func (m *MockDomainServices) KeyUpdater() *service17.WatchableService {
+func (m *MockDomainServices) KeyUpdater() *service18.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "KeyUpdater")
-	ret0, _ := ret[0].(*service17.WatchableService)
+	ret0, _ := ret[0].(*service18.WatchableService)
 	return ret0
 }
 
@@ -1039,28 +1081,28 @@ type MockDomainServicesKeyUpdaterCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesKeyUpdaterCall) Return(arg0 *service17.WatchableService) *MockDomainServicesKeyUpdaterCall {
+func (c *MockDomainServicesKeyUpdaterCall) Return(arg0 *service18.WatchableService) *MockDomainServicesKeyUpdaterCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesKeyUpdaterCall) Do(f func() *service17.WatchableService) *MockDomainServicesKeyUpdaterCall {
+func (c *MockDomainServicesKeyUpdaterCall) Do(f func() *service18.WatchableService) *MockDomainServicesKeyUpdaterCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesKeyUpdaterCall) DoAndReturn(f func() *service17.WatchableService) *MockDomainServicesKeyUpdaterCall {
+func (c *MockDomainServicesKeyUpdaterCall) DoAndReturn(f func() *service18.WatchableService) *MockDomainServicesKeyUpdaterCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Macaroon mocks base method.
-func (m *MockDomainServices) Macaroon() *service18.Service {
+func (m *MockDomainServices) Macaroon() *service19.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Macaroon")
-	ret0, _ := ret[0].(*service18.Service)
+	ret0, _ := ret[0].(*service19.Service)
 	return ret0
 }
 
@@ -1077,28 +1119,28 @@ type MockDomainServicesMacaroonCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesMacaroonCall) Return(arg0 *service18.Service) *MockDomainServicesMacaroonCall {
+func (c *MockDomainServicesMacaroonCall) Return(arg0 *service19.Service) *MockDomainServicesMacaroonCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesMacaroonCall) Do(f func() *service18.Service) *MockDomainServicesMacaroonCall {
+func (c *MockDomainServicesMacaroonCall) Do(f func() *service19.Service) *MockDomainServicesMacaroonCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesMacaroonCall) DoAndReturn(f func() *service18.Service) *MockDomainServicesMacaroonCall {
+func (c *MockDomainServicesMacaroonCall) DoAndReturn(f func() *service19.Service) *MockDomainServicesMacaroonCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Machine mocks base method.
-func (m *MockDomainServices) Machine() *service19.WatchableService {
+func (m *MockDomainServices) Machine() *service20.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Machine")
-	ret0, _ := ret[0].(*service19.WatchableService)
+	ret0, _ := ret[0].(*service20.WatchableService)
 	return ret0
 }
 
@@ -1115,28 +1157,28 @@ type MockDomainServicesMachineCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesMachineCall) Return(arg0 *service19.WatchableService) *MockDomainServicesMachineCall {
+func (c *MockDomainServicesMachineCall) Return(arg0 *service20.WatchableService) *MockDomainServicesMachineCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesMachineCall) Do(f func() *service19.WatchableService) *MockDomainServicesMachineCall {
+func (c *MockDomainServicesMachineCall) Do(f func() *service20.WatchableService) *MockDomainServicesMachineCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesMachineCall) DoAndReturn(f func() *service19.WatchableService) *MockDomainServicesMachineCall {
+func (c *MockDomainServicesMachineCall) DoAndReturn(f func() *service20.WatchableService) *MockDomainServicesMachineCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Model mocks base method.
-func (m *MockDomainServices) Model() *service20.WatchableService {
+func (m *MockDomainServices) Model() *service21.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Model")
-	ret0, _ := ret[0].(*service20.WatchableService)
+	ret0, _ := ret[0].(*service21.WatchableService)
 	return ret0
 }
 
@@ -1153,28 +1195,28 @@ type MockDomainServicesModelCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesModelCall) Return(arg0 *service20.WatchableService) *MockDomainServicesModelCall {
+func (c *MockDomainServicesModelCall) Return(arg0 *service21.WatchableService) *MockDomainServicesModelCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesModelCall) Do(f func() *service20.WatchableService) *MockDomainServicesModelCall {
+func (c *MockDomainServicesModelCall) Do(f func() *service21.WatchableService) *MockDomainServicesModelCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesModelCall) DoAndReturn(f func() *service20.WatchableService) *MockDomainServicesModelCall {
+func (c *MockDomainServicesModelCall) DoAndReturn(f func() *service21.WatchableService) *MockDomainServicesModelCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ModelDefaults mocks base method.
-func (m *MockDomainServices) ModelDefaults() *service23.Service {
+func (m *MockDomainServices) ModelDefaults() *service24.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ModelDefaults")
-	ret0, _ := ret[0].(*service23.Service)
+	ret0, _ := ret[0].(*service24.Service)
 	return ret0
 }
 
@@ -1191,28 +1233,28 @@ type MockDomainServicesModelDefaultsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesModelDefaultsCall) Return(arg0 *service23.Service) *MockDomainServicesModelDefaultsCall {
+func (c *MockDomainServicesModelDefaultsCall) Return(arg0 *service24.Service) *MockDomainServicesModelDefaultsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesModelDefaultsCall) Do(f func() *service23.Service) *MockDomainServicesModelDefaultsCall {
+func (c *MockDomainServicesModelDefaultsCall) Do(f func() *service24.Service) *MockDomainServicesModelDefaultsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesModelDefaultsCall) DoAndReturn(f func() *service23.Service) *MockDomainServicesModelDefaultsCall {
+func (c *MockDomainServicesModelDefaultsCall) DoAndReturn(f func() *service24.Service) *MockDomainServicesModelDefaultsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ModelInfo mocks base method.
-func (m *MockDomainServices) ModelInfo() *service20.ProviderModelService {
+func (m *MockDomainServices) ModelInfo() *service21.ProviderModelService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ModelInfo")
-	ret0, _ := ret[0].(*service20.ProviderModelService)
+	ret0, _ := ret[0].(*service21.ProviderModelService)
 	return ret0
 }
 
@@ -1229,28 +1271,28 @@ type MockDomainServicesModelInfoCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesModelInfoCall) Return(arg0 *service20.ProviderModelService) *MockDomainServicesModelInfoCall {
+func (c *MockDomainServicesModelInfoCall) Return(arg0 *service21.ProviderModelService) *MockDomainServicesModelInfoCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesModelInfoCall) Do(f func() *service20.ProviderModelService) *MockDomainServicesModelInfoCall {
+func (c *MockDomainServicesModelInfoCall) Do(f func() *service21.ProviderModelService) *MockDomainServicesModelInfoCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesModelInfoCall) DoAndReturn(f func() *service20.ProviderModelService) *MockDomainServicesModelInfoCall {
+func (c *MockDomainServicesModelInfoCall) DoAndReturn(f func() *service21.ProviderModelService) *MockDomainServicesModelInfoCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ModelMigration mocks base method.
-func (m *MockDomainServices) ModelMigration() *service24.Service {
+func (m *MockDomainServices) ModelMigration() *service25.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ModelMigration")
-	ret0, _ := ret[0].(*service24.Service)
+	ret0, _ := ret[0].(*service25.Service)
 	return ret0
 }
 
@@ -1267,28 +1309,28 @@ type MockDomainServicesModelMigrationCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesModelMigrationCall) Return(arg0 *service24.Service) *MockDomainServicesModelMigrationCall {
+func (c *MockDomainServicesModelMigrationCall) Return(arg0 *service25.Service) *MockDomainServicesModelMigrationCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesModelMigrationCall) Do(f func() *service24.Service) *MockDomainServicesModelMigrationCall {
+func (c *MockDomainServicesModelMigrationCall) Do(f func() *service25.Service) *MockDomainServicesModelMigrationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesModelMigrationCall) DoAndReturn(f func() *service24.Service) *MockDomainServicesModelMigrationCall {
+func (c *MockDomainServicesModelMigrationCall) DoAndReturn(f func() *service25.Service) *MockDomainServicesModelMigrationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ModelProvider mocks base method.
-func (m *MockDomainServices) ModelProvider() *service25.Service {
+func (m *MockDomainServices) ModelProvider() *service26.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ModelProvider")
-	ret0, _ := ret[0].(*service25.Service)
+	ret0, _ := ret[0].(*service26.Service)
 	return ret0
 }
 
@@ -1305,28 +1347,28 @@ type MockDomainServicesModelProviderCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesModelProviderCall) Return(arg0 *service25.Service) *MockDomainServicesModelProviderCall {
+func (c *MockDomainServicesModelProviderCall) Return(arg0 *service26.Service) *MockDomainServicesModelProviderCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesModelProviderCall) Do(f func() *service25.Service) *MockDomainServicesModelProviderCall {
+func (c *MockDomainServicesModelProviderCall) Do(f func() *service26.Service) *MockDomainServicesModelProviderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesModelProviderCall) DoAndReturn(f func() *service25.Service) *MockDomainServicesModelProviderCall {
+func (c *MockDomainServicesModelProviderCall) DoAndReturn(f func() *service26.Service) *MockDomainServicesModelProviderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ModelSecretBackend mocks base method.
-func (m *MockDomainServices) ModelSecretBackend() *service34.ModelSecretBackendService {
+func (m *MockDomainServices) ModelSecretBackend() *service35.ModelSecretBackendService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ModelSecretBackend")
-	ret0, _ := ret[0].(*service34.ModelSecretBackendService)
+	ret0, _ := ret[0].(*service35.ModelSecretBackendService)
 	return ret0
 }
 
@@ -1343,28 +1385,28 @@ type MockDomainServicesModelSecretBackendCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesModelSecretBackendCall) Return(arg0 *service34.ModelSecretBackendService) *MockDomainServicesModelSecretBackendCall {
+func (c *MockDomainServicesModelSecretBackendCall) Return(arg0 *service35.ModelSecretBackendService) *MockDomainServicesModelSecretBackendCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesModelSecretBackendCall) Do(f func() *service34.ModelSecretBackendService) *MockDomainServicesModelSecretBackendCall {
+func (c *MockDomainServicesModelSecretBackendCall) Do(f func() *service35.ModelSecretBackendService) *MockDomainServicesModelSecretBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesModelSecretBackendCall) DoAndReturn(f func() *service34.ModelSecretBackendService) *MockDomainServicesModelSecretBackendCall {
+func (c *MockDomainServicesModelSecretBackendCall) DoAndReturn(f func() *service35.ModelSecretBackendService) *MockDomainServicesModelSecretBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Network mocks base method.
-func (m *MockDomainServices) Network() *service26.WatchableService {
+func (m *MockDomainServices) Network() *service27.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Network")
-	ret0, _ := ret[0].(*service26.WatchableService)
+	ret0, _ := ret[0].(*service27.WatchableService)
 	return ret0
 }
 
@@ -1381,28 +1423,28 @@ type MockDomainServicesNetworkCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesNetworkCall) Return(arg0 *service26.WatchableService) *MockDomainServicesNetworkCall {
+func (c *MockDomainServicesNetworkCall) Return(arg0 *service27.WatchableService) *MockDomainServicesNetworkCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesNetworkCall) Do(f func() *service26.WatchableService) *MockDomainServicesNetworkCall {
+func (c *MockDomainServicesNetworkCall) Do(f func() *service27.WatchableService) *MockDomainServicesNetworkCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesNetworkCall) DoAndReturn(f func() *service26.WatchableService) *MockDomainServicesNetworkCall {
+func (c *MockDomainServicesNetworkCall) DoAndReturn(f func() *service27.WatchableService) *MockDomainServicesNetworkCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Port mocks base method.
-func (m *MockDomainServices) Port() *service27.WatchableService {
+func (m *MockDomainServices) Port() *service28.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Port")
-	ret0, _ := ret[0].(*service27.WatchableService)
+	ret0, _ := ret[0].(*service28.WatchableService)
 	return ret0
 }
 
@@ -1419,28 +1461,28 @@ type MockDomainServicesPortCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesPortCall) Return(arg0 *service27.WatchableService) *MockDomainServicesPortCall {
+func (c *MockDomainServicesPortCall) Return(arg0 *service28.WatchableService) *MockDomainServicesPortCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesPortCall) Do(f func() *service27.WatchableService) *MockDomainServicesPortCall {
+func (c *MockDomainServicesPortCall) Do(f func() *service28.WatchableService) *MockDomainServicesPortCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesPortCall) DoAndReturn(f func() *service27.WatchableService) *MockDomainServicesPortCall {
+func (c *MockDomainServicesPortCall) DoAndReturn(f func() *service28.WatchableService) *MockDomainServicesPortCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Proxy mocks base method.
-func (m *MockDomainServices) Proxy() *service28.Service {
+func (m *MockDomainServices) Proxy() *service29.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Proxy")
-	ret0, _ := ret[0].(*service28.Service)
+	ret0, _ := ret[0].(*service29.Service)
 	return ret0
 }
 
@@ -1457,28 +1499,28 @@ type MockDomainServicesProxyCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesProxyCall) Return(arg0 *service28.Service) *MockDomainServicesProxyCall {
+func (c *MockDomainServicesProxyCall) Return(arg0 *service29.Service) *MockDomainServicesProxyCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesProxyCall) Do(f func() *service28.Service) *MockDomainServicesProxyCall {
+func (c *MockDomainServicesProxyCall) Do(f func() *service29.Service) *MockDomainServicesProxyCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesProxyCall) DoAndReturn(f func() *service28.Service) *MockDomainServicesProxyCall {
+func (c *MockDomainServicesProxyCall) DoAndReturn(f func() *service29.Service) *MockDomainServicesProxyCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Relation mocks base method.
-func (m *MockDomainServices) Relation() *service29.WatchableService {
+func (m *MockDomainServices) Relation() *service30.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Relation")
-	ret0, _ := ret[0].(*service29.WatchableService)
+	ret0, _ := ret[0].(*service30.WatchableService)
 	return ret0
 }
 
@@ -1495,28 +1537,28 @@ type MockDomainServicesRelationCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesRelationCall) Return(arg0 *service29.WatchableService) *MockDomainServicesRelationCall {
+func (c *MockDomainServicesRelationCall) Return(arg0 *service30.WatchableService) *MockDomainServicesRelationCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesRelationCall) Do(f func() *service29.WatchableService) *MockDomainServicesRelationCall {
+func (c *MockDomainServicesRelationCall) Do(f func() *service30.WatchableService) *MockDomainServicesRelationCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesRelationCall) DoAndReturn(f func() *service29.WatchableService) *MockDomainServicesRelationCall {
+func (c *MockDomainServicesRelationCall) DoAndReturn(f func() *service30.WatchableService) *MockDomainServicesRelationCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Removal mocks base method.
-func (m *MockDomainServices) Removal() *service30.WatchableService {
+func (m *MockDomainServices) Removal() *service31.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Removal")
-	ret0, _ := ret[0].(*service30.WatchableService)
+	ret0, _ := ret[0].(*service31.WatchableService)
 	return ret0
 }
 
@@ -1533,28 +1575,28 @@ type MockDomainServicesRemovalCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesRemovalCall) Return(arg0 *service30.WatchableService) *MockDomainServicesRemovalCall {
+func (c *MockDomainServicesRemovalCall) Return(arg0 *service31.WatchableService) *MockDomainServicesRemovalCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesRemovalCall) Do(f func() *service30.WatchableService) *MockDomainServicesRemovalCall {
+func (c *MockDomainServicesRemovalCall) Do(f func() *service31.WatchableService) *MockDomainServicesRemovalCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesRemovalCall) DoAndReturn(f func() *service30.WatchableService) *MockDomainServicesRemovalCall {
+func (c *MockDomainServicesRemovalCall) DoAndReturn(f func() *service31.WatchableService) *MockDomainServicesRemovalCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Resolve mocks base method.
-func (m *MockDomainServices) Resolve() *service31.WatchableService {
+func (m *MockDomainServices) Resolve() *service32.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Resolve")
-	ret0, _ := ret[0].(*service31.WatchableService)
+	ret0, _ := ret[0].(*service32.WatchableService)
 	return ret0
 }
 
@@ -1571,28 +1613,28 @@ type MockDomainServicesResolveCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesResolveCall) Return(arg0 *service31.WatchableService) *MockDomainServicesResolveCall {
+func (c *MockDomainServicesResolveCall) Return(arg0 *service32.WatchableService) *MockDomainServicesResolveCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesResolveCall) Do(f func() *service31.WatchableService) *MockDomainServicesResolveCall {
+func (c *MockDomainServicesResolveCall) Do(f func() *service32.WatchableService) *MockDomainServicesResolveCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesResolveCall) DoAndReturn(f func() *service31.WatchableService) *MockDomainServicesResolveCall {
+func (c *MockDomainServicesResolveCall) DoAndReturn(f func() *service32.WatchableService) *MockDomainServicesResolveCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Resource mocks base method.
-func (m *MockDomainServices) Resource() *service32.Service {
+func (m *MockDomainServices) Resource() *service33.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Resource")
-	ret0, _ := ret[0].(*service32.Service)
+	ret0, _ := ret[0].(*service33.Service)
 	return ret0
 }
 
@@ -1609,28 +1651,142 @@ type MockDomainServicesResourceCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesResourceCall) Return(arg0 *service32.Service) *MockDomainServicesResourceCall {
+func (c *MockDomainServicesResourceCall) Return(arg0 *service33.Service) *MockDomainServicesResourceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesResourceCall) Do(f func() *service33.Service) *MockDomainServicesResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesResourceCall) DoAndReturn(f func() *service33.Service) *MockDomainServicesResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHAccess mocks base method.
+func (m *MockDomainServices) SSHAccess() *service36.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHAccess")
+	ret0, _ := ret[0].(*service36.Service)
+	return ret0
+}
+
+// SSHAccess indicates an expected call of SSHAccess.
+func (mr *MockDomainServicesMockRecorder) SSHAccess() *MockDomainServicesSSHAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHAccess", reflect.TypeOf((*MockDomainServices)(nil).SSHAccess))
+	return &MockDomainServicesSSHAccessCall{Call: call}
+}
+
+// MockDomainServicesSSHAccessCall wrap *gomock.Call
+type MockDomainServicesSSHAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesSSHAccessCall) Return(arg0 *service36.Service) *MockDomainServicesSSHAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesSSHAccessCall) Do(f func() *service36.Service) *MockDomainServicesSSHAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesSSHAccessCall) DoAndReturn(f func() *service36.Service) *MockDomainServicesSSHAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHCA mocks base method.
+func (m *MockDomainServices) SSHCA() *service37.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHCA")
+	ret0, _ := ret[0].(*service37.Service)
+	return ret0
+}
+
+// SSHCA indicates an expected call of SSHCA.
+func (mr *MockDomainServicesMockRecorder) SSHCA() *MockDomainServicesSSHCACall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHCA", reflect.TypeOf((*MockDomainServices)(nil).SSHCA))
+	return &MockDomainServicesSSHCACall{Call: call}
+}
+
+// MockDomainServicesSSHCACall wrap *gomock.Call
+type MockDomainServicesSSHCACall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesSSHCACall) Return(arg0 *service37.Service) *MockDomainServicesSSHCACall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDomainServicesSSHCACall) Do(f func() *service37.Service) *MockDomainServicesSSHCACall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDomainServicesSSHCACall) DoAndReturn(f func() *service37.Service) *MockDomainServicesSSHCACall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHSession mocks base method.
+func (m *MockDomainServices) SSHSession() *service38.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHSession")
+	ret0, _ := ret[0].(*service38.Service)
+	return ret0
+}
+
+// SSHSession indicates an expected call of SSHSession.
+func (mr *MockDomainServicesMockRecorder) SSHSession() *MockDomainServicesSSHSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHSession", reflect.TypeOf((*MockDomainServices)(nil).SSHSession))
+	return &MockDomainServicesSSHSessionCall{Call: call}
+}
+
+// MockDomainServicesSSHSessionCall wrap *gomock.Call
+type MockDomainServicesSSHSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDomainServicesSSHSessionCall) Return(arg0 *service38.Service) *MockDomainServicesSSHSessionCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesResourceCall) Do(f func() *service32.Service) *MockDomainServicesResourceCall {
+func (c *MockDomainServicesSSHSessionCall) Do(f func() *service38.Service) *MockDomainServicesSSHSessionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesResourceCall) DoAndReturn(f func() *service32.Service) *MockDomainServicesResourceCall {
+func (c *MockDomainServicesSSHSessionCall) DoAndReturn(f func() *service38.Service) *MockDomainServicesSSHSessionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Secret mocks base method.
-func (m *MockDomainServices) Secret() *service33.WatchableService {
+func (m *MockDomainServices) Secret() *service34.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Secret")
-	ret0, _ := ret[0].(*service33.WatchableService)
+	ret0, _ := ret[0].(*service34.WatchableService)
 	return ret0
 }
 
@@ -1647,28 +1803,28 @@ type MockDomainServicesSecretCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesSecretCall) Return(arg0 *service33.WatchableService) *MockDomainServicesSecretCall {
+func (c *MockDomainServicesSecretCall) Return(arg0 *service34.WatchableService) *MockDomainServicesSecretCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesSecretCall) Do(f func() *service33.WatchableService) *MockDomainServicesSecretCall {
+func (c *MockDomainServicesSecretCall) Do(f func() *service34.WatchableService) *MockDomainServicesSecretCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesSecretCall) DoAndReturn(f func() *service33.WatchableService) *MockDomainServicesSecretCall {
+func (c *MockDomainServicesSecretCall) DoAndReturn(f func() *service34.WatchableService) *MockDomainServicesSecretCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // SecretBackend mocks base method.
-func (m *MockDomainServices) SecretBackend() *service34.WatchableService {
+func (m *MockDomainServices) SecretBackend() *service35.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SecretBackend")
-	ret0, _ := ret[0].(*service34.WatchableService)
+	ret0, _ := ret[0].(*service35.WatchableService)
 	return ret0
 }
 
@@ -1685,28 +1841,28 @@ type MockDomainServicesSecretBackendCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesSecretBackendCall) Return(arg0 *service34.WatchableService) *MockDomainServicesSecretBackendCall {
+func (c *MockDomainServicesSecretBackendCall) Return(arg0 *service35.WatchableService) *MockDomainServicesSecretBackendCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesSecretBackendCall) Do(f func() *service34.WatchableService) *MockDomainServicesSecretBackendCall {
+func (c *MockDomainServicesSecretBackendCall) Do(f func() *service35.WatchableService) *MockDomainServicesSecretBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesSecretBackendCall) DoAndReturn(f func() *service34.WatchableService) *MockDomainServicesSecretBackendCall {
+func (c *MockDomainServicesSecretBackendCall) DoAndReturn(f func() *service35.WatchableService) *MockDomainServicesSecretBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Status mocks base method.
-func (m *MockDomainServices) Status() *service35.LeadershipService {
+func (m *MockDomainServices) Status() *service39.LeadershipService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Status")
-	ret0, _ := ret[0].(*service35.LeadershipService)
+	ret0, _ := ret[0].(*service39.LeadershipService)
 	return ret0
 }
 
@@ -1723,28 +1879,28 @@ type MockDomainServicesStatusCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesStatusCall) Return(arg0 *service35.LeadershipService) *MockDomainServicesStatusCall {
+func (c *MockDomainServicesStatusCall) Return(arg0 *service39.LeadershipService) *MockDomainServicesStatusCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesStatusCall) Do(f func() *service35.LeadershipService) *MockDomainServicesStatusCall {
+func (c *MockDomainServicesStatusCall) Do(f func() *service39.LeadershipService) *MockDomainServicesStatusCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesStatusCall) DoAndReturn(f func() *service35.LeadershipService) *MockDomainServicesStatusCall {
+func (c *MockDomainServicesStatusCall) DoAndReturn(f func() *service39.LeadershipService) *MockDomainServicesStatusCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Storage mocks base method.
-func (m *MockDomainServices) Storage() *service36.Service {
+func (m *MockDomainServices) Storage() *service40.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Storage")
-	ret0, _ := ret[0].(*service36.Service)
+	ret0, _ := ret[0].(*service40.Service)
 	return ret0
 }
 
@@ -1761,19 +1917,19 @@ type MockDomainServicesStorageCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesStorageCall) Return(arg0 *service36.Service) *MockDomainServicesStorageCall {
+func (c *MockDomainServicesStorageCall) Return(arg0 *service40.Service) *MockDomainServicesStorageCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesStorageCall) Do(f func() *service36.Service) *MockDomainServicesStorageCall {
+func (c *MockDomainServicesStorageCall) Do(f func() *service40.Service) *MockDomainServicesStorageCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesStorageCall) DoAndReturn(f func() *service36.Service) *MockDomainServicesStorageCall {
+func (c *MockDomainServicesStorageCall) DoAndReturn(f func() *service40.Service) *MockDomainServicesStorageCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1817,10 +1973,10 @@ func (c *MockDomainServicesStubCall) DoAndReturn(f func() *stub.StubService) *Mo
 }
 
 // UnitState mocks base method.
-func (m *MockDomainServices) UnitState() *service37.Service {
+func (m *MockDomainServices) UnitState() *service41.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UnitState")
-	ret0, _ := ret[0].(*service37.Service)
+	ret0, _ := ret[0].(*service41.Service)
 	return ret0
 }
 
@@ -1837,28 +1993,28 @@ type MockDomainServicesUnitStateCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesUnitStateCall) Return(arg0 *service37.Service) *MockDomainServicesUnitStateCall {
+func (c *MockDomainServicesUnitStateCall) Return(arg0 *service41.Service) *MockDomainServicesUnitStateCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesUnitStateCall) Do(f func() *service37.Service) *MockDomainServicesUnitStateCall {
+func (c *MockDomainServicesUnitStateCall) Do(f func() *service41.Service) *MockDomainServicesUnitStateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesUnitStateCall) DoAndReturn(f func() *service37.Service) *MockDomainServicesUnitStateCall {
+func (c *MockDomainServicesUnitStateCall) DoAndReturn(f func() *service41.Service) *MockDomainServicesUnitStateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Upgrade mocks base method.
-func (m *MockDomainServices) Upgrade() *service38.WatchableService {
+func (m *MockDomainServices) Upgrade() *service42.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Upgrade")
-	ret0, _ := ret[0].(*service38.WatchableService)
+	ret0, _ := ret[0].(*service42.WatchableService)
 	return ret0
 }
 
@@ -1875,19 +2031,19 @@ type MockDomainServicesUpgradeCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDomainServicesUpgradeCall) Return(arg0 *service38.WatchableService) *MockDomainServicesUpgradeCall {
+func (c *MockDomainServicesUpgradeCall) Return(arg0 *service42.WatchableService) *MockDomainServicesUpgradeCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDomainServicesUpgradeCall) Do(f func() *service38.WatchableService) *MockDomainServicesUpgradeCall {
+func (c *MockDomainServicesUpgradeCall) Do(f func() *service42.WatchableService) *MockDomainServicesUpgradeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDomainServicesUpgradeCall) DoAndReturn(f func() *service38.WatchableService) *MockDomainServicesUpgradeCall {
+func (c *MockDomainServicesUpgradeCall) DoAndReturn(f func() *service42.WatchableService) *MockDomainServicesUpgradeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
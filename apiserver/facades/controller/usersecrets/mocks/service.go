@@ -15,6 +15,7 @@ import (
 
 	secrets "github.com/juju/juju/core/secrets"
 	watcher "github.com/juju/juju/core/watcher"
+	service "github.com/juju/juju/domain/secret/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -118,6 +119,83 @@ func (c *MockSecretServiceGetSecretCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
+// GetUserSecretGenerator mocks base method.
+func (m *MockSecretService) GetUserSecretGenerator(arg0 context.Context, arg1 *secrets.URI) (*secrets.GeneratorConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSecretGenerator", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.GeneratorConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSecretGenerator indicates an expected call of GetUserSecretGenerator.
+func (mr *MockSecretServiceMockRecorder) GetUserSecretGenerator(arg0, arg1 any) *MockSecretServiceGetUserSecretGeneratorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSecretGenerator", reflect.TypeOf((*MockSecretService)(nil).GetUserSecretGenerator), arg0, arg1)
+	return &MockSecretServiceGetUserSecretGeneratorCall{Call: call}
+}
+
+// MockSecretServiceGetUserSecretGeneratorCall wrap *gomock.Call
+type MockSecretServiceGetUserSecretGeneratorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetUserSecretGeneratorCall) Return(arg0 *secrets.GeneratorConfig, arg1 error) *MockSecretServiceGetUserSecretGeneratorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetUserSecretGeneratorCall) Do(f func(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error)) *MockSecretServiceGetUserSecretGeneratorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetUserSecretGeneratorCall) DoAndReturn(f func(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error)) *MockSecretServiceGetUserSecretGeneratorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RotateUserSecret mocks base method.
+func (m *MockSecretService) RotateUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 service.RotateUserSecretParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateUserSecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateUserSecret indicates an expected call of RotateUserSecret.
+func (mr *MockSecretServiceMockRecorder) RotateUserSecret(arg0, arg1, arg2 any) *MockSecretServiceRotateUserSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateUserSecret", reflect.TypeOf((*MockSecretService)(nil).RotateUserSecret), arg0, arg1, arg2)
+	return &MockSecretServiceRotateUserSecretCall{Call: call}
+}
+
+// MockSecretServiceRotateUserSecretCall wrap *gomock.Call
+type MockSecretServiceRotateUserSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceRotateUserSecretCall) Return(arg0 error) *MockSecretServiceRotateUserSecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceRotateUserSecretCall) Do(f func(context.Context, *secrets.URI, service.RotateUserSecretParams) error) *MockSecretServiceRotateUserSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceRotateUserSecretCall) DoAndReturn(f func(context.Context, *secrets.URI, service.RotateUserSecretParams) error) *MockSecretServiceRotateUserSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchObsoleteUserSecretsToPrune mocks base method.
 func (m *MockSecretService) WatchObsoleteUserSecretsToPrune(arg0 context.Context) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -156,3 +234,42 @@ func (c *MockSecretServiceWatchObsoleteUserSecretsToPruneCall) DoAndReturn(f fun
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// WatchUserSecretsRotationChanges mocks base method.
+func (m *MockSecretService) WatchUserSecretsRotationChanges(arg0 context.Context) (watcher.Watcher[[]watcher.SecretTriggerChange], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUserSecretsRotationChanges", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]watcher.SecretTriggerChange])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchUserSecretsRotationChanges indicates an expected call of WatchUserSecretsRotationChanges.
+func (mr *MockSecretServiceMockRecorder) WatchUserSecretsRotationChanges(arg0 any) *MockSecretServiceWatchUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserSecretsRotationChanges", reflect.TypeOf((*MockSecretService)(nil).WatchUserSecretsRotationChanges), arg0)
+	return &MockSecretServiceWatchUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockSecretServiceWatchUserSecretsRotationChangesCall wrap *gomock.Call
+type MockSecretServiceWatchUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceWatchUserSecretsRotationChangesCall) Return(arg0 watcher.Watcher[[]watcher.SecretTriggerChange], arg1 error) *MockSecretServiceWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceWatchUserSecretsRotationChangesCall) Do(f func(context.Context) (watcher.Watcher[[]watcher.SecretTriggerChange], error)) *MockSecretServiceWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceWatchUserSecretsRotationChangesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]watcher.SecretTriggerChange], error)) *MockSecretServiceWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -13,7 +13,7 @@ import (
 )
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/service.go github.com/juju/juju/apiserver/facades/controller/usersecrets SecretService
-//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/watcher.go github.com/juju/juju/core/watcher NotifyWatcher
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/watcher.go github.com/juju/juju/core/watcher NotifyWatcher,SecretTriggerWatcher
 
 func TestPackage(t *testing.T) {
 	gc.TestingT(t)
@@ -23,11 +23,13 @@ func NewTestAPI(
 	authorizer facade.Authorizer,
 	watcherRegistry facade.WatcherRegistry,
 	secretService SecretService,
+	modelUUID string,
 ) (*UserSecretsManager, error) {
 	if !authorizer.AuthController() {
 		return nil, apiservererrors.ErrPerm
 	}
 	return &UserSecretsManager{
+		modelUUID:       modelUUID,
 		secretService:   secretService,
 		watcherRegistry: watcherRegistry,
 	}, nil
@@ -13,7 +13,7 @@ import (
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
-	registry.MustRegister("UserSecretsManager", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+	registry.MustRegister("UserSecretsManager", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return NewUserSecretsManager(stdCtx, ctx)
 	}, reflect.TypeOf((*UserSecretsManager)(nil)))
 }
@@ -25,6 +25,7 @@ func NewUserSecretsManager(stdCtx context.Context, ctx facade.ModelContext) (*Us
 	}
 	domainServices := ctx.DomainServices()
 	return &UserSecretsManager{
+		modelUUID:       ctx.ModelUUID().String(),
 		watcherRegistry: ctx.WatcherRegistry(),
 		secretService:   domainServices.Secret(),
 	}, nil
@@ -11,11 +11,16 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/internal"
+	coresecrets "github.com/juju/juju/core/secrets"
+	corewatcher "github.com/juju/juju/core/watcher"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	"github.com/juju/juju/rpc/params"
 )
 
 // UserSecretsManager is the implementation for the usersecrets facade.
 type UserSecretsManager struct {
+	modelUUID       string
 	watcherRegistry facade.WatcherRegistry
 	secretService   SecretService
 }
@@ -38,3 +43,80 @@ func (s *UserSecretsManager) WatchRevisionsToPrune(ctx context.Context) (params.
 func (s *UserSecretsManager) DeleteObsoleteUserSecretRevisions(ctx context.Context) error {
 	return s.secretService.DeleteObsoleteUserSecretRevisions(ctx)
 }
+
+// WatchUserSecretsRotationChanges returns a watcher for notifying when
+// the rotation time of a user secret changes.
+func (s *UserSecretsManager) WatchUserSecretsRotationChanges(ctx context.Context) (params.SecretTriggerWatchResult, error) {
+	result := params.SecretTriggerWatchResult{}
+	w, err := s.secretService.WatchUserSecretsRotationChanges(ctx)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	id, secretChanges, err := internal.EnsureRegisterWatcher[[]corewatcher.SecretTriggerChange](ctx, s.watcherRegistry, w)
+	if err != nil {
+		result.Error = apiservererrors.ServerError(err)
+		return result, nil
+	}
+	changes := make([]params.SecretTriggerChange, len(secretChanges))
+	for i, c := range secretChanges {
+		changes[i] = params.SecretTriggerChange{
+			URI:             c.URI.ID,
+			NextTriggerTime: c.NextTriggerTime,
+		}
+	}
+	result.WatcherId = id
+	result.Changes = changes
+	return result, nil
+}
+
+// GetUserSecretGenerators returns the generators used to create
+// new content for the specified user secrets when they are rotated.
+func (s *UserSecretsManager) GetUserSecretGenerators(ctx context.Context, args params.Entities) (params.SecretGeneratorResults, error) {
+	result := params.SecretGeneratorResults{
+		Results: make([]params.SecretGeneratorResult, len(args.Entities)),
+	}
+	for i, arg := range args.Entities {
+		uri, err := coresecrets.ParseURI(arg.Tag)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		cfg, err := s.secretService.GetUserSecretGenerator(ctx, uri)
+		if errors.Is(err, secreterrors.SecretGeneratorNotFound) {
+			// The generator has been removed so there's
+			// nothing left to rotate the secret.
+			err = errors.NotFoundf("generator for secret %q", uri.ID)
+		}
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		result.Results[i].Kind = string(cfg.Kind)
+		result.Results[i].Params = cfg.Params
+	}
+	return result, nil
+}
+
+// RotateUserSecrets saves newly generated content for the specified
+// user secrets and schedules their next rotation.
+func (s *UserSecretsManager) RotateUserSecrets(ctx context.Context, args params.RotateUserSecretArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Args)),
+	}
+	for i, arg := range args.Args {
+		uri, err := coresecrets.ParseURI(arg.URI)
+		if err != nil {
+			result.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = s.secretService.RotateUserSecret(ctx, uri, secretservice.RotateUserSecretParams{
+			Accessor: secretservice.SecretAccessor{
+				Kind: secretservice.ModelAccessor,
+				ID:   s.modelUUID,
+			},
+			Data: arg.Content.Data,
+		})
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
@@ -5,6 +5,7 @@ package usersecrets_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -14,6 +15,11 @@ import (
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
 	"github.com/juju/juju/apiserver/facades/controller/usersecrets"
 	"github.com/juju/juju/apiserver/facades/controller/usersecrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	corewatcher "github.com/juju/juju/core/watcher"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -42,7 +48,7 @@ func (s *userSecretsSuite) setup(c *gc.C) *gomock.Controller {
 	s.authorizer.EXPECT().AuthController().Return(true)
 
 	var err error
-	s.facade, err = usersecrets.NewTestAPI(s.authorizer, s.watcherRegistry, s.secretService)
+	s.facade, err = usersecrets.NewTestAPI(s.authorizer, s.watcherRegistry, s.secretService, coretesting.ModelTag.Id())
 	c.Assert(err, jc.ErrorIsNil)
 	return ctrl
 }
@@ -71,3 +77,82 @@ func (s *userSecretsSuite) TestDeleteRevisionsAutoPruneEnabled(c *gc.C) {
 	err := s.facade.DeleteObsoleteUserSecretRevisions(context.Background())
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *userSecretsSuite) TestWatchUserSecretsRotationChanges(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	next := time.Now().Add(time.Hour)
+	w := mocks.NewMockSecretTriggerWatcher(gomock.NewController(c))
+	s.secretService.EXPECT().WatchUserSecretsRotationChanges(gomock.Any()).Return(w, nil)
+	ch := make(chan []corewatcher.SecretTriggerChange, 1)
+	ch <- []corewatcher.SecretTriggerChange{{
+		URI:             uri,
+		Revision:        1,
+		NextTriggerTime: next,
+	}}
+	w.EXPECT().Changes().Return(ch)
+
+	s.watcherRegistry.EXPECT().Register(gomock.Any()).Return("watcher-id", nil)
+
+	result, err := s.facade.WatchUserSecretsRotationChanges(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.SecretTriggerWatchResult{
+		WatcherId: "watcher-id",
+		Changes: []params.SecretTriggerChange{{
+			URI:             uri.ID,
+			NextTriggerTime: next,
+		}},
+	})
+}
+
+func (s *userSecretsSuite) TestGetUserSecretGenerators(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	uri2 := coresecrets.NewURI()
+	s.secretService.EXPECT().GetUserSecretGenerator(gomock.Any(), uri).Return(&coresecrets.GeneratorConfig{
+		Kind:   coresecrets.GeneratorPassword,
+		Params: map[string]string{"length": "32"},
+	}, nil)
+	s.secretService.EXPECT().GetUserSecretGenerator(gomock.Any(), uri2).Return(nil, secreterrors.SecretGeneratorNotFound)
+	uri3 := coresecrets.NewURI()
+	s.secretService.EXPECT().GetUserSecretGenerator(gomock.Any(), uri3).Return(nil, secreterrors.SecretNotFound)
+
+	result, err := s.facade.GetUserSecretGenerators(context.Background(), params.Entities{
+		Entities: []params.Entity{{Tag: uri.String()}, {Tag: uri2.String()}, {Tag: uri3.String()}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Assert(result.Results[0], jc.DeepEquals, params.SecretGeneratorResult{
+		Kind:   "password",
+		Params: map[string]string{"length": "32"},
+	})
+	c.Assert(result.Results[1].Error, jc.Satisfies, params.IsCodeNotFound)
+	c.Assert(result.Results[1].Error, gc.ErrorMatches, `generator for secret ".*" not found`)
+	c.Assert(result.Results[2].Error, jc.Satisfies, params.IsCodeSecretNotFound)
+}
+
+func (s *userSecretsSuite) TestRotateUserSecrets(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().RotateUserSecret(gomock.Any(), uri, secretservice.RotateUserSecretParams{
+		Accessor: secretservice.SecretAccessor{
+			Kind: secretservice.ModelAccessor,
+			ID:   coretesting.ModelTag.Id(),
+		},
+		Data: map[string]string{"password": "c2VjcmV0"},
+	}).Return(nil)
+
+	result, err := s.facade.RotateUserSecrets(context.Background(), params.RotateUserSecretArgs{
+		Args: []params.RotateUserSecretArg{{
+			URI:     uri.String(),
+			Content: params.SecretContentParams{Data: map[string]string{"password": "c2VjcmV0"}},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+}
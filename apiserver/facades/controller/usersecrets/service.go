@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/core/watcher"
+	secretservice "github.com/juju/juju/domain/secret/service"
 )
 
 // SecretService instances provide secret apis.
@@ -15,4 +16,7 @@ type SecretService interface {
 	GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error)
 	DeleteObsoleteUserSecretRevisions(ctx context.Context) error
 	WatchObsoleteUserSecretsToPrune(ctx context.Context) (watcher.NotifyWatcher, error)
+	WatchUserSecretsRotationChanges(ctx context.Context) (watcher.SecretTriggerWatcher, error)
+	GetUserSecretGenerator(ctx context.Context, uri *secrets.URI) (*secrets.GeneratorConfig, error)
+	RotateUserSecret(ctx context.Context, uri *secrets.URI, params secretservice.RotateUserSecretParams) error
 }
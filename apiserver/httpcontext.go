@@ -275,3 +275,43 @@ func (a controllerAdminAuthorizer) Authorize(ctx context.Context, authInfo authe
 	}
 	return nil
 }
+
+// modelAdminAuthorizer authorizes users that are either controller
+// superusers or admins of the model the request is for.
+type modelAdminAuthorizer struct {
+	controllerTag names.Tag
+}
+
+// Authorize is part of the httpcontext.Authorizer interface.
+func (a modelAdminAuthorizer) Authorize(ctx context.Context, authInfo authentication.AuthInfo) error {
+	userTag, ok := authInfo.Entity.Tag().(names.UserTag)
+	if !ok {
+		return errors.Errorf("%s is not a user", names.ReadableString(authInfo.Entity.Tag()))
+	}
+	modelUUID, ok := httpcontext.RequestModelUUID(ctx)
+	if !ok {
+		return errors.New("no model uuid for request")
+	}
+
+	hasPermission := func(ctx context.Context, userName user.Name, subject permission.ID) (permission.Access, error) {
+		if userName.Name() != userTag.Id() {
+			return permission.NoAccess, fmt.Errorf("expected user %q got %q", userTag.String(), userName)
+		}
+		return authInfo.SubjectPermissions(ctx, subject)
+	}
+	isSuperuser, err := common.HasPermission(ctx, hasPermission, userTag, permission.SuperuserAccess, a.controllerTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if isSuperuser {
+		return nil
+	}
+	isAdmin, err := common.HasPermission(ctx, hasPermission, userTag, permission.AdminAccess, names.NewModelTag(modelUUID))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !isAdmin {
+		return errors.Errorf("%s is not a model admin", names.ReadableString(authInfo.Entity.Tag()))
+	}
+	return nil
+}
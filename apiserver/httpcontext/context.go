@@ -21,3 +21,18 @@ func EntityForContext(ctx context.Context) string {
 
 	return authInfo.Entity.Tag().Id()
 }
+
+type remoteAddressKey struct{}
+
+// SetContextRemoteAddress is responsible for taking the address a request came
+// from and setting it on the supplied context.
+func SetContextRemoteAddress(ctx context.Context, address string) context.Context {
+	return context.WithValue(ctx, remoteAddressKey{}, address)
+}
+
+// RequestRemoteAddress returns the address the request associated with the
+// given context came from.
+func RequestRemoteAddress(ctx context.Context) (string, bool) {
+	address, ok := ctx.Value(remoteAddressKey{}).(string)
+	return address, ok
+}
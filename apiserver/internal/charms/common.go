@@ -237,6 +237,7 @@ func convertCharmMeta(meta *charm.Meta) *params.CharmMeta {
 		Containers:     convertCharmContainers(meta.Containers),
 		AssumesExpr:    meta.Assumes,
 		CharmUser:      string(meta.CharmUser),
+		DeploymentType: string(meta.DeploymentType),
 	}
 }
 
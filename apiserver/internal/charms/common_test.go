@@ -70,7 +70,8 @@ func (s *exportSuite) TestExport(c *gc.C) {
 				SubExpressions: []assumes.Expression{},
 			},
 		},
-		CharmUser: internalcharm.RunAsNonRoot,
+		CharmUser:      internalcharm.RunAsNonRoot,
+		DeploymentType: internalcharm.DeploymentDaemon,
 	}
 	manifest := &internalcharm.Manifest{
 		Bases: []internalcharm.Base{
@@ -161,7 +162,8 @@ func (s *exportSuite) TestExport(c *gc.C) {
 					SubExpressions: []assumes.Expression{},
 				},
 			},
-			CharmUser: "non-root",
+			CharmUser:      "non-root",
+			DeploymentType: "daemon",
 		},
 		Actions: &params.CharmActions{
 			ActionSpecs: map[string]params.CharmActionSpec{
@@ -0,0 +1,7 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshsessions provides handlers for downloading the recordings of
+// SSH sessions made through the controller.
+
+package sshsessions
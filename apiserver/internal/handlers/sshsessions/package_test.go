@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshsessions
+
+import (
+	"testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshsessions -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/sshsessions SSHSessionServiceGetter,SSHSessionService
+
+func TestPackage(t *testing.T) {
+	gc.TestingT(t)
+}
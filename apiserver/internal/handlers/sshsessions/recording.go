@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshsessions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	jujuerrors "github.com/juju/errors"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/httpcontext"
+	internalhttp "github.com/juju/juju/apiserver/internal/http"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/sshsession"
+	sshsessionerrors "github.com/juju/juju/domain/sshsession/errors"
+	"github.com/juju/juju/internal/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/rpc/params"
+)
+
+var logger = internallogger.GetLogger("juju.apiserver.sshsessions")
+
+// RecordingContentType is the content type of an SSH session recording.
+const RecordingContentType = "application/x-asciicast"
+
+// SSHSessionService is an interface that provides access to the recordings
+// of SSH sessions.
+type SSHSessionService interface {
+	// GetSessionRecording returns the session with the given UUID in the
+	// given model, along with a reader for its recording.
+	GetSessionRecording(ctx context.Context, modelUUID coremodel.UUID, sessionUUID string) (sshsession.Session, io.ReadCloser, error)
+}
+
+// SSHSessionServiceGetter is an interface that provides a method to get an
+// SSH session service.
+type SSHSessionServiceGetter interface {
+	SSHSession(*http.Request) (SSHSessionService, error)
+}
+
+// RecordingHTTPHandler implements the http.Handler interface for downloading
+// SSH session recordings.
+type RecordingHTTPHandler struct {
+	sshSessionGetter SSHSessionServiceGetter
+}
+
+// NewRecordingHTTPHandler returns a new RecordingHTTPHandler.
+func NewRecordingHTTPHandler(
+	sshSessionGetter SSHSessionServiceGetter,
+) *RecordingHTTPHandler {
+	return &RecordingHTTPHandler{
+		sshSessionGetter: sshSessionGetter,
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *RecordingHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var err error
+	switch r.Method {
+	case "GET":
+		err = h.ServeGet(w, r)
+		if err != nil {
+			err = errors.Errorf("cannot retrieve ssh session recording: %w", err)
+		}
+	default:
+		http.Error(w, fmt.Sprintf("http method %s not implemented", r.Method), http.StatusNotImplemented)
+		return
+	}
+
+	if err == nil {
+		return
+	}
+
+	if err := sendJSONError(w, errors.Capture(err)); err != nil {
+		logger.Errorf(r.Context(), "%v", errors.Errorf("cannot return error to user: %w", err))
+	}
+}
+
+// ServeGet streams the recording of the requested SSH session to the
+// response. The recording is copied directly from the object store, so it is
+// never held in memory in full.
+func (h *RecordingHTTPHandler) ServeGet(w http.ResponseWriter, r *http.Request) error {
+	modelUUID, ok := httpcontext.RequestModelUUID(r.Context())
+	if !ok {
+		return jujuerrors.BadRequestf("missing model uuid")
+	}
+
+	sessionUUID := r.URL.Query().Get(":session")
+	if sessionUUID == "" {
+		return jujuerrors.BadRequestf("missing ssh session uuid")
+	}
+
+	service, err := h.sshSessionGetter.SSHSession(r)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	_, reader, err := service.GetSessionRecording(r.Context(), coremodel.UUID(modelUUID), sessionUUID)
+	if errors.Is(err, sshsessionerrors.SessionNotFound) {
+		return jujuerrors.NotFoundf("ssh session %q", sessionUUID)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	defer reader.Close()
+
+	w.Header().Set("Content-Type", RecordingContentType)
+	if _, err := io.Copy(w, reader); err != nil {
+		// The headers have already been sent, so the best we can do is log
+		// the failure; the client will see a truncated recording.
+		logger.Errorf(r.Context(), "streaming recording of ssh session %q: %v", sessionUUID, err)
+	}
+	return nil
+}
+
+// sendJSONError sends a JSON-encoded error response.
+func sendJSONError(w http.ResponseWriter, err error) error {
+	perr, status := apiservererrors.ServerErrorAndStatus(err)
+	return errors.Capture(internalhttp.SendStatusAndJSON(w, status, &params.ErrorResult{
+		Error: perr,
+	}))
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshsessions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gomock "go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/apiserverhttp"
+	"github.com/juju/juju/apiserver/httpcontext"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/sshsession"
+	sshsessionerrors "github.com/juju/juju/domain/sshsession/errors"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	recordingRoutePrefix = "/model/:modeluuid/ssh-sessions/:session/recording"
+)
+
+type recordingHandlerSuite struct {
+	sshSessionGetter  *MockSSHSessionServiceGetter
+	sshSessionService *MockSSHSessionService
+
+	mux *apiserverhttp.Mux
+	srv *httptest.Server
+}
+
+var _ = gc.Suite(&recordingHandlerSuite{})
+
+func (s *recordingHandlerSuite) SetUpTest(c *gc.C) {
+	s.mux = apiserverhttp.NewMux()
+	s.srv = httptest.NewServer(s.mux)
+}
+
+func (s *recordingHandlerSuite) TearDownTest(c *gc.C) {
+	s.srv.Close()
+}
+
+func (s *recordingHandlerSuite) TestServeMethodNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.addHandler("POST")
+	defer s.mux.RemoveHandler("POST", recordingRoutePrefix)
+
+	resp, err := http.Post(s.recordingURL("session-uuid"), "application/octet-stream", strings.NewReader("recording"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(resp.StatusCode, gc.Equals, http.StatusNotImplemented)
+}
+
+func (s *recordingHandlerSuite) TestServeGet(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.sshSessionGetter.EXPECT().SSHSession(gomock.Any()).Return(s.sshSessionService, nil)
+	reader := io.NopCloser(strings.NewReader("recording-content"))
+	s.sshSessionService.EXPECT().GetSessionRecording(gomock.Any(), coremodel.UUID(testing.ModelTag.Id()), "session-uuid").
+		Return(sshsession.Session{UUID: "session-uuid", Size: 17}, reader, nil)
+
+	s.addHandler("GET")
+	defer s.mux.RemoveHandler("GET", recordingRoutePrefix)
+
+	resp, err := http.Get(s.recordingURL("session-uuid"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer resp.Body.Close()
+
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusOK)
+	c.Check(resp.Header.Get("Content-Type"), gc.Equals, RecordingContentType)
+	body, err := io.ReadAll(resp.Body)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(body), gc.Equals, "recording-content")
+}
+
+func (s *recordingHandlerSuite) TestServeGetNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.sshSessionGetter.EXPECT().SSHSession(gomock.Any()).Return(s.sshSessionService, nil)
+	s.sshSessionService.EXPECT().GetSessionRecording(gomock.Any(), coremodel.UUID(testing.ModelTag.Id()), "session-uuid").
+		Return(sshsession.Session{}, nil, sshsessionerrors.SessionNotFound)
+
+	s.addHandler("GET")
+	defer s.mux.RemoveHandler("GET", recordingRoutePrefix)
+
+	resp, err := http.Get(s.recordingURL("session-uuid"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer resp.Body.Close()
+
+	c.Assert(resp.StatusCode, gc.Equals, http.StatusNotFound)
+	c.Check(resp.Header.Get("Content-Type"), gc.Equals, "application/json")
+	var result params.ErrorResult
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.NotNil)
+	c.Check(result.Error.Code, gc.Equals, params.CodeNotFound)
+	c.Check(result.Error.Message, gc.Matches, `.*ssh session "session-uuid" not found`)
+}
+
+func (s *recordingHandlerSuite) addHandler(method string) {
+	handler := &httpcontext.QueryModelHandler{
+		Handler: &RecordingHTTPHandler{
+			sshSessionGetter: s.sshSessionGetter,
+		},
+		Query: ":modeluuid",
+	}
+	s.mux.AddHandler(method, recordingRoutePrefix, handler)
+}
+
+func (s *recordingHandlerSuite) recordingURL(sessionUUID string) string {
+	return fmt.Sprintf("%s/model/%s/ssh-sessions/%s/recording", s.srv.URL, testing.ModelTag.Id(), sessionUUID)
+}
+
+func (s *recordingHandlerSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.sshSessionGetter = NewMockSSHSessionServiceGetter(ctrl)
+	s.sshSessionService = NewMockSSHSessionService(ctrl)
+
+	return ctrl
+}
@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/handlers/sshsessions (interfaces: SSHSessionServiceGetter,SSHSessionService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package sshsessions -destination service_mock_test.go github.com/juju/juju/apiserver/internal/handlers/sshsessions SSHSessionServiceGetter,SSHSessionService
+//
+
+// Package sshsessions is a generated GoMock package.
+package sshsessions
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+	reflect "reflect"
+
+	model "github.com/juju/juju/core/model"
+	sshsession "github.com/juju/juju/domain/sshsession"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSSHSessionServiceGetter is a mock of SSHSessionServiceGetter interface.
+type MockSSHSessionServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHSessionServiceGetterMockRecorder
+}
+
+// MockSSHSessionServiceGetterMockRecorder is the mock recorder for MockSSHSessionServiceGetter.
+type MockSSHSessionServiceGetterMockRecorder struct {
+	mock *MockSSHSessionServiceGetter
+}
+
+// NewMockSSHSessionServiceGetter creates a new mock instance.
+func NewMockSSHSessionServiceGetter(ctrl *gomock.Controller) *MockSSHSessionServiceGetter {
+	mock := &MockSSHSessionServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockSSHSessionServiceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHSessionServiceGetter) EXPECT() *MockSSHSessionServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// SSHSession mocks base method.
+func (m *MockSSHSessionServiceGetter) SSHSession(arg0 *http.Request) (SSHSessionService, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHSession", arg0)
+	ret0, _ := ret[0].(SSHSessionService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SSHSession indicates an expected call of SSHSession.
+func (mr *MockSSHSessionServiceGetterMockRecorder) SSHSession(arg0 any) *MockSSHSessionServiceGetterSSHSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHSession", reflect.TypeOf((*MockSSHSessionServiceGetter)(nil).SSHSession), arg0)
+	return &MockSSHSessionServiceGetterSSHSessionCall{Call: call}
+}
+
+// MockSSHSessionServiceGetterSSHSessionCall wrap *gomock.Call
+type MockSSHSessionServiceGetterSSHSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHSessionServiceGetterSSHSessionCall) Return(arg0 SSHSessionService, arg1 error) *MockSSHSessionServiceGetterSSHSessionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHSessionServiceGetterSSHSessionCall) Do(f func(*http.Request) (SSHSessionService, error)) *MockSSHSessionServiceGetterSSHSessionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHSessionServiceGetterSSHSessionCall) DoAndReturn(f func(*http.Request) (SSHSessionService, error)) *MockSSHSessionServiceGetterSSHSessionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSSHSessionService is a mock of SSHSessionService interface.
+type MockSSHSessionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHSessionServiceMockRecorder
+}
+
+// MockSSHSessionServiceMockRecorder is the mock recorder for MockSSHSessionService.
+type MockSSHSessionServiceMockRecorder struct {
+	mock *MockSSHSessionService
+}
+
+// NewMockSSHSessionService creates a new mock instance.
+func NewMockSSHSessionService(ctrl *gomock.Controller) *MockSSHSessionService {
+	mock := &MockSSHSessionService{ctrl: ctrl}
+	mock.recorder = &MockSSHSessionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHSessionService) EXPECT() *MockSSHSessionServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSessionRecording mocks base method.
+func (m *MockSSHSessionService) GetSessionRecording(arg0 context.Context, arg1 model.UUID, arg2 string) (sshsession.Session, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionRecording", arg0, arg1, arg2)
+	ret0, _ := ret[0].(sshsession.Session)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSessionRecording indicates an expected call of GetSessionRecording.
+func (mr *MockSSHSessionServiceMockRecorder) GetSessionRecording(arg0, arg1, arg2 any) *MockSSHSessionServiceGetSessionRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionRecording", reflect.TypeOf((*MockSSHSessionService)(nil).GetSessionRecording), arg0, arg1, arg2)
+	return &MockSSHSessionServiceGetSessionRecordingCall{Call: call}
+}
+
+// MockSSHSessionServiceGetSessionRecordingCall wrap *gomock.Call
+type MockSSHSessionServiceGetSessionRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHSessionServiceGetSessionRecordingCall) Return(arg0 sshsession.Session, arg1 io.ReadCloser, arg2 error) *MockSSHSessionServiceGetSessionRecordingCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHSessionServiceGetSessionRecordingCall) Do(f func(context.Context, model.UUID, string) (sshsession.Session, io.ReadCloser, error)) *MockSSHSessionServiceGetSessionRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHSessionServiceGetSessionRecordingCall) DoAndReturn(f func(context.Context, model.UUID, string) (sshsession.Session, io.ReadCloser, error)) *MockSSHSessionServiceGetSessionRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,157 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package ratelimiter provides the token buckets used by the API server to
+// rate limit the API requests of users, so that a single user or model can
+// not degrade the controller for everyone else.
+package ratelimiter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/ratelimit"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+)
+
+// pruneThreshold is the number of buckets of a kind above which full
+// buckets, which belong to idle users or models, are discarded.
+const pruneThreshold = 1024
+
+// Config holds the size of the token buckets and the interval at which
+// tokens are added to them. A size of 0 disables a limit.
+type Config struct {
+	// UserMax and UserRate limit the API requests of each user.
+	UserMax  int
+	UserRate time.Duration
+
+	// ModelMax and ModelRate limit the API requests of all users to each
+	// model.
+	ModelMax  int
+	ModelRate time.Duration
+
+	// ExpensiveMax and ExpensiveRate limit the expensive API requests of
+	// each user, in addition to the other limits.
+	ExpensiveMax  int
+	ExpensiveRate time.Duration
+}
+
+// Limiter rate limits API requests with a token bucket per user, per model
+// and per user for expensive requests.
+type Limiter struct {
+	clock rateClock
+
+	mu        sync.Mutex
+	config    Config
+	users     bucketSet
+	models    bucketSet
+	expensive bucketSet
+}
+
+// New returns a Limiter using the clock, which does not limit requests
+// until it is configured with Update.
+func New(clock clock.Clock) *Limiter {
+	return &Limiter{
+		clock: rateClock{Clock: clock},
+	}
+}
+
+// Update configures the limits. The buckets are reset if the limits
+// change.
+func (l *Limiter) Update(config Config) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if config == l.config {
+		return
+	}
+	l.config = config
+	l.users = newBucketSet(config.UserMax, config.UserRate)
+	l.models = newBucketSet(config.ModelMax, config.ModelRate)
+	l.expensive = newBucketSet(config.ExpensiveMax, config.ExpensiveRate)
+}
+
+// Take takes a token for an API request of the user to the model, which is
+// empty for requests to the controller. It returns a
+// [apiservererrors.RateLimitExceededError] without taking any tokens if a
+// limit is exceeded.
+func (l *Limiter) Take(user, model string, expensive bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var (
+		take       []*ratelimit.Bucket
+		retryAfter time.Duration
+	)
+	check := func(set *bucketSet, key string) {
+		bucket := set.get(l.clock, key)
+		if bucket == nil {
+			return
+		}
+		if bucket.Available() < 1 {
+			retryAfter = max(retryAfter, set.rate)
+			return
+		}
+		take = append(take, bucket)
+	}
+	check(&l.users, user)
+	check(&l.models, model)
+	if expensive {
+		check(&l.expensive, user)
+	}
+	if retryAfter > 0 {
+		return apiservererrors.NewRateLimitExceededError(retryAfter)
+	}
+	for _, bucket := range take {
+		bucket.TakeAvailable(1)
+	}
+	return nil
+}
+
+// bucketSet holds a token bucket per key.
+type bucketSet struct {
+	max     int64
+	rate    time.Duration
+	buckets map[string]*ratelimit.Bucket
+}
+
+func newBucketSet(max int, rate time.Duration) bucketSet {
+	if max <= 0 || rate <= 0 {
+		return bucketSet{}
+	}
+	return bucketSet{
+		max:     int64(max),
+		rate:    rate,
+		buckets: make(map[string]*ratelimit.Bucket),
+	}
+}
+
+// get returns the bucket for the key, or nil if the limit is disabled or
+// the key is empty.
+func (s *bucketSet) get(clock rateClock, key string) *ratelimit.Bucket {
+	if s.buckets == nil || key == "" {
+		return nil
+	}
+	if bucket, ok := s.buckets[key]; ok {
+		return bucket
+	}
+	if len(s.buckets) >= pruneThreshold {
+		for k, bucket := range s.buckets {
+			if bucket.Available() >= bucket.Capacity() {
+				delete(s.buckets, k)
+			}
+		}
+	}
+	bucket := ratelimit.NewBucketWithClock(s.rate, s.max, clock)
+	s.buckets[key] = bucket
+	return bucket
+}
+
+// rateClock adapts a clock for use by the token buckets, which never wait.
+type rateClock struct {
+	clock.Clock
+}
+
+// Sleep is a no-op, as tokens are only taken when available.
+func (rateClock) Sleep(time.Duration) {}
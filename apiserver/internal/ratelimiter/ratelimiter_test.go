@@ -0,0 +1,136 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ratelimiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+)
+
+type limiterSuite struct {
+	clock *testclock.Clock
+}
+
+var _ = gc.Suite(&limiterSuite{})
+
+func (s *limiterSuite) SetUpTest(c *gc.C) {
+	s.clock = testclock.NewClock(time.Now())
+}
+
+func (s *limiterSuite) assertRateLimited(c *gc.C, err error, retryAfter time.Duration) {
+	rateLimitErr, ok := errors.AsType[*apiservererrors.RateLimitExceededError](err)
+	c.Assert(ok, jc.IsTrue, gc.Commentf("unexpected error %v", err))
+	c.Check(rateLimitErr.RetryAfter, gc.Equals, retryAfter)
+}
+
+func (s *limiterSuite) TestNotConfigured(c *gc.C) {
+	limiter := New(s.clock)
+	for i := 0; i < 100; i++ {
+		c.Assert(limiter.Take("bob", "model-uuid", true), jc.ErrorIsNil)
+	}
+}
+
+func (s *limiterSuite) TestUserLimit(c *gc.C) {
+	limiter := New(s.clock)
+	limiter.Update(Config{UserMax: 2, UserRate: time.Second})
+
+	c.Assert(limiter.Take("bob", "model-uuid", false), jc.ErrorIsNil)
+	c.Assert(limiter.Take("bob", "", false), jc.ErrorIsNil)
+	s.assertRateLimited(c, limiter.Take("bob", "model-uuid", false), time.Second)
+
+	// Other users have their own bucket.
+	c.Assert(limiter.Take("alice", "model-uuid", false), jc.ErrorIsNil)
+
+	s.clock.Advance(time.Second)
+	c.Assert(limiter.Take("bob", "model-uuid", false), jc.ErrorIsNil)
+	s.assertRateLimited(c, limiter.Take("bob", "model-uuid", false), time.Second)
+}
+
+func (s *limiterSuite) TestModelLimit(c *gc.C) {
+	limiter := New(s.clock)
+	limiter.Update(Config{ModelMax: 2, ModelRate: time.Second})
+
+	c.Assert(limiter.Take("bob", "model-uuid", false), jc.ErrorIsNil)
+	c.Assert(limiter.Take("alice", "model-uuid", false), jc.ErrorIsNil)
+	s.assertRateLimited(c, limiter.Take("carol", "model-uuid", false), time.Second)
+
+	// Other models and the controller have their own limits.
+	c.Assert(limiter.Take("carol", "other-uuid", false), jc.ErrorIsNil)
+	c.Assert(limiter.Take("carol", "", false), jc.ErrorIsNil)
+}
+
+func (s *limiterSuite) TestExpensiveLimit(c *gc.C) {
+	limiter := New(s.clock)
+	limiter.Update(Config{
+		UserMax:       10,
+		UserRate:      time.Second,
+		ExpensiveMax:  1,
+		ExpensiveRate: time.Minute,
+	})
+
+	c.Assert(limiter.Take("bob", "model-uuid", true), jc.ErrorIsNil)
+	s.assertRateLimited(c, limiter.Take("bob", "model-uuid", true), time.Minute)
+
+	// Cheap requests are still allowed.
+	c.Assert(limiter.Take("bob", "model-uuid", false), jc.ErrorIsNil)
+}
+
+func (s *limiterSuite) TestRejectedRequestTakesNoTokens(c *gc.C) {
+	limiter := New(s.clock)
+	limiter.Update(Config{
+		UserMax:   2,
+		UserRate:  time.Second,
+		ModelMax:  1,
+		ModelRate: time.Minute,
+	})
+
+	c.Assert(limiter.Take("bob", "model-uuid", false), jc.ErrorIsNil)
+	s.assertRateLimited(c, limiter.Take("bob", "model-uuid", false), time.Minute)
+
+	// The rejected request did not take a token from bob's bucket.
+	c.Assert(limiter.Take("bob", "other-uuid", false), jc.ErrorIsNil)
+}
+
+func (s *limiterSuite) TestUpdateResetsBuckets(c *gc.C) {
+	limiter := New(s.clock)
+	limiter.Update(Config{UserMax: 1, UserRate: time.Minute})
+
+	c.Assert(limiter.Take("bob", "", false), jc.ErrorIsNil)
+	s.assertRateLimited(c, limiter.Take("bob", "", false), time.Minute)
+
+	// Updating with the same config keeps the buckets.
+	limiter.Update(Config{UserMax: 1, UserRate: time.Minute})
+	s.assertRateLimited(c, limiter.Take("bob", "", false), time.Minute)
+
+	limiter.Update(Config{UserMax: 2, UserRate: time.Minute})
+	c.Assert(limiter.Take("bob", "", false), jc.ErrorIsNil)
+
+	limiter.Update(Config{})
+	for i := 0; i < 10; i++ {
+		c.Assert(limiter.Take("bob", "", false), jc.ErrorIsNil)
+	}
+}
+
+func (s *limiterSuite) TestPruneIdleBuckets(c *gc.C) {
+	limiter := New(s.clock)
+	limiter.Update(Config{UserMax: 1, UserRate: time.Second})
+
+	for i := 0; i < pruneThreshold; i++ {
+		c.Assert(limiter.Take(fmt.Sprintf("user-%d", i), "", false), jc.ErrorIsNil)
+	}
+	c.Assert(limiter.users.buckets, gc.HasLen, pruneThreshold)
+
+	// Once the buckets have refilled, they are discarded when a new
+	// bucket is needed.
+	s.clock.Advance(time.Second)
+	c.Assert(limiter.Take("bob", "", false), jc.ErrorIsNil)
+	c.Assert(limiter.users.buckets, gc.HasLen, 1)
+}
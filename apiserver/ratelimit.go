@@ -0,0 +1,35 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/internal/ratelimiter"
+	"github.com/juju/juju/rpc"
+)
+
+// expensiveAPIMethods are the API methods of users which are rate limited
+// by the expensive call limit, in addition to the user and model limits.
+// Debug-log streams are also limited by it.
+var expensiveAPIMethods = set.NewStrings(
+	"Application.Deploy",
+	"Application.DeployFromRepository",
+	"Client.FullStatus",
+)
+
+// rateLimitRoot wraps the provided root so that the API requests of the
+// user are rate limited. The model UUID is empty for controller-only
+// logins. Pings are never limited, as a rejected ping would break the
+// connection.
+func rateLimitRoot(root rpc.Root, limiter *ratelimiter.Limiter, user names.UserTag, modelUUID string) *restrictedRoot {
+	return restrictRoot(root, func(facadeName, methodName string) error {
+		if facadeName == "Pinger" {
+			return nil
+		}
+		expensive := expensiveAPIMethods.Contains(facadeName + "." + methodName)
+		return limiter.Take(user.Id(), modelUUID, expensive)
+	})
+}
@@ -13,11 +13,14 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api"
+	"github.com/juju/juju/apiserver"
+	"github.com/juju/juju/apiserver/internal/ratelimiter"
 	corecontroller "github.com/juju/juju/controller"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/access/service"
 	"github.com/juju/juju/internal/auth"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/testing/factory"
 	jujutesting "github.com/juju/juju/juju/testing"
 )
@@ -140,3 +143,55 @@ func (s *rateLimitSuite) infoForNewUser(c *gc.C, info *api.Info, name string) *a
 	newInfo.Password = "hunter2"
 	return &newInfo
 }
+
+type rateLimitRootSuite struct {
+	coretesting.BaseSuite
+	limiter *ratelimiter.Limiter
+}
+
+var _ = gc.Suite(&rateLimitRootSuite{})
+
+func (s *rateLimitRootSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.limiter = ratelimiter.New(testclock.NewClock(time.Now()))
+}
+
+func (s *rateLimitRootSuite) TestUserLimit(c *gc.C) {
+	s.limiter.Update(ratelimiter.Config{UserMax: 1, UserRate: time.Second})
+	root := apiserver.TestingRateLimitedRoot(s.limiter, names.NewUserTag("bob"), "")
+
+	_, err := root.FindMethod("Client", 8, "FullStatus")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = root.FindMethod("Client", 8, "FullStatus")
+	c.Assert(err, gc.ErrorMatches, `rate limit exceeded, retry after 1s`)
+
+	other := apiserver.TestingRateLimitedRoot(s.limiter, names.NewUserTag("mary"), "")
+	_, err = other.FindMethod("Client", 8, "FullStatus")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *rateLimitRootSuite) TestExpensiveLimit(c *gc.C) {
+	s.limiter.Update(ratelimiter.Config{ExpensiveMax: 1, ExpensiveRate: time.Minute})
+	root := apiserver.TestingRateLimitedRoot(s.limiter, names.NewUserTag("bob"), coretesting.ModelTag.Id())
+
+	_, err := root.FindMethod("Client", 8, "FullStatus")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = root.FindMethod("Client", 8, "FullStatus")
+	c.Assert(err, gc.ErrorMatches, `rate limit exceeded, retry after 1m0s`)
+
+	// Cheap calls are not limited by the expensive call limit.
+	_, err = root.FindMethod("Client", 8, "WatchAll")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *rateLimitRootSuite) TestPingerNotLimited(c *gc.C) {
+	s.limiter.Update(ratelimiter.Config{UserMax: 1, UserRate: time.Minute})
+	root := apiserver.TestingRateLimitedRoot(s.limiter, names.NewUserTag("bob"), "")
+
+	_, err := root.FindMethod("Client", 8, "FullStatus")
+	c.Assert(err, jc.ErrorIsNil)
+	for i := 0; i < 3; i++ {
+		_, err = root.FindMethod("Pinger", 1, "Ping")
+		c.Check(err, gc.Not(gc.ErrorMatches), "rate limit exceeded.*")
+	}
+}
@@ -14,16 +14,21 @@ import (
 // using a controller-only login. Any facade added here needs to work
 // independently of individual models.
 var controllerFacadeNames = set.NewStrings(
+	"AdmissionPolicy",
 	"AllModelWatcher",
 	"ApplicationOffers",
 	"Cloud",
 	"Controller",
 	"CrossController",
+	"GroupManager",
 	"MigrationTarget",
 	"ModelManager",
 	"ModelUpgrader",
 	"ModelSummaryWatcher",
+	"RoleManager",
 	"SecretBackends",
+	"ServiceAccountManager",
+	"SSHCertificates",
 	"UserManager",
 )
 
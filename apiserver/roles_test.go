@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver"
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/authentication/serviceaccount"
+	"github.com/juju/juju/apiserver/stateauthenticator"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accessservice "github.com/juju/juju/domain/access/service"
+	"github.com/juju/juju/internal/services"
+	"github.com/juju/juju/internal/testing"
+)
+
+type rolesSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&rolesSuite{})
+
+const rolesModelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+// TestRoleAllowsCall checks that a role allows a local user a call their
+// access level does not.
+func (s *rolesSuite) TestRoleAllowsCall(c *gc.C) {
+	userTag := names.NewUserTag("bob")
+	accessService := accessservice.NewService(&roleState{access: permission.ReadAccess})
+	handler := apiserver.APIHandlerWithAuthInfo(authentication.AuthInfo{
+		Entity:    roleEntity{tag: userTag},
+		Delegator: &stateauthenticator.PermissionDelegator{AccessService: accessService},
+	}, roleDomainServices{access: accessService})
+
+	ctx := apiserver.WithAPICall(context.Background(), "Application.Deploy")
+	err := handler.EntityHasPermission(ctx, userTag, permission.WriteAccess, names.NewModelTag(rolesModelUUID))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// TestRoleDoesNotWidenScopedToken checks that a role does not allow a
+// service account a call the scope of its token does not, even though the
+// access of the service account itself would.
+func (s *rolesSuite) TestRoleDoesNotWidenScopedToken(c *gc.C) {
+	userTag := names.NewUserTag("ci")
+	modelTag := names.NewModelTag(rolesModelUUID)
+	accessService := accessservice.NewService(&roleState{access: permission.WriteAccess})
+	handler := apiserver.APIHandlerWithAuthInfo(authentication.AuthInfo{
+		Entity: roleEntity{tag: userTag},
+		Delegator: &serviceaccount.PermissionDelegator{
+			AccessService: accessService,
+			Token: access.ServiceAccountToken{
+				ServiceAccount: user.NameFromTag(userTag),
+				Scopes: []permission.AccessSpec{{
+					Target: permission.ID{ObjectType: permission.Model, Key: rolesModelUUID},
+					Access: permission.ReadAccess,
+				}},
+			},
+		},
+	}, roleDomainServices{access: accessService})
+
+	ctx := apiserver.WithAPICall(context.Background(), "Application.Deploy")
+	err := handler.EntityHasPermission(ctx, userTag, permission.WriteAccess, modelTag)
+	c.Assert(err, jc.ErrorIs, authentication.ErrorEntityMissingPermission)
+
+	err = handler.EntityHasPermission(ctx, userTag, permission.ReadAccess, modelTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+type roleEntity struct {
+	tag names.Tag
+}
+
+func (e roleEntity) Tag() names.Tag {
+	return e.tag
+}
+
+// roleDomainServices provides the access service of the model.
+type roleDomainServices struct {
+	services.DomainServices
+	access *accessservice.Service
+}
+
+func (s roleDomainServices) Access() *accessservice.Service {
+	return s.access
+}
+
+// roleState grants every user the access, and a role allowing
+// Application.Deploy, on every target.
+type roleState struct {
+	accessservice.State
+	access permission.Access
+}
+
+func (s *roleState) ReadUserAccessLevelForTarget(context.Context, user.Name, permission.ID) (permission.Access, error) {
+	return s.access, nil
+}
+
+func (s *roleState) GetUserRoles(context.Context, user.Name, permission.ID) ([]access.Role, error) {
+	return []access.Role{{Name: "deployer", Methods: []string{"Application.Deploy"}}}, nil
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/juju/clock"
@@ -386,6 +387,11 @@ func (r *apiHandler) EntityHasPermission(
 	if err != nil {
 		return fmt.Errorf("checking entity %q has permission: %w", entity, err)
 	}
+	if !has {
+		if has, err = r.roleAllowsCall(ctx, entity, target); err != nil {
+			return fmt.Errorf("checking entity %q roles: %w", entity, err)
+		}
+	}
 	if !has && r.authInfo.Delegator != nil {
 		err = r.authInfo.Delegator.PermissionError(target, operation)
 	}
@@ -396,11 +402,59 @@ func (r *apiHandler) EntityHasPermission(
 	return nil
 }
 
+// roleAllowsCall reports whether a role granted to the entity on the target,
+// or on the controller, allows the API method being called. Roles only apply
+// to the logged in user, and are consulted after their access level, so they
+// can only ever add to what the user is allowed to do. They are not consulted
+// for users authenticated in a way that narrows their access, such as with a
+// scoped token, which their roles would otherwise widen.
+func (r *apiHandler) roleAllowsCall(ctx context.Context, entity, target names.Tag) (bool, error) {
+	call, ok := ctx.Value(apiCallKey{}).(*apiCall)
+	if !ok {
+		return false, nil
+	}
+	delegator, ok := r.authInfo.Delegator.(authentication.RoleDelegator)
+	if !ok || !delegator.RolesApply() {
+		return false, nil
+	}
+	userTag, ok := entity.(names.UserTag)
+	if !ok || r.authInfo.Entity == nil || r.authInfo.Entity.Tag() != entity {
+		return false, nil
+	}
+	id, err := permission.ParseTagForID(target)
+	if err != nil || (id.ObjectType != permission.Model && id.ObjectType != permission.Controller) {
+		return false, nil
+	}
+	allowed, err := r.domainServices.Access().RoleAllowsMethod(ctx, user.NameFromTag(userTag), id, call.method)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if allowed {
+		call.elevated.Store(true)
+	}
+	return allowed, nil
+}
+
+// apiCallKey is the context key of the API call being served.
+type apiCallKey struct{}
+
+// apiCall holds the API method being served, so that permission checks made
+// while serving it can consult the roles granted to the caller.
+type apiCall struct {
+	// method is the method called, as "Facade.Method".
+	method string
+
+	// elevated records whether a role allowed a permission check which the
+	// caller's access level did not.
+	elevated atomic.Bool
+}
+
 // srvCaller is our implementation of the rpcreflect.MethodCaller interface.
 // It lives just long enough to encapsulate the methods that should be
 // available for an RPC call and allow the RPC code to instantiate an object
 // and place a call on its method.
 type srvCaller struct {
+	method    string
 	objMethod rpcreflect.ObjMethod
 	creator   func(ctx context.Context, id string) (reflect.Value, error)
 }
@@ -420,6 +474,7 @@ func (s *srvCaller) ResultType() reflect.Type {
 // Call takes the object Id and an instance of ParamsType to create an object and place
 // a call on its method. It then returns an instance of ResultType.
 func (s *srvCaller) Call(ctx context.Context, objId string, arg reflect.Value) (reflect.Value, error) {
+	ctx = context.WithValue(ctx, apiCallKey{}, &apiCall{method: s.method})
 	objVal, err := s.creator(ctx, objId)
 	if err != nil {
 		return reflect.Value{}, err
@@ -652,10 +707,17 @@ func (r *apiRoot) FindMethod(rootName string, version int, methodName string) (r
 			asInterface.Set(objValue)
 			objValue = asInterface
 		}
+		// A facade whose construction was only allowed by a role granted
+		// to the caller is not cached, so that it is not reused for other
+		// methods the role does not allow.
+		if call, ok := ctx.Value(apiCallKey{}).(*apiCall); ok && call.elevated.Load() {
+			return objValue, nil
+		}
 		r.objectCache[objKey] = objValue
 		return objValue, nil
 	}
 	return &srvCaller{
+		method:    rootName + "." + methodName,
 		creator:   creator,
 		objMethod: objMethod,
 	}, nil
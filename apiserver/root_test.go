@@ -334,6 +334,48 @@ func (r *rootSuite) TestFindMethodCacheRaceSafe(c *gc.C) {
 	assertCallResult(c, caller, "third", "third3")
 }
 
+func (r *rootSuite) TestFindMethodRecordsAPICall(c *gc.C) {
+	var methods []string
+	newCounter := func(ctx context.Context, _ facade.ModelContext) (facade.Facade, error) {
+		methods = append(methods, apiserver.APICallMethod(ctx))
+		return &countingType{}, nil
+	}
+	registry := new(facade.Registry)
+	registry.MustRegister("my-counting-facade", 0, newCounter, reflect.TypeOf((*countingType)(nil)))
+	srvRoot := apiserver.TestingAPIRoot(registry)
+
+	caller, err := srvRoot.FindMethod("my-counting-facade", 0, "AltCount")
+	c.Assert(err, jc.ErrorIsNil)
+	assertCallResult(c, caller, "", "ALT-0")
+	c.Check(methods, jc.DeepEquals, []string{"my-counting-facade.AltCount"})
+}
+
+func (r *rootSuite) TestFindMethodDoesNotCacheElevatedFacades(c *gc.C) {
+	var count int64
+	elevate := true
+	newCounter := func(ctx context.Context, _ facade.ModelContext) (facade.Facade, error) {
+		count += 1
+		if elevate {
+			apiserver.ElevateAPICall(ctx)
+		}
+		return &countingType{count: count}, nil
+	}
+	registry := new(facade.Registry)
+	registry.MustRegister("my-counting-facade", 0, newCounter, reflect.TypeOf((*countingType)(nil)))
+	srvRoot := apiserver.TestingAPIRoot(registry)
+
+	// A facade only allowed by a role is created anew for every call.
+	caller, err := srvRoot.FindMethod("my-counting-facade", 0, "Count")
+	c.Assert(err, jc.ErrorIsNil)
+	assertCallResult(c, caller, "", "1")
+	assertCallResult(c, caller, "", "2")
+
+	// Other facades are cached as usual.
+	elevate = false
+	assertCallResult(c, caller, "", "3")
+	assertCallResult(c, caller, "", "3")
+}
+
 type smallInterface interface {
 	OneMethod() stringVar
 }
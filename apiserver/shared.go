@@ -207,3 +207,12 @@ func (c *sharedServerContext) maxDebugLogDuration() time.Duration {
 	defer c.configMutex.RUnlock()
 	return c.controllerConfig.MaxDebugLogDuration()
 }
+
+// oidcConfig returns the issuer URL of the OIDC identity provider users log
+// in with, and the client ID of the controller, which are empty if OIDC login
+// is not configured.
+func (c *sharedServerContext) oidcConfig() (issuerURL, clientID string) {
+	c.configMutex.RLock()
+	defer c.configMutex.RUnlock()
+	return c.controllerConfig.OIDCIssuerURL(), c.controllerConfig.OIDCClientID()
+}
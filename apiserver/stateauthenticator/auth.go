@@ -150,6 +150,7 @@ func (a *Authenticator) Authenticate(req *http.Request) (authentication.AuthInfo
 		Nonce:         loginRequest.Nonce,
 		Macaroons:     loginRequest.Macaroons,
 		BakeryVersion: loginRequest.BakeryVersion,
+		RemoteAddress: req.RemoteAddr,
 	}
 	if loginRequest.AuthTag != "" {
 		authParams.AuthTag, err = names.ParseTag(loginRequest.AuthTag)
@@ -64,7 +64,7 @@ func (s *agentAuthenticatorSuite) TestAuthenticatorForTag(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Check(authenticator, gc.NotNil)
 
-	s.accessService.EXPECT().GetUserByAuth(context.Background(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password")).Return(user, nil).AnyTimes()
+	s.accessService.EXPECT().AuthenticateUser(gomock.Any(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password"), gomock.Any()).Return(user, nil).AnyTimes()
 
 	entity, err := authenticator.Authenticate(context.Background(), authentication.AuthParams{
 		AuthTag:     tag,
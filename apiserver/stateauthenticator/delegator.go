@@ -50,3 +50,9 @@ func (p *PermissionDelegator) SubjectPermissions(
 func (p *PermissionDelegator) PermissionError(_ names.Tag, _ permission.Access) error {
 	return apiservererrors.ErrPerm
 }
+
+// RolesApply returns true, as the roles granted to the user extend their
+// access.
+func (p *PermissionDelegator) RolesApply() bool {
+	return true
+}
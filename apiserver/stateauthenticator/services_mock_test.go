@@ -111,79 +111,79 @@ func (m *MockAccessService) EXPECT() *MockAccessServiceMockRecorder {
 	return m.recorder
 }
 
-// EnsureExternalUserIfAuthorized mocks base method.
-func (m *MockAccessService) EnsureExternalUserIfAuthorized(arg0 context.Context, arg1 user.Name, arg2 permission.ID) error {
+// AuthenticateUser mocks base method.
+func (m *MockAccessService) AuthenticateUser(arg0 context.Context, arg1 user.Name, arg2 auth.Password, arg3 string) (user.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EnsureExternalUserIfAuthorized", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AuthenticateUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EnsureExternalUserIfAuthorized indicates an expected call of EnsureExternalUserIfAuthorized.
-func (mr *MockAccessServiceMockRecorder) EnsureExternalUserIfAuthorized(arg0, arg1, arg2 any) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+// AuthenticateUser indicates an expected call of AuthenticateUser.
+func (mr *MockAccessServiceMockRecorder) AuthenticateUser(arg0, arg1, arg2, arg3 any) *MockAccessServiceAuthenticateUserCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureExternalUserIfAuthorized", reflect.TypeOf((*MockAccessService)(nil).EnsureExternalUserIfAuthorized), arg0, arg1, arg2)
-	return &MockAccessServiceEnsureExternalUserIfAuthorizedCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateUser", reflect.TypeOf((*MockAccessService)(nil).AuthenticateUser), arg0, arg1, arg2, arg3)
+	return &MockAccessServiceAuthenticateUserCall{Call: call}
 }
 
-// MockAccessServiceEnsureExternalUserIfAuthorizedCall wrap *gomock.Call
-type MockAccessServiceEnsureExternalUserIfAuthorizedCall struct {
+// MockAccessServiceAuthenticateUserCall wrap *gomock.Call
+type MockAccessServiceAuthenticateUserCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) Return(arg0 error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockAccessServiceAuthenticateUserCall) Return(arg0 user.User, arg1 error) *MockAccessServiceAuthenticateUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) Do(f func(context.Context, user.Name, permission.ID) error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+func (c *MockAccessServiceAuthenticateUserCall) Do(f func(context.Context, user.Name, auth.Password, string) (user.User, error)) *MockAccessServiceAuthenticateUserCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+func (c *MockAccessServiceAuthenticateUserCall) DoAndReturn(f func(context.Context, user.Name, auth.Password, string) (user.User, error)) *MockAccessServiceAuthenticateUserCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetUserByAuth mocks base method.
-func (m *MockAccessService) GetUserByAuth(arg0 context.Context, arg1 user.Name, arg2 auth.Password) (user.User, error) {
+// EnsureExternalUserIfAuthorized mocks base method.
+func (m *MockAccessService) EnsureExternalUserIfAuthorized(arg0 context.Context, arg1 user.Name, arg2 permission.ID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByAuth", arg0, arg1, arg2)
-	ret0, _ := ret[0].(user.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "EnsureExternalUserIfAuthorized", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetUserByAuth indicates an expected call of GetUserByAuth.
-func (mr *MockAccessServiceMockRecorder) GetUserByAuth(arg0, arg1, arg2 any) *MockAccessServiceGetUserByAuthCall {
+// EnsureExternalUserIfAuthorized indicates an expected call of EnsureExternalUserIfAuthorized.
+func (mr *MockAccessServiceMockRecorder) EnsureExternalUserIfAuthorized(arg0, arg1, arg2 any) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByAuth", reflect.TypeOf((*MockAccessService)(nil).GetUserByAuth), arg0, arg1, arg2)
-	return &MockAccessServiceGetUserByAuthCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureExternalUserIfAuthorized", reflect.TypeOf((*MockAccessService)(nil).EnsureExternalUserIfAuthorized), arg0, arg1, arg2)
+	return &MockAccessServiceEnsureExternalUserIfAuthorizedCall{Call: call}
 }
 
-// MockAccessServiceGetUserByAuthCall wrap *gomock.Call
-type MockAccessServiceGetUserByAuthCall struct {
+// MockAccessServiceEnsureExternalUserIfAuthorizedCall wrap *gomock.Call
+type MockAccessServiceEnsureExternalUserIfAuthorizedCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockAccessServiceGetUserByAuthCall) Return(arg0 user.User, arg1 error) *MockAccessServiceGetUserByAuthCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) Return(arg0 error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockAccessServiceGetUserByAuthCall) Do(f func(context.Context, user.Name, auth.Password) (user.User, error)) *MockAccessServiceGetUserByAuthCall {
+func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) Do(f func(context.Context, user.Name, permission.ID) error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockAccessServiceGetUserByAuthCall) DoAndReturn(f func(context.Context, user.Name, auth.Password) (user.User, error)) *MockAccessServiceGetUserByAuthCall {
+func (c *MockAccessServiceEnsureExternalUserIfAuthorizedCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) error) *MockAccessServiceEnsureExternalUserIfAuthorizedCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
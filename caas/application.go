@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/watcher"
@@ -46,6 +47,11 @@ type Application interface {
 	// Service returns the service associated with the application.
 	Service() (*Service, error)
 
+	// UpdateNetworkPolicy restricts the traffic reaching the units of the
+	// application to that allowed by the policy. A nil policy removes the
+	// restriction.
+	UpdateNetworkPolicy(*NetworkPolicy) error
+
 	ServiceInterface
 }
 
@@ -71,6 +77,22 @@ type ServiceInterface interface {
 	UpdatePorts(ports []ServicePort, updateContainerPorts bool) error
 }
 
+// NetworkPolicy describes the traffic allowed to reach the units of an
+// application. Traffic between the units of the application itself is always
+// allowed, and any other traffic not allowed by the policy is denied.
+type NetworkPolicy struct {
+	Ingress []NetworkPolicyIngress
+}
+
+// NetworkPolicyIngress allows traffic from the units of other applications,
+// or from CIDRs, to reach the specified ports of the units of an application.
+// Without any ports, traffic may reach all the ports of the units' containers.
+type NetworkPolicyIngress struct {
+	Applications []string
+	CIDRs        []string
+	Ports        []network.PortRange
+}
+
 // ApplicationState represents the application state.
 type ApplicationState struct {
 	DesiredReplicas int
@@ -6,6 +6,8 @@ package caas
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -216,6 +218,11 @@ type ApplicationBroker interface {
 
 	// AnnotateUnit annotates the specified pod (name or uid) with a unit tag.
 	AnnotateUnit(ctx context.Context, appName string, podName string, unit names.UnitTag) error
+
+	// ApplicationUtilisation returns the average resource utilisation of the
+	// running units of the specified application. If customMetric is not
+	// empty, the average value of that custom metric is also returned.
+	ApplicationUtilisation(ctx context.Context, appName string, customMetric string) (Utilisation, error)
 }
 
 // ModelOperatorManager provides an API for deploying operators for individual
@@ -265,6 +272,47 @@ type ProxyManager interface {
 	ProxyToApplication(ctx context.Context, appName, remotePort string) (proxy.Proxier, error)
 }
 
+// PodDialer provides the API to connect to the ports of pods.
+type PodDialer interface {
+	// DialPod dials the port of the named pod through the k8s API server,
+	// reaching ports that are only bound within the pod.
+	DialPod(ctx context.Context, podName string, port int) (net.Conn, error)
+}
+
+// PodExecer provides the API to run commands in the containers of pods.
+type PodExecer interface {
+	// ExecPod runs a command in a container of the named pod through the
+	// k8s API server, until the command exits or the context is cancelled.
+	// A command which exits with a non-zero status returns an error with
+	// an ExitStatus method.
+	ExecPod(ctx context.Context, args ExecPodArgs) error
+}
+
+// ExecPodArgs holds the command to run in a container of a pod, and the
+// streams connected to it.
+type ExecPodArgs struct {
+	PodName       string
+	ContainerName string
+	Command       []string
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// TTY allocates a terminal for the command. The output of a command
+	// run on a terminal is written to Stdout only.
+	TTY bool
+
+	// TerminalSizes receives the size of the terminal, and changes to it.
+	TerminalSizes <-chan TerminalSize
+}
+
+// TerminalSize is the size of a terminal, in characters.
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
 // ServiceManager provides the API to manipulate services.
 type ServiceManager interface {
 	// GetService returns the service for the specified application.
@@ -312,6 +360,21 @@ type Unit struct {
 	FilesystemInfo []FilesystemInfo
 }
 
+// Utilisation holds the average resource utilisation of the running units of
+// an application. A nil value means the measure is not available, either
+// because the metric is not served or the units do not request the resource.
+type Utilisation struct {
+	// Units is the number of running units the averages are taken over.
+	Units int
+	// CPUPercent is the CPU used as a percentage of the CPU requested.
+	CPUPercent *float64
+	// MemoryPercent is the memory used as a percentage of the memory
+	// requested.
+	MemoryPercent *float64
+	// CustomMetric is the average value of the requested custom metric.
+	CustomMetric *float64
+}
+
 // Operator represents information about the status of an "operator pod".
 type Operator struct {
 	Id     string
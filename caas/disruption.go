@@ -0,0 +1,110 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caas
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+const (
+	// DisruptionBudgetMinAvailableTag is the constraints tag used to set the
+	// number, or percentage, of units of an application which must remain
+	// available during voluntary disruptions such as node drains.
+	DisruptionBudgetMinAvailableTag = "pdb.min-available"
+
+	// DisruptionBudgetMaxUnavailableTag is the constraints tag used to set
+	// the number, or percentage, of units of an application which may be
+	// unavailable during voluntary disruptions such as node drains.
+	DisruptionBudgetMaxUnavailableTag = "pdb.max-unavailable"
+)
+
+// DisruptionBudget describes how many units of an application may be
+// disrupted at once. Each value is either a number of units or a percentage
+// of the application's scale, such as "50%". Only one of the values is set.
+type DisruptionBudget struct {
+	MinAvailable   string
+	MaxUnavailable string
+}
+
+// MinAvailableUnits returns the minimum number of units of an application
+// with the specified scale which must remain available. Percentages are
+// rounded up, as they are by Kubernetes, so a budget of "50%" of 3 units
+// keeps 2 units available and a budget allowing "50%" of 3 units to be
+// unavailable keeps 1 unit available.
+func (b DisruptionBudget) MinAvailableUnits(scale int) int {
+	var minAvailable int
+	switch {
+	case b.MinAvailable != "":
+		minAvailable = scaledDisruptionValue(b.MinAvailable, scale)
+	case b.MaxUnavailable != "":
+		minAvailable = scale - scaledDisruptionValue(b.MaxUnavailable, scale)
+	}
+	return max(minAvailable, 0)
+}
+
+// scaledDisruptionValue returns the number of units, of an application with
+// the specified scale, represented by a valid disruption value.
+func scaledDisruptionValue(value string, scale int) int {
+	if pct, ok := strings.CutSuffix(value, "%"); ok {
+		n, _ := strconv.Atoi(pct)
+		return (n*scale + 99) / 100
+	}
+	n, _ := strconv.Atoi(value)
+	return n
+}
+
+// ApplicationDisruptionBudget returns the disruption budget requested by the
+// tags of the specified application constraints, or nil if there is none.
+func ApplicationDisruptionBudget(cons constraints.Value) (*DisruptionBudget, error) {
+	if cons.Tags == nil {
+		return nil, nil
+	}
+	var budget DisruptionBudget
+	for _, tag := range *cons.Tags {
+		key, value, ok := strings.Cut(tag, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch key {
+		case DisruptionBudgetMinAvailableTag:
+			budget.MinAvailable = value
+		case DisruptionBudgetMaxUnavailableTag:
+			budget.MaxUnavailable = value
+		default:
+			continue
+		}
+		if err := validateDisruptionValue(value); err != nil {
+			return nil, errors.Annotatef(err, "constraint %q", key)
+		}
+	}
+	if budget.MinAvailable == "" && budget.MaxUnavailable == "" {
+		return nil, nil
+	}
+	if budget.MinAvailable != "" && budget.MaxUnavailable != "" {
+		return nil, errors.NotValidf("specifying both %q and %q", DisruptionBudgetMinAvailableTag, DisruptionBudgetMaxUnavailableTag)
+	}
+	return &budget, nil
+}
+
+func validateDisruptionValue(value string) error {
+	if pct, ok := strings.CutSuffix(value, "%"); ok {
+		n, err := strconv.Atoi(pct)
+		if err != nil || n < 0 || n > 100 {
+			return errors.NotValidf("percentage %q", value)
+		}
+		return nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return errors.NotValidf("number of units %q", value)
+	}
+	return nil
+}
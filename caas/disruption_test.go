@@ -0,0 +1,86 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caas_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/internal/testing"
+)
+
+type disruptionSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&disruptionSuite{})
+
+func (s *disruptionSuite) TestApplicationDisruptionBudget(c *gc.C) {
+	for i, t := range []struct {
+		cons     string
+		expected *caas.DisruptionBudget
+	}{{
+		cons: "mem=4G",
+	}, {
+		cons: "tags=node.foo=bar,anti-pod.app=db",
+	}, {
+		cons:     "tags=pdb.min-available=2",
+		expected: &caas.DisruptionBudget{MinAvailable: "2"},
+	}, {
+		cons:     "tags=node.foo=bar,pdb.max-unavailable=25%",
+		expected: &caas.DisruptionBudget{MaxUnavailable: "25%"},
+	}} {
+		c.Logf("test %d: %s", i, t.cons)
+		budget, err := caas.ApplicationDisruptionBudget(constraints.MustParse(t.cons))
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(budget, gc.DeepEquals, t.expected)
+	}
+}
+
+func (s *disruptionSuite) TestApplicationDisruptionBudgetNotValid(c *gc.C) {
+	for i, t := range []struct {
+		cons string
+		err  string
+	}{{
+		cons: "tags=pdb.min-available=two",
+		err:  `constraint "pdb.min-available": number of units "two" not valid`,
+	}, {
+		cons: "tags=pdb.max-unavailable=-1",
+		err:  `constraint "pdb.max-unavailable": number of units "-1" not valid`,
+	}, {
+		cons: "tags=pdb.max-unavailable=150%",
+		err:  `constraint "pdb.max-unavailable": percentage "150%" not valid`,
+	}, {
+		cons: "tags=pdb.min-available=1,pdb.max-unavailable=1",
+		err:  `specifying both "pdb.min-available" and "pdb.max-unavailable" not valid`,
+	}} {
+		c.Logf("test %d: %s", i, t.cons)
+		_, err := caas.ApplicationDisruptionBudget(constraints.MustParse(t.cons))
+		c.Check(err, gc.ErrorMatches, t.err)
+		c.Check(err, jc.ErrorIs, errors.NotValid)
+	}
+}
+
+func (s *disruptionSuite) TestMinAvailableUnits(c *gc.C) {
+	for i, t := range []struct {
+		budget caas.DisruptionBudget
+		scale  int
+		expect int
+	}{
+		{budget: caas.DisruptionBudget{MinAvailable: "3"}, scale: 5, expect: 3},
+		{budget: caas.DisruptionBudget{MinAvailable: "50%"}, scale: 4, expect: 2},
+		{budget: caas.DisruptionBudget{MinAvailable: "50%"}, scale: 3, expect: 2},
+		{budget: caas.DisruptionBudget{MinAvailable: "0%"}, scale: 3, expect: 0},
+		{budget: caas.DisruptionBudget{MaxUnavailable: "1"}, scale: 5, expect: 4},
+		{budget: caas.DisruptionBudget{MaxUnavailable: "2"}, scale: 1, expect: 0},
+		{budget: caas.DisruptionBudget{MaxUnavailable: "50%"}, scale: 3, expect: 1},
+		{budget: caas.DisruptionBudget{MaxUnavailable: "100%"}, scale: 3, expect: 0},
+	} {
+		c.Logf("test %d: %+v scale %d", i, t.budget, t.scale)
+		c.Check(t.budget.MinAvailableUnits(t.scale), gc.Equals, t.expect)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package kubernetes
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// DialPod dials the port of the pod in the namespace through the port
+// forwarding API of the k8s API server. Unlike a Tunnel, no local listener
+// is opened; the returned connection is the forwarded stream itself.
+func DialPod(ctx context.Context, c *rest.Config, namespace, podName string, port int) (net.Conn, error) {
+	config := *c
+	gv := corev1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/api"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, errors.Annotate(err, "creating kubernetes rest client for pod connection")
+	}
+
+	u := client.Post().
+		Resource(string(TunnelKindPods)).
+		Namespace(namespace).
+		Name(podName).
+		SubResource("portforward").URL()
+
+	transport, upgrader, err := spdy.RoundTripperFor(&config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, u)
+
+	type dialResult struct {
+		streamConn httpstream.Connection
+		err        error
+	}
+	dialed := make(chan dialResult, 1)
+	go func() {
+		streamConn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+		dialed <- dialResult{streamConn: streamConn, err: err}
+	}()
+
+	var streamConn httpstream.Connection
+	select {
+	case res := <-dialed:
+		if res.err != nil {
+			return nil, errors.Annotatef(res.err, "dialing pod %q", podName)
+		}
+		streamConn = res.streamConn
+	case <-ctx.Done():
+		// Close the connection once the abandoned dial completes.
+		go func() {
+			if res := <-dialed; res.err == nil {
+				_ = res.streamConn.Close()
+			}
+		}()
+		return nil, errors.Annotatef(ctx.Err(), "dialing pod %q", podName)
+	}
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(port))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		_ = streamConn.Close()
+		return nil, errors.Annotatef(err, "creating error stream for pod %q port %d", podName, port)
+	}
+	// Nothing is written to the error stream.
+	_ = errorStream.Close()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		_ = streamConn.Close()
+		return nil, errors.Annotatef(err, "creating data stream for pod %q port %d", podName, port)
+	}
+
+	conn := &podConn{
+		Stream:     dataStream,
+		streamConn: streamConn,
+		addr:       podAddr(namespace + "/" + podName + ":" + strconv.Itoa(port)),
+	}
+	go func() {
+		// The API server reports failures to reach the port, such as
+		// nothing listening on it, on the error stream. The connection
+		// is closed so that readers of the data stream are not left
+		// waiting.
+		if message, _ := io.ReadAll(errorStream); len(message) > 0 {
+			_ = conn.Close()
+		}
+	}()
+	return conn, nil
+}
+
+// podAddr is the address of a port of a pod.
+type podAddr string
+
+// Network returns the name of the network.
+func (podAddr) Network() string {
+	return "k8s-portforward"
+}
+
+// String returns the namespace, name and port of the pod.
+func (a podAddr) String() string {
+	return string(a)
+}
+
+// podConn is a connection to the port of a pod, forwarded over a stream
+// of the k8s API server connection. It closes the API server connection
+// when it is closed.
+type podConn struct {
+	httpstream.Stream
+	streamConn httpstream.Connection
+	addr       podAddr
+}
+
+// Close closes the stream and the API server connection it was opened on.
+func (c *podConn) Close() error {
+	err := c.Stream.Close()
+	_ = c.streamConn.Close()
+	return err
+}
+
+// LocalAddr returns the address of the pod's port.
+func (c *podConn) LocalAddr() net.Addr {
+	return c.addr
+}
+
+// RemoteAddr returns the address of the pod's port.
+func (c *podConn) RemoteAddr() net.Addr {
+	return c.addr
+}
+
+// SetDeadline is not supported by forwarded streams.
+func (c *podConn) SetDeadline(time.Time) error {
+	return errors.NotSupportedf("deadlines on pod connections")
+}
+
+// SetReadDeadline is not supported by forwarded streams.
+func (c *podConn) SetReadDeadline(time.Time) error {
+	return errors.NotSupportedf("deadlines on pod connections")
+}
+
+// SetWriteDeadline is not supported by forwarded streams.
+func (c *podConn) SetWriteDeadline(time.Time) error {
+	return errors.NotSupportedf("deadlines on pod connections")
+}
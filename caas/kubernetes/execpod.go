@@ -0,0 +1,83 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package kubernetes
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/juju/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecPodParams holds the command to run in a container of a pod, and the
+// streams connected to it.
+type ExecPodParams struct {
+	PodName       string
+	ContainerName string
+	Command       []string
+
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// TTY allocates a terminal for the command. The output of a command
+	// run on a terminal is written to Stdout only.
+	TTY bool
+
+	// TerminalSizeQueue passes the size of the terminal, and changes to
+	// it, to the command.
+	TerminalSizeQueue remotecommand.TerminalSizeQueue
+}
+
+// ExecPod runs the command in the container of the pod in the namespace
+// through the exec API of the k8s API server, until the command exits or
+// the context is cancelled. A command which exits with a non-zero status
+// returns an error with an ExitStatus method.
+func ExecPod(ctx context.Context, c *rest.Config, namespace string, params ExecPodParams) error {
+	config := *c
+	gv := corev1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/api"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return errors.Annotate(err, "creating kubernetes rest client for pod exec")
+	}
+
+	u := client.Post().
+		Resource(string(TunnelKindPods)).
+		Namespace(namespace).
+		Name(params.PodName).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: params.ContainerName,
+			Command:   params.Command,
+			Stdin:     params.Stdin != nil,
+			Stdout:    params.Stdout != nil,
+			Stderr:    params.Stderr != nil && !params.TTY,
+			TTY:       params.TTY,
+		}, scheme.ParameterCodec).URL()
+
+	executor, err := remotecommand.NewSPDYExecutor(&config, http.MethodPost, u)
+	if err != nil {
+		return errors.Annotatef(err, "creating executor for pod %q", params.PodName)
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:             params.Stdin,
+		Stdout:            params.Stdout,
+		Tty:               params.TTY,
+		TerminalSizeQueue: params.TerminalSizeQueue,
+	}
+	if !params.TTY {
+		streamOptions.Stderr = params.Stderr
+	}
+	return executor.StreamWithContext(ctx, streamOptions)
+}
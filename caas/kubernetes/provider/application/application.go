@@ -18,6 +18,7 @@ import (
 	"github.com/kr/pretty"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -189,6 +190,12 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 		return errors.Annotate(err, "generating application podspec")
 	}
 
+	budget, err := caas.ApplicationDisruptionBudget(config.Constraints)
+	if err != nil {
+		return errors.Annotate(err, "processing disruption budget constraints")
+	}
+	a.applyDisruptionBudget(applier, budget)
+
 	var handleVolume handleVolumeFunc = func(v corev1.Volume, mountPath string, readOnly bool) (*corev1.VolumeMount, error) {
 		if err := storage.PushUniqueVolume(podSpec, v, false); err != nil {
 			return nil, errors.Trace(err)
@@ -362,6 +369,7 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 						},
 						Spec: *podSpec,
 					},
+					Strategy: deploymentStrategy(budget),
 				},
 			},
 		}
@@ -409,6 +417,56 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 	return applier.Run(context.Background(), a.client, false)
 }
 
+// applyDisruptionBudget ensures the pod disruption budget of the application
+// matches the budget requested by its constraints. The budget is removed if
+// the constraints no longer request one.
+func (a *app) applyDisruptionBudget(applier resources.Applier, budget *caas.DisruptionBudget) {
+	if budget == nil {
+		applier.Delete(resources.NewPodDisruptionBudget(a.name, a.namespace, nil))
+		return
+	}
+	spec := policyv1.PodDisruptionBudgetSpec{
+		Selector: &metav1.LabelSelector{
+			MatchLabels: a.selectorLabels(),
+		},
+	}
+	if budget.MinAvailable != "" {
+		minAvailable := intstr.Parse(budget.MinAvailable)
+		spec.MinAvailable = &minAvailable
+	} else {
+		maxUnavailable := intstr.Parse(budget.MaxUnavailable)
+		spec.MaxUnavailable = &maxUnavailable
+	}
+	applier.Apply(resources.NewPodDisruptionBudget(a.name, a.namespace, &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: a.labels(),
+		},
+		Spec: spec,
+	}))
+}
+
+// deploymentStrategy returns the rolling update strategy of a deployment
+// which keeps a charm refresh within the disruption budget of the
+// application. Without a budget, the Kubernetes defaults are used.
+// Stateful sets need no such strategy, as they replace one pod at a time.
+func deploymentStrategy(budget *caas.DisruptionBudget) appsv1.DeploymentStrategy {
+	if budget == nil {
+		return appsv1.DeploymentStrategy{}
+	}
+	// With a minimum number of available units, only surge pods are used
+	// so that no existing pod is taken down before its replacement is ready.
+	maxUnavailable := intstr.FromInt32(0)
+	if budget.MaxUnavailable != "" {
+		maxUnavailable = intstr.Parse(budget.MaxUnavailable)
+	}
+	return appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxUnavailable: &maxUnavailable,
+		},
+	}
+}
+
 func (a *app) applyServiceAccountAndSecrets(applier resources.Applier, config caas.ApplicationConfig) error {
 	secret := resources.Secret{
 		Secret: corev1.Secret{
@@ -575,30 +633,57 @@ func (a *app) upgradeMainResource(applier resources.Applier, ver semversion.Numb
 		if err := ss.Get(context.Background(), a.client); err != nil {
 			return errors.Trace(err)
 		}
-		initContainers := ss.Spec.Template.Spec.InitContainers
-		if len(initContainers) != 1 {
-			return errors.NotValidf("init container of %q", a.name)
-		}
-		initContainer := initContainers[0]
-		var err error
-		initContainer.Image, err = podcfg.RebuildOldOperatorImagePath(initContainer.Image, ver)
-		if err != nil {
+		if err := a.upgradePodTemplate(&ss.Spec.Template, ver); err != nil {
 			return errors.Trace(err)
 		}
-		ss.Spec.Template.Spec.InitContainers = []corev1.Container{initContainer}
-		ss.Spec.Template.SetAnnotations(a.upgradeAnnotations(annotations.New(ss.Spec.Template.GetAnnotations()), ver))
 		ss.SetAnnotations(a.upgradeAnnotations(annotations.New(ss.GetAnnotations()), ver))
 		applier.Apply(ss)
 		return nil
 	case caas.DeploymentStateless:
-		return errors.NotSupportedf("upgrade for deployment type %q", a.deploymentType)
+		d := resources.NewDeployment(a.name, a.namespace, nil)
+		if err := d.Get(context.Background(), a.client); err != nil {
+			return errors.Trace(err)
+		}
+		if err := a.upgradePodTemplate(&d.Spec.Template, ver); err != nil {
+			return errors.Trace(err)
+		}
+		d.SetAnnotations(a.upgradeAnnotations(annotations.New(d.GetAnnotations()), ver))
+		applier.Apply(d)
+		return nil
 	case caas.DeploymentDaemon:
-		return errors.NotSupportedf("upgrade for deployment type %q", a.deploymentType)
+		ds := resources.NewDaemonSet(a.name, a.namespace, nil)
+		if err := ds.Get(context.Background(), a.client); err != nil {
+			return errors.Trace(err)
+		}
+		if err := a.upgradePodTemplate(&ds.Spec.Template, ver); err != nil {
+			return errors.Trace(err)
+		}
+		ds.SetAnnotations(a.upgradeAnnotations(annotations.New(ds.GetAnnotations()), ver))
+		applier.Apply(ds)
+		return nil
 	default:
 		return errors.NotSupportedf("unknown deployment type %q", a.deploymentType)
 	}
 }
 
+// upgradePodTemplate updates the charm init container image and the
+// version annotations of the pod template to the specified version.
+func (a *app) upgradePodTemplate(template *corev1.PodTemplateSpec, ver semversion.Number) error {
+	initContainers := template.Spec.InitContainers
+	if len(initContainers) != 1 {
+		return errors.NotValidf("init container of %q", a.name)
+	}
+	initContainer := initContainers[0]
+	var err error
+	initContainer.Image, err = podcfg.RebuildOldOperatorImagePath(initContainer.Image, ver)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	template.Spec.InitContainers = []corev1.Container{initContainer}
+	template.SetAnnotations(a.upgradeAnnotations(annotations.New(template.GetAnnotations()), ver))
+	return nil
+}
+
 // Exists indicates if the application for the specified
 // application exists, and whether the application is terminating.
 func (a *app) Exists() (caas.DeploymentState, error) {
@@ -1004,6 +1089,8 @@ func (a *app) Delete() error {
 	default:
 		return errors.NotSupportedf("unknown deployment type")
 	}
+	applier.Delete(resources.NewPodDisruptionBudget(a.name, a.namespace, nil))
+	applier.Delete(resources.NewNetworkPolicy(a.name, a.namespace, nil))
 	applier.Delete(resources.NewService(a.name, a.namespace, nil))
 	applier.Delete(resources.NewSecret(a.secretName(), a.namespace, nil))
 	applier.Delete(resources.NewRoleBinding(a.serviceAccountName(), a.namespace, nil))
@@ -1157,36 +1244,60 @@ func (a *app) Service() (*caas.Service, error) {
 	}, nil
 }
 
+// statusResource is a workload resource whose status
+// is used to compute the status of the application.
+type statusResource interface {
+	GetDeletionTimestamp() *metav1.Time
+	Events(ctx context.Context, client kubernetes.Interface) ([]corev1.Event, error)
+}
+
 func (a *app) computeStatus(ctx context.Context, client kubernetes.Interface, now time.Time) (string, status.Status, time.Time, error) {
 	jujuStatus := status.Waiting
+	var (
+		res   statusResource
+		ready bool
+	)
 	switch a.deploymentType {
 	case caas.DeploymentStateful:
 		ss, err := a.getStatefulSet()
 		if err != nil {
 			return "", jujuStatus, now, errors.Trace(err)
 		}
-		if ss.GetDeletionTimestamp() != nil {
-			return "", status.Terminated, now, nil
-		} else if ss.Status.ReadyReplicas > 0 {
-			return "", status.Active, now, nil
-		}
-		var statusMessage string
-		events, err := ss.Events(ctx, client)
+		res, ready = ss, ss.Status.ReadyReplicas > 0
+	case caas.DeploymentStateless:
+		d, err := a.getDeployment()
 		if err != nil {
 			return "", jujuStatus, now, errors.Trace(err)
 		}
-		// Take the most recent event.
-		if count := len(events); count > 0 {
-			evt := events[count-1]
-			if evt.Type == corev1.EventTypeWarning && evt.Reason == "FailedCreate" {
-				jujuStatus = status.Error
-				statusMessage = evt.Message
-			}
+		res, ready = d, d.Status.ReadyReplicas > 0
+	case caas.DeploymentDaemon:
+		ds, err := a.getDaemonSet()
+		if err != nil {
+			return "", jujuStatus, now, errors.Trace(err)
 		}
-		return statusMessage, jujuStatus, now, nil
+		res, ready = ds, ds.Status.NumberReady > 0
 	default:
 		return "", jujuStatus, now, errors.NotSupportedf("deployment type %q", a.deploymentType)
 	}
+	if res.GetDeletionTimestamp() != nil {
+		return "", status.Terminated, now, nil
+	} else if ready {
+		return "", status.Active, now, nil
+	}
+	var statusMessage string
+	events, err := res.Events(ctx, client)
+	if err != nil {
+		return "", jujuStatus, now, errors.Trace(err)
+	}
+	// Take the most recent event.
+	if count := len(events); count > 0 {
+		evt := events[count-1]
+		if evt.Type == corev1.EventTypeWarning && evt.Reason == "FailedCreate" {
+			jujuStatus = status.Error
+			statusMessage = evt.Message
+		}
+	}
+	return statusMessage, jujuStatus, now, nil
 }
 
 // Units of the application fetched from kubernetes by matching pod labels.
@@ -1794,6 +1905,10 @@ func (a *app) ApplicationPodSpec(config caas.ApplicationConfig) (*corev1.PodSpec
 	if err != nil {
 		return nil, errors.Annotate(err, "processing constraints")
 	}
+	spec.TopologySpreadConstraints, err = topologySpreadConstraints(config.Constraints, a.selectorLabels())
+	if err != nil {
+		return nil, errors.Annotate(err, "processing spread constraints")
+	}
 
 	if requireSecurityContext {
 		// Rootless charms are any charm after juju 3.5 that declare
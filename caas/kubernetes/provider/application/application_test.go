@@ -16,7 +16,9 @@ import (
 	gc "gopkg.in/check.v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -919,6 +921,53 @@ func (s *applicationSuite) TestEnsureStateless(c *gc.C) {
 	s.assertDelete(c, app)
 }
 
+func (s *applicationSuite) TestEnsureStatelessDisruptionBudget(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateless, false)
+	s.assertEnsure(
+		c, app, false, constraints.MustParse("tags=pdb.max-unavailable=1,spread.zone=1"), true, false, "", func() {
+			d, err := s.client.AppsV1().Deployments("test").Get(context.Background(), "gitlab", metav1.GetOptions{})
+			c.Assert(err, jc.ErrorIsNil)
+			maxUnavailable := intstr.FromInt32(1)
+			c.Assert(d.Spec.Strategy, gc.DeepEquals, appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxUnavailable: &maxUnavailable,
+				},
+			})
+			c.Assert(d.Spec.Template.Spec.TopologySpreadConstraints, gc.DeepEquals, []corev1.TopologySpreadConstraint{{
+				MaxSkew:           1,
+				TopologyKey:       "topology.kubernetes.io/zone",
+				WhenUnsatisfiable: corev1.DoNotSchedule,
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+				},
+			}})
+
+			pdb, err := s.client.PolicyV1().PodDisruptionBudgets("test").Get(context.Background(), "gitlab", metav1.GetOptions{})
+			c.Assert(err, jc.ErrorIsNil)
+			c.Assert(pdb.Spec, gc.DeepEquals, policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: &maxUnavailable,
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+				},
+			})
+		},
+	)
+	s.assertDelete(c, app)
+
+	_, err := s.client.PolicyV1().PodDisruptionBudgets("test").Get(context.Background(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, jc.Satisfies, k8serrors.IsNotFound)
+}
+
+func (s *applicationSuite) TestEnsureDisruptionBudgetNotValid(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateful, false)
+	err := app.Ensure(caas.ApplicationConfig{
+		CharmBaseImagePath: "ubuntu@22.04",
+		Constraints:        constraints.MustParse("tags=pdb.min-available=1,pdb.max-unavailable=1"),
+	})
+	c.Assert(err, gc.ErrorMatches, `processing disruption budget constraints: specifying both "pdb.min-available" and "pdb.max-unavailable" not valid`)
+}
+
 func (s *applicationSuite) TestEnsureDaemon(c *gc.C) {
 	app, _ := s.getApp(c, caas.DeploymentDaemon, false)
 	s.assertEnsure(
@@ -1160,6 +1209,36 @@ func (s *applicationSuite) TestUpgradeStateful(c *gc.C) {
 	})
 }
 
+func (s *applicationSuite) TestUpgradeStateless(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateless, false)
+	s.assertEnsure(c, app, false, constraints.Value{}, true, false, "", func() {})
+
+	err := app.Upgrade(semversion.MustParse("3.6.1"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	d, err := s.client.AppsV1().Deployments("test").Get(context.Background(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(d.Annotations["juju.is/version"], gc.Equals, "3.6.1")
+	c.Assert(d.Spec.Template.Annotations["juju.is/version"], gc.Equals, "3.6.1")
+	c.Assert(d.Spec.Template.Spec.InitContainers, gc.HasLen, 1)
+	c.Assert(d.Spec.Template.Spec.InitContainers[0].Image, gc.Equals, "operator/image-path:3.6.1")
+}
+
+func (s *applicationSuite) TestUpgradeDaemon(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentDaemon, false)
+	s.assertEnsure(c, app, false, constraints.Value{}, true, false, "", func() {})
+
+	err := app.Upgrade(semversion.MustParse("3.6.1"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	ds, err := s.client.AppsV1().DaemonSets("test").Get(context.Background(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ds.Annotations["juju.is/version"], gc.Equals, "3.6.1")
+	c.Assert(ds.Spec.Template.Annotations["juju.is/version"], gc.Equals, "3.6.1")
+	c.Assert(ds.Spec.Template.Spec.InitContainers, gc.HasLen, 1)
+	c.Assert(ds.Spec.Template.Spec.InitContainers[0].Image, gc.Equals, "operator/image-path:3.6.1")
+}
+
 func (s *applicationSuite) TestDeleteStateful(c *gc.C) {
 	app, ctrl := s.getApp(c, caas.DeploymentStateful, true)
 	defer ctrl.Finish()
@@ -1167,6 +1246,8 @@ func (s *applicationSuite) TestDeleteStateful(c *gc.C) {
 	gomock.InOrder(
 		s.applier.EXPECT().Delete(resources.NewStatefulSet("gitlab", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewService("gitlab-endpoints", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewPodDisruptionBudget("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewNetworkPolicy("gitlab", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewService("gitlab", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewSecret("gitlab-application-config", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewRoleBinding("gitlab", "test", nil)),
@@ -1185,6 +1266,8 @@ func (s *applicationSuite) TestDeleteStateless(c *gc.C) {
 
 	gomock.InOrder(
 		s.applier.EXPECT().Delete(resources.NewDeployment("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewPodDisruptionBudget("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewNetworkPolicy("gitlab", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewService("gitlab", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewSecret("gitlab-application-config", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewRoleBinding("gitlab", "test", nil)),
@@ -1203,6 +1286,8 @@ func (s *applicationSuite) TestDeleteDaemon(c *gc.C) {
 
 	gomock.InOrder(
 		s.applier.EXPECT().Delete(resources.NewDaemonSet("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewPodDisruptionBudget("gitlab", "test", nil)),
+		s.applier.EXPECT().Delete(resources.NewNetworkPolicy("gitlab", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewService("gitlab", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewSecret("gitlab-application-config", "test", nil)),
 		s.applier.EXPECT().Delete(resources.NewRoleBinding("gitlab", "test", nil)),
@@ -2559,7 +2644,7 @@ func (s *applicationSuite) TestServiceActive(c *gc.C) {
 	})
 }
 
-func (s *applicationSuite) TestServiceNotSupportedDaemon(c *gc.C) {
+func (s *applicationSuite) TestServiceActiveDaemon(c *gc.C) {
 	app, _ := s.getApp(c, caas.DeploymentDaemon, false)
 	s.assertEnsure(
 		c, app, false, constraints.Value{}, false, false, "", func() {},
@@ -2572,11 +2657,18 @@ func (s *applicationSuite) TestServiceNotSupportedDaemon(c *gc.C) {
 	_, err := s.client.CoreV1().Services("test").Update(context.Background(), testSvc, metav1.UpdateOptions{})
 	c.Assert(err, jc.ErrorIsNil)
 
-	_, err = app.Service()
-	c.Assert(err, gc.ErrorMatches, `deployment type "daemon" not supported`)
+	ds, err := s.client.AppsV1().DaemonSets("test").Get(context.Background(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	ds.Status.NumberReady = 2
+	_, err = s.client.AppsV1().DaemonSets("test").Update(context.Background(), ds, metav1.UpdateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	svc, err := app.Service()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svc.Status.Status, gc.Equals, status.Active)
 }
 
-func (s *applicationSuite) TestServiceNotSupportedStateless(c *gc.C) {
+func (s *applicationSuite) TestServiceActiveStateless(c *gc.C) {
 	app, _ := s.getApp(c, caas.DeploymentStateless, false)
 	s.assertEnsure(
 		c, app, false, constraints.Value{}, false, false, "", func() {},
@@ -2589,8 +2681,47 @@ func (s *applicationSuite) TestServiceNotSupportedStateless(c *gc.C) {
 	_, err := s.client.CoreV1().Services("test").Update(context.Background(), testSvc, metav1.UpdateOptions{})
 	c.Assert(err, jc.ErrorIsNil)
 
-	_, err = app.Service()
-	c.Assert(err, gc.ErrorMatches, `deployment type "stateless" not supported`)
+	d, err := s.client.AppsV1().Deployments("test").Get(context.Background(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	d.Status.ReadyReplicas = 1
+	_, err = s.client.AppsV1().Deployments("test").Update(context.Background(), d, metav1.UpdateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	svc, err := app.Service()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svc.Status.Status, gc.Equals, status.Active)
+}
+
+func (s *applicationSuite) TestServiceErrorStateless(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateless, false)
+	s.assertEnsure(
+		c, app, false, constraints.Value{}, false, false, "", func() {},
+	)
+	defer s.assertDelete(c, app)
+
+	evt := corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "evt1",
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Name: "gitlab",
+			Kind: "Deployment",
+		},
+		Type:    corev1.EventTypeWarning,
+		Reason:  "FailedCreate",
+		Message: "quota exceeded",
+	}
+	_, err := s.client.CoreV1().Events("test").Create(context.Background(), &evt, metav1.CreateOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() {
+		_ = s.client.CoreV1().Events("test").Delete(context.Background(), evt.GetName(), metav1.DeleteOptions{})
+	}()
+
+	svc, err := app.Service()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svc.Status.Status, gc.Equals, status.Error)
+	c.Assert(svc.Status.Message, gc.Equals, "quota exceeded")
 }
 
 func (s *applicationSuite) TestServiceTerminated(c *gc.C) {
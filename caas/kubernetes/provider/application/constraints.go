@@ -6,6 +6,7 @@ package application
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/juju/errors"
@@ -114,6 +115,13 @@ const (
 	antiPodPrefix  = "anti-pod."
 	topologyKeyTag = "topology-key"
 	nodePrefix     = "node."
+	spreadPrefix   = "spread."
+	pdbPrefix      = "pdb."
+)
+
+const (
+	spreadZoneTag = spreadPrefix + "zone"
+	spreadNodeTag = spreadPrefix + "node"
 )
 
 func processNodeAffinity(pod *core.PodSpec, affinityLabels map[string]string) error {
@@ -126,7 +134,8 @@ func processNodeAffinity(pod *core.PodSpec, affinityLabels map[string]string) er
 			}
 			key = key[1:]
 		}
-		if strings.HasPrefix(key, podPrefix) || strings.HasPrefix(key, antiPodPrefix) {
+		if strings.HasPrefix(key, podPrefix) || strings.HasPrefix(key, antiPodPrefix) ||
+			strings.HasPrefix(key, spreadPrefix) || strings.HasPrefix(key, pdbPrefix) {
 			continue
 		}
 		key = strings.TrimPrefix(keyVal, nodePrefix)
@@ -266,6 +275,50 @@ func processPodAffinity(pod *core.PodSpec, affinityLabels map[string]string) err
 	return nil
 }
 
+// topologySpreadConstraints returns the constraints which spread the pods
+// matching the selector across zones or nodes. They are requested with the
+// "spread.zone" and "spread.node" tags, whose values are the maximum skew
+// allowed between the number of pods in any two zones or nodes.
+func topologySpreadConstraints(cons constraints.Value, selector map[string]string) ([]core.TopologySpreadConstraint, error) {
+	if cons.Tags == nil {
+		return nil, nil
+	}
+	topologyKeys := map[string]string{
+		spreadZoneTag: "topology.kubernetes.io/zone",
+		spreadNodeTag: "kubernetes.io/hostname",
+	}
+	var result []core.TopologySpreadConstraint
+	for _, labelPair := range *cons.Tags {
+		key, value, _ := strings.Cut(labelPair, "=")
+		key = strings.Trim(key, " ")
+		value = strings.Trim(value, " ")
+		if !strings.HasPrefix(key, spreadPrefix) {
+			continue
+		}
+		topologyKey, ok := topologyKeys[key]
+		if !ok {
+			return nil, errors.NotValidf("spread constraint %q", key)
+		}
+		maxSkew, err := strconv.Atoi(value)
+		if err != nil || maxSkew < 1 {
+			return nil, errors.NotValidf("maximum skew %q for %q", value, key)
+		}
+		result = append(result, core.TopologySpreadConstraint{
+			MaxSkew:           int32(maxSkew),
+			TopologyKey:       topologyKey,
+			WhenUnsatisfiable: core.DoNotSchedule,
+			LabelSelector: &v1.LabelSelector{
+				MatchLabels: selector,
+			},
+		})
+	}
+	// Sort for stable ordering.
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TopologyKey < result[j].TopologyKey
+	})
+	return result, nil
+}
+
 func configureConstraint(pod *core.PodSpec, resourceName core.ResourceName, value string) (err error) {
 	if len(pod.Containers) == 0 {
 		return nil
@@ -223,3 +223,13 @@ func (s *applyConstraintsSuite) TestNodeAntiAffinity(c *gc.C) {
 	c.Assert(pod.Affinity.PodAffinity, gc.IsNil)
 	c.Assert(pod.Affinity.PodAntiAffinity, gc.IsNil)
 }
+
+func (s *applyConstraintsSuite) TestSpreadAndDisruptionBudgetNotNodeAffinity(c *gc.C) {
+	configureConstraint := func(pod *corev1.PodSpec, resourceName corev1.ResourceName, value string) (err error) {
+		return errors.New("unexpected")
+	}
+	pod := &corev1.PodSpec{}
+	err := application.ApplyConstraints(pod, "foo", constraints.MustParse("tags=spread.node=1,pdb.min-available=2"), configureConstraint)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pod.Affinity, gc.IsNil)
+}
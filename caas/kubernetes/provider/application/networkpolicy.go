@@ -0,0 +1,120 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/caas/kubernetes/provider/resources"
+	"github.com/juju/juju/caas/kubernetes/provider/utils"
+	"github.com/juju/juju/core/network"
+)
+
+// UpdateNetworkPolicy restricts the traffic reaching the units of the
+// application to that allowed by the policy. The units of the application
+// may always reach each other. A nil policy removes the restriction.
+func (a *app) UpdateNetworkPolicy(policy *caas.NetworkPolicy) error {
+	applier := a.newApplier()
+	if policy == nil {
+		applier.Delete(resources.NewNetworkPolicy(a.name, a.namespace, nil))
+		return applier.Run(context.Background(), a.client, false)
+	}
+
+	rules := []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: a.selectorLabels(),
+			},
+		}},
+	}}
+	for _, in := range policy.Ingress {
+		rule, ok := a.networkPolicyIngressRule(in)
+		if ok {
+			rules = append(rules, rule)
+		}
+	}
+	applier.Apply(resources.NewNetworkPolicy(a.name, a.namespace, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: a.labels(),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: a.selectorLabels(),
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     rules,
+		},
+	}))
+	err := applier.Run(context.Background(), a.client, false)
+	return errors.Trace(err)
+}
+
+// networkPolicyIngressRule returns the ingress rule for the specified
+// ingress, or false if the rule would allow no traffic at all.
+func (a *app) networkPolicyIngressRule(in caas.NetworkPolicyIngress) (networkingv1.NetworkPolicyIngressRule, bool) {
+	var rule networkingv1.NetworkPolicyIngressRule
+	for _, appName := range in.Applications {
+		rule.From = append(rule.From, networkingv1.NetworkPolicyPeer{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: utils.SelectorLabelsForApp(appName, a.labelVersion),
+			},
+		})
+	}
+	for _, cidr := range in.CIDRs {
+		rule.From = append(rule.From, networkingv1.NetworkPolicyPeer{
+			IPBlock: &networkingv1.IPBlock{CIDR: cidr},
+		})
+	}
+	if len(rule.From) == 0 {
+		return rule, false
+	}
+	for _, portRange := range in.Ports {
+		port, ok := networkPolicyPort(portRange)
+		if ok {
+			rule.Ports = append(rule.Ports, port)
+		}
+	}
+	// A rule without ports allows traffic to all ports, so a rule whose
+	// ports are all unsupported must be dropped rather than widened.
+	if len(in.Ports) > 0 && len(rule.Ports) == 0 {
+		return rule, false
+	}
+	return rule, true
+}
+
+// networkPolicyPort returns the network policy port for the specified port
+// range, or false if the protocol is not supported by network policies.
+func networkPolicyPort(portRange network.PortRange) (networkingv1.NetworkPolicyPort, bool) {
+	var protocol corev1.Protocol
+	switch strings.ToLower(portRange.Protocol) {
+	case "tcp":
+		protocol = corev1.ProtocolTCP
+	case "udp":
+		protocol = corev1.ProtocolUDP
+	case "sctp":
+		protocol = corev1.ProtocolSCTP
+	default:
+		return networkingv1.NetworkPolicyPort{}, false
+	}
+	port := networkingv1.NetworkPolicyPort{
+		Protocol: &protocol,
+	}
+	if portRange.FromPort > 0 {
+		from := intstr.FromInt32(int32(portRange.FromPort))
+		port.Port = &from
+		if portRange.ToPort > portRange.FromPort {
+			to := int32(portRange.ToPort)
+			port.EndPort = &to
+		}
+	}
+	return port, true
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application_test
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/network"
+)
+
+func (s *applicationSuite) TestUpdateNetworkPolicy(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateless, false)
+
+	err := app.UpdateNetworkPolicy(&caas.NetworkPolicy{
+		Ingress: []caas.NetworkPolicyIngress{{
+			Applications: []string{"postgresql"},
+			Ports:        []network.PortRange{network.MustParsePortRange("5432/tcp")},
+		}, {
+			CIDRs: []string{"10.0.0.0/24"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("8000-8080/tcp"),
+				network.MustParsePortRange("icmp"),
+			},
+		}, {
+			// Only unsupported protocols, so no rule at all.
+			CIDRs: []string{"0.0.0.0/0"},
+			Ports: []network.PortRange{network.MustParsePortRange("icmp")},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	np, err := s.client.NetworkingV1().NetworkPolicies(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	tcp := corev1.ProtocolTCP
+	port5432 := intstr.FromInt32(5432)
+	port8000 := intstr.FromInt32(8000)
+	endPort := int32(8080)
+	c.Assert(np.Labels, gc.DeepEquals, map[string]string{
+		"app.kubernetes.io/name":       "gitlab",
+		"app.kubernetes.io/managed-by": "juju",
+	})
+	c.Assert(np.Spec, gc.DeepEquals, networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{
+			MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+		},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{{
+			From: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app.kubernetes.io/name": "gitlab"},
+				},
+			}},
+		}, {
+			From: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app.kubernetes.io/name": "postgresql"},
+				},
+			}},
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: &tcp,
+				Port:     &port5432,
+			}},
+		}, {
+			From: []networkingv1.NetworkPolicyPeer{{
+				IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/24"},
+			}},
+			Ports: []networkingv1.NetworkPolicyPort{{
+				Protocol: &tcp,
+				Port:     &port8000,
+				EndPort:  &endPort,
+			}},
+		}},
+	})
+}
+
+func (s *applicationSuite) TestUpdateNetworkPolicyAllPorts(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateless, false)
+
+	err := app.UpdateNetworkPolicy(&caas.NetworkPolicy{
+		Ingress: []caas.NetworkPolicyIngress{{
+			Applications: []string{"postgresql"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	np, err := s.client.NetworkingV1().NetworkPolicies(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(np.Spec.Ingress, gc.HasLen, 2)
+	// A rule without ports allows the related units to reach all the
+	// ports of the application's containers.
+	c.Assert(np.Spec.Ingress[1], gc.DeepEquals, networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app.kubernetes.io/name": "postgresql"},
+			},
+		}},
+	})
+}
+
+func (s *applicationSuite) TestUpdateNetworkPolicyNilRemovesPolicy(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateless, false)
+
+	err := app.UpdateNetworkPolicy(&caas.NetworkPolicy{})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.client.NetworkingV1().NetworkPolicies(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = app.UpdateNetworkPolicy(nil)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.client.NetworkingV1().NetworkPolicies(s.namespace).Get(context.Background(), s.appName, metav1.GetOptions{})
+	c.Assert(k8serrors.IsNotFound(err), jc.IsTrue)
+
+	// Removing a policy which does not exist is not an error.
+	err = app.UpdateNetworkPolicy(nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
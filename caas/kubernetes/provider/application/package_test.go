@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/caas/kubernetes/provider/resources"
 	k8sutils "github.com/juju/juju/caas/kubernetes/provider/utils"
 	k8swatcher "github.com/juju/juju/caas/kubernetes/provider/watcher"
+	"github.com/juju/juju/core/semversion"
 )
 
 func Test(t *testing.T) {
@@ -32,6 +33,7 @@ func (a *app) LabelVersion() constants.LabelVersion {
 type ApplicationInterfaceForTest interface {
 	caas.Application
 	LabelVersion() constants.LabelVersion
+	Upgrade(semversion.Number) error
 }
 
 func NewApplicationForTest(
@@ -6,15 +6,24 @@ package application
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/juju/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/juju/juju/caas"
 	"github.com/juju/juju/caas/kubernetes/provider/scale"
+	"github.com/juju/juju/caas/kubernetes/provider/utils"
 )
 
+// podDeletionCostAnnotation is the Kubernetes annotation used to rank which
+// pods of a replica set are removed first when scaling down.
+const podDeletionCostAnnotation = "controller.kubernetes.io/pod-deletion-cost"
+
 // Scale scales the Application's unit to the value specificied. Scale must
 // be >= 0. Application units will be removed or added to meet the scale
 // defined.
@@ -57,6 +66,18 @@ func (a *app) currentScale(ctx context.Context) (int, error) {
 
 		return int(*ss.Spec.Replicas), nil
 
+	case caas.DeploymentStateless:
+		d, err := a.client.AppsV1().Deployments(a.namespace).Get(ctx, a.name, meta.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			err = errors.WithType(err, errors.NotFound)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("fetching scale for application %q deployment: %w",
+				a.name, err)
+		}
+
+		return int(*d.Spec.Replicas), nil
+
 	default:
 		return 0, fmt.Errorf("application %q deployment type %q is not supported for fetching scale",
 			a.name, a.deploymentType)
@@ -76,9 +97,70 @@ func (a *app) UnitsToRemove(ctx context.Context, desiredScale int) ([]string, er
 		return unitsToRemove, nil
 	}
 
+	if a.deploymentType == caas.DeploymentStateless {
+		return a.deploymentUnitsToRemove(ctx, -numUnitsToRemove)
+	}
+
 	for ; numUnitsToRemove != 0; numUnitsToRemove++ {
 		unitsToRemove = append(unitsToRemove, fmt.Sprintf("%s/%d", a.name, currentScale+numUnitsToRemove))
 	}
 
 	return unitsToRemove, nil
 }
+
+// deploymentUnitsToRemove picks the newest units of a deployment to remove.
+// Pods of a deployment do not have stable ordinal names, so the units are
+// found from the unit annotation on each pod. The chosen pods are marked with
+// the lowest pod deletion cost so that Kubernetes removes those pods, and not
+// arbitrary ones, when the deployment is scaled down.
+func (a *app) deploymentUnitsToRemove(ctx context.Context, count int) ([]string, error) {
+	pods, err := a.client.CoreV1().Pods(a.namespace).List(ctx, meta.ListOptions{
+		LabelSelector: a.labelSelector(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing pods for application %q: %w", a.name, err)
+	}
+
+	type podUnit struct {
+		podName string
+		unitNum int
+	}
+	var candidates []podUnit
+	for _, p := range pods.Items {
+		if p.DeletionTimestamp != nil {
+			continue
+		}
+		unitID, ok := p.Annotations[utils.AnnotationUnitKey(a.labelVersion)]
+		if !ok {
+			continue
+		}
+		_, num, ok := strings.Cut(unitID, "/")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, podUnit{podName: p.Name, unitNum: n})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].unitNum > candidates[j].unitNum
+	})
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	var unitsToRemove []string
+	for _, c := range candidates[:count] {
+		patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:"-1"}}}`, podDeletionCostAnnotation)
+		_, err := a.client.CoreV1().Pods(a.namespace).Patch(
+			ctx, c.podName, types.StrategicMergePatchType, []byte(patch), meta.PatchOptions{},
+		)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("marking pod %q for removal: %w", c.podName, err)
+		}
+		unitsToRemove = append(unitsToRemove, fmt.Sprintf("%s/%d", a.name, c.unitNum))
+	}
+	return unitsToRemove, nil
+}
@@ -5,13 +5,17 @@ package application_test
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/juju/juju/caas"
+	"github.com/juju/juju/caas/kubernetes/provider/constants"
+	k8sutils "github.com/juju/juju/caas/kubernetes/provider/utils"
 	"github.com/juju/juju/core/constraints"
 )
 
@@ -64,3 +68,37 @@ func (s *applicationSuite) TestCurrentScale(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(units, gc.HasLen, 0)
 }
+
+func (s *applicationSuite) TestUnitsToRemoveStateless(c *gc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateless, false)
+	s.assertEnsure(c, app, false, constraints.Value{}, false, false, "", func() {})
+
+	c.Assert(app.Scale(3), jc.ErrorIsNil)
+	for i, unitNum := range []int{0, 4, 2} {
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("gitlab-6f8b9c-%d", i),
+				Labels: k8sutils.SelectorLabelsForApp(s.appName, constants.LabelVersion2),
+				Annotations: map[string]string{
+					k8sutils.AnnotationUnitKey(constants.LabelVersion2): fmt.Sprintf("gitlab/%d", unitNum),
+				},
+			},
+		}
+		_, err := s.client.CoreV1().Pods(s.namespace).Create(context.Background(), &pod, metav1.CreateOptions{})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	units, err := app.UnitsToRemove(context.Background(), 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(units, jc.SameContents, []string{"gitlab/4", "gitlab/2"})
+
+	for podName, cost := range map[string]string{
+		"gitlab-6f8b9c-0": "",
+		"gitlab-6f8b9c-1": "-1",
+		"gitlab-6f8b9c-2": "-1",
+	} {
+		pod, err := s.client.CoreV1().Pods(s.namespace).Get(context.Background(), podName, metav1.GetOptions{})
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(pod.Annotations["controller.kubernetes.io/pod-deletion-cost"], gc.Equals, cost)
+	}
+}
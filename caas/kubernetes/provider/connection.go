@@ -6,10 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
 
 	"github.com/juju/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/remotecommand"
 
+	"github.com/juju/juju/caas"
+	k8s "github.com/juju/juju/caas/kubernetes"
 	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
 	k8sproxy "github.com/juju/juju/caas/kubernetes/provider/proxy"
 	"github.com/juju/juju/internal/proxy"
@@ -89,3 +93,56 @@ func (k *kubernetesClient) ConnectionProxyInfo(ctx context.Context) (proxy.Proxi
 	}
 	return p, nil
 }
+
+var _ caas.PodDialer = (*kubernetesClient)(nil)
+
+// DialPod dials the port of the named pod in the model's namespace through
+// the k8s API server.
+func (k *kubernetesClient) DialPod(ctx context.Context, podName string, port int) (net.Conn, error) {
+	k.lock.Lock()
+	cfg := k.k8sCfgUnlocked
+	k.lock.Unlock()
+	if cfg == nil {
+		return nil, errors.NotProvisionedf("kubernetes client for pod %q", podName)
+	}
+	return k8s.DialPod(ctx, cfg, k.namespace, podName, port)
+}
+
+var _ caas.PodExecer = (*kubernetesClient)(nil)
+
+// ExecPod runs a command in a container of the named pod in the model's
+// namespace through the k8s API server.
+func (k *kubernetesClient) ExecPod(ctx context.Context, args caas.ExecPodArgs) error {
+	k.lock.Lock()
+	cfg := k.k8sCfgUnlocked
+	k.lock.Unlock()
+	if cfg == nil {
+		return errors.NotProvisionedf("kubernetes client for pod %q", args.PodName)
+	}
+	params := k8s.ExecPodParams{
+		PodName:       args.PodName,
+		ContainerName: args.ContainerName,
+		Command:       args.Command,
+		Stdin:         args.Stdin,
+		Stdout:        args.Stdout,
+		Stderr:        args.Stderr,
+		TTY:           args.TTY,
+	}
+	if args.TerminalSizes != nil {
+		params.TerminalSizeQueue = terminalSizeQueue(args.TerminalSizes)
+	}
+	return k8s.ExecPod(ctx, cfg, k.namespace, params)
+}
+
+// terminalSizeQueue passes terminal sizes received on a channel to the
+// k8s API server.
+type terminalSizeQueue <-chan caas.TerminalSize
+
+// Next returns the next terminal size, or nil once the channel is closed.
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+	return &remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+}
@@ -75,6 +75,11 @@ const (
 	// Since LabelVersion1.
 	LabelJujuStorageName = "storage.juju.is/name"
 
+	// LabelJujuStorageVolume is the juju label applied to volume snapshots to
+	// record the persistent volume they were taken from.
+	// Since LabelVersion1.
+	LabelJujuStorageVolume = "storage.juju.is/volume"
+
 	// LegacyLabelKubernetesAppName is the legacy label key used for juju app
 	// identification. This purely exists to maintain backwards functionality.
 	// See https://bugs.launchpad.net/juju/+bug/1888513
@@ -17,6 +17,8 @@ var unsupportedConstraints = []string{
 	constraints.Spaces,
 	constraints.AllocatePublicIP,
 	constraints.ImageID,
+	constraints.Spot,
+	constraints.SpotMaxPrice,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
@@ -12,9 +12,11 @@ import (
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/juju/juju/caas"
+	"github.com/juju/juju/caas/kubernetes/provider/constants"
 	jujucloud "github.com/juju/juju/cloud"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/internal/cloudconfig/podcfg"
@@ -132,8 +134,14 @@ func (k *kubernetesClient) DeleteNamespaceModelTeardown(ctx context.Context, wg
 	k.deleteNamespaceModelTeardown(ctx, wg, errChan)
 }
 
-func StorageProvider(k8sClient kubernetes.Interface, namespace string) storage.Provider {
-	return &storageProvider{&kubernetesClient{clientUnlocked: k8sClient, namespace: namespace}}
+func StorageProvider(k8sClient kubernetes.Interface, dynamicClient dynamic.Interface, namespace, modelName string) storage.Provider {
+	return &storageProvider{&kubernetesClient{
+		clientUnlocked:        k8sClient,
+		dynamicClientUnlocked: dynamicClient,
+		namespace:             namespace,
+		modelName:             modelName,
+		labelVersion:          constants.LastLabelVersion,
+	}}
 }
 
 func GetCloudProviderFromNodeMeta(node core.Node) (string, string) {
@@ -6,6 +6,8 @@ package provider_test
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
@@ -30,6 +32,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sversion "k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	restfake "k8s.io/client-go/rest/fake"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
 
@@ -1298,3 +1303,53 @@ func (s *K8sBrokerSuite) TestUpdateStrategyForStatefulSet(c *gc.C) {
 		},
 	})
 }
+
+func (s *K8sBrokerSuite) TestApplicationUtilisationWithoutCustomMetricsAPI(c *gc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	s.mockPods.EXPECT().List(gomock.Any(), gomock.Any()).Return(&core.PodList{Items: []core.Pod{{
+		ObjectMeta: v1.ObjectMeta{Name: "gitlab-0"},
+		Spec: core.PodSpec{Containers: []core.Container{{
+			Name: "gitlab",
+			Resources: core.ResourceRequirements{Requests: core.ResourceList{
+				core.ResourceCPU: resource.MustParse("200m"),
+			}},
+		}}},
+		Status: core.PodStatus{Phase: core.PodRunning},
+	}}}, nil)
+
+	// The resource metrics API is served, but there is no custom metrics
+	// adapter in the cluster.
+	metricsClient := &restfake.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		Client: restfake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			if strings.HasPrefix(req.URL.Path, "/apis/custom.metrics.k8s.io/") {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(strings.NewReader(`{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)),
+				}, nil
+			}
+			c.Check(req.URL.Path, gc.Equals, "/apis/metrics.k8s.io/v1beta1/namespaces/test/pods")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body: io.NopCloser(strings.NewReader(
+					`{"items":[{"metadata":{"name":"gitlab-0"},"containers":[{"name":"gitlab","usage":{"cpu":"100m"}}]}]}`,
+				)),
+			}, nil
+		}),
+	}
+	s.mockRestClient.EXPECT().Get().DoAndReturn(func() *rest.Request {
+		return metricsClient.Get()
+	}).Times(2)
+
+	utilisation, err := s.broker.ApplicationUtilisation(context.Background(), "gitlab", "requests-per-second")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(utilisation.Units, gc.Equals, 1)
+	c.Assert(utilisation.CPUPercent, gc.NotNil)
+	c.Check(*utilisation.CPUPercent, gc.Equals, 50.0)
+	c.Check(utilisation.MemoryPercent, gc.IsNil)
+	c.Check(utilisation.CustomMetric, gc.IsNil)
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/juju/errors"
+	core "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/juju/juju/caas"
+)
+
+const (
+	// resourceMetricsPath is the path of the pod metrics served by the
+	// Kubernetes resource metrics API (usually metrics-server).
+	resourceMetricsPath = "/apis/metrics.k8s.io/v1beta1/namespaces/%s/pods"
+
+	// customMetricsPath is the path of a pod metric served by the
+	// Kubernetes custom metrics API (for example prometheus-adapter).
+	customMetricsPath = "/apis/custom.metrics.k8s.io/v1beta2/namespaces/%s/pods/*/%s"
+)
+
+// podMetricsList mirrors the PodMetricsList type of the resource metrics API.
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+type podMetrics struct {
+	Metadata   v1.ObjectMeta      `json:"metadata"`
+	Containers []containerMetrics `json:"containers"`
+}
+
+type containerMetrics struct {
+	Name  string            `json:"name"`
+	Usage core.ResourceList `json:"usage"`
+}
+
+// metricValueList mirrors the MetricValueList type of the custom metrics API.
+type metricValueList struct {
+	Items []metricValue `json:"items"`
+}
+
+type metricValue struct {
+	DescribedObject core.ObjectReference `json:"describedObject"`
+	Value           resource.Quantity    `json:"value"`
+}
+
+// ApplicationUtilisation returns the average resource utilisation of the
+// running units of the specified application. CPU and memory usage are read
+// from the resource metrics API, and the custom metric, if any, from the
+// custom metrics API. An error satisfying [errors.NotSupported] is returned if
+// the cluster does not serve the resource metrics API. If the custom metric
+// is not served, the utilisation is returned without it.
+func (k *kubernetesClient) ApplicationUtilisation(
+	ctx context.Context, appName string, customMetric string,
+) (caas.Utilisation, error) {
+	if k.namespace == "" {
+		return caas.Utilisation{}, errNoNamespace
+	}
+	selector := k.applicationSelector(appName)
+	pods, err := k.client().CoreV1().Pods(k.namespace).List(ctx, v1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return caas.Utilisation{}, errors.Trace(err)
+	}
+
+	var podUsage podMetricsList
+	if err := k.getMetrics(ctx, fmt.Sprintf(resourceMetricsPath, k.namespace), selector, &podUsage); err != nil {
+		return caas.Utilisation{}, errors.Annotatef(err, "reading resource metrics for %q", appName)
+	}
+
+	var custom *metricValueList
+	if customMetric != "" {
+		custom = &metricValueList{}
+		path := fmt.Sprintf(customMetricsPath, k.namespace, customMetric)
+		err := k.getMetrics(ctx, path, selector, custom)
+		if errors.Is(err, errors.NotSupported) {
+			// Without a custom metrics adapter, or a metric of that name,
+			// only the custom metric is missing; the CPU and memory
+			// utilisation can still drive the autoscaler.
+			logger.Warningf(ctx, "custom metric %q for %q not available: %v", customMetric, appName, err)
+			custom = nil
+		} else if err != nil {
+			return caas.Utilisation{}, errors.Annotatef(err, "reading custom metric %q for %q", customMetric, appName)
+		}
+	}
+	return computeUtilisation(pods.Items, podUsage, custom), nil
+}
+
+func (k *kubernetesClient) getMetrics(ctx context.Context, path, selector string, into any) error {
+	data, err := k.client().CoreV1().RESTClient().Get().
+		AbsPath(path).
+		Param("labelSelector", selector).
+		DoRaw(ctx)
+	if k8serrors.IsNotFound(err) {
+		return errors.NotSupportedf("metrics API %q", path)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(json.Unmarshal(data, into))
+}
+
+// computeUtilisation averages the usage of the running pods against the
+// resources they request. Only containers requesting a resource count towards
+// its utilisation. Pods which are terminating, not yet running, or which have
+// no metrics are ignored.
+func computeUtilisation(pods []core.Pod, usage podMetricsList, custom *metricValueList) caas.Utilisation {
+	usageByPod := make(map[string]podMetrics)
+	for _, m := range usage.Items {
+		usageByPod[m.Metadata.Name] = m
+	}
+	customByPod := make(map[string]resource.Quantity)
+	if custom != nil {
+		for _, m := range custom.Items {
+			customByPod[m.DescribedObject.Name] = m.Value
+		}
+	}
+
+	var (
+		result                      caas.Utilisation
+		cpuUsed, cpuRequested       int64
+		memoryUsed, memoryRequested int64
+		customTotal                 float64
+		customUnits                 int
+	)
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != core.PodRunning {
+			continue
+		}
+		metrics, ok := usageByPod[pod.Name]
+		if !ok {
+			continue
+		}
+		result.Units++
+
+		usageByContainer := make(map[string]core.ResourceList)
+		for _, c := range metrics.Containers {
+			usageByContainer[c.Name] = c.Usage
+		}
+		for _, c := range pod.Spec.Containers {
+			used := usageByContainer[c.Name]
+			if cpu, ok := c.Resources.Requests[core.ResourceCPU]; ok && !cpu.IsZero() {
+				cpuRequested += cpu.MilliValue()
+				cpuUsed += used.Cpu().MilliValue()
+			}
+			if memory, ok := c.Resources.Requests[core.ResourceMemory]; ok && !memory.IsZero() {
+				memoryRequested += memory.Value()
+				memoryUsed += used.Memory().Value()
+			}
+		}
+		if value, ok := customByPod[pod.Name]; ok {
+			customTotal += value.AsApproximateFloat64()
+			customUnits++
+		}
+	}
+	if result.Units == 0 {
+		return result
+	}
+
+	if cpuRequested > 0 {
+		percent := float64(cpuUsed) * 100 / float64(cpuRequested)
+		result.CPUPercent = &percent
+	}
+	if memoryRequested > 0 {
+		percent := float64(memoryUsed) * 100 / float64(memoryRequested)
+		result.MemoryPercent = &percent
+	}
+	if customUnits > 0 {
+		average := customTotal / float64(customUnits)
+		result.CustomMetric = &average
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"encoding/json"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type metricsSuite struct{}
+
+var _ = gc.Suite(&metricsSuite{})
+
+func runningPod(name string, requests ...core.ResourceList) core.Pod {
+	pod := core.Pod{
+		ObjectMeta: meta.ObjectMeta{Name: name},
+		Status:     core.PodStatus{Phase: core.PodRunning},
+		Spec: core.PodSpec{
+			Containers: []core.Container{{Name: "charm"}},
+		},
+	}
+	for i, r := range requests {
+		pod.Spec.Containers = append(pod.Spec.Containers, core.Container{
+			Name:      []string{"workload", "sidecar"}[i],
+			Resources: core.ResourceRequirements{Requests: r},
+		})
+	}
+	return pod
+}
+
+func (s *metricsSuite) TestComputeUtilisation(c *gc.C) {
+	requests := core.ResourceList{
+		core.ResourceCPU:    resource.MustParse("500m"),
+		core.ResourceMemory: resource.MustParse("100Mi"),
+	}
+	terminating := runningPod("app-2", requests)
+	terminating.DeletionTimestamp = &meta.Time{}
+	pending := runningPod("app-3", requests)
+	pending.Status.Phase = core.PodPending
+	pods := []core.Pod{
+		runningPod("app-0", requests),
+		runningPod("app-1", requests),
+		terminating,
+		pending,
+	}
+
+	var usage podMetricsList
+	err := json.Unmarshal([]byte(`{"items": [
+		{"metadata": {"name": "app-0"}, "containers": [
+			{"name": "charm", "usage": {"cpu": "900m", "memory": "500Mi"}},
+			{"name": "workload", "usage": {"cpu": "250m", "memory": "50Mi"}}
+		]},
+		{"metadata": {"name": "app-1"}, "containers": [
+			{"name": "charm", "usage": {"cpu": "900m", "memory": "500Mi"}},
+			{"name": "workload", "usage": {"cpu": "750m", "memory": "150Mi"}}
+		]},
+		{"metadata": {"name": "app-2"}, "containers": [
+			{"name": "workload", "usage": {"cpu": "2", "memory": "1Gi"}}
+		]}
+	]}`), &usage)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var custom metricValueList
+	err = json.Unmarshal([]byte(`{"items": [
+		{"describedObject": {"kind": "Pod", "name": "app-0"}, "value": "10"},
+		{"describedObject": {"kind": "Pod", "name": "app-1"}, "value": "30"}
+	]}`), &custom)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result := computeUtilisation(pods, usage, &custom)
+	c.Assert(result.Units, gc.Equals, 2)
+	// The charm container has no requests, so it is not counted.
+	c.Assert(result.CPUPercent, gc.NotNil)
+	c.Check(*result.CPUPercent, gc.Equals, 100.0)
+	c.Assert(result.MemoryPercent, gc.NotNil)
+	c.Check(*result.MemoryPercent, gc.Equals, 100.0)
+	c.Assert(result.CustomMetric, gc.NotNil)
+	c.Check(*result.CustomMetric, gc.Equals, 20.0)
+}
+
+func (s *metricsSuite) TestComputeUtilisationNoRequests(c *gc.C) {
+	pods := []core.Pod{runningPod("app-0")}
+
+	var usage podMetricsList
+	err := json.Unmarshal([]byte(`{"items": [
+		{"metadata": {"name": "app-0"}, "containers": [
+			{"name": "charm", "usage": {"cpu": "100m", "memory": "50Mi"}}
+		]}
+	]}`), &usage)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result := computeUtilisation(pods, usage, nil)
+	c.Check(result.Units, gc.Equals, 1)
+	c.Check(result.CPUPercent, gc.IsNil)
+	c.Check(result.MemoryPercent, gc.IsNil)
+	c.Check(result.CustomMetric, gc.IsNil)
+}
+
+func (s *metricsSuite) TestComputeUtilisationNoMetrics(c *gc.C) {
+	pods := []core.Pod{runningPod("app-0", core.ResourceList{
+		core.ResourceCPU: resource.MustParse("1"),
+	})}
+
+	result := computeUtilisation(pods, podMetricsList{}, nil)
+	c.Check(result.Units, gc.Equals, 0)
+	c.Check(result.CPUPercent, gc.IsNil)
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
+	"github.com/juju/juju/core/status"
+)
+
+// PodDisruptionBudget extends the k8s pod disruption budget.
+type PodDisruptionBudget struct {
+	policyv1.PodDisruptionBudget
+}
+
+// NewPodDisruptionBudget creates a new pod disruption budget resource.
+func NewPodDisruptionBudget(name string, namespace string, in *policyv1.PodDisruptionBudget) *PodDisruptionBudget {
+	if in == nil {
+		in = &policyv1.PodDisruptionBudget{}
+	}
+	in.SetName(name)
+	in.SetNamespace(namespace)
+	return &PodDisruptionBudget{*in}
+}
+
+// Clone returns a copy of the resource.
+func (pdb *PodDisruptionBudget) Clone() Resource {
+	clone := *pdb
+	return &clone
+}
+
+// ID returns a comparable ID for the Resource
+func (pdb *PodDisruptionBudget) ID() ID {
+	return ID{"PodDisruptionBudget", pdb.Name, pdb.Namespace}
+}
+
+// Apply patches the resource change.
+func (pdb *PodDisruptionBudget) Apply(ctx context.Context, client kubernetes.Interface) error {
+	api := client.PolicyV1().PodDisruptionBudgets(pdb.Namespace)
+	data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, &pdb.PodDisruptionBudget)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	res, err := api.Patch(ctx, pdb.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{
+		FieldManager: JujuFieldManager,
+	})
+	if k8serrors.IsNotFound(err) {
+		res, err = api.Create(ctx, &pdb.PodDisruptionBudget, metav1.CreateOptions{
+			FieldManager: JujuFieldManager,
+		})
+	}
+	if k8serrors.IsConflict(err) {
+		return errors.Annotatef(errConflict, "pod disruption budget %q", pdb.Name)
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	pdb.PodDisruptionBudget = *res
+	return nil
+}
+
+// Get refreshes the resource.
+func (pdb *PodDisruptionBudget) Get(ctx context.Context, client kubernetes.Interface) error {
+	api := client.PolicyV1().PodDisruptionBudgets(pdb.Namespace)
+	res, err := api.Get(ctx, pdb.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return errors.NewNotFound(err, "k8s")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	pdb.PodDisruptionBudget = *res
+	return nil
+}
+
+// Delete removes the resource.
+func (pdb *PodDisruptionBudget) Delete(ctx context.Context, client kubernetes.Interface) error {
+	api := client.PolicyV1().PodDisruptionBudgets(pdb.Namespace)
+	err := api.Delete(ctx, pdb.Name, metav1.DeleteOptions{
+		PropagationPolicy: k8sconstants.DefaultPropagationPolicy(),
+	})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// Events emitted by the resource.
+func (pdb *PodDisruptionBudget) Events(ctx context.Context, client kubernetes.Interface) ([]corev1.Event, error) {
+	return ListEventsForObject(ctx, client, pdb.Namespace, pdb.Name, "PodDisruptionBudget")
+}
+
+// ComputeStatus returns a juju status for the resource.
+func (pdb *PodDisruptionBudget) ComputeStatus(_ context.Context, _ kubernetes.Interface, now time.Time) (string, status.Status, time.Time, error) {
+	if pdb.DeletionTimestamp != nil {
+		return "", status.Terminated, pdb.DeletionTimestamp.Time, nil
+	}
+	return "", status.Active, now, nil
+}
@@ -7,15 +7,21 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/juju/errors"
 	core "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/juju/juju/caas/kubernetes/provider/constants"
 	"github.com/juju/juju/caas/kubernetes/provider/storage"
+	"github.com/juju/juju/caas/kubernetes/provider/utils"
 	jujustorage "github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/uuid"
 )
 
 func validateStorageAttributes(attributes map[string]interface{}) error {
@@ -102,7 +108,19 @@ type volumeSource struct {
 	client *kubernetesClient
 }
 
-var _ jujustorage.VolumeSource = (*volumeSource)(nil)
+var (
+	_ jujustorage.VolumeSource      = (*volumeSource)(nil)
+	_ jujustorage.VolumeResizer     = (*volumeSource)(nil)
+	_ jujustorage.VolumeSnapshotter = (*volumeSource)(nil)
+)
+
+// volumeSnapshotResource is the CSI external snapshotter resource used to
+// snapshot persistent volume claims.
+var volumeSnapshotResource = schema.GroupVersionResource{
+	Group:    "snapshot.storage.k8s.io",
+	Version:  "v1",
+	Resource: "volumesnapshots",
+}
 
 // CreateVolumes is specified on the jujustorage.VolumeSource interface.
 func (v *volumeSource) CreateVolumes(ctx context.Context, params []jujustorage.VolumeParams) (_ []jujustorage.CreateVolumesResult, err error) {
@@ -206,6 +224,184 @@ func (v *volumeSource) DetachVolumes(ctx context.Context, attachParams []jujusto
 	return make([]error, len(attachParams)), nil
 }
 
+// ResizeVolumes is specified on the jujustorage.VolumeResizer interface.
+// The volumes are resized by expanding the persistent volume claims bound to
+// them, which requires the storage class to allow volume expansion.
+func (v *volumeSource) ResizeVolumes(ctx context.Context, params []jujustorage.VolumeResizeParams) ([]jujustorage.ResizeVolumesResult, error) {
+	results := make([]jujustorage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		size, err := v.resizeVolume(ctx, p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %v", p.VolumeId)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (v *volumeSource) resizeVolume(ctx context.Context, p jujustorage.VolumeResizeParams) (uint64, error) {
+	vol, err := v.client.client().CoreV1().PersistentVolumes().Get(ctx, p.VolumeId, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return 0, errors.NotFoundf("volume %v", p.VolumeId)
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	claimRef := vol.Spec.ClaimRef
+	if claimRef == nil {
+		return 0, errors.NotValidf("volume %v without a claim", p.VolumeId)
+	}
+
+	pClaims := v.client.client().CoreV1().PersistentVolumeClaims(claimRef.Namespace)
+	pvc, err := pClaims.Get(ctx, claimRef.Name, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return 0, errors.NotFoundf("volume claim %v", claimRef.Name)
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	current := pvc.Spec.Resources.Requests[core.ResourceStorage]
+	// Round the requested storage up to whole MiB.
+	currentSize := uint64((current.Value() + 1024*1024 - 1) / (1024 * 1024))
+	if p.Size <= currentSize {
+		return currentSize, nil
+	}
+
+	if pvc.Spec.Resources.Requests == nil {
+		pvc.Spec.Resources.Requests = core.ResourceList{}
+	}
+	pvc.Spec.Resources.Requests[core.ResourceStorage] = resource.MustParse(fmt.Sprintf("%dMi", p.Size))
+	if _, err := pClaims.Update(ctx, pvc, v1.UpdateOptions{}); err != nil {
+		return 0, errors.Annotatef(err, "expanding volume claim %v", claimRef.Name)
+	}
+	return p.Size, nil
+}
+
+// CreateVolumeSnapshots is specified on the jujustorage.VolumeSnapshotter
+// interface. A VolumeSnapshot is created for the claim bound to each volume,
+// using the cluster's default volume snapshot class.
+func (v *volumeSource) CreateVolumeSnapshots(ctx context.Context, params []jujustorage.VolumeSnapshotParams) ([]jujustorage.CreateSnapshotsResult, error) {
+	results := make([]jujustorage.CreateSnapshotsResult, len(params))
+	for i, p := range params {
+		snapshot, err := v.createVolumeSnapshot(ctx, p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "creating snapshot of volume %v", p.VolumeId)
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (v *volumeSource) createVolumeSnapshot(ctx context.Context, p jujustorage.VolumeSnapshotParams) (*jujustorage.Snapshot, error) {
+	vol, err := v.client.client().CoreV1().PersistentVolumes().Get(ctx, p.VolumeId, v1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, errors.NotFoundf("volume %v", p.VolumeId)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	claimRef := vol.Spec.ClaimRef
+	if claimRef == nil {
+		return nil, errors.NotValidf("volume %v without a claim", p.VolumeId)
+	}
+
+	snapshotUUID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, errors.Annotate(err, "generating snapshot name")
+	}
+	// Resource tags are not valid label values, so the model labels are
+	// applied instead.
+	snapshotLabels := map[string]interface{}{
+		constants.LabelJujuStorageVolume: p.VolumeId,
+	}
+	modelLabels := utils.LabelsForModel(
+		v.client.ModelName(), v.client.ModelUUID(), v.client.ControllerUUID(), v.client.LabelVersion())
+	for k, v := range modelLabels {
+		snapshotLabels[k] = v
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": volumeSnapshotResource.GroupVersion().String(),
+		"kind":       "VolumeSnapshot",
+		"metadata": map[string]interface{}{
+			"name":   "snap-" + snapshotUUID.String(),
+			"labels": snapshotLabels,
+		},
+		"spec": map[string]interface{}{
+			"source": map[string]interface{}{
+				"persistentVolumeClaimName": claimRef.Name,
+			},
+		},
+	}}
+	out, err := v.client.dynamicClient().Resource(volumeSnapshotResource).
+		Namespace(claimRef.Namespace).Create(ctx, obj, v1.CreateOptions{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshot := k8sToJujuSnapshot(p.VolumeId, out)
+	return &snapshot, nil
+}
+
+// ListVolumeSnapshots is specified on the jujustorage.VolumeSnapshotter
+// interface.
+func (v *volumeSource) ListVolumeSnapshots(ctx context.Context, volumeIds []string) ([]jujustorage.ListSnapshotsResult, error) {
+	snapshots := v.client.dynamicClient().Resource(volumeSnapshotResource).Namespace(v.client.namespace)
+	results := make([]jujustorage.ListSnapshotsResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		list, err := snapshots.List(ctx, v1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", constants.LabelJujuStorageVolume, volumeId),
+		})
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "listing snapshots of volume %v", volumeId)
+			continue
+		}
+		for _, item := range list.Items {
+			results[i].Snapshots = append(results[i].Snapshots, k8sToJujuSnapshot(volumeId, &item))
+		}
+	}
+	return results, nil
+}
+
+// DeleteVolumeSnapshots is specified on the jujustorage.VolumeSnapshotter
+// interface.
+func (v *volumeSource) DeleteVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	snapshots := v.client.dynamicClient().Resource(volumeSnapshotResource).Namespace(v.client.namespace)
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		err := snapshots.Delete(ctx, snapshotId, v1.DeleteOptions{
+			PropagationPolicy: constants.DefaultPropagationPolicy(),
+		})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			results[i] = errors.Annotatef(err, "deleting snapshot %v", snapshotId)
+		}
+	}
+	return results, nil
+}
+
+// k8sToJujuSnapshot converts a VolumeSnapshot object into a
+// jujustorage.Snapshot.
+func k8sToJujuSnapshot(volumeId string, obj *unstructured.Unstructured) jujustorage.Snapshot {
+	snapshot := jujustorage.Snapshot{
+		SnapshotId: obj.GetName(),
+		SourceId:   volumeId,
+		Created:    obj.GetCreationTimestamp().Time,
+		Status:     "pending",
+	}
+	if ready, _, _ := unstructured.NestedBool(obj.Object, "status", "readyToUse"); ready {
+		snapshot.Status = "ready"
+	}
+	if created, ok, _ := unstructured.NestedString(obj.Object, "status", "creationTime"); ok {
+		if t, err := time.Parse(time.RFC3339, created); err == nil {
+			snapshot.Created = t
+		}
+	}
+	if size, ok, _ := unstructured.NestedString(obj.Object, "status", "restoreSize"); ok {
+		if q, err := resource.ParseQuantity(size); err == nil {
+			// Round the restore size up to whole MiB.
+			snapshot.Size = uint64((q.Value() + 1024*1024 - 1) / (1024 * 1024))
+		}
+	}
+	return snapshot
+}
+
 func foreachVolume(volumeIds []string, f func(string) error) []error {
 	results := make([]error, len(volumeIds))
 	var wg sync.WaitGroup
@@ -5,13 +5,17 @@ package provider_test
 
 import (
 	"context"
+	"time"
 
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 	core "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/juju/juju/caas/kubernetes/provider"
 	"github.com/juju/juju/caas/kubernetes/provider/constants"
@@ -25,7 +29,7 @@ type storageSuite struct {
 }
 
 func (s *storageSuite) k8sProvider(c *gc.C, ctrl *gomock.Controller) storage.Provider {
-	return provider.StorageProvider(s.k8sClient, s.getNamespace())
+	return provider.StorageProvider(s.k8sClient, s.mockDynamicClient, s.getNamespace(), "test")
 }
 
 func (s *storageSuite) TestValidateConfig(c *gc.C) {
@@ -173,6 +177,203 @@ func (s *storageSuite) TestDescribeVolumes(c *gc.C) {
 	}})
 }
 
+func (s *storageSuite) TestResizeVolumes(c *gc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	pvc := &core.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{Name: "vol-1-pvc", Namespace: "test"},
+		Spec: core.PersistentVolumeClaimSpec{
+			Resources: core.VolumeResourceRequirements{
+				Requests: core.ResourceList{core.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		},
+	}
+	expanded := pvc.DeepCopy()
+	expanded.Spec.Resources.Requests[core.ResourceStorage] = resource.MustParse("2048Mi")
+
+	gomock.InOrder(
+		s.mockPersistentVolumes.EXPECT().Get(gomock.Any(), "vol-1", v1.GetOptions{}).
+			Return(&core.PersistentVolume{
+				Spec: core.PersistentVolumeSpec{
+					ClaimRef: &core.ObjectReference{Namespace: "test", Name: "vol-1-pvc"},
+				}}, nil),
+		s.mockPersistentVolumeClaims.EXPECT().Get(gomock.Any(), "vol-1-pvc", v1.GetOptions{}).
+			Return(pvc, nil),
+		s.mockPersistentVolumeClaims.EXPECT().Update(gomock.Any(), expanded, v1.UpdateOptions{}).
+			Return(expanded, nil),
+	)
+
+	p := s.k8sProvider(c, ctrl)
+	vs, err := p.VolumeSource(&storage.Config{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(vs, gc.Implements, new(storage.VolumeResizer))
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		VolumeId: "vol-1",
+		Size:     2048,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{Size: 2048}})
+}
+
+func (s *storageSuite) TestResizeVolumesNoShrink(c *gc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		s.mockPersistentVolumes.EXPECT().Get(gomock.Any(), "vol-1", v1.GetOptions{}).
+			Return(&core.PersistentVolume{
+				Spec: core.PersistentVolumeSpec{
+					ClaimRef: &core.ObjectReference{Namespace: "test", Name: "vol-1-pvc"},
+				}}, nil),
+		s.mockPersistentVolumeClaims.EXPECT().Get(gomock.Any(), "vol-1-pvc", v1.GetOptions{}).
+			Return(&core.PersistentVolumeClaim{
+				Spec: core.PersistentVolumeClaimSpec{
+					Resources: core.VolumeResourceRequirements{
+						Requests: core.ResourceList{core.ResourceStorage: resource.MustParse("4Gi")},
+					},
+				}}, nil),
+	)
+
+	p := s.k8sProvider(c, ctrl)
+	vs, err := p.VolumeSource(&storage.Config{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		VolumeId: "vol-1",
+		Size:     2048,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{Size: 4096}})
+}
+
+var volumeSnapshotResource = schema.GroupVersionResource{
+	Group:    "snapshot.storage.k8s.io",
+	Version:  "v1",
+	Resource: "volumesnapshots",
+}
+
+func (s *storageSuite) TestCreateVolumeSnapshots(c *gc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		s.mockPersistentVolumes.EXPECT().Get(gomock.Any(), "vol-1", v1.GetOptions{}).
+			Return(&core.PersistentVolume{
+				Spec: core.PersistentVolumeSpec{
+					ClaimRef: &core.ObjectReference{Namespace: "test", Name: "vol-1-pvc"},
+				}}, nil),
+		s.mockDynamicClient.EXPECT().Resource(volumeSnapshotResource).
+			Return(s.mockNamespaceableResourceClient),
+		s.mockResourceClient.EXPECT().Create(gomock.Any(), gomock.Any(), v1.CreateOptions{}).
+			DoAndReturn(func(_ context.Context, obj *unstructured.Unstructured, _ v1.CreateOptions, _ ...string) (*unstructured.Unstructured, error) {
+				c.Check(obj.GetKind(), gc.Equals, "VolumeSnapshot")
+				c.Check(obj.GetName(), gc.Matches, "snap-.+")
+				c.Check(obj.GetLabels()["storage.juju.is/volume"], gc.Equals, "vol-1")
+				c.Check(obj.GetLabels()["model.juju.is/name"], gc.Equals, "test")
+				claim, _, _ := unstructured.NestedString(obj.Object, "spec", "source", "persistentVolumeClaimName")
+				c.Check(claim, gc.Equals, "vol-1-pvc")
+				obj.SetName("snap-1")
+				return obj, nil
+			}),
+	)
+
+	p := s.k8sProvider(c, ctrl)
+	vs, err := p.VolumeSource(&storage.Config{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(vs, gc.Implements, new(storage.VolumeSnapshotter))
+
+	results, err := vs.(storage.VolumeSnapshotter).CreateVolumeSnapshots(context.Background(), []storage.VolumeSnapshotParams{{
+		VolumeId: "vol-1",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateSnapshotsResult{{
+		Snapshot: &storage.Snapshot{
+			SnapshotId: "snap-1",
+			SourceId:   "vol-1",
+			Status:     "pending",
+		},
+	}})
+}
+
+func (s *storageSuite) TestCreateVolumeSnapshotsNoClaim(c *gc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	s.mockPersistentVolumes.EXPECT().Get(gomock.Any(), "vol-1", v1.GetOptions{}).
+		Return(&core.PersistentVolume{}, nil)
+
+	p := s.k8sProvider(c, ctrl)
+	vs, err := p.VolumeSource(&storage.Config{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := vs.(storage.VolumeSnapshotter).CreateVolumeSnapshots(context.Background(), []storage.VolumeSnapshotParams{{
+		VolumeId: "vol-1",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "creating snapshot of volume vol-1: volume vol-1 without a claim not valid")
+}
+
+func (s *storageSuite) TestListVolumeSnapshots(c *gc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	snapshot := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "snap-1"},
+		"status": map[string]interface{}{
+			"readyToUse":   true,
+			"creationTime": "2025-01-02T03:04:05Z",
+			"restoreSize":  "1Gi",
+		},
+	}}
+	gomock.InOrder(
+		s.mockDynamicClient.EXPECT().Resource(volumeSnapshotResource).
+			Return(s.mockNamespaceableResourceClient),
+		s.mockResourceClient.EXPECT().List(gomock.Any(), v1.ListOptions{LabelSelector: "storage.juju.is/volume=vol-1"}).
+			Return(&unstructured.UnstructuredList{Items: []unstructured.Unstructured{*snapshot}}, nil),
+	)
+
+	p := s.k8sProvider(c, ctrl)
+	vs, err := p.VolumeSource(&storage.Config{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := vs.(storage.VolumeSnapshotter).ListVolumeSnapshots(context.Background(), []string{"vol-1"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ListSnapshotsResult{{
+		Snapshots: []storage.Snapshot{{
+			SnapshotId: "snap-1",
+			SourceId:   "vol-1",
+			Size:       1024,
+			Created:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Status:     "ready",
+		}},
+	}})
+}
+
+func (s *storageSuite) TestDeleteVolumeSnapshots(c *gc.C) {
+	ctrl := s.setupController(c)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		s.mockDynamicClient.EXPECT().Resource(volumeSnapshotResource).
+			Return(s.mockNamespaceableResourceClient),
+		s.mockResourceClient.EXPECT().Delete(gomock.Any(), "snap-1", s.deleteOptions(v1.DeletePropagationForeground, "")).
+			Return(nil),
+		s.mockResourceClient.EXPECT().Delete(gomock.Any(), "snap-2", s.deleteOptions(v1.DeletePropagationForeground, "")).
+			Return(k8serrors.NewNotFound(volumeSnapshotResource.GroupResource(), "snap-2")),
+	)
+
+	p := s.k8sProvider(c, ctrl)
+	vs, err := p.VolumeSource(&storage.Config{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := vs.(storage.VolumeSnapshotter).DeleteVolumeSnapshots(context.Background(), []string{"snap-1", "snap-2"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []error{nil, nil})
+}
+
 func (s *storageSuite) TestValidateStorageProvider(c *gc.C) {
 	ctrl := s.setupController(c)
 	defer ctrl.Finish()
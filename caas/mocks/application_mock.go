@@ -428,6 +428,44 @@ func (c *MockApplicationUnitsToRemoveCall) DoAndReturn(f func(context.Context, i
 	return c
 }
 
+// UpdateNetworkPolicy mocks base method.
+func (m *MockApplication) UpdateNetworkPolicy(arg0 *caas.NetworkPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkPolicy indicates an expected call of UpdateNetworkPolicy.
+func (mr *MockApplicationMockRecorder) UpdateNetworkPolicy(arg0 any) *MockApplicationUpdateNetworkPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkPolicy", reflect.TypeOf((*MockApplication)(nil).UpdateNetworkPolicy), arg0)
+	return &MockApplicationUpdateNetworkPolicyCall{Call: call}
+}
+
+// MockApplicationUpdateNetworkPolicyCall wrap *gomock.Call
+type MockApplicationUpdateNetworkPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationUpdateNetworkPolicyCall) Return(arg0 error) *MockApplicationUpdateNetworkPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationUpdateNetworkPolicyCall) Do(f func(*caas.NetworkPolicy) error) *MockApplicationUpdateNetworkPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationUpdateNetworkPolicyCall) DoAndReturn(f func(*caas.NetworkPolicy) error) *MockApplicationUpdateNetworkPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdatePorts mocks base method.
 func (m *MockApplication) UpdatePorts(arg0 []caas.ServicePort, arg1 bool) error {
 	m.ctrl.T.Helper()
@@ -201,6 +201,45 @@ func (c *MockBrokerApplicationCall) DoAndReturn(f func(string, caas.DeploymentTy
 	return c
 }
 
+// ApplicationUtilisation mocks base method.
+func (m *MockBroker) ApplicationUtilisation(arg0 context.Context, arg1, arg2 string) (caas.Utilisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationUtilisation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(caas.Utilisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationUtilisation indicates an expected call of ApplicationUtilisation.
+func (mr *MockBrokerMockRecorder) ApplicationUtilisation(arg0, arg1, arg2 any) *MockBrokerApplicationUtilisationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationUtilisation", reflect.TypeOf((*MockBroker)(nil).ApplicationUtilisation), arg0, arg1, arg2)
+	return &MockBrokerApplicationUtilisationCall{Call: call}
+}
+
+// MockBrokerApplicationUtilisationCall wrap *gomock.Call
+type MockBrokerApplicationUtilisationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBrokerApplicationUtilisationCall) Return(arg0 caas.Utilisation, arg1 error) *MockBrokerApplicationUtilisationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBrokerApplicationUtilisationCall) Do(f func(context.Context, string, string) (caas.Utilisation, error)) *MockBrokerApplicationUtilisationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBrokerApplicationUtilisationCall) DoAndReturn(f func(context.Context, string, string) (caas.Utilisation, error)) *MockBrokerApplicationUtilisationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Bootstrap mocks base method.
 func (m *MockBroker) Bootstrap(arg0 environs.BootstrapContext, arg1 environs.BootstrapParams) (*environs.BootstrapResult, error) {
 	m.ctrl.T.Helper()
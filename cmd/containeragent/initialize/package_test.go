@@ -95,6 +95,7 @@ func (*importSuite) TestImports(c *gc.C) {
 		"internal/http",
 		"internal/logger",
 		"internal/macaroon",
+		"internal/oidc",
 		"internal/mongo",
 		"internal/network",
 		"internal/network/netplan",
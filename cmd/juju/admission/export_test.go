@@ -0,0 +1,32 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission
+
+import (
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/jujuclient"
+)
+
+func newPolicyCommandBase(api AdmissionPolicyAPI, store jujuclient.ClientStore) policyCommandBase {
+	c := policyCommandBase{api: api}
+	c.SetClientStore(store)
+	return c
+}
+
+func NewAddPolicyCommandForTest(api AdmissionPolicyAPI, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&addPolicyCommand{policyCommandBase: newPolicyCommandBase(api, store)})
+}
+
+func NewRemovePolicyCommandForTest(api AdmissionPolicyAPI, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&removePolicyCommand{policyCommandBase: newPolicyCommandBase(api, store)})
+}
+
+func NewPoliciesCommandForTest(api AdmissionPolicyAPI, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&policiesCommand{policyCommandBase: newPolicyCommandBase(api, store)})
+}
+
+func NewTestPolicyCommandForTest(api AdmissionPolicyAPI, store jujuclient.ClientStore) cmd.Command {
+	return modelcmd.Wrap(&testPolicyCommand{policyCommandBase: newPolicyCommandBase(api, store)})
+}
@@ -0,0 +1,563 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/admissionpolicy"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	internaladmission "github.com/juju/juju/internal/admission"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// AdmissionPolicyAPI defines the admissionpolicy API methods that the
+// admission policy commands use.
+type AdmissionPolicyAPI interface {
+	AddPolicy(ctx context.Context, policy admissionpolicy.Policy) error
+	RemovePolicy(ctx context.Context, modelUUID, name string) error
+	ListPolicies(ctx context.Context, modelUUID string) ([]admissionpolicy.Policy, error)
+	TestAdmission(
+		ctx context.Context, modelUUID, operation string, fields map[string][]string, candidates []admissionpolicy.Policy,
+	) ([]admissionpolicy.Policy, error)
+	Close() error
+}
+
+// policyCommandBase holds the code shared by the admission policy commands.
+// Policies apply to the model of the command, or to every model when
+// --controller is specified.
+type policyCommandBase struct {
+	modelcmd.ModelCommandBase
+	api AdmissionPolicyAPI
+
+	controllerScope bool
+}
+
+func (c *policyCommandBase) getAPI(ctx context.Context) (AdmissionPolicyAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewControllerAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return admissionpolicy.NewClient(root), nil
+}
+
+func (c *policyCommandBase) setScopeFlag(f *gnuflag.FlagSet, usage string) {
+	f.BoolVar(&c.controllerScope, "controller", false, usage)
+}
+
+// modelUUID returns the UUID of the model of the command.
+func (c *policyCommandBase) modelUUID(ctx context.Context) (string, error) {
+	_, details, err := c.ModelDetails(ctx)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return details.ModelUUID, nil
+}
+
+// scopeUUID returns the UUID of the model of the command, or an empty UUID
+// if the command applies to the controller.
+func (c *policyCommandBase) scopeUUID(ctx context.Context) (string, error) {
+	if c.controllerScope {
+		return "", nil
+	}
+	return c.modelUUID(ctx)
+}
+
+// scopeName returns the name of the scope of the command for messages.
+func (c *policyCommandBase) scopeName() string {
+	if c.controllerScope {
+		return "controller"
+	}
+	modelName, err := c.ModelIdentifier()
+	if err != nil {
+		return "model"
+	}
+	return fmt.Sprintf("model %q", modelName)
+}
+
+const addPolicyDoc = `
+Adds an admission policy, which denies the model-changing operations its rule
+describes before they are made. Policies are added to the model, or with
+--controller to the controller, in which case they apply to every model.
+
+Operations subject to admission are deploy, refresh, add-unit, add-machine,
+expose, integrate, set-config, set-model-config and set-constraints. A rule is one or more conditions joined by
+"and", each comparing a field of the operation with a quoted value or a list
+of quoted values:
+
+    <field> == "<value>"          a value of the field is the value
+    <field> != "<value>"          no value of the field is the value
+    <field> in [...]              every value of the field is in the list
+    <field> not in [...]          a value of the field is not in the list
+    <field> within [...]          every value of the field is within one of
+                                  the CIDRs of the list
+    <field> not within [...]      a value of the field is not within them
+    <field> is empty              the field has no values
+    <field> is set                the field has a value
+
+The fields are:
+
+    operation, application, charm, charm.source, charm.channel,
+    charm.risk, constraints.zones, expose.cidrs, expose.endpoints,
+    expose.spaces, endpoints, config.keys
+
+Exposing an endpoint without spaces or CIDRs exposes it to 0.0.0.0/0 and ::/0.
+
+When --dry-run is specified the rule is checked, and printed in its canonical
+form, without the policy being added. Use test-admission-policy to evaluate
+policies against an operation.
+
+Controller superusers may add policies to the controller and to any model;
+model admins may add policies to their models.
+`
+
+const addPolicyExamples = `
+Deny charms from non-stable channels in the model:
+
+    juju add-admission-policy stable-only \
+        'operation == "deploy" and charm.risk != "stable"' \
+        --message "production models only run stable charms"
+
+Only allow applications to be exposed to the internal network:
+
+    juju add-admission-policy internal-only --controller \
+        'operation == "expose" and expose.cidrs not within ["10.0.0.0/8"]'
+
+Require the zones constraint to be set when deploying:
+
+    juju add-admission-policy zones -m production \
+        'operation == "deploy" and constraints.zones is empty'
+`
+
+// NewAddPolicyCommand returns a command to add an admission policy.
+func NewAddPolicyCommand() cmd.Command {
+	return modelcmd.Wrap(&addPolicyCommand{})
+}
+
+// addPolicyCommand adds an admission policy.
+type addPolicyCommand struct {
+	policyCommandBase
+	policy admissionpolicy.Policy
+	dryRun bool
+}
+
+// Info implements Command.Info.
+func (c *addPolicyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-admission-policy",
+		Args:     "<policy name> <rule>",
+		Purpose:  "Adds a policy denying model changes before they are made.",
+		Doc:      addPolicyDoc,
+		Examples: addPolicyExamples,
+		SeeAlso: []string{
+			"admission-policies",
+			"remove-admission-policy",
+			"test-admission-policy",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *addPolicyCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.setScopeFlag(f, "Add the policy to the controller, applying it to every model")
+	f.StringVar(&c.policy.Message, "message", "", "The reason given to users when an operation is denied")
+	f.BoolVar(&c.dryRun, "dry-run", false, "Check the rule without adding the policy")
+}
+
+// Init implements Command.Init.
+func (c *addPolicyCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no policy name specified")
+	}
+	if len(args) == 1 {
+		return errors.New("no rule specified")
+	}
+	c.policy.Name = args[0]
+	c.policy.Rule = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run implements Command.Run.
+func (c *addPolicyCommand) Run(ctx *cmd.Context) error {
+	if c.dryRun {
+		rule, err := internaladmission.ParseRule(c.policy.Rule)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		ctx.Infof("Rule of policy %q is valid: %s", c.policy.Name, rule)
+		return nil
+	}
+
+	modelUUID, err := c.scopeUUID(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	c.policy.ModelUUID = modelUUID
+
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.AddPolicy(ctx, c.policy); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Added admission policy %q to %s", c.policy.Name, c.scopeName())
+	return nil
+}
+
+const removePolicyDoc = `
+Removes an admission policy from the model, or with --controller from the
+controller.
+
+Controller superusers may remove policies from the controller and from any
+model; model admins may remove policies from their models.
+`
+
+const removePolicyExamples = `
+    juju remove-admission-policy zones -m production
+    juju remove-admission-policy internal-only --controller
+`
+
+// NewRemovePolicyCommand returns a command to remove an admission policy.
+func NewRemovePolicyCommand() cmd.Command {
+	return modelcmd.Wrap(&removePolicyCommand{})
+}
+
+// removePolicyCommand removes an admission policy.
+type removePolicyCommand struct {
+	policyCommandBase
+	name string
+}
+
+// Info implements Command.Info.
+func (c *removePolicyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-admission-policy",
+		Args:     "<policy name>",
+		Purpose:  "Removes an admission policy.",
+		Doc:      removePolicyDoc,
+		Examples: removePolicyExamples,
+		SeeAlso: []string{
+			"admission-policies",
+			"add-admission-policy",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *removePolicyCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.setScopeFlag(f, "Remove the policy from the controller")
+}
+
+// Init implements Command.Init.
+func (c *removePolicyCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no policy name specified")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *removePolicyCommand) Run(ctx *cmd.Context) error {
+	modelUUID, err := c.scopeUUID(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.RemovePolicy(ctx, modelUUID, c.name); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Removed admission policy %q from %s", c.name, c.scopeName())
+	return nil
+}
+
+const policiesDoc = `
+Lists the admission policies which apply to the model: those of the
+controller, followed by those of the model. When --controller is specified,
+only the policies of the controller are listed.
+`
+
+const policiesExamples = `
+    juju admission-policies
+    juju admission-policies -m production --format yaml
+    juju admission-policies --controller
+`
+
+// NewPoliciesCommand returns a command to list admission policies.
+func NewPoliciesCommand() cmd.Command {
+	return modelcmd.Wrap(&policiesCommand{})
+}
+
+// policiesCommand lists admission policies.
+type policiesCommand struct {
+	policyCommandBase
+	out cmd.Output
+}
+
+// PolicyInfo holds the details of an admission policy for output.
+type PolicyInfo struct {
+	Name string `yaml:"name" json:"name"`
+	// Scope is "controller" for policies which apply to every model, or
+	// "model" otherwise.
+	Scope   string `yaml:"scope" json:"scope"`
+	Rule    string `yaml:"rule" json:"rule"`
+	Message string `yaml:"message,omitempty" json:"message,omitempty"`
+	// CreatedBy is the user who added the policy.
+	CreatedBy string `yaml:"created-by,omitempty" json:"created-by,omitempty"`
+}
+
+// Info implements Command.Info.
+func (c *policiesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "admission-policies",
+		Purpose:  "Lists the admission policies of a model or the controller.",
+		Doc:      policiesDoc,
+		Examples: policiesExamples,
+		Aliases:  []string{"list-admission-policies"},
+		SeeAlso: []string{
+			"add-admission-policy",
+			"test-admission-policy",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *policiesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.setScopeFlag(f, "List the policies of the controller only")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatPoliciesTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *policiesCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements Command.Run.
+func (c *policiesCommand) Run(ctx *cmd.Context) error {
+	var modelUUID string
+	if !c.controllerScope {
+		var err error
+		if modelUUID, err = c.modelUUID(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	policies, err := api.ListPolicies(ctx, "")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if modelUUID != "" {
+		modelPolicies, err := api.ListPolicies(ctx, modelUUID)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		policies = append(policies, modelPolicies...)
+	}
+	if len(policies) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No admission policies to display.")
+		return nil
+	}
+	return c.out.Write(ctx, policiesToInfo(policies))
+}
+
+const testPolicyDoc = `
+Evaluates the admission policies of the model against an operation, without
+the operation being made, and lists the policies which would deny it. This
+allows policies to be tried before changes are made to the model.
+
+The operation is one of deploy, refresh, add-unit, add-machine, expose,
+integrate, set-config, set-model-config and set-constraints, and its fields are given as <field>=<value>. Fields with
+several values are given as a comma separated list, or by repeating the
+field. See add-admission-policy for the fields.
+
+A rule may be given with --rule, which is evaluated along with the policies
+of the model without being added, so that a new policy can be tried against
+the operations it is meant to deny.
+`
+
+const testPolicyExamples = `
+Check whether an edge charm may be deployed:
+
+    juju test-admission-policy deploy charm=mysql charm.risk=edge
+
+Try a rule before adding it as a policy:
+
+    juju test-admission-policy expose expose.cidrs=0.0.0.0/0 \
+        --rule 'operation == "expose" and expose.cidrs not within ["10.0.0.0/8"]'
+`
+
+// candidatePolicyName is the name given to the rule of the
+// test-admission-policy command.
+const candidatePolicyName = "candidate"
+
+// NewTestPolicyCommand returns a command to test admission policies against
+// an operation.
+func NewTestPolicyCommand() cmd.Command {
+	return modelcmd.Wrap(&testPolicyCommand{})
+}
+
+// testPolicyCommand evaluates the admission policies of a model against an
+// operation, without the operation being made.
+type testPolicyCommand struct {
+	policyCommandBase
+	out cmd.Output
+
+	operation string
+	fields    map[string][]string
+	rule      string
+}
+
+// Info implements Command.Info.
+func (c *testPolicyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "test-admission-policy",
+		Args:     "<operation> [<field>=<value>[,<value>...] ...]",
+		Purpose:  "Tests whether admission policies would deny an operation.",
+		Doc:      testPolicyDoc,
+		Examples: testPolicyExamples,
+		SeeAlso: []string{
+			"add-admission-policy",
+			"admission-policies",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *testPolicyCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.rule, "rule", "", "A rule to evaluate along with the policies of the model")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatPoliciesTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *testPolicyCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no operation specified")
+	}
+	c.operation = args[0]
+	if !internaladmission.Operation(c.operation).IsValid() {
+		return errors.NotValidf("operation %q", c.operation)
+	}
+	c.fields = make(map[string][]string)
+	for _, arg := range args[1:] {
+		field, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return errors.Errorf("expected <field>=<value>, got %q", arg)
+		}
+		if field == internaladmission.FieldOperation || !internaladmission.IsField(field) {
+			return errors.NotValidf("field %q", field)
+		}
+		c.fields[field] = append(c.fields[field], strings.Split(value, ",")...)
+	}
+	if c.rule != "" {
+		if _, err := internaladmission.ParseRule(c.rule); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *testPolicyCommand) Run(ctx *cmd.Context) error {
+	modelUUID, err := c.modelUUID(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	var candidates []admissionpolicy.Policy
+	if c.rule != "" {
+		candidates = append(candidates, admissionpolicy.Policy{
+			Name: candidatePolicyName,
+			Rule: c.rule,
+		})
+	}
+	denied, err := api.TestAdmission(ctx, modelUUID, c.operation, c.fields, candidates)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if c.out.Name() == "tabular" {
+		if len(denied) == 0 {
+			ctx.Infof("Operation %s would be admitted.", c.operation)
+			return nil
+		}
+		ctx.Infof("Operation %s would be denied by:", c.operation)
+	}
+	return c.out.Write(ctx, policiesToInfo(denied))
+}
+
+func policiesToInfo(policies []admissionpolicy.Policy) []PolicyInfo {
+	result := make([]PolicyInfo, len(policies))
+	for i, policy := range policies {
+		scope := "model"
+		if policy.ModelUUID == "" {
+			scope = "controller"
+		}
+		result[i] = PolicyInfo{
+			Name:      policy.Name,
+			Scope:     scope,
+			Rule:      policy.Rule,
+			Message:   policy.Message,
+			CreatedBy: policy.CreatedBy,
+		}
+	}
+	return result
+}
+
+func formatPoliciesTabular(writer io.Writer, value interface{}) error {
+	policies, ok := value.([]PolicyInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", policies, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Name", "Scope", "Rule", "Message")
+	for _, policy := range policies {
+		w.Println(policy.Name, policy.Scope, policy.Rule, policy.Message)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,242 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/client/admissionpolicy"
+	"github.com/juju/juju/cmd/juju/admission"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+)
+
+const modelUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+type PoliciesCommandSuite struct {
+	testing.BaseSuite
+	api   *mockAdmissionPolicyAPI
+	store *jujuclient.MemStore
+}
+
+var _ = gc.Suite(&PoliciesCommandSuite{})
+
+func (s *PoliciesCommandSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.api = &mockAdmissionPolicyAPI{}
+	s.store = jujuclienttesting.MinimalStore()
+	s.store.Models["arthur"].Models["king/sword"] = jujuclient.ModelDetails{
+		ModelUUID: modelUUID,
+		ModelType: model.IAAS,
+	}
+}
+
+type mockAdmissionPolicyAPI struct {
+	jujutesting.Stub
+	policies map[string][]admissionpolicy.Policy
+	denied   []admissionpolicy.Policy
+}
+
+func (m *mockAdmissionPolicyAPI) AddPolicy(ctx context.Context, policy admissionpolicy.Policy) error {
+	m.MethodCall(m, "AddPolicy", policy)
+	return m.NextErr()
+}
+
+func (m *mockAdmissionPolicyAPI) RemovePolicy(ctx context.Context, modelUUID, name string) error {
+	m.MethodCall(m, "RemovePolicy", modelUUID, name)
+	return m.NextErr()
+}
+
+func (m *mockAdmissionPolicyAPI) ListPolicies(ctx context.Context, modelUUID string) ([]admissionpolicy.Policy, error) {
+	m.MethodCall(m, "ListPolicies", modelUUID)
+	return m.policies[modelUUID], m.NextErr()
+}
+
+func (m *mockAdmissionPolicyAPI) TestAdmission(
+	ctx context.Context, modelUUID, operation string, fields map[string][]string, candidates []admissionpolicy.Policy,
+) ([]admissionpolicy.Policy, error) {
+	m.MethodCall(m, "TestAdmission", modelUUID, operation, fields, candidates)
+	return m.denied, m.NextErr()
+}
+
+func (m *mockAdmissionPolicyAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
+
+func (s *PoliciesCommandSuite) TestAddPolicy(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, admission.NewAddPolicyCommandForTest(s.api, s.store),
+		"stable-only", `operation == "deploy" and charm.risk != "stable"`, "--message", "stable only")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "AddPolicy", admissionpolicy.Policy{
+		Name:      "stable-only",
+		ModelUUID: modelUUID,
+		Rule:      `operation == "deploy" and charm.risk != "stable"`,
+		Message:   "stable only",
+	})
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Added admission policy \"stable-only\" to model \"king/sword\"\n")
+}
+
+func (s *PoliciesCommandSuite) TestAddPolicyController(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, admission.NewAddPolicyCommandForTest(s.api, s.store),
+		"internal-only", "--controller", `expose.cidrs not within ["10.0.0.0/8"]`)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "AddPolicy", admissionpolicy.Policy{
+		Name: "internal-only",
+		Rule: `expose.cidrs not within ["10.0.0.0/8"]`,
+	})
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Added admission policy \"internal-only\" to controller\n")
+}
+
+func (s *PoliciesCommandSuite) TestAddPolicyDryRun(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, admission.NewAddPolicyCommandForTest(s.api, s.store),
+		"zones", "--dry-run", `operation=="deploy" and constraints.zones is empty`)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckNoCalls(c)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals,
+		"Rule of policy \"zones\" is valid: operation == \"deploy\" and constraints.zones is empty\n")
+}
+
+func (s *PoliciesCommandSuite) TestAddPolicyDryRunNotValid(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, admission.NewAddPolicyCommandForTest(s.api, s.store),
+		"zones", "--dry-run", `colour == "blue"`)
+	c.Assert(err, gc.ErrorMatches, `admission rule not valid: .*`)
+	s.api.CheckNoCalls(c)
+}
+
+func (s *PoliciesCommandSuite) TestAddPolicyError(c *gc.C) {
+	s.api.SetErrors(errors.AlreadyExistsf("admission policy"))
+	_, err := cmdtesting.RunCommand(c, admission.NewAddPolicyCommandForTest(s.api, s.store),
+		"zones", `constraints.zones is empty`)
+	c.Assert(err, jc.ErrorIs, errors.AlreadyExists)
+}
+
+func (s *PoliciesCommandSuite) TestAddPolicyInit(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, admission.NewAddPolicyCommandForTest(s.api, s.store))
+	c.Assert(err, gc.ErrorMatches, "no policy name specified")
+	_, err = cmdtesting.RunCommand(c, admission.NewAddPolicyCommandForTest(s.api, s.store), "zones")
+	c.Assert(err, gc.ErrorMatches, "no rule specified")
+}
+
+func (s *PoliciesCommandSuite) TestRemovePolicy(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, admission.NewRemovePolicyCommandForTest(s.api, s.store), "zones")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "RemovePolicy", modelUUID, "zones")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Removed admission policy \"zones\" from model \"king/sword\"\n")
+}
+
+func (s *PoliciesCommandSuite) TestRemovePolicyController(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, admission.NewRemovePolicyCommandForTest(s.api, s.store),
+		"internal-only", "--controller")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "RemovePolicy", "", "internal-only")
+}
+
+func (s *PoliciesCommandSuite) TestPolicies(c *gc.C) {
+	s.api.policies = map[string][]admissionpolicy.Policy{
+		"": {{
+			Name:    "internal-only",
+			Rule:    `expose.cidrs not within ["10.0.0.0/8"]`,
+			Message: "internal network only",
+		}},
+		modelUUID: {{
+			Name:      "zones",
+			ModelUUID: modelUUID,
+			Rule:      `constraints.zones is empty`,
+		}},
+	}
+	ctx, err := cmdtesting.RunCommand(c, admission.NewPoliciesCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCallNames(c, "ListPolicies", "ListPolicies", "Close")
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, ""+
+		"Name           Scope       Rule                                    Message\n"+
+		"internal-only  controller  expose.cidrs not within [\"10.0.0.0/8\"]  internal network only\n"+
+		"zones          model       constraints.zones is empty              \n")
+}
+
+func (s *PoliciesCommandSuite) TestPoliciesControllerYAML(c *gc.C) {
+	s.api.policies = map[string][]admissionpolicy.Policy{
+		"": {{
+			Name: "internal-only",
+			Rule: `expose.cidrs not within ["10.0.0.0/8"]`,
+		}},
+	}
+	ctx, err := cmdtesting.RunCommand(c, admission.NewPoliciesCommandForTest(s.api, s.store),
+		"--controller", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCalls(c, []jujutesting.StubCall{
+		{FuncName: "ListPolicies", Args: []interface{}{""}},
+		{FuncName: "Close"},
+	})
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- name: internal-only
+  scope: controller
+  rule: expose.cidrs not within ["10.0.0.0/8"]
+`[1:])
+}
+
+func (s *PoliciesCommandSuite) TestPoliciesNone(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, admission.NewPoliciesCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No admission policies to display.\n")
+}
+
+func (s *PoliciesCommandSuite) TestTestPolicyDenied(c *gc.C) {
+	s.api.denied = []admissionpolicy.Policy{{
+		Name:    "stable-only",
+		Rule:    `charm.risk != "stable"`,
+		Message: "stable only",
+	}, {
+		Name:      "candidate",
+		ModelUUID: modelUUID,
+		Rule:      `charm == "mysql"`,
+	}}
+	ctx, err := cmdtesting.RunCommand(c, admission.NewTestPolicyCommandForTest(s.api, s.store),
+		"deploy", "charm=mysql", "charm.risk=edge", "constraints.zones=az1,az2", "--rule", `charm == "mysql"`)
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "TestAdmission", modelUUID, "deploy", map[string][]string{
+		"charm":             {"mysql"},
+		"charm.risk":        {"edge"},
+		"constraints.zones": {"az1", "az2"},
+	}, []admissionpolicy.Policy{{
+		Name: "candidate",
+		Rule: `charm == "mysql"`,
+	}})
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Operation deploy would be denied by:\n")
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, ""+
+		"Name         Scope       Rule                    Message\n"+
+		"stable-only  controller  charm.risk != \"stable\"  stable only\n"+
+		"candidate    model       charm == \"mysql\"        \n")
+}
+
+func (s *PoliciesCommandSuite) TestTestPolicyAdmitted(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, admission.NewTestPolicyCommandForTest(s.api, s.store),
+		"expose", "expose.cidrs=10.0.0.0/24")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Operation expose would be admitted.\n")
+}
+
+func (s *PoliciesCommandSuite) TestTestPolicyInit(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, admission.NewTestPolicyCommandForTest(s.api, s.store))
+	c.Check(err, gc.ErrorMatches, "no operation specified")
+	_, err = cmdtesting.RunCommand(c, admission.NewTestPolicyCommandForTest(s.api, s.store), "destroy")
+	c.Check(err, gc.ErrorMatches, `operation "destroy" not valid`)
+	_, err = cmdtesting.RunCommand(c, admission.NewTestPolicyCommandForTest(s.api, s.store), "deploy", "charm")
+	c.Check(err, gc.ErrorMatches, `expected <field>=<value>, got "charm"`)
+	_, err = cmdtesting.RunCommand(c, admission.NewTestPolicyCommandForTest(s.api, s.store), "deploy", "colour=blue")
+	c.Check(err, gc.ErrorMatches, `field "colour" not valid`)
+	_, err = cmdtesting.RunCommand(c, admission.NewTestPolicyCommandForTest(s.api, s.store), "deploy", "--rule", "charm")
+	c.Check(err, gc.ErrorMatches, `admission rule not valid: .*`)
+	s.api.CheckNoCalls(c)
+}
@@ -109,19 +109,22 @@ type UnitCommandBase struct {
 	// AttachStorage is a list of storage IDs, identifying storage to
 	// attach to the unit created by deploy.
 	AttachStorage []string
+	// StorageSnapshots maps storage names to the IDs of snapshots from
+	// which to restore the new storage of the unit created by deploy.
+	StorageSnapshots map[string]string
 }
 
 func (c *UnitCommandBase) SetFlags(f *gnuflag.FlagSet) {
 	f.IntVar(&c.NumUnits, "num-units", 1, "")
 	f.StringVar(&c.PlacementSpec, "to", "", "The machine and/or container to deploy the unit in (bypasses constraints)")
-	f.Var(attachStorageFlag{&c.AttachStorage}, "attach-storage", "Existing storage to attach to the deployed unit (not available on k8s models)")
+	f.Var(attachStorageFlag{&c.AttachStorage, &c.StorageSnapshots}, "attach-storage", "Existing storage to attach to the deployed unit (not available on k8s models)")
 }
 
 func (c *UnitCommandBase) Init(args []string) error {
 	if c.NumUnits < 1 {
 		return errors.New("--num-units must be a positive integer")
 	}
-	if len(c.AttachStorage) > 0 && c.NumUnits != 1 {
+	if (len(c.AttachStorage) > 0 || len(c.StorageSnapshots) > 0) && c.NumUnits != 1 {
 		return errors.New("--attach-storage cannot be used with -n")
 	}
 	if c.PlacementSpec != "" {
@@ -189,6 +192,9 @@ func (c *addUnitCommand) Init(args []string) error {
 	if err := cmd.CheckEmpty(args[1:]); err != nil {
 		return err
 	}
+	if len(c.StorageSnapshots) > 0 {
+		return errors.New("--attach-storage does not support snapshots when adding units")
+	}
 	if err := c.validateArgsByModelType(context.TODO()); err != nil {
 		if !errors.Is(err, errors.NotFound) {
 			return errors.Trace(err)
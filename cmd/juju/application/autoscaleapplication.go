@@ -0,0 +1,213 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// NewAutoscaleApplicationCommand returns a command which manages the
+// autoscaling policy of an application.
+func NewAutoscaleApplicationCommand() modelcmd.ModelCommand {
+	cmd := &autoscaleApplicationCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (autoscaleApplicationAPI, error) {
+		root, err := cmd.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return application.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// autoscaleApplicationCommand sets, shows or removes the autoscaling policy
+// of an application.
+type autoscaleApplicationCommand struct {
+	modelcmd.ModelCommandBase
+	modelcmd.CAASOnlyCommand
+
+	newAPIFunc      func(ctx context.Context) (autoscaleApplicationAPI, error)
+	out             cmd.Output
+	applicationName string
+
+	minUnits      int
+	maxUnits      int
+	cpuPercent    int
+	memoryPercent int
+	metric        string
+	unset         bool
+
+	set    bool
+	policy application.AutoscalePolicy
+}
+
+const autoscaleApplicationDoc = `
+Automatically scale a k8s application between a minimum and maximum number
+of units, according to the utilisation of its units.
+
+The utilisation targets are the average CPU or memory usage of the units, as
+a percentage of the resources they request, or the average value of a custom
+pod metric served by the Kubernetes custom metrics API. When more than one
+target is given, the application is scaled to the largest number of units
+needed to meet any of them.
+
+CPU and memory utilisation are read from the Kubernetes resource metrics API,
+which is normally served by metrics-server. If the metrics are not available,
+the application is only kept within its minimum and maximum units.
+
+With no options, the current autoscaling policy of the application is shown.
+The --unset option removes the policy, leaving the application at its
+current scale.
+`
+
+const autoscaleApplicationExamples = `
+    juju autoscale-application mariadb --min 2 --max 10 --cpu 70
+    juju autoscale-application web --min 1 --max 5 --metric http_requests_per_second=100
+    juju autoscale-application mariadb
+    juju autoscale-application mariadb --unset
+`
+
+// Info implements cmd.Command.
+func (c *autoscaleApplicationCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "autoscale-application",
+		Args:     "<application>",
+		Purpose:  "Set the autoscaling policy of a k8s application.",
+		Doc:      autoscaleApplicationDoc,
+		Examples: autoscaleApplicationExamples,
+		SeeAlso: []string{
+			"scale-application",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *autoscaleApplicationCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.IntVar(&c.minUnits, "min", -1, "The minimum number of units")
+	f.IntVar(&c.maxUnits, "max", -1, "The maximum number of units")
+	f.IntVar(&c.cpuPercent, "cpu", 0, "The target average CPU utilisation, as a percentage of the CPU requested")
+	f.IntVar(&c.memoryPercent, "memory", 0, "The target average memory utilisation, as a percentage of the memory requested")
+	f.StringVar(&c.metric, "metric", "", "The target average value of a custom metric, as <name>=<value>")
+	f.BoolVar(&c.unset, "unset", false, "Remove the autoscaling policy")
+	c.out.AddFlags(f, "yaml", cmd.DefaultFormatters.Formatters())
+}
+
+// Init implements cmd.Command.
+func (c *autoscaleApplicationCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("no application specified")
+	}
+	c.applicationName = args[0]
+	if !names.IsValidApplication(c.applicationName) {
+		return errors.Errorf("invalid application name %q", c.applicationName)
+	}
+	if err := cmd.CheckEmpty(args[1:]); err != nil {
+		return err
+	}
+
+	c.set = c.minUnits >= 0 || c.maxUnits >= 0 || c.cpuPercent != 0 || c.memoryPercent != 0 || c.metric != ""
+	if c.unset && c.set {
+		return errors.New("cannot specify --unset with a policy")
+	}
+	if !c.set {
+		return nil
+	}
+
+	if c.minUnits < 0 {
+		return errors.New("--min must be specified")
+	}
+	if c.maxUnits < 0 {
+		return errors.New("--max must be specified")
+	}
+	c.policy = application.AutoscalePolicy{
+		MinUnits:            c.minUnits,
+		MaxUnits:            c.maxUnits,
+		TargetCPUPercent:    c.cpuPercent,
+		TargetMemoryPercent: c.memoryPercent,
+	}
+	if c.metric != "" {
+		name, value, ok := strings.Cut(c.metric, "=")
+		if !ok || name == "" {
+			return errors.Errorf("invalid metric %q, expected <name>=<value>", c.metric)
+		}
+		target, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return errors.Annotatef(err, "invalid metric target %q", value)
+		}
+		c.policy.CustomMetric = name
+		c.policy.CustomMetricTarget = target
+	}
+	if c.policy.TargetCPUPercent == 0 && c.policy.TargetMemoryPercent == 0 && c.policy.CustomMetric == "" {
+		return errors.New("at least one of --cpu, --memory or --metric must be specified")
+	}
+	return nil
+}
+
+type autoscaleApplicationAPI interface {
+	Close() error
+	SetAutoscalePolicy(context.Context, string, application.AutoscalePolicy) error
+	GetAutoscalePolicy(context.Context, string) (application.AutoscalePolicy, error)
+	UnsetAutoscalePolicy(context.Context, string) error
+}
+
+// autoscalePolicyOutput is the shown form of an autoscaling policy.
+type autoscalePolicyOutput struct {
+	MinUnits            int     `yaml:"min-units" json:"min-units"`
+	MaxUnits            int     `yaml:"max-units" json:"max-units"`
+	TargetCPUPercent    int     `yaml:"target-cpu-percent,omitempty" json:"target-cpu-percent,omitempty"`
+	TargetMemoryPercent int     `yaml:"target-memory-percent,omitempty" json:"target-memory-percent,omitempty"`
+	CustomMetric        string  `yaml:"custom-metric,omitempty" json:"custom-metric,omitempty"`
+	CustomMetricTarget  float64 `yaml:"custom-metric-target,omitempty" json:"custom-metric-target,omitempty"`
+}
+
+// Run implements cmd.Command.
+func (c *autoscaleApplicationCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	switch {
+	case c.unset:
+		if err := client.UnsetAutoscalePolicy(ctx, c.applicationName); err != nil {
+			return block.ProcessBlockedError(errors.Annotatef(err, "could not remove autoscaling policy of %q", c.applicationName), block.BlockChange)
+		}
+		ctx.Infof("%v is no longer autoscaled", c.applicationName)
+		return nil
+
+	case c.set:
+		if err := client.SetAutoscalePolicy(ctx, c.applicationName, c.policy); err != nil {
+			return block.ProcessBlockedError(errors.Annotatef(err, "could not autoscale application %q", c.applicationName), block.BlockChange)
+		}
+		ctx.Infof("%v autoscaled between %d and %d units", c.applicationName, c.policy.MinUnits, c.policy.MaxUnits)
+		return nil
+	}
+
+	policy, err := client.GetAutoscalePolicy(ctx, c.applicationName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return c.out.Write(ctx, autoscalePolicyOutput{
+		MinUnits:            policy.MinUnits,
+		MaxUnits:            policy.MaxUnits,
+		TargetCPUPercent:    policy.TargetCPUPercent,
+		TargetMemoryPercent: policy.TargetMemoryPercent,
+		CustomMetric:        policy.CustomMetric,
+		CustomMetricTarget:  policy.CustomMetricTarget,
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/jujuclient"
+	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type AutoscaleApplicationSuite struct {
+	testing.IsolationSuite
+
+	mockAPI *mockAutoscaleApplicationAPI
+}
+
+var _ = gc.Suite(&AutoscaleApplicationSuite{})
+
+type mockAutoscaleApplicationAPI struct {
+	*testing.Stub
+
+	policy application.AutoscalePolicy
+}
+
+func (s mockAutoscaleApplicationAPI) Close() error {
+	s.MethodCall(s, "Close")
+	return s.NextErr()
+}
+
+func (s mockAutoscaleApplicationAPI) SetAutoscalePolicy(ctx context.Context, appName string, policy application.AutoscalePolicy) error {
+	s.MethodCall(s, "SetAutoscalePolicy", appName, policy)
+	return s.NextErr()
+}
+
+func (s mockAutoscaleApplicationAPI) GetAutoscalePolicy(ctx context.Context, appName string) (application.AutoscalePolicy, error) {
+	s.MethodCall(s, "GetAutoscalePolicy", appName)
+	return s.policy, s.NextErr()
+}
+
+func (s mockAutoscaleApplicationAPI) UnsetAutoscalePolicy(ctx context.Context, appName string) error {
+	s.MethodCall(s, "UnsetAutoscalePolicy", appName)
+	return s.NextErr()
+}
+
+func (s *AutoscaleApplicationSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.mockAPI = &mockAutoscaleApplicationAPI{Stub: &testing.Stub{}}
+}
+
+func (s *AutoscaleApplicationSuite) runAutoscaleApplication(c *gc.C, args ...string) (*cmd.Context, error) {
+	store := jujuclienttesting.MinimalStore()
+	store.Models["arthur"] = &jujuclient.ControllerModels{
+		CurrentModel: "king/sword",
+		Models: map[string]jujuclient.ModelDetails{"king/sword": {
+			ModelType: model.CAAS,
+		}},
+	}
+	return cmdtesting.RunCommand(c, NewAutoscaleCommandForTest(s.mockAPI, store), args...)
+}
+
+func (s *AutoscaleApplicationSuite) TestSetPolicy(c *gc.C) {
+	ctx, err := s.runAutoscaleApplication(c, "foo", "--min", "1", "--max", "5", "--cpu", "70", "--metric", "rps=100.5")
+	c.Assert(err, jc.ErrorIsNil)
+
+	stderr := cmdtesting.Stderr(ctx)
+	c.Assert(strings.TrimSpace(stderr), gc.Equals, `foo autoscaled between 1 and 5 units`)
+	s.mockAPI.CheckCall(c, 0, "SetAutoscalePolicy", "foo", application.AutoscalePolicy{
+		MinUnits:           1,
+		MaxUnits:           5,
+		TargetCPUPercent:   70,
+		CustomMetric:       "rps",
+		CustomMetricTarget: 100.5,
+	})
+}
+
+func (s *AutoscaleApplicationSuite) TestShowPolicy(c *gc.C) {
+	s.mockAPI.policy = application.AutoscalePolicy{
+		MinUnits:            2,
+		MaxUnits:            4,
+		TargetMemoryPercent: 80,
+	}
+	ctx, err := s.runAutoscaleApplication(c, "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+min-units: 2
+max-units: 4
+target-memory-percent: 80
+`[1:])
+	s.mockAPI.CheckCallNames(c, "GetAutoscalePolicy", "Close")
+}
+
+func (s *AutoscaleApplicationSuite) TestUnsetPolicy(c *gc.C) {
+	ctx, err := s.runAutoscaleApplication(c, "foo", "--unset")
+	c.Assert(err, jc.ErrorIsNil)
+
+	stderr := cmdtesting.Stderr(ctx)
+	c.Assert(strings.TrimSpace(stderr), gc.Equals, `foo is no longer autoscaled`)
+	s.mockAPI.CheckCall(c, 0, "UnsetAutoscalePolicy", "foo")
+}
+
+func (s *AutoscaleApplicationSuite) TestSetPolicyBlocked(c *gc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+	_, err := s.runAutoscaleApplication(c, "foo", "--min", "1", "--max", "5", "--cpu", "70")
+	c.Assert(err.Error(), jc.Contains, `could not autoscale application "foo": nope`)
+	c.Assert(err.Error(), jc.Contains, `All operations that change model have been disabled for the current model.`)
+}
+
+func (s *AutoscaleApplicationSuite) TestAutoscaleApplicationWrongModel(c *gc.C) {
+	store := jujuclienttesting.MinimalStore()
+	_, err := cmdtesting.RunCommand(c, NewAutoscaleCommandForTest(s.mockAPI, store), "foo")
+	c.Assert(err, gc.ErrorMatches, `Juju command "autoscale-application" only supported on k8s container models`)
+}
+
+func (s *AutoscaleApplicationSuite) TestInvalidArgs(c *gc.C) {
+	_, err := s.runAutoscaleApplication(c)
+	c.Assert(err, gc.ErrorMatches, `no application specified`)
+	_, err = s.runAutoscaleApplication(c, "invalid:name")
+	c.Assert(err, gc.ErrorMatches, `invalid application name "invalid:name"`)
+	_, err = s.runAutoscaleApplication(c, "foo", "bar")
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["bar"\]`)
+	_, err = s.runAutoscaleApplication(c, "foo", "--unset", "--max", "2")
+	c.Assert(err, gc.ErrorMatches, `cannot specify --unset with a policy`)
+	_, err = s.runAutoscaleApplication(c, "foo", "--max", "2", "--cpu", "50")
+	c.Assert(err, gc.ErrorMatches, `--min must be specified`)
+	_, err = s.runAutoscaleApplication(c, "foo", "--min", "1", "--cpu", "50")
+	c.Assert(err, gc.ErrorMatches, `--max must be specified`)
+	_, err = s.runAutoscaleApplication(c, "foo", "--min", "1", "--max", "2")
+	c.Assert(err, gc.ErrorMatches, `at least one of --cpu, --memory or --metric must be specified`)
+	_, err = s.runAutoscaleApplication(c, "foo", "--min", "1", "--max", "2", "--metric", "rps")
+	c.Assert(err, gc.ErrorMatches, `invalid metric "rps", expected <name>=<value>`)
+	_, err = s.runAutoscaleApplication(c, "foo", "--min", "1", "--max", "2", "--metric", "rps=lots")
+	c.Assert(err, gc.ErrorMatches, `invalid metric target "lots": .*`)
+}
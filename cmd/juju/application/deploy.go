@@ -5,6 +5,7 @@ package application
 
 import (
 	"context"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -34,9 +35,11 @@ import (
 	corecharm "github.com/juju/juju/core/charm"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charmhub"
 	"github.com/juju/juju/internal/cmd"
@@ -203,6 +206,9 @@ func newDeployCommand() *DeployCommand {
 		}
 		return applicationoffers.NewClient(root), nil
 	}
+	deployCmd.NewCostAPI = func(api base.APICallCloser) common.CostAPI {
+		return machinemanager.NewClient(api)
+	}
 	deployCmd.NewDeployerFactory = deployer.NewDeployerFactory
 	deployCmd.NewResolver = func(charmsAPI store.CharmsAPI, downloadClientFn store.DownloadBundleClientFunc) deployer.Resolver {
 		return store.NewCharmAdaptor(charmsAPI, downloadClientFn)
@@ -264,6 +270,14 @@ type DeployCommand struct {
 	// deployed but just output the changes.
 	DryRun bool
 
+	// Estimate is used to specify that the charm shouldn't actually be
+	// deployed but the monthly cost of deploying it output instead.
+	Estimate bool
+
+	// PricesFile is the path of a price catalogue file used to estimate
+	// costs.
+	PricesFile string
+
 	ApplicationName  string
 	ConfigOptions    common.ConfigFlag
 	ConstraintsStr   common.ConstraintsFlag
@@ -312,6 +326,10 @@ type DeployCommand struct {
 	// client. This is used to get the model config.
 	NewModelConfigAPI func(base.APICallCloser) ModelConfigGetter
 
+	// NewCostAPI stores a function which returns a client for getting
+	// instance types and prices, used to estimate costs.
+	NewCostAPI func(base.APICallCloser) common.CostAPI
+
 	// NewCharmsAPI stores a function for getting info about charms.
 	NewCharmsAPI func(caller base.APICallCloser) CharmsAPI
 
@@ -424,6 +442,12 @@ the charm metadata file:
 
     <label>=[<count>,]<device-class>|<vendor/type>[,<attributes>]
 
+Use the ` + "`--attach-storage`" + ` option to attach existing storage, by ID, to the
+deployed unit. A value of the form <storage>=<snapshot-id> instead restores the
+unit's new <storage> storage from a snapshot, as output by ` + "`storage-snapshots`" + `.
+The size and pool of the restored storage are taken from ` + "`--storage`" + `, or the
+charm's defaults; the snapshot must be from the same pool.
+
 Use the ` + "`--config`" + ` option to specify application configuration values. This
 option accepts either a path to a YAML-formatted file or a key=value pair. A
 file should be of this format:
@@ -543,6 +567,14 @@ for security purposes by allowing only certain configurations and devices. Use
 the ` + "`--force`" + ` option to bypass this check. Doing so is not recommended as it
 can lead to unexpected behaviour.
 
+Use the ` + "`--estimate`" + ` option to show the monthly cost of the machines and
+storage that deploying a charm would add to the model, without deploying it.
+Each new machine is priced as the cheapest instance type satisfying the
+model and application constraints. Prices come from the file given with
+` + "`--prices`" + `, or the ` + "`prices.yaml`" + ` file in the Juju data directory, merged with
+any prices published by the cloud provider. Estimates are not available for
+bundles or Kubernetes models.
+
 Further reading: https://juju.is/docs/olm/manage-applications
 `
 
@@ -592,6 +624,10 @@ Deploy to a machine that is in the 'dmz' network space but not in either the
 
     juju deploy haproxy -n 2 --constraints spaces=dmz,^cms,^database
 
+Estimate the monthly cost of deploying 3 units, using a local price catalogue:
+
+    juju deploy postgresql -n 3 --constraints mem=8G --estimate --prices prices.yaml
+
 Deploy a k8s charm that requires a single Nvidia GPU:
 
     juju deploy mycharm --device miner=1,nvidia.com/gpu
@@ -602,6 +638,10 @@ attribute of 'gpu=nvidia-tesla-p100':
     juju deploy mycharm --device \
        twingpu=2,nvidia.com/gpu,gpu=nvidia-tesla-p100
 
+Deploy a single unit whose 'pgdata' storage is restored from an EBS snapshot:
+
+    juju deploy postgresql --storage pgdata=ebs,100G --attach-storage pgdata=snap-0123456789abcdef0
+
 Deploy with specific resources:
 
     juju deploy foo --resource bar=/some/file.tgz --resource baz=./docs/cfg.xml
@@ -644,6 +684,8 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.Base, "base", "", "The base on which to deploy")
 	f.IntVar(&c.Revision, "revision", -1, "The revision to deploy")
 	f.BoolVar(&c.DryRun, "dry-run", false, "Just show what the deploy would do")
+	f.BoolVar(&c.Estimate, "estimate", false, "Show the monthly cost of the machines and storage the charm would add, without deploying it")
+	f.StringVar(&c.PricesFile, "prices", "", "Path to a price catalogue file to use with --estimate")
 	f.BoolVar(&c.Force, "force", false, "Allow a charm/bundle to be deployed which bypasses checks such as supported base or LXD profile allow list")
 	f.Var(storageFlag{&c.Storage, &c.BundleStorage}, "storage", "Charm storage directives")
 	f.Var(devicesFlag{&c.Devices, &c.BundleDevices}, "device", "Charm device constraints")
@@ -711,6 +753,12 @@ func (c *DeployCommand) Init(args []string) error {
 		// do a late validation at Run().
 		c.unknownModel = true
 	}
+	if c.PricesFile != "" && !c.Estimate {
+		return errors.New("--prices can only be used with --estimate")
+	}
+	if c.Estimate && c.DryRun {
+		return errors.New("--estimate and --dry-run cannot be used together")
+	}
 	if c.channelStr != "" {
 		c.Channel, err = charm.ParseChannelNormalize(c.channelStr)
 		if err != nil {
@@ -728,7 +776,7 @@ func (c *DeployCommand) validateStorageByModelType(ctx context.Context) error {
 	if modelType == model.IAAS {
 		return nil
 	}
-	if len(c.AttachStorage) > 0 {
+	if len(c.AttachStorage) > 0 || len(c.StorageSnapshots) > 0 {
 		return errors.New("--attach-storage cannot be used on k8s models")
 	}
 	return nil
@@ -818,6 +866,9 @@ func (c *DeployCommand) Run(ctx *cmd.Context) error {
 	if c.ModelConstraints, err = deployAPI.GetModelConstraints(ctx); err != nil {
 		return errors.Trace(err)
 	}
+	if c.Estimate {
+		return errors.Trace(c.estimateCosts(ctx, deployAPI))
+	}
 
 	if err := c.parseBindFlag(ctx, deployAPI); err != nil {
 		return errors.Trace(err)
@@ -839,6 +890,76 @@ func (c *DeployCommand) Run(ctx *cmd.Context) error {
 	return block.ProcessBlockedError(deploy.PrepareAndDeploy(ctx, deployAPI, charmAdaptor), block.BlockChange)
 }
 
+// estimateCosts writes the estimated monthly cost of the machines and
+// storage that deploying the charm would add to the model.
+func (c *DeployCommand) estimateCosts(ctx *cmd.Context, deployAPI deployer.DeployerAPI) error {
+	status, err := deployAPI.Status(ctx, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if model.ModelType(status.Model.Type) == model.CAAS {
+		return errors.NotSupportedf("estimating costs on a Kubernetes model")
+	}
+	region := status.Model.CloudRegion
+
+	costAPI := c.NewCostAPI(deployAPI)
+	catalogue, err := common.LoadPriceCatalogue(ctx, costAPI, c.PricesFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	name := c.ApplicationName
+	if name == "" {
+		name = c.CharmOrBundle
+	}
+	estimator := pricing.NewEstimator(catalogue, region)
+	if count := c.newMachineCount(); count > 0 {
+		cons, err := constraints.NewValidator().Merge(c.ModelConstraints, c.Constraints)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		results, err := costAPI.InstanceTypes(ctx, []constraints.Value{cons})
+		if err != nil {
+			return errors.Annotate(err, "getting instance types")
+		}
+		var instanceType string
+		if len(results) == 1 && results[0].Error == nil {
+			instanceType = common.CheapestInstanceType(results[0], catalogue, region, nil)
+		}
+		estimator.AddMachines(name, instanceType, count)
+	}
+	storageNames := make([]string, 0, len(c.Storage))
+	for storageName := range c.Storage {
+		storageNames = append(storageNames, storageName)
+	}
+	sort.Strings(storageNames)
+	for _, storageName := range storageNames {
+		directive := c.Storage[storageName]
+		estimator.AddVolumes(
+			name+"/"+storageName, directive.Pool, directive.Size, int(directive.Count)*c.NumUnits,
+		)
+	}
+	return common.FormatEstimateTabular(ctx.Stdout, estimator.Estimate())
+}
+
+// newMachineCount returns the number of new machines that deploying the
+// units would provision. Units placed on an existing machine, or in a
+// container on an existing machine, need no new machine.
+func (c *DeployCommand) newMachineCount() int {
+	count := c.NumUnits
+	for i, p := range c.Placement {
+		if i >= c.NumUnits || p == nil || p.Directive == "" {
+			continue
+		}
+		if p.Scope == instance.MachineScope {
+			count--
+		} else if _, err := instance.ParseContainerType(p.Scope); err == nil {
+			count--
+		}
+	}
+	return count
+}
+
 func (c *DeployCommand) parseBindFlag(ctx context.Context, api SpacesAPI) error {
 	if c.BindToSpaces == "" {
 		return nil
@@ -875,6 +996,7 @@ func (c *DeployCommand) getDeployerFactory(base corebase.Base, defaultCharmSchem
 	cfg := deployer.DeployerConfig{
 		ApplicationName:    c.ApplicationName,
 		AttachStorage:      c.AttachStorage,
+		StorageSnapshots:   c.StorageSnapshots,
 		Bindings:           c.Bindings,
 		BundleDevices:      c.BundleDevices,
 		BundleMachines:     c.BundleMachines,
@@ -45,6 +45,7 @@ import (
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/version"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/internal/charm"
 	charmresource "github.com/juju/juju/internal/charm/resource"
 	"github.com/juju/juju/internal/cmd"
@@ -120,6 +121,9 @@ var initErrorTests = []struct {
 	}, {
 		args: []string{"charm", "--attach-storage", "foo/0", "-n", "2"},
 		err:  `--attach-storage cannot be used with -n`,
+	}, {
+		args: []string{"charm", "--attach-storage", "data=snap-0", "-n", "2"},
+		err:  `--attach-storage cannot be used with -n`,
 	}, {
 		args: []string{"bundle", "--map-machines", "foo"},
 		err:  `error in --map-machines: expected "existing" or "<bundle-id>=<machine-id>", got "foo"`,
@@ -525,6 +529,8 @@ var caasTests = []struct {
 }{
 	{[]string{"-m", "caas-model", "some-application-name", "--attach-storage", "foo/0"},
 		"--attach-storage cannot be used on k8s models"},
+	{[]string{"-m", "caas-model", "some-application-name", "--attach-storage", "data=snap-0"},
+		"--attach-storage cannot be used on k8s models"},
 	{[]string{"-m", "caas-model", "some-application-name", "--to", "a=b"},
 		regexp.QuoteMeta(`--to cannot be used on k8s models`)},
 }
@@ -1053,6 +1059,31 @@ func (s *DeployUnitTestSuite) TestDeployAttachStorage(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *DeployUnitTestSuite) TestDeployAttachStorageSnapshot(c *gc.C) {
+	charmsPath := c.MkDir()
+	charmDir := testcharms.RepoWithSeries("bionic").CharmArchive(charmsPath, "dummy")
+
+	defer s.setupMocks(c).Finish()
+	cfg := basicDeployerConfig("local:dummy-0")
+	cfg.AttachStorage = []string{"foo/0"}
+	cfg.StorageSnapshots = map[string]string{"data": "snap-0123"}
+	s.expectDeployer(c, cfg)
+
+	fakeAPI := s.fakeAPI()
+
+	dummyURL := charm.MustParseURL("local:dummy-0")
+	withLocalCharmDeployable(fakeAPI, dummyURL, charmDir, false)
+	withCharmDeployable(
+		fakeAPI, dummyURL, defaultBase, charmDir.Meta(), false, 1, []string{"foo/0"}, nil,
+	)
+
+	deployCmd := newDeployCommandForTest(fakeAPI)
+	_, err := cmdtesting.RunCommand(c, deployCmd, dummyURL.String(),
+		"--attach-storage", "foo/0,data=snap-0123",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *DeployUnitTestSuite) TestDeployAttachStorageContainer(c *gc.C) {
 	charmsPath := c.MkDir()
 	charmDir := testcharms.RepoWithSeries("bionic").CharmArchive(charmsPath, "dummy")
@@ -1083,6 +1114,56 @@ func (s *DeployUnitTestSuite) TestDeployAttachStorageContainer(c *gc.C) {
 
 }
 
+func (s *DeployUnitTestSuite) TestDeployEstimate(c *gc.C) {
+	pricesFile := filepath.Join(c.MkDir(), "prices.yaml")
+	err := os.WriteFile(pricesFile, []byte(`
+currency: USD
+regions:
+  us-east-1:
+    instance-types:
+      m5.large: 0.1
+      m5.xlarge: 0.2
+    volumes:
+      ebs: 0.08
+`[1:]), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	fakeAPI := s.fakeAPI()
+	fakeAPI.modelCons = constraints.MustParse("arch=amd64")
+	fakeAPI.Call("Status", (*apiclient.StatusArgs)(nil)).Returns(&params.FullStatus{
+		Model: params.ModelStatusInfo{Type: "iaas", CloudRegion: "us-east-1"},
+	}, error(nil))
+	fakeAPI.Call("PriceCatalogue").Returns((*pricing.Catalogue)(nil), errors.NotSupportedf("price catalogue"))
+	fakeAPI.Call("InstanceTypes", []constraints.Value{constraints.MustParse("arch=amd64 mem=8G")}).Returns(
+		[]params.InstanceTypesResult{{
+			InstanceTypes: []params.InstanceType{
+				{Name: "m5.xlarge", Arches: []string{"amd64"}, CPUCores: 4, Memory: 16384},
+				{Name: "m5.large", Arches: []string{"amd64"}, CPUCores: 2, Memory: 8192},
+			},
+		}}, error(nil))
+
+	ctx, err := s.runDeploy(c, fakeAPI, "postgresql", "-n", "3", "--to", "3",
+		"--constraints", "mem=8G", "--storage", "pgdata=ebs,10G",
+		"--estimate", "--prices", pricesFile,
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Machine     Instance type  Count  Hourly  Monthly
+postgresql  m5.large       2      0.1     146
+
+Volume             Pool  Size    Count  Monthly
+postgresql/pgdata  ebs   10 GiB  3      2.4
+
+Estimated monthly cost: 148.40 USD
+`[1:])
+	fakeAPI.CheckCallNames(c, "GetModelConstraints", "Status", "PriceCatalogue", "InstanceTypes")
+}
+
+func (s *DeployUnitTestSuite) TestDeployPricesWithoutEstimate(c *gc.C) {
+	_, err := s.runDeploy(c, s.fakeAPI(), "postgresql", "--prices", "prices.yaml")
+	c.Assert(err, gc.ErrorMatches, "--prices can only be used with --estimate")
+}
+
 func basicDeployerConfig(charmOrBundle string) deployer.DeployerConfig {
 	cfgOps := common.ConfigFlag{}
 	return deployer.DeployerConfig{
@@ -1169,6 +1250,9 @@ func newDeployCommandForTest(fakeAPI *fakeDeployAPI) modelcmd.ModelCommand {
 	deployCmd.NewConsumeDetailsAPI = func(ctx context.Context, url *charm.OfferURL) (deployer.ConsumeDetails, error) {
 		return fakeAPI, nil
 	}
+	deployCmd.NewCostAPI = func(api base.APICallCloser) common.CostAPI {
+		return fakeAPI
+	}
 	return cmd
 }
 
@@ -1337,6 +1421,16 @@ func (f *fakeDeployAPI) Status(ctx context.Context, args *apiclient.StatusArgs)
 	return results[0].(*params.FullStatus), jujutesting.TypeAssertError(results[1])
 }
 
+func (f *fakeDeployAPI) InstanceTypes(ctx context.Context, cons []constraints.Value) ([]params.InstanceTypesResult, error) {
+	results := f.MethodCall(f, "InstanceTypes", cons)
+	return results[0].([]params.InstanceTypesResult), jujutesting.TypeAssertError(results[1])
+}
+
+func (f *fakeDeployAPI) PriceCatalogue(ctx context.Context) (*pricing.Catalogue, error) {
+	results := f.MethodCall(f, "PriceCatalogue")
+	return results[0].(*pricing.Catalogue), jujutesting.TypeAssertError(results[1])
+}
+
 func (f *fakeDeployAPI) AddRelation(ctx context.Context, endpoints, viaCIDRs []string) (*params.AddRelationResults, error) {
 	results := f.MethodCall(f, "AddRelation", stringToInterface(endpoints), stringToInterface(viaCIDRs))
 	return results[0].(*params.AddRelationResults), jujutesting.TypeAssertError(results[1])
@@ -32,6 +32,7 @@ import (
 type deployCharm struct {
 	applicationName  string
 	attachStorage    []string
+	storageSnapshots map[string]string
 	bindings         map[string]string
 	configOptions    DeployConfigFlag
 	constraints      constraints.Value
@@ -88,7 +89,7 @@ func (d *deployCharm) deploy(
 		if err != nil {
 			continue
 		}
-		if len(d.attachStorage) > 0 {
+		if len(d.attachStorage) > 0 || len(d.storageSnapshots) > 0 {
 			return errors.NotSupportedf("attaching storage to %s container", string(t))
 		}
 		for _, s := range d.storage {
@@ -163,6 +164,7 @@ func (d *deployCharm) deploy(
 		Storage:          d.storage,
 		Devices:          d.devices,
 		AttachStorage:    d.attachStorage,
+		StorageSnapshots: d.storageSnapshots,
 		Resources:        ids,
 		EndpointBindings: d.bindings,
 		Force:            d.force,
@@ -365,6 +367,7 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 		CharmName:        charmName,
 		ApplicationName:  c.applicationName,
 		AttachStorage:    c.attachStorage,
+		StorageSnapshots: c.storageSnapshots,
 		Base:             base,
 		Channel:          channel,
 		ConfigYAML:       configYAML,
@@ -357,6 +357,7 @@ func (d *factory) setConfig(cfg DeployerConfig) {
 	d.placement = cfg.Placement
 	d.numUnits = cfg.NumUnits
 	d.attachStorage = cfg.AttachStorage
+	d.storageSnapshots = cfg.StorageSnapshots
 	d.charmOrBundle = cfg.CharmOrBundle
 	d.defaultCharmSchema = cfg.DefaultCharmSchema
 	d.bundleOverlayFile = cfg.BundleOverlayFile
@@ -399,6 +400,7 @@ type DeployerConfig struct {
 	Model                ModelCommand
 	ApplicationName      string
 	AttachStorage        []string
+	StorageSnapshots     map[string]string
 	Bindings             map[string]string
 	BindToSpaces         string
 	BundleDevices        map[string]map[string]devices.Constraints
@@ -443,6 +445,7 @@ type factory struct {
 	placement          []*instance.Placement
 	numUnits           int
 	attachStorage      []string
+	storageSnapshots   map[string]string
 	charmOrBundle      string
 	bundleOverlayFile  []string
 	channel            charm.Channel
@@ -476,6 +479,7 @@ func (d *factory) newDeployCharm() deployCharm {
 	return deployCharm{
 		applicationName:  d.applicationName,
 		attachStorage:    d.attachStorage,
+		storageSnapshots: d.storageSnapshots,
 		bindings:         d.bindings,
 		configOptions:    &d.configOptions,
 		constraints:      d.constraints,
@@ -698,7 +702,7 @@ func (d *factory) validateBundleFlags() error {
 func CharmOnlyFlags() []string {
 	charmOnlyFlags := []string{
 		"bind", "config", "constraints", "n", "num-units",
-		"base", "to", "resource", "attach-storage",
+		"base", "to", "resource", "attach-storage", "estimate", "prices",
 	}
 
 	return charmOnlyFlags
@@ -164,6 +164,15 @@ func NewScaleCommandForTest(api scaleApplicationAPI, store jujuclient.ClientStor
 	return modelcmd.Wrap(cmd)
 }
 
+// NewAutoscaleCommandForTest returns an autoscaleApplicationCommand with the api provided as specified.
+func NewAutoscaleCommandForTest(api autoscaleApplicationAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &autoscaleApplicationCommand{newAPIFunc: func(ctx context.Context) (autoscaleApplicationAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
 func NewDiffBundleCommandForTest(api base.APICallCloser,
 	charmStoreFn func(base.APICallCloser, *charm.URL) (BundleResolver, error),
 	modelConsFn func(ctx context.Context) (ModelConstraintsClient, error),
@@ -192,6 +201,19 @@ func NewShowCommandForTest(api ApplicationsInfoAPI, store jujuclient.ClientStore
 	return modelcmd.Wrap(cmd)
 }
 
+func NewShowRightsizingCommandForTest(api ApplicationsInfoAPI, rightsizingAPI RightsizingAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &showApplicationCommand{
+		newAPIFunc: func(ctx context.Context) (ApplicationsInfoAPI, error) {
+			return api, nil
+		},
+		newRightsizingAPIFunc: func(ctx context.Context) (RightsizingAPI, error) {
+			return rightsizingAPI, nil
+		},
+	}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
 func NewShowUnitCommandForTest(api UnitsInfoAPI, store jujuclient.ClientStore) cmd.Command {
 	cmd := &showUnitCommand{newAPIFunc: func(ctx context.Context) (UnitsInfoAPI, error) {
 		return api, nil
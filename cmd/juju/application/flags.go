@@ -5,6 +5,7 @@ package application
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/juju/errors"
@@ -142,16 +143,37 @@ func (f devicesFlag) String() string {
 	return strings.Join(strs, " ")
 }
 
+var validStorageName = regexp.MustCompile("^" + names.StorageNameSnippet + "$")
+
 type attachStorageFlag struct {
 	storageIDs *[]string
+	snapshots  *map[string]string
 }
 
-// Set implements gnuflag.Value.Set.
+// Set implements gnuflag.Value.Set. Each comma separated value is either
+// the ID of existing storage, or <storage>=<snapshot-id> to restore new
+// storage from a snapshot.
 func (f attachStorageFlag) Set(s string) error {
 	if s == "" {
 		return nil
 	}
 	for _, id := range strings.Split(s, ",") {
+		if storageName, snapshotId, ok := strings.Cut(id, "="); ok {
+			if !validStorageName.MatchString(storageName) {
+				return errors.NotValidf("storage name %q", storageName)
+			}
+			if snapshotId == "" {
+				return errors.NotValidf("empty snapshot ID for storage %q", storageName)
+			}
+			if *f.snapshots == nil {
+				*f.snapshots = make(map[string]string)
+			}
+			if _, ok := (*f.snapshots)[storageName]; ok {
+				return errors.Errorf("storage %q specified more than once", storageName)
+			}
+			(*f.snapshots)[storageName] = snapshotId
+			continue
+		}
 		if !names.IsValidStorage(id) {
 			return errors.NotValidf("storage ID %q", id)
 		}
@@ -162,7 +184,11 @@ func (f attachStorageFlag) Set(s string) error {
 
 // String implements gnuflag.Value.String.
 func (f attachStorageFlag) String() string {
-	return strings.Join(*f.storageIDs, ",")
+	values := append([]string(nil), *f.storageIDs...)
+	for storageName, snapshotId := range *f.snapshots {
+		values = append(values, storageName+"="+snapshotId)
+	}
+	return strings.Join(values, ",")
 }
 
 // stringMap is a type that deserializes a CLI string using gnuflag's Value
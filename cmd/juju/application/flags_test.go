@@ -97,16 +97,37 @@ func (FlagSuite) TestStorageFlagBundleStorageErrors(c *gc.C) {
 
 func (FlagSuite) TestAttachStorageFlag(c *gc.C) {
 	var stores []string
-	flag := attachStorageFlag{&stores}
+	flag := attachStorageFlag{&stores, new(map[string]string)}
 	err := flag.Set("foo/0,bar/1")
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(stores, jc.DeepEquals, []string{"foo/0", "bar/1"})
 }
 
+func (FlagSuite) TestAttachStorageFlagSnapshots(c *gc.C) {
+	var stores []string
+	var snapshots map[string]string
+	flag := attachStorageFlag{&stores, &snapshots}
+	err := flag.Set("foo/0,pg-data=snap-0123")
+	c.Assert(err, jc.ErrorIsNil)
+	err = flag.Set("logs=snap-4567")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(stores, jc.DeepEquals, []string{"foo/0"})
+	c.Assert(snapshots, jc.DeepEquals, map[string]string{
+		"pg-data": "snap-0123",
+		"logs":    "snap-4567",
+	})
+}
+
 func (FlagSuite) TestAttachStorageFlagErrors(c *gc.C) {
-	flag := attachStorageFlag{new([]string)}
+	flag := attachStorageFlag{new([]string), new(map[string]string)}
 	err := flag.Set("zing")
 	c.Assert(err, gc.ErrorMatches, `storage ID "zing" not valid`)
+	err = flag.Set("Zing=snap-0")
+	c.Assert(err, gc.ErrorMatches, `storage name "Zing" not valid`)
+	err = flag.Set("zing=")
+	c.Assert(err, gc.ErrorMatches, `empty snapshot ID for storage "zing" not valid`)
+	err = flag.Set("zing=snap-0,zing=snap-1")
+	c.Assert(err, gc.ErrorMatches, `storage "zing" specified more than once`)
 }
 
 func (FlagSuite) TestDevicesFlag(c *gc.C) {
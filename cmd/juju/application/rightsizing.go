@@ -0,0 +1,317 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	apiclient "github.com/juju/juju/api/client/client"
+	"github.com/juju/juju/api/client/machinemanager"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/pricing"
+	"github.com/juju/juju/environs/rightsizing"
+	"github.com/juju/juju/rpc/params"
+)
+
+// RightsizingAPI defines the API methods that show-application uses to
+// recommend instance types.
+type RightsizingAPI interface {
+	common.CostAPI
+	Close() error
+	Status(context.Context, *apiclient.StatusArgs) (*params.FullStatus, error)
+	MachineUtilisation(context.Context, []names.MachineTag) ([]params.MachineUtilisationResult, error)
+	SetConstraints(ctx context.Context, application string, constraints constraints.Value) error
+}
+
+type rightsizingAPI struct {
+	*machinemanager.Client
+	status      *apiclient.Client
+	application *application.Client
+}
+
+// Status implements RightsizingAPI.
+func (api rightsizingAPI) Status(ctx context.Context, args *apiclient.StatusArgs) (*params.FullStatus, error) {
+	return api.status.Status(ctx, args)
+}
+
+// SetConstraints implements RightsizingAPI.
+func (api rightsizingAPI) SetConstraints(ctx context.Context, application string, cons constraints.Value) error {
+	return api.application.SetConstraints(ctx, application, cons)
+}
+
+func (c *showApplicationCommand) newRightsizingAPI(ctx context.Context) (RightsizingAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return rightsizingAPI{
+		Client:      machinemanager.NewClient(root),
+		status:      apiclient.NewClient(root, logger),
+		application: application.NewClient(root),
+	}, nil
+}
+
+// RightsizingInfo defines the serialization behaviour of an instance type
+// recommendation for an application.
+type RightsizingInfo struct {
+	Window                  string                            `yaml:"window,omitempty" json:"window,omitempty"`
+	Machines                map[string]MachineUtilisationInfo `yaml:"machines,omitempty" json:"machines,omitempty"`
+	InstanceType            string                            `yaml:"instance-type,omitempty" json:"instance-type,omitempty"`
+	RecommendedInstanceType string                            `yaml:"recommended-instance-type,omitempty" json:"recommended-instance-type,omitempty"`
+	Change                  string                            `yaml:"change,omitempty" json:"change,omitempty"`
+	Applied                 bool                              `yaml:"applied,omitempty" json:"applied,omitempty"`
+	Message                 string                            `yaml:"message,omitempty" json:"message,omitempty"`
+}
+
+// MachineUtilisationInfo defines the serialization behaviour of the
+// utilisation of a machine, as percentages of its CPU and memory.
+type MachineUtilisationInfo struct {
+	Samples    int     `yaml:"samples" json:"samples"`
+	MeanCPU    float64 `yaml:"mean-cpu" json:"mean-cpu"`
+	PeakCPU    float64 `yaml:"peak-cpu" json:"peak-cpu"`
+	MeanMemory float64 `yaml:"mean-memory" json:"mean-memory"`
+	PeakMemory float64 `yaml:"peak-memory" json:"peak-memory"`
+}
+
+// appMachines holds the machines that run the units of an application,
+// keyed by machine id.
+type appMachines map[string]instance.HardwareCharacteristics
+
+// addRightsizing adds instance type recommendations to the application
+// infos and, if requested, sets the recommended instance types as the
+// application constraints.
+func (c *showApplicationCommand) addRightsizing(ctx context.Context, output map[string]ApplicationInfo) error {
+	api, err := c.newRightsizingAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	status, err := api.Status(ctx, &apiclient.StatusArgs{Patterns: c.apps})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	machines := make(map[string]appMachines)
+	var tags []names.MachineTag
+	for appName := range output {
+		machines[appName], err = applicationMachines(status, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for id := range machines[appName] {
+			tags = append(tags, names.NewMachineTag(id))
+		}
+	}
+	slices.SortFunc(tags, func(a, b names.MachineTag) int {
+		return strings.Compare(a.Id(), b.Id())
+	})
+	tags = slices.CompactFunc(tags, func(a, b names.MachineTag) bool {
+		return a == b
+	})
+
+	var results []params.MachineUtilisationResult
+	if len(tags) > 0 {
+		if results, err = api.MachineUtilisation(ctx, tags); err != nil {
+			return errors.Annotate(err, "getting machine utilisation")
+		}
+	}
+	utilisation := make(map[string]params.MachineUtilisation)
+	var window time.Duration
+	for i, result := range results {
+		if result.Error != nil {
+			logger.Debugf(ctx, "cannot get utilisation of machine %s: %v", tags[i].Id(), result.Error)
+			continue
+		}
+		utilisation[tags[i].Id()] = *result.Result
+		window = time.Duration(result.Result.WindowSeconds) * time.Second
+	}
+
+	catalogue, err := common.LoadPriceCatalogue(ctx, api, c.pricesFile)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	candidates := make(map[string][]instances.InstanceType)
+
+	for appName, info := range output {
+		r := &RightsizingInfo{}
+		if window > 0 {
+			r.Window = window.String()
+		}
+		info.Rightsizing = r
+		output[appName] = info
+
+		req, hc, ok := requirement(machines[appName], utilisation, r)
+		if !ok {
+			continue
+		}
+		var arch string
+		if hc.Arch != nil {
+			arch = *hc.Arch
+		}
+		if _, ok := candidates[arch]; !ok {
+			cons := constraints.Value{}
+			if arch != "" {
+				cons.Arch = &arch
+			}
+			itypes, err := api.InstanceTypes(ctx, []constraints.Value{cons})
+			if err != nil {
+				return errors.Annotate(err, "getting instance types")
+			}
+			if itypes[0].Error != nil {
+				return errors.Annotate(itypes[0].Error, "getting instance types")
+			}
+			candidates[arch] = common.InstanceTypes(itypes[0], arch)
+		}
+
+		current := currentInstanceType(info.Constraints, hc, candidates[arch], catalogue, status.Model.CloudRegion)
+		r.InstanceType = current.Name
+		rec, err := rightsizing.Recommend(current, req, candidates[arch], catalogue, status.Model.CloudRegion)
+		if errors.Is(err, errors.NotFound) {
+			r.Message = err.Error()
+			continue
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		r.RecommendedInstanceType = rec.InstanceType.Name
+		r.Change = string(rec.Change)
+
+		if !c.apply || rec.Change == rightsizing.Keep || rec.InstanceType.Name == "" {
+			continue
+		}
+		cons := info.Constraints
+		cons.CpuCores, cons.CpuPower, cons.Mem = nil, nil, nil
+		cons.InstanceType = &rec.InstanceType.Name
+		if err := api.SetConstraints(ctx, appName, cons); err != nil {
+			return errors.Annotatef(err, "setting constraints for application %q", appName)
+		}
+		info.Constraints = cons
+		r.Applied = true
+		output[appName] = info
+	}
+	return nil
+}
+
+// applicationMachines returns the machines, not including containers,
+// that run the units of the application in the status.
+func applicationMachines(status *params.FullStatus, appName string) (appMachines, error) {
+	result := make(appMachines)
+	app, ok := status.Applications[appName]
+	if !ok {
+		return result, nil
+	}
+	for _, unit := range app.Units {
+		machine, ok := status.Machines[unit.Machine]
+		if !ok {
+			// Containers are not listed at the top level, and
+			// their size is not set by an instance type.
+			continue
+		}
+		hc, err := instance.ParseHardware(machine.Hardware)
+		if err != nil {
+			return nil, errors.Annotatef(err, "parsing hardware of machine %s", unit.Machine)
+		}
+		result[unit.Machine] = hc
+	}
+	return result, nil
+}
+
+// requirement returns the capacity required by the busiest of the
+// machines of an application, and the hardware of one of them, recording
+// the utilisation of each in the rightsizing info. It returns false if
+// there is not enough data to make a recommendation.
+func requirement(
+	machines appMachines, utilisation map[string]params.MachineUtilisation, r *RightsizingInfo,
+) (rightsizing.Requirement, instance.HardwareCharacteristics, bool) {
+	if len(machines) == 0 {
+		r.Message = "no machines to recommend an instance type for"
+		return rightsizing.Requirement{}, instance.HardwareCharacteristics{}, false
+	}
+	ids := make([]string, 0, len(machines))
+	for id := range machines {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var (
+		req   rightsizing.Requirement
+		hc    instance.HardwareCharacteristics
+		found bool
+	)
+	r.Machines = make(map[string]MachineUtilisationInfo)
+	for _, id := range ids {
+		u, ok := utilisation[id]
+		if !ok {
+			continue
+		}
+		r.Machines[id] = MachineUtilisationInfo{
+			Samples:    u.Samples,
+			MeanCPU:    round(u.MeanCPU),
+			PeakCPU:    round(u.PeakCPU),
+			MeanMemory: round(u.MeanMemory),
+			PeakMemory: round(u.PeakMemory),
+		}
+		machineHC := machines[id]
+		if u.Samples < rightsizing.MinSamples || machineHC.CpuCores == nil || machineHC.Mem == nil {
+			continue
+		}
+		req = req.Max(rightsizing.RequirementOf(*machineHC.CpuCores, *machineHC.Mem, u.PeakCPU, u.PeakMemory))
+		if !found {
+			hc, found = machineHC, true
+		}
+	}
+	if !found {
+		r.Message = "not enough utilisation data"
+	}
+	return req, hc, found
+}
+
+// currentInstanceType returns the instance type of the application's
+// machines: the one in the application constraints if there is one,
+// otherwise the cheapest that matches the hardware of the machines.
+// If no instance type matches, one is made up from the hardware.
+func currentInstanceType(
+	cons constraints.Value, hc instance.HardwareCharacteristics,
+	candidates []instances.InstanceType, catalogue *pricing.Catalogue, region string,
+) instances.InstanceType {
+	if cons.HasInstanceType() {
+		for _, itype := range candidates {
+			if itype.Name == *cons.InstanceType {
+				return itype
+			}
+		}
+	}
+	if matching := pricing.MatchingHardware(candidates, hc); len(matching) > 0 {
+		if itype, ok := catalogue.CheapestInstanceType(region, matching); ok {
+			return itype
+		}
+		return matching[0]
+	}
+	current := instances.InstanceType{}
+	if hc.Arch != nil {
+		current.Arch = *hc.Arch
+	}
+	if hc.CpuCores != nil {
+		current.CpuCores = *hc.CpuCores
+	}
+	if hc.Mem != nil {
+		current.Mem = *hc.Mem
+	}
+	return current
+}
+
+// round rounds a percentage to one decimal place.
+func round(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -24,6 +24,19 @@ const showApplicationDoc = `
 The command takes deployed application names or aliases as an argument.
 
 The command does an exact search. It does not support wildcards.
+
+With --rightsizing, an instance type is recommended for each application
+from the CPU and memory utilisation reported by the agents of its machines
+over the model's rightsizing window (see the rightsizing-window model
+config key). The recommended instance type is the cheapest, by the prices
+in the price catalogue, that would run the busiest machine of the
+application at no more than 70% CPU and 80% memory utilisation at its
+peak. Machines with less than an hour of utilisation data are ignored.
+
+With --apply, the recommended instance type is set as the instance-type
+constraint of the application, replacing its cores, cpu-power and mem
+constraints. Only units added afterwards are affected; existing machines
+are not resized.
 `
 
 const showApplicationExamples = `
@@ -33,6 +46,10 @@ const showApplicationExamples = `
     juju show-application myapplication
 
 where "myapplication" is the application name alias; see "juju help deploy" for more information.
+
+    juju show-application mysql --rightsizing
+    juju show-application mysql --rightsizing --apply
+    juju show-application mysql --rightsizing --prices ./prices.yaml
 `
 
 // NewShowApplicationCommand returns a command that displays applications info.
@@ -41,6 +58,7 @@ func NewShowApplicationCommand() cmd.Command {
 	s.newAPIFunc = func(ctx context.Context) (ApplicationsInfoAPI, error) {
 		return s.newApplicationAPI(ctx)
 	}
+	s.newRightsizingAPIFunc = s.newRightsizingAPI
 	return modelcmd.Wrap(s)
 }
 
@@ -48,9 +66,14 @@ func NewShowApplicationCommand() cmd.Command {
 type showApplicationCommand struct {
 	modelcmd.ModelCommandBase
 
-	out        cmd.Output
-	apps       []string
-	newAPIFunc func(ctx context.Context) (ApplicationsInfoAPI, error)
+	out         cmd.Output
+	apps        []string
+	rightsizing bool
+	apply       bool
+	pricesFile  string
+
+	newAPIFunc            func(ctx context.Context) (ApplicationsInfoAPI, error)
+	newRightsizingAPIFunc func(ctx context.Context) (RightsizingAPI, error)
 }
 
 // Info implements Command.Info.
@@ -70,6 +93,12 @@ func (c *showApplicationCommand) Init(args []string) error {
 	if len(args) < 1 {
 		return errors.Errorf("an application name must be supplied")
 	}
+	if c.apply && !c.rightsizing {
+		return errors.New("--apply can only be used with --rightsizing")
+	}
+	if c.pricesFile != "" && !c.rightsizing {
+		return errors.New("--prices can only be used with --rightsizing")
+	}
 	c.apps = args
 	var invalid []string
 	for _, one := range c.apps {
@@ -91,6 +120,9 @@ func (c *showApplicationCommand) Init(args []string) error {
 func (c *showApplicationCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	c.out.AddFlags(f, "yaml", cmd.DefaultFormatters.Formatters())
+	f.BoolVar(&c.rightsizing, "rightsizing", false, "Recommend instance types from the utilisation of the application machines")
+	f.BoolVar(&c.apply, "apply", false, "Set the recommended instance types as the application constraints")
+	f.StringVar(&c.pricesFile, "prices", "", "Path to a price catalogue file used to compare instance types")
 }
 
 // ApplicationsInfoAPI defines the API methods that show-application command uses.
@@ -141,6 +173,11 @@ func (c *showApplicationCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.rightsizing {
+		if err := c.addRightsizing(ctx, output); err != nil {
+			return errors.Annotate(err, "recommending instance types")
+		}
+	}
 	return c.out.Write(ctx, output)
 }
 
@@ -184,6 +221,7 @@ type ApplicationInfo struct {
 	Remote           bool                       `yaml:"remote" json:"remote"`
 	Life             string                     `yaml:"life,omitempty" json:"life,omitempty"`
 	EndpointBindings map[string]string          `yaml:"endpoint-bindings,omitempty" json:"endpoint-bindings,omitempty"`
+	Rightsizing      *RightsizingInfo           `yaml:"rightsizing,omitempty" json:"rightsizing,omitempty"`
 }
 
 // ExposedEndpoint defines the serialization behavior of the expose settings
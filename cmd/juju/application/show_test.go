@@ -11,9 +11,11 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	apiclient "github.com/juju/juju/api/client/client"
 	"github.com/juju/juju/cmd/juju/application"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/relation"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/cmd/cmdtesting"
 	jujutesting "github.com/juju/juju/internal/testing"
@@ -276,3 +278,184 @@ func (s mockShowAPI) Close() error {
 func (s mockShowAPI) ApplicationsInfo(ctx context.Context, tags []names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
 	return s.applicationsInfoFunc(tags)
 }
+
+func (s *ShowSuite) TestShowApplyWithoutRightsizing(c *gc.C) {
+	msg := "--apply can only be used with --rightsizing"
+	s.assertRunShow(c, showTest{
+		args:   []string{"wordpress", "--apply"},
+		err:    msg,
+		stderr: fmt.Sprintf("ERROR %v\n", msg),
+	})
+}
+
+func (s *ShowSuite) TestShowPricesWithoutRightsizing(c *gc.C) {
+	msg := "--prices can only be used with --rightsizing"
+	s.assertRunShow(c, showTest{
+		args:   []string{"wordpress", "--prices", "prices.yaml"},
+		err:    msg,
+		stderr: fmt.Sprintf("ERROR %v\n", msg),
+	})
+}
+
+func (s *ShowSuite) newRightsizingAPI() *mockRightsizingAPI {
+	s.mockAPI.applicationsInfoFunc = func([]names.ApplicationTag) ([]params.ApplicationInfoResult, error) {
+		return []params.ApplicationInfoResult{
+			{Result: s.createTestApplicationInfo("wordpress", "")},
+		}, nil
+	}
+	return &mockRightsizingAPI{
+		status: &params.FullStatus{
+			Model: params.ModelStatusInfo{CloudRegion: "west"},
+			Machines: map[string]params.MachineStatus{
+				"0": {Hardware: "arch=amd64 cores=4 mem=8192M"},
+				"1": {Hardware: "arch=amd64 cores=4 mem=8192M"},
+			},
+			Applications: map[string]params.ApplicationStatus{
+				"wordpress": {
+					Units: map[string]params.UnitStatus{
+						"wordpress/0": {Machine: "0"},
+						"wordpress/1": {Machine: "1"},
+						"wordpress/2": {Machine: "0/lxd/0"},
+					},
+				},
+			},
+		},
+		utilisation: map[string]params.MachineUtilisation{
+			"0": {Samples: 24, MeanCPU: 20.04, PeakCPU: 35, MeanMemory: 30, PeakMemory: 40, WindowSeconds: 7 * 24 * 60 * 60},
+			"1": {Samples: 5, MeanCPU: 90, PeakCPU: 99, MeanMemory: 90, PeakMemory: 99, WindowSeconds: 7 * 24 * 60 * 60},
+		},
+		instanceTypes: params.InstanceTypesResult{
+			InstanceTypes: []params.InstanceType{
+				{Name: "small", Arches: []string{"amd64"}, CPUCores: 2, Memory: 4096},
+				{Name: "medium", Arches: []string{"amd64"}, CPUCores: 4, Memory: 8192},
+				{Name: "large", Arches: []string{"amd64"}, CPUCores: 8, Memory: 16384},
+			},
+		},
+		catalogue: &pricing.Catalogue{
+			Currency: "USD",
+			Regions: map[string]pricing.RegionPrices{
+				"west": {InstanceTypes: map[string]float64{"small": 0.05, "medium": 0.1, "large": 0.2}},
+			},
+		},
+	}
+}
+
+const rightsizingOutput = `
+wordpress:
+  charm: charm-wordpress
+  base: ubuntu@12.10
+  channel: development
+  constraints:
+    arch: amd64
+    cores: 1
+    mem: 4096
+    root-disk: 8192
+  principal: true
+  exposed: false
+  remote: false
+  life: alive
+  endpoint-bindings:
+    juju-info: myspace
+  rightsizing:
+    window: 168h0m0s
+    machines:
+      "0":
+        samples: 24
+        mean-cpu: 20
+        peak-cpu: 35
+        mean-memory: 30
+        peak-memory: 40
+      "1":
+        samples: 5
+        mean-cpu: 90
+        peak-cpu: 99
+        mean-memory: 90
+        peak-memory: 99
+    instance-type: medium
+    recommended-instance-type: small
+    change: downsize
+`
+
+func (s *ShowSuite) TestShowRightsizing(c *gc.C) {
+	api := s.newRightsizingAPI()
+	ctx, err := cmdtesting.RunCommand(c, application.NewShowRightsizingCommandForTest(s.mockAPI, api, s.store), "wordpress", "--rightsizing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, rightsizingOutput[1:])
+	c.Assert(api.statusArgs, jc.DeepEquals, &apiclient.StatusArgs{Patterns: []string{"wordpress"}})
+	c.Assert(api.machines, jc.DeepEquals, []names.MachineTag{names.NewMachineTag("0"), names.NewMachineTag("1")})
+	c.Assert(api.instanceTypesCons, jc.DeepEquals, []constraints.Value{constraints.MustParse("arch=amd64")})
+	c.Assert(api.setConstraints, gc.HasLen, 0)
+}
+
+func (s *ShowSuite) TestShowRightsizingApply(c *gc.C) {
+	api := s.newRightsizingAPI()
+	ctx, err := cmdtesting.RunCommand(c, application.NewShowRightsizingCommandForTest(s.mockAPI, api, s.store), "wordpress", "--rightsizing", "--apply")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(api.setConstraints, jc.DeepEquals, map[string]constraints.Value{
+		"wordpress": constraints.MustParse("arch=amd64 root-disk=8G instance-type=small"),
+	})
+	c.Assert(cmdtesting.Stdout(ctx), jc.Contains, "    change: downsize\n    applied: true\n")
+	c.Assert(cmdtesting.Stdout(ctx), jc.Contains, "    root-disk: 8192\n    instance-type: small\n")
+}
+
+func (s *ShowSuite) TestShowRightsizingNotEnoughData(c *gc.C) {
+	api := s.newRightsizingAPI()
+	api.utilisation["0"] = params.MachineUtilisation{Samples: 3, WindowSeconds: 3600}
+	ctx, err := cmdtesting.RunCommand(c, application.NewShowRightsizingCommandForTest(s.mockAPI, api, s.store), "wordpress", "--rightsizing", "--apply")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), jc.Contains, "    message: not enough utilisation data\n")
+	c.Assert(api.instanceTypesCons, gc.HasLen, 0)
+	c.Assert(api.setConstraints, gc.HasLen, 0)
+}
+
+type mockRightsizingAPI struct {
+	status        *params.FullStatus
+	utilisation   map[string]params.MachineUtilisation
+	instanceTypes params.InstanceTypesResult
+	catalogue     *pricing.Catalogue
+
+	statusArgs        *apiclient.StatusArgs
+	machines          []names.MachineTag
+	instanceTypesCons []constraints.Value
+	setConstraints    map[string]constraints.Value
+}
+
+func (m *mockRightsizingAPI) Close() error {
+	return nil
+}
+
+func (m *mockRightsizingAPI) Status(_ context.Context, args *apiclient.StatusArgs) (*params.FullStatus, error) {
+	m.statusArgs = args
+	return m.status, nil
+}
+
+func (m *mockRightsizingAPI) MachineUtilisation(_ context.Context, machines []names.MachineTag) ([]params.MachineUtilisationResult, error) {
+	m.machines = machines
+	results := make([]params.MachineUtilisationResult, len(machines))
+	for i, machine := range machines {
+		u, ok := m.utilisation[machine.Id()]
+		if !ok {
+			results[i].Error = &params.Error{Code: params.CodeNotFound, Message: "not found"}
+			continue
+		}
+		results[i].Result = &u
+	}
+	return results, nil
+}
+
+func (m *mockRightsizingAPI) InstanceTypes(_ context.Context, cons []constraints.Value) ([]params.InstanceTypesResult, error) {
+	m.instanceTypesCons = append(m.instanceTypesCons, cons...)
+	return []params.InstanceTypesResult{m.instanceTypes}, nil
+}
+
+func (m *mockRightsizingAPI) PriceCatalogue(context.Context) (*pricing.Catalogue, error) {
+	return m.catalogue, nil
+}
+
+func (m *mockRightsizingAPI) SetConstraints(_ context.Context, application string, cons constraints.Value) error {
+	if m.setConstraints == nil {
+		m.setConstraints = make(map[string]constraints.Value)
+	}
+	m.setConstraints[application] = cons
+	return nil
+}
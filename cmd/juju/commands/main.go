@@ -17,6 +17,7 @@ import (
 	cloudfile "github.com/juju/juju/cloud"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/action"
+	"github.com/juju/juju/cmd/juju/admission"
 	"github.com/juju/juju/cmd/juju/application"
 	"github.com/juju/juju/cmd/juju/backups"
 	"github.com/juju/juju/cmd/juju/block"
@@ -352,7 +353,11 @@ func registerCommands(r commandRegistry) {
 
 	// Firewall rule commands.
 	r.Register(firewall.NewSetFirewallRuleCommand())
+	r.Register(firewall.NewRemoveFirewallRuleCommand())
 	r.Register(firewall.NewListFirewallRulesCommand())
+	r.Register(firewall.NewSetCIDRSetCommand())
+	r.Register(firewall.NewRemoveCIDRSetCommand())
+	r.Register(firewall.NewListCIDRSetsCommand())
 
 	// Destruction commands.
 	r.Register(application.NewRemoveRelationCommand())
@@ -369,6 +374,16 @@ func registerCommands(r commandRegistry) {
 	r.Register(action.NewExecCommand(nil))
 	r.Register(ssh.NewSCPCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
 	r.Register(ssh.NewSSHCommand(nil, nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
+	r.Register(ssh.NewProxyCommand())
+	r.Register(ssh.NewSSHSessionsCommand())
+	r.Register(ssh.NewRequestSSHAccessCommand())
+	r.Register(ssh.NewListSSHAccessGrantsCommand())
+	r.Register(ssh.NewApproveSSHAccessCommand())
+	r.Register(ssh.NewRejectSSHAccessCommand())
+	r.Register(ssh.NewRevokeSSHAccessCommand())
+	r.Register(ssh.NewSSHCertificateCommand())
+	r.Register(ssh.NewListSSHCertificatesCommand())
+	r.Register(ssh.NewRevokeSSHCertificateCommand())
 	r.Register(application.NewResolvedCommand())
 	r.Register(newDebugLogCommand(nil))
 	r.Register(ssh.NewDebugHooksCommand(nil, ssh.DefaultSSHRetryStrategy, ssh.DefaultSSHPublicKeyRetryStrategy))
@@ -408,6 +423,17 @@ func registerCommands(r commandRegistry) {
 	r.Register(user.NewLogoutCommand())
 	r.Register(user.NewRemoveCommand())
 	r.Register(user.NewWhoAmICommand())
+	r.Register(user.NewAddRoleCommand())
+	r.Register(user.NewRemoveRoleCommand())
+	r.Register(user.NewRolesCommand())
+	r.Register(user.NewGrantRoleCommand())
+	r.Register(user.NewRevokeRoleCommand())
+	r.Register(user.NewGroupsCommand())
+	r.Register(user.NewGrantGroupCommand())
+	r.Register(user.NewRevokeGroupCommand())
+	r.Register(user.NewAddServiceAccountCommand())
+	r.Register(user.NewIssueTokenCommand())
+	r.Register(user.NewRevokeTokenCommand())
 
 	// Manage machines
 	r.Register(machine.NewAddCommand())
@@ -462,6 +488,12 @@ func registerCommands(r commandRegistry) {
 	r.Register(block.NewListCommand())
 	r.Register(block.NewEnableCommand())
 
+	// Admission policy commands
+	r.Register(admission.NewAddPolicyCommand())
+	r.Register(admission.NewRemovePolicyCommand())
+	r.Register(admission.NewPoliciesCommand())
+	r.Register(admission.NewTestPolicyCommand())
+
 	// Manage storage
 	r.Register(storage.NewAddCommand())
 	r.Register(storage.NewListCommand())
@@ -473,6 +505,10 @@ func registerCommands(r commandRegistry) {
 	r.Register(storage.NewRemoveStorageCommandWithAPI())
 	r.Register(storage.NewDetachStorageCommandWithAPI())
 	r.Register(storage.NewAttachStorageCommandWithAPI())
+	r.Register(storage.NewResizeStorageCommandWithAPI())
+	r.Register(storage.NewSnapshotCreateCommand())
+	r.Register(storage.NewSnapshotListCommand())
+	r.Register(storage.NewSnapshotRemoveCommand())
 	r.Register(storage.NewImportFilesystemCommand(storage.NewStorageImporter, nil))
 
 	// Manage spaces
@@ -523,6 +559,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(caas.NewUpdateCAASCommand(&cloudToCommandAdaptor{}))
 	r.Register(caas.NewRemoveCAASCommand(&cloudToCommandAdaptor{}))
 	r.Register(application.NewScaleApplicationCommand())
+	r.Register(application.NewAutoscaleApplicationCommand())
 
 	// Manage Application Credential Access
 	r.Register(application.NewTrustCommand())
@@ -308,26 +308,33 @@ func assertNoArgs(c *gc.C, argChan <-chan []string) {
 
 var commandNames = []string{
 	"actions",
+	"add-admission-policy",
 	"add-cloud",
 	"add-credential",
 	"add-k8s",
 	"add-machine",
 	"add-model",
+	"add-role",
 	"add-secret-backend",
 	"add-secret",
+	"add-service-account",
 	"add-space",
 	"add-ssh-key",
 	"add-storage",
 	"add-unit",
 	"add-user",
+	"admission-policies",
+	"approve-ssh-access",
 	"attach-resource",
 	"attach-storage",
 	"autoload-credentials",
+	"autoscale-application",
 	"bind",
 	"bootstrap",
 	"cancel-task",
 	"change-user-password",
 	"charm-resources",
+	"cidr-sets",
 	"clouds",
 	"config",
 	"constraints",
@@ -336,6 +343,7 @@ var commandNames = []string{
 	"controllers",
 	"create-backup",
 	"create-storage-pool",
+	"create-storage-snapshot",
 	"credentials",
 	"dashboard",
 	"debug-code",
@@ -366,8 +374,11 @@ var commandNames = []string{
 	"find",
 	"firewall-rules",
 	"grant-cloud",
+	"grant-group",
+	"grant-role",
 	"grant-secret",
 	"grant",
+	"groups",
 	"help",
 	"help-action-commands",
 	"help-hook-commands",
@@ -375,25 +386,33 @@ var commandNames = []string{
 	"import-ssh-key",
 	"info",
 	"integrate",
+	"issue-token",
 	"kill-controller",
 	"list-actions",
+	"list-admission-policies",
 	"list-charm-resources",
+	"list-cidr-sets",
 	"list-clouds",
 	"list-controllers",
 	"list-credentials",
 	"list-disabled-commands",
 	"list-firewall-rules",
+	"list-groups",
 	"list-machines",
 	"list-models",
 	"list-offers",
 	"list-operations",
 	"list-regions",
 	"list-resources",
+	"list-roles",
 	"list-secret-backends",
 	"list-secrets",
 	"list-spaces",
+	"list-ssh-access-grants",
+	"list-ssh-certificates",
 	"list-ssh-keys",
 	"list-storage-pools",
+	"list-storage-snapshots",
 	"list-storage",
 	"list-subnets",
 	"list-users",
@@ -411,41 +430,57 @@ var commandNames = []string{
 	"offer",
 	"offers",
 	"operations",
+	"proxy",
 	"refresh",
 	"regions",
 	"register",
+	"reject-ssh-access",
 	"relate", // alias for integrate
 	"reload-spaces",
+	"remove-admission-policy",
 	"remove-application",
+	"remove-cidr-set",
 	"remove-cloud",
 	"remove-credential",
+	"remove-firewall-rule",
 	"remove-k8s",
 	"remove-machine",
 	"remove-offer",
 	"remove-relation",
+	"remove-role",
 	"remove-saas",
 	"remove-secret-backend",
 	"remove-secret",
 	"remove-space",
 	"remove-ssh-key",
 	"remove-storage-pool",
+	"remove-storage-snapshot",
 	"remove-storage",
 	"remove-unit",
 	"remove-user",
 	"rename-space",
+	"request-ssh-access",
+	"resize-storage",
 	"resolve",
 	"resolved",
 	"resources",
 	"resume-relation",
 	"retry-provisioning",
 	"revoke-cloud",
+	"revoke-group",
+	"revoke-role",
 	"revoke-secret",
+	"revoke-ssh-access",
+	"revoke-ssh-certificate",
+	"revoke-token",
 	"revoke",
+	"roles",
 	"run",
 	"scale-application",
 	"scp",
 	"secret-backends",
 	"secrets",
+	"set-cidr-set",
 	"set-constraints",
 	"set-credential",
 	"set-default-credentials",
@@ -471,15 +506,21 @@ var commandNames = []string{
 	"show-unit",
 	"show-user",
 	"spaces",
+	"ssh-access-grants",
+	"ssh-certificate",
+	"ssh-certificates",
 	"ssh-keys",
+	"ssh-sessions",
 	"ssh",
 	"status",
 	"storage-pools",
+	"storage-snapshots",
 	"storage",
 	"subnets",
 	"suspend-relation",
 	"switch",
 	"sync-agent-binary",
+	"test-admission-policy",
 	"trust",
 	"unexpose",
 	"unregister",
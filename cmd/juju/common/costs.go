@@ -0,0 +1,160 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/pricing"
+	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/rpc/params"
+)
+
+// PriceCatalogueFile is the name of the price catalogue file in the Juju
+// data directory, which is read when no catalogue file is specified.
+const PriceCatalogueFile = "prices.yaml"
+
+// CostAPI provides the prices and instance types needed to estimate what
+// machines and volumes cost to run in a model.
+type CostAPI interface {
+	InstanceTypes(context.Context, []constraints.Value) ([]params.InstanceTypesResult, error)
+	PriceCatalogue(context.Context) (*pricing.Catalogue, error)
+}
+
+// LoadPriceCatalogue returns the prices read from the catalogue file at the
+// input path, merged with the prices published by the pricing API of the
+// model's provider. Prices from the file take precedence. If no path is
+// given, the catalogue file in the Juju data directory is read if it
+// exists.
+func LoadPriceCatalogue(ctx context.Context, api CostAPI, path string) (*pricing.Catalogue, error) {
+	if path == "" {
+		path = osenv.JujuXDGDataHomePath(PriceCatalogueFile)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			path = ""
+		}
+	}
+	var fileCatalogue *pricing.Catalogue
+	if path != "" {
+		var err error
+		if fileCatalogue, err = pricing.ReadFile(path); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	providerCatalogue, err := api.PriceCatalogue(ctx)
+	if err != nil && !errors.Is(err, errors.NotSupported) {
+		return nil, errors.Annotate(err, "getting provider prices")
+	}
+	return pricing.Merge(fileCatalogue, providerCatalogue)
+}
+
+// CheapestInstanceType returns the name of the cheapest of the instance
+// types in the result that has a price in the region. If hardware
+// characteristics are given, the instance types that match them exactly
+// are preferred. If none of the instance types has a price, the first is
+// returned so that it can be reported as unpriced.
+func CheapestInstanceType(
+	result params.InstanceTypesResult, catalogue *pricing.Catalogue, region string, hc *instance.HardwareCharacteristics,
+) string {
+	var arch string
+	if hc != nil && hc.Arch != nil {
+		arch = *hc.Arch
+	}
+	itypes := InstanceTypes(result, arch)
+	if hc != nil {
+		if matching := pricing.MatchingHardware(itypes, *hc); len(matching) > 0 {
+			itypes = matching
+		}
+	}
+	if itype, ok := catalogue.CheapestInstanceType(region, itypes); ok {
+		return itype.Name
+	}
+	if len(itypes) > 0 {
+		return itypes[0].Name
+	}
+	return ""
+}
+
+// InstanceTypes returns the instance types in the result. Each has the
+// input architecture if it supports it, or else the first architecture
+// that it supports.
+func InstanceTypes(result params.InstanceTypesResult, arch string) []instances.InstanceType {
+	itypes := make([]instances.InstanceType, len(result.InstanceTypes))
+	for i, t := range result.InstanceTypes {
+		itypes[i] = instances.InstanceType{
+			Name:     t.Name,
+			CpuCores: uint64(t.CPUCores),
+			Mem:      uint64(t.Memory),
+			RootDisk: uint64(t.RootDiskSize),
+			Cost:     uint64(t.Cost),
+		}
+		if len(t.Arches) > 0 {
+			itypes[i].Arch = t.Arches[0]
+		}
+		if arch != "" && slices.Contains(t.Arches, arch) {
+			itypes[i].Arch = arch
+		}
+	}
+	return itypes
+}
+
+// FormatEstimateTabular writes a tabular summary of a cost estimate.
+func FormatEstimateTabular(writer io.Writer, value interface{}) error {
+	estimate, ok := value.(pricing.Estimate)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", estimate, value)
+	}
+
+	tw := output.TabWriter(writer)
+	print := func(values ...string) {
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	price := func(v float64) string {
+		if v == 0 {
+			return "-"
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+
+	if len(estimate.Machines) > 0 {
+		print("Machine", "Instance type", "Count", "Hourly", "Monthly")
+		for _, m := range estimate.Machines {
+			print(m.Id, m.InstanceType, strconv.Itoa(m.Count), price(m.Hourly), price(m.Monthly))
+		}
+		print()
+	}
+	if len(estimate.Volumes) > 0 {
+		print("Volume", "Pool", "Size", "Count", "Monthly")
+		for _, v := range estimate.Volumes {
+			size := "-"
+			if v.Size > 0 {
+				size = humanize.IBytes(v.Size * humanize.MiByte)
+			}
+			print(v.Id, v.Pool, size, strconv.Itoa(v.Count), price(v.Monthly))
+		}
+		print()
+	}
+	if err := tw.Flush(); err != nil {
+		return errors.Trace(err)
+	}
+
+	fmt.Fprintf(writer, "Estimated monthly cost: %.2f %s\n", estimate.Total, estimate.Currency)
+	if len(estimate.Unpriced) > 0 {
+		fmt.Fprintf(writer, "No price found for: %s\n", strings.Join(estimate.Unpriced, ", "))
+	}
+	return nil
+}
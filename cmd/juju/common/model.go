@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/rpc/params"
 )
@@ -40,6 +41,7 @@ type ModelInfo struct {
 	SecretBackends map[string]SecretBackendInfo `json:"secret-backends,omitempty" yaml:"secret-backends,omitempty"`
 	AgentVersion   string                       `json:"agent-version,omitempty" yaml:"agent-version,omitempty"`
 	Credential     *ModelCredential             `json:"credential,omitempty" yaml:"credential,omitempty"`
+	Costs          *pricing.Estimate            `json:"costs,omitempty" yaml:"costs,omitempty"`
 
 	SupportedFeatures []SupportedFeature `json:"supported-features,omitempty" yaml:"supported-features,omitempty"`
 }
@@ -0,0 +1,269 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"context"
+	"io"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/firewallrules"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// CIDRSetAPI defines the API methods that the CIDR set commands use.
+type CIDRSetAPI interface {
+	Close() error
+	SetCIDRSet(ctx context.Context, name string, cidrs []string) error
+	RemoveCIDRSet(ctx context.Context, name string) error
+	CIDRSets(ctx context.Context) ([]firewallrules.CIDRSet, error)
+}
+
+// cidrSetCommandBase holds the code shared by the CIDR set commands.
+type cidrSetCommandBase struct {
+	modelcmd.ModelCommandBase
+	modelcmd.IAASOnlyCommand
+
+	newAPIFunc func(ctx context.Context) (CIDRSetAPI, error)
+}
+
+func (c *cidrSetCommandBase) getAPI(ctx context.Context) (CIDRSetAPI, error) {
+	if c.newAPIFunc != nil {
+		return c.newAPIFunc(ctx)
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return firewallrules.NewClient(root), nil
+}
+
+const setCIDRSetDoc = `
+Creates a named set of CIDRs, or replaces the CIDRs of an existing set.
+
+CIDR sets are reusable allow-lists which firewall rules may refer to by name
+instead of listing CIDRs. When the CIDRs of a set change, the firewall rules
+which refer to it are updated on every machine of the model.
+`
+
+const setCIDRSetExamples = `
+    juju set-cidr-set office 10.0.0.0/8 192.168.0.0/16
+    juju set-firewall-rule postgresql:db --allowlist office
+`
+
+// NewSetCIDRSetCommand returns a command to set a CIDR set.
+func NewSetCIDRSetCommand() cmd.Command {
+	return modelcmd.Wrap(&setCIDRSetCommand{})
+}
+
+// setCIDRSetCommand creates or replaces a CIDR set.
+type setCIDRSetCommand struct {
+	cidrSetCommandBase
+	name  string
+	cidrs []string
+}
+
+// Info implements cmd.Command.
+func (c *setCIDRSetCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "set-cidr-set",
+		Args:     "<name> <cidr> [<cidr>...]",
+		Purpose:  "Sets a named set of CIDRs for use in firewall rules.",
+		Doc:      setCIDRSetDoc,
+		Examples: setCIDRSetExamples,
+		SeeAlso: []string{
+			"cidr-sets",
+			"remove-cidr-set",
+			"set-firewall-rule",
+		},
+	})
+}
+
+// Init implements cmd.Command.
+func (c *setCIDRSetCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no CIDR set name specified")
+	}
+	if len(args) == 1 {
+		return errors.New("no CIDRs specified")
+	}
+	c.name = args[0]
+	for _, cidr := range args[1:] {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.NotValidf("CIDR %q", cidr)
+		}
+	}
+	c.cidrs = args[1:]
+	return nil
+}
+
+// Run implements cmd.Command.
+func (c *setCIDRSetCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	err = client.SetCIDRSet(ctx, c.name, c.cidrs)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
+const removeCIDRSetDoc = `
+Removes a named set of CIDRs. A CIDR set cannot be removed while firewall
+rules refer to it.
+`
+
+const removeCIDRSetExamples = `
+    juju remove-cidr-set office
+`
+
+// NewRemoveCIDRSetCommand returns a command to remove a CIDR set.
+func NewRemoveCIDRSetCommand() cmd.Command {
+	return modelcmd.Wrap(&removeCIDRSetCommand{})
+}
+
+// removeCIDRSetCommand removes a CIDR set.
+type removeCIDRSetCommand struct {
+	cidrSetCommandBase
+	name string
+}
+
+// Info implements cmd.Command.
+func (c *removeCIDRSetCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-cidr-set",
+		Args:     "<name>",
+		Purpose:  "Removes a named set of CIDRs.",
+		Doc:      removeCIDRSetDoc,
+		Examples: removeCIDRSetExamples,
+		SeeAlso: []string{
+			"cidr-sets",
+			"set-cidr-set",
+		},
+	})
+}
+
+// Init implements cmd.Command.
+func (c *removeCIDRSetCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no CIDR set name specified")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *removeCIDRSetCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	err = client.RemoveCIDRSet(ctx, c.name)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
+const listCIDRSetsDoc = `
+Lists the named sets of CIDRs of the model, which firewall rules may refer to.
+`
+
+const listCIDRSetsExamples = `
+    juju cidr-sets
+    juju cidr-sets --format yaml
+`
+
+// NewListCIDRSetsCommand returns a command to list CIDR sets.
+func NewListCIDRSetsCommand() cmd.Command {
+	return modelcmd.Wrap(&listCIDRSetsCommand{})
+}
+
+// listCIDRSetsCommand lists the CIDR sets of a model.
+type listCIDRSetsCommand struct {
+	cidrSetCommandBase
+	out cmd.Output
+}
+
+// Info implements cmd.Command.
+func (c *listCIDRSetsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "cidr-sets",
+		Purpose:  "Lists the named sets of CIDRs.",
+		Doc:      listCIDRSetsDoc,
+		Aliases:  []string{"list-cidr-sets"},
+		Examples: listCIDRSetsExamples,
+		SeeAlso: []string{
+			"set-cidr-set",
+			"remove-cidr-set",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *listCIDRSetsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatCIDRSetsTabular,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *listCIDRSetsCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.
+func (c *listCIDRSetsCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	sets, err := client.CIDRSets(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(sets) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No CIDR sets to display.")
+		return nil
+	}
+	result := make(map[string][]string, len(sets))
+	for _, set := range sets {
+		result[set.Name] = set.CIDRs
+	}
+	return c.out.Write(ctx, result)
+}
+
+func formatCIDRSetsTabular(writer io.Writer, value interface{}) error {
+	sets, ok := value.(map[string][]string)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", sets, value)
+	}
+	names := make([]string, 0, len(sets))
+	for name := range sets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Name", "CIDRs")
+	for _, name := range names {
+		w.Println(name, strings.Join(sets[name], ","))
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/client/firewallrules"
+	"github.com/juju/juju/cmd/juju/firewall"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type CIDRSetSuite struct {
+	testing.BaseSuite
+
+	mockAPI *mockCIDRSetAPI
+}
+
+var _ = gc.Suite(&CIDRSetSuite{})
+
+func (s *CIDRSetSuite) SetUpTest(c *gc.C) {
+	s.mockAPI = &mockCIDRSetAPI{sets: make(map[string][]string)}
+}
+
+func (s *CIDRSetSuite) TestSetCIDRSet(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, firewall.NewSetCIDRSetCommandForTest(s.mockAPI),
+		"office", "10.0.0.0/8", "192.168.0.0/16")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.mockAPI.sets, jc.DeepEquals, map[string][]string{
+		"office": {"10.0.0.0/8", "192.168.0.0/16"},
+	})
+}
+
+func (s *CIDRSetSuite) TestSetCIDRSetMissingCIDRs(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, firewall.NewSetCIDRSetCommandForTest(s.mockAPI), "office")
+	c.Assert(err, gc.ErrorMatches, "no CIDRs specified")
+}
+
+func (s *CIDRSetSuite) TestSetCIDRSetInvalidCIDR(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, firewall.NewSetCIDRSetCommandForTest(s.mockAPI), "office", "10.0.0.0")
+	c.Assert(err, gc.ErrorMatches, `CIDR "10.0.0.0" not valid`)
+}
+
+func (s *CIDRSetSuite) TestRemoveCIDRSet(c *gc.C) {
+	s.mockAPI.sets["office"] = []string{"10.0.0.0/8"}
+	_, err := cmdtesting.RunCommand(c, firewall.NewRemoveCIDRSetCommandForTest(s.mockAPI), "office")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.mockAPI.sets, gc.HasLen, 0)
+}
+
+func (s *CIDRSetSuite) TestRemoveCIDRSetError(c *gc.C) {
+	s.mockAPI.err = errors.NotFoundf(`cidr set "office"`)
+	_, err := cmdtesting.RunCommand(c, firewall.NewRemoveCIDRSetCommandForTest(s.mockAPI), "office")
+	c.Assert(err, gc.ErrorMatches, `cidr set "office" not found`)
+}
+
+func (s *CIDRSetSuite) TestListCIDRSetsTabular(c *gc.C) {
+	s.mockAPI.sets["vpn"] = []string{"172.16.0.0/12"}
+	s.mockAPI.sets["office"] = []string{"10.0.0.0/8", "192.168.0.0/16"}
+	ctx, err := cmdtesting.RunCommand(c, firewall.NewListCIDRSetsCommandForTest(s.mockAPI))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Name    CIDRs
+office  10.0.0.0/8,192.168.0.0/16
+vpn     172.16.0.0/12
+`[1:])
+}
+
+func (s *CIDRSetSuite) TestListCIDRSetsYAML(c *gc.C) {
+	s.mockAPI.sets["office"] = []string{"10.0.0.0/8"}
+	ctx, err := cmdtesting.RunCommand(c, firewall.NewListCIDRSetsCommandForTest(s.mockAPI), "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+office:
+- 10.0.0.0/8
+`[1:])
+}
+
+func (s *CIDRSetSuite) TestListCIDRSetsEmpty(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, firewall.NewListCIDRSetsCommandForTest(s.mockAPI))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No CIDR sets to display.\n")
+}
+
+type mockCIDRSetAPI struct {
+	sets map[string][]string
+	err  error
+}
+
+func (s *mockCIDRSetAPI) Close() error {
+	return nil
+}
+
+func (s *mockCIDRSetAPI) SetCIDRSet(ctx context.Context, name string, cidrs []string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sets[name] = cidrs
+	return nil
+}
+
+func (s *mockCIDRSetAPI) RemoveCIDRSet(ctx context.Context, name string) error {
+	if s.err != nil {
+		return s.err
+	}
+	delete(s.sets, name)
+	return nil
+}
+
+func (s *mockCIDRSetAPI) CIDRSets(ctx context.Context) ([]firewallrules.CIDRSet, error) {
+	var sets []firewallrules.CIDRSet
+	for name, cidrs := range s.sets {
+		sets = append(sets, firewallrules.CIDRSet{Name: name, CIDRs: cidrs})
+	}
+	return sets, s.err
+}
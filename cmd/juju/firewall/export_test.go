@@ -13,11 +13,15 @@ import (
 
 func NewListRulesCommandForTest(
 	api ListFirewallRulesAPI,
+	rulesAPI ListApplicationFirewallRulesAPI,
 ) cmd.Command {
 	aCmd := &listFirewallRulesCommand{
 		newAPIFunc: func(ctx context.Context) (ListFirewallRulesAPI, error) {
 			return api, nil
 		},
+		newRulesAPIFunc: func(ctx context.Context) (ListApplicationFirewallRulesAPI, error) {
+			return rulesAPI, nil
+		},
 	}
 	aCmd.SetClientStore(jujuclienttesting.MinimalStore())
 	return modelcmd.Wrap(aCmd)
@@ -25,12 +29,50 @@ func NewListRulesCommandForTest(
 
 func NewSetRulesCommandForTest(
 	api SetFirewallRuleAPI,
+	ruleAPI SetApplicationFirewallRuleAPI,
 ) cmd.Command {
 	aCmd := &setFirewallRuleCommand{
 		newAPIFunc: func(ctx context.Context) (SetFirewallRuleAPI, error) {
 			return api, nil
 		},
+		newRuleAPIFunc: func(ctx context.Context) (SetApplicationFirewallRuleAPI, error) {
+			return ruleAPI, nil
+		},
 	}
 	aCmd.SetClientStore(jujuclienttesting.MinimalStore())
 	return modelcmd.Wrap(aCmd)
 }
+
+func NewRemoveRuleCommandForTest(
+	api RemoveFirewallRuleAPI,
+) cmd.Command {
+	aCmd := &removeFirewallRuleCommand{
+		newAPIFunc: func(ctx context.Context) (RemoveFirewallRuleAPI, error) {
+			return api, nil
+		},
+	}
+	aCmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return modelcmd.Wrap(aCmd)
+}
+
+func newCIDRSetCommandBaseForTest(api CIDRSetAPI) cidrSetCommandBase {
+	base := cidrSetCommandBase{
+		newAPIFunc: func(ctx context.Context) (CIDRSetAPI, error) {
+			return api, nil
+		},
+	}
+	base.SetClientStore(jujuclienttesting.MinimalStore())
+	return base
+}
+
+func NewSetCIDRSetCommandForTest(api CIDRSetAPI) cmd.Command {
+	return modelcmd.Wrap(&setCIDRSetCommand{cidrSetCommandBase: newCIDRSetCommandBaseForTest(api)})
+}
+
+func NewRemoveCIDRSetCommandForTest(api CIDRSetAPI) cmd.Command {
+	return modelcmd.Wrap(&removeCIDRSetCommand{cidrSetCommandBase: newCIDRSetCommandBaseForTest(api)})
+}
+
+func NewListCIDRSetsCommandForTest(api CIDRSetAPI) cmd.Command {
+	return modelcmd.Wrap(&listCIDRSetsCommand{cidrSetCommandBase: newCIDRSetCommandBaseForTest(api)})
+}
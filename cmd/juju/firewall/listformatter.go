@@ -10,13 +10,29 @@ import (
 
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/core/output"
 )
 
 type firewallRule struct {
-	KnownService   firewall.WellKnownServiceType `yaml:"known-service" json:"known-service"`
-	WhitelistCIDRS []string                      `yaml:"allowlist-subnets,omitempty" json:"allowlist-subnets,omitempty"`
+	KnownService firewall.WellKnownServiceType `yaml:"known-service,omitempty" json:"known-service,omitempty"`
+	// Application, Endpoint, Ports and Allowlist are set instead of
+	// KnownService for application firewall rules.
+	Application    string   `yaml:"application,omitempty" json:"application,omitempty"`
+	Endpoint       string   `yaml:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Ports          string   `yaml:"ports,omitempty" json:"ports,omitempty"`
+	Allowlist      []string `yaml:"allowlist,omitempty" json:"allowlist,omitempty"`
+	WhitelistCIDRS []string `yaml:"allowlist-subnets,omitempty" json:"allowlist-subnets,omitempty"`
+}
+
+// machineFirewallRule is an effective ingress rule of a machine.
+type machineFirewallRule struct {
+	Machine        string   `yaml:"machine" json:"machine"`
+	Application    string   `yaml:"application" json:"application"`
+	Source         string   `yaml:"source" json:"source"`
+	Ports          string   `yaml:"ports" json:"ports"`
+	WhitelistCIDRS []string `yaml:"allowlist-subnets" json:"allowlist-subnets"`
 }
 
 type firewallRules []firewallRule
@@ -28,24 +44,74 @@ func (o firewallRules) Less(i, j int) bool {
 }
 
 func formatListTabular(writer io.Writer, value interface{}) error {
-	rules, ok := value.([]firewallRule)
-	if !ok {
-		return errors.Errorf("expected value of type %T, got %T", rules, value)
+	switch rules := value.(type) {
+	case []firewallRule:
+		formatFirewallRulesTabular(writer, firewallRules(rules))
+	case []machineFirewallRule:
+		formatMachineFirewallRulesTabular(writer, rules)
+	default:
+		return errors.Errorf("expected value of type %T, got %T", []firewallRule(nil), value)
 	}
-	formatFirewallRulesTabular(writer, firewallRules(rules))
 	return nil
 }
 
 // formatFirewallRulesTabular returns a tabular summary of firewall rules.
+// The rules of applications follow those of well known services.
 func formatFirewallRulesTabular(writer io.Writer, rules firewallRules) {
 	tw := output.TabWriter(writer)
 	w := output.Wrapper{tw}
 
-	sort.Sort(rules)
+	var serviceRules, appRules firewallRules
+	for _, rule := range rules {
+		if rule.KnownService != "" {
+			serviceRules = append(serviceRules, rule)
+		} else {
+			appRules = append(appRules, rule)
+		}
+	}
+	sort.Sort(serviceRules)
 
 	w.Println("Service", "Allowlist subnets")
-	for _, rule := range rules {
+	for _, rule := range serviceRules {
 		w.Println(rule.KnownService, strings.Join(rule.WhitelistCIDRS, ","))
 	}
+	if len(appRules) != 0 {
+		w.Println()
+		w.Println("Application", "Endpoint", "Ports", "Allowlist", "Allowlist subnets")
+		for _, rule := range appRules {
+			endpoint := rule.Endpoint
+			if endpoint == "" {
+				endpoint = "*"
+			}
+			ports := rule.Ports
+			if ports == "" {
+				ports = "opened"
+			}
+			w.Println(rule.Application, endpoint, ports,
+				strings.Join(rule.Allowlist, ","), strings.Join(rule.WhitelistCIDRS, ","))
+		}
+	}
 	tw.Flush()
 }
+
+// formatMachineFirewallRulesTabular returns a tabular summary of the
+// effective ingress rules of machines.
+func formatMachineFirewallRulesTabular(writer io.Writer, rules []machineFirewallRule) {
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+
+	w.Println("Machine", "Application", "Source", "Ports", "Allowlist subnets")
+	for _, rule := range rules {
+		w.Println(rule.Machine, rule.Application, rule.Source, rule.Ports, strings.Join(rule.WhitelistCIDRS, ","))
+	}
+	tw.Flush()
+}
+
+// formatPortRange returns the port range of an application firewall rule,
+// or an empty string if the rule applies to the opened ports.
+func formatPortRange(portRange *network.PortRange) string {
+	if portRange == nil {
+		return ""
+	}
+	return portRange.String()
+}
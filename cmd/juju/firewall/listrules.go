@@ -9,7 +9,9 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/api/client/firewallrules"
 	"github.com/juju/juju/api/client/modelconfig"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
@@ -23,7 +25,16 @@ Prints the firewall rules.`[1:]
 
 var listRulesHelpDetails = `
 Lists the firewall rules which control ingress to well known services
-within a Juju model.
+and to applications within a Juju model.
+
+Application rules without an endpoint apply to all the endpoints of the
+application, and rules without a port range apply to the ports opened by
+its units.
+
+With --machines, the effective ingress rules of machines are listed
+instead, along with the application each rule is for and whether it
+results from exposing the application or from a firewall rule. All
+machines are listed unless machines are given.
 
 DEPRECATION WARNING: %v
 
@@ -31,6 +42,8 @@ DEPRECATION WARNING: %v
 
 const listRulesHelpExamples = `
     juju firewall-rules
+    juju firewall-rules --machines
+    juju firewall-rules --machines 0 1
 
 `
 
@@ -45,27 +58,40 @@ func NewListFirewallRulesCommand() cmd.Command {
 		return modelconfig.NewClient(root), nil
 
 	}
+	cmd.newRulesAPIFunc = func(ctx context.Context) (ListApplicationFirewallRulesAPI, error) {
+		root, err := cmd.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return firewallrules.NewClient(root), nil
+	}
 	return modelcmd.Wrap(cmd)
 }
 
 type listFirewallRulesCommand struct {
 	modelcmd.ModelCommandBase
 	modelcmd.IAASOnlyCommand
-	out cmd.Output
+	out        cmd.Output
+	machines   bool
+	machineIDs []string
 
-	newAPIFunc func(ctx context.Context) (ListFirewallRulesAPI, error)
+	newAPIFunc      func(ctx context.Context) (ListFirewallRulesAPI, error)
+	newRulesAPIFunc func(ctx context.Context) (ListApplicationFirewallRulesAPI, error)
 }
 
 // Info implements cmd.Command.
 func (c *listFirewallRulesCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "firewall-rules",
+		Args:     "[--machines [<machine>...]]",
 		Purpose:  listRulesHelpSummary,
 		Doc:      fmt.Sprintf(listRulesHelpDetails, deprecationWarning),
 		Aliases:  []string{"list-firewall-rules"},
 		Examples: listRulesHelpExamples,
 		SeeAlso: []string{
 			"set-firewall-rule",
+			"remove-firewall-rule",
+			"cidr-sets",
 		},
 	})
 }
@@ -77,11 +103,21 @@ func (c *listFirewallRulesCommand) SetFlags(f *gnuflag.FlagSet) {
 		"json":    cmd.FormatJson,
 		"tabular": formatListTabular,
 	})
+	f.BoolVar(&c.machines, "machines", false, "List the effective ingress rules of machines")
 }
 
 // Init implements cmd.Command.
 func (c *listFirewallRulesCommand) Init(args []string) (err error) {
-	return cmd.CheckEmpty(args)
+	if !c.machines {
+		return cmd.CheckEmpty(args)
+	}
+	for _, id := range args {
+		if !names.IsValidMachine(id) {
+			return errors.NotValidf("machine %q", id)
+		}
+	}
+	c.machineIDs = args
+	return nil
 }
 
 // ListFirewallRulesAPI defines the API methods that the list firewall rules command uses.
@@ -90,8 +126,20 @@ type ListFirewallRulesAPI interface {
 	ModelGet(ctx context.Context) (map[string]interface{}, error)
 }
 
+// ListApplicationFirewallRulesAPI defines the API methods that the list
+// firewall rules command uses for application firewall rules.
+type ListApplicationFirewallRulesAPI interface {
+	Close() error
+	BestAPIVersion() int
+	FirewallRules(ctx context.Context) ([]firewallrules.Rule, error)
+	MachineFirewallRules(ctx context.Context, machines ...string) ([]firewallrules.MachineRules, error)
+}
+
 // Run implements cmd.Command.
 func (c *listFirewallRulesCommand) Run(ctx *cmd.Context) error {
+	if c.machines {
+		return c.listMachineRules(ctx)
+	}
 	ctx.Warningf(deprecationWarning + "\n")
 
 	client, err := c.newAPIFunc(ctx)
@@ -115,5 +163,61 @@ func (c *listFirewallRulesCommand) Run(ctx *cmd.Context) error {
 		KnownService:   firewall.JujuApplicationOfferRule,
 		WhitelistCIDRS: cfg.SAASIngressAllow(),
 	}}
+
+	// Controllers without the FirewallRules facade have no application
+	// firewall rules.
+	rulesClient, err := c.newRulesAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer rulesClient.Close()
+	if rulesClient.BestAPIVersion() < 1 {
+		return c.out.Write(ctx, rules)
+	}
+	appRules, err := rulesClient.FirewallRules(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rule := range appRules {
+		rules = append(rules, firewallRule{
+			Application:    rule.Application,
+			Endpoint:       rule.Endpoint,
+			Ports:          formatPortRange(rule.PortRange),
+			Allowlist:      append(append([]string(nil), rule.CIDRSets...), rule.CIDRs...),
+			WhitelistCIDRS: rule.SourceCIDRs,
+		})
+	}
+	return c.out.Write(ctx, rules)
+}
+
+func (c *listFirewallRulesCommand) listMachineRules(ctx *cmd.Context) error {
+	client, err := c.newRulesAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if client.BestAPIVersion() < 1 {
+		return errors.NotSupportedf("listing machine firewall rules on this controller")
+	}
+
+	results, err := client.MachineFirewallRules(ctx, c.machineIDs...)
+	if err != nil {
+		return err
+	}
+	rules := []machineFirewallRule{}
+	for _, result := range results {
+		if result.Error != nil {
+			return errors.Annotatef(result.Error, "machine %s", result.Machine)
+		}
+		for _, rule := range result.Rules {
+			rules = append(rules, machineFirewallRule{
+				Machine:        result.Machine,
+				Application:    rule.Application,
+				Source:         rule.Source,
+				Ports:          rule.PortRange.String(),
+				WhitelistCIDRS: rule.SourceCIDRs,
+			})
+		}
+	}
 	return c.out.Write(ctx, rules)
 }
@@ -11,7 +11,9 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/api/client/firewallrules"
 	"github.com/juju/juju/cmd/juju/firewall"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/cmd/cmdtesting"
@@ -21,7 +23,8 @@ import (
 type ListSuite struct {
 	testing.BaseSuite
 
-	mockAPI *mockListAPI
+	mockAPI      *mockListAPI
+	mockRulesAPI *mockListRulesAPI
 }
 
 var _ = gc.Suite(&ListSuite{})
@@ -30,6 +33,7 @@ func (s *ListSuite) SetUpTest(c *gc.C) {
 	s.mockAPI = &mockListAPI{
 		rules: "192.168.1.0/16,10.0.0.0/8",
 	}
+	s.mockRulesAPI = &mockListRulesAPI{version: 1}
 }
 
 func (s *ListSuite) TestListError(c *gc.C) {
@@ -83,8 +87,140 @@ ssh
 
 }
 
+func (s *ListSuite) TestListApplicationRulesTabular(c *gc.C) {
+	portRange := network.MustParsePortRange("5432/tcp")
+	s.mockRulesAPI.rules = []firewallrules.Rule{{
+		Application: "postgresql",
+		Endpoint:    "db",
+		PortRange:   &portRange,
+		CIDRs:       []string{"172.16.0.0/12"},
+		CIDRSets:    []string{"office"},
+		SourceCIDRs: []string{"10.0.0.0/8", "172.16.0.0/12"},
+	}, {
+		Application: "wordpress",
+		CIDRs:       []string{"203.0.113.0/24"},
+		SourceCIDRs: []string{"203.0.113.0/24"},
+	}}
+	s.assertValidList(
+		c,
+		[]string{"--format", "tabular"},
+		`
+Service                 Allowlist subnets
+juju-application-offer  0.0.0.0/0
+ssh                     192.168.1.0/16,10.0.0.0/8
+
+Application  Endpoint  Ports     Allowlist             Allowlist subnets
+postgresql   db        5432/tcp  office,172.16.0.0/12  10.0.0.0/8,172.16.0.0/12
+wordpress    \*         opened    203.0.113.0/24        203.0.113.0/24
+`[1:],
+		"",
+	)
+}
+
+func (s *ListSuite) TestListApplicationRulesYAML(c *gc.C) {
+	s.mockRulesAPI.rules = []firewallrules.Rule{{
+		Application: "wordpress",
+		Endpoint:    "url",
+		CIDRSets:    []string{"office"},
+		SourceCIDRs: []string{"10.0.0.0/8"},
+	}}
+	s.assertValidList(
+		c,
+		[]string{"--format", "yaml"},
+		`
+- known-service: ssh
+  allowlist-subnets:
+  - 192.168.1.0/16
+  - 10.0.0.0/8
+- known-service: juju-application-offer
+  allowlist-subnets:
+  - 0.0.0.0/0
+- application: wordpress
+  endpoint: url
+  allowlist:
+  - office
+  allowlist-subnets:
+  - 10.0.0.0/8
+`[1:],
+		"",
+	)
+}
+
+func (s *ListSuite) TestListOldController(c *gc.C) {
+	// Controllers without application firewall rules only list the
+	// rules of well known services.
+	s.mockRulesAPI.version = 0
+	s.mockRulesAPI.err = errors.New("should not be called")
+	s.assertValidList(
+		c,
+		[]string{"--format", "tabular"},
+		`
+Service                 Allowlist subnets
+juju-application-offer  0.0.0.0/0
+ssh                     192.168.1.0/16,10.0.0.0/8
+`[1:],
+		"",
+	)
+}
+
+func (s *ListSuite) TestListMachines(c *gc.C) {
+	s.mockAPI.err = errors.New("should not be called")
+	s.mockRulesAPI.machineRules = []firewallrules.MachineRules{{
+		Machine: "0",
+		Rules: []firewallrules.MachineIngressRule{{
+			Application: "wordpress",
+			Source:      "expose",
+			PortRange:   network.MustParsePortRange("80/tcp"),
+			SourceCIDRs: []string{"0.0.0.0/0"},
+		}, {
+			Application: "wordpress",
+			Source:      "firewall-rule",
+			PortRange:   network.MustParsePortRange("8080/tcp"),
+			SourceCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		}},
+	}, {
+		Machine: "1",
+	}}
+	s.assertValidList(
+		c,
+		[]string{"--machines", "0", "1"},
+		`
+Machine  Application  Source         Ports     Allowlist subnets
+0        wordpress    expose         80/tcp    0.0.0.0/0
+0        wordpress    firewall-rule  8080/tcp  10.0.0.0/8,192.168.0.0/16
+`[1:],
+		"",
+	)
+	c.Check(s.mockRulesAPI.machines, jc.DeepEquals, []string{"0", "1"})
+}
+
+func (s *ListSuite) TestListMachinesError(c *gc.C) {
+	s.mockRulesAPI.machineRules = []firewallrules.MachineRules{{
+		Machine: "2",
+		Error:   errors.NotFoundf("machine 2"),
+	}}
+	_, err := s.runList(c, []string{"--machines", "2"})
+	c.Assert(err, gc.ErrorMatches, "machine 2: machine 2 not found")
+}
+
+func (s *ListSuite) TestListMachinesOldController(c *gc.C) {
+	s.mockRulesAPI.version = 0
+	_, err := s.runList(c, []string{"--machines"})
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *ListSuite) TestListMachinesInvalidMachine(c *gc.C) {
+	_, err := s.runList(c, []string{"--machines", "wordpress/0"})
+	c.Assert(err, gc.ErrorMatches, `machine "wordpress/0" not valid`)
+}
+
+func (s *ListSuite) TestListArgsWithoutMachines(c *gc.C) {
+	_, err := s.runList(c, []string{"0"})
+	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["0"\]`)
+}
+
 func (s *ListSuite) runList(c *gc.C, args []string) (*cmd.Context, error) {
-	return cmdtesting.RunCommand(c, firewall.NewListRulesCommandForTest(s.mockAPI), args...)
+	return cmdtesting.RunCommand(c, firewall.NewListRulesCommandForTest(s.mockAPI, s.mockRulesAPI), args...)
 }
 
 func (s *ListSuite) assertValidList(c *gc.C, args []string, expectedValid, expectedErr string) {
@@ -116,3 +252,28 @@ func (s *mockListAPI) ModelGet(ctx context.Context) (map[string]interface{}, err
 		config.SAASIngressAllowKey: "0.0.0.0/0",
 	}), nil
 }
+
+type mockListRulesAPI struct {
+	version      int
+	rules        []firewallrules.Rule
+	machineRules []firewallrules.MachineRules
+	machines     []string
+	err          error
+}
+
+func (s *mockListRulesAPI) Close() error {
+	return nil
+}
+
+func (s *mockListRulesAPI) BestAPIVersion() int {
+	return s.version
+}
+
+func (s *mockListRulesAPI) FirewallRules(ctx context.Context) ([]firewallrules.Rule, error) {
+	return s.rules, s.err
+}
+
+func (s *mockListRulesAPI) MachineFirewallRules(ctx context.Context, machines ...string) ([]firewallrules.MachineRules, error) {
+	s.machines = machines
+	return s.machineRules, s.err
+}
@@ -0,0 +1,106 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/firewallrules"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/cmd"
+)
+
+const removeRuleHelpDetails = `
+Removes the firewall rule of an application for an endpoint, or for all its
+endpoints if none is given. A rule which was set with --ports is removed by
+giving the same port range.
+`
+
+const removeRuleHelpExamples = `
+    juju remove-firewall-rule wordpress
+    juju remove-firewall-rule postgresql:db --ports 5432/tcp
+`
+
+// NewRemoveFirewallRuleCommand returns a command to remove application
+// firewall rules.
+func NewRemoveFirewallRuleCommand() cmd.Command {
+	cmd := &removeFirewallRuleCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (RemoveFirewallRuleAPI, error) {
+		root, err := cmd.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return firewallrules.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+type removeFirewallRuleCommand struct {
+	modelcmd.ModelCommandBase
+	modelcmd.IAASOnlyCommand
+	ports       string
+	application string
+	endpoint    string
+	portRange   *network.PortRange
+
+	newAPIFunc func(ctx context.Context) (RemoveFirewallRuleAPI, error)
+}
+
+// Info implements cmd.Command.
+func (c *removeFirewallRuleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-firewall-rule",
+		Args:     "<application>[:<endpoint>]",
+		Purpose:  "Removes a firewall rule of an application.",
+		Doc:      removeRuleHelpDetails,
+		Examples: removeRuleHelpExamples,
+		SeeAlso: []string{
+			"firewall-rules",
+			"set-firewall-rule",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *removeFirewallRuleCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.ports, "ports", "", "port range of the rule")
+}
+
+// Init implements cmd.Command.
+func (c *removeFirewallRuleCommand) Init(args []string) (err error) {
+	if len(args) == 0 {
+		return errors.New("no application specified")
+	}
+	c.application, c.endpoint, c.portRange, err = parseRuleTarget(args[0], c.ports)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// RemoveFirewallRuleAPI defines the API methods that the remove firewall rule
+// command uses.
+type RemoveFirewallRuleAPI interface {
+	Close() error
+	RemoveFirewallRule(ctx context.Context, application, endpoint string, portRange *network.PortRange) error
+}
+
+// Run implements cmd.Command.
+func (c *removeFirewallRuleCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	err = client.RemoveFirewallRule(ctx, c.application, c.endpoint, c.portRange)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewall_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/firewall"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type RemoveRuleSuite struct {
+	testing.BaseSuite
+
+	mockAPI *mockRemoveRuleAPI
+}
+
+var _ = gc.Suite(&RemoveRuleSuite{})
+
+func (s *RemoveRuleSuite) SetUpTest(c *gc.C) {
+	s.mockAPI = &mockRemoveRuleAPI{}
+}
+
+func (s *RemoveRuleSuite) TestInitMissingApplication(c *gc.C) {
+	_, err := s.runRemoveRule(c)
+	c.Assert(err, gc.ErrorMatches, "no application specified")
+}
+
+func (s *RemoveRuleSuite) TestInitInvalidApplication(c *gc.C) {
+	_, err := s.runRemoveRule(c, "Wordpress!")
+	c.Assert(err, gc.ErrorMatches, `application name "Wordpress!" not valid`)
+}
+
+func (s *RemoveRuleSuite) TestRemoveRule(c *gc.C) {
+	_, err := s.runRemoveRule(c, "wordpress")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.mockAPI.application, gc.Equals, "wordpress")
+	c.Check(s.mockAPI.endpoint, gc.Equals, "")
+	c.Check(s.mockAPI.portRange, gc.IsNil)
+}
+
+func (s *RemoveRuleSuite) TestRemoveEndpointRuleWithPorts(c *gc.C) {
+	_, err := s.runRemoveRule(c, "postgresql:db", "--ports", "5432/tcp")
+	c.Assert(err, jc.ErrorIsNil)
+	portRange := network.MustParsePortRange("5432/tcp")
+	c.Check(s.mockAPI.application, gc.Equals, "postgresql")
+	c.Check(s.mockAPI.endpoint, gc.Equals, "db")
+	c.Check(s.mockAPI.portRange, jc.DeepEquals, &portRange)
+}
+
+func (s *RemoveRuleSuite) TestRemoveRuleError(c *gc.C) {
+	s.mockAPI.err = errors.NotFoundf("firewall rule")
+	_, err := s.runRemoveRule(c, "wordpress")
+	c.Assert(err, gc.ErrorMatches, "firewall rule not found")
+}
+
+func (s *RemoveRuleSuite) runRemoveRule(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, firewall.NewRemoveRuleCommandForTest(s.mockAPI), args...)
+}
+
+type mockRemoveRuleAPI struct {
+	application string
+	endpoint    string
+	portRange   *network.PortRange
+	err         error
+}
+
+func (s *mockRemoveRuleAPI) Close() error {
+	return nil
+}
+
+func (s *mockRemoveRuleAPI) RemoveFirewallRule(ctx context.Context, application, endpoint string, portRange *network.PortRange) error {
+	s.application = application
+	s.endpoint = endpoint
+	s.portRange = portRange
+	return s.err
+}
@@ -11,11 +11,14 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/api/client/firewallrules"
 	"github.com/juju/juju/api/client/modelconfig"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/network/firewall"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/cmd"
@@ -25,9 +28,20 @@ var setRuleHelpSummary = `
 Sets a firewall rule.`[1:]
 
 var setRuleHelpDetails = `
-Firewall rules control ingress to a well known services
-within a Juju model. A rule consists of the service name
-and a allowlist of allowed ingress subnets.
+Firewall rules control ingress to well known services and to
+applications within a Juju model.
+
+A rule for an application allows ingress to the ports of one
+of its endpoints, or of all its endpoints if none is given,
+from an allowlist of CIDRs and named CIDR sets. The rule
+applies to the ports opened by the application's units, or
+to the port range given with --ports, whether or not the
+application is exposed. Setting a rule for the same endpoint
+and port range replaces it. Application rules are not
+supported on Kubernetes models.
+
+A rule for a well known service consists of the service
+name and an allowlist of allowed ingress subnets.
 The currently supported services are:
 - ssh
 - juju-application-offer
@@ -37,6 +51,8 @@ DEPRECATION WARNING: %v
 
 const setRuleHelpExamples = `
     juju set-firewall-rule ssh --allowlist 192.168.1.0/16
+    juju set-firewall-rule wordpress --allowlist 10.0.0.0/8,office
+    juju set-firewall-rule postgresql:db --ports 5432/tcp --allowlist office
 `
 
 // NewSetFirewallRuleCommand returns a command to set firewall rules.
@@ -50,6 +66,13 @@ func NewSetFirewallRuleCommand() cmd.Command {
 		return modelconfig.NewClient(root), nil
 
 	}
+	cmd.newRuleAPIFunc = func(ctx context.Context) (SetApplicationFirewallRuleAPI, error) {
+		root, err := cmd.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return firewallrules.NewClient(root), nil
+	}
 	return modelcmd.Wrap(cmd)
 }
 
@@ -59,34 +82,42 @@ type setFirewallRuleCommand struct {
 	service   firewall.WellKnownServiceType
 	allowlist string
 	whitelist string
+	ports     string
 
-	newAPIFunc func(ctx context.Context) (SetFirewallRuleAPI, error)
+	// rule is set instead of service for application firewall rules.
+	rule *firewallrules.Rule
+
+	newAPIFunc     func(ctx context.Context) (SetFirewallRuleAPI, error)
+	newRuleAPIFunc func(ctx context.Context) (SetApplicationFirewallRuleAPI, error)
 }
 
 // Info implements cmd.Command.
 func (c *setFirewallRuleCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "set-firewall-rule",
-		Args:     "<service-name>, --allowlist <cidr>[,<cidr>...]",
+		Args:     "<service-name>|<application>[:<endpoint>] --allowlist <cidr>|<cidr-set>[,...]",
 		Purpose:  setRuleHelpSummary,
 		Doc:      fmt.Sprintf(setRuleHelpDetails, deprecationWarning),
 		Examples: setRuleHelpExamples,
 		SeeAlso: []string{
 			"firewall-rules",
+			"remove-firewall-rule",
+			"set-cidr-set",
+			"expose",
 		},
 	})
 }
 
 // SetFlags implements cmd.Command.
 func (c *setFirewallRuleCommand) SetFlags(f *gnuflag.FlagSet) {
-	f.StringVar(&c.allowlist, "allowlist", "", "list of subnets to allowlist")
+	f.StringVar(&c.allowlist, "allowlist", "", "list of subnets, or CIDR sets for applications, to allowlist")
 	f.StringVar(&c.whitelist, "whitelist", "", "")
+	f.StringVar(&c.ports, "ports", "", "port range the application rule applies to, instead of the opened ports")
 }
 
 // Init implements cmd.Command.
 func (c *setFirewallRuleCommand) Init(args []string) (err error) {
 	if len(args) == 1 {
-		c.service = firewall.WellKnownServiceType(args[0])
 		if c.allowlist == "" && c.whitelist == "" {
 			return errors.New("no allowlist subnets specified")
 		}
@@ -94,8 +125,15 @@ func (c *setFirewallRuleCommand) Init(args []string) (err error) {
 			return errors.New("cannot specify both whitelist and allowlist")
 		}
 		if args[0] != "ssh" && args[0] != "juju-application-offer" {
-			return errors.NotSupportedf("service %q", args[0])
+			if c.rule, err = c.parseApplicationRule(args[0]); err != nil {
+				return errors.Trace(err)
+			}
+			return nil
 		}
+		if c.ports != "" {
+			return errors.Errorf("--ports cannot be specified for service %q", args[0])
+		}
+		c.service = firewall.WellKnownServiceType(args[0])
 		if err := c.validateCIDRS(c.allowlist + c.whitelist); err != nil {
 			return errors.Trace(err)
 		}
@@ -106,6 +144,49 @@ func (c *setFirewallRuleCommand) Init(args []string) (err error) {
 	return cmd.CheckEmpty(args[1:])
 }
 
+// parseApplicationRule returns the firewall rule for the application and
+// optional endpoint. Allowlist entries which are not CIDRs are the names of
+// CIDR sets.
+func (c *setFirewallRuleCommand) parseApplicationRule(arg string) (*firewallrules.Rule, error) {
+	application, endpoint, portRange, err := parseRuleTarget(arg, c.ports)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rule := &firewallrules.Rule{
+		Application: application,
+		Endpoint:    endpoint,
+		PortRange:   portRange,
+	}
+	for _, entry := range strings.Split(c.allowlist+c.whitelist, ",") {
+		entry = strings.TrimSpace(entry)
+		if _, _, err := net.ParseCIDR(entry); err == nil {
+			rule.CIDRs = append(rule.CIDRs, entry)
+		} else if names.IsValidSpace(entry) {
+			rule.CIDRSets = append(rule.CIDRSets, entry)
+		} else {
+			return nil, errors.NotValidf("allowlist entry %q", entry)
+		}
+	}
+	return rule, nil
+}
+
+// parseRuleTarget parses the application, optional endpoint and optional
+// port range of an application firewall rule.
+func parseRuleTarget(arg, ports string) (string, string, *network.PortRange, error) {
+	application, endpoint, _ := strings.Cut(arg, ":")
+	if !names.IsValidApplication(application) {
+		return "", "", nil, errors.NotValidf("application name %q", application)
+	}
+	if ports == "" {
+		return application, endpoint, nil, nil
+	}
+	portRange, err := network.ParsePortRange(ports)
+	if err != nil {
+		return "", "", nil, errors.Trace(err)
+	}
+	return application, endpoint, &portRange, nil
+}
+
 func (c *setFirewallRuleCommand) validateCIDRS(value string) error {
 	rawValues := strings.Split(value, ",")
 	for _, cidrStr := range rawValues {
@@ -123,6 +204,13 @@ type SetFirewallRuleAPI interface {
 	ModelSet(ctx context.Context, config map[string]interface{}) error
 }
 
+// SetApplicationFirewallRuleAPI defines the API methods that the set firewall
+// rules command uses for application firewall rules.
+type SetApplicationFirewallRuleAPI interface {
+	Close() error
+	SetFirewallRule(ctx context.Context, rule firewallrules.Rule) error
+}
+
 var deprecationWarning = `
 Firewall rules have been moved to model-config settings "ssh-allow" and
 "saas-ingress-allow". This command is deprecated in favour of
@@ -134,6 +222,9 @@ func (c *setFirewallRuleCommand) Run(ctx *cmd.Context) error {
 		c.allowlist = c.whitelist
 		ctx.Warningf("--whitelist is deprecated in favour of --allowlist")
 	}
+	if c.rule != nil {
+		return c.setApplicationRule(ctx)
+	}
 	ctx.Warningf(deprecationWarning)
 
 	client, err := c.newAPIFunc(ctx)
@@ -152,3 +243,14 @@ func (c *setFirewallRuleCommand) Run(ctx *cmd.Context) error {
 	}
 	return block.ProcessBlockedError(err, block.BlockChange)
 }
+
+func (c *setFirewallRuleCommand) setApplicationRule(ctx *cmd.Context) error {
+	client, err := c.newRuleAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	err = client.SetFirewallRule(ctx, *c.rule)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
@@ -10,7 +10,9 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/api/client/firewallrules"
 	"github.com/juju/juju/cmd/juju/firewall"
+	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/cmd/cmdtesting"
@@ -20,13 +22,15 @@ import (
 type SetRuleSuite struct {
 	testing.BaseSuite
 
-	mockAPI *mockSetRuleAPI
+	mockAPI     *mockSetRuleAPI
+	mockRuleAPI *mockSetApplicationRuleAPI
 }
 
 var _ = gc.Suite(&SetRuleSuite{})
 
 func (s *SetRuleSuite) SetUpTest(c *gc.C) {
 	s.mockAPI = &mockSetRuleAPI{}
+	s.mockRuleAPI = &mockSetApplicationRuleAPI{}
 }
 
 func (s *SetRuleSuite) TestInitMissingService(c *gc.C) {
@@ -62,8 +66,57 @@ func (s *SetRuleSuite) TestSetError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, ".*fail.*")
 }
 
+func (s *SetRuleSuite) TestSetApplicationRule(c *gc.C) {
+	_, err := s.runSetRule(c, "wordpress", "--allowlist", "10.0.0.0/8, office")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mockRuleAPI.rules, jc.DeepEquals, []firewallrules.Rule{{
+		Application: "wordpress",
+		CIDRs:       []string{"10.0.0.0/8"},
+		CIDRSets:    []string{"office"},
+	}})
+	c.Assert(s.mockAPI.sshRule, gc.Equals, "")
+}
+
+func (s *SetRuleSuite) TestSetApplicationEndpointRuleWithPorts(c *gc.C) {
+	_, err := s.runSetRule(c, "postgresql:db", "--ports", "5432/tcp", "--allowlist", "office")
+	c.Assert(err, jc.ErrorIsNil)
+	portRange := network.MustParsePortRange("5432/tcp")
+	c.Assert(s.mockRuleAPI.rules, jc.DeepEquals, []firewallrules.Rule{{
+		Application: "postgresql",
+		Endpoint:    "db",
+		PortRange:   &portRange,
+		CIDRSets:    []string{"office"},
+	}})
+}
+
+func (s *SetRuleSuite) TestSetApplicationRuleInvalidAllowlist(c *gc.C) {
+	_, err := s.runSetRule(c, "wordpress", "--allowlist", "10.0.0.0/33")
+	c.Assert(err, gc.ErrorMatches, `allowlist entry "10.0.0.0/33" not valid`)
+}
+
+func (s *SetRuleSuite) TestSetApplicationRuleInvalidApplication(c *gc.C) {
+	_, err := s.runSetRule(c, "Wordpress!", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, gc.ErrorMatches, `application name "Wordpress!" not valid`)
+}
+
+func (s *SetRuleSuite) TestSetApplicationRuleInvalidPorts(c *gc.C) {
+	_, err := s.runSetRule(c, "wordpress", "--ports", "http", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, gc.ErrorMatches, `invalid port "http": .*`)
+}
+
+func (s *SetRuleSuite) TestSetServiceRuleWithPorts(c *gc.C) {
+	_, err := s.runSetRule(c, "ssh", "--ports", "22/tcp", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, gc.ErrorMatches, `--ports cannot be specified for service "ssh"`)
+}
+
+func (s *SetRuleSuite) TestSetApplicationRuleError(c *gc.C) {
+	s.mockRuleAPI.err = errors.NotFoundf(`application "wordpress"`)
+	_, err := s.runSetRule(c, "wordpress", "--allowlist", "10.0.0.0/8")
+	c.Assert(err, gc.ErrorMatches, `application "wordpress" not found`)
+}
+
 func (s *SetRuleSuite) runSetRule(c *gc.C, args ...string) (*cmd.Context, error) {
-	return cmdtesting.RunCommand(c, firewall.NewSetRulesCommandForTest(s.mockAPI), args...)
+	return cmdtesting.RunCommand(c, firewall.NewSetRulesCommandForTest(s.mockAPI, s.mockRuleAPI), args...)
 }
 
 type mockSetRuleAPI struct {
@@ -91,3 +144,20 @@ func (s *mockSetRuleAPI) ModelSet(ctx context.Context, cfg map[string]interface{
 
 	return nil
 }
+
+type mockSetApplicationRuleAPI struct {
+	rules []firewallrules.Rule
+	err   error
+}
+
+func (s *mockSetApplicationRuleAPI) Close() error {
+	return nil
+}
+
+func (s *mockSetApplicationRuleAPI) SetFirewallRule(ctx context.Context, rule firewallrules.Rule) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.rules = append(s.rules, rule)
+	return nil
+}
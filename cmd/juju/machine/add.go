@@ -6,12 +6,14 @@ package machine
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
+	"github.com/juju/utils/v4"
 
 	"github.com/juju/juju/api/client/machinemanager"
 	"github.com/juju/juju/api/client/modelconfig"
@@ -76,6 +78,24 @@ Manual provisioning is the process of installing Juju on an existing machine
 and bringing it under Juju's management. The Juju controller must be able to
 access the new machine over the network.
 
+To allocate many computers at once, list them in an inventory file and pass
+it with the --inventory option. The inventory is a YAML document with a list
+of hosts, each with an address and optionally a user, private-key and
+public-key. A user or key given at the top level of the document applies to
+every host that does not specify its own:
+
+    user: admin
+    private-key: ~/.ssh/fleet_ed25519
+    hosts:
+      - address: 10.0.0.1
+      - address: 10.0.0.2
+        user: root
+
+The hosts are allocated in parallel, at most --parallel at a time, and the
+result for each host is reported once all of them have been attempted. As
+no password can be entered while allocating hosts in parallel, each host must
+accept the SSH key and allow passwordless sudo for its user.
+
 
 Container creation
 
@@ -142,6 +162,10 @@ Allocate a machine specifying a public key to set in the list of authorized keys
 
 	juju add-machine ssh:user@10.10.0.3 --public-key /tmp/id_ed25519.pub --private-key /tmp/id_ed25519
 	
+Allocate all the machines listed in an inventory file, 20 at a time:
+
+	juju add-machine --inventory hosts.yaml --parallel 20
+
 Allocate a machine to the model. Note: specific to MAAS.
 
 	juju add-machine host.internal
@@ -176,12 +200,18 @@ type addCommand struct {
 	// PublicKey is the path for a file containing a public key required
 	// by the server
 	PublicKey string
+	// Inventory is the path of a file listing hosts to be manually
+	// provisioned.
+	Inventory string
+	// Parallel is the maximum number of hosts from the inventory to
+	// provision at once.
+	Parallel int
 }
 
 func (c *addCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
 		Name:     "add-machine",
-		Args:     "[<container-type>[:<machine-id>] | ssh:[<user>@]<host> | <placement>] | <private-key> | <public-key>",
+		Args:     "[<container-type>[:<machine-id>] | ssh:[<user>@]<host> | <placement>] | <private-key> | <public-key> | --inventory <file>",
 		Purpose:  "Provision a new machine or assign one to the model.",
 		Doc:      addMachineDoc,
 		Examples: addMachineExamples,
@@ -201,6 +231,8 @@ func (c *addCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(disksFlag{&c.Disks}, "disks", "Storage directives for disks to attach to the machine(s)")
 	f.StringVar(&c.PrivateKey, "private-key", "", "Path to the private key to use during the connection")
 	f.StringVar(&c.PublicKey, "public-key", "", "Path to the public key to add to the remote authorized keys")
+	f.StringVar(&c.Inventory, "inventory", "", "Path to a YAML file listing hosts to allocate via SSH")
+	f.IntVar(&c.Parallel, "parallel", defaultInventoryParallel, "The maximum number of hosts from the inventory to allocate at once")
 }
 
 func (c *addCommand) Init(args []string) error {
@@ -222,6 +254,17 @@ func (c *addCommand) Init(args []string) error {
 	if c.NumMachines > 1 && c.Placement != nil && c.Placement.Directive != "" {
 		return errors.New("cannot use -n when specifying a placement directive")
 	}
+	if c.Inventory != "" {
+		if c.Placement != nil {
+			return errors.New("cannot use --inventory when specifying a placement directive")
+		}
+		if c.NumMachines > 1 {
+			return errors.New("cannot use -n with --inventory")
+		}
+		if c.Parallel < 1 {
+			return errors.NotValidf("--parallel %d", c.Parallel)
+		}
+	}
 	return nil
 }
 
@@ -313,6 +356,10 @@ func (c *addCommand) Run(ctx *cmd.Context) error {
 		return errors.Trace(err)
 	}
 
+	if c.Inventory != "" {
+		return c.provisionInventory(ctx, machineManager, cfg)
+	}
+
 	if c.Placement != nil {
 		err := c.tryManualProvision(ctx, machineManager, cfg)
 		if err != errNonManualScope {
@@ -436,3 +483,81 @@ func (c *addCommand) tryManualProvision(ctx *cmd.Context, client manual.Provisio
 	ctx.Infof("created machine %v", machineId)
 	return nil
 }
+
+// defaultInventoryParallel is the default maximum number of hosts from an
+// inventory that are provisioned at once.
+const defaultInventoryParallel = 10
+
+// provisionInventory provisions, over SSH, each of the hosts listed in the
+// inventory file, and reports the result for each of them.
+func (c *addCommand) provisionInventory(ctx *cmd.Context, client manual.ProvisioningClientAPI, config *config.Config) error {
+	hosts, err := manual.ReadInventory(c.Inventory)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Hosts commonly share keys, so only read each of them once.
+	authKeys := make(map[string]string)
+	args := make([]manual.ProvisionMachineArgs, len(hosts))
+	for i, host := range hosts {
+		publicKey, err := inventoryKeyPath(host.PublicKey, c.PublicKey)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		keys, ok := authKeys[publicKey]
+		if !ok {
+			if keys, err = common.ReadAuthorizedKeys(ctx, publicKey); err != nil {
+				return errors.Annotatef(err, "cannot read authorized-keys for host %q", host.Address)
+			}
+			authKeys[publicKey] = keys
+		}
+		privateKey, err := inventoryKeyPath(host.PrivateKey, c.PrivateKey)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		// Hosts are provisioned in parallel, so there is no terminal
+		// for sudo to prompt on, and no point in showing progress.
+		args[i] = manual.ProvisionMachineArgs{
+			Host:           host.Address,
+			User:           host.User,
+			Client:         client,
+			Stdin:          strings.NewReader(""),
+			Stdout:         io.Discard,
+			Stderr:         io.Discard,
+			AuthorizedKeys: keys,
+			PrivateKey:     privateKey,
+			UpdateBehavior: &params.UpdateBehavior{
+				EnableOSRefreshUpdate: config.EnableOSRefreshUpdate(),
+				EnableOSUpgrade:       config.EnableOSUpgrade(),
+			},
+		}
+	}
+
+	ctx.Infof("allocating %d hosts from %s", len(args), c.Inventory)
+	results := manual.ProvisionMachines(ctx, sshProvisioner, args, c.Parallel)
+
+	failed := 0
+	for _, result := range results {
+		if result.Error != nil {
+			failed++
+			fmt.Fprintf(ctx.Stderr, "%s: %v\n", result.Host, result.Error)
+			continue
+		}
+		ctx.Infof("%s: created machine %v", result.Host, result.MachineId)
+	}
+	if failed > 0 {
+		return errors.Errorf("failed to allocate %d of %d hosts", failed, len(results))
+	}
+	return nil
+}
+
+// inventoryKeyPath returns the normalised path of the key given for a host
+// in the inventory, or the path given on the command line if there is none.
+func inventoryKeyPath(hostPath, defaultPath string) (string, error) {
+	if hostPath == "" {
+		return defaultPath, nil
+	}
+	path, err := utils.NormalizePath(hostPath)
+	return path, errors.Trace(err)
+}
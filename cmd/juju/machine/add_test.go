@@ -5,8 +5,11 @@ package machine_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/juju/errors"
@@ -101,6 +104,18 @@ func (s *AddMachineSuite) TestInit(c *gc.C) {
 			args:      []string{"something:special"},
 			count:     1,
 			placement: "something:special",
+		}, {
+			args:  []string{"--inventory", "hosts.yaml"},
+			count: 1,
+		}, {
+			args:        []string{"--inventory", "hosts.yaml", "ssh:10.10.0.3"},
+			errorString: "cannot use --inventory when specifying a placement directive",
+		}, {
+			args:        []string{"--inventory", "hosts.yaml", "-n", "2"},
+			errorString: "cannot use -n with --inventory",
+		}, {
+			args:        []string{"--inventory", "hosts.yaml", "--parallel", "0"},
+			errorString: "--parallel 0 not valid",
 		},
 	} {
 		c.Logf("test %d", i)
@@ -164,6 +179,90 @@ func (s *AddMachineSuite) TestSSHPlacementError(c *gc.C) {
 	c.Assert(cmdtesting.Stderr(context), gc.Equals, "")
 }
 
+func (s *AddMachineSuite) writeInventory(c *gc.C, content string) string {
+	path := filepath.Join(c.MkDir(), "hosts.yaml")
+	err := os.WriteFile(path, []byte(content), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	return path
+}
+
+func (s *AddMachineSuite) TestInventory(c *gc.C) {
+	var (
+		mu   sync.Mutex
+		args = make(map[string]manual.ProvisionMachineArgs)
+	)
+	s.PatchValue(machine.SSHProvisioner, func(_ context.Context, a manual.ProvisionMachineArgs) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		args[a.Host] = a
+		return strconv.Itoa(len(args)), nil
+	})
+	path := s.writeInventory(c, `
+user: admin
+private-key: /keys/fleet
+hosts:
+  - address: 10.0.0.1
+  - address: 10.0.0.2
+    user: root
+    private-key: /keys/other
+`)
+
+	context, err := s.run(c, "--inventory", path, "--private-key", "/keys/default")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(context), gc.Matches, `allocating 2 hosts from .*
+10.0.0.1: created machine \d
+10.0.0.2: created machine \d
+`)
+
+	c.Assert(args, gc.HasLen, 2)
+	c.Check(args["10.0.0.1"].User, gc.Equals, "admin")
+	c.Check(args["10.0.0.1"].PrivateKey, gc.Equals, "/keys/fleet")
+	c.Check(args["10.0.0.2"].User, gc.Equals, "root")
+	c.Check(args["10.0.0.2"].PrivateKey, gc.Equals, "/keys/other")
+	c.Check(args["10.0.0.2"].AuthorizedKeys, gc.Not(gc.Equals), "")
+	c.Check(args["10.0.0.2"].UpdateBehavior, gc.NotNil)
+}
+
+func (s *AddMachineSuite) TestInventoryDefaultsToCommandLineKey(c *gc.C) {
+	var privateKey string
+	s.PatchValue(machine.SSHProvisioner, func(_ context.Context, a manual.ProvisionMachineArgs) (string, error) {
+		privateKey = a.PrivateKey
+		return "0", nil
+	})
+	path := s.writeInventory(c, "hosts:\n  - address: 10.0.0.1\n")
+
+	_, err := s.run(c, "--inventory", path, "--private-key", "/keys/default")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(privateKey, gc.Equals, "/keys/default")
+}
+
+func (s *AddMachineSuite) TestInventoryReportsFailures(c *gc.C) {
+	s.PatchValue(machine.SSHProvisioner, func(_ context.Context, a manual.ProvisionMachineArgs) (string, error) {
+		if a.Host == "10.0.0.2" {
+			return "", errors.New("no route to host")
+		}
+		return "7", nil
+	})
+	path := s.writeInventory(c, `
+hosts:
+  - address: 10.0.0.1
+  - address: 10.0.0.2
+`)
+
+	context, err := s.run(c, "--inventory", path)
+	c.Assert(err, gc.ErrorMatches, "failed to allocate 1 of 2 hosts")
+	c.Assert(cmdtesting.Stderr(context), gc.Matches, `allocating 2 hosts from .*
+10.0.0.1: created machine 7
+10.0.0.2: no route to host
+`)
+}
+
+func (s *AddMachineSuite) TestInventoryInvalid(c *gc.C) {
+	path := s.writeInventory(c, "hosts: []\n")
+	_, err := s.run(c, "--inventory", path)
+	c.Assert(err, gc.ErrorMatches, `parsing inventory ".*": inventory with no hosts not valid`)
+}
+
 func (s *AddMachineSuite) TestParamsPassedOn(c *gc.C) {
 	_, err := s.run(c, "--constraints", "mem=8G", "--base=ubuntu@22.04", "zone=nz")
 	c.Assert(err, jc.ErrorIsNil)
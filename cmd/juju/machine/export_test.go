@@ -13,6 +13,9 @@ import (
 
 var (
 	SSHProvisioner        = &sshProvisioner
+	SSHDecommissioner     = &sshDecommissioner
+	DecommissionPoll      = &decommissionPollInterval
+	DecommissionTimeout   = &decommissionTimeout
 	ErrDryRunNotSupported = errDryRunNotSupported
 )
 
@@ -59,6 +62,12 @@ func NewRemoveCommandForTest(apiRoot api.Connection, machineAPI RemoveMachineAPI
 	return modelcmd.Wrap(command), &RemoveCommand{command}
 }
 
+// SetStatusAPI sets the api used to resolve and wait for the machines
+// being decommissioned.
+func (c *RemoveCommand) SetStatusAPI(api statusAPI) {
+	c.statusAPI = api
+}
+
 func NewDisksFlag(disks *[]storage.Directive) *disksFlag {
 	return &disksFlag{disks}
 }
@@ -6,6 +6,7 @@ package machine
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,15 +15,27 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api"
+	apiclient "github.com/juju/juju/api/client/client"
 	"github.com/juju/juju/api/client/machinemanager"
 	"github.com/juju/juju/api/client/modelconfig"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/manual"
+	"github.com/juju/juju/environs/manual/sshprovisioner"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/rpc/params"
 )
 
+var (
+	// sshDecommissioner removes Juju from a manually provisioned host.
+	sshDecommissioner manual.DecommissionMachineFunc = sshprovisioner.DecommissionMachine
+
+	decommissionPollInterval = 5 * time.Second
+	decommissionTimeout      = 10 * time.Minute
+)
+
 // NewRemoveCommand returns a command used to remove a specified machine.
 func NewRemoveCommand() cmd.Command {
 	return modelcmd.Wrap(&removeCommand{})
@@ -36,12 +49,15 @@ type removeCommand struct {
 
 	machineAPI     RemoveMachineAPI
 	modelConfigApi ModelConfigAPI
+	statusAPI      statusAPI
 
 	MachineIds   []string
 	Force        bool
 	KeepInstance bool
 	NoWait       bool
 	DryRun       bool
+	Decommission bool
+	PrivateKey   string
 	fs           *gnuflag.FlagSet
 }
 
@@ -62,6 +78,12 @@ Machine removal is a multi-step process. Under normal circumstances, Juju will n
 proceed to the next step until the current step has finished. 
 However, when using --force, users can also specify --no-wait to progress through steps 
 without delay waiting for each step to complete.
+
+Machines that were allocated over SSH with add-machine can be decommissioned
+using the --decommission option. Once Juju has removed such a machine, the
+Juju agent, its services and its data directories are removed from the host
+over SSH, so that the host can be allocated again. The --private-key option
+specifies the key used to connect to the hosts.
 `
 
 const destroyMachineExamples = `
@@ -69,6 +91,7 @@ const destroyMachineExamples = `
     juju remove-machine 6 --force
     juju remove-machine 6 --force --no-wait
     juju remove-machine 7 --keep-instance
+    juju remove-machine 8 --decommission
 `
 
 var removeMachineMsgNoDryRun = `
@@ -101,6 +124,8 @@ func (c *removeCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.Force, "force", false, "Completely remove a machine and all its dependencies")
 	f.BoolVar(&c.KeepInstance, "keep-instance", false, "Do not stop the running cloud instance")
 	f.BoolVar(&c.NoWait, "no-wait", false, "Rush through machine removal without waiting for each individual step to complete")
+	f.BoolVar(&c.Decommission, "decommission", false, "Remove Juju from the hosts of manually provisioned machines once they are removed")
+	f.StringVar(&c.PrivateKey, "private-key", "", "Path to the private key used to connect to the hosts when decommissioning")
 	c.fs = f
 }
 
@@ -116,6 +141,12 @@ func (c *removeCommand) Init(args []string) error {
 	if !c.Force && c.NoWait {
 		return errors.NotValidf("--no-wait without --force")
 	}
+	if c.Decommission && c.KeepInstance {
+		return errors.New("cannot use --decommission with --keep-instance")
+	}
+	if c.PrivateKey != "" && !c.Decommission {
+		return errors.NotValidf("--private-key without --decommission")
+	}
 	c.MachineIds = args
 	return nil
 }
@@ -155,6 +186,13 @@ func (c *removeCommand) getModelConfigAPI(ctx context.Context) (ModelConfigAPI,
 	return modelconfig.NewClient(root), nil
 }
 
+func (c *removeCommand) getStatusAPI(ctx context.Context) (statusAPI, error) {
+	if c.statusAPI != nil {
+		return c.statusAPI, nil
+	}
+	return c.NewAPIClient(ctx)
+}
+
 // Run implements Command.Run.
 func (c *removeCommand) Run(ctx *cmd.Context) error {
 	var maxWait *time.Duration
@@ -179,6 +217,22 @@ func (c *removeCommand) Run(ctx *cmd.Context) error {
 		return c.performDryRun(ctx, client)
 	}
 
+	// The hosts to decommission must be resolved before the machines,
+	// and with them their instance ids, are removed.
+	var (
+		statusClient statusAPI
+		hosts        map[string]string
+	)
+	if c.Decommission {
+		if statusClient, err = c.getStatusAPI(ctx); err != nil {
+			return errors.Trace(err)
+		}
+		defer statusClient.Close()
+		if hosts, err = c.manualHosts(ctx, statusClient); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	needsConfirmation := c.NeedsConfirmation(ctx, modelConfigClient)
 	if needsConfirmation {
 		err := c.performDryRun(ctx, client)
@@ -199,9 +253,111 @@ func (c *removeCommand) Run(ctx *cmd.Context) error {
 
 	logAll := !needsConfirmation || client.BestAPIVersion() < 10
 	if logAll {
-		return c.logResults(ctx, results)
+		err = c.logResults(ctx, results)
 	} else {
-		return c.logErrors(ctx, results)
+		err = c.logErrors(ctx, results)
+	}
+	if err != nil || !c.Decommission {
+		return err
+	}
+	return c.decommission(ctx, statusClient, hosts)
+}
+
+// manualHosts returns the hosts of the machines being removed, keyed by
+// machine id. It fails if any of the machines was not manually provisioned.
+func (c *removeCommand) manualHosts(ctx context.Context, client statusAPI) (map[string]string, error) {
+	status, err := client.Status(ctx, &apiclient.StatusArgs{Patterns: c.MachineIds})
+	if err != nil {
+		return nil, errors.Annotate(err, "getting machine status")
+	}
+	hosts := make(map[string]string, len(c.MachineIds))
+	for _, id := range c.MachineIds {
+		machine, ok := findMachineStatus(status.Machines, id)
+		if !ok {
+			return nil, errors.NotFoundf("machine %s", id)
+		}
+		host, ok := manual.InstanceHost(instance.Id(machine.InstanceId))
+		if !ok {
+			return nil, errors.NotValidf("decommissioning machine %s which was not manually provisioned", id)
+		}
+		hosts[id] = host
+	}
+	return hosts, nil
+}
+
+// findMachineStatus returns the status of the machine with the input id,
+// which may be a container.
+func findMachineStatus(machines map[string]params.MachineStatus, id string) (params.MachineStatus, bool) {
+	for machineId, machine := range machines {
+		if machineId == id {
+			return machine, true
+		}
+		if container, ok := findMachineStatus(machine.Containers, id); ok {
+			return container, true
+		}
+	}
+	return params.MachineStatus{}, false
+}
+
+// decommission waits for the machines to be removed from the model, then
+// removes Juju from each of their hosts.
+func (c *removeCommand) decommission(ctx *cmd.Context, client statusAPI, hosts map[string]string) error {
+	ids := make([]string, 0, len(hosts))
+	for id := range hosts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	ctx.Infof("waiting for machine(s) %s to be removed", strings.Join(ids, ", "))
+	if err := c.waitForRemoval(ctx, client, ids); err != nil {
+		return errors.Trace(err)
+	}
+
+	failed := 0
+	for _, id := range ids {
+		err := sshDecommissioner(ctx, manual.DecommissionMachineArgs{
+			Host:       hosts[id],
+			PrivateKey: c.PrivateKey,
+			Stderr:     ctx.Stderr,
+		})
+		if err != nil {
+			failed++
+			cmd.WriteError(ctx.Stderr, errors.Annotatef(err, "decommissioning machine %s on %s", id, hosts[id]))
+			continue
+		}
+		ctx.Infof("decommissioned machine %s on %s", id, hosts[id])
+	}
+	if failed > 0 {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// waitForRemoval polls the model status until none of the machines with
+// the input ids remain.
+func (c *removeCommand) waitForRemoval(ctx context.Context, client statusAPI, ids []string) error {
+	timeout := time.After(decommissionTimeout)
+	for {
+		status, err := client.Status(ctx, &apiclient.StatusArgs{Patterns: ids})
+		if err != nil {
+			return errors.Annotate(err, "getting machine status")
+		}
+		var remaining []string
+		for _, id := range ids {
+			if _, ok := findMachineStatus(status.Machines, id); ok {
+				remaining = append(remaining, id)
+			}
+		}
+		if len(remaining) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return errors.Errorf("timed out waiting for machine(s) %s to be removed", strings.Join(remaining, ", "))
+		case <-time.After(decommissionPollInterval):
+		}
 	}
 }
 
@@ -8,15 +8,18 @@ import (
 	"context"
 	"time"
 
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/client/client"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/cmd/juju/machine"
 	"github.com/juju/juju/cmd/juju/machine/mocks"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/manual"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/cmd/cmdtesting"
 	"github.com/juju/juju/internal/testing"
@@ -398,6 +401,128 @@ func (s *RemoveMachineSuite) TestForceBlockedError(c *gc.C) {
 	testing.AssertOperationWasBlocked(c, err, ".*TestForceBlockedError.*")
 }
 
+func (s *RemoveMachineSuite) runDecommission(c *gc.C, status *fakeRemoveStatusAPI, args ...string) (*cmd.Context, error) {
+	remove, removeCmd := machine.NewRemoveCommandForTest(s.apiConnection, s.mockApi, s.mockModelConfigApi)
+	removeCmd.SetStatusAPI(status)
+	return cmdtesting.RunCommand(c, remove, args...)
+}
+
+func (s *RemoveMachineSuite) TestInitDecommission(c *gc.C) {
+	_, err := s.run(c, "--decommission", "--keep-instance", "1")
+	c.Assert(err, gc.ErrorMatches, "cannot use --decommission with --keep-instance")
+
+	_, err = s.run(c, "--private-key", "/keys/fleet", "1")
+	c.Assert(err, gc.ErrorMatches, "--private-key without --decommission not valid")
+}
+
+func (s *RemoveMachineSuite) TestRemoveDecommission(c *gc.C) {
+	defer s.setup(c).Finish()
+	s.PatchValue(machine.DecommissionPoll, time.Millisecond)
+
+	var decommissioned []manual.DecommissionMachineArgs
+	s.PatchValue(machine.SSHDecommissioner, func(_ context.Context, args manual.DecommissionMachineArgs) error {
+		decommissioned = append(decommissioned, args)
+		return nil
+	})
+	s.mockApi.EXPECT().DestroyMachinesWithParams(gomock.Any(), false, false, false, gomock.Any(), "1", "2").DoAndReturn(defaultDestroyMachineResult)
+
+	status := &fakeRemoveStatusAPI{machines: []map[string]params.MachineStatus{{
+		"1": {InstanceId: "manual:10.0.0.1"},
+		"2": {InstanceId: "manual:10.0.0.2"},
+	}, {
+		"2": {InstanceId: "manual:10.0.0.2"},
+	}, {}}}
+	ctx, err := s.runDecommission(c, status, "--no-prompt", "--decommission", "--private-key", "/keys/fleet", "1", "2")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(status.calls, gc.Equals, 3)
+	c.Assert(status.closed, jc.IsTrue)
+	c.Assert(decommissioned, gc.HasLen, 2)
+	c.Check(decommissioned[0].Host, gc.Equals, "10.0.0.1")
+	c.Check(decommissioned[0].PrivateKey, gc.Equals, "/keys/fleet")
+	c.Check(decommissioned[1].Host, gc.Equals, "10.0.0.2")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, `
+waiting for machine(s) 1, 2 to be removed
+decommissioned machine 1 on 10.0.0.1
+decommissioned machine 2 on 10.0.0.2
+`[1:])
+}
+
+func (s *RemoveMachineSuite) TestRemoveDecommissionNotManual(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	status := &fakeRemoveStatusAPI{machines: []map[string]params.MachineStatus{{
+		"1": {InstanceId: "i-1234"},
+	}}}
+	_, err := s.runDecommission(c, status, "--no-prompt", "--decommission", "1")
+	c.Assert(err, gc.ErrorMatches, "decommissioning machine 1 which was not manually provisioned not valid")
+}
+
+func (s *RemoveMachineSuite) TestRemoveDecommissionContainer(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	status := &fakeRemoveStatusAPI{machines: []map[string]params.MachineStatus{{
+		"1": {
+			InstanceId: "manual:10.0.0.1",
+			Containers: map[string]params.MachineStatus{
+				"1/lxd/0": {InstanceId: "juju-1-lxd-0"},
+			},
+		},
+	}}}
+	_, err := s.runDecommission(c, status, "--no-prompt", "--decommission", "1/lxd/0")
+	c.Assert(err, gc.ErrorMatches, "decommissioning machine 1/lxd/0 which was not manually provisioned not valid")
+}
+
+func (s *RemoveMachineSuite) TestRemoveDecommissionTimeout(c *gc.C) {
+	defer s.setup(c).Finish()
+	s.PatchValue(machine.DecommissionPoll, time.Millisecond)
+	s.PatchValue(machine.DecommissionTimeout, 10*time.Millisecond)
+	s.PatchValue(machine.SSHDecommissioner, func(context.Context, manual.DecommissionMachineArgs) error {
+		c.Fatalf("unexpected decommission")
+		return nil
+	})
+	s.mockApi.EXPECT().DestroyMachinesWithParams(gomock.Any(), false, false, false, gomock.Any(), "1").DoAndReturn(defaultDestroyMachineResult)
+
+	status := &fakeRemoveStatusAPI{machines: []map[string]params.MachineStatus{{
+		"1": {InstanceId: "manual:10.0.0.1"},
+	}}}
+	_, err := s.runDecommission(c, status, "--no-prompt", "--decommission", "1")
+	c.Assert(err, gc.ErrorMatches, "timed out waiting for machine\\(s\\) 1 to be removed")
+}
+
+func (s *RemoveMachineSuite) TestRemoveDecommissionError(c *gc.C) {
+	defer s.setup(c).Finish()
+	s.PatchValue(machine.SSHDecommissioner, func(context.Context, manual.DecommissionMachineArgs) error {
+		return errors.New("connection refused")
+	})
+	s.mockApi.EXPECT().DestroyMachinesWithParams(gomock.Any(), false, false, false, gomock.Any(), "1").DoAndReturn(defaultDestroyMachineResult)
+
+	status := &fakeRemoveStatusAPI{machines: []map[string]params.MachineStatus{{
+		"1": {InstanceId: "manual:10.0.0.1"},
+	}, {}}}
+	ctx, err := s.runDecommission(c, status, "--no-prompt", "--decommission", "1")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(cmdtesting.Stderr(ctx), jc.Contains, "ERROR decommissioning machine 1 on 10.0.0.1: connection refused\n")
+}
+
+// fakeRemoveStatusAPI returns the machines in turn for each call to
+// Status, repeating the last of them once they are exhausted.
+type fakeRemoveStatusAPI struct {
+	machines []map[string]params.MachineStatus
+	calls    int
+	closed   bool
+}
+
+func (f *fakeRemoveStatusAPI) Status(context.Context, *client.StatusArgs) (*params.FullStatus, error) {
+	machines := f.machines[min(f.calls, len(f.machines)-1)]
+	f.calls++
+	return &params.FullStatus{Machines: machines}, nil
+}
+
+func (f *fakeRemoveStatusAPI) Close() error {
+	f.closed = true
+	return nil
+}
+
 type mockAPIConnection struct {
 	api.Connection
 }
@@ -58,6 +58,16 @@ func NewShowCommandForTest(api ShowModelAPI, refreshFunc func(context.Context, j
 	)
 }
 
+// NewShowCommandWithCostAPIForTest returns a ShowCommand with the api and
+// cost api provided as specified.
+func NewShowCommandWithCostAPIForTest(api ShowModelAPI, costAPI ModelCostAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &showModelCommand{api: api, costAPI: costAPI}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd,
+		modelcmd.WrapSkipModelFlags,
+	)
+}
+
 // NewDumpCommandForTest returns a DumpCommand with the api provided as specified.
 func NewDumpCommandForTest(api DumpModelAPI, store jujuclient.ClientStore) cmd.Command {
 	cmd := &dumpCommand{api: api}
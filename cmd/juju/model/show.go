@@ -12,16 +12,48 @@ import (
 	"github.com/juju/names/v6"
 
 	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/client/machinemanager"
 	"github.com/juju/juju/api/client/modelmanager"
+	"github.com/juju/juju/api/client/storage"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/rpc/params"
 )
 
-const showModelCommandDoc = `Show information about the current or specified model.`
+const showModelCommandDoc = `
+Show information about the current or specified model.
+
+With --costs, the monthly cost of the model's machines and volumes is
+estimated and shown. Prices are read from the price catalogue file given
+with --prices, or from prices.yaml in the Juju data directory if it
+exists, and from the pricing API of the model's cloud provider where one
+is available. Prices in the file take precedence. A catalogue file holds
+hourly prices of instance types and monthly prices per GiB of storage
+pools, by cloud region:
+
+    currency: USD
+    regions:
+      us-east-1:
+        instance-types:
+          m5.large: 0.096
+        volumes:
+          ebs-ssd: 0.08
+
+The instance type of a running machine is the cheapest one that matches
+the machine's hardware.
+`
+
+const showModelCommandExamples = `
+    juju show-model
+    juju show-model mymodel --costs
+    juju show-model mymodel --costs --prices ./prices.yaml
+`
 
 func NewShowCommand() cmd.Command {
 	showCmd := &showModelCommand{}
@@ -33,8 +65,11 @@ func NewShowCommand() cmd.Command {
 // showModelCommand shows all the users with access to the current model.
 type showModelCommand struct {
 	modelcmd.ModelCommandBase
-	out cmd.Output
-	api ShowModelAPI
+	out        cmd.Output
+	api        ShowModelAPI
+	costAPI    ModelCostAPI
+	costs      bool
+	pricesFile string
 }
 
 // ShowModelAPI defines the methods on the client API that the
@@ -55,13 +90,46 @@ func (c *showModelCommand) getAPI(ctx context.Context) (ShowModelAPI, error) {
 	return modelmanager.NewClient(api), nil
 }
 
+// ModelCostAPI defines the methods on the client API that the show-model
+// command calls to estimate the cost of the model.
+type ModelCostAPI interface {
+	common.CostAPI
+	Close() error
+	ListVolumes(ctx context.Context, machines []string) ([]params.VolumeDetailsListResult, error)
+}
+
+type modelCostAPI struct {
+	*machinemanager.Client
+	storage *storage.Client
+}
+
+// ListVolumes implements ModelCostAPI.
+func (api modelCostAPI) ListVolumes(ctx context.Context, machines []string) ([]params.VolumeDetailsListResult, error) {
+	return api.storage.ListVolumes(ctx, machines)
+}
+
+func (c *showModelCommand) getCostAPI(ctx context.Context) (ModelCostAPI, error) {
+	if c.costAPI != nil {
+		return c.costAPI, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return modelCostAPI{
+		Client:  machinemanager.NewClient(root),
+		storage: storage.NewClient(root),
+	}, nil
+}
+
 // Info implements Command.Info.
 func (c *showModelCommand) Info() *cmd.Info {
 	return jujucmd.Info(&cmd.Info{
-		Name:    "show-model",
-		Args:    "<model name>",
-		Purpose: "Shows information about the current or specified model.",
-		Doc:     showModelCommandDoc,
+		Name:     "show-model",
+		Args:     "<model name>",
+		Purpose:  "Shows information about the current or specified model.",
+		Doc:      showModelCommandDoc,
+		Examples: showModelCommandExamples,
 		SeeAlso: []string{
 			"add-model",
 		},
@@ -72,6 +140,8 @@ func (c *showModelCommand) Info() *cmd.Info {
 func (c *showModelCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
+	f.BoolVar(&c.costs, "costs", false, "Show the estimated monthly cost of the model's machines and volumes")
+	f.StringVar(&c.pricesFile, "prices", "", "Path to a price catalogue file used to estimate costs")
 }
 
 // Init implements Command.Init.
@@ -87,6 +157,9 @@ func (c *showModelCommand) Init(args []string) error {
 	if err := c.ModelCommandBase.Init(args); err != nil {
 		return err
 	}
+	if c.pricesFile != "" && !c.costs {
+		return errors.New("--prices can only be used with --costs")
+	}
 	return nil
 }
 
@@ -119,9 +192,108 @@ func (c *showModelCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if c.costs {
+		estimate, err := c.modelCosts(ctx, *results[0].Result)
+		if err != nil {
+			return errors.Annotate(err, "estimating model costs")
+		}
+		for name, info := range infoMap {
+			info.Costs = estimate
+			infoMap[name] = info
+		}
+	}
 	return c.out.Write(ctx, infoMap)
 }
 
+// modelCosts estimates the monthly cost of the provisioned machines and
+// volumes in the model.
+func (c *showModelCommand) modelCosts(ctx context.Context, info params.ModelInfo) (*pricing.Estimate, error) {
+	api, err := c.getCostAPI(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer api.Close()
+
+	catalogue, err := common.LoadPriceCatalogue(ctx, api, c.pricesFile)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	estimator := pricing.NewEstimator(catalogue, info.CloudRegion)
+
+	// Containers run on their host machines, so only the cost of
+	// provisioned top level machines is counted.
+	var (
+		machines []params.ModelMachineInfo
+		cons     []constraints.Value
+	)
+	for _, m := range info.Machines {
+		if m.InstanceId == "" || names.IsContainerMachine(m.Id) {
+			continue
+		}
+		machines = append(machines, m)
+		cons = append(cons, hardwareConstraints(m.Hardware))
+	}
+	if len(machines) > 0 {
+		results, err := api.InstanceTypes(ctx, cons)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for i, m := range machines {
+			var instanceType string
+			if results[i].Error == nil {
+				hc := hardwareCharacteristics(m.Hardware)
+				instanceType = common.CheapestInstanceType(results[i], catalogue, info.CloudRegion, &hc)
+			}
+			estimator.AddMachines(m.Id, instanceType, 1)
+		}
+	}
+
+	volumes, err := api.ListVolumes(ctx, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, result := range volumes {
+		if result.Error != nil {
+			return nil, errors.Trace(result.Error)
+		}
+		for _, v := range result.Result {
+			if v.Info.VolumeId == "" {
+				continue
+			}
+			tag, err := names.ParseVolumeTag(v.VolumeTag)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			estimator.AddVolumes(tag.Id(), v.Info.Pool, v.Info.Size, 1)
+		}
+	}
+
+	estimate := estimator.Estimate()
+	return &estimate, nil
+}
+
+func hardwareConstraints(hw *params.MachineHardware) constraints.Value {
+	if hw == nil {
+		return constraints.Value{}
+	}
+	return constraints.Value{
+		Arch:     hw.Arch,
+		CpuCores: hw.Cores,
+		Mem:      hw.Mem,
+	}
+}
+
+func hardwareCharacteristics(hw *params.MachineHardware) instance.HardwareCharacteristics {
+	if hw == nil {
+		return instance.HardwareCharacteristics{}
+	}
+	return instance.HardwareCharacteristics{
+		Arch:     hw.Arch,
+		CpuCores: hw.Cores,
+		Mem:      hw.Mem,
+	}
+}
+
 func (c *showModelCommand) apiModelInfoToModelInfoMap(modelInfo []params.ModelInfo, controllerName string) (map[string]common.ModelInfo, error) {
 	// TODO(perrito666) 2016-05-02 lp:1558657
 	now := time.Now()
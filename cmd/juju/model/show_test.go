@@ -5,6 +5,8 @@ package model_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/juju/errors"
@@ -16,11 +18,13 @@ import (
 	"github.com/juju/juju/api"
 	"github.com/juju/juju/cmd/juju/common"
 	"github.com/juju/juju/cmd/juju/model"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/life"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/cmd/cmdtesting"
 	"github.com/juju/juju/internal/pki"
@@ -638,6 +642,94 @@ func (s *ShowCommandSuite) assertShowOutput(c *gc.C, format string) {
 	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, s.expectedDisplay)
 }
 
+func (s *ShowCommandSuite) TestShowCosts(c *gc.C) {
+	amd64, cores, mem := "amd64", uint64(2), uint64(8192)
+	s.fake.info.Machines = []params.ModelMachineInfo{{
+		Id:         "0",
+		InstanceId: "i-0",
+		Hardware:   &params.MachineHardware{Arch: &amd64, Cores: &cores, Mem: &mem},
+	}, {
+		Id:         "0/lxd/0",
+		InstanceId: "juju-0-lxd-0",
+	}, {
+		Id: "1",
+	}}
+	costAPI := &fakeModelCostAPI{
+		instanceTypes: []params.InstanceTypesResult{{
+			InstanceTypes: []params.InstanceType{
+				{Name: "c5.large", Arches: []string{"amd64"}, CPUCores: 2, Memory: 4096},
+				{Name: "m5.large", Arches: []string{"amd64"}, CPUCores: 2, Memory: 8192},
+				{Name: "m5.xlarge", Arches: []string{"amd64"}, CPUCores: 4, Memory: 16384},
+			},
+		}},
+		volumes: []params.VolumeDetailsListResult{{
+			Result: []params.VolumeDetails{{
+				VolumeTag: "volume-0",
+				Info:      params.VolumeInfo{VolumeId: "vol-0", Pool: "ebs", Size: 20480},
+			}, {
+				VolumeTag: "volume-1",
+				Info:      params.VolumeInfo{Pool: "ebs", Size: 20480},
+			}},
+		}},
+	}
+	pricesFile := filepath.Join(c.MkDir(), "prices.yaml")
+	err := os.WriteFile(pricesFile, []byte(`
+currency: USD
+regions:
+  some-region:
+    instance-types:
+      c5.large: 0.085
+      m5.large: 0.096
+      m5.xlarge: 0.192
+    volumes:
+      ebs: 0.1
+`), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cmd := model.NewShowCommandWithCostAPIForTest(&s.fake, costAPI, s.store)
+	ctx, err := cmdtesting.RunCommand(c, cmd, "--format", "yaml", "--costs", "--prices", pricesFile)
+	c.Assert(err, jc.ErrorIsNil)
+
+	modelOutput := s.expectedOutput["mymodel"].(attrs)
+	modelOutput["machines"] = attrs{
+		"0":       attrs{"cores": 2},
+		"0/lxd/0": attrs{"cores": 0},
+		"1":       attrs{"cores": 0},
+	}
+	modelOutput["costs"] = attrs{
+		"currency": "USD",
+		"machines": []attrs{{
+			"id":            "0",
+			"instance-type": "m5.large",
+			"count":         1,
+			"hourly":        0.096,
+			"monthly":       70.08,
+		}},
+		"volumes": []attrs{{
+			"id":      "0",
+			"pool":    "ebs",
+			"size":    20480,
+			"count":   1,
+			"monthly": 2,
+		}},
+		"monthly-total": 72.08,
+	}
+	c.Assert(cmdtesting.Stdout(ctx), jc.YAMLEquals, s.expectedOutput)
+
+	arch := constraints.MustParse("arch=amd64 cores=2 mem=8192M")
+	costAPI.CheckCalls(c, []jujutesting.StubCall{
+		{"PriceCatalogue", nil},
+		{"InstanceTypes", []interface{}{[]constraints.Value{arch}}},
+		{"ListVolumes", []interface{}{[]string(nil)}},
+		{"Close", nil},
+	})
+}
+
+func (s *ShowCommandSuite) TestShowPricesWithoutCosts(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.newShowCommand(), "--prices", "prices.yaml")
+	c.Assert(err, gc.ErrorMatches, "--prices can only be used with --costs")
+}
+
 func (s *ShowCommandSuite) TestHandleRedirectError(c *gc.C) {
 	nhp, _ := network.ParseMachineHostPort("1.2.3.4:5555")
 	caFingerprint, _, _ := pki.Fingerprint([]byte(testing.CACert))
@@ -718,3 +810,29 @@ func (f *fakeModelShowClient) ModelInfo(ctx context.Context, tags []names.ModelT
 	}
 	return []params.ModelInfoResult{{Result: &f.info, Error: f.err}}, f.NextErr()
 }
+
+type fakeModelCostAPI struct {
+	jujutesting.Stub
+	instanceTypes []params.InstanceTypesResult
+	volumes       []params.VolumeDetailsListResult
+}
+
+func (f *fakeModelCostAPI) Close() error {
+	f.MethodCall(f, "Close")
+	return f.NextErr()
+}
+
+func (f *fakeModelCostAPI) InstanceTypes(ctx context.Context, cons []constraints.Value) ([]params.InstanceTypesResult, error) {
+	f.MethodCall(f, "InstanceTypes", cons)
+	return f.instanceTypes, f.NextErr()
+}
+
+func (f *fakeModelCostAPI) PriceCatalogue(ctx context.Context) (*pricing.Catalogue, error) {
+	f.MethodCall(f, "PriceCatalogue")
+	return nil, errors.NotSupportedf("price catalogue")
+}
+
+func (f *fakeModelCostAPI) ListVolumes(ctx context.Context, machines []string) ([]params.VolumeDetailsListResult, error) {
+	f.MethodCall(f, "ListVolumes", machines)
+	return f.volumes, f.NextErr()
+}
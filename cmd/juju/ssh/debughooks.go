@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
 	"github.com/juju/retry"
 
@@ -95,6 +96,12 @@ func (c *debugHooksCommand) Info() *cmd.Info {
 	})
 }
 
+// SetFlags implements cmd.Command. Ports can't be forwarded while debugging,
+// so only the session flags of the ssh command apply.
+func (c *debugHooksCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.setSessionFlags(f)
+}
+
 func (c *debugHooksCommand) Init(args []string) error {
 	if len(args) < 1 {
 		return errors.Errorf("no unit name specified")
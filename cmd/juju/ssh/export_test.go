@@ -7,11 +7,14 @@ import (
 	"context"
 	"net/url"
 
+	"github.com/juju/clock"
 	"github.com/juju/retry"
 
 	k8sexec "github.com/juju/juju/caas/kubernetes/provider/exec"
+	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/internal/cmd"
 	jujussh "github.com/juju/juju/internal/network/ssh"
 	"github.com/juju/juju/internal/uuid"
 	"github.com/juju/juju/jujuclient"
@@ -220,3 +223,89 @@ func NewDebugCodeCommandForTest(
 	c.SetClientStore(clientStore())
 	return c
 }
+
+func NewListSSHSessionsCommandForTest(api SSHSessionsAPI) cmd.Command {
+	c := &listSSHSessionsCommand{
+		sshSessionsAPIFunc: func(context.Context) (SSHSessionsAPI, error) { return api, nil },
+	}
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
+
+func NewReplaySSHSessionCommandForTest(api SSHSessionsAPI, clock clock.Clock) cmd.Command {
+	c := &replaySSHSessionCommand{
+		sshSessionsAPIFunc: func(context.Context) (SSHSessionsAPI, error) { return api, nil },
+		clock:              clock,
+	}
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
+
+func NewRequestSSHAccessCommandForTest(api SSHAccessAPI) cmd.Command {
+	c := &requestSSHAccessCommand{}
+	c.sshAccessAPIFunc = func(context.Context) (SSHAccessAPI, error) { return api, nil }
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
+
+func NewListSSHAccessGrantsCommandForTest(api SSHAccessAPI) cmd.Command {
+	c := &listSSHAccessGrantsCommand{}
+	c.sshAccessAPIFunc = func(context.Context) (SSHAccessAPI, error) { return api, nil }
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
+
+func newDecideSSHAccessCommandForTest(api SSHAccessAPI, decision sshAccessDecision) cmd.Command {
+	c := &decideSSHAccessCommand{decision: decision}
+	c.sshAccessAPIFunc = func(context.Context) (SSHAccessAPI, error) { return api, nil }
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
+
+func NewApproveSSHAccessCommandForTest(api SSHAccessAPI) cmd.Command {
+	return newDecideSSHAccessCommandForTest(api, approveSSHAccess)
+}
+
+func NewRejectSSHAccessCommandForTest(api SSHAccessAPI) cmd.Command {
+	return newDecideSSHAccessCommandForTest(api, rejectSSHAccess)
+}
+
+func NewRevokeSSHAccessCommandForTest(api SSHAccessAPI) cmd.Command {
+	return newDecideSSHAccessCommandForTest(api, revokeSSHAccess)
+}
+
+func NewSSHCertificateCommandForTest(api SSHCertificatesAPI) cmd.Command {
+	c := &sshCertificateCommand{}
+	c.sshCertificatesAPIFunc = func(context.Context) (SSHCertificatesAPI, error) { return api, nil }
+	c.SetClientStore(clientStore())
+	return modelcmd.WrapController(c)
+}
+
+func NewListSSHCertificatesCommandForTest(api SSHCertificatesAPI) cmd.Command {
+	c := &listSSHCertificatesCommand{}
+	c.sshCertificatesAPIFunc = func(context.Context) (SSHCertificatesAPI, error) { return api, nil }
+	c.SetClientStore(clientStore())
+	return modelcmd.WrapController(c)
+}
+
+func NewRevokeSSHCertificateCommandForTest(api SSHCertificatesAPI) cmd.Command {
+	c := &revokeSSHCertificateCommand{}
+	c.sshCertificatesAPIFunc = func(context.Context) (SSHCertificatesAPI, error) { return api, nil }
+	c.SetClientStore(clientStore())
+	return modelcmd.WrapController(c)
+}
+
+func (c *sshCommand) SetJumpServer(api JumpServerAPI, dialer TargetDialer) {
+	c.openJumpServerFunc = func(context.Context, ModelCommand) (JumpServerAPI, TargetDialer, error) {
+		return api, dialer, nil
+	}
+}
+
+func NewProxyCommandForTest(api JumpServerAPI, dialer TargetDialer) cmd.Command {
+	c := &proxyCommand{}
+	c.openJumpServerFunc = func(context.Context, ModelCommand) (JumpServerAPI, TargetDialer, error) {
+		return api, dialer, nil
+	}
+	c.SetClientStore(clientStore())
+	return modelcmd.Wrap(c)
+}
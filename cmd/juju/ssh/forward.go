@@ -0,0 +1,228 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/cmd"
+)
+
+// portForward is a local port forwarded to a port reachable from the ssh
+// target.
+type portForward struct {
+	localPort  int
+	remoteHost string
+	remotePort int
+}
+
+// String returns the forward in the format it is specified in.
+func (f portForward) String() string {
+	return fmt.Sprintf("%d:%s:%d", f.localPort, f.remoteHost, f.remotePort)
+}
+
+// remoteAddr returns the address dialed from the ssh target.
+func (f portForward) remoteAddr() string {
+	return net.JoinHostPort(f.remoteHost, strconv.Itoa(f.remotePort))
+}
+
+// portForwards is the value of the repeatable --forward flag.
+type portForwards []portForward
+
+// Set implements gnuflag.Value. The forward is specified as
+// [<local port>:][<remote host>:]<remote port>, where the local port
+// defaults to the remote port, and the remote host to localhost.
+func (f *portForwards) Set(value string) error {
+	forward, err := parsePortForward(value)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	*f = append(*f, forward)
+	return nil
+}
+
+// String implements gnuflag.Value.
+func (f *portForwards) String() string {
+	values := make([]string, len(*f))
+	for i, forward := range *f {
+		values[i] = forward.String()
+	}
+	return strings.Join(values, ",")
+}
+
+func parsePortForward(value string) (portForward, error) {
+	forward := portForward{remoteHost: "localhost"}
+	parts := strings.Split(value, ":")
+
+	var (
+		localPort string
+		err       error
+	)
+	switch len(parts) {
+	case 1:
+		localPort = parts[0]
+	case 2:
+		if _, err := strconv.Atoi(parts[0]); err == nil {
+			localPort = parts[0]
+		} else {
+			forward.remoteHost = parts[0]
+			localPort = parts[1]
+		}
+	case 3:
+		localPort = parts[0]
+		forward.remoteHost = parts[1]
+	default:
+		return portForward{}, errors.NotValidf("port forward %q", value)
+	}
+	if forward.remoteHost == "" {
+		return portForward{}, errors.NotValidf("port forward %q with empty host", value)
+	}
+	if forward.remotePort, err = parsePort(parts[len(parts)-1]); err != nil {
+		return portForward{}, errors.Annotatef(err, "port forward %q", value)
+	}
+	if forward.localPort, err = parsePort(localPort); err != nil {
+		return portForward{}, errors.Annotatef(err, "port forward %q", value)
+	}
+	return forward, nil
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, errors.NotValidf("port %q", value)
+	}
+	return port, nil
+}
+
+// runForwards forwards the local ports to the target through the
+// controller's ssh server, until interrupted.
+func (c *sshCommand) runForwards(ctx *cmd.Context) error {
+	if err := c.provider.initRun(ctx, &c.ModelCommandBase); err != nil {
+		return errors.Trace(err)
+	}
+	defer c.provider.cleanupRun()
+
+	_, target := splitUserTarget(c.provider.getTarget())
+	target, err := c.provider.maybeResolveLeaderUnit(ctx, target)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	api, dialer, err := c.openJumpServerFunc(ctx, &c.ModelCommandBase)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = dialer.Close()
+		_ = api.Close()
+	}()
+
+	var container *string
+	if c.container != "" {
+		container = &c.container
+	}
+	virtualHostname, err := api.VirtualHostname(ctx, target, container)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	listeners := make([]net.Listener, 0, len(c.forwards))
+	defer func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}()
+	for _, forward := range c.forwards {
+		l, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(forward.localPort)))
+		if err != nil {
+			return errors.Annotatef(err, "listening for %s", forward)
+		}
+		listeners = append(listeners, l)
+	}
+
+	var wg sync.WaitGroup
+	for i, forward := range c.forwards {
+		l := listeners[i]
+		ctx.Infof("Forwarding %s to %s on %s", l.Addr(), forward.remoteAddr(), target)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			serveConns(l, func(conn net.Conn) {
+				target, err := dialer.DialTarget(virtualHostname, forward.remoteAddr())
+				if err != nil {
+					logger.Warningf(ctx, "forwarding connection from %s: %v", conn.RemoteAddr(), err)
+					_ = conn.Close()
+					return
+				}
+				proxyConns(conn, target)
+			})
+		}()
+	}
+
+	waitForInterrupt(ctx)
+	for _, l := range listeners {
+		_ = l.Close()
+	}
+	wg.Wait()
+	return nil
+}
+
+// serveConns accepts connections on the listener until it is closed,
+// handling each in its own goroutine. Once the listener is closed, the
+// connections still open are closed too.
+func serveConns(l net.Listener, handle func(net.Conn)) {
+	var (
+		mu    sync.Mutex
+		conns = make(map[net.Conn]struct{})
+		wg    sync.WaitGroup
+	)
+	defer func() {
+		mu.Lock()
+		for conn := range conns {
+			_ = conn.Close()
+		}
+		mu.Unlock()
+		wg.Wait()
+	}()
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		mu.Lock()
+		conns[conn] = struct{}{}
+		mu.Unlock()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				mu.Lock()
+				delete(conns, conn)
+				mu.Unlock()
+			}()
+			handle(conn)
+		}()
+	}
+}
+
+// waitForInterrupt blocks until the user interrupts the command or its
+// context is done.
+func waitForInterrupt(ctx *cmd.Context) {
+	interrupted := make(chan os.Signal, 1)
+	ctx.InterruptNotify(interrupted)
+	defer ctx.StopInterruptNotify(interrupted)
+
+	select {
+	case <-interrupted:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type forwardSuite struct {
+	jujutesting.IsolationSuite
+
+	sshClient    *mocks.MockSSHClientAPI
+	app          *mocks.MockApplicationAPI
+	status       *mocks.MockStatusClientAPI
+	jumpServer   *mocks.MockJumpServerAPI
+	targetDialer *mocks.MockTargetDialer
+}
+
+var _ = gc.Suite(&forwardSuite{})
+
+func (s *forwardSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.sshClient = mocks.NewMockSSHClientAPI(ctrl)
+	s.app = mocks.NewMockApplicationAPI(ctrl)
+	s.status = mocks.NewMockStatusClientAPI(ctrl)
+	s.jumpServer = mocks.NewMockJumpServerAPI(ctrl)
+	s.targetDialer = mocks.NewMockTargetDialer(ctrl)
+	return ctrl
+}
+
+func (s *forwardSuite) TestParsePortForward(c *gc.C) {
+	for i, t := range []struct {
+		value      string
+		localPort  int
+		remoteHost string
+		remotePort int
+		err        string
+	}{{
+		value:      "5432",
+		localPort:  5432,
+		remoteHost: "localhost",
+		remotePort: 5432,
+	}, {
+		value:      "8080:80",
+		localPort:  8080,
+		remoteHost: "localhost",
+		remotePort: 80,
+	}, {
+		value:      "10.0.0.5:80",
+		localPort:  80,
+		remoteHost: "10.0.0.5",
+		remotePort: 80,
+	}, {
+		value:      "8080:10.0.0.5:80",
+		localPort:  8080,
+		remoteHost: "10.0.0.5",
+		remotePort: 80,
+	}, {
+		value: "8080::80",
+		err:   `port forward "8080::80" with empty host not valid`,
+	}, {
+		value: "8080:host:80:1",
+		err:   `port forward "8080:host:80:1" not valid`,
+	}, {
+		value: "http",
+		err:   `port forward "http": port "http" not valid`,
+	}, {
+		value: "70000:80",
+		err:   `port forward "70000:80": port "70000" not valid`,
+	}} {
+		c.Logf("test %d: %s", i, t.value)
+		forward, err := parsePortForward(t.value)
+		if t.err != "" {
+			c.Check(err, gc.ErrorMatches, t.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(forward, gc.Equals, portForward{
+			localPort:  t.localPort,
+			remoteHost: t.remoteHost,
+			remotePort: t.remotePort,
+		})
+	}
+}
+
+func (s *forwardSuite) TestForwardWithCommand(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sshCmd := NewSSHCommandForTest(s.app, s.sshClient, s.status, nil, nil, baseTestingRetryStrategy, baseTestingRetryStrategy)
+	err := cmdtesting.InitCommand(modelcmd.Wrap(sshCmd), []string{"--forward", "8080", "0", "uname", "-a"})
+	c.Assert(err, gc.ErrorMatches, "cannot run a command or pass ssh options when forwarding ports")
+}
+
+func (s *forwardSuite) TestForward(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	localPort := freePort(c)
+	targetConn, remoteConn := net.Pipe()
+	go echo(remoteConn)
+
+	s.sshClient.EXPECT().Proxy(gomock.Any()).Return(false, nil)
+	s.sshClient.EXPECT().Close().Return(nil)
+	s.status.EXPECT().Close().Return(nil)
+	s.app.EXPECT().Close().Return(nil)
+	s.jumpServer.EXPECT().VirtualHostname(gomock.Any(), "mysql/0", nil).Return("0.mysql.uuid.juju.local", nil)
+	s.jumpServer.EXPECT().Close().Return(nil)
+	s.targetDialer.EXPECT().DialTarget("0.mysql.uuid.juju.local", "localhost:3306").Return(targetConn, nil)
+	s.targetDialer.EXPECT().Close().Return(nil)
+
+	sshCmd := NewSSHCommandForTest(s.app, s.sshClient, s.status, nil, nil, baseTestingRetryStrategy, baseTestingRetryStrategy)
+	sshCmd.SetJumpServer(s.jumpServer, s.targetDialer)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmdCtx := cmdtesting.Context(c).With(ctx)
+	errc := cmdtesting.RunCommandWithContext(cmdCtx, modelcmd.Wrap(sshCmd),
+		"--forward", fmt.Sprintf("%d:3306", localPort), "ubuntu@mysql/0")
+
+	conn := dialRetry(c, net.JoinHostPort("127.0.0.1", strconv.Itoa(localPort)))
+	checkEcho(c, conn)
+
+	cancel()
+	select {
+	case err := <-errc:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for the command to stop")
+	}
+	c.Check(cmdtesting.Stderr(cmdCtx), gc.Matches, fmt.Sprintf("Forwarding 127.0.0.1:%d to localhost:3306 on mysql/0\n", localPort))
+}
+
+// freePort returns a local port which is not listened on.
+func freePort(c *gc.C) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, jc.ErrorIsNil)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// dialRetry dials the address until it is listened on.
+func dialRetry(c *gc.C, addr string) net.Conn {
+	deadline := time.Now().Add(testing.LongWait)
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			return conn
+		}
+		if time.Now().After(deadline) {
+			c.Fatalf("timed out dialing %s: %v", addr, err)
+		}
+		time.Sleep(testing.ShortWait)
+	}
+}
+
+// echo writes back what is read from the connection until it is closed.
+func echo(conn net.Conn) {
+	defer conn.Close()
+	_, _ = io.Copy(conn, conn)
+}
+
+// checkEcho checks that what is written to the connection is echoed back,
+// then closes it.
+func checkEcho(c *gc.C, conn net.Conn) {
+	defer conn.Close()
+	_, err := conn.Write([]byte("ping"))
+	c.Assert(err, jc.ErrorIsNil)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(buf), gc.Equals, "ping")
+}
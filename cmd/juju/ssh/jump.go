@@ -0,0 +1,331 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+	"github.com/juju/utils/v4/ssh"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/juju/juju/api/client/sshclient"
+)
+
+// JumpServerAPI defines the APIs used to reach units and machines through
+// the controller's ssh server.
+type JumpServerAPI interface {
+	SSHServerPort(ctx context.Context) (int, error)
+	VirtualHostname(ctx context.Context, target string, container *string) (string, error)
+	Close() error
+}
+
+// TargetDialer dials addresses from units and machines, tunnelling the
+// connections through the controller's ssh server.
+type TargetDialer interface {
+	// DialTarget dials the address from the unit or machine with the
+	// virtual hostname.
+	DialTarget(virtualHostname, addr string) (net.Conn, error)
+	Close() error
+}
+
+// defaultPrivateKeyFiles are the private keys, relative to the user's .ssh
+// directory, offered to the controller's ssh server along with juju's own
+// client keys.
+var defaultPrivateKeyFiles = []string{
+	"id_ed25519",
+	"id_ecdsa",
+	"id_rsa",
+}
+
+// jumpServerTimeout is the time to wait for the connection to the
+// controller's ssh server to be established.
+const jumpServerTimeout = 30 * time.Second
+
+// openJumpServer connects to the ssh server of the model's controller as
+// the current user. It returns the API used to name the targets to dial,
+// along with the dialer.
+func openJumpServer(ctx context.Context, mc ModelCommand) (JumpServerAPI, TargetDialer, error) {
+	root, err := mc.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	api := sshclient.NewFacade(root)
+	port, err := api.SSHServerPort(ctx)
+	if err != nil {
+		_ = api.Close()
+		return nil, nil, errors.Trace(err)
+	}
+	addr := net.JoinHostPort(root.Addr().Hostname(), strconv.Itoa(port))
+	dialer, err := dialJumpServer(addr, root.AuthTag().Id())
+	if err != nil {
+		_ = api.Close()
+		return nil, nil, errors.Annotatef(err, "connecting to the controller's ssh server at %s", addr)
+	}
+	return api, dialer, nil
+}
+
+// jumpServerDialer is a TargetDialer connected to the controller's ssh
+// server.
+type jumpServerDialer struct {
+	client  *gossh.Client
+	signers []gossh.Signer
+	agent   io.Closer
+
+	mu      sync.Mutex
+	targets map[string]*gossh.Client
+}
+
+// dialJumpServer connects to the controller's ssh server at the address,
+// authenticating as the user with the keys held by the ssh agent and the
+// user's private keys.
+func dialJumpServer(addr, user string) (*jumpServerDialer, error) {
+	signers, agentConn := clientSigners()
+	if len(signers) == 0 {
+		if agentConn != nil {
+			_ = agentConn.Close()
+		}
+		return nil, errors.NotFoundf("ssh keys to authenticate with")
+	}
+	client, err := gossh.Dial("tcp", addr, &gossh.ClientConfig{
+		User:            user,
+		Auth:            []gossh.AuthMethod{gossh.PublicKeys(signers...)},
+		HostKeyCallback: trustOnFirstUse(ssh.GoCryptoKnownHostsFile()),
+		Timeout:         jumpServerTimeout,
+	})
+	if err != nil {
+		if agentConn != nil {
+			_ = agentConn.Close()
+		}
+		return nil, errors.Trace(err)
+	}
+	return &jumpServerDialer{
+		client:  client,
+		signers: signers,
+		agent:   agentConn,
+		targets: make(map[string]*gossh.Client),
+	}, nil
+}
+
+// DialTarget implements TargetDialer.
+func (d *jumpServerDialer) DialTarget(virtualHostname, addr string) (net.Conn, error) {
+	client, err := d.targetClient(virtualHostname)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	conn, err := client.Dial("tcp", addr)
+	var rejected *gossh.OpenChannelError
+	if err != nil && !errors.As(err, &rejected) {
+		// The connection to the target has been lost, so the next dial
+		// reconnects.
+		d.dropTargetClient(virtualHostname, client)
+		return nil, errors.Trace(err)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return conn, nil
+}
+
+// targetClient returns the ssh client connected to the target through the
+// controller's ssh server, connecting it first if needed.
+func (d *jumpServerDialer) targetClient(virtualHostname string) (*gossh.Client, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if client, ok := d.targets[virtualHostname]; ok {
+		return client, nil
+	}
+	conn, err := d.client.Dial("tcp", net.JoinHostPort(virtualHostname, "0"))
+	if err != nil {
+		return nil, errors.Annotatef(err, "connecting to %q", virtualHostname)
+	}
+	clientConn, chans, reqs, err := gossh.NewClientConn(conn, virtualHostname, &gossh.ClientConfig{
+		User: d.client.User(),
+		Auth: []gossh.AuthMethod{gossh.PublicKeys(d.signers...)},
+		// The controller generates the target's host key for each
+		// connection, and the connection is tunnelled through the
+		// verified connection to the controller, so there is no stable
+		// key to check.
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+	})
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Annotatef(err, "connecting to %q", virtualHostname)
+	}
+	client := gossh.NewClient(clientConn, chans, reqs)
+	d.targets[virtualHostname] = client
+	return client, nil
+}
+
+func (d *jumpServerDialer) dropTargetClient(virtualHostname string, client *gossh.Client) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.targets[virtualHostname] == client {
+		delete(d.targets, virtualHostname)
+		_ = client.Close()
+	}
+}
+
+// Close implements TargetDialer.
+func (d *jumpServerDialer) Close() error {
+	d.mu.Lock()
+	for _, client := range d.targets {
+		_ = client.Close()
+	}
+	d.targets = nil
+	d.mu.Unlock()
+
+	err := d.client.Close()
+	if d.agent != nil {
+		_ = d.agent.Close()
+	}
+	return err
+}
+
+// clientSigners returns the signers to authenticate with: those of the ssh
+// agent, if one is running, followed by juju's client keys and the user's
+// private keys. Keys which have a certificate next to them are offered with
+// the certificate first. The connection to the agent is returned so that it
+// can be closed once the signers are no longer needed.
+func clientSigners() ([]gossh.Signer, io.Closer) {
+	var (
+		signers   []gossh.Signer
+		agentConn net.Conn
+	)
+	if socket := os.Getenv("SSH_AUTH_SOCK"); socket != "" {
+		conn, err := net.Dial("unix", socket)
+		if err != nil {
+			logger.Debugf(context.TODO(), "cannot connect to ssh agent: %v", err)
+		} else if agentSigners, err := agent.NewClient(conn).Signers(); err != nil {
+			logger.Debugf(context.TODO(), "cannot list ssh agent keys: %v", err)
+			_ = conn.Close()
+		} else {
+			signers = append(signers, agentSigners...)
+			agentConn = conn
+		}
+	}
+
+	keyFiles := ssh.PrivateKeyFiles()
+	if sshDir, err := utils.NormalizePath("~/.ssh"); err == nil {
+		for _, name := range defaultPrivateKeyFiles {
+			keyFiles = append(keyFiles, filepath.Join(sshDir, name))
+		}
+	}
+	for _, keyFile := range keyFiles {
+		signers = append(signers, fileSigners(keyFile)...)
+	}
+
+	if agentConn == nil {
+		return signers, nil
+	}
+	return signers, agentConn
+}
+
+// fileSigners returns the signers for the private key file, if it can be
+// read without a passphrase. If a certificate for the key exists, the
+// certificate signer comes first.
+func fileSigners(keyFile string) []gossh.Signer {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil
+	}
+	signer, err := gossh.ParsePrivateKey(data)
+	if err != nil {
+		logger.Debugf(context.TODO(), "skipping ssh key %q: %v", keyFile, err)
+		return nil
+	}
+	certData, err := os.ReadFile(keyFile + "-cert.pub")
+	if err != nil {
+		return []gossh.Signer{signer}
+	}
+	pub, _, _, _, err := gossh.ParseAuthorizedKey(certData)
+	if err != nil {
+		logger.Debugf(context.TODO(), "skipping ssh certificate for %q: %v", keyFile, err)
+		return []gossh.Signer{signer}
+	}
+	cert, ok := pub.(*gossh.Certificate)
+	if !ok {
+		return []gossh.Signer{signer}
+	}
+	certSigner, err := gossh.NewCertSigner(cert, signer)
+	if err != nil {
+		logger.Debugf(context.TODO(), "skipping ssh certificate for %q: %v", keyFile, err)
+		return []gossh.Signer{signer}
+	}
+	return []gossh.Signer{certSigner, signer}
+}
+
+// trustOnFirstUse returns a host key callback checking host keys against the
+// known hosts file. The key of a host which is not in the file is added to
+// it, while a key which does not match the one recorded is refused.
+func trustOnFirstUse(knownHostsFile string) gossh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key gossh.PublicKey) error {
+		if knownHostsFile == "" {
+			return errors.New("no known hosts file to verify the host key with")
+		}
+		if _, err := os.Stat(knownHostsFile); err == nil {
+			check, err := knownhosts.New(knownHostsFile)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			err = check(hostname, remote, key)
+			var keyErr *knownhosts.KeyError
+			if err == nil {
+				return nil
+			} else if !errors.As(err, &keyErr) {
+				return errors.Trace(err)
+			} else if len(keyErr.Want) > 0 {
+				return errors.Errorf("host key of %s does not match the key recorded in %s", hostname, knownHostsFile)
+			}
+		} else if !os.IsNotExist(err) {
+			return errors.Trace(err)
+		}
+
+		logger.Warningf(context.TODO(), "adding host key for %s to %s", hostname, knownHostsFile)
+		f, err := os.OpenFile(knownHostsFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer f.Close()
+		line := knownhosts.Line([]string{knownhosts.Normalize(hostname)}, key)
+		_, err = fmt.Fprintln(f, line)
+		return errors.Trace(err)
+	}
+}
+
+// proxyConns copies data in both directions between the connections until
+// either is closed, then closes both.
+func proxyConns(a, b net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		_ = a.Close()
+		_ = b.Close()
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer once.Do(closeBoth)
+		_, _ = io.Copy(a, b)
+	}()
+	go func() {
+		defer wg.Done()
+		defer once.Do(closeBoth)
+		_, _ = io.Copy(b, a)
+	}()
+	wg.Wait()
+}
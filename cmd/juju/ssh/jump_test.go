@@ -0,0 +1,111 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gossh "golang.org/x/crypto/ssh"
+	gc "gopkg.in/check.v1"
+)
+
+type jumpSuite struct {
+	jujutesting.IsolationSuite
+}
+
+var _ = gc.Suite(&jumpSuite{})
+
+func (s *jumpSuite) newSigner(c *gc.C) (gossh.Signer, ed25519.PrivateKey) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	c.Assert(err, jc.ErrorIsNil)
+	signer, err := gossh.NewSignerFromKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	return signer, key
+}
+
+func (s *jumpSuite) TestTrustOnFirstUse(c *gc.C) {
+	knownHosts := filepath.Join(c.MkDir(), "known_hosts")
+	hostKey, _ := s.newSigner(c)
+	otherKey, _ := s.newSigner(c)
+	addr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 17022}
+
+	check := trustOnFirstUse(knownHosts)
+
+	// The key of an unknown host is added.
+	err := check("10.0.0.1:17022", addr, hostKey.PublicKey())
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := os.ReadFile(knownHosts)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "[10.0.0.1]:17022 "+string(gossh.MarshalAuthorizedKey(hostKey.PublicKey())))
+
+	// The same key is then accepted, and another refused.
+	err = check("10.0.0.1:17022", addr, hostKey.PublicKey())
+	c.Assert(err, jc.ErrorIsNil)
+	err = check("10.0.0.1:17022", addr, otherKey.PublicKey())
+	c.Assert(err, gc.ErrorMatches, "host key of 10.0.0.1:17022 does not match the key recorded in .*")
+
+	// Other hosts are added alongside.
+	err = check("10.0.0.2:17022", addr, otherKey.PublicKey())
+	c.Assert(err, jc.ErrorIsNil)
+	data, err = os.ReadFile(knownHosts)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Matches, `(?s)\[10.0.0.1\]:17022 .*\n\[10.0.0.2\]:17022 .*\n`)
+}
+
+func (s *jumpSuite) TestTrustOnFirstUseNoKnownHostsFile(c *gc.C) {
+	hostKey, _ := s.newSigner(c)
+	err := trustOnFirstUse("")("10.0.0.1:17022", &net.TCPAddr{}, hostKey.PublicKey())
+	c.Assert(err, gc.ErrorMatches, "no known hosts file to verify the host key with")
+}
+
+func (s *jumpSuite) TestFileSigners(c *gc.C) {
+	signer, key := s.newSigner(c)
+	keyFile := filepath.Join(c.MkDir(), "id_ed25519")
+	block, err := gossh.MarshalPrivateKey(key, "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600), jc.ErrorIsNil)
+
+	signers := fileSigners(keyFile)
+	c.Assert(signers, gc.HasLen, 1)
+	c.Check(signers[0].PublicKey().Marshal(), gc.DeepEquals, signer.PublicKey().Marshal())
+}
+
+func (s *jumpSuite) TestFileSignersWithCertificate(c *gc.C) {
+	signer, key := s.newSigner(c)
+	keyFile := filepath.Join(c.MkDir(), "id_ed25519")
+	block, err := gossh.MarshalPrivateKey(key, "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600), jc.ErrorIsNil)
+
+	ca, _ := s.newSigner(c)
+	cert := &gossh.Certificate{
+		Key:             signer.PublicKey(),
+		CertType:        gossh.UserCert,
+		ValidPrincipals: []string{"admin"},
+		ValidBefore:     gossh.CertTimeInfinity,
+	}
+	c.Assert(cert.SignCert(rand.Reader, ca), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(keyFile+"-cert.pub", gossh.MarshalAuthorizedKey(cert), 0644), jc.ErrorIsNil)
+
+	signers := fileSigners(keyFile)
+	c.Assert(signers, gc.HasLen, 2)
+	c.Check(signers[0].PublicKey().Type(), gc.Equals, gossh.CertAlgoED25519v01)
+	c.Check(signers[1].PublicKey().Marshal(), gc.DeepEquals, signer.PublicKey().Marshal())
+}
+
+func (s *jumpSuite) TestFileSignersSkipsUnreadableKeys(c *gc.C) {
+	dir := c.MkDir()
+	c.Check(fileSigners(filepath.Join(dir, "missing")), gc.HasLen, 0)
+
+	keyFile := filepath.Join(dir, "id_rsa")
+	c.Assert(os.WriteFile(keyFile, []byte("not a key"), 0600), jc.ErrorIsNil)
+	c.Check(fileSigners(keyFile), gc.HasLen, 0)
+}
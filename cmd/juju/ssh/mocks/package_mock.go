@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/ssh (interfaces: Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand)
+// Source: github.com/juju/juju/cmd/juju/ssh (interfaces: Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand,SSHSessionsAPI,SSHAccessAPI,SSHCertificatesAPI,JumpServerAPI,TargetDialer)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/package_mock.go github.com/juju/juju/cmd/juju/ssh Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand
+//	mockgen -typed -package mocks -destination mocks/package_mock.go github.com/juju/juju/cmd/juju/ssh Context,LeaderAPI,SSHClientAPI,SSHControllerAPI,StatusClientAPI,CloudCredentialAPI,ApplicationAPI,CharmAPI,ModelCommand,SSHSessionsAPI,SSHAccessAPI,SSHCertificatesAPI,JumpServerAPI,TargetDialer
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	io "io"
+	net "net"
 	os "os"
 	reflect "reflect"
 	time "time"
@@ -1663,3 +1664,850 @@ func (c *MockModelCommandNewControllerAPIRootCall) DoAndReturn(f func(context.Co
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSSHSessionsAPI is a mock of SSHSessionsAPI interface.
+type MockSSHSessionsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHSessionsAPIMockRecorder
+}
+
+// MockSSHSessionsAPIMockRecorder is the mock recorder for MockSSHSessionsAPI.
+type MockSSHSessionsAPIMockRecorder struct {
+	mock *MockSSHSessionsAPI
+}
+
+// NewMockSSHSessionsAPI creates a new mock instance.
+func NewMockSSHSessionsAPI(ctrl *gomock.Controller) *MockSSHSessionsAPI {
+	mock := &MockSSHSessionsAPI{ctrl: ctrl}
+	mock.recorder = &MockSSHSessionsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHSessionsAPI) EXPECT() *MockSSHSessionsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSSHSessionsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSSHSessionsAPIMockRecorder) Close() *MockSSHSessionsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSSHSessionsAPI)(nil).Close))
+	return &MockSSHSessionsAPICloseCall{Call: call}
+}
+
+// MockSSHSessionsAPICloseCall wrap *gomock.Call
+type MockSSHSessionsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHSessionsAPICloseCall) Return(arg0 error) *MockSSHSessionsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHSessionsAPICloseCall) Do(f func() error) *MockSSHSessionsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHSessionsAPICloseCall) DoAndReturn(f func() error) *MockSSHSessionsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSSHSessions mocks base method.
+func (m *MockSSHSessionsAPI) ListSSHSessions(arg0 context.Context) ([]params.SSHSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSSHSessions", arg0)
+	ret0, _ := ret[0].([]params.SSHSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSSHSessions indicates an expected call of ListSSHSessions.
+func (mr *MockSSHSessionsAPIMockRecorder) ListSSHSessions(arg0 any) *MockSSHSessionsAPIListSSHSessionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHSessions", reflect.TypeOf((*MockSSHSessionsAPI)(nil).ListSSHSessions), arg0)
+	return &MockSSHSessionsAPIListSSHSessionsCall{Call: call}
+}
+
+// MockSSHSessionsAPIListSSHSessionsCall wrap *gomock.Call
+type MockSSHSessionsAPIListSSHSessionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHSessionsAPIListSSHSessionsCall) Return(arg0 []params.SSHSession, arg1 error) *MockSSHSessionsAPIListSSHSessionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHSessionsAPIListSSHSessionsCall) Do(f func(context.Context) ([]params.SSHSession, error)) *MockSSHSessionsAPIListSSHSessionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHSessionsAPIListSSHSessionsCall) DoAndReturn(f func(context.Context) ([]params.SSHSession, error)) *MockSSHSessionsAPIListSSHSessionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHSessionRecording mocks base method.
+func (m *MockSSHSessionsAPI) SSHSessionRecording(arg0 context.Context, arg1 string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHSessionRecording", arg0, arg1)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SSHSessionRecording indicates an expected call of SSHSessionRecording.
+func (mr *MockSSHSessionsAPIMockRecorder) SSHSessionRecording(arg0, arg1 any) *MockSSHSessionsAPISSHSessionRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHSessionRecording", reflect.TypeOf((*MockSSHSessionsAPI)(nil).SSHSessionRecording), arg0, arg1)
+	return &MockSSHSessionsAPISSHSessionRecordingCall{Call: call}
+}
+
+// MockSSHSessionsAPISSHSessionRecordingCall wrap *gomock.Call
+type MockSSHSessionsAPISSHSessionRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHSessionsAPISSHSessionRecordingCall) Return(arg0 io.ReadCloser, arg1 error) *MockSSHSessionsAPISSHSessionRecordingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHSessionsAPISSHSessionRecordingCall) Do(f func(context.Context, string) (io.ReadCloser, error)) *MockSSHSessionsAPISSHSessionRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHSessionsAPISSHSessionRecordingCall) DoAndReturn(f func(context.Context, string) (io.ReadCloser, error)) *MockSSHSessionsAPISSHSessionRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSSHAccessAPI is a mock of SSHAccessAPI interface.
+type MockSSHAccessAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHAccessAPIMockRecorder
+}
+
+// MockSSHAccessAPIMockRecorder is the mock recorder for MockSSHAccessAPI.
+type MockSSHAccessAPIMockRecorder struct {
+	mock *MockSSHAccessAPI
+}
+
+// NewMockSSHAccessAPI creates a new mock instance.
+func NewMockSSHAccessAPI(ctrl *gomock.Controller) *MockSSHAccessAPI {
+	mock := &MockSSHAccessAPI{ctrl: ctrl}
+	mock.recorder = &MockSSHAccessAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHAccessAPI) EXPECT() *MockSSHAccessAPIMockRecorder {
+	return m.recorder
+}
+
+// ApproveSSHAccess mocks base method.
+func (m *MockSSHAccessAPI) ApproveSSHAccess(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveSSHAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveSSHAccess indicates an expected call of ApproveSSHAccess.
+func (mr *MockSSHAccessAPIMockRecorder) ApproveSSHAccess(arg0, arg1 any) *MockSSHAccessAPIApproveSSHAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveSSHAccess", reflect.TypeOf((*MockSSHAccessAPI)(nil).ApproveSSHAccess), arg0, arg1)
+	return &MockSSHAccessAPIApproveSSHAccessCall{Call: call}
+}
+
+// MockSSHAccessAPIApproveSSHAccessCall wrap *gomock.Call
+type MockSSHAccessAPIApproveSSHAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessAPIApproveSSHAccessCall) Return(arg0 error) *MockSSHAccessAPIApproveSSHAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessAPIApproveSSHAccessCall) Do(f func(context.Context, string) error) *MockSSHAccessAPIApproveSSHAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessAPIApproveSSHAccessCall) DoAndReturn(f func(context.Context, string) error) *MockSSHAccessAPIApproveSSHAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Close mocks base method.
+func (m *MockSSHAccessAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSSHAccessAPIMockRecorder) Close() *MockSSHAccessAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSSHAccessAPI)(nil).Close))
+	return &MockSSHAccessAPICloseCall{Call: call}
+}
+
+// MockSSHAccessAPICloseCall wrap *gomock.Call
+type MockSSHAccessAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessAPICloseCall) Return(arg0 error) *MockSSHAccessAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessAPICloseCall) Do(f func() error) *MockSSHAccessAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessAPICloseCall) DoAndReturn(f func() error) *MockSSHAccessAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSSHAccessGrants mocks base method.
+func (m *MockSSHAccessAPI) ListSSHAccessGrants(arg0 context.Context) ([]params.SSHAccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSSHAccessGrants", arg0)
+	ret0, _ := ret[0].([]params.SSHAccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSSHAccessGrants indicates an expected call of ListSSHAccessGrants.
+func (mr *MockSSHAccessAPIMockRecorder) ListSSHAccessGrants(arg0 any) *MockSSHAccessAPIListSSHAccessGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHAccessGrants", reflect.TypeOf((*MockSSHAccessAPI)(nil).ListSSHAccessGrants), arg0)
+	return &MockSSHAccessAPIListSSHAccessGrantsCall{Call: call}
+}
+
+// MockSSHAccessAPIListSSHAccessGrantsCall wrap *gomock.Call
+type MockSSHAccessAPIListSSHAccessGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessAPIListSSHAccessGrantsCall) Return(arg0 []params.SSHAccessGrant, arg1 error) *MockSSHAccessAPIListSSHAccessGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessAPIListSSHAccessGrantsCall) Do(f func(context.Context) ([]params.SSHAccessGrant, error)) *MockSSHAccessAPIListSSHAccessGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessAPIListSSHAccessGrantsCall) DoAndReturn(f func(context.Context) ([]params.SSHAccessGrant, error)) *MockSSHAccessAPIListSSHAccessGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RejectSSHAccess mocks base method.
+func (m *MockSSHAccessAPI) RejectSSHAccess(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectSSHAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectSSHAccess indicates an expected call of RejectSSHAccess.
+func (mr *MockSSHAccessAPIMockRecorder) RejectSSHAccess(arg0, arg1 any) *MockSSHAccessAPIRejectSSHAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectSSHAccess", reflect.TypeOf((*MockSSHAccessAPI)(nil).RejectSSHAccess), arg0, arg1)
+	return &MockSSHAccessAPIRejectSSHAccessCall{Call: call}
+}
+
+// MockSSHAccessAPIRejectSSHAccessCall wrap *gomock.Call
+type MockSSHAccessAPIRejectSSHAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessAPIRejectSSHAccessCall) Return(arg0 error) *MockSSHAccessAPIRejectSSHAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessAPIRejectSSHAccessCall) Do(f func(context.Context, string) error) *MockSSHAccessAPIRejectSSHAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessAPIRejectSSHAccessCall) DoAndReturn(f func(context.Context, string) error) *MockSSHAccessAPIRejectSSHAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequestSSHAccess mocks base method.
+func (m *MockSSHAccessAPI) RequestSSHAccess(arg0 context.Context, arg1 string, arg2 *string, arg3 string, arg4 time.Duration) (params.SSHAccessGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestSSHAccess", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(params.SSHAccessGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestSSHAccess indicates an expected call of RequestSSHAccess.
+func (mr *MockSSHAccessAPIMockRecorder) RequestSSHAccess(arg0, arg1, arg2, arg3, arg4 any) *MockSSHAccessAPIRequestSSHAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestSSHAccess", reflect.TypeOf((*MockSSHAccessAPI)(nil).RequestSSHAccess), arg0, arg1, arg2, arg3, arg4)
+	return &MockSSHAccessAPIRequestSSHAccessCall{Call: call}
+}
+
+// MockSSHAccessAPIRequestSSHAccessCall wrap *gomock.Call
+type MockSSHAccessAPIRequestSSHAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessAPIRequestSSHAccessCall) Return(arg0 params.SSHAccessGrant, arg1 error) *MockSSHAccessAPIRequestSSHAccessCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessAPIRequestSSHAccessCall) Do(f func(context.Context, string, *string, string, time.Duration) (params.SSHAccessGrant, error)) *MockSSHAccessAPIRequestSSHAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessAPIRequestSSHAccessCall) DoAndReturn(f func(context.Context, string, *string, string, time.Duration) (params.SSHAccessGrant, error)) *MockSSHAccessAPIRequestSSHAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeSSHAccess mocks base method.
+func (m *MockSSHAccessAPI) RevokeSSHAccess(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSSHAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSSHAccess indicates an expected call of RevokeSSHAccess.
+func (mr *MockSSHAccessAPIMockRecorder) RevokeSSHAccess(arg0, arg1 any) *MockSSHAccessAPIRevokeSSHAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSSHAccess", reflect.TypeOf((*MockSSHAccessAPI)(nil).RevokeSSHAccess), arg0, arg1)
+	return &MockSSHAccessAPIRevokeSSHAccessCall{Call: call}
+}
+
+// MockSSHAccessAPIRevokeSSHAccessCall wrap *gomock.Call
+type MockSSHAccessAPIRevokeSSHAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessAPIRevokeSSHAccessCall) Return(arg0 error) *MockSSHAccessAPIRevokeSSHAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessAPIRevokeSSHAccessCall) Do(f func(context.Context, string) error) *MockSSHAccessAPIRevokeSSHAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessAPIRevokeSSHAccessCall) DoAndReturn(f func(context.Context, string) error) *MockSSHAccessAPIRevokeSSHAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSSHCertificatesAPI is a mock of SSHCertificatesAPI interface.
+type MockSSHCertificatesAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHCertificatesAPIMockRecorder
+}
+
+// MockSSHCertificatesAPIMockRecorder is the mock recorder for MockSSHCertificatesAPI.
+type MockSSHCertificatesAPIMockRecorder struct {
+	mock *MockSSHCertificatesAPI
+}
+
+// NewMockSSHCertificatesAPI creates a new mock instance.
+func NewMockSSHCertificatesAPI(ctrl *gomock.Controller) *MockSSHCertificatesAPI {
+	mock := &MockSSHCertificatesAPI{ctrl: ctrl}
+	mock.recorder = &MockSSHCertificatesAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHCertificatesAPI) EXPECT() *MockSSHCertificatesAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSSHCertificatesAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSSHCertificatesAPIMockRecorder) Close() *MockSSHCertificatesAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSSHCertificatesAPI)(nil).Close))
+	return &MockSSHCertificatesAPICloseCall{Call: call}
+}
+
+// MockSSHCertificatesAPICloseCall wrap *gomock.Call
+type MockSSHCertificatesAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCertificatesAPICloseCall) Return(arg0 error) *MockSSHCertificatesAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCertificatesAPICloseCall) Do(f func() error) *MockSSHCertificatesAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCertificatesAPICloseCall) DoAndReturn(f func() error) *MockSSHCertificatesAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IssueSSHCertificate mocks base method.
+func (m *MockSSHCertificatesAPI) IssueSSHCertificate(arg0 context.Context, arg1 string) (params.SSHCertificateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueSSHCertificate", arg0, arg1)
+	ret0, _ := ret[0].(params.SSHCertificateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueSSHCertificate indicates an expected call of IssueSSHCertificate.
+func (mr *MockSSHCertificatesAPIMockRecorder) IssueSSHCertificate(arg0, arg1 any) *MockSSHCertificatesAPIIssueSSHCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueSSHCertificate", reflect.TypeOf((*MockSSHCertificatesAPI)(nil).IssueSSHCertificate), arg0, arg1)
+	return &MockSSHCertificatesAPIIssueSSHCertificateCall{Call: call}
+}
+
+// MockSSHCertificatesAPIIssueSSHCertificateCall wrap *gomock.Call
+type MockSSHCertificatesAPIIssueSSHCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCertificatesAPIIssueSSHCertificateCall) Return(arg0 params.SSHCertificateResult, arg1 error) *MockSSHCertificatesAPIIssueSSHCertificateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCertificatesAPIIssueSSHCertificateCall) Do(f func(context.Context, string) (params.SSHCertificateResult, error)) *MockSSHCertificatesAPIIssueSSHCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCertificatesAPIIssueSSHCertificateCall) DoAndReturn(f func(context.Context, string) (params.SSHCertificateResult, error)) *MockSSHCertificatesAPIIssueSSHCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSSHCertificates mocks base method.
+func (m *MockSSHCertificatesAPI) ListSSHCertificates(arg0 context.Context, arg1 string, arg2 bool) ([]params.SSHCertificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSSHCertificates", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]params.SSHCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSSHCertificates indicates an expected call of ListSSHCertificates.
+func (mr *MockSSHCertificatesAPIMockRecorder) ListSSHCertificates(arg0, arg1, arg2 any) *MockSSHCertificatesAPIListSSHCertificatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHCertificates", reflect.TypeOf((*MockSSHCertificatesAPI)(nil).ListSSHCertificates), arg0, arg1, arg2)
+	return &MockSSHCertificatesAPIListSSHCertificatesCall{Call: call}
+}
+
+// MockSSHCertificatesAPIListSSHCertificatesCall wrap *gomock.Call
+type MockSSHCertificatesAPIListSSHCertificatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCertificatesAPIListSSHCertificatesCall) Return(arg0 []params.SSHCertificate, arg1 error) *MockSSHCertificatesAPIListSSHCertificatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCertificatesAPIListSSHCertificatesCall) Do(f func(context.Context, string, bool) ([]params.SSHCertificate, error)) *MockSSHCertificatesAPIListSSHCertificatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCertificatesAPIListSSHCertificatesCall) DoAndReturn(f func(context.Context, string, bool) ([]params.SSHCertificate, error)) *MockSSHCertificatesAPIListSSHCertificatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeSSHCertificate mocks base method.
+func (m *MockSSHCertificatesAPI) RevokeSSHCertificate(arg0 context.Context, arg1 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSSHCertificate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSSHCertificate indicates an expected call of RevokeSSHCertificate.
+func (mr *MockSSHCertificatesAPIMockRecorder) RevokeSSHCertificate(arg0, arg1 any) *MockSSHCertificatesAPIRevokeSSHCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSSHCertificate", reflect.TypeOf((*MockSSHCertificatesAPI)(nil).RevokeSSHCertificate), arg0, arg1)
+	return &MockSSHCertificatesAPIRevokeSSHCertificateCall{Call: call}
+}
+
+// MockSSHCertificatesAPIRevokeSSHCertificateCall wrap *gomock.Call
+type MockSSHCertificatesAPIRevokeSSHCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCertificatesAPIRevokeSSHCertificateCall) Return(arg0 error) *MockSSHCertificatesAPIRevokeSSHCertificateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCertificatesAPIRevokeSSHCertificateCall) Do(f func(context.Context, uint64) error) *MockSSHCertificatesAPIRevokeSSHCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCertificatesAPIRevokeSSHCertificateCall) DoAndReturn(f func(context.Context, uint64) error) *MockSSHCertificatesAPIRevokeSSHCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeUserSSHCertificates mocks base method.
+func (m *MockSSHCertificatesAPI) RevokeUserSSHCertificates(arg0 context.Context, arg1 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSSHCertificates", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUserSSHCertificates indicates an expected call of RevokeUserSSHCertificates.
+func (mr *MockSSHCertificatesAPIMockRecorder) RevokeUserSSHCertificates(arg0, arg1 any) *MockSSHCertificatesAPIRevokeUserSSHCertificatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSSHCertificates", reflect.TypeOf((*MockSSHCertificatesAPI)(nil).RevokeUserSSHCertificates), arg0, arg1)
+	return &MockSSHCertificatesAPIRevokeUserSSHCertificatesCall{Call: call}
+}
+
+// MockSSHCertificatesAPIRevokeUserSSHCertificatesCall wrap *gomock.Call
+type MockSSHCertificatesAPIRevokeUserSSHCertificatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHCertificatesAPIRevokeUserSSHCertificatesCall) Return(arg0 int, arg1 error) *MockSSHCertificatesAPIRevokeUserSSHCertificatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHCertificatesAPIRevokeUserSSHCertificatesCall) Do(f func(context.Context, string) (int, error)) *MockSSHCertificatesAPIRevokeUserSSHCertificatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHCertificatesAPIRevokeUserSSHCertificatesCall) DoAndReturn(f func(context.Context, string) (int, error)) *MockSSHCertificatesAPIRevokeUserSSHCertificatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockJumpServerAPI is a mock of JumpServerAPI interface.
+type MockJumpServerAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockJumpServerAPIMockRecorder
+}
+
+// MockJumpServerAPIMockRecorder is the mock recorder for MockJumpServerAPI.
+type MockJumpServerAPIMockRecorder struct {
+	mock *MockJumpServerAPI
+}
+
+// NewMockJumpServerAPI creates a new mock instance.
+func NewMockJumpServerAPI(ctrl *gomock.Controller) *MockJumpServerAPI {
+	mock := &MockJumpServerAPI{ctrl: ctrl}
+	mock.recorder = &MockJumpServerAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJumpServerAPI) EXPECT() *MockJumpServerAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockJumpServerAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockJumpServerAPIMockRecorder) Close() *MockJumpServerAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockJumpServerAPI)(nil).Close))
+	return &MockJumpServerAPICloseCall{Call: call}
+}
+
+// MockJumpServerAPICloseCall wrap *gomock.Call
+type MockJumpServerAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJumpServerAPICloseCall) Return(arg0 error) *MockJumpServerAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJumpServerAPICloseCall) Do(f func() error) *MockJumpServerAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJumpServerAPICloseCall) DoAndReturn(f func() error) *MockJumpServerAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHServerPort mocks base method.
+func (m *MockJumpServerAPI) SSHServerPort(arg0 context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHServerPort", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SSHServerPort indicates an expected call of SSHServerPort.
+func (mr *MockJumpServerAPIMockRecorder) SSHServerPort(arg0 any) *MockJumpServerAPISSHServerPortCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHServerPort", reflect.TypeOf((*MockJumpServerAPI)(nil).SSHServerPort), arg0)
+	return &MockJumpServerAPISSHServerPortCall{Call: call}
+}
+
+// MockJumpServerAPISSHServerPortCall wrap *gomock.Call
+type MockJumpServerAPISSHServerPortCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJumpServerAPISSHServerPortCall) Return(arg0 int, arg1 error) *MockJumpServerAPISSHServerPortCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJumpServerAPISSHServerPortCall) Do(f func(context.Context) (int, error)) *MockJumpServerAPISSHServerPortCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJumpServerAPISSHServerPortCall) DoAndReturn(f func(context.Context) (int, error)) *MockJumpServerAPISSHServerPortCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// VirtualHostname mocks base method.
+func (m *MockJumpServerAPI) VirtualHostname(arg0 context.Context, arg1 string, arg2 *string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualHostname", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VirtualHostname indicates an expected call of VirtualHostname.
+func (mr *MockJumpServerAPIMockRecorder) VirtualHostname(arg0, arg1, arg2 any) *MockJumpServerAPIVirtualHostnameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualHostname", reflect.TypeOf((*MockJumpServerAPI)(nil).VirtualHostname), arg0, arg1, arg2)
+	return &MockJumpServerAPIVirtualHostnameCall{Call: call}
+}
+
+// MockJumpServerAPIVirtualHostnameCall wrap *gomock.Call
+type MockJumpServerAPIVirtualHostnameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockJumpServerAPIVirtualHostnameCall) Return(arg0 string, arg1 error) *MockJumpServerAPIVirtualHostnameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockJumpServerAPIVirtualHostnameCall) Do(f func(context.Context, string, *string) (string, error)) *MockJumpServerAPIVirtualHostnameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockJumpServerAPIVirtualHostnameCall) DoAndReturn(f func(context.Context, string, *string) (string, error)) *MockJumpServerAPIVirtualHostnameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockTargetDialer is a mock of TargetDialer interface.
+type MockTargetDialer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTargetDialerMockRecorder
+}
+
+// MockTargetDialerMockRecorder is the mock recorder for MockTargetDialer.
+type MockTargetDialerMockRecorder struct {
+	mock *MockTargetDialer
+}
+
+// NewMockTargetDialer creates a new mock instance.
+func NewMockTargetDialer(ctrl *gomock.Controller) *MockTargetDialer {
+	mock := &MockTargetDialer{ctrl: ctrl}
+	mock.recorder = &MockTargetDialerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTargetDialer) EXPECT() *MockTargetDialerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockTargetDialer) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockTargetDialerMockRecorder) Close() *MockTargetDialerCloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTargetDialer)(nil).Close))
+	return &MockTargetDialerCloseCall{Call: call}
+}
+
+// MockTargetDialerCloseCall wrap *gomock.Call
+type MockTargetDialerCloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTargetDialerCloseCall) Return(arg0 error) *MockTargetDialerCloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTargetDialerCloseCall) Do(f func() error) *MockTargetDialerCloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTargetDialerCloseCall) DoAndReturn(f func() error) *MockTargetDialerCloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DialTarget mocks base method.
+func (m *MockTargetDialer) DialTarget(arg0, arg1 string) (net.Conn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DialTarget", arg0, arg1)
+	ret0, _ := ret[0].(net.Conn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DialTarget indicates an expected call of DialTarget.
+func (mr *MockTargetDialerMockRecorder) DialTarget(arg0, arg1 any) *MockTargetDialerDialTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DialTarget", reflect.TypeOf((*MockTargetDialer)(nil).DialTarget), arg0, arg1)
+	return &MockTargetDialerDialTargetCall{Call: call}
+}
+
+// MockTargetDialerDialTargetCall wrap *gomock.Call
+type MockTargetDialerDialTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTargetDialerDialTargetCall) Return(arg0 net.Conn, arg1 error) *MockTargetDialerDialTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTargetDialerDialTargetCall) Do(f func(string, string) (net.Conn, error)) *MockTargetDialerDialTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTargetDialerDialTargetCall) DoAndReturn(f func(string, string) (net.Conn, error)) *MockTargetDialerDialTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
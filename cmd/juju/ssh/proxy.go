@@ -0,0 +1,158 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// defaultProxyPort is the port the SOCKS proxy listens on by default.
+const defaultProxyPort = 1080
+
+const proxyDoc = `
+Runs a SOCKS proxy on the local machine which tunnels connections through the
+controller's ssh server to the units and machines of the model, until
+interrupted. The proxy listens on 127.0.0.1, on port 1080 unless another is
+given.
+
+Hosts requested through the proxy are virtual hostnames, which are dialed
+from the target they name, so that ports listening only on the target's
+loopback interface are reachable. Within the current model, a machine is
+named by its number, a unit by its number followed by its application, and a
+container by its name followed by its unit, e.g. 0, 0.mysql and
+charm.0.mysql. Targets in other models are named by their full virtual
+hostname.
+
+Proxying requires the "ssh-port-forwarding" controller configuration key to
+be enabled. Port forwarding to k8s units is not supported.
+`
+
+const proxyExamples = `
+    juju proxy
+    juju proxy --port 9050
+
+Fetch a page served on port 8080 of the mysql/0 unit:
+
+    curl --socks5-hostname localhost:1080 http://0.mysql:8080/
+`
+
+// NewProxyCommand returns a command to run a SOCKS proxy to the units and
+// machines of the model.
+func NewProxyCommand() cmd.Command {
+	c := &proxyCommand{
+		openJumpServerFunc: openJumpServer,
+	}
+	return modelcmd.Wrap(c)
+}
+
+// proxyCommand runs a SOCKS proxy tunnelling connections through the
+// controller's ssh server.
+type proxyCommand struct {
+	modelcmd.ModelCommandBase
+
+	port int
+
+	openJumpServerFunc func(context.Context, ModelCommand) (JumpServerAPI, TargetDialer, error)
+}
+
+// Info implements cmd.Command.
+func (c *proxyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "proxy",
+		Purpose:  "Runs a SOCKS proxy to the units and machines of the model.",
+		Doc:      proxyDoc,
+		Examples: proxyExamples,
+		SeeAlso: []string{
+			"ssh",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *proxyCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.IntVar(&c.port, "port", defaultProxyPort, "The local port to listen on")
+}
+
+// Init implements cmd.Command.
+func (c *proxyCommand) Init(args []string) error {
+	if _, err := parsePort(strconv.Itoa(c.port)); err != nil {
+		return errors.Trace(err)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.
+func (c *proxyCommand) Run(ctx *cmd.Context) error {
+	_, details, err := c.ModelDetails(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	modelUUID := details.ModelUUID
+
+	api, dialer, err := c.openJumpServerFunc(ctx, &c.ModelCommandBase)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = dialer.Close()
+		_ = api.Close()
+	}()
+
+	l, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(c.port)))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer l.Close()
+	ctx.Infof("SOCKS proxy listening on %s", l.Addr())
+
+	dial := func(host string, port int) (net.Conn, error) {
+		hostname, err := proxyTargetHostname(host, modelUUID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return dialer.DialTarget(hostname, net.JoinHostPort("localhost", strconv.Itoa(port)))
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		serveConns(l, func(conn net.Conn) {
+			if err := serveSOCKS(conn, dial); err != nil {
+				logger.Warningf(ctx, "proxying connection from %s: %v", conn.RemoteAddr(), err)
+			}
+		})
+	}()
+
+	waitForInterrupt(ctx)
+	_ = l.Close()
+	wg.Wait()
+	return nil
+}
+
+// proxyTargetHostname returns the virtual hostname of the host requested
+// through the proxy, which is either a full virtual hostname or one relative
+// to the model.
+func proxyTargetHostname(host, modelUUID string) (string, error) {
+	if _, err := virtualhostname.Parse(host); err == nil {
+		return host, nil
+	}
+	hostname := host + "." + modelUUID + "." + virtualhostname.Domain
+	if _, err := virtualhostname.Parse(hostname); err != nil {
+		return "", errors.NotValidf("target %q", host)
+	}
+	return hostname, nil
+}
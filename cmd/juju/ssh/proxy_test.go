@@ -0,0 +1,183 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/net/proxy"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type proxySuite struct {
+	jujutesting.IsolationSuite
+
+	jumpServer   *mocks.MockJumpServerAPI
+	targetDialer *mocks.MockTargetDialer
+}
+
+var _ = gc.Suite(&proxySuite{})
+
+func (s *proxySuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.jumpServer = mocks.NewMockJumpServerAPI(ctrl)
+	s.targetDialer = mocks.NewMockTargetDialer(ctrl)
+	return ctrl
+}
+
+func (s *proxySuite) TestProxyTargetHostname(c *gc.C) {
+	const modelUUID = "8419cd78-4993-4c3a-928e-c646226beeee"
+	for i, t := range []struct {
+		host     string
+		expected string
+		err      string
+	}{{
+		host:     "0",
+		expected: "0.8419cd78-4993-4c3a-928e-c646226beeee.juju.local",
+	}, {
+		host:     "0.mysql",
+		expected: "0.mysql.8419cd78-4993-4c3a-928e-c646226beeee.juju.local",
+	}, {
+		host:     "charm.0.mysql",
+		expected: "charm.0.mysql.8419cd78-4993-4c3a-928e-c646226beeee.juju.local",
+	}, {
+		host:     "1.postgresql.c3b23cd4-1b7e-4a5a-8e7c-8c4b3e1b3b3b.juju.local",
+		expected: "1.postgresql.c3b23cd4-1b7e-4a5a-8e7c-8c4b3e1b3b3b.juju.local",
+	}, {
+		host: "example.com",
+		err:  `target "example.com" not valid`,
+	}, {
+		host: "10.0.0.5",
+		err:  `target "10.0.0.5" not valid`,
+	}} {
+		c.Logf("test %d: %s", i, t.host)
+		hostname, err := proxyTargetHostname(t.host, modelUUID)
+		if t.err != "" {
+			c.Check(err, gc.ErrorMatches, t.err)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(hostname, gc.Equals, t.expected)
+	}
+}
+
+func (s *proxySuite) TestServeSOCKS(c *gc.C) {
+	serverConn, clientConn := net.Pipe()
+	targetConn, remoteConn := net.Pipe()
+	go echo(remoteConn)
+
+	var dialed string
+	done := make(chan error, 1)
+	go func() {
+		done <- serveSOCKS(serverConn, func(host string, port int) (net.Conn, error) {
+			dialed = net.JoinHostPort(host, strconv.Itoa(port))
+			return targetConn, nil
+		})
+	}()
+
+	conn := socksDial(c, clientConn, "0.mysql:8080")
+	checkEcho(c, conn)
+
+	select {
+	case err := <-done:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for the connection to be served")
+	}
+	c.Check(dialed, gc.Equals, "0.mysql:8080")
+}
+
+func (s *proxySuite) TestServeSOCKSDialError(c *gc.C) {
+	serverConn, clientConn := net.Pipe()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- serveSOCKS(serverConn, func(host string, port int) (net.Conn, error) {
+			return nil, errors.New("boom")
+		})
+	}()
+
+	dialer, err := proxy.SOCKS5("tcp", "proxy", nil, pipeDialer{conn: clientConn})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = dialer.Dial("tcp", "0.mysql:8080")
+	c.Check(err, gc.ErrorMatches, ".*host unreachable")
+
+	select {
+	case err := <-done:
+		c.Assert(err, gc.ErrorMatches, `dialing 0.mysql:8080: boom`)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for the connection to be served")
+	}
+}
+
+func (s *proxySuite) TestProxy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	port := freePort(c)
+	targetConn, remoteConn := net.Pipe()
+	go echo(remoteConn)
+
+	s.jumpServer.EXPECT().Close().Return(nil)
+	s.targetDialer.EXPECT().DialTarget(gomock.Any(), "localhost:8080").DoAndReturn(func(hostname, addr string) (net.Conn, error) {
+		c.Check(strings.HasPrefix(hostname, "0.mysql."), jc.IsTrue)
+		c.Check(strings.HasSuffix(hostname, ".juju.local"), jc.IsTrue)
+		return targetConn, nil
+	})
+	s.targetDialer.EXPECT().Close().Return(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmdCtx := cmdtesting.Context(c).With(ctx)
+	errc := cmdtesting.RunCommandWithContext(cmdCtx, NewProxyCommandForTest(s.jumpServer, s.targetDialer),
+		"--port", strconv.Itoa(port))
+
+	proxyConn := dialRetry(c, net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	conn := socksDial(c, proxyConn, "0.mysql:8080")
+	checkEcho(c, conn)
+
+	cancel()
+	select {
+	case err := <-errc:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for the command to stop")
+	}
+	c.Check(cmdtesting.Stderr(cmdCtx), gc.Equals, "SOCKS proxy listening on 127.0.0.1:"+strconv.Itoa(port)+"\n")
+}
+
+func (s *proxySuite) TestProxyInvalidPort(c *gc.C) {
+	err := cmdtesting.InitCommand(NewProxyCommandForTest(nil, nil), []string{"--port", "0"})
+	c.Assert(err, gc.ErrorMatches, `port "0" not valid`)
+}
+
+// pipeDialer is a proxy.Dialer returning the connection it holds.
+type pipeDialer struct {
+	conn net.Conn
+}
+
+func (d pipeDialer) Dial(_, _ string) (net.Conn, error) {
+	return d.conn, nil
+}
+
+// socksDial negotiates a SOCKS connection to the address over the
+// connection to the proxy.
+func socksDial(c *gc.C, proxyConn net.Conn, addr string) net.Conn {
+	dialer, err := proxy.SOCKS5("tcp", "proxy", nil, pipeDialer{conn: proxyConn})
+	c.Assert(err, jc.ErrorIsNil)
+	conn, err := dialer.Dial("tcp", addr)
+	c.Assert(err, jc.ErrorIsNil)
+	return conn
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/sshclient"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/ssh/recording"
+)
+
+const replaySSHSessionDoc = `
+Plays back a recorded ssh session to a unit or machine of the model, with
+the same timing as the original session. The output of the session is
+written to the terminal; the input typed by the user is not recorded.
+
+Long pauses in the session are shortened to --max-idle. A pause of 0 plays
+the session back with its original timing.
+
+Use --output to save the recording to a file instead, in asciicast v2
+format, which can be played back by other tools such as asciinema.
+`
+
+const replaySSHSessionExamples = `
+    juju ssh-sessions replay 9f2e3b5c-4b1e-4c5e-8d35-2b0b0d0e7c11
+    juju ssh-sessions replay 9f2e3b5c-4b1e-4c5e-8d35-2b0b0d0e7c11 --max-idle 0
+    juju ssh-sessions replay 9f2e3b5c-4b1e-4c5e-8d35-2b0b0d0e7c11 --output session.cast
+`
+
+// NewReplaySSHSessionCommand returns a command to play back a recorded ssh
+// session.
+func NewReplaySSHSessionCommand() cmd.Command {
+	c := &replaySSHSessionCommand{
+		clock: clock.WallClock,
+	}
+	c.sshSessionsAPIFunc = c.sshSessionsAPI
+	return modelcmd.Wrap(c)
+}
+
+type replaySSHSessionCommand struct {
+	modelcmd.ModelCommandBase
+
+	sshSessionsAPIFunc func(ctx context.Context) (SSHSessionsAPI, error)
+	clock              clock.Clock
+
+	sessionID  string
+	maxIdle    time.Duration
+	outputPath string
+}
+
+func (c *replaySSHSessionCommand) sshSessionsAPI(ctx context.Context) (SSHSessionsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sshclient.NewFacade(root), nil
+}
+
+// Info implements cmd.Command.
+func (c *replaySSHSessionCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "replay",
+		Args:     "<session ID>",
+		Purpose:  "Plays back a recorded ssh session.",
+		Doc:      replaySSHSessionDoc,
+		Examples: replaySSHSessionExamples,
+		SeeAlso: []string{
+			"ssh",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *replaySSHSessionCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.DurationVar(&c.maxIdle, "max-idle", 2*time.Second, "The longest pause to play back")
+	f.StringVar(&c.outputPath, "o", "", "Save the recording to the specified file instead of playing it back")
+	f.StringVar(&c.outputPath, "output", "", "")
+}
+
+// Init implements cmd.Command.
+func (c *replaySSHSessionCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no session ID specified")
+	}
+	c.sessionID = args[0]
+	if c.maxIdle < 0 {
+		return errors.NotValidf("negative --max-idle")
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *replaySSHSessionCommand) Run(ctx *cmd.Context) error {
+	api, err := c.sshSessionsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	rc, err := api.SSHSessionRecording(ctx, c.sessionID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer rc.Close()
+
+	if c.outputPath != "" {
+		path := ctx.AbsPath(c.outputPath)
+		if err := saveRecording(path, rc); err != nil {
+			return errors.Annotate(err, "saving recording")
+		}
+		ctx.Infof("Recording of session %s saved to %s", c.sessionID, path)
+		return nil
+	}
+
+	reader, err := recording.NewReader(rc)
+	if err != nil {
+		return errors.Annotatef(err, "reading recording of session %s", c.sessionID)
+	}
+	exitCode, err := recording.Play(ctx, reader, ctx.Stdout, c.clock, c.maxIdle)
+	if err != nil {
+		return errors.Annotatef(err, "playing recording of session %s", c.sessionID)
+	}
+	if exitCode >= 0 {
+		ctx.Infof("Session exited with code %d", exitCode)
+	}
+	return nil
+}
+
+// saveRecording streams the recording to the file at path.
+func saveRecording(path string, r io.Reader) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return errors.Trace(err)
+	}
+	return errors.Trace(f.Close())
+}
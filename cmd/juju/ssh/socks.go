@@ -0,0 +1,135 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/juju/errors"
+)
+
+// The SOCKS version 5 protocol constants used, as specified in RFC 1928.
+const (
+	socksVersion5 = 0x05
+
+	socksAuthNone         = 0x00
+	socksAuthNoAcceptable = 0xff
+
+	socksCmdConnect = 0x01
+
+	socksAddrIPv4   = 0x01
+	socksAddrDomain = 0x03
+	socksAddrIPv6   = 0x04
+
+	socksReplySucceeded           = 0x00
+	socksReplyHostUnreachable     = 0x04
+	socksReplyCommandNotSupported = 0x07
+	socksReplyAddrNotSupported    = 0x08
+)
+
+// socksDialFunc dials the host and port requested by a SOCKS client.
+type socksDialFunc func(host string, port int) (net.Conn, error)
+
+// serveSOCKS negotiates a SOCKS version 5 CONNECT request on the connection
+// without authentication, dials the requested address and then copies data
+// in both directions until either side is closed.
+func serveSOCKS(conn net.Conn, dial socksDialFunc) error {
+	defer conn.Close()
+
+	host, port, err := readSOCKSRequest(conn)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	target, err := dial(host, port)
+	if err != nil {
+		_ = writeSOCKSReply(conn, socksReplyHostUnreachable)
+		return errors.Annotatef(err, "dialing %s", net.JoinHostPort(host, strconv.Itoa(port)))
+	}
+	defer target.Close()
+	if err := writeSOCKSReply(conn, socksReplySucceeded); err != nil {
+		return errors.Trace(err)
+	}
+	proxyConns(conn, target)
+	return nil
+}
+
+// readSOCKSRequest reads the client's greeting and CONNECT request,
+// replying to the client when the request can't be served.
+func readSOCKSRequest(conn net.Conn) (string, int, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", 0, errors.Annotate(err, "reading greeting")
+	}
+	if header[0] != socksVersion5 {
+		return "", 0, errors.NotSupportedf("SOCKS version %d", header[0])
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", 0, errors.Annotate(err, "reading authentication methods")
+	}
+	method := byte(socksAuthNoAcceptable)
+	for _, m := range methods {
+		if m == socksAuthNone {
+			method = socksAuthNone
+			break
+		}
+	}
+	if _, err := conn.Write([]byte{socksVersion5, method}); err != nil {
+		return "", 0, errors.Trace(err)
+	}
+	if method == socksAuthNoAcceptable {
+		return "", 0, errors.NotSupportedf("SOCKS authentication")
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", 0, errors.Annotate(err, "reading request")
+	}
+	if request[1] != socksCmdConnect {
+		_ = writeSOCKSReply(conn, socksReplyCommandNotSupported)
+		return "", 0, errors.NotSupportedf("SOCKS command %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddrIPv4, socksAddrIPv6:
+		ip := make(net.IP, net.IPv4len)
+		if request[3] == socksAddrIPv6 {
+			ip = make(net.IP, net.IPv6len)
+		}
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", 0, errors.Annotate(err, "reading address")
+		}
+		host = ip.String()
+	case socksAddrDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", 0, errors.Annotate(err, "reading address")
+		}
+		domain := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", 0, errors.Annotate(err, "reading address")
+		}
+		host = string(domain)
+	default:
+		_ = writeSOCKSReply(conn, socksReplyAddrNotSupported)
+		return "", 0, errors.NotSupportedf("SOCKS address type %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", 0, errors.Annotate(err, "reading port")
+	}
+	return host, int(binary.BigEndian.Uint16(port)), nil
+}
+
+// writeSOCKSReply writes a reply with the status to the client. The bound
+// address is left unspecified, as the connection is made remotely.
+func writeSOCKSReply(conn net.Conn, status byte) error {
+	_, err := conn.Write([]byte{socksVersion5, status, 0x00, socksAddrIPv4, 0, 0, 0, 0, 0, 0})
+	return errors.Trace(err)
+}
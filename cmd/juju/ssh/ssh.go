@@ -69,6 +69,15 @@ container in the pod. For charms which run the workload in a separate pod
 to that of the charm, the default ssh target is the charm operator pod.
 The workload pod may be specified using the --remote argument.
 
+The --forward option forwards a local port to a port reachable from the
+target, tunnelling the connections through the controller's ssh server, until
+interrupted. The forward is given as [<local port>:][<remote host>:]<remote port>,
+where the local port defaults to the remote port and the remote host to
+localhost, as seen from the target. Local ports are bound to 127.0.0.1, and
+the option may be repeated. No command or ssh options may be given with it.
+Forwarding requires the "ssh-port-forwarding" controller configuration key
+to be enabled.
+
 `
 
 const usageSSHExamples = `
@@ -131,6 +140,16 @@ Connect to the api server pod:
 Connect to the mongo db pod:
 
     juju ssh --container mongodb 0
+
+**Forwarding ports:**
+
+Forward local port 5432 to the postgresql port of a unit:
+
+    juju ssh --forward 5432 postgresql/0
+
+Forward local port 8080 to port 80 of a host reachable from machine 0:
+
+    juju ssh --forward 8080:10.0.0.5:80 0
 `
 
 const (
@@ -153,6 +172,7 @@ func NewSSHCommand(
 		isTerminal:             isTerminal,
 		retryStrategy:          retryStrategy,
 		publicKeyRetryStrategy: publicKeyRetryStrategy,
+		openJumpServerFunc:     openJumpServer,
 	}
 	return modelcmd.Wrap(c)
 }
@@ -187,9 +207,19 @@ type sshCommand struct {
 
 	retryStrategy          retry.CallArgs
 	publicKeyRetryStrategy retry.CallArgs
+
+	forwards           portForwards
+	openJumpServerFunc func(context.Context, ModelCommand) (JumpServerAPI, TargetDialer, error)
 }
 
 func (c *sshCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.setSessionFlags(f)
+	f.Var(&c.forwards, "forward", "Forward a local port to [<local port>:][<remote host>:]<remote port> from the target, may be repeated")
+}
+
+// setSessionFlags sets the flags common to the commands opening an ssh
+// session on the target.
+func (c *sshCommand) setSessionFlags(f *gnuflag.FlagSet) {
 	c.sshMachine.SetFlags(f)
 	c.sshContainer.SetFlags(f)
 	f.Var(&c.pty, "pty", "Enable pseudo-tty allocation")
@@ -212,6 +242,9 @@ func (c *sshCommand) Init(args []string) (err error) {
 	if len(args) == 0 {
 		return errors.Errorf("no target name specified")
 	}
+	if len(c.forwards) > 0 && len(args) > 1 {
+		return errors.Errorf("cannot run a command or pass ssh options when forwarding ports")
+	}
 	if c.modelType, err = c.ModelType(context.TODO()); err != nil {
 		return err
 	}
@@ -263,6 +296,9 @@ type sshProvider interface {
 // Run resolves the given target to a machine or unit, then opens
 // an SSH connection to this target.
 func (c *sshCommand) Run(ctx *cmd.Context) error {
+	if len(c.forwards) > 0 {
+		return c.runForwards(ctx)
+	}
 	if err := c.provider.initRun(ctx.Context, &c.ModelCommandBase); err != nil {
 		return errors.Trace(err)
 	}
@@ -0,0 +1,396 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/sshclient"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SSHAccessAPI defines the APIs used to request, list, approve, reject and
+// revoke time-bounded ssh access grants.
+type SSHAccessAPI interface {
+	RequestSSHAccess(ctx context.Context, target string, container *string, reason string, duration time.Duration) (params.SSHAccessGrant, error)
+	ListSSHAccessGrants(ctx context.Context) ([]params.SSHAccessGrant, error)
+	ApproveSSHAccess(ctx context.Context, uuid string) error
+	RejectSSHAccess(ctx context.Context, uuid string) error
+	RevokeSSHAccess(ctx context.Context, uuid string) error
+	Close() error
+}
+
+// sshAccessCommandBase holds what is common to the ssh access commands.
+type sshAccessCommandBase struct {
+	modelcmd.ModelCommandBase
+
+	sshAccessAPIFunc func(ctx context.Context) (SSHAccessAPI, error)
+}
+
+func (c *sshAccessCommandBase) sshAccessAPI(ctx context.Context) (SSHAccessAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sshclient.NewFacade(root), nil
+}
+
+const requestSSHAccessDoc = `
+Requests time-bounded ssh access to a unit or machine of the model.
+
+While the "ssh-access-grants-required" controller configuration key is
+enabled, ssh sessions through the controller to a unit or machine are only
+accepted from users holding an approved grant for it. Sessions are terminated
+when the grant expires or is revoked.
+
+Requests for no longer than the "ssh-access-grant-auto-approve-duration"
+controller configuration key are approved straight away. Other requests remain
+pending until a model admin approves them with ` + "`juju approve-ssh-access`" + `.
+Access is granted for the requested duration from the time of approval, up to
+a maximum of 24 hours.
+`
+
+const requestSSHAccessExamples = `
+    juju request-ssh-access mysql/0 --duration 1h --reason "investigating INC-42"
+    juju request-ssh-access 2 --duration 30m
+    juju request-ssh-access postgresql/0 --container charm --duration 15m
+`
+
+// NewRequestSSHAccessCommand returns a command to request an ssh access grant.
+func NewRequestSSHAccessCommand() cmd.Command {
+	c := &requestSSHAccessCommand{}
+	c.sshAccessAPIFunc = c.sshAccessAPI
+	return modelcmd.Wrap(c)
+}
+
+type requestSSHAccessCommand struct {
+	sshAccessCommandBase
+	out cmd.Output
+
+	target    string
+	container string
+	reason    string
+	duration  time.Duration
+}
+
+// Info implements cmd.Command.
+func (c *requestSSHAccessCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "request-ssh-access",
+		Args:     "<unit or machine>",
+		Purpose:  "Requests time-bounded ssh access to a unit or machine.",
+		Doc:      requestSSHAccessDoc,
+		Examples: requestSSHAccessExamples,
+		SeeAlso: []string{
+			"ssh",
+			"ssh-access-grants",
+			"approve-ssh-access",
+			"revoke-ssh-access",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *requestSSHAccessCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
+	f.DurationVar(&c.duration, "duration", time.Hour, "How long access is granted for once approved")
+	f.StringVar(&c.reason, "reason", "", "Why access is needed")
+	f.StringVar(&c.container, "container", "", "The container of a Kubernetes unit to access")
+}
+
+// Init implements cmd.Command.
+func (c *requestSSHAccessCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no unit or machine specified")
+	}
+	c.target, args = args[0], args[1:]
+	if c.duration <= 0 {
+		return errors.NotValidf("duration %v", c.duration)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.
+func (c *requestSSHAccessCommand) Run(ctx *cmd.Context) error {
+	api, err := c.sshAccessAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	var container *string
+	if c.container != "" {
+		container = &c.container
+	}
+	grant, err := api.RequestSSHAccess(ctx, c.target, container, c.reason, c.duration)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if grant.Status == "pending" {
+		ctx.Infof("SSH access to %s requested; waiting for approval.", c.target)
+	}
+	return c.out.Write(ctx, newSSHAccessGrantDetails(grant))
+}
+
+const listSSHAccessGrantsDoc = `
+Lists the ssh access grants of the model.
+
+Model admins see every grant; other users see only their own. Approved grants
+which have expired are shown as expired.
+`
+
+const listSSHAccessGrantsExamples = `
+    juju ssh-access-grants
+    juju ssh-access-grants --format yaml
+`
+
+// NewListSSHAccessGrantsCommand returns a command to list ssh access grants.
+func NewListSSHAccessGrantsCommand() cmd.Command {
+	c := &listSSHAccessGrantsCommand{}
+	c.sshAccessAPIFunc = c.sshAccessAPI
+	return modelcmd.Wrap(c)
+}
+
+type listSSHAccessGrantsCommand struct {
+	sshAccessCommandBase
+	out cmd.Output
+}
+
+// Info implements cmd.Command.
+func (c *listSSHAccessGrantsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "ssh-access-grants",
+		Purpose:  "Lists the ssh access grants of the model.",
+		Doc:      listSSHAccessGrantsDoc,
+		Examples: listSSHAccessGrantsExamples,
+		Aliases:  []string{"list-ssh-access-grants"},
+		SeeAlso: []string{
+			"request-ssh-access",
+			"approve-ssh-access",
+			"reject-ssh-access",
+			"revoke-ssh-access",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *listSSHAccessGrantsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSSHAccessGrantsTabular,
+	})
+}
+
+// Run implements cmd.Command.
+func (c *listSSHAccessGrantsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.sshAccessAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	grants, err := api.ListSSHAccessGrants(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	details := make([]sshAccessGrantDetails, len(grants))
+	for i, grant := range grants {
+		details[i] = newSSHAccessGrantDetails(grant)
+	}
+	return c.out.Write(ctx, details)
+}
+
+type sshAccessGrantDetails struct {
+	ID          string     `json:"id" yaml:"id"`
+	User        string     `json:"user" yaml:"user"`
+	Target      string     `json:"target" yaml:"target"`
+	Reason      string     `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Duration    string     `json:"duration" yaml:"duration"`
+	Status      string     `json:"status" yaml:"status"`
+	RequestedAt time.Time  `json:"requested" yaml:"requested"`
+	DecidedBy   string     `json:"decided-by,omitempty" yaml:"decided-by,omitempty"`
+	DecidedAt   *time.Time `json:"decided,omitempty" yaml:"decided,omitempty"`
+	ExpiresAt   *time.Time `json:"expires,omitempty" yaml:"expires,omitempty"`
+}
+
+func newSSHAccessGrantDetails(grant params.SSHAccessGrant) sshAccessGrantDetails {
+	return sshAccessGrantDetails{
+		ID:          grant.UUID,
+		User:        grant.User,
+		Target:      grant.Target,
+		Reason:      grant.Reason,
+		Duration:    grant.Duration.String(),
+		Status:      grant.Status,
+		RequestedAt: grant.RequestedAt,
+		DecidedBy:   grant.DecidedBy,
+		DecidedAt:   grant.DecidedAt,
+		ExpiresAt:   grant.ExpiresAt,
+	}
+}
+
+// formatSSHAccessGrantsTabular writes a tabular summary of ssh access grants.
+func formatSSHAccessGrantsTabular(writer io.Writer, value interface{}) error {
+	grants, ok := value.([]sshAccessGrantDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", grants, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+
+	w.Println("ID", "User", "Target", "Status", "Duration", "Requested", "Expires", "Reason")
+	for _, g := range grants {
+		expires := "-"
+		if g.ExpiresAt != nil {
+			expires = common.FormatTime(g.ExpiresAt, true)
+		}
+		reason := g.Reason
+		if reason == "" {
+			reason = "-"
+		}
+		requested := common.FormatTime(&g.RequestedAt, true)
+		w.Println(g.ID, g.User, g.Target, g.Status, g.Duration, requested, expires, reason)
+	}
+	return tw.Flush()
+}
+
+// sshAccessDecision describes one of the commands which act on an existing
+// ssh access grant.
+type sshAccessDecision struct {
+	name     string
+	purpose  string
+	doc      string
+	examples string
+	done     string
+	decide   func(api SSHAccessAPI, ctx context.Context, uuid string) error
+}
+
+var (
+	approveSSHAccess = sshAccessDecision{
+		name:    "approve-ssh-access",
+		purpose: "Approves a pending ssh access request.",
+		doc: `
+Approves a pending request for ssh access to a unit or machine of the model.
+Access is granted from now for the duration of the request.
+
+Only model admins may approve requests, and not their own.
+`,
+		examples: `
+    juju approve-ssh-access 8d3a4bfb-5f53-4dc9-8d6b-bb3c2c7b1c51
+`,
+		done:   "SSH access %s approved.",
+		decide: SSHAccessAPI.ApproveSSHAccess,
+	}
+	rejectSSHAccess = sshAccessDecision{
+		name:    "reject-ssh-access",
+		purpose: "Rejects a pending ssh access request.",
+		doc: `
+Rejects a pending request for ssh access to a unit or machine of the model.
+
+Only model admins may reject requests.
+`,
+		examples: `
+    juju reject-ssh-access 8d3a4bfb-5f53-4dc9-8d6b-bb3c2c7b1c51
+`,
+		done:   "SSH access %s rejected.",
+		decide: SSHAccessAPI.RejectSSHAccess,
+	}
+	revokeSSHAccess = sshAccessDecision{
+		name:    "revoke-ssh-access",
+		purpose: "Revokes an ssh access grant.",
+		doc: `
+Revokes a pending or approved grant of ssh access to a unit or machine of the
+model. Sessions using the grant are terminated.
+
+Model admins may revoke any grant; other users may revoke their own.
+`,
+		examples: `
+    juju revoke-ssh-access 8d3a4bfb-5f53-4dc9-8d6b-bb3c2c7b1c51
+`,
+		done:   "SSH access %s revoked.",
+		decide: SSHAccessAPI.RevokeSSHAccess,
+	}
+)
+
+// NewApproveSSHAccessCommand returns a command to approve an ssh access
+// request.
+func NewApproveSSHAccessCommand() cmd.Command {
+	return newDecideSSHAccessCommand(approveSSHAccess)
+}
+
+// NewRejectSSHAccessCommand returns a command to reject an ssh access
+// request.
+func NewRejectSSHAccessCommand() cmd.Command {
+	return newDecideSSHAccessCommand(rejectSSHAccess)
+}
+
+// NewRevokeSSHAccessCommand returns a command to revoke an ssh access grant.
+func NewRevokeSSHAccessCommand() cmd.Command {
+	return newDecideSSHAccessCommand(revokeSSHAccess)
+}
+
+func newDecideSSHAccessCommand(decision sshAccessDecision) cmd.Command {
+	c := &decideSSHAccessCommand{decision: decision}
+	c.sshAccessAPIFunc = c.sshAccessAPI
+	return modelcmd.Wrap(c)
+}
+
+type decideSSHAccessCommand struct {
+	sshAccessCommandBase
+
+	decision sshAccessDecision
+	uuid     string
+}
+
+// Info implements cmd.Command.
+func (c *decideSSHAccessCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     c.decision.name,
+		Args:     "<grant ID>",
+		Purpose:  c.decision.purpose,
+		Doc:      c.decision.doc,
+		Examples: c.decision.examples,
+		SeeAlso: []string{
+			"ssh-access-grants",
+			"request-ssh-access",
+		},
+	})
+}
+
+// Init implements cmd.Command.
+func (c *decideSSHAccessCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no ssh access grant ID specified")
+	}
+	c.uuid, args = args[0], args[1:]
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.
+func (c *decideSSHAccessCommand) Run(ctx *cmd.Context) error {
+	api, err := c.sshAccessAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := c.decision.decide(api, ctx, c.uuid); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof(c.decision.done, c.uuid)
+	return nil
+}
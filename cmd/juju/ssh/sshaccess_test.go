@@ -0,0 +1,162 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/ssh"
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type SSHAccessSuite struct {
+	jujutesting.IsolationSuite
+
+	api *mocks.MockSSHAccessAPI
+}
+
+var _ = gc.Suite(&SSHAccessSuite{})
+
+func (s *SSHAccessSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = mocks.NewMockSSHAccessAPI(ctrl)
+	return ctrl
+}
+
+func (s *SSHAccessSuite) TestRequest(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	requested := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	s.api.EXPECT().RequestSSHAccess(gomock.Any(), "mysql/0", nil, "incident", 30*time.Minute).Return(params.SSHAccessGrant{
+		UUID:        "grant-1",
+		User:        "bob",
+		Target:      "0.mysql.deadbeef.juju.local",
+		Reason:      "incident",
+		Duration:    30 * time.Minute,
+		Status:      "pending",
+		RequestedAt: requested,
+	}, nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewRequestSSHAccessCommandForTest(s.api),
+		"mysql/0", "--duration", "30m", "--reason", "incident")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "SSH access to mysql/0 requested; waiting for approval.\n")
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+id: grant-1
+user: bob
+target: 0.mysql.deadbeef.juju.local
+reason: incident
+duration: 30m0s
+status: pending
+requested: 2025-03-01T10:00:00Z
+`[1:])
+}
+
+func (s *SSHAccessSuite) TestRequestContainer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	container := "charm"
+	s.api.EXPECT().RequestSSHAccess(gomock.Any(), "postgresql/0", &container, "", time.Hour).Return(params.SSHAccessGrant{
+		UUID:   "grant-1",
+		Status: "approved",
+	}, nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewRequestSSHAccessCommandForTest(s.api),
+		"postgresql/0", "--container", "charm")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "")
+}
+
+func (s *SSHAccessSuite) TestRequestInit(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, ssh.NewRequestSSHAccessCommandForTest(nil))
+	c.Check(err, gc.ErrorMatches, "no unit or machine specified")
+
+	_, err = cmdtesting.RunCommand(c, ssh.NewRequestSSHAccessCommandForTest(nil), "0", "--duration", "-1m")
+	c.Check(err, gc.ErrorMatches, "duration -1m0s not valid")
+
+	_, err = cmdtesting.RunCommand(c, ssh.NewRequestSSHAccessCommandForTest(nil), "0", "1")
+	c.Check(err, gc.ErrorMatches, `unrecognized args: \["1"\]`)
+}
+
+func (s *SSHAccessSuite) TestListTabular(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	requested := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	expires := requested.Add(time.Hour)
+	s.api.EXPECT().ListSSHAccessGrants(gomock.Any()).Return([]params.SSHAccessGrant{{
+		UUID:        "grant-1",
+		User:        "bob",
+		Target:      "0.mysql.deadbeef.juju.local",
+		Reason:      "incident",
+		Duration:    time.Hour,
+		Status:      "approved",
+		RequestedAt: requested,
+		DecidedBy:   "admin",
+		DecidedAt:   &requested,
+		ExpiresAt:   &expires,
+	}, {
+		UUID:        "grant-2",
+		User:        "alice",
+		Target:      "1.deadbeef.juju.local",
+		Duration:    30 * time.Minute,
+		Status:      "pending",
+		RequestedAt: requested,
+	}}, nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSSHAccessGrantsCommandForTest(s.api))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+ID       User   Target                       Status    Duration  Requested             Expires               Reason
+grant-1  bob    0.mysql.deadbeef.juju.local  approved  1h0m0s    2025-03-01 10:00:00Z  2025-03-01 11:00:00Z  incident
+grant-2  alice  1.deadbeef.juju.local        pending   30m0s     2025-03-01 10:00:00Z  -                     -
+`[1:])
+}
+
+func (s *SSHAccessSuite) TestApprove(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ApproveSSHAccess(gomock.Any(), "grant-1").Return(nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewApproveSSHAccessCommandForTest(s.api), "grant-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "SSH access grant-1 approved.\n")
+}
+
+func (s *SSHAccessSuite) TestReject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().RejectSSHAccess(gomock.Any(), "grant-1").Return(nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewRejectSSHAccessCommandForTest(s.api), "grant-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "SSH access grant-1 rejected.\n")
+}
+
+func (s *SSHAccessSuite) TestRevokeError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().RevokeSSHAccess(gomock.Any(), "grant-1").Return(errors.NotFoundf("ssh access grant %q", "grant-1"))
+	s.api.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewRevokeSSHAccessCommandForTest(s.api), "grant-1")
+	c.Assert(err, gc.ErrorMatches, `ssh access grant "grant-1" not found`)
+}
+
+func (s *SSHAccessSuite) TestDecideInit(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, ssh.NewApproveSSHAccessCommandForTest(nil))
+	c.Check(err, gc.ErrorMatches, "no ssh access grant ID specified")
+}
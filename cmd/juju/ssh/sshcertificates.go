@@ -0,0 +1,412 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/api/client/sshcertificates"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SSHCertificatesAPI defines the APIs used to issue, list and revoke the
+// certificates users authenticate to the controller's ssh server with.
+type SSHCertificatesAPI interface {
+	IssueSSHCertificate(ctx context.Context, publicKey string) (params.SSHCertificateResult, error)
+	ListSSHCertificates(ctx context.Context, user string, all bool) ([]params.SSHCertificate, error)
+	RevokeSSHCertificate(ctx context.Context, serial uint64) error
+	RevokeUserSSHCertificates(ctx context.Context, user string) (int, error)
+	Close() error
+}
+
+// sshCertificatesCommandBase holds what is common to the ssh certificate
+// commands.
+type sshCertificatesCommandBase struct {
+	modelcmd.ControllerCommandBase
+
+	sshCertificatesAPIFunc func(ctx context.Context) (SSHCertificatesAPI, error)
+}
+
+func (c *sshCertificatesCommandBase) sshCertificatesAPI(ctx context.Context) (SSHCertificatesAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sshcertificates.NewClient(root), nil
+}
+
+// defaultPublicKeyFiles are the public keys, relative to the user's .ssh
+// directory, which are certified when none is specified.
+var defaultPublicKeyFiles = []string{
+	"id_ed25519.pub",
+	"id_ecdsa.pub",
+	"id_rsa.pub",
+}
+
+const sshCertificateDoc = `
+Issues a certificate for an ssh public key, signed by the controller's ssh
+user certificate authority.
+
+While the "ssh-user-certificates-required" controller configuration key is
+enabled, the controller's ssh server only accepts users authenticating with a
+certificate it issued. The certificate is bound to the current user, and is
+valid for the "ssh-user-certificate-ttl" controller configuration key.
+
+The first of ~/.ssh/id_ed25519.pub, ~/.ssh/id_ecdsa.pub and ~/.ssh/id_rsa.pub
+which exists is certified, unless another public key is given. The certificate
+is written next to the public key, with the "-cert.pub" suffix that ssh looks
+for, unless another output file is given.
+`
+
+const sshCertificateExamples = `
+    juju ssh-certificate
+    juju ssh-certificate --public-key ~/.ssh/juju.pub
+    juju ssh-certificate --public-key ~/.ssh/juju.pub -o ~/.ssh/juju-cert.pub
+`
+
+// NewSSHCertificateCommand returns a command to issue an ssh user
+// certificate.
+func NewSSHCertificateCommand() cmd.Command {
+	c := &sshCertificateCommand{}
+	c.sshCertificatesAPIFunc = c.sshCertificatesAPI
+	return modelcmd.WrapController(c)
+}
+
+type sshCertificateCommand struct {
+	sshCertificatesCommandBase
+
+	publicKeyPath string
+	outputPath    string
+}
+
+// Info implements cmd.Command.
+func (c *sshCertificateCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "ssh-certificate",
+		Purpose:  "Issues a certificate for authenticating to the controller's ssh server.",
+		Doc:      sshCertificateDoc,
+		Examples: sshCertificateExamples,
+		SeeAlso: []string{
+			"ssh",
+			"ssh-certificates",
+			"revoke-ssh-certificate",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *sshCertificateCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.publicKeyPath, "public-key", "", "The ssh public key file to certify")
+	f.StringVar(&c.outputPath, "o", "", "The file to write the certificate to")
+	f.StringVar(&c.outputPath, "output", "", "")
+}
+
+// Init implements cmd.Command.
+func (c *sshCertificateCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.
+func (c *sshCertificateCommand) Run(ctx *cmd.Context) error {
+	publicKeyPath, publicKey, err := c.readPublicKey()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	outputPath := c.outputPath
+	if outputPath == "" {
+		outputPath = strings.TrimSuffix(publicKeyPath, ".pub") + "-cert.pub"
+	} else if outputPath, err = utils.NormalizePath(outputPath); err != nil {
+		return errors.Trace(err)
+	}
+
+	api, err := c.sshCertificatesAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	result, err := api.IssueSSHCertificate(ctx, publicKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := os.WriteFile(outputPath, []byte(result.SignedCertificate+"\n"), 0644); err != nil {
+		return errors.Annotate(err, "writing ssh certificate")
+	}
+	ctx.Infof("SSH certificate %d for %s written to %s, valid until %s.",
+		result.Certificate.Serial,
+		publicKeyPath,
+		outputPath,
+		common.FormatTime(&result.Certificate.ValidBefore, false),
+	)
+	return nil
+}
+
+// readPublicKey returns the path and contents of the public key to certify.
+func (c *sshCertificateCommand) readPublicKey() (string, string, error) {
+	if c.publicKeyPath != "" {
+		path, err := utils.NormalizePath(c.publicKeyPath)
+		if err != nil {
+			return "", "", errors.Trace(err)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", "", errors.Annotate(err, "reading ssh public key")
+		}
+		return path, strings.TrimSpace(string(data)), nil
+	}
+	for _, name := range defaultPublicKeyFiles {
+		path := filepath.Join(utils.Home(), ".ssh", name)
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return "", "", errors.Annotate(err, "reading ssh public key")
+		}
+		return path, strings.TrimSpace(string(data)), nil
+	}
+	return "", "", errors.New("no ssh public key found, specify one with --public-key")
+}
+
+const listSSHCertificatesDoc = `
+Lists the ssh user certificates issued by the controller.
+
+Users see the certificates issued to them. Controller superusers may list the
+certificates of another user, or of every user.
+`
+
+const listSSHCertificatesExamples = `
+    juju ssh-certificates
+    juju ssh-certificates --user bob
+    juju ssh-certificates --all --format yaml
+`
+
+// NewListSSHCertificatesCommand returns a command to list ssh user
+// certificates.
+func NewListSSHCertificatesCommand() cmd.Command {
+	c := &listSSHCertificatesCommand{}
+	c.sshCertificatesAPIFunc = c.sshCertificatesAPI
+	return modelcmd.WrapController(c)
+}
+
+type listSSHCertificatesCommand struct {
+	sshCertificatesCommandBase
+	out cmd.Output
+
+	user string
+	all  bool
+}
+
+// Info implements cmd.Command.
+func (c *listSSHCertificatesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "ssh-certificates",
+		Purpose:  "Lists the ssh user certificates issued by the controller.",
+		Doc:      listSSHCertificatesDoc,
+		Examples: listSSHCertificatesExamples,
+		Aliases:  []string{"list-ssh-certificates"},
+		SeeAlso: []string{
+			"ssh-certificate",
+			"revoke-ssh-certificate",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *listSSHCertificatesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.user, "user", "", "List the certificates of this user")
+	f.BoolVar(&c.all, "all", false, "List the certificates of every user")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSSHCertificatesTabular,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *listSSHCertificatesCommand) Init(args []string) error {
+	if c.all && c.user != "" {
+		return errors.New("cannot specify both --user and --all")
+	}
+	if c.user != "" && !names.IsValidUser(c.user) {
+		return errors.NotValidf("user name %q", c.user)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.
+func (c *listSSHCertificatesCommand) Run(ctx *cmd.Context) error {
+	api, err := c.sshCertificatesAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	certs, err := api.ListSSHCertificates(ctx, c.user, c.all)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	details := make([]sshCertificateDetails, len(certs))
+	for i, cert := range certs {
+		details[i] = newSSHCertificateDetails(cert)
+	}
+	return c.out.Write(ctx, details)
+}
+
+type sshCertificateDetails struct {
+	Serial         uint64     `json:"serial" yaml:"serial"`
+	User           string     `json:"user" yaml:"user"`
+	KeyFingerprint string     `json:"fingerprint" yaml:"fingerprint"`
+	ValidAfter     time.Time  `json:"valid-after" yaml:"valid-after"`
+	ValidBefore    time.Time  `json:"valid-before" yaml:"valid-before"`
+	RevokedBy      string     `json:"revoked-by,omitempty" yaml:"revoked-by,omitempty"`
+	RevokedAt      *time.Time `json:"revoked,omitempty" yaml:"revoked,omitempty"`
+}
+
+func newSSHCertificateDetails(cert params.SSHCertificate) sshCertificateDetails {
+	return sshCertificateDetails{
+		Serial:         cert.Serial,
+		User:           cert.User,
+		KeyFingerprint: cert.KeyFingerprint,
+		ValidAfter:     cert.ValidAfter,
+		ValidBefore:    cert.ValidBefore,
+		RevokedBy:      cert.RevokedBy,
+		RevokedAt:      cert.RevokedAt,
+	}
+}
+
+// formatSSHCertificatesTabular writes a tabular summary of ssh user
+// certificates.
+func formatSSHCertificatesTabular(writer io.Writer, value interface{}) error {
+	certs, ok := value.([]sshCertificateDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", certs, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+
+	w.Println("Serial", "User", "Fingerprint", "Valid until", "Revoked")
+	for _, cert := range certs {
+		revoked := "-"
+		if cert.RevokedAt != nil {
+			revoked = common.FormatTime(cert.RevokedAt, true) + " by " + cert.RevokedBy
+		}
+		w.Println(cert.Serial, cert.User, cert.KeyFingerprint, common.FormatTime(&cert.ValidBefore, true), revoked)
+	}
+	return tw.Flush()
+}
+
+const revokeSSHCertificateDoc = `
+Revokes an ssh user certificate issued by the controller, so that the
+controller's ssh server no longer accepts it. Either the serial of the
+certificate, or a user whose unexpired certificates should all be revoked, is
+specified.
+
+Users may revoke their own certificates; controller superusers may revoke
+those of any user.
+`
+
+const revokeSSHCertificateExamples = `
+    juju revoke-ssh-certificate 4417350211457839193
+    juju revoke-ssh-certificate --user bob
+`
+
+// NewRevokeSSHCertificateCommand returns a command to revoke ssh user
+// certificates.
+func NewRevokeSSHCertificateCommand() cmd.Command {
+	c := &revokeSSHCertificateCommand{}
+	c.sshCertificatesAPIFunc = c.sshCertificatesAPI
+	return modelcmd.WrapController(c)
+}
+
+type revokeSSHCertificateCommand struct {
+	sshCertificatesCommandBase
+
+	serial uint64
+	user   string
+}
+
+// Info implements cmd.Command.
+func (c *revokeSSHCertificateCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "revoke-ssh-certificate",
+		Args:     "[<serial>]",
+		Purpose:  "Revokes ssh user certificates issued by the controller.",
+		Doc:      revokeSSHCertificateDoc,
+		Examples: revokeSSHCertificateExamples,
+		SeeAlso: []string{
+			"ssh-certificate",
+			"ssh-certificates",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *revokeSSHCertificateCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.user, "user", "", "Revoke every unexpired certificate of this user")
+}
+
+// Init implements cmd.Command.
+func (c *revokeSSHCertificateCommand) Init(args []string) error {
+	if c.user != "" {
+		if !names.IsValidUser(c.user) {
+			return errors.NotValidf("user name %q", c.user)
+		}
+		if len(args) > 0 {
+			return errors.New("cannot specify both a serial and --user")
+		}
+		return nil
+	}
+	if len(args) == 0 {
+		return errors.New("no certificate serial or --user specified")
+	}
+	serial, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil || serial == 0 {
+		return errors.NotValidf("certificate serial %q", args[0])
+	}
+	c.serial = serial
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *revokeSSHCertificateCommand) Run(ctx *cmd.Context) error {
+	api, err := c.sshCertificatesAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if c.user != "" {
+		revoked, err := api.RevokeUserSSHCertificates(ctx, c.user)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		ctx.Infof("Revoked %d SSH certificate(s) of %q.", revoked, c.user)
+		return nil
+	}
+	if err := api.RevokeSSHCertificate(ctx, c.serial); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("SSH certificate %d revoked.", c.serial)
+	return nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/ssh"
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type SSHCertificatesSuite struct {
+	jujutesting.IsolationSuite
+
+	api *mocks.MockSSHCertificatesAPI
+}
+
+var _ = gc.Suite(&SSHCertificatesSuite{})
+
+func (s *SSHCertificatesSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = mocks.NewMockSSHCertificatesAPI(ctrl)
+	return ctrl
+}
+
+func (s *SSHCertificatesSuite) issuedCertificate() params.SSHCertificateResult {
+	validAfter := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	return params.SSHCertificateResult{
+		Certificate: params.SSHCertificate{
+			Serial:      42,
+			User:        "admin",
+			ValidAfter:  validAfter,
+			ValidBefore: validAfter.Add(8 * time.Hour),
+		},
+		SignedCertificate: "ssh-ed25519-cert-v01@openssh.com CCCC",
+	}
+}
+
+func (s *SSHCertificatesSuite) TestIssueDefaultPublicKey(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	home := c.MkDir()
+	s.PatchEnvironment("HOME", home)
+	sshDir := filepath.Join(home, ".ssh")
+	c.Assert(os.Mkdir(sshDir, 0700), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(sshDir, "id_ecdsa.pub"), []byte("ecdsa-sha2-nistp256 BBBB bob@host\n"), 0644), jc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(sshDir, "id_rsa.pub"), []byte("ssh-rsa DDDD bob@host\n"), 0644), jc.ErrorIsNil)
+
+	s.api.EXPECT().IssueSSHCertificate(gomock.Any(), "ecdsa-sha2-nistp256 BBBB bob@host").Return(s.issuedCertificate(), nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewSSHCertificateCommandForTest(s.api))
+	c.Assert(err, jc.ErrorIsNil)
+
+	certPath := filepath.Join(sshDir, "id_ecdsa-cert.pub")
+	data, err := os.ReadFile(certPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "ssh-ed25519-cert-v01@openssh.com CCCC\n")
+	c.Check(cmdtesting.Stderr(ctx), gc.Matches, `SSH certificate 42 for .*id_ecdsa.pub written to .*id_ecdsa-cert.pub, valid until .*\n`)
+}
+
+func (s *SSHCertificatesSuite) TestIssuePublicKeyAndOutput(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dir := c.MkDir()
+	keyPath := filepath.Join(dir, "juju.pub")
+	certPath := filepath.Join(dir, "cert")
+	c.Assert(os.WriteFile(keyPath, []byte("ssh-ed25519 BBBB\n"), 0644), jc.ErrorIsNil)
+
+	s.api.EXPECT().IssueSSHCertificate(gomock.Any(), "ssh-ed25519 BBBB").Return(s.issuedCertificate(), nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewSSHCertificateCommandForTest(s.api),
+		"--public-key", keyPath, "-o", certPath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := os.ReadFile(certPath)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "ssh-ed25519-cert-v01@openssh.com CCCC\n")
+}
+
+func (s *SSHCertificatesSuite) TestIssueNoPublicKey(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.PatchEnvironment("HOME", c.MkDir())
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewSSHCertificateCommandForTest(s.api))
+	c.Assert(err, gc.ErrorMatches, "no ssh public key found, specify one with --public-key")
+}
+
+func (s *SSHCertificatesSuite) TestIssueError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	keyPath := filepath.Join(c.MkDir(), "juju.pub")
+	c.Assert(os.WriteFile(keyPath, []byte("junk"), 0644), jc.ErrorIsNil)
+
+	s.api.EXPECT().IssueSSHCertificate(gomock.Any(), "junk").Return(params.SSHCertificateResult{}, errors.NotValidf("public key"))
+	s.api.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewSSHCertificateCommandForTest(s.api), "--public-key", keyPath)
+	c.Assert(err, gc.ErrorMatches, "public key not valid")
+}
+
+func (s *SSHCertificatesSuite) TestList(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	validAfter := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	revokedAt := validAfter.Add(time.Hour)
+	s.api.EXPECT().ListSSHCertificates(gomock.Any(), "bob", false).Return([]params.SSHCertificate{{
+		Serial:         42,
+		User:           "bob",
+		KeyFingerprint: "SHA256:fingerprint",
+		ValidAfter:     validAfter,
+		ValidBefore:    validAfter.Add(8 * time.Hour),
+		RevokedBy:      "admin",
+		RevokedAt:      &revokedAt,
+	}}, nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSSHCertificatesCommandForTest(s.api),
+		"--user", "bob", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- serial: 42
+  user: bob
+  fingerprint: SHA256:fingerprint
+  valid-after: 2025-04-01T10:00:00Z
+  valid-before: 2025-04-01T18:00:00Z
+  revoked-by: admin
+  revoked: 2025-04-01T11:00:00Z
+`[1:])
+}
+
+func (s *SSHCertificatesSuite) TestListAll(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().ListSSHCertificates(gomock.Any(), "", true).Return(nil, nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewListSSHCertificatesCommandForTest(s.api), "--all")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *SSHCertificatesSuite) TestListUserAndAll(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, ssh.NewListSSHCertificatesCommandForTest(nil), "--all", "--user", "bob")
+	c.Assert(err, gc.ErrorMatches, "cannot specify both --user and --all")
+}
+
+func (s *SSHCertificatesSuite) TestRevokeSerial(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().RevokeSSHCertificate(gomock.Any(), uint64(42)).Return(nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewRevokeSSHCertificateCommandForTest(s.api), "42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "SSH certificate 42 revoked.\n")
+}
+
+func (s *SSHCertificatesSuite) TestRevokeUser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().RevokeUserSSHCertificates(gomock.Any(), "bob").Return(3, nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewRevokeSSHCertificateCommandForTest(s.api), "--user", "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Revoked 3 SSH certificate(s) of \"bob\".\n")
+}
+
+func (s *SSHCertificatesSuite) TestRevokeInit(c *gc.C) {
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{{
+		args: nil,
+		err:  "no certificate serial or --user specified",
+	}, {
+		args: []string{"serial"},
+		err:  `certificate serial "serial" not valid`,
+	}, {
+		args: []string{"0"},
+		err:  `certificate serial "0" not valid`,
+	}, {
+		args: []string{"42", "--user", "bob"},
+		err:  "cannot specify both a serial and --user",
+	}, {
+		args: []string{"42", "43"},
+		err:  `unrecognized args: \["43"\]`,
+	}} {
+		_, err := cmdtesting.RunCommand(c, ssh.NewRevokeSSHCertificateCommandForTest(nil), t.args...)
+		c.Check(err, gc.ErrorMatches, t.err, gc.Commentf("args %v", t.args))
+	}
+}
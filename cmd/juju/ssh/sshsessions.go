@@ -0,0 +1,179 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/sshclient"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// SSHSessionsAPI defines the APIs used to list and replay recorded ssh
+// sessions.
+type SSHSessionsAPI interface {
+	ListSSHSessions(ctx context.Context) ([]params.SSHSession, error)
+	SSHSessionRecording(ctx context.Context, uuid string) (io.ReadCloser, error)
+	Close() error
+}
+
+const sshSessionsDoc = `
+Lists and plays back the ssh sessions to units and machines of the model
+which were proxied by the controller and recorded.
+
+Sessions are only recorded while the "ssh-session-recording" controller
+configuration key is enabled.
+`
+
+const sshSessionsExamples = `
+    juju ssh-sessions list
+    juju ssh-sessions replay 9f2e3b5c-4b1e-4c5e-8d35-2b0b0d0e7c11
+`
+
+// NewSSHSessionsCommand returns a command to list and replay recorded ssh
+// sessions, with a subcommand for each.
+func NewSSHSessionsCommand() cmd.Command {
+	sshSessions := jujucmd.NewSuperCommand(cmd.SuperCommandParams{
+		Name:        "ssh-sessions",
+		UsagePrefix: "juju",
+		Purpose:     "Lists and plays back the recorded ssh sessions of the model.",
+		Doc:         sshSessionsDoc,
+		Examples:    sshSessionsExamples,
+	})
+	sshSessions.Register(NewListSSHSessionsCommand())
+	sshSessions.Register(NewReplaySSHSessionCommand())
+	return sshSessions
+}
+
+const listSSHSessionsDoc = `
+Lists the ssh sessions to units and machines of the model which were proxied
+by the controller and recorded.
+
+Sessions are only recorded while the "ssh-session-recording" controller
+configuration key is enabled. Use ` + "`juju ssh-sessions replay`" + ` to play a
+recorded session back.
+`
+
+const listSSHSessionsExamples = `
+    juju ssh-sessions list
+    juju ssh-sessions list --format yaml
+`
+
+// NewListSSHSessionsCommand returns a command to list recorded ssh sessions.
+func NewListSSHSessionsCommand() cmd.Command {
+	c := &listSSHSessionsCommand{}
+	c.sshSessionsAPIFunc = c.sshSessionsAPI
+	return modelcmd.Wrap(c)
+}
+
+type listSSHSessionsCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+
+	sshSessionsAPIFunc func(ctx context.Context) (SSHSessionsAPI, error)
+}
+
+func (c *listSSHSessionsCommand) sshSessionsAPI(ctx context.Context) (SSHSessionsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sshclient.NewFacade(root), nil
+}
+
+// Info implements cmd.Command.
+func (c *listSSHSessionsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "list",
+		Purpose:  "Lists the recorded ssh sessions of the model.",
+		Doc:      listSSHSessionsDoc,
+		Examples: listSSHSessionsExamples,
+		SeeAlso: []string{
+			"ssh",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *listSSHSessionsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSSHSessionsTabular,
+	})
+}
+
+type sshSessionDetails struct {
+	ID        string    `json:"id" yaml:"id"`
+	User      string    `json:"user" yaml:"user"`
+	Target    string    `json:"target" yaml:"target"`
+	Command   string    `json:"command,omitempty" yaml:"command,omitempty"`
+	StartedAt time.Time `json:"started" yaml:"started"`
+	EndedAt   time.Time `json:"ended" yaml:"ended"`
+	ExitCode  int       `json:"exit-code" yaml:"exit-code"`
+	Size      int64     `json:"size" yaml:"size"`
+}
+
+// Run implements cmd.Command.
+func (c *listSSHSessionsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.sshSessionsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	sessions, err := api.ListSSHSessions(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	details := make([]sshSessionDetails, len(sessions))
+	for i, session := range sessions {
+		details[i] = sshSessionDetails{
+			ID:        session.UUID,
+			User:      session.User,
+			Target:    session.Target,
+			Command:   session.Command,
+			StartedAt: session.StartedAt,
+			EndedAt:   session.EndedAt,
+			ExitCode:  session.ExitCode,
+			Size:      session.Size,
+		}
+	}
+	return c.out.Write(ctx, details)
+}
+
+// formatSSHSessionsTabular writes a tabular summary of recorded ssh sessions.
+func formatSSHSessionsTabular(writer io.Writer, value interface{}) error {
+	sessions, ok := value.([]sshSessionDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", sessions, value)
+	}
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.SetColumnAlignRight(5)
+
+	w.Println("ID", "User", "Target", "Command", "Started", "Exit", "Duration")
+	for _, s := range sessions {
+		command := s.Command
+		if command == "" {
+			command = "-"
+		}
+		started := common.FormatTime(&s.StartedAt, true)
+		duration := s.EndedAt.Sub(s.StartedAt).Round(time.Second)
+		w.Println(s.ID, s.User, s.Target, command, started, s.ExitCode, duration)
+	}
+	return tw.Flush()
+}
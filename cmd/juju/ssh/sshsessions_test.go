@@ -0,0 +1,166 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/ssh"
+	"github.com/juju/juju/cmd/juju/ssh/mocks"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/ssh/recording"
+	"github.com/juju/juju/rpc/params"
+)
+
+type SSHSessionsSuite struct {
+	jujutesting.IsolationSuite
+
+	api *mocks.MockSSHSessionsAPI
+}
+
+var _ = gc.Suite(&SSHSessionsSuite{})
+
+func (s *SSHSessionsSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.api = mocks.NewMockSSHSessionsAPI(ctrl)
+	s.api.EXPECT().Close().Return(nil)
+	return ctrl
+}
+
+func (s *SSHSessionsSuite) TestListTabular(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	started := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	s.api.EXPECT().ListSSHSessions(gomock.Any()).Return([]params.SSHSession{{
+		UUID:      "session-1",
+		User:      "alice",
+		Target:    "0.postgresql.deadbeef.juju.local",
+		StartedAt: started,
+		EndedAt:   started.Add(90 * time.Second),
+	}, {
+		UUID:      "session-2",
+		User:      "bob",
+		Target:    "1.deadbeef.juju.local",
+		Command:   "uptime",
+		StartedAt: started.Add(time.Hour),
+		EndedAt:   started.Add(time.Hour + time.Second),
+		ExitCode:  1,
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSSHSessionsCommandForTest(s.api))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+ID         User   Target                            Command  Started               Exit  Duration
+session-1  alice  0.postgresql.deadbeef.juju.local  -        2025-03-01 10:00:00Z     0  1m30s
+session-2  bob    1.deadbeef.juju.local             uptime   2025-03-01 11:00:00Z     1  1s
+`[1:])
+}
+
+func (s *SSHSessionsSuite) TestListYAML(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	started := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	s.api.EXPECT().ListSSHSessions(gomock.Any()).Return([]params.SSHSession{{
+		UUID:      "session-1",
+		User:      "alice",
+		Target:    "1.deadbeef.juju.local",
+		Command:   "uptime",
+		StartedAt: started,
+		EndedAt:   started.Add(time.Second),
+		Size:      100,
+	}}, nil)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewListSSHSessionsCommandForTest(s.api), "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+- id: session-1
+  user: alice
+  target: 1.deadbeef.juju.local
+  command: uptime
+  started: 2025-03-01T10:00:00Z
+  ended: 2025-03-01T10:00:01Z
+  exit-code: 0
+  size: 100
+`[1:])
+}
+
+func (s *SSHSessionsSuite) TestReplay(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().SSHSessionRecording(gomock.Any(), "session-1").Return(
+		io.NopCloser(bytes.NewReader(s.recording(c, "hello\r\n", 3))), nil,
+	)
+
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewReplaySSHSessionCommandForTest(s.api, testclock.NewDilatedWallClock(time.Millisecond)), "session-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "hello\r\n")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Session exited with code 3\n")
+}
+
+func (s *SSHSessionsSuite) TestReplayToFile(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	data := s.recording(c, "hello\r\n", 0)
+	s.api.EXPECT().SSHSessionRecording(gomock.Any(), "session-1").Return(
+		io.NopCloser(bytes.NewReader(data)), nil,
+	)
+
+	path := filepath.Join(c.MkDir(), "session.cast")
+	_, err := cmdtesting.RunCommand(c, ssh.NewReplaySSHSessionCommandForTest(s.api, testclock.NewClock(time.Now())), "session-1", "--output", path)
+	c.Assert(err, jc.ErrorIsNil)
+
+	saved, err := os.ReadFile(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(saved, jc.DeepEquals, data)
+}
+
+func (s *SSHSessionsSuite) TestReplayNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.api.EXPECT().SSHSessionRecording(gomock.Any(), "session-1").Return(
+		nil, errors.NotFoundf("ssh session %q", "session-1"),
+	)
+
+	_, err := cmdtesting.RunCommand(c, ssh.NewReplaySSHSessionCommandForTest(s.api, testclock.NewClock(time.Now())), "session-1")
+	c.Check(err, gc.ErrorMatches, `ssh session "session-1" not found`)
+}
+
+func (s *SSHSessionsSuite) TestReplayInit(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, ssh.NewReplaySSHSessionCommandForTest(nil, nil))
+	c.Check(err, gc.ErrorMatches, "no session ID specified")
+
+	_, err = cmdtesting.RunCommand(c, ssh.NewReplaySSHSessionCommandForTest(nil, nil), "session-1", "--max-idle", "-1s")
+	c.Check(err, gc.ErrorMatches, "negative --max-idle not valid")
+
+	_, err = cmdtesting.RunCommand(c, ssh.NewReplaySSHSessionCommandForTest(nil, nil), "session-1", "session-2")
+	c.Check(err, gc.ErrorMatches, `unrecognized args: \["session-2"\]`)
+}
+
+func (s *SSHSessionsSuite) TestSubcommands(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, ssh.NewSSHSessionsCommand(), "help", "commands")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Matches, `(?s).*\nlist +Lists the recorded ssh sessions of the model\.\n.*`)
+	c.Check(cmdtesting.Stdout(ctx), gc.Matches, `(?s).*\nreplay +Plays back a recorded ssh session\.\n.*`)
+}
+
+func (s *SSHSessionsSuite) recording(c *gc.C, output string, exitCode int) []byte {
+	var buf bytes.Buffer
+	recorder, err := recording.NewRecorder(&buf, testclock.NewClock(time.Now()), recording.Header{Width: 80, Height: 24})
+	c.Assert(err, jc.ErrorIsNil)
+	_, _ = recorder.Write([]byte(output))
+	recorder.Exit(exitCode)
+	c.Assert(recorder.Err(), jc.ErrorIsNil)
+	return buf.Bytes()
+}
@@ -93,6 +93,41 @@ transcode/1   shared-fs/0   filesystem  radiance  /mnt/huang  1.0 GiB  attached
 `[1:])
 }
 
+func (s *MinimalStatusSuite) TestGoodCallWithStorageUsage(c *gc.C) {
+	t := time.Now()
+	s.statusapi.expectIncludeStorage = true
+	s.statusapi.result.Storage = storageDetails(t)
+	s.statusapi.result.Filesystems = filesystemDetails(t)
+	s.statusapi.result.Volumes = volumeDetails(t)
+	for _, f := range s.statusapi.result.Filesystems {
+		if f.FilesystemTag != "filesystem-4" {
+			continue
+		}
+		attachment := f.MachineAttachments["machine-1"]
+		attachment.Usage = &params.FilesystemUsage{
+			UsedBytes:      25,
+			AvailableBytes: 75,
+		}
+		f.MachineAttachments["machine-1"] = attachment
+	}
+
+	context, err := s.runStatus(c, "--no-color", "--storage")
+	c.Assert(err, jc.ErrorIsNil)
+
+	obtainedValid := cmdtesting.Stdout(context)
+	c.Assert(obtainedValid, gc.Equals, `
+Model  Controller  Cloud/Region  Version
+test   kontroll    foo           
+
+Storage Unit  Storage ID    Type        Pool      Mountpoint  Size     Used  Status    Message
+              persistent/1  filesystem                                       detached  
+postgresql/0  db-dir/1100   block                             3.0 MiB        attached  
+transcode/0   db-dir/1000   block                                            pending   creating volume
+transcode/0   shared-fs/0   filesystem  radiance  /mnt/doom   1.0 GiB        attached  
+transcode/1   shared-fs/0   filesystem  radiance  /mnt/huang  1.0 GiB  25%   attached  
+`[1:])
+}
+
 func (s *MinimalStatusSuite) TestRetryOnError(c *gc.C) {
 	s.statusapi.errors = []error{
 		errors.New("boom"),
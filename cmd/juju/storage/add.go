@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/names/v6"
 
 	jujucmd "github.com/juju/juju/cmd"
@@ -69,6 +70,11 @@ by the charm, which can specify a maximum number of storage instances per unit.
 positive number, followed by a size suffix.  Valid suffixes include M, G, T,
 and P.  Defaults to "1024M", or the which can specify a minimum size required 
 by the charm.
+
+Use --snapshot to restore the new storage instances from a snapshot, as
+output by 'juju storage-snapshots'. The snapshot must have been taken from
+storage in the same storage pool, and <size> must be at least the size of the
+snapshot.
 `
 
 	addCommandExamples = `
@@ -84,6 +90,10 @@ Add a storage instance from the (AWS-specific) ebs-ssd storage pool for "brick"
 
     juju add-storage gluster/0 brick=ebs-ssd
 
+Add a storage instance for "pgdata" storage to unit postgresql/1, restored from an EBS snapshot:
+
+    juju add-storage postgresql/1 pgdata=ebs,100G --snapshot snap-0123456789abcdef0
+
 
 Further reading:
 
@@ -103,6 +113,16 @@ type addCommand struct {
 	// defined in charm storage metadata.
 	storageDirectives map[string]storage.Directive
 	newAPIFunc        func(ctx context.Context) (StorageAddAPI, error)
+
+	// snapshotId, if non-empty, is the provider ID of a snapshot
+	// from which to restore the added storage.
+	snapshotId string
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *addCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.snapshotId, "snapshot", "", "Restore the storage from the snapshot with this ID")
 }
 
 // Init implements Command.Init.
@@ -133,6 +153,7 @@ func (c *addCommand) Info() *cmd.Info {
 			"import-filesystem",
 			"storage",
 			"storage-pools",
+			"storage-snapshots",
 		},
 	})
 }
@@ -214,6 +235,7 @@ func (c *addCommand) createStorageAddParams() []params.StorageAddParams {
 				Size:  &d.Size,
 				Count: &d.Count,
 			},
+			SnapshotId: c.snapshotId,
 		})
 	}
 
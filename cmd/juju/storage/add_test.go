@@ -118,6 +118,20 @@ added storage foo/1 to tst/123
 	}
 }
 
+func (s *addSuite) TestAddFromSnapshot(c *gc.C) {
+	var added []params.StorageAddParams
+	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
+		added = storages
+		return make([]params.AddStorageResult, len(storages)), nil
+	}
+	_, err := s.runAdd(c, "tst/123", "data=ebs,100G", "--snapshot", "snap-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(added, gc.HasLen, 1)
+	c.Assert(added[0].StorageName, gc.Equals, "data")
+	c.Assert(added[0].Directives.Pool, gc.Equals, "ebs")
+	c.Assert(added[0].SnapshotId, gc.Equals, "snap-1")
+}
+
 func (s *addSuite) TestAddOperationAborted(c *gc.C) {
 	s.args = []string{"tst/123", "data=676"}
 	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.AddStorageResult, error) {
@@ -24,6 +24,30 @@ func NewPoolListCommandForTest(api PoolListAPI, store jujuclient.ClientStore) cm
 	return modelcmd.Wrap(cmd)
 }
 
+func NewSnapshotCreateCommandForTest(api SnapshotCreateAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &snapshotCreateCommand{newAPIFunc: func(ctx context.Context) (SnapshotCreateAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewSnapshotListCommandForTest(api SnapshotListAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &snapshotListCommand{newAPIFunc: func(ctx context.Context) (SnapshotListAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewSnapshotRemoveCommandForTest(api SnapshotRemoveAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &snapshotRemoveCommand{newAPIFunc: func(ctx context.Context) (SnapshotRemoveAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
 func NewPoolCreateCommandForTest(api PoolCreateAPI, store jujuclient.ClientStore) cmd.Command {
 	cmd := &poolCreateCommand{newAPIFunc: func(ctx context.Context) (PoolCreateAPI, error) {
 		return api, nil
@@ -86,6 +110,13 @@ func NewAttachStorageCommandForTest(new NewEntityAttacherCloserFunc, store jujuc
 	return modelcmd.Wrap(cmd)
 }
 
+func NewResizeStorageCommandForTest(new NewEntityResizerCloserFunc, store jujuclient.ClientStore) cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.SetClientStore(store)
+	cmd.newEntityResizerCloser = new
+	return modelcmd.Wrap(cmd)
+}
+
 func NewDetachStorageCommandForTest(new NewEntityDetacherCloserFunc, store jujuclient.ClientStore) cmd.Command {
 	cmd := &detachStorageCommand{}
 	cmd.SetClientStore(store)
@@ -54,9 +54,31 @@ type FilesystemAttachments struct {
 }
 
 type FilesystemAttachment struct {
-	MountPoint string `yaml:"mount-point" json:"mount-point"`
-	ReadOnly   bool   `yaml:"read-only" json:"read-only"`
-	Life       string `yaml:"life,omitempty" json:"life,omitempty"`
+	MountPoint string           `yaml:"mount-point" json:"mount-point"`
+	ReadOnly   bool             `yaml:"read-only" json:"read-only"`
+	Life       string           `yaml:"life,omitempty" json:"life,omitempty"`
+	Usage      *FilesystemUsage `yaml:"usage,omitempty" json:"usage,omitempty"`
+}
+
+// FilesystemUsage defines the serialization behaviour for the reported
+// usage of an attached filesystem.
+type FilesystemUsage struct {
+	UsedBytes       uint64 `yaml:"used-bytes" json:"used-bytes"`
+	AvailableBytes  uint64 `yaml:"available-bytes" json:"available-bytes"`
+	UsedInodes      uint64 `yaml:"used-inodes" json:"used-inodes"`
+	AvailableInodes uint64 `yaml:"available-inodes" json:"available-inodes"`
+}
+
+func filesystemUsageFromParams(in *params.FilesystemUsage) *FilesystemUsage {
+	if in == nil {
+		return nil
+	}
+	return &FilesystemUsage{
+		UsedBytes:       in.UsedBytes,
+		AvailableBytes:  in.AvailableBytes,
+		UsedInodes:      in.UsedInodes,
+		AvailableInodes: in.AvailableInodes,
+	}
 }
 
 // generateListFilesystemOutput returns a map filesystem IDs to filesystem info
@@ -132,9 +154,10 @@ func createFilesystemInfo(details params.FilesystemDetails) (names.FilesystemTag
 				return errors.Trace(err)
 			}
 			out[id] = FilesystemAttachment{
-				attachment.MountPoint,
-				attachment.ReadOnly,
-				string(attachment.Life),
+				MountPoint: attachment.MountPoint,
+				ReadOnly:   attachment.ReadOnly,
+				Life:       string(attachment.Life),
+				Usage:      filesystemUsageFromParams(attachment.Usage),
 			}
 		}
 		return nil
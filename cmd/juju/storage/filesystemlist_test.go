@@ -165,6 +165,19 @@ func (s *ListSuite) TestCAASFilesystemListTabular(c *gc.C) {
 	s.assertValidFilesystemList(c, []string{}, expectedCAASFilesystemListTabular)
 }
 
+func (s *ListSuite) TestFilesystemListTabularWithUsage(c *gc.C) {
+	s.mockAPI.withUsage = true
+	s.assertValidFilesystemList(c, []string{}, `
+Machine  Unit         Storage ID   ID   Volume  Provider ID                       Mountpoint  Size     Used  State      Message
+0        abc/0        db-dir/1001  0/0  0/1     provider-supplied-filesystem-0-0  /mnt/fuji   512 MiB        attached   
+0        transcode/0  shared-fs/0  4            provider-supplied-filesystem-4    /mnt/doom   1.0 GiB  93%   attached   nearly full: 93% of space used
+0                                  1            provider-supplied-filesystem-1                2.0 GiB        attaching  failed to attach, will retry
+1        transcode/1  shared-fs/0  4            provider-supplied-filesystem-4    /mnt/huang  1.0 GiB        attached   nearly full: 93% of space used
+1                                  2            provider-supplied-filesystem-2    /mnt/zion   3.0 MiB        attached   
+1                                  3                                                          42 MiB         pending    
+`[1:])
+}
+
 func (s *ListSuite) assertUnmarshalledOutput(c *gc.C, unmarshal unmarshaller, expectedErr string, args ...string) {
 	context, err := s.runFilesystemList(c, args...)
 	c.Assert(err, jc.ErrorIsNil)
@@ -378,5 +391,18 @@ func (s mockListAPI) ListFilesystems(ctx context.Context, machines []string) ([]
 			}
 		}
 	}
+	if s.withUsage {
+		// filesystem 4 is nearly full on machine 0.
+		details := &results[0].Result[4]
+		details.Status.Info = "nearly full: 93% of space used"
+		attachment := details.MachineAttachments["machine-0"]
+		attachment.Usage = &params.FilesystemUsage{
+			UsedBytes:       930,
+			AvailableBytes:  70,
+			UsedInodes:      10,
+			AvailableInodes: 90,
+		}
+		details.MachineAttachments["machine-0"] = attachment
+	}
 	return results, nil
 }
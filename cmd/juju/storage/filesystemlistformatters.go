@@ -23,40 +23,52 @@ func formatFilesystemListTabular(writer io.Writer, infos map[string]FilesystemIn
 	}
 
 	haveMachines := false
+	haveUsage := false
 	var filesystemAttachmentInfos filesystemAttachmentInfos
 	for filesystemId, info := range infos {
 		var withMachines bool
 		filesystemAttachmentInfos, withMachines = extractFilesystemAttachmentInfo(filesystemAttachmentInfos, filesystemId, info)
 		haveMachines = haveMachines || withMachines
 	}
+	for _, info := range filesystemAttachmentInfos {
+		haveUsage = haveUsage || info.FilesystemAttachment.Usage != nil
+	}
 	sort.Sort(filesystemAttachmentInfos)
 
+	var headings []string
 	if haveMachines {
-		print("Machine", "Unit", "Storage ID", "ID", "Volume", "Provider ID", "Mountpoint", "Size", "State", "Message")
+		headings = []string{"Machine", "Unit", "Storage ID", "ID", "Volume", "Provider ID", "Mountpoint", "Size"}
 	} else {
-		print("Unit", "Storage ID", "ID", "Provider ID", "Mountpoint", "Size", "State", "Message")
+		headings = []string{"Unit", "Storage ID", "ID", "Provider ID", "Mountpoint", "Size"}
+	}
+	if haveUsage {
+		headings = append(headings, "Used")
 	}
+	print(append(headings, "State", "Message")...)
 
 	for _, info := range filesystemAttachmentInfos {
 		var size string
 		if info.Size > 0 {
 			size = humanize.IBytes(info.Size * humanize.MiByte)
 		}
+		var values []string
 		if haveMachines {
-			print(
+			values = []string{
 				info.MachineId, info.UnitId, info.Storage,
 				info.FilesystemId, info.Volume, info.ProviderFilesystemId,
 				info.FilesystemAttachment.MountPoint, size,
-				string(info.Status.Current), info.Status.Message,
-			)
+			}
 		} else {
-			print(
+			values = []string{
 				info.UnitId, info.Storage,
 				info.FilesystemId, info.ProviderFilesystemId,
 				info.FilesystemAttachment.MountPoint, size,
-				string(info.Status.Current), info.Status.Message,
-			)
+			}
+		}
+		if haveUsage {
+			values = append(values, formatFilesystemUsage(info.FilesystemAttachment.Usage))
 		}
+		print(append(values, string(info.Status.Current), info.Status.Message)...)
 	}
 
 	return tw.Flush()
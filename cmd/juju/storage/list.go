@@ -28,6 +28,13 @@ func NewListCommand() cmd.Command {
 
 const listCommandDoc = `
 List information about storage.
+
+Machine agents periodically report how much of each attached filesystem
+is in use. When usage has been reported, the tabular output includes a
+Used column, and filesystems whose space or inode usage exceeds the
+model's storage-usage-warning-threshold or storage-inode-warning-threshold
+are given the warning status, and active units using them are blocked
+until the usage drops or their charm next sets the unit's status.
 `
 
 const listCommandExample = `
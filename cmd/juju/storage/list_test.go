@@ -52,6 +52,21 @@ transcode/1   shared-fs/0   filesystem  radiance  1.0 GiB  attached
 `[1:])
 }
 
+func (s *ListSuite) TestListWithUsage(c *gc.C) {
+	s.mockAPI.withUsage = true
+	s.assertValidList(
+		c,
+		nil,
+		`
+Unit          Storage ID    Type        Pool      Size     Used  Status    Message
+              persistent/1  filesystem                           detached  
+postgresql/0  db-dir/1100   block                 3.0 MiB        attached  
+transcode/0   db-dir/1000   block                                pending   creating volume
+transcode/0   shared-fs/0   filesystem  radiance  1.0 GiB  93%   attached  
+transcode/1   shared-fs/0   filesystem  radiance  1.0 GiB        attached  
+`[1:])
+}
+
 func (s *ListSuite) TestListNoPool(c *gc.C) {
 	s.mockAPI.omitPool = true
 	s.assertValidList(
@@ -324,6 +339,7 @@ type mockListAPI struct {
 	listFilesystems func([]string) ([]params.FilesystemDetailsListResult, error)
 	listVolumes     func([]string) ([]params.VolumeDetailsListResult, error)
 	omitPool        bool
+	withUsage       bool
 	time            time.Time
 }
 
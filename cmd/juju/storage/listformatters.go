@@ -4,6 +4,7 @@
 package storage
 
 import (
+	"fmt"
 	"io"
 	"sort"
 	"strconv"
@@ -22,6 +23,7 @@ func formatStorageInstancesListTabular(writer io.Writer, s CombinedStorage) erro
 
 	storagePool, storageSize := getStoragePoolAndSize(s)
 	units, byUnit := sortStorageInstancesByUnitId(s)
+	haveUsage := haveFilesystemUsage(s)
 
 	w.Print("Unit", "Storage ID", "Type")
 	if len(storagePool) > 0 {
@@ -30,7 +32,13 @@ func formatStorageInstancesListTabular(writer io.Writer, s CombinedStorage) erro
 		// We omit the column in that case.
 		w.Print("Pool")
 	}
-	w.Println("Size", "Status", "Message")
+	w.Print("Size")
+	if haveUsage {
+		// Usage is only known for filesystems attached to machines
+		// whose agents report it. We omit the column otherwise.
+		w.Print("Used")
+	}
+	w.Println("Status", "Message")
 
 	for _, unit := range units {
 		// Then sort by storage IDs
@@ -50,6 +58,9 @@ func formatStorageInstancesListTabular(writer io.Writer, s CombinedStorage) erro
 				w.Print(storagePool[info.storageId])
 			}
 			w.Print(humanizeStorageSize(storageSize[storageId]))
+			if haveUsage {
+				w.Print(formatFilesystemUsage(getFilesystemAttachment(s, info).Usage))
+			}
 			w.PrintStatus(info.status.Current)
 			w.Println(info.status.Message)
 		}
@@ -141,13 +152,18 @@ func FormatStorageListForStatusTabular(writer *ansiterm.TabWriter, s CombinedSto
 
 	storagePool, storageSize := getStoragePoolAndSize(s)
 	units, byUnit := sortStorageInstancesByUnitId(s)
+	haveUsage := haveFilesystemUsage(s)
 
 	w.Println()
 	w.Print("Storage Unit", "Storage ID", "Type")
 	if len(storagePool) > 0 {
 		w.Print("Pool")
 	}
-	w.Println("Mountpoint", "Size", "Status", "Message")
+	w.Print("Mountpoint", "Size")
+	if haveUsage {
+		w.Print("Used")
+	}
+	w.Println("Status", "Message")
 
 	for _, unit := range units {
 		byStorage := byUnit[unit]
@@ -166,8 +182,12 @@ func FormatStorageListForStatusTabular(writer *ansiterm.TabWriter, s CombinedSto
 			if len(storagePool) > 0 {
 				w.Print(storagePool[info.storageId])
 			}
-			w.Print(getFilesystemAttachment(s, info).MountPoint)
+			attachment := getFilesystemAttachment(s, info)
+			w.Print(attachment.MountPoint)
 			w.Print(humanizeStorageSize(storageSize[storageId]))
+			if haveUsage {
+				w.Print(formatFilesystemUsage(attachment.Usage))
+			}
 			w.PrintStatus(info.status.Current)
 			w.PrintColorNoTab(output.EmphasisHighlight.Gray, info.status.Message)
 			w.Println()
@@ -184,6 +204,40 @@ func humanizeStorageSize(size uint64) string {
 	return sizeStr
 }
 
+// haveFilesystemUsage reports whether usage has been reported for any
+// of the filesystem attachments.
+func haveFilesystemUsage(s CombinedStorage) bool {
+	for _, f := range s.Filesystems {
+		if f.Attachments == nil {
+			continue
+		}
+		for _, a := range f.Attachments.Machines {
+			if a.Usage != nil {
+				return true
+			}
+		}
+		for _, a := range f.Attachments.Containers {
+			if a.Usage != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatFilesystemUsage returns the percentage of space used on a
+// filesystem, or the empty string if no usage has been reported.
+func formatFilesystemUsage(usage *FilesystemUsage) string {
+	if usage == nil {
+		return ""
+	}
+	total := usage.UsedBytes + usage.AvailableBytes
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d%%", usage.UsedBytes*100/total)
+}
+
 type storageAttachmentInfo struct {
 	storageId string
 	unitId    string
@@ -0,0 +1,164 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+	"github.com/juju/utils/v4"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewResizeStorageCommandWithAPI returns a command
+// used to resize storage instances.
+func NewResizeStorageCommandWithAPI() cmd.Command {
+	command := &resizeStorageCommand{}
+	command.newEntityResizerCloser = func(ctx context.Context) (EntityResizerCloser, error) {
+		return command.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(command)
+}
+
+// NewResizeStorageCommand returns a command used to
+// resize storage instances.
+func NewResizeStorageCommand(new NewEntityResizerCloserFunc) cmd.Command {
+	command := &resizeStorageCommand{}
+	command.newEntityResizerCloser = new
+	return modelcmd.Wrap(command)
+}
+
+const (
+	resizeStorageCommandDoc = `
+Grows storage instances to a new size. Specify one or more storage IDs, as
+output by "juju storage", and the new size with --size. The size is given in
+MiB, or with a unit suffix such as M, G or T.
+
+Storage can only be grown, and only storage provisioned by the model's cloud
+may be resized; storage scoped to a machine cannot be resized. The volume or
+filesystem is resized by the cloud, and the charms of the units attached to
+the storage are then notified with the storage-resized hook, so that they may
+grow their filesystems or adjust their configuration.
+`
+
+	resizeStorageCommandExamples = `
+    juju resize-storage pgdata/0 --size 100G
+    juju resize-storage pgdata/0 pgdata/1 --size 204800
+
+`
+
+	resizeStorageCommandArgs = `<storage> [<storage> ...] --size <size>`
+)
+
+// resizeStorageCommand resizes storage instances.
+type resizeStorageCommand struct {
+	StorageCommandBase
+	newEntityResizerCloser NewEntityResizerCloserFunc
+	storageIds             []string
+
+	sizeArg string
+	size    uint64
+}
+
+// Init implements Command.Init.
+func (c *resizeStorageCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("resize-storage requires at least one storage ID")
+	}
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	if c.sizeArg == "" {
+		return errors.New("--size is required")
+	}
+	size, err := utils.ParseSize(c.sizeArg)
+	if err != nil {
+		return errors.Annotate(err, "parsing --size")
+	}
+	if size == 0 {
+		return errors.NotValidf("size %q", c.sizeArg)
+	}
+	c.size = size
+	c.storageIds = args
+	return nil
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *resizeStorageCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.sizeArg, "size", "", "The new size of the storage")
+}
+
+// Info implements Command.Info.
+func (c *resizeStorageCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "resize-storage",
+		Purpose:  "Grows storage to a new size.",
+		Doc:      resizeStorageCommandDoc,
+		Examples: resizeStorageCommandExamples,
+		Args:     resizeStorageCommandArgs,
+		SeeAlso: []string{
+			"storage",
+			"show-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *resizeStorageCommand) Run(ctx *cmd.Context) error {
+	resizer, err := c.newEntityResizerCloser(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resizer.Close()
+
+	results, err := resizer.Resize(ctx, c.storageIds, c.size)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "resize storage")
+		}
+		return err
+	}
+	for i, result := range results {
+		if result.Error == nil {
+			ctx.Infof("resizing %s to %dMiB", c.storageIds[i], c.size)
+		}
+	}
+	anyFailed := false
+	for i, result := range results {
+		if result.Error != nil {
+			ctx.Infof("failed to resize %s: %s", c.storageIds[i], result.Error)
+			anyFailed = true
+		}
+	}
+	if anyFailed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// NewEntityResizerCloserFunc is the type of a function that returns an
+// EntityResizerCloser.
+type NewEntityResizerCloserFunc func(ctx context.Context) (EntityResizerCloser, error)
+
+// EntityResizerCloser extends EntityResizer with a Closer method.
+type EntityResizerCloser interface {
+	EntityResizer
+	Close() error
+}
+
+// EntityResizer defines an interface for resizing storage with the
+// specified IDs.
+type EntityResizer interface {
+	Resize(context.Context, []string, uint64) ([]params.ErrorResult, error)
+}
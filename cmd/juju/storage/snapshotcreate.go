@@ -0,0 +1,126 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewSnapshotCreateCommand returns a command used to snapshot storage
+// instances.
+func NewSnapshotCreateCommand() cmd.Command {
+	cmd := &snapshotCreateCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (SnapshotCreateAPI, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	snapshotCreateCommandDoc = `
+Takes a snapshot of the volume or filesystem backing each of the specified
+storage instances. Specify one or more storage IDs, as output by
+"juju storage".
+
+Only storage provisioned by a cloud that supports snapshots can be
+snapshotted; storage scoped to a machine cannot be. Snapshots are taken while
+the storage remains attached, so they are crash-consistent rather than
+application-consistent.
+
+A snapshot may be used to restore storage with "juju add-storage --snapshot".
+`
+
+	snapshotCreateCommandExamples = `
+    juju create-storage-snapshot pgdata/0
+    juju create-storage-snapshot pgdata/0 pgdata/1
+`
+
+	snapshotCreateCommandArgs = `<storage> [<storage> ...]`
+)
+
+// snapshotCreateCommand snapshots storage instances.
+type snapshotCreateCommand struct {
+	StorageCommandBase
+	newAPIFunc func(ctx context.Context) (SnapshotCreateAPI, error)
+	storageIds []string
+}
+
+// Init implements Command.Init.
+func (c *snapshotCreateCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("create-storage-snapshot requires at least one storage ID")
+	}
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *snapshotCreateCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "create-storage-snapshot",
+		Purpose:  "Takes a snapshot of storage.",
+		Doc:      snapshotCreateCommandDoc,
+		Examples: snapshotCreateCommandExamples,
+		Args:     snapshotCreateCommandArgs,
+		SeeAlso: []string{
+			"storage-snapshots",
+			"remove-storage-snapshot",
+			"add-storage",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *snapshotCreateCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	results, err := api.CreateSnapshots(ctx, c.storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "snapshot storage")
+		}
+		return err
+	}
+	for i, result := range results {
+		if result.Error == nil && result.Result != nil {
+			ctx.Infof("created snapshot %s of %s", result.Result.SnapshotId, c.storageIds[i])
+		}
+	}
+	anyFailed := false
+	for i, result := range results {
+		if result.Error != nil {
+			ctx.Infof("failed to snapshot %s: %s", c.storageIds[i], result.Error)
+			anyFailed = true
+		}
+	}
+	if anyFailed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// SnapshotCreateAPI defines the API methods that the create-storage-snapshot
+// command uses.
+type SnapshotCreateAPI interface {
+	Close() error
+	CreateSnapshots(ctx context.Context, storageIds []string) ([]params.StorageSnapshotResult, error)
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"context"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type snapshotCreateSuite struct {
+	SubStorageSuite
+	mockAPI *mockSnapshotAPI
+}
+
+var _ = gc.Suite(&snapshotCreateSuite{})
+
+func (s *snapshotCreateSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.mockAPI = &mockSnapshotAPI{}
+}
+
+func (s *snapshotCreateSuite) runCreate(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, storage.NewSnapshotCreateCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *snapshotCreateSuite) TestCreate(c *gc.C) {
+	s.mockAPI.createResults = []params.StorageSnapshotResult{
+		{Result: &params.StorageSnapshot{StorageTag: "storage-foo-0", SnapshotId: "snap-1"}},
+		{Result: &params.StorageSnapshot{StorageTag: "storage-bar-1", SnapshotId: "snap-2"}},
+	}
+	ctx, err := s.runCreate(c, "foo/0", "bar/1")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCalls(c, []testing.StubCall{
+		{FuncName: "CreateSnapshots", Args: []interface{}{[]string{"foo/0", "bar/1"}}},
+		{FuncName: "Close"},
+	})
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+created snapshot snap-1 of foo/0
+created snapshot snap-2 of bar/1
+`[1:])
+}
+
+func (s *snapshotCreateSuite) TestCreateError(c *gc.C) {
+	s.mockAPI.createResults = []params.StorageSnapshotResult{
+		{Error: &params.Error{Message: "not supported"}},
+		{Result: &params.StorageSnapshot{StorageTag: "storage-bar-1", SnapshotId: "snap-2"}},
+	}
+	ctx, err := s.runCreate(c, "foo/0", "bar/1")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+created snapshot snap-2 of bar/1
+failed to snapshot foo/0: not supported
+`[1:])
+}
+
+func (s *snapshotCreateSuite) TestCreateUnauthorizedError(c *gc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeUnauthorized, Message: "nope"})
+	ctx, err := s.runCreate(c, "foo/0")
+	c.Assert(err, gc.ErrorMatches, "nope")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+You do not have permission to snapshot storage.
+You may ask an administrator to grant you access with "juju grant".
+
+`)
+}
+
+func (s *snapshotCreateSuite) TestCreateInitErrors(c *gc.C) {
+	_, err := s.runCreate(c)
+	c.Assert(err, gc.ErrorMatches, "create-storage-snapshot requires at least one storage ID")
+	_, err = s.runCreate(c, "foo")
+	c.Assert(err, gc.ErrorMatches, `storage ID "foo" not valid`)
+}
+
+type mockSnapshotAPI struct {
+	testing.Stub
+	createResults []params.StorageSnapshotResult
+	listResults   []params.StorageSnapshotsResult
+	removeResults []params.ErrorResult
+}
+
+func (m *mockSnapshotAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
+
+func (m *mockSnapshotAPI) CreateSnapshots(ctx context.Context, storageIds []string) ([]params.StorageSnapshotResult, error) {
+	m.MethodCall(m, "CreateSnapshots", storageIds)
+	return m.createResults, m.NextErr()
+}
+
+func (m *mockSnapshotAPI) ListSnapshots(ctx context.Context, storageIds []string) ([]params.StorageSnapshotsResult, error) {
+	m.MethodCall(m, "ListSnapshots", storageIds)
+	return m.listResults, m.NextErr()
+}
+
+func (m *mockSnapshotAPI) RemoveSnapshots(ctx context.Context, storageId string, snapshotIds []string) ([]params.ErrorResult, error) {
+	m.MethodCall(m, "RemoveSnapshots", storageId, snapshotIds)
+	return m.removeResults, m.NextErr()
+}
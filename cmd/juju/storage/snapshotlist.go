@@ -0,0 +1,167 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewSnapshotListCommand returns a command used to list storage snapshots.
+func NewSnapshotListCommand() cmd.Command {
+	cmd := &snapshotListCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (SnapshotListAPI, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	snapshotListCommandDoc = `
+Lists the snapshots taken of the specified storage instances. Specify one or
+more storage IDs, as output by "juju storage".
+
+Snapshots are listed from the cloud, so snapshots taken outside of Juju are
+listed too.
+`
+
+	snapshotListCommandExamples = `
+    juju storage-snapshots pgdata/0
+    juju storage-snapshots pgdata/0 pgdata/1 --format yaml
+`
+
+	snapshotListCommandArgs = `<storage> [<storage> ...]`
+)
+
+// SnapshotInfo defines the serialization behaviour of a storage snapshot.
+type SnapshotInfo struct {
+	SnapshotId string    `yaml:"id" json:"id"`
+	SourceId   string    `yaml:"source" json:"source"`
+	Size       uint64    `yaml:"size,omitempty" json:"size,omitempty"`
+	Created    time.Time `yaml:"created" json:"created"`
+	Status     string    `yaml:"status,omitempty" json:"status,omitempty"`
+}
+
+// snapshotListCommand lists storage snapshots.
+type snapshotListCommand struct {
+	StorageCommandBase
+	newAPIFunc func(ctx context.Context) (SnapshotListAPI, error)
+	storageIds []string
+	out        cmd.Output
+}
+
+// Init implements Command.Init.
+func (c *snapshotListCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("storage-snapshots requires at least one storage ID")
+	}
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *snapshotListCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "storage-snapshots",
+		Purpose:  "Lists storage snapshots.",
+		Doc:      snapshotListCommandDoc,
+		Aliases:  []string{"list-storage-snapshots"},
+		Examples: snapshotListCommandExamples,
+		Args:     snapshotListCommandArgs,
+		SeeAlso: []string{
+			"create-storage-snapshot",
+			"remove-storage-snapshot",
+			"add-storage",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *snapshotListCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSnapshotListTabular,
+	})
+}
+
+// Run implements Command.Run.
+func (c *snapshotListCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	results, err := api.ListSnapshots(ctx, c.storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "list storage snapshots")
+		}
+		return err
+	}
+
+	output := make(map[string][]SnapshotInfo)
+	var failures []string
+	for i, result := range results {
+		if result.Error != nil {
+			failures = append(failures, fmt.Sprintf("failed to list snapshots of %s: %s", c.storageIds[i], result.Error))
+			continue
+		}
+		if len(result.Result) == 0 {
+			continue
+		}
+		snapshots := make([]SnapshotInfo, len(result.Result))
+		for j, snapshot := range result.Result {
+			snapshots[j] = SnapshotInfo{
+				SnapshotId: snapshot.SnapshotId,
+				SourceId:   snapshot.SourceId,
+				Size:       snapshot.Size,
+				Created:    snapshot.Created,
+				Status:     snapshot.Status,
+			}
+		}
+		output[c.storageIds[i]] = snapshots
+	}
+	if len(output) == 0 && len(failures) == 0 {
+		ctx.Infof("No storage snapshots to display.")
+		return nil
+	}
+	if len(output) > 0 {
+		if err := c.out.Write(ctx, output); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	for _, failure := range failures {
+		ctx.Infof("%s", failure)
+	}
+	if len(failures) > 0 {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// SnapshotListAPI defines the API methods that the storage-snapshots
+// command uses.
+type SnapshotListAPI interface {
+	Close() error
+	ListSnapshots(ctx context.Context, storageIds []string) ([]params.StorageSnapshotsResult, error)
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type snapshotListSuite struct {
+	SubStorageSuite
+	mockAPI *mockSnapshotAPI
+}
+
+var _ = gc.Suite(&snapshotListSuite{})
+
+func (s *snapshotListSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.mockAPI = &mockSnapshotAPI{
+		listResults: []params.StorageSnapshotsResult{{
+			Result: []params.StorageSnapshot{{
+				StorageTag: "storage-foo-0",
+				SnapshotId: "snap-2",
+				SourceId:   "vol-1",
+				Size:       2048,
+				Created:    time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+				Status:     "pending",
+			}, {
+				StorageTag: "storage-foo-0",
+				SnapshotId: "snap-1",
+				SourceId:   "vol-1",
+				Size:       1024,
+				Created:    time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+				Status:     "completed",
+			}},
+		}, {
+			Error: &params.Error{Message: "not supported"},
+		}},
+	}
+}
+
+func (s *snapshotListSuite) runList(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, storage.NewSnapshotListCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *snapshotListSuite) TestListTabular(c *gc.C) {
+	ctx, err := s.runList(c, "foo/0", "bar/1")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	s.mockAPI.CheckCall(c, 0, "ListSnapshots", []string{"foo/0", "bar/1"})
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+Storage  Snapshot  Source  Size     Status     Created
+foo/0    snap-1    vol-1   1.0 GiB  completed  2025-01-02 00:00:00Z
+foo/0    snap-2    vol-1   2.0 GiB  pending    2025-01-03 00:00:00Z
+`[1:])
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, "failed to list snapshots of bar/1: not supported\n")
+}
+
+func (s *snapshotListSuite) TestListYAML(c *gc.C) {
+	s.mockAPI.listResults = s.mockAPI.listResults[:1]
+	ctx, err := s.runList(c, "foo/0", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, `
+foo/0:
+- id: snap-2
+  source: vol-1
+  size: 2048
+  created: 2025-01-03T00:00:00Z
+  status: pending
+- id: snap-1
+  source: vol-1
+  size: 1024
+  created: 2025-01-02T00:00:00Z
+  status: completed
+`[1:])
+}
+
+func (s *snapshotListSuite) TestListNone(c *gc.C) {
+	s.mockAPI.listResults = []params.StorageSnapshotsResult{{}}
+	ctx, err := s.runList(c, "foo/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, "No storage snapshots to display.\n")
+}
+
+func (s *snapshotListSuite) TestListInitErrors(c *gc.C) {
+	_, err := s.runList(c)
+	c.Assert(err, gc.ErrorMatches, "storage-snapshots requires at least one storage ID")
+}
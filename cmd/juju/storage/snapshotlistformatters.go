@@ -0,0 +1,50 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/core/output"
+)
+
+// formatSnapshotListTabular returns a tabular summary of storage snapshots
+// or errors out if parameter is not a map of SnapshotInfo slices.
+func formatSnapshotListTabular(writer io.Writer, value interface{}) error {
+	snapshots, ok := value.(map[string][]SnapshotInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", snapshots, value)
+	}
+
+	tw := output.TabWriter(writer)
+	print := func(values ...string) {
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+
+	print("Storage", "Snapshot", "Source", "Size", "Status", "Created")
+
+	storageIds := make([]string, 0, len(snapshots))
+	for id := range snapshots {
+		storageIds = append(storageIds, id)
+	}
+	sort.Strings(storageIds)
+	for _, id := range storageIds {
+		infos := snapshots[id]
+		// Show the most recent snapshots last.
+		sort.SliceStable(infos, func(i, j int) bool {
+			return infos[i].Created.Before(infos[j].Created)
+		})
+		for _, info := range infos {
+			created := common.FormatTime(&info.Created, true)
+			print(id, info.SnapshotId, info.SourceId, humanizeStorageSize(info.Size), info.Status, created)
+		}
+	}
+	return tw.Flush()
+}
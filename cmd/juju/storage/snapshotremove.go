@@ -0,0 +1,119 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/rpc/params"
+)
+
+// NewSnapshotRemoveCommand returns a command used to remove storage
+// snapshots.
+func NewSnapshotRemoveCommand() cmd.Command {
+	cmd := &snapshotRemoveCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (SnapshotRemoveAPI, error) {
+		return cmd.NewStorageAPI(ctx)
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	snapshotRemoveCommandDoc = `
+Removes snapshots taken of a storage instance. Specify the storage ID the
+snapshots were taken from, as output by "juju storage", followed by one or
+more snapshot IDs, as output by "juju storage-snapshots".
+
+Removing a snapshot that no longer exists is not an error.
+`
+
+	snapshotRemoveCommandExamples = `
+    juju remove-storage-snapshot pgdata/0 snap-0123456789abcdef0
+`
+
+	snapshotRemoveCommandArgs = `<storage> <snapshot> [<snapshot> ...]`
+)
+
+// snapshotRemoveCommand removes storage snapshots.
+type snapshotRemoveCommand struct {
+	StorageCommandBase
+	newAPIFunc  func(ctx context.Context) (SnapshotRemoveAPI, error)
+	storageId   string
+	snapshotIds []string
+}
+
+// Init implements Command.Init.
+func (c *snapshotRemoveCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("remove-storage-snapshot requires a storage ID and at least one snapshot ID")
+	}
+	if !names.IsValidStorage(args[0]) {
+		return errors.NotValidf("storage ID %q", args[0])
+	}
+	c.storageId = args[0]
+	c.snapshotIds = args[1:]
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *snapshotRemoveCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-storage-snapshot",
+		Purpose:  "Removes storage snapshots.",
+		Doc:      snapshotRemoveCommandDoc,
+		Examples: snapshotRemoveCommandExamples,
+		Args:     snapshotRemoveCommandArgs,
+		SeeAlso: []string{
+			"create-storage-snapshot",
+			"storage-snapshots",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *snapshotRemoveCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	results, err := api.RemoveSnapshots(ctx, c.storageId, c.snapshotIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "remove storage snapshots")
+		}
+		return err
+	}
+	for i, result := range results {
+		if result.Error == nil {
+			ctx.Infof("removed snapshot %s", c.snapshotIds[i])
+		}
+	}
+	anyFailed := false
+	for i, result := range results {
+		if result.Error != nil {
+			ctx.Infof("failed to remove snapshot %s: %s", c.snapshotIds[i], result.Error)
+			anyFailed = true
+		}
+	}
+	if anyFailed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// SnapshotRemoveAPI defines the API methods that the remove-storage-snapshot
+// command uses.
+type SnapshotRemoveAPI interface {
+	Close() error
+	RemoveSnapshots(ctx context.Context, storageId string, snapshotIds []string) ([]params.ErrorResult, error)
+}
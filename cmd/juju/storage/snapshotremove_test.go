@@ -0,0 +1,65 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/rpc/params"
+)
+
+type snapshotRemoveSuite struct {
+	SubStorageSuite
+	mockAPI *mockSnapshotAPI
+}
+
+var _ = gc.Suite(&snapshotRemoveSuite{})
+
+func (s *snapshotRemoveSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.mockAPI = &mockSnapshotAPI{}
+}
+
+func (s *snapshotRemoveSuite) runRemove(c *gc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, storage.NewSnapshotRemoveCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *snapshotRemoveSuite) TestRemove(c *gc.C) {
+	s.mockAPI.removeResults = []params.ErrorResult{{}, {}}
+	ctx, err := s.runRemove(c, "foo/0", "snap-1", "snap-2")
+	c.Assert(err, jc.ErrorIsNil)
+	s.mockAPI.CheckCalls(c, []testing.StubCall{
+		{FuncName: "RemoveSnapshots", Args: []interface{}{"foo/0", []string{"snap-1", "snap-2"}}},
+		{FuncName: "Close"},
+	})
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+removed snapshot snap-1
+removed snapshot snap-2
+`[1:])
+}
+
+func (s *snapshotRemoveSuite) TestRemoveError(c *gc.C) {
+	s.mockAPI.removeResults = []params.ErrorResult{
+		{Error: &params.Error{Message: "snapshot in use"}},
+		{},
+	}
+	ctx, err := s.runRemove(c, "foo/0", "snap-1", "snap-2")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(cmdtesting.Stderr(ctx), gc.Equals, `
+removed snapshot snap-2
+failed to remove snapshot snap-1: snapshot in use
+`[1:])
+}
+
+func (s *snapshotRemoveSuite) TestRemoveInitErrors(c *gc.C) {
+	_, err := s.runRemove(c, "foo/0")
+	c.Assert(err, gc.ErrorMatches, "remove-storage-snapshot requires a storage ID and at least one snapshot ID")
+	_, err = s.runRemove(c, "foo", "snap-1")
+	c.Assert(err, gc.ErrorMatches, `storage ID "foo" not valid`)
+}
@@ -134,3 +134,69 @@ func NewLoginCommandWithSessionLoginFactory(factory modelcmd.SessionLoginFactory
 	c.SetSessionLoginFactory(factory)
 	return modelcmd.WrapController(&c, modelcmd.WrapControllerSkipControllerFlags)
 }
+
+func NewAddRoleCommandForTest(api RoleManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &addRoleCommand{roleCommandBase: roleCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewRemoveRoleCommandForTest(api RoleManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &removeRoleCommand{roleCommandBase: roleCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewRolesCommandForTest(api RoleManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &rolesCommand{roleCommandBase: roleCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewGrantRoleCommandForTest(api RoleManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &grantRoleCommand{roleGrantCommandBase{roleCommandBase: roleCommandBase{api: api}}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewRevokeRoleCommandForTest(api RoleManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &revokeRoleCommand{roleGrantCommandBase{roleCommandBase: roleCommandBase{api: api}}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewGroupsCommandForTest(api GroupManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &groupsCommand{groupCommandBase: groupCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewGrantGroupCommandForTest(api GroupManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &grantGroupCommand{groupCommandBase: groupCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewRevokeGroupCommandForTest(api GroupManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &revokeGroupCommand{groupCommandBase: groupCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewAddServiceAccountCommandForTest(api ServiceAccountManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &addServiceAccountCommand{serviceAccountCommandBase: serviceAccountCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewIssueTokenCommandForTest(api ServiceAccountManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &issueTokenCommand{serviceAccountCommandBase: serviceAccountCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
+
+func NewRevokeTokenCommandForTest(api ServiceAccountManagerAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &revokeTokenCommand{serviceAccountCommandBase: serviceAccountCommandBase{api: api}}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
@@ -0,0 +1,304 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"context"
+	"io"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/groupmanager"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// GroupManagerAPI defines the groupmanager API methods that the group
+// commands use.
+type GroupManagerAPI interface {
+	GrantGroup(ctx context.Context, group, access string, targets ...names.Tag) error
+	RevokeGroup(ctx context.Context, group string, targets ...names.Tag) error
+	ListGroupGrants(ctx context.Context, group string) ([]groupmanager.GroupGrant, error)
+	Close() error
+}
+
+// groupCommandBase holds the code shared by the group commands.
+type groupCommandBase struct {
+	modelcmd.ControllerCommandBase
+	api GroupManagerAPI
+}
+
+func (c *groupCommandBase) getAPI(ctx context.Context) (GroupManagerAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return groupmanager.NewClient(root), nil
+}
+
+const groupsDoc = `
+Lists the access granted to groups of the controller's OpenID Connect identity
+provider. Users logging in with "juju login --oidc" have the access granted to
+the groups the identity provider claims they belong to.
+
+Only controller superusers may list group grants.
+`
+
+const groupsExamples = `
+    juju groups
+    juju groups developers
+`
+
+// NewGroupsCommand returns a command to list group grants.
+func NewGroupsCommand() cmd.Command {
+	return modelcmd.WrapController(&groupsCommand{})
+}
+
+// groupsCommand lists the access granted to groups.
+type groupsCommand struct {
+	groupCommandBase
+	out cmd.Output
+
+	group string
+}
+
+// GroupGrantInfo holds the details of a group grant for output.
+type GroupGrantInfo struct {
+	Group  string `yaml:"group" json:"group"`
+	Access string `yaml:"access" json:"access"`
+	Target string `yaml:"target" json:"target"`
+}
+
+// Info implements Command.Info.
+func (c *groupsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "groups",
+		Args:     "[<group name>]",
+		Purpose:  "Lists the access granted to identity provider groups.",
+		Doc:      groupsDoc,
+		Examples: groupsExamples,
+		Aliases:  []string{"list-groups"},
+		SeeAlso: []string{
+			"grant-group",
+			"revoke-group",
+			"login",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *groupsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatGroupsTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *groupsCommand) Init(args []string) (err error) {
+	c.group, err = cmd.ZeroOrOneArgs(args)
+	return errors.Trace(err)
+}
+
+// Run implements Command.Run.
+func (c *groupsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	grants, err := api.ListGroupGrants(ctx, c.group)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(grants) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No access granted to groups.")
+		return nil
+	}
+	result := make([]GroupGrantInfo, len(grants))
+	for i, grant := range grants {
+		result[i] = GroupGrantInfo{
+			Group:  grant.Group,
+			Access: grant.Access,
+			Target: roleGrantTarget(grant.Target),
+		}
+	}
+	return c.out.Write(ctx, result)
+}
+
+func formatGroupsTabular(writer io.Writer, value interface{}) error {
+	grants, ok := value.([]GroupGrantInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", grants, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	w.Println("Group", "Access", "Target")
+	for _, grant := range grants {
+		w.Println(grant.Group, grant.Access, grant.Target)
+	}
+	return tw.Flush()
+}
+
+const grantGroupDoc = `
+Grants access to a group of the controller's OpenID Connect identity provider
+on the specified models, or on the controller if no models are specified.
+Users logging in with "juju login --oidc" have the access granted to the
+groups the identity provider claims they belong to, in addition to any access
+granted to them directly. Granting access to a group replaces the access it
+had on the models or controller.
+
+Valid access levels for models are:
+    read
+    write
+    admin
+
+Valid access levels for the controller are:
+    login
+    superuser
+
+Controller superusers may grant access on any model or the controller, and
+model admins on their models.
+`
+
+const grantGroupExamples = `
+Allow members of the developers group to deploy to the mymodel model:
+
+    juju grant-group developers write mymodel
+
+Make members of the operators group controller superusers:
+
+    juju grant-group operators superuser
+`
+
+const revokeGroupDoc = `
+Revokes the access of a group of the controller's OpenID Connect identity
+provider on the specified models, or on the controller if no models are
+specified.
+`
+
+const revokeGroupExamples = `
+    juju revoke-group developers mymodel
+    juju revoke-group operators
+`
+
+// NewGrantGroupCommand returns a command to grant access to a group.
+func NewGrantGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&grantGroupCommand{})
+}
+
+// NewRevokeGroupCommand returns a command to revoke the access of a group.
+func NewRevokeGroupCommand() cmd.Command {
+	return modelcmd.WrapController(&revokeGroupCommand{})
+}
+
+// grantGroupCommand grants access to a group.
+type grantGroupCommand struct {
+	groupCommandBase
+	group      string
+	access     string
+	modelNames []string
+}
+
+// Info implements Command.Info.
+func (c *grantGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "grant-group",
+		Args:     "<group name> <permission> [<model name> ...]",
+		Purpose:  "Grants access to an identity provider group on models or the controller.",
+		Doc:      grantGroupDoc,
+		Examples: grantGroupExamples,
+		SeeAlso: []string{
+			"revoke-group",
+			"groups",
+			"grant",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *grantGroupCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("no group and permission specified")
+	}
+	c.group = args[0]
+	c.access = args[1]
+	c.modelNames = args[2:]
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *grantGroupCommand) Run(ctx *cmd.Context) error {
+	targets, err := grantTargets(ctx, &c.ControllerCommandBase, c.modelNames)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	err = api.GrantGroup(ctx, c.group, c.access, targets...)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
+// revokeGroupCommand revokes the access of a group.
+type revokeGroupCommand struct {
+	groupCommandBase
+	group      string
+	modelNames []string
+}
+
+// Info implements Command.Info.
+func (c *revokeGroupCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "revoke-group",
+		Args:     "<group name> [<model name> ...]",
+		Purpose:  "Revokes the access of an identity provider group on models or the controller.",
+		Doc:      revokeGroupDoc,
+		Examples: revokeGroupExamples,
+		SeeAlso: []string{
+			"grant-group",
+			"groups",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *revokeGroupCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no group specified")
+	}
+	c.group = args[0]
+	c.modelNames = args[1:]
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *revokeGroupCommand) Run(ctx *cmd.Context) error {
+	targets, err := grantTargets(ctx, &c.ControllerCommandBase, c.modelNames)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	err = api.RevokeGroup(ctx, c.group, targets...)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
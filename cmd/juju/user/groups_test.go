@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user_test
+
+import (
+	"context"
+
+	"github.com/juju/names/v6"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/client/groupmanager"
+	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type GroupsCommandSuite struct {
+	BaseSuite
+	api *mockGroupManagerAPI
+}
+
+var _ = gc.Suite(&GroupsCommandSuite{})
+
+func (s *GroupsCommandSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.api = &mockGroupManagerAPI{}
+}
+
+type mockGroupManagerAPI struct {
+	jujutesting.Stub
+	grants []groupmanager.GroupGrant
+}
+
+func (m *mockGroupManagerAPI) GrantGroup(ctx context.Context, group, access string, targets ...names.Tag) error {
+	m.MethodCall(m, "GrantGroup", group, access, targets)
+	return m.NextErr()
+}
+
+func (m *mockGroupManagerAPI) RevokeGroup(ctx context.Context, group string, targets ...names.Tag) error {
+	m.MethodCall(m, "RevokeGroup", group, targets)
+	return m.NextErr()
+}
+
+func (m *mockGroupManagerAPI) ListGroupGrants(ctx context.Context, group string) ([]groupmanager.GroupGrant, error) {
+	m.MethodCall(m, "ListGroupGrants", group)
+	return m.grants, m.NextErr()
+}
+
+func (m *mockGroupManagerAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
+
+func (s *GroupsCommandSuite) TestGroups(c *gc.C) {
+	s.api.grants = []groupmanager.GroupGrant{{
+		Group:  "developers",
+		Access: "write",
+		Target: testing.ModelTag,
+	}, {
+		Group:  "operators",
+		Access: "superuser",
+		Target: testing.ControllerTag,
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewGroupsCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "ListGroupGrants", "")
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `Group       Access     Target
+developers  write      model:`+testing.ModelTag.Id()+`
+operators   superuser  controller
+`)
+}
+
+func (s *GroupsCommandSuite) TestGroupsYAML(c *gc.C) {
+	s.api.grants = []groupmanager.GroupGrant{{
+		Group:  "developers",
+		Access: "read",
+		Target: testing.ModelTag,
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewGroupsCommandForTest(s.api, s.store), "developers", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "ListGroupGrants", "developers")
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `- group: developers
+  access: read
+  target: model:`+testing.ModelTag.Id()+`
+`)
+}
+
+func (s *GroupsCommandSuite) TestGroupsNone(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewGroupsCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No access granted to groups.\n")
+}
+
+func (s *GroupsCommandSuite) TestGrantGroupOnModels(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewGrantGroupCommandForTest(s.api, s.store), "developers", "write", "adam/test")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "GrantGroup", "developers", "write", []names.Tag{testing.ModelTag})
+}
+
+func (s *GroupsCommandSuite) TestGrantGroupOnController(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewGrantGroupCommandForTest(s.api, s.store), "operators", "superuser")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "GrantGroup", "operators", "superuser", []names.Tag{testing.ControllerTag})
+}
+
+func (s *GroupsCommandSuite) TestGrantGroupInit(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewGrantGroupCommandForTest(s.api, s.store), []string{"developers"})
+	c.Check(err, gc.ErrorMatches, "no group and permission specified")
+}
+
+func (s *GroupsCommandSuite) TestRevokeGroup(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewRevokeGroupCommandForTest(s.api, s.store), "developers", "adam/test")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "RevokeGroup", "developers", []names.Tag{testing.ModelTag})
+}
+
+func (s *GroupsCommandSuite) TestRevokeGroupInit(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewRevokeGroupCommandForTest(s.api, s.store), nil)
+	c.Check(err, gc.ErrorMatches, "no group specified")
+}
@@ -26,6 +26,10 @@ Show information about a user.`[1:]
 var helpDetails = `
 By default, the YAML format is used and the user name is the current
 user.
+
+The --logins option lists the recent password logins of the user, the
+most recent first, including failed logins and the addresses they came
+from. Only controller superusers can see the logins of other users.
 `[1:]
 
 const helpExamples = `
@@ -33,11 +37,13 @@ const helpExamples = `
     juju show-user jsmith
     juju show-user --format json
     juju show-user --format yaml
+    juju show-user jsmith --logins
 `
 
 // UserInfoAPI defines the API methods that the info command uses.
 type UserInfoAPI interface {
 	UserInfo(context.Context, []string, usermanager.IncludeDisabled) ([]params.UserInfo, error)
+	LoginHistory(context.Context, string) ([]params.LoginAttempt, error)
 	Close() error
 }
 
@@ -66,6 +72,7 @@ func NewShowUserCommand() cmd.Command {
 type infoCommand struct {
 	infoCommandBase
 	Username string
+	logins   bool
 }
 
 // UserInfo defines the serialization behaviour of the user information.
@@ -76,6 +83,16 @@ type UserInfo struct {
 	DateCreated    string `yaml:"date-created,omitempty" json:"date-created,omitempty"`
 	LastConnection string `yaml:"last-connection,omitempty" json:"last-connection,omitempty"`
 	Disabled       bool   `yaml:"disabled,omitempty" json:"disabled,omitempty"`
+
+	Logins []LoginAttempt `yaml:"logins,omitempty" json:"logins,omitempty"`
+}
+
+// LoginAttempt defines the serialization behaviour of a password login of
+// a user.
+type LoginAttempt struct {
+	Time    string `yaml:"time" json:"time"`
+	Result  string `yaml:"result" json:"result"`
+	Address string `yaml:"address,omitempty" json:"address,omitempty"`
 }
 
 // Info implements Command.Info.
@@ -97,6 +114,7 @@ func (c *infoCommand) Info() *cmd.Info {
 // SetFlags implements Command.SetFlags.
 func (c *infoCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.infoCommandBase.SetFlags(f)
+	f.BoolVar(&c.logins, "logins", false, "Show the recent logins of the user")
 	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
 }
 
@@ -139,7 +157,32 @@ func (c *infoCommand) Run(ctx *cmd.Context) (err error) {
 	if len(output) != 1 {
 		return errors.Errorf("expected 1 result, got %d", len(output))
 	}
-	return c.out.Write(ctx, output[0])
+	info := output[0]
+	if c.logins {
+		attempts, err := client.LoginHistory(ctx, username)
+		if err != nil {
+			return errors.Annotate(err, "getting login history")
+		}
+		info.Logins = c.apiLoginAttemptsToLoginAttempts(attempts)
+	}
+	return c.out.Write(ctx, info)
+}
+
+func (c *infoCommandBase) apiLoginAttemptsToLoginAttempts(attempts []params.LoginAttempt) []LoginAttempt {
+	var output []LoginAttempt
+	now := c.clock.Now()
+	for _, attempt := range attempts {
+		result := "failure"
+		if attempt.Success {
+			result = "success"
+		}
+		output = append(output, LoginAttempt{
+			Time:    common.LastConnection(&attempt.Time, now, c.exactTime),
+			Result:  result,
+			Address: attempt.Address,
+		})
+	}
+	return output
 }
 
 func (c *infoCommandBase) apiUsersToUserInfoSlice(users []params.UserInfo) []UserInfo {
@@ -69,6 +69,20 @@ func (*fakeUserInfoAPI) UserInfo(ctx context.Context, usernames []string, all us
 	return []params.UserInfo{info}, nil
 }
 
+func (*fakeUserInfoAPI) LoginHistory(ctx context.Context, username string) ([]params.LoginAttempt, error) {
+	if username != "foobar" {
+		return nil, apiservererrors.ErrPerm
+	}
+	return []params.LoginAttempt{{
+		Time:    lastConnection,
+		Success: true,
+		Address: "10.0.0.1:4567",
+	}, {
+		Time:    lastConnection.Add(-time.Minute),
+		Address: "10.0.0.2:4567",
+	}}, nil
+}
+
 func (s *UserInfoCommandSuite) TestUserInfo(c *gc.C) {
 	context, err := cmdtesting.RunCommand(c, s.NewShowUserCommand())
 	c.Assert(err, jc.ErrorIsNil)
@@ -144,3 +158,26 @@ func (s *UserInfoCommandSuite) TestTooManyArgs(c *gc.C) {
 	_, err := cmdtesting.RunCommand(c, s.NewShowUserCommand(), "username", "whoops")
 	c.Assert(err, gc.ErrorMatches, `unrecognized args: \["whoops"\]`)
 }
+
+func (s *UserInfoCommandSuite) TestUserInfoLogins(c *gc.C) {
+	context, err := cmdtesting.RunCommand(c, s.NewShowUserCommand(), "foobar", "--logins", "--exact-time")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(context), gc.Equals, `user-name: foobar
+display-name: Foo Bar
+access: login
+date-created: 1981-02-27 16:10:05 +0000 UTC
+last-connection: 2014-01-01 00:00:00 +0000 UTC
+logins:
+- time: 2014-01-01 00:00:00 +0000 UTC
+  result: success
+  address: 10.0.0.1:4567
+- time: 2013-12-31 23:59:00 +0000 UTC
+  result: failure
+  address: 10.0.0.2:4567
+`)
+}
+
+func (s *UserInfoCommandSuite) TestUserInfoLoginsPermissionDenied(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, s.NewShowUserCommand(), "--logins")
+	c.Assert(err, gc.ErrorMatches, "getting login history: permission denied")
+}
@@ -79,6 +79,10 @@ func (f *fakeUserListAPI) ModelUserInfo(ctx context.Context, modelUUID string) (
 	return userlist, nil
 }
 
+func (f *fakeUserListAPI) LoginHistory(ctx context.Context, username string) ([]params.LoginAttempt, error) {
+	return nil, errors.NotImplementedf("LoginHistory")
+}
+
 func (f *fakeUserListAPI) UserInfo(ctx context.Context, usernames []string, all usermanager.IncludeDisabled) ([]params.UserInfo, error) {
 	if len(usernames) > 0 {
 		return nil, errors.Errorf("expected no usernames, got %d", len(usernames))
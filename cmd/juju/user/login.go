@@ -33,6 +33,7 @@ import (
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/internal/cmd"
 	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/pki"
 	"github.com/juju/juju/juju"
 	"github.com/juju/juju/jujuclient"
@@ -57,6 +58,12 @@ except verify that fact.
 If the -u option is provided, the juju login command will attempt to log
 into the controller as that user.
 
+If the --oidc option is provided, the user logs in with the OpenID Connect
+identity provider configured for the controller (see the oidc-issuer-url
+controller config key): they are shown a URL to visit and a code to enter
+there. The tokens the provider issues are kept, and refreshed as they
+expire, so later commands do not need to log in again.
+
 After login, a token ("macaroon") will become active. It has an expiration
 time of 24 hours. Upon expiration, no further Juju commands can be issued
 and the user will be prompted to log in again.
@@ -74,6 +81,7 @@ const loginExamples = `
     juju login somepubliccontroller
     juju login jimm.jujucharms.com
     juju login -u bob
+    juju login --oidc
 `
 
 // Functions defined as variables so they can be overridden in tests.
@@ -104,6 +112,7 @@ type loginCommand struct {
 	noPrompt         bool
 	noPromptPassword string
 	trust            bool
+	oidc             bool
 	pollster         *interact.Pollster
 
 	// controllerName holds the name of the current controller.
@@ -143,6 +152,7 @@ func (c *loginCommand) SetFlags(fset *gnuflag.FlagSet) {
 	fset.StringVar(&c.username, "user", "", "")
 	fset.BoolVar(&c.noPrompt, "no-prompt", false, "don't prompt for password just read a line from stdin")
 	fset.BoolVar(&c.trust, "trust", false, "automatically trust controller CA certificate")
+	fset.BoolVar(&c.oidc, "oidc", false, "log in with the OpenID Connect identity provider of the controller")
 }
 
 // Init implements Command.Init.
@@ -152,6 +162,9 @@ func (c *loginCommand) Init(args []string) error {
 		return errors.Trace(err)
 	}
 	c.domain = domain
+	if c.oidc && c.username != "" {
+		return errors.New("cannot specify a username when logging in with --oidc")
+	}
 	return nil
 }
 
@@ -312,9 +325,37 @@ func (c *loginCommand) existingControllerLogin(ctx *cmd.Context, store jujuclien
 		return newAPIConnection(ctx, args)
 	}
 
+	if c.oidc || (c.username == "" && currentAccountDetails != nil && currentAccountDetails.OIDCToken != nil) {
+		// The account details hold the tokens, so the API connection
+		// logs in with the identity provider of the controller.
+		accountDetails := &jujuclient.AccountDetails{OIDCToken: &oidc.Token{}}
+		if currentAccountDetails != nil && currentAccountDetails.OIDCToken != nil {
+			accountDetails.OIDCToken = currentAccountDetails.OIDCToken
+		}
+		return c.oidcLogin(accountDetails, dial)
+	}
 	return c.login(ctx, currentAccountDetails, dial)
 }
 
+// oidcLogin logs into a controller as the user the identity provider of the
+// controller issues tokens to, recording their name in the account details.
+func (c *loginCommand) oidcLogin(
+	accountDetails *jujuclient.AccountDetails,
+	dial func(*jujuclient.AccountDetails) (api.Connection, error),
+) (api.Connection, *jujuclient.AccountDetails, error) {
+	conn, err := dial(accountDetails)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	user, ok := conn.AuthTag().(names.UserTag)
+	if !ok {
+		conn.Close()
+		return nil, nil, errors.Errorf("logged in as %v, not a user", conn.AuthTag())
+	}
+	accountDetails.User = user.Id()
+	return conn, accountDetails, nil
+}
+
 func cookieURL(host string) (*url.URL, error) {
 	if strings.Contains(host, ":") {
 		var err error
@@ -383,6 +424,33 @@ func (c *loginCommand) publicControllerLogin(
 		sessionToken = currentAccountDetails.SessionToken
 	}
 
+	if c.oidc {
+		// Log in with the identity provider of the controller, keeping
+		// the tokens it issues in the account details.
+		accountDetails := &jujuclient.AccountDetails{OIDCToken: &oidc.Token{}}
+		if currentAccountDetails != nil && currentAccountDetails.OIDCToken != nil {
+			accountDetails.OIDCToken = currentAccountDetails.OIDCToken
+		}
+		dialOpts.LoginProvider = api.NewOIDCLoginProvider(*accountDetails.OIDCToken, ctx.Stderr, func(token oidc.Token) {
+			accountDetails.OIDCToken = &token
+		})
+		conn, accountDetails, err := c.oidcLogin(accountDetails, func(*jujuclient.AccountDetails) (api.Connection, error) {
+			sniHost, _, err := net.SplitHostPort(host)
+			if err != nil {
+				return nil, errors.Annotatef(err, "getting sni host from host %q", host)
+			}
+			return apiOpen(&c.CommandBase, ctx, &api.Info{
+				Addrs:       []string{host},
+				SNIHostName: sniHost,
+			}, dialOpts)
+		})
+		if err != nil {
+			return fail(errors.Trace(err))
+		}
+		ctrlDetails.ControllerUUID = conn.ControllerTag().Id()
+		return conn, ctrlDetails, accountDetails, nil
+	}
+
 	var oidcLogin bool
 	dialOpts.LoginProvider = loginprovider.NewTryInOrderLoginProvider(
 		internallogger.GetLogger("juju.cmd.loginprovider"),
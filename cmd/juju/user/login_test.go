@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/cmd/juju/user"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/pki"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/juju"
@@ -532,6 +533,76 @@ func (s *LoginCommandSuite) TestLoginToPublicControllerWithOIDC(c *gc.C) {
 	c.Assert(acc.User, gc.Equals, "user@external")
 }
 
+func (s *LoginCommandSuite) TestLoginWithOIDCFlag(c *gc.C) {
+	var checkPatchFuncCalled bool
+	s.PatchValue(user.NewAPIConnection, func(_ context.Context, p juju.NewAPIConnectionParams) (api.Connection, error) {
+		c.Check(p.DialOpts.LoginProvider, gc.FitsTypeOf, api.NewOIDCLoginProvider(oidc.Token{}, nil, nil))
+		c.Check(p.AccountDetails.OIDCToken, gc.NotNil)
+		// The login provider updates the account details with the
+		// tokens it is issued.
+		p.AccountDetails.OIDCToken = &oidc.Token{IDToken: "id-token"}
+		checkPatchFuncCalled = true
+		return s.apiConnection, nil
+	})
+	err := s.store.RemoveAccount("testing")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, stderr, code := runLogin(c, "", "--oidc")
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(checkPatchFuncCalled, jc.IsTrue)
+	c.Check(stderr, gc.Matches, `Welcome, user@external. You are now logged into "testing".(.|\n)*`)
+
+	account := s.store.Accounts["testing"]
+	c.Check(account.User, gc.Equals, "user@external")
+	c.Check(account.OIDCToken, jc.DeepEquals, &oidc.Token{IDToken: "id-token"})
+}
+
+func (s *LoginCommandSuite) TestLoginWithOIDCToken(c *gc.C) {
+	// Without --oidc, an account which logged in with the identity
+	// provider keeps doing so.
+	s.store.Accounts["testing"] = jujuclient.AccountDetails{
+		User:      "user@external",
+		OIDCToken: &oidc.Token{IDToken: "id-token"},
+	}
+	var checkPatchFuncCalled bool
+	s.PatchValue(user.NewAPIConnection, func(_ context.Context, p juju.NewAPIConnectionParams) (api.Connection, error) {
+		c.Check(p.DialOpts.LoginProvider, gc.FitsTypeOf, api.NewOIDCLoginProvider(oidc.Token{}, nil, nil))
+		c.Check(p.AccountDetails.OIDCToken, jc.DeepEquals, &oidc.Token{IDToken: "id-token"})
+		checkPatchFuncCalled = true
+		return s.apiConnection, nil
+	})
+
+	_, _, code := runLogin(c, "")
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(checkPatchFuncCalled, jc.IsTrue)
+}
+
+func (s *LoginCommandSuite) TestLoginWithOIDCFlagAndUsername(c *gc.C) {
+	stdout, stderr, code := runLogin(c, "", "--oidc", "-u", "bob")
+	c.Assert(code, gc.Equals, 2)
+	c.Check(stdout, gc.Equals, "")
+	c.Check(stderr, gc.Equals, "ERROR cannot specify a username when logging in with --oidc\n")
+}
+
+func (s *LoginCommandSuite) TestLoginToPublicControllerWithOIDCFlag(c *gc.C) {
+	var checkPatchFuncCalled bool
+	*user.APIOpen = func(cmd *modelcmd.CommandBase, ctx context.Context, info *api.Info, opts api.DialOpts) (api.Connection, error) {
+		checkPatchFuncCalled = true
+		c.Check(info.Tag, gc.IsNil)
+		c.Check(opts.LoginProvider, gc.FitsTypeOf, api.NewOIDCLoginProvider(oidc.Token{}, nil, nil))
+		return s.apiConnection, nil
+	}
+
+	_, _, code := runLogin(c, "", "mycontroller.com", "-c", "public-oidc", "--oidc")
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(checkPatchFuncCalled, jc.IsTrue)
+
+	c.Check(s.store.Controllers["public-oidc"].ControllerUUID, gc.Equals, mockControllerUUID)
+	acc := s.store.Accounts["public-oidc"]
+	c.Check(acc.User, gc.Equals, "user@external")
+	c.Check(acc.OIDCToken, gc.NotNil)
+}
+
 func runLoginWithFakeSessionLoginProvider(c *gc.C, factory modelcmd.SessionLoginFactory, args ...string) (stdout, stderr string, errCode int) {
 	loginCmd := user.NewLoginCommandWithSessionLoginFactory(factory)
 	return run(c, "", loginCmd, args...)
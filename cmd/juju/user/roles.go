@@ -0,0 +1,509 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/rolemanager"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// RoleManagerAPI defines the rolemanager API methods that the role commands
+// use.
+type RoleManagerAPI interface {
+	AddRole(ctx context.Context, role rolemanager.Role) error
+	RemoveRole(ctx context.Context, name string) error
+	ListRoles(ctx context.Context) ([]rolemanager.Role, error)
+	GrantRole(ctx context.Context, role string, user names.UserTag, targets ...names.Tag) error
+	RevokeRole(ctx context.Context, role string, user names.UserTag, targets ...names.Tag) error
+	ListRoleGrants(ctx context.Context, role string) ([]rolemanager.RoleGrant, error)
+	Close() error
+}
+
+// roleCommandBase holds the code shared by the role commands.
+type roleCommandBase struct {
+	modelcmd.ControllerCommandBase
+	api RoleManagerAPI
+}
+
+func (c *roleCommandBase) getAPI(ctx context.Context) (RoleManagerAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return rolemanager.NewClient(root), nil
+}
+
+const addRoleDoc = `
+A role is a named set of API methods which users granted the role may call,
+in addition to those their access level allows. Roles are granted to users on
+models, or on the controller, in which case they apply to every model. The
+user must still be able to log in to the model, so they need at least read
+access to it.
+
+Methods are given as <facade>.<method>, or <facade>.* for every method of the
+facade.
+
+Only controller superusers may add roles.
+`
+
+const addRoleExamples = `
+Add a role which allows running actions, but not deploying:
+
+    juju add-role action-runner 'Action.*' --description "Runs actions"
+
+Add a role which allows creating backups:
+
+    juju add-role backup-operator Backups.Create Backups.List
+`
+
+// NewAddRoleCommand returns a command to add a role.
+func NewAddRoleCommand() cmd.Command {
+	return modelcmd.WrapController(&addRoleCommand{})
+}
+
+// addRoleCommand adds a role.
+type addRoleCommand struct {
+	roleCommandBase
+	role rolemanager.Role
+}
+
+// Info implements Command.Info.
+func (c *addRoleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-role",
+		Args:     "<role name> <method> ...",
+		Purpose:  "Adds a role allowing a set of API methods.",
+		Doc:      addRoleDoc,
+		Examples: addRoleExamples,
+		SeeAlso: []string{
+			"roles",
+			"remove-role",
+			"grant-role",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *addRoleCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.role.Description, "description", "", "A description of the role")
+}
+
+// Init implements Command.Init.
+func (c *addRoleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no role name specified")
+	}
+	if len(args) == 1 {
+		return errors.New("no methods specified")
+	}
+	c.role.Name = args[0]
+	c.role.Methods = args[1:]
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *addRoleCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.AddRole(ctx, c.role); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Added role %q", c.role.Name)
+	return nil
+}
+
+const removeRoleDoc = `
+Removes a role, revoking it from every user it was granted to.
+
+Only controller superusers may remove roles.
+`
+
+const removeRoleExamples = `
+    juju remove-role action-runner
+`
+
+// NewRemoveRoleCommand returns a command to remove a role.
+func NewRemoveRoleCommand() cmd.Command {
+	return modelcmd.WrapController(&removeRoleCommand{})
+}
+
+// removeRoleCommand removes a role.
+type removeRoleCommand struct {
+	roleCommandBase
+	name string
+}
+
+// Info implements Command.Info.
+func (c *removeRoleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "remove-role",
+		Args:     "<role name>",
+		Purpose:  "Removes a role.",
+		Doc:      removeRoleDoc,
+		Examples: removeRoleExamples,
+		SeeAlso: []string{
+			"roles",
+			"add-role",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *removeRoleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no role name specified")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *removeRoleCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.RemoveRole(ctx, c.name); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Removed role %q", c.name)
+	return nil
+}
+
+const rolesDoc = `
+Lists the roles of the controller, along with the API methods they allow.
+When --grants is specified, the users each role is granted to are listed
+instead; only controller superusers see the grants made to other users.
+`
+
+const rolesExamples = `
+    juju roles
+    juju roles --grants
+    juju roles --grants action-runner
+`
+
+// NewRolesCommand returns a command to list roles.
+func NewRolesCommand() cmd.Command {
+	return modelcmd.WrapController(&rolesCommand{})
+}
+
+// rolesCommand lists roles, or the grants of them.
+type rolesCommand struct {
+	roleCommandBase
+	out cmd.Output
+
+	grants bool
+	role   string
+}
+
+// RoleInfo holds the details of a role for output.
+type RoleInfo struct {
+	Name        string   `yaml:"name" json:"name"`
+	Description string   `yaml:"description,omitempty" json:"description,omitempty"`
+	Methods     []string `yaml:"methods" json:"methods"`
+}
+
+// RoleGrantInfo holds the details of a role grant for output.
+type RoleGrantInfo struct {
+	Role   string `yaml:"role" json:"role"`
+	User   string `yaml:"user" json:"user"`
+	Target string `yaml:"target" json:"target"`
+}
+
+// Info implements Command.Info.
+func (c *rolesCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "roles",
+		Args:     "[<role name>]",
+		Purpose:  "Lists roles, or the users they are granted to.",
+		Doc:      rolesDoc,
+		Examples: rolesExamples,
+		Aliases:  []string{"list-roles"},
+		SeeAlso: []string{
+			"add-role",
+			"grant-role",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *rolesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.BoolVar(&c.grants, "grants", false, "List the users roles are granted to")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatRolesTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *rolesCommand) Init(args []string) (err error) {
+	c.role, err = cmd.ZeroOrOneArgs(args)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if c.role != "" && !c.grants {
+		return errors.New("a role name can only be specified with --grants")
+	}
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *rolesCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if c.grants {
+		grants, err := api.ListRoleGrants(ctx, c.role)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(grants) == 0 && c.out.Name() == "tabular" {
+			ctx.Infof("No roles granted.")
+			return nil
+		}
+		result := make([]RoleGrantInfo, len(grants))
+		for i, grant := range grants {
+			result[i] = RoleGrantInfo{
+				Role:   grant.Role,
+				User:   grant.User.Id(),
+				Target: roleGrantTarget(grant.Target),
+			}
+		}
+		return c.out.Write(ctx, result)
+	}
+
+	roles, err := api.ListRoles(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(roles) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No roles to display.")
+		return nil
+	}
+	result := make([]RoleInfo, len(roles))
+	for i, role := range roles {
+		result[i] = RoleInfo{
+			Name:        role.Name,
+			Description: role.Description,
+			Methods:     role.Methods,
+		}
+	}
+	return c.out.Write(ctx, result)
+}
+
+// roleGrantTarget returns the target of a grant for output.
+func roleGrantTarget(target names.Tag) string {
+	if target.Kind() == names.ControllerTagKind {
+		return "controller"
+	}
+	return target.Kind() + ":" + target.Id()
+}
+
+func formatRolesTabular(writer io.Writer, value interface{}) error {
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+	switch value := value.(type) {
+	case []RoleInfo:
+		w.Println("Role", "Methods", "Description")
+		for _, role := range value {
+			w.Println(role.Name, strings.Join(role.Methods, ","), role.Description)
+		}
+	case []RoleGrantInfo:
+		w.Println("Role", "User", "Target")
+		for _, grant := range value {
+			w.Println(grant.Role, grant.User, grant.Target)
+		}
+	default:
+		return errors.Errorf("expected value of type %T or %T, got %T", value, []RoleInfo{}, []RoleGrantInfo{})
+	}
+	return tw.Flush()
+}
+
+const grantRoleDoc = `
+Grants a role to a user on the specified models, or on the controller if no
+models are specified, in which case the role applies to every model.
+
+Controller superusers may grant roles on any model or the controller, and
+model admins on their models.
+`
+
+const grantRoleExamples = `
+Allow bob to run actions in the mymodel model:
+
+    juju grant-role bob action-runner mymodel
+
+Allow sue to create backups of every model:
+
+    juju grant-role sue backup-operator
+`
+
+const revokeRoleDoc = `
+Revokes a role from a user on the specified models, or on the controller if no
+models are specified.
+`
+
+const revokeRoleExamples = `
+    juju revoke-role bob action-runner mymodel
+    juju revoke-role sue backup-operator
+`
+
+// NewGrantRoleCommand returns a command to grant a role to a user.
+func NewGrantRoleCommand() cmd.Command {
+	return modelcmd.WrapController(&grantRoleCommand{})
+}
+
+// NewRevokeRoleCommand returns a command to revoke a role from a user.
+func NewRevokeRoleCommand() cmd.Command {
+	return modelcmd.WrapController(&revokeRoleCommand{})
+}
+
+// roleGrantCommandBase holds the code shared by the grant-role and
+// revoke-role commands.
+type roleGrantCommandBase struct {
+	roleCommandBase
+	user       string
+	role       string
+	modelNames []string
+}
+
+// Init implements Command.Init.
+func (c *roleGrantCommandBase) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("no user and role specified")
+	}
+	c.user = args[0]
+	if !names.IsValidUser(c.user) {
+		return errors.NotValidf("user name %q", c.user)
+	}
+	c.role = args[1]
+	c.modelNames = args[2:]
+	return nil
+}
+
+// targets returns the tags of the models the role is granted on, or that of
+// the controller if no models were specified.
+func (c *roleGrantCommandBase) targets(ctx context.Context) ([]names.Tag, error) {
+	return grantTargets(ctx, &c.ControllerCommandBase, c.modelNames)
+}
+
+// grantTargets returns the tags of the named models, or that of the
+// controller if no models are named.
+func grantTargets(ctx context.Context, c *modelcmd.ControllerCommandBase, modelNames []string) ([]names.Tag, error) {
+	if len(modelNames) == 0 {
+		controllerName, err := c.ControllerName()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		details, err := c.ClientStore().ControllerByName(controllerName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return []names.Tag{names.NewControllerTag(details.ControllerUUID)}, nil
+	}
+	uuids, err := c.ModelUUIDs(ctx, modelNames)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	targets := make([]names.Tag, len(uuids))
+	for i, uuid := range uuids {
+		targets[i] = names.NewModelTag(uuid)
+	}
+	return targets, nil
+}
+
+func (c *roleGrantCommandBase) run(
+	ctx *cmd.Context,
+	change func(RoleManagerAPI, context.Context, string, names.UserTag, ...names.Tag) error,
+) error {
+	targets, err := c.targets(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	err = change(api, ctx, c.role, names.NewUserTag(c.user), targets...)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
+
+// grantRoleCommand grants a role to a user.
+type grantRoleCommand struct {
+	roleGrantCommandBase
+}
+
+// Info implements Command.Info.
+func (c *grantRoleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "grant-role",
+		Args:     "<user name> <role name> [<model name> ...]",
+		Purpose:  "Grants a role to a user on models or the controller.",
+		Doc:      grantRoleDoc,
+		Examples: grantRoleExamples,
+		SeeAlso: []string{
+			"revoke-role",
+			"roles",
+			"grant",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *grantRoleCommand) Run(ctx *cmd.Context) error {
+	return c.run(ctx, RoleManagerAPI.GrantRole)
+}
+
+// revokeRoleCommand revokes a role from a user.
+type revokeRoleCommand struct {
+	roleGrantCommandBase
+}
+
+// Info implements Command.Info.
+func (c *revokeRoleCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "revoke-role",
+		Args:     "<user name> <role name> [<model name> ...]",
+		Purpose:  "Revokes a role from a user on models or the controller.",
+		Doc:      revokeRoleDoc,
+		Examples: revokeRoleExamples,
+		SeeAlso: []string{
+			"grant-role",
+			"roles",
+		},
+	})
+}
+
+// Run implements Command.Run.
+func (c *revokeRoleCommand) Run(ctx *cmd.Context) error {
+	return c.run(ctx, RoleManagerAPI.RevokeRole)
+}
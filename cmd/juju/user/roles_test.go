@@ -0,0 +1,175 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user_test
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/client/rolemanager"
+	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type RolesCommandSuite struct {
+	BaseSuite
+	api *mockRoleManagerAPI
+}
+
+var _ = gc.Suite(&RolesCommandSuite{})
+
+func (s *RolesCommandSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.api = &mockRoleManagerAPI{}
+}
+
+type mockRoleManagerAPI struct {
+	jujutesting.Stub
+	roles  []rolemanager.Role
+	grants []rolemanager.RoleGrant
+}
+
+func (m *mockRoleManagerAPI) AddRole(ctx context.Context, role rolemanager.Role) error {
+	m.MethodCall(m, "AddRole", role)
+	return m.NextErr()
+}
+
+func (m *mockRoleManagerAPI) RemoveRole(ctx context.Context, name string) error {
+	m.MethodCall(m, "RemoveRole", name)
+	return m.NextErr()
+}
+
+func (m *mockRoleManagerAPI) ListRoles(ctx context.Context) ([]rolemanager.Role, error) {
+	m.MethodCall(m, "ListRoles")
+	return m.roles, m.NextErr()
+}
+
+func (m *mockRoleManagerAPI) GrantRole(ctx context.Context, role string, user names.UserTag, targets ...names.Tag) error {
+	m.MethodCall(m, "GrantRole", role, user, targets)
+	return m.NextErr()
+}
+
+func (m *mockRoleManagerAPI) RevokeRole(ctx context.Context, role string, user names.UserTag, targets ...names.Tag) error {
+	m.MethodCall(m, "RevokeRole", role, user, targets)
+	return m.NextErr()
+}
+
+func (m *mockRoleManagerAPI) ListRoleGrants(ctx context.Context, role string) ([]rolemanager.RoleGrant, error) {
+	m.MethodCall(m, "ListRoleGrants", role)
+	return m.grants, m.NextErr()
+}
+
+func (m *mockRoleManagerAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
+
+func (s *RolesCommandSuite) TestAddRole(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewAddRoleCommandForTest(s.api, s.store),
+		"action-runner", "Action.*", "Application.Get", "--description", "Runs actions")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "AddRole", rolemanager.Role{
+		Name:        "action-runner",
+		Description: "Runs actions",
+		Methods:     []string{"Action.*", "Application.Get"},
+	})
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Added role \"action-runner\"\n")
+}
+
+func (s *RolesCommandSuite) TestAddRoleInit(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewAddRoleCommandForTest(s.api, s.store), nil)
+	c.Check(err, gc.ErrorMatches, "no role name specified")
+	err = cmdtesting.InitCommand(user.NewAddRoleCommandForTest(s.api, s.store), []string{"action-runner"})
+	c.Check(err, gc.ErrorMatches, "no methods specified")
+}
+
+func (s *RolesCommandSuite) TestRemoveRole(c *gc.C) {
+	s.api.SetErrors(errors.NotFoundf(`role "action-runner"`))
+	_, err := cmdtesting.RunCommand(c, user.NewRemoveRoleCommandForTest(s.api, s.store), "action-runner")
+	c.Assert(err, gc.ErrorMatches, `role "action-runner" not found`)
+	s.api.CheckCall(c, 0, "RemoveRole", "action-runner")
+}
+
+func (s *RolesCommandSuite) TestRoles(c *gc.C) {
+	s.api.roles = []rolemanager.Role{{
+		Name:        "action-runner",
+		Description: "Runs actions",
+		Methods:     []string{"Action.*", "Application.Get"},
+	}, {
+		Name:    "backup-operator",
+		Methods: []string{"Backups.Create"},
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewRolesCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `
+Role             Methods                   Description
+action-runner    Action.*,Application.Get  Runs actions
+backup-operator  Backups.Create            
+`[1:])
+}
+
+func (s *RolesCommandSuite) TestRolesNone(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewRolesCommandForTest(s.api, s.store))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "No roles to display.\n")
+}
+
+func (s *RolesCommandSuite) TestRoleGrants(c *gc.C) {
+	s.api.grants = []rolemanager.RoleGrant{{
+		Role:   "action-runner",
+		User:   names.NewUserTag("bob"),
+		Target: testing.ModelTag,
+	}, {
+		Role:   "action-runner",
+		User:   names.NewUserTag("sue"),
+		Target: testing.ControllerTag,
+	}}
+	ctx, err := cmdtesting.RunCommand(c, user.NewRolesCommandForTest(s.api, s.store), "--grants", "action-runner", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "ListRoleGrants", "action-runner")
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `- role: action-runner
+  user: bob
+  target: model:`+testing.ModelTag.Id()+`
+- role: action-runner
+  user: sue
+  target: controller
+`)
+}
+
+func (s *RolesCommandSuite) TestRolesNameWithoutGrants(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewRolesCommandForTest(s.api, s.store), []string{"action-runner"})
+	c.Assert(err, gc.ErrorMatches, "a role name can only be specified with --grants")
+}
+
+func (s *RolesCommandSuite) TestGrantRoleOnModels(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewGrantRoleCommandForTest(s.api, s.store), "bob", "action-runner", "adam/test")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "GrantRole", "action-runner", names.NewUserTag("bob"), []names.Tag{testing.ModelTag})
+}
+
+func (s *RolesCommandSuite) TestGrantRoleOnController(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewGrantRoleCommandForTest(s.api, s.store), "bob", "action-runner")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "GrantRole", "action-runner", names.NewUserTag("bob"), []names.Tag{testing.ControllerTag})
+}
+
+func (s *RolesCommandSuite) TestGrantRoleInit(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewGrantRoleCommandForTest(s.api, s.store), []string{"bob"})
+	c.Check(err, gc.ErrorMatches, "no user and role specified")
+	err = cmdtesting.InitCommand(user.NewGrantRoleCommandForTest(s.api, s.store), []string{"bob!", "action-runner"})
+	c.Check(err, gc.ErrorMatches, `user name "bob!" not valid`)
+}
+
+func (s *RolesCommandSuite) TestRevokeRole(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewRevokeRoleCommandForTest(s.api, s.store), "bob", "action-runner", "adam/test")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "RevokeRole", "action-runner", names.NewUserTag("bob"), []names.Tag{testing.ModelTag})
+}
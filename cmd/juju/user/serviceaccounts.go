@@ -0,0 +1,285 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/serviceaccountmanager"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// defaultTokenExpiry is how long the API tokens issued by issue-token are
+// valid for unless --expires-in is specified.
+const defaultTokenExpiry = 30 * 24 * time.Hour
+
+// ServiceAccountManagerAPI defines the serviceaccountmanager API methods
+// that the service account commands use.
+type ServiceAccountManagerAPI interface {
+	AddServiceAccount(ctx context.Context, name string) error
+	IssueToken(ctx context.Context, account, name, access string, expiresIn time.Duration, targets ...names.Tag) (string, error)
+	RevokeToken(ctx context.Context, account, name string) error
+	Close() error
+}
+
+// serviceAccountCommandBase holds the code shared by the service account
+// commands.
+type serviceAccountCommandBase struct {
+	modelcmd.ControllerCommandBase
+	api ServiceAccountManagerAPI
+}
+
+func (c *serviceAccountCommandBase) getAPI(ctx context.Context) (ServiceAccountManagerAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return serviceaccountmanager.NewClient(root), nil
+}
+
+const addServiceAccountDoc = `
+Adds a service account to the controller. Service accounts are non-human users
+for automation such as CI pipelines. They have no password and authenticate
+with named API tokens issued with "juju issue-token".
+
+Service accounts are granted access on models and the controller like any other
+user, with "juju grant". A token never allows more than the access granted to
+its service account.
+
+Only controller superusers may add service accounts.
+`
+
+const addServiceAccountExamples = `
+    juju add-service-account ci
+    juju grant ci write mymodel
+`
+
+const issueTokenDoc = `
+Issues a named API token to a service account, allowing the specified access
+on the specified models, or on the controller if no models are specified.
+
+The token is printed once and can not be retrieved again, as the controller
+only keeps a hash of it. Clients authenticate with the token by sending it in
+an "Authorization: Bearer <token>" header, or as the token of an API login
+request.
+
+Tokens expire after 30 days unless --expires-in is specified. A token issued
+with --expires-in=0 never expires. Tokens remain usable until they expire or
+are revoked with "juju revoke-token".
+
+Valid access levels for models are:
+    read
+    write
+    admin
+
+Valid access levels for the controller are:
+    login
+    superuser
+
+Only controller superusers may issue tokens.
+`
+
+const issueTokenExamples = `
+Issue a token allowing the ci service account to deploy to two models for a
+week:
+
+    juju issue-token ci pipeline write staging production --expires-in=168h
+`
+
+const revokeTokenDoc = `
+Revokes a named API token of a service account. The token can not be used to
+log in once it is revoked.
+
+Only controller superusers may revoke tokens.
+`
+
+const revokeTokenExamples = `
+    juju revoke-token ci pipeline
+`
+
+// NewAddServiceAccountCommand returns a command to add a service account.
+func NewAddServiceAccountCommand() cmd.Command {
+	return modelcmd.WrapController(&addServiceAccountCommand{})
+}
+
+// NewIssueTokenCommand returns a command to issue an API token to a
+// service account.
+func NewIssueTokenCommand() cmd.Command {
+	return modelcmd.WrapController(&issueTokenCommand{})
+}
+
+// NewRevokeTokenCommand returns a command to revoke an API token of a
+// service account.
+func NewRevokeTokenCommand() cmd.Command {
+	return modelcmd.WrapController(&revokeTokenCommand{})
+}
+
+// addServiceAccountCommand adds a service account.
+type addServiceAccountCommand struct {
+	serviceAccountCommandBase
+	name string
+}
+
+// Info implements Command.Info.
+func (c *addServiceAccountCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "add-service-account",
+		Args:     "<service account name>",
+		Purpose:  "Adds a service account to the controller.",
+		Doc:      addServiceAccountDoc,
+		Examples: addServiceAccountExamples,
+		SeeAlso: []string{
+			"issue-token",
+			"revoke-token",
+			"grant",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *addServiceAccountCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no service account name specified")
+	}
+	c.name = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements Command.Run.
+func (c *addServiceAccountCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	if err := api.AddServiceAccount(ctx, c.name); err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	ctx.Infof("Service account %q added", c.name)
+	return nil
+}
+
+// issueTokenCommand issues an API token to a service account.
+type issueTokenCommand struct {
+	serviceAccountCommandBase
+	account    string
+	name       string
+	access     string
+	modelNames []string
+	expiresIn  time.Duration
+}
+
+// Info implements Command.Info.
+func (c *issueTokenCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "issue-token",
+		Args:     "<service account name> <token name> <permission> [<model name> ...]",
+		Purpose:  "Issues an API token to a service account.",
+		Doc:      issueTokenDoc,
+		Examples: issueTokenExamples,
+		SeeAlso: []string{
+			"add-service-account",
+			"revoke-token",
+		},
+	})
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *issueTokenCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.DurationVar(&c.expiresIn, "expires-in", defaultTokenExpiry, "How long the token is valid for, or 0 for a token which never expires")
+}
+
+// Init implements Command.Init.
+func (c *issueTokenCommand) Init(args []string) error {
+	if len(args) < 3 {
+		return errors.New("no service account, token name and permission specified")
+	}
+	if c.expiresIn < 0 {
+		return errors.NotValidf("negative --expires-in %v", c.expiresIn)
+	}
+	c.account = args[0]
+	c.name = args[1]
+	c.access = args[2]
+	c.modelNames = args[3:]
+	return nil
+}
+
+// Run implements Command.Run.
+func (c *issueTokenCommand) Run(ctx *cmd.Context) error {
+	targets, err := grantTargets(ctx, &c.ControllerCommandBase, c.modelNames)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	token, err := api.IssueToken(ctx, c.account, c.name, c.access, c.expiresIn, targets...)
+	if err != nil {
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	fmt.Fprintln(ctx.Stdout, token)
+	ctx.Infof("Store the token securely, it can not be shown again.")
+	return nil
+}
+
+// revokeTokenCommand revokes an API token of a service account.
+type revokeTokenCommand struct {
+	serviceAccountCommandBase
+	account string
+	name    string
+}
+
+// Info implements Command.Info.
+func (c *revokeTokenCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "revoke-token",
+		Args:     "<service account name> <token name>",
+		Purpose:  "Revokes an API token of a service account.",
+		Doc:      revokeTokenDoc,
+		Examples: revokeTokenExamples,
+		SeeAlso: []string{
+			"add-service-account",
+			"issue-token",
+		},
+	})
+}
+
+// Init implements Command.Init.
+func (c *revokeTokenCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("no service account and token name specified")
+	}
+	c.account = args[0]
+	c.name = args[1]
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Run implements Command.Run.
+func (c *revokeTokenCommand) Run(ctx *cmd.Context) error {
+	api, err := c.getAPI(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	err = api.RevokeToken(ctx, c.account, c.name)
+	return block.ProcessBlockedError(err, block.BlockChange)
+}
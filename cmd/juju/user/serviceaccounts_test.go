@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package user_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/names/v6"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/cmd/juju/user"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testing"
+)
+
+type ServiceAccountsCommandSuite struct {
+	BaseSuite
+	api *mockServiceAccountManagerAPI
+}
+
+var _ = gc.Suite(&ServiceAccountsCommandSuite{})
+
+func (s *ServiceAccountsCommandSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.api = &mockServiceAccountManagerAPI{}
+}
+
+type mockServiceAccountManagerAPI struct {
+	jujutesting.Stub
+}
+
+func (m *mockServiceAccountManagerAPI) AddServiceAccount(ctx context.Context, name string) error {
+	m.MethodCall(m, "AddServiceAccount", name)
+	return m.NextErr()
+}
+
+func (m *mockServiceAccountManagerAPI) IssueToken(
+	ctx context.Context, account, name, access string, expiresIn time.Duration, targets ...names.Tag,
+) (string, error) {
+	m.MethodCall(m, "IssueToken", account, name, access, expiresIn, targets)
+	return "juju-sa-token", m.NextErr()
+}
+
+func (m *mockServiceAccountManagerAPI) RevokeToken(ctx context.Context, account, name string) error {
+	m.MethodCall(m, "RevokeToken", account, name)
+	return m.NextErr()
+}
+
+func (m *mockServiceAccountManagerAPI) Close() error {
+	m.MethodCall(m, "Close")
+	return nil
+}
+
+func (s *ServiceAccountsCommandSuite) TestAddServiceAccount(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewAddServiceAccountCommandForTest(s.api, s.store), "ci")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "AddServiceAccount", "ci")
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "Service account \"ci\" added\n")
+}
+
+func (s *ServiceAccountsCommandSuite) TestAddServiceAccountInit(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewAddServiceAccountCommandForTest(s.api, s.store), nil)
+	c.Check(err, gc.ErrorMatches, "no service account name specified")
+	err = cmdtesting.InitCommand(user.NewAddServiceAccountCommandForTest(s.api, s.store), []string{"ci", "extra"})
+	c.Check(err, gc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *ServiceAccountsCommandSuite) TestIssueTokenOnModels(c *gc.C) {
+	ctx, err := cmdtesting.RunCommand(c, user.NewIssueTokenCommandForTest(s.api, s.store),
+		"ci", "pipeline", "write", "adam/test", "--expires-in", "1h")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "IssueToken", "ci", "pipeline", "write", time.Hour, []names.Tag{testing.ModelTag})
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "juju-sa-token\n")
+}
+
+func (s *ServiceAccountsCommandSuite) TestIssueTokenOnController(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewIssueTokenCommandForTest(s.api, s.store), "ci", "admin-token", "superuser")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "IssueToken", "ci", "admin-token", "superuser", 30*24*time.Hour, []names.Tag{testing.ControllerTag})
+}
+
+func (s *ServiceAccountsCommandSuite) TestIssueTokenNeverExpires(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewIssueTokenCommandForTest(s.api, s.store), "ci", "pipeline", "login", "--expires-in", "0")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "IssueToken", "ci", "pipeline", "login", time.Duration(0), []names.Tag{testing.ControllerTag})
+}
+
+func (s *ServiceAccountsCommandSuite) TestIssueTokenInit(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewIssueTokenCommandForTest(s.api, s.store), []string{"ci", "pipeline"})
+	c.Check(err, gc.ErrorMatches, "no service account, token name and permission specified")
+	err = cmdtesting.InitCommand(user.NewIssueTokenCommandForTest(s.api, s.store), []string{"ci", "pipeline", "read", "--expires-in", "-1h"})
+	c.Check(err, gc.ErrorMatches, "negative --expires-in -1h0m0s not valid")
+}
+
+func (s *ServiceAccountsCommandSuite) TestRevokeToken(c *gc.C) {
+	_, err := cmdtesting.RunCommand(c, user.NewRevokeTokenCommandForTest(s.api, s.store), "ci", "pipeline")
+	c.Assert(err, jc.ErrorIsNil)
+	s.api.CheckCall(c, 0, "RevokeToken", "ci", "pipeline")
+}
+
+func (s *ServiceAccountsCommandSuite) TestRevokeTokenInit(c *gc.C) {
+	err := cmdtesting.InitCommand(user.NewRevokeTokenCommandForTest(s.api, s.store), []string{"ci"})
+	c.Check(err, gc.ErrorMatches, "no service account and token name specified")
+}
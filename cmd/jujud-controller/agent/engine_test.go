@@ -53,6 +53,7 @@ var (
 		"undertaker",
 		"unit-assigner", // tertiary dependency: will be inactive because migration workers will be inactive
 		"user-secrets-drain-worker",
+		"user-secrets-rotator",
 	}
 	aliveModelWorkers = []string{
 		"application-scaler",
@@ -74,6 +75,7 @@ var (
 		"storage-provisioner",
 		"unit-assigner",
 		"user-secrets-drain-worker",
+		"user-secrets-rotator",
 	}
 	migratingModelWorkers = []string{
 		"provider-tracker",
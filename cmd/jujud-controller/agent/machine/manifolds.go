@@ -118,6 +118,7 @@ import (
 	"github.com/juju/juju/internal/worker/upgrader"
 	"github.com/juju/juju/internal/worker/upgradesteps"
 	"github.com/juju/juju/internal/worker/upgradestepsmachine"
+	"github.com/juju/juju/internal/worker/utilisationreporter"
 	"github.com/juju/juju/state"
 )
 
@@ -798,12 +799,22 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 
 		// The ssh server worker runs on the controller machine.
 		sshServerName: ifController(sshserver.Manifold(sshserver.ManifoldConfig{
-			DomainServicesName:         domainServicesName,
-			Logger:                     internallogger.GetLogger("juju.worker.sshserver"),
-			NewServerWrapperWorker:     sshserver.NewServerWrapperWorker,
-			NewServerWorker:            sshserver.NewServerWorker,
-			GetControllerConfigService: sshserver.GetControllerConfigService,
-			NewSSHServerListener:       sshserver.NewSSHServerListener,
+			DomainServicesName:          domainServicesName,
+			StateName:                   stateName,
+			ProviderFactoryName:         providerTrackerName,
+			Logger:                      internallogger.GetLogger("juju.worker.sshserver"),
+			NewServerWrapperWorker:      sshserver.NewServerWrapperWorker,
+			NewServerWorker:             sshserver.NewServerWorker,
+			GetControllerConfigService:  sshserver.GetControllerConfigService,
+			GetSessionRecordingService:  sshserver.GetSessionRecordingService,
+			GetSSHAccessGrantService:    sshserver.GetSSHAccessGrantService,
+			GetUserCertificateService:   sshserver.GetUserCertificateService,
+			GetUserAccessService:        sshserver.GetUserAccessService,
+			GetModelService:             sshserver.GetModelService,
+			GetApplicationServiceGetter: sshserver.GetApplicationServiceGetter,
+			NewTunnelTracker:            sshserver.NewTunnelTracker,
+			NewSSHServerListener:        sshserver.NewSSHServerListener,
+			Clock:                       config.Clock,
 		})),
 
 		objectStoreName: ifDatabaseUpgradeComplete(objectstore.Manifold(objectstore.ManifoldConfig{
@@ -996,6 +1007,14 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			APICallerName: apiCallerName,
 		})),
 
+		// The utilisation reporter worker periodically reports the CPU and
+		// memory utilisation of the machine it runs on, from which instance
+		// types are recommended for applications.
+		utilisationReporterName: ifNotMigrating(utilisationreporter.Manifold(utilisationreporter.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+		})),
+
 		// The api address updater is a leaf worker that rewrites agent config
 		// as the state server addresses change. We should only need one of
 		// these in a consolidated agent.
@@ -1317,6 +1336,7 @@ const (
 	dbAccessorName                = "db-accessor"
 	deployerName                  = "deployer"
 	diskManagerName               = "disk-manager"
+	utilisationReporterName       = "utilisation-reporter"
 	domainServicesName            = "domain-services"
 	externalControllerUpdaterName = "external-controller-updater"
 	fileNotifyWatcherName         = "file-notify-watcher"
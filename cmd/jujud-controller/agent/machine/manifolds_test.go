@@ -143,6 +143,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *gc.C) {
 			"upgrade-steps-gate",
 			"upgrade-steps-runner",
 			"upgrader",
+			"utilisation-reporter",
 			"valid-credential-flag",
 		},
 	)
@@ -1328,6 +1329,7 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"provider-services",
 		"provider-tracker",
 		"query-logger",
+		"state",
 		"state-config-watcher",
 		"storage-registry",
 		"trace",
@@ -1502,6 +1504,18 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-steps-gate",
 	},
 
+	"utilisation-reporter": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
 	"valid-credential-flag": {
 		"agent",
 		"api-caller",
@@ -2134,6 +2148,7 @@ var expectedMachineManifoldsWithDependenciesCAAS = map[string][]string{
 		"provider-services",
 		"provider-tracker",
 		"query-logger",
+		"state",
 		"state-config-watcher",
 		"storage-registry",
 		"trace",
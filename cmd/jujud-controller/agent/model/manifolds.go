@@ -25,12 +25,14 @@ import (
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/internal/pki"
+	"github.com/juju/juju/internal/secrets/generator"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/agent"
 	"github.com/juju/juju/internal/worker/apicaller"
 	"github.com/juju/juju/internal/worker/apiconfigwatcher"
 	"github.com/juju/juju/internal/worker/asynccharmdownloader"
 	"github.com/juju/juju/internal/worker/caasapplicationprovisioner"
+	"github.com/juju/juju/internal/worker/caasautoscaler"
 	"github.com/juju/juju/internal/worker/caasfirewaller"
 	"github.com/juju/juju/internal/worker/caasmodelconfigmanager"
 	"github.com/juju/juju/internal/worker/caasmodeloperator"
@@ -57,6 +59,7 @@ import (
 	"github.com/juju/juju/internal/worker/storageprovisioner"
 	"github.com/juju/juju/internal/worker/undertaker"
 	"github.com/juju/juju/internal/worker/unitassigner"
+	"github.com/juju/juju/internal/worker/usersecretsrotator"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -345,6 +348,15 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewUserSecretsFacade: secretspruner.NewUserSecretsFacade,
 			NewWorker:            secretspruner.NewWorker,
 		})),
+		userSecretsRotatorName: ifNotMigrating(usersecretsrotator.Manifold(usersecretsrotator.ManifoldConfig{
+			APICallerName:        apiCallerName,
+			Authority:            config.Authority,
+			Clock:                config.Clock,
+			Logger:               config.LoggingContext.GetLogger("juju.worker.usersecretsrotator"),
+			NewUserSecretsFacade: usersecretsrotator.NewUserSecretsFacade,
+			NewGenerator:         generator.New,
+			NewWorker:            usersecretsrotator.NewWorker,
+		})),
 		// The userSecretsDrainWorker is the worker that drains the user secrets from the inactive backend to the current active backend.
 		userSecretsDrainWorker: ifNotMigrating(secretsdrainworker.Manifold(secretsdrainworker.ManifoldConfig{
 			APICallerName:         apiCallerName,
@@ -406,6 +418,7 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			DomainServicesName: domainServicesName,
 			GetMachineService:  provisioner.GetMachineService,
 			Logger:             config.LoggingContext.GetLogger("juju.worker.provisioner"),
+			Clock:              config.Clock,
 
 			NewProvisionerFunc: provisioner.NewEnvironProvisioner,
 		})),
@@ -521,6 +534,15 @@ func CAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 				Logger:        config.LoggingContext.GetLogger("juju.worker.caasapplicationprovisioner"),
 			},
 		)),
+		caasAutoscalerName: ifNotMigrating(caasautoscaler.Manifold(
+			caasautoscaler.ManifoldConfig{
+				BrokerName:         providerTrackerName,
+				DomainServicesName: domainServicesName,
+				Clock:              config.Clock,
+				Logger:             config.LoggingContext.GetLogger("juju.worker.caasautoscaler"),
+				NewWorker:          caasautoscaler.NewWorker,
+			},
+		)),
 		caasStorageProvisionerName: ifNotMigrating(ifCredentialValid(storageprovisioner.ModelManifold(storageprovisioner.ModelManifoldConfig{
 			APICallerName:       apiCallerName,
 			Clock:               config.Clock,
@@ -646,10 +668,12 @@ const (
 	caasModelOperatorName          = "caas-model-operator"
 	caasmodelconfigmanagerName     = "caas-model-config-manager"
 	caasApplicationProvisionerName = "caas-application-provisioner"
+	caasAutoscalerName             = "caas-autoscaler"
 	caasStorageProvisionerName     = "caas-storage-provisioner"
 
 	secretsPrunerName      = "secrets-pruner"
 	userSecretsDrainWorker = "user-secrets-drain-worker"
+	userSecretsRotatorName = "user-secrets-rotator"
 
 	validCredentialFlagName = "valid-credential-flag"
 )
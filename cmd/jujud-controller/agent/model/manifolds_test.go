@@ -67,6 +67,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *gc.C) {
 		"undertaker",
 		"unit-assigner",
 		"user-secrets-drain-worker",
+		"user-secrets-rotator",
 		"valid-credential-flag",
 	})
 }
@@ -88,6 +89,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *gc.C) {
 		"api-config-watcher",
 		"async-charm-downloader",
 		"caas-application-provisioner",
+		"caas-autoscaler",
 		"caas-firewaller",
 		"caas-model-config-manager",
 		"caas-model-operator",
@@ -112,6 +114,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *gc.C) {
 		"state-cleaner",
 		"undertaker",
 		"user-secrets-drain-worker",
+		"user-secrets-rotator",
 		"valid-credential-flag",
 	})
 }
@@ -292,6 +295,20 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"valid-credential-flag",
 	},
 
+	"caas-autoscaler": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+		"provider-service-factories",
+		"provider-tracker",
+		"valid-credential-flag",
+	},
+
 	"caas-storage-provisioner": {
 		"agent",
 		"api-caller",
@@ -422,6 +439,17 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"not-alive-flag",
 	},
 
+	"user-secrets-rotator": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"valid-credential-flag": {"agent", "api-caller"},
 }
 
@@ -676,5 +704,16 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"not-dead-flag",
 	},
 
+	"user-secrets-rotator": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"valid-credential-flag": {"agent", "api-caller"},
 }
@@ -66,6 +66,7 @@ import (
 	"github.com/juju/juju/internal/worker/trace"
 	"github.com/juju/juju/internal/worker/upgrader"
 	"github.com/juju/juju/internal/worker/upgradestepsmachine"
+	"github.com/juju/juju/internal/worker/utilisationreporter"
 	"github.com/juju/juju/state"
 )
 
@@ -426,6 +427,14 @@ func IAASManifolds(config ManifoldsConfig) dependency.Manifolds {
 			APICallerName: apiCallerName,
 		})),
 
+		// The utilisation reporter worker periodically reports the CPU and
+		// memory utilisation of the machine it runs on, from which instance
+		// types are recommended for applications.
+		utilisationReporterName: ifNotMigrating(utilisationreporter.Manifold(utilisationreporter.ManifoldConfig{
+			AgentName:     agentName,
+			APICallerName: apiCallerName,
+		})),
+
 		// The api address updater is a leaf worker that rewrites agent config
 		// as the state server addresses change. We should only need one of
 		// these in a consolidated agent.
@@ -638,6 +647,7 @@ const (
 	rebootName               = "reboot-executor"
 	loggingConfigUpdaterName = "logging-config-updater"
 	diskManagerName          = "disk-manager"
+	utilisationReporterName  = "utilisation-reporter"
 	proxyConfigUpdater       = "proxy-config-updater"
 	apiAddressUpdaterName    = "api-address-updater"
 	machinerName             = "machiner"
@@ -99,6 +99,7 @@ func (s *ManifoldsSuite) TestManifoldNamesIAAS(c *gc.C) {
 			"upgrade-steps-gate",
 			"upgrade-steps-runner",
 			"upgrader",
+			"utilisation-reporter",
 			"valid-credential-flag",
 		},
 	)
@@ -685,6 +686,18 @@ var expectedMachineManifoldsWithDependenciesIAAS = map[string][]string{
 		"upgrade-steps-gate",
 	},
 
+	"utilisation-reporter": {
+		"agent",
+		"api-caller",
+		"api-config-watcher",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"upgrade-check-flag",
+		"upgrade-check-gate",
+		"upgrade-steps-flag",
+		"upgrade-steps-gate",
+	},
+
 	"valid-credential-flag": {
 		"agent",
 		"api-caller",
@@ -29,6 +29,7 @@ import (
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/pki"
 	proxyerrors "github.com/juju/juju/internal/proxy/errors"
 	"github.com/juju/juju/juju"
@@ -611,7 +612,8 @@ func newAPIConnectionParams(
 		return juju.NewAPIConnectionParams{}, errors.Annotatef(err, "getting controller %q", controllerName)
 	}
 
-	if controllerDetails.OIDCLogin {
+	switch {
+	case controllerDetails.OIDCLogin:
 		dialOpts.LoginProvider = sessionLoginFactory.NewLoginProvider(
 			accountDetails.SessionToken,
 			cmdOut,
@@ -619,6 +621,17 @@ func newAPIConnectionParams(
 				accountDetails.SessionToken = sessionToken
 			},
 		)
+	case accountDetails.OIDCToken != nil:
+		// The user logged in with the identity provider of the
+		// controller; the token is refreshed as needed, and persisted
+		// by the caller after a successful login.
+		dialOpts.LoginProvider = api.NewOIDCLoginProvider(
+			*accountDetails.OIDCToken,
+			cmdOut,
+			func(token oidc.Token) {
+				accountDetails.OIDCToken = &token
+			},
+		)
 	}
 
 	// Embedded clients with macaroons cannot discharge.
@@ -31,6 +31,7 @@ import (
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/pki"
 	coretesting "github.com/juju/juju/internal/testing"
 	jujutesting "github.com/juju/juju/juju/testing"
@@ -337,6 +338,27 @@ func (s *BaseCommandSuite) TestNewAPIConnectionParamsWithOAuthController(c *gc.C
 	c.Assert(params.DialOpts.LoginProvider, gc.FitsTypeOf, sessionTokenLogin)
 }
 
+// TestNewAPIConnectionParamsWithOIDCToken verifies that an account holding
+// tokens issued by the identity provider of the controller logs in with
+// them.
+func (s *BaseCommandSuite) TestNewAPIConnectionParamsWithOIDCToken(c *gc.C) {
+	baseCmd := new(modelcmd.ModelCommandBase)
+	modelcmd.InitContexts(&cmd.Context{Stderr: io.Discard}, baseCmd)
+	modelcmd.SetRunStarted(baseCmd)
+	account := jujuclient.AccountDetails{
+		User:      "bob@example.com",
+		OIDCToken: &oidc.Token{IDToken: "id-token"},
+	}
+	params, err := baseCmd.NewAPIConnectionParams(s.store, s.store.CurrentControllerName, "", &account)
+	c.Assert(err, jc.ErrorIsNil)
+	oidcLogin := api.NewOIDCLoginProvider(oidc.Token{}, nil, nil)
+	c.Assert(params.DialOpts.LoginProvider, gc.FitsTypeOf, oidcLogin)
+
+	header, err := params.DialOpts.LoginProvider.AuthHeader()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(header.Get("Authorization"), gc.Equals, "Bearer id-token")
+}
+
 type NewGetBootstrapConfigParamsFuncSuite struct {
 	testing.IsolationSuite
 }
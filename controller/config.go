@@ -312,6 +312,99 @@ const (
 	// SSHMaxConcurrentConnections is the maximum number of concurrent SSH
 	// connections to the controller.
 	SSHMaxConcurrentConnections = "ssh-max-concurrent-connections"
+
+	// SSHSessionRecording indicates whether the sessions proxied by the
+	// embedded SSH server are recorded in the controller's object store.
+	SSHSessionRecording = "ssh-session-recording"
+
+	// SSHAccessGrantsRequired indicates whether users need an approved,
+	// unexpired grant to ssh to a unit or machine through the embedded SSH
+	// server.
+	SSHAccessGrantsRequired = "ssh-access-grants-required"
+
+	// SSHAccessGrantAutoApproveDuration is the longest ssh access grant
+	// which is approved as soon as it is requested. Requests for longer
+	// grants must be approved by an admin.
+	SSHAccessGrantAutoApproveDuration = "ssh-access-grant-auto-approve-duration"
+
+	// SSHUserCertificatesRequired indicates whether users must authenticate
+	// to the embedded SSH server with a certificate signed by the
+	// controller's ssh user certificate authority.
+	SSHUserCertificatesRequired = "ssh-user-certificates-required"
+
+	// SSHUserCertificateTTL is how long the ssh user certificates issued by
+	// the controller are valid for.
+	SSHUserCertificateTTL = "ssh-user-certificate-ttl"
+
+	// SSHPortForwarding indicates whether users may forward TCP connections
+	// to units and machines through the embedded SSH server.
+	SSHPortForwarding = "ssh-port-forwarding"
+
+	// OIDCIssuerURL sets the URL of the OpenID Connect identity provider
+	// users may log in with. Use this when users should authenticate with
+	// an external single sign-on service rather than locally on the
+	// controller.
+	OIDCIssuerURL = "oidc-issuer-url"
+
+	// OIDCClientID is the client ID the controller is registered with at
+	// the OpenID Connect identity provider. ID tokens must be issued to
+	// this client.
+	OIDCClientID = "oidc-client-id"
+
+	// OIDCUsernameClaim is the ID token claim holding the name of the user.
+	// Names without a domain are given the "external" domain.
+	OIDCUsernameClaim = "oidc-username-claim"
+
+	// OIDCGroupsClaim is the ID token claim holding the groups of the user,
+	// which are mapped to access by group grants.
+	OIDCGroupsClaim = "oidc-groups-claim"
+
+	// UserRateLimitMax is the maximum size of the token bucket used to
+	// ratelimit the API requests of each user. A value of 0 disables the
+	// limit.
+	UserRateLimitMax = "user-ratelimit-max"
+
+	// UserRateLimitRate is the interval at which a new token is added to
+	// the token bucket of each user.
+	UserRateLimitRate = "user-ratelimit-rate"
+
+	// ModelRateLimitMax is the maximum size of the token bucket used to
+	// ratelimit the API requests of users to each model. A value of 0
+	// disables the limit.
+	ModelRateLimitMax = "model-ratelimit-max"
+
+	// ModelRateLimitRate is the interval at which a new token is added to
+	// the token bucket of each model.
+	ModelRateLimitRate = "model-ratelimit-rate"
+
+	// ExpensiveCallRateLimitMax is the maximum size of the token bucket used
+	// to ratelimit the expensive API calls of each user, such as full status,
+	// deploys and debug-log streams. A value of 0 disables the limit.
+	ExpensiveCallRateLimitMax = "expensive-call-ratelimit-max"
+
+	// ExpensiveCallRateLimitRate is the interval at which a new token is
+	// added to the expensive call token bucket of each user.
+	ExpensiveCallRateLimitRate = "expensive-call-ratelimit-rate"
+
+	// PasswordMinLength is the minimum number of characters in the
+	// passwords of local users. A value of 0 disables the rule.
+	PasswordMinLength = "password-min-length"
+
+	// PasswordMinCharacterClasses is the minimum number of character classes
+	// used by the passwords of local users, of lower case letters, upper case
+	// letters, digits and symbols. A value of 0 disables the rule.
+	PasswordMinCharacterClasses = "password-min-character-classes"
+
+	// LoginLockoutThreshold is the number of consecutive failed password
+	// logins after which a local user is locked out. A value of 0 disables
+	// the lockout. Setting the password of a locked out user, or enabling
+	// them, unlocks them.
+	LoginLockoutThreshold = "login-lockout-threshold"
+
+	// LoginLockoutDuration is how long a local user is locked out for once
+	// they reach the login lockout threshold. The lockout doubles with each
+	// further failed login.
+	LoginLockoutDuration = "login-lockout-duration"
 )
 
 // Attribute Defaults
@@ -323,6 +416,90 @@ const (
 	// DefaultSSHServerPort is the default port used for the embedded SSH server.
 	DefaultSSHServerPort = 17022
 
+	// DefaultSSHSessionRecording is the default for whether the sessions
+	// proxied by the embedded SSH server are recorded.
+	DefaultSSHSessionRecording = false
+
+	// DefaultSSHAccessGrantsRequired is the default for whether users need
+	// a grant to ssh to a unit or machine through the embedded SSH server.
+	DefaultSSHAccessGrantsRequired = false
+
+	// DefaultSSHAccessGrantAutoApproveDuration is the default longest ssh
+	// access grant which is approved as soon as it is requested, which is
+	// none.
+	DefaultSSHAccessGrantAutoApproveDuration = time.Duration(0)
+
+	// DefaultSSHUserCertificatesRequired is the default for whether users
+	// must authenticate to the embedded SSH server with a certificate.
+	DefaultSSHUserCertificatesRequired = false
+
+	// DefaultSSHUserCertificateTTL is the default validity of the ssh user
+	// certificates issued by the controller.
+	DefaultSSHUserCertificateTTL = 8 * time.Hour
+
+	// MaxSSHUserCertificateTTL is the longest validity allowed for the ssh
+	// user certificates issued by the controller.
+	MaxSSHUserCertificateTTL = 7 * 24 * time.Hour
+
+	// DefaultSSHPortForwarding is the default for whether users may forward
+	// TCP connections through the embedded SSH server.
+	DefaultSSHPortForwarding = true
+
+	// DefaultOIDCUsernameClaim is the default ID token claim holding the
+	// name of the user.
+	DefaultOIDCUsernameClaim = "email"
+
+	// DefaultOIDCGroupsClaim is the default ID token claim holding the
+	// groups of the user.
+	DefaultOIDCGroupsClaim = "groups"
+
+	// DefaultUserRateLimitMax disables rate limiting the API requests of
+	// users.
+	DefaultUserRateLimitMax = 0
+
+	// DefaultUserRateLimitRate allows each user ten API requests a second
+	// once the limit is enabled.
+	DefaultUserRateLimitRate = 100 * time.Millisecond
+
+	// DefaultModelRateLimitMax disables rate limiting the API requests of
+	// users to models.
+	DefaultModelRateLimitMax = 0
+
+	// DefaultModelRateLimitRate allows fifty API requests a second to each
+	// model once the limit is enabled.
+	DefaultModelRateLimitRate = 20 * time.Millisecond
+
+	// DefaultExpensiveCallRateLimitMax disables rate limiting the expensive
+	// API calls of users.
+	DefaultExpensiveCallRateLimitMax = 0
+
+	// DefaultExpensiveCallRateLimitRate allows each user one expensive API
+	// call a second once the limit is enabled.
+	DefaultExpensiveCallRateLimitRate = time.Second
+
+	// MaxAPIRateLimitRate is the longest interval at which tokens may be
+	// added to the token buckets used to ratelimit API requests.
+	MaxAPIRateLimitRate = time.Hour
+
+	// DefaultPasswordMinLength disables the minimum password length.
+	DefaultPasswordMinLength = 0
+
+	// DefaultPasswordMinCharacterClasses disables the minimum number of
+	// character classes in passwords.
+	DefaultPasswordMinCharacterClasses = 0
+
+	// DefaultLoginLockoutThreshold disables the lockout of local users after
+	// failed password logins.
+	DefaultLoginLockoutThreshold = 0
+
+	// DefaultLoginLockoutDuration is how long local users are first locked
+	// out for.
+	DefaultLoginLockoutDuration = time.Minute
+
+	// MaxLoginLockoutDuration is the longest a local user is locked out for,
+	// however many failed logins they make.
+	MaxLoginLockoutDuration = 24 * time.Hour
+
 	// DefaultApplicationResourceDownloadLimit allows unlimited
 	// resource download requests initiated by a unit agent per application.
 	DefaultApplicationResourceDownloadLimit = 0
@@ -538,6 +715,26 @@ var (
 		JujudControllerSnapSource,
 		SSHMaxConcurrentConnections,
 		SSHServerPort,
+		SSHSessionRecording,
+		SSHAccessGrantsRequired,
+		SSHAccessGrantAutoApproveDuration,
+		SSHUserCertificatesRequired,
+		SSHUserCertificateTTL,
+		SSHPortForwarding,
+		OIDCIssuerURL,
+		OIDCClientID,
+		OIDCUsernameClaim,
+		OIDCGroupsClaim,
+		UserRateLimitMax,
+		UserRateLimitRate,
+		ModelRateLimitMax,
+		ModelRateLimitRate,
+		ExpensiveCallRateLimitMax,
+		ExpensiveCallRateLimitRate,
+		PasswordMinLength,
+		PasswordMinCharacterClasses,
+		LoginLockoutThreshold,
+		LoginLockoutDuration,
 	}
 
 	// For backwards compatibility, we must include "anything", "juju-apiserver"
@@ -597,6 +794,26 @@ var (
 		ObjectStoreS3StaticSecret,
 		ObjectStoreS3StaticSession,
 		SSHMaxConcurrentConnections,
+		SSHSessionRecording,
+		SSHAccessGrantsRequired,
+		SSHAccessGrantAutoApproveDuration,
+		SSHUserCertificatesRequired,
+		SSHUserCertificateTTL,
+		SSHPortForwarding,
+		OIDCIssuerURL,
+		OIDCClientID,
+		OIDCUsernameClaim,
+		OIDCGroupsClaim,
+		UserRateLimitMax,
+		UserRateLimitRate,
+		ModelRateLimitMax,
+		ModelRateLimitRate,
+		ExpensiveCallRateLimitMax,
+		ExpensiveCallRateLimitRate,
+		PasswordMinLength,
+		PasswordMinCharacterClasses,
+		LoginLockoutThreshold,
+		LoginLockoutDuration,
 	)
 
 	methodNameRE = regexp.MustCompile(`[[:alpha:]][[:alnum:]]*\.[[:alpha:]][[:alnum:]]*`)
@@ -678,6 +895,20 @@ func (c Config) intOrDefault(name string, defaultVal int) int {
 	return defaultVal
 }
 
+// limitOrDefault returns the value of a limit which is disabled by a value
+// of 0, which intOrDefault does not allow.
+func (c Config) limitOrDefault(name string, defaultVal int) int {
+	switch v := c[name].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		// nil type shows up here
+	}
+	return defaultVal
+}
+
 func (c Config) boolOrDefault(name string, defaultVal bool) bool {
 	if value, ok := c[name]; ok {
 		// Value has already been validated.
@@ -1147,6 +1378,130 @@ func (c Config) SSHMaxConcurrentConnections() int {
 	return c.intOrDefault(SSHMaxConcurrentConnections, DefaultSSHMaxConcurrentConnections)
 }
 
+// SSHSessionRecording returns whether the sessions proxied by the embedded
+// SSH server are recorded in the controller's object store.
+func (c Config) SSHSessionRecording() bool {
+	return c.boolOrDefault(SSHSessionRecording, DefaultSSHSessionRecording)
+}
+
+// SSHAccessGrantsRequired returns whether users need an approved, unexpired
+// grant to ssh to a unit or machine through the embedded SSH server.
+func (c Config) SSHAccessGrantsRequired() bool {
+	return c.boolOrDefault(SSHAccessGrantsRequired, DefaultSSHAccessGrantsRequired)
+}
+
+// SSHAccessGrantAutoApproveDuration returns the longest ssh access grant
+// which is approved as soon as it is requested. Zero means that every grant
+// must be approved by an admin.
+func (c Config) SSHAccessGrantAutoApproveDuration() time.Duration {
+	return c.durationOrDefault(SSHAccessGrantAutoApproveDuration, DefaultSSHAccessGrantAutoApproveDuration)
+}
+
+// SSHUserCertificatesRequired returns whether users must authenticate to the
+// embedded SSH server with a certificate signed by the controller.
+func (c Config) SSHUserCertificatesRequired() bool {
+	return c.boolOrDefault(SSHUserCertificatesRequired, DefaultSSHUserCertificatesRequired)
+}
+
+// SSHUserCertificateTTL returns how long the ssh user certificates issued by
+// the controller are valid for.
+func (c Config) SSHUserCertificateTTL() time.Duration {
+	return c.durationOrDefault(SSHUserCertificateTTL, DefaultSSHUserCertificateTTL)
+}
+
+// SSHPortForwarding returns whether users may forward TCP connections to
+// units and machines through the embedded SSH server.
+func (c Config) SSHPortForwarding() bool {
+	return c.boolOrDefault(SSHPortForwarding, DefaultSSHPortForwarding)
+}
+
+// OIDCIssuerURL returns the URL of the OpenID Connect identity provider users
+// may log in with, or the empty string if OIDC login is not configured.
+func (c Config) OIDCIssuerURL() string {
+	return c.asString(OIDCIssuerURL)
+}
+
+// OIDCClientID returns the client ID the controller is registered with at
+// the OpenID Connect identity provider.
+func (c Config) OIDCClientID() string {
+	return c.asString(OIDCClientID)
+}
+
+// OIDCUsernameClaim returns the ID token claim holding the name of the user.
+func (c Config) OIDCUsernameClaim() string {
+	if v := c.asString(OIDCUsernameClaim); v != "" {
+		return v
+	}
+	return DefaultOIDCUsernameClaim
+}
+
+// OIDCGroupsClaim returns the ID token claim holding the groups of the user.
+func (c Config) OIDCGroupsClaim() string {
+	if v := c.asString(OIDCGroupsClaim); v != "" {
+		return v
+	}
+	return DefaultOIDCGroupsClaim
+}
+
+// UserRateLimitMax is the size of the token bucket used to rate limit the
+// API requests of each user, or 0 if they are not limited.
+func (c Config) UserRateLimitMax() int {
+	return c.limitOrDefault(UserRateLimitMax, DefaultUserRateLimitMax)
+}
+
+// UserRateLimitRate is the time taken to add a token into the token bucket
+// of each user.
+func (c Config) UserRateLimitRate() time.Duration {
+	return c.durationOrDefault(UserRateLimitRate, DefaultUserRateLimitRate)
+}
+
+// ModelRateLimitMax is the size of the token bucket used to rate limit the
+// API requests of users to each model, or 0 if they are not limited.
+func (c Config) ModelRateLimitMax() int {
+	return c.limitOrDefault(ModelRateLimitMax, DefaultModelRateLimitMax)
+}
+
+// ModelRateLimitRate is the time taken to add a token into the token bucket
+// of each model.
+func (c Config) ModelRateLimitRate() time.Duration {
+	return c.durationOrDefault(ModelRateLimitRate, DefaultModelRateLimitRate)
+}
+
+// ExpensiveCallRateLimitMax is the size of the token bucket used to rate
+// limit the expensive API calls of each user, or 0 if they are not limited.
+func (c Config) ExpensiveCallRateLimitMax() int {
+	return c.limitOrDefault(ExpensiveCallRateLimitMax, DefaultExpensiveCallRateLimitMax)
+}
+
+// ExpensiveCallRateLimitRate is the time taken to add a token into the
+// expensive call token bucket of each user.
+func (c Config) ExpensiveCallRateLimitRate() time.Duration {
+	return c.durationOrDefault(ExpensiveCallRateLimitRate, DefaultExpensiveCallRateLimitRate)
+}
+
+// PasswordMinLength is the minimum number of characters in the passwords of
+// local users, or 0 if there is no minimum.
+func (c Config) PasswordMinLength() int {
+	return c.limitOrDefault(PasswordMinLength, DefaultPasswordMinLength)
+}
+
+// PasswordMinCharacterClasses is the minimum number of character classes
+// used by the passwords of local users, or 0 if there is no minimum.
+func (c Config) PasswordMinCharacterClasses() int {
+	return c.limitOrDefault(PasswordMinCharacterClasses, DefaultPasswordMinCharacterClasses)
+}
+
+// LoginLockoutThreshold is the number of consecutive failed password logins
+// after which a local user is locked out, or 0 if they are never locked out.
+func (c Config) LoginLockoutThreshold() int {
+	return c.limitOrDefault(LoginLockoutThreshold, DefaultLoginLockoutThreshold)
+}
+
+// LoginLockoutDuration is how long a local user is first locked out for.
+func (c Config) LoginLockoutDuration() time.Duration {
+	return c.durationOrDefault(LoginLockoutDuration, DefaultLoginLockoutDuration)
+}
+
 // Validate ensures that config is a valid configuration.
 func Validate(c Config) error {
 	if v, ok := c[IdentityPublicKey].(string); ok {
@@ -1180,6 +1535,19 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[OIDCIssuerURL].(string); ok && v != "" {
+		u, err := url.Parse(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid OIDC issuer URL")
+		}
+		if u.Scheme != "https" || u.Host == "" {
+			return errors.NotValidf("OIDC issuer URL %q, must be an https URL", v)
+		}
+		if v, _ := c[OIDCClientID].(string); v == "" {
+			return errors.NotValidf("empty %s with %s", OIDCClientID, OIDCIssuerURL)
+		}
+	}
+
 	caCert, caCertOK := c.CACert()
 	if !caCertOK {
 		return errors.Errorf("missing CA certificate")
@@ -1224,6 +1592,33 @@ func Validate(c Config) error {
 		}
 	}
 
+	for _, key := range []string{UserRateLimitMax, ModelRateLimitMax, ExpensiveCallRateLimitMax} {
+		if v, ok := c[key].(int); ok && v < 0 {
+			return errors.NotValidf("negative %s (%d)", key, v)
+		}
+	}
+	for _, key := range []string{UserRateLimitRate, ModelRateLimitRate, ExpensiveCallRateLimitRate} {
+		if v, err := parseDuration(c, key); err != nil && !errors.Is(err, errors.NotFound) {
+			return errors.Trace(err)
+		} else if err == nil && (v <= 0 || v > MaxAPIRateLimitRate) {
+			return errors.Errorf("%s must be between 0..%v", key, MaxAPIRateLimitRate)
+		}
+	}
+
+	for _, key := range []string{PasswordMinLength, LoginLockoutThreshold} {
+		if v, ok := c[key].(int); ok && v < 0 {
+			return errors.NotValidf("negative %s (%d)", key, v)
+		}
+	}
+	if v, ok := c[PasswordMinCharacterClasses].(int); ok && (v < 0 || v > 4) {
+		return errors.Errorf("%s must be between 0..4", PasswordMinCharacterClasses)
+	}
+	if v, err := parseDuration(c, LoginLockoutDuration); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Trace(err)
+	} else if err == nil && (v <= 0 || v > MaxLoginLockoutDuration) {
+		return errors.Errorf("%s must be between 0..%v", LoginLockoutDuration, MaxLoginLockoutDuration)
+	}
+
 	if v, err := parseDuration(c, MaxDebugLogDuration); err != nil && !errors.Is(err, errors.NotFound) {
 		return errors.Trace(err)
 	} else if err == nil {
@@ -1232,6 +1627,24 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, err := parseDuration(c, SSHAccessGrantAutoApproveDuration); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Trace(err)
+	} else if err == nil && v < 0 {
+		return errors.NotValidf("negative %s", SSHAccessGrantAutoApproveDuration)
+	}
+
+	if v, err := parseDuration(c, SSHUserCertificateTTL); err != nil && !errors.Is(err, errors.NotFound) {
+		return errors.Trace(err)
+	} else if err == nil && (v <= 0 || v > MaxSSHUserCertificateTTL) {
+		return errors.NotValidf("%s %v, must be positive and at most %v", SSHUserCertificateTTL, v, MaxSSHUserCertificateTTL)
+	}
+
+	// Access grants are held by users, so they can only be checked for users
+	// whose identity was verified by a controller issued certificate.
+	if c.SSHAccessGrantsRequired() && !c.SSHUserCertificatesRequired() {
+		return errors.NotValidf("%s without %s", SSHAccessGrantsRequired, SSHUserCertificatesRequired)
+	}
+
 	if v, ok := c[AgentLogfileMaxBackups].(int); ok {
 		if v < 0 {
 			return errors.NotValidf("negative %s", AgentLogfileMaxBackups)
@@ -199,6 +199,24 @@ var newConfigTests = []struct {
 		controller.MaxDebugLogDuration: time.Duration(0),
 	},
 	expectError: `max-debug-log-duration cannot be zero`,
+}, {
+	about: "ssh-access-grant-auto-approve-duration not valid",
+	config: controller.Config{
+		controller.SSHAccessGrantAutoApproveDuration: -time.Minute,
+	},
+	expectError: `negative ssh-access-grant-auto-approve-duration not valid`,
+}, {
+	about: "ssh-user-certificate-ttl not valid",
+	config: controller.Config{
+		controller.SSHUserCertificateTTL: 8 * 24 * time.Hour,
+	},
+	expectError: `ssh-user-certificate-ttl 192h0m0s, must be positive and at most 168h0m0s not valid`,
+}, {
+	about: "ssh-access-grants-required without ssh-user-certificates-required",
+	config: controller.Config{
+		controller.SSHAccessGrantsRequired: true,
+	},
+	expectError: `ssh-access-grants-required without ssh-user-certificates-required not valid`,
 }, {
 	about: "agent-logfile-max-backups not valid",
 	config: controller.Config{
@@ -331,6 +349,67 @@ var newConfigTests = []struct {
 		controller.LoginTokenRefreshURL: `xxxx`,
 	},
 	expectError: `logic token refresh URL "xxxx" not valid`,
+}, {
+	about: "oidc issuer url",
+	config: controller.Config{
+		controller.OIDCIssuerURL: `https://sso.example.com`,
+		controller.OIDCClientID:  `juju`,
+	},
+}, {
+	about: "oidc issuer url not https",
+	config: controller.Config{
+		controller.OIDCIssuerURL: `http://sso.example.com`,
+		controller.OIDCClientID:  `juju`,
+	},
+	expectError: `OIDC issuer URL "http://sso.example.com", must be an https URL not valid`,
+}, {
+	about: "oidc issuer url without client id",
+	config: controller.Config{
+		controller.OIDCIssuerURL: `https://sso.example.com`,
+	},
+	expectError: `empty oidc-client-id with oidc-issuer-url not valid`,
+}, {
+	about: "negative user-ratelimit-max",
+	config: controller.Config{
+		controller.UserRateLimitMax: -1,
+	},
+	expectError: `negative user-ratelimit-max \(-1\) not valid`,
+}, {
+	about: "zero model-ratelimit-rate",
+	config: controller.Config{
+		controller.ModelRateLimitRate: "0s",
+	},
+	expectError: `model-ratelimit-rate must be between 0..1h0m0s`,
+}, {
+	about: "expensive-call-ratelimit-rate too long",
+	config: controller.Config{
+		controller.ExpensiveCallRateLimitRate: "2h",
+	},
+	expectError: `expensive-call-ratelimit-rate must be between 0..1h0m0s`,
+}, {
+	about: "password-min-length negative",
+	config: controller.Config{
+		controller.PasswordMinLength: -1,
+	},
+	expectError: `negative password-min-length \(-1\) not valid`,
+}, {
+	about: "password-min-character-classes too many",
+	config: controller.Config{
+		controller.PasswordMinCharacterClasses: 5,
+	},
+	expectError: `password-min-character-classes must be between 0..4`,
+}, {
+	about: "login-lockout-threshold negative",
+	config: controller.Config{
+		controller.LoginLockoutThreshold: -3,
+	},
+	expectError: `negative login-lockout-threshold \(-3\) not valid`,
+}, {
+	about: "login-lockout-duration zero",
+	config: controller.Config{
+		controller.LoginLockoutDuration: "0s",
+	},
+	expectError: `login-lockout-duration must be between 0..24h0m0s`,
 }, {
 	about: "invalid query tracing value",
 	config: controller.Config{
@@ -767,6 +846,129 @@ func (s *ConfigSuite) TestMaxDebugLogDuration(c *gc.C) {
 	c.Assert(cfg.MaxDebugLogDuration(), gc.Equals, 90*time.Minute)
 }
 
+func (s *ConfigSuite) TestSSHAccessGrantAutoApproveDuration(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"ssh-access-grant-auto-approve-duration": "30m",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.SSHAccessGrantAutoApproveDuration(), gc.Equals, 30*time.Minute)
+	c.Assert(cfg.SSHAccessGrantsRequired(), jc.IsFalse)
+}
+
+func (s *ConfigSuite) TestSSHUserCertificateTTL(c *gc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"ssh-user-certificates-required": true,
+			"ssh-user-certificate-ttl":       "2h",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.SSHUserCertificateTTL(), gc.Equals, 2*time.Hour)
+	c.Assert(cfg.SSHUserCertificatesRequired(), jc.IsTrue)
+}
+
+func (s *ConfigSuite) TestSSHPortForwarding(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.SSHPortForwarding(), jc.IsTrue)
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"ssh-port-forwarding": false,
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.SSHPortForwarding(), jc.IsFalse)
+}
+
+func (s *ConfigSuite) TestOIDCClaims(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.OIDCIssuerURL(), gc.Equals, "")
+	c.Assert(cfg.OIDCUsernameClaim(), gc.Equals, "email")
+	c.Assert(cfg.OIDCGroupsClaim(), gc.Equals, "groups")
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"oidc-issuer-url":     "https://sso.example.com",
+			"oidc-client-id":      "juju",
+			"oidc-username-claim": "preferred_username",
+			"oidc-groups-claim":   "roles",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.OIDCIssuerURL(), gc.Equals, "https://sso.example.com")
+	c.Assert(cfg.OIDCClientID(), gc.Equals, "juju")
+	c.Assert(cfg.OIDCUsernameClaim(), gc.Equals, "preferred_username")
+	c.Assert(cfg.OIDCGroupsClaim(), gc.Equals, "roles")
+}
+
+func (s *ConfigSuite) TestAPIRateLimits(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.UserRateLimitMax(), gc.Equals, 0)
+	c.Assert(cfg.UserRateLimitRate(), gc.Equals, controller.DefaultUserRateLimitRate)
+	c.Assert(cfg.ModelRateLimitMax(), gc.Equals, 0)
+	c.Assert(cfg.ModelRateLimitRate(), gc.Equals, controller.DefaultModelRateLimitRate)
+	c.Assert(cfg.ExpensiveCallRateLimitMax(), gc.Equals, 0)
+	c.Assert(cfg.ExpensiveCallRateLimitRate(), gc.Equals, controller.DefaultExpensiveCallRateLimitRate)
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"user-ratelimit-max":            20,
+			"user-ratelimit-rate":           "500ms",
+			"model-ratelimit-max":           100,
+			"model-ratelimit-rate":          "50ms",
+			"expensive-call-ratelimit-max":  "5",
+			"expensive-call-ratelimit-rate": "10s",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.UserRateLimitMax(), gc.Equals, 20)
+	c.Assert(cfg.UserRateLimitRate(), gc.Equals, 500*time.Millisecond)
+	c.Assert(cfg.ModelRateLimitMax(), gc.Equals, 100)
+	c.Assert(cfg.ModelRateLimitRate(), gc.Equals, 50*time.Millisecond)
+	c.Assert(cfg.ExpensiveCallRateLimitMax(), gc.Equals, 5)
+	c.Assert(cfg.ExpensiveCallRateLimitRate(), gc.Equals, 10*time.Second)
+}
+
+func (s *ConfigSuite) TestPasswordPolicyAndLockout(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.PasswordMinLength(), gc.Equals, 0)
+	c.Assert(cfg.PasswordMinCharacterClasses(), gc.Equals, 0)
+	c.Assert(cfg.LoginLockoutThreshold(), gc.Equals, controller.DefaultLoginLockoutThreshold)
+	c.Assert(cfg.LoginLockoutDuration(), gc.Equals, controller.DefaultLoginLockoutDuration)
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			"password-min-length":            12,
+			"password-min-character-classes": "3",
+			"login-lockout-threshold":        5,
+			"login-lockout-duration":         "5m",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.PasswordMinLength(), gc.Equals, 12)
+	c.Assert(cfg.PasswordMinCharacterClasses(), gc.Equals, 3)
+	c.Assert(cfg.LoginLockoutThreshold(), gc.Equals, 5)
+	c.Assert(cfg.LoginLockoutDuration(), gc.Equals, 5*time.Minute)
+}
+
 func (s *ConfigSuite) TestMaxDebugLogDurationSchemaCoerce(c *gc.C) {
 	_, err := controller.NewConfig(
 		testing.ControllerTag.Id(),
@@ -70,6 +70,26 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          schema.String(),
 	SSHServerPort:                      schema.ForceInt(),
 	SSHMaxConcurrentConnections:        schema.ForceInt(),
+	SSHSessionRecording:                schema.Bool(),
+	SSHAccessGrantsRequired:            schema.Bool(),
+	SSHAccessGrantAutoApproveDuration:  schema.TimeDurationString(),
+	SSHUserCertificatesRequired:        schema.Bool(),
+	SSHUserCertificateTTL:              schema.TimeDurationString(),
+	SSHPortForwarding:                  schema.Bool(),
+	OIDCIssuerURL:                      schema.String(),
+	OIDCClientID:                       schema.String(),
+	OIDCUsernameClaim:                  schema.String(),
+	OIDCGroupsClaim:                    schema.String(),
+	UserRateLimitMax:                   schema.ForceInt(),
+	UserRateLimitRate:                  schema.TimeDurationString(),
+	ModelRateLimitMax:                  schema.ForceInt(),
+	ModelRateLimitRate:                 schema.TimeDurationString(),
+	ExpensiveCallRateLimitMax:          schema.ForceInt(),
+	ExpensiveCallRateLimitRate:         schema.TimeDurationString(),
+	PasswordMinLength:                  schema.ForceInt(),
+	PasswordMinCharacterClasses:        schema.ForceInt(),
+	LoginLockoutThreshold:              schema.ForceInt(),
+	LoginLockoutDuration:               schema.TimeDurationString(),
 }, schema.Defaults{
 	AgentRateLimitMax:                  schema.Omit,
 	AgentRateLimitRate:                 schema.Omit,
@@ -129,6 +149,26 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          DefaultJujudControllerSnapSource,
 	SSHServerPort:                      DefaultSSHServerPort,
 	SSHMaxConcurrentConnections:        DefaultSSHMaxConcurrentConnections,
+	SSHSessionRecording:                DefaultSSHSessionRecording,
+	SSHAccessGrantsRequired:            DefaultSSHAccessGrantsRequired,
+	SSHAccessGrantAutoApproveDuration:  DefaultSSHAccessGrantAutoApproveDuration,
+	SSHUserCertificatesRequired:        DefaultSSHUserCertificatesRequired,
+	SSHUserCertificateTTL:              DefaultSSHUserCertificateTTL,
+	SSHPortForwarding:                  DefaultSSHPortForwarding,
+	OIDCIssuerURL:                      schema.Omit,
+	OIDCClientID:                       schema.Omit,
+	OIDCUsernameClaim:                  DefaultOIDCUsernameClaim,
+	OIDCGroupsClaim:                    DefaultOIDCGroupsClaim,
+	UserRateLimitMax:                   schema.Omit,
+	UserRateLimitRate:                  schema.Omit,
+	ModelRateLimitMax:                  schema.Omit,
+	ModelRateLimitRate:                 schema.Omit,
+	ExpensiveCallRateLimitMax:          schema.Omit,
+	ExpensiveCallRateLimitRate:         schema.Omit,
+	PasswordMinLength:                  schema.Omit,
+	PasswordMinCharacterClasses:        schema.Omit,
+	LoginLockoutThreshold:              schema.Omit,
+	LoginLockoutDuration:               schema.Omit,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -378,4 +418,84 @@ will be output if tracing is enabled.`,
 		Type:        configschema.Tint,
 		Description: `The maximum number of concurrent ssh connections to the controller`,
 	},
+	SSHSessionRecording: {
+		Type:        configschema.Tbool,
+		Description: `Whether ssh sessions to units and machines through the controller are recorded`,
+	},
+	SSHAccessGrantsRequired: {
+		Type:        configschema.Tbool,
+		Description: `Whether ssh to units and machines through the controller requires an approved access grant; requires ssh-user-certificates-required`,
+	},
+	SSHAccessGrantAutoApproveDuration: {
+		Type:        configschema.Tstring,
+		Description: `The longest ssh access grant which is approved without an admin`,
+	},
+	SSHUserCertificatesRequired: {
+		Type:        configschema.Tbool,
+		Description: `Whether ssh to units and machines through the controller requires a certificate signed by the controller`,
+	},
+	SSHUserCertificateTTL: {
+		Type:        configschema.Tstring,
+		Description: `How long the ssh user certificates issued by the controller are valid for`,
+	},
+	SSHPortForwarding: {
+		Type:        configschema.Tbool,
+		Description: `Whether users may forward TCP connections to units and machines through the controller's ssh server`,
+	},
+	OIDCIssuerURL: {
+		Type:        configschema.Tstring,
+		Description: `The URL of the OpenID Connect identity provider users may log in with`,
+	},
+	OIDCClientID: {
+		Type:        configschema.Tstring,
+		Description: `The client ID of the controller at the OpenID Connect identity provider`,
+	},
+	OIDCUsernameClaim: {
+		Type:        configschema.Tstring,
+		Description: `The ID token claim holding the name of the user`,
+	},
+	OIDCGroupsClaim: {
+		Type:        configschema.Tstring,
+		Description: `The ID token claim holding the groups of the user`,
+	},
+	UserRateLimitMax: {
+		Type:        configschema.Tint,
+		Description: `The maximum size of the token bucket used to ratelimit the API requests of each user, or 0 to disable the limit`,
+	},
+	UserRateLimitRate: {
+		Type:        configschema.Tstring,
+		Description: `The time taken to add a new token to the ratelimit bucket of each user`,
+	},
+	ModelRateLimitMax: {
+		Type:        configschema.Tint,
+		Description: `The maximum size of the token bucket used to ratelimit the API requests of users to each model, or 0 to disable the limit`,
+	},
+	ModelRateLimitRate: {
+		Type:        configschema.Tstring,
+		Description: `The time taken to add a new token to the ratelimit bucket of each model`,
+	},
+	ExpensiveCallRateLimitMax: {
+		Type:        configschema.Tint,
+		Description: `The maximum size of the token bucket used to ratelimit expensive API calls of each user, such as full status, deploys and debug-log streams, or 0 to disable the limit`,
+	},
+	ExpensiveCallRateLimitRate: {
+		Type:        configschema.Tstring,
+		Description: `The time taken to add a new token to the expensive call ratelimit bucket of each user`,
+	},
+	PasswordMinLength: {
+		Type:        configschema.Tint,
+		Description: `The minimum number of characters in the passwords of local users, or 0 for no minimum`,
+	},
+	PasswordMinCharacterClasses: {
+		Type:        configschema.Tint,
+		Description: `The minimum number of lower case letters, upper case letters, digits and symbols character classes used by the passwords of local users, or 0 for no minimum`,
+	},
+	LoginLockoutThreshold: {
+		Type:        configschema.Tint,
+		Description: `The number of consecutive failed password logins after which a local user is locked out, or 0 to disable the lockout`,
+	},
+	LoginLockoutDuration: {
+		Type:        configschema.Tstring,
+		Description: `How long a local user is first locked out for, doubling with each further failed login`,
+	},
 }
@@ -0,0 +1,9 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+// KubernetesDeploymentTypeConfigOptionName is the option name used to
+// override the deployment type declared by the charm of a kubernetes
+// application in application configuration.
+const KubernetesDeploymentTypeConfigOptionName = "kubernetes-deployment-type"
@@ -37,6 +37,8 @@ const (
 	Zones            = "zones"
 	AllocatePublicIP = "allocate-public-ip"
 	ImageID          = "image-id"
+	Spot             = "spot"
+	SpotMaxPrice     = "spot-max-price"
 
 	// excludedPrefix is the prefix Juju expects to be in front of a value when
 	// it is to be considered excluded as part of constraints.
@@ -120,6 +122,18 @@ type Value struct {
 	// image. This is provider specific, and for the moment is only
 	// implemented on MAAS clouds.
 	ImageID *string `json:"image-id,omitempty" yaml:"image-id,omitempty"`
+
+	// Spot, if true, indicates that a machine should be started on spot
+	// (or preemptible) capacity, which is cheaper but may be reclaimed
+	// by the cloud at any time. Only valid for clouds which offer such
+	// capacity.
+	Spot *bool `json:"spot,omitempty" yaml:"spot,omitempty"`
+
+	// SpotMaxPrice, if not nil, is the highest hourly price, in the
+	// cloud's billing currency, that will be paid for spot capacity.
+	// It only applies when Spot is true; if unset the price is capped
+	// at the on-demand price.
+	SpotMaxPrice *float64 `json:"spot-max-price,omitempty" yaml:"spot-max-price,omitempty"`
 }
 
 var rawAliases = map[string]string{
@@ -264,6 +278,17 @@ func (v *Value) HasImageID() bool {
 	return v.ImageID != nil && *v.ImageID != ""
 }
 
+// HasSpot returns true if the constraints.Value requests spot capacity.
+func (v *Value) HasSpot() bool {
+	return v.Spot != nil && *v.Spot
+}
+
+// HasSpotMaxPrice returns true if the constraints.Value specifies a
+// maximum price for spot capacity.
+func (v *Value) HasSpotMaxPrice() bool {
+	return v.SpotMaxPrice != nil && *v.SpotMaxPrice > 0
+}
+
 // String expresses a constraints.Value in the language in which it was specified.
 func (v Value) String() string {
 	var strs []string
@@ -324,6 +349,12 @@ func (v Value) String() string {
 	if v.ImageID != nil {
 		strs = append(strs, "image-id="+(*v.ImageID))
 	}
+	if v.Spot != nil {
+		strs = append(strs, "spot="+boolStr(*v.Spot))
+	}
+	if v.SpotMaxPrice != nil {
+		strs = append(strs, "spot-max-price="+floatStr(*v.SpotMaxPrice))
+	}
 
 	// Ensure constraint values with spaces are properly escaped
 	for i := 0; i < len(strs); i++ {
@@ -385,6 +416,12 @@ func (v Value) GoString() string {
 	if v.ImageID != nil {
 		values = append(values, fmt.Sprintf("ImageID: %q", *v.ImageID))
 	}
+	if v.Spot != nil {
+		values = append(values, fmt.Sprintf("Spot: %v", *v.Spot))
+	}
+	if v.SpotMaxPrice != nil {
+		values = append(values, fmt.Sprintf("SpotMaxPrice: %v", *v.SpotMaxPrice))
+	}
 	return fmt.Sprintf("{%s}", strings.Join(values, ", "))
 }
 
@@ -399,6 +436,13 @@ func boolStr(b bool) string {
 	return fmt.Sprintf("%v", b)
 }
 
+func floatStr(f float64) string {
+	if f == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
 // Parse constructs a constraints.Value from the supplied arguments,
 // each of which must contain only spaces and name=value pairs. If any
 // name is specified more than once, an error is returned.
@@ -560,6 +604,10 @@ func (v *Value) setRaw(name, str string) error {
 		err = v.setAllocatePublicIP(str)
 	case ImageID:
 		err = v.setImageID(str)
+	case Spot:
+		err = v.setSpot(str)
+	case SpotMaxPrice:
+		err = v.setSpotMaxPrice(str)
 	default:
 		return errors.Errorf("unknown constraint %q", name)
 	}
@@ -633,6 +681,10 @@ func (v *Value) UnmarshalYAML(unmarshal func(interface{}) error) error {
 			v.AllocatePublicIP, err = parseBool(vstr)
 		case ImageID:
 			v.ImageID = &vstr
+		case Spot:
+			v.Spot, err = parseBool(vstr)
+		case SpotMaxPrice:
+			v.SpotMaxPrice, err = parsePrice(vstr)
 		default:
 			return errors.Errorf("unknown constraint value: %v", k)
 		}
@@ -800,6 +852,22 @@ func (v *Value) setImageID(str string) (err error) {
 	return
 }
 
+func (v *Value) setSpot(str string) (err error) {
+	if v.Spot != nil {
+		return errors.Errorf("already set")
+	}
+	v.Spot, err = parseBool(str)
+	return
+}
+
+func (v *Value) setSpotMaxPrice(str string) (err error) {
+	if v.SpotMaxPrice != nil {
+		return errors.Errorf("already set")
+	}
+	v.SpotMaxPrice, err = parsePrice(str)
+	return
+}
+
 func parseBool(str string) (*bool, error) {
 	var value bool
 	if str != "" {
@@ -824,6 +892,18 @@ func parseUint64(str string) (*uint64, error) {
 	return &value, nil
 }
 
+func parsePrice(str string) (*float64, error) {
+	var value float64
+	if str != "" {
+		val, err := strconv.ParseFloat(str, 64)
+		if err != nil || val < 0 || math.IsInf(val, 0) || math.IsNaN(val) {
+			return nil, errors.Errorf("must be a non-negative decimal")
+		}
+		value = val
+	}
+	return &value, nil
+}
+
 func parseSize(str string) (*uint64, error) {
 	var value uint64
 	if str != "" {
@@ -390,6 +390,38 @@ var parseConstraintsTests = []struct {
 		err:     `bad "image-id" constraint: already set`,
 	},
 
+	// Spot
+	{
+		summary: "set spot",
+		args:    []string{"spot=true"},
+	}, {
+		summary: "set nonsense spot",
+		args:    []string{"spot=fred"},
+		err:     `bad "spot" constraint: must be 'true' or 'false'`,
+	}, {
+		summary: "try to set spot twice",
+		args:    []string{"spot=true spot=false"},
+		err:     `bad "spot" constraint: already set`,
+	}, {
+		summary: "set spot-max-price",
+		args:    []string{"spot=true spot-max-price=0.045"},
+	}, {
+		summary: "set empty spot-max-price",
+		args:    []string{"spot-max-price="},
+	}, {
+		summary: "set nonsense spot-max-price",
+		args:    []string{"spot-max-price=cheap"},
+		err:     `bad "spot-max-price" constraint: must be a non-negative decimal`,
+	}, {
+		summary: "set negative spot-max-price",
+		args:    []string{"spot-max-price=-1"},
+		err:     `bad "spot-max-price" constraint: must be a non-negative decimal`,
+	}, {
+		summary: "try to set spot-max-price twice",
+		args:    []string{"spot-max-price=0.1 spot-max-price=0.2"},
+		err:     `bad "spot-max-price" constraint: already set`,
+	},
+
 	// Everything at once.
 	{
 		summary: "kitchen sink together",
@@ -636,6 +668,25 @@ func (s *ConstraintsSuite) TestHasImageID(c *gc.C) {
 	c.Check(con.HasImageID(), jc.IsFalse)
 }
 
+func (s *ConstraintsSuite) TestHasSpot(c *gc.C) {
+	con := constraints.MustParse("spot=true")
+	c.Check(con.HasSpot(), jc.IsTrue)
+	con = constraints.MustParse("spot=false")
+	c.Check(con.HasSpot(), jc.IsFalse)
+	con = constraints.MustParse("spot-max-price=0.5")
+	c.Check(con.HasSpot(), jc.IsFalse)
+}
+
+func (s *ConstraintsSuite) TestHasSpotMaxPrice(c *gc.C) {
+	con := constraints.MustParse("spot=true spot-max-price=0.5")
+	c.Check(con.HasSpotMaxPrice(), jc.IsTrue)
+	c.Check(con.String(), gc.Equals, "spot=true spot-max-price=0.5")
+	con = constraints.MustParse("spot-max-price=")
+	c.Check(con.HasSpotMaxPrice(), jc.IsFalse)
+	con = constraints.MustParse("spot=true")
+	c.Check(con.HasSpotMaxPrice(), jc.IsFalse)
+}
+
 func (s *ConstraintsSuite) TestIsEmpty(c *gc.C) {
 	con := constraints.Value{}
 	c.Check(&con, jc.Satisfies, constraints.IsEmpty)
@@ -675,6 +726,10 @@ func boolp(b bool) *bool {
 	return &b
 }
 
+func float64p(f float64) *float64 {
+	return &f
+}
+
 func uint64p(i uint64) *uint64 {
 	return &i
 }
@@ -732,6 +787,11 @@ var constraintsRoundtripTests = []roundTrip{
 	{"ImageID1", constraints.Value{ImageID: nil}},
 	{"ImageID1", constraints.Value{ImageID: strp("")}},
 	{"ImageID1", constraints.Value{ImageID: strp("ubuntu-bf2")}},
+	{"Spot1", constraints.Value{Spot: nil}},
+	{"Spot2", constraints.Value{Spot: boolp(true)}},
+	{"SpotMaxPrice1", constraints.Value{SpotMaxPrice: nil}},
+	{"SpotMaxPrice2", constraints.Value{SpotMaxPrice: float64p(0)}},
+	{"SpotMaxPrice3", constraints.Value{SpotMaxPrice: float64p(0.125)}},
 	{"All", constraints.Value{
 		Arch:             strp("arm64"),
 		Container:        ctypep("lxd"),
@@ -0,0 +1,90 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"fmt"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GeneratorKind identifies a server side generator used to
+// produce new content for a user secret when it is rotated.
+type GeneratorKind string
+
+const (
+	// GeneratorPassword generates a random password.
+	GeneratorPassword = GeneratorKind("password")
+	// GeneratorSSHKeyPair generates a new SSH private/public key pair.
+	GeneratorSSHKeyPair = GeneratorKind("ssh-keypair")
+	// GeneratorTLSCertificate generates a new TLS certificate and key
+	// signed by the controller CA.
+	GeneratorTLSCertificate = GeneratorKind("tls-certificate")
+)
+
+const (
+	// GeneratorParam is the key in a secret's params which
+	// holds the kind of generator used to rotate the secret.
+	GeneratorParam = "generator"
+)
+
+// IsValid returns true if k is a known generator kind.
+func (k GeneratorKind) IsValid() bool {
+	switch k {
+	case GeneratorPassword, GeneratorSSHKeyPair, GeneratorTLSCertificate:
+		return true
+	}
+	return false
+}
+
+// GeneratorConfig describes how new content for a rotating
+// user secret is generated.
+type GeneratorConfig struct {
+	// Kind is the kind of generator to use.
+	Kind GeneratorKind
+	// Params are the generator specific parameters,
+	// eg the length of a password.
+	Params map[string]string
+}
+
+// Validate returns an error if the generator config is invalid.
+func (c GeneratorConfig) Validate() error {
+	if !c.Kind.IsValid() {
+		return errors.Errorf("secret generator %q %w", c.Kind, coreerrors.NotValid)
+	}
+	return nil
+}
+
+// GeneratorConfigFromParams extracts the generator config from the params
+// supplied when creating or updating a secret. The generator kind is read
+// from the "generator" key and all other keys are passed to the generator.
+// If no generator is specified, or it is empty, nil is returned.
+func GeneratorConfigFromParams(params map[string]interface{}) (*GeneratorConfig, error) {
+	kind, ok := params[GeneratorParam]
+	if !ok || RemovesGenerator(params) {
+		return nil, nil
+	}
+	cfg := &GeneratorConfig{
+		Kind:   GeneratorKind(fmt.Sprint(kind)),
+		Params: make(map[string]string),
+	}
+	for k, v := range params {
+		if k == GeneratorParam {
+			continue
+		}
+		cfg.Params[k] = fmt.Sprint(v)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return cfg, nil
+}
+
+// RemovesGenerator returns true if the params supplied when updating a
+// secret remove its generator, ie the "generator" key is set but empty.
+func RemovesGenerator(params map[string]interface{}) bool {
+	kind, ok := params[GeneratorParam]
+	return ok && (kind == nil || fmt.Sprint(kind) == "")
+}
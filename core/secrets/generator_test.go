@@ -0,0 +1,61 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/secrets"
+)
+
+type GeneratorSuite struct{}
+
+var _ = gc.Suite(&GeneratorSuite{})
+
+func (s *GeneratorSuite) TestGeneratorKindIsValid(c *gc.C) {
+	for _, k := range []secrets.GeneratorKind{
+		secrets.GeneratorPassword,
+		secrets.GeneratorSSHKeyPair,
+		secrets.GeneratorTLSCertificate,
+	} {
+		c.Check(k.IsValid(), jc.IsTrue, gc.Commentf("kind %q", k))
+	}
+	c.Check(secrets.GeneratorKind("foo").IsValid(), jc.IsFalse)
+	c.Check(secrets.GeneratorKind("").IsValid(), jc.IsFalse)
+}
+
+func (s *GeneratorSuite) TestGeneratorConfigFromParams(c *gc.C) {
+	cfg, err := secrets.GeneratorConfigFromParams(map[string]interface{}{
+		"generator": "password",
+		"length":    32,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg, jc.DeepEquals, &secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorPassword,
+		Params: map[string]string{"length": "32"},
+	})
+}
+
+func (s *GeneratorSuite) TestGeneratorConfigFromParamsNone(c *gc.C) {
+	cfg, err := secrets.GeneratorConfigFromParams(map[string]interface{}{"foo": "bar"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg, gc.IsNil)
+}
+
+func (s *GeneratorSuite) TestGeneratorConfigFromParamsInvalid(c *gc.C) {
+	_, err := secrets.GeneratorConfigFromParams(map[string]interface{}{"generator": "foo"})
+	c.Assert(err, gc.ErrorMatches, `secret generator "foo" not valid`)
+}
+
+func (s *GeneratorSuite) TestRemovesGenerator(c *gc.C) {
+	params := map[string]interface{}{"generator": ""}
+	c.Check(secrets.RemovesGenerator(params), jc.IsTrue)
+	cfg, err := secrets.GeneratorConfigFromParams(params)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cfg, gc.IsNil)
+
+	c.Check(secrets.RemovesGenerator(map[string]interface{}{"generator": "password"}), jc.IsFalse)
+	c.Check(secrets.RemovesGenerator(map[string]interface{}{"foo": "bar"}), jc.IsFalse)
+}
@@ -173,6 +173,10 @@ const (
 	// Detached indicates that the storage is not attached to
 	// any machine.
 	Detached Status = "detached"
+
+	// Warning indicates that the storage is attached to a
+	// machine, but needs attention, e.g. it is nearly full.
+	Warning Status = "warning"
 )
 
 const (
@@ -225,6 +229,10 @@ const (
 	Provisioning      Status = "allocating"
 	Running           Status = "running"
 	ProvisioningError Status = "provisioning error"
+
+	// Interrupted is set when the cloud has reclaimed, or has given
+	// notice that it is about to reclaim, a spot or preemptible instance.
+	Interrupted Status = "interrupted"
 )
 
 // ModificationStatus
@@ -263,6 +271,7 @@ func (s Status) KnownInstanceStatus() bool {
 		ProvisioningError,
 		Allocating,
 		Running,
+		Interrupted,
 		Error,
 		Unknown:
 		return true
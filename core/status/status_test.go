@@ -140,6 +140,7 @@ func (s *StatusSuite) TestKnownInstanceStatus(c *gc.C) {
 		{status.Allocating, true},
 		{status.Provisioning, true},
 		{status.Running, true},
+		{status.Interrupted, true},
 		{status.Error, true},
 		{status.Unknown, true},
 	} {
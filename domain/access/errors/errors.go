@@ -36,6 +36,11 @@ const (
 	// have the required permissions to perform an action.
 	UserUnauthorized = errors.ConstError("user unauthorized")
 
+	// UserLockedOut describes an error that occurs when a user tries to log
+	// in with a password while they are locked out after too many failed
+	// logins.
+	UserLockedOut = errors.ConstError("user locked out")
+
 	// PermissionNotValid is used when a permission has failed validation.
 	PermissionNotValid = errors.ConstError("permission not valid")
 
@@ -79,4 +84,54 @@ const (
 	// UserNeverAccessedModel describes an error that occurs if a user has
 	// never accessed a model.
 	UserNeverAccessedModel = errors.ConstError("user never accessed model")
+
+	// RoleNotFound describes an error that occurs when the role being
+	// requested does not exist.
+	RoleNotFound = errors.ConstError("role not found")
+
+	// RoleAlreadyExists describes an error that occurs when the role being
+	// added already exists.
+	RoleAlreadyExists = errors.ConstError("role already exists")
+
+	// RoleNotValid describes an error that occurs when a role has failed
+	// validation, e.g. its name or one of its methods is not valid.
+	RoleNotValid = errors.ConstError("role not valid")
+
+	// RoleGrantNotFound describes an error that occurs when the role being
+	// revoked was not granted to the user on the target.
+	RoleGrantNotFound = errors.ConstError("role grant not found")
+
+	// RoleGrantAlreadyExists describes an error that occurs when the role
+	// being granted was already granted to the user on the target.
+	RoleGrantAlreadyExists = errors.ConstError("role grant already exists")
+
+	// GroupNotValid describes an error that occurs when the name of a group
+	// is not valid.
+	GroupNotValid = errors.ConstError("group not valid")
+
+	// GroupGrantNotFound describes an error that occurs when the access being
+	// revoked was not granted to the group on the target.
+	GroupGrantNotFound = errors.ConstError("group grant not found")
+
+	// ServiceAccountNotFound describes an error that occurs when the service
+	// account being requested does not exist, or the user is not a service
+	// account.
+	ServiceAccountNotFound = errors.ConstError("service account not found")
+
+	// ServiceAccountTokenNotValid describes an error that occurs when a
+	// service account token has failed validation, e.g. its name is not valid
+	// or it has no scopes.
+	ServiceAccountTokenNotValid = errors.ConstError("service account token not valid")
+
+	// ServiceAccountTokenNotFound describes an error that occurs when the
+	// service account token being requested does not exist.
+	ServiceAccountTokenNotFound = errors.ConstError("service account token not found")
+
+	// ServiceAccountTokenAlreadyExists describes an error that occurs when
+	// the service account already has a token with the name being issued.
+	ServiceAccountTokenAlreadyExists = errors.ConstError("service account token already exists")
+
+	// ServiceAccountTokenExpired describes an error that occurs when a
+	// service account token is used after it has expired.
+	ServiceAccountTokenExpired = errors.ConstError("service account token expired")
 )
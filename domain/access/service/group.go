@@ -0,0 +1,135 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// GroupState describes retrieval and persistence methods for the access
+// granted to groups of users of an external identity provider.
+type GroupState interface {
+	// GrantGroupAccess grants the access to the group on the target,
+	// replacing any access the group had on it. If the target does not exist
+	// an error satisfying [accesserrors.PermissionTargetInvalid] is
+	// returned.
+	GrantGroupAccess(ctx context.Context, uuid string, grant access.GroupGrant) error
+
+	// RevokeGroupAccess revokes the access of the group on the target. If
+	// the group has no access on the target an error satisfying
+	// [accesserrors.GroupGrantNotFound] is returned.
+	RevokeGroupAccess(ctx context.Context, group string, target corepermission.ID) error
+
+	// ListGroupGrants returns the access granted to the group, or to every
+	// group if the name is empty.
+	ListGroupGrants(ctx context.Context, group string) ([]access.GroupGrant, error)
+
+	// ReadGroupAccessLevelForTarget returns the greatest access any of the
+	// groups have on the target, or [corepermission.NoAccess] if none of
+	// them do.
+	ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target corepermission.ID) (corepermission.Access, error)
+
+	// EnsureExternalUserIfGroupAuthorized adds the external user if they are
+	// missing and any of their groups have been granted access.
+	EnsureExternalUserIfGroupAuthorized(ctx context.Context, subject user.Name, groups []string) error
+}
+
+// GroupService provides the API for working with the access granted to
+// groups of users of an external identity provider. The groups a user
+// belongs to are claimed by the identity provider when they log in.
+type GroupService struct {
+	st GroupState
+}
+
+// NewGroupService returns a new GroupService for interacting with the
+// underlying group state.
+func NewGroupService(st GroupState) *GroupService {
+	return &GroupService{
+		st: st,
+	}
+}
+
+// GrantGroupAccess grants the access to the group on the target model or
+// controller, replacing any access the group had on it.
+// The following errors can be returned:
+// - [accesserrors.GroupNotValid] if the group name is not valid.
+// - [coreerrors.NotValid] if the access is not valid for the target.
+// - [accesserrors.PermissionTargetInvalid] if the target does not exist.
+func (s *GroupService) GrantGroupAccess(ctx context.Context, grant access.GroupGrant) error {
+	if err := validateGroupTarget(grant.Target); err != nil {
+		return errors.Capture(err)
+	}
+	if err := grant.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	grantUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.GrantGroupAccess(ctx, grantUUID.String(), grant))
+}
+
+// RevokeGroupAccess revokes the access of the group on the target model or
+// controller.
+// The following errors can be returned:
+// - [accesserrors.GroupNotValid] if the group name is empty.
+// - [accesserrors.GroupGrantNotFound] if the group has no access on the
+// target.
+func (s *GroupService) RevokeGroupAccess(ctx context.Context, group string, target corepermission.ID) error {
+	if group == "" {
+		return errors.Errorf("empty group %w", accesserrors.GroupNotValid)
+	}
+	if err := validateGroupTarget(target); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.RevokeGroupAccess(ctx, group, target))
+}
+
+// ListGroupGrants returns the access granted to the group, or to every group
+// if the name is empty, ordered by group and target.
+func (s *GroupService) ListGroupGrants(ctx context.Context, group string) ([]access.GroupGrant, error) {
+	grants, err := s.st.ListGroupGrants(ctx, group)
+	return grants, errors.Capture(err)
+}
+
+// ReadGroupAccessLevelForTarget returns the greatest access any of the groups
+// have on the target, or [corepermission.NoAccess] if none of them do.
+func (s *GroupService) ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target corepermission.ID) (corepermission.Access, error) {
+	if err := target.Validate(); err != nil {
+		return corepermission.NoAccess, errors.Capture(err)
+	}
+	level, err := s.st.ReadGroupAccessLevelForTarget(ctx, groups, target)
+	return level, errors.Capture(err)
+}
+
+// EnsureExternalUserIfGroupAuthorized adds the external user if they are
+// missing and any of their groups have been granted access, so that juju has
+// a record of the users that inherit their access from their groups.
+func (s *GroupService) EnsureExternalUserIfGroupAuthorized(ctx context.Context, subject user.Name, groups []string) error {
+	if subject.IsZero() {
+		return errors.Errorf("empty subject %w", coreerrors.NotValid)
+	}
+	return errors.Capture(s.st.EnsureExternalUserIfGroupAuthorized(ctx, subject, groups))
+}
+
+// validateGroupTarget checks that the target is a model or the controller.
+func validateGroupTarget(target corepermission.ID) error {
+	if err := target.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	switch target.ObjectType {
+	case corepermission.Model, corepermission.Controller:
+	default:
+		return errors.Errorf("group access granted on %q %w", target.ObjectType, accesserrors.PermissionTargetInvalid)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corepermission "github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+)
+
+type groupServiceSuite struct {
+	testing.IsolationSuite
+
+	state *MockState
+}
+
+var _ = gc.Suite(&groupServiceSuite{})
+
+func (s *groupServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	return ctrl
+}
+
+func (s *groupServiceSuite) modelTarget() corepermission.ID {
+	return corepermission.ID{
+		ObjectType: corepermission.Model,
+		Key:        "5f8b9a4e-8d5c-4d3e-8b5a-3b0c1a2d9e7f",
+	}
+}
+
+func (s *groupServiceSuite) TestGrantGroupAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	grant := access.GroupGrant{
+		Group:  "devs",
+		Access: corepermission.WriteAccess,
+		Target: s.modelTarget(),
+	}
+	s.state.EXPECT().GrantGroupAccess(gomock.Any(), gomock.Any(), grant).Return(nil)
+
+	err := NewService(s.state).GrantGroupAccess(context.Background(), grant)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupServiceSuite) TestGrantGroupAccessGroupNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.state).GrantGroupAccess(context.Background(), access.GroupGrant{
+		Group:  " devs",
+		Access: corepermission.WriteAccess,
+		Target: s.modelTarget(),
+	})
+	c.Assert(err, jc.ErrorIs, accesserrors.GroupNotValid)
+}
+
+func (s *groupServiceSuite) TestGrantGroupAccessNotValidForTarget(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.state).GrantGroupAccess(context.Background(), access.GroupGrant{
+		Group:  "devs",
+		Access: corepermission.SuperuserAccess,
+		Target: s.modelTarget(),
+	})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *groupServiceSuite) TestGrantGroupAccessCloudTarget(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.state).GrantGroupAccess(context.Background(), access.GroupGrant{
+		Group:  "devs",
+		Access: corepermission.AddModelAccess,
+		Target: corepermission.ID{ObjectType: corepermission.Cloud, Key: "aws"},
+	})
+	c.Assert(err, jc.ErrorIs, accesserrors.PermissionTargetInvalid)
+}
+
+func (s *groupServiceSuite) TestRevokeGroupAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RevokeGroupAccess(gomock.Any(), "devs", s.modelTarget()).Return(accesserrors.GroupGrantNotFound)
+
+	err := NewService(s.state).RevokeGroupAccess(context.Background(), "devs", s.modelTarget())
+	c.Assert(err, jc.ErrorIs, accesserrors.GroupGrantNotFound)
+}
+
+func (s *groupServiceSuite) TestReadGroupAccessLevelForTarget(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	groups := []string{"devs", "ops"}
+	s.state.EXPECT().ReadGroupAccessLevelForTarget(gomock.Any(), groups, s.modelTarget()).Return(corepermission.AdminAccess, nil)
+
+	level, err := NewService(s.state).ReadGroupAccessLevelForTarget(context.Background(), groups, s.modelTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(level, gc.Equals, corepermission.AdminAccess)
+}
+
+func (s *groupServiceSuite) TestEnsureExternalUserIfGroupAuthorized(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := usertesting.GenNewName(c, "alice@external")
+	s.state.EXPECT().EnsureExternalUserIfGroupAuthorized(gomock.Any(), name, []string{"devs"}).Return(nil)
+
+	err := NewService(s.state).EnsureExternalUserIfGroupAuthorized(context.Background(), name, []string{"devs"})
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/auth"
+	"github.com/juju/juju/internal/errors"
+)
+
+// loginHistoryLimit is the number of recent password logins kept for each
+// user. It also bounds the consecutive failed logins counted for lockouts.
+const loginHistoryLimit = 100
+
+// lockoutPolicy describes when users are locked out after failed password
+// logins.
+type lockoutPolicy struct {
+	// threshold is the number of consecutive failed logins after which a
+	// user is locked out, or 0 if users are never locked out.
+	threshold int
+	// duration is how long a user is first locked out for. The lockout
+	// doubles with each further failed login.
+	duration time.Duration
+}
+
+// lockedOutUntil returns when the lockout of a user with the login attempts,
+// most recent first, ends. The zero time is returned if the user has not
+// reached the lockout threshold.
+func (p lockoutPolicy) lockedOutUntil(attempts []access.LoginAttempt) time.Time {
+	if p.threshold <= 0 {
+		return time.Time{}
+	}
+	failures := 0
+	for _, attempt := range attempts {
+		if attempt.Success {
+			break
+		}
+		failures++
+	}
+	if failures < p.threshold {
+		return time.Time{}
+	}
+
+	lockout := p.duration
+	for i := p.threshold; i < failures && lockout < controller.MaxLoginLockoutDuration; i++ {
+		lockout *= 2
+	}
+	lockout = min(lockout, controller.MaxLoginLockoutDuration)
+	return attempts[0].Time.Add(lockout)
+}
+
+// AuthenticateUser returns the user with the name and password, recording the
+// login attempt from the address for the login history of the user. Users
+// who fail to log in too many times in a row are locked out for a time which
+// doubles with each further failure, as configured by the controller.
+// The following error types are possible from this function:
+//   - accesserrors.UserNameNotValid: When the username supplied is not valid.
+//   - accesserrors.UserNotFound: If no user by the given name exists.
+//   - accesserrors.UserUnauthorized: If the password is not correct.
+//   - accesserrors.UserLockedOut: If the user is locked out.
+func (s *UserService) AuthenticateUser(
+	ctx context.Context,
+	name user.Name,
+	password auth.Password,
+	address string,
+) (user.User, error) {
+	if name.IsZero() {
+		return user.User{}, errors.Errorf("empty username: %w", accesserrors.UserNameNotValid)
+	}
+
+	policy, err := s.lockoutPolicy(ctx)
+	if err != nil {
+		password.Destroy()
+		return user.User{}, errors.Capture(err)
+	}
+	if policy.threshold > 0 {
+		attempts, err := s.st.GetLoginAttempts(ctx, name, loginHistoryLimit)
+		if err != nil {
+			password.Destroy()
+			return user.User{}, errors.Errorf("getting login attempts for user %q: %w", name, err)
+		}
+		if until := policy.lockedOutUntil(attempts); s.clock.Now().Before(until) {
+			password.Destroy()
+			return user.User{}, errors.Errorf(
+				"%w until %s", accesserrors.UserLockedOut, until.UTC().Format(time.RFC3339),
+			)
+		}
+	}
+
+	usr, err := s.GetUserByAuth(ctx, name, password)
+	if err != nil && !errors.Is(err, accesserrors.UserUnauthorized) {
+		return user.User{}, errors.Capture(err)
+	} else if err == nil && usr.Disabled {
+		// Disabled users can not log in, so their correct password is
+		// not recorded as a successful login which would end a lockout.
+		return usr, nil
+	}
+
+	attempt := access.LoginAttempt{
+		Time:    s.clock.Now(),
+		Success: err == nil,
+		Address: address,
+	}
+	if recordErr := s.st.RecordLoginAttempt(ctx, name, attempt, loginHistoryLimit); recordErr != nil {
+		return user.User{}, errors.Errorf("recording login attempt for user %q: %w", name, recordErr)
+	}
+	if err != nil {
+		return user.User{}, errors.Capture(err)
+	}
+	return usr, nil
+}
+
+// GetLoginHistory returns the most recent password logins of the user, up to
+// the limit, most recent first.
+// The following error types are possible from this function:
+//   - accesserrors.UserNameNotValid: When the username supplied is not valid.
+//   - accesserrors.UserNotFound: If no user by the given name exists.
+func (s *UserService) GetLoginHistory(ctx context.Context, name user.Name, limit int) ([]access.LoginAttempt, error) {
+	if name.IsZero() {
+		return nil, errors.Errorf("empty username: %w", accesserrors.UserNameNotValid)
+	}
+	if limit <= 0 || limit > loginHistoryLimit {
+		limit = loginHistoryLimit
+	}
+
+	attempts, err := s.st.GetLoginAttempts(ctx, name, limit)
+	if err != nil {
+		return nil, errors.Errorf("getting login history for user %q: %w", name, err)
+	}
+	return attempts, nil
+}
+
+// checkPasswordPolicy checks that the password is valid and meets the
+// password policy of the controller.
+func (s *UserService) checkPasswordPolicy(ctx context.Context, password auth.Password) error {
+	if err := password.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+
+	config, err := s.st.GetControllerConfigKeys(ctx, []string{
+		controller.PasswordMinLength,
+		controller.PasswordMinCharacterClasses,
+	})
+	if err != nil {
+		return errors.Errorf("getting password policy: %w", err)
+	}
+
+	var policy auth.PasswordPolicy
+	if policy.MinLength, err = configInt(config, controller.PasswordMinLength, controller.DefaultPasswordMinLength); err != nil {
+		return errors.Capture(err)
+	}
+	if policy.MinCharacterClasses, err = configInt(config, controller.PasswordMinCharacterClasses, controller.DefaultPasswordMinCharacterClasses); err != nil {
+		return errors.Capture(err)
+	}
+	return policy.Check(password)
+}
+
+// lockoutPolicy returns the login lockout policy of the controller.
+func (s *UserService) lockoutPolicy(ctx context.Context) (lockoutPolicy, error) {
+	config, err := s.st.GetControllerConfigKeys(ctx, []string{
+		controller.LoginLockoutThreshold,
+		controller.LoginLockoutDuration,
+	})
+	if err != nil {
+		return lockoutPolicy{}, errors.Errorf("getting login lockout policy: %w", err)
+	}
+
+	policy := lockoutPolicy{duration: controller.DefaultLoginLockoutDuration}
+	if policy.threshold, err = configInt(config, controller.LoginLockoutThreshold, controller.DefaultLoginLockoutThreshold); err != nil {
+		return lockoutPolicy{}, errors.Capture(err)
+	}
+	if v, ok := config[controller.LoginLockoutDuration]; ok {
+		if policy.duration, err = time.ParseDuration(v); err != nil {
+			return lockoutPolicy{}, errors.Errorf("parsing %s: %w", controller.LoginLockoutDuration, err)
+		}
+	}
+	return policy, nil
+}
+
+// configInt returns the integer value of the controller config key, or the
+// default if it is not set.
+func configInt(config map[string]string, key string, defaultVal int) (int, error) {
+	v, ok := config[key]
+	if !ok {
+		return defaultVal, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, errors.Errorf("parsing %s: %w", key, err)
+	}
+	return i, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/user"
+	coreusertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	usererrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/auth"
+)
+
+type loginServiceSuite struct {
+	state *MockState
+	clock *testclock.Clock
+}
+
+var _ = gc.Suite(&loginServiceSuite{})
+
+func (s *loginServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *loginServiceSuite) service() *UserService {
+	svc := NewUserService(s.state)
+	svc.clock = s.clock
+	return svc
+}
+
+func (s *loginServiceSuite) expectLockoutPolicy(threshold, duration string) {
+	s.state.EXPECT().GetControllerConfigKeys(gomock.Any(), []string{
+		controller.LoginLockoutThreshold,
+		controller.LoginLockoutDuration,
+	}).Return(map[string]string{
+		controller.LoginLockoutThreshold: threshold,
+		controller.LoginLockoutDuration:  duration,
+	}, nil)
+}
+
+func (s *loginServiceSuite) failures(n int, last time.Time) []access.LoginAttempt {
+	attempts := make([]access.LoginAttempt, n)
+	for i := range attempts {
+		attempts[i] = access.LoginAttempt{Time: last.Add(-time.Duration(i) * time.Second)}
+	}
+	return attempts
+}
+
+func (s *loginServiceSuite) TestSetPasswordPolicy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "bob")
+	s.state.EXPECT().GetControllerConfigKeys(gomock.Any(), []string{
+		controller.PasswordMinLength,
+		controller.PasswordMinCharacterClasses,
+	}).Return(map[string]string{
+		controller.PasswordMinLength:           "10",
+		controller.PasswordMinCharacterClasses: "3",
+	}, nil).Times(2)
+	s.state.EXPECT().SetPasswordHash(gomock.Any(), name, gomock.Any(), gomock.Any()).Return(nil)
+	s.state.EXPECT().ClearLoginFailures(gomock.Any(), name).Return(nil)
+
+	err := s.service().SetPassword(context.Background(), name, auth.NewPassword("hunter2"))
+	c.Assert(err, jc.ErrorIs, auth.ErrPasswordNotValid)
+	c.Assert(err, gc.ErrorMatches, "password not valid, must be at least 10 characters")
+
+	err = s.service().SetPassword(context.Background(), name, auth.NewPassword("Correct-Horse"))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *loginServiceSuite) TestAuthenticateUserSuccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "bob")
+	s.expectLockoutPolicy("3", "1m")
+	s.state.EXPECT().GetLoginAttempts(gomock.Any(), name, loginHistoryLimit).Return(nil, nil)
+	s.state.EXPECT().GetUserByAuth(gomock.Any(), name, gomock.Any()).Return(user.User{Name: name}, nil)
+	s.state.EXPECT().RecordLoginAttempt(gomock.Any(), name, access.LoginAttempt{
+		Time:    s.clock.Now(),
+		Success: true,
+		Address: "10.0.0.1:4242",
+	}, loginHistoryLimit).Return(nil)
+
+	usr, err := s.service().AuthenticateUser(context.Background(), name, auth.NewPassword("secret"), "10.0.0.1:4242")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(usr.Name, gc.Equals, name)
+}
+
+func (s *loginServiceSuite) TestAuthenticateUserWrongPassword(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "bob")
+	s.expectLockoutPolicy("3", "1m")
+	s.state.EXPECT().GetLoginAttempts(gomock.Any(), name, loginHistoryLimit).Return(s.failures(2, s.clock.Now()), nil)
+	s.state.EXPECT().GetUserByAuth(gomock.Any(), name, gomock.Any()).Return(user.User{}, usererrors.UserUnauthorized)
+	s.state.EXPECT().RecordLoginAttempt(gomock.Any(), name, access.LoginAttempt{
+		Time:    s.clock.Now(),
+		Address: "10.0.0.1:4242",
+	}, loginHistoryLimit).Return(nil)
+
+	_, err := s.service().AuthenticateUser(context.Background(), name, auth.NewPassword("wrong"), "10.0.0.1:4242")
+	c.Assert(err, jc.ErrorIs, usererrors.UserUnauthorized)
+}
+
+func (s *loginServiceSuite) TestAuthenticateUserDisabledNotRecorded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "bob")
+	s.expectLockoutPolicy("3", "1m")
+	s.state.EXPECT().GetLoginAttempts(gomock.Any(), name, loginHistoryLimit).Return(s.failures(2, s.clock.Now()), nil)
+	s.state.EXPECT().GetUserByAuth(gomock.Any(), name, gomock.Any()).Return(user.User{Name: name, Disabled: true}, nil)
+
+	usr, err := s.service().AuthenticateUser(context.Background(), name, auth.NewPassword("secret"), "10.0.0.1:4242")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(usr.Disabled, jc.IsTrue)
+}
+
+func (s *loginServiceSuite) TestAuthenticateUserNotFoundNotRecorded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "ghost")
+	s.expectLockoutPolicy("0", "1m")
+	s.state.EXPECT().GetUserByAuth(gomock.Any(), name, gomock.Any()).Return(user.User{}, usererrors.UserNotFound)
+
+	_, err := s.service().AuthenticateUser(context.Background(), name, auth.NewPassword("secret"), "")
+	c.Assert(err, jc.ErrorIs, usererrors.UserNotFound)
+}
+
+func (s *loginServiceSuite) TestAuthenticateUserLockedOut(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "bob")
+	s.expectLockoutPolicy("3", "1m")
+	s.state.EXPECT().GetLoginAttempts(gomock.Any(), name, loginHistoryLimit).Return(
+		s.failures(3, s.clock.Now().Add(-30*time.Second)), nil)
+
+	_, err := s.service().AuthenticateUser(context.Background(), name, auth.NewPassword("secret"), "")
+	c.Assert(err, jc.ErrorIs, usererrors.UserLockedOut)
+	c.Assert(err, gc.ErrorMatches, `user locked out until 2025-06-01T12:00:30Z`)
+}
+
+func (s *loginServiceSuite) TestAuthenticateUserLockoutExpired(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "bob")
+	s.expectLockoutPolicy("3", "1m")
+	s.state.EXPECT().GetLoginAttempts(gomock.Any(), name, loginHistoryLimit).Return(
+		s.failures(3, s.clock.Now().Add(-2*time.Minute)), nil)
+	s.state.EXPECT().GetUserByAuth(gomock.Any(), name, gomock.Any()).Return(user.User{Name: name}, nil)
+	s.state.EXPECT().RecordLoginAttempt(gomock.Any(), name, gomock.Any(), loginHistoryLimit).Return(nil)
+
+	_, err := s.service().AuthenticateUser(context.Background(), name, auth.NewPassword("secret"), "")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *loginServiceSuite) TestLockedOutUntil(c *gc.C) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := lockoutPolicy{threshold: 3, duration: time.Minute}
+
+	// Failures before the last success are not counted.
+	attempts := append(s.failures(2, now), access.LoginAttempt{Success: true})
+	attempts = append(attempts, s.failures(5, now.Add(-time.Hour))...)
+	c.Check(policy.lockedOutUntil(attempts), gc.Equals, time.Time{})
+
+	c.Check(policy.lockedOutUntil(s.failures(3, now)), gc.Equals, now.Add(time.Minute))
+	c.Check(policy.lockedOutUntil(s.failures(4, now)), gc.Equals, now.Add(2*time.Minute))
+	c.Check(policy.lockedOutUntil(s.failures(6, now)), gc.Equals, now.Add(8*time.Minute))
+	c.Check(policy.lockedOutUntil(s.failures(100, now)), gc.Equals, now.Add(controller.MaxLoginLockoutDuration))
+
+	policy.threshold = 0
+	c.Check(policy.lockedOutUntil(s.failures(100, now)), gc.Equals, time.Time{})
+}
+
+func (s *loginServiceSuite) TestGetLoginHistory(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := coreusertesting.GenNewName(c, "bob")
+	attempts := []access.LoginAttempt{{Time: s.clock.Now(), Success: true, Address: "10.0.0.1:4242"}}
+	s.state.EXPECT().GetLoginAttempts(gomock.Any(), name, 10).Return(attempts, nil)
+	s.state.EXPECT().GetLoginAttempts(gomock.Any(), name, loginHistoryLimit).Return(attempts, nil)
+
+	got, err := s.service().GetLoginHistory(context.Background(), name, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, attempts)
+
+	_, err = s.service().GetLoginHistory(context.Background(), name, 0)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.service().GetLoginHistory(context.Background(), user.Name{}, 10)
+	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// RoleState describes retrieval and persistence methods for custom roles.
+type RoleState interface {
+	// AddRole adds the role with the methods it allows. If a role with the
+	// same name exists an error satisfying
+	// [accesserrors.RoleAlreadyExists] is returned.
+	AddRole(ctx context.Context, uuid string, role access.Role) error
+
+	// GetRole returns the role with the name. If the role does not exist an
+	// error satisfying [accesserrors.RoleNotFound] is returned.
+	GetRole(ctx context.Context, name string) (access.Role, error)
+
+	// ListRoles returns every role, ordered by name.
+	ListRoles(ctx context.Context) ([]access.Role, error)
+
+	// RemoveRole removes the role, along with every grant of it. If the role
+	// does not exist an error satisfying [accesserrors.RoleNotFound] is
+	// returned.
+	RemoveRole(ctx context.Context, name string) error
+
+	// GrantRole grants the role to the user on the target model or
+	// controller. If the role was already granted an error satisfying
+	// [accesserrors.RoleGrantAlreadyExists] is returned.
+	GrantRole(ctx context.Context, uuid string, grant access.RoleGrant) error
+
+	// RevokeRole revokes the role from the user on the target. If the role
+	// was not granted an error satisfying [accesserrors.RoleGrantNotFound]
+	// is returned.
+	RevokeRole(ctx context.Context, grant access.RoleGrant) error
+
+	// ListRoleGrants returns the grants of the role with the name, or of
+	// every role if the name is empty.
+	ListRoleGrants(ctx context.Context, roleName string) ([]access.RoleGrant, error)
+
+	// GetUserRoles returns the roles granted to the user on the target,
+	// along with those granted to them on the controller.
+	GetUserRoles(ctx context.Context, subject user.Name, target corepermission.ID) ([]access.Role, error)
+}
+
+// RoleService provides the API for working with custom roles, which allow
+// users to call a set of API methods on the models or controller they are
+// granted on, in addition to those their access level allows.
+type RoleService struct {
+	st RoleState
+}
+
+// NewRoleService returns a new RoleService for interacting with the
+// underlying role state.
+func NewRoleService(st RoleState) *RoleService {
+	return &RoleService{
+		st: st,
+	}
+}
+
+// AddRole adds the role.
+// The following errors can be returned:
+// - [accesserrors.RoleNotValid] if the role is not valid.
+// - [accesserrors.RoleAlreadyExists] if a role with the same name exists.
+func (s *RoleService) AddRole(ctx context.Context, role access.Role) error {
+	if err := role.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	roleUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.AddRole(ctx, roleUUID.String(), role))
+}
+
+// GetRole returns the role with the name.
+// The following errors can be returned:
+// - [accesserrors.RoleNotFound] if the role does not exist.
+func (s *RoleService) GetRole(ctx context.Context, name string) (access.Role, error) {
+	role, err := s.st.GetRole(ctx, name)
+	return role, errors.Capture(err)
+}
+
+// ListRoles returns every role, ordered by name.
+func (s *RoleService) ListRoles(ctx context.Context) ([]access.Role, error) {
+	roles, err := s.st.ListRoles(ctx)
+	return roles, errors.Capture(err)
+}
+
+// RemoveRole removes the role, revoking it from every user it was granted
+// to.
+// The following errors can be returned:
+// - [accesserrors.RoleNotFound] if the role does not exist.
+func (s *RoleService) RemoveRole(ctx context.Context, name string) error {
+	return errors.Capture(s.st.RemoveRole(ctx, name))
+}
+
+// GrantRole grants the role to the user on the target model or controller.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the grant is not valid.
+// - [accesserrors.RoleNotFound] if the role does not exist.
+// - [accesserrors.UserNotFound] if the user does not exist.
+// - [accesserrors.PermissionTargetInvalid] if the target does not exist.
+// - [accesserrors.RoleGrantAlreadyExists] if the role was already granted to
+// the user on the target.
+func (s *RoleService) GrantRole(ctx context.Context, grant access.RoleGrant) error {
+	if err := validateRoleGrant(grant); err != nil {
+		return errors.Capture(err)
+	}
+	grantUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.GrantRole(ctx, grantUUID.String(), grant))
+}
+
+// RevokeRole revokes the role from the user on the target model or
+// controller.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the grant is not valid.
+// - [accesserrors.RoleGrantNotFound] if the role was not granted to the user
+// on the target.
+func (s *RoleService) RevokeRole(ctx context.Context, grant access.RoleGrant) error {
+	if err := validateRoleGrant(grant); err != nil {
+		return errors.Capture(err)
+	}
+	return errors.Capture(s.st.RevokeRole(ctx, grant))
+}
+
+// ListRoleGrants returns the grants of the role with the name, or of every
+// role if the name is empty.
+// The following errors can be returned:
+// - [accesserrors.RoleNotFound] if the named role does not exist.
+func (s *RoleService) ListRoleGrants(ctx context.Context, roleName string) ([]access.RoleGrant, error) {
+	grants, err := s.st.ListRoleGrants(ctx, roleName)
+	return grants, errors.Capture(err)
+}
+
+// RoleAllowsMethod reports whether any role granted to the user on the
+// target, or on the controller, allows them to call the API method, which
+// is given as "Facade.Method".
+func (s *RoleService) RoleAllowsMethod(ctx context.Context, subject user.Name, target corepermission.ID, method string) (bool, error) {
+	if subject.IsZero() {
+		return false, errors.Errorf("empty subject %w", coreerrors.NotValid)
+	}
+	roles, err := s.st.GetUserRoles(ctx, subject, target)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+	for _, role := range roles {
+		if role.Allows(method) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validateRoleGrant checks that the grant names a role and a user, and that
+// its target is a model or the controller.
+func validateRoleGrant(grant access.RoleGrant) error {
+	if grant.Role == "" {
+		return errors.Errorf("empty role %w", coreerrors.NotValid)
+	}
+	if grant.Subject.IsZero() {
+		return errors.Errorf("empty subject %w", coreerrors.NotValid)
+	}
+	if err := grant.Target.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	switch grant.Target.ObjectType {
+	case corepermission.Model, corepermission.Controller:
+	default:
+		return errors.Errorf("role granted on %q %w", grant.Target.ObjectType, accesserrors.PermissionTargetInvalid)
+	}
+	return nil
+}
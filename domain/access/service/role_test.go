@@ -0,0 +1,143 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+)
+
+type roleServiceSuite struct {
+	testing.IsolationSuite
+
+	state *MockState
+}
+
+var _ = gc.Suite(&roleServiceSuite{})
+
+func (s *roleServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	return ctrl
+}
+
+func (s *roleServiceSuite) modelTarget() corepermission.ID {
+	return corepermission.ID{
+		ObjectType: corepermission.Model,
+		Key:        "5f8b9a4e-8d5c-4d3e-8b5a-3b0c1a2d9e7f",
+	}
+}
+
+func (s *roleServiceSuite) TestAddRole(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	role := access.Role{Name: "operator", Methods: []string{"Application.Get"}}
+	s.state.EXPECT().AddRole(gomock.Any(), gomock.Any(), role).Return(nil)
+
+	err := NewService(s.state).AddRole(context.Background(), role)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleServiceSuite) TestAddRoleNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.state).AddRole(context.Background(), access.Role{Name: "operator"})
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleNotValid)
+}
+
+func (s *roleServiceSuite) TestGrantRole(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	grant := access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  s.modelTarget(),
+	}
+	s.state.EXPECT().GrantRole(gomock.Any(), gomock.Any(), grant).Return(nil)
+
+	err := NewService(s.state).GrantRole(context.Background(), grant)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleServiceSuite) TestGrantRoleNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := NewService(s.state)
+	err := svc.GrantRole(context.Background(), access.RoleGrant{
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  s.modelTarget(),
+	})
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+
+	err = svc.GrantRole(context.Background(), access.RoleGrant{
+		Role:   "operator",
+		Target: s.modelTarget(),
+	})
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+
+	err = svc.GrantRole(context.Background(), access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  corepermission.ID{ObjectType: corepermission.Cloud, Key: "aws"},
+	})
+	c.Check(err, jc.ErrorIs, accesserrors.PermissionTargetInvalid)
+}
+
+func (s *roleServiceSuite) TestRevokeRole(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	grant := access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  s.modelTarget(),
+	}
+	s.state.EXPECT().RevokeRole(gomock.Any(), grant).Return(accesserrors.RoleGrantNotFound)
+
+	err := NewService(s.state).RevokeRole(context.Background(), grant)
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleGrantNotFound)
+}
+
+func (s *roleServiceSuite) TestRoleAllowsMethod(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	bob := usertesting.GenNewName(c, "bob")
+	s.state.EXPECT().GetUserRoles(gomock.Any(), bob, s.modelTarget()).Return([]access.Role{{
+		Name:    "operator",
+		Methods: []string{"Application.Get"},
+	}, {
+		Name:    "actions",
+		Methods: []string{"Action.*"},
+	}}, nil).Times(3)
+
+	svc := NewService(s.state)
+	allowed, err := svc.RoleAllowsMethod(context.Background(), bob, s.modelTarget(), "Application.Get")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(allowed, jc.IsTrue)
+
+	allowed, err = svc.RoleAllowsMethod(context.Background(), bob, s.modelTarget(), "Action.EnqueueOperation")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(allowed, jc.IsTrue)
+
+	allowed, err = svc.RoleAllowsMethod(context.Background(), bob, s.modelTarget(), "Application.Deploy")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(allowed, jc.IsFalse)
+}
+
+func (s *roleServiceSuite) TestRoleAllowsMethodEmptySubject(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewService(s.state).RoleAllowsMethod(context.Background(), user.Name{}, s.modelTarget(), "Application.Get")
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
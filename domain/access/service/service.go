@@ -7,6 +7,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/juju/clock"
+
 	"github.com/juju/juju/core/credential"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
@@ -20,6 +22,9 @@ import (
 type State interface {
 	UserState
 	PermissionState
+	RoleState
+	GroupState
+	ServiceAccountState
 }
 
 // UserState describes retrieval and persistence methods for user identify and
@@ -136,6 +141,28 @@ type UserState interface {
 	// - accesserrors.UserNeverAccessedModel: If there is no record of the user
 	// accessing the model.
 	LastModelLogin(context.Context, user.Name, coremodel.UUID) (time.Time, error)
+
+	// RecordLoginAttempt records a password login of the user, keeping only
+	// the most recent attempts up to the limit.
+	// The following error types are possible from this function:
+	// - accesserrors.UserNotFound: When the user cannot be found.
+	RecordLoginAttempt(ctx context.Context, name user.Name, attempt access.LoginAttempt, keep int) error
+
+	// GetLoginAttempts returns the most recent password logins of the user,
+	// up to the limit, most recent first.
+	// The following error types are possible from this function:
+	// - accesserrors.UserNotFound: When the user cannot be found.
+	GetLoginAttempts(ctx context.Context, name user.Name, limit int) ([]access.LoginAttempt, error)
+
+	// ClearLoginFailures removes the failed password logins the user made
+	// since their last successful one.
+	// The following error types are possible from this function:
+	// - accesserrors.UserNotFound: When the user cannot be found.
+	ClearLoginFailures(ctx context.Context, name user.Name) error
+
+	// GetControllerConfigKeys returns the values of the controller config
+	// keys which are set.
+	GetControllerConfigKeys(ctx context.Context, keys []string) (map[string]string, error)
 }
 
 // PermissionState describes retrieval and persistence methods for user
@@ -193,13 +220,19 @@ type PermissionState interface {
 type Service struct {
 	*UserService
 	*PermissionService
+	*RoleService
+	*GroupService
+	*ServiceAccountService
 }
 
 // NewService returns a new Service for interacting with the underlying access
 // state.
 func NewService(st State) *Service {
 	return &Service{
-		UserService:       NewUserService(st),
-		PermissionService: NewPermissionService(st),
+		UserService:           NewUserService(st),
+		PermissionService:     NewPermissionService(st),
+		RoleService:           NewRoleService(st),
+		GroupService:          NewGroupService(st),
+		ServiceAccountService: NewServiceAccountService(st, clock.WallClock),
 	}
 }
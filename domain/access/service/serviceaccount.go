@@ -0,0 +1,183 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/juju/clock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// ServiceAccountState describes retrieval and persistence methods for
+// service accounts and the API tokens issued to them.
+type ServiceAccountState interface {
+	// AddServiceAccount adds a service account, a local user without a
+	// password. If a user with the name already exists an error satisfying
+	// [accesserrors.UserAlreadyExists] is returned.
+	AddServiceAccount(ctx context.Context, uuid user.UUID, name, creator user.Name) error
+
+	// IssueServiceAccountToken records the token issued to the service
+	// account, storing only the hash of the token. If the service account
+	// already has a token with the name an error satisfying
+	// [accesserrors.ServiceAccountTokenAlreadyExists] is returned.
+	IssueServiceAccountToken(ctx context.Context, uuid string, token access.ServiceAccountToken, tokenHash string) error
+
+	// RevokeServiceAccountToken removes the token of the service account. If
+	// the service account has no token with the name an error satisfying
+	// [accesserrors.ServiceAccountTokenNotFound] is returned.
+	RevokeServiceAccountToken(ctx context.Context, account user.Name, name string) error
+
+	// GetServiceAccountToken returns the token with the hash. If there is no
+	// such token, or its service account has been removed or disabled, an
+	// error satisfying [accesserrors.ServiceAccountTokenNotFound] is
+	// returned.
+	GetServiceAccountToken(ctx context.Context, tokenHash string) (access.ServiceAccountToken, error)
+}
+
+// ServiceAccountService provides the API for working with service accounts,
+// the identities of automation such as CI pipelines. Service accounts
+// authenticate with named, revocable and expiring API tokens, each scoped to
+// access on models or the controller.
+type ServiceAccountService struct {
+	st    ServiceAccountState
+	clock clock.Clock
+}
+
+// NewServiceAccountService returns a new ServiceAccountService for
+// interacting with the underlying service account state.
+func NewServiceAccountService(st ServiceAccountState, clock clock.Clock) *ServiceAccountService {
+	return &ServiceAccountService{
+		st:    st,
+		clock: clock,
+	}
+}
+
+// AddServiceAccount adds a service account with the name, created by the
+// creator. Service accounts are local users without a password, and are
+// granted access like any other user.
+// The following errors can be returned:
+// - [accesserrors.UserNameNotValid] if the name is not that of a local user.
+// - [accesserrors.UserAlreadyExists] if a user with the name already exists.
+// - [accesserrors.UserCreatorUUIDNotFound] if the creator does not exist.
+func (s *ServiceAccountService) AddServiceAccount(ctx context.Context, name, creator user.Name) (user.UUID, error) {
+	if name.IsZero() || !name.IsLocal() {
+		return "", errors.Errorf("service account %q %w", name, accesserrors.UserNameNotValid)
+	}
+	accountUUID, err := user.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	if err := s.st.AddServiceAccount(ctx, accountUUID, name, creator); err != nil {
+		return "", errors.Errorf("adding service account %q: %w", name, err)
+	}
+	return accountUUID, nil
+}
+
+// IssueServiceAccountToken issues a token to the service account, returning
+// the token. Only a hash of the token is kept, so it cannot be read again.
+// The following errors can be returned:
+// - [accesserrors.ServiceAccountTokenNotValid] if the arguments are not
+// valid.
+// - [accesserrors.ServiceAccountNotFound] if the service account does not
+// exist.
+// - [accesserrors.ServiceAccountTokenAlreadyExists] if the service account
+// already has a token with the name.
+// - [accesserrors.PermissionTargetInvalid] if the target of a scope does not
+// exist.
+func (s *ServiceAccountService) IssueServiceAccountToken(ctx context.Context, args access.IssueServiceAccountTokenArgs) (string, error) {
+	if err := args.Validate(); err != nil {
+		return "", errors.Capture(err)
+	}
+	token, err := generateServiceAccountToken()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	tokenUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	now := s.clock.Now().UTC()
+	issued := access.ServiceAccountToken{
+		Name:           args.Name,
+		ServiceAccount: args.ServiceAccount,
+		Scopes:         args.Scopes,
+		CreatedAt:      now,
+	}
+	if args.ExpiresIn > 0 {
+		expiresAt := now.Add(args.ExpiresIn)
+		issued.ExpiresAt = &expiresAt
+	}
+	if err := s.st.IssueServiceAccountToken(ctx, tokenUUID.String(), issued, hashServiceAccountToken(token)); err != nil {
+		return "", errors.Errorf("issuing token %q to %q: %w", args.Name, args.ServiceAccount, err)
+	}
+	return token, nil
+}
+
+// RevokeServiceAccountToken revokes the token of the service account, so it
+// can no longer be used.
+// The following errors can be returned:
+// - [accesserrors.ServiceAccountNotFound] if the service account does not
+// exist.
+// - [accesserrors.ServiceAccountTokenNotFound] if the service account has no
+// token with the name.
+func (s *ServiceAccountService) RevokeServiceAccountToken(ctx context.Context, account user.Name, name string) error {
+	if account.IsZero() {
+		return errors.Errorf("empty service account %w", accesserrors.ServiceAccountNotFound)
+	}
+	return errors.Capture(s.st.RevokeServiceAccountToken(ctx, account, name))
+}
+
+// AuthenticateServiceAccountToken returns the service account token, if it
+// is one issued by the controller and has not expired or been revoked.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the token is not a service account token.
+// - [accesserrors.ServiceAccountTokenNotFound] if the token was not issued
+// by the controller, or has been revoked.
+// - [accesserrors.ServiceAccountTokenExpired] if the token has expired.
+func (s *ServiceAccountService) AuthenticateServiceAccountToken(ctx context.Context, token string) (access.ServiceAccountToken, error) {
+	if !access.IsServiceAccountToken(token) {
+		return access.ServiceAccountToken{}, errors.Errorf("service account token %w", coreerrors.NotValid)
+	}
+	issued, err := s.st.GetServiceAccountToken(ctx, hashServiceAccountToken(token))
+	if err != nil {
+		return access.ServiceAccountToken{}, errors.Capture(err)
+	}
+	if issued.ExpiresAt != nil && !s.clock.Now().Before(*issued.ExpiresAt) {
+		return access.ServiceAccountToken{}, errors.Errorf(
+			"token %q of %q: %w", issued.Name, issued.ServiceAccount, accesserrors.ServiceAccountTokenExpired)
+	}
+	return issued, nil
+}
+
+// serviceAccountTokenLength is the number of random bytes in a service
+// account token.
+const serviceAccountTokenLength = 32
+
+// generateServiceAccountToken returns a new random service account token.
+func generateServiceAccountToken() (string, error) {
+	var secret [serviceAccountTokenLength]byte
+	if _, err := rand.Read(secret[:]); err != nil {
+		return "", errors.Errorf("generating service account token: %w", err)
+	}
+	return access.ServiceAccountTokenPrefix + base64.RawURLEncoding.EncodeToString(secret[:]), nil
+}
+
+// hashServiceAccountToken returns the hash of the token which is stored in
+// place of it. The tokens are random, so a fast hash is sufficient.
+func hashServiceAccountToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
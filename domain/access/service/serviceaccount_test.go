@@ -0,0 +1,160 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corepermission "github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+)
+
+type serviceAccountServiceSuite struct {
+	testing.IsolationSuite
+
+	state *MockState
+	clock *testclock.Clock
+}
+
+var _ = gc.Suite(&serviceAccountServiceSuite{})
+
+func (s *serviceAccountServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *serviceAccountServiceSuite) service() *ServiceAccountService {
+	return NewServiceAccountService(s.state, s.clock)
+}
+
+func (s *serviceAccountServiceSuite) scopes() []corepermission.AccessSpec {
+	return []corepermission.AccessSpec{{
+		Target: corepermission.ID{
+			ObjectType: corepermission.Model,
+			Key:        "5f8b9a4e-8d5c-4d3e-8b5a-3b0c1a2d9e7f",
+		},
+		Access: corepermission.WriteAccess,
+	}}
+}
+
+func (s *serviceAccountServiceSuite) TestAddServiceAccount(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	name := usertesting.GenNewName(c, "ci-bot")
+	creator := usertesting.GenNewName(c, "admin")
+	s.state.EXPECT().AddServiceAccount(gomock.Any(), gomock.Any(), name, creator).Return(nil)
+
+	uuid, err := s.service().AddServiceAccount(context.Background(), name, creator)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(uuid.Validate(), jc.ErrorIsNil)
+}
+
+func (s *serviceAccountServiceSuite) TestAddServiceAccountExternal(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service().AddServiceAccount(context.Background(),
+		usertesting.GenNewName(c, "ci-bot@external"), usertesting.GenNewName(c, "admin"))
+	c.Assert(err, jc.ErrorIs, accesserrors.UserNameNotValid)
+}
+
+func (s *serviceAccountServiceSuite) TestIssueServiceAccountToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	account := usertesting.GenNewName(c, "ci-bot")
+	expiresAt := s.clock.Now().Add(time.Hour)
+	var hash string
+	s.state.EXPECT().IssueServiceAccountToken(gomock.Any(), gomock.Any(), access.ServiceAccountToken{
+		Name:           "deploy",
+		ServiceAccount: account,
+		Scopes:         s.scopes(),
+		CreatedAt:      s.clock.Now(),
+		ExpiresAt:      &expiresAt,
+	}, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ access.ServiceAccountToken, tokenHash string) error {
+		hash = tokenHash
+		return nil
+	})
+
+	token, err := s.service().IssueServiceAccountToken(context.Background(), access.IssueServiceAccountTokenArgs{
+		ServiceAccount: account,
+		Name:           "deploy",
+		Scopes:         s.scopes(),
+		ExpiresIn:      time.Hour,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(strings.HasPrefix(token, access.ServiceAccountTokenPrefix), jc.IsTrue)
+	c.Check(hash, gc.Equals, hashServiceAccountToken(token))
+	c.Check(strings.Contains(hash, token), jc.IsFalse)
+}
+
+func (s *serviceAccountServiceSuite) TestIssueServiceAccountTokenNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service().IssueServiceAccountToken(context.Background(), access.IssueServiceAccountTokenArgs{
+		ServiceAccount: usertesting.GenNewName(c, "ci-bot"),
+		Name:           "deploy",
+	})
+	c.Assert(err, jc.ErrorIs, accesserrors.ServiceAccountTokenNotValid)
+}
+
+func (s *serviceAccountServiceSuite) TestAuthenticateServiceAccountToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	token := access.ServiceAccountTokenPrefix + "secret"
+	issued := access.ServiceAccountToken{
+		Name:           "deploy",
+		ServiceAccount: usertesting.GenNewName(c, "ci-bot"),
+		Scopes:         s.scopes(),
+	}
+	s.state.EXPECT().GetServiceAccountToken(gomock.Any(), hashServiceAccountToken(token)).Return(issued, nil)
+
+	result, err := s.service().AuthenticateServiceAccountToken(context.Background(), token)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, issued)
+}
+
+func (s *serviceAccountServiceSuite) TestAuthenticateServiceAccountTokenExpired(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	token := access.ServiceAccountTokenPrefix + "secret"
+	expiresAt := s.clock.Now()
+	s.state.EXPECT().GetServiceAccountToken(gomock.Any(), hashServiceAccountToken(token)).Return(access.ServiceAccountToken{
+		Name:           "deploy",
+		ServiceAccount: usertesting.GenNewName(c, "ci-bot"),
+		Scopes:         s.scopes(),
+		ExpiresAt:      &expiresAt,
+	}, nil)
+
+	_, err := s.service().AuthenticateServiceAccountToken(context.Background(), token)
+	c.Assert(err, jc.ErrorIs, accesserrors.ServiceAccountTokenExpired)
+}
+
+func (s *serviceAccountServiceSuite) TestAuthenticateServiceAccountTokenNotServiceAccountToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service().AuthenticateServiceAccountToken(context.Background(), "eyJhbGciOi")
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceAccountServiceSuite) TestRevokeServiceAccountToken(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	account := usertesting.GenNewName(c, "ci-bot")
+	s.state.EXPECT().RevokeServiceAccountToken(gomock.Any(), account, "deploy").Return(accesserrors.ServiceAccountTokenNotFound)
+
+	err := s.service().RevokeServiceAccountToken(context.Background(), account, "deploy")
+	c.Assert(err, jc.ErrorIs, accesserrors.ServiceAccountTokenNotFound)
+}
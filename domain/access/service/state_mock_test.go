@@ -47,6 +47,82 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddRole mocks base method.
+func (m *MockState) AddRole(arg0 context.Context, arg1 string, arg2 access.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRole indicates an expected call of AddRole.
+func (mr *MockStateMockRecorder) AddRole(arg0, arg1, arg2 any) *MockStateAddRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRole", reflect.TypeOf((*MockState)(nil).AddRole), arg0, arg1, arg2)
+	return &MockStateAddRoleCall{Call: call}
+}
+
+// MockStateAddRoleCall wrap *gomock.Call
+type MockStateAddRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddRoleCall) Return(arg0 error) *MockStateAddRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddRoleCall) Do(f func(context.Context, string, access.Role) error) *MockStateAddRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddRoleCall) DoAndReturn(f func(context.Context, string, access.Role) error) *MockStateAddRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddServiceAccount mocks base method.
+func (m *MockState) AddServiceAccount(arg0 context.Context, arg1 user.UUID, arg2, arg3 user.Name) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddServiceAccount", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddServiceAccount indicates an expected call of AddServiceAccount.
+func (mr *MockStateMockRecorder) AddServiceAccount(arg0, arg1, arg2, arg3 any) *MockStateAddServiceAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddServiceAccount", reflect.TypeOf((*MockState)(nil).AddServiceAccount), arg0, arg1, arg2, arg3)
+	return &MockStateAddServiceAccountCall{Call: call}
+}
+
+// MockStateAddServiceAccountCall wrap *gomock.Call
+type MockStateAddServiceAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddServiceAccountCall) Return(arg0 error) *MockStateAddServiceAccountCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddServiceAccountCall) Do(f func(context.Context, user.UUID, user.Name, user.Name) error) *MockStateAddServiceAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddServiceAccountCall) DoAndReturn(f func(context.Context, user.UUID, user.Name, user.Name) error) *MockStateAddServiceAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddUser mocks base method.
 func (m *MockState) AddUser(arg0 context.Context, arg1 user.UUID, arg2 user.Name, arg3 string, arg4 bool, arg5 user.UUID) error {
 	m.ctrl.T.Helper()
@@ -200,6 +276,44 @@ func (c *MockStateAllModelAccessForCloudCredentialCall) DoAndReturn(f func(conte
 	return c
 }
 
+// ClearLoginFailures mocks base method.
+func (m *MockState) ClearLoginFailures(arg0 context.Context, arg1 user.Name) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearLoginFailures", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearLoginFailures indicates an expected call of ClearLoginFailures.
+func (mr *MockStateMockRecorder) ClearLoginFailures(arg0, arg1 any) *MockStateClearLoginFailuresCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearLoginFailures", reflect.TypeOf((*MockState)(nil).ClearLoginFailures), arg0, arg1)
+	return &MockStateClearLoginFailuresCall{Call: call}
+}
+
+// MockStateClearLoginFailuresCall wrap *gomock.Call
+type MockStateClearLoginFailuresCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateClearLoginFailuresCall) Return(arg0 error) *MockStateClearLoginFailuresCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateClearLoginFailuresCall) Do(f func(context.Context, user.Name) error) *MockStateClearLoginFailuresCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateClearLoginFailuresCall) DoAndReturn(f func(context.Context, user.Name) error) *MockStateClearLoginFailuresCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreatePermission mocks base method.
 func (m *MockState) CreatePermission(arg0 context.Context, arg1 uuid.UUID, arg2 permission.UserAccessSpec) (permission.UserAccess, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +505,44 @@ func (c *MockStateEnsureExternalUserIfAuthorizedCall) DoAndReturn(f func(context
 	return c
 }
 
+// EnsureExternalUserIfGroupAuthorized mocks base method.
+func (m *MockState) EnsureExternalUserIfGroupAuthorized(arg0 context.Context, arg1 user.Name, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureExternalUserIfGroupAuthorized", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureExternalUserIfGroupAuthorized indicates an expected call of EnsureExternalUserIfGroupAuthorized.
+func (mr *MockStateMockRecorder) EnsureExternalUserIfGroupAuthorized(arg0, arg1, arg2 any) *MockStateEnsureExternalUserIfGroupAuthorizedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureExternalUserIfGroupAuthorized", reflect.TypeOf((*MockState)(nil).EnsureExternalUserIfGroupAuthorized), arg0, arg1, arg2)
+	return &MockStateEnsureExternalUserIfGroupAuthorizedCall{Call: call}
+}
+
+// MockStateEnsureExternalUserIfGroupAuthorizedCall wrap *gomock.Call
+type MockStateEnsureExternalUserIfGroupAuthorizedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateEnsureExternalUserIfGroupAuthorizedCall) Return(arg0 error) *MockStateEnsureExternalUserIfGroupAuthorizedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateEnsureExternalUserIfGroupAuthorizedCall) Do(f func(context.Context, user.Name, []string) error) *MockStateEnsureExternalUserIfGroupAuthorizedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateEnsureExternalUserIfGroupAuthorizedCall) DoAndReturn(f func(context.Context, user.Name, []string) error) *MockStateEnsureExternalUserIfGroupAuthorizedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetActivationKey mocks base method.
 func (m *MockState) GetActivationKey(arg0 context.Context, arg1 user.Name) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -469,328 +621,793 @@ func (c *MockStateGetAllUsersCall) DoAndReturn(f func(context.Context, bool) ([]
 	return c
 }
 
-// GetUser mocks base method.
-func (m *MockState) GetUser(arg0 context.Context, arg1 user.UUID) (user.User, error) {
+// GetControllerConfigKeys mocks base method.
+func (m *MockState) GetControllerConfigKeys(arg0 context.Context, arg1 []string) (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
-	ret0, _ := ret[0].(user.User)
+	ret := m.ctrl.Call(m, "GetControllerConfigKeys", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockStateMockRecorder) GetUser(arg0, arg1 any) *MockStateGetUserCall {
+// GetControllerConfigKeys indicates an expected call of GetControllerConfigKeys.
+func (mr *MockStateMockRecorder) GetControllerConfigKeys(arg0, arg1 any) *MockStateGetControllerConfigKeysCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockState)(nil).GetUser), arg0, arg1)
-	return &MockStateGetUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerConfigKeys", reflect.TypeOf((*MockState)(nil).GetControllerConfigKeys), arg0, arg1)
+	return &MockStateGetControllerConfigKeysCall{Call: call}
 }
 
-// MockStateGetUserCall wrap *gomock.Call
-type MockStateGetUserCall struct {
+// MockStateGetControllerConfigKeysCall wrap *gomock.Call
+type MockStateGetControllerConfigKeysCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetUserCall) Return(arg0 user.User, arg1 error) *MockStateGetUserCall {
+func (c *MockStateGetControllerConfigKeysCall) Return(arg0 map[string]string, arg1 error) *MockStateGetControllerConfigKeysCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetUserCall) Do(f func(context.Context, user.UUID) (user.User, error)) *MockStateGetUserCall {
+func (c *MockStateGetControllerConfigKeysCall) Do(f func(context.Context, []string) (map[string]string, error)) *MockStateGetControllerConfigKeysCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetUserCall) DoAndReturn(f func(context.Context, user.UUID) (user.User, error)) *MockStateGetUserCall {
+func (c *MockStateGetControllerConfigKeysCall) DoAndReturn(f func(context.Context, []string) (map[string]string, error)) *MockStateGetControllerConfigKeysCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetUserByAuth mocks base method.
-func (m *MockState) GetUserByAuth(arg0 context.Context, arg1 user.Name, arg2 auth.Password) (user.User, error) {
+// GetLoginAttempts mocks base method.
+func (m *MockState) GetLoginAttempts(arg0 context.Context, arg1 user.Name, arg2 int) ([]access.LoginAttempt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByAuth", arg0, arg1, arg2)
-	ret0, _ := ret[0].(user.User)
+	ret := m.ctrl.Call(m, "GetLoginAttempts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]access.LoginAttempt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByAuth indicates an expected call of GetUserByAuth.
-func (mr *MockStateMockRecorder) GetUserByAuth(arg0, arg1, arg2 any) *MockStateGetUserByAuthCall {
+// GetLoginAttempts indicates an expected call of GetLoginAttempts.
+func (mr *MockStateMockRecorder) GetLoginAttempts(arg0, arg1, arg2 any) *MockStateGetLoginAttemptsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByAuth", reflect.TypeOf((*MockState)(nil).GetUserByAuth), arg0, arg1, arg2)
-	return &MockStateGetUserByAuthCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginAttempts", reflect.TypeOf((*MockState)(nil).GetLoginAttempts), arg0, arg1, arg2)
+	return &MockStateGetLoginAttemptsCall{Call: call}
 }
 
-// MockStateGetUserByAuthCall wrap *gomock.Call
-type MockStateGetUserByAuthCall struct {
+// MockStateGetLoginAttemptsCall wrap *gomock.Call
+type MockStateGetLoginAttemptsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetUserByAuthCall) Return(arg0 user.User, arg1 error) *MockStateGetUserByAuthCall {
+func (c *MockStateGetLoginAttemptsCall) Return(arg0 []access.LoginAttempt, arg1 error) *MockStateGetLoginAttemptsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetUserByAuthCall) Do(f func(context.Context, user.Name, auth.Password) (user.User, error)) *MockStateGetUserByAuthCall {
+func (c *MockStateGetLoginAttemptsCall) Do(f func(context.Context, user.Name, int) ([]access.LoginAttempt, error)) *MockStateGetLoginAttemptsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetUserByAuthCall) DoAndReturn(f func(context.Context, user.Name, auth.Password) (user.User, error)) *MockStateGetUserByAuthCall {
+func (c *MockStateGetLoginAttemptsCall) DoAndReturn(f func(context.Context, user.Name, int) ([]access.LoginAttempt, error)) *MockStateGetLoginAttemptsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetUserByName mocks base method.
-func (m *MockState) GetUserByName(arg0 context.Context, arg1 user.Name) (user.User, error) {
+// GetRole mocks base method.
+func (m *MockState) GetRole(arg0 context.Context, arg1 string) (access.Role, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByName", arg0, arg1)
-	ret0, _ := ret[0].(user.User)
+	ret := m.ctrl.Call(m, "GetRole", arg0, arg1)
+	ret0, _ := ret[0].(access.Role)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByName indicates an expected call of GetUserByName.
-func (mr *MockStateMockRecorder) GetUserByName(arg0, arg1 any) *MockStateGetUserByNameCall {
+// GetRole indicates an expected call of GetRole.
+func (mr *MockStateMockRecorder) GetRole(arg0, arg1 any) *MockStateGetRoleCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByName", reflect.TypeOf((*MockState)(nil).GetUserByName), arg0, arg1)
-	return &MockStateGetUserByNameCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRole", reflect.TypeOf((*MockState)(nil).GetRole), arg0, arg1)
+	return &MockStateGetRoleCall{Call: call}
 }
 
-// MockStateGetUserByNameCall wrap *gomock.Call
-type MockStateGetUserByNameCall struct {
+// MockStateGetRoleCall wrap *gomock.Call
+type MockStateGetRoleCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetUserByNameCall) Return(arg0 user.User, arg1 error) *MockStateGetUserByNameCall {
+func (c *MockStateGetRoleCall) Return(arg0 access.Role, arg1 error) *MockStateGetRoleCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetUserByNameCall) Do(f func(context.Context, user.Name) (user.User, error)) *MockStateGetUserByNameCall {
+func (c *MockStateGetRoleCall) Do(f func(context.Context, string) (access.Role, error)) *MockStateGetRoleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetUserByNameCall) DoAndReturn(f func(context.Context, user.Name) (user.User, error)) *MockStateGetUserByNameCall {
+func (c *MockStateGetRoleCall) DoAndReturn(f func(context.Context, string) (access.Role, error)) *MockStateGetRoleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// LastModelLogin mocks base method.
-func (m *MockState) LastModelLogin(arg0 context.Context, arg1 user.Name, arg2 model.UUID) (time.Time, error) {
+// GetServiceAccountToken mocks base method.
+func (m *MockState) GetServiceAccountToken(arg0 context.Context, arg1 string) (access.ServiceAccountToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LastModelLogin", arg0, arg1, arg2)
-	ret0, _ := ret[0].(time.Time)
+	ret := m.ctrl.Call(m, "GetServiceAccountToken", arg0, arg1)
+	ret0, _ := ret[0].(access.ServiceAccountToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// LastModelLogin indicates an expected call of LastModelLogin.
-func (mr *MockStateMockRecorder) LastModelLogin(arg0, arg1, arg2 any) *MockStateLastModelLoginCall {
+// GetServiceAccountToken indicates an expected call of GetServiceAccountToken.
+func (mr *MockStateMockRecorder) GetServiceAccountToken(arg0, arg1 any) *MockStateGetServiceAccountTokenCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastModelLogin", reflect.TypeOf((*MockState)(nil).LastModelLogin), arg0, arg1, arg2)
-	return &MockStateLastModelLoginCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceAccountToken", reflect.TypeOf((*MockState)(nil).GetServiceAccountToken), arg0, arg1)
+	return &MockStateGetServiceAccountTokenCall{Call: call}
 }
 
-// MockStateLastModelLoginCall wrap *gomock.Call
-type MockStateLastModelLoginCall struct {
+// MockStateGetServiceAccountTokenCall wrap *gomock.Call
+type MockStateGetServiceAccountTokenCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateLastModelLoginCall) Return(arg0 time.Time, arg1 error) *MockStateLastModelLoginCall {
+func (c *MockStateGetServiceAccountTokenCall) Return(arg0 access.ServiceAccountToken, arg1 error) *MockStateGetServiceAccountTokenCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateLastModelLoginCall) Do(f func(context.Context, user.Name, model.UUID) (time.Time, error)) *MockStateLastModelLoginCall {
+func (c *MockStateGetServiceAccountTokenCall) Do(f func(context.Context, string) (access.ServiceAccountToken, error)) *MockStateGetServiceAccountTokenCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateLastModelLoginCall) DoAndReturn(f func(context.Context, user.Name, model.UUID) (time.Time, error)) *MockStateLastModelLoginCall {
+func (c *MockStateGetServiceAccountTokenCall) DoAndReturn(f func(context.Context, string) (access.ServiceAccountToken, error)) *MockStateGetServiceAccountTokenCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadAllAccessForUserAndObjectType mocks base method.
-func (m *MockState) ReadAllAccessForUserAndObjectType(arg0 context.Context, arg1 user.Name, arg2 permission.ObjectType) ([]permission.UserAccess, error) {
+// GetUser mocks base method.
+func (m *MockState) GetUser(arg0 context.Context, arg1 user.UUID) (user.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadAllAccessForUserAndObjectType", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]permission.UserAccess)
+	ret := m.ctrl.Call(m, "GetUser", arg0, arg1)
+	ret0, _ := ret[0].(user.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadAllAccessForUserAndObjectType indicates an expected call of ReadAllAccessForUserAndObjectType.
-func (mr *MockStateMockRecorder) ReadAllAccessForUserAndObjectType(arg0, arg1, arg2 any) *MockStateReadAllAccessForUserAndObjectTypeCall {
+// GetUser indicates an expected call of GetUser.
+func (mr *MockStateMockRecorder) GetUser(arg0, arg1 any) *MockStateGetUserCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllAccessForUserAndObjectType", reflect.TypeOf((*MockState)(nil).ReadAllAccessForUserAndObjectType), arg0, arg1, arg2)
-	return &MockStateReadAllAccessForUserAndObjectTypeCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockState)(nil).GetUser), arg0, arg1)
+	return &MockStateGetUserCall{Call: call}
 }
 
-// MockStateReadAllAccessForUserAndObjectTypeCall wrap *gomock.Call
-type MockStateReadAllAccessForUserAndObjectTypeCall struct {
+// MockStateGetUserCall wrap *gomock.Call
+type MockStateGetUserCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateReadAllAccessForUserAndObjectTypeCall) Return(arg0 []permission.UserAccess, arg1 error) *MockStateReadAllAccessForUserAndObjectTypeCall {
+func (c *MockStateGetUserCall) Return(arg0 user.User, arg1 error) *MockStateGetUserCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateReadAllAccessForUserAndObjectTypeCall) Do(f func(context.Context, user.Name, permission.ObjectType) ([]permission.UserAccess, error)) *MockStateReadAllAccessForUserAndObjectTypeCall {
+func (c *MockStateGetUserCall) Do(f func(context.Context, user.UUID) (user.User, error)) *MockStateGetUserCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateReadAllAccessForUserAndObjectTypeCall) DoAndReturn(f func(context.Context, user.Name, permission.ObjectType) ([]permission.UserAccess, error)) *MockStateReadAllAccessForUserAndObjectTypeCall {
+func (c *MockStateGetUserCall) DoAndReturn(f func(context.Context, user.UUID) (user.User, error)) *MockStateGetUserCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadAllUserAccessForTarget mocks base method.
-func (m *MockState) ReadAllUserAccessForTarget(arg0 context.Context, arg1 permission.ID) ([]permission.UserAccess, error) {
+// GetUserByAuth mocks base method.
+func (m *MockState) GetUserByAuth(arg0 context.Context, arg1 user.Name, arg2 auth.Password) (user.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadAllUserAccessForTarget", arg0, arg1)
-	ret0, _ := ret[0].([]permission.UserAccess)
+	ret := m.ctrl.Call(m, "GetUserByAuth", arg0, arg1, arg2)
+	ret0, _ := ret[0].(user.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadAllUserAccessForTarget indicates an expected call of ReadAllUserAccessForTarget.
-func (mr *MockStateMockRecorder) ReadAllUserAccessForTarget(arg0, arg1 any) *MockStateReadAllUserAccessForTargetCall {
+// GetUserByAuth indicates an expected call of GetUserByAuth.
+func (mr *MockStateMockRecorder) GetUserByAuth(arg0, arg1, arg2 any) *MockStateGetUserByAuthCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllUserAccessForTarget", reflect.TypeOf((*MockState)(nil).ReadAllUserAccessForTarget), arg0, arg1)
-	return &MockStateReadAllUserAccessForTargetCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByAuth", reflect.TypeOf((*MockState)(nil).GetUserByAuth), arg0, arg1, arg2)
+	return &MockStateGetUserByAuthCall{Call: call}
 }
 
-// MockStateReadAllUserAccessForTargetCall wrap *gomock.Call
-type MockStateReadAllUserAccessForTargetCall struct {
+// MockStateGetUserByAuthCall wrap *gomock.Call
+type MockStateGetUserByAuthCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateReadAllUserAccessForTargetCall) Return(arg0 []permission.UserAccess, arg1 error) *MockStateReadAllUserAccessForTargetCall {
+func (c *MockStateGetUserByAuthCall) Return(arg0 user.User, arg1 error) *MockStateGetUserByAuthCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateReadAllUserAccessForTargetCall) Do(f func(context.Context, permission.ID) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForTargetCall {
+func (c *MockStateGetUserByAuthCall) Do(f func(context.Context, user.Name, auth.Password) (user.User, error)) *MockStateGetUserByAuthCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateReadAllUserAccessForTargetCall) DoAndReturn(f func(context.Context, permission.ID) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForTargetCall {
+func (c *MockStateGetUserByAuthCall) DoAndReturn(f func(context.Context, user.Name, auth.Password) (user.User, error)) *MockStateGetUserByAuthCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadAllUserAccessForUser mocks base method.
-func (m *MockState) ReadAllUserAccessForUser(arg0 context.Context, arg1 user.Name) ([]permission.UserAccess, error) {
+// GetUserByName mocks base method.
+func (m *MockState) GetUserByName(arg0 context.Context, arg1 user.Name) (user.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadAllUserAccessForUser", arg0, arg1)
-	ret0, _ := ret[0].([]permission.UserAccess)
+	ret := m.ctrl.Call(m, "GetUserByName", arg0, arg1)
+	ret0, _ := ret[0].(user.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadAllUserAccessForUser indicates an expected call of ReadAllUserAccessForUser.
-func (mr *MockStateMockRecorder) ReadAllUserAccessForUser(arg0, arg1 any) *MockStateReadAllUserAccessForUserCall {
+// GetUserByName indicates an expected call of GetUserByName.
+func (mr *MockStateMockRecorder) GetUserByName(arg0, arg1 any) *MockStateGetUserByNameCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllUserAccessForUser", reflect.TypeOf((*MockState)(nil).ReadAllUserAccessForUser), arg0, arg1)
-	return &MockStateReadAllUserAccessForUserCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByName", reflect.TypeOf((*MockState)(nil).GetUserByName), arg0, arg1)
+	return &MockStateGetUserByNameCall{Call: call}
 }
 
-// MockStateReadAllUserAccessForUserCall wrap *gomock.Call
-type MockStateReadAllUserAccessForUserCall struct {
+// MockStateGetUserByNameCall wrap *gomock.Call
+type MockStateGetUserByNameCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateReadAllUserAccessForUserCall) Return(arg0 []permission.UserAccess, arg1 error) *MockStateReadAllUserAccessForUserCall {
+func (c *MockStateGetUserByNameCall) Return(arg0 user.User, arg1 error) *MockStateGetUserByNameCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateReadAllUserAccessForUserCall) Do(f func(context.Context, user.Name) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForUserCall {
+func (c *MockStateGetUserByNameCall) Do(f func(context.Context, user.Name) (user.User, error)) *MockStateGetUserByNameCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateReadAllUserAccessForUserCall) DoAndReturn(f func(context.Context, user.Name) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForUserCall {
+func (c *MockStateGetUserByNameCall) DoAndReturn(f func(context.Context, user.Name) (user.User, error)) *MockStateGetUserByNameCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadUserAccessForTarget mocks base method.
-func (m *MockState) ReadUserAccessForTarget(arg0 context.Context, arg1 user.Name, arg2 permission.ID) (permission.UserAccess, error) {
+// GetUserRoles mocks base method.
+func (m *MockState) GetUserRoles(arg0 context.Context, arg1 user.Name, arg2 permission.ID) ([]access.Role, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadUserAccessForTarget", arg0, arg1, arg2)
-	ret0, _ := ret[0].(permission.UserAccess)
+	ret := m.ctrl.Call(m, "GetUserRoles", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]access.Role)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadUserAccessForTarget indicates an expected call of ReadUserAccessForTarget.
-func (mr *MockStateMockRecorder) ReadUserAccessForTarget(arg0, arg1, arg2 any) *MockStateReadUserAccessForTargetCall {
+// GetUserRoles indicates an expected call of GetUserRoles.
+func (mr *MockStateMockRecorder) GetUserRoles(arg0, arg1, arg2 any) *MockStateGetUserRolesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserAccessForTarget", reflect.TypeOf((*MockState)(nil).ReadUserAccessForTarget), arg0, arg1, arg2)
-	return &MockStateReadUserAccessForTargetCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockState)(nil).GetUserRoles), arg0, arg1, arg2)
+	return &MockStateGetUserRolesCall{Call: call}
 }
 
-// MockStateReadUserAccessForTargetCall wrap *gomock.Call
-type MockStateReadUserAccessForTargetCall struct {
+// MockStateGetUserRolesCall wrap *gomock.Call
+type MockStateGetUserRolesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateReadUserAccessForTargetCall) Return(arg0 permission.UserAccess, arg1 error) *MockStateReadUserAccessForTargetCall {
+func (c *MockStateGetUserRolesCall) Return(arg0 []access.Role, arg1 error) *MockStateGetUserRolesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateReadUserAccessForTargetCall) Do(f func(context.Context, user.Name, permission.ID) (permission.UserAccess, error)) *MockStateReadUserAccessForTargetCall {
+func (c *MockStateGetUserRolesCall) Do(f func(context.Context, user.Name, permission.ID) ([]access.Role, error)) *MockStateGetUserRolesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateReadUserAccessForTargetCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) (permission.UserAccess, error)) *MockStateReadUserAccessForTargetCall {
+func (c *MockStateGetUserRolesCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) ([]access.Role, error)) *MockStateGetUserRolesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// ReadUserAccessLevelForTarget mocks base method.
-func (m *MockState) ReadUserAccessLevelForTarget(arg0 context.Context, arg1 user.Name, arg2 permission.ID) (permission.Access, error) {
+// GrantGroupAccess mocks base method.
+func (m *MockState) GrantGroupAccess(arg0 context.Context, arg1 string, arg2 access.GroupGrant) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadUserAccessLevelForTarget", arg0, arg1, arg2)
-	ret0, _ := ret[0].(permission.Access)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "GrantGroupAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ReadUserAccessLevelForTarget indicates an expected call of ReadUserAccessLevelForTarget.
+// GrantGroupAccess indicates an expected call of GrantGroupAccess.
+func (mr *MockStateMockRecorder) GrantGroupAccess(arg0, arg1, arg2 any) *MockStateGrantGroupAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantGroupAccess", reflect.TypeOf((*MockState)(nil).GrantGroupAccess), arg0, arg1, arg2)
+	return &MockStateGrantGroupAccessCall{Call: call}
+}
+
+// MockStateGrantGroupAccessCall wrap *gomock.Call
+type MockStateGrantGroupAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGrantGroupAccessCall) Return(arg0 error) *MockStateGrantGroupAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGrantGroupAccessCall) Do(f func(context.Context, string, access.GroupGrant) error) *MockStateGrantGroupAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGrantGroupAccessCall) DoAndReturn(f func(context.Context, string, access.GroupGrant) error) *MockStateGrantGroupAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GrantRole mocks base method.
+func (m *MockState) GrantRole(arg0 context.Context, arg1 string, arg2 access.RoleGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantRole", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantRole indicates an expected call of GrantRole.
+func (mr *MockStateMockRecorder) GrantRole(arg0, arg1, arg2 any) *MockStateGrantRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantRole", reflect.TypeOf((*MockState)(nil).GrantRole), arg0, arg1, arg2)
+	return &MockStateGrantRoleCall{Call: call}
+}
+
+// MockStateGrantRoleCall wrap *gomock.Call
+type MockStateGrantRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGrantRoleCall) Return(arg0 error) *MockStateGrantRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGrantRoleCall) Do(f func(context.Context, string, access.RoleGrant) error) *MockStateGrantRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGrantRoleCall) DoAndReturn(f func(context.Context, string, access.RoleGrant) error) *MockStateGrantRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IssueServiceAccountToken mocks base method.
+func (m *MockState) IssueServiceAccountToken(arg0 context.Context, arg1 string, arg2 access.ServiceAccountToken, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueServiceAccountToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IssueServiceAccountToken indicates an expected call of IssueServiceAccountToken.
+func (mr *MockStateMockRecorder) IssueServiceAccountToken(arg0, arg1, arg2, arg3 any) *MockStateIssueServiceAccountTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueServiceAccountToken", reflect.TypeOf((*MockState)(nil).IssueServiceAccountToken), arg0, arg1, arg2, arg3)
+	return &MockStateIssueServiceAccountTokenCall{Call: call}
+}
+
+// MockStateIssueServiceAccountTokenCall wrap *gomock.Call
+type MockStateIssueServiceAccountTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateIssueServiceAccountTokenCall) Return(arg0 error) *MockStateIssueServiceAccountTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateIssueServiceAccountTokenCall) Do(f func(context.Context, string, access.ServiceAccountToken, string) error) *MockStateIssueServiceAccountTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateIssueServiceAccountTokenCall) DoAndReturn(f func(context.Context, string, access.ServiceAccountToken, string) error) *MockStateIssueServiceAccountTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastModelLogin mocks base method.
+func (m *MockState) LastModelLogin(arg0 context.Context, arg1 user.Name, arg2 model.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastModelLogin", arg0, arg1, arg2)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastModelLogin indicates an expected call of LastModelLogin.
+func (mr *MockStateMockRecorder) LastModelLogin(arg0, arg1, arg2 any) *MockStateLastModelLoginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastModelLogin", reflect.TypeOf((*MockState)(nil).LastModelLogin), arg0, arg1, arg2)
+	return &MockStateLastModelLoginCall{Call: call}
+}
+
+// MockStateLastModelLoginCall wrap *gomock.Call
+type MockStateLastModelLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateLastModelLoginCall) Return(arg0 time.Time, arg1 error) *MockStateLastModelLoginCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateLastModelLoginCall) Do(f func(context.Context, user.Name, model.UUID) (time.Time, error)) *MockStateLastModelLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateLastModelLoginCall) DoAndReturn(f func(context.Context, user.Name, model.UUID) (time.Time, error)) *MockStateLastModelLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListGroupGrants mocks base method.
+func (m *MockState) ListGroupGrants(arg0 context.Context, arg1 string) ([]access.GroupGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupGrants", arg0, arg1)
+	ret0, _ := ret[0].([]access.GroupGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupGrants indicates an expected call of ListGroupGrants.
+func (mr *MockStateMockRecorder) ListGroupGrants(arg0, arg1 any) *MockStateListGroupGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupGrants", reflect.TypeOf((*MockState)(nil).ListGroupGrants), arg0, arg1)
+	return &MockStateListGroupGrantsCall{Call: call}
+}
+
+// MockStateListGroupGrantsCall wrap *gomock.Call
+type MockStateListGroupGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListGroupGrantsCall) Return(arg0 []access.GroupGrant, arg1 error) *MockStateListGroupGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListGroupGrantsCall) Do(f func(context.Context, string) ([]access.GroupGrant, error)) *MockStateListGroupGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListGroupGrantsCall) DoAndReturn(f func(context.Context, string) ([]access.GroupGrant, error)) *MockStateListGroupGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRoleGrants mocks base method.
+func (m *MockState) ListRoleGrants(arg0 context.Context, arg1 string) ([]access.RoleGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleGrants", arg0, arg1)
+	ret0, _ := ret[0].([]access.RoleGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleGrants indicates an expected call of ListRoleGrants.
+func (mr *MockStateMockRecorder) ListRoleGrants(arg0, arg1 any) *MockStateListRoleGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleGrants", reflect.TypeOf((*MockState)(nil).ListRoleGrants), arg0, arg1)
+	return &MockStateListRoleGrantsCall{Call: call}
+}
+
+// MockStateListRoleGrantsCall wrap *gomock.Call
+type MockStateListRoleGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListRoleGrantsCall) Return(arg0 []access.RoleGrant, arg1 error) *MockStateListRoleGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListRoleGrantsCall) Do(f func(context.Context, string) ([]access.RoleGrant, error)) *MockStateListRoleGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListRoleGrantsCall) DoAndReturn(f func(context.Context, string) ([]access.RoleGrant, error)) *MockStateListRoleGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListRoles mocks base method.
+func (m *MockState) ListRoles(arg0 context.Context) ([]access.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	ret0, _ := ret[0].([]access.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoles indicates an expected call of ListRoles.
+func (mr *MockStateMockRecorder) ListRoles(arg0 any) *MockStateListRolesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockState)(nil).ListRoles), arg0)
+	return &MockStateListRolesCall{Call: call}
+}
+
+// MockStateListRolesCall wrap *gomock.Call
+type MockStateListRolesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListRolesCall) Return(arg0 []access.Role, arg1 error) *MockStateListRolesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListRolesCall) Do(f func(context.Context) ([]access.Role, error)) *MockStateListRolesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListRolesCall) DoAndReturn(f func(context.Context) ([]access.Role, error)) *MockStateListRolesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadAllAccessForUserAndObjectType mocks base method.
+func (m *MockState) ReadAllAccessForUserAndObjectType(arg0 context.Context, arg1 user.Name, arg2 permission.ObjectType) ([]permission.UserAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAllAccessForUserAndObjectType", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]permission.UserAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAllAccessForUserAndObjectType indicates an expected call of ReadAllAccessForUserAndObjectType.
+func (mr *MockStateMockRecorder) ReadAllAccessForUserAndObjectType(arg0, arg1, arg2 any) *MockStateReadAllAccessForUserAndObjectTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllAccessForUserAndObjectType", reflect.TypeOf((*MockState)(nil).ReadAllAccessForUserAndObjectType), arg0, arg1, arg2)
+	return &MockStateReadAllAccessForUserAndObjectTypeCall{Call: call}
+}
+
+// MockStateReadAllAccessForUserAndObjectTypeCall wrap *gomock.Call
+type MockStateReadAllAccessForUserAndObjectTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateReadAllAccessForUserAndObjectTypeCall) Return(arg0 []permission.UserAccess, arg1 error) *MockStateReadAllAccessForUserAndObjectTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateReadAllAccessForUserAndObjectTypeCall) Do(f func(context.Context, user.Name, permission.ObjectType) ([]permission.UserAccess, error)) *MockStateReadAllAccessForUserAndObjectTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateReadAllAccessForUserAndObjectTypeCall) DoAndReturn(f func(context.Context, user.Name, permission.ObjectType) ([]permission.UserAccess, error)) *MockStateReadAllAccessForUserAndObjectTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadAllUserAccessForTarget mocks base method.
+func (m *MockState) ReadAllUserAccessForTarget(arg0 context.Context, arg1 permission.ID) ([]permission.UserAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAllUserAccessForTarget", arg0, arg1)
+	ret0, _ := ret[0].([]permission.UserAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAllUserAccessForTarget indicates an expected call of ReadAllUserAccessForTarget.
+func (mr *MockStateMockRecorder) ReadAllUserAccessForTarget(arg0, arg1 any) *MockStateReadAllUserAccessForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllUserAccessForTarget", reflect.TypeOf((*MockState)(nil).ReadAllUserAccessForTarget), arg0, arg1)
+	return &MockStateReadAllUserAccessForTargetCall{Call: call}
+}
+
+// MockStateReadAllUserAccessForTargetCall wrap *gomock.Call
+type MockStateReadAllUserAccessForTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateReadAllUserAccessForTargetCall) Return(arg0 []permission.UserAccess, arg1 error) *MockStateReadAllUserAccessForTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateReadAllUserAccessForTargetCall) Do(f func(context.Context, permission.ID) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateReadAllUserAccessForTargetCall) DoAndReturn(f func(context.Context, permission.ID) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadAllUserAccessForUser mocks base method.
+func (m *MockState) ReadAllUserAccessForUser(arg0 context.Context, arg1 user.Name) ([]permission.UserAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAllUserAccessForUser", arg0, arg1)
+	ret0, _ := ret[0].([]permission.UserAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAllUserAccessForUser indicates an expected call of ReadAllUserAccessForUser.
+func (mr *MockStateMockRecorder) ReadAllUserAccessForUser(arg0, arg1 any) *MockStateReadAllUserAccessForUserCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAllUserAccessForUser", reflect.TypeOf((*MockState)(nil).ReadAllUserAccessForUser), arg0, arg1)
+	return &MockStateReadAllUserAccessForUserCall{Call: call}
+}
+
+// MockStateReadAllUserAccessForUserCall wrap *gomock.Call
+type MockStateReadAllUserAccessForUserCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateReadAllUserAccessForUserCall) Return(arg0 []permission.UserAccess, arg1 error) *MockStateReadAllUserAccessForUserCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateReadAllUserAccessForUserCall) Do(f func(context.Context, user.Name) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForUserCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateReadAllUserAccessForUserCall) DoAndReturn(f func(context.Context, user.Name) ([]permission.UserAccess, error)) *MockStateReadAllUserAccessForUserCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadGroupAccessLevelForTarget mocks base method.
+func (m *MockState) ReadGroupAccessLevelForTarget(arg0 context.Context, arg1 []string, arg2 permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGroupAccessLevelForTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(permission.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGroupAccessLevelForTarget indicates an expected call of ReadGroupAccessLevelForTarget.
+func (mr *MockStateMockRecorder) ReadGroupAccessLevelForTarget(arg0, arg1, arg2 any) *MockStateReadGroupAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGroupAccessLevelForTarget", reflect.TypeOf((*MockState)(nil).ReadGroupAccessLevelForTarget), arg0, arg1, arg2)
+	return &MockStateReadGroupAccessLevelForTargetCall{Call: call}
+}
+
+// MockStateReadGroupAccessLevelForTargetCall wrap *gomock.Call
+type MockStateReadGroupAccessLevelForTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateReadGroupAccessLevelForTargetCall) Return(arg0 permission.Access, arg1 error) *MockStateReadGroupAccessLevelForTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateReadGroupAccessLevelForTargetCall) Do(f func(context.Context, []string, permission.ID) (permission.Access, error)) *MockStateReadGroupAccessLevelForTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateReadGroupAccessLevelForTargetCall) DoAndReturn(f func(context.Context, []string, permission.ID) (permission.Access, error)) *MockStateReadGroupAccessLevelForTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUserAccessForTarget mocks base method.
+func (m *MockState) ReadUserAccessForTarget(arg0 context.Context, arg1 user.Name, arg2 permission.ID) (permission.UserAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUserAccessForTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(permission.UserAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUserAccessForTarget indicates an expected call of ReadUserAccessForTarget.
+func (mr *MockStateMockRecorder) ReadUserAccessForTarget(arg0, arg1, arg2 any) *MockStateReadUserAccessForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserAccessForTarget", reflect.TypeOf((*MockState)(nil).ReadUserAccessForTarget), arg0, arg1, arg2)
+	return &MockStateReadUserAccessForTargetCall{Call: call}
+}
+
+// MockStateReadUserAccessForTargetCall wrap *gomock.Call
+type MockStateReadUserAccessForTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateReadUserAccessForTargetCall) Return(arg0 permission.UserAccess, arg1 error) *MockStateReadUserAccessForTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateReadUserAccessForTargetCall) Do(f func(context.Context, user.Name, permission.ID) (permission.UserAccess, error)) *MockStateReadUserAccessForTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateReadUserAccessForTargetCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) (permission.UserAccess, error)) *MockStateReadUserAccessForTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReadUserAccessLevelForTarget mocks base method.
+func (m *MockState) ReadUserAccessLevelForTarget(arg0 context.Context, arg1 user.Name, arg2 permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUserAccessLevelForTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(permission.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUserAccessLevelForTarget indicates an expected call of ReadUserAccessLevelForTarget.
 func (mr *MockStateMockRecorder) ReadUserAccessLevelForTarget(arg0, arg1, arg2 any) *MockStateReadUserAccessLevelForTargetCall {
 	mr.mock.ctrl.T.Helper()
 	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserAccessLevelForTarget", reflect.TypeOf((*MockState)(nil).ReadUserAccessLevelForTarget), arg0, arg1, arg2)
@@ -820,6 +1437,82 @@ func (c *MockStateReadUserAccessLevelForTargetCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// RecordLoginAttempt mocks base method.
+func (m *MockState) RecordLoginAttempt(arg0 context.Context, arg1 user.Name, arg2 access.LoginAttempt, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLoginAttempt", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLoginAttempt indicates an expected call of RecordLoginAttempt.
+func (mr *MockStateMockRecorder) RecordLoginAttempt(arg0, arg1, arg2, arg3 any) *MockStateRecordLoginAttemptCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLoginAttempt", reflect.TypeOf((*MockState)(nil).RecordLoginAttempt), arg0, arg1, arg2, arg3)
+	return &MockStateRecordLoginAttemptCall{Call: call}
+}
+
+// MockStateRecordLoginAttemptCall wrap *gomock.Call
+type MockStateRecordLoginAttemptCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRecordLoginAttemptCall) Return(arg0 error) *MockStateRecordLoginAttemptCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRecordLoginAttemptCall) Do(f func(context.Context, user.Name, access.LoginAttempt, int) error) *MockStateRecordLoginAttemptCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRecordLoginAttemptCall) DoAndReturn(f func(context.Context, user.Name, access.LoginAttempt, int) error) *MockStateRecordLoginAttemptCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveRole mocks base method.
+func (m *MockState) RemoveRole(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRole indicates an expected call of RemoveRole.
+func (mr *MockStateMockRecorder) RemoveRole(arg0, arg1 any) *MockStateRemoveRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRole", reflect.TypeOf((*MockState)(nil).RemoveRole), arg0, arg1)
+	return &MockStateRemoveRoleCall{Call: call}
+}
+
+// MockStateRemoveRoleCall wrap *gomock.Call
+type MockStateRemoveRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRemoveRoleCall) Return(arg0 error) *MockStateRemoveRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRemoveRoleCall) Do(f func(context.Context, string) error) *MockStateRemoveRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRemoveRoleCall) DoAndReturn(f func(context.Context, string) error) *MockStateRemoveRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveUser mocks base method.
 func (m *MockState) RemoveUser(arg0 context.Context, arg1 user.Name) error {
 	m.ctrl.T.Helper()
@@ -858,6 +1551,120 @@ func (c *MockStateRemoveUserCall) DoAndReturn(f func(context.Context, user.Name)
 	return c
 }
 
+// RevokeGroupAccess mocks base method.
+func (m *MockState) RevokeGroupAccess(arg0 context.Context, arg1 string, arg2 permission.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeGroupAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeGroupAccess indicates an expected call of RevokeGroupAccess.
+func (mr *MockStateMockRecorder) RevokeGroupAccess(arg0, arg1, arg2 any) *MockStateRevokeGroupAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGroupAccess", reflect.TypeOf((*MockState)(nil).RevokeGroupAccess), arg0, arg1, arg2)
+	return &MockStateRevokeGroupAccessCall{Call: call}
+}
+
+// MockStateRevokeGroupAccessCall wrap *gomock.Call
+type MockStateRevokeGroupAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRevokeGroupAccessCall) Return(arg0 error) *MockStateRevokeGroupAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRevokeGroupAccessCall) Do(f func(context.Context, string, permission.ID) error) *MockStateRevokeGroupAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRevokeGroupAccessCall) DoAndReturn(f func(context.Context, string, permission.ID) error) *MockStateRevokeGroupAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeRole mocks base method.
+func (m *MockState) RevokeRole(arg0 context.Context, arg1 access.RoleGrant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRole", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRole indicates an expected call of RevokeRole.
+func (mr *MockStateMockRecorder) RevokeRole(arg0, arg1 any) *MockStateRevokeRoleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRole", reflect.TypeOf((*MockState)(nil).RevokeRole), arg0, arg1)
+	return &MockStateRevokeRoleCall{Call: call}
+}
+
+// MockStateRevokeRoleCall wrap *gomock.Call
+type MockStateRevokeRoleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRevokeRoleCall) Return(arg0 error) *MockStateRevokeRoleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRevokeRoleCall) Do(f func(context.Context, access.RoleGrant) error) *MockStateRevokeRoleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRevokeRoleCall) DoAndReturn(f func(context.Context, access.RoleGrant) error) *MockStateRevokeRoleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeServiceAccountToken mocks base method.
+func (m *MockState) RevokeServiceAccountToken(arg0 context.Context, arg1 user.Name, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeServiceAccountToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeServiceAccountToken indicates an expected call of RevokeServiceAccountToken.
+func (mr *MockStateMockRecorder) RevokeServiceAccountToken(arg0, arg1, arg2 any) *MockStateRevokeServiceAccountTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeServiceAccountToken", reflect.TypeOf((*MockState)(nil).RevokeServiceAccountToken), arg0, arg1, arg2)
+	return &MockStateRevokeServiceAccountTokenCall{Call: call}
+}
+
+// MockStateRevokeServiceAccountTokenCall wrap *gomock.Call
+type MockStateRevokeServiceAccountTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRevokeServiceAccountTokenCall) Return(arg0 error) *MockStateRevokeServiceAccountTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRevokeServiceAccountTokenCall) Do(f func(context.Context, user.Name, string) error) *MockStateRevokeServiceAccountTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRevokeServiceAccountTokenCall) DoAndReturn(f func(context.Context, user.Name, string) error) *MockStateRevokeServiceAccountTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetActivationKey mocks base method.
 func (m *MockState) SetActivationKey(arg0 context.Context, arg1 user.Name, arg2 []byte) error {
 	m.ctrl.T.Helper()
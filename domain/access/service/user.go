@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/juju/clock"
 	"golang.org/x/crypto/nacl/secretbox"
 
 	coreerrors "github.com/juju/juju/core/errors"
@@ -21,14 +22,16 @@ import (
 
 // UserService provides the API for working with users.
 type UserService struct {
-	st UserState
+	st    UserState
+	clock clock.Clock
 }
 
 // NewUserService returns a new UserService for interacting with the underlying user
 // state.
 func NewUserService(st UserState) *UserService {
 	return &UserService{
-		st: st,
+		st:    st,
+		clock: clock.WallClock,
 	}
 }
 
@@ -122,7 +125,8 @@ func (s *UserService) GetUserByAuth(
 //   - accesserrors.UserAlreadyExists: If a user with the supplied name already exists.
 //   - accesserrors.CreatorUUIDNotFound: If a creator has been supplied for the user
 //     and the creator does not exist.
-//   - auth.ErrPasswordNotValid: If the password supplied is not valid.
+//   - auth.ErrPasswordNotValid: If the password supplied is not valid, or does
+//     not meet the password policy of the controller.
 func (s *UserService) AddUser(ctx context.Context, arg AddUserArg) (user.UUID, []byte, error) {
 	if arg.Name.IsZero() {
 		return "", nil, errors.Errorf("empty username: %w", accesserrors.UserNameNotValid)
@@ -162,7 +166,7 @@ func (s *UserService) AddUser(ctx context.Context, arg AddUserArg) (user.UUID, [
 }
 
 func (s *UserService) addUserWithPassword(ctx context.Context, arg AddUserArg) error {
-	if err := arg.Password.Validate(); err != nil {
+	if err := s.checkPasswordPolicy(ctx, *arg.Password); err != nil {
 		return errors.Capture(err)
 	}
 
@@ -229,22 +233,31 @@ func (s *UserService) RemoveUser(ctx context.Context, name user.Name) error {
 }
 
 // SetPassword changes the users password to the new value and removes any
-// active activation keys for the users.
+// active activation keys for the users. It also ends any lockout of the user
+// after failed logins.
 // The following error types are possible from this function:
 //   - accesserrors.UserNameNotValid: When the username supplied is not valid.
 //   - accesserrors.NotFound: If no user by the given name exists.
-//   - internal/auth.ErrPasswordNotValid: If the password supplied is not valid.
+//   - internal/auth.ErrPasswordNotValid: If the password supplied is not valid,
+//     or does not meet the password policy of the controller.
 func (s *UserService) SetPassword(ctx context.Context, name user.Name, pass auth.Password) error {
 	if name.IsZero() {
 		return errors.Errorf("empty username: %w", accesserrors.UserNameNotValid)
 	}
 
-	if err := pass.Validate(); err != nil {
+	if err := s.checkPasswordPolicy(ctx, pass); err != nil {
 		return errors.Capture(err)
 	}
 
-	err := s.setPassword(ctx, name, pass)
-	return errors.Capture(err)
+	if err := s.setPassword(ctx, name, pass); err != nil {
+		return errors.Capture(err)
+	}
+
+	// Setting the password of a locked out user unlocks them.
+	if err := s.st.ClearLoginFailures(ctx, name); err != nil {
+		return errors.Errorf("unlocking user %q: %w", name, err)
+	}
+	return nil
 }
 
 // ResetPassword will remove any active passwords for a user and generate a new
@@ -268,7 +281,8 @@ func (s *UserService) ResetPassword(ctx context.Context, name user.Name) ([]byte
 	return activationKey, nil
 }
 
-// EnableUserAuthentication will enable the user for authentication.
+// EnableUserAuthentication will enable the user for authentication, ending
+// any lockout of the user after failed logins.
 // The following error types are possible from this function:
 // - accesserrors.UserNameNotValid: When the username supplied is not valid.
 // - accesserrors.NotFound: If no user by the given UUID exists.
@@ -280,6 +294,11 @@ func (s *UserService) EnableUserAuthentication(ctx context.Context, name user.Na
 	if err := s.st.EnableUserAuthentication(ctx, name); err != nil {
 		return errors.Errorf("enabling user with uuid %q: %w", name, err)
 	}
+
+	// Enabling a locked out user unlocks them.
+	if err := s.st.ClearLoginFailures(ctx, name); err != nil {
+		return errors.Errorf("unlocking user %q: %w", name, err)
+	}
 	return nil
 }
 
@@ -380,7 +399,9 @@ type Sealer interface {
 // SetPasswordWithActivationKey will use the activation key from the user. To
 // then apply the payload password. If the user does not exist an error that
 // satisfies accesserrors.NotFound will be returned. If the nonce is not the
-// correct length an error that satisfies errors.NotValid will be returned.
+// correct length an error that satisfies errors.NotValid will be returned. If
+// the password does not meet the password policy of the controller an error
+// that satisfies auth.ErrPasswordNotValid will be returned.
 //
 // This will use the NaCl secretbox to open the box and then unmarshal the
 // payload to set the new password for the user. If the payload cannot be
@@ -424,7 +445,11 @@ func (s *UserService) SetPasswordWithActivationKey(ctx context.Context, name use
 		return nil, errors.Errorf("cannot unmarshal payload: %w", err)
 	}
 
-	if err := s.setPassword(ctx, name, auth.NewPassword(payload.Password)); err != nil {
+	password := auth.NewPassword(payload.Password)
+	if err := s.checkPasswordPolicy(ctx, password); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := s.setPassword(ctx, name, password); err != nil {
 		return nil, errors.Errorf("setting new password: %w", err)
 	}
 
@@ -122,6 +122,7 @@ func (s *userServiceSuite) TestAddUserWithPassword(c *gc.C) {
 	}
 
 	name := coreusertesting.GenNewName(c, "valid")
+	s.state.EXPECT().GetControllerConfigKeys(gomock.Any(), gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().AddUserWithPasswordHash(
 		gomock.Any(), userUUID, name, "display", creatorUUID, perms, gomock.Any(), gomock.Any()).Return(nil)
 
@@ -249,7 +250,9 @@ func (s *userServiceSuite) TestSetPassword(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	a := gomock.Any()
+	s.state.EXPECT().GetControllerConfigKeys(a, a).Return(nil, nil)
 	s.state.EXPECT().SetPasswordHash(a, a, a, a).Return(nil)
+	s.state.EXPECT().ClearLoginFailures(a, coreusertesting.GenNewName(c, "user")).Return(nil)
 
 	err := s.service().SetPassword(context.Background(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password"))
 	c.Assert(err, jc.ErrorIsNil)
@@ -268,6 +271,7 @@ func (s *userServiceSuite) TestSetPasswordUserNotFound(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	a := gomock.Any()
+	s.state.EXPECT().GetControllerConfigKeys(a, a).Return(nil, nil)
 	s.state.EXPECT().SetPasswordHash(a, a, a, a).Return(usererrors.UserNotFound)
 
 	err := s.service().SetPassword(context.Background(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password"))
@@ -417,6 +421,7 @@ func (s *userServiceSuite) TestEnableUserAuthentication(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.state.EXPECT().EnableUserAuthentication(gomock.Any(), coreusertesting.GenNewName(c, "name"))
+	s.state.EXPECT().ClearLoginFailures(gomock.Any(), coreusertesting.GenNewName(c, "name"))
 
 	err := s.service().EnableUserAuthentication(context.Background(), coreusertesting.GenNewName(c, "name"))
 	c.Assert(err, jc.ErrorIsNil)
@@ -445,6 +450,7 @@ func (s *userServiceSuite) TestSetPasswordWithActivationKey(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	s.state.EXPECT().GetActivationKey(gomock.Any(), coreusertesting.GenNewName(c, "name")).Return(key, nil)
+	s.state.EXPECT().GetControllerConfigKeys(gomock.Any(), gomock.Any()).Return(nil, nil)
 	s.state.EXPECT().SetPasswordHash(gomock.Any(), coreusertesting.GenNewName(c, "name"), gomock.Any(), gomock.Any()).Return(nil)
 
 	// Create a nonce for the activation box.
@@ -0,0 +1,280 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GroupState describes retrieval and persistence methods for the access
+// granted to groups of users of an external identity provider.
+type GroupState struct {
+	*domain.StateBase
+}
+
+// NewGroupState returns a new state reference.
+func NewGroupState(factory coredatabase.TxnRunnerFactory) *GroupState {
+	return &GroupState{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// GrantGroupAccess grants the access to the group on the target, replacing
+// any access the group had on it.
+// The following errors can be returned:
+// - [accesserrors.PermissionTargetInvalid] if the target does not exist.
+func (st *GroupState) GrantGroupAccess(ctx context.Context, uuid string, grant access.GroupGrant) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := dbPermissionGroup{
+		UUID:       uuid,
+		GroupName:  grant.Group,
+		AccessType: grant.Access.String(),
+		ObjectType: grant.Target.ObjectType.String(),
+		GrantOn:    grant.Target.Key,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO permission_group (uuid, group_name, access_type_id, object_type_id, grant_on)
+SELECT $dbPermissionGroup.uuid,
+       $dbPermissionGroup.group_name,
+       at.id,
+       ot.id,
+       $dbPermissionGroup.grant_on
+FROM   permission_access_type at,
+       permission_object_type ot
+WHERE  at.type = $dbPermissionGroup.access_type
+AND    ot.type = $dbPermissionGroup.object_type
+ON CONFLICT (group_name, grant_on) DO UPDATE SET
+    access_type_id = excluded.access_type_id,
+    object_type_id = excluded.object_type_id
+`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := targetExists(ctx, tx, grant.Target); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, row).Run(); err != nil {
+			return errors.Errorf("granting %q to group %q on %q: %w", grant.Access, grant.Group, grant.Target.Key, err)
+		}
+		return nil
+	})
+}
+
+// RevokeGroupAccess revokes the access of the group on the target.
+// The following errors can be returned:
+// - [accesserrors.GroupGrantNotFound] if the group has no access on the
+// target.
+func (st *GroupState) RevokeGroupAccess(ctx context.Context, group string, target corepermission.ID) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := dbPermissionGroup{
+		GroupName:  group,
+		ObjectType: target.ObjectType.String(),
+		GrantOn:    target.Key,
+	}
+	stmt, err := st.Prepare(`
+DELETE FROM permission_group
+WHERE  uuid IN (
+    SELECT uuid
+    FROM   v_permission_group
+    WHERE  group_name = $dbPermissionGroup.group_name
+    AND    object_type = $dbPermissionGroup.object_type
+    AND    grant_on = $dbPermissionGroup.grant_on
+)
+`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, row).Get(&outcome); err != nil {
+			return errors.Errorf("revoking access of group %q on %q: %w", group, target.Key, err)
+		}
+		affected, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if affected == 0 {
+			return errors.Errorf("group %q on %q: %w", group, target.Key, accesserrors.GroupGrantNotFound)
+		}
+		return nil
+	})
+}
+
+// ListGroupGrants returns the access granted to the group, or to every group
+// if the name is empty, ordered by group and target.
+func (st *GroupState) ListGroupGrants(ctx context.Context, group string) ([]access.GroupGrant, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	filter := dbPermissionGroup{GroupName: group}
+	stmt, err := st.Prepare(`
+SELECT &dbPermissionGroup.*
+FROM   v_permission_group
+WHERE  ($dbPermissionGroup.group_name = '' OR group_name = $dbPermissionGroup.group_name)
+ORDER BY group_name, object_type, grant_on
+`, filter)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []dbPermissionGroup
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, filter).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("listing group grants: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]access.GroupGrant, len(rows))
+	for i, row := range rows {
+		result[i] = access.GroupGrant{
+			Group:  row.GroupName,
+			Access: corepermission.Access(row.AccessType),
+			Target: corepermission.ID{
+				ObjectType: corepermission.ObjectType(row.ObjectType),
+				Key:        row.GrantOn,
+			},
+		}
+	}
+	return result, nil
+}
+
+// ReadGroupAccessLevelForTarget returns the greatest access any of the groups
+// have on the target, or [corepermission.NoAccess] if none of them do.
+func (st *GroupState) ReadGroupAccessLevelForTarget(ctx context.Context, groups []string, target corepermission.ID) (corepermission.Access, error) {
+	if len(groups) == 0 {
+		return corepermission.NoAccess, nil
+	}
+	db, err := st.DB()
+	if err != nil {
+		return corepermission.NoAccess, errors.Capture(err)
+	}
+
+	names := groupNames(groups)
+	filter := dbPermissionGroup{GrantOn: target.Key}
+	stmt, err := st.Prepare(`
+SELECT &dbPermissionGroup.*
+FROM   v_permission_group
+WHERE  group_name IN ($groupNames[:])
+AND    grant_on = $dbPermissionGroup.grant_on
+`, filter, names)
+	if err != nil {
+		return corepermission.NoAccess, errors.Capture(err)
+	}
+
+	var rows []dbPermissionGroup
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, filter, names).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("reading group access level for target: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return corepermission.NoAccess, errors.Capture(err)
+	}
+
+	result := corepermission.NoAccess
+	for _, row := range rows {
+		spec := corepermission.AccessSpec{
+			Target: corepermission.ID{
+				ObjectType: corepermission.ObjectType(row.ObjectType),
+				Key:        row.GrantOn,
+			},
+			Access: corepermission.Access(row.AccessType),
+		}
+		if spec.EqualOrGreaterThan(result) {
+			result = spec.Access
+		}
+	}
+	return result, nil
+}
+
+// EnsureExternalUserIfGroupAuthorized adds the external user, with
+// everyone@external as its creator, if they are missing from the database and
+// any of their groups have been granted access. This ensures that juju has a
+// record of external users that have inherited their permissions from their
+// groups.
+func (st *GroupState) EnsureExternalUserIfGroupAuthorized(ctx context.Context, subject user.Name, groups []string) error {
+	if subject.IsLocal() || len(groups) == 0 {
+		return nil
+	}
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	names := groupNames(groups)
+	stmt, err := st.Prepare(`
+SELECT &dbPermissionGroup.*
+FROM   v_permission_group
+WHERE  group_name IN ($groupNames[:])
+LIMIT 1
+`, dbPermissionGroup{}, names)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		_, err := GetUserUUIDByName(ctx, tx, subject)
+		if err == nil {
+			return nil
+		} else if !errors.Is(err, accesserrors.UserNotFound) {
+			return errors.Capture(err)
+		}
+
+		var grant dbPermissionGroup
+		err = tx.Query(ctx, stmt, names).Get(&grant)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("reading group grants: %w", err)
+		}
+
+		creatorUUID, err := GetUserUUIDByName(ctx, tx, corepermission.EveryoneUserName)
+		if errors.Is(err, accesserrors.UserNotFound) {
+			return errors.Errorf("%q (should be added on bootstrap): %w", corepermission.EveryoneUserName, accesserrors.UserNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		userUUID, err := user.NewUUID()
+		if err != nil {
+			return errors.Errorf("generating user UUID: %w", err)
+		}
+		return AddUser(ctx, tx, userUUID, subject, subject.Name(), true, creatorUUID)
+	})
+	if err != nil {
+		return errors.Errorf("adding external user %q if missing: %w", subject, err)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coremodel "github.com/juju/juju/core/model"
+	corepermission "github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	modeltesting "github.com/juju/juju/domain/model/state/testing"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type groupStateSuite struct {
+	schematesting.ControllerSuite
+
+	controllerUUID string
+	modelUUID      coremodel.UUID
+}
+
+var _ = gc.Suite(&groupStateSuite{})
+
+func (s *groupStateSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.controllerUUID = s.SeedControllerUUID(c)
+	s.modelUUID = modeltesting.CreateTestModel(c, s.TxnRunnerFactory(), "test-model")
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO user (uuid, name, display_name, external, removed, created_by_uuid, created_at)
+			VALUES ('567', 'everyone@external', 'everyone@external', true, false, '567', ?)
+		`, time.Now())
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupStateSuite) modelTarget() corepermission.ID {
+	return corepermission.ID{ObjectType: corepermission.Model, Key: s.modelUUID.String()}
+}
+
+func (s *groupStateSuite) controllerTarget() corepermission.ID {
+	return corepermission.ID{ObjectType: corepermission.Controller, Key: s.controllerUUID}
+}
+
+func (s *groupStateSuite) grant(c *gc.C, st *GroupState, group string, level corepermission.Access, target corepermission.ID) {
+	err := st.GrantGroupAccess(context.Background(), uuid.MustNewUUID().String(), access.GroupGrant{
+		Group:  group,
+		Access: level,
+		Target: target,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupStateSuite) TestGrantGroupAccess(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+	s.grant(c, st, "devs", corepermission.WriteAccess, s.modelTarget())
+	s.grant(c, st, "ops", corepermission.SuperuserAccess, s.controllerTarget())
+
+	grants, err := st.ListGroupGrants(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, jc.DeepEquals, []access.GroupGrant{{
+		Group:  "devs",
+		Access: corepermission.WriteAccess,
+		Target: s.modelTarget(),
+	}, {
+		Group:  "ops",
+		Access: corepermission.SuperuserAccess,
+		Target: s.controllerTarget(),
+	}})
+}
+
+func (s *groupStateSuite) TestGrantGroupAccessReplaces(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+	s.grant(c, st, "devs", corepermission.WriteAccess, s.modelTarget())
+	s.grant(c, st, "devs", corepermission.ReadAccess, s.modelTarget())
+
+	grants, err := st.ListGroupGrants(context.Background(), "devs")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, jc.DeepEquals, []access.GroupGrant{{
+		Group:  "devs",
+		Access: corepermission.ReadAccess,
+		Target: s.modelTarget(),
+	}})
+}
+
+func (s *groupStateSuite) TestGrantGroupAccessTargetNotFound(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+	err := st.GrantGroupAccess(context.Background(), uuid.MustNewUUID().String(), access.GroupGrant{
+		Group:  "devs",
+		Access: corepermission.ReadAccess,
+		Target: corepermission.ID{ObjectType: corepermission.Model, Key: uuid.MustNewUUID().String()},
+	})
+	c.Check(err, jc.ErrorIs, accesserrors.PermissionTargetInvalid)
+}
+
+func (s *groupStateSuite) TestListGroupGrantsFiltered(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+	s.grant(c, st, "devs", corepermission.WriteAccess, s.modelTarget())
+	s.grant(c, st, "ops", corepermission.AdminAccess, s.modelTarget())
+
+	grants, err := st.ListGroupGrants(context.Background(), "ops")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, jc.DeepEquals, []access.GroupGrant{{
+		Group:  "ops",
+		Access: corepermission.AdminAccess,
+		Target: s.modelTarget(),
+	}})
+
+	grants, err = st.ListGroupGrants(context.Background(), "missing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, gc.HasLen, 0)
+}
+
+func (s *groupStateSuite) TestRevokeGroupAccess(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+	s.grant(c, st, "devs", corepermission.WriteAccess, s.modelTarget())
+
+	err := st.RevokeGroupAccess(context.Background(), "devs", s.modelTarget())
+	c.Assert(err, jc.ErrorIsNil)
+
+	grants, err := st.ListGroupGrants(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, gc.HasLen, 0)
+
+	err = st.RevokeGroupAccess(context.Background(), "devs", s.modelTarget())
+	c.Check(err, jc.ErrorIs, accesserrors.GroupGrantNotFound)
+}
+
+func (s *groupStateSuite) TestReadGroupAccessLevelForTarget(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+	s.grant(c, st, "devs", corepermission.WriteAccess, s.modelTarget())
+	s.grant(c, st, "ops", corepermission.AdminAccess, s.modelTarget())
+	s.grant(c, st, "ops", corepermission.SuperuserAccess, s.controllerTarget())
+
+	level, err := st.ReadGroupAccessLevelForTarget(context.Background(), []string{"devs", "ops"}, s.modelTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(level, gc.Equals, corepermission.AdminAccess)
+
+	level, err = st.ReadGroupAccessLevelForTarget(context.Background(), []string{"devs"}, s.modelTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(level, gc.Equals, corepermission.WriteAccess)
+
+	level, err = st.ReadGroupAccessLevelForTarget(context.Background(), []string{"devs"}, s.controllerTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(level, gc.Equals, corepermission.NoAccess)
+
+	level, err = st.ReadGroupAccessLevelForTarget(context.Background(), nil, s.modelTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(level, gc.Equals, corepermission.NoAccess)
+}
+
+func (s *groupStateSuite) TestEnsureExternalUserIfGroupAuthorized(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+	s.grant(c, st, "devs", corepermission.ReadAccess, s.modelTarget())
+
+	name := usertesting.GenNewName(c, "alice@external")
+	err := st.EnsureExternalUserIfGroupAuthorized(context.Background(), name, []string{"devs"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	userState := NewUserState(s.TxnRunnerFactory())
+	user, err := userState.GetUserByName(context.Background(), name)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(user.Name, gc.Equals, name)
+	c.Check(user.CreatorName, gc.Equals, corepermission.EveryoneUserName)
+
+	// Ensuring the user again does nothing.
+	err = st.EnsureExternalUserIfGroupAuthorized(context.Background(), name, []string{"devs"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *groupStateSuite) TestEnsureExternalUserIfGroupAuthorizedNoGrant(c *gc.C) {
+	st := NewGroupState(s.TxnRunnerFactory())
+
+	name := usertesting.GenNewName(c, "alice@external")
+	err := st.EnsureExternalUserIfGroupAuthorized(context.Background(), name, []string{"devs"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	userState := NewUserState(s.TxnRunnerFactory())
+	_, err = userState.GetUserByName(context.Background(), name)
+	c.Check(err, jc.ErrorIs, accesserrors.UserNotFound)
+}
@@ -0,0 +1,219 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain/access"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RecordLoginAttempt records a password login of the user, keeping only the
+// most recent attempts up to the limit.
+// The following errors can be returned:
+// - [accesserrors.UserNotFound] when the user cannot be found.
+func (st *UserState) RecordLoginAttempt(ctx context.Context, name user.Name, attempt access.LoginAttempt, keep int) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Errorf("getting DB access: %w", err)
+	}
+
+	uuidStmt, err := st.getActiveUUIDStmt()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO user_login_attempt (*)
+VALUES ($dbLoginAttempt.*)
+`, dbLoginAttempt{})
+	if err != nil {
+		return errors.Errorf("preparing insert login attempt query: %w", err)
+	}
+
+	// The rowid orders attempts recorded within the same second.
+	pruneStmt, err := st.Prepare(`
+DELETE FROM user_login_attempt
+WHERE user_uuid = $loginAttemptLimit.user_uuid
+AND rowid NOT IN (
+    SELECT rowid FROM user_login_attempt
+    WHERE user_uuid = $loginAttemptLimit.user_uuid
+    ORDER BY attempted_at DESC, rowid DESC
+    LIMIT $loginAttemptLimit.limit
+)
+`, loginAttemptLimit{})
+	if err != nil {
+		return errors.Errorf("preparing prune login attempts query: %w", err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		userUUID, err := st.uuidForName(ctx, tx, uuidStmt, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		dbAttempt := dbLoginAttempt{
+			UserUUID:    userUUID.String(),
+			AttemptedAt: attempt.Time.UTC(),
+			Success:     attempt.Success,
+			Address:     attempt.Address,
+		}
+		if err := tx.Query(ctx, insertStmt, dbAttempt).Run(); err != nil {
+			return errors.Errorf("inserting login attempt: %w", err)
+		}
+
+		limit := loginAttemptLimit{UserUUID: userUUID.String(), Limit: keep}
+		if err := tx.Query(ctx, pruneStmt, limit).Run(); err != nil {
+			return errors.Errorf("pruning login attempts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("recording login attempt for user %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetLoginAttempts returns the most recent password logins of the user, up to
+// the limit, most recent first.
+// The following errors can be returned:
+// - [accesserrors.UserNotFound] when the user cannot be found.
+func (st *UserState) GetLoginAttempts(ctx context.Context, name user.Name, limit int) ([]access.LoginAttempt, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Errorf("getting DB access: %w", err)
+	}
+
+	uuidStmt, err := st.getActiveUUIDStmt()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &dbLoginAttempt.*
+FROM user_login_attempt
+WHERE user_uuid = $loginAttemptLimit.user_uuid
+ORDER BY attempted_at DESC, rowid DESC
+LIMIT $loginAttemptLimit.limit
+`, dbLoginAttempt{}, loginAttemptLimit{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select login attempts query: %w", err)
+	}
+
+	var dbAttempts []dbLoginAttempt
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		userUUID, err := st.uuidForName(ctx, tx, uuidStmt, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		err = tx.Query(ctx, stmt, loginAttemptLimit{UserUUID: userUUID.String(), Limit: limit}).GetAll(&dbAttempts)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting login attempts for user %q: %w", name, err)
+	}
+
+	attempts := make([]access.LoginAttempt, len(dbAttempts))
+	for i, a := range dbAttempts {
+		attempts[i] = access.LoginAttempt{
+			Time:    a.AttemptedAt.In(time.UTC),
+			Success: a.Success,
+			Address: a.Address,
+		}
+	}
+	return attempts, nil
+}
+
+// ClearLoginFailures removes the failed password logins the user made since
+// their last successful one, which ends any lockout of the user.
+// The following errors can be returned:
+// - [accesserrors.UserNotFound] when the user cannot be found.
+func (st *UserState) ClearLoginFailures(ctx context.Context, name user.Name) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Errorf("getting DB access: %w", err)
+	}
+
+	uuidStmt, err := st.getActiveUUIDStmt()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// Failures are ordered after the last success in the same way attempts
+	// are ordered when they are read.
+	stmt, err := st.Prepare(`
+DELETE FROM user_login_attempt
+WHERE user_uuid = $userUUID.uuid
+AND success = FALSE
+AND NOT EXISTS (
+    SELECT 1 FROM user_login_attempt AS s
+    WHERE s.user_uuid = user_login_attempt.user_uuid
+    AND s.success = TRUE
+    AND (s.attempted_at > user_login_attempt.attempted_at
+        OR (s.attempted_at = user_login_attempt.attempted_at
+            AND s.rowid > user_login_attempt.rowid))
+)
+`, userUUID{})
+	if err != nil {
+		return errors.Errorf("preparing clear login failures query: %w", err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		uuid, err := st.uuidForName(ctx, tx, uuidStmt, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return tx.Query(ctx, stmt, userUUID{UUID: uuid.String()}).Run()
+	})
+	if err != nil {
+		return errors.Errorf("clearing login failures for user %q: %w", name, err)
+	}
+	return nil
+}
+
+// GetControllerConfigKeys returns the values of the controller config keys
+// which are set. Keys which are not set are not included.
+func (st *UserState) GetControllerConfigKeys(ctx context.Context, keys []string) (map[string]string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Errorf("getting DB access: %w", err)
+	}
+
+	sqlKeys := controllerConfigKeys(keys)
+	stmt, err := st.Prepare(`
+SELECT &controllerConfigKeyValue.*
+FROM v_controller_config
+WHERE key IN ($controllerConfigKeys[:])
+`, controllerConfigKeyValue{}, sqlKeys)
+	if err != nil {
+		return nil, errors.Errorf("preparing select controller config query: %w", err)
+	}
+
+	var keyValues []controllerConfigKeyValue
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, sqlKeys).GetAll(&keyValues)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting controller config for keys %v: %w", keys, err)
+	}
+
+	result := make(map[string]string, len(keyValues))
+	for _, kv := range keyValues {
+		result[kv.Key] = kv.Value
+	}
+	return result, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+)
+
+func (s *userStateSuite) TestRecordAndGetLoginAttempts(c *gc.C) {
+	st := NewUserState(s.TxnRunnerFactory())
+	name, _ := s.addTestUser(c, st, "bob")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	attempts := []access.LoginAttempt{
+		{Time: now.Add(-2 * time.Minute), Success: true, Address: "10.0.0.1:1234"},
+		{Time: now.Add(-time.Minute), Success: false, Address: "10.0.0.2:1234"},
+		{Time: now, Success: false},
+	}
+	for _, attempt := range attempts {
+		err := st.RecordLoginAttempt(context.Background(), name, attempt, 10)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	got, err := st.GetLoginAttempts(context.Background(), name, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, []access.LoginAttempt{
+		attempts[2], attempts[1], attempts[0],
+	})
+
+	got, err = st.GetLoginAttempts(context.Background(), name, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, []access.LoginAttempt{attempts[2]})
+}
+
+func (s *userStateSuite) TestRecordLoginAttemptPrunes(c *gc.C) {
+	st := NewUserState(s.TxnRunnerFactory())
+	name, _ := s.addTestUser(c, st, "bob")
+	other, _ := s.addTestUser(c, st, "mary")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	err := st.RecordLoginAttempt(context.Background(), other, access.LoginAttempt{Time: now, Success: true}, 2)
+	c.Assert(err, jc.ErrorIsNil)
+	for i := 0; i < 5; i++ {
+		err := st.RecordLoginAttempt(context.Background(), name, access.LoginAttempt{
+			Time: now.Add(time.Duration(i) * time.Second),
+		}, 2)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	got, err := st.GetLoginAttempts(context.Background(), name, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, []access.LoginAttempt{
+		{Time: now.Add(4 * time.Second)},
+		{Time: now.Add(3 * time.Second)},
+	})
+
+	// The attempts of other users are kept.
+	got, err = st.GetLoginAttempts(context.Background(), other, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, gc.HasLen, 1)
+}
+
+func (s *userStateSuite) TestClearLoginFailures(c *gc.C) {
+	st := NewUserState(s.TxnRunnerFactory())
+	name, _ := s.addTestUser(c, st, "bob")
+
+	now := time.Now().UTC().Truncate(time.Second)
+	attempts := []access.LoginAttempt{
+		{Time: now.Add(-3 * time.Minute), Success: false},
+		{Time: now.Add(-2 * time.Minute), Success: true},
+		{Time: now.Add(-time.Minute), Success: false},
+		{Time: now, Success: false},
+	}
+	for _, attempt := range attempts {
+		err := st.RecordLoginAttempt(context.Background(), name, attempt, 10)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	err := st.ClearLoginFailures(context.Background(), name)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Only the failures since the last success are removed.
+	got, err := st.GetLoginAttempts(context.Background(), name, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, []access.LoginAttempt{
+		attempts[1], attempts[0],
+	})
+}
+
+func (s *userStateSuite) TestLoginAttemptsUserNotFound(c *gc.C) {
+	st := NewUserState(s.TxnRunnerFactory())
+	name := usertesting.GenNewName(c, "ghost")
+
+	err := st.RecordLoginAttempt(context.Background(), name, access.LoginAttempt{Time: time.Now()}, 10)
+	c.Assert(err, jc.ErrorIs, accesserrors.UserNotFound)
+
+	_, err = st.GetLoginAttempts(context.Background(), name, 10)
+	c.Assert(err, jc.ErrorIs, accesserrors.UserNotFound)
+
+	err = st.ClearLoginFailures(context.Background(), name)
+	c.Assert(err, jc.ErrorIs, accesserrors.UserNotFound)
+}
+
+func (s *userStateSuite) TestGetControllerConfigKeys(c *gc.C) {
+	st := NewUserState(s.TxnRunnerFactory())
+	_, err := s.DB().Exec(`INSERT INTO controller_config (key, value) VALUES ('password-min-length', '12')`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := st.GetControllerConfigKeys(context.Background(), []string{"password-min-length", "login-lockout-threshold"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, map[string]string{"password-min-length": "12"})
+}
@@ -0,0 +1,447 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"sort"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RoleState describes retrieval and persistence methods for the custom roles
+// granted to users on models and the controller.
+type RoleState struct {
+	*domain.StateBase
+}
+
+// NewRoleState returns a new state reference.
+func NewRoleState(factory coredatabase.TxnRunnerFactory) *RoleState {
+	return &RoleState{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddRole adds the role with the methods it allows.
+// The following errors can be returned:
+// - [accesserrors.RoleAlreadyExists] if a role with the same name exists.
+func (st *RoleState) AddRole(ctx context.Context, uuid string, role access.Role) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := dbRole{
+		UUID:        uuid,
+		Name:        role.Name,
+		Description: role.Description,
+	}
+	insertRoleStmt, err := st.Prepare(`
+INSERT INTO permission_role (*)
+VALUES ($dbRole.*)
+`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertMethodStmt, err := st.Prepare(`
+INSERT INTO permission_role_method (*)
+VALUES ($dbRoleMethod.*)
+`, dbRoleMethod{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, insertRoleStmt, row).Run()
+		if internaldatabase.IsErrConstraintUnique(err) {
+			return errors.Errorf("role %q: %w", role.Name, accesserrors.RoleAlreadyExists)
+		} else if err != nil {
+			return errors.Errorf("adding role %q: %w", role.Name, err)
+		}
+		methods := make([]dbRoleMethod, len(role.Methods))
+		for i, method := range role.Methods {
+			methods[i] = dbRoleMethod{RoleUUID: uuid, Method: method}
+		}
+		if err := tx.Query(ctx, insertMethodStmt, methods).Run(); err != nil {
+			return errors.Errorf("adding methods of role %q: %w", role.Name, err)
+		}
+		return nil
+	})
+}
+
+// GetRole returns the role with the name.
+// The following errors can be returned:
+// - [accesserrors.RoleNotFound] if the role does not exist.
+func (st *RoleState) GetRole(ctx context.Context, name string) (access.Role, error) {
+	db, err := st.DB()
+	if err != nil {
+		return access.Role{}, errors.Capture(err)
+	}
+
+	var result access.Role
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		role, err := st.getRole(ctx, tx, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		roles, err := st.rolesWithMethods(ctx, tx, []dbRole{role})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		result = roles[0]
+		return nil
+	})
+	return result, errors.Capture(err)
+}
+
+// ListRoles returns every role, ordered by name.
+func (st *RoleState) ListRoles(ctx context.Context) ([]access.Role, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &dbRole.*
+FROM   permission_role
+ORDER BY name
+`, dbRole{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var result []access.Role
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var rows []dbRole
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("listing roles: %w", err)
+		}
+		result, err = st.rolesWithMethods(ctx, tx, rows)
+		return errors.Capture(err)
+	})
+	return result, errors.Capture(err)
+}
+
+// RemoveRole removes the role, along with every grant of it.
+// The following errors can be returned:
+// - [accesserrors.RoleNotFound] if the role does not exist.
+func (st *RoleState) RemoveRole(ctx context.Context, name string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	role := dbRole{}
+	deleteGrantsStmt, err := st.Prepare(`
+DELETE FROM permission_role_grant
+WHERE  role_uuid = $dbRole.uuid
+`, role)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteMethodsStmt, err := st.Prepare(`
+DELETE FROM permission_role_method
+WHERE  role_uuid = $dbRole.uuid
+`, role)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteRoleStmt, err := st.Prepare(`
+DELETE FROM permission_role
+WHERE  uuid = $dbRole.uuid
+`, role)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		role, err := st.getRole(ctx, tx, name)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		for _, stmt := range []*sqlair.Statement{deleteGrantsStmt, deleteMethodsStmt, deleteRoleStmt} {
+			if err := tx.Query(ctx, stmt, role).Run(); err != nil {
+				return errors.Errorf("removing role %q: %w", name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// GrantRole grants the role to the user on the target model or controller.
+// The following errors can be returned:
+// - [accesserrors.RoleNotFound] if the role does not exist.
+// - [accesserrors.UserNotFound] if the user does not exist.
+// - [accesserrors.PermissionTargetInvalid] if the target does not exist.
+// - [accesserrors.RoleGrantAlreadyExists] if the role was already granted to
+// the user on the target.
+func (st *RoleState) GrantRole(ctx context.Context, uuid string, grant access.RoleGrant) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO permission_role_grant (uuid, role_uuid, object_type_id, grant_on, grant_to)
+SELECT $dbRoleGrant.uuid,
+       $dbRoleGrant.role_uuid,
+       ot.id,
+       $dbRoleGrant.grant_on,
+       $dbRoleGrant.grant_to
+FROM   permission_object_type ot
+WHERE  ot.type = $dbRoleGrant.object_type
+`, dbRoleGrant{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		role, err := st.getRole(ctx, tx, grant.Role)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		userUUID, err := GetUserUUIDByName(ctx, tx, grant.Subject)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := targetExists(ctx, tx, grant.Target); err != nil {
+			return errors.Capture(err)
+		}
+
+		row := dbRoleGrant{
+			UUID:       uuid,
+			RoleUUID:   role.UUID,
+			ObjectType: grant.Target.ObjectType.String(),
+			GrantOn:    grant.Target.Key,
+			GrantTo:    userUUID.String(),
+		}
+		err = tx.Query(ctx, insertStmt, row).Run()
+		if internaldatabase.IsErrConstraintUnique(err) {
+			return errors.Errorf("role %q for %q on %q: %w", grant.Role, grant.Subject, grant.Target.Key, accesserrors.RoleGrantAlreadyExists)
+		} else if err != nil {
+			return errors.Errorf("granting role %q to %q on %q: %w", grant.Role, grant.Subject, grant.Target.Key, err)
+		}
+		return nil
+	})
+}
+
+// RevokeRole revokes the role from the user on the target model or
+// controller.
+// The following errors can be returned:
+// - [accesserrors.RoleGrantNotFound] if the role was not granted to the user
+// on the target.
+func (st *RoleState) RevokeRole(ctx context.Context, grant access.RoleGrant) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := dbRoleGrantKey{
+		RoleName:   grant.Role,
+		UserName:   grant.Subject.Name(),
+		ObjectType: grant.Target.ObjectType.String(),
+		GrantOn:    grant.Target.Key,
+	}
+	stmt, err := st.Prepare(`
+DELETE FROM permission_role_grant
+WHERE  uuid IN (
+    SELECT uuid
+    FROM   v_permission_role_grant
+    WHERE  role_name = $dbRoleGrantKey.role_name
+    AND    user_name = $dbRoleGrantKey.user_name
+    AND    object_type = $dbRoleGrantKey.object_type
+    AND    grant_on = $dbRoleGrantKey.grant_on
+)
+`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, row).Get(&outcome); err != nil {
+			return errors.Errorf("revoking role %q from %q on %q: %w", grant.Role, grant.Subject, grant.Target.Key, err)
+		}
+		affected, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if affected == 0 {
+			return errors.Errorf("role %q for %q on %q: %w", grant.Role, grant.Subject, grant.Target.Key, accesserrors.RoleGrantNotFound)
+		}
+		return nil
+	})
+}
+
+// ListRoleGrants returns the grants of the role with the name, or of every
+// role if the name is empty, ordered by role, user and target. Grants to
+// removed users are not returned.
+func (st *RoleState) ListRoleGrants(ctx context.Context, roleName string) ([]access.RoleGrant, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	filter := dbRoleGrantKey{RoleName: roleName}
+	stmt, err := st.Prepare(`
+SELECT &dbRoleGrantKey.*
+FROM   v_permission_role_grant
+WHERE  user_removed = false
+AND    ($dbRoleGrantKey.role_name = '' OR role_name = $dbRoleGrantKey.role_name)
+ORDER BY role_name, user_name, object_type, grant_on
+`, filter)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []dbRoleGrantKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if roleName != "" {
+			if _, err := st.getRole(ctx, tx, roleName); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		err := tx.Query(ctx, stmt, filter).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("listing role grants: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]access.RoleGrant, len(rows))
+	for i, row := range rows {
+		subject, err := user.NewName(row.UserName)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		result[i] = access.RoleGrant{
+			Role:    row.RoleName,
+			Subject: subject,
+			Target: corepermission.ID{
+				ObjectType: corepermission.ObjectType(row.ObjectType),
+				Key:        row.GrantOn,
+			},
+		}
+	}
+	return result, nil
+}
+
+// GetUserRoles returns the roles granted to the user on the target, along
+// with those granted to them on the controller, which apply to every model.
+func (st *RoleState) GetUserRoles(ctx context.Context, subject user.Name, target corepermission.ID) ([]access.Role, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	filter := dbRoleGrantKey{
+		UserName:   subject.Name(),
+		ObjectType: target.ObjectType.String(),
+		GrantOn:    target.Key,
+	}
+	stmt, err := st.Prepare(`
+SELECT DISTINCT (r.uuid, r.name, r.description) AS (&dbRole.*)
+FROM   v_permission_role_grant g
+       JOIN permission_role r ON g.role_name = r.name
+WHERE  g.user_name = $dbRoleGrantKey.user_name
+AND    g.user_removed = false
+AND    (g.object_type = 'controller'
+        OR (g.object_type = $dbRoleGrantKey.object_type AND g.grant_on = $dbRoleGrantKey.grant_on))
+ORDER BY r.name
+`, filter, dbRole{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var result []access.Role
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var rows []dbRole
+		err := tx.Query(ctx, stmt, filter).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting roles of %q: %w", subject, err)
+		}
+		result, err = st.rolesWithMethods(ctx, tx, rows)
+		return errors.Capture(err)
+	})
+	return result, errors.Capture(err)
+}
+
+func (st *RoleState) getRole(ctx context.Context, tx *sqlair.TX, name string) (dbRole, error) {
+	role := dbRole{Name: name}
+	stmt, err := st.Prepare(`
+SELECT &dbRole.*
+FROM   permission_role
+WHERE  name = $dbRole.name
+`, role)
+	if err != nil {
+		return dbRole{}, errors.Capture(err)
+	}
+	err = tx.Query(ctx, stmt, role).Get(&role)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return dbRole{}, errors.Errorf("role %q: %w", name, accesserrors.RoleNotFound)
+	} else if err != nil {
+		return dbRole{}, errors.Errorf("getting role %q: %w", name, err)
+	}
+	return role, nil
+}
+
+// rolesWithMethods returns the roles along with the methods they allow,
+// sorted by method.
+func (st *RoleState) rolesWithMethods(ctx context.Context, tx *sqlair.TX, roles []dbRole) ([]access.Role, error) {
+	if len(roles) == 0 {
+		return nil, nil
+	}
+	uuids := make(roleUUIDs, len(roles))
+	for i, role := range roles {
+		uuids[i] = role.UUID
+	}
+	stmt, err := st.Prepare(`
+SELECT &dbRoleMethod.*
+FROM   permission_role_method
+WHERE  role_uuid IN ($roleUUIDs[:])
+`, dbRoleMethod{}, uuids)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var methods []dbRoleMethod
+	err = tx.Query(ctx, stmt, uuids).GetAll(&methods)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return nil, errors.Errorf("getting role methods: %w", err)
+	}
+
+	byRole := make(map[string][]string)
+	for _, method := range methods {
+		byRole[method.RoleUUID] = append(byRole[method.RoleUUID], method.Method)
+	}
+	result := make([]access.Role, len(roles))
+	for i, role := range roles {
+		roleMethods := byRole[role.UUID]
+		sort.Strings(roleMethods)
+		result[i] = access.Role{
+			Name:        role.Name,
+			Description: role.Description,
+			Methods:     roleMethods,
+		}
+	}
+	return result, nil
+}
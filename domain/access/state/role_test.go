@@ -0,0 +1,286 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coremodel "github.com/juju/juju/core/model"
+	corepermission "github.com/juju/juju/core/permission"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	modeltesting "github.com/juju/juju/domain/model/state/testing"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type roleStateSuite struct {
+	schematesting.ControllerSuite
+
+	controllerUUID string
+	modelUUID      coremodel.UUID
+	otherModelUUID coremodel.UUID
+}
+
+var _ = gc.Suite(&roleStateSuite{})
+
+func (s *roleStateSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.controllerUUID = s.SeedControllerUUID(c)
+	s.modelUUID = modeltesting.CreateTestModel(c, s.TxnRunnerFactory(), "test-model")
+	s.otherModelUUID = modeltesting.CreateTestModel(c, s.TxnRunnerFactory(), "other-model")
+
+	s.ensureUser(c, "123", "bob", false)
+	s.ensureUser(c, "456", "sue", false)
+	s.ensureUser(c, "789", "gone", true)
+}
+
+func (s *roleStateSuite) ensureUser(c *gc.C, userUUID, name string, removed bool) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO user (uuid, name, display_name, external, removed, created_by_uuid, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, userUUID, name, name, false, removed, userUUID, time.Now())
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleStateSuite) modelTarget() corepermission.ID {
+	return corepermission.ID{ObjectType: corepermission.Model, Key: s.modelUUID.String()}
+}
+
+func (s *roleStateSuite) controllerTarget() corepermission.ID {
+	return corepermission.ID{ObjectType: corepermission.Controller, Key: s.controllerUUID}
+}
+
+func (s *roleStateSuite) addRole(c *gc.C, st *RoleState, name string, methods ...string) {
+	err := st.AddRole(context.Background(), uuid.MustNewUUID().String(), access.Role{
+		Name:        name,
+		Description: name + " role",
+		Methods:     methods,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *roleStateSuite) TestAddRole(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get", "Action.*")
+
+	role, err := st.GetRole(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(role, jc.DeepEquals, access.Role{
+		Name:        "operator",
+		Description: "operator role",
+		Methods:     []string{"Action.*", "Application.Get"},
+	})
+}
+
+func (s *roleStateSuite) TestAddRoleAlreadyExists(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get")
+
+	err := st.AddRole(context.Background(), uuid.MustNewUUID().String(), access.Role{
+		Name:    "operator",
+		Methods: []string{"Action.*"},
+	})
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleAlreadyExists)
+}
+
+func (s *roleStateSuite) TestGetRoleNotFound(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	_, err := st.GetRole(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleNotFound)
+}
+
+func (s *roleStateSuite) TestListRoles(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	roles, err := st.ListRoles(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(roles, gc.HasLen, 0)
+
+	s.addRole(c, st, "viewer", "Client.FullStatus")
+	s.addRole(c, st, "operator", "Application.Get", "Action.*")
+
+	roles, err = st.ListRoles(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(roles, jc.DeepEquals, []access.Role{{
+		Name:        "operator",
+		Description: "operator role",
+		Methods:     []string{"Action.*", "Application.Get"},
+	}, {
+		Name:        "viewer",
+		Description: "viewer role",
+		Methods:     []string{"Client.FullStatus"},
+	}})
+}
+
+func (s *roleStateSuite) TestRemoveRole(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get")
+	err := st.GrantRole(context.Background(), uuid.MustNewUUID().String(), access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  s.modelTarget(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RemoveRole(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.GetRole(context.Background(), "operator")
+	c.Check(err, jc.ErrorIs, accesserrors.RoleNotFound)
+	grants, err := st.ListRoleGrants(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, gc.HasLen, 0)
+}
+
+func (s *roleStateSuite) TestRemoveRoleNotFound(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	err := st.RemoveRole(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleNotFound)
+}
+
+func (s *roleStateSuite) TestGrantRole(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get")
+	s.addRole(c, st, "viewer", "Client.FullStatus")
+
+	bob := usertesting.GenNewName(c, "bob")
+	sue := usertesting.GenNewName(c, "sue")
+	for _, grant := range []access.RoleGrant{
+		{Role: "operator", Subject: bob, Target: s.modelTarget()},
+		{Role: "viewer", Subject: sue, Target: s.controllerTarget()},
+		{Role: "operator", Subject: sue, Target: s.modelTarget()},
+	} {
+		err := st.GrantRole(context.Background(), uuid.MustNewUUID().String(), grant)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	grants, err := st.ListRoleGrants(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, jc.DeepEquals, []access.RoleGrant{
+		{Role: "operator", Subject: bob, Target: s.modelTarget()},
+		{Role: "operator", Subject: sue, Target: s.modelTarget()},
+		{Role: "viewer", Subject: sue, Target: s.controllerTarget()},
+	})
+
+	grants, err = st.ListRoleGrants(context.Background(), "viewer")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, jc.DeepEquals, []access.RoleGrant{
+		{Role: "viewer", Subject: sue, Target: s.controllerTarget()},
+	})
+}
+
+func (s *roleStateSuite) TestGrantRoleAlreadyExists(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get")
+	grant := access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  s.modelTarget(),
+	}
+	err := st.GrantRole(context.Background(), uuid.MustNewUUID().String(), grant)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.GrantRole(context.Background(), uuid.MustNewUUID().String(), grant)
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleGrantAlreadyExists)
+}
+
+func (s *roleStateSuite) TestGrantRoleErrors(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get")
+	bob := usertesting.GenNewName(c, "bob")
+
+	err := st.GrantRole(context.Background(), uuid.MustNewUUID().String(), access.RoleGrant{
+		Role: "missing", Subject: bob, Target: s.modelTarget(),
+	})
+	c.Check(err, jc.ErrorIs, accesserrors.RoleNotFound)
+
+	err = st.GrantRole(context.Background(), uuid.MustNewUUID().String(), access.RoleGrant{
+		Role: "operator", Subject: usertesting.GenNewName(c, "gone"), Target: s.modelTarget(),
+	})
+	c.Check(err, jc.ErrorIs, accesserrors.UserNotFound)
+
+	err = st.GrantRole(context.Background(), uuid.MustNewUUID().String(), access.RoleGrant{
+		Role:    "operator",
+		Subject: bob,
+		Target:  corepermission.ID{ObjectType: corepermission.Model, Key: uuid.MustNewUUID().String()},
+	})
+	c.Check(err, jc.ErrorIs, accesserrors.PermissionTargetInvalid)
+}
+
+func (s *roleStateSuite) TestRevokeRole(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get")
+	grant := access.RoleGrant{
+		Role:    "operator",
+		Subject: usertesting.GenNewName(c, "bob"),
+		Target:  s.modelTarget(),
+	}
+	err := st.GrantRole(context.Background(), uuid.MustNewUUID().String(), grant)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RevokeRole(context.Background(), grant)
+	c.Assert(err, jc.ErrorIsNil)
+	grants, err := st.ListRoleGrants(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, gc.HasLen, 0)
+
+	err = st.RevokeRole(context.Background(), grant)
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleGrantNotFound)
+}
+
+func (s *roleStateSuite) TestListRoleGrantsRoleNotFound(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	_, err := st.ListRoleGrants(context.Background(), "operator")
+	c.Assert(err, jc.ErrorIs, accesserrors.RoleNotFound)
+}
+
+func (s *roleStateSuite) TestGetUserRoles(c *gc.C) {
+	st := NewRoleState(s.TxnRunnerFactory())
+	s.addRole(c, st, "operator", "Application.Get")
+	s.addRole(c, st, "viewer", "Client.FullStatus")
+	s.addRole(c, st, "deployer", "Application.Deploy")
+
+	bob := usertesting.GenNewName(c, "bob")
+	for _, grant := range []access.RoleGrant{
+		{Role: "operator", Subject: bob, Target: s.modelTarget()},
+		{Role: "viewer", Subject: bob, Target: s.controllerTarget()},
+		{Role: "deployer", Subject: bob, Target: corepermission.ID{
+			ObjectType: corepermission.Model, Key: s.otherModelUUID.String(),
+		}},
+		{Role: "deployer", Subject: usertesting.GenNewName(c, "sue"), Target: s.modelTarget()},
+	} {
+		err := st.GrantRole(context.Background(), uuid.MustNewUUID().String(), grant)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	roles, err := st.GetUserRoles(context.Background(), bob, s.modelTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(roles, jc.DeepEquals, []access.Role{{
+		Name:        "operator",
+		Description: "operator role",
+		Methods:     []string{"Application.Get"},
+	}, {
+		Name:        "viewer",
+		Description: "viewer role",
+		Methods:     []string{"Client.FullStatus"},
+	}})
+
+	roles, err = st.GetUserRoles(context.Background(), bob, s.controllerTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(roles, gc.HasLen, 1)
+	c.Check(roles[0].Name, gc.Equals, "viewer")
+
+	roles, err = st.GetUserRoles(context.Background(), usertesting.GenNewName(c, "gone"), s.modelTarget())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(roles, gc.HasLen, 0)
+}
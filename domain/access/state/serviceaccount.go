@@ -0,0 +1,309 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ServiceAccountState describes retrieval and persistence methods for
+// service accounts and the API tokens issued to them.
+type ServiceAccountState struct {
+	*domain.StateBase
+}
+
+// NewServiceAccountState returns a new state reference.
+func NewServiceAccountState(factory coredatabase.TxnRunnerFactory) *ServiceAccountState {
+	return &ServiceAccountState{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddServiceAccount adds a service account, a local user without a password.
+// The following errors can be returned:
+// - [accesserrors.UserAlreadyExists] if a user with the name already exists.
+// - [accesserrors.UserCreatorUUIDNotFound] if the creator does not exist.
+func (st *ServiceAccountState) AddServiceAccount(ctx context.Context, uuid user.UUID, name, creator user.Name) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	account := dbServiceAccount{UserUUID: uuid.String()}
+	stmt, err := st.Prepare(`
+INSERT INTO service_account (user_uuid)
+VALUES ($dbServiceAccount.user_uuid)
+`, account)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		creatorUUID, err := GetUserUUIDByName(ctx, tx, creator)
+		if errors.Is(err, accesserrors.UserNotFound) {
+			return errors.Errorf("creator %q: %w", creator, accesserrors.UserCreatorUUIDNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		if err := AddUser(ctx, tx, uuid, name, name.Name(), false, creatorUUID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, account).Run(); err != nil {
+			return errors.Errorf("adding service account %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// IssueServiceAccountToken records the token issued to the service account,
+// storing only the hash of the token.
+// The following errors can be returned:
+// - [accesserrors.ServiceAccountNotFound] if the service account does not
+// exist.
+// - [accesserrors.ServiceAccountTokenAlreadyExists] if the service account
+// already has a token with the name.
+// - [accesserrors.PermissionTargetInvalid] if the target of a scope does not
+// exist.
+func (st *ServiceAccountState) IssueServiceAccountToken(
+	ctx context.Context, uuid string, token access.ServiceAccountToken, tokenHash string,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	tokenStmt, err := st.Prepare(`
+INSERT INTO service_account_token (*)
+VALUES ($dbServiceAccountToken.*)
+`, dbServiceAccountToken{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	scopeStmt, err := st.Prepare(`
+INSERT INTO service_account_token_scope (token_uuid, access_type_id, object_type_id, grant_on)
+SELECT $dbServiceAccountTokenScope.token_uuid,
+       at.id,
+       ot.id,
+       $dbServiceAccountTokenScope.grant_on
+FROM   permission_access_type at,
+       permission_object_type ot
+WHERE  at.type = $dbServiceAccountTokenScope.access_type
+AND    ot.type = $dbServiceAccountTokenScope.object_type
+`, dbServiceAccountTokenScope{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		accountUUID, err := st.serviceAccountUUID(ctx, tx, token.ServiceAccount)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		row := dbServiceAccountToken{
+			UUID:               uuid,
+			ServiceAccountUUID: accountUUID,
+			Name:               token.Name,
+			TokenHash:          tokenHash,
+			CreatedAt:          token.CreatedAt,
+			ExpiresAt:          token.ExpiresAt,
+		}
+		err = tx.Query(ctx, tokenStmt, row).Run()
+		if internaldatabase.IsErrConstraintUnique(err) {
+			return errors.Errorf("token %q of %q: %w", token.Name, token.ServiceAccount, accesserrors.ServiceAccountTokenAlreadyExists)
+		} else if err != nil {
+			return errors.Errorf("issuing token %q to %q: %w", token.Name, token.ServiceAccount, err)
+		}
+
+		for _, scope := range token.Scopes {
+			if err := targetExists(ctx, tx, scope.Target); err != nil {
+				return errors.Capture(err)
+			}
+			scopeRow := dbServiceAccountTokenScope{
+				TokenUUID:  uuid,
+				AccessType: scope.Access.String(),
+				ObjectType: scope.Target.ObjectType.String(),
+				GrantOn:    scope.Target.Key,
+			}
+			if err := tx.Query(ctx, scopeStmt, scopeRow).Run(); err != nil {
+				return errors.Errorf("scoping token %q to %q: %w", token.Name, scope.Target.Key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// RevokeServiceAccountToken removes the token of the service account, so it
+// can no longer be used.
+// The following errors can be returned:
+// - [accesserrors.ServiceAccountNotFound] if the service account does not
+// exist.
+// - [accesserrors.ServiceAccountTokenNotFound] if the service account has no
+// token with the name.
+func (st *ServiceAccountState) RevokeServiceAccountToken(ctx context.Context, account user.Name, name string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	selectStmt, err := st.Prepare(`
+SELECT &dbServiceAccountToken.uuid
+FROM   service_account_token
+WHERE  service_account_uuid = $dbServiceAccountToken.service_account_uuid
+AND    name = $dbServiceAccountToken.name
+`, dbServiceAccountToken{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteScopesStmt, err := st.Prepare(`
+DELETE FROM service_account_token_scope
+WHERE  token_uuid = $dbServiceAccountToken.uuid
+`, dbServiceAccountToken{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteStmt, err := st.Prepare(`
+DELETE FROM service_account_token
+WHERE  uuid = $dbServiceAccountToken.uuid
+`, dbServiceAccountToken{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		accountUUID, err := st.serviceAccountUUID(ctx, tx, account)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		row := dbServiceAccountToken{ServiceAccountUUID: accountUUID, Name: name}
+		err = tx.Query(ctx, selectStmt, row).Get(&row)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("token %q of %q: %w", name, account, accesserrors.ServiceAccountTokenNotFound)
+		} else if err != nil {
+			return errors.Errorf("reading token %q of %q: %w", name, account, err)
+		}
+
+		if err := tx.Query(ctx, deleteScopesStmt, row).Run(); err != nil {
+			return errors.Errorf("revoking token %q of %q: %w", name, account, err)
+		}
+		if err := tx.Query(ctx, deleteStmt, row).Run(); err != nil {
+			return errors.Errorf("revoking token %q of %q: %w", name, account, err)
+		}
+		return nil
+	})
+}
+
+// GetServiceAccountToken returns the token with the hash, along with its
+// scopes. Tokens of service accounts which have been removed or disabled are
+// not returned.
+// The following errors can be returned:
+// - [accesserrors.ServiceAccountTokenNotFound] if there is no such token.
+func (st *ServiceAccountState) GetServiceAccountToken(ctx context.Context, tokenHash string) (access.ServiceAccountToken, error) {
+	db, err := st.DB()
+	if err != nil {
+		return access.ServiceAccountToken{}, errors.Capture(err)
+	}
+
+	tokenStmt, err := st.Prepare(`
+SELECT &dbServiceAccountTokenInfo.*
+FROM   v_service_account_token
+WHERE  token_hash = $dbServiceAccountTokenInfo.token_hash
+AND    removed = FALSE
+AND    disabled = FALSE
+`, dbServiceAccountTokenInfo{})
+	if err != nil {
+		return access.ServiceAccountToken{}, errors.Capture(err)
+	}
+	scopesStmt, err := st.Prepare(`
+SELECT &dbServiceAccountTokenScope.*
+FROM   v_service_account_token_scope
+WHERE  token_uuid = $dbServiceAccountTokenInfo.uuid
+ORDER BY object_type, grant_on
+`, dbServiceAccountTokenInfo{}, dbServiceAccountTokenScope{})
+	if err != nil {
+		return access.ServiceAccountToken{}, errors.Capture(err)
+	}
+
+	var (
+		info   dbServiceAccountTokenInfo
+		scopes []dbServiceAccountTokenScope
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		info = dbServiceAccountTokenInfo{TokenHash: tokenHash}
+		err := tx.Query(ctx, tokenStmt, info).Get(&info)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return accesserrors.ServiceAccountTokenNotFound
+		} else if err != nil {
+			return errors.Errorf("reading service account token: %w", err)
+		}
+
+		err = tx.Query(ctx, scopesStmt, info).GetAll(&scopes)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("reading scopes of service account token %q: %w", info.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return access.ServiceAccountToken{}, errors.Capture(err)
+	}
+
+	name, err := user.NewName(info.ServiceAccountName)
+	if err != nil {
+		return access.ServiceAccountToken{}, errors.Capture(err)
+	}
+	token := access.ServiceAccountToken{
+		Name:           info.Name,
+		ServiceAccount: name,
+		Scopes:         make([]corepermission.AccessSpec, len(scopes)),
+		CreatedAt:      info.CreatedAt,
+		ExpiresAt:      info.ExpiresAt,
+	}
+	for i, scope := range scopes {
+		token.Scopes[i] = corepermission.AccessSpec{
+			Target: corepermission.ID{
+				ObjectType: corepermission.ObjectType(scope.ObjectType),
+				Key:        scope.GrantOn,
+			},
+			Access: corepermission.Access(scope.AccessType),
+		}
+	}
+	return token, nil
+}
+
+// serviceAccountUUID returns the uuid of the service account with the name.
+// If the user does not exist, has been removed, or is not a service account,
+// an error satisfying [accesserrors.ServiceAccountNotFound] is returned.
+func (st *ServiceAccountState) serviceAccountUUID(ctx context.Context, tx *sqlair.TX, name user.Name) (string, error) {
+	stmt, err := st.Prepare(`
+SELECT sa.user_uuid AS &dbServiceAccount.user_uuid
+FROM   service_account AS sa
+JOIN   user AS u ON sa.user_uuid = u.uuid
+WHERE  u.name = $dbUser.name
+AND    u.removed = FALSE
+`, dbServiceAccount{}, dbUser{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var account dbServiceAccount
+	err = tx.Query(ctx, stmt, dbUser{Name: name.Name()}).Get(&account)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return "", errors.Errorf("%q: %w", name, accesserrors.ServiceAccountNotFound)
+	} else if err != nil {
+		return "", errors.Errorf("reading service account %q: %w", name, err)
+	}
+	return account.UserUUID, nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coremodel "github.com/juju/juju/core/model"
+	corepermission "github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	usertesting "github.com/juju/juju/core/user/testing"
+	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	modeltesting "github.com/juju/juju/domain/model/state/testing"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type serviceAccountStateSuite struct {
+	schematesting.ControllerSuite
+
+	controllerUUID string
+	modelUUID      coremodel.UUID
+	admin          user.Name
+	account        user.Name
+}
+
+var _ = gc.Suite(&serviceAccountStateSuite{})
+
+func (s *serviceAccountStateSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.controllerUUID = s.SeedControllerUUID(c)
+	s.modelUUID = modeltesting.CreateTestModel(c, s.TxnRunnerFactory(), "test-model")
+	s.admin = usertesting.GenNewName(c, "fred")
+	s.account = usertesting.GenNewName(c, "ci-bot")
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO user (uuid, name, display_name, external, removed, created_by_uuid, created_at)
+			VALUES ('123', 'fred', 'fred', false, false, '123', ?)
+		`, time.Now())
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	err = st.AddServiceAccount(context.Background(), usertesting.GenUserUUID(c), s.account, s.admin)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceAccountStateSuite) modelTarget() corepermission.ID {
+	return corepermission.ID{ObjectType: corepermission.Model, Key: s.modelUUID.String()}
+}
+
+func (s *serviceAccountStateSuite) controllerTarget() corepermission.ID {
+	return corepermission.ID{ObjectType: corepermission.Controller, Key: s.controllerUUID}
+}
+
+func (s *serviceAccountStateSuite) issue(c *gc.C, st *ServiceAccountState, name, hash string, expiresAt *time.Time) {
+	err := st.IssueServiceAccountToken(context.Background(), uuid.MustNewUUID().String(), access.ServiceAccountToken{
+		Name:           name,
+		ServiceAccount: s.account,
+		Scopes: []corepermission.AccessSpec{
+			{Target: s.modelTarget(), Access: corepermission.WriteAccess},
+			{Target: s.controllerTarget(), Access: corepermission.LoginAccess},
+		},
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt,
+	}, hash)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceAccountStateSuite) TestAddServiceAccountAlreadyExists(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	err := st.AddServiceAccount(context.Background(), usertesting.GenUserUUID(c), s.account, s.admin)
+	c.Assert(err, jc.ErrorIs, accesserrors.UserAlreadyExists)
+
+	err = st.AddServiceAccount(context.Background(), usertesting.GenUserUUID(c), s.admin, s.admin)
+	c.Assert(err, jc.ErrorIs, accesserrors.UserAlreadyExists)
+}
+
+func (s *serviceAccountStateSuite) TestAddServiceAccountCreatorNotFound(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	err := st.AddServiceAccount(context.Background(), usertesting.GenUserUUID(c), usertesting.GenNewName(c, "deploy-bot"), usertesting.GenNewName(c, "nobody"))
+	c.Assert(err, jc.ErrorIs, accesserrors.UserCreatorUUIDNotFound)
+}
+
+func (s *serviceAccountStateSuite) TestIssueAndGetServiceAccountToken(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	expiresAt := time.Now().Add(time.Hour).UTC()
+	s.issue(c, st, "deploy", "hash", &expiresAt)
+
+	token, err := st.GetServiceAccountToken(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(token.Name, gc.Equals, "deploy")
+	c.Check(token.ServiceAccount, gc.Equals, s.account)
+	c.Check(token.Scopes, jc.DeepEquals, []corepermission.AccessSpec{
+		{Target: s.controllerTarget(), Access: corepermission.LoginAccess},
+		{Target: s.modelTarget(), Access: corepermission.WriteAccess},
+	})
+	c.Assert(token.ExpiresAt, gc.NotNil)
+	c.Check(token.ExpiresAt.Equal(expiresAt), jc.IsTrue)
+
+	_, err = st.GetServiceAccountToken(context.Background(), "other-hash")
+	c.Check(err, jc.ErrorIs, accesserrors.ServiceAccountTokenNotFound)
+}
+
+func (s *serviceAccountStateSuite) TestIssueServiceAccountTokenNoExpiry(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	s.issue(c, st, "deploy", "hash", nil)
+
+	token, err := st.GetServiceAccountToken(context.Background(), "hash")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(token.ExpiresAt, gc.IsNil)
+}
+
+func (s *serviceAccountStateSuite) TestIssueServiceAccountTokenAlreadyExists(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	s.issue(c, st, "deploy", "hash", nil)
+
+	err := st.IssueServiceAccountToken(context.Background(), uuid.MustNewUUID().String(), access.ServiceAccountToken{
+		Name:           "deploy",
+		ServiceAccount: s.account,
+		Scopes:         []corepermission.AccessSpec{{Target: s.modelTarget(), Access: corepermission.ReadAccess}},
+		CreatedAt:      time.Now(),
+	}, "other-hash")
+	c.Assert(err, jc.ErrorIs, accesserrors.ServiceAccountTokenAlreadyExists)
+}
+
+func (s *serviceAccountStateSuite) TestIssueServiceAccountTokenNotServiceAccount(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	err := st.IssueServiceAccountToken(context.Background(), uuid.MustNewUUID().String(), access.ServiceAccountToken{
+		Name:           "deploy",
+		ServiceAccount: s.admin,
+		Scopes:         []corepermission.AccessSpec{{Target: s.modelTarget(), Access: corepermission.ReadAccess}},
+		CreatedAt:      time.Now(),
+	}, "hash")
+	c.Assert(err, jc.ErrorIs, accesserrors.ServiceAccountNotFound)
+}
+
+func (s *serviceAccountStateSuite) TestIssueServiceAccountTokenTargetNotFound(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	err := st.IssueServiceAccountToken(context.Background(), uuid.MustNewUUID().String(), access.ServiceAccountToken{
+		Name:           "deploy",
+		ServiceAccount: s.account,
+		Scopes: []corepermission.AccessSpec{{
+			Target: corepermission.ID{ObjectType: corepermission.Model, Key: uuid.MustNewUUID().String()},
+			Access: corepermission.ReadAccess,
+		}},
+		CreatedAt: time.Now(),
+	}, "hash")
+	c.Assert(err, jc.ErrorIs, accesserrors.PermissionTargetInvalid)
+
+	_, err = st.GetServiceAccountToken(context.Background(), "hash")
+	c.Check(err, jc.ErrorIs, accesserrors.ServiceAccountTokenNotFound)
+}
+
+func (s *serviceAccountStateSuite) TestRevokeServiceAccountToken(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	s.issue(c, st, "deploy", "hash", nil)
+
+	err := st.RevokeServiceAccountToken(context.Background(), s.account, "deploy")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.GetServiceAccountToken(context.Background(), "hash")
+	c.Check(err, jc.ErrorIs, accesserrors.ServiceAccountTokenNotFound)
+
+	err = st.RevokeServiceAccountToken(context.Background(), s.account, "deploy")
+	c.Check(err, jc.ErrorIs, accesserrors.ServiceAccountTokenNotFound)
+
+	err = st.RevokeServiceAccountToken(context.Background(), s.admin, "deploy")
+	c.Check(err, jc.ErrorIs, accesserrors.ServiceAccountNotFound)
+}
+
+func (s *serviceAccountStateSuite) TestGetServiceAccountTokenDisabled(c *gc.C) {
+	st := NewServiceAccountState(s.TxnRunnerFactory())
+	s.issue(c, st, "deploy", "hash", nil)
+
+	err := NewUserState(s.TxnRunnerFactory()).DisableUserAuthentication(context.Background(), s.account)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.GetServiceAccountToken(context.Background(), "hash")
+	c.Check(err, jc.ErrorIs, accesserrors.ServiceAccountTokenNotFound)
+}
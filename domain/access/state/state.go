@@ -9,17 +9,23 @@ import (
 )
 
 // State represents a type for interacting with the underlying state.
-// Composes both user and permission state, so we can interact with both
-// from the single state, whilst also keeping the concerns separate.
+// Composes the user, permission, role, group and service account state, so we can interact with all
+// of them from the single state, whilst also keeping the concerns separate.
 type State struct {
 	*UserState
 	*PermissionState
+	*RoleState
+	*GroupState
+	*ServiceAccountState
 }
 
 // NewState returns a new State for interacting with the underlying state.
 func NewState(factory database.TxnRunnerFactory, logger logger.Logger) *State {
 	return &State{
-		UserState:       NewUserState(factory),
-		PermissionState: NewPermissionState(factory, logger),
+		UserState:           NewUserState(factory),
+		PermissionState:     NewPermissionState(factory, logger),
+		RoleState:           NewRoleState(factory),
+		GroupState:          NewGroupState(factory),
+		ServiceAccountState: NewServiceAccountState(factory),
 	}
 }
@@ -209,3 +209,112 @@ type dbModelExists struct {
 
 // dbEveryoneExternal represents the permissions of the everyone@external user.
 type dbEveryoneExternal dbPermission
+
+// dbRole represents a custom role.
+type dbRole struct {
+	UUID        string `db:"uuid"`
+	Name        string `db:"name"`
+	Description string `db:"description"`
+}
+
+// dbRoleMethod represents an API method allowed by a role.
+type dbRoleMethod struct {
+	RoleUUID string `db:"role_uuid"`
+	Method   string `db:"method"`
+}
+
+// dbRoleGrant represents a role granted to a user on a model or the
+// controller.
+type dbRoleGrant struct {
+	UUID       string `db:"uuid"`
+	RoleUUID   string `db:"role_uuid"`
+	ObjectType string `db:"object_type"`
+	GrantOn    string `db:"grant_on"`
+	GrantTo    string `db:"grant_to"`
+}
+
+// dbRoleGrantKey identifies a role grant by the names of its role and user.
+type dbRoleGrantKey struct {
+	RoleName   string `db:"role_name"`
+	UserName   string `db:"user_name"`
+	ObjectType string `db:"object_type"`
+	GrantOn    string `db:"grant_on"`
+}
+
+// roleUUIDs is used to pass a list of role UUIDs as an argument to SQL.
+type roleUUIDs []string
+
+// dbPermissionGroup represents access granted to a group of users of an
+// external identity provider.
+type dbPermissionGroup struct {
+	UUID       string `db:"uuid"`
+	GroupName  string `db:"group_name"`
+	AccessType string `db:"access_type"`
+	ObjectType string `db:"object_type"`
+	GrantOn    string `db:"grant_on"`
+}
+
+// groupNames is used to pass a list of group names as an argument to SQL.
+type groupNames []string
+
+// dbServiceAccount marks a user as a service account.
+type dbServiceAccount struct {
+	UserUUID string `db:"user_uuid"`
+}
+
+// dbServiceAccountToken represents an API token issued to a service account.
+type dbServiceAccountToken struct {
+	UUID               string     `db:"uuid"`
+	ServiceAccountUUID string     `db:"service_account_uuid"`
+	Name               string     `db:"name"`
+	TokenHash          string     `db:"token_hash"`
+	CreatedAt          time.Time  `db:"created_at"`
+	ExpiresAt          *time.Time `db:"expires_at"`
+}
+
+// dbServiceAccountTokenInfo represents a service account token read from
+// v_service_account_token.
+type dbServiceAccountTokenInfo struct {
+	UUID               string     `db:"uuid"`
+	Name               string     `db:"name"`
+	TokenHash          string     `db:"token_hash"`
+	CreatedAt          time.Time  `db:"created_at"`
+	ExpiresAt          *time.Time `db:"expires_at"`
+	ServiceAccountName string     `db:"service_account_name"`
+	Removed            bool       `db:"removed"`
+	Disabled           bool       `db:"disabled"`
+}
+
+// dbServiceAccountTokenScope represents the access a service account token
+// allows on a model or the controller.
+type dbServiceAccountTokenScope struct {
+	TokenUUID  string `db:"token_uuid"`
+	AccessType string `db:"access_type"`
+	ObjectType string `db:"object_type"`
+	GrantOn    string `db:"grant_on"`
+}
+
+// dbLoginAttempt represents a password login of a local user.
+type dbLoginAttempt struct {
+	UserUUID    string    `db:"user_uuid"`
+	AttemptedAt time.Time `db:"attempted_at"`
+	Success     bool      `db:"success"`
+	Address     string    `db:"address"`
+}
+
+// loginAttemptLimit is used to limit the number of login attempts read or
+// kept for a user.
+type loginAttemptLimit struct {
+	UserUUID string `db:"user_uuid"`
+	Limit    int    `db:"limit"`
+}
+
+// controllerConfigKeyValue is a key and value of the controller config.
+type controllerConfigKeyValue struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
+// controllerConfigKeys is used to pass a list of controller config keys as
+// an argument to SQL.
+type controllerConfigKeys []string
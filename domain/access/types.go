@@ -4,9 +4,14 @@
 package access
 
 import (
+	"regexp"
+	"strings"
+	"time"
+
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/user"
+	accesserrors "github.com/juju/juju/domain/access/errors"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -42,3 +47,176 @@ type CredentialOwnerModelAccess struct {
 	ModelName   string            `db:"model_name"`
 	OwnerAccess permission.Access `db:"access_type"`
 }
+
+// Role is a named set of API methods which may be granted to users on a model
+// or the controller, allowing them to call those methods beyond what their
+// access level allows.
+type Role struct {
+	// Name is the unique name of the role.
+	Name string
+	// Description describes the purpose of the role.
+	Description string
+	// Methods are the API methods the role allows, each either
+	// "Facade.Method", or "Facade.*" for every method of the facade.
+	Methods []string
+}
+
+var (
+	roleNameRegexp   = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+	roleMethodRegexp = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*\.(\*|[A-Z][A-Za-z0-9]*)$`)
+)
+
+// Validate returns an error satisfying accesserrors.RoleNotValid if the
+// role's name or one of its methods is not valid, or it has no methods.
+func (r Role) Validate() error {
+	if !roleNameRegexp.MatchString(r.Name) {
+		return errors.Errorf("name %q %w", r.Name, accesserrors.RoleNotValid)
+	}
+	if len(r.Methods) == 0 {
+		return errors.Errorf("role %q without methods %w", r.Name, accesserrors.RoleNotValid)
+	}
+	for _, method := range r.Methods {
+		if !roleMethodRegexp.MatchString(method) {
+			return errors.Errorf("method %q %w", method, accesserrors.RoleNotValid)
+		}
+	}
+	return nil
+}
+
+// Allows reports whether the role allows the API method, given as
+// "Facade.Method".
+func (r Role) Allows(method string) bool {
+	facadeName, _, ok := strings.Cut(method, ".")
+	if !ok {
+		return false
+	}
+	for _, m := range r.Methods {
+		if m == method || m == facadeName+".*" {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleGrant is a role granted to a user on a model or the controller.
+type RoleGrant struct {
+	// Role is the name of the role granted.
+	Role string
+	// Subject is the user the role is granted to.
+	Subject user.Name
+	// Target is the model or controller the role is granted on.
+	Target permission.ID
+}
+
+// GroupGrant is access granted on a target to every user in a group of an
+// external identity provider, as named by the groups claim of the ID tokens
+// the user logs in with.
+type GroupGrant struct {
+	// Group is the name of the group.
+	Group string
+	// Access is the access granted.
+	Access permission.Access
+	// Target is what the access is granted on.
+	Target permission.ID
+}
+
+// Validate returns an error if the group grant is not valid.
+func (g GroupGrant) Validate() error {
+	if g.Group == "" || strings.TrimSpace(g.Group) != g.Group {
+		return errors.Errorf("group %q %w", g.Group, accesserrors.GroupNotValid)
+	}
+	return errors.Capture(g.Target.ValidateAccess(g.Access))
+}
+
+// ServiceAccountTokenPrefix starts every service account token, telling them
+// apart from the other tokens the controller accepts.
+const ServiceAccountTokenPrefix = "juju-sa-"
+
+// IsServiceAccountToken reports whether the token is a service account token.
+func IsServiceAccountToken(token string) bool {
+	return strings.HasPrefix(token, ServiceAccountTokenPrefix)
+}
+
+// ServiceAccountToken is a named API token issued to a service account. The
+// token is scoped to access on models or the controller, and never allows
+// more than the service account has been granted.
+type ServiceAccountToken struct {
+	// Name is the name of the token, unique for the service account.
+	Name string
+	// ServiceAccount is the service account the token was issued to.
+	ServiceAccount user.Name
+	// Scopes are the access the token allows on each model or the
+	// controller.
+	Scopes []permission.AccessSpec
+	// CreatedAt is when the token was issued.
+	CreatedAt time.Time
+	// ExpiresAt is when the token expires, or nil if it never does.
+	ExpiresAt *time.Time
+}
+
+// Scope returns the access the token allows on the target. Tokens allow
+// login access to the controller even if they are only scoped to models, as
+// that is needed to connect to them.
+func (t ServiceAccountToken) Scope(target permission.ID) permission.Access {
+	for _, scope := range t.Scopes {
+		if scope.Target == target {
+			return scope.Access
+		}
+	}
+	if target.ObjectType == permission.Controller {
+		return permission.LoginAccess
+	}
+	return permission.NoAccess
+}
+
+// IssueServiceAccountTokenArgs holds the arguments for issuing a token to a
+// service account.
+type IssueServiceAccountTokenArgs struct {
+	// ServiceAccount is the service account the token is issued to.
+	ServiceAccount user.Name
+	// Name is the name of the token, unique for the service account.
+	Name string
+	// Scopes are the access the token allows on each model or the
+	// controller.
+	Scopes []permission.AccessSpec
+	// ExpiresIn is how long the token is valid for. Tokens issued without
+	// an expiry never expire.
+	ExpiresIn time.Duration
+}
+
+// Validate returns an error satisfying accesserrors.ServiceAccountTokenNotValid
+// if the token's name or one of its scopes is not valid, or it has no
+// scopes.
+func (a IssueServiceAccountTokenArgs) Validate() error {
+	if a.ServiceAccount.IsZero() {
+		return errors.Errorf("empty service account %w", accesserrors.ServiceAccountTokenNotValid)
+	}
+	if !roleNameRegexp.MatchString(a.Name) {
+		return errors.Errorf("name %q %w", a.Name, accesserrors.ServiceAccountTokenNotValid)
+	}
+	if len(a.Scopes) == 0 {
+		return errors.Errorf("token %q without scopes %w", a.Name, accesserrors.ServiceAccountTokenNotValid)
+	}
+	if a.ExpiresIn < 0 {
+		return errors.Errorf("negative expiry %w", accesserrors.ServiceAccountTokenNotValid)
+	}
+	for _, scope := range a.Scopes {
+		if scope.Target.ObjectType != permission.Model && scope.Target.ObjectType != permission.Controller {
+			return errors.Errorf("scope on %q %w", scope.Target.ObjectType, accesserrors.ServiceAccountTokenNotValid)
+		}
+		if err := scope.Validate(); err != nil {
+			return errors.Errorf("scope on %s %q %w: %v", scope.Target.ObjectType, scope.Target.Key, accesserrors.ServiceAccountTokenNotValid, err)
+		}
+	}
+	return nil
+}
+
+// LoginAttempt is a password login of a local user.
+type LoginAttempt struct {
+	// Time is when the login was attempted.
+	Time time.Time
+	// Success is true if the user logged in.
+	Success bool
+	// Address is the address the login came from, if known.
+	Address string
+}
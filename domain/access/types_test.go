@@ -4,12 +4,15 @@
 package access
 
 import (
+	"time"
+
 	"github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/permission"
 	usertesting "github.com/juju/juju/core/user/testing"
+	accesserrors "github.com/juju/juju/domain/access/errors"
 )
 
 type typesSuite struct{}
@@ -46,3 +49,92 @@ func (s *typesSuite) TestUpsertPermissionArgsValidationFail(c *gc.C) {
 		c.Check(args.Validate(), checkers.ErrorIs, coreerrors.NotValid)
 	}
 }
+
+func (s *typesSuite) TestRoleValidate(c *gc.C) {
+	role := Role{Name: "app-operator", Methods: []string{"Application.Get", "Action.*"}}
+	c.Check(role.Validate(), checkers.ErrorIsNil)
+
+	for i, role := range []Role{
+		{Name: "", Methods: []string{"Application.Get"}},
+		{Name: "Operator", Methods: []string{"Application.Get"}},
+		{Name: "operator-", Methods: []string{"Application.Get"}},
+		{Name: "operator"},
+		{Name: "operator", Methods: []string{"Application"}},
+		{Name: "operator", Methods: []string{"application.Get"}},
+		{Name: "operator", Methods: []string{"*.Get"}},
+		{Name: "operator", Methods: []string{"Application.Get*"}},
+	} {
+		c.Logf("test %d: %+v", i, role)
+		c.Check(role.Validate(), checkers.ErrorIs, accesserrors.RoleNotValid)
+	}
+}
+
+func (s *typesSuite) TestRoleAllows(c *gc.C) {
+	role := Role{Name: "operator", Methods: []string{"Application.Get", "Action.*"}}
+	c.Check(role.Allows("Application.Get"), checkers.IsTrue)
+	c.Check(role.Allows("Application.Deploy"), checkers.IsFalse)
+	c.Check(role.Allows("Action.EnqueueOperation"), checkers.IsTrue)
+	c.Check(role.Allows("ActionPruner.Prune"), checkers.IsFalse)
+}
+
+func (s *typesSuite) TestGroupGrantValidate(c *gc.C) {
+	model := permission.ID{ObjectType: permission.Model, Key: "e4b6ae3a-0a0d-4f39-8b4e-a5a53d8e28ed"}
+	grant := GroupGrant{Group: "devs", Access: permission.WriteAccess, Target: model}
+	c.Check(grant.Validate(), checkers.ErrorIsNil)
+
+	for i, grant := range []GroupGrant{
+		{Group: "", Access: permission.WriteAccess, Target: model},
+		{Group: " devs", Access: permission.WriteAccess, Target: model},
+	} {
+		c.Logf("test %d: %+v", i, grant)
+		c.Check(grant.Validate(), checkers.ErrorIs, accesserrors.GroupNotValid)
+	}
+
+	grant = GroupGrant{Group: "devs", Access: permission.SuperuserAccess, Target: model}
+	c.Check(grant.Validate(), checkers.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *typesSuite) TestIssueServiceAccountTokenArgsValidate(c *gc.C) {
+	model := permission.ID{ObjectType: permission.Model, Key: "e4b6ae3a-0a0d-4f39-8b4e-a5a53d8e28ed"}
+	cloud := permission.ID{ObjectType: permission.Cloud, Key: "aws"}
+	account := usertesting.GenNewName(c, "ci-bot")
+	args := IssueServiceAccountTokenArgs{
+		ServiceAccount: account,
+		Name:           "deploy",
+		Scopes:         []permission.AccessSpec{{Target: model, Access: permission.WriteAccess}},
+		ExpiresIn:      time.Hour,
+	}
+	c.Check(args.Validate(), checkers.ErrorIsNil)
+
+	for i, args := range []IssueServiceAccountTokenArgs{
+		{Name: "deploy", Scopes: args.Scopes},
+		{ServiceAccount: account, Name: "Deploy", Scopes: args.Scopes},
+		{ServiceAccount: account, Name: "deploy"},
+		{ServiceAccount: account, Name: "deploy", Scopes: args.Scopes, ExpiresIn: -time.Hour},
+		{ServiceAccount: account, Name: "deploy", Scopes: []permission.AccessSpec{{Target: cloud, Access: permission.AdminAccess}}},
+		{ServiceAccount: account, Name: "deploy", Scopes: []permission.AccessSpec{{Target: model, Access: permission.SuperuserAccess}}},
+	} {
+		c.Logf("test %d: %+v", i, args)
+		c.Check(args.Validate(), checkers.ErrorIs, accesserrors.ServiceAccountTokenNotValid)
+	}
+}
+
+func (s *typesSuite) TestServiceAccountTokenScope(c *gc.C) {
+	model := permission.ID{ObjectType: permission.Model, Key: "e4b6ae3a-0a0d-4f39-8b4e-a5a53d8e28ed"}
+	otherModel := permission.ID{ObjectType: permission.Model, Key: "5d3e3c9e-1b2c-4a6f-9b6b-6d0c1e3f2a11"}
+	controller := permission.ID{ObjectType: permission.Controller, Key: "deadbeef-1bad-500d-9000-4b1d0d06f00d"}
+	token := ServiceAccountToken{
+		Scopes: []permission.AccessSpec{{Target: model, Access: permission.WriteAccess}},
+	}
+	c.Check(token.Scope(model), gc.Equals, permission.WriteAccess)
+	c.Check(token.Scope(otherModel), gc.Equals, permission.NoAccess)
+	c.Check(token.Scope(controller), gc.Equals, permission.LoginAccess)
+
+	token.Scopes = append(token.Scopes, permission.AccessSpec{Target: controller, Access: permission.SuperuserAccess})
+	c.Check(token.Scope(controller), gc.Equals, permission.SuperuserAccess)
+}
+
+func (s *typesSuite) TestIsServiceAccountToken(c *gc.C) {
+	c.Check(IsServiceAccountToken("juju-sa-abc"), checkers.IsTrue)
+	c.Check(IsServiceAccountToken("eyJhbGciOi"), checkers.IsFalse)
+}
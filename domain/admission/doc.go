@@ -0,0 +1,9 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package admission provides the service for the admission policies of the
+// controller and its models. Policies are evaluated before model-changing
+// operations, such as deploying or exposing an application, and any policy
+// whose rule matches the operation denies it. Policies without a model apply
+// to every model of the controller.
+package admission
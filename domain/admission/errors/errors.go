@@ -0,0 +1,31 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// PolicyNotFound describes an error that occurs when the admission
+	// policy being requested does not exist.
+	PolicyNotFound = errors.ConstError("admission policy not found")
+
+	// PolicyAlreadyExists describes an error that occurs when adding an
+	// admission policy with the name of an existing policy of the same
+	// model, or of the controller.
+	PolicyAlreadyExists = errors.ConstError("admission policy already exists")
+
+	// PolicyNotValid describes an error that occurs when the name or rule of
+	// an admission policy is not valid.
+	PolicyNotValid = errors.ConstError("admission policy not valid")
+
+	// ModelNotFound describes an error that occurs when adding an admission
+	// policy for a model which does not exist.
+	ModelNotFound = errors.ConstError("model not found")
+
+	// Denied describes an error that occurs when a model-changing operation
+	// is denied by an admission policy.
+	Denied = errors.ConstError("admission denied")
+)
@@ -0,0 +1,172 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/admission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	internaladmission "github.com/juju/juju/internal/admission"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// validPolicyName matches the names of admission policies.
+var validPolicyName = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// State describes retrieval and persistence methods for admission policies.
+type State interface {
+	// AddPolicy adds the admission policy.
+	AddPolicy(ctx context.Context, uuid string, policy admission.Policy) error
+
+	// RemovePolicy removes the admission policy with the name from the
+	// model, or from the controller if the model is empty.
+	RemovePolicy(ctx context.Context, modelUUID, name string) error
+
+	// ListPolicies returns the admission policies of the model, or of the
+	// controller if the model is empty, ordered by name.
+	ListPolicies(ctx context.Context, modelUUID string) ([]admission.Policy, error)
+
+	// GetModelPolicies returns the admission policies which apply to the
+	// model, those of the controller followed by those of the model.
+	GetModelPolicies(ctx context.Context, modelUUID string) ([]admission.Policy, error)
+}
+
+// Service provides the API for managing admission policies and admitting
+// model-changing operations.
+type Service struct {
+	st     State
+	logger logger.Logger
+}
+
+// NewService returns a new Service for managing admission policies.
+func NewService(st State, logger logger.Logger) *Service {
+	return &Service{
+		st:     st,
+		logger: logger,
+	}
+}
+
+// AddPolicy adds the admission policy to its model, or to the controller if
+// it has no model.
+// The following errors can be returned:
+// - [admissionerrors.PolicyNotValid] if the name or rule of the policy is not
+// valid.
+// - [admissionerrors.PolicyAlreadyExists] if a policy with the same name
+// exists for the model, or the controller.
+// - [admissionerrors.ModelNotFound] if the model does not exist.
+func (s *Service) AddPolicy(ctx context.Context, policy admission.Policy) error {
+	rule, err := validatePolicy(policy)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	// Store the rule in its canonical form.
+	policy.Rule = rule.String()
+
+	policyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return s.st.AddPolicy(ctx, policyUUID.String(), policy)
+}
+
+// RemovePolicy removes the admission policy with the name from the model,
+// or from the controller if the model is empty.
+// The following errors can be returned:
+// - [admissionerrors.PolicyNotFound] if the policy does not exist.
+func (s *Service) RemovePolicy(ctx context.Context, modelUUID, name string) error {
+	return s.st.RemovePolicy(ctx, modelUUID, name)
+}
+
+// ListPolicies returns the admission policies of the model, or of the
+// controller if the model is empty, ordered by name.
+func (s *Service) ListPolicies(ctx context.Context, modelUUID string) ([]admission.Policy, error) {
+	return s.st.ListPolicies(ctx, modelUUID)
+}
+
+// CheckAdmission evaluates the admission policies of the controller and the
+// model against the request.
+// The following errors can be returned:
+// - [admissionerrors.Denied] if a policy denies the request. The error
+// names every policy which denies the request, along with its message.
+func (s *Service) CheckAdmission(ctx context.Context, modelUUID string, req *internaladmission.Request) error {
+	denied, err := s.EvaluatePolicies(ctx, modelUUID, req, nil)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if len(denied) == 0 {
+		return nil
+	}
+
+	reasons := make([]string, len(denied))
+	for i, policy := range denied {
+		reasons[i] = fmt.Sprintf("policy %q", policy.Name)
+		if policy.Message != "" {
+			reasons[i] += ": " + policy.Message
+		}
+	}
+	return errors.Errorf("%w for %s by %s", admissionerrors.Denied, req.Operation(), strings.Join(reasons, "; "))
+}
+
+// EvaluatePolicies returns the policies which deny the request, of the
+// admission policies of the controller and the model along with the
+// candidate policies, which are not stored. It allows policies to be tested
+// without any changes being made.
+// The following errors can be returned:
+// - [admissionerrors.PolicyNotValid] if a candidate policy is not valid.
+func (s *Service) EvaluatePolicies(
+	ctx context.Context, modelUUID string, req *internaladmission.Request, candidates []admission.Policy,
+) ([]admission.Policy, error) {
+	rules := make([]internaladmission.Rule, len(candidates))
+	for i, candidate := range candidates {
+		rule, err := validatePolicy(candidate)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		rules[i] = rule
+	}
+
+	policies, err := s.st.GetModelPolicies(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting admission policies of model %q: %w", modelUUID, err)
+	}
+
+	var denied []admission.Policy
+	for _, policy := range policies {
+		rule, err := internaladmission.ParseRule(policy.Rule)
+		if err != nil {
+			// Rules are validated before they are stored, so this should
+			// never happen. Don't let a broken policy block every change.
+			s.logger.Errorf(ctx, "admission policy %q has an invalid rule: %v", policy.Name, err)
+			continue
+		}
+		if rule.Matches(req) {
+			denied = append(denied, policy)
+		}
+	}
+	for i, rule := range rules {
+		if rule.Matches(req) {
+			denied = append(denied, candidates[i])
+		}
+	}
+	return denied, nil
+}
+
+func validatePolicy(policy admission.Policy) (internaladmission.Rule, error) {
+	if !validPolicyName.MatchString(policy.Name) {
+		return internaladmission.Rule{}, errors.Errorf(
+			"policy name %q must be lower case letters and digits separated by hyphens", policy.Name,
+		).Add(admissionerrors.PolicyNotValid)
+	}
+	rule, err := internaladmission.ParseRule(policy.Rule)
+	if err != nil {
+		return internaladmission.Rule{}, errors.Errorf("policy %q: %w", policy.Name, err).Add(admissionerrors.PolicyNotValid)
+	}
+	return rule, nil
+}
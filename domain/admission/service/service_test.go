@@ -0,0 +1,152 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coremodel "github.com/juju/juju/core/model"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/domain/admission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	internaladmission "github.com/juju/juju/internal/admission"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type serviceSuite struct {
+	state *MockState
+
+	modelUUID coremodel.UUID
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.modelUUID = modeltesting.GenModelUUID(c)
+	return ctrl
+}
+
+func (s *serviceSuite) service(c *gc.C) *Service {
+	return NewService(s.state, loggertesting.WrapCheckLog(c))
+}
+
+func (s *serviceSuite) TestAddPolicy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddPolicy(gomock.Any(), gomock.Any(), admission.Policy{
+		Name:      "stable-only",
+		ModelUUID: s.modelUUID.String(),
+		Rule:      `operation == "deploy" and charm.risk != "stable"`,
+		Message:   "charms must come from stable channels",
+	}).Return(nil)
+
+	err := s.service(c).AddPolicy(context.Background(), admission.Policy{
+		Name:      "stable-only",
+		ModelUUID: s.modelUUID.String(),
+		Rule:      `operation=="deploy" and   charm.risk!="stable"`,
+		Message:   "charms must come from stable channels",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddPolicyNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service(c).AddPolicy(context.Background(), admission.Policy{
+		Name: "Stable Only",
+		Rule: `charm.risk != "stable"`,
+	})
+	c.Check(err, jc.ErrorIs, admissionerrors.PolicyNotValid)
+	c.Check(err, gc.ErrorMatches, `policy name "Stable Only" must be lower case letters and digits separated by hyphens`)
+
+	err = s.service(c).AddPolicy(context.Background(), admission.Policy{
+		Name: "stable-only",
+		Rule: `charm.colour != "stable"`,
+	})
+	c.Check(err, jc.ErrorIs, admissionerrors.PolicyNotValid)
+	c.Check(err, gc.ErrorMatches, `policy "stable-only": admission rule not valid: unknown field "charm.colour"`)
+}
+
+func (s *serviceSuite) TestCheckAdmission(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetModelPolicies(gomock.Any(), s.modelUUID.String()).Return([]admission.Policy{{
+		Name:    "stable-only",
+		Rule:    `operation == "deploy" and charm.risk != "stable"`,
+		Message: "charms must come from stable channels",
+	}, {
+		Name:      "zones",
+		ModelUUID: s.modelUUID.String(),
+		Rule:      `operation == "deploy" and constraints.zones is empty`,
+	}, {
+		Name: "private-expose",
+		Rule: `operation == "expose" and expose.cidrs not within ["10.0.0.0/8"]`,
+	}}, nil).Times(2)
+
+	req := internaladmission.NewRequest(internaladmission.Deploy).
+		Set(internaladmission.FieldCharmRisk, "stable").
+		Set(internaladmission.FieldConstraintsZones, "zone-a")
+	err := s.service(c).CheckAdmission(context.Background(), s.modelUUID.String(), req)
+	c.Assert(err, jc.ErrorIsNil)
+
+	req = internaladmission.NewRequest(internaladmission.Deploy).
+		Set(internaladmission.FieldCharmRisk, "edge")
+	err = s.service(c).CheckAdmission(context.Background(), s.modelUUID.String(), req)
+	c.Check(err, jc.ErrorIs, admissionerrors.Denied)
+	c.Check(err, gc.ErrorMatches, `admission denied for deploy by policy "stable-only": charms must come from stable channels; policy "zones"`)
+}
+
+func (s *serviceSuite) TestCheckAdmissionSkipsInvalidRules(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetModelPolicies(gomock.Any(), s.modelUUID.String()).Return([]admission.Policy{{
+		Name: "broken",
+		Rule: `operation ==`,
+	}}, nil)
+
+	req := internaladmission.NewRequest(internaladmission.Deploy)
+	err := s.service(c).CheckAdmission(context.Background(), s.modelUUID.String(), req)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestEvaluatePoliciesWithCandidates(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	stored := admission.Policy{
+		Name: "stable-only",
+		Rule: `operation == "deploy" and charm.risk != "stable"`,
+	}
+	s.state.EXPECT().GetModelPolicies(gomock.Any(), s.modelUUID.String()).Return([]admission.Policy{stored}, nil)
+
+	candidate := admission.Policy{
+		Name: "no-postgresql",
+		Rule: `application == "postgresql"`,
+	}
+	req := internaladmission.NewRequest(internaladmission.Deploy).
+		Set(internaladmission.FieldApplication, "postgresql").
+		Set(internaladmission.FieldCharmRisk, "edge")
+	denied, err := s.service(c).EvaluatePolicies(context.Background(), s.modelUUID.String(), req, []admission.Policy{
+		candidate,
+		{Name: "mysql", Rule: `application == "mysql"`},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(denied, jc.DeepEquals, []admission.Policy{stored, candidate})
+}
+
+func (s *serviceSuite) TestEvaluatePoliciesInvalidCandidate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	req := internaladmission.NewRequest(internaladmission.Deploy)
+	_, err := s.service(c).EvaluatePolicies(context.Background(), s.modelUUID.String(), req, []admission.Policy{{
+		Name: "broken",
+		Rule: `application ==`,
+	}})
+	c.Assert(err, jc.ErrorIs, admissionerrors.PolicyNotValid)
+}
@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/admission/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/admission/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	admission "github.com/juju/juju/domain/admission"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// AddPolicy mocks base method.
+func (m *MockState) AddPolicy(arg0 context.Context, arg1 string, arg2 admission.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPolicy indicates an expected call of AddPolicy.
+func (mr *MockStateMockRecorder) AddPolicy(arg0, arg1, arg2 any) *MockStateAddPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPolicy", reflect.TypeOf((*MockState)(nil).AddPolicy), arg0, arg1, arg2)
+	return &MockStateAddPolicyCall{Call: call}
+}
+
+// MockStateAddPolicyCall wrap *gomock.Call
+type MockStateAddPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddPolicyCall) Return(arg0 error) *MockStateAddPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddPolicyCall) Do(f func(context.Context, string, admission.Policy) error) *MockStateAddPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddPolicyCall) DoAndReturn(f func(context.Context, string, admission.Policy) error) *MockStateAddPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetModelPolicies mocks base method.
+func (m *MockState) GetModelPolicies(arg0 context.Context, arg1 string) ([]admission.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]admission.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelPolicies indicates an expected call of GetModelPolicies.
+func (mr *MockStateMockRecorder) GetModelPolicies(arg0, arg1 any) *MockStateGetModelPoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelPolicies", reflect.TypeOf((*MockState)(nil).GetModelPolicies), arg0, arg1)
+	return &MockStateGetModelPoliciesCall{Call: call}
+}
+
+// MockStateGetModelPoliciesCall wrap *gomock.Call
+type MockStateGetModelPoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetModelPoliciesCall) Return(arg0 []admission.Policy, arg1 error) *MockStateGetModelPoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetModelPoliciesCall) Do(f func(context.Context, string) ([]admission.Policy, error)) *MockStateGetModelPoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetModelPoliciesCall) DoAndReturn(f func(context.Context, string) ([]admission.Policy, error)) *MockStateGetModelPoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPolicies mocks base method.
+func (m *MockState) ListPolicies(arg0 context.Context, arg1 string) ([]admission.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]admission.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockStateMockRecorder) ListPolicies(arg0, arg1 any) *MockStateListPoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockState)(nil).ListPolicies), arg0, arg1)
+	return &MockStateListPoliciesCall{Call: call}
+}
+
+// MockStateListPoliciesCall wrap *gomock.Call
+type MockStateListPoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListPoliciesCall) Return(arg0 []admission.Policy, arg1 error) *MockStateListPoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListPoliciesCall) Do(f func(context.Context, string) ([]admission.Policy, error)) *MockStateListPoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListPoliciesCall) DoAndReturn(f func(context.Context, string) ([]admission.Policy, error)) *MockStateListPoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemovePolicy mocks base method.
+func (m *MockState) RemovePolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePolicy indicates an expected call of RemovePolicy.
+func (mr *MockStateMockRecorder) RemovePolicy(arg0, arg1, arg2 any) *MockStateRemovePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePolicy", reflect.TypeOf((*MockState)(nil).RemovePolicy), arg0, arg1, arg2)
+	return &MockStateRemovePolicyCall{Call: call}
+}
+
+// MockStateRemovePolicyCall wrap *gomock.Call
+type MockStateRemovePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRemovePolicyCall) Return(arg0 error) *MockStateRemovePolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRemovePolicyCall) Do(f func(context.Context, string, string) error) *MockStateRemovePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRemovePolicyCall) DoAndReturn(f func(context.Context, string, string) error) *MockStateRemovePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
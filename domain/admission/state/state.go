@@ -0,0 +1,182 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/admission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	internaldatabase "github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State is used to access the database.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState creates a state to access the database.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// AddPolicy adds the admission policy.
+// The following errors can be returned:
+// - [admissionerrors.PolicyAlreadyExists] if a policy with the same name
+// exists for the model, or the controller.
+// - [admissionerrors.ModelNotFound] if the model of the policy does not
+// exist.
+func (st *State) AddPolicy(ctx context.Context, uuid string, policy admission.Policy) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := admissionPolicy{
+		UUID: uuid,
+		Name: policy.Name,
+		ModelUUID: sql.NullString{
+			String: policy.ModelUUID,
+			Valid:  policy.ModelUUID != "",
+		},
+		Rule: policy.Rule,
+		Message: sql.NullString{
+			String: policy.Message,
+			Valid:  policy.Message != "",
+		},
+		CreatedBy: sql.NullString{
+			String: policy.CreatedBy,
+			Valid:  policy.CreatedBy != "",
+		},
+		CreatedBySuperuser: policy.CreatedBySuperuser,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO admission_policy (*)
+VALUES ($admissionPolicy.*)
+`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, row).Run()
+		if internaldatabase.IsErrConstraintUnique(err) {
+			return errors.Errorf("admission policy %q: %w", policy.Name, admissionerrors.PolicyAlreadyExists)
+		} else if internaldatabase.IsErrConstraintForeignKey(err) {
+			return errors.Errorf("model %q: %w", policy.ModelUUID, admissionerrors.ModelNotFound)
+		} else if err != nil {
+			return errors.Errorf("adding admission policy %q: %w", policy.Name, err)
+		}
+		return nil
+	})
+}
+
+// RemovePolicy removes the admission policy with the name from the model,
+// or from the controller if the model is empty.
+// The following errors can be returned:
+// - [admissionerrors.PolicyNotFound] if the policy does not exist.
+func (st *State) RemovePolicy(ctx context.Context, modelUUID, name string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := policyIdent{ModelUUID: modelUUID, Name: name}
+	stmt, err := st.Prepare(`
+DELETE FROM admission_policy
+WHERE  COALESCE(model_uuid, '') = $policyIdent.model_uuid
+AND    name = $policyIdent.name
+`, ident)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, ident).Get(&outcome); err != nil {
+			return errors.Errorf("removing admission policy %q: %w", name, err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("removing admission policy %q: %w", name, err)
+		} else if affected == 0 {
+			return errors.Errorf("admission policy %q: %w", name, admissionerrors.PolicyNotFound)
+		}
+		return nil
+	})
+}
+
+// ListPolicies returns the admission policies of the model, or of the
+// controller if the model is empty, ordered by name.
+func (st *State) ListPolicies(ctx context.Context, modelUUID string) ([]admission.Policy, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := modelIdent{ModelUUID: modelUUID}
+	stmt, err := st.Prepare(`
+SELECT &admissionPolicy.*
+FROM   admission_policy
+WHERE  COALESCE(model_uuid, '') = $modelIdent.model_uuid
+ORDER BY name
+`, ident, admissionPolicy{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return st.getPolicies(ctx, db, stmt, ident)
+}
+
+// GetModelPolicies returns the admission policies which apply to the model,
+// those of the controller followed by those of the model, ordered by name.
+func (st *State) GetModelPolicies(ctx context.Context, modelUUID string) ([]admission.Policy, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := modelIdent{ModelUUID: modelUUID}
+	stmt, err := st.Prepare(`
+SELECT &admissionPolicy.*
+FROM   admission_policy
+WHERE  model_uuid IS NULL
+OR     model_uuid = $modelIdent.model_uuid
+ORDER BY model_uuid IS NOT NULL, name
+`, ident, admissionPolicy{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return st.getPolicies(ctx, db, stmt, ident)
+}
+
+func (st *State) getPolicies(
+	ctx context.Context, db domain.TxnRunner, stmt *sqlair.Statement, ident modelIdent,
+) ([]admission.Policy, error) {
+	var rows []admissionPolicy
+	err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting admission policies: %w", err)
+	}
+
+	policies := make([]admission.Policy, len(rows))
+	for i, row := range rows {
+		policies[i] = row.toPolicy()
+	}
+	return policies, nil
+}
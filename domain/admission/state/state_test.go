@@ -0,0 +1,142 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/admission"
+	admissionerrors "github.com/juju/juju/domain/admission/errors"
+	modelstatetesting "github.com/juju/juju/domain/model/state/testing"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+
+	state *State
+
+	modelUUID string
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory())
+	s.modelUUID = modelstatetesting.CreateTestModel(c, s.TxnRunnerFactory(), "production").String()
+}
+
+func (s *stateSuite) addPolicy(c *gc.C, policy admission.Policy) {
+	err := s.state.AddPolicy(context.Background(), uuid.MustNewUUID().String(), policy)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestAddAndListPolicies(c *gc.C) {
+	s.addPolicy(c, admission.Policy{
+		Name:               "stable-only",
+		Rule:               `operation == "deploy" and charm.risk != "stable"`,
+		Message:            "charms must come from stable channels",
+		CreatedBy:          "admin",
+		CreatedBySuperuser: true,
+	})
+	s.addPolicy(c, admission.Policy{
+		Name:      "private-expose",
+		ModelUUID: s.modelUUID,
+		Rule:      `expose.cidrs not within ["10.0.0.0/8"]`,
+		CreatedBy: "bob",
+	})
+	s.addPolicy(c, admission.Policy{
+		Name:      "availability-zones",
+		ModelUUID: s.modelUUID,
+		Rule:      `constraints.zones is empty`,
+	})
+
+	policies, err := s.state.ListPolicies(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(policies, jc.DeepEquals, []admission.Policy{{
+		Name:               "stable-only",
+		Rule:               `operation == "deploy" and charm.risk != "stable"`,
+		Message:            "charms must come from stable channels",
+		CreatedBy:          "admin",
+		CreatedBySuperuser: true,
+	}})
+
+	policies, err = s.state.ListPolicies(context.Background(), s.modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(policies, jc.DeepEquals, []admission.Policy{{
+		Name:      "availability-zones",
+		ModelUUID: s.modelUUID,
+		Rule:      `constraints.zones is empty`,
+	}, {
+		Name:      "private-expose",
+		ModelUUID: s.modelUUID,
+		Rule:      `expose.cidrs not within ["10.0.0.0/8"]`,
+		CreatedBy: "bob",
+	}})
+}
+
+func (s *stateSuite) TestGetModelPolicies(c *gc.C) {
+	otherModelUUID := modelstatetesting.CreateTestModel(c, s.TxnRunnerFactory(), "staging").String()
+	s.addPolicy(c, admission.Policy{Name: "zones", ModelUUID: s.modelUUID, Rule: `constraints.zones is empty`})
+	s.addPolicy(c, admission.Policy{Name: "zones", ModelUUID: otherModelUUID, Rule: `constraints.zones is set`})
+	s.addPolicy(c, admission.Policy{Name: "zones", Rule: `application == "foo"`})
+	s.addPolicy(c, admission.Policy{Name: "stable-only", Rule: `charm.risk != "stable"`})
+
+	policies, err := s.state.GetModelPolicies(context.Background(), s.modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(policies, jc.DeepEquals, []admission.Policy{
+		{Name: "stable-only", Rule: `charm.risk != "stable"`},
+		{Name: "zones", Rule: `application == "foo"`},
+		{Name: "zones", ModelUUID: s.modelUUID, Rule: `constraints.zones is empty`},
+	})
+}
+
+func (s *stateSuite) TestAddPolicyAlreadyExists(c *gc.C) {
+	s.addPolicy(c, admission.Policy{Name: "zones", Rule: `constraints.zones is empty`})
+	s.addPolicy(c, admission.Policy{Name: "zones", ModelUUID: s.modelUUID, Rule: `constraints.zones is empty`})
+
+	err := s.state.AddPolicy(context.Background(), uuid.MustNewUUID().String(), admission.Policy{
+		Name: "zones",
+		Rule: `constraints.zones is set`,
+	})
+	c.Check(err, jc.ErrorIs, admissionerrors.PolicyAlreadyExists)
+
+	err = s.state.AddPolicy(context.Background(), uuid.MustNewUUID().String(), admission.Policy{
+		Name:      "zones",
+		ModelUUID: s.modelUUID,
+		Rule:      `constraints.zones is set`,
+	})
+	c.Check(err, jc.ErrorIs, admissionerrors.PolicyAlreadyExists)
+}
+
+func (s *stateSuite) TestAddPolicyModelNotFound(c *gc.C) {
+	err := s.state.AddPolicy(context.Background(), uuid.MustNewUUID().String(), admission.Policy{
+		Name:      "zones",
+		ModelUUID: uuid.MustNewUUID().String(),
+		Rule:      `constraints.zones is empty`,
+	})
+	c.Check(err, jc.ErrorIs, admissionerrors.ModelNotFound)
+}
+
+func (s *stateSuite) TestRemovePolicy(c *gc.C) {
+	s.addPolicy(c, admission.Policy{Name: "zones", Rule: `constraints.zones is empty`})
+	s.addPolicy(c, admission.Policy{Name: "zones", ModelUUID: s.modelUUID, Rule: `constraints.zones is empty`})
+
+	err := s.state.RemovePolicy(context.Background(), s.modelUUID, "zones")
+	c.Assert(err, jc.ErrorIsNil)
+
+	policies, err := s.state.GetModelPolicies(context.Background(), s.modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(policies, jc.DeepEquals, []admission.Policy{
+		{Name: "zones", Rule: `constraints.zones is empty`},
+	})
+
+	err = s.state.RemovePolicy(context.Background(), s.modelUUID, "zones")
+	c.Check(err, jc.ErrorIs, admissionerrors.PolicyNotFound)
+}
@@ -0,0 +1,46 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+
+	"github.com/juju/juju/domain/admission"
+)
+
+// admissionPolicy represents a row of the admission_policy table.
+type admissionPolicy struct {
+	UUID      string         `db:"uuid"`
+	Name      string         `db:"name"`
+	ModelUUID sql.NullString `db:"model_uuid"`
+	Rule      string         `db:"rule"`
+	Message   sql.NullString `db:"message"`
+	CreatedBy sql.NullString `db:"created_by"`
+	// CreatedBySuperuser is true for policies only superusers may remove.
+	CreatedBySuperuser bool `db:"created_by_superuser"`
+}
+
+func (p admissionPolicy) toPolicy() admission.Policy {
+	return admission.Policy{
+		Name:      p.Name,
+		ModelUUID: p.ModelUUID.String,
+		Rule:      p.Rule,
+		Message:   p.Message.String,
+
+		CreatedBy:          p.CreatedBy.String,
+		CreatedBySuperuser: p.CreatedBySuperuser,
+	}
+}
+
+// policyIdent identifies a policy by its model and name. The model is empty
+// for policies of the controller.
+type policyIdent struct {
+	ModelUUID string `db:"model_uuid"`
+	Name      string `db:"name"`
+}
+
+// modelIdent identifies a model.
+type modelIdent struct {
+	ModelUUID string `db:"model_uuid"`
+}
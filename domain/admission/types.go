@@ -0,0 +1,25 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission
+
+// Policy is an admission policy, which denies the model-changing operations
+// described by its rule.
+type Policy struct {
+	// Name is the name of the policy, which is unique within the model or,
+	// for policies without a model, the controller.
+	Name string
+	// ModelUUID is the model the policy applies to, or empty if the policy
+	// applies to every model.
+	ModelUUID string
+	// Rule describes the operations which the policy denies, in the rules
+	// language of the internal/admission package.
+	Rule string
+	// Message explains to users why an operation was denied.
+	Message string
+	// CreatedBy is the name of the user who added the policy.
+	CreatedBy string
+	// CreatedBySuperuser reports whether the policy was added by a
+	// controller superuser, in which case only superusers may remove it.
+	CreatedBySuperuser bool
+}
@@ -304,6 +304,8 @@ func (d DownloadInfo) Validate() error {
 //     set of structs.
 //   - RunAs default value is marshalled as "default" and not as an empty
 //     string.
+//   - DeploymentType default value is marshalled as "default" and not as an
+//     empty string.
 type Metadata struct {
 	Name           string
 	Summary        string
@@ -323,6 +325,7 @@ type Metadata struct {
 	Containers     map[string]Container
 	Assumes        []byte
 	RunAs          RunAs
+	DeploymentType DeploymentType
 }
 
 // RunAs defines which user to run a certain process as.
@@ -335,6 +338,17 @@ const (
 	RunAsNonRoot RunAs = "non-root"
 )
 
+// DeploymentType defines the kind of workload resource used
+// to run the units of a charm on kubernetes.
+type DeploymentType string
+
+const (
+	DeploymentDefault   DeploymentType = "default"
+	DeploymentStateful  DeploymentType = "stateful"
+	DeploymentStateless DeploymentType = "stateless"
+	DeploymentDaemon    DeploymentType = "daemon"
+)
+
 // RelationRole defines the role of a relation.
 type RelationRole string
 
@@ -38,6 +38,22 @@ const (
 	// application scale value.
 	ScaleChangeInvalid = errors.ConstError("scale change invalid")
 
+	// AutoscalePolicyNotFound describes an error that occurs when the
+	// application has no autoscale policy.
+	AutoscalePolicyNotFound = errors.ConstError("autoscale policy not found")
+
+	// AutoscalePolicyNotValid describes an error that occurs when an
+	// autoscale policy is not valid.
+	AutoscalePolicyNotValid = errors.ConstError("autoscale policy not valid")
+
+	// FirewallRuleNotFound describes an error that occurs when the
+	// application has no firewall rule for an endpoint and port range.
+	FirewallRuleNotFound = errors.ConstError("firewall rule not found")
+
+	// FirewallRuleNotValid describes an error that occurs when a firewall
+	// rule is not valid.
+	FirewallRuleNotValid = errors.ConstError("firewall rule not valid")
+
 	// MissingStorageDirective describes an error that occurs when expected
 	// storage directives are missing.
 	MissingStorageDirective = errors.ConstError("no storage directive specified")
@@ -222,6 +238,11 @@ const (
 	// config is not valid.
 	InvalidApplicationConfig = errors.ConstError("invalid application config")
 
+	// DeploymentTypeNotChangeable describes an error that occurs when the
+	// deployment type of an application is changed after its kubernetes
+	// workload has been created.
+	DeploymentTypeNotChangeable = errors.ConstError("deployment type cannot be changed once the workload exists")
+
 	// ApplicationHasDifferentCharm describes an error that occurs when the
 	// application has a different charm.
 	ApplicationHasDifferentCharm = errors.ConstError("application has different charm")
@@ -260,6 +281,10 @@ const (
 	// NetNodeNotFound describes an error that occurs when the net node being
 	// operated on does not exist.
 	NetNodeNotFound = errors.ConstError("net node not found")
+
+	// WarmPoolMachineNotFound describes an error that occurs when the warm
+	// pool has no machine for the unit being assigned.
+	WarmPoolMachineNotFound = errors.ConstError("warm pool machine not found")
 )
 
 const (
@@ -0,0 +1,66 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/collections/set"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+)
+
+// FirewallRule allows ingress to the ports of an application endpoint from
+// a set of sources, independently of whether the application is exposed.
+type FirewallRule struct {
+	// Endpoint is the name of the endpoint the rule applies to. The
+	// wildcard endpoint ("") applies the rule to all endpoints.
+	Endpoint string
+	// PortRange restricts the rule to a port range. If nil, the rule
+	// applies to the ports opened by the application's units on the
+	// endpoint.
+	PortRange *network.PortRange
+	// CIDRs is the set of CIDRs that are allowed ingress.
+	CIDRs set.Strings
+	// CIDRSets is the set of names of CIDR sets whose members are allowed
+	// ingress.
+	CIDRSets set.Strings
+	// SourceCIDRs is the effective set of CIDRs that are allowed ingress,
+	// made up of CIDRs and the members of CIDRSets. It is populated when
+	// reading rules and is ignored when setting them.
+	SourceCIDRs set.Strings
+}
+
+// IngressRules returns the ingress rules that the firewall rule requires,
+// given the port ranges opened by a unit of the application, grouped by
+// endpoint.
+//
+// A rule with a port range always results in a single ingress rule. A rule
+// for the wildcard endpoint without a port range applies to all the opened
+// ports, while a rule for a named endpoint applies to the ports opened for
+// that endpoint and for all endpoints.
+func (r FirewallRule) IngressRules(openedPorts network.GroupedPortRanges) firewall.IngressRules {
+	if r.SourceCIDRs.Size() == 0 {
+		return nil
+	}
+	cidrs := r.SourceCIDRs.SortedValues()
+
+	if r.PortRange != nil {
+		return firewall.IngressRules{firewall.NewIngressRule(*r.PortRange, cidrs...)}
+	}
+
+	var rules firewall.IngressRules
+	if r.Endpoint == network.WildcardEndpoint {
+		for _, portRange := range openedPorts.UniquePortRanges() {
+			rules = append(rules, firewall.NewIngressRule(portRange, cidrs...))
+		}
+		return rules
+	}
+	for _, portRange := range openedPorts[r.Endpoint] {
+		rules = append(rules, firewall.NewIngressRule(portRange, cidrs...))
+	}
+	for _, portRange := range openedPorts[network.WildcardEndpoint] {
+		rules = append(rules, firewall.NewIngressRule(portRange, cidrs...))
+	}
+	return rules
+}
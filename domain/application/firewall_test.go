@@ -0,0 +1,68 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"github.com/juju/collections/set"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+)
+
+type firewallRuleSuite struct{}
+
+var _ = gc.Suite(&firewallRuleSuite{})
+
+var openedPorts = network.GroupedPortRanges{
+	"":      {network.MustParsePortRange("22/tcp")},
+	"web":   {network.MustParsePortRange("80/tcp"), network.MustParsePortRange("443/tcp")},
+	"admin": {network.MustParsePortRange("8080/tcp")},
+}
+
+func (s *firewallRuleSuite) TestIngressRulesNoSources(c *gc.C) {
+	rule := FirewallRule{}
+	c.Check(rule.IngressRules(openedPorts), gc.HasLen, 0)
+}
+
+func (s *firewallRuleSuite) TestIngressRulesPortRange(c *gc.C) {
+	portRange := network.MustParsePortRange("5432/tcp")
+	rule := FirewallRule{
+		Endpoint:    "web",
+		PortRange:   &portRange,
+		SourceCIDRs: set.NewStrings("10.0.0.0/8", "192.168.0.0/16"),
+	}
+	c.Check(rule.IngressRules(openedPorts), jc.DeepEquals, firewall.IngressRules{
+		firewall.NewIngressRule(portRange, "10.0.0.0/8", "192.168.0.0/16"),
+	})
+}
+
+func (s *firewallRuleSuite) TestIngressRulesWildcardEndpoint(c *gc.C) {
+	rule := FirewallRule{
+		SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+	}
+	rules := rule.IngressRules(openedPorts)
+	rules.Sort()
+	c.Check(rules, jc.DeepEquals, firewall.IngressRules{
+		firewall.NewIngressRule(network.MustParsePortRange("22/tcp"), "10.0.0.0/8"),
+		firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), "10.0.0.0/8"),
+		firewall.NewIngressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+		firewall.NewIngressRule(network.MustParsePortRange("8080/tcp"), "10.0.0.0/8"),
+	})
+}
+
+func (s *firewallRuleSuite) TestIngressRulesNamedEndpoint(c *gc.C) {
+	rule := FirewallRule{
+		Endpoint:    "web",
+		SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+	}
+	rules := rule.IngressRules(openedPorts)
+	rules.Sort()
+	c.Check(rules, jc.DeepEquals, firewall.IngressRules{
+		firewall.NewIngressRule(network.MustParsePortRange("22/tcp"), "10.0.0.0/8"),
+		firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), "10.0.0.0/8"),
+		firewall.NewIngressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8"),
+	})
+}
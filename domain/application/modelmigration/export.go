@@ -192,9 +192,13 @@ func (e *exportOperation) Execute(ctx context.Context, m description.Model) erro
 		// config is the application config overlaid from the charm config. The
 		// application config, is the application settings.
 		descriptionApp.SetCharmConfig(config)
-		descriptionApp.SetApplicationConfig(map[string]any{
+		appSettings := map[string]any{
 			coreapplication.TrustConfigOptionName: settings.Trust,
-		})
+		}
+		if settings.DeploymentType != "" {
+			appSettings[coreapplication.KubernetesDeploymentTypeConfigOptionName] = string(settings.DeploymentType)
+		}
+		descriptionApp.SetApplicationConfig(appSettings)
 
 		charm, _, err := e.service.GetCharmByApplicationName(ctx, app.Name)
 		if err != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/juju/juju/core/semversion"
 	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/application/service"
 	"github.com/juju/juju/domain/application/state"
 	internalcharm "github.com/juju/juju/internal/charm"
@@ -292,8 +293,13 @@ func (i *importOperation) importApplicationSettings(app description.Application)
 	appSettings := app.ApplicationConfig()
 	if len(appSettings) == 0 {
 		return application.ApplicationSettings{}, nil
-	} else if len(appSettings) > 1 {
-		return application.ApplicationSettings{}, errors.Errorf("application %q has multiple settings, expected only one", app.Name())
+	}
+	for key := range appSettings {
+		switch key {
+		case coreapplication.TrustConfigOptionName, coreapplication.KubernetesDeploymentTypeConfigOptionName:
+		default:
+			return application.ApplicationSettings{}, errors.Errorf("application %q has unexpected setting %q", app.Name(), key)
+		}
 	}
 
 	var trust bool
@@ -311,8 +317,18 @@ func (i *importOperation) importApplicationSettings(app description.Application)
 		return application.ApplicationSettings{}, errors.Errorf("trust value %q is not a boolean", trustValue)
 	}
 
+	var deploymentType applicationcharm.DeploymentType
+	if value, ok := appSettings[coreapplication.KubernetesDeploymentTypeConfigOptionName]; ok {
+		t, ok := value.(string)
+		if !ok {
+			return application.ApplicationSettings{}, errors.Errorf("deployment type value %q is not a string", value)
+		}
+		deploymentType = applicationcharm.DeploymentType(t)
+	}
+
 	return application.ApplicationSettings{
-		Trust: trust,
+		Trust:          trust,
+		DeploymentType: deploymentType,
 	}, nil
 }
 
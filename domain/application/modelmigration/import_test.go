@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/application/service"
 	internalcharm "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charm/assumes"
@@ -269,7 +270,8 @@ func (s *importSuite) TestApplicationImportWithApplicationConfigAndSettings(c *g
 			"foo": "bar",
 		},
 		ApplicationConfig: map[string]interface{}{
-			"trust": true,
+			"trust":                      true,
+			"kubernetes-deployment-type": "daemon",
 		},
 	}
 	app := model.AddApplication(appArgs)
@@ -320,7 +322,8 @@ func (s *importSuite) TestApplicationImportWithApplicationConfigAndSettings(c *g
 		"foo": "bar",
 	})
 	c.Check(importArgs.ApplicationSettings, jc.DeepEquals, application.ApplicationSettings{
-		Trust: true,
+		Trust:          true,
+		DeploymentType: applicationcharm.DeploymentDaemon,
 	})
 }
 
@@ -113,6 +113,24 @@ type ApplicationState interface {
 	// [applicationerrors.ScaleChangeInvalid] is returned.
 	UpdateApplicationScale(ctx context.Context, appUUID coreapplication.ID, delta int) (int, error)
 
+	// SetApplicationAutoscalePolicy sets the autoscale policy of the specified
+	// application, replacing any existing policy.
+	SetApplicationAutoscalePolicy(context.Context, coreapplication.ID, application.AutoscalePolicy) error
+
+	// GetApplicationAutoscalePolicy returns the autoscale policy of the
+	// specified application. If the application has no policy, an error
+	// satisfying [applicationerrors.AutoscalePolicyNotFound] is returned.
+	GetApplicationAutoscalePolicy(context.Context, coreapplication.ID) (application.AutoscalePolicy, error)
+
+	// RemoveApplicationAutoscalePolicy removes the autoscale policy of the
+	// specified application. If the application has no policy, an error
+	// satisfying [applicationerrors.AutoscalePolicyNotFound] is returned.
+	RemoveApplicationAutoscalePolicy(context.Context, coreapplication.ID) error
+
+	// GetAutoscalePolicies returns the autoscale policies of all alive
+	// applications in the model, along with their current scale.
+	GetAutoscalePolicies(context.Context) ([]application.ApplicationAutoscalePolicy, error)
+
 	// DeleteApplication deletes the specified application, returning an error
 	// satisfying [applicationerrors.ApplicationNotFoundError] if the
 	// application doesn't exist. If the application still has units, as error
@@ -341,6 +359,34 @@ type ApplicationState interface {
 	// of the provided spaces do not exist.
 	SpacesExist(ctx context.Context, spaceUUIDs set.Strings) error
 
+	// GetFirewallRules returns the firewall rules of the provided
+	// application.
+	GetFirewallRules(ctx context.Context, appID coreapplication.ID) ([]application.FirewallRule, error)
+
+	// GetAllFirewallRules returns the firewall rules of all applications in
+	// the model, keyed on application name.
+	GetAllFirewallRules(ctx context.Context) (map[string][]application.FirewallRule, error)
+
+	// SetFirewallRule adds the provided firewall rule to the application,
+	// replacing any existing rule for the same endpoint and port range.
+	//
+	// If any of the referenced CIDR sets do not exist, an error satisfying
+	// [networkerrors.CIDRSetNotFound] is returned.
+	SetFirewallRule(ctx context.Context, appID coreapplication.ID, rule application.FirewallRule) error
+
+	// DeleteFirewallRule removes the firewall rule of the application for the
+	// provided endpoint and port range.
+	//
+	// If no such rule exists, an error satisfying
+	// [applicationerrors.FirewallRuleNotFound] is returned.
+	DeleteFirewallRule(ctx context.Context, appID coreapplication.ID, endpoint string, portRange *network.PortRange) error
+
+	// NamespaceForWatchFirewallRules returns the namespace identifiers for
+	// firewall rule changes. The first return value is the namespace for the
+	// application firewall rule table, and the second is the namespace for
+	// the CIDRs of CIDR sets.
+	NamespaceForWatchFirewallRules() (string, string)
+
 	// GetDeviceConstraints returns the device constraints for an application.
 	GetDeviceConstraints(ctx context.Context, appID coreapplication.ID) (map[string]devices.Constraints, error)
 }
@@ -563,6 +609,10 @@ func makeCreateApplicationArgs(
 		return application.AddApplicationArg{}, errors.Errorf("encoding application status: %w", err)
 	}
 
+	if err := validateDeploymentTypeSetting(args.ApplicationSettings.DeploymentType); err != nil {
+		return application.AddApplicationArg{}, errors.Capture(err)
+	}
+
 	return application.AddApplicationArg{
 		Charm:             ch,
 		CharmDownloadInfo: args.DownloadInfo,
@@ -846,6 +896,9 @@ func (s *Service) SetApplicationScale(ctx context.Context, appName string, scale
 	s.logger.Tracef(ctx,
 		"SetScale DesiredScale %v -> %v", appScale.Scale, scale,
 	)
+	if err := s.checkDisruptionBudget(ctx, appID, appScale.Scale, scale); err != nil {
+		return errors.Errorf("setting scale for application %q: %w", appName, err)
+	}
 	err = s.st.SetDesiredApplicationScale(ctx, appID, scale)
 	if err != nil {
 		return errors.Errorf("setting scale for application %q: %w", appName, err)
@@ -878,6 +931,16 @@ func (s *Service) ChangeApplicationScale(ctx context.Context, appName string, sc
 		return -1, errors.Capture(err)
 	}
 
+	if scaleChange < 0 {
+		appScale, err := s.st.GetApplicationScaleState(ctx, appID)
+		if err != nil {
+			return -1, errors.Errorf("getting application scale state for app %q: %w", appID, err)
+		}
+		if err := s.checkDisruptionBudget(ctx, appID, appScale.Scale, appScale.Scale+scaleChange); err != nil {
+			return -1, errors.Errorf("changing scale for application %q: %w", appName, err)
+		}
+	}
+
 	newScale, err := s.st.UpdateApplicationScale(ctx, appID, scaleChange)
 	if err != nil {
 		return -1, errors.Errorf("changing scaling state for %q: %w", appName, err)
@@ -885,6 +948,34 @@ func (s *Service) ChangeApplicationScale(ctx context.Context, appName string, sc
 	return newScale, nil
 }
 
+// checkDisruptionBudget returns an error satisfying
+// [applicationerrors.ScaleChangeInvalid] if scaling the application down from
+// the current scale to the specified scale would leave fewer units than the
+// disruption budget in its constraints requires to be available. Scaling to
+// zero is always allowed, so that the application can be removed.
+func (s *Service) checkDisruptionBudget(ctx context.Context, appID coreapplication.ID, current, scale int) error {
+	if scale <= 0 || scale >= current {
+		return nil
+	}
+	cons, err := s.st.GetApplicationConstraints(ctx, appID)
+	if err != nil {
+		return errors.Errorf("getting application constraints: %w", err)
+	}
+	budget, err := caas.ApplicationDisruptionBudget(constraints.EncodeConstraints(cons))
+	if err != nil {
+		return errors.Errorf("getting disruption budget: %w", err)
+	}
+	if budget == nil {
+		return nil
+	}
+	if minAvailable := budget.MinAvailableUnits(current); scale < minAvailable {
+		return errors.Errorf(
+			"scale %d is below the %d units which must remain available", scale, minAvailable,
+		).Add(applicationerrors.ScaleChangeInvalid)
+	}
+	return nil
+}
+
 // SetApplicationScalingState updates the scale state of an application, returning an error
 // satisfying [applicationerrors.ApplicationNotFoundError] if the application doesn't exist.
 // This is used on CAAS models.
@@ -913,6 +1004,106 @@ func (s *Service) GetApplicationScalingState(ctx context.Context, appName string
 	}, nil
 }
 
+// SetApplicationAutoscalePolicy sets the autoscale policy of the specified
+// application, replacing any existing policy. The autoscaler keeps the scale
+// of the application between the policy's minimum and maximum units, aiming
+// for the configured metric targets. This is used on CAAS models.
+//
+// If the policy is not valid, an error satisfying
+// [applicationerrors.AutoscalePolicyNotValid] is returned. If the
+// application's charm is deployed as a daemonset, which can't be scaled, an
+// error satisfying [coreerrors.NotSupported] is returned.
+func (s *Service) SetApplicationAutoscalePolicy(ctx context.Context, appName string, policy application.AutoscalePolicy) error {
+	if err := validateAutoscalePolicy(policy); err != nil {
+		return errors.Capture(err)
+	}
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	charmID, err := s.st.GetCharmIDByApplicationName(ctx, appName)
+	if err != nil {
+		return errors.Errorf("getting charm for application %q: %w", appName, err)
+	}
+	metadata, err := s.st.GetCharmMetadata(ctx, charmID)
+	if err != nil {
+		return errors.Errorf("getting charm metadata for application %q: %w", appName, err)
+	}
+	if metadata.DeploymentType == charm.DeploymentDaemon {
+		return errors.Errorf("autoscaling daemon application %q %w", appName, coreerrors.NotSupported)
+	}
+	if err := s.st.SetApplicationAutoscalePolicy(ctx, appID, policy); err != nil {
+		return errors.Errorf("setting autoscale policy for %q: %w", appName, err)
+	}
+	return nil
+}
+
+// GetApplicationAutoscalePolicy returns the autoscale policy of the specified
+// application. If the application doesn't exist, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned. If the application has
+// no policy, an error satisfying [applicationerrors.AutoscalePolicyNotFound]
+// is returned.
+func (s *Service) GetApplicationAutoscalePolicy(ctx context.Context, appName string) (application.AutoscalePolicy, error) {
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return application.AutoscalePolicy{}, errors.Capture(err)
+	}
+	policy, err := s.st.GetApplicationAutoscalePolicy(ctx, appID)
+	if err != nil {
+		return application.AutoscalePolicy{}, errors.Errorf("getting autoscale policy for %q: %w", appName, err)
+	}
+	return policy, nil
+}
+
+// RemoveApplicationAutoscalePolicy removes the autoscale policy of the
+// specified application, leaving its scale as it is. If the application
+// doesn't exist, an error satisfying [applicationerrors.ApplicationNotFound]
+// is returned. If the application has no policy, an error satisfying
+// [applicationerrors.AutoscalePolicyNotFound] is returned.
+func (s *Service) RemoveApplicationAutoscalePolicy(ctx context.Context, appName string) error {
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.st.RemoveApplicationAutoscalePolicy(ctx, appID); err != nil {
+		return errors.Errorf("removing autoscale policy for %q: %w", appName, err)
+	}
+	return nil
+}
+
+// GetAutoscalePolicies returns the autoscale policies of all alive
+// applications in the model, along with their current scale.
+func (s *Service) GetAutoscalePolicies(ctx context.Context) ([]application.ApplicationAutoscalePolicy, error) {
+	policies, err := s.st.GetAutoscalePolicies(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return policies, nil
+}
+
+func validateAutoscalePolicy(policy application.AutoscalePolicy) error {
+	if policy.MinUnits < 0 {
+		return errors.Errorf("minimum units %d must not be negative", policy.MinUnits).Add(applicationerrors.AutoscalePolicyNotValid)
+	}
+	if policy.MaxUnits <= 0 || policy.MaxUnits < policy.MinUnits {
+		return errors.Errorf("maximum units %d must be positive and at least the minimum units %d",
+			policy.MaxUnits, policy.MinUnits).Add(applicationerrors.AutoscalePolicyNotValid)
+	}
+	if policy.TargetCPUPercent < 0 || policy.TargetMemoryPercent < 0 {
+		return errors.Errorf("target utilisation must not be negative").Add(applicationerrors.AutoscalePolicyNotValid)
+	}
+	if policy.CustomMetric == "" && policy.CustomMetricTarget != 0 {
+		return errors.Errorf("custom metric target set without a custom metric").Add(applicationerrors.AutoscalePolicyNotValid)
+	}
+	if policy.CustomMetric != "" && policy.CustomMetricTarget <= 0 {
+		return errors.Errorf("custom metric %q target must be positive", policy.CustomMetric).Add(applicationerrors.AutoscalePolicyNotValid)
+	}
+	if policy.TargetCPUPercent == 0 && policy.TargetMemoryPercent == 0 && policy.CustomMetric == "" {
+		return errors.Errorf("at least one target must be set").Add(applicationerrors.AutoscalePolicyNotValid)
+	}
+	return nil
+}
+
 // GetApplicationsWithPendingCharmsFromUUIDs returns the application UUIDs that
 // have pending charms from the provided UUIDs. If there are no applications
 // with pending status charms, then those applications are ignored.
@@ -1088,9 +1279,43 @@ func (s *Service) GetApplicationConfig(ctx context.Context, appID coreapplicatio
 	// Always return the trust setting, as it's a special case.
 	result[coreapplication.TrustConfigOptionName] = settings.Trust
 
+	// The deployment type is only returned when it overrides the charm.
+	if settings.DeploymentType != "" {
+		result[coreapplication.KubernetesDeploymentTypeConfigOptionName] = string(settings.DeploymentType)
+	}
+
 	return result, nil
 }
 
+// GetApplicationDeploymentType returns the deployment type of the named
+// application. The deployment type set in the application config takes
+// precedence over the one declared by the charm.
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetApplicationDeploymentType(ctx context.Context, appName string) (charm.DeploymentType, error) {
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	_, settings, err := s.st.GetApplicationConfigAndSettings(ctx, appID)
+	if err != nil {
+		return "", errors.Errorf("getting settings for application %q: %w", appName, err)
+	}
+	if settings.DeploymentType != "" {
+		return settings.DeploymentType, nil
+	}
+
+	charmID, err := s.st.GetCharmIDByApplicationName(ctx, appName)
+	if err != nil {
+		return "", errors.Errorf("getting charm for application %q: %w", appName, err)
+	}
+	metadata, err := s.st.GetCharmMetadata(ctx, charmID)
+	if err != nil {
+		return "", errors.Errorf("getting charm metadata for application %q: %w", appName, err)
+	}
+	return metadata.DeploymentType, nil
+}
+
 // GetApplicationTrustSetting returns the application trust setting.
 // If no application is found, an error satisfying
 // [applicationerrors.ApplicationNotFound] is returned.
@@ -1150,6 +1375,7 @@ func (s *Service) GetApplicationAndCharmConfig(ctx context.Context, appID coreap
 		CharmConfig:       decodedCharmConfig,
 		ApplicationConfig: result,
 		Trust:             settings.Trust,
+		DeploymentType:    settings.DeploymentType,
 		Principal:         !subordinate,
 	}, nil
 }
@@ -1201,11 +1427,16 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appID coreapplica
 		return errors.Capture(err)
 	}
 
-	// Grab the application settings, which is currently just the trust setting.
+	// Grab the application settings, which are the trust and deployment type
+	// settings.
 	trust, err := getTrustSettingFromConfig(newConfig)
 	if err != nil {
 		return errors.Capture(err)
 	}
+	deploymentType, err := getDeploymentTypeSettingFromConfig(newConfig)
+	if err != nil {
+		return errors.Capture(err)
+	}
 
 	// Everything else from the newConfig is just application config. Treat it
 	// as such.
@@ -1245,7 +1476,8 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appID coreapplica
 	}
 
 	return s.st.UpdateApplicationConfigAndSettings(ctx, appID, encodedConfig, application.UpdateApplicationSettingsArg{
-		Trust: trust,
+		Trust:          trust,
+		DeploymentType: deploymentType,
 	})
 }
 
@@ -1295,6 +1527,40 @@ func getTrustSettingFromConfig(cfg map[string]string) (*bool, error) {
 	return &b, nil
 }
 
+func getDeploymentTypeSettingFromConfig(cfg map[string]string) (*charm.DeploymentType, error) {
+	value, ok := cfg[coreapplication.KubernetesDeploymentTypeConfigOptionName]
+	if !ok {
+		// The deployment type is not included, so we should not update it.
+		return nil, nil
+	}
+	delete(cfg, coreapplication.KubernetesDeploymentTypeConfigOptionName)
+
+	// An empty value resets the deployment type to the one declared by the
+	// charm.
+	deploymentType := charm.DeploymentType(value)
+	if err := validateDeploymentTypeSetting(deploymentType); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &deploymentType, nil
+}
+
+// validateDeploymentTypeSetting checks that the deployment type is one that
+// can override the charm's deployment type. The empty value defers to the
+// charm.
+func validateDeploymentTypeSetting(deploymentType charm.DeploymentType) error {
+	switch deploymentType {
+	case "", charm.DeploymentStateful, charm.DeploymentStateless, charm.DeploymentDaemon:
+		return nil
+	default:
+		return errors.Errorf(
+			"%w: %s %q not valid, expected one of %q, %q or %q",
+			applicationerrors.InvalidApplicationConfig,
+			coreapplication.KubernetesDeploymentTypeConfigOptionName, deploymentType,
+			charm.DeploymentStateful, charm.DeploymentStateless, charm.DeploymentDaemon,
+		)
+	}
+}
+
 func encodeApplicationConfig(cfg config.ConfigAttributes, charmConfig charm.Config) (map[string]application.ApplicationConfig, error) {
 	// If there is no config, then we can just return nil.
 	if len(cfg) == 0 {
@@ -474,6 +474,24 @@ func (s *applicationServiceSuite) TestGetApplicationConfigNoConfigWithTrust(c *g
 	})
 }
 
+func (s *applicationServiceSuite) TestGetApplicationConfigWithDeploymentType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetApplicationConfigAndSettings(gomock.Any(), appUUID).
+		Return(map[string]application.ApplicationConfig{}, application.ApplicationSettings{
+			DeploymentType: applicationcharm.DeploymentDaemon,
+		}, nil)
+
+	results, err := s.service.GetApplicationConfig(context.Background(), appUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, gc.DeepEquals, config.ConfigAttributes{
+		"trust":                      false,
+		"kubernetes-deployment-type": "daemon",
+	})
+}
+
 func (s *applicationServiceSuite) TestGetApplicationConfigInvalidApplicationID(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -687,6 +705,92 @@ func (s *applicationServiceSuite) TestUpdateApplicationConfigInvalidTrustType(c
 	c.Assert(err, gc.ErrorMatches, `.*parsing trust setting.*`)
 }
 
+func (s *applicationServiceSuite) TestUpdateApplicationConfigDeploymentType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{},
+	}, nil)
+	s.state.EXPECT().UpdateApplicationConfigAndSettings(gomock.Any(), appUUID, map[string]application.ApplicationConfig{}, application.UpdateApplicationSettingsArg{
+		DeploymentType: ptr(applicationcharm.DeploymentStateless),
+	}).Return(nil)
+
+	err := s.service.UpdateApplicationConfig(context.Background(), appUUID, map[string]string{
+		"kubernetes-deployment-type": "stateless",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigResetDeploymentType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{},
+	}, nil)
+	s.state.EXPECT().UpdateApplicationConfigAndSettings(gomock.Any(), appUUID, map[string]application.ApplicationConfig{}, application.UpdateApplicationSettingsArg{
+		DeploymentType: ptr(applicationcharm.DeploymentType("")),
+	}).Return(nil)
+
+	err := s.service.UpdateApplicationConfig(context.Background(), appUUID, map[string]string{
+		"kubernetes-deployment-type": "",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigInvalidDeploymentType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{},
+	}, nil)
+
+	err := s.service.UpdateApplicationConfig(context.Background(), appUUID, map[string]string{
+		"kubernetes-deployment-type": "replicaset",
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.InvalidApplicationConfig)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationDeploymentType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	charmUUID := charmtesting.GenCharmID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().GetApplicationConfigAndSettings(gomock.Any(), appUUID).
+		Return(map[string]application.ApplicationConfig{}, application.ApplicationSettings{}, nil)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "foo").Return(charmUUID, nil)
+	s.state.EXPECT().GetCharmMetadata(gomock.Any(), charmUUID).Return(applicationcharm.Metadata{
+		DeploymentType: applicationcharm.DeploymentStateless,
+	}, nil)
+
+	deploymentType, err := s.service.GetApplicationDeploymentType(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(deploymentType, gc.Equals, applicationcharm.DeploymentStateless)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationDeploymentTypeOverridden(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appUUID, nil)
+	s.state.EXPECT().GetApplicationConfigAndSettings(gomock.Any(), appUUID).
+		Return(map[string]application.ApplicationConfig{}, application.ApplicationSettings{
+			DeploymentType: applicationcharm.DeploymentDaemon,
+		}, nil)
+
+	deploymentType, err := s.service.GetApplicationDeploymentType(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(deploymentType, gc.Equals, applicationcharm.DeploymentDaemon)
+}
+
 func (s *applicationServiceSuite) TestUpdateApplicationConfigNoConfig(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1040,6 +1144,103 @@ func (s *applicationServiceSuite) TestCloudServiceAddressesNotFound(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, applicationerrors.ApplicationNotFound.Error())
 }
 
+func (s *applicationServiceSuite) TestSetApplicationAutoscalePolicy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	charmID := charmtesting.GenCharmID(c)
+	policy := application.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         5,
+		TargetCPUPercent: 70,
+	}
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "foo").Return(charmID, nil)
+	s.state.EXPECT().GetCharmMetadata(gomock.Any(), charmID).Return(applicationcharm.Metadata{
+		DeploymentType: applicationcharm.DeploymentStateless,
+	}, nil)
+	s.state.EXPECT().SetApplicationAutoscalePolicy(gomock.Any(), appID, policy).Return(nil)
+
+	err := s.service.SetApplicationAutoscalePolicy(context.Background(), "foo", policy)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestSetApplicationAutoscalePolicyDaemon(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	charmID := charmtesting.GenCharmID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "foo").Return(charmID, nil)
+	s.state.EXPECT().GetCharmMetadata(gomock.Any(), charmID).Return(applicationcharm.Metadata{
+		DeploymentType: applicationcharm.DeploymentDaemon,
+	}, nil)
+
+	err := s.service.SetApplicationAutoscalePolicy(context.Background(), "foo", application.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         5,
+		TargetCPUPercent: 70,
+	})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *applicationServiceSuite) TestSetApplicationAutoscalePolicyNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	for i, policy := range []application.AutoscalePolicy{{
+		MinUnits: -1, MaxUnits: 2, TargetCPUPercent: 50,
+	}, {
+		MinUnits: 0, MaxUnits: 0, TargetCPUPercent: 50,
+	}, {
+		MinUnits: 3, MaxUnits: 2, TargetCPUPercent: 50,
+	}, {
+		MinUnits: 1, MaxUnits: 2, TargetMemoryPercent: -5,
+	}, {
+		MinUnits: 1, MaxUnits: 2,
+	}, {
+		MinUnits: 1, MaxUnits: 2, CustomMetric: "rps",
+	}, {
+		MinUnits: 1, MaxUnits: 2, TargetCPUPercent: 50, CustomMetricTarget: 10,
+	}} {
+		c.Logf("test %d: %+v", i, policy)
+		err := s.service.SetApplicationAutoscalePolicy(context.Background(), "foo", policy)
+		c.Check(err, jc.ErrorIs, applicationerrors.AutoscalePolicyNotValid)
+	}
+}
+
+func (s *applicationServiceSuite) TestGetApplicationAutoscalePolicy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	policy := application.AutoscalePolicy{
+		MinUnits:           1,
+		MaxUnits:           5,
+		CustomMetric:       "rps",
+		CustomMetricTarget: 100,
+	}
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().GetApplicationAutoscalePolicy(gomock.Any(), appID).Return(policy, nil)
+
+	got, err := s.service.GetApplicationAutoscalePolicy(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, gc.DeepEquals, policy)
+}
+
+func (s *applicationServiceSuite) TestRemoveApplicationAutoscalePolicyNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().RemoveApplicationAutoscalePolicy(gomock.Any(), appID).Return(applicationerrors.AutoscalePolicyNotFound)
+
+	err := s.service.RemoveApplicationAutoscalePolicy(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.AutoscalePolicyNotFound)
+}
+
 type applicationWatcherServiceSuite struct {
 	testing.IsolationSuite
 
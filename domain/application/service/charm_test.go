@@ -765,8 +765,9 @@ func (s *charmServiceSuite) TestSetCharm(c *gc.C) {
 
 	s.state.EXPECT().SetCharm(gomock.Any(), charm.Charm{
 		Metadata: charm.Metadata{
-			Name:  "foo",
-			RunAs: "default",
+			Name:           "foo",
+			RunAs:          "default",
+			DeploymentType: "default",
 		},
 		Manifest:      s.minimalManifest(),
 		ReferenceName: "baz",
@@ -844,8 +845,9 @@ func (s *charmServiceSuite) TestSetCharmCharmhub(c *gc.C) {
 
 	s.state.EXPECT().SetCharm(gomock.Any(), charm.Charm{
 		Metadata: charm.Metadata{
-			Name:  "foo",
-			RunAs: "default",
+			Name:           "foo",
+			RunAs:          "default",
+			DeploymentType: "default",
 		},
 		Manifest:      s.minimalManifest(),
 		ReferenceName: "baz",
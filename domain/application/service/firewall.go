@@ -0,0 +1,128 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/juju/collections/set"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetFirewallRules returns the firewall rules of the provided application.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetFirewallRules(ctx context.Context, appName string) ([]application.FirewallRule, error) {
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rules, err := s.st.GetFirewallRules(ctx, appID)
+	if err != nil {
+		return nil, errors.Errorf("getting firewall rules for %q: %w", appName, err)
+	}
+	return rules, nil
+}
+
+// GetAllFirewallRules returns the firewall rules of all applications in the
+// model, keyed on application name.
+func (s *Service) GetAllFirewallRules(ctx context.Context) (map[string][]application.FirewallRule, error) {
+	rules, err := s.st.GetAllFirewallRules(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting firewall rules: %w", err)
+	}
+	return rules, nil
+}
+
+// SetFirewallRule adds the provided firewall rule to the application,
+// replacing any existing rule for the same endpoint and port range. Firewall
+// rules are applied whether or not the application is exposed.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned. If the rule is not
+// valid, an error satisfying [applicationerrors.FirewallRuleNotValid] is
+// returned. If the endpoint does not exist, an error satisfying
+// [applicationerrors.EndpointNotFound] is returned. If any of the CIDR sets
+// do not exist, an error satisfying [networkerrors.CIDRSetNotFound] is
+// returned.
+func (s *Service) SetFirewallRule(ctx context.Context, appName string, rule application.FirewallRule) error {
+	if err := validateFirewallRule(rule); err != nil {
+		return errors.Capture(err)
+	}
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if rule.Endpoint != network.WildcardEndpoint {
+		if err := s.st.EndpointsExist(ctx, appID, set.NewStrings(rule.Endpoint)); err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	if err := s.st.SetFirewallRule(ctx, appID, rule); err != nil {
+		return errors.Errorf("setting firewall rule for %q: %w", appName, err)
+	}
+	return nil
+}
+
+// RemoveFirewallRule removes the firewall rule of the application for the
+// provided endpoint and port range. A nil port range identifies the rule
+// for the ports opened on the endpoint.
+//
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned. If no such rule
+// exists, an error satisfying [applicationerrors.FirewallRuleNotFound] is
+// returned.
+func (s *Service) RemoveFirewallRule(ctx context.Context, appName, endpoint string, portRange *network.PortRange) error {
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := s.st.DeleteFirewallRule(ctx, appID, endpoint, portRange); err != nil {
+		return errors.Errorf("removing firewall rule for %q: %w", appName, err)
+	}
+	return nil
+}
+
+// WatchFirewallRules returns a watcher that notifies when the firewall
+// rules of any application in the model change, including changes to the
+// CIDRs of the CIDR sets they refer to.
+func (s *WatchableService) WatchFirewallRules(ctx context.Context) (watcher.NotifyWatcher, error) {
+	rulesNamespace, cidrSetsNamespace := s.st.NamespaceForWatchFirewallRules()
+	return s.watcherFactory.NewNotifyWatcher(
+		eventsource.NamespaceFilter(rulesNamespace, changestream.All),
+		eventsource.NamespaceFilter(cidrSetsNamespace, changestream.All),
+	)
+}
+
+func validateFirewallRule(rule application.FirewallRule) error {
+	if rule.CIDRs.Size()+rule.CIDRSets.Size() == 0 {
+		return errors.Errorf("firewall rule requires at least one cidr or cidr set").
+			Add(applicationerrors.FirewallRuleNotValid)
+	}
+	for _, cidr := range rule.CIDRs.Values() {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.Errorf("cidr %q not valid", cidr).Add(applicationerrors.FirewallRuleNotValid)
+		}
+	}
+	if rule.PortRange != nil {
+		if err := rule.PortRange.Validate(); err != nil {
+			return errors.Errorf("port range %q: %w", rule.PortRange, err).Add(applicationerrors.FirewallRuleNotValid)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreapplication "github.com/juju/juju/core/application"
+	applicationtesting "github.com/juju/juju/core/application/testing"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+)
+
+type firewallServiceSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&firewallServiceSuite{})
+
+func (s *firewallServiceSuite) TestGetFirewallRules(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	applicationUUID := applicationtesting.GenApplicationUUID(c)
+	expected := []application.FirewallRule{{
+		Endpoint:    "web",
+		CIDRs:       set.NewStrings("10.0.0.0/8"),
+		SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+	}}
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().GetFirewallRules(gomock.Any(), applicationUUID).Return(expected, nil)
+
+	rules, err := s.service.GetFirewallRules(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(rules, jc.DeepEquals, expected)
+}
+
+func (s *firewallServiceSuite) TestSetFirewallRule(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	applicationUUID := applicationtesting.GenApplicationUUID(c)
+	portRange := network.MustParsePortRange("443/tcp")
+	rule := application.FirewallRule{
+		Endpoint:  "web",
+		PortRange: &portRange,
+		CIDRs:     set.NewStrings("10.0.0.0/8"),
+		CIDRSets:  set.NewStrings("office"),
+	}
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().EndpointsExist(gomock.Any(), applicationUUID, set.NewStrings("web")).Return(nil)
+	s.state.EXPECT().SetFirewallRule(gomock.Any(), applicationUUID, rule).Return(nil)
+
+	err := s.service.SetFirewallRule(context.Background(), "foo", rule)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *firewallServiceSuite) TestSetFirewallRuleWildcardEndpoint(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	applicationUUID := applicationtesting.GenApplicationUUID(c)
+	rule := application.FirewallRule{
+		CIDRSets: set.NewStrings("office"),
+	}
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().SetFirewallRule(gomock.Any(), applicationUUID, rule).Return(networkerrors.CIDRSetNotFound)
+
+	err := s.service.SetFirewallRule(context.Background(), "foo", rule)
+	c.Assert(err, jc.ErrorIs, networkerrors.CIDRSetNotFound)
+}
+
+func (s *firewallServiceSuite) TestSetFirewallRuleNoSources(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetFirewallRule(context.Background(), "foo", application.FirewallRule{Endpoint: "web"})
+	c.Assert(err, jc.ErrorIs, applicationerrors.FirewallRuleNotValid)
+}
+
+func (s *firewallServiceSuite) TestSetFirewallRuleInvalidCIDR(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetFirewallRule(context.Background(), "foo", application.FirewallRule{
+		CIDRs: set.NewStrings("10.0.0.1"),
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.FirewallRuleNotValid)
+	c.Check(err, gc.ErrorMatches, `cidr "10.0.0.1" not valid`)
+}
+
+func (s *firewallServiceSuite) TestSetFirewallRuleInvalidPortRange(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	portRange := network.PortRange{Protocol: "tcp", FromPort: 90, ToPort: 80}
+	err := s.service.SetFirewallRule(context.Background(), "foo", application.FirewallRule{
+		PortRange: &portRange,
+		CIDRs:     set.NewStrings("10.0.0.0/8"),
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.FirewallRuleNotValid)
+}
+
+func (s *firewallServiceSuite) TestSetFirewallRuleEndpointNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	applicationUUID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().EndpointsExist(gomock.Any(), applicationUUID, set.NewStrings("missing")).
+		Return(applicationerrors.EndpointNotFound)
+
+	err := s.service.SetFirewallRule(context.Background(), "foo", application.FirewallRule{
+		Endpoint: "missing",
+		CIDRs:    set.NewStrings("10.0.0.0/8"),
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.EndpointNotFound)
+}
+
+func (s *firewallServiceSuite) TestRemoveFirewallRuleApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").
+		Return(coreapplication.ID(""), applicationerrors.ApplicationNotFound)
+
+	err := s.service.RemoveFirewallRule(context.Background(), "foo", "web", nil)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *firewallServiceSuite) TestRemoveFirewallRule(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	applicationUUID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(applicationUUID, nil)
+	s.state.EXPECT().DeleteFirewallRule(gomock.Any(), applicationUUID, "web", nil).
+		Return(applicationerrors.FirewallRuleNotFound)
+
+	err := s.service.RemoveFirewallRule(context.Background(), "foo", "web", nil)
+	c.Assert(err, jc.ErrorIs, applicationerrors.FirewallRuleNotFound)
+}
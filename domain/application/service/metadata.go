@@ -69,6 +69,11 @@ func decodeMetadata(metadata charm.Metadata) (internalcharm.Meta, error) {
 		return internalcharm.Meta{}, errors.Errorf("decode charm user: %w", err)
 	}
 
+	deploymentType, err := decodeMetadataDeploymentType(metadata.DeploymentType)
+	if err != nil {
+		return internalcharm.Meta{}, errors.Errorf("decode deployment type: %w", err)
+	}
+
 	return internalcharm.Meta{
 		Name:           metadata.Name,
 		Summary:        metadata.Summary,
@@ -88,6 +93,7 @@ func decodeMetadata(metadata charm.Metadata) (internalcharm.Meta, error) {
 		Containers:     containers,
 		Assumes:        assumes,
 		CharmUser:      charmUser,
+		DeploymentType: deploymentType,
 	}, nil
 }
 
@@ -304,6 +310,23 @@ func decodeMetadataRunAs(charmUser charm.RunAs) (internalcharm.RunAs, error) {
 	}
 }
 
+func decodeMetadataDeploymentType(deploymentType charm.DeploymentType) (internalcharm.DeploymentType, error) {
+	// DeploymentDefault is different from the wire protocol. Ensure we
+	// decode it correctly.
+	switch deploymentType {
+	case charm.DeploymentDefault, "":
+		return internalcharm.DeploymentDefault, nil
+	case charm.DeploymentStateful:
+		return internalcharm.DeploymentStateful, nil
+	case charm.DeploymentStateless:
+		return internalcharm.DeploymentStateless, nil
+	case charm.DeploymentDaemon:
+		return internalcharm.DeploymentDaemon, nil
+	default:
+		return "", errors.Errorf("unknown deployment type %q", deploymentType)
+	}
+}
+
 func decodeMetadataAssumes(bytes []byte) (*assumes.ExpressionTree, error) {
 	if len(bytes) == 0 {
 		return nil, nil
@@ -376,6 +399,11 @@ func encodeMetadata(metadata *internalcharm.Meta) (charm.Metadata, error) {
 		return charm.Metadata{}, errors.Errorf("encode charm user: %w", err)
 	}
 
+	deploymentType, err := encodeMetadataDeploymentType(metadata.DeploymentType)
+	if err != nil {
+		return charm.Metadata{}, errors.Errorf("encode deployment type: %w", err)
+	}
+
 	return charm.Metadata{
 		Name:           metadata.Name,
 		Summary:        metadata.Summary,
@@ -395,6 +423,7 @@ func encodeMetadata(metadata *internalcharm.Meta) (charm.Metadata, error) {
 		Containers:     containers,
 		Assumes:        assumes,
 		RunAs:          charmUser,
+		DeploymentType: deploymentType,
 	}, nil
 }
 
@@ -735,6 +764,21 @@ func encodeMetadataRunAs(charmUser internalcharm.RunAs) (charm.RunAs, error) {
 	}
 }
 
+func encodeMetadataDeploymentType(deploymentType internalcharm.DeploymentType) (charm.DeploymentType, error) {
+	switch deploymentType {
+	case internalcharm.DeploymentDefault:
+		return charm.DeploymentDefault, nil
+	case internalcharm.DeploymentStateful:
+		return charm.DeploymentStateful, nil
+	case internalcharm.DeploymentStateless:
+		return charm.DeploymentStateless, nil
+	case internalcharm.DeploymentDaemon:
+		return charm.DeploymentDaemon, nil
+	default:
+		return "", errors.Errorf("unknown deployment type %q", deploymentType)
+	}
+}
+
 func encodeMetadataExtraBindings(bindings map[string]internalcharm.ExtraBinding) map[string]charm.ExtraBinding {
 	if len(bindings) == 0 {
 		return nil
@@ -33,7 +33,8 @@ var metadataTestCases = [...]struct {
 			Name: "foo",
 			// RunAs is optional and defaults to "default", this means we're
 			// storing a valid value in the persistence layer.
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 		},
 		output: internalcharm.Meta{
 			Name: "foo",
@@ -42,13 +43,14 @@ var metadataTestCases = [...]struct {
 	{
 		name: "common",
 		input: charm.Metadata{
-			Name:        "foo",
-			RunAs:       charm.RunAsDefault,
-			Summary:     "summary",
-			Description: "description",
-			Categories:  []string{"cat1", "cat2"},
-			Subordinate: true,
-			Terms:       []string{"term1", "term2"},
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
+			Summary:        "summary",
+			Description:    "description",
+			Categories:     []string{"cat1", "cat2"},
+			Subordinate:    true,
+			Terms:          []string{"term1", "term2"},
 		},
 		output: internalcharm.Meta{
 			Name:        "foo",
@@ -64,6 +66,7 @@ var metadataTestCases = [...]struct {
 		input: charm.Metadata{
 			Name:           "foo",
 			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			MinJujuVersion: semversion.MustParse("2.0.0"),
 		},
 		output: internalcharm.Meta{
@@ -74,19 +77,33 @@ var metadataTestCases = [...]struct {
 	{
 		name: "charm user",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsNonRoot,
+			Name:           "foo",
+			RunAs:          charm.RunAsNonRoot,
+			DeploymentType: charm.DeploymentDefault,
 		},
 		output: internalcharm.Meta{
 			Name:      "foo",
 			CharmUser: internalcharm.RunAsNonRoot,
 		},
 	},
+	{
+		name: "deployment type",
+		input: charm.Metadata{
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDaemon,
+		},
+		output: internalcharm.Meta{
+			Name:           "foo",
+			DeploymentType: internalcharm.DeploymentDaemon,
+		},
+	},
 	{
 		name: "provides",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Provides: map[string]charm.Relation{
 				"baz": {
 					Name:      "baz",
@@ -115,8 +132,9 @@ var metadataTestCases = [...]struct {
 	{
 		name: "provides juju-info",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Provides: map[string]charm.Relation{
 				relation.JujuInfo: {
 					Name:      relation.JujuInfo,
@@ -141,8 +159,9 @@ var metadataTestCases = [...]struct {
 	{
 		name: "requires",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Requires: map[string]charm.Relation{
 				"baz": {
 					Name:      "baz",
@@ -171,8 +190,9 @@ var metadataTestCases = [...]struct {
 	{
 		name: "peers",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Peers: map[string]charm.Relation{
 				"baz": {
 					Name:      "baz",
@@ -201,8 +221,9 @@ var metadataTestCases = [...]struct {
 	{
 		name: "storage",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Storage: map[string]charm.Storage{
 				"sda": {
 					Name:        "sda",
@@ -239,8 +260,9 @@ var metadataTestCases = [...]struct {
 	{
 		name: "devices",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Devices: map[string]charm.Device{
 				"gpu": {
 					Name:        "gpu",
@@ -267,8 +289,9 @@ var metadataTestCases = [...]struct {
 	{
 		name: "resources",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Resources: map[string]charm.Resource{
 				"foo": {
 					Name:        "foo",
@@ -293,8 +316,9 @@ var metadataTestCases = [...]struct {
 	{
 		name: "containers",
 		input: charm.Metadata{
-			Name:  "foo",
-			RunAs: charm.RunAsDefault,
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Containers: map[string]charm.Container{
 				"foo": {
 					Resource: "bar",
@@ -325,9 +349,10 @@ var metadataTestCases = [...]struct {
 	{
 		name: "assumes",
 		input: charm.Metadata{
-			Name:    "foo",
-			RunAs:   charm.RunAsDefault,
-			Assumes: []byte(`{"assumes":["chips",{"any-of":["guacamole","salsa",{"any-of":["good-weather","great-music"]}]},{"all-of":["table","lazy-suzan"]}]}`),
+			Name:           "foo",
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
+			Assumes:        []byte(`{"assumes":["chips",{"any-of":["guacamole","salsa",{"any-of":["good-weather","great-music"]}]},{"all-of":["table","lazy-suzan"]}]}`),
 		},
 		output: internalcharm.Meta{
 			Name: "foo",
@@ -417,8 +417,9 @@ func (s *migrationServiceSuite) assertImportApplication(c *gc.C, modelType corem
 
 	ch := domaincharm.Charm{
 		Metadata: domaincharm.Metadata{
-			Name:  "ubuntu",
-			RunAs: "default",
+			Name:           "ubuntu",
+			RunAs:          "default",
+			DeploymentType: "default",
 		},
 		Manifest: s.minimalManifest(),
 		Config: domaincharm.Config{
@@ -264,6 +264,45 @@ func (c *MockStateAttachStorageCall) DoAndReturn(f func(context.Context, string,
 	return c
 }
 
+// ClaimWarmPoolMachine mocks base method.
+func (m *MockState) ClaimWarmPoolMachine(ctx context.Context, constraints, base string) (machine.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimWarmPoolMachine", ctx, constraints, base)
+	ret0, _ := ret[0].(machine.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimWarmPoolMachine indicates an expected call of ClaimWarmPoolMachine.
+func (mr *MockStateMockRecorder) ClaimWarmPoolMachine(ctx, constraints, base any) *MockStateClaimWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimWarmPoolMachine", reflect.TypeOf((*MockState)(nil).ClaimWarmPoolMachine), ctx, constraints, base)
+	return &MockStateClaimWarmPoolMachineCall{Call: call}
+}
+
+// MockStateClaimWarmPoolMachineCall wrap *gomock.Call
+type MockStateClaimWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateClaimWarmPoolMachineCall) Return(arg0 machine.Name, arg1 error) *MockStateClaimWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateClaimWarmPoolMachineCall) Do(f func(context.Context, string, string) (machine.Name, error)) *MockStateClaimWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateClaimWarmPoolMachineCall) DoAndReturn(f func(context.Context, string, string) (machine.Name, error)) *MockStateClaimWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CloudServiceAddresses mocks base method.
 func (m *MockState) CloudServiceAddresses(ctx context.Context, applicationName string) (network.SpaceAddresses, error) {
 	m.ctrl.T.Helper()
@@ -418,6 +457,44 @@ func (c *MockStateDeleteCharmCall) DoAndReturn(f func(context.Context, charm.ID)
 	return c
 }
 
+// DeleteFirewallRule mocks base method.
+func (m *MockState) DeleteFirewallRule(ctx context.Context, appID application.ID, endpoint string, portRange *network.PortRange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewallRule", ctx, appID, endpoint, portRange)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFirewallRule indicates an expected call of DeleteFirewallRule.
+func (mr *MockStateMockRecorder) DeleteFirewallRule(ctx, appID, endpoint, portRange any) *MockStateDeleteFirewallRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockState)(nil).DeleteFirewallRule), ctx, appID, endpoint, portRange)
+	return &MockStateDeleteFirewallRuleCall{Call: call}
+}
+
+// MockStateDeleteFirewallRuleCall wrap *gomock.Call
+type MockStateDeleteFirewallRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteFirewallRuleCall) Return(arg0 error) *MockStateDeleteFirewallRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteFirewallRuleCall) Do(f func(context.Context, application.ID, string, *network.PortRange) error) *MockStateDeleteFirewallRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteFirewallRuleCall) DoAndReturn(f func(context.Context, application.ID, string, *network.PortRange) error) *MockStateDeleteFirewallRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteUnit mocks base method.
 func (m *MockState) DeleteUnit(arg0 context.Context, arg1 unit.Name) (bool, error) {
 	m.ctrl.T.Helper()
@@ -610,6 +687,45 @@ func (c *MockStateGetAddressesHashCall) DoAndReturn(f func(context.Context, appl
 	return c
 }
 
+// GetAllFirewallRules mocks base method.
+func (m *MockState) GetAllFirewallRules(ctx context.Context) (map[string][]application0.FirewallRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFirewallRules", ctx)
+	ret0, _ := ret[0].(map[string][]application0.FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFirewallRules indicates an expected call of GetAllFirewallRules.
+func (mr *MockStateMockRecorder) GetAllFirewallRules(ctx any) *MockStateGetAllFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFirewallRules", reflect.TypeOf((*MockState)(nil).GetAllFirewallRules), ctx)
+	return &MockStateGetAllFirewallRulesCall{Call: call}
+}
+
+// MockStateGetAllFirewallRulesCall wrap *gomock.Call
+type MockStateGetAllFirewallRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetAllFirewallRulesCall) Return(arg0 map[string][]application0.FirewallRule, arg1 error) *MockStateGetAllFirewallRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetAllFirewallRulesCall) Do(f func(context.Context) (map[string][]application0.FirewallRule, error)) *MockStateGetAllFirewallRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetAllFirewallRulesCall) DoAndReturn(f func(context.Context) (map[string][]application0.FirewallRule, error)) *MockStateGetAllFirewallRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAllUnitNames mocks base method.
 func (m *MockState) GetAllUnitNames(arg0 context.Context) ([]unit.Name, error) {
 	m.ctrl.T.Helper()
@@ -649,6 +765,45 @@ func (c *MockStateGetAllUnitNamesCall) DoAndReturn(f func(context.Context) ([]un
 	return c
 }
 
+// GetApplicationAutoscalePolicy mocks base method.
+func (m *MockState) GetApplicationAutoscalePolicy(arg0 context.Context, arg1 application.ID) (application0.AutoscalePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAutoscalePolicy", arg0, arg1)
+	ret0, _ := ret[0].(application0.AutoscalePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAutoscalePolicy indicates an expected call of GetApplicationAutoscalePolicy.
+func (mr *MockStateMockRecorder) GetApplicationAutoscalePolicy(arg0, arg1 any) *MockStateGetApplicationAutoscalePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAutoscalePolicy", reflect.TypeOf((*MockState)(nil).GetApplicationAutoscalePolicy), arg0, arg1)
+	return &MockStateGetApplicationAutoscalePolicyCall{Call: call}
+}
+
+// MockStateGetApplicationAutoscalePolicyCall wrap *gomock.Call
+type MockStateGetApplicationAutoscalePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationAutoscalePolicyCall) Return(arg0 application0.AutoscalePolicy, arg1 error) *MockStateGetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationAutoscalePolicyCall) Do(f func(context.Context, application.ID) (application0.AutoscalePolicy, error)) *MockStateGetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationAutoscalePolicyCall) DoAndReturn(f func(context.Context, application.ID) (application0.AutoscalePolicy, error)) *MockStateGetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationCharmOrigin mocks base method.
 func (m *MockState) GetApplicationCharmOrigin(ctx context.Context, appID application.ID) (application0.CharmOrigin, error) {
 	m.ctrl.T.Helper()
@@ -1281,6 +1436,45 @@ func (c *MockStateGetAsyncCharmDownloadInfoCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// GetAutoscalePolicies mocks base method.
+func (m *MockState) GetAutoscalePolicies(arg0 context.Context) ([]application0.ApplicationAutoscalePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoscalePolicies", arg0)
+	ret0, _ := ret[0].([]application0.ApplicationAutoscalePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoscalePolicies indicates an expected call of GetAutoscalePolicies.
+func (mr *MockStateMockRecorder) GetAutoscalePolicies(arg0 any) *MockStateGetAutoscalePoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoscalePolicies", reflect.TypeOf((*MockState)(nil).GetAutoscalePolicies), arg0)
+	return &MockStateGetAutoscalePoliciesCall{Call: call}
+}
+
+// MockStateGetAutoscalePoliciesCall wrap *gomock.Call
+type MockStateGetAutoscalePoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetAutoscalePoliciesCall) Return(arg0 []application0.ApplicationAutoscalePolicy, arg1 error) *MockStateGetAutoscalePoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetAutoscalePoliciesCall) Do(f func(context.Context) ([]application0.ApplicationAutoscalePolicy, error)) *MockStateGetAutoscalePoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetAutoscalePoliciesCall) DoAndReturn(f func(context.Context) ([]application0.ApplicationAutoscalePolicy, error)) *MockStateGetAutoscalePoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAvailableCharmArchiveSHA256 mocks base method.
 func (m *MockState) GetAvailableCharmArchiveSHA256(ctx context.Context, id charm.ID) (string, error) {
 	m.ctrl.T.Helper()
@@ -2143,6 +2337,45 @@ func (c *MockStateGetExposedEndpointsCall) DoAndReturn(f func(context.Context, a
 	return c
 }
 
+// GetFirewallRules mocks base method.
+func (m *MockState) GetFirewallRules(ctx context.Context, appID application.ID) ([]application0.FirewallRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirewallRules", ctx, appID)
+	ret0, _ := ret[0].([]application0.FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirewallRules indicates an expected call of GetFirewallRules.
+func (mr *MockStateMockRecorder) GetFirewallRules(ctx, appID any) *MockStateGetFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRules", reflect.TypeOf((*MockState)(nil).GetFirewallRules), ctx, appID)
+	return &MockStateGetFirewallRulesCall{Call: call}
+}
+
+// MockStateGetFirewallRulesCall wrap *gomock.Call
+type MockStateGetFirewallRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetFirewallRulesCall) Return(arg0 []application0.FirewallRule, arg1 error) *MockStateGetFirewallRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetFirewallRulesCall) Do(f func(context.Context, application.ID) ([]application0.FirewallRule, error)) *MockStateGetFirewallRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetFirewallRulesCall) DoAndReturn(f func(context.Context, application.ID) ([]application0.FirewallRule, error)) *MockStateGetFirewallRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetLatestPendingCharmhubCharm mocks base method.
 func (m *MockState) GetLatestPendingCharmhubCharm(ctx context.Context, name string, arch architecture.Architecture) (charm0.CharmLocator, error) {
 	m.ctrl.T.Helper()
@@ -3591,6 +3824,45 @@ func (c *MockStateNamespaceForWatchCharmCall) DoAndReturn(f func() string) *Mock
 	return c
 }
 
+// NamespaceForWatchFirewallRules mocks base method.
+func (m *MockState) NamespaceForWatchFirewallRules() (string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchFirewallRules")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// NamespaceForWatchFirewallRules indicates an expected call of NamespaceForWatchFirewallRules.
+func (mr *MockStateMockRecorder) NamespaceForWatchFirewallRules() *MockStateNamespaceForWatchFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchFirewallRules", reflect.TypeOf((*MockState)(nil).NamespaceForWatchFirewallRules))
+	return &MockStateNamespaceForWatchFirewallRulesCall{Call: call}
+}
+
+// MockStateNamespaceForWatchFirewallRulesCall wrap *gomock.Call
+type MockStateNamespaceForWatchFirewallRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateNamespaceForWatchFirewallRulesCall) Return(arg0, arg1 string) *MockStateNamespaceForWatchFirewallRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateNamespaceForWatchFirewallRulesCall) Do(f func() (string, string)) *MockStateNamespaceForWatchFirewallRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateNamespaceForWatchFirewallRulesCall) DoAndReturn(f func() (string, string)) *MockStateNamespaceForWatchFirewallRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NamespaceForWatchUnitForLegacyUniter mocks base method.
 func (m *MockState) NamespaceForWatchUnitForLegacyUniter() (string, string, string) {
 	m.ctrl.T.Helper()
@@ -3632,11 +3904,12 @@ func (c *MockStateNamespaceForWatchUnitForLegacyUniterCall) DoAndReturn(f func()
 }
 
 // RegisterCAASUnit mocks base method.
-func (m *MockState) RegisterCAASUnit(arg0 context.Context, arg1 string, arg2 application0.RegisterCAASUnitArg) error {
+func (m *MockState) RegisterCAASUnit(arg0 context.Context, arg1 string, arg2 application0.RegisterCAASUnitArg) (unit.Name, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RegisterCAASUnit", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(unit.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RegisterCAASUnit indicates an expected call of RegisterCAASUnit.
@@ -3652,19 +3925,57 @@ type MockStateRegisterCAASUnitCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateRegisterCAASUnitCall) Return(arg0 error) *MockStateRegisterCAASUnitCall {
+func (c *MockStateRegisterCAASUnitCall) Return(arg0 unit.Name, arg1 error) *MockStateRegisterCAASUnitCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRegisterCAASUnitCall) Do(f func(context.Context, string, application0.RegisterCAASUnitArg) (unit.Name, error)) *MockStateRegisterCAASUnitCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRegisterCAASUnitCall) DoAndReturn(f func(context.Context, string, application0.RegisterCAASUnitArg) (unit.Name, error)) *MockStateRegisterCAASUnitCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveApplicationAutoscalePolicy mocks base method.
+func (m *MockState) RemoveApplicationAutoscalePolicy(arg0 context.Context, arg1 application.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveApplicationAutoscalePolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveApplicationAutoscalePolicy indicates an expected call of RemoveApplicationAutoscalePolicy.
+func (mr *MockStateMockRecorder) RemoveApplicationAutoscalePolicy(arg0, arg1 any) *MockStateRemoveApplicationAutoscalePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveApplicationAutoscalePolicy", reflect.TypeOf((*MockState)(nil).RemoveApplicationAutoscalePolicy), arg0, arg1)
+	return &MockStateRemoveApplicationAutoscalePolicyCall{Call: call}
+}
+
+// MockStateRemoveApplicationAutoscalePolicyCall wrap *gomock.Call
+type MockStateRemoveApplicationAutoscalePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRemoveApplicationAutoscalePolicyCall) Return(arg0 error) *MockStateRemoveApplicationAutoscalePolicyCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateRegisterCAASUnitCall) Do(f func(context.Context, string, application0.RegisterCAASUnitArg) error) *MockStateRegisterCAASUnitCall {
+func (c *MockStateRemoveApplicationAutoscalePolicyCall) Do(f func(context.Context, application.ID) error) *MockStateRemoveApplicationAutoscalePolicyCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateRegisterCAASUnitCall) DoAndReturn(f func(context.Context, string, application0.RegisterCAASUnitArg) error) *MockStateRegisterCAASUnitCall {
+func (c *MockStateRemoveApplicationAutoscalePolicyCall) DoAndReturn(f func(context.Context, application.ID) error) *MockStateRemoveApplicationAutoscalePolicyCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -3746,6 +4057,44 @@ func (c *MockStateResolveMigratingUploadedCharmCall) DoAndReturn(f func(context.
 	return c
 }
 
+// SetApplicationAutoscalePolicy mocks base method.
+func (m *MockState) SetApplicationAutoscalePolicy(arg0 context.Context, arg1 application.ID, arg2 application0.AutoscalePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationAutoscalePolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationAutoscalePolicy indicates an expected call of SetApplicationAutoscalePolicy.
+func (mr *MockStateMockRecorder) SetApplicationAutoscalePolicy(arg0, arg1, arg2 any) *MockStateSetApplicationAutoscalePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationAutoscalePolicy", reflect.TypeOf((*MockState)(nil).SetApplicationAutoscalePolicy), arg0, arg1, arg2)
+	return &MockStateSetApplicationAutoscalePolicyCall{Call: call}
+}
+
+// MockStateSetApplicationAutoscalePolicyCall wrap *gomock.Call
+type MockStateSetApplicationAutoscalePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationAutoscalePolicyCall) Return(arg0 error) *MockStateSetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationAutoscalePolicyCall) Do(f func(context.Context, application.ID, application0.AutoscalePolicy) error) *MockStateSetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationAutoscalePolicyCall) DoAndReturn(f func(context.Context, application.ID, application0.AutoscalePolicy) error) *MockStateSetApplicationAutoscalePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationConstraints mocks base method.
 func (m *MockState) SetApplicationConstraints(ctx context.Context, appID application.ID, cons constraints0.Constraints) error {
 	m.ctrl.T.Helper()
@@ -3976,6 +4325,44 @@ func (c *MockStateSetDesiredApplicationScaleCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// SetFirewallRule mocks base method.
+func (m *MockState) SetFirewallRule(ctx context.Context, appID application.ID, rule application0.FirewallRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFirewallRule", ctx, appID, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFirewallRule indicates an expected call of SetFirewallRule.
+func (mr *MockStateMockRecorder) SetFirewallRule(ctx, appID, rule any) *MockStateSetFirewallRuleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFirewallRule", reflect.TypeOf((*MockState)(nil).SetFirewallRule), ctx, appID, rule)
+	return &MockStateSetFirewallRuleCall{Call: call}
+}
+
+// MockStateSetFirewallRuleCall wrap *gomock.Call
+type MockStateSetFirewallRuleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetFirewallRuleCall) Return(arg0 error) *MockStateSetFirewallRuleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetFirewallRuleCall) Do(f func(context.Context, application.ID, application0.FirewallRule) error) *MockStateSetFirewallRuleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetFirewallRuleCall) DoAndReturn(f func(context.Context, application.ID, application0.FirewallRule) error) *MockStateSetFirewallRuleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetUnitConstraints mocks base method.
 func (m *MockState) SetUnitConstraints(arg0 context.Context, arg1 unit.UUID, arg2 constraints0.Constraints) error {
 	m.ctrl.T.Helper()
@@ -257,6 +257,7 @@ type ApplicationConfig struct {
 	CharmConfig       internalcharm.Config
 	ApplicationConfig config.ConfigAttributes
 	Trust             bool
+	DeploymentType    domaincharm.DeploymentType
 	CharmName         string
 	Principal         bool
 }
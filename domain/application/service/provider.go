@@ -23,6 +23,7 @@ import (
 	corestorage "github.com/juju/juju/core/storage"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/constraints"
 	"github.com/juju/juju/domain/life"
@@ -405,9 +406,18 @@ func (s *ProviderService) CAASUnitTerminating(ctx context.Context, unitNameStr s
 		return false, errors.Errorf("terminating k8s unit %s/%q: %w", appName, unitNum, err)
 	}
 
-	// We currently only support statefulset.
+	deploymentType, err := s.caasDeploymentType(ctx, appName)
+	if err != nil {
+		return false, errors.Errorf("terminating k8s unit %s/%q: %w", appName, unitNum, err)
+	}
+	if deploymentType != caas.DeploymentStateful {
+		// Pods of deployments and daemon sets are replaced rather than
+		// restarted, so the unit never comes back.
+		return false, nil
+	}
+
 	restart := true
-	caasApp := caasApplicationProvider.Application(appName, caas.DeploymentStateful)
+	caasApp := caasApplicationProvider.Application(appName, deploymentType)
 	appState, err := caasApp.State()
 	if err != nil {
 		return false, errors.Capture(err)
@@ -449,28 +459,36 @@ func (s *ProviderService) RegisterCAASUnit(
 		PasswordHash:     password.AgentPasswordHash(pass),
 	}
 
-	// We don't support anything other that statefulsets.
-	// So the pod name contains the unit number.
 	appName := params.ApplicationName
-	splitPodName := strings.Split(params.ProviderID, "-")
-	ord, err := strconv.Atoi(splitPodName[len(splitPodName)-1])
+	deploymentType, err := s.caasDeploymentType(ctx, appName)
 	if err != nil {
-		return "", "", errors.Capture(err)
+		return "", "", errors.Errorf("registering k8s units for application %q: %w", appName, err)
 	}
-	unitName, err := coreunit.NewNameFromParts(appName, ord)
-	if err != nil {
-		return "", "", errors.Capture(err)
+
+	// Pods of a statefulset are named after the unit number. Pods of
+	// deployments and daemon sets have random names, so the unit is
+	// found or allocated from the provider id when it is saved.
+	if deploymentType == caas.DeploymentStateful {
+		splitPodName := strings.Split(params.ProviderID, "-")
+		ord, err := strconv.Atoi(splitPodName[len(splitPodName)-1])
+		if err != nil {
+			return "", "", errors.Capture(err)
+		}
+		unitName, err := coreunit.NewNameFromParts(appName, ord)
+		if err != nil {
+			return "", "", errors.Capture(err)
+		}
+		registerArgs.UnitName = unitName
+		registerArgs.OrderedId = ord
+		registerArgs.OrderedScale = true
 	}
-	registerArgs.UnitName = unitName
-	registerArgs.OrderedId = ord
-	registerArgs.OrderedScale = true
 
 	// Find the pod/unit in the provider.
 	caasApplicationProvider, err := s.caasApplicationProvider(ctx)
 	if err != nil {
 		return "", "", errors.Errorf("registering k8s units for application %q: %w", appName, err)
 	}
-	caasApp := caasApplicationProvider.Application(appName, caas.DeploymentStateful)
+	caasApp := caasApplicationProvider.Application(appName, deploymentType)
 	pods, err := caasApp.Units()
 	if err != nil {
 		return "", "", errors.Errorf("finding k8s units for application %q: %w", appName, err)
@@ -497,13 +515,31 @@ func (s *ProviderService) RegisterCAASUnit(
 		registerArgs.ObservedAttachedVolumeIDs = append(registerArgs.ObservedAttachedVolumeIDs, fs.Volume.VolumeId)
 	}
 
-	err = s.st.RegisterCAASUnit(ctx, appName, registerArgs)
+	unitName, err := s.st.RegisterCAASUnit(ctx, appName, registerArgs)
 	if err != nil {
-		return "", "", errors.Errorf("saving caas unit %q: %w", registerArgs.UnitName, err)
+		return "", "", errors.Errorf("saving caas unit for pod %q: %w", params.ProviderID, err)
 	}
 	return unitName, pass, nil
 }
 
+// caasDeploymentType returns the kind of workload resource used to run the
+// units of the application, as set in the application config or declared by
+// its charm. Applications without a deployment type are run as a statefulset.
+func (s *ProviderService) caasDeploymentType(ctx context.Context, appName string) (caas.DeploymentType, error) {
+	deploymentType, err := s.GetApplicationDeploymentType(ctx, appName)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	switch deploymentType {
+	case charm.DeploymentStateless:
+		return caas.DeploymentStateless, nil
+	case charm.DeploymentDaemon:
+		return caas.DeploymentDaemon, nil
+	default:
+		return caas.DeploymentStateful, nil
+	}
+}
+
 func (s *ProviderService) mergeApplicationAndModelConstraints(ctx context.Context, appCons constraints.Constraints) (coreconstraints.Value, error) {
 	// If the provider doesn't support constraints validation, then we can
 	// just return the zero value.
@@ -72,8 +72,9 @@ func (s *providerServiceSuite) TestCreateApplication(c *gc.C) {
 	}}
 	ch := applicationcharm.Charm{
 		Metadata: applicationcharm.Metadata{
-			Name:  "ubuntu",
-			RunAs: "default",
+			Name:           "ubuntu",
+			RunAs:          "default",
+			DeploymentType: "default",
 			Resources: map[string]applicationcharm.Resource{
 				"foo": {Name: "foo", Type: applicationcharm.ResourceTypeFile},
 				"bar": {Name: "bar", Type: applicationcharm.ResourceTypeContainerImage},
@@ -276,8 +277,9 @@ func (s *providerServiceSuite) TestCreateApplicationPendingResources(c *gc.C) {
 
 	ch := applicationcharm.Charm{
 		Metadata: applicationcharm.Metadata{
-			Name:  "ubuntu",
-			RunAs: "default",
+			Name:           "ubuntu",
+			RunAs:          "default",
+			DeploymentType: "default",
 			Resources: map[string]applicationcharm.Resource{
 				"foo": {Name: "foo", Type: applicationcharm.ResourceTypeFile},
 			},
@@ -661,8 +663,9 @@ func (s *providerServiceSuite) TestCreateApplicationWithStorageBlock(c *gc.C) {
 	}}
 	ch := applicationcharm.Charm{
 		Metadata: applicationcharm.Metadata{
-			Name:  "foo",
-			RunAs: "default",
+			Name:           "foo",
+			RunAs:          "default",
+			DeploymentType: "default",
 			Storage: map[string]applicationcharm.Storage{
 				"data": {
 					Name:        "data",
@@ -776,8 +779,9 @@ func (s *providerServiceSuite) TestCreateApplicationWithStorageBlockDefaultSourc
 	}}
 	ch := applicationcharm.Charm{
 		Metadata: applicationcharm.Metadata{
-			Name:  "foo",
-			RunAs: "default",
+			Name:           "foo",
+			RunAs:          "default",
+			DeploymentType: "default",
 			Storage: map[string]applicationcharm.Storage{
 				"data": {
 					Name:        "data",
@@ -896,8 +900,9 @@ func (s *providerServiceSuite) TestCreateApplicationWithStorageFilesystem(c *gc.
 	}}
 	ch := applicationcharm.Charm{
 		Metadata: applicationcharm.Metadata{
-			Name:  "foo",
-			RunAs: "default",
+			Name:           "foo",
+			RunAs:          "default",
+			DeploymentType: "default",
 			Storage: map[string]applicationcharm.Storage{
 				"data": {
 					Name:        "data",
@@ -1013,8 +1018,9 @@ func (s *providerServiceSuite) TestCreateApplicationWithStorageFilesystemDefault
 	}}
 	ch := applicationcharm.Charm{
 		Metadata: applicationcharm.Metadata{
-			Name:  "foo",
-			RunAs: "default",
+			Name:           "foo",
+			RunAs:          "default",
+			DeploymentType: "default",
 			Storage: map[string]applicationcharm.Storage{
 				"data": {
 					Name:        "data",
@@ -40,6 +40,12 @@ type UnitState interface {
 	// is returned.
 	AddCAASUnits(context.Context, string, coreapplication.ID, corecharm.ID, ...application.AddUnitArg) error
 
+	// ClaimWarmPoolMachine takes an alive machine with the input constraints
+	// and base out of the model's warm pool, and returns its name.
+	// If the pool has no such machine, an error satisfying
+	// [applicationerrors.WarmPoolMachineNotFound] is returned.
+	ClaimWarmPoolMachine(ctx context.Context, constraints, base string) (machine.Name, error)
+
 	// InsertMigratingIAASUnits inserts the fully formed units for the specified
 	// IAAS application. This is only used when inserting units during model
 	// migration. If the application is not found, an error satisfying
@@ -56,12 +62,12 @@ type UnitState interface {
 	// is returned.
 	InsertMigratingCAASUnits(context.Context, coreapplication.ID, ...application.ImportUnitArg) error
 
-	// RegisterCAASUnit registers the specified CAAS application unit. The
-	// following errors can be expected:
+	// RegisterCAASUnit registers the specified CAAS application unit,
+	// returning the name of the unit. The following errors can be expected:
 	// [applicationerrors.ApplicationNotAlive] when the application is not alive
 	// [applicationerrors.UnitAlreadyExists] when the unit exists
 	// [applicationerrors.UnitNotAssigned] when the unit was not assigned
-	RegisterCAASUnit(context.Context, string, application.RegisterCAASUnitArg) error
+	RegisterCAASUnit(context.Context, string, application.RegisterCAASUnitArg) (coreunit.Name, error)
 
 	// UpdateCAASUnit updates the cloud container for specified unit,
 	// returning an error satisfying [applicationerrors.UnitNotFoundError]
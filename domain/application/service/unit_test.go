@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/caas"
 	coreapplication "github.com/juju/juju/core/application"
 	applicationtesting "github.com/juju/juju/core/application/testing"
+	charmtesting "github.com/juju/juju/core/charm/testing"
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/machine"
 	coremodel "github.com/juju/juju/core/model"
@@ -23,6 +24,7 @@ import (
 	coreunit "github.com/juju/juju/core/unit"
 	unittesting "github.com/juju/juju/core/unit/testing"
 	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/life"
 	"github.com/juju/juju/domain/status"
@@ -92,6 +94,8 @@ func (s *unitServiceSuite) TestRegisterCAASUnit(c *gc.C) {
 		})
 	defer ctrl.Finish()
 
+	s.expectCharmDeploymentType(c, "foo", applicationcharm.DeploymentDefault)
+
 	app := NewMockApplication(ctrl)
 	app.EXPECT().Units().Return([]caas.Unit{{
 		Id:      "foo-666",
@@ -114,7 +118,7 @@ func (s *unitServiceSuite) TestRegisterCAASUnit(c *gc.C) {
 		StorageParentDir:          application.StorageParentDir,
 		ObservedAttachedVolumeIDs: []string{"vol-666"},
 	}
-	s.state.EXPECT().RegisterCAASUnit(gomock.Any(), "foo", registerArgMatcher{arg: arg})
+	s.state.EXPECT().RegisterCAASUnit(gomock.Any(), "foo", registerArgMatcher{arg: arg}).Return("foo/666", nil)
 
 	p := application.RegisterCAASUnitParams{
 		ApplicationName: "foo",
@@ -126,6 +130,48 @@ func (s *unitServiceSuite) TestRegisterCAASUnit(c *gc.C) {
 	c.Assert(password, gc.Not(gc.Equals), "")
 }
 
+func (s *unitServiceSuite) TestRegisterCAASUnitStateless(c *gc.C) {
+	ctrl := s.setupMocksWithProvider(c,
+		func(ctx context.Context) (Provider, error) {
+			return s.provider, nil
+		},
+		func(ctx context.Context) (SupportedFeatureProvider, error) {
+			return s.supportedFeaturesProvider, nil
+		},
+		func(ctx context.Context) (CAASApplicationProvider, error) {
+			return s.caasApplicationProvider, nil
+		})
+	defer ctrl.Finish()
+
+	s.expectCharmDeploymentType(c, "foo", applicationcharm.DeploymentStateless)
+
+	app := NewMockApplication(ctrl)
+	app.EXPECT().Units().Return([]caas.Unit{{
+		Id:      "foo-6f8b9c-x2z4q",
+		Address: "10.6.6.6",
+	}}, nil)
+	s.caasApplicationProvider.EXPECT().Application("foo", caas.DeploymentStateless).Return(app)
+
+	// The unit name is allocated by the state, as the pod name has no
+	// ordinal.
+	arg := application.RegisterCAASUnitArg{
+		PasswordHash:     "secret",
+		ProviderID:       "foo-6f8b9c-x2z4q",
+		Address:          ptr("10.6.6.6"),
+		StorageParentDir: application.StorageParentDir,
+	}
+	s.state.EXPECT().RegisterCAASUnit(gomock.Any(), "foo", registerArgMatcher{arg: arg}).Return("foo/3", nil)
+
+	p := application.RegisterCAASUnitParams{
+		ApplicationName: "foo",
+		ProviderID:      "foo-6f8b9c-x2z4q",
+	}
+	unitName, password, err := s.service.RegisterCAASUnit(context.Background(), p)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unitName.String(), gc.Equals, "foo/3")
+	c.Assert(password, gc.Not(gc.Equals), "")
+}
+
 func (s *unitServiceSuite) TestRegisterCAASUnitMissingProviderID(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -149,6 +195,8 @@ func (s *unitServiceSuite) TestRegisterCAASUnitApplicationNoPods(c *gc.C) {
 		})
 	defer ctrl.Finish()
 
+	s.expectCharmDeploymentType(c, "foo", applicationcharm.DeploymentDefault)
+
 	app := NewMockApplication(ctrl)
 	app.EXPECT().Units().Return([]caas.Unit{}, nil)
 	s.caasApplicationProvider.EXPECT().Application("foo", caas.DeploymentStateful).Return(app)
@@ -583,3 +631,17 @@ func (s *unitServiceSuite) TestGetUnitMachineUUIDError(c *gc.C) {
 	_, err := s.service.GetUnitMachineUUID(context.Background(), unitName)
 	c.Assert(err, jc.ErrorIs, boom)
 }
+
+func (s *unitServiceSuite) expectCharmDeploymentType(c *gc.C, appName string, deploymentType applicationcharm.DeploymentType) {
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), appName).Return(appUUID, nil)
+	s.state.EXPECT().GetApplicationConfigAndSettings(gomock.Any(), appUUID).
+		Return(map[string]application.ApplicationConfig{}, application.ApplicationSettings{}, nil)
+
+	id := charmtesting.GenCharmID(c)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), appName).Return(id, nil)
+	s.state.EXPECT().GetCharmMetadata(gomock.Any(), id).Return(applicationcharm.Metadata{
+		Name:           appName,
+		DeploymentType: deploymentType,
+	}, nil)
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/machine"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	domainmachine "github.com/juju/juju/domain/machine"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ClaimWarmPoolMachine takes a machine out of the model's warm pool for a
+// unit with the input constraints and base to be assigned to, and returns
+// its name. Units placed in a container are never given a pool machine.
+// If the pool has no machine for the unit, an error satisfying
+// [applicationerrors.WarmPoolMachineNotFound] is returned.
+func (s *Service) ClaimWarmPoolMachine(ctx context.Context, cons constraints.Value, base corebase.Base) (machine.Name, error) {
+	if cons.HasContainer() {
+		return "", errors.Errorf("unit placed in a %s container", *cons.Container).
+			Add(applicationerrors.WarmPoolMachineNotFound)
+	}
+	name, err := s.st.ClaimWarmPoolMachine(
+		ctx, domainmachine.WarmPoolConstraints(cons), domainmachine.WarmPoolBase(base),
+	)
+	if err != nil {
+		return "", errors.Errorf("claiming warm pool machine: %w", err)
+	}
+	return name, nil
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/machine"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+)
+
+type warmPoolServiceSuite struct {
+	baseSuite
+}
+
+var _ = gc.Suite(&warmPoolServiceSuite{})
+
+func (s *warmPoolServiceSuite) TestClaimWarmPoolMachine(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ClaimWarmPoolMachine(gomock.Any(), "arch=amd64 mem=4096M", "ubuntu@24.04").Return(machine.Name("3"), nil)
+
+	name, err := s.service.ClaimWarmPoolMachine(
+		context.Background(), constraints.MustParse("mem=4G"), corebase.MustParseBaseFromString("ubuntu@24.04/stable"),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(name, gc.Equals, machine.Name("3"))
+}
+
+func (s *warmPoolServiceSuite) TestClaimWarmPoolMachineNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ClaimWarmPoolMachine(gomock.Any(), "arch=arm64", "ubuntu@22.04").Return("", applicationerrors.WarmPoolMachineNotFound)
+
+	_, err := s.service.ClaimWarmPoolMachine(
+		context.Background(), constraints.MustParse("arch=arm64"), corebase.MustParseBaseFromString("ubuntu@22.04"),
+	)
+	c.Assert(err, jc.ErrorIs, applicationerrors.WarmPoolMachineNotFound)
+}
+
+func (s *warmPoolServiceSuite) TestClaimWarmPoolMachineContainer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.ClaimWarmPoolMachine(
+		context.Background(), constraints.MustParse("container=lxd"), corebase.MustParseBaseFromString("ubuntu@24.04"),
+	)
+	c.Assert(err, jc.ErrorIs, applicationerrors.WarmPoolMachineNotFound)
+}
@@ -579,6 +579,75 @@ func (s *serviceSuite) TestChangeScaleInvalid(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, applicationerrors.ScaleChangeInvalid)
 }
 
+func (s *serviceSuite) TestSetScaleBelowDisruptionBudget(c *gc.C) {
+	appID := s.createApplication(c, "foo")
+	err := s.svc.SetApplicationConstraints(context.Background(), appID, constraints.MustParse("tags=pdb.min-available=2"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.svc.SetApplicationScale(context.Background(), "foo", 3)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.svc.SetApplicationScale(context.Background(), "foo", 1)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ScaleChangeInvalid)
+	c.Assert(err, gc.ErrorMatches, `.*scale 1 is below the 2 units which must remain available.*`)
+
+	// The application can always be scaled to zero.
+	err = s.svc.SetApplicationScale(context.Background(), "foo", 0)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestChangeScaleBelowDisruptionBudget(c *gc.C) {
+	appID := s.createApplication(c, "foo", service.AddUnitArg{
+		UnitName: "foo/0",
+	}, service.AddUnitArg{
+		UnitName: "foo/1",
+	}, service.AddUnitArg{
+		UnitName: "foo/2",
+	})
+	err := s.svc.SetApplicationConstraints(context.Background(), appID, constraints.MustParse("tags=pdb.min-available=2"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	newScale, err := s.svc.ChangeApplicationScale(context.Background(), "foo", -1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(newScale, gc.Equals, 2)
+
+	_, err = s.svc.ChangeApplicationScale(context.Background(), "foo", -1)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ScaleChangeInvalid)
+}
+
+func (s *serviceSuite) TestSetScaleBelowPercentageDisruptionBudget(c *gc.C) {
+	appID := s.createApplication(c, "foo")
+	err := s.svc.SetApplicationConstraints(context.Background(), appID, constraints.MustParse("tags=pdb.min-available=50%"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.svc.SetApplicationScale(context.Background(), "foo", 4)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.svc.SetApplicationScale(context.Background(), "foo", 1)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ScaleChangeInvalid)
+	c.Assert(err, gc.ErrorMatches, `.*scale 1 is below the 2 units which must remain available.*`)
+
+	err = s.svc.SetApplicationScale(context.Background(), "foo", 2)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestChangeScaleBeyondMaxUnavailableDisruptionBudget(c *gc.C) {
+	appID := s.createApplication(c, "foo")
+	err := s.svc.SetApplicationConstraints(context.Background(), appID, constraints.MustParse("tags=pdb.max-unavailable=1"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.svc.SetApplicationScale(context.Background(), "foo", 4)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.svc.ChangeApplicationScale(context.Background(), "foo", -2)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ScaleChangeInvalid)
+	c.Assert(err, gc.ErrorMatches, `.*scale 2 is below the 3 units which must remain available.*`)
+
+	newScale, err := s.svc.ChangeApplicationScale(context.Background(), "foo", -1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(newScale, gc.Equals, 3)
+}
+
 func (s *serviceSuite) TestCAASUnitTerminatingUnitNumLessThanScale(c *gc.C) {
 	u := service.AddUnitArg{
 		UnitName: "foo/0",
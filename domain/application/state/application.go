@@ -403,6 +403,11 @@ WHERE application_uuid = $applicationDetails.uuid
 	// resource
 	// resource_meta
 
+	// Firewall rules reference the application's endpoints, so they must be
+	// removed before the endpoints themselves.
+	if err := st.deleteApplicationFirewallRules(ctx, tx, app.UUID); err != nil {
+		return errors.Errorf("deleting firewall rules for application %q: %w", name, err)
+	}
 	if err := st.deleteSimpleApplicationReferences(ctx, tx, app.UUID); err != nil {
 		return errors.Errorf("deleting associated records for application %q: %w", name, err)
 	}
@@ -468,6 +473,7 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"application_channel",
 		"application_platform",
 		"application_scale",
+		"application_autoscale",
 		"application_config",
 		"application_config_hash",
 		"application_constraint",
@@ -1998,8 +2004,14 @@ func (st *State) GetApplicationConfigAndSettings(ctx context.Context, appID core
 			Value: c.Value,
 		}
 	}
+
+	deploymentType, err := decodeDeploymentTypeSetting(settings.DeploymentTypeID)
+	if err != nil {
+		return nil, application.ApplicationSettings{}, errors.Errorf("decoding deployment type: %w", err)
+	}
 	return result, application.ApplicationSettings{
-		Trust: settings.Trust,
+		Trust:          settings.Trust,
+		DeploymentType: deploymentType,
 	}, nil
 }
 
@@ -2082,6 +2094,13 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 		return errors.Errorf("preparing upsert settings query: %w", err)
 	}
 
+	deploymentTypeID := -1
+	if settings.DeploymentType != nil {
+		if deploymentTypeID, err = encodeDeploymentType(*settings.DeploymentType); err != nil {
+			return errors.Errorf("encoding deployment type: %w", err)
+		}
+	}
+
 	upserts := make([]setApplicationConfig, 0, len(config))
 	for k, cfgVal := range config {
 		typeID, err := encodeConfigType(cfgVal.Type)
@@ -2116,6 +2135,12 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 			}
 		}
 
+		if deploymentTypeID >= 0 {
+			if err := st.setApplicationDeploymentType(ctx, tx, ident, deploymentTypeID); err != nil {
+				return errors.Capture(err)
+			}
+		}
+
 		if err := st.updateConfigHash(ctx, tx, ident); err != nil {
 			return errors.Errorf("refreshing config hash: %w", err)
 		}
@@ -2177,7 +2202,8 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 	for i, k := range keys {
 		removals[i] = k
 	}
-	removeTrust := slices.Contains(keys, "trust")
+	removeTrust := slices.Contains(keys, coreapplication.TrustConfigOptionName)
+	removeDeploymentType := slices.Contains(keys, coreapplication.KubernetesDeploymentTypeConfigOptionName)
 
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		if err := tx.Query(ctx, appStmt, ident).Get(&ident); errors.Is(err, sqlair.ErrNoRows) {
@@ -2192,15 +2218,19 @@ ON CONFLICT(application_uuid) DO UPDATE SET
 			return errors.Errorf("deleting config: %w", err)
 		}
 
-		if !removeTrust {
-			return nil
+		if removeTrust {
+			if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
+				ApplicationUUID: ident.ID,
+				Trust:           false,
+			}).Run(); err != nil {
+				return errors.Errorf("deleting setting: %w", err)
+			}
 		}
 
-		if err := tx.Query(ctx, settingsStmt, setApplicationSettings{
-			ApplicationUUID: ident.ID,
-			Trust:           false,
-		}).Run(); err != nil {
-			return errors.Errorf("deleting setting: %w", err)
+		if removeDeploymentType {
+			if err := st.setApplicationDeploymentType(ctx, tx, ident, 0); err != nil {
+				return errors.Capture(err)
+			}
 		}
 
 		return nil
@@ -2566,7 +2596,9 @@ ON CONFLICT (uuid) DO UPDATE SET
     container_type_id = excluded.container_type_id,
     virt_type = excluded.virt_type,
     allocate_public_ip = excluded.allocate_public_ip,
-    image_id = excluded.image_id
+    image_id = excluded.image_id,
+    spot = excluded.spot,
+    spot_max_price = excluded.spot_max_price
 `
 	insertConstraintsStmt, err := st.Prepare(insertConstraintsQuery, setConstraint{})
 	if err != nil {
@@ -2909,6 +2941,12 @@ func decodeConstraints(cons applicationConstraints) constraints.Constraints {
 		if row.ImageID.Valid {
 			res.ImageID = &row.ImageID.String
 		}
+		if row.Spot.Valid {
+			res.Spot = &row.Spot.Bool
+		}
+		if row.SpotMaxPrice.Valid {
+			res.SpotMaxPrice = &row.SpotMaxPrice.Float64
+		}
 		if row.SpaceName.Valid {
 			var exclude bool
 			if row.SpaceExclude.Valid {
@@ -2959,6 +2997,8 @@ func encodeConstraints(constraintUUID string, cons constraints.Constraints, cont
 		VirtType:         cons.VirtType,
 		ImageID:          cons.ImageID,
 		AllocatePublicIP: cons.AllocatePublicIP,
+		Spot:             cons.Spot,
+		SpotMaxPrice:     cons.SpotMaxPrice,
 	}
 	if cons.Container != nil {
 		res.ContainerTypeID = &containerTypeID
@@ -3067,6 +3107,59 @@ WHERE application_uuid = $applicationID.uuid;
 	return result, nil
 }
 
+// setApplicationDeploymentType sets the deployment type override of the
+// application. The deployment type cannot be changed once the kubernetes
+// workload of the application exists, as the workload resource can't be
+// converted in place; [applicationerrors.DeploymentTypeNotChangeable] is
+// returned in that case.
+func (st *State) setApplicationDeploymentType(ctx context.Context, tx *sqlair.TX, appID applicationID, deploymentTypeID int) error {
+	settings, err := st.getApplicationSettings(ctx, tx, appID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if settings.DeploymentTypeID == deploymentTypeID {
+		return nil
+	}
+
+	serviceQuery := `
+SELECT COUNT(*) AS &countResult.count
+FROM k8s_service
+WHERE application_uuid = $applicationID.uuid;
+`
+	serviceStmt, err := st.Prepare(serviceQuery, countResult{}, appID)
+	if err != nil {
+		return errors.Errorf("preparing query for application workload: %w", err)
+	}
+
+	upsertQuery := `
+INSERT INTO application_setting (*)
+VALUES ($setApplicationSettings.*)
+ON CONFLICT(application_uuid) DO UPDATE SET
+    deployment_type_id = excluded.deployment_type_id;
+`
+	upsertStmt, err := st.Prepare(upsertQuery, setApplicationSettings{})
+	if err != nil {
+		return errors.Errorf("preparing query for application deployment type: %w", err)
+	}
+
+	var services countResult
+	if err := tx.Query(ctx, serviceStmt, appID).Get(&services); err != nil {
+		return errors.Errorf("querying application workload: %w", err)
+	}
+	if services.Count > 0 {
+		return applicationerrors.DeploymentTypeNotChangeable
+	}
+
+	if err := tx.Query(ctx, upsertStmt, setApplicationSettings{
+		ApplicationUUID:  appID.ID,
+		Trust:            settings.Trust,
+		DeploymentTypeID: deploymentTypeID,
+	}).Run(); err != nil {
+		return errors.Errorf("setting deployment type: %w", err)
+	}
+	return nil
+}
+
 func (st *State) insertApplicationConfig(
 	ctx context.Context,
 	tx *sqlair.TX,
@@ -3123,9 +3216,15 @@ VALUES ($setApplicationSettings.*);
 		return errors.Errorf("preparing insert query: %w", err)
 	}
 
+	deploymentTypeID, err := encodeDeploymentType(settings.DeploymentType)
+	if err != nil {
+		return errors.Errorf("encoding deployment type: %w", err)
+	}
+
 	if err := tx.Query(ctx, insertStmt, setApplicationSettings{
-		ApplicationUUID: appID,
-		Trust:           settings.Trust,
+		ApplicationUUID:  appID,
+		Trust:            settings.Trust,
+		DeploymentTypeID: deploymentTypeID,
 	}).Run(); err != nil {
 		return errors.Errorf("inserting settings: %w", err)
 	}
@@ -3252,6 +3351,13 @@ func hashConfigAndSettings(config []applicationConfig, settings applicationSetti
 	if _, err := h.Write([]byte(strconv.FormatBool(settings.Trust))); err != nil {
 		return "", errors.Errorf("writing settings: %w", err)
 	}
+	// The deployment type is only written when overridden, so that the hash
+	// of applications deferring to their charm is unchanged.
+	if settings.DeploymentTypeID != 0 {
+		if _, err := h.Write([]byte(strconv.Itoa(settings.DeploymentTypeID))); err != nil {
+			return "", errors.Errorf("writing settings: %w", err)
+		}
+	}
 
 	return hex.EncodeToString(h.Sum(nil)), nil
 }
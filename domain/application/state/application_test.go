@@ -173,7 +173,8 @@ func (s *applicationStateSuite) TestCreateApplicationWithConfigAndSettings(c *gc
 			},
 		},
 		Settings: application.ApplicationSettings{
-			Trust: true,
+			Trust:          true,
+			DeploymentType: charm.DeploymentStateless,
 		},
 	}, nil)
 	c.Assert(err, jc.ErrorIsNil)
@@ -189,7 +190,10 @@ func (s *applicationStateSuite) TestCreateApplicationWithConfigAndSettings(c *gc
 			Type:  charm.OptionString,
 		},
 	})
-	c.Check(settings, gc.DeepEquals, application.ApplicationSettings{Trust: true})
+	c.Check(settings, gc.DeepEquals, application.ApplicationSettings{
+		Trust:          true,
+		DeploymentType: charm.DeploymentStateless,
+	})
 }
 
 func (s *applicationStateSuite) TestCreateApplicationWithPeerRelation(c *gc.C) {
@@ -1673,6 +1677,7 @@ func (s *applicationStateSuite) TestGetCharmIDByApplicationName(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -1742,6 +1747,7 @@ func (s *applicationStateSuite) TestGetCharmByApplicationID(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -1844,9 +1850,10 @@ WHERE application_uuid = ?
 
 func (s *applicationStateSuite) TestCreateApplicationDefaultSourceIsCharmhub(c *gc.C) {
 	expectedMetadata := charm.Metadata{
-		Name:    "ubuntu",
-		RunAs:   charm.RunAsRoot,
-		Assumes: []byte{},
+		Name:           "ubuntu",
+		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
+		Assumes:        []byte{},
 	}
 	expectedManifest := charm.Manifest{
 		Bases: []charm.Base{
@@ -1924,6 +1931,7 @@ func (s *applicationStateSuite) TestSetCharmThenGetCharmByApplicationNameInvalid
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -2700,6 +2708,84 @@ func (s *applicationStateSuite) TestUnsetApplicationConfigKeys(c *gc.C) {
 	c.Check(settings, jc.DeepEquals, application.ApplicationSettings{})
 }
 
+func (s *applicationStateSuite) TestUpdateApplicationConfigAndSettingsDeploymentType(c *gc.C) {
+	id := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.UpdateApplicationConfigAndSettings(context.Background(), id,
+		map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{DeploymentType: ptr(charm.DeploymentDaemon)},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, settings, err := s.state.GetApplicationConfigAndSettings(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(settings, jc.DeepEquals, application.ApplicationSettings{
+		DeploymentType: charm.DeploymentDaemon,
+	})
+}
+
+func (s *applicationStateSuite) TestUpdateApplicationConfigAndSettingsDeploymentTypeWorkloadExists(c *gc.C) {
+	id := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.UpsertCloudService(context.Background(), "foo", "provider-id", network.SpaceAddresses{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.UpdateApplicationConfigAndSettings(context.Background(), id,
+		map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{DeploymentType: ptr(charm.DeploymentDaemon)},
+	)
+	c.Assert(err, jc.ErrorIs, applicationerrors.DeploymentTypeNotChangeable)
+
+	// Setting the deployment type to its current value is not a change.
+	err = s.state.UpdateApplicationConfigAndSettings(context.Background(), id,
+		map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{DeploymentType: ptr(charm.DeploymentType(""))},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, settings, err := s.state.GetApplicationConfigAndSettings(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(settings, jc.DeepEquals, application.ApplicationSettings{})
+}
+
+func (s *applicationStateSuite) TestUnsetApplicationConfigKeysIncludingDeploymentType(c *gc.C) {
+	id := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.UpdateApplicationConfigAndSettings(context.Background(), id,
+		map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			Trust:          ptr(true),
+			DeploymentType: ptr(charm.DeploymentStateless),
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.UnsetApplicationConfigKeys(context.Background(), id, []string{"kubernetes-deployment-type"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, settings, err := s.state.GetApplicationConfigAndSettings(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(settings, jc.DeepEquals, application.ApplicationSettings{
+		Trust: true,
+	})
+}
+
+func (s *applicationStateSuite) TestUnsetApplicationConfigKeysDeploymentTypeWorkloadExists(c *gc.C) {
+	id := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.UpdateApplicationConfigAndSettings(context.Background(), id,
+		map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{DeploymentType: ptr(charm.DeploymentStateless)},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.UpsertCloudService(context.Background(), "foo", "provider-id", network.SpaceAddresses{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.UnsetApplicationConfigKeys(context.Background(), id, []string{"kubernetes-deployment-type"})
+	c.Assert(err, jc.ErrorIs, applicationerrors.DeploymentTypeNotChangeable)
+}
+
 func (s *applicationStateSuite) TestUnsetApplicationConfigKeysApplicationNotFound(c *gc.C) {
 	// If the application is not found, it should return application not found.
 	id := applicationtesting.GenApplicationUUID(c)
@@ -3048,6 +3134,8 @@ func (s *applicationStateSuite) TestSetConstraintFull(c *gc.C) {
 		VirtType:         ptr("virt-type"),
 		AllocatePublicIP: ptr(true),
 		ImageID:          ptr("image-id"),
+		Spot:             ptr(true),
+		SpotMaxPrice:     ptr(0.25),
 		Spaces: ptr([]constraints.SpaceConstraint{
 			{SpaceName: "space0", Exclude: false},
 			{SpaceName: "space1", Exclude: true},
@@ -0,0 +1,201 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetApplicationAutoscalePolicy sets the autoscale policy of the specified
+// application, replacing any existing policy.
+//   - If the application is not alive, [applicationerrors.ApplicationNotAlive] is returned.
+//   - If the application is not found, [applicationerrors.ApplicationNotFound]
+//     is returned.
+func (st *State) SetApplicationAutoscalePolicy(
+	ctx context.Context, appUUID coreapplication.ID, policy application.AutoscalePolicy,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	arg := encodeAutoscalePolicy(appUUID, policy)
+	upsertStmt, err := st.Prepare(`
+INSERT INTO application_autoscale (*) VALUES ($applicationAutoscale.*)
+ON CONFLICT (application_uuid) DO UPDATE SET
+    min_units = excluded.min_units,
+    max_units = excluded.max_units,
+    target_cpu_percent = excluded.target_cpu_percent,
+    target_memory_percent = excluded.target_memory_percent,
+    custom_metric_name = excluded.custom_metric_name,
+    custom_metric_target = excluded.custom_metric_target
+`, arg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationAlive(ctx, tx, appUUID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, upsertStmt, arg).Run(); err != nil {
+			return errors.Errorf("setting autoscale policy for application %q: %w", appUUID, err)
+		}
+		return nil
+	})
+}
+
+// GetApplicationAutoscalePolicy returns the autoscale policy of the specified
+// application. If the application has no policy, an error satisfying
+// [applicationerrors.AutoscalePolicyNotFound] is returned.
+func (st *State) GetApplicationAutoscalePolicy(
+	ctx context.Context, appUUID coreapplication.ID,
+) (application.AutoscalePolicy, error) {
+	db, err := st.DB()
+	if err != nil {
+		return application.AutoscalePolicy{}, errors.Capture(err)
+	}
+
+	result := applicationAutoscale{ApplicationUUID: appUUID}
+	stmt, err := st.Prepare(`
+SELECT &applicationAutoscale.*
+FROM   application_autoscale
+WHERE  application_uuid = $applicationAutoscale.application_uuid
+`, result)
+	if err != nil {
+		return application.AutoscalePolicy{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, result).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("application %q: %w", appUUID, applicationerrors.AutoscalePolicyNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return application.AutoscalePolicy{}, errors.Capture(err)
+	}
+	return decodeAutoscalePolicy(result), nil
+}
+
+// RemoveApplicationAutoscalePolicy removes the autoscale policy of the
+// specified application. If the application has no policy, an error
+// satisfying [applicationerrors.AutoscalePolicyNotFound] is returned.
+func (st *State) RemoveApplicationAutoscalePolicy(ctx context.Context, appUUID coreapplication.ID) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := applicationID{ID: appUUID}
+	stmt, err := st.Prepare(`
+DELETE FROM application_autoscale
+WHERE application_uuid = $applicationID.uuid
+`, ident)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, ident).Get(&outcome); err != nil {
+			return errors.Errorf("removing autoscale policy for application %q: %w", appUUID, err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		} else if affected == 0 {
+			return errors.Errorf("application %q: %w", appUUID, applicationerrors.AutoscalePolicyNotFound)
+		}
+		return nil
+	})
+}
+
+// GetAutoscalePolicies returns the autoscale policies of all alive
+// applications in the model, along with their current scale.
+func (st *State) GetAutoscalePolicies(ctx context.Context) ([]application.ApplicationAutoscalePolicy, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	alive := applicationIDAndLife{LifeID: life.Alive}
+	stmt, err := st.Prepare(`
+SELECT a.name AS &applicationAutoscaleScale.name,
+       s.scale AS &applicationAutoscaleScale.scale,
+       aa.* AS &applicationAutoscale.*
+FROM   application_autoscale AS aa
+JOIN   application AS a ON a.uuid = aa.application_uuid
+JOIN   application_scale AS s ON s.application_uuid = aa.application_uuid
+WHERE  a.life_id = $applicationIDAndLife.life_id
+ORDER BY a.name
+`, alive, applicationAutoscaleScale{}, applicationAutoscale{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		scales   []applicationAutoscaleScale
+		policies []applicationAutoscale
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, alive).GetAll(&scales, &policies)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying autoscale policies: %w", err)
+	}
+
+	result := make([]application.ApplicationAutoscalePolicy, len(policies))
+	for i, p := range policies {
+		result[i] = application.ApplicationAutoscalePolicy{
+			ApplicationName: scales[i].Name,
+			Scale:           scales[i].Scale,
+			Policy:          decodeAutoscalePolicy(p),
+		}
+	}
+	return result, nil
+}
+
+func encodeAutoscalePolicy(appUUID coreapplication.ID, policy application.AutoscalePolicy) applicationAutoscale {
+	result := applicationAutoscale{
+		ApplicationUUID: appUUID,
+		MinUnits:        policy.MinUnits,
+		MaxUnits:        policy.MaxUnits,
+	}
+	if policy.TargetCPUPercent > 0 {
+		result.TargetCPUPercent = sql.NullInt64{Int64: int64(policy.TargetCPUPercent), Valid: true}
+	}
+	if policy.TargetMemoryPercent > 0 {
+		result.TargetMemoryPercent = sql.NullInt64{Int64: int64(policy.TargetMemoryPercent), Valid: true}
+	}
+	if policy.CustomMetric != "" {
+		result.CustomMetricName = sql.NullString{String: policy.CustomMetric, Valid: true}
+		result.CustomMetricTarget = sql.NullFloat64{Float64: policy.CustomMetricTarget, Valid: true}
+	}
+	return result
+}
+
+func decodeAutoscalePolicy(policy applicationAutoscale) application.AutoscalePolicy {
+	return application.AutoscalePolicy{
+		MinUnits:            policy.MinUnits,
+		MaxUnits:            policy.MaxUnits,
+		TargetCPUPercent:    int(policy.TargetCPUPercent.Int64),
+		TargetMemoryPercent: int(policy.TargetMemoryPercent.Int64),
+		CustomMetric:        policy.CustomMetricName.String,
+		CustomMetricTarget:  policy.CustomMetricTarget.Float64,
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type autoscaleStateSuite struct {
+	baseSuite
+
+	state *State
+}
+
+var _ = gc.Suite(&autoscaleStateSuite{})
+
+func (s *autoscaleStateSuite) SetUpTest(c *gc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.state = NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *autoscaleStateSuite) TestSetAndGetAutoscalePolicy(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	policy := application.AutoscalePolicy{
+		MinUnits:           1,
+		MaxUnits:           5,
+		TargetCPUPercent:   70,
+		CustomMetric:       "requests_per_second",
+		CustomMetricTarget: 100.5,
+	}
+	err := s.state.SetApplicationAutoscalePolicy(context.Background(), appID, policy)
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := s.state.GetApplicationAutoscalePolicy(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, policy)
+
+	// Setting the policy again replaces it.
+	policy = application.AutoscalePolicy{
+		MinUnits:            2,
+		MaxUnits:            3,
+		TargetMemoryPercent: 80,
+	}
+	err = s.state.SetApplicationAutoscalePolicy(context.Background(), appID, policy)
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err = s.state.GetApplicationAutoscalePolicy(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, policy)
+}
+
+func (s *autoscaleStateSuite) TestSetAutoscalePolicyApplicationNotFound(c *gc.C) {
+	appID := coreapplication.ID(uuid.MustNewUUID().String())
+
+	err := s.state.SetApplicationAutoscalePolicy(context.Background(), appID, application.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         2,
+		TargetCPUPercent: 50,
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *autoscaleStateSuite) TestGetAutoscalePolicyNotFound(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	_, err := s.state.GetApplicationAutoscalePolicy(context.Background(), appID)
+	c.Assert(err, jc.ErrorIs, applicationerrors.AutoscalePolicyNotFound)
+}
+
+func (s *autoscaleStateSuite) TestRemoveAutoscalePolicy(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationAutoscalePolicy(context.Background(), appID, application.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         2,
+		TargetCPUPercent: 50,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.RemoveApplicationAutoscalePolicy(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.GetApplicationAutoscalePolicy(context.Background(), appID)
+	c.Assert(err, jc.ErrorIs, applicationerrors.AutoscalePolicyNotFound)
+
+	err = s.state.RemoveApplicationAutoscalePolicy(context.Background(), appID)
+	c.Assert(err, jc.ErrorIs, applicationerrors.AutoscalePolicyNotFound)
+}
+
+func (s *autoscaleStateSuite) TestGetAutoscalePolicies(c *gc.C) {
+	fooID := s.createApplication(c, "foo", life.Alive)
+	barID := s.createApplication(c, "bar", life.Alive)
+	s.createApplication(c, "baz", life.Alive)
+
+	fooPolicy := application.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         5,
+		TargetCPUPercent: 70,
+	}
+	barPolicy := application.AutoscalePolicy{
+		MinUnits:            0,
+		MaxUnits:            3,
+		TargetMemoryPercent: 60,
+	}
+	err := s.state.SetApplicationAutoscalePolicy(context.Background(), fooID, fooPolicy)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetApplicationAutoscalePolicy(context.Background(), barID, barPolicy)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetDesiredApplicationScale(context.Background(), fooID, 3)
+	c.Assert(err, jc.ErrorIsNil)
+
+	policies, err := s.state.GetAutoscalePolicies(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(policies, jc.DeepEquals, []application.ApplicationAutoscalePolicy{{
+		ApplicationName: "bar",
+		Scale:           0,
+		Policy:          barPolicy,
+	}, {
+		ApplicationName: "foo",
+		Scale:           3,
+		Policy:          fooPolicy,
+	}})
+}
+
+func (s *autoscaleStateSuite) TestGetAutoscalePoliciesNone(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+
+	policies, err := s.state.GetAutoscalePolicies(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(policies, gc.HasLen, 0)
+}
+
+func (s *autoscaleStateSuite) TestDeleteApplicationRemovesAutoscalePolicy(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationAutoscalePolicy(context.Background(), appID, application.AutoscalePolicy{
+		MinUnits:         1,
+		MaxUnits:         2,
+		TargetCPUPercent: 50,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.DeleteApplication(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.GetApplicationAutoscalePolicy(context.Background(), appID)
+	c.Assert(err, jc.ErrorIs, applicationerrors.AutoscalePolicyNotFound)
+}
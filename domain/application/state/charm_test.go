@@ -109,6 +109,7 @@ func (s *charmStateSuite) TestSetCharmObjectStoreUUID(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -156,6 +157,7 @@ func (s *charmStateSuite) TestSetCharmWithoutObjectStoreUUID(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -196,6 +198,7 @@ func (s *charmStateSuite) TestSetCharmNotAvailable(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -241,6 +244,7 @@ func (s *charmStateSuite) TestSetCharmGetCharmID(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -580,6 +584,7 @@ func (s *charmStateSuite) TestGetCharmMetadata(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	})
@@ -1280,6 +1285,7 @@ func (s *charmStateSuite) TestSetCharmDownloadInfoForCharmhub(c *gc.C) {
 			Description:    "description",
 			Subordinate:    true,
 			RunAs:          charm.RunAsRoot,
+			DeploymentType: charm.DeploymentDefault,
 			MinJujuVersion: semversion.MustParse("4.0.0"),
 			Assumes:        []byte("null"),
 		},
@@ -1325,6 +1331,7 @@ func (s *charmStateSuite) TestSetCharmDownloadInfoForCharmhubWithoutDownloadInfo
 			Description:    "description",
 			Subordinate:    true,
 			RunAs:          charm.RunAsRoot,
+			DeploymentType: charm.DeploymentDefault,
 			MinJujuVersion: semversion.MustParse("4.0.0"),
 			Assumes:        []byte("null"),
 		},
@@ -1368,6 +1375,7 @@ func (s *charmStateSuite) TestSetCharmDownloadInfoForLocal(c *gc.C) {
 			Description:    "description",
 			Subordinate:    true,
 			RunAs:          charm.RunAsRoot,
+			DeploymentType: charm.DeploymentDefault,
 			MinJujuVersion: semversion.MustParse("4.0.0"),
 			Assumes:        []byte("null"),
 		},
@@ -1416,6 +1424,7 @@ func (s *charmStateSuite) TestSetCharmCharmSequencingInvalidRevision(c *gc.C) {
 			Description:    "description",
 			Subordinate:    true,
 			RunAs:          charm.RunAsRoot,
+			DeploymentType: charm.DeploymentDefault,
 			MinJujuVersion: semversion.MustParse("4.0.0"),
 			Assumes:        []byte("null"),
 		},
@@ -1456,6 +1465,7 @@ func (s *charmStateSuite) TestSetCharmLocalCharmSequencing(c *gc.C) {
 			Description:    "description",
 			Subordinate:    true,
 			RunAs:          charm.RunAsRoot,
+			DeploymentType: charm.DeploymentDefault,
 			MinJujuVersion: semversion.MustParse("4.0.0"),
 			Assumes:        []byte("null"),
 		},
@@ -1502,6 +1512,7 @@ func (s *charmStateSuite) TestSetCharmDownloadInfoForLocalWithoutInfo(c *gc.C) {
 			Description:    "description",
 			Subordinate:    true,
 			RunAs:          charm.RunAsRoot,
+			DeploymentType: charm.DeploymentDefault,
 			MinJujuVersion: semversion.MustParse("4.0.0"),
 			Assumes:        []byte("null"),
 		},
@@ -1539,6 +1550,7 @@ func (s *charmStateSuite) TestSetCharmTwice(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -1584,6 +1596,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharm(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -1666,6 +1679,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmProvidesJujuInfo(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Provides:       jujuInfoRelation(),
@@ -1721,6 +1735,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmWithDifferentReferenceName(c *
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -1804,6 +1819,7 @@ func (s *charmStateSuite) TestSetCharmAllowsSameNameButDifferentRevision(c *gc.C
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -1868,6 +1884,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadata(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}
@@ -1907,6 +1924,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithTagsAndCategories(
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Tags:           []string{"foo", "foo", "bar"},
@@ -1948,6 +1966,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithTerms(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Terms:          []string{"foo", "foo", "bar"},
@@ -1988,6 +2007,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithRelations(c *gc.C)
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Provides: map[string]charm.Relation{
@@ -2054,6 +2074,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithExtraBindings(c *g
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		ExtraBindings: map[string]charm.ExtraBinding{
@@ -2101,6 +2122,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithStorageWithNoPrope
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Storage: map[string]charm.Storage{
@@ -2164,6 +2186,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithStorageWithPropert
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Storage: map[string]charm.Storage{
@@ -2230,6 +2253,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithDevices(c *gc.C) {
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Devices: map[string]charm.Device{
@@ -2285,6 +2309,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithResources(c *gc.C)
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Resources: map[string]charm.Resource{
@@ -2338,6 +2363,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithContainersWithNoMo
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Containers: map[string]charm.Container{
@@ -2387,6 +2413,7 @@ func (s *charmStateSuite) TestSetCharmThenGetCharmMetadataWithContainersWithMoun
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 		Containers: map[string]charm.Container{
@@ -3689,6 +3716,7 @@ func insertCharmMetadata(ctx context.Context, c *gc.C, tx *sql.Tx, uuid string)
 		Description:    "description",
 		Subordinate:    true,
 		RunAs:          charm.RunAsRoot,
+		DeploymentType: charm.DeploymentDefault,
 		MinJujuVersion: semversion.MustParse("4.0.0"),
 		Assumes:        []byte("null"),
 	}, nil
@@ -0,0 +1,408 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"sort"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/set"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+const selectFirewallRulesQuery = `
+SELECT
+    r.uuid AS &firewallRuleRow.uuid,
+    a.name AS &firewallRuleRow.application_name,
+    cr.name AS &firewallRuleRow.endpoint,
+    p.protocol AS &firewallRuleRow.protocol,
+    r.from_port AS &firewallRuleRow.from_port,
+    r.to_port AS &firewallRuleRow.to_port
+FROM application_firewall_rule AS r
+JOIN application AS a ON r.application_uuid = a.uuid
+LEFT JOIN application_endpoint AS ae ON r.application_endpoint_uuid = ae.uuid
+LEFT JOIN charm_relation AS cr ON ae.charm_relation_uuid = cr.uuid
+LEFT JOIN protocol AS p ON r.protocol_id = p.id
+`
+
+const selectFirewallRuleSourcesQuery = `
+SELECT &firewallRuleSource.*
+FROM v_application_firewall_rule_source
+`
+
+// GetFirewallRules returns the firewall rules of the provided application.
+func (st *State) GetFirewallRules(ctx context.Context, appID coreapplication.ID) ([]application.FirewallRule, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := applicationID{ID: appID}
+	rulesStmt, err := st.Prepare(selectFirewallRulesQuery+`
+WHERE r.application_uuid = $applicationID.uuid`, firewallRuleRow{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing firewall rules query: %w", err)
+	}
+
+	sourcesStmt, err := st.Prepare(selectFirewallRuleSourcesQuery+`
+WHERE application_uuid = $applicationID.uuid`, firewallRuleSource{}, ident)
+	if err != nil {
+		return nil, errors.Errorf("preparing firewall rule sources query: %w", err)
+	}
+
+	var (
+		rules   []firewallRuleRow
+		sources []firewallRuleSource
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, rulesStmt, ident).GetAll(&rules); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving firewall rules for application %q: %w", appID, err)
+		}
+		if err := tx.Query(ctx, sourcesStmt, ident).GetAll(&sources); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving firewall rule sources for application %q: %w", appID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return encodeFirewallRules(rules, sources)[rules[0].ApplicationName], nil
+}
+
+// GetAllFirewallRules returns the firewall rules of all applications in the
+// model, keyed on application name.
+func (st *State) GetAllFirewallRules(ctx context.Context) (map[string][]application.FirewallRule, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rulesStmt, err := st.Prepare(selectFirewallRulesQuery, firewallRuleRow{})
+	if err != nil {
+		return nil, errors.Errorf("preparing firewall rules query: %w", err)
+	}
+	sourcesStmt, err := st.Prepare(selectFirewallRuleSourcesQuery, firewallRuleSource{})
+	if err != nil {
+		return nil, errors.Errorf("preparing firewall rule sources query: %w", err)
+	}
+
+	var (
+		rules   []firewallRuleRow
+		sources []firewallRuleSource
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, rulesStmt).GetAll(&rules); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving firewall rules: %w", err)
+		}
+		if err := tx.Query(ctx, sourcesStmt).GetAll(&sources); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving firewall rule sources: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	return encodeFirewallRules(rules, sources), nil
+}
+
+// SetFirewallRule adds the provided firewall rule to the application,
+// replacing any existing rule for the same endpoint and port range.
+//
+// If any of the referenced CIDR sets do not exist, an error satisfying
+// [networkerrors.CIDRSetNotFound] is returned.
+func (st *State) SetFirewallRule(ctx context.Context, appID coreapplication.ID, rule application.FirewallRule) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	insertRule := setFirewallRule{
+		ApplicationUUID: appID.String(),
+		EndpointName:    rule.Endpoint,
+	}
+	if rule.PortRange != nil {
+		insertRule.Protocol = rule.PortRange.Protocol
+		insertRule.FromPort = rule.PortRange.FromPort
+		insertRule.ToPort = rule.PortRange.ToPort
+	}
+
+	// The endpoint and protocol are resolved from their names. NULL values
+	// represent the wildcard endpoint and the opened ports respectively.
+	var insertRuleQuery string
+	switch {
+	case rule.Endpoint == network.WildcardEndpoint && rule.PortRange == nil:
+		insertRuleQuery = `
+INSERT INTO application_firewall_rule (uuid, application_uuid)
+VALUES ($setFirewallRule.uuid, $setFirewallRule.application_uuid)`
+	case rule.Endpoint == network.WildcardEndpoint:
+		insertRuleQuery = `
+INSERT INTO application_firewall_rule (uuid, application_uuid, protocol_id, from_port, to_port)
+    SELECT $setFirewallRule.uuid, $setFirewallRule.application_uuid, id,
+           $setFirewallRule.from_port, $setFirewallRule.to_port
+    FROM protocol
+    WHERE protocol = $setFirewallRule.protocol`
+	case rule.PortRange == nil:
+		insertRuleQuery = `
+INSERT INTO application_firewall_rule (uuid, application_uuid, application_endpoint_uuid)
+    SELECT $setFirewallRule.uuid, $setFirewallRule.application_uuid, uuid
+    FROM v_application_endpoint_uuid
+    WHERE name = $setFirewallRule.endpoint
+    AND application_uuid = $setFirewallRule.application_uuid`
+	default:
+		insertRuleQuery = `
+INSERT INTO application_firewall_rule (uuid, application_uuid, application_endpoint_uuid, protocol_id, from_port, to_port)
+    SELECT $setFirewallRule.uuid, $setFirewallRule.application_uuid, e.uuid, p.id,
+           $setFirewallRule.from_port, $setFirewallRule.to_port
+    FROM v_application_endpoint_uuid AS e, protocol AS p
+    WHERE e.name = $setFirewallRule.endpoint
+    AND e.application_uuid = $setFirewallRule.application_uuid
+    AND p.protocol = $setFirewallRule.protocol`
+	}
+	insertRuleStmt, err := st.Prepare(insertRuleQuery, insertRule)
+	if err != nil {
+		return errors.Errorf("preparing insert firewall rule query: %w", err)
+	}
+
+	insertCIDRStmt, err := st.Prepare(`
+INSERT INTO application_firewall_rule_cidr (application_firewall_rule_uuid, cidr)
+VALUES ($firewallRuleCIDR.*)`, firewallRuleCIDR{})
+	if err != nil {
+		return errors.Errorf("preparing insert firewall rule cidr query: %w", err)
+	}
+
+	insertCIDRSetStmt, err := st.Prepare(`
+INSERT INTO application_firewall_rule_cidr_set (application_firewall_rule_uuid, cidr_set_uuid)
+    SELECT $firewallRuleCIDRSet.rule_uuid, uuid
+    FROM cidr_set
+    WHERE name = $firewallRuleCIDRSet.name`, firewallRuleCIDRSet{})
+	if err != nil {
+		return errors.Errorf("preparing insert firewall rule cidr set query: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		existing, err := st.findFirewallRule(ctx, tx, appID, rule.Endpoint, rule.PortRange)
+		if err != nil && !errors.Is(err, applicationerrors.FirewallRuleNotFound) {
+			return errors.Capture(err)
+		} else if err == nil {
+			if err := st.deleteFirewallRules(ctx, tx, existing); err != nil {
+				return errors.Capture(err)
+			}
+		}
+
+		ruleUUID, err := uuid.NewUUID()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		insertRule.UUID = ruleUUID.String()
+
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, insertRuleStmt, insertRule).Get(&outcome); err != nil {
+			return errors.Errorf("inserting firewall rule for application %q: %w", appID, err)
+		}
+		if n, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		} else if n != 1 {
+			return errors.Errorf("endpoint %q of application %q", rule.Endpoint, appID).
+				Add(applicationerrors.EndpointNotFound)
+		}
+
+		for _, cidr := range rule.CIDRs.SortedValues() {
+			row := firewallRuleCIDR{RuleUUID: insertRule.UUID, CIDR: cidr}
+			if err := tx.Query(ctx, insertCIDRStmt, row).Run(); err != nil {
+				return errors.Errorf("inserting cidr %q for firewall rule: %w", cidr, err)
+			}
+		}
+		for _, name := range rule.CIDRSets.SortedValues() {
+			row := firewallRuleCIDRSet{RuleUUID: insertRule.UUID, Name: name}
+			var outcome sqlair.Outcome
+			if err := tx.Query(ctx, insertCIDRSetStmt, row).Get(&outcome); err != nil {
+				return errors.Errorf("inserting cidr set %q for firewall rule: %w", name, err)
+			}
+			if n, err := outcome.Result().RowsAffected(); err != nil {
+				return errors.Capture(err)
+			} else if n != 1 {
+				return errors.Errorf("cidr set %q", name).Add(networkerrors.CIDRSetNotFound)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteFirewallRule removes the firewall rule of the application for the
+// provided endpoint and port range.
+//
+// If no such rule exists, an error satisfying
+// [applicationerrors.FirewallRuleNotFound] is returned.
+func (st *State) DeleteFirewallRule(ctx context.Context, appID coreapplication.ID, endpoint string, portRange *network.PortRange) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		ruleUUID, err := st.findFirewallRule(ctx, tx, appID, endpoint, portRange)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		return st.deleteFirewallRules(ctx, tx, ruleUUID)
+	})
+}
+
+// NamespaceForWatchFirewallRules returns the namespace identifiers for
+// firewall rule changes. The first return value is the namespace for the
+// application firewall rule table, and the second is the namespace for the
+// CIDRs of CIDR sets, which rules may refer to.
+func (*State) NamespaceForWatchFirewallRules() (string, string) {
+	return "application_firewall_rule", "cidr_set_cidr"
+}
+
+// findFirewallRule returns the uuid of the application's firewall rule for
+// the endpoint and port range, or an error satisfying
+// [applicationerrors.FirewallRuleNotFound].
+func (st *State) findFirewallRule(
+	ctx context.Context, tx *sqlair.TX, appID coreapplication.ID, endpoint string, portRange *network.PortRange,
+) (string, error) {
+	ident := applicationID{ID: appID}
+	stmt, err := st.Prepare(selectFirewallRulesQuery+`
+WHERE r.application_uuid = $applicationID.uuid`, firewallRuleRow{}, ident)
+	if err != nil {
+		return "", errors.Errorf("preparing firewall rules query: %w", err)
+	}
+
+	var rows []firewallRuleRow
+	if err := tx.Query(ctx, stmt, ident).GetAll(&rows); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return "", errors.Errorf("retrieving firewall rules for application %q: %w", appID, err)
+	}
+	for _, row := range rows {
+		if row.Endpoint.String != endpoint {
+			continue
+		}
+		rowRange := row.portRange()
+		if (rowRange == nil) != (portRange == nil) {
+			continue
+		}
+		if rowRange == nil || *rowRange == *portRange {
+			return row.UUID, nil
+		}
+	}
+	return "", errors.Errorf("endpoint %q of application %q", endpoint, appID).
+		Add(applicationerrors.FirewallRuleNotFound)
+}
+
+// deleteFirewallRules removes the firewall rules with the provided uuids,
+// along with their sources.
+func (st *State) deleteFirewallRules(ctx context.Context, tx *sqlair.TX, ruleUUIDs ...string) error {
+	uuids := firewallRuleUUIDs(ruleUUIDs)
+	for _, query := range []string{
+		`DELETE FROM application_firewall_rule_cidr WHERE application_firewall_rule_uuid IN ($firewallRuleUUIDs[:])`,
+		`DELETE FROM application_firewall_rule_cidr_set WHERE application_firewall_rule_uuid IN ($firewallRuleUUIDs[:])`,
+		`DELETE FROM application_firewall_rule WHERE uuid IN ($firewallRuleUUIDs[:])`,
+	} {
+		stmt, err := st.Prepare(query, uuids)
+		if err != nil {
+			return errors.Errorf("preparing delete firewall rules query: %w", err)
+		}
+		if err := tx.Query(ctx, stmt, uuids).Run(); err != nil {
+			return errors.Errorf("deleting firewall rules: %w", err)
+		}
+	}
+	return nil
+}
+
+// deleteApplicationFirewallRules removes all firewall rules of the
+// application, along with their sources.
+func (st *State) deleteApplicationFirewallRules(ctx context.Context, tx *sqlair.TX, appID coreapplication.ID) error {
+	ident := applicationID{ID: appID}
+	for _, query := range []string{
+		`
+DELETE FROM application_firewall_rule_cidr
+WHERE application_firewall_rule_uuid IN (
+    SELECT uuid FROM application_firewall_rule WHERE application_uuid = $applicationID.uuid
+)`,
+		`
+DELETE FROM application_firewall_rule_cidr_set
+WHERE application_firewall_rule_uuid IN (
+    SELECT uuid FROM application_firewall_rule WHERE application_uuid = $applicationID.uuid
+)`,
+		`DELETE FROM application_firewall_rule WHERE application_uuid = $applicationID.uuid`,
+	} {
+		stmt, err := st.Prepare(query, ident)
+		if err != nil {
+			return errors.Errorf("preparing delete application firewall rules query: %w", err)
+		}
+		if err := tx.Query(ctx, stmt, ident).Run(); err != nil {
+			return errors.Errorf("deleting firewall rules of application %q: %w", appID, err)
+		}
+	}
+	return nil
+}
+
+// encodeFirewallRules groups the rule and source rows into firewall rules,
+// keyed on application name.
+func encodeFirewallRules(rows []firewallRuleRow, sources []firewallRuleSource) map[string][]application.FirewallRule {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	rules := make(map[string]*application.FirewallRule, len(rows))
+	for _, row := range rows {
+		rules[row.UUID] = &application.FirewallRule{
+			Endpoint:    row.Endpoint.String,
+			PortRange:   row.portRange(),
+			CIDRs:       set.NewStrings(),
+			CIDRSets:    set.NewStrings(),
+			SourceCIDRs: set.NewStrings(),
+		}
+	}
+	for _, source := range sources {
+		rule, ok := rules[source.RuleUUID]
+		if !ok {
+			continue
+		}
+		if source.CIDRSetName.Valid {
+			rule.CIDRSets.Add(source.CIDRSetName.String)
+		} else if source.CIDR.Valid {
+			rule.CIDRs.Add(source.CIDR.String)
+		}
+		if source.CIDR.Valid {
+			rule.SourceCIDRs.Add(source.CIDR.String)
+		}
+	}
+
+	result := make(map[string][]application.FirewallRule)
+	for _, row := range rows {
+		result[row.ApplicationName] = append(result[row.ApplicationName], *rules[row.UUID])
+	}
+	for _, appRules := range result {
+		sort.Slice(appRules, func(i, j int) bool {
+			return firewallRuleLess(appRules[i], appRules[j])
+		})
+	}
+	return result
+}
+
+// firewallRuleLess orders rules by endpoint, and then by port range with
+// rules for the opened ports first.
+func firewallRuleLess(a, b application.FirewallRule) bool {
+	if a.Endpoint != b.Endpoint {
+		return a.Endpoint < b.Endpoint
+	}
+	if a.PortRange == nil || b.PortRange == nil {
+		return a.PortRange == nil && b.PortRange != nil
+	}
+	return a.PortRange.LessThan(*b.PortRange)
+}
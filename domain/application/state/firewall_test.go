@@ -0,0 +1,258 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type firewallStateSuite struct {
+	baseSuite
+
+	state *State
+}
+
+var _ = gc.Suite(&firewallStateSuite{})
+
+func (s *firewallStateSuite) SetUpTest(c *gc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.state = NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *firewallStateSuite) TestGetFirewallRulesEmpty(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	rules, err := s.state.GetFirewallRules(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(rules, gc.HasLen, 0)
+}
+
+func (s *firewallStateSuite) TestSetFirewallRule(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+	s.setUpEndpoint(c, appID)
+	s.createCIDRSet(c, "office", "10.0.0.0/8", "192.168.0.0/16")
+
+	portRange := network.MustParsePortRange("8080-8090/tcp")
+	err := s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		Endpoint:  "endpoint0",
+		PortRange: &portRange,
+		CIDRs:     set.NewStrings("172.16.0.0/12"),
+		CIDRSets:  set.NewStrings("office"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		CIDRs: set.NewStrings("203.0.113.0/24"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	rules, err := s.state.GetFirewallRules(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(rules, jc.DeepEquals, []application.FirewallRule{{
+		CIDRs:       set.NewStrings("203.0.113.0/24"),
+		CIDRSets:    set.NewStrings(),
+		SourceCIDRs: set.NewStrings("203.0.113.0/24"),
+	}, {
+		Endpoint:    "endpoint0",
+		PortRange:   &portRange,
+		CIDRs:       set.NewStrings("172.16.0.0/12"),
+		CIDRSets:    set.NewStrings("office"),
+		SourceCIDRs: set.NewStrings("172.16.0.0/12", "10.0.0.0/8", "192.168.0.0/16"),
+	}})
+}
+
+func (s *firewallStateSuite) TestSetFirewallRuleReplacesRule(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+	s.setUpEndpoint(c, appID)
+
+	err := s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		Endpoint: "endpoint0",
+		CIDRs:    set.NewStrings("10.0.0.0/8"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		Endpoint: "endpoint0",
+		CIDRs:    set.NewStrings("10.1.0.0/16"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	rules, err := s.state.GetFirewallRules(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rules, gc.HasLen, 1)
+	c.Check(rules[0].CIDRs.SortedValues(), jc.DeepEquals, []string{"10.1.0.0/16"})
+}
+
+func (s *firewallStateSuite) TestSetFirewallRuleEndpointNotFound(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		Endpoint: "missing",
+		CIDRs:    set.NewStrings("10.0.0.0/8"),
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.EndpointNotFound)
+}
+
+func (s *firewallStateSuite) TestSetFirewallRuleCIDRSetNotFound(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		CIDRSets: set.NewStrings("missing"),
+	})
+	c.Assert(err, jc.ErrorIs, networkerrors.CIDRSetNotFound)
+
+	// The rule is not persisted when one of its sources is invalid.
+	rules, err := s.state.GetFirewallRules(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(rules, gc.HasLen, 0)
+}
+
+func (s *firewallStateSuite) TestDeleteFirewallRule(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+	s.setUpEndpoint(c, appID)
+
+	portRange := network.MustParsePortRange("443/tcp")
+	err := s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		Endpoint:  "endpoint0",
+		PortRange: &portRange,
+		CIDRs:     set.NewStrings("10.0.0.0/8"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A rule on the same endpoint without a port range is a different rule.
+	err = s.state.DeleteFirewallRule(context.Background(), appID, "endpoint0", nil)
+	c.Assert(err, jc.ErrorIs, applicationerrors.FirewallRuleNotFound)
+
+	err = s.state.DeleteFirewallRule(context.Background(), appID, "endpoint0", &portRange)
+	c.Assert(err, jc.ErrorIsNil)
+
+	rules, err := s.state.GetFirewallRules(context.Background(), appID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(rules, gc.HasLen, 0)
+}
+
+func (s *firewallStateSuite) TestGetAllFirewallRules(c *gc.C) {
+	fooID := s.createApplication(c, "foo", life.Alive)
+	barID := s.createApplication(c, "bar", life.Alive)
+
+	err := s.state.SetFirewallRule(context.Background(), fooID, application.FirewallRule{
+		CIDRs: set.NewStrings("10.0.0.0/8"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetFirewallRule(context.Background(), barID, application.FirewallRule{
+		CIDRs: set.NewStrings("10.1.0.0/16"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	rules, err := s.state.GetAllFirewallRules(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rules, gc.HasLen, 2)
+	c.Check(rules["foo"][0].SourceCIDRs.SortedValues(), jc.DeepEquals, []string{"10.0.0.0/8"})
+	c.Check(rules["bar"][0].SourceCIDRs.SortedValues(), jc.DeepEquals, []string{"10.1.0.0/16"})
+}
+
+func (s *firewallStateSuite) TestDeleteApplicationFirewallRules(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+	s.setUpEndpoint(c, appID)
+	s.createCIDRSet(c, "office", "10.0.0.0/8")
+
+	err := s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		Endpoint: "endpoint0",
+		CIDRs:    set.NewStrings("10.1.0.0/16"),
+		CIDRSets: set.NewStrings("office"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.TxnRunner().Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return s.state.deleteApplicationFirewallRules(ctx, tx, appID)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertNoRows(c, "application_firewall_rule")
+	s.assertNoRows(c, "application_firewall_rule_cidr")
+	s.assertNoRows(c, "application_firewall_rule_cidr_set")
+}
+
+func (s *firewallStateSuite) TestDeleteApplicationWithFirewallRules(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+	s.setUpEndpoint(c, appID)
+	s.createCIDRSet(c, "office", "10.0.0.0/8")
+
+	err := s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		Endpoint: "endpoint0",
+		CIDRs:    set.NewStrings("10.1.0.0/16"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	portRange := network.MustParsePortRange("5432/tcp")
+	err = s.state.SetFirewallRule(context.Background(), appID, application.FirewallRule{
+		PortRange: &portRange,
+		CIDRSets:  set.NewStrings("office"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.DeleteApplication(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertNoRows(c, "application_firewall_rule")
+	s.assertNoRows(c, "application_firewall_rule_cidr")
+	s.assertNoRows(c, "application_firewall_rule_cidr_set")
+	s.assertNoRows(c, "application_endpoint")
+	s.assertNoRows(c, "application")
+}
+
+func (s *firewallStateSuite) setUpEndpoint(c *gc.C, appID coreapplication.ID) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO charm (uuid, reference_name) VALUES (?, ?)`,
+			"charm0-uuid", "foo"); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO charm_relation (uuid, charm_uuid, scope_id, role_id, name) VALUES (?, ?, ?, ?, ?)`,
+			"charm-relation0-uuid", "charm0-uuid", "0", "0", "endpoint0"); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO application_endpoint (uuid, application_uuid, charm_relation_uuid) VALUES (?, ?, ?)`,
+			"app-endpoint0-uuid", appID, "charm-relation0-uuid")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *firewallStateSuite) createCIDRSet(c *gc.C, name string, cidrs ...string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		setUUID := name + "-uuid"
+		if _, err := tx.ExecContext(ctx, `INSERT INTO cidr_set (uuid, name) VALUES (?, ?)`, setUUID, name); err != nil {
+			return err
+		}
+		for _, cidr := range cidrs {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO cidr_set_cidr (cidr_set_uuid, cidr) VALUES (?, ?)`, setUUID, cidr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *firewallStateSuite) assertNoRows(c *gc.C, table string) {
+	var count int
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM " + table)
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0, gc.Commentf("table %q", table))
+}
@@ -41,6 +41,11 @@ func decodeMetadata(metadata charmMetadata, args decodeMetadataArgs) (charm.Meta
 		return charm.Metadata{}, errors.Errorf("cannot decode run as %q: %w", metadata.RunAs, err)
 	}
 
+	deploymentType, err := decodeDeploymentType(metadata.DeploymentType)
+	if err != nil {
+		return charm.Metadata{}, errors.Errorf("cannot decode deployment type %q: %w", metadata.DeploymentType, err)
+	}
+
 	provides, requires, peer, err := decodeRelations(args.relations)
 	if err != nil {
 		return charm.Metadata{}, errors.Errorf("cannot decode relations: %w", err)
@@ -68,6 +73,7 @@ func decodeMetadata(metadata charmMetadata, args decodeMetadataArgs) (charm.Meta
 		Subordinate:    metadata.Subordinate,
 		MinJujuVersion: minVersion,
 		RunAs:          runAs,
+		DeploymentType: deploymentType,
 		Assumes:        metadata.Assumes,
 		Tags:           decodeTags(args.tags),
 		Categories:     decodeCategories(args.categories),
@@ -98,6 +104,39 @@ func decodeRunAs(runAs string) (charm.RunAs, error) {
 	}
 }
 
+func decodeDeploymentType(deploymentType string) (charm.DeploymentType, error) {
+	switch deploymentType {
+	case "default", "":
+		return charm.DeploymentDefault, nil
+	case "stateful":
+		return charm.DeploymentStateful, nil
+	case "stateless":
+		return charm.DeploymentStateless, nil
+	case "daemon":
+		return charm.DeploymentDaemon, nil
+	default:
+		return "", errors.Errorf("unknown deployment type value %q", deploymentType)
+	}
+}
+
+// decodeDeploymentTypeSetting decodes the deployment type override held in
+// the application settings. The default type decodes to the zero value, as
+// the application defers to its charm.
+func decodeDeploymentTypeSetting(id int) (charm.DeploymentType, error) {
+	switch id {
+	case 0:
+		return "", nil
+	case 1:
+		return charm.DeploymentStateful, nil
+	case 2:
+		return charm.DeploymentStateless, nil
+	case 3:
+		return charm.DeploymentDaemon, nil
+	default:
+		return "", errors.Errorf("unknown deployment type id %d", id)
+	}
+}
+
 func decodeTags(tags []charmTag) []string {
 	var result []string
 	for _, tag := range tags {
@@ -373,15 +412,21 @@ func encodeMetadata(id corecharm.ID, metadata charm.Metadata) (setCharmMetadata,
 		return setCharmMetadata{}, errors.Errorf("cannot encode run as %q: %w", metadata.RunAs, err)
 	}
 
+	deploymentType, err := encodeDeploymentType(metadata.DeploymentType)
+	if err != nil {
+		return setCharmMetadata{}, errors.Errorf("cannot encode deployment type %q: %w", metadata.DeploymentType, err)
+	}
+
 	return setCharmMetadata{
-		CharmUUID:      id.String(),
-		Name:           metadata.Name,
-		Summary:        metadata.Summary,
-		Description:    metadata.Description,
-		Subordinate:    metadata.Subordinate,
-		MinJujuVersion: metadata.MinJujuVersion.String(),
-		RunAsID:        runAs,
-		Assumes:        metadata.Assumes,
+		CharmUUID:        id.String(),
+		Name:             metadata.Name,
+		Summary:          metadata.Summary,
+		Description:      metadata.Description,
+		Subordinate:      metadata.Subordinate,
+		MinJujuVersion:   metadata.MinJujuVersion.String(),
+		RunAsID:          runAs,
+		DeploymentTypeID: deploymentType,
+		Assumes:          metadata.Assumes,
 	}, nil
 }
 
@@ -400,6 +445,21 @@ func encodeRunAs(runAs charm.RunAs) (int, error) {
 	}
 }
 
+func encodeDeploymentType(deploymentType charm.DeploymentType) (int, error) {
+	switch deploymentType {
+	case charm.DeploymentDefault, "":
+		return 0, nil
+	case charm.DeploymentStateful:
+		return 1, nil
+	case charm.DeploymentStateless:
+		return 2, nil
+	case charm.DeploymentDaemon:
+		return 3, nil
+	default:
+		return -1, errors.Errorf("unknown deployment type value %q", deploymentType)
+	}
+}
+
 func encodeTags(id corecharm.ID, tags []string) []setCharmTag {
 	var result []setCharmTag
 	for i, tag := range tags {
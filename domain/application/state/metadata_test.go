@@ -33,7 +33,8 @@ var metadataDecodeTestCases = [...]struct {
 		input:     charmMetadata{},
 		inputArgs: decodeMetadataArgs{},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 		},
 	},
 	{
@@ -54,6 +55,7 @@ var metadataDecodeTestCases = [...]struct {
 			Description:    "description",
 			MinJujuVersion: semversion.MustParse("2.0.0"),
 			RunAs:          charm.RunAsRoot,
+			DeploymentType: charm.DeploymentDefault,
 			Subordinate:    true,
 			Assumes:        []byte("null"),
 		},
@@ -68,8 +70,9 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
-			Tags:  []string{"tag1", "tag2"},
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
+			Tags:           []string{"tag1", "tag2"},
 		},
 	},
 	{
@@ -82,8 +85,9 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs:      charm.RunAsDefault,
-			Categories: []string{"category1", "category2"},
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
+			Categories:     []string{"category1", "category2"},
 		},
 	},
 	{
@@ -96,8 +100,9 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
-			Terms: []string{"term1", "term2"},
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
+			Terms:          []string{"term1", "term2"},
 		},
 	},
 	{
@@ -140,7 +145,8 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Provides: map[string]charm.Relation{
 				"db1": {
 					Name:      "db1",
@@ -191,7 +197,8 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			ExtraBindings: map[string]charm.ExtraBinding{
 				"foo": {Name: "foo"},
 				"baz": {Name: "baz"},
@@ -242,7 +249,8 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Storage: map[string]charm.Storage{
 				"foo": {
 					Name:        "foo",
@@ -295,7 +303,8 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Devices: map[string]charm.Device{
 				"alpha": {
 					Name:        "foo",
@@ -334,7 +343,8 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Resources: map[string]charm.Resource{
 				"foo": {
 					Name:        "foo",
@@ -383,7 +393,8 @@ var metadataDecodeTestCases = [...]struct {
 			},
 		},
 		output: charm.Metadata{
-			RunAs: charm.RunAsDefault,
+			RunAs:          charm.RunAsDefault,
+			DeploymentType: charm.DeploymentDefault,
 			Containers: map[string]charm.Container{
 				"alpha": {
 					Resource: "foo",
@@ -485,6 +496,45 @@ func (s *metadataStateSuite) TestMetadataRunAsWithError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `unknown run as value "invalid"`)
 }
 
+// Bake the charm.DeploymentType values into the database.
+func (s *metadataStateSuite) TestMetadataDeploymentType(c *gc.C) {
+	type charmDeploymentType struct {
+		ID   int    `db:"id"`
+		Name string `db:"name"`
+	}
+
+	stmt := sqlair.MustPrepare(`
+SELECT charm_deployment_type.* AS &charmDeploymentType.* FROM charm_deployment_type ORDER BY id;
+`, charmDeploymentType{})
+
+	var results []charmDeploymentType
+	err := s.TxnRunner().Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt).GetAll(&results)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 4)
+
+	m := []charm.DeploymentType{
+		charm.DeploymentDefault,
+		charm.DeploymentStateful,
+		charm.DeploymentStateless,
+		charm.DeploymentDaemon,
+	}
+
+	for i, value := range m {
+		c.Logf("result %d: %#v", i, value)
+		result, err := encodeDeploymentType(value)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(result, gc.DeepEquals, results[i].ID)
+		c.Check(string(value), gc.Equals, results[i].Name)
+	}
+}
+
+func (s *metadataStateSuite) TestMetadataDeploymentTypeWithError(c *gc.C) {
+	_, err := encodeDeploymentType(charm.DeploymentType("invalid"))
+	c.Assert(err, gc.ErrorMatches, `unknown deployment type value "invalid"`)
+}
+
 func (s *metadataStateSuite) TestMetadataRelationRole(c *gc.C) {
 	type charmRelationRole struct {
 		ID   int    `db:"id"`
@@ -82,6 +82,24 @@ type applicationScale struct {
 	ScaleTarget   int                `db:"scale_target"`
 }
 
+// applicationAutoscale represents a row of the application_autoscale table.
+type applicationAutoscale struct {
+	ApplicationUUID     coreapplication.ID `db:"application_uuid"`
+	MinUnits            int                `db:"min_units"`
+	MaxUnits            int                `db:"max_units"`
+	TargetCPUPercent    sql.NullInt64      `db:"target_cpu_percent"`
+	TargetMemoryPercent sql.NullInt64      `db:"target_memory_percent"`
+	CustomMetricName    sql.NullString     `db:"custom_metric_name"`
+	CustomMetricTarget  sql.NullFloat64    `db:"custom_metric_target"`
+}
+
+// applicationAutoscaleScale is used to get the name and current scale of an
+// application with an autoscale policy.
+type applicationAutoscaleScale struct {
+	Name  string `db:"name"`
+	Scale int    `db:"scale"`
+}
+
 type architectureMap struct {
 	ID   int    `db:"id"`
 	Name string `db:"name"`
@@ -315,19 +333,21 @@ type charmMetadata struct {
 	MinJujuVersion string `db:"min_juju_version"`
 	Assumes        []byte `db:"assumes"`
 	RunAs          string `db:"run_as"`
+	DeploymentType string `db:"deployment_type"`
 }
 
 // setCharmMetadata is used to set the metadata of a charm.
 // This includes the setting of the LXD profile.
 type setCharmMetadata struct {
-	CharmUUID      string `db:"charm_uuid"`
-	Name           string `db:"name"`
-	Summary        string `db:"summary"`
-	Description    string `db:"description"`
-	Subordinate    bool   `db:"subordinate"`
-	MinJujuVersion string `db:"min_juju_version"`
-	Assumes        []byte `db:"assumes"`
-	RunAsID        int    `db:"run_as_id"`
+	CharmUUID        string `db:"charm_uuid"`
+	Name             string `db:"name"`
+	Summary          string `db:"summary"`
+	Description      string `db:"description"`
+	Subordinate      bool   `db:"subordinate"`
+	MinJujuVersion   string `db:"min_juju_version"`
+	Assumes          []byte `db:"assumes"`
+	RunAsID          int    `db:"run_as_id"`
+	DeploymentTypeID int    `db:"deployment_type_id"`
 }
 
 // charmTag is used to get the tags of a charm.
@@ -714,12 +734,14 @@ type setApplicationConfig struct {
 }
 
 type applicationSettings struct {
-	Trust bool `db:"trust"`
+	Trust            bool `db:"trust"`
+	DeploymentTypeID int  `db:"deployment_type_id"`
 }
 
 type setApplicationSettings struct {
-	ApplicationUUID coreapplication.ID `db:"application_uuid"`
-	Trust           bool               `db:"trust"`
+	ApplicationUUID  coreapplication.ID `db:"application_uuid"`
+	Trust            bool               `db:"trust"`
+	DeploymentTypeID int                `db:"deployment_type_id"`
 }
 
 type applicationConfigHash struct {
@@ -739,23 +761,25 @@ type applicationStatus struct {
 // constraint table with the constraint_space, constraint_tag and
 // constraint_zone.
 type applicationConstraint struct {
-	ApplicationUUID  string         `db:"application_uuid"`
-	Arch             sql.NullString `db:"arch"`
-	CPUCores         sql.NullInt64  `db:"cpu_cores"`
-	CPUPower         sql.NullInt64  `db:"cpu_power"`
-	Mem              sql.NullInt64  `db:"mem"`
-	RootDisk         sql.NullInt64  `db:"root_disk"`
-	RootDiskSource   sql.NullString `db:"root_disk_source"`
-	InstanceRole     sql.NullString `db:"instance_role"`
-	InstanceType     sql.NullString `db:"instance_type"`
-	ContainerType    sql.NullString `db:"container_type"`
-	VirtType         sql.NullString `db:"virt_type"`
-	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
-	ImageID          sql.NullString `db:"image_id"`
-	SpaceName        sql.NullString `db:"space_name"`
-	SpaceExclude     sql.NullBool   `db:"space_exclude"`
-	Tag              sql.NullString `db:"tag"`
-	Zone             sql.NullString `db:"zone"`
+	ApplicationUUID  string          `db:"application_uuid"`
+	Arch             sql.NullString  `db:"arch"`
+	CPUCores         sql.NullInt64   `db:"cpu_cores"`
+	CPUPower         sql.NullInt64   `db:"cpu_power"`
+	Mem              sql.NullInt64   `db:"mem"`
+	RootDisk         sql.NullInt64   `db:"root_disk"`
+	RootDiskSource   sql.NullString  `db:"root_disk_source"`
+	InstanceRole     sql.NullString  `db:"instance_role"`
+	InstanceType     sql.NullString  `db:"instance_type"`
+	ContainerType    sql.NullString  `db:"container_type"`
+	VirtType         sql.NullString  `db:"virt_type"`
+	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
+	ImageID          sql.NullString  `db:"image_id"`
+	Spot             sql.NullBool    `db:"spot"`
+	SpotMaxPrice     sql.NullFloat64 `db:"spot_max_price"`
+	SpaceName        sql.NullString  `db:"space_name"`
+	SpaceExclude     sql.NullBool    `db:"space_exclude"`
+	Tag              sql.NullString  `db:"tag"`
+	Zone             sql.NullString  `db:"zone"`
 }
 
 type applicationConstraints []applicationConstraint
@@ -779,19 +803,21 @@ type setApplicationExtraEndpoint struct {
 }
 
 type setConstraint struct {
-	UUID             string  `db:"uuid"`
-	Arch             *string `db:"arch"`
-	CPUCores         *uint64 `db:"cpu_cores"`
-	CPUPower         *uint64 `db:"cpu_power"`
-	Mem              *uint64 `db:"mem"`
-	RootDisk         *uint64 `db:"root_disk"`
-	RootDiskSource   *string `db:"root_disk_source"`
-	InstanceRole     *string `db:"instance_role"`
-	InstanceType     *string `db:"instance_type"`
-	ContainerTypeID  *uint64 `db:"container_type_id"`
-	VirtType         *string `db:"virt_type"`
-	AllocatePublicIP *bool   `db:"allocate_public_ip"`
-	ImageID          *string `db:"image_id"`
+	UUID             string   `db:"uuid"`
+	Arch             *string  `db:"arch"`
+	CPUCores         *uint64  `db:"cpu_cores"`
+	CPUPower         *uint64  `db:"cpu_power"`
+	Mem              *uint64  `db:"mem"`
+	RootDisk         *uint64  `db:"root_disk"`
+	RootDiskSource   *string  `db:"root_disk_source"`
+	InstanceRole     *string  `db:"instance_role"`
+	InstanceType     *string  `db:"instance_type"`
+	ContainerTypeID  *uint64  `db:"container_type_id"`
+	VirtType         *string  `db:"virt_type"`
+	AllocatePublicIP *bool    `db:"allocate_public_ip"`
+	ImageID          *string  `db:"image_id"`
+	Spot             *bool    `db:"spot"`
+	SpotMaxPrice     *float64 `db:"spot_max_price"`
 }
 
 type containerTypeID struct {
@@ -951,18 +977,20 @@ type setUnitConstraint struct {
 
 // dbConstraint represents a single row within the v_model_constraint view.
 type dbConstraint struct {
-	Arch             sql.NullString `db:"arch"`
-	CPUCores         sql.NullInt64  `db:"cpu_cores"`
-	CPUPower         sql.NullInt64  `db:"cpu_power"`
-	Mem              sql.NullInt64  `db:"mem"`
-	RootDisk         sql.NullInt64  `db:"root_disk"`
-	RootDiskSource   sql.NullString `db:"root_disk_source"`
-	InstanceRole     sql.NullString `db:"instance_role"`
-	InstanceType     sql.NullString `db:"instance_type"`
-	ContainerType    sql.NullString `db:"container_type"`
-	VirtType         sql.NullString `db:"virt_type"`
-	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
-	ImageID          sql.NullString `db:"image_id"`
+	Arch             sql.NullString  `db:"arch"`
+	CPUCores         sql.NullInt64   `db:"cpu_cores"`
+	CPUPower         sql.NullInt64   `db:"cpu_power"`
+	Mem              sql.NullInt64   `db:"mem"`
+	RootDisk         sql.NullInt64   `db:"root_disk"`
+	RootDiskSource   sql.NullString  `db:"root_disk_source"`
+	InstanceRole     sql.NullString  `db:"instance_role"`
+	InstanceType     sql.NullString  `db:"instance_type"`
+	ContainerType    sql.NullString  `db:"container_type"`
+	VirtType         sql.NullString  `db:"virt_type"`
+	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
+	ImageID          sql.NullString  `db:"image_id"`
+	Spot             sql.NullBool    `db:"spot"`
+	SpotMaxPrice     sql.NullFloat64 `db:"spot_max_price"`
 }
 
 func (c dbConstraint) toValue(
@@ -1007,6 +1035,12 @@ func (c dbConstraint) toValue(
 	if c.ImageID.Valid {
 		rval.ImageID = &c.ImageID.String
 	}
+	if c.Spot.Valid {
+		rval.Spot = &c.Spot.Bool
+	}
+	if c.SpotMaxPrice.Valid {
+		rval.SpotMaxPrice = &c.SpotMaxPrice.Float64
+	}
 	if c.ContainerType.Valid {
 		containerType := instance.ContainerType(c.ContainerType.String)
 		rval.Container = &containerType
@@ -1181,6 +1215,13 @@ type machineNameWithNetNode struct {
 	NetNodeUUID string       `db:"net_node_uuid"`
 }
 
+// warmPoolMatch is used to find a machine in the warm pool with the
+// constraints and base of a unit.
+type warmPoolMatch struct {
+	Constraints string `db:"constraints"`
+	Base        string `db:"base"`
+}
+
 type machineNameWithMachineUUID struct {
 	Name machine.Name `db:"name"`
 	UUID machine.UUID `db:"uuid"`
@@ -1234,3 +1275,58 @@ type getUnitMachineUUID struct {
 type lifeID struct {
 	LifeID life.Life `db:"life_id"`
 }
+
+// firewallRuleRow represents a row of an application firewall rule, with
+// its endpoint and protocol resolved to names.
+type firewallRuleRow struct {
+	UUID            string         `db:"uuid"`
+	ApplicationName string         `db:"application_name"`
+	Endpoint        sql.NullString `db:"endpoint"`
+	Protocol        sql.NullString `db:"protocol"`
+	FromPort        sql.NullInt64  `db:"from_port"`
+	ToPort          sql.NullInt64  `db:"to_port"`
+}
+
+// portRange returns the port range of the rule, or nil if the rule applies
+// to the opened ports.
+func (r firewallRuleRow) portRange() *network.PortRange {
+	if !r.Protocol.Valid {
+		return nil
+	}
+	return &network.PortRange{
+		Protocol: r.Protocol.String,
+		FromPort: int(r.FromPort.Int64),
+		ToPort:   int(r.ToPort.Int64),
+	}
+}
+
+// firewallRuleSource represents a source of a firewall rule. CIDRSetName is
+// set if the CIDR is a member of a CIDR set referenced by the rule.
+type firewallRuleSource struct {
+	RuleUUID    string         `db:"rule_uuid"`
+	CIDR        sql.NullString `db:"cidr"`
+	CIDRSetName sql.NullString `db:"cidr_set_name"`
+}
+
+type setFirewallRule struct {
+	UUID            string `db:"uuid"`
+	ApplicationUUID string `db:"application_uuid"`
+	EndpointName    string `db:"endpoint"`
+	Protocol        string `db:"protocol"`
+	FromPort        int    `db:"from_port"`
+	ToPort          int    `db:"to_port"`
+}
+
+type firewallRuleCIDR struct {
+	RuleUUID string `db:"application_firewall_rule_uuid"`
+	CIDR     string `db:"cidr"`
+}
+
+type firewallRuleCIDRSet struct {
+	RuleUUID string `db:"rule_uuid"`
+	Name     string `db:"name"`
+}
+
+// firewallRuleUUIDs is a type used to pass a slice of firewall rule UUIDs to
+// a query using `IN` and sqlair.
+type firewallRuleUUIDs []string
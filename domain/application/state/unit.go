@@ -991,15 +991,18 @@ func makeCloudContainerArg(unitName coreunit.Name, cloudContainer application.Cl
 	return result
 }
 
-// RegisterCAASUnit registers the specified CAAS application unit.
+// RegisterCAASUnit registers the specified CAAS application unit, returning
+// the name of the unit. Units of applications without ordered scale have no
+// ordinal in their pod name, so the unit is found from the provider id of its
+// pod, or a new unit name is allocated if the pod is not yet known.
 // The following errors can be expected:
 // - [applicationerrors.ApplicationNotAlive] when the application is not alive
 // - [applicationerrors.UnitAlreadyExists] when the unit exists
 // - [applicationerrors.UnitNotAssigned] when the unit was not assigned
-func (st *State) RegisterCAASUnit(ctx context.Context, appName string, arg application.RegisterCAASUnitArg) error {
+func (st *State) RegisterCAASUnit(ctx context.Context, appName string, arg application.RegisterCAASUnitArg) (coreunit.Name, error) {
 	db, err := st.DB()
 	if err != nil {
-		return errors.Capture(err)
+		return "", errors.Capture(err)
 	}
 
 	cloudContainerParams := application.CloudContainerParams{
@@ -1012,29 +1015,31 @@ func (st *State) RegisterCAASUnit(ctx context.Context, appName string, arg appli
 		origin := network.OriginProvider
 		cloudContainerParams.AddressOrigin = &origin
 	}
-	cloudContainer := makeCloudContainerArg(arg.UnitName, cloudContainerParams)
 
 	now := ptr(st.clock.Now())
-	insertArg := application.InsertUnitArg{
-		UnitName: arg.UnitName,
-		Password: &application.PasswordInfo{
-			PasswordHash:  arg.PasswordHash,
-			HashAlgorithm: application.HashAlgorithmSHA256,
-		},
-		CloudContainer: cloudContainer,
-		UnitStatusArg: application.UnitStatusArg{
-			AgentStatus: &status.StatusInfo[status.UnitAgentStatusType]{
-				Status: status.UnitAgentStatusAllocating,
-				Since:  now,
+	makeInsertArg := func(name coreunit.Name) application.InsertUnitArg {
+		return application.InsertUnitArg{
+			UnitName: name,
+			Password: &application.PasswordInfo{
+				PasswordHash:  arg.PasswordHash,
+				HashAlgorithm: application.HashAlgorithmSHA256,
 			},
-			WorkloadStatus: &status.StatusInfo[status.WorkloadStatusType]{
-				Status:  status.WorkloadStatusWaiting,
-				Message: corestatus.MessageInstallingAgent,
-				Since:   now,
+			CloudContainer: makeCloudContainerArg(name, cloudContainerParams),
+			UnitStatusArg: application.UnitStatusArg{
+				AgentStatus: &status.StatusInfo[status.UnitAgentStatusType]{
+					Status: status.UnitAgentStatusAllocating,
+					Since:  now,
+				},
+				WorkloadStatus: &status.StatusInfo[status.WorkloadStatusType]{
+					Status:  status.WorkloadStatusWaiting,
+					Message: corestatus.MessageInstallingAgent,
+					Since:   now,
+				},
 			},
-		},
+		}
 	}
 
+	var unitName coreunit.Name
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		appDetails, err := st.getApplicationDetails(ctx, tx, appName)
 		if err != nil {
@@ -1045,7 +1050,23 @@ func (st *State) RegisterCAASUnit(ctx context.Context, appName string, arg appli
 		}
 		appUUID := appDetails.UUID
 
-		unitLife, err := st.getLifeForUnitName(ctx, tx, arg.UnitName)
+		unitName = arg.UnitName
+		if !arg.OrderedScale {
+			unitName, err = st.getUnitNameForProviderID(ctx, tx, appUUID, arg.ProviderID)
+			if errors.Is(err, applicationerrors.UnitNotFound) {
+				// The pod is new, so allocate the next unit name. The
+				// number of pods is controlled by the provider, so there
+				// is no scale to check against.
+				if unitName, err = st.newUnitName(ctx, tx, appUUID); err != nil {
+					return errors.Errorf("allocating unit name for application %q: %w", appName, err)
+				}
+				return st.insertCAASUnit(ctx, tx, appUUID, makeInsertArg(unitName))
+			} else if err != nil {
+				return errors.Errorf("looking up unit for provider id %q: %w", arg.ProviderID, err)
+			}
+		}
+
+		unitLife, err := st.getLifeForUnitName(ctx, tx, unitName)
 		if errors.Is(err, applicationerrors.UnitNotFound) {
 			appScale, err := st.getApplicationScaleState(ctx, tx, appUUID)
 			if err != nil {
@@ -1053,25 +1074,26 @@ func (st *State) RegisterCAASUnit(ctx context.Context, appName string, arg appli
 			}
 			if arg.OrderedId >= appScale.Scale ||
 				(appScale.Scaling && arg.OrderedId >= appScale.ScaleTarget) {
-				return errors.Errorf("unrequired unit %s is not assigned", arg.UnitName).Add(applicationerrors.UnitNotAssigned)
+				return errors.Errorf("unrequired unit %s is not assigned", unitName).Add(applicationerrors.UnitNotAssigned)
 			}
 
-			return st.insertCAASUnit(ctx, tx, appUUID, insertArg)
+			return st.insertCAASUnit(ctx, tx, appUUID, makeInsertArg(unitName))
 		} else if err != nil {
-			return errors.Errorf("checking unit life %q: %w", arg.UnitName, err)
+			return errors.Errorf("checking unit life %q: %w", unitName, err)
 		}
 		if unitLife == life.Dead {
-			return errors.Errorf("dead unit %q already exists", arg.UnitName).Add(applicationerrors.UnitAlreadyExists)
+			return errors.Errorf("dead unit %q already exists", unitName).Add(applicationerrors.UnitAlreadyExists)
 		}
 
 		// Unit already exists and is not dead. Update the cloud container.
-		toUpdate, err := st.getUnitDetails(ctx, tx, arg.UnitName)
+		toUpdate, err := st.getUnitDetails(ctx, tx, unitName)
 		if err != nil {
 			return errors.Capture(err)
 		}
+		cloudContainer := makeCloudContainerArg(unitName, cloudContainerParams)
 		err = st.upsertUnitCloudContainer(ctx, tx, toUpdate.Name, toUpdate.UnitUUID, toUpdate.NetNodeID, cloudContainer)
 		if err != nil {
-			return errors.Errorf("updating cloud container for unit %q: %w", arg.UnitName, err)
+			return errors.Errorf("updating cloud container for unit %q: %w", unitName, err)
 		}
 
 		err = st.setUnitPassword(ctx, tx, toUpdate.UnitUUID, application.PasswordInfo{
@@ -1079,14 +1101,46 @@ func (st *State) RegisterCAASUnit(ctx context.Context, appName string, arg appli
 			HashAlgorithm: application.HashAlgorithmSHA256,
 		})
 		if err != nil {
-			return errors.Errorf("setting password for unit %q: %w", arg.UnitName, err)
+			return errors.Errorf("setting password for unit %q: %w", unitName, err)
 		}
 		return nil
 	})
 	if err != nil {
-		return errors.Capture(err)
+		return "", errors.Capture(err)
 	}
-	return nil
+	return unitName, nil
+}
+
+// getUnitNameForProviderID returns the name of the unit of the application
+// whose cloud container has the specified provider id. If there is no such
+// unit, an error satisfying [applicationerrors.UnitNotFound] is returned.
+func (st *State) getUnitNameForProviderID(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appUUID coreapplication.ID,
+	providerID string,
+) (coreunit.Name, error) {
+	app := applicationID{ID: appUUID}
+	container := cloudContainer{ProviderID: providerID}
+	stmt, err := st.Prepare(`
+SELECT u.name AS &unitName.name
+FROM   unit AS u
+JOIN   k8s_pod AS kp ON kp.unit_uuid = u.uuid
+WHERE  u.application_uuid = $applicationID.uuid
+AND    kp.provider_id = $cloudContainer.provider_id
+`, app, container, unitName{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var result unitName
+	err = tx.Query(ctx, stmt, app, container).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return "", errors.Errorf("unit with provider id %q %w", providerID, applicationerrors.UnitNotFound)
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+	return result.Name, nil
 }
 
 func (st *State) setUnitPassword(ctx context.Context, tx *sqlair.TX, unitUUID coreunit.UUID, password application.PasswordInfo) error {
@@ -1837,7 +1891,9 @@ ON CONFLICT (uuid) DO UPDATE SET
     container_type_id = excluded.container_type_id,
     virt_type = excluded.virt_type,
     allocate_public_ip = excluded.allocate_public_ip,
-    image_id = excluded.image_id
+    image_id = excluded.image_id,
+    spot = excluded.spot,
+    spot_max_price = excluded.spot_max_price
 `
 	insertConstraintsStmt, err := st.Prepare(insertConstraintsQuery, setConstraint{})
 	if err != nil {
@@ -260,13 +260,43 @@ func (s *unitStateSuite) TestRegisterCAASUnit(c *gc.C) {
 		OrderedId:        0,
 		StorageParentDir: c.MkDir(),
 	}
-	err := s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	unitName, err := s.state.RegisterCAASUnit(context.Background(), "foo", p)
 	c.Assert(err, jc.ErrorIsNil)
+	c.Check(unitName, gc.Equals, coreunit.Name("foo/666"))
 
-	c.Assert(err, jc.ErrorIsNil)
 	s.assertCAASUnit(c, "foo/666", "passwordhash", "10.6.6.6", []string{"666"})
 }
 
+func (s *unitStateSuite) TestRegisterCAASUnitUnordered(c *gc.C) {
+	s.createScalingApplication(c, "foo", life.Alive, 1)
+
+	p := application.RegisterCAASUnitArg{
+		PasswordHash:     "passwordhash",
+		ProviderID:       "foo-6f8b9c-x2z4q",
+		Address:          ptr("10.6.6.6"),
+		Ports:            ptr([]string{"666"}),
+		StorageParentDir: c.MkDir(),
+	}
+	unitName, err := s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(unitName, gc.Equals, coreunit.Name("foo/0"))
+	s.assertCAASUnit(c, "foo/0", "passwordhash", "10.6.6.6", []string{"666"})
+
+	// Registering the same pod again finds the existing unit.
+	p.PasswordHash = "passwordhash2"
+	unitName, err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(unitName, gc.Equals, coreunit.Name("foo/0"))
+	s.assertCAASUnit(c, "foo/0", "passwordhash2", "10.6.6.6", []string{"666"})
+
+	// A new pod is given the next unit number, regardless of scale.
+	p.ProviderID = "foo-6f8b9c-a7b8c"
+	p.PasswordHash = "passwordhash3"
+	unitName, err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(unitName, gc.Equals, coreunit.Name("foo/1"))
+}
+
 func (s *unitStateSuite) assertCAASUnit(c *gc.C, name, passwordHash, addressValue string, ports []string) {
 	var (
 		gotPasswordHash  string
@@ -336,7 +366,7 @@ func (s *unitStateSuite) TestRegisterCAASUnitAlreadyExists(c *gc.C) {
 		OrderedId:        0,
 		StorageParentDir: c.MkDir(),
 	}
-	err := s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	_, err := s.state.RegisterCAASUnit(context.Background(), "foo", p)
 	c.Assert(err, jc.ErrorIsNil)
 
 	var (
@@ -386,7 +416,7 @@ func (s *unitStateSuite) TestRegisterCAASUnitReplaceDead(c *gc.C) {
 		OrderedId:        0,
 		StorageParentDir: c.MkDir(),
 	}
-	err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	_, err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
 	c.Assert(err, jc.ErrorIs, applicationerrors.UnitAlreadyExists)
 }
 
@@ -405,7 +435,7 @@ func (s *unitStateSuite) TestRegisterCAASUnitApplicationNotALive(c *gc.C) {
 		StorageParentDir: c.MkDir(),
 	}
 
-	err := s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	_, err := s.state.RegisterCAASUnit(context.Background(), "foo", p)
 	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotAlive)
 }
 
@@ -434,7 +464,7 @@ WHERE application_uuid = ?`, 1, 3, appUUID)
 		StorageParentDir: c.MkDir(),
 	}
 
-	err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	_, err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
 	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotAssigned)
 }
 
@@ -463,7 +493,7 @@ WHERE application_uuid = ?`, true, 3, 1, appUUID)
 		StorageParentDir: c.MkDir(),
 	}
 
-	err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
+	_, err = s.state.RegisterCAASUnit(context.Background(), "foo", p)
 	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotAssigned)
 }
 
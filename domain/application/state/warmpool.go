@@ -0,0 +1,73 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/machine"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ClaimWarmPoolMachine takes an alive machine with the input constraints
+// and base out of the model's warm pool, and returns its name. Machines
+// are claimed in name order, and each is only claimed once.
+// If the pool has no such machine, an error satisfying
+// [applicationerrors.WarmPoolMachineNotFound] is returned.
+func (st *State) ClaimWarmPoolMachine(ctx context.Context, constraints, base string) (machine.Name, error) {
+	db, err := st.DB()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	match := warmPoolMatch{
+		Constraints: constraints,
+		Base:        base,
+	}
+	alive := lifeID{LifeID: life.Alive}
+	queryStmt, err := st.Prepare(`
+SELECT (m.name, m.uuid) AS (&machineNameWithMachineUUID.*)
+FROM machine_warm_pool AS wp
+JOIN machine AS m ON m.uuid = wp.machine_uuid
+WHERE wp.constraints = $warmPoolMatch.constraints
+AND wp.base = $warmPoolMatch.base
+AND m.life_id = $lifeID.life_id
+ORDER BY m.name
+LIMIT 1
+`, match, alive, machineNameWithMachineUUID{})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	var claimed machineNameWithMachineUUID
+	deleteStmt, err := st.Prepare(`
+DELETE FROM machine_warm_pool
+WHERE machine_uuid = $machineNameWithMachineUUID.uuid
+`, claimed)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryStmt, match, alive).Get(&claimed)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("constraints %q, base %q", constraints, base).
+				Add(applicationerrors.WarmPoolMachineNotFound)
+		} else if err != nil {
+			return errors.Errorf("querying warm pool machines: %w", err)
+		}
+		if err := tx.Query(ctx, deleteStmt, claimed).Run(); err != nil {
+			return errors.Errorf("removing machine %q from the warm pool: %w", claimed.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return claimed.Name, nil
+}
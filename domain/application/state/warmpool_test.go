@@ -0,0 +1,75 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/machine"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/internal/errors"
+)
+
+func (s *unitStateSuite) TestClaimWarmPoolMachine(c *gc.C) {
+	s.addWarmPoolMachine(c, "arch=amd64 mem=4096M", "ubuntu@24.04")
+	s.addWarmPoolMachine(c, "arch=amd64", "ubuntu@24.04")
+	s.addWarmPoolMachine(c, "arch=amd64", "ubuntu@24.04")
+	s.addWarmPoolMachine(c, "arch=amd64", "ubuntu@22.04")
+
+	name, err := s.state.ClaimWarmPoolMachine(context.Background(), "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(name, gc.Equals, machine.Name("1"))
+
+	// Each machine is only claimed once.
+	name, err = s.state.ClaimWarmPoolMachine(context.Background(), "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(name, gc.Equals, machine.Name("2"))
+
+	_, err = s.state.ClaimWarmPoolMachine(context.Background(), "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIs, applicationerrors.WarmPoolMachineNotFound)
+}
+
+func (s *unitStateSuite) TestClaimWarmPoolMachineNotAlive(c *gc.C) {
+	s.addWarmPoolMachine(c, "arch=amd64", "ubuntu@24.04")
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `UPDATE machine SET life_id = 1 WHERE name = '0'`)
+		return errors.Capture(err)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.ClaimWarmPoolMachine(context.Background(), "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIs, applicationerrors.WarmPoolMachineNotFound)
+}
+
+func (s *unitStateSuite) TestClaimWarmPoolMachineEmpty(c *gc.C) {
+	_, err := s.state.ClaimWarmPoolMachine(context.Background(), "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIs, applicationerrors.WarmPoolMachineNotFound)
+}
+
+// addWarmPoolMachine adds a new machine to the model's warm pool.
+func (s *unitStateSuite) addWarmPoolMachine(c *gc.C, constraints, base string) {
+	var netNode string
+	err := s.TxnRunner().Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		netNode, err = s.state.placeMachine(ctx, tx, deployment.Placement{
+			Type: deployment.PlacementTypeUnset,
+		})
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO machine_warm_pool (machine_uuid, constraints, base)
+SELECT uuid, ?, ? FROM machine WHERE net_node_uuid = ?`, constraints, base, netNode)
+		return errors.Capture(err)
+	})
+	c.Assert(err, jc.ErrorIsNil, gc.Commentf("(Arrange) Failed to add warm pool machine: %v", err))
+}
@@ -108,6 +108,35 @@ type ScaleState struct {
 	ScaleTarget int
 }
 
+// AutoscalePolicy describes how a k8s application is scaled based on the
+// utilisation of its units. At least one target must be set. Targets which
+// are zero are not considered.
+type AutoscalePolicy struct {
+	// MinUnits is the fewest units the application is scaled down to.
+	MinUnits int
+	// MaxUnits is the most units the application is scaled up to.
+	MaxUnits int
+	// TargetCPUPercent is the average CPU utilisation, as a percentage of
+	// the CPU requested by the units, to maintain.
+	TargetCPUPercent int
+	// TargetMemoryPercent is the average memory utilisation, as a percentage
+	// of the memory requested by the units, to maintain.
+	TargetMemoryPercent int
+	// CustomMetric is the name of a metric served by the custom metrics API.
+	CustomMetric string
+	// CustomMetricTarget is the average value of the custom metric per unit
+	// to maintain.
+	CustomMetricTarget float64
+}
+
+// ApplicationAutoscalePolicy is the autoscale policy of an application along
+// with the application's current scale.
+type ApplicationAutoscalePolicy struct {
+	ApplicationName string
+	Scale           int
+	Policy          AutoscalePolicy
+}
+
 // CloudService contains parameters for an application's cloud service.
 type CloudService struct {
 	ProviderID string
@@ -326,12 +355,17 @@ type ApplicationConfig struct {
 // ApplicationSettings contains the settings for an application.
 type ApplicationSettings struct {
 	Trust bool
+
+	// DeploymentType overrides the deployment type declared by the charm of
+	// a kubernetes application. The zero value defers to the charm.
+	DeploymentType domaincharm.DeploymentType
 }
 
 // UpdateApplicationSettingsArg is the argument used to update an application's
 // settings
 type UpdateApplicationSettingsArg struct {
-	Trust *bool
+	Trust          *bool
+	DeploymentType *domaincharm.DeploymentType
 }
 
 // ExposedEndpoint encapsulates the expose-related details of a particular
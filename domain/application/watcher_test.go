@@ -989,6 +989,65 @@ func (s *watcherSuite) TestWatchApplicationExposedBadName(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *watcherSuite) TestWatchFirewallRules(c *gc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "application_firewall_rule")
+
+	svc := s.setupService(c, factory)
+
+	s.createApplication(c, svc, "foo")
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO cidr_set (uuid, name) VALUES (?, ?)`, "set0-uuid", "office"); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO cidr_set_cidr (cidr_set_uuid, cidr) VALUES (?, ?)`, "set0-uuid", "10.0.0.0/8")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctx := context.Background()
+	watcher, err := svc.WatchFirewallRules(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+
+	harness := watchertest.NewHarness[struct{}](s, watchertest.NewWatcherC[struct{}](c, watcher))
+
+	// Assert that adding a rule triggers the watcher.
+	harness.AddTest(func(c *gc.C) {
+		err := svc.SetFirewallRule(ctx, "foo", application.FirewallRule{
+			CIDRSets: set.NewStrings("office"),
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that changing the CIDRs of a referenced set triggers the
+	// watcher.
+	harness.AddTest(func(c *gc.C) {
+		err := s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `INSERT INTO cidr_set_cidr (cidr_set_uuid, cidr) VALUES (?, ?)`, "set0-uuid", "10.1.0.0/16")
+			return err
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that removing a rule triggers the watcher.
+	harness.AddTest(func(c *gc.C) {
+		err := svc.RemoveFirewallRule(ctx, "foo", "", nil)
+		c.Assert(err, jc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertChange()
+	})
+
+	// Assert that nothing changes if nothing happens.
+	harness.AddTest(func(c *gc.C) {}, func(w watchertest.WatcherC[struct{}]) {
+		w.AssertNoChange()
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) TestWatchUnitForLegacyUniter(c *gc.C) {
 	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, s.ModelUUID())
 
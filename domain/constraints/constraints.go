@@ -82,6 +82,14 @@ type Constraints struct {
 	// image. This is provider specific, and for the moment is only
 	// implemented on MAAS clouds.
 	ImageID *string
+
+	// Spot, if true, indicates that a machine should be started on spot
+	// (or preemptible) capacity.
+	Spot *bool
+
+	// SpotMaxPrice, if not nil, is the highest hourly price that will be
+	// paid for spot capacity.
+	SpotMaxPrice *float64
 }
 
 // SpaceConstraint represents a single space constraint for an application.
@@ -112,6 +120,8 @@ func DecodeConstraints(coreCons constraints.Value) Constraints {
 		Zones:            coreCons.Zones,
 		AllocatePublicIP: coreCons.AllocatePublicIP,
 		ImageID:          coreCons.ImageID,
+		Spot:             coreCons.Spot,
+		SpotMaxPrice:     coreCons.SpotMaxPrice,
 	}
 
 	if coreCons.Spaces == nil {
@@ -157,6 +167,8 @@ func EncodeConstraints(cons Constraints) constraints.Value {
 		Zones:            cons.Zones,
 		AllocatePublicIP: cons.AllocatePublicIP,
 		ImageID:          cons.ImageID,
+		Spot:             cons.Spot,
+		SpotMaxPrice:     cons.SpotMaxPrice,
 	}
 
 	if cons.Spaces == nil {
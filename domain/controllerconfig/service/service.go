@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/changestream"
@@ -116,6 +117,9 @@ func (s *Service) UpdateControllerConfig(ctx context.Context, updateAttrs contro
 		if err := validObjectStoreProgression(current, updateAttrs, removeAttrs); err != nil {
 			return errors.Capture(err)
 		}
+		if err := validSSHAccessGrants(current, coerced, removeAttrs); err != nil {
+			return errors.Capture(err)
+		}
 
 		return nil
 	})
@@ -221,6 +225,39 @@ func validObjectStoreProgression(current map[string]string, updateAttrs controll
 	return errors.Errorf("can not change %q from %q to %q", controller.ObjectStoreType, cur, upd)
 }
 
+// validSSHAccessGrants validates that ssh access grants are only required
+// when users must also authenticate with an ssh user certificate. Grants are
+// held by users, so without a certificate there is no verified user to check
+// the grant of.
+func validSSHAccessGrants(current, updateAttrs map[string]string, removeAttrs []string) error {
+	required := func(key string) (bool, error) {
+		if contains(removeAttrs, key) {
+			return false, nil
+		}
+		value, ok := updateAttrs[key]
+		if !ok {
+			value, ok = current[key]
+		}
+		if !ok || value == "" {
+			return false, nil
+		}
+		return strconv.ParseBool(value)
+	}
+
+	grantsRequired, err := required(controller.SSHAccessGrantsRequired)
+	if err != nil {
+		return errors.Errorf("parsing %q: %w", controller.SSHAccessGrantsRequired, err)
+	}
+	certificatesRequired, err := required(controller.SSHUserCertificatesRequired)
+	if err != nil {
+		return errors.Errorf("parsing %q: %w", controller.SSHUserCertificatesRequired, err)
+	}
+	if grantsRequired && !certificatesRequired {
+		return errors.Errorf("can not enable %q without %q", controller.SSHAccessGrantsRequired, controller.SSHUserCertificatesRequired)
+	}
+	return nil
+}
+
 func contains(s []string, e string) bool {
 	for _, a := range s {
 		if a == e {
@@ -157,6 +157,56 @@ func (s *serviceSuite) TestUpdateControllerValidationIgnored(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestUpdateControllerSSHAccessGrantsWithoutCertificates(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Ensure that access grants can not be required while users are not
+	// required to authenticate with a certificate.
+
+	cfg := controller.Config{controller.SSHAccessGrantsRequired: true}
+	coerced := map[string]string{controller.SSHAccessGrantsRequired: "true"}
+	_, current := makeDefaultConfig("file")
+
+	s.state.EXPECT().UpdateControllerConfig(gomock.Any(), coerced, nil, gomock.Any()).DoAndReturn(func(ctx context.Context, updateAttrs map[string]string, removeAttrs []string, validateModification ModificationValidatorFunc) error {
+		return validateModification(current)
+	})
+
+	err := NewWatchableService(s.state, s.watcherFactory).UpdateControllerConfig(context.Background(), cfg, nil)
+	c.Assert(err, gc.ErrorMatches, `updating controller config state: can not enable "ssh-access-grants-required" without "ssh-user-certificates-required"`)
+}
+
+func (s *serviceSuite) TestUpdateControllerSSHAccessGrantsWithCertificates(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := controller.Config{controller.SSHAccessGrantsRequired: true}
+	coerced := map[string]string{controller.SSHAccessGrantsRequired: "true"}
+	_, current := makeDefaultConfig("file")
+	current[controller.SSHUserCertificatesRequired] = "true"
+
+	s.state.EXPECT().UpdateControllerConfig(gomock.Any(), coerced, nil, gomock.Any()).DoAndReturn(func(ctx context.Context, updateAttrs map[string]string, removeAttrs []string, validateModification ModificationValidatorFunc) error {
+		return validateModification(current)
+	})
+
+	err := NewWatchableService(s.state, s.watcherFactory).UpdateControllerConfig(context.Background(), cfg, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestUpdateControllerRemoveSSHCertificatesWithAccessGrants(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, current := makeDefaultConfig("file")
+	current[controller.SSHAccessGrantsRequired] = "true"
+	current[controller.SSHUserCertificatesRequired] = "true"
+
+	removeAttrs := []string{controller.SSHUserCertificatesRequired}
+	s.state.EXPECT().UpdateControllerConfig(gomock.Any(), map[string]string{}, removeAttrs, gomock.Any()).DoAndReturn(func(ctx context.Context, updateAttrs map[string]string, removeAttrs []string, validateModification ModificationValidatorFunc) error {
+		return validateModification(current)
+	})
+
+	err := NewWatchableService(s.state, s.watcherFactory).UpdateControllerConfig(context.Background(), controller.Config{}, removeAttrs)
+	c.Assert(err, gc.ErrorMatches, `updating controller config state: can not enable "ssh-access-grants-required" without "ssh-user-certificates-required"`)
+}
+
 func (s *serviceSuite) TestWatch(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -50,4 +50,8 @@ const (
 	// MachineCloudInstanceAlreadyExists describes an error that occurs
 	// when adding cloud instance on a machine that already exists.
 	MachineCloudInstanceAlreadyExists = errors.ConstError("machine cloud instance already exists")
+
+	// MachineNotInWarmPool describes an error that occurs when the machine
+	// being taken out of the warm pool is not in it.
+	MachineNotInWarmPool = errors.ConstError("machine not in warm pool")
 )
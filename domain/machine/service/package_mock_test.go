@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	agentbinary "github.com/juju/juju/core/agentbinary"
 	constraints "github.com/juju/juju/core/constraints"
@@ -49,6 +50,82 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddMachineUtilisationSample mocks base method.
+func (m *MockState) AddMachineUtilisationSample(arg0 context.Context, arg1 machine.Name, arg2 machine0.UtilisationSample, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMachineUtilisationSample", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMachineUtilisationSample indicates an expected call of AddMachineUtilisationSample.
+func (mr *MockStateMockRecorder) AddMachineUtilisationSample(arg0, arg1, arg2, arg3 any) *MockStateAddMachineUtilisationSampleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMachineUtilisationSample", reflect.TypeOf((*MockState)(nil).AddMachineUtilisationSample), arg0, arg1, arg2, arg3)
+	return &MockStateAddMachineUtilisationSampleCall{Call: call}
+}
+
+// MockStateAddMachineUtilisationSampleCall wrap *gomock.Call
+type MockStateAddMachineUtilisationSampleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddMachineUtilisationSampleCall) Return(arg0 error) *MockStateAddMachineUtilisationSampleCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddMachineUtilisationSampleCall) Do(f func(context.Context, machine.Name, machine0.UtilisationSample, time.Time) error) *MockStateAddMachineUtilisationSampleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddMachineUtilisationSampleCall) DoAndReturn(f func(context.Context, machine.Name, machine0.UtilisationSample, time.Time) error) *MockStateAddMachineUtilisationSampleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddWarmPoolMachine mocks base method.
+func (m *MockState) AddWarmPoolMachine(ctx context.Context, mName machine.Name, constraints, base string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWarmPoolMachine", ctx, mName, constraints, base)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddWarmPoolMachine indicates an expected call of AddWarmPoolMachine.
+func (mr *MockStateMockRecorder) AddWarmPoolMachine(ctx, mName, constraints, base any) *MockStateAddWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWarmPoolMachine", reflect.TypeOf((*MockState)(nil).AddWarmPoolMachine), ctx, mName, constraints, base)
+	return &MockStateAddWarmPoolMachineCall{Call: call}
+}
+
+// MockStateAddWarmPoolMachineCall wrap *gomock.Call
+type MockStateAddWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddWarmPoolMachineCall) Return(arg0 error) *MockStateAddWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddWarmPoolMachineCall) Do(f func(context.Context, machine.Name, string, string) error) *MockStateAddWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddWarmPoolMachineCall) DoAndReturn(f func(context.Context, machine.Name, string, string) error) *MockStateAddWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AllMachineNames mocks base method.
 func (m *MockState) AllMachineNames(arg0 context.Context) ([]machine.Name, error) {
 	m.ctrl.T.Helper()
@@ -590,6 +667,84 @@ func (c *MockStateGetMachineUUIDCall) DoAndReturn(f func(context.Context, machin
 	return c
 }
 
+// GetMachineUtilisationSamples mocks base method.
+func (m *MockState) GetMachineUtilisationSamples(arg0 context.Context, arg1 machine.Name, arg2 time.Time) ([]machine0.UtilisationSample, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineUtilisationSamples", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]machine0.UtilisationSample)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineUtilisationSamples indicates an expected call of GetMachineUtilisationSamples.
+func (mr *MockStateMockRecorder) GetMachineUtilisationSamples(arg0, arg1, arg2 any) *MockStateGetMachineUtilisationSamplesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineUtilisationSamples", reflect.TypeOf((*MockState)(nil).GetMachineUtilisationSamples), arg0, arg1, arg2)
+	return &MockStateGetMachineUtilisationSamplesCall{Call: call}
+}
+
+// MockStateGetMachineUtilisationSamplesCall wrap *gomock.Call
+type MockStateGetMachineUtilisationSamplesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetMachineUtilisationSamplesCall) Return(arg0 []machine0.UtilisationSample, arg1 error) *MockStateGetMachineUtilisationSamplesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetMachineUtilisationSamplesCall) Do(f func(context.Context, machine.Name, time.Time) ([]machine0.UtilisationSample, error)) *MockStateGetMachineUtilisationSamplesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetMachineUtilisationSamplesCall) DoAndReturn(f func(context.Context, machine.Name, time.Time) ([]machine0.UtilisationSample, error)) *MockStateGetMachineUtilisationSamplesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetWarmPoolMachines mocks base method.
+func (m *MockState) GetWarmPoolMachines(arg0 context.Context) ([]machine0.WarmPoolMachine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarmPoolMachines", arg0)
+	ret0, _ := ret[0].([]machine0.WarmPoolMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarmPoolMachines indicates an expected call of GetWarmPoolMachines.
+func (mr *MockStateMockRecorder) GetWarmPoolMachines(arg0 any) *MockStateGetWarmPoolMachinesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarmPoolMachines", reflect.TypeOf((*MockState)(nil).GetWarmPoolMachines), arg0)
+	return &MockStateGetWarmPoolMachinesCall{Call: call}
+}
+
+// MockStateGetWarmPoolMachinesCall wrap *gomock.Call
+type MockStateGetWarmPoolMachinesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetWarmPoolMachinesCall) Return(arg0 []machine0.WarmPoolMachine, arg1 error) *MockStateGetWarmPoolMachinesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetWarmPoolMachinesCall) Do(f func(context.Context) ([]machine0.WarmPoolMachine, error)) *MockStateGetWarmPoolMachinesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetWarmPoolMachinesCall) DoAndReturn(f func(context.Context) ([]machine0.WarmPoolMachine, error)) *MockStateGetWarmPoolMachinesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // HardwareCharacteristics mocks base method.
 func (m *MockState) HardwareCharacteristics(arg0 context.Context, arg1 string) (*instance.HardwareCharacteristics, error) {
 	m.ctrl.T.Helper()
@@ -1016,6 +1171,44 @@ func (c *MockStateNamespaceForWatchMachineRebootCall) DoAndReturn(f func() strin
 	return c
 }
 
+// RemoveWarmPoolMachine mocks base method.
+func (m *MockState) RemoveWarmPoolMachine(arg0 context.Context, arg1 machine.Name) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWarmPoolMachine", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWarmPoolMachine indicates an expected call of RemoveWarmPoolMachine.
+func (mr *MockStateMockRecorder) RemoveWarmPoolMachine(arg0, arg1 any) *MockStateRemoveWarmPoolMachineCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWarmPoolMachine", reflect.TypeOf((*MockState)(nil).RemoveWarmPoolMachine), arg0, arg1)
+	return &MockStateRemoveWarmPoolMachineCall{Call: call}
+}
+
+// MockStateRemoveWarmPoolMachineCall wrap *gomock.Call
+type MockStateRemoveWarmPoolMachineCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRemoveWarmPoolMachineCall) Return(arg0 error) *MockStateRemoveWarmPoolMachineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRemoveWarmPoolMachineCall) Do(f func(context.Context, machine.Name) error) *MockStateRemoveWarmPoolMachineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRemoveWarmPoolMachineCall) DoAndReturn(f func(context.Context, machine.Name) error) *MockStateRemoveWarmPoolMachineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RequireMachineReboot mocks base method.
 func (m *MockState) RequireMachineReboot(ctx context.Context, uuid string) error {
 	m.ctrl.T.Helper()
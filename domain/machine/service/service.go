@@ -6,6 +6,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	coreagentbinary "github.com/juju/juju/core/agentbinary"
 	"github.com/juju/juju/core/instance"
@@ -153,6 +154,31 @@ type State interface {
 	// of profiles for the given machine without any checks.
 	SetAppliedLXDProfileNames(ctx context.Context, mUUID string, profileNames []string) error
 
+	// AddMachineUtilisationSample records a sample of the CPU and memory
+	// utilisation of the specified machine, and removes the samples of the
+	// machine taken before the input time.
+	// It returns a MachineNotFound if the machine does not exist.
+	AddMachineUtilisationSample(context.Context, machine.Name, domainmachine.UtilisationSample, time.Time) error
+
+	// GetMachineUtilisationSamples returns the utilisation samples of the
+	// specified machine taken at or after the input time, oldest first.
+	// It returns a MachineNotFound if the machine does not exist.
+	GetMachineUtilisationSamples(context.Context, machine.Name, time.Time) ([]domainmachine.UtilisationSample, error)
+
+	// AddWarmPoolMachine puts the specified machine in the model's warm
+	// pool, to be matched to units by the input constraints and base.
+	// It returns a MachineNotFound if the machine does not exist.
+	AddWarmPoolMachine(ctx context.Context, mName machine.Name, constraints, base string) error
+
+	// GetWarmPoolMachines returns the alive machines in the model's warm
+	// pool, ordered by name.
+	GetWarmPoolMachines(context.Context) ([]domainmachine.WarmPoolMachine, error)
+
+	// RemoveWarmPoolMachine takes the specified machine out of the model's
+	// warm pool.
+	// It returns a MachineNotInWarmPool if the machine is not in the pool.
+	RemoveWarmPoolMachine(context.Context, machine.Name) error
+
 	// NamespaceForWatchMachineCloudInstance returns the namespace for watching
 	// machine cloud instance changes.
 	NamespaceForWatchMachineCloudInstance() string
@@ -0,0 +1,95 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RecordMachineUtilisation records a sample of the CPU and memory
+// utilisation of the specified machine. Samples of the machine taken more
+// than the window before the new sample are removed.
+// It returns a MachineNotFound if the machine does not exist, and a
+// NotValid if the utilisation is not a percentage.
+func (s *Service) RecordMachineUtilisation(
+	ctx context.Context, machineName machine.Name, sample domainmachine.UtilisationSample, window time.Duration,
+) error {
+	if !isPercentage(sample.CPU) || !isPercentage(sample.Memory) {
+		return errors.Errorf(
+			"recording utilisation of machine %q: CPU %v%%, memory %v%% %w",
+			machineName, sample.CPU, sample.Memory, coreerrors.NotValid,
+		)
+	}
+	err := s.st.AddMachineUtilisationSample(ctx, machineName, sample, sample.Time.Add(-window))
+	if err != nil {
+		return errors.Errorf("recording utilisation of machine %q: %w", machineName, err)
+	}
+	return nil
+}
+
+// GetMachineUtilisation returns a summary of the utilisation samples of
+// the specified machine taken at or after the input time. The summary has
+// no samples if none have been recorded.
+// It returns a MachineNotFound if the machine does not exist.
+func (s *Service) GetMachineUtilisation(
+	ctx context.Context, machineName machine.Name, since time.Time,
+) (domainmachine.Utilisation, error) {
+	samples, err := s.st.GetMachineUtilisationSamples(ctx, machineName, since)
+	if err != nil {
+		return domainmachine.Utilisation{}, errors.Errorf("getting utilisation of machine %q: %w", machineName, err)
+	}
+	return summariseUtilisation(samples), nil
+}
+
+// summariseUtilisation returns the summary of the samples, which must be
+// ordered oldest first.
+func summariseUtilisation(samples []domainmachine.UtilisationSample) domainmachine.Utilisation {
+	n := len(samples)
+	if n == 0 {
+		return domainmachine.Utilisation{}
+	}
+	cpu := make([]float64, n)
+	memory := make([]float64, n)
+	for i, sample := range samples {
+		cpu[i] = sample.CPU
+		memory[i] = sample.Memory
+	}
+	return domainmachine.Utilisation{
+		Samples:    n,
+		From:       samples[0].Time,
+		To:         samples[n-1].Time,
+		MeanCPU:    mean(cpu),
+		PeakCPU:    percentile95(cpu),
+		MeanMemory: mean(memory),
+		PeakMemory: percentile95(memory),
+	}
+}
+
+func mean(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+// percentile95 returns the 95th percentile of the values using the
+// nearest-rank method. The values are sorted in place.
+func percentile95(values []float64) float64 {
+	sort.Float64s(values)
+	rank := int(math.Ceil(0.95 * float64(len(values))))
+	return values[rank-1]
+}
+
+func isPercentage(v float64) bool {
+	return v >= 0 && v <= 100
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	cmachine "github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+)
+
+func (s *serviceSuite) TestRecordMachineUtilisation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := time.Now()
+	sample := domainmachine.UtilisationSample{Time: now, CPU: 12.5, Memory: 40}
+	s.state.EXPECT().AddMachineUtilisationSample(gomock.Any(), cmachine.Name("666"), sample, now.Add(-time.Hour)).Return(nil)
+
+	err := NewService(s.state).RecordMachineUtilisation(context.Background(), "666", sample, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRecordMachineUtilisationNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sample := domainmachine.UtilisationSample{Time: time.Now(), CPU: 120, Memory: 40}
+	err := NewService(s.state).RecordMachineUtilisation(context.Background(), "666", sample, time.Hour)
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestRecordMachineUtilisationMachineNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddMachineUtilisationSample(gomock.Any(), cmachine.Name("666"), gomock.Any(), gomock.Any()).Return(machineerrors.MachineNotFound)
+
+	err := NewService(s.state).RecordMachineUtilisation(context.Background(), "666", domainmachine.UtilisationSample{}, time.Hour)
+	c.Assert(err, jc.ErrorIs, machineerrors.MachineNotFound)
+}
+
+func (s *serviceSuite) TestGetMachineUtilisation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	samples := make([]domainmachine.UtilisationSample, 20)
+	for i := range samples {
+		samples[i] = domainmachine.UtilisationSample{
+			Time:   start.Add(time.Duration(i) * time.Hour),
+			CPU:    float64(i + 1),
+			Memory: 50,
+		}
+	}
+	s.state.EXPECT().GetMachineUtilisationSamples(gomock.Any(), cmachine.Name("666"), start).Return(samples, nil)
+
+	utilisation, err := NewService(s.state).GetMachineUtilisation(context.Background(), "666", start)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(utilisation, jc.DeepEquals, domainmachine.Utilisation{
+		Samples:    20,
+		From:       start,
+		To:         start.Add(19 * time.Hour),
+		MeanCPU:    10.5,
+		PeakCPU:    19,
+		MeanMemory: 50,
+		PeakMemory: 50,
+	})
+}
+
+func (s *serviceSuite) TestGetMachineUtilisationNoSamples(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetMachineUtilisationSamples(gomock.Any(), cmachine.Name("666"), gomock.Any()).Return(nil, nil)
+
+	utilisation, err := NewService(s.state).GetMachineUtilisation(context.Background(), "666", time.Time{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(utilisation, jc.DeepEquals, domainmachine.Utilisation{})
+}
@@ -0,0 +1,89 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sort"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AddWarmPoolMachine puts the specified machine, started with the input
+// constraints and base, in the model's warm pool.
+// It returns a MachineNotFound if the machine does not exist.
+func (s *Service) AddWarmPoolMachine(
+	ctx context.Context, machineName machine.Name, cons constraints.Value, base corebase.Base,
+) error {
+	err := s.st.AddWarmPoolMachine(
+		ctx, machineName, domainmachine.WarmPoolConstraints(cons), domainmachine.WarmPoolBase(base),
+	)
+	if err != nil {
+		return errors.Errorf("adding machine %q to the warm pool: %w", machineName, err)
+	}
+	return nil
+}
+
+// ReconcileWarmPool compares the machines in the model's warm pool with
+// the entries, which are for machines with the input base. It returns the
+// number of machines to add to the pool for each entry, and takes the
+// machines the pool no longer wants out of it, returning them to be
+// removed from the model. A machine given to a unit in the meantime is
+// left alone.
+func (s *Service) ReconcileWarmPool(
+	ctx context.Context, entries []domainmachine.WarmPoolEntry, base corebase.Base,
+) (domainmachine.WarmPoolReconciliation, error) {
+	var result domainmachine.WarmPoolReconciliation
+	machines, err := s.st.GetWarmPoolMachines(ctx)
+	if err != nil {
+		return result, errors.Errorf("getting warm pool machines: %w", err)
+	}
+
+	poolBase := domainmachine.WarmPoolBase(base)
+	pooled := make(map[string][]machine.Name)
+	for _, m := range machines {
+		// Machines with another base are no longer wanted.
+		key := m.Constraints
+		if m.Base != poolBase {
+			key = ""
+		}
+		pooled[key] = append(pooled[key], m.Name)
+	}
+
+	result.Missing = make([]int, len(entries))
+	for i, entry := range entries {
+		key := domainmachine.WarmPoolConstraints(entry.Constraints)
+		have := pooled[key]
+		if len(have) < entry.Size {
+			result.Missing[i] = entry.Size - len(have)
+		}
+		if len(have) > entry.Size {
+			pooled[key] = have[entry.Size:]
+		} else {
+			delete(pooled, key)
+		}
+	}
+
+	// Whatever remains is no longer wanted by the pool.
+	var surplus []machine.Name
+	for _, names := range pooled {
+		surplus = append(surplus, names...)
+	}
+	sort.Slice(surplus, func(i, j int) bool { return surplus[i] < surplus[j] })
+	for _, name := range surplus {
+		err := s.st.RemoveWarmPoolMachine(ctx, name)
+		if errors.Is(err, machineerrors.MachineNotInWarmPool) {
+			continue
+		} else if err != nil {
+			return result, errors.Errorf("removing surplus machine %q from the warm pool: %w", name, err)
+		}
+		result.Surplus = append(result.Surplus, name)
+	}
+	return result, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	cmachine "github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+)
+
+func (s *serviceSuite) TestAddWarmPoolMachine(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddWarmPoolMachine(gomock.Any(), cmachine.Name("666"), "arch=amd64 mem=4096M", "ubuntu@24.04").Return(nil)
+
+	err := NewService(s.state).AddWarmPoolMachine(
+		context.Background(), "666", constraints.MustParse("mem=4G container=lxd"), corebase.MustParseBaseFromString("ubuntu@24.04/stable"),
+	)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestAddWarmPoolMachineNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().AddWarmPoolMachine(gomock.Any(), cmachine.Name("666"), gomock.Any(), gomock.Any()).Return(machineerrors.MachineNotFound)
+
+	err := NewService(s.state).AddWarmPoolMachine(
+		context.Background(), "666", constraints.Value{}, corebase.MustParseBaseFromString("ubuntu@24.04"),
+	)
+	c.Assert(err, jc.ErrorIs, machineerrors.MachineNotFound)
+}
+
+func (s *serviceSuite) TestReconcileWarmPool(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetWarmPoolMachines(gomock.Any()).Return([]domainmachine.WarmPoolMachine{
+		{Name: "0", Constraints: "arch=amd64", Base: "ubuntu@24.04"},
+		{Name: "1", Constraints: "arch=amd64 mem=4096M", Base: "ubuntu@24.04"},
+		{Name: "2", Constraints: "arch=amd64 mem=4096M", Base: "ubuntu@24.04"},
+		{Name: "3", Constraints: "arch=amd64 mem=4096M", Base: "ubuntu@24.04"},
+		{Name: "4", Constraints: "arch=amd64", Base: "ubuntu@22.04"},
+		{Name: "5", Constraints: "arch=arm64", Base: "ubuntu@24.04"},
+	}, nil)
+	s.state.EXPECT().RemoveWarmPoolMachine(gomock.Any(), cmachine.Name("3")).Return(nil)
+	s.state.EXPECT().RemoveWarmPoolMachine(gomock.Any(), cmachine.Name("4")).Return(nil)
+	// Machine 5 has been given to a unit in the meantime.
+	s.state.EXPECT().RemoveWarmPoolMachine(gomock.Any(), cmachine.Name("5")).Return(machineerrors.MachineNotInWarmPool)
+
+	result, err := NewService(s.state).ReconcileWarmPool(context.Background(), []domainmachine.WarmPoolEntry{
+		{Constraints: constraints.Value{}, Size: 3},
+		{Constraints: constraints.MustParse("mem=4G"), Size: 2},
+		{Constraints: constraints.MustParse("cores=8"), Size: 1},
+	}, corebase.MustParseBaseFromString("ubuntu@24.04"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, domainmachine.WarmPoolReconciliation{
+		Missing: []int{2, 0, 1},
+		Surplus: []cmachine.Name{"3", "4"},
+	})
+}
+
+func (s *serviceSuite) TestReconcileWarmPoolEmpty(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetWarmPoolMachines(gomock.Any()).Return([]domainmachine.WarmPoolMachine{
+		{Name: "0", Constraints: "arch=amd64", Base: "ubuntu@24.04"},
+	}, nil)
+	s.state.EXPECT().RemoveWarmPoolMachine(gomock.Any(), cmachine.Name("0")).Return(nil)
+
+	result, err := NewService(s.state).ReconcileWarmPool(context.Background(), nil, corebase.MustParseBaseFromString("ubuntu@24.04"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, domainmachine.WarmPoolReconciliation{
+		Missing: []int{},
+		Surplus: []cmachine.Name{"0"},
+	})
+}
@@ -259,6 +259,20 @@ DELETE FROM net_node WHERE uuid IN
 		return errors.Capture(err)
 	}
 
+	// Prepare query for deleting the utilisation samples of the machine.
+	deleteUtilisation := `DELETE FROM machine_utilisation WHERE machine_uuid = $machineUUID.uuid`
+	deleteUtilisationStmt, err := st.Prepare(deleteUtilisation, machineUUIDParam)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// Prepare query for taking the machine out of the warm pool.
+	deleteWarmPool := `DELETE FROM machine_warm_pool WHERE machine_uuid = $machineUUID.uuid`
+	deleteWarmPoolStmt, err := st.Prepare(deleteWarmPool, machineUUIDParam)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		err = tx.Query(ctx, queryMachineStmt, machineNameParam).Get(&machineUUIDParam)
 		if errors.Is(err, sqlair.ErrNoRows) {
@@ -279,6 +293,16 @@ DELETE FROM net_node WHERE uuid IN
 			return errors.Errorf("deleting status for machine %q: %w", mName, err)
 		}
 
+		// Remove the utilisation samples of the machine.
+		if err := tx.Query(ctx, deleteUtilisationStmt, machineUUIDParam).Run(); err != nil {
+			return errors.Errorf("deleting utilisation samples for machine %q: %w", mName, err)
+		}
+
+		// Take the machine out of the warm pool.
+		if err := tx.Query(ctx, deleteWarmPoolStmt, machineUUIDParam).Run(); err != nil {
+			return errors.Errorf("deleting warm pool entry for machine %q: %w", mName, err)
+		}
+
 		// Remove the machine.
 		if err := tx.Query(ctx, deleteMachineStmt, machineNameParam).Run(); err != nil {
 			return errors.Errorf("deleting machine %q: %w", mName, err)
@@ -160,6 +160,40 @@ type machineParent struct {
 	ParentUUID  string `db:"parent_uuid"`
 }
 
+// machineUtilisation represents the struct to be used for the columns of the
+// machine_utilisation table within the sqlair statements in the machine
+// domain.
+type machineUtilisation struct {
+	MachineUUID string    `db:"machine_uuid"`
+	SampledAt   time.Time `db:"sampled_at"`
+	CPU         float64   `db:"cpu_percent"`
+	Memory      float64   `db:"memory_percent"`
+}
+
+// warmPoolMachine represents the struct to be used for the columns of the
+// machine_warm_pool table within the sqlair statements in the machine
+// domain.
+type warmPoolMachine struct {
+	MachineUUID string `db:"machine_uuid"`
+	Constraints string `db:"constraints"`
+	Base        string `db:"base"`
+}
+
+// warmPoolMachineName represents the struct used to list the machines of
+// the warm pool by name.
+type warmPoolMachineName struct {
+	Name        machine.Name `db:"name"`
+	Constraints string       `db:"constraints"`
+	Base        string       `db:"base"`
+}
+
+// utilisationBound represents the struct used to select the utilisation
+// samples of a machine taken before or after a time.
+type utilisationBound struct {
+	MachineUUID string    `db:"machine_uuid"`
+	Time        time.Time `db:"time"`
+}
+
 // uuidSliceTransform is a function that is used to transform a slice of
 // machineUUID into a slice of string.
 func (s machineMarkForRemoval) uuidSliceTransform() string {
@@ -0,0 +1,156 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/machine"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AddMachineUtilisationSample records a sample of the CPU and memory
+// utilisation of the specified machine, and removes the samples of the
+// machine taken before the input time. A sample taken at the same time
+// as an existing one replaces it.
+// It returns a MachineNotFound if the machine does not exist.
+func (st *State) AddMachineUtilisationSample(
+	ctx context.Context, mName machine.Name, sample domainmachine.UtilisationSample, removeBefore time.Time,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	nameIdent := machineName{Name: mName}
+	var mUUID machineUUID
+	queryMachineStmt, err := st.Prepare(`SELECT uuid AS &machineUUID.* FROM machine WHERE name = $machineName.name`, nameIdent, mUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	utilisation := machineUtilisation{
+		SampledAt: utilisationTime(sample.Time),
+		CPU:       sample.CPU,
+		Memory:    sample.Memory,
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO machine_utilisation (*) VALUES ($machineUtilisation.*)
+ON CONFLICT (machine_uuid, sampled_at) DO
+UPDATE SET cpu_percent = excluded.cpu_percent, memory_percent = excluded.memory_percent
+`, utilisation)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	bound := utilisationBound{
+		Time: utilisationTime(removeBefore),
+	}
+	removeStmt, err := st.Prepare(`
+DELETE FROM machine_utilisation
+WHERE machine_uuid = $utilisationBound.machine_uuid
+AND sampled_at < $utilisationBound.time
+`, bound)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryMachineStmt, nameIdent).Get(&mUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return machineerrors.MachineNotFound
+		} else if err != nil {
+			return errors.Errorf("querying uuid for machine: %w", err)
+		}
+
+		utilisation.MachineUUID = mUUID.UUID
+		if err := tx.Query(ctx, insertStmt, utilisation).Run(); err != nil {
+			return errors.Errorf("inserting utilisation sample: %w", err)
+		}
+		bound.MachineUUID = mUUID.UUID
+		if err := tx.Query(ctx, removeStmt, bound).Run(); err != nil {
+			return errors.Errorf("removing old utilisation samples: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("adding utilisation sample for machine %q: %w", mName, err)
+	}
+	return nil
+}
+
+// GetMachineUtilisationSamples returns the utilisation samples of the
+// specified machine taken at or after the input time, oldest first.
+// It returns a MachineNotFound if the machine does not exist.
+func (st *State) GetMachineUtilisationSamples(
+	ctx context.Context, mName machine.Name, since time.Time,
+) ([]domainmachine.UtilisationSample, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	nameIdent := machineName{Name: mName}
+	var mUUID machineUUID
+	queryMachineStmt, err := st.Prepare(`SELECT uuid AS &machineUUID.* FROM machine WHERE name = $machineName.name`, nameIdent, mUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	bound := utilisationBound{
+		Time: utilisationTime(since),
+	}
+	querySamplesStmt, err := st.Prepare(`
+SELECT &machineUtilisation.*
+FROM machine_utilisation
+WHERE machine_uuid = $utilisationBound.machine_uuid
+AND sampled_at >= $utilisationBound.time
+ORDER BY sampled_at
+`, bound, machineUtilisation{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []machineUtilisation
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryMachineStmt, nameIdent).Get(&mUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return machineerrors.MachineNotFound
+		} else if err != nil {
+			return errors.Errorf("querying uuid for machine: %w", err)
+		}
+
+		bound.MachineUUID = mUUID.UUID
+		err = tx.Query(ctx, querySamplesStmt, bound).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying utilisation samples: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting utilisation samples for machine %q: %w", mName, err)
+	}
+
+	samples := make([]domainmachine.UtilisationSample, len(rows))
+	for i, row := range rows {
+		samples[i] = domainmachine.UtilisationSample{
+			Time:   row.SampledAt,
+			CPU:    row.CPU,
+			Memory: row.Memory,
+		}
+	}
+	return samples, nil
+}
+
+// utilisationTime returns the time as it is stored in the
+// machine_utilisation table. Times are stored in UTC to the second, so
+// that they compare in the order of their text representation.
+func utilisationTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Second)
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+)
+
+func (s *stateSuite) TestAddMachineUtilisationSample(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		err = s.state.AddMachineUtilisationSample(context.Background(), "666", domainmachine.UtilisationSample{
+			Time:   start.Add(time.Duration(i) * time.Hour),
+			CPU:    float64(10 * (i + 1)),
+			Memory: 50,
+		}, start)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	samples, err := s.state.GetMachineUtilisationSamples(context.Background(), "666", start.Add(time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(samples, gc.HasLen, 3)
+	for i, sample := range samples {
+		c.Check(sample.Time.Equal(start.Add(time.Duration(i+1)*time.Hour)), jc.IsTrue)
+		c.Check(sample.CPU, gc.Equals, float64(10*(i+2)))
+		c.Check(sample.Memory, gc.Equals, float64(50))
+	}
+}
+
+func (s *stateSuite) TestAddMachineUtilisationSampleRemovesOldSamples(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	err = s.state.AddMachineUtilisationSample(context.Background(), "666", domainmachine.UtilisationSample{
+		Time: start, CPU: 10, Memory: 20,
+	}, start)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.AddMachineUtilisationSample(context.Background(), "666", domainmachine.UtilisationSample{
+		Time: start.Add(2 * time.Hour), CPU: 30, Memory: 40,
+	}, start.Add(time.Hour))
+	c.Assert(err, jc.ErrorIsNil)
+
+	samples, err := s.state.GetMachineUtilisationSamples(context.Background(), "666", time.Time{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(samples, gc.HasLen, 1)
+	c.Check(samples[0].CPU, gc.Equals, float64(30))
+}
+
+func (s *stateSuite) TestAddMachineUtilisationSampleNotFound(c *gc.C) {
+	err := s.state.AddMachineUtilisationSample(context.Background(), "666", domainmachine.UtilisationSample{
+		Time: time.Now(),
+	}, time.Now())
+	c.Assert(err, jc.ErrorIs, machineerrors.MachineNotFound)
+}
+
+func (s *stateSuite) TestGetMachineUtilisationSamplesNone(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+
+	samples, err := s.state.GetMachineUtilisationSamples(context.Background(), "666", time.Time{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(samples, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetMachineUtilisationSamplesNotFound(c *gc.C) {
+	_, err := s.state.GetMachineUtilisationSamples(context.Background(), "666", time.Time{})
+	c.Assert(err, jc.ErrorIs, machineerrors.MachineNotFound)
+}
+
+func (s *stateSuite) TestDeleteMachineWithUtilisationSamples(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.AddMachineUtilisationSample(context.Background(), "666", domainmachine.UtilisationSample{
+		Time: time.Now(), CPU: 10, Memory: 20,
+	}, time.Time{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.DeleteMachine(context.Background(), "666")
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/domain/life"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AddWarmPoolMachine puts the specified machine in the model's warm pool,
+// to be matched to units by the input constraints and base. A machine
+// already in the pool has its constraints and base replaced.
+// It returns a MachineNotFound if the machine does not exist.
+func (st *State) AddWarmPoolMachine(ctx context.Context, mName machine.Name, constraints, base string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	nameIdent := machineName{Name: mName}
+	var mUUID machineUUID
+	queryMachineStmt, err := st.Prepare(`SELECT uuid AS &machineUUID.* FROM machine WHERE name = $machineName.name`, nameIdent, mUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	pooled := warmPoolMachine{
+		Constraints: constraints,
+		Base:        base,
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO machine_warm_pool (*) VALUES ($warmPoolMachine.*)
+ON CONFLICT (machine_uuid) DO
+UPDATE SET constraints = excluded.constraints, base = excluded.base
+`, pooled)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryMachineStmt, nameIdent).Get(&mUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return machineerrors.MachineNotFound
+		} else if err != nil {
+			return errors.Errorf("querying uuid for machine: %w", err)
+		}
+
+		pooled.MachineUUID = mUUID.UUID
+		if err := tx.Query(ctx, insertStmt, pooled).Run(); err != nil {
+			return errors.Errorf("adding machine to warm pool: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetWarmPoolMachines returns the alive machines in the model's warm pool,
+// ordered by name.
+func (st *State) GetWarmPoolMachines(ctx context.Context) ([]domainmachine.WarmPoolMachine, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	alive := machineLife{LifeID: life.Alive}
+	stmt, err := st.Prepare(`
+SELECT (m.name, wp.constraints, wp.base) AS (&warmPoolMachineName.*)
+FROM machine_warm_pool AS wp
+JOIN machine AS m ON m.uuid = wp.machine_uuid
+WHERE m.life_id = $machineLife.life_id
+ORDER BY m.name
+`, alive, warmPoolMachineName{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []warmPoolMachineName
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, alive).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("querying warm pool machines: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]domainmachine.WarmPoolMachine, len(rows))
+	for i, row := range rows {
+		result[i] = domainmachine.WarmPoolMachine{
+			Name:        row.Name,
+			Constraints: row.Constraints,
+			Base:        row.Base,
+		}
+	}
+	return result, nil
+}
+
+// RemoveWarmPoolMachine takes the specified machine out of the model's
+// warm pool.
+// It returns a MachineNotInWarmPool if the machine is not in the pool,
+// e.g. because it has been given to a unit.
+func (st *State) RemoveWarmPoolMachine(ctx context.Context, mName machine.Name) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	nameIdent := machineName{Name: mName}
+	stmt, err := st.Prepare(`
+DELETE FROM machine_warm_pool
+WHERE machine_uuid = (SELECT uuid FROM machine WHERE name = $machineName.name)
+`, nameIdent)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, nameIdent).Get(&outcome); err != nil {
+			return errors.Errorf("removing machine from warm pool: %w", err)
+		}
+		if n, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Capture(err)
+		} else if n == 0 {
+			return machineerrors.MachineNotInWarmPool
+		}
+		return nil
+	})
+}
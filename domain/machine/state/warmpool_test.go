@@ -0,0 +1,103 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/life"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+)
+
+func (s *stateSuite) TestGetWarmPoolMachines(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "667", "4", "2")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachine(context.Background(), "666", "3", "1")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachine(context.Background(), "668", "5", "3")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.AddWarmPoolMachine(context.Background(), "667", "arch=amd64 mem=4096M", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.AddWarmPoolMachine(context.Background(), "666", "arch=amd64", "ubuntu@22.04")
+	c.Assert(err, jc.ErrorIsNil)
+
+	machines, err := s.state.GetWarmPoolMachines(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machines, jc.DeepEquals, []domainmachine.WarmPoolMachine{
+		{Name: "666", Constraints: "arch=amd64", Base: "ubuntu@22.04"},
+		{Name: "667", Constraints: "arch=amd64 mem=4096M", Base: "ubuntu@24.04"},
+	})
+}
+
+func (s *stateSuite) TestAddWarmPoolMachineReplaces(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.AddWarmPoolMachine(context.Background(), "666", "arch=amd64", "ubuntu@22.04")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.AddWarmPoolMachine(context.Background(), "666", "arch=arm64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIsNil)
+
+	machines, err := s.state.GetWarmPoolMachines(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machines, jc.DeepEquals, []domainmachine.WarmPoolMachine{
+		{Name: "666", Constraints: "arch=arm64", Base: "ubuntu@24.04"},
+	})
+}
+
+func (s *stateSuite) TestAddWarmPoolMachineNotFound(c *gc.C) {
+	err := s.state.AddWarmPoolMachine(context.Background(), "666", "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIs, machineerrors.MachineNotFound)
+}
+
+func (s *stateSuite) TestGetWarmPoolMachinesOnlyAlive(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.AddWarmPoolMachine(context.Background(), "666", "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetMachineLife(context.Background(), "666", life.Dying)
+	c.Assert(err, jc.ErrorIsNil)
+
+	machines, err := s.state.GetWarmPoolMachines(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machines, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestRemoveWarmPoolMachine(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.AddWarmPoolMachine(context.Background(), "666", "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.RemoveWarmPoolMachine(context.Background(), "666")
+	c.Assert(err, jc.ErrorIsNil)
+
+	machines, err := s.state.GetWarmPoolMachines(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machines, gc.HasLen, 0)
+
+	// A machine can only be taken out of the pool once.
+	err = s.state.RemoveWarmPoolMachine(context.Background(), "666")
+	c.Assert(err, jc.ErrorIs, machineerrors.MachineNotInWarmPool)
+}
+
+func (s *stateSuite) TestDeleteWarmPoolMachine(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "666", "", "")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.AddWarmPoolMachine(context.Background(), "666", "arch=amd64", "ubuntu@24.04")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.DeleteMachine(context.Background(), "666")
+	c.Assert(err, jc.ErrorIsNil)
+
+	machines, err := s.state.GetWarmPoolMachines(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(machines, gc.HasLen, 0)
+}
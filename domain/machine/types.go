@@ -5,6 +5,10 @@ package machine
 
 import (
 	"time"
+
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/machine"
 )
 
 // StatusID represents the status of an entity.
@@ -50,3 +54,76 @@ const (
 	InstanceStatusRunning
 	InstanceStatusProvisioningError
 )
+
+// UtilisationSample is a sample of the CPU and memory utilisation of a
+// machine, as percentages, reported by its agent.
+type UtilisationSample struct {
+	Time   time.Time
+	CPU    float64
+	Memory float64
+}
+
+// Utilisation summarises the utilisation samples of a machine taken over
+// a period.
+type Utilisation struct {
+	// Samples is the number of samples taken.
+	Samples int
+
+	// From and To are the times of the first and last samples.
+	From, To time.Time
+
+	// MeanCPU and PeakCPU are the mean and 95th percentile of the CPU
+	// utilisation, as percentages.
+	MeanCPU, PeakCPU float64
+
+	// MeanMemory and PeakMemory are the mean and 95th percentile of the
+	// memory utilisation, as percentages.
+	MeanMemory, PeakMemory float64
+}
+
+// WarmPoolEntry is the number of machines with a set of constraints that
+// the model's warm pool should hold.
+type WarmPoolEntry struct {
+	Constraints constraints.Value
+	Size        int
+}
+
+// WarmPoolMachine is a machine in the model's warm pool, with the
+// constraints and base it is matched to units by.
+type WarmPoolMachine struct {
+	Name        machine.Name
+	Constraints string
+	Base        string
+}
+
+// WarmPoolReconciliation describes how to bring the model's warm pool in
+// line with its entries.
+type WarmPoolReconciliation struct {
+	// Missing holds the number of machines to add to the pool for each
+	// of the entries, in the order the entries were given.
+	Missing []int
+
+	// Surplus holds the machines taken out of the pool because it no
+	// longer wants them. They are to be removed from the model.
+	Surplus []machine.Name
+}
+
+// WarmPoolConstraints returns the constraints that the machines of the
+// warm pool started with the input constraints are matched to units by.
+// A machine has the default architecture if none is given, and the
+// container constraint does not apply to machines.
+func WarmPoolConstraints(cons constraints.Value) string {
+	if !cons.HasArch() {
+		a := constraints.ArchOrDefault(cons, nil)
+		cons.Arch = &a
+	}
+	cons.Container = nil
+	return cons.String()
+}
+
+// WarmPoolBase returns the base that the machines of the warm pool
+// started with the input base are matched to units by. The risk of the
+// base's channel is ignored.
+func WarmPoolBase(base corebase.Base) string {
+	return base.OS + "@" + base.Channel.Track
+}
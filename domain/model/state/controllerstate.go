@@ -751,7 +751,9 @@ func createModel(
 // - Secret backend ref counting
 // - Model agent information
 // - Model permissions
+// - Model role grants
 // - Model login information
+// - Model admission policies
 func (s *State) Delete(
 	ctx context.Context,
 	uuid coremodel.UUID,
@@ -768,7 +770,11 @@ func (s *State) Delete(
 		`DELETE FROM secret_backend_reference WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM model_authorized_keys WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM permission WHERE grant_on = $dbUUID.uuid`,
+		`DELETE FROM permission_role_grant WHERE grant_on = $dbUUID.uuid`,
+		`DELETE FROM permission_group WHERE grant_on = $dbUUID.uuid`,
+		`DELETE FROM service_account_token_scope WHERE grant_on = $dbUUID.uuid`,
 		`DELETE FROM model_last_login WHERE model_uuid = $dbUUID.uuid`,
+		`DELETE FROM admission_policy WHERE model_uuid = $dbUUID.uuid`,
 	}
 
 	var stmts []*sqlair.Statement
@@ -425,35 +425,39 @@ type dbModelConstraint struct {
 
 // dbConstraint represents a single row within the v_model_constraint view.
 type dbConstraint struct {
-	Arch             sql.NullString `db:"arch"`
-	CPUCores         sql.NullInt64  `db:"cpu_cores"`
-	CPUPower         sql.NullInt64  `db:"cpu_power"`
-	Mem              sql.NullInt64  `db:"mem"`
-	RootDisk         sql.NullInt64  `db:"root_disk"`
-	RootDiskSource   sql.NullString `db:"root_disk_source"`
-	InstanceRole     sql.NullString `db:"instance_role"`
-	InstanceType     sql.NullString `db:"instance_type"`
-	ContainerType    sql.NullString `db:"container_type"`
-	VirtType         sql.NullString `db:"virt_type"`
-	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
-	ImageID          sql.NullString `db:"image_id"`
+	Arch             sql.NullString  `db:"arch"`
+	CPUCores         sql.NullInt64   `db:"cpu_cores"`
+	CPUPower         sql.NullInt64   `db:"cpu_power"`
+	Mem              sql.NullInt64   `db:"mem"`
+	RootDisk         sql.NullInt64   `db:"root_disk"`
+	RootDiskSource   sql.NullString  `db:"root_disk_source"`
+	InstanceRole     sql.NullString  `db:"instance_role"`
+	InstanceType     sql.NullString  `db:"instance_type"`
+	ContainerType    sql.NullString  `db:"container_type"`
+	VirtType         sql.NullString  `db:"virt_type"`
+	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
+	ImageID          sql.NullString  `db:"image_id"`
+	Spot             sql.NullBool    `db:"spot"`
+	SpotMaxPrice     sql.NullFloat64 `db:"spot_max_price"`
 }
 
 // dbConstraintInsert is used to supply insert values into the constraint table.
 type dbConstraintInsert struct {
-	UUID             string         `db:"uuid"`
-	Arch             sql.NullString `db:"arch"`
-	CPUCores         sql.NullInt64  `db:"cpu_cores"`
-	CPUPower         sql.NullInt64  `db:"cpu_power"`
-	Mem              sql.NullInt64  `db:"mem"`
-	RootDisk         sql.NullInt64  `db:"root_disk"`
-	RootDiskSource   sql.NullString `db:"root_disk_source"`
-	InstanceRole     sql.NullString `db:"instance_role"`
-	InstanceType     sql.NullString `db:"instance_type"`
-	ContainerTypeId  sql.NullInt64  `db:"container_type_id"`
-	VirtType         sql.NullString `db:"virt_type"`
-	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
-	ImageID          sql.NullString `db:"image_id"`
+	UUID             string          `db:"uuid"`
+	Arch             sql.NullString  `db:"arch"`
+	CPUCores         sql.NullInt64   `db:"cpu_cores"`
+	CPUPower         sql.NullInt64   `db:"cpu_power"`
+	Mem              sql.NullInt64   `db:"mem"`
+	RootDisk         sql.NullInt64   `db:"root_disk"`
+	RootDiskSource   sql.NullString  `db:"root_disk_source"`
+	InstanceRole     sql.NullString  `db:"instance_role"`
+	InstanceType     sql.NullString  `db:"instance_type"`
+	ContainerTypeId  sql.NullInt64   `db:"container_type_id"`
+	VirtType         sql.NullString  `db:"virt_type"`
+	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
+	ImageID          sql.NullString  `db:"image_id"`
+	Spot             sql.NullBool    `db:"spot"`
+	SpotMaxPrice     sql.NullFloat64 `db:"spot_max_price"`
 }
 
 // constraintsToDBInsert is responsible for taking a constraints value and
@@ -508,6 +512,14 @@ func constraintsToDBInsert(
 			String: deref(constraints.ImageID),
 			Valid:  constraints.ImageID != nil,
 		},
+		Spot: sql.NullBool{
+			Bool:  deref(constraints.Spot),
+			Valid: constraints.Spot != nil,
+		},
+		SpotMaxPrice: sql.NullFloat64{
+			Float64: deref(constraints.SpotMaxPrice),
+			Valid:   constraints.SpotMaxPrice != nil,
+		},
 	}
 }
 
@@ -567,6 +579,12 @@ func (c dbConstraint) toValue(
 	if c.ImageID.Valid {
 		rval.ImageID = &c.ImageID.String
 	}
+	if c.Spot.Valid {
+		rval.Spot = &c.Spot.Bool
+	}
+	if c.SpotMaxPrice.Valid {
+		rval.SpotMaxPrice = &c.SpotMaxPrice.Float64
+	}
 	if c.ContainerType.Valid {
 		containerType := instance.ContainerType(c.ContainerType.String)
 		rval.Container = &containerType
@@ -21,4 +21,14 @@ const (
 	// AvailabilityZoneNotFound is returned when an availability zone is
 	// not found.
 	AvailabilityZoneNotFound = errors.ConstError("availability zone not found")
+
+	// CIDRSetNotFound is returned when a CIDR set is not found.
+	CIDRSetNotFound = errors.ConstError("cidr set not found")
+
+	// CIDRSetNameNotValid is returned when a CIDR set name is not valid.
+	CIDRSetNameNotValid = errors.ConstError("cidr set name is not valid")
+
+	// CIDRSetInUse is returned when a CIDR set cannot be removed because
+	// it is referenced by an application firewall rule.
+	CIDRSetInUse = errors.ConstError("cidr set in use")
 )
@@ -0,0 +1,57 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/juju/names/v6"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainnetwork "github.com/juju/juju/domain/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// SetCIDRSet creates the named CIDR set, or replaces the CIDRs of an
+// existing set with the same name. Set names follow the same rules as
+// space names, and at least one valid CIDR must be supplied.
+func (s *Service) SetCIDRSet(ctx context.Context, name string, cidrs []string) error {
+	if !names.IsValidSpace(name) {
+		return errors.Errorf("cidr set name %q not valid", name).Add(networkerrors.CIDRSetNameNotValid)
+	}
+	if len(cidrs) == 0 {
+		return errors.Errorf("cidr set %q requires at least one cidr", name).Add(coreerrors.NotValid)
+	}
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.Errorf("cidr %q for cidr set %q not valid", cidr, name).Add(coreerrors.NotValid)
+		}
+	}
+
+	setUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Errorf("creating uuid for cidr set %q: %w", name, err)
+	}
+	return errors.Capture(s.st.SetCIDRSet(ctx, setUUID.String(), name, cidrs))
+}
+
+// RemoveCIDRSet removes the named CIDR set. If the set is not found, an
+// error is returned matching [networkerrors.CIDRSetNotFound]. If the set is
+// referenced by an application firewall rule, an error is returned matching
+// [networkerrors.CIDRSetInUse].
+func (s *Service) RemoveCIDRSet(ctx context.Context, name string) error {
+	return errors.Capture(s.st.DeleteCIDRSet(ctx, name))
+}
+
+// GetCIDRSets returns all the CIDR sets in the model.
+func (s *Service) GetCIDRSets(ctx context.Context) ([]domainnetwork.CIDRSet, error) {
+	sets, err := s.st.GetCIDRSets(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return sets, nil
+}
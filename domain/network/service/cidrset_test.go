@@ -0,0 +1,87 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	domainnetwork "github.com/juju/juju/domain/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type cidrSetSuite struct {
+	testing.IsolationSuite
+
+	st *MockState
+}
+
+var _ = gc.Suite(&cidrSetSuite{})
+
+func (s *cidrSetSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.st = NewMockState(ctrl)
+	return ctrl
+}
+
+func (s *cidrSetSuite) TestSetCIDRSet(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().SetCIDRSet(gomock.Any(), gomock.Any(), "office", []string{"10.0.0.0/8", "2001:db8::/32"}).Return(nil)
+
+	err := NewService(s.st, loggertesting.WrapCheckLog(c)).SetCIDRSet(
+		context.Background(), "office", []string{"10.0.0.0/8", "2001:db8::/32"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *cidrSetSuite) TestSetCIDRSetInvalidName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.st, loggertesting.WrapCheckLog(c)).SetCIDRSet(
+		context.Background(), "-bad name-", []string{"10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIs, networkerrors.CIDRSetNameNotValid)
+}
+
+func (s *cidrSetSuite) TestSetCIDRSetNoCIDRs(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.st, loggertesting.WrapCheckLog(c)).SetCIDRSet(
+		context.Background(), "office", nil)
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *cidrSetSuite) TestSetCIDRSetInvalidCIDR(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.st, loggertesting.WrapCheckLog(c)).SetCIDRSet(
+		context.Background(), "office", []string{"10.0.0.0/8", "10.0.0.1"})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+	c.Check(err, gc.ErrorMatches, `cidr "10.0.0.1" for cidr set "office" not valid`)
+}
+
+func (s *cidrSetSuite) TestRemoveCIDRSetInUse(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().DeleteCIDRSet(gomock.Any(), "office").Return(networkerrors.CIDRSetInUse)
+
+	err := NewService(s.st, loggertesting.WrapCheckLog(c)).RemoveCIDRSet(context.Background(), "office")
+	c.Assert(err, jc.ErrorIs, networkerrors.CIDRSetInUse)
+}
+
+func (s *cidrSetSuite) TestGetCIDRSets(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expected := []domainnetwork.CIDRSet{{Name: "office", CIDRs: []string{"10.0.0.0/8"}}}
+	s.st.EXPECT().GetCIDRSets(gomock.Any()).Return(expected, nil)
+
+	sets, err := NewService(s.st, loggertesting.WrapCheckLog(c)).GetCIDRSets(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sets, jc.DeepEquals, expected)
+}
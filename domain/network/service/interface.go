@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
+	domainnetwork "github.com/juju/juju/domain/network"
 	"github.com/juju/juju/environs"
 )
 
@@ -47,6 +48,7 @@ type WatcherFactory interface {
 type State interface {
 	SpaceState
 	SubnetState
+	CIDRSetState
 }
 
 // SpaceState describes persistence layer methods for the space (sub-) domain.
@@ -105,3 +107,18 @@ type SubnetState interface {
 	// observing changes to subnets.
 	NamespaceForWatchSubnet() string
 }
+
+// CIDRSetState describes persistence layer methods for named CIDR sets.
+type CIDRSetState interface {
+	// SetCIDRSet creates the named CIDR set, or replaces the CIDRs of the
+	// existing set with the same name.
+	SetCIDRSet(ctx context.Context, uuid, name string, cidrs []string) error
+	// DeleteCIDRSet deletes the named CIDR set. If the set is not found, an
+	// error is returned matching
+	// [github.com/juju/juju/domain/network/errors.CIDRSetNotFound]. If the
+	// set is referenced by a firewall rule, an error is returned matching
+	// [github.com/juju/juju/domain/network/errors.CIDRSetInUse].
+	DeleteCIDRSet(ctx context.Context, name string) error
+	// GetCIDRSets returns all the CIDR sets in the model.
+	GetCIDRSets(ctx context.Context) ([]domainnetwork.CIDRSet, error)
+}
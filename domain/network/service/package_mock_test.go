@@ -16,6 +16,7 @@ import (
 	database "github.com/juju/juju/core/database"
 	instance "github.com/juju/juju/core/instance"
 	network "github.com/juju/juju/core/network"
+	network0 "github.com/juju/juju/domain/network"
 	environs "github.com/juju/juju/environs"
 	names "github.com/juju/names/v6"
 	gomock "go.uber.org/mock/gomock"
@@ -159,6 +160,44 @@ func (c *MockStateAllSubnetsQueryCall) DoAndReturn(f func(context.Context, datab
 	return c
 }
 
+// DeleteCIDRSet mocks base method.
+func (m *MockState) DeleteCIDRSet(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCIDRSet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCIDRSet indicates an expected call of DeleteCIDRSet.
+func (mr *MockStateMockRecorder) DeleteCIDRSet(arg0, arg1 any) *MockStateDeleteCIDRSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCIDRSet", reflect.TypeOf((*MockState)(nil).DeleteCIDRSet), arg0, arg1)
+	return &MockStateDeleteCIDRSetCall{Call: call}
+}
+
+// MockStateDeleteCIDRSetCall wrap *gomock.Call
+type MockStateDeleteCIDRSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteCIDRSetCall) Return(arg0 error) *MockStateDeleteCIDRSetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteCIDRSetCall) Do(f func(context.Context, string) error) *MockStateDeleteCIDRSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteCIDRSetCall) DoAndReturn(f func(context.Context, string) error) *MockStateDeleteCIDRSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteSpace mocks base method.
 func (m *MockState) DeleteSpace(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -313,6 +352,45 @@ func (c *MockStateGetAllSubnetsCall) DoAndReturn(f func(context.Context) (networ
 	return c
 }
 
+// GetCIDRSets mocks base method.
+func (m *MockState) GetCIDRSets(arg0 context.Context) ([]network0.CIDRSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCIDRSets", arg0)
+	ret0, _ := ret[0].([]network0.CIDRSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCIDRSets indicates an expected call of GetCIDRSets.
+func (mr *MockStateMockRecorder) GetCIDRSets(arg0 any) *MockStateGetCIDRSetsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCIDRSets", reflect.TypeOf((*MockState)(nil).GetCIDRSets), arg0)
+	return &MockStateGetCIDRSetsCall{Call: call}
+}
+
+// MockStateGetCIDRSetsCall wrap *gomock.Call
+type MockStateGetCIDRSetsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetCIDRSetsCall) Return(arg0 []network0.CIDRSet, arg1 error) *MockStateGetCIDRSetsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetCIDRSetsCall) Do(f func(context.Context) ([]network0.CIDRSet, error)) *MockStateGetCIDRSetsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetCIDRSetsCall) DoAndReturn(f func(context.Context) ([]network0.CIDRSet, error)) *MockStateGetCIDRSetsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSpace mocks base method.
 func (m *MockState) GetSpace(arg0 context.Context, arg1 string) (*network.SpaceInfo, error) {
 	m.ctrl.T.Helper()
@@ -551,6 +629,44 @@ func (c *MockStateNamespaceForWatchSubnetCall) DoAndReturn(f func() string) *Moc
 	return c
 }
 
+// SetCIDRSet mocks base method.
+func (m *MockState) SetCIDRSet(arg0 context.Context, arg1, arg2 string, arg3 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCIDRSet", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCIDRSet indicates an expected call of SetCIDRSet.
+func (mr *MockStateMockRecorder) SetCIDRSet(arg0, arg1, arg2, arg3 any) *MockStateSetCIDRSetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCIDRSet", reflect.TypeOf((*MockState)(nil).SetCIDRSet), arg0, arg1, arg2, arg3)
+	return &MockStateSetCIDRSetCall{Call: call}
+}
+
+// MockStateSetCIDRSetCall wrap *gomock.Call
+type MockStateSetCIDRSetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetCIDRSetCall) Return(arg0 error) *MockStateSetCIDRSetCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetCIDRSetCall) Do(f func(context.Context, string, string, []string) error) *MockStateSetCIDRSetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetCIDRSetCall) DoAndReturn(f func(context.Context, string, string, []string) error) *MockStateSetCIDRSetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSpace mocks base method.
 func (m *MockState) UpdateSpace(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
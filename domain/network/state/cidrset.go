@@ -0,0 +1,189 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	domainnetwork "github.com/juju/juju/domain/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetCIDRSet creates the named CIDR set, or replaces the CIDRs of the
+// existing set with the same name. The uuid is only used when the set
+// is created.
+func (st *State) SetCIDRSet(ctx context.Context, uuid, name string, cidrs []string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	set := cidrSet{UUID: uuid, Name: name}
+	selectStmt, err := st.Prepare(`
+SELECT &cidrSet.uuid
+FROM   cidr_set
+WHERE  name = $cidrSet.name`, set)
+	if err != nil {
+		return errors.Errorf("preparing select cidr set statement: %w", err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO cidr_set (uuid, name)
+VALUES ($cidrSet.*)`, set)
+	if err != nil {
+		return errors.Errorf("preparing insert cidr set statement: %w", err)
+	}
+
+	deleteCIDRsStmt, err := st.Prepare(`
+DELETE FROM cidr_set_cidr
+WHERE cidr_set_uuid = $cidrSet.uuid`, set)
+	if err != nil {
+		return errors.Errorf("preparing delete cidr set cidrs statement: %w", err)
+	}
+
+	insertCIDRStmt, err := st.Prepare(`
+INSERT INTO cidr_set_cidr (cidr_set_uuid, cidr)
+VALUES ($cidrSetCIDR.*)`, cidrSetCIDR{})
+	if err != nil {
+		return errors.Errorf("preparing insert cidr set cidr statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existing cidrSet
+		err := tx.Query(ctx, selectStmt, set).Get(&existing)
+		switch {
+		case errors.Is(err, sqlair.ErrNoRows):
+			if err := tx.Query(ctx, insertStmt, set).Run(); err != nil {
+				return errors.Errorf("inserting cidr set %q: %w", name, err)
+			}
+		case err != nil:
+			return errors.Errorf("retrieving cidr set %q: %w", name, err)
+		default:
+			set.UUID = existing.UUID
+			if err := tx.Query(ctx, deleteCIDRsStmt, set).Run(); err != nil {
+				return errors.Errorf("removing cidrs of cidr set %q: %w", name, err)
+			}
+		}
+
+		for _, cidr := range cidrs {
+			row := cidrSetCIDR{CIDRSetUUID: set.UUID, CIDR: cidr}
+			if err := tx.Query(ctx, insertCIDRStmt, row).Run(); err != nil {
+				return errors.Errorf("inserting cidr %q for cidr set %q: %w", cidr, name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteCIDRSet deletes the named CIDR set. If the set is not found, an
+// error is returned matching [networkerrors.CIDRSetNotFound]. If the set is
+// referenced by an application firewall rule, an error is returned matching
+// [networkerrors.CIDRSetInUse].
+func (st *State) DeleteCIDRSet(ctx context.Context, name string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	set := cidrSet{Name: name}
+	selectStmt, err := st.Prepare(`
+SELECT &cidrSet.uuid
+FROM   cidr_set
+WHERE  name = $cidrSet.name`, set)
+	if err != nil {
+		return errors.Errorf("preparing select cidr set statement: %w", err)
+	}
+
+	usageStmt, err := st.Prepare(`
+SELECT COUNT(*) AS &countResult.count
+FROM   application_firewall_rule_cidr_set
+WHERE  cidr_set_uuid = $cidrSet.uuid`, countResult{}, set)
+	if err != nil {
+		return errors.Errorf("preparing cidr set usage statement: %w", err)
+	}
+
+	deleteCIDRsStmt, err := st.Prepare(`
+DELETE FROM cidr_set_cidr
+WHERE cidr_set_uuid = $cidrSet.uuid`, set)
+	if err != nil {
+		return errors.Errorf("preparing delete cidr set cidrs statement: %w", err)
+	}
+
+	deleteStmt, err := st.Prepare(`
+DELETE FROM cidr_set
+WHERE uuid = $cidrSet.uuid`, set)
+	if err != nil {
+		return errors.Errorf("preparing delete cidr set statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, selectStmt, set).Get(&set)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("cidr set %q: %w", name, networkerrors.CIDRSetNotFound)
+		} else if err != nil {
+			return errors.Errorf("retrieving cidr set %q: %w", name, err)
+		}
+
+		var usage countResult
+		if err := tx.Query(ctx, usageStmt, set).Get(&usage); err != nil {
+			return errors.Errorf("checking usage of cidr set %q: %w", name, err)
+		}
+		if usage.Count > 0 {
+			return errors.Errorf(
+				"cidr set %q is referenced by %d firewall rule(s): %w", name, usage.Count, networkerrors.CIDRSetInUse)
+		}
+
+		if err := tx.Query(ctx, deleteCIDRsStmt, set).Run(); err != nil {
+			return errors.Errorf("removing cidrs of cidr set %q: %w", name, err)
+		}
+		if err := tx.Query(ctx, deleteStmt, set).Run(); err != nil {
+			return errors.Errorf("removing cidr set %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// GetCIDRSets returns all the CIDR sets in the model, ordered by name.
+func (st *State) GetCIDRSets(ctx context.Context) ([]domainnetwork.CIDRSet, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT (cs.name, csc.cidr) AS (&cidrSetRow.*)
+FROM   cidr_set AS cs
+LEFT JOIN cidr_set_cidr AS csc ON cs.uuid = csc.cidr_set_uuid
+ORDER BY cs.name, csc.cidr`, cidrSetRow{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select cidr sets statement: %w", err)
+	}
+
+	var rows []cidrSetRow
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving cidr sets: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// Rows are ordered by name, so each set's rows are contiguous.
+	var result []domainnetwork.CIDRSet
+	for _, row := range rows {
+		if len(result) == 0 || result[len(result)-1].Name != row.Name {
+			result = append(result, domainnetwork.CIDRSet{Name: row.Name})
+		}
+		if row.CIDR.Valid {
+			last := &result[len(result)-1]
+			last.CIDRs = append(last.CIDRs, row.CIDR.String)
+		}
+	}
+	return result, nil
+}
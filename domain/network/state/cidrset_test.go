@@ -0,0 +1,94 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	domainnetwork "github.com/juju/juju/domain/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type cidrSetSuite struct {
+	schematesting.ModelSuite
+}
+
+var _ = gc.Suite(&cidrSetSuite{})
+
+func (s *cidrSetSuite) TestSetCIDRSet(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetCIDRSet(context.Background(), "set-uuid-0", "office", []string{"10.0.0.0/8", "192.168.0.0/16"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.SetCIDRSet(context.Background(), "set-uuid-1", "vpn", []string{"172.16.0.0/12"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	sets, err := st.GetCIDRSets(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sets, jc.DeepEquals, []domainnetwork.CIDRSet{
+		{Name: "office", CIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+		{Name: "vpn", CIDRs: []string{"172.16.0.0/12"}},
+	})
+}
+
+func (s *cidrSetSuite) TestSetCIDRSetReplacesCIDRs(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetCIDRSet(context.Background(), "set-uuid-0", "office", []string{"10.0.0.0/8", "192.168.0.0/16"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.SetCIDRSet(context.Background(), "set-uuid-1", "office", []string{"10.1.0.0/16"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The original uuid is kept so that rules referencing the set are
+	// unaffected.
+	row := s.DB().QueryRow("SELECT uuid FROM cidr_set WHERE name = 'office'")
+	var uuid string
+	c.Assert(row.Scan(&uuid), jc.ErrorIsNil)
+	c.Check(uuid, gc.Equals, "set-uuid-0")
+
+	sets, err := st.GetCIDRSets(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sets, jc.DeepEquals, []domainnetwork.CIDRSet{
+		{Name: "office", CIDRs: []string{"10.1.0.0/16"}},
+	})
+}
+
+func (s *cidrSetSuite) TestGetCIDRSetsEmpty(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	sets, err := st.GetCIDRSets(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sets, gc.HasLen, 0)
+}
+
+func (s *cidrSetSuite) TestDeleteCIDRSet(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetCIDRSet(context.Background(), "set-uuid-0", "office", []string{"10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.DeleteCIDRSet(context.Background(), "office")
+	c.Assert(err, jc.ErrorIsNil)
+
+	sets, err := st.GetCIDRSets(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sets, gc.HasLen, 0)
+
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM cidr_set_cidr")
+	var count int
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+}
+
+func (s *cidrSetSuite) TestDeleteCIDRSetNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.DeleteCIDRSet(context.Background(), "office")
+	c.Assert(err, jc.ErrorIs, networkerrors.CIDRSetNotFound)
+}
@@ -295,3 +295,27 @@ type machineInterfaceRow struct {
 	IsSecondary      sql.NullBool   `db:"is_secondary"`
 	IsShadow         sql.NullBool   `db:"is_shadow"`
 }
+
+// cidrSet represents a single row from the cidr_set table.
+type cidrSet struct {
+	// UUID is the unique ID of the CIDR set.
+	UUID string `db:"uuid"`
+	// Name is the unique name of the CIDR set.
+	Name string `db:"name"`
+}
+
+// cidrSetCIDR represents a single row from the cidr_set_cidr table.
+type cidrSetCIDR struct {
+	// CIDRSetUUID is the UUID of the CIDR set.
+	CIDRSetUUID string `db:"cidr_set_uuid"`
+	// CIDR is a CIDR in the set.
+	CIDR string `db:"cidr"`
+}
+
+// cidrSetRow represents a CIDR set joined with one of its CIDRs.
+type cidrSetRow struct {
+	// Name is the name of the CIDR set.
+	Name string `db:"name"`
+	// CIDR is a CIDR in the set, NULL if the set is empty.
+	CIDR sql.NullString `db:"cidr"`
+}
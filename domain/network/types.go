@@ -0,0 +1,13 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network
+
+// CIDRSet is a named set of CIDRs that can be referenced as the source of
+// application firewall rules.
+type CIDRSet struct {
+	// Name is the unique name of the set.
+	Name string
+	// CIDRs are the CIDRs that make up the set.
+	CIDRs []string
+}
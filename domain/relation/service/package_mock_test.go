@@ -1447,6 +1447,44 @@ func (c *MockStateWatcherApplicationSettingsNamespaceCall) DoAndReturn(f func()
 	return c
 }
 
+// WatcherRelationsNamespace mocks base method.
+func (m *MockState) WatcherRelationsNamespace() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatcherRelationsNamespace")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WatcherRelationsNamespace indicates an expected call of WatcherRelationsNamespace.
+func (mr *MockStateMockRecorder) WatcherRelationsNamespace() *MockStateWatcherRelationsNamespaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatcherRelationsNamespace", reflect.TypeOf((*MockState)(nil).WatcherRelationsNamespace))
+	return &MockStateWatcherRelationsNamespaceCall{Call: call}
+}
+
+// MockStateWatcherRelationsNamespaceCall wrap *gomock.Call
+type MockStateWatcherRelationsNamespaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateWatcherRelationsNamespaceCall) Return(arg0 string) *MockStateWatcherRelationsNamespaceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateWatcherRelationsNamespaceCall) Do(f func() string) *MockStateWatcherRelationsNamespaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateWatcherRelationsNamespaceCall) DoAndReturn(f func() string) *MockStateWatcherRelationsNamespaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockWatcherFactory is a mock of WatcherFactory interface.
 type MockWatcherFactory struct {
 	ctrl     *gomock.Controller
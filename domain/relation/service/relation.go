@@ -315,6 +315,10 @@ type State interface {
 	// watchers for relation application settings.
 	WatcherApplicationSettingsNamespace() string
 
+	// WatcherRelationsNamespace provides the table name to set up watchers
+	// for the relations in the model.
+	WatcherRelationsNamespace() string
+
 	// InitialWatchRelatedUnits initializes a watch for changes related to the
 	// specified unit in the given relation.
 	InitialWatchRelatedUnits(name unit.Name, uuid corerelation.UUID) ([]string, eventsource.NamespaceQuery, eventsource.Mapper)
@@ -83,6 +83,14 @@ func (s *WatchableService) WatchApplicationSettings(
 	)
 }
 
+// WatchRelations returns a notify watcher that will signal whenever a
+// relation in the model is added, removed, or changes life.
+func (s *WatchableService) WatchRelations(ctx context.Context) (watcher.NotifyWatcher, error) {
+	return s.watcherFactory.NewNotifyWatcher(
+		eventsource.NamespaceFilter(s.st.WatcherRelationsNamespace(), changestream.All),
+	)
+}
+
 // WatchLifeSuspendedStatus returns a watcher that notifies of changes to
 // the life or suspended status any relation the unit's application is part
 // of. If the unit is a subordinate, its principal application is watched.
@@ -2704,6 +2704,12 @@ func (st *State) WatcherApplicationSettingsNamespace() string {
 	return "relation_application_settings_hash"
 }
 
+// WatcherRelationsNamespace returns the namespace string used for tracking
+// the relations of the model in the database.
+func (st *State) WatcherRelationsNamespace() string {
+	return "relation"
+}
+
 // GetMapperDataForWatchLifeSuspendedStatus returns data needed to evaluate a relation
 // uuid as part of WatchLifeSuspendedStatus eventmapper.
 //
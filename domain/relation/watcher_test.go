@@ -123,6 +123,39 @@ WHERE relation_endpoint_uuid = ?
 	harness.Run(c, struct{}{})
 }
 
+// TestWatchRelations ensures the relations watcher notifies when relations
+// are added, change life, or are removed.
+func (s *watcherSuite) TestWatchRelations(c *gc.C) {
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "relation")
+	relationUUID := relationtesting.GenRelationUUID(c)
+
+	svc := s.setupService(c, factory)
+	watcher, err := svc.WatchRelations(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	harness.AddTest(func(c *gc.C) {
+		s.addRelation(c, relationUUID)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.Check(watchertest.SliceAssert(struct{}{}))
+	})
+
+	harness.AddTest(func(c *gc.C) {
+		s.act(c, `UPDATE relation SET life_id = 1 WHERE uuid = ?`, relationUUID)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.Check(watchertest.SliceAssert(struct{}{}))
+	})
+
+	harness.AddTest(func(c *gc.C) {
+		s.act(c, `DELETE FROM relation WHERE uuid = ?`, relationUUID)
+	}, func(w watchertest.WatcherC[struct{}]) {
+		w.Check(watchertest.SliceAssert(struct{}{}))
+	})
+
+	harness.Run(c, struct{}{})
+}
+
 func (s *watcherSuite) TestWatchLifeSuspendedStatusPrincipal(c *gc.C) {
 	// Arrange: create the required state, with one relation and its status.
 	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, s.ModelUUID())
@@ -0,0 +1,38 @@
+-- The ssh_session table records the sessions to units and machines proxied by
+-- the controller's embedded SSH server. The recording of each session is held
+-- in the controller's object store.
+-- The model is not a foreign key, as the record of a session must outlive the
+-- model for auditing.
+CREATE TABLE ssh_session (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    user_name TEXT NOT NULL,
+    model_uuid TEXT NOT NULL,
+    target TEXT NOT NULL,
+    command TEXT,
+    started_at DATETIME NOT NULL,
+    ended_at DATETIME NOT NULL,
+    exit_code INT NOT NULL,
+    object_store_uuid TEXT NOT NULL,
+    CONSTRAINT fk_ssh_session_object_store_metadata
+    FOREIGN KEY (object_store_uuid)
+    REFERENCES object_store_metadata (uuid)
+);
+
+CREATE INDEX idx_ssh_session_model_uuid ON ssh_session (model_uuid);
+
+CREATE VIEW v_ssh_session AS
+SELECT
+    s.uuid,
+    s.user_name,
+    s.model_uuid,
+    s.target,
+    s.command,
+    s.started_at,
+    s.ended_at,
+    s.exit_code,
+    s.object_store_uuid,
+    osm.size,
+    osmp.path
+FROM ssh_session AS s
+JOIN object_store_metadata AS osm ON s.object_store_uuid = osm.uuid
+JOIN object_store_metadata_path AS osmp ON osm.uuid = osmp.metadata_uuid;
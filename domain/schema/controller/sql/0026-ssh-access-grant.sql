@@ -0,0 +1,59 @@
+CREATE TABLE ssh_access_grant_status (
+    id INT PRIMARY KEY,
+    status TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_ssh_access_grant_status_status
+ON ssh_access_grant_status (status);
+
+INSERT INTO ssh_access_grant_status VALUES
+(0, 'pending'),  -- Requested, waiting for approval.
+(1, 'approved'), -- Approved, valid until the grant expires.
+(2, 'rejected'), -- Rejected before it was approved.
+(3, 'revoked');  -- Revoked by an admin or the user.
+
+-- The ssh_access_grant table records the requests of users for time bounded
+-- ssh access to a unit or machine through the controller's embedded SSH server,
+-- along with their approval.
+-- The model is not a foreign key, as the record of a grant must outlive the
+-- model for auditing.
+CREATE TABLE ssh_access_grant (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    user_name TEXT NOT NULL,
+    model_uuid TEXT NOT NULL,
+    -- The virtual hostname of the unit or machine.
+    target TEXT NOT NULL,
+    reason TEXT,
+    -- The duration of the grant in seconds, from when it is approved.
+    duration_seconds INT NOT NULL,
+    status_id INT NOT NULL,
+    requested_at DATETIME NOT NULL,
+    decided_by TEXT,
+    decided_at DATETIME,
+    expires_at DATETIME,
+    CONSTRAINT fk_ssh_access_grant_status
+    FOREIGN KEY (status_id)
+    REFERENCES ssh_access_grant_status (id)
+);
+
+CREATE INDEX idx_ssh_access_grant_model_uuid
+ON ssh_access_grant (model_uuid);
+
+CREATE INDEX idx_ssh_access_grant_user_target
+ON ssh_access_grant (user_name, model_uuid, target);
+
+CREATE VIEW v_ssh_access_grant AS
+SELECT
+    g.uuid,
+    g.user_name,
+    g.model_uuid,
+    g.target,
+    g.reason,
+    g.duration_seconds,
+    s.status,
+    g.requested_at,
+    g.decided_by,
+    g.decided_at,
+    g.expires_at
+FROM ssh_access_grant AS g
+JOIN ssh_access_grant_status AS s ON g.status_id = s.id;
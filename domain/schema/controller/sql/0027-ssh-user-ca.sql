@@ -0,0 +1,29 @@
+-- The ssh_user_ca table holds the certificate authority which signs the
+-- certificates users authenticate to the controller's embedded SSH server
+-- with. There is only ever one authority for a controller.
+CREATE TABLE ssh_user_ca (
+    id INT NOT NULL PRIMARY KEY DEFAULT (0),
+    -- The PEM encoded private key of the authority.
+    private_key TEXT NOT NULL,
+    -- The public key of the authority, in authorized keys format.
+    public_key TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    CHECK (id = 0)
+);
+
+-- The ssh_user_certificate table records the certificates issued to users by
+-- the ssh user certificate authority, so that they can be listed and revoked
+-- before they expire.
+CREATE TABLE ssh_user_certificate (
+    serial INT NOT NULL PRIMARY KEY,
+    user_name TEXT NOT NULL,
+    -- The SHA256 fingerprint of the certified public key.
+    key_fingerprint TEXT NOT NULL,
+    valid_after DATETIME NOT NULL,
+    valid_before DATETIME NOT NULL,
+    revoked_by TEXT,
+    revoked_at DATETIME
+);
+
+CREATE INDEX idx_ssh_user_certificate_user_name
+ON ssh_user_certificate (user_name);
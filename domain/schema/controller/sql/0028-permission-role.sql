@@ -0,0 +1,60 @@
+-- The permission_role table holds the custom roles, each a named set of API
+-- methods which users may be granted on a model or the controller, beyond the
+-- fixed access levels of the permission table.
+CREATE TABLE permission_role (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT
+);
+
+CREATE UNIQUE INDEX idx_permission_role_name
+ON permission_role (name);
+
+-- A method is either "Facade.Method", or "Facade.*" for every method of the
+-- facade.
+CREATE TABLE permission_role_method (
+    role_uuid TEXT NOT NULL,
+    method TEXT NOT NULL,
+    PRIMARY KEY (role_uuid, method),
+    CONSTRAINT fk_permission_role_method_role
+    FOREIGN KEY (role_uuid)
+    REFERENCES permission_role (uuid)
+);
+
+-- Roles are granted on models or the controller. A role granted on the
+-- controller applies to every model too.
+CREATE TABLE permission_role_grant (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    role_uuid TEXT NOT NULL,
+    object_type_id INT NOT NULL,
+    grant_on TEXT NOT NULL, -- uuid of the model or controller
+    grant_to TEXT NOT NULL,
+    CONSTRAINT fk_permission_role_grant_role
+    FOREIGN KEY (role_uuid)
+    REFERENCES permission_role (uuid),
+    CONSTRAINT fk_permission_role_grant_object_type
+    FOREIGN KEY (object_type_id)
+    REFERENCES permission_object_type (id),
+    CONSTRAINT fk_permission_role_grant_user_uuid
+    FOREIGN KEY (grant_to)
+    REFERENCES user (uuid)
+);
+
+CREATE UNIQUE INDEX idx_permission_role_grant
+ON permission_role_grant (role_uuid, grant_on, grant_to);
+
+CREATE INDEX idx_permission_role_grant_to
+ON permission_role_grant (grant_to);
+
+CREATE VIEW v_permission_role_grant AS
+SELECT
+    g.uuid,
+    r.name AS role_name,
+    ot.type AS object_type,
+    g.grant_on,
+    u.name AS user_name,
+    u.removed AS user_removed
+FROM permission_role_grant AS g
+JOIN permission_role AS r ON g.role_uuid = r.uuid
+JOIN permission_object_type AS ot ON g.object_type_id = ot.id
+JOIN user AS u ON g.grant_to = u.uuid;
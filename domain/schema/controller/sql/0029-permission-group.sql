@@ -0,0 +1,32 @@
+-- The permission_group table holds the access granted to groups of users of
+-- an external identity provider, as named by the groups claim of the ID
+-- tokens users log in with. Users have the greatest of their own access and
+-- that of their groups.
+CREATE TABLE permission_group (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    group_name TEXT NOT NULL,
+    access_type_id INT NOT NULL,
+    object_type_id INT NOT NULL,
+    grant_on TEXT NOT NULL, -- name or uuid of the object
+    CONSTRAINT fk_permission_group_object_access
+    FOREIGN KEY (access_type_id, object_type_id)
+    REFERENCES permission_object_access (access_type_id, object_type_id)
+);
+
+-- Allow only 1 combination of group_name and grant_on.
+CREATE UNIQUE INDEX idx_permission_group_name_on
+ON permission_group (group_name, grant_on);
+
+CREATE INDEX idx_permission_group_grant_on
+ON permission_group (grant_on);
+
+CREATE VIEW v_permission_group AS
+SELECT
+    pg.uuid,
+    pg.group_name,
+    pg.grant_on,
+    at.type AS access_type,
+    ot.type AS object_type
+FROM permission_group AS pg
+JOIN permission_access_type AS at ON pg.access_type_id = at.id
+JOIN permission_object_type AS ot ON pg.object_type_id = ot.id;
@@ -0,0 +1,72 @@
+-- The service_account table marks the users which are service accounts:
+-- identities for automation, such as CI pipelines, rather than people.
+-- Service accounts have no password and authenticate with API tokens.
+CREATE TABLE service_account (
+    user_uuid TEXT NOT NULL PRIMARY KEY,
+    CONSTRAINT fk_service_account_user
+    FOREIGN KEY (user_uuid)
+    REFERENCES user (uuid)
+);
+
+-- Only the SHA-256 hash of a token is stored; the token itself is shown once,
+-- when it is issued. Tokens without an expiry never expire.
+CREATE TABLE service_account_token (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    service_account_uuid TEXT NOT NULL,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP,
+    CONSTRAINT fk_service_account_token_service_account
+    FOREIGN KEY (service_account_uuid)
+    REFERENCES service_account (user_uuid)
+);
+
+CREATE UNIQUE INDEX idx_service_account_token_name
+ON service_account_token (service_account_uuid, name);
+
+CREATE UNIQUE INDEX idx_service_account_token_hash
+ON service_account_token (token_hash);
+
+-- A token is scoped to models or the controller, with an access level on
+-- each. It never allows more than the service account has been granted.
+CREATE TABLE service_account_token_scope (
+    token_uuid TEXT NOT NULL,
+    access_type_id INT NOT NULL,
+    object_type_id INT NOT NULL,
+    grant_on TEXT NOT NULL, -- uuid of the model or controller
+    PRIMARY KEY (token_uuid, grant_on),
+    CONSTRAINT fk_service_account_token_scope_token
+    FOREIGN KEY (token_uuid)
+    REFERENCES service_account_token (uuid),
+    CONSTRAINT fk_service_account_token_scope_object_access
+    FOREIGN KEY (access_type_id, object_type_id)
+    REFERENCES permission_object_access (access_type_id, object_type_id)
+);
+
+CREATE INDEX idx_service_account_token_scope_grant_on
+ON service_account_token_scope (grant_on);
+
+CREATE VIEW v_service_account_token AS
+SELECT
+    t.uuid,
+    t.name,
+    t.token_hash,
+    t.created_at,
+    t.expires_at,
+    u.name AS service_account_name,
+    u.removed,
+    COALESCE(a.disabled, FALSE) AS disabled
+FROM service_account_token AS t
+JOIN user AS u ON t.service_account_uuid = u.uuid
+LEFT JOIN user_authentication AS a ON u.uuid = a.user_uuid;
+
+CREATE VIEW v_service_account_token_scope AS
+SELECT
+    s.token_uuid,
+    s.grant_on,
+    at.type AS access_type,
+    ot.type AS object_type
+FROM service_account_token_scope AS s
+JOIN permission_access_type AS at ON s.access_type_id = at.id
+JOIN permission_object_type AS ot ON s.object_type_id = ot.id;
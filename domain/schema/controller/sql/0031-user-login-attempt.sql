@@ -0,0 +1,15 @@
+-- The user_login_attempt table records the recent password logins of local
+-- users, both successful and failed, with the address they came from. The
+-- consecutive failed attempts of a user are used to lock them out.
+CREATE TABLE user_login_attempt (
+    user_uuid TEXT NOT NULL,
+    attempted_at TIMESTAMP NOT NULL,
+    success BOOLEAN NOT NULL,
+    address TEXT,
+    CONSTRAINT fk_user_login_attempt_user
+    FOREIGN KEY (user_uuid)
+    REFERENCES user (uuid)
+);
+
+CREATE INDEX idx_user_login_attempt_user
+ON user_login_attempt (user_uuid, attempted_at);
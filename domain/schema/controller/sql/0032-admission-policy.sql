@@ -0,0 +1,20 @@
+-- The admission_policy table holds the policies which are evaluated before
+-- model-changing operations, such as deploying or exposing an application.
+-- The rule describes the requests that the policy denies.
+-- Policies without a model apply to every model of the controller.
+-- Policies created by a controller superuser can only be removed by one.
+CREATE TABLE admission_policy (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    model_uuid TEXT,
+    rule TEXT NOT NULL,
+    message TEXT,
+    created_by TEXT,
+    created_by_superuser BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_admission_policy_model
+    FOREIGN KEY (model_uuid)
+    REFERENCES model (uuid)
+);
+
+CREATE UNIQUE INDEX idx_admission_policy_model_name
+ON admission_policy (COALESCE(model_uuid, ''), name);
@@ -129,6 +129,36 @@ func (s *controllerSchemaSuite) TestControllerTables(c *gc.C) {
 
 		// Agent binary metadata.
 		"agent_binary_store",
+
+		// SSH sessions.
+		"ssh_session",
+
+		// SSH access grants.
+		"ssh_access_grant_status",
+		"ssh_access_grant",
+
+		// SSH user certificate authority.
+		"ssh_user_ca",
+		"ssh_user_certificate",
+
+		// Permission roles.
+		"permission_role",
+		"permission_role_method",
+		"permission_role_grant",
+
+		// Permission groups.
+		"permission_group",
+
+		// Service accounts.
+		"service_account",
+		"service_account_token",
+		"service_account_token_scope",
+
+		// User login attempts.
+		"user_login_attempt",
+
+		// Admission policies.
+		"admission_policy",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
@@ -182,6 +212,22 @@ func (s *controllerSchemaSuite) TestControllerViews(c *gc.C) {
 
 		// Agent binary store
 		"v_agent_binary_store",
+
+		// SSH sessions
+		"v_ssh_session",
+
+		// SSH access grants
+		"v_ssh_access_grant",
+
+		// Permission roles
+		"v_permission_role_grant",
+
+		// Permission groups
+		"v_permission_group",
+
+		// Service accounts
+		"v_service_account_token",
+		"v_service_account_token_scope",
 	)
 	c.Assert(readEntityNames(c, s.DB(), "view"), jc.SameContents, expected.SortedValues())
 }
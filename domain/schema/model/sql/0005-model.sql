@@ -39,7 +39,9 @@ SELECT
     c.container_type,
     c.virt_type,
     c.allocate_public_ip,
-    c.image_id
+    c.image_id,
+    c.spot,
+    c.spot_max_price
 FROM model_constraint AS mc
 JOIN v_constraint AS c ON mc.constraint_uuid = c.uuid;
 
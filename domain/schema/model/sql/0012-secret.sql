@@ -60,6 +60,30 @@ CREATE TABLE secret_rotation (
     REFERENCES secret_metadata (secret_id)
 );
 
+-- secret_generator records the server side generator used to
+-- create new content when a user secret is due to be rotated.
+CREATE TABLE secret_generator (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    kind TEXT NOT NULL,
+    CONSTRAINT chk_empty_kind
+    CHECK (kind != ''),
+    CONSTRAINT fk_secret_generator_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
+
+CREATE TABLE secret_generator_param (
+    secret_id TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    value TEXT NOT NULL,
+    CONSTRAINT chk_empty_key
+    CHECK ("key" != ''),
+    CONSTRAINT fk_secret_generator_param_secret_generator_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_generator (secret_id),
+    PRIMARY KEY (secret_id, "key")
+);
+
 -- 1:1
 CREATE TABLE secret_value_ref (
     revision_uuid TEXT NOT NULL PRIMARY KEY,
@@ -18,6 +18,10 @@ CREATE TABLE "constraint" (
     -- limitations with NULL bools.
     allocate_public_ip INT,
     image_id TEXT,
+    -- spot is a bool value, stored as an int for the same reason as
+    -- allocate_public_ip.
+    spot INT,
+    spot_max_price REAL,
     CONSTRAINT fk_constraint_container_type
     FOREIGN KEY (container_type_id)
     REFERENCES container_type (id)
@@ -39,7 +43,9 @@ SELECT
     ct.value AS container_type,
     c.virt_type,
     c.allocate_public_ip,
-    c.image_id
+    c.image_id,
+    c.spot,
+    c.spot_max_price
 FROM "constraint" AS c
 LEFT JOIN container_type AS ct ON c.container_type_id = ct.id;
 
@@ -12,6 +12,20 @@ INSERT INTO charm_run_as_kind VALUES
 (2, 'sudoer'),
 (3, 'non-root');
 
+CREATE TABLE charm_deployment_type (
+    id INT PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_charm_deployment_type_name
+ON charm_deployment_type (name);
+
+INSERT INTO charm_deployment_type VALUES
+(0, 'default'),
+(1, 'stateful'),
+(2, 'stateless'),
+(3, 'daemon');
+
 -- The charm table exists as the nexus to all charm data. 
 --
 -- The fact that the charm is in the database indicates that it's a placeholder.
@@ -149,6 +163,9 @@ CREATE TABLE charm_metadata (
     subordinate BOOLEAN DEFAULT FALSE,
     min_juju_version TEXT,
     run_as_id INT DEFAULT 0,
+    -- deployment_type_id is the kind of workload resource used to run the
+    -- units of the charm on kubernetes.
+    deployment_type_id INT DEFAULT 0,
     -- Assumes is a blob of YAML that will be parsed by the charm to compute
     -- the result of the SAT expression.
     -- As the expression tree is generic, you can't use RI or index into the
@@ -157,6 +174,9 @@ CREATE TABLE charm_metadata (
     CONSTRAINT fk_charm_run_as_kind_charm
     FOREIGN KEY (run_as_id)
     REFERENCES charm_run_as_kind (id),
+    CONSTRAINT fk_charm_deployment_type_charm
+    FOREIGN KEY (deployment_type_id)
+    REFERENCES charm_deployment_type (id),
     CONSTRAINT fk_charm_metadata_charm
     FOREIGN KEY (charm_uuid)
     REFERENCES charm (uuid)
@@ -175,11 +195,13 @@ SELECT
     cm.subordinate,
     cm.min_juju_version,
     crak.name AS run_as,
+    cdt.name AS deployment_type,
     cm.assumes,
     c.available
 FROM charm AS c
 LEFT JOIN charm_metadata AS cm ON c.uuid = cm.charm_uuid
-LEFT JOIN charm_run_as_kind AS crak ON cm.run_as_id = crak.id;
+LEFT JOIN charm_run_as_kind AS crak ON cm.run_as_id = crak.id
+LEFT JOIN charm_deployment_type AS cdt ON cm.deployment_type_id = cdt.id;
 
 CREATE TABLE charm_source (
     id INT PRIMARY KEY,
@@ -232,3 +232,33 @@ CREATE TABLE machine_agent_presence (
     FOREIGN KEY (machine_uuid)
     REFERENCES machine (uuid)
 );
+
+-- machine_utilisation holds samples of the CPU and memory utilisation of
+-- machines, as percentages, reported by their agents. Samples older than
+-- the model's rightsizing window are removed as new ones are added.
+CREATE TABLE machine_utilisation (
+    machine_uuid TEXT NOT NULL,
+    sampled_at DATETIME NOT NULL,
+    cpu_percent REAL NOT NULL,
+    memory_percent REAL NOT NULL,
+    PRIMARY KEY (machine_uuid, sampled_at),
+    CONSTRAINT fk_machine_utilisation_machine
+    FOREIGN KEY (machine_uuid)
+    REFERENCES machine (uuid)
+);
+
+-- machine_warm_pool records the machines of the model's warm pool, which
+-- are started ahead of demand and given to units with matching constraints
+-- and base. A machine leaves the pool when it is given to a unit or is
+-- removed as surplus.
+CREATE TABLE machine_warm_pool (
+    machine_uuid TEXT NOT NULL PRIMARY KEY,
+    constraints TEXT NOT NULL,
+    base TEXT NOT NULL,
+    CONSTRAINT fk_machine_warm_pool_machine
+    FOREIGN KEY (machine_uuid)
+    REFERENCES machine (uuid)
+);
+
+CREATE INDEX idx_machine_warm_pool_constraints_base
+ON machine_warm_pool (constraints, base);
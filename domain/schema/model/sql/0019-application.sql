@@ -62,6 +62,28 @@ CREATE TABLE application_scale (
     REFERENCES application (uuid)
 );
 
+-- application_autoscale holds the policy used to scale a CAAS application
+-- based on the utilisation of its units. The target utilisations are
+-- percentages of the resources requested by the units, and the custom metric
+-- target is the average value of the metric per unit. Targets which are NULL
+-- are not considered when scaling.
+CREATE TABLE application_autoscale (
+    application_uuid TEXT NOT NULL PRIMARY KEY,
+    min_units INT NOT NULL,
+    max_units INT NOT NULL,
+    target_cpu_percent INT,
+    target_memory_percent INT,
+    custom_metric_name TEXT,
+    custom_metric_target REAL,
+    CONSTRAINT chk_application_autoscale_units
+    CHECK (min_units >= 0 AND max_units >= min_units AND max_units > 0),
+    CONSTRAINT chk_application_autoscale_custom_metric
+    CHECK ((custom_metric_name IS NULL) = (custom_metric_target IS NULL)),
+    CONSTRAINT fk_application_autoscale_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid)
+);
+
 CREATE TABLE application_exposed_endpoint_space (
     application_uuid TEXT NOT NULL,
     -- NULL application_endpoint_uuid represents the wildcard endpoint.
@@ -161,9 +183,15 @@ CREATE TABLE application_constraint (
 CREATE TABLE application_setting (
     application_uuid TEXT NOT NULL PRIMARY KEY,
     trust BOOLEAN DEFAULT FALSE,
+    -- deployment_type_id overrides the deployment type declared by the
+    -- charm. The default (0) defers to the charm metadata.
+    deployment_type_id INT NOT NULL DEFAULT 0,
     CONSTRAINT fk_application_setting_application
     FOREIGN KEY (application_uuid)
-    REFERENCES application (uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT fk_application_setting_deployment_type
+    FOREIGN KEY (deployment_type_id)
+    REFERENCES charm_deployment_type (id)
 );
 
 CREATE TABLE application_platform (
@@ -246,6 +274,8 @@ SELECT
     c.virt_type,
     c.allocate_public_ip,
     c.image_id,
+    c.spot,
+    c.spot_max_price,
     ctag.tag,
     cspace.space AS space_name,
     cspace."exclude" AS space_exclude,
@@ -241,6 +241,8 @@ SELECT
     c.virt_type,
     c.allocate_public_ip,
     c.image_id,
+    c.spot,
+    c.spot_max_price,
     ctag.tag,
     cspace.space AS space_name,
     cspace."exclude" AS space_exclude,
@@ -368,4 +368,25 @@ LEFT JOIN link_layer_device AS dd ON dp.parent_uuid = dd.uuid
 LEFT JOIN link_layer_device_dns_address AS dns ON d.uuid = dns.device_uuid
 LEFT JOIN ip_address AS a ON d.uuid = a.device_uuid
 LEFT JOIN subnet AS s ON a.subnet_uuid = s.uuid
-LEFT JOIN provider_subnet AS ps ON a.subnet_uuid = ps.subnet_uuid
+LEFT JOIN provider_subnet AS ps ON a.subnet_uuid = ps.subnet_uuid;
+
+-- cidr_set is a named, reusable set of CIDRs that can be referenced by
+-- application firewall rules. This allows allow-lists to be managed
+-- centrally, rather than repeated on every rule.
+CREATE TABLE cidr_set (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_cidr_set_name
+ON cidr_set (name);
+
+-- There is no FK against the CIDR, because it's currently free-form.
+CREATE TABLE cidr_set_cidr (
+    cidr_set_uuid TEXT NOT NULL,
+    cidr TEXT NOT NULL,
+    CONSTRAINT fk_cidr_set_cidr_cidr_set
+    FOREIGN KEY (cidr_set_uuid)
+    REFERENCES cidr_set (uuid),
+    PRIMARY KEY (cidr_set_uuid, cidr)
+);
@@ -56,3 +56,79 @@ SELECT
 FROM unit AS u
 LEFT JOIN application AS a ON u.application_uuid = a.uuid
 LEFT JOIN charm_relation AS cr ON a.charm_uuid = cr.charm_uuid;
+
+-- application_firewall_rule allows ingress to an application's endpoint
+-- from a set of sources, independently of whether the application is
+-- exposed.
+CREATE TABLE application_firewall_rule (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    application_uuid TEXT NOT NULL,
+    -- NULL application_endpoint_uuid represents the wildcard endpoint.
+    application_endpoint_uuid TEXT,
+    -- NULL protocol_id represents the port ranges opened by the units
+    -- of the application on the endpoint.
+    protocol_id INT,
+    from_port INT,
+    to_port INT,
+    CONSTRAINT fk_application_firewall_rule_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT fk_application_firewall_rule_application_endpoint
+    FOREIGN KEY (application_endpoint_uuid)
+    REFERENCES application_endpoint (uuid),
+    CONSTRAINT fk_application_firewall_rule_protocol
+    FOREIGN KEY (protocol_id)
+    REFERENCES protocol (id)
+);
+
+CREATE INDEX idx_application_firewall_rule_application
+ON application_firewall_rule (application_uuid);
+
+-- There is no FK against the CIDR, because it's currently free-form.
+CREATE TABLE application_firewall_rule_cidr (
+    application_firewall_rule_uuid TEXT NOT NULL,
+    cidr TEXT NOT NULL,
+    CONSTRAINT fk_application_firewall_rule_cidr_rule
+    FOREIGN KEY (application_firewall_rule_uuid)
+    REFERENCES application_firewall_rule (uuid),
+    PRIMARY KEY (application_firewall_rule_uuid, cidr)
+);
+
+CREATE TABLE application_firewall_rule_cidr_set (
+    application_firewall_rule_uuid TEXT NOT NULL,
+    cidr_set_uuid TEXT NOT NULL,
+    CONSTRAINT fk_application_firewall_rule_cidr_set_rule
+    FOREIGN KEY (application_firewall_rule_uuid)
+    REFERENCES application_firewall_rule (uuid),
+    CONSTRAINT fk_application_firewall_rule_cidr_set_cidr_set
+    FOREIGN KEY (cidr_set_uuid)
+    REFERENCES cidr_set (uuid),
+    PRIMARY KEY (application_firewall_rule_uuid, cidr_set_uuid)
+);
+
+-- v_application_firewall_rule_source flattens the sources of firewall
+-- rules into CIDRs. cidr_set_name is set for CIDRs that are members of a
+-- CIDR set referenced by the rule.
+CREATE VIEW v_application_firewall_rule_source (
+    application_uuid,
+    rule_uuid,
+    cidr,
+    cidr_set_name
+) AS
+SELECT
+    r.application_uuid,
+    rc.application_firewall_rule_uuid,
+    rc.cidr,
+    NULL AS n
+FROM application_firewall_rule_cidr AS rc
+JOIN application_firewall_rule AS r ON rc.application_firewall_rule_uuid = r.uuid
+UNION ALL
+SELECT
+    r.application_uuid,
+    rcs.application_firewall_rule_uuid,
+    csc.cidr,
+    cs.name
+FROM application_firewall_rule_cidr_set AS rcs
+JOIN application_firewall_rule AS r ON rcs.application_firewall_rule_uuid = r.uuid
+JOIN cidr_set AS cs ON rcs.cidr_set_uuid = cs.uuid
+LEFT JOIN cidr_set_cidr AS csc ON cs.uuid = csc.cidr_set_uuid;
@@ -160,6 +160,46 @@ END;`, columnName, namespaceID))
 	}
 }
 
+// ChangeLogTriggersForApplicationFirewallRule generates the triggers for the
+// application_firewall_rule table.
+func ChangeLogTriggersForApplicationFirewallRule(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for ApplicationFirewallRule
+INSERT INTO change_log_namespace VALUES (%[2]d, 'application_firewall_rule', 'ApplicationFirewallRule changes based on %[1]s');
+
+-- insert trigger for ApplicationFirewallRule
+CREATE TRIGGER trg_log_application_firewall_rule_insert
+AFTER INSERT ON application_firewall_rule FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for ApplicationFirewallRule
+CREATE TRIGGER trg_log_application_firewall_rule_update
+AFTER UPDATE ON application_firewall_rule FOR EACH ROW
+WHEN 
+	NEW.uuid != OLD.uuid OR
+	NEW.application_uuid != OLD.application_uuid OR
+	(NEW.application_endpoint_uuid != OLD.application_endpoint_uuid OR (NEW.application_endpoint_uuid IS NOT NULL AND OLD.application_endpoint_uuid IS NULL) OR (NEW.application_endpoint_uuid IS NULL AND OLD.application_endpoint_uuid IS NOT NULL)) OR
+	(NEW.protocol_id != OLD.protocol_id OR (NEW.protocol_id IS NOT NULL AND OLD.protocol_id IS NULL) OR (NEW.protocol_id IS NULL AND OLD.protocol_id IS NOT NULL)) OR
+	(NEW.from_port != OLD.from_port OR (NEW.from_port IS NOT NULL AND OLD.from_port IS NULL) OR (NEW.from_port IS NULL AND OLD.from_port IS NOT NULL)) OR
+	(NEW.to_port != OLD.to_port OR (NEW.to_port IS NOT NULL AND OLD.to_port IS NULL) OR (NEW.to_port IS NULL AND OLD.to_port IS NOT NULL)) 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for ApplicationFirewallRule
+CREATE TRIGGER trg_log_application_firewall_rule_delete
+AFTER DELETE ON application_firewall_rule FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
 // ChangeLogTriggersForApplicationScale generates the triggers for the
 // application_scale table.
 func ChangeLogTriggersForApplicationScale(columnName string, namespaceID int) func() schema.Patch {
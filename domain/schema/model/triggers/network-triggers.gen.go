@@ -9,6 +9,42 @@ import (
 )
 
 
+// ChangeLogTriggersForCidrSetCidr generates the triggers for the
+// cidr_set_cidr table.
+func ChangeLogTriggersForCidrSetCidr(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for CidrSetCidr
+INSERT INTO change_log_namespace VALUES (%[2]d, 'cidr_set_cidr', 'CidrSetCidr changes based on %[1]s');
+
+-- insert trigger for CidrSetCidr
+CREATE TRIGGER trg_log_cidr_set_cidr_insert
+AFTER INSERT ON cidr_set_cidr FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for CidrSetCidr
+CREATE TRIGGER trg_log_cidr_set_cidr_update
+AFTER UPDATE ON cidr_set_cidr FOR EACH ROW
+WHEN 
+	NEW.cidr_set_uuid != OLD.cidr_set_uuid OR
+	NEW.cidr != OLD.cidr 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for CidrSetCidr
+CREATE TRIGGER trg_log_cidr_set_cidr_delete
+AFTER DELETE ON cidr_set_cidr FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
 // ChangeLogTriggersForIpAddress generates the triggers for the
 // ip_address table.
 func ChangeLogTriggersForIpAddress(columnName string, namespaceID int) func() schema.Patch {
@@ -26,12 +26,16 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 	expected := set.NewStrings(
 		// Application
 		"application",
+		"application_autoscale",
 		"application_channel",
 		"application_config_hash",
 		"application_config",
 		"application_constraint",
 		"application_exposed_endpoint_cidr",
 		"application_exposed_endpoint_space",
+		"application_firewall_rule",
+		"application_firewall_rule_cidr",
+		"application_firewall_rule_cidr_set",
 		"application_platform",
 		"application_scale",
 		"application_setting",
@@ -154,7 +158,9 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"machine_requires_reboot",
 		"machine_status_value",
 		"machine_status",
+		"machine_utilisation",
 		"machine_volume",
+		"machine_warm_pool",
 
 		// Charm
 		"architecture",
@@ -164,6 +170,7 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"charm_config",
 		"charm_container_mount",
 		"charm_container",
+		"charm_deployment_type",
 		"charm_device",
 		"charm_download_info",
 		"charm_extra_binding",
@@ -213,6 +220,10 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"provider_subnet",
 		"subnet",
 
+		// CIDR sets
+		"cidr_set",
+		"cidr_set_cidr",
+
 		// Block device
 		"block_device_link_device",
 		"block_device",
@@ -243,6 +254,8 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"secret_reference",
 		"secret_metadata",
 		"secret_rotation",
+		"secret_generator",
+		"secret_generator_param",
 		"secret_value_ref",
 		"secret_deleted_value_ref",
 		"secret_content",
@@ -310,6 +323,7 @@ func (s *modelSchemaSuite) TestModelViews(c *gc.C) {
 		"v_application_endpoint_uuid",
 		"v_application_export",
 		"v_application_exposed_endpoint",
+		"v_application_firewall_rule_source",
 		"v_application_origin",
 		"v_application_platform_channel",
 		"v_application_resource",
@@ -399,6 +413,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *gc.C) {
 		"trg_log_application_exposed_endpoint_space_insert",
 		"trg_log_application_exposed_endpoint_space_update",
 
+		"trg_log_application_firewall_rule_delete",
+		"trg_log_application_firewall_rule_insert",
+		"trg_log_application_firewall_rule_update",
+
 		"trg_log_application_scale_delete",
 		"trg_log_application_scale_insert",
 		"trg_log_application_scale_update",
@@ -411,6 +429,10 @@ func (s *modelSchemaSuite) TestModelTriggers(c *gc.C) {
 		"trg_log_charm_insert",
 		"trg_log_charm_update",
 
+		"trg_log_cidr_set_cidr_delete",
+		"trg_log_cidr_set_cidr_insert",
+		"trg_log_cidr_set_cidr_update",
+
 		"trg_log_ip_address_delete",
 		"trg_log_ip_address_insert",
 		"trg_log_ip_address_update",
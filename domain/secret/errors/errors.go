@@ -41,4 +41,8 @@ const (
 
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
+
+	// SecretGeneratorNotFound describes an error that occurs when a user secret being
+	// rotated has no generator configured.
+	SecretGeneratorNotFound = errors.ConstError("secret generator not found")
 )
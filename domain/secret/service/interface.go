@@ -88,6 +88,7 @@ type State interface {
 	SecretRotated(ctx context.Context, uri *secrets.URI, next time.Time) error
 	GetRotatePolicy(ctx context.Context, uri *secrets.URI) (secrets.RotatePolicy, error)
 	GetRotationExpiryInfo(ctx context.Context, uri *secrets.URI) (*domainsecret.RotationExpiryInfo, error)
+	GetSecretGenerator(ctx context.Context, uri *secrets.URI) (*secrets.GeneratorConfig, error)
 	GetSecretRevisionID(ctx context.Context, uri *secrets.URI, revision int) (string, error)
 	ChangeSecretBackend(
 		ctx context.Context, revisionID uuid.UUID, valueRef *secrets.ValueRef, data secrets.SecretData,
@@ -124,6 +125,10 @@ type State interface {
 		ctx context.Context, appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners, secretIDs ...string,
 	) ([]domainsecret.RotationInfo, error)
 
+	// For watching user secret rotation changes.
+	InitialWatchStatementForUserSecretsRotationChanges() (string, eventsource.NamespaceQuery)
+	GetUserSecretsRotationChanges(ctx context.Context, secretIDs ...string) ([]domainsecret.RotationInfo, error)
+
 	// For watching secret revision expiry changes.
 	InitialWatchStatementForSecretsRevisionExpiryChanges(
 		appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners,
@@ -1120,6 +1120,45 @@ func (c *MockStateGetSecretConsumerCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
+// GetSecretGenerator mocks base method.
+func (m *MockState) GetSecretGenerator(arg0 context.Context, arg1 *secrets.URI) (*secrets.GeneratorConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretGenerator", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.GeneratorConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretGenerator indicates an expected call of GetSecretGenerator.
+func (mr *MockStateMockRecorder) GetSecretGenerator(arg0, arg1 any) *MockStateGetSecretGeneratorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretGenerator", reflect.TypeOf((*MockState)(nil).GetSecretGenerator), arg0, arg1)
+	return &MockStateGetSecretGeneratorCall{Call: call}
+}
+
+// MockStateGetSecretGeneratorCall wrap *gomock.Call
+type MockStateGetSecretGeneratorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretGeneratorCall) Return(arg0 *secrets.GeneratorConfig, arg1 error) *MockStateGetSecretGeneratorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretGeneratorCall) Do(f func(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error)) *MockStateGetSecretGeneratorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretGeneratorCall) DoAndReturn(f func(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error)) *MockStateGetSecretGeneratorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretGrants mocks base method.
 func (m *MockState) GetSecretGrants(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretRole) ([]secret.GrantParams, error) {
 	m.ctrl.T.Helper()
@@ -1561,6 +1600,50 @@ func (c *MockStateGetUserSecretURIByLabelCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetUserSecretsRotationChanges mocks base method.
+func (m *MockState) GetUserSecretsRotationChanges(arg0 context.Context, arg1 ...string) ([]secret.RotationInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserSecretsRotationChanges", varargs...)
+	ret0, _ := ret[0].([]secret.RotationInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSecretsRotationChanges indicates an expected call of GetUserSecretsRotationChanges.
+func (mr *MockStateMockRecorder) GetUserSecretsRotationChanges(arg0 any, arg1 ...any) *MockStateGetUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSecretsRotationChanges", reflect.TypeOf((*MockState)(nil).GetUserSecretsRotationChanges), varargs...)
+	return &MockStateGetUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockStateGetUserSecretsRotationChangesCall wrap *gomock.Call
+type MockStateGetUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUserSecretsRotationChangesCall) Return(arg0 []secret.RotationInfo, arg1 error) *MockStateGetUserSecretsRotationChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUserSecretsRotationChangesCall) Do(f func(context.Context, ...string) ([]secret.RotationInfo, error)) *MockStateGetUserSecretsRotationChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUserSecretsRotationChangesCall) DoAndReturn(f func(context.Context, ...string) ([]secret.RotationInfo, error)) *MockStateGetUserSecretsRotationChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GrantAccess mocks base method.
 func (m *MockState) GrantAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.GrantParams) error {
 	m.ctrl.T.Helper()
@@ -1872,6 +1955,45 @@ func (c *MockStateInitialWatchStatementForSecretsRotationChangesCall) DoAndRetur
 	return c
 }
 
+// InitialWatchStatementForUserSecretsRotationChanges mocks base method.
+func (m *MockState) InitialWatchStatementForUserSecretsRotationChanges() (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementForUserSecretsRotationChanges")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementForUserSecretsRotationChanges indicates an expected call of InitialWatchStatementForUserSecretsRotationChanges.
+func (mr *MockStateMockRecorder) InitialWatchStatementForUserSecretsRotationChanges() *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementForUserSecretsRotationChanges", reflect.TypeOf((*MockState)(nil).InitialWatchStatementForUserSecretsRotationChanges))
+	return &MockStateInitialWatchStatementForUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockStateInitialWatchStatementForUserSecretsRotationChangesCall wrap *gomock.Call
+type MockStateInitialWatchStatementForUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementForUserSecretsRotationChangesCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementForUserSecretsRotationChangesCall) Do(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementForUserSecretsRotationChangesCall) DoAndReturn(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementForUserSecretsRotationChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsSecretOwnedBy mocks base method.
 func (m *MockState) IsSecretOwnedBy(arg0 context.Context, arg1 *secrets.URI, arg2 secret.ApplicationOwners, arg3 secret.UnitOwners) (bool, error) {
 	m.ctrl.T.Helper()
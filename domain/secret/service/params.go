@@ -39,15 +39,27 @@ type CreateUserSecretParams struct {
 }
 
 // UpdateUserSecretParams are used to update a user secret.
+// A user secret with a rotate policy must have a generator
+// specified in Params which is used to create new content
+// when the secret is rotated. An empty generator in Params
+// removes the generator from a secret which no longer rotates.
 type UpdateUserSecretParams struct {
 	Accessor SecretAccessor
 
-	Description *string
-	Label       *string
-	Params      map[string]interface{}
-	Data        secrets.SecretData
-	Checksum    string
-	AutoPrune   *bool
+	RotatePolicy *secrets.RotatePolicy
+	Description  *string
+	Label        *string
+	Params       map[string]interface{}
+	Data         secrets.SecretData
+	Checksum     string
+	AutoPrune    *bool
+}
+
+// RotateUserSecretParams are used to rotate a user secret.
+type RotateUserSecretParams struct {
+	Accessor SecretAccessor
+
+	Data secrets.SecretData
 }
 
 // DeleteSecretParams are used to delete a secret.
@@ -20,6 +20,7 @@ import (
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/internal/errors"
+	secretgenerator "github.com/juju/juju/internal/secrets/generator"
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/uuid"
@@ -207,6 +208,9 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 		AutoPrune:   params.AutoPrune,
 		Checksum:    params.Checksum,
 	}
+	if err := s.setUserSecretRotation(ctx, uri, false, params.RotatePolicy, params.Params, &p); err != nil {
+		return errors.Capture(err)
+	}
 	// Take a copy as we may set it to nil below
 	// if the content is saved to a backend.
 	p.Data = make(map[string]string)
@@ -368,6 +372,9 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 		AutoPrune:   params.AutoPrune,
 		Checksum:    params.Checksum,
 	}
+	if err := s.setUserSecretRotation(ctx, uri, true, params.RotatePolicy, params.Params, &p); err != nil {
+		return errors.Capture(err)
+	}
 
 	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
 		// Take a copy as we may set it to nil below
@@ -449,6 +456,121 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 	})
 }
 
+// setUserSecretRotation fills in the rotation and generator details used to
+// upsert a user secret. Unlike charm secrets, there's no hook to create new
+// content for a user secret, so a user secret can only rotate if it has a
+// generator, either supplied in params or already recorded for the secret.
+func (s *SecretService) setUserSecretRotation(
+	ctx context.Context, uri *secrets.URI, exists bool,
+	policy *secrets.RotatePolicy, params map[string]interface{}, p *domainsecret.UpsertSecretParams,
+) error {
+	generator, err := secrets.GeneratorConfigFromParams(params)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if generator != nil {
+		if err := secretgenerator.Validate(*generator); err != nil {
+			return errors.Capture(err)
+		}
+	}
+	p.Generator = generator
+	if exists && secrets.RemovesGenerator(params) {
+		if err := s.checkCanRemoveGenerator(ctx, uri, policy); err != nil {
+			return errors.Capture(err)
+		}
+		p.RemoveGenerator = true
+	}
+	if policy == nil {
+		return nil
+	}
+	if !policy.IsValid() {
+		return errors.Errorf("secret rotate policy %q %w", *policy, coreerrors.NotValid)
+	}
+	if policy.WillRotate() && generator == nil {
+		noGeneratorErr := errors.Errorf(
+			"rotating user secret %q without a generator %w", uri.ID, coreerrors.NotValid)
+		if !exists {
+			return noGeneratorErr
+		}
+		_, err := s.secretState.GetSecretGenerator(ctx, uri)
+		if errors.Is(err, secreterrors.SecretGeneratorNotFound) {
+			return noGeneratorErr
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+	}
+	p.RotatePolicy = ptr(domainsecret.MarshallRotatePolicy(policy))
+	if policy.WillRotate() {
+		p.NextRotateTime = policy.NextRotateTime(s.clock.Now())
+	}
+	return nil
+}
+
+// checkCanRemoveGenerator returns an error satisfying [coreerrors.NotValid]
+// if the generator of a user secret is removed while the secret is still
+// rotated, either by the rotate policy being set or the existing policy.
+func (s *SecretService) checkCanRemoveGenerator(ctx context.Context, uri *secrets.URI, policy *secrets.RotatePolicy) error {
+	if policy == nil {
+		existing, err := s.secretState.GetRotatePolicy(ctx, uri)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		policy = &existing
+	}
+	if policy.WillRotate() {
+		return errors.Errorf(
+			"removing the generator of rotating user secret %q %w", uri.ID, coreerrors.NotValid)
+	}
+	return nil
+}
+
+// GetUserSecretGenerator returns the generator used to create new content
+// when the specified user secret is rotated. It returns an error satisfying
+// [secreterrors.SecretGeneratorNotFound] if the secret has no generator, or
+// [secreterrors.SecretNotFound] if the secret does not exist.
+func (s *SecretService) GetUserSecretGenerator(ctx context.Context, uri *secrets.URI) (*secrets.GeneratorConfig, error) {
+	cfg, err := s.secretState.GetSecretGenerator(ctx, uri)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return cfg, nil
+}
+
+// RotateUserSecret saves the newly generated content as a new revision of the
+// specified user secret and schedules the next rotation according to the
+// secret's rotate policy. Consumers are notified of the new revision just
+// like any other update to the secret.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+func (s *SecretService) RotateUserSecret(ctx context.Context, uri *secrets.URI, params RotateUserSecretParams) error {
+	if len(params.Data) == 0 {
+		return errors.Errorf("empty secret value %w", coreerrors.NotValid)
+	}
+	checksum, err := secrets.NewSecretValue(params.Data).Checksum()
+	if err != nil {
+		return errors.Errorf("calculating secret checksum: %w", err)
+	}
+	err = s.UpdateUserSecret(ctx, uri, UpdateUserSecretParams{
+		Accessor: params.Accessor,
+		Data:     params.Data,
+		Checksum: checksum,
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	policy, err := s.secretState.GetRotatePolicy(ctx, uri)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if !policy.WillRotate() {
+		s.logger.Debugf(ctx, "user secret %q was rotated but now is set to not rotate", uri.ID)
+		return nil
+	}
+	next := policy.NextRotateTime(s.clock.Now())
+	s.logger.Debugf(ctx, "user secret %q next rotate time is now: %s", uri.ID, next.UTC().Format(time.RFC3339))
+	return s.secretState.SecretRotated(ctx, uri, *next)
+}
+
 // UpdateCharmSecret updates a charm secret with the specified parameters, returning an error
 // satisfying [secreterrors.SecretNotFound] if the secret does not exist.
 // It also returns an error satisfying [secreterrors.SecretLabelAlreadyExists] if
@@ -1712,6 +1712,182 @@ func (s *serviceSuite) TestSecretsRotatedThenNever(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestCreateUserSecretRotateWithoutGenerator(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	err := s.service.CreateUserSecret(context.Background(), uri, CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor: SecretAccessor{
+				Kind: ModelAccessor,
+				ID:   s.modelID.String(),
+			},
+			RotatePolicy: ptr(coresecrets.RotateDaily),
+			Data:         map[string]string{"foo": "bar"},
+		},
+		Version: 1,
+	})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `rotating user secret .* without a generator not valid`)
+}
+
+func (s *serviceSuite) TestCreateUserSecretInvalidGenerator(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	err := s.service.CreateUserSecret(context.Background(), uri, CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor: SecretAccessor{
+				Kind: ModelAccessor,
+				ID:   s.modelID.String(),
+			},
+			RotatePolicy: ptr(coresecrets.RotateDaily),
+			Params:       map[string]interface{}{"generator": "foo"},
+			Data:         map[string]string{"foo": "bar"},
+		},
+		Version: 1,
+	})
+	c.Assert(err, gc.ErrorMatches, `secret generator "foo" not valid`)
+}
+
+func (s *serviceSuite) TestCreateUserSecretUnknownGeneratorParam(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	err := s.service.CreateUserSecret(context.Background(), uri, CreateUserSecretParams{
+		UpdateUserSecretParams: UpdateUserSecretParams{
+			Accessor: SecretAccessor{
+				Kind: ModelAccessor,
+				ID:   s.modelID.String(),
+			},
+			RotatePolicy: ptr(coresecrets.RotateDaily),
+			Params:       map[string]interface{}{"generator": "password", "lenght": 32},
+			Data:         map[string]string{"foo": "bar"},
+		},
+		Version: 1,
+	})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `secret generator "password" params \["lenght"\] .* not valid`)
+}
+
+func (s *serviceSuite) TestUpdateUserSecretRemoveGenerator(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, domainsecret.UpsertSecretParams{
+		RotatePolicy:    ptr(domainsecret.RotateNever),
+		RemoveGenerator: true,
+	}).Return(nil)
+
+	err := s.service.UpdateUserSecret(context.Background(), uri, UpdateUserSecretParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   s.modelID.String(),
+		},
+		RotatePolicy: ptr(coresecrets.RotateNever),
+		Params:       map[string]interface{}{"generator": ""},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestUpdateUserSecretRemoveGeneratorStillRotating(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetRotatePolicy(gomock.Any(), uri).Return(coresecrets.RotateDaily, nil)
+
+	err := s.service.UpdateUserSecret(context.Background(), uri, UpdateUserSecretParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   s.modelID.String(),
+		},
+		Params: map[string]interface{}{"generator": ""},
+	})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+	c.Assert(err, gc.ErrorMatches, `removing the generator of rotating user secret .* not valid`)
+}
+
+func (s *serviceSuite) TestUpdateUserSecretRotateWithoutGenerator(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretGenerator(gomock.Any(), uri).Return(nil, secreterrors.SecretGeneratorNotFound)
+
+	err := s.service.UpdateUserSecret(context.Background(), uri, UpdateUserSecretParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   s.modelID.String(),
+		},
+		RotatePolicy: ptr(coresecrets.RotateDaily),
+	})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestUpdateUserSecretRotateWithExistingGenerator(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelID.String(),
+	}).Return("manage", nil)
+	s.state.EXPECT().GetSecretGenerator(gomock.Any(), uri).Return(&coresecrets.GeneratorConfig{
+		Kind: coresecrets.GeneratorPassword,
+	}, nil)
+	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, domainsecret.UpsertSecretParams{
+		RotatePolicy:   ptr(domainsecret.RotateDaily),
+		NextRotateTime: ptr(s.clock.Now().AddDate(0, 0, 1)),
+	}).Return(nil)
+
+	err := s.service.UpdateUserSecret(context.Background(), uri, UpdateUserSecretParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   s.modelID.String(),
+		},
+		RotatePolicy: ptr(coresecrets.RotateDaily),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestGetUserSecretGenerator(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	cfg := &coresecrets.GeneratorConfig{
+		Kind:   coresecrets.GeneratorPassword,
+		Params: map[string]string{"length": "32"},
+	}
+	s.state.EXPECT().GetSecretGenerator(gomock.Any(), uri).Return(cfg, nil)
+
+	result, err := s.service.GetUserSecretGenerator(context.Background(), uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, cfg)
+}
+
+func (s *serviceSuite) TestRotateUserSecretEmptyData(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.RotateUserSecret(context.Background(), coresecrets.NewURI(), RotateUserSecretParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   s.modelID.String(),
+		},
+	})
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *serviceSuite) TestGetConsumedRevisionFirstTime(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -441,6 +441,12 @@ VALUES ($secretID.id)`
 		}
 	}
 
+	if secret.Generator != nil {
+		if err := st.upsertSecretGenerator(ctx, tx, uri, *secret.Generator); err != nil {
+			return errors.Errorf("inserting generator for secret %q: %w", uri, err)
+		}
+	}
+
 	if len(secret.Data) > 0 {
 		if err := st.updateSecretContent(ctx, tx, dbRevision.ID, secret.Data); err != nil {
 			return errors.Errorf("updating content for secret %q: %w", uri, err)
@@ -606,6 +612,15 @@ GROUP BY sm.secret_id`
 			return errors.Errorf("updating next rotate time for secret %q: %w", uri, err)
 		}
 	}
+	if secret.Generator != nil {
+		if err := st.upsertSecretGenerator(ctx, tx, uri, *secret.Generator); err != nil {
+			return errors.Errorf("updating generator for secret %q: %w", uri, err)
+		}
+	} else if secret.RemoveGenerator {
+		if err := st.deleteSecretGenerator(ctx, tx, uri); err != nil {
+			return errors.Errorf("removing generator for secret %q: %w", uri, err)
+		}
+	}
 
 	var dbRevision *secretRevision
 	shouldCreateNewRevision := (len(secret.Data) > 0 || secret.ValueRef != nil) && (secret.Checksum != existing.LatestRevisionChecksum ||
@@ -888,6 +903,73 @@ ON CONFLICT(secret_id) DO UPDATE SET
 	return nil
 }
 
+func (st State) deleteSecretGenerator(ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI) error {
+	deleteParamsQuery := `
+DELETE FROM secret_generator_param WHERE secret_id = $secretID.id`
+	deleteQuery := `
+DELETE FROM secret_generator WHERE secret_id = $secretID.id`
+
+	for _, query := range []string{deleteParamsQuery, deleteQuery} {
+		stmt, err := st.Prepare(query, secretID{})
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, secretID{ID: uri.ID}).Run(); err != nil {
+			return errors.Capture(err)
+		}
+	}
+	return nil
+}
+
+func (st State) upsertSecretGenerator(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI, cfg coresecrets.GeneratorConfig,
+) error {
+	insertQuery := `
+INSERT INTO secret_generator (*)
+VALUES ($secretGenerator.*)
+ON CONFLICT(secret_id) DO UPDATE SET
+    kind=excluded.kind`
+
+	deleteParamsQuery := `
+DELETE FROM secret_generator_param WHERE secret_id = $secretID.id`
+
+	insertParamQuery := `
+INSERT INTO secret_generator_param (*)
+VALUES ($secretGeneratorParam.*)`
+
+	insertStmt, err := st.Prepare(insertQuery, secretGenerator{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	deleteParamsStmt, err := st.Prepare(deleteParamsQuery, secretID{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertParamStmt, err := st.Prepare(insertParamQuery, secretGeneratorParam{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	generator := secretGenerator{SecretID: uri.ID, Kind: string(cfg.Kind)}
+	if err := tx.Query(ctx, insertStmt, generator).Run(); err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, deleteParamsStmt, secretID{ID: uri.ID}).Run(); err != nil {
+		return errors.Capture(err)
+	}
+	if len(cfg.Params) == 0 {
+		return nil
+	}
+	params := make([]secretGeneratorParam, 0, len(cfg.Params))
+	for k, v := range cfg.Params {
+		params = append(params, secretGeneratorParam{SecretID: uri.ID, Key: k, Value: v})
+	}
+	if err := tx.Query(ctx, insertParamStmt, params).Run(); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
 func (st State) upsertSecretRevision(
 	ctx context.Context, tx *sqlair.TX, dbRevision *secretRevision,
 ) error {
@@ -3604,6 +3686,10 @@ DELETE FROM secret_revision WHERE uuid IN ($revisionUUIDs[:])`
 func (st State) deleteSecret(ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI) error {
 	deleteSecretRotation := `
 DELETE FROM secret_rotation WHERE secret_id = $secretID.id`
+	deleteSecretGeneratorParam := `
+DELETE FROM secret_generator_param WHERE secret_id = $secretID.id`
+	deleteSecretGenerator := `
+DELETE FROM secret_generator WHERE secret_id = $secretID.id`
 	deleteSecretUnitOwner := `
 DELETE FROM secret_unit_owner WHERE secret_id = $secretID.id`
 	deleteSecretApplicationOwner := `
@@ -3625,6 +3711,8 @@ DELETE FROM secret WHERE id = $secretID.id`
 
 	deleteSecretQueries := []string{
 		deleteSecretRotation,
+		deleteSecretGeneratorParam,
+		deleteSecretGenerator,
 		deleteSecretUnitOwner,
 		deleteSecretApplicationOwner,
 		deleteSecretModelOwner,
@@ -3768,6 +3856,79 @@ GROUP BY sro.secret_id`
 	return result, nil
 }
 
+// GetSecretGenerator returns the generator used to create new content when
+// the specified user secret is rotated, returning an error satisfying
+// [secreterrors.SecretGeneratorNotFound] if there's no generator, or
+// [secreterrors.SecretNotFound] if there's no such secret.
+func (st State) GetSecretGenerator(ctx context.Context, uri *coresecrets.URI) (*coresecrets.GeneratorConfig, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	generatorQuery := `
+SELECT &secretGenerator.*
+FROM   secret_generator
+WHERE  secret_id = $secretID.id`
+	paramsQuery := `
+SELECT &secretGeneratorParam.*
+FROM   secret_generator_param
+WHERE  secret_id = $secretID.id`
+	secretQuery := `
+SELECT secret_id AS &secretID.id
+FROM   secret_metadata
+WHERE  secret_id = $secretID.id`
+
+	generatorStmt, err := st.Prepare(generatorQuery, secretID{}, secretGenerator{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	secretStmt, err := st.Prepare(secretQuery, secretID{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	paramsStmt, err := st.Prepare(paramsQuery, secretID{}, secretGeneratorParam{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var (
+		generator secretGenerator
+		params    []secretGeneratorParam
+	)
+	input := secretID{ID: uri.ID}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, generatorStmt, input).Get(&generator)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			err = tx.Query(ctx, secretStmt, input).Get(&secretID{})
+			if errors.Is(err, sqlair.ErrNoRows) {
+				return errors.Errorf("secret %q not found", uri).Add(secreterrors.SecretNotFound)
+			} else if err != nil {
+				return errors.Capture(err)
+			}
+			return errors.Errorf("generator for secret %q not found", uri).Add(secreterrors.SecretGeneratorNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		err = tx.Query(ctx, paramsStmt, input).GetAll(&params)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	result := &coresecrets.GeneratorConfig{
+		Kind:   coresecrets.GeneratorKind(generator.Kind),
+		Params: make(map[string]string, len(params)),
+	}
+	for _, p := range params {
+		result.Params[p.Key] = p.Value
+	}
+	return result, nil
+}
+
 // ChangeSecretBackend changes the secret backend for the specified secret.
 func (st State) ChangeSecretBackend(
 	ctx context.Context, revisionID uuid.UUID,
@@ -3846,6 +4007,85 @@ func (st State) InitialWatchStatementForSecretsRotationChanges(
 	return "secret_rotation", queryFunc
 }
 
+// InitialWatchStatementForUserSecretsRotationChanges returns the initial watch
+// statement and the table name for watching user secret rotations.
+func (st State) InitialWatchStatementForUserSecretsRotationChanges() (string, eventsource.NamespaceQuery) {
+	queryFunc := func(ctx context.Context, runner coredatabase.TxnRunner) ([]string, error) {
+		result, err := st.getUserSecretsRotationChanges(ctx, runner)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		secretIDs := make([]string, len(result))
+		for i, d := range result {
+			secretIDs[i] = d.URI.ID
+		}
+		return secretIDs, nil
+	}
+	return "secret_rotation", queryFunc
+}
+
+// GetUserSecretsRotationChanges returns the rotation changes for user secrets.
+// If secret IDs are specified, only those secrets are considered.
+func (st State) GetUserSecretsRotationChanges(ctx context.Context, secretIDs ...string) ([]domainsecret.RotationInfo, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return st.getUserSecretsRotationChanges(ctx, db, secretIDs...)
+}
+
+func (st State) getUserSecretsRotationChanges(
+	ctx context.Context, runner domain.TxnRunner, secretIDs ...string,
+) ([]domainsecret.RotationInfo, error) {
+	q := `
+SELECT
+       sro.secret_id AS &secretRotationChange.secret_id,
+       sro.next_rotation_time AS &secretRotationChange.next_rotation_time,
+       MAX(sr.revision) AS &secretRotationChange.revision
+FROM   secret_rotation sro
+       JOIN secret_revision sr ON sr.secret_id = sro.secret_id
+       JOIN secret_model_owner smo ON smo.secret_id = sro.secret_id`
+
+	var queryParams []any
+	if len(secretIDs) > 0 {
+		queryParams = append(queryParams, dbSecretIDs(secretIDs))
+		q += `
+WHERE  sro.secret_id IN ($dbSecretIDs[:])`
+	}
+	q += `
+GROUP BY sro.secret_id`
+
+	stmt, err := st.Prepare(q, append(queryParams, secretRotationChange{})...)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	var data []secretRotationChange
+	err = runner.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, queryParams...).GetAll(&data)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			// It's ok because the secret or the rotation was just deleted.
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	result := make([]domainsecret.RotationInfo, len(data))
+	for i, d := range data {
+		result[i] = domainsecret.RotationInfo{
+			Revision:        d.Revision,
+			NextTriggerTime: d.NextRotateTime,
+		}
+		uri, err := coresecrets.ParseURI(d.SecretID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		result[i].URI = uri
+	}
+	return result, nil
+}
+
 // GetSecretsRotationChanges returns the rotation changes for the owners' secrets.
 func (st State) GetSecretsRotationChanges(
 	ctx context.Context, appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners, secretIDs ...string,
@@ -4232,6 +4232,116 @@ WHERE secret_id = ?`, uri.ID)
 	c.Assert(nextRotationTime.Equal(next), jc.IsTrue)
 }
 
+func (s *stateSuite) TestUserSecretGenerator(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"password": "secret"},
+		Generator: &coresecrets.GeneratorConfig{
+			Kind:   coresecrets.GeneratorPassword,
+			Params: map[string]string{"length": "32"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg, err := st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg, jc.DeepEquals, &coresecrets.GeneratorConfig{
+		Kind:   coresecrets.GeneratorPassword,
+		Params: map[string]string{"length": "32"},
+	})
+
+	err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+		Generator: &coresecrets.GeneratorConfig{
+			Kind: coresecrets.GeneratorSSHKeyPair,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg, err = st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg, jc.DeepEquals, &coresecrets.GeneratorConfig{
+		Kind:   coresecrets.GeneratorSSHKeyPair,
+		Params: map[string]string{},
+	})
+
+	err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+		RemoveGenerator: true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretGeneratorNotFound)
+
+	err = st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		return st.DeleteSecret(ctx, uri, nil)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *stateSuite) TestGetSecretGeneratorNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = st.GetSecretGenerator(ctx, uri)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretGeneratorNotFound)
+}
+
+func (s *stateSuite) TestGetUserSecretsRotationChanges(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	s.setupUnits(c, "mysql")
+	appURI := coresecrets.NewURI()
+	err := createCharmApplicationSecret(ctx, st, 1, appURI, "mysql", domainsecret.UpsertSecretParams{
+		RevisionID:     ptr(uuid.MustNewUUID().String()),
+		Data:           coresecrets.SecretData{"foo": "bar"},
+		RotatePolicy:   ptr(domainsecret.RotateHourly),
+		NextRotateTime: ptr(now.Add(time.Hour)),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	userURI := coresecrets.NewURI()
+	err = createUserSecret(ctx, st, 1, userURI, domainsecret.UpsertSecretParams{
+		RevisionID:     ptr(uuid.MustNewUUID().String()),
+		Data:           coresecrets.SecretData{"password": "secret"},
+		RotatePolicy:   ptr(domainsecret.RotateDaily),
+		NextRotateTime: ptr(now.Add(24 * time.Hour)),
+		Generator:      &coresecrets.GeneratorConfig{Kind: coresecrets.GeneratorPassword},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	tableName, f := st.InitialWatchStatementForUserSecretsRotationChanges()
+	c.Check(tableName, gc.Equals, "secret_rotation")
+	initial, err := f(ctx, s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(initial, jc.SameContents, []string{userURI.ID})
+
+	result, err := st.GetUserSecretsRotationChanges(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, []domainsecret.RotationInfo{{
+		URI:             userURI,
+		Revision:        1,
+		NextTriggerTime: now.Add(24 * time.Hour),
+	}})
+
+	result, err = st.GetUserSecretsRotationChanges(ctx, appURI.ID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.HasLen, 0)
+}
+
 func (s *stateSuite) TestGetObsoleteUserSecretRevisionsReadyToPrune(c *gc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 
@@ -112,6 +112,17 @@ type secretRotate struct {
 	NextRotateTime time.Time `db:"next_rotation_time"`
 }
 
+type secretGenerator struct {
+	SecretID string `db:"secret_id"`
+	Kind     string `db:"kind"`
+}
+
+type secretGeneratorParam struct {
+	SecretID string `db:"secret_id"`
+	Key      string `db:"key"`
+	Value    string `db:"value"`
+}
+
 type secretRotationChange struct {
 	SecretID       string    `db:"secret_id"`
 	Revision       int       `db:"revision"`
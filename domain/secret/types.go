@@ -48,6 +48,10 @@ type UpsertSecretParams struct {
 	Description    *string
 	Label          *string
 	AutoPrune      *bool
+	Generator      *secrets.GeneratorConfig
+	// RemoveGenerator is true if the secret's generator
+	// is to be removed. It is ignored if Generator is set.
+	RemoveGenerator bool
 
 	Data     secrets.SecretData
 	ValueRef *secrets.ValueRef
@@ -63,7 +67,9 @@ func (u *UpsertSecretParams) HasUpdate() bool {
 		u.ExpireTime != nil ||
 		len(u.Data) > 0 ||
 		u.ValueRef != nil ||
-		u.AutoPrune != nil
+		u.AutoPrune != nil ||
+		u.Generator != nil ||
+		u.RemoveGenerator
 }
 
 // GrantParams are used when granting access to a secret.
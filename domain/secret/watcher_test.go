@@ -852,6 +852,58 @@ func ptr[T any](v T) *T {
 	return &v
 }
 
+func (s *watcherSuite) TestWatchUserSecretsRotationChanges(c *gc.C) {
+	s.setupUnits(c, "mysql")
+
+	ctx := context.Background()
+	svc, st := s.setupServiceAndState(c)
+
+	appURI := coresecrets.NewURI()
+	userURI := coresecrets.NewURI()
+
+	w, err := svc.WatchUserSecretsRotationChanges(ctx)
+	c.Assert(err, gc.IsNil)
+	c.Assert(w, gc.NotNil)
+	defer watchertest.CleanKill(c, w)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, w))
+	harness.AddTest(func(c *gc.C) {
+		err := createCharmApplicationSecret(ctx, st, 1, appURI, "mysql", secret.UpsertSecretParams{
+			Data:       coresecrets.SecretData{"foo": "bar"},
+			RevisionID: ptr(uuid.MustNewUUID().String()),
+		})
+		c.Assert(err, jc.ErrorIsNil)
+		err = createUserSecret(ctx, st, 1, userURI, secret.UpsertSecretParams{
+			Data:       coresecrets.SecretData{"password": "secret"},
+			RevisionID: ptr(uuid.MustNewUUID().String()),
+			Generator:  &coresecrets.GeneratorConfig{Kind: coresecrets.GeneratorPassword},
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]corewatcher.SecretTriggerChange]) {
+		w.AssertNoChange()
+	})
+
+	now := time.Now()
+	harness.AddTest(func(c *gc.C) {
+		err = st.SecretRotated(ctx, appURI, now.Add(1*time.Hour))
+		c.Assert(err, jc.ErrorIsNil)
+		err = st.SecretRotated(ctx, userURI, now.Add(2*time.Hour))
+		c.Assert(err, jc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]corewatcher.SecretTriggerChange]) {
+		w.Check(
+			watchertest.SecretTriggerSliceAssert(
+				corewatcher.SecretTriggerChange{
+					URI:             userURI,
+					Revision:        1,
+					NextTriggerTime: now.Add(2 * time.Hour),
+				},
+			),
+		)
+	})
+
+	harness.Run(c, []corewatcher.SecretTriggerChange(nil))
+}
+
 func (s *watcherSuite) TestWatchSecretsRevisionExpiryChanges(c *gc.C) {
 	s.setupUnits(c, "mysql")
 	s.setupUnits(c, "mediawiki")
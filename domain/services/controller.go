@@ -12,6 +12,8 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	accessservice "github.com/juju/juju/domain/access/service"
 	accessstate "github.com/juju/juju/domain/access/state"
+	admissionservice "github.com/juju/juju/domain/admission/service"
+	admissionstate "github.com/juju/juju/domain/admission/state"
 	agentbinaryservice "github.com/juju/juju/domain/agentbinary/service"
 	agentbinarystate "github.com/juju/juju/domain/agentbinary/state"
 	autocertcacheservice "github.com/juju/juju/domain/autocert/service"
@@ -38,6 +40,12 @@ import (
 	modeldefaultsstate "github.com/juju/juju/domain/modeldefaults/state"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	secretbackendstate "github.com/juju/juju/domain/secretbackend/state"
+	sshaccessservice "github.com/juju/juju/domain/sshaccess/service"
+	sshaccessstate "github.com/juju/juju/domain/sshaccess/state"
+	sshcaservice "github.com/juju/juju/domain/sshca/service"
+	sshcastate "github.com/juju/juju/domain/sshca/state"
+	sshsessionservice "github.com/juju/juju/domain/sshsession/service"
+	sshsessionstate "github.com/juju/juju/domain/sshsession/state"
 	upgradeservice "github.com/juju/juju/domain/upgrade/service"
 	upgradestate "github.com/juju/juju/domain/upgrade/state"
 )
@@ -193,3 +201,42 @@ func (s *ControllerServices) ControllerAgentBinaryStore() *agentbinaryservice.Ag
 		s.controllerObjectStore,
 	)
 }
+
+// SSHSession returns the service for recording the SSH sessions proxied by
+// the controller, and playing them back.
+func (s *ControllerServices) SSHSession() *sshsessionservice.Service {
+	return sshsessionservice.NewService(
+		sshsessionstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.logger.Child("sshsession"),
+		s.controllerObjectStore,
+	)
+}
+
+// SSHAccess returns the service for granting users time-bounded ssh access to
+// units and machines.
+func (s *ControllerServices) SSHAccess() *sshaccessservice.Service {
+	return sshaccessservice.NewService(
+		sshaccessstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.clock,
+		s.logger.Child("sshaccess"),
+	)
+}
+
+// SSHCA returns the service for the controller's ssh user certificate
+// authority.
+func (s *ControllerServices) SSHCA() *sshcaservice.Service {
+	return sshcaservice.NewService(
+		sshcastate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.clock,
+		s.logger.Child("sshca"),
+	)
+}
+
+// Admission returns the service for the admission policies which are
+// evaluated before model-changing operations.
+func (s *ControllerServices) Admission() *admissionservice.Service {
+	return admissionservice.NewService(
+		admissionstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		s.logger.Child("admission"),
+	)
+}
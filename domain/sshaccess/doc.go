@@ -0,0 +1,9 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshaccess provides the service for just in time ssh access to units
+// and machines through the controller's embedded SSH server. A user requests
+// access to a target for a limited time, the request is approved by an admin
+// or by policy, and the embedded SSH server only lets the user connect to the
+// target while the grant is approved and unexpired.
+package sshaccess
@@ -0,0 +1,30 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// GrantNotFound describes an error that occurs when the ssh access grant
+	// being requested does not exist.
+	GrantNotFound = errors.ConstError("ssh access grant not found")
+
+	// GrantNotPending describes an error that occurs when approving or
+	// rejecting an ssh access grant which has already been decided.
+	GrantNotPending = errors.ConstError("ssh access grant not pending")
+
+	// GrantNotActive describes an error that occurs when revoking an ssh
+	// access grant which was rejected or has already been revoked.
+	GrantNotActive = errors.ConstError("ssh access grant not active")
+
+	// SelfApproval describes an error that occurs when users try to approve
+	// their own requests for ssh access.
+	SelfApproval = errors.ConstError("ssh access grant cannot be approved by the requesting user")
+
+	// NoActiveGrant describes an error that occurs when a user has no
+	// approved and unexpired grant for ssh access to a target.
+	NoActiveGrant = errors.ConstError("no active ssh access grant")
+)
@@ -0,0 +1,234 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/domain/sshaccess"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// State describes retrieval and persistence methods for ssh access grants.
+type State interface {
+	// InsertGrant records a pending request for ssh access.
+	InsertGrant(context.Context, sshaccess.InsertGrantArgs) error
+
+	// GetGrant returns the specified grant.
+	GetGrant(ctx context.Context, uuid string) (sshaccess.Grant, error)
+
+	// ListGrants returns the grants for ssh access to units and machines of
+	// the specified model, in the order they were requested.
+	ListGrants(ctx context.Context, modelUUID string) ([]sshaccess.Grant, error)
+
+	// ApproveGrant approves the specified pending grant, which then expires
+	// after its duration.
+	ApproveGrant(ctx context.Context, uuid, approvedBy string, approvedAt time.Time) error
+
+	// RejectGrant rejects the specified pending grant.
+	RejectGrant(ctx context.Context, uuid, rejectedBy string, rejectedAt time.Time) error
+
+	// RevokeGrant revokes the specified pending or approved grant.
+	RevokeGrant(ctx context.Context, uuid, revokedBy string, revokedAt time.Time) error
+
+	// GetApprovedGrantExpiries returns when each of the approved grants of
+	// the user for ssh access to the target expire.
+	GetApprovedGrantExpiries(ctx context.Context, userName, modelUUID, target string) ([]time.Time, error)
+}
+
+// Service provides the API for granting users time-bounded ssh access to
+// units and machines.
+type Service struct {
+	st     State
+	clock  clock.Clock
+	logger logger.Logger
+}
+
+// NewService returns a new Service for managing ssh access grants.
+func NewService(st State, clock clock.Clock, logger logger.Logger) *Service {
+	return &Service{
+		st:     st,
+		clock:  clock,
+		logger: logger,
+	}
+}
+
+// RequestGrant records a request for ssh access to a unit or machine. If
+// autoApproveUpTo is positive, requests for no longer than it are approved
+// by policy straight away.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the user, model, target or duration of the
+// request is not valid.
+func (s *Service) RequestGrant(
+	ctx context.Context,
+	args sshaccess.RequestGrantArgs,
+	autoApproveUpTo time.Duration,
+) (sshaccess.Grant, error) {
+	if args.UserName == "" {
+		return sshaccess.Grant{}, errors.New("empty user name").Add(coreerrors.NotValid)
+	}
+	if args.ModelUUID == "" {
+		return sshaccess.Grant{}, errors.New("empty model UUID").Add(coreerrors.NotValid)
+	}
+	info, err := virtualhostname.Parse(args.Target)
+	if err != nil {
+		return sshaccess.Grant{}, errors.Errorf("target %q: %w", args.Target, err).Add(coreerrors.NotValid)
+	}
+	if info.ModelUUID() != args.ModelUUID {
+		return sshaccess.Grant{}, errors.Errorf(
+			"target %q is not in model %q", args.Target, args.ModelUUID,
+		).Add(coreerrors.NotValid)
+	}
+	if args.Duration <= 0 {
+		return sshaccess.Grant{}, errors.New("duration must be positive").Add(coreerrors.NotValid)
+	}
+	if args.Duration > sshaccess.MaxGrantDuration {
+		return sshaccess.Grant{}, errors.Errorf(
+			"duration %v exceeds the maximum of %v", args.Duration, sshaccess.MaxGrantDuration,
+		).Add(coreerrors.NotValid)
+	}
+
+	grantUUID, err := uuid.NewUUID()
+	if err != nil {
+		return sshaccess.Grant{}, errors.Capture(err)
+	}
+	err = s.st.InsertGrant(ctx, sshaccess.InsertGrantArgs{
+		RequestGrantArgs: args,
+		UUID:             grantUUID.String(),
+		RequestedAt:      s.clock.Now(),
+	})
+	if err != nil {
+		return sshaccess.Grant{}, errors.Capture(err)
+	}
+
+	if autoApproveUpTo > 0 && args.Duration <= autoApproveUpTo {
+		err := s.st.ApproveGrant(ctx, grantUUID.String(), sshaccess.PolicyApprover, s.clock.Now())
+		if err != nil {
+			return sshaccess.Grant{}, errors.Errorf("auto-approving ssh access grant: %w", err)
+		}
+		s.logger.Infof(ctx, "ssh access of %q to %q for %v approved by policy", args.UserName, args.Target, args.Duration)
+	}
+	return s.GetGrant(ctx, args.ModelUUID, grantUUID.String())
+}
+
+// GetGrant returns the specified grant for ssh access to a unit or machine of
+// the model.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist in the model.
+func (s *Service) GetGrant(ctx context.Context, modelUUID, uuid string) (sshaccess.Grant, error) {
+	grant, err := s.st.GetGrant(ctx, uuid)
+	if err != nil {
+		return sshaccess.Grant{}, errors.Capture(err)
+	}
+	if grant.ModelUUID != modelUUID {
+		return sshaccess.Grant{}, errors.Errorf("ssh access grant %q", uuid).Add(sshaccesserrors.GrantNotFound)
+	}
+	return s.withExpiry(grant), nil
+}
+
+// ListGrants returns the grants for ssh access to units and machines of the
+// model, in the order they were requested. Approved grants which have expired
+// are reported as expired.
+func (s *Service) ListGrants(ctx context.Context, modelUUID string) ([]sshaccess.Grant, error) {
+	grants, err := s.st.ListGrants(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	for i, grant := range grants {
+		grants[i] = s.withExpiry(grant)
+	}
+	return grants, nil
+}
+
+// ApproveGrant approves the specified pending grant. Access is granted from
+// now for the duration of the grant.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist in the model.
+// - [sshaccesserrors.GrantNotPending] if the grant has already been decided.
+// - [sshaccesserrors.SelfApproval] if the approver requested the grant.
+func (s *Service) ApproveGrant(ctx context.Context, modelUUID, uuid, approvedBy string) error {
+	grant, err := s.GetGrant(ctx, modelUUID, uuid)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if grant.UserName == approvedBy {
+		return errors.Errorf("ssh access grant %q", uuid).Add(sshaccesserrors.SelfApproval)
+	}
+	if err := s.st.ApproveGrant(ctx, uuid, approvedBy, s.clock.Now()); err != nil {
+		return errors.Capture(err)
+	}
+	s.logger.Infof(ctx, "ssh access of %q to %q for %v approved by %q", grant.UserName, grant.Target, grant.Duration, approvedBy)
+	return nil
+}
+
+// RejectGrant rejects the specified pending grant.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist in the model.
+// - [sshaccesserrors.GrantNotPending] if the grant has already been decided.
+func (s *Service) RejectGrant(ctx context.Context, modelUUID, uuid, rejectedBy string) error {
+	if _, err := s.GetGrant(ctx, modelUUID, uuid); err != nil {
+		return errors.Capture(err)
+	}
+	return s.st.RejectGrant(ctx, uuid, rejectedBy, s.clock.Now())
+}
+
+// RevokeGrant revokes the specified pending or approved grant. Sessions
+// using the grant are terminated by the ssh server.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist in the model.
+// - [sshaccesserrors.GrantNotActive] if the grant was rejected or has
+// already been revoked.
+func (s *Service) RevokeGrant(ctx context.Context, modelUUID, uuid, revokedBy string) error {
+	grant, err := s.GetGrant(ctx, modelUUID, uuid)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.st.RevokeGrant(ctx, uuid, revokedBy, s.clock.Now()); err != nil {
+		return errors.Capture(err)
+	}
+	s.logger.Infof(ctx, "ssh access of %q to %q revoked by %q", grant.UserName, grant.Target, revokedBy)
+	return nil
+}
+
+// GrantExpiry returns when the user's ssh access to the target expires. If
+// the user holds several approved grants, the latest expiry is returned.
+// The following errors can be returned:
+// - [sshaccesserrors.NoActiveGrant] if the user holds no approved grant for
+// the target which has not expired.
+func (s *Service) GrantExpiry(ctx context.Context, userName, modelUUID, target string) (time.Time, error) {
+	expiries, err := s.st.GetApprovedGrantExpiries(ctx, userName, modelUUID, target)
+	if err != nil {
+		return time.Time{}, errors.Capture(err)
+	}
+
+	now := s.clock.Now()
+	var latest time.Time
+	for _, expiry := range expiries {
+		if expiry.After(now) && expiry.After(latest) {
+			latest = expiry
+		}
+	}
+	if latest.IsZero() {
+		return time.Time{}, errors.Errorf(
+			"%q to %q", userName, target,
+		).Add(sshaccesserrors.NoActiveGrant)
+	}
+	return latest, nil
+}
+
+// withExpiry reports approved grants which have expired as expired.
+func (s *Service) withExpiry(grant sshaccess.Grant) sshaccess.Grant {
+	if grant.Status == sshaccess.GrantApproved && !grant.ExpiresAt.After(s.clock.Now()) {
+		grant.Status = sshaccess.GrantExpired
+	}
+	return grant
+}
@@ -0,0 +1,267 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/domain/sshaccess"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type serviceSuite struct {
+	state *MockState
+	clock *testclock.Clock
+
+	modelUUID coremodel.UUID
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC))
+	s.modelUUID = modeltesting.GenModelUUID(c)
+	return ctrl
+}
+
+func (s *serviceSuite) service(c *gc.C) *Service {
+	return NewService(s.state, s.clock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *serviceSuite) target() string {
+	return "0." + s.modelUUID.String() + ".juju.local"
+}
+
+func (s *serviceSuite) requestArgs() sshaccess.RequestGrantArgs {
+	return sshaccess.RequestGrantArgs{
+		UserName:  "bob",
+		ModelUUID: s.modelUUID.String(),
+		Target:    s.target(),
+		Reason:    "incident",
+		Duration:  time.Hour,
+	}
+}
+
+func (s *serviceSuite) TestRequestGrant(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var grantUUID string
+	s.state.EXPECT().InsertGrant(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, args sshaccess.InsertGrantArgs) error {
+			c.Check(args.RequestGrantArgs, jc.DeepEquals, s.requestArgs())
+			c.Check(args.RequestedAt, gc.Equals, s.clock.Now())
+			c.Check(args.UUID, gc.Not(gc.Equals), "")
+			grantUUID = args.UUID
+			return nil
+		})
+	s.state.EXPECT().GetGrant(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, uuid string) (sshaccess.Grant, error) {
+			c.Check(uuid, gc.Equals, grantUUID)
+			return sshaccess.Grant{
+				UUID:      uuid,
+				ModelUUID: s.modelUUID.String(),
+				Status:    sshaccess.GrantPending,
+			}, nil
+		})
+
+	grant, err := s.service(c).RequestGrant(context.Background(), s.requestArgs(), 0)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grant.UUID, gc.Equals, grantUUID)
+	c.Check(grant.Status, gc.Equals, sshaccess.GrantPending)
+}
+
+func (s *serviceSuite) TestRequestGrantAutoApproved(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().InsertGrant(gomock.Any(), gomock.Any()).Return(nil)
+	s.state.EXPECT().ApproveGrant(gomock.Any(), gomock.Any(), sshaccess.PolicyApprover, s.clock.Now()).Return(nil)
+	s.state.EXPECT().GetGrant(gomock.Any(), gomock.Any()).Return(sshaccess.Grant{
+		ModelUUID: s.modelUUID.String(),
+		Status:    sshaccess.GrantApproved,
+		ExpiresAt: s.clock.Now().Add(time.Hour),
+	}, nil)
+
+	grant, err := s.service(c).RequestGrant(context.Background(), s.requestArgs(), time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grant.Status, gc.Equals, sshaccess.GrantApproved)
+}
+
+func (s *serviceSuite) TestRequestGrantLongerThanAutoApproval(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().InsertGrant(gomock.Any(), gomock.Any()).Return(nil)
+	s.state.EXPECT().GetGrant(gomock.Any(), gomock.Any()).Return(sshaccess.Grant{
+		ModelUUID: s.modelUUID.String(),
+		Status:    sshaccess.GrantPending,
+	}, nil)
+
+	grant, err := s.service(c).RequestGrant(context.Background(), s.requestArgs(), 30*time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grant.Status, gc.Equals, sshaccess.GrantPending)
+}
+
+func (s *serviceSuite) TestRequestGrantNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	otherModel := modeltesting.GenModelUUID(c)
+	for i, test := range []struct {
+		modify func(*sshaccess.RequestGrantArgs)
+		err    string
+	}{{
+		modify: func(a *sshaccess.RequestGrantArgs) { a.UserName = "" },
+		err:    "empty user name",
+	}, {
+		modify: func(a *sshaccess.RequestGrantArgs) { a.ModelUUID = "" },
+		err:    "empty model UUID",
+	}, {
+		modify: func(a *sshaccess.RequestGrantArgs) { a.Target = "nonsense" },
+		err:    `target "nonsense": .*`,
+	}, {
+		modify: func(a *sshaccess.RequestGrantArgs) { a.Target = "0." + otherModel.String() + ".juju.local" },
+		err:    `target ".*" is not in model ".*"`,
+	}, {
+		modify: func(a *sshaccess.RequestGrantArgs) { a.Duration = 0 },
+		err:    "duration must be positive",
+	}, {
+		modify: func(a *sshaccess.RequestGrantArgs) { a.Duration = 25 * time.Hour },
+		err:    "duration 25h0m0s exceeds the maximum of 24h0m0s",
+	}} {
+		c.Logf("test %d", i)
+		args := s.requestArgs()
+		test.modify(&args)
+		_, err := s.service(c).RequestGrant(context.Background(), args, 0)
+		c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *serviceSuite) TestGetGrantOtherModel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetGrant(gomock.Any(), "grant-1").Return(sshaccess.Grant{
+		UUID:      "grant-1",
+		ModelUUID: modeltesting.GenModelUUID(c).String(),
+	}, nil)
+
+	_, err := s.service(c).GetGrant(context.Background(), s.modelUUID.String(), "grant-1")
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.GrantNotFound)
+}
+
+func (s *serviceSuite) TestListGrantsReportsExpiry(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	s.state.EXPECT().ListGrants(gomock.Any(), s.modelUUID.String()).Return([]sshaccess.Grant{{
+		UUID:      "expired",
+		Status:    sshaccess.GrantApproved,
+		ExpiresAt: now,
+	}, {
+		UUID:      "active",
+		Status:    sshaccess.GrantApproved,
+		ExpiresAt: now.Add(time.Second),
+	}, {
+		UUID:   "pending",
+		Status: sshaccess.GrantPending,
+	}}, nil)
+
+	grants, err := s.service(c).ListGrants(context.Background(), s.modelUUID.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(grants, gc.HasLen, 3)
+	c.Check(grants[0].Status, gc.Equals, sshaccess.GrantExpired)
+	c.Check(grants[1].Status, gc.Equals, sshaccess.GrantApproved)
+	c.Check(grants[2].Status, gc.Equals, sshaccess.GrantPending)
+}
+
+func (s *serviceSuite) TestApproveGrant(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetGrant(gomock.Any(), "grant-1").Return(sshaccess.Grant{
+		UUID:      "grant-1",
+		UserName:  "bob",
+		ModelUUID: s.modelUUID.String(),
+		Status:    sshaccess.GrantPending,
+	}, nil)
+	s.state.EXPECT().ApproveGrant(gomock.Any(), "grant-1", "admin", s.clock.Now()).Return(nil)
+
+	err := s.service(c).ApproveGrant(context.Background(), s.modelUUID.String(), "grant-1", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestApproveGrantSelfApproval(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetGrant(gomock.Any(), "grant-1").Return(sshaccess.Grant{
+		UUID:      "grant-1",
+		UserName:  "bob",
+		ModelUUID: s.modelUUID.String(),
+		Status:    sshaccess.GrantPending,
+	}, nil)
+
+	err := s.service(c).ApproveGrant(context.Background(), s.modelUUID.String(), "grant-1", "bob")
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.SelfApproval)
+}
+
+func (s *serviceSuite) TestRejectGrant(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetGrant(gomock.Any(), "grant-1").Return(sshaccess.Grant{
+		UUID:      "grant-1",
+		ModelUUID: s.modelUUID.String(),
+	}, nil)
+	s.state.EXPECT().RejectGrant(gomock.Any(), "grant-1", "admin", s.clock.Now()).Return(nil)
+
+	err := s.service(c).RejectGrant(context.Background(), s.modelUUID.String(), "grant-1", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRevokeGrant(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetGrant(gomock.Any(), "grant-1").Return(sshaccess.Grant{
+		UUID:      "grant-1",
+		ModelUUID: s.modelUUID.String(),
+	}, nil)
+	s.state.EXPECT().RevokeGrant(gomock.Any(), "grant-1", "admin", s.clock.Now()).Return(nil)
+
+	err := s.service(c).RevokeGrant(context.Background(), s.modelUUID.String(), "grant-1", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestGrantExpiry(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now()
+	s.state.EXPECT().GetApprovedGrantExpiries(gomock.Any(), "bob", s.modelUUID.String(), s.target()).Return([]time.Time{
+		now.Add(-time.Hour),
+		now.Add(2 * time.Hour),
+		now.Add(time.Hour),
+	}, nil)
+
+	expiry, err := s.service(c).GrantExpiry(context.Background(), "bob", s.modelUUID.String(), s.target())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(expiry, gc.Equals, now.Add(2*time.Hour))
+}
+
+func (s *serviceSuite) TestGrantExpiryNoActiveGrant(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApprovedGrantExpiries(gomock.Any(), "bob", s.modelUUID.String(), s.target()).Return([]time.Time{
+		s.clock.Now(),
+	}, nil)
+
+	_, err := s.service(c).GrantExpiry(context.Background(), "bob", s.modelUUID.String(), s.target())
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.NoActiveGrant)
+}
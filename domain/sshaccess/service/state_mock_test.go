@@ -0,0 +1,311 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/sshaccess/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/sshaccess/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	sshaccess "github.com/juju/juju/domain/sshaccess"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// ApproveGrant mocks base method.
+func (m *MockState) ApproveGrant(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveGrant", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveGrant indicates an expected call of ApproveGrant.
+func (mr *MockStateMockRecorder) ApproveGrant(arg0, arg1, arg2, arg3 any) *MockStateApproveGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveGrant", reflect.TypeOf((*MockState)(nil).ApproveGrant), arg0, arg1, arg2, arg3)
+	return &MockStateApproveGrantCall{Call: call}
+}
+
+// MockStateApproveGrantCall wrap *gomock.Call
+type MockStateApproveGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateApproveGrantCall) Return(arg0 error) *MockStateApproveGrantCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateApproveGrantCall) Do(f func(context.Context, string, string, time.Time) error) *MockStateApproveGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateApproveGrantCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockStateApproveGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApprovedGrantExpiries mocks base method.
+func (m *MockState) GetApprovedGrantExpiries(arg0 context.Context, arg1, arg2, arg3 string) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovedGrantExpiries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovedGrantExpiries indicates an expected call of GetApprovedGrantExpiries.
+func (mr *MockStateMockRecorder) GetApprovedGrantExpiries(arg0, arg1, arg2, arg3 any) *MockStateGetApprovedGrantExpiriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovedGrantExpiries", reflect.TypeOf((*MockState)(nil).GetApprovedGrantExpiries), arg0, arg1, arg2, arg3)
+	return &MockStateGetApprovedGrantExpiriesCall{Call: call}
+}
+
+// MockStateGetApprovedGrantExpiriesCall wrap *gomock.Call
+type MockStateGetApprovedGrantExpiriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApprovedGrantExpiriesCall) Return(arg0 []time.Time, arg1 error) *MockStateGetApprovedGrantExpiriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApprovedGrantExpiriesCall) Do(f func(context.Context, string, string, string) ([]time.Time, error)) *MockStateGetApprovedGrantExpiriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApprovedGrantExpiriesCall) DoAndReturn(f func(context.Context, string, string, string) ([]time.Time, error)) *MockStateGetApprovedGrantExpiriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetGrant mocks base method.
+func (m *MockState) GetGrant(arg0 context.Context, arg1 string) (sshaccess.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrant", arg0, arg1)
+	ret0, _ := ret[0].(sshaccess.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGrant indicates an expected call of GetGrant.
+func (mr *MockStateMockRecorder) GetGrant(arg0, arg1 any) *MockStateGetGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrant", reflect.TypeOf((*MockState)(nil).GetGrant), arg0, arg1)
+	return &MockStateGetGrantCall{Call: call}
+}
+
+// MockStateGetGrantCall wrap *gomock.Call
+type MockStateGetGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetGrantCall) Return(arg0 sshaccess.Grant, arg1 error) *MockStateGetGrantCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetGrantCall) Do(f func(context.Context, string) (sshaccess.Grant, error)) *MockStateGetGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetGrantCall) DoAndReturn(f func(context.Context, string) (sshaccess.Grant, error)) *MockStateGetGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InsertGrant mocks base method.
+func (m *MockState) InsertGrant(arg0 context.Context, arg1 sshaccess.InsertGrantArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertGrant", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertGrant indicates an expected call of InsertGrant.
+func (mr *MockStateMockRecorder) InsertGrant(arg0, arg1 any) *MockStateInsertGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGrant", reflect.TypeOf((*MockState)(nil).InsertGrant), arg0, arg1)
+	return &MockStateInsertGrantCall{Call: call}
+}
+
+// MockStateInsertGrantCall wrap *gomock.Call
+type MockStateInsertGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInsertGrantCall) Return(arg0 error) *MockStateInsertGrantCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInsertGrantCall) Do(f func(context.Context, sshaccess.InsertGrantArgs) error) *MockStateInsertGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInsertGrantCall) DoAndReturn(f func(context.Context, sshaccess.InsertGrantArgs) error) *MockStateInsertGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListGrants mocks base method.
+func (m *MockState) ListGrants(arg0 context.Context, arg1 string) ([]sshaccess.Grant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGrants", arg0, arg1)
+	ret0, _ := ret[0].([]sshaccess.Grant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrants indicates an expected call of ListGrants.
+func (mr *MockStateMockRecorder) ListGrants(arg0, arg1 any) *MockStateListGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrants", reflect.TypeOf((*MockState)(nil).ListGrants), arg0, arg1)
+	return &MockStateListGrantsCall{Call: call}
+}
+
+// MockStateListGrantsCall wrap *gomock.Call
+type MockStateListGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListGrantsCall) Return(arg0 []sshaccess.Grant, arg1 error) *MockStateListGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListGrantsCall) Do(f func(context.Context, string) ([]sshaccess.Grant, error)) *MockStateListGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListGrantsCall) DoAndReturn(f func(context.Context, string) ([]sshaccess.Grant, error)) *MockStateListGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RejectGrant mocks base method.
+func (m *MockState) RejectGrant(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectGrant", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectGrant indicates an expected call of RejectGrant.
+func (mr *MockStateMockRecorder) RejectGrant(arg0, arg1, arg2, arg3 any) *MockStateRejectGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectGrant", reflect.TypeOf((*MockState)(nil).RejectGrant), arg0, arg1, arg2, arg3)
+	return &MockStateRejectGrantCall{Call: call}
+}
+
+// MockStateRejectGrantCall wrap *gomock.Call
+type MockStateRejectGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRejectGrantCall) Return(arg0 error) *MockStateRejectGrantCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRejectGrantCall) Do(f func(context.Context, string, string, time.Time) error) *MockStateRejectGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRejectGrantCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockStateRejectGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeGrant mocks base method.
+func (m *MockState) RevokeGrant(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeGrant", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeGrant indicates an expected call of RevokeGrant.
+func (mr *MockStateMockRecorder) RevokeGrant(arg0, arg1, arg2, arg3 any) *MockStateRevokeGrantCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGrant", reflect.TypeOf((*MockState)(nil).RevokeGrant), arg0, arg1, arg2, arg3)
+	return &MockStateRevokeGrantCall{Call: call}
+}
+
+// MockStateRevokeGrantCall wrap *gomock.Call
+type MockStateRevokeGrantCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRevokeGrantCall) Return(arg0 error) *MockStateRevokeGrantCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRevokeGrantCall) Do(f func(context.Context, string, string, time.Time) error) *MockStateRevokeGrantCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRevokeGrantCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockStateRevokeGrantCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
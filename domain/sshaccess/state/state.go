@@ -0,0 +1,310 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/sshaccess"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State is used to access the database.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState creates a state to access the database.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// InsertGrant records a pending request for ssh access.
+func (st *State) InsertGrant(ctx context.Context, arg sshaccess.InsertGrantArgs) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := sshAccessGrant{
+		UUID:      arg.UUID,
+		UserName:  arg.UserName,
+		ModelUUID: arg.ModelUUID,
+		Target:    arg.Target,
+		Reason: sql.NullString{
+			String: arg.Reason,
+			Valid:  arg.Reason != "",
+		},
+		DurationSeconds: int64(arg.Duration / time.Second),
+		StatusID:        grantStatusID[sshaccess.GrantPending],
+		RequestedAt:     arg.RequestedAt.UTC(),
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO ssh_access_grant (*)
+VALUES ($sshAccessGrant.*)
+`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, row).Run()
+	})
+	if err != nil {
+		return errors.Errorf("inserting ssh access grant %q: %w", arg.UUID, err)
+	}
+	return nil
+}
+
+// GetGrant returns the specified grant.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist.
+func (st *State) GetGrant(ctx context.Context, uuid string) (sshaccess.Grant, error) {
+	db, err := st.DB()
+	if err != nil {
+		return sshaccess.Grant{}, errors.Capture(err)
+	}
+
+	var result grant
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		result, err = st.getGrant(ctx, tx, uuid)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return sshaccess.Grant{}, errors.Capture(err)
+	}
+	return result.toGrant(), nil
+}
+
+// ListGrants returns the grants for ssh access to units and machines of the
+// specified model, in the order they were requested.
+func (st *State) ListGrants(ctx context.Context, modelUUID string) ([]sshaccess.Grant, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := modelIdent{ModelUUID: modelUUID}
+	stmt, err := st.Prepare(`
+SELECT &grant.*
+FROM   v_ssh_access_grant
+WHERE  model_uuid = $modelIdent.model_uuid
+ORDER BY requested_at
+`, ident, grant{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []grant
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing ssh access grants: %w", err)
+	}
+
+	grants := make([]sshaccess.Grant, len(rows))
+	for i, row := range rows {
+		grants[i] = row.toGrant()
+	}
+	return grants, nil
+}
+
+// ApproveGrant approves the specified pending grant, which then expires
+// after its duration.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist.
+// - [sshaccesserrors.GrantNotPending] if the grant has already been decided.
+func (st *State) ApproveGrant(ctx context.Context, uuid, approvedBy string, approvedAt time.Time) error {
+	return st.decideGrant(ctx, uuid, func(current grant) (grantDecision, error) {
+		if current.Status != string(sshaccess.GrantPending) {
+			return grantDecision{}, errors.Errorf(
+				"ssh access grant %q is %s", uuid, current.Status,
+			).Add(sshaccesserrors.GrantNotPending)
+		}
+		expiresAt := approvedAt.Add(time.Duration(current.DurationSeconds) * time.Second)
+		return grantDecision{
+			StatusID:  grantStatusID[sshaccess.GrantApproved],
+			DecidedBy: approvedBy,
+			DecidedAt: approvedAt.UTC(),
+			ExpiresAt: sql.NullTime{Time: expiresAt.UTC(), Valid: true},
+		}, nil
+	})
+}
+
+// RejectGrant rejects the specified pending grant.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist.
+// - [sshaccesserrors.GrantNotPending] if the grant has already been decided.
+func (st *State) RejectGrant(ctx context.Context, uuid, rejectedBy string, rejectedAt time.Time) error {
+	return st.decideGrant(ctx, uuid, func(current grant) (grantDecision, error) {
+		if current.Status != string(sshaccess.GrantPending) {
+			return grantDecision{}, errors.Errorf(
+				"ssh access grant %q is %s", uuid, current.Status,
+			).Add(sshaccesserrors.GrantNotPending)
+		}
+		return grantDecision{
+			StatusID:  grantStatusID[sshaccess.GrantRejected],
+			DecidedBy: rejectedBy,
+			DecidedAt: rejectedAt.UTC(),
+		}, nil
+	})
+}
+
+// RevokeGrant revokes the specified pending or approved grant.
+// The following errors can be returned:
+// - [sshaccesserrors.GrantNotFound] if the grant does not exist.
+// - [sshaccesserrors.GrantNotActive] if the grant was rejected or has
+// already been revoked.
+func (st *State) RevokeGrant(ctx context.Context, uuid, revokedBy string, revokedAt time.Time) error {
+	return st.decideGrant(ctx, uuid, func(current grant) (grantDecision, error) {
+		switch sshaccess.GrantStatus(current.Status) {
+		case sshaccess.GrantPending, sshaccess.GrantApproved:
+		default:
+			return grantDecision{}, errors.Errorf(
+				"ssh access grant %q is %s", uuid, current.Status,
+			).Add(sshaccesserrors.GrantNotActive)
+		}
+		return grantDecision{
+			StatusID:  grantStatusID[sshaccess.GrantRevoked],
+			DecidedBy: revokedBy,
+			DecidedAt: revokedAt.UTC(),
+			ExpiresAt: current.ExpiresAt,
+		}, nil
+	})
+}
+
+// GetApprovedGrantExpiries returns when each of the approved grants of the
+// user for ssh access to the target expire. Grants which have already
+// expired are included.
+func (st *State) GetApprovedGrantExpiries(ctx context.Context, userName, modelUUID, target string) ([]time.Time, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := targetIdent{
+		UserName:  userName,
+		ModelUUID: modelUUID,
+		Target:    target,
+		StatusID:  grantStatusID[sshaccess.GrantApproved],
+	}
+	stmt, err := st.Prepare(`
+SELECT &expiry.*
+FROM   ssh_access_grant
+WHERE  user_name = $targetIdent.user_name
+AND    model_uuid = $targetIdent.model_uuid
+AND    target = $targetIdent.target
+AND    status_id = $targetIdent.status_id
+`, ident, expiry{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []expiry
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting ssh access grants: %w", err)
+	}
+
+	expiries := make([]time.Time, len(rows))
+	for i, row := range rows {
+		expiries[i] = row.ExpiresAt
+	}
+	return expiries, nil
+}
+
+// decideGrant updates the status of the grant with the decision returned by
+// decide for its current state, in a single transaction.
+func (st *State) decideGrant(ctx context.Context, uuid string, decide func(grant) (grantDecision, error)) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE ssh_access_grant
+SET    status_id = $grantDecision.status_id,
+       decided_by = $grantDecision.decided_by,
+       decided_at = $grantDecision.decided_at,
+       expires_at = $grantDecision.expires_at
+WHERE  uuid = $grantDecision.uuid
+`, grantDecision{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		current, err := st.getGrant(ctx, tx, uuid)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		decision, err := decide(current)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		decision.UUID = uuid
+		if err := tx.Query(ctx, updateStmt, decision).Run(); err != nil {
+			return errors.Errorf("updating ssh access grant %q: %w", uuid, err)
+		}
+		return nil
+	})
+}
+
+func (st *State) getGrant(ctx context.Context, tx *sqlair.TX, uuid string) (grant, error) {
+	ident := grantIdent{UUID: uuid}
+	stmt, err := st.Prepare(`
+SELECT &grant.*
+FROM   v_ssh_access_grant
+WHERE  uuid = $grantIdent.uuid
+`, ident, grant{})
+	if err != nil {
+		return grant{}, errors.Capture(err)
+	}
+
+	var result grant
+	err = tx.Query(ctx, stmt, ident).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return grant{}, errors.Errorf("ssh access grant %q", uuid).Add(sshaccesserrors.GrantNotFound)
+	} else if err != nil {
+		return grant{}, errors.Errorf("getting ssh access grant %q: %w", uuid, err)
+	}
+	return result, nil
+}
+
+func (g grant) toGrant() sshaccess.Grant {
+	return sshaccess.Grant{
+		UUID:        g.UUID,
+		UserName:    g.UserName,
+		ModelUUID:   g.ModelUUID,
+		Target:      g.Target,
+		Reason:      g.Reason.String,
+		Duration:    time.Duration(g.DurationSeconds) * time.Second,
+		Status:      sshaccess.GrantStatus(g.Status),
+		RequestedAt: g.RequestedAt,
+		DecidedBy:   g.DecidedBy.String,
+		DecidedAt:   g.DecidedAt.Time,
+		ExpiresAt:   g.ExpiresAt.Time,
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/domain/sshaccess"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+
+	state *State
+
+	modelUUID string
+	now       time.Time
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory())
+	s.modelUUID = uuid.MustNewUUID().String()
+	s.now = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+}
+
+func (s *stateSuite) TestInsertAndGetGrant(c *gc.C) {
+	s.insertGrant(c, "grant-1", "bob", "1.postgresql."+s.modelUUID+".juju.local", time.Hour)
+
+	grant, err := s.state.GetGrant(context.Background(), "grant-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grant, jc.DeepEquals, sshaccess.Grant{
+		UUID:        "grant-1",
+		UserName:    "bob",
+		ModelUUID:   s.modelUUID,
+		Target:      "1.postgresql." + s.modelUUID + ".juju.local",
+		Reason:      "incident",
+		Duration:    time.Hour,
+		Status:      sshaccess.GrantPending,
+		RequestedAt: s.now,
+	})
+}
+
+func (s *stateSuite) TestGetGrantNotFound(c *gc.C) {
+	_, err := s.state.GetGrant(context.Background(), "missing")
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.GrantNotFound)
+}
+
+func (s *stateSuite) TestListGrants(c *gc.C) {
+	s.insertGrantAt(c, "grant-1", "bob", "0."+s.modelUUID+".juju.local", s.now.Add(time.Minute))
+	s.insertGrantAt(c, "grant-2", "alice", "1."+s.modelUUID+".juju.local", s.now)
+
+	// Grants for other models are not listed.
+	err := s.state.InsertGrant(context.Background(), sshaccess.InsertGrantArgs{
+		UUID:        "grant-3",
+		RequestedAt: s.now,
+		RequestGrantArgs: sshaccess.RequestGrantArgs{
+			UserName:  "bob",
+			ModelUUID: uuid.MustNewUUID().String(),
+			Target:    "0.other.juju.local",
+			Duration:  time.Hour,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	grants, err := s.state.ListGrants(context.Background(), s.modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(grants, gc.HasLen, 2)
+	c.Check(grants[0].UUID, gc.Equals, "grant-2")
+	c.Check(grants[1].UUID, gc.Equals, "grant-1")
+}
+
+func (s *stateSuite) TestListGrantsEmpty(c *gc.C) {
+	grants, err := s.state.ListGrants(context.Background(), s.modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grants, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestApproveGrant(c *gc.C) {
+	s.insertGrant(c, "grant-1", "bob", "0."+s.modelUUID+".juju.local", 2*time.Hour)
+
+	approvedAt := s.now.Add(time.Minute)
+	err := s.state.ApproveGrant(context.Background(), "grant-1", "admin", approvedAt)
+	c.Assert(err, jc.ErrorIsNil)
+
+	grant, err := s.state.GetGrant(context.Background(), "grant-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grant.Status, gc.Equals, sshaccess.GrantApproved)
+	c.Check(grant.DecidedBy, gc.Equals, "admin")
+	c.Check(grant.DecidedAt, gc.Equals, approvedAt)
+	c.Check(grant.ExpiresAt, gc.Equals, approvedAt.Add(2*time.Hour))
+
+	err = s.state.ApproveGrant(context.Background(), "grant-1", "admin", approvedAt)
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.GrantNotPending)
+}
+
+func (s *stateSuite) TestApproveGrantNotFound(c *gc.C) {
+	err := s.state.ApproveGrant(context.Background(), "missing", "admin", s.now)
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.GrantNotFound)
+}
+
+func (s *stateSuite) TestRejectGrant(c *gc.C) {
+	s.insertGrant(c, "grant-1", "bob", "0."+s.modelUUID+".juju.local", time.Hour)
+
+	err := s.state.RejectGrant(context.Background(), "grant-1", "admin", s.now)
+	c.Assert(err, jc.ErrorIsNil)
+
+	grant, err := s.state.GetGrant(context.Background(), "grant-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grant.Status, gc.Equals, sshaccess.GrantRejected)
+	c.Check(grant.DecidedBy, gc.Equals, "admin")
+	c.Check(grant.ExpiresAt.IsZero(), jc.IsTrue)
+
+	err = s.state.ApproveGrant(context.Background(), "grant-1", "admin", s.now)
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.GrantNotPending)
+	err = s.state.RevokeGrant(context.Background(), "grant-1", "admin", s.now)
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.GrantNotActive)
+}
+
+func (s *stateSuite) TestRevokeApprovedGrant(c *gc.C) {
+	target := "0." + s.modelUUID + ".juju.local"
+	s.insertGrant(c, "grant-1", "bob", target, time.Hour)
+	err := s.state.ApproveGrant(context.Background(), "grant-1", "admin", s.now)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.RevokeGrant(context.Background(), "grant-1", "bob", s.now.Add(time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+
+	grant, err := s.state.GetGrant(context.Background(), "grant-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(grant.Status, gc.Equals, sshaccess.GrantRevoked)
+	c.Check(grant.DecidedBy, gc.Equals, "bob")
+
+	expiries, err := s.state.GetApprovedGrantExpiries(context.Background(), "bob", s.modelUUID, target)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(expiries, gc.HasLen, 0)
+
+	err = s.state.RevokeGrant(context.Background(), "grant-1", "bob", s.now)
+	c.Assert(err, jc.ErrorIs, sshaccesserrors.GrantNotActive)
+}
+
+func (s *stateSuite) TestGetApprovedGrantExpiries(c *gc.C) {
+	target := "0." + s.modelUUID + ".juju.local"
+	s.insertGrant(c, "grant-1", "bob", target, time.Hour)
+	s.insertGrant(c, "grant-2", "bob", target, 2*time.Hour)
+	s.insertGrant(c, "grant-3", "bob", target, 3*time.Hour)
+	s.insertGrant(c, "grant-4", "alice", target, time.Hour)
+	s.insertGrant(c, "grant-5", "bob", "1."+s.modelUUID+".juju.local", time.Hour)
+
+	for _, uuid := range []string{"grant-1", "grant-2", "grant-4", "grant-5"} {
+		err := s.state.ApproveGrant(context.Background(), uuid, "admin", s.now)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	expiries, err := s.state.GetApprovedGrantExpiries(context.Background(), "bob", s.modelUUID, target)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(expiries, jc.SameContents, []time.Time{
+		s.now.Add(time.Hour),
+		s.now.Add(2 * time.Hour),
+	})
+}
+
+func (s *stateSuite) insertGrant(c *gc.C, uuid, userName, target string, duration time.Duration) {
+	err := s.state.InsertGrant(context.Background(), sshaccess.InsertGrantArgs{
+		UUID:        uuid,
+		RequestedAt: s.now,
+		RequestGrantArgs: sshaccess.RequestGrantArgs{
+			UserName:  userName,
+			ModelUUID: s.modelUUID,
+			Target:    target,
+			Reason:    "incident",
+			Duration:  duration,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) insertGrantAt(c *gc.C, uuid, userName, target string, requestedAt time.Time) {
+	err := s.state.InsertGrant(context.Background(), sshaccess.InsertGrantArgs{
+		UUID:        uuid,
+		RequestedAt: requestedAt,
+		RequestGrantArgs: sshaccess.RequestGrantArgs{
+			UserName:  userName,
+			ModelUUID: s.modelUUID,
+			Target:    target,
+			Duration:  time.Hour,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
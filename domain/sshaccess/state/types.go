@@ -0,0 +1,80 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/juju/juju/domain/sshaccess"
+)
+
+// grantStatusID maps the statuses of grants to the ids in the
+// ssh_access_grant_status table.
+var grantStatusID = map[sshaccess.GrantStatus]int{
+	sshaccess.GrantPending:  0,
+	sshaccess.GrantApproved: 1,
+	sshaccess.GrantRejected: 2,
+	sshaccess.GrantRevoked:  3,
+}
+
+// sshAccessGrant represents a row of the ssh_access_grant table.
+type sshAccessGrant struct {
+	UUID            string         `db:"uuid"`
+	UserName        string         `db:"user_name"`
+	ModelUUID       string         `db:"model_uuid"`
+	Target          string         `db:"target"`
+	Reason          sql.NullString `db:"reason"`
+	DurationSeconds int64          `db:"duration_seconds"`
+	StatusID        int            `db:"status_id"`
+	RequestedAt     time.Time      `db:"requested_at"`
+}
+
+// grantDecision represents the columns of the ssh_access_grant table which
+// are set when the grant is approved, rejected or revoked.
+type grantDecision struct {
+	UUID      string       `db:"uuid"`
+	StatusID  int          `db:"status_id"`
+	DecidedBy string       `db:"decided_by"`
+	DecidedAt time.Time    `db:"decided_at"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+}
+
+// grant represents a row of the v_ssh_access_grant view.
+type grant struct {
+	UUID            string         `db:"uuid"`
+	UserName        string         `db:"user_name"`
+	ModelUUID       string         `db:"model_uuid"`
+	Target          string         `db:"target"`
+	Reason          sql.NullString `db:"reason"`
+	DurationSeconds int64          `db:"duration_seconds"`
+	Status          string         `db:"status"`
+	RequestedAt     time.Time      `db:"requested_at"`
+	DecidedBy       sql.NullString `db:"decided_by"`
+	DecidedAt       sql.NullTime   `db:"decided_at"`
+	ExpiresAt       sql.NullTime   `db:"expires_at"`
+}
+
+// grantIdent identifies a grant.
+type grantIdent struct {
+	UUID string `db:"uuid"`
+}
+
+// modelIdent identifies a model.
+type modelIdent struct {
+	ModelUUID string `db:"model_uuid"`
+}
+
+// targetIdent identifies the grants of a user to a target.
+type targetIdent struct {
+	UserName  string `db:"user_name"`
+	ModelUUID string `db:"model_uuid"`
+	Target    string `db:"target"`
+	StatusID  int    `db:"status_id"`
+}
+
+// expiry holds the expiry of a grant.
+type expiry struct {
+	ExpiresAt time.Time `db:"expires_at"`
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshaccess
+
+import (
+	"time"
+)
+
+// GrantStatus describes the status of an ssh access grant.
+type GrantStatus string
+
+const (
+	// GrantPending is the status of a grant waiting for approval.
+	GrantPending GrantStatus = "pending"
+	// GrantApproved is the status of an approved grant which has not
+	// expired.
+	GrantApproved GrantStatus = "approved"
+	// GrantRejected is the status of a grant which was rejected.
+	GrantRejected GrantStatus = "rejected"
+	// GrantRevoked is the status of a grant which was revoked.
+	GrantRevoked GrantStatus = "revoked"
+	// GrantExpired is the status of an approved grant which has expired.
+	// It is never recorded, but derived from the expiry of the grant.
+	GrantExpired GrantStatus = "expired"
+)
+
+const (
+	// MaxGrantDuration is the longest ssh access which can be granted.
+	MaxGrantDuration = 24 * time.Hour
+
+	// PolicyApprover is recorded as the approver of grants approved by
+	// policy rather than by an admin. It is not a valid user name.
+	PolicyApprover = "<policy>"
+)
+
+// Grant describes a request for ssh access to a unit or machine.
+type Grant struct {
+	// UUID uniquely identifies the grant.
+	UUID string
+	// UserName is the name of the user requesting access.
+	UserName string
+	// ModelUUID is the UUID of the model of the target.
+	ModelUUID string
+	// Target is the virtual hostname of the unit or machine.
+	Target string
+	// Reason is why the user requested access.
+	Reason string
+	// Duration is how long access is granted for once approved.
+	Duration time.Duration
+	// Status is the status of the grant.
+	Status GrantStatus
+	// RequestedAt is when access was requested.
+	RequestedAt time.Time
+	// DecidedBy is the name of the user who approved, rejected or revoked
+	// the grant, empty while it is pending.
+	DecidedBy string
+	// DecidedAt is when the grant was approved, rejected or revoked, zero
+	// while it is pending.
+	DecidedAt time.Time
+	// ExpiresAt is when the grant expires, zero until it is approved.
+	ExpiresAt time.Time
+}
+
+// RequestGrantArgs holds the details of a request for ssh access.
+type RequestGrantArgs struct {
+	UserName  string
+	ModelUUID string
+	Target    string
+	Reason    string
+	Duration  time.Duration
+}
+
+// InsertGrantArgs holds the details of a grant to insert into the database.
+type InsertGrantArgs struct {
+	RequestGrantArgs
+	UUID        string
+	RequestedAt time.Time
+}
@@ -0,0 +1,10 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshca provides the service for the controller's ssh user
+// certificate authority. Logged in users are issued short lived certificates
+// for their public keys, bound to their Juju user name, which the
+// controller's embedded SSH server accepts in place of registered public keys.
+// Certificates are recorded when they are issued so that they can be listed
+// and revoked before they expire.
+package sshca
@@ -0,0 +1,31 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// CANotFound describes an error that occurs when the ssh user certificate
+	// authority has not been created yet.
+	CANotFound = errors.ConstError("ssh user certificate authority not found")
+
+	// CertificateNotFound describes an error that occurs when the ssh user
+	// certificate being requested was not issued by the controller.
+	CertificateNotFound = errors.ConstError("ssh user certificate not found")
+
+	// CertificateRevoked describes an error that occurs when the ssh user
+	// certificate has been revoked.
+	CertificateRevoked = errors.ConstError("ssh user certificate revoked")
+
+	// InvalidCertificate describes an error that occurs when an ssh user
+	// certificate was not signed by the controller, has expired or was not
+	// issued to the user presenting it.
+	InvalidCertificate = errors.ConstError("invalid ssh user certificate")
+
+	// InvalidPublicKey describes an error that occurs when a certificate is
+	// requested for a public key which cannot be certified.
+	InvalidPublicKey = errors.ConstError("invalid public key")
+)
@@ -0,0 +1,296 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/juju/clock"
+	gossh "golang.org/x/crypto/ssh"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	"github.com/juju/juju/internal/errors"
+	pkissh "github.com/juju/juju/internal/pki/ssh"
+)
+
+// certificateClockSkew is how long before they are issued certificates are
+// valid from, so that they are accepted straight away by servers whose
+// clocks are slightly behind.
+const certificateClockSkew = time.Minute
+
+// State describes retrieval and persistence methods for the ssh user
+// certificate authority and the certificates it issues.
+type State interface {
+	// GetCA returns the ssh user certificate authority.
+	GetCA(ctx context.Context) (sshca.CA, error)
+
+	// EnsureCA creates the ssh user certificate authority from the
+	// arguments, unless it has already been created, and returns the
+	// authority.
+	EnsureCA(ctx context.Context, arg sshca.InsertCAArgs) (sshca.CA, error)
+
+	// InsertCertificate records a certificate issued by the ssh user
+	// certificate authority.
+	InsertCertificate(ctx context.Context, cert sshca.Certificate) error
+
+	// GetCertificate returns the certificate with the specified serial.
+	GetCertificate(ctx context.Context, serial uint64) (sshca.Certificate, error)
+
+	// ListCertificates returns the certificates issued to the specified
+	// user, or to all users if the user name is empty.
+	ListCertificates(ctx context.Context, userName string) ([]sshca.Certificate, error)
+
+	// RevokeCertificate revokes the certificate with the specified serial.
+	RevokeCertificate(ctx context.Context, serial uint64, revokedBy string, revokedAt time.Time) error
+
+	// RevokeUserCertificates revokes every unexpired certificate issued to
+	// the user, returning the number of certificates revoked.
+	RevokeUserCertificates(ctx context.Context, userName, revokedBy string, revokedAt time.Time) (int, error)
+}
+
+// Service provides the API for the controller's ssh user certificate
+// authority.
+type Service struct {
+	st     State
+	clock  clock.Clock
+	logger logger.Logger
+}
+
+// NewService returns a new Service for issuing and checking ssh user
+// certificates.
+func NewService(st State, clock clock.Clock, logger logger.Logger) *Service {
+	return &Service{
+		st:     st,
+		clock:  clock,
+		logger: logger,
+	}
+}
+
+// CAPublicKey returns the public key of the ssh user certificate authority,
+// in authorized keys format, creating the authority if needed.
+func (s *Service) CAPublicKey(ctx context.Context) (string, error) {
+	ca, err := s.ensureCA(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	return ca.PublicKey, nil
+}
+
+// IssueUserCertificate signs a certificate for the public key, in authorized
+// keys format, which lets the user authenticate to the controller's embedded
+// SSH server for the ttl.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the user name or ttl is not valid.
+// - [sshcaerrors.InvalidPublicKey] if the public key cannot be parsed or is
+// already a certificate.
+func (s *Service) IssueUserCertificate(ctx context.Context, userName, publicKey string, ttl time.Duration) (sshca.IssuedCertificate, error) {
+	if userName == "" {
+		return sshca.IssuedCertificate{}, errors.New("empty user name").Add(coreerrors.NotValid)
+	}
+	if ttl <= 0 {
+		return sshca.IssuedCertificate{}, errors.Errorf("certificate ttl %v not positive", ttl).Add(coreerrors.NotValid)
+	}
+	key, _, _, _, err := gossh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return sshca.IssuedCertificate{}, errors.Errorf("parsing public key: %w", err).Add(sshcaerrors.InvalidPublicKey)
+	}
+	if _, ok := key.(*gossh.Certificate); ok {
+		return sshca.IssuedCertificate{}, errors.New("public key is a certificate").Add(sshcaerrors.InvalidPublicKey)
+	}
+
+	signer, err := s.caSigner(ctx)
+	if err != nil {
+		return sshca.IssuedCertificate{}, errors.Capture(err)
+	}
+
+	serial, err := newSerial()
+	if err != nil {
+		return sshca.IssuedCertificate{}, errors.Capture(err)
+	}
+	now := s.clock.Now().Truncate(time.Second)
+	record := sshca.Certificate{
+		Serial:         serial,
+		UserName:       userName,
+		KeyFingerprint: gossh.FingerprintSHA256(key),
+		ValidAfter:     now.Add(-certificateClockSkew),
+		ValidBefore:    now.Add(ttl),
+	}
+	cert, err := pkissh.NewUserCertificate(signer, key, pkissh.UserCertificateParams{
+		KeyID:       fmt.Sprintf("juju:%s:%d", userName, serial),
+		Serial:      serial,
+		Principals:  []string{userName},
+		ValidAfter:  record.ValidAfter,
+		ValidBefore: record.ValidBefore,
+	})
+	if err != nil {
+		return sshca.IssuedCertificate{}, errors.Errorf("signing certificate for %q: %w", userName, err)
+	}
+
+	if err := s.st.InsertCertificate(ctx, record); err != nil {
+		return sshca.IssuedCertificate{}, errors.Capture(err)
+	}
+	s.logger.Infof(ctx, "issued ssh user certificate %d to %q for key %s, valid until %v",
+		serial, userName, record.KeyFingerprint, record.ValidBefore)
+
+	return sshca.IssuedCertificate{
+		Certificate:           record,
+		AuthorizedCertificate: strings.TrimSpace(string(gossh.MarshalAuthorizedKey(cert))),
+	}, nil
+}
+
+// CheckUserCertificate checks that the certificate presented by the user was
+// issued to them by the controller, has not expired and has not been revoked.
+// The following errors can be returned:
+// - [sshcaerrors.InvalidCertificate] if the certificate was not issued to the
+// user by the controller or has expired.
+// - [sshcaerrors.CertificateRevoked] if the certificate has been revoked.
+func (s *Service) CheckUserCertificate(ctx context.Context, cert *gossh.Certificate, userName string) error {
+	ca, err := s.st.GetCA(ctx)
+	if errors.Is(err, sshcaerrors.CANotFound) {
+		return errors.New("no certificates have been issued").Add(sshcaerrors.InvalidCertificate)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	caKey, _, _, _, err := gossh.ParseAuthorizedKey([]byte(ca.PublicKey))
+	if err != nil {
+		return errors.Errorf("parsing ssh user certificate authority public key: %w", err)
+	}
+
+	if err := pkissh.CheckUserCertificate(caKey, cert, userName, s.clock.Now()); err != nil {
+		return errors.Errorf("checking certificate of %q: %w", userName, err).Add(sshcaerrors.InvalidCertificate)
+	}
+
+	record, err := s.st.GetCertificate(ctx, cert.Serial)
+	if errors.Is(err, sshcaerrors.CertificateNotFound) {
+		return errors.Errorf("certificate %d of %q was not issued", cert.Serial, userName).Add(sshcaerrors.InvalidCertificate)
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	if record.UserName != userName || record.KeyFingerprint != gossh.FingerprintSHA256(cert.Key) {
+		return errors.Errorf("certificate %d was not issued to %q for this key", cert.Serial, userName).Add(sshcaerrors.InvalidCertificate)
+	}
+	if record.Revoked() {
+		return errors.Errorf("certificate %d of %q", cert.Serial, userName).Add(sshcaerrors.CertificateRevoked)
+	}
+	return nil
+}
+
+// GetCertificate returns the certificate with the specified serial.
+// The following errors can be returned:
+// - [sshcaerrors.CertificateNotFound] if no certificate has the serial.
+func (s *Service) GetCertificate(ctx context.Context, serial uint64) (sshca.Certificate, error) {
+	cert, err := s.st.GetCertificate(ctx, serial)
+	return cert, errors.Capture(err)
+}
+
+// ListCertificates returns the certificates issued to the specified user, or
+// to all users if the user name is empty, in the order they were issued.
+func (s *Service) ListCertificates(ctx context.Context, userName string) ([]sshca.Certificate, error) {
+	certs, err := s.st.ListCertificates(ctx, userName)
+	return certs, errors.Capture(err)
+}
+
+// RevokeCertificate revokes the certificate with the specified serial, so
+// that it is no longer accepted by the embedded SSH server.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the revoking user name is empty.
+// - [sshcaerrors.CertificateNotFound] if no certificate has the serial.
+// - [sshcaerrors.CertificateRevoked] if the certificate has already been
+// revoked.
+func (s *Service) RevokeCertificate(ctx context.Context, serial uint64, revokedBy string) error {
+	if revokedBy == "" {
+		return errors.New("empty revoking user name").Add(coreerrors.NotValid)
+	}
+	if err := s.st.RevokeCertificate(ctx, serial, revokedBy, s.clock.Now()); err != nil {
+		return errors.Capture(err)
+	}
+	s.logger.Infof(ctx, "ssh user certificate %d revoked by %q", serial, revokedBy)
+	return nil
+}
+
+// RevokeUserCertificates revokes every unexpired certificate issued to the
+// user, returning the number of certificates revoked.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the user name or revoking user name is empty.
+func (s *Service) RevokeUserCertificates(ctx context.Context, userName, revokedBy string) (int, error) {
+	if userName == "" {
+		return 0, errors.New("empty user name").Add(coreerrors.NotValid)
+	}
+	if revokedBy == "" {
+		return 0, errors.New("empty revoking user name").Add(coreerrors.NotValid)
+	}
+	revoked, err := s.st.RevokeUserCertificates(ctx, userName, revokedBy, s.clock.Now())
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+	s.logger.Infof(ctx, "%d ssh user certificates of %q revoked by %q", revoked, userName, revokedBy)
+	return revoked, nil
+}
+
+// ensureCA returns the ssh user certificate authority, creating it the first
+// time it is needed.
+func (s *Service) ensureCA(ctx context.Context) (sshca.CA, error) {
+	ca, err := s.st.GetCA(ctx)
+	if err == nil {
+		return ca, nil
+	} else if !errors.Is(err, sshcaerrors.CANotFound) {
+		return sshca.CA{}, errors.Capture(err)
+	}
+
+	key, err := pkissh.ED25519()
+	if err != nil {
+		return sshca.CA{}, errors.Errorf("generating ssh user certificate authority key: %w", err)
+	}
+	privateKey, err := pkissh.MarshalPrivateKey(key)
+	if err != nil {
+		return sshca.CA{}, errors.Capture(err)
+	}
+	signer, err := gossh.NewSignerFromKey(key)
+	if err != nil {
+		return sshca.CA{}, errors.Capture(err)
+	}
+	ca, err = s.st.EnsureCA(ctx, sshca.InsertCAArgs{
+		CA: sshca.CA{
+			PrivateKey: string(privateKey),
+			PublicKey:  strings.TrimSpace(string(gossh.MarshalAuthorizedKey(signer.PublicKey()))),
+		},
+		CreatedAt: s.clock.Now(),
+	})
+	return ca, errors.Capture(err)
+}
+
+// caSigner returns a signer for the ssh user certificate authority.
+func (s *Service) caSigner(ctx context.Context) (gossh.Signer, error) {
+	ca, err := s.ensureCA(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	signer, err := gossh.ParsePrivateKey([]byte(ca.PrivateKey))
+	if err != nil {
+		return nil, errors.Errorf("parsing ssh user certificate authority private key: %w", err)
+	}
+	return signer, nil
+}
+
+// newSerial returns a random, non-zero certificate serial within the range
+// of a signed integer.
+func newSerial() (uint64, error) {
+	var b [8]byte
+	for {
+		if _, err := rand.Read(b[:]); err != nil {
+			return 0, errors.Errorf("generating certificate serial: %w", err)
+		}
+		if serial := binary.BigEndian.Uint64(b[:]) >> 1; serial != 0 {
+			return serial, nil
+		}
+	}
+}
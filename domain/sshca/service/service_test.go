@@ -0,0 +1,282 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gossh "golang.org/x/crypto/ssh"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	pkissh "github.com/juju/juju/internal/pki/ssh"
+)
+
+type serviceSuite struct {
+	state *MockState
+	clock *testclock.Clock
+
+	ca        sshca.CA
+	userKey   gossh.PublicKey
+	publicKey string
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) SetUpSuite(c *gc.C) {
+	s.ca = newCA(c)
+	signer := newSigner(c)
+	s.userKey = signer.PublicKey()
+	s.publicKey = string(gossh.MarshalAuthorizedKey(s.userKey))
+}
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC))
+	return ctrl
+}
+
+func (s *serviceSuite) service(c *gc.C) *Service {
+	return NewService(s.state, s.clock, loggertesting.WrapCheckLog(c))
+}
+
+func newSigner(c *gc.C) gossh.Signer {
+	key, err := pkissh.ED25519()
+	c.Assert(err, jc.ErrorIsNil)
+	signer, err := gossh.NewSignerFromKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	return signer
+}
+
+func newCA(c *gc.C) sshca.CA {
+	key, err := pkissh.ED25519()
+	c.Assert(err, jc.ErrorIsNil)
+	privateKey, err := pkissh.MarshalPrivateKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	signer, err := gossh.NewSignerFromKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	return sshca.CA{
+		PrivateKey: string(privateKey),
+		PublicKey:  strings.TrimSpace(string(gossh.MarshalAuthorizedKey(signer.PublicKey()))),
+	}
+}
+
+// issue issues a certificate for the user key, returning the parsed
+// certificate and the record inserted into state.
+func (s *serviceSuite) issue(c *gc.C, svc *Service, userName string) (*gossh.Certificate, sshca.Certificate) {
+	var record sshca.Certificate
+	s.state.EXPECT().GetCA(gomock.Any()).Return(s.ca, nil)
+	s.state.EXPECT().InsertCertificate(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, cert sshca.Certificate) error {
+			record = cert
+			return nil
+		})
+
+	issued, err := svc.IssueUserCertificate(context.Background(), userName, s.publicKey, time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(issued.Certificate, jc.DeepEquals, record)
+
+	key, _, _, _, err := gossh.ParseAuthorizedKey([]byte(issued.AuthorizedCertificate))
+	c.Assert(err, jc.ErrorIsNil)
+	cert, ok := key.(*gossh.Certificate)
+	c.Assert(ok, jc.IsTrue)
+	return cert, record
+}
+
+func (s *serviceSuite) TestCAPublicKeyCreatesAuthority(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(sshca.CA{}, sshcaerrors.CANotFound)
+	s.state.EXPECT().EnsureCA(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg sshca.InsertCAArgs) (sshca.CA, error) {
+			c.Check(arg.CreatedAt, gc.Equals, s.clock.Now())
+			signer, err := gossh.ParsePrivateKey([]byte(arg.PrivateKey))
+			c.Assert(err, jc.ErrorIsNil)
+			c.Check(strings.TrimSpace(string(gossh.MarshalAuthorizedKey(signer.PublicKey()))), gc.Equals, arg.PublicKey)
+			return arg.CA, nil
+		})
+
+	publicKey, err := s.service(c).CAPublicKey(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(publicKey, gc.Matches, "ssh-ed25519 .*")
+}
+
+func (s *serviceSuite) TestCAPublicKeyExistingAuthority(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(s.ca, nil)
+
+	publicKey, err := s.service(c).CAPublicKey(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(publicKey, gc.Equals, s.ca.PublicKey)
+}
+
+func (s *serviceSuite) TestIssueUserCertificate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cert, record := s.issue(c, s.service(c), "bob")
+
+	c.Check(record.UserName, gc.Equals, "bob")
+	c.Check(record.Serial, gc.Not(gc.Equals), uint64(0))
+	c.Check(record.KeyFingerprint, gc.Equals, gossh.FingerprintSHA256(s.userKey))
+	c.Check(record.ValidAfter, gc.Equals, s.clock.Now().Add(-time.Minute))
+	c.Check(record.ValidBefore, gc.Equals, s.clock.Now().Add(time.Hour))
+
+	c.Check(cert.Serial, gc.Equals, record.Serial)
+	c.Check(cert.ValidPrincipals, jc.DeepEquals, []string{"bob"})
+	c.Check(cert.Key.Marshal(), jc.DeepEquals, s.userKey.Marshal())
+	c.Check(cert.ValidBefore, gc.Equals, uint64(record.ValidBefore.Unix()))
+}
+
+func (s *serviceSuite) TestIssueUserCertificateNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).IssueUserCertificate(context.Background(), "", s.publicKey, time.Hour)
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+
+	_, err = s.service(c).IssueUserCertificate(context.Background(), "bob", s.publicKey, 0)
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+
+	_, err = s.service(c).IssueUserCertificate(context.Background(), "bob", "not a key", time.Hour)
+	c.Check(err, jc.ErrorIs, sshcaerrors.InvalidPublicKey)
+}
+
+func (s *serviceSuite) TestIssueUserCertificateForCertificate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cert, _ := s.issue(c, s.service(c), "bob")
+
+	_, err := s.service(c).IssueUserCertificate(
+		context.Background(), "bob", string(gossh.MarshalAuthorizedKey(cert)), time.Hour)
+	c.Check(err, jc.ErrorIs, sshcaerrors.InvalidPublicKey)
+}
+
+func (s *serviceSuite) TestCheckUserCertificate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.service(c)
+	cert, record := s.issue(c, svc, "bob")
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(s.ca, nil)
+	s.state.EXPECT().GetCertificate(gomock.Any(), record.Serial).Return(record, nil)
+
+	err := svc.CheckUserCertificate(context.Background(), cert, "bob")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCheckUserCertificateOtherUser(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.service(c)
+	cert, _ := s.issue(c, svc, "bob")
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(s.ca, nil)
+
+	err := svc.CheckUserCertificate(context.Background(), cert, "alice")
+	c.Assert(err, jc.ErrorIs, sshcaerrors.InvalidCertificate)
+}
+
+func (s *serviceSuite) TestCheckUserCertificateExpired(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.service(c)
+	cert, _ := s.issue(c, svc, "bob")
+	s.clock.Advance(2 * time.Hour)
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(s.ca, nil)
+
+	err := svc.CheckUserCertificate(context.Background(), cert, "bob")
+	c.Assert(err, jc.ErrorIs, sshcaerrors.InvalidCertificate)
+}
+
+func (s *serviceSuite) TestCheckUserCertificateOtherAuthority(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.service(c)
+	cert, _ := s.issue(c, svc, "bob")
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(newCA(c), nil)
+
+	err := svc.CheckUserCertificate(context.Background(), cert, "bob")
+	c.Assert(err, jc.ErrorIs, sshcaerrors.InvalidCertificate)
+}
+
+func (s *serviceSuite) TestCheckUserCertificateNotRecorded(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.service(c)
+	cert, record := s.issue(c, svc, "bob")
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(s.ca, nil)
+	s.state.EXPECT().GetCertificate(gomock.Any(), record.Serial).Return(sshca.Certificate{}, sshcaerrors.CertificateNotFound)
+
+	err := svc.CheckUserCertificate(context.Background(), cert, "bob")
+	c.Assert(err, jc.ErrorIs, sshcaerrors.InvalidCertificate)
+}
+
+func (s *serviceSuite) TestCheckUserCertificateRevoked(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := s.service(c)
+	cert, record := s.issue(c, svc, "bob")
+	record.RevokedBy = "admin"
+	record.RevokedAt = s.clock.Now()
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(s.ca, nil)
+	s.state.EXPECT().GetCertificate(gomock.Any(), record.Serial).Return(record, nil)
+
+	err := svc.CheckUserCertificate(context.Background(), cert, "bob")
+	c.Assert(err, jc.ErrorIs, sshcaerrors.CertificateRevoked)
+}
+
+func (s *serviceSuite) TestCheckUserCertificateNoAuthority(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cert, _ := s.issue(c, s.service(c), "bob")
+
+	s.state.EXPECT().GetCA(gomock.Any()).Return(sshca.CA{}, sshcaerrors.CANotFound)
+
+	err := s.service(c).CheckUserCertificate(context.Background(), cert, "bob")
+	c.Assert(err, jc.ErrorIs, sshcaerrors.InvalidCertificate)
+}
+
+func (s *serviceSuite) TestRevokeCertificate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RevokeCertificate(gomock.Any(), uint64(42), "admin", s.clock.Now()).Return(nil)
+
+	err := s.service(c).RevokeCertificate(context.Background(), 42, "admin")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRevokeCertificateNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RevokeCertificate(gomock.Any(), uint64(42), "admin", s.clock.Now()).Return(sshcaerrors.CertificateNotFound)
+
+	err := s.service(c).RevokeCertificate(context.Background(), 42, "admin")
+	c.Assert(err, jc.ErrorIs, sshcaerrors.CertificateNotFound)
+}
+
+func (s *serviceSuite) TestRevokeUserCertificates(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RevokeUserCertificates(gomock.Any(), "bob", "admin", s.clock.Now()).Return(2, nil)
+
+	revoked, err := s.service(c).RevokeUserCertificates(context.Background(), "bob", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(revoked, gc.Equals, 2)
+
+	_, err = s.service(c).RevokeUserCertificates(context.Background(), "", "admin")
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+}
@@ -0,0 +1,313 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/sshca/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/sshca/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	sshca "github.com/juju/juju/domain/sshca"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// EnsureCA mocks base method.
+func (m *MockState) EnsureCA(arg0 context.Context, arg1 sshca.InsertCAArgs) (sshca.CA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureCA", arg0, arg1)
+	ret0, _ := ret[0].(sshca.CA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureCA indicates an expected call of EnsureCA.
+func (mr *MockStateMockRecorder) EnsureCA(arg0, arg1 any) *MockStateEnsureCACall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureCA", reflect.TypeOf((*MockState)(nil).EnsureCA), arg0, arg1)
+	return &MockStateEnsureCACall{Call: call}
+}
+
+// MockStateEnsureCACall wrap *gomock.Call
+type MockStateEnsureCACall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateEnsureCACall) Return(arg0 sshca.CA, arg1 error) *MockStateEnsureCACall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateEnsureCACall) Do(f func(context.Context, sshca.InsertCAArgs) (sshca.CA, error)) *MockStateEnsureCACall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateEnsureCACall) DoAndReturn(f func(context.Context, sshca.InsertCAArgs) (sshca.CA, error)) *MockStateEnsureCACall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCA mocks base method.
+func (m *MockState) GetCA(arg0 context.Context) (sshca.CA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCA", arg0)
+	ret0, _ := ret[0].(sshca.CA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCA indicates an expected call of GetCA.
+func (mr *MockStateMockRecorder) GetCA(arg0 any) *MockStateGetCACall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCA", reflect.TypeOf((*MockState)(nil).GetCA), arg0)
+	return &MockStateGetCACall{Call: call}
+}
+
+// MockStateGetCACall wrap *gomock.Call
+type MockStateGetCACall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetCACall) Return(arg0 sshca.CA, arg1 error) *MockStateGetCACall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetCACall) Do(f func(context.Context) (sshca.CA, error)) *MockStateGetCACall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetCACall) DoAndReturn(f func(context.Context) (sshca.CA, error)) *MockStateGetCACall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCertificate mocks base method.
+func (m *MockState) GetCertificate(arg0 context.Context, arg1 uint64) (sshca.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificate", arg0, arg1)
+	ret0, _ := ret[0].(sshca.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCertificate indicates an expected call of GetCertificate.
+func (mr *MockStateMockRecorder) GetCertificate(arg0, arg1 any) *MockStateGetCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificate", reflect.TypeOf((*MockState)(nil).GetCertificate), arg0, arg1)
+	return &MockStateGetCertificateCall{Call: call}
+}
+
+// MockStateGetCertificateCall wrap *gomock.Call
+type MockStateGetCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetCertificateCall) Return(arg0 sshca.Certificate, arg1 error) *MockStateGetCertificateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetCertificateCall) Do(f func(context.Context, uint64) (sshca.Certificate, error)) *MockStateGetCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetCertificateCall) DoAndReturn(f func(context.Context, uint64) (sshca.Certificate, error)) *MockStateGetCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InsertCertificate mocks base method.
+func (m *MockState) InsertCertificate(arg0 context.Context, arg1 sshca.Certificate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertCertificate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertCertificate indicates an expected call of InsertCertificate.
+func (mr *MockStateMockRecorder) InsertCertificate(arg0, arg1 any) *MockStateInsertCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertCertificate", reflect.TypeOf((*MockState)(nil).InsertCertificate), arg0, arg1)
+	return &MockStateInsertCertificateCall{Call: call}
+}
+
+// MockStateInsertCertificateCall wrap *gomock.Call
+type MockStateInsertCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInsertCertificateCall) Return(arg0 error) *MockStateInsertCertificateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInsertCertificateCall) Do(f func(context.Context, sshca.Certificate) error) *MockStateInsertCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInsertCertificateCall) DoAndReturn(f func(context.Context, sshca.Certificate) error) *MockStateInsertCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListCertificates mocks base method.
+func (m *MockState) ListCertificates(arg0 context.Context, arg1 string) ([]sshca.Certificate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificates", arg0, arg1)
+	ret0, _ := ret[0].([]sshca.Certificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCertificates indicates an expected call of ListCertificates.
+func (mr *MockStateMockRecorder) ListCertificates(arg0, arg1 any) *MockStateListCertificatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificates", reflect.TypeOf((*MockState)(nil).ListCertificates), arg0, arg1)
+	return &MockStateListCertificatesCall{Call: call}
+}
+
+// MockStateListCertificatesCall wrap *gomock.Call
+type MockStateListCertificatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListCertificatesCall) Return(arg0 []sshca.Certificate, arg1 error) *MockStateListCertificatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListCertificatesCall) Do(f func(context.Context, string) ([]sshca.Certificate, error)) *MockStateListCertificatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListCertificatesCall) DoAndReturn(f func(context.Context, string) ([]sshca.Certificate, error)) *MockStateListCertificatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeCertificate mocks base method.
+func (m *MockState) RevokeCertificate(arg0 context.Context, arg1 uint64, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCertificate indicates an expected call of RevokeCertificate.
+func (mr *MockStateMockRecorder) RevokeCertificate(arg0, arg1, arg2, arg3 any) *MockStateRevokeCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificate", reflect.TypeOf((*MockState)(nil).RevokeCertificate), arg0, arg1, arg2, arg3)
+	return &MockStateRevokeCertificateCall{Call: call}
+}
+
+// MockStateRevokeCertificateCall wrap *gomock.Call
+type MockStateRevokeCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRevokeCertificateCall) Return(arg0 error) *MockStateRevokeCertificateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRevokeCertificateCall) Do(f func(context.Context, uint64, string, time.Time) error) *MockStateRevokeCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRevokeCertificateCall) DoAndReturn(f func(context.Context, uint64, string, time.Time) error) *MockStateRevokeCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeUserCertificates mocks base method.
+func (m *MockState) RevokeUserCertificates(arg0 context.Context, arg1, arg2 string, arg3 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserCertificates", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeUserCertificates indicates an expected call of RevokeUserCertificates.
+func (mr *MockStateMockRecorder) RevokeUserCertificates(arg0, arg1, arg2, arg3 any) *MockStateRevokeUserCertificatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserCertificates", reflect.TypeOf((*MockState)(nil).RevokeUserCertificates), arg0, arg1, arg2, arg3)
+	return &MockStateRevokeUserCertificatesCall{Call: call}
+}
+
+// MockStateRevokeUserCertificatesCall wrap *gomock.Call
+type MockStateRevokeUserCertificatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRevokeUserCertificatesCall) Return(arg0 int, arg1 error) *MockStateRevokeUserCertificatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRevokeUserCertificatesCall) Do(f func(context.Context, string, string, time.Time) (int, error)) *MockStateRevokeUserCertificatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRevokeUserCertificatesCall) DoAndReturn(f func(context.Context, string, string, time.Time) (int, error)) *MockStateRevokeUserCertificatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,308 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State is used to access the database.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState creates a state to access the database.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// GetCA returns the ssh user certificate authority.
+// The following errors can be returned:
+// - [sshcaerrors.CANotFound] if the authority has not been created.
+func (st *State) GetCA(ctx context.Context) (sshca.CA, error) {
+	db, err := st.DB()
+	if err != nil {
+		return sshca.CA{}, errors.Capture(err)
+	}
+
+	var result userCA
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		result, err = st.getCA(ctx, tx)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return sshca.CA{}, errors.Capture(err)
+	}
+	return sshca.CA{
+		PrivateKey: result.PrivateKey,
+		PublicKey:  result.PublicKey,
+	}, nil
+}
+
+// EnsureCA creates the ssh user certificate authority from the arguments,
+// unless it has already been created, and returns the authority. Concurrent
+// callers all get the authority which was created first.
+func (st *State) EnsureCA(ctx context.Context, arg sshca.InsertCAArgs) (sshca.CA, error) {
+	db, err := st.DB()
+	if err != nil {
+		return sshca.CA{}, errors.Capture(err)
+	}
+
+	row := userCA{
+		PrivateKey: arg.PrivateKey,
+		PublicKey:  arg.PublicKey,
+		CreatedAt:  arg.CreatedAt.UTC(),
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO ssh_user_ca (*)
+VALUES ($userCA.*)
+ON CONFLICT DO NOTHING
+`, row)
+	if err != nil {
+		return sshca.CA{}, errors.Capture(err)
+	}
+
+	var result userCA
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, insertStmt, row).Run(); err != nil {
+			return errors.Errorf("inserting ssh user certificate authority: %w", err)
+		}
+		var err error
+		result, err = st.getCA(ctx, tx)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return sshca.CA{}, errors.Capture(err)
+	}
+	return sshca.CA{
+		PrivateKey: result.PrivateKey,
+		PublicKey:  result.PublicKey,
+	}, nil
+}
+
+// InsertCertificate records a certificate issued by the ssh user certificate
+// authority.
+func (st *State) InsertCertificate(ctx context.Context, cert sshca.Certificate) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := userCertificate{
+		Serial:         int64(cert.Serial),
+		UserName:       cert.UserName,
+		KeyFingerprint: cert.KeyFingerprint,
+		ValidAfter:     cert.ValidAfter.UTC(),
+		ValidBefore:    cert.ValidBefore.UTC(),
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO ssh_user_certificate (*)
+VALUES ($userCertificate.*)
+`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return tx.Query(ctx, stmt, row).Run()
+	})
+	if err != nil {
+		return errors.Errorf("inserting ssh user certificate %d: %w", cert.Serial, err)
+	}
+	return nil
+}
+
+// GetCertificate returns the certificate with the specified serial.
+// The following errors can be returned:
+// - [sshcaerrors.CertificateNotFound] if no certificate has the serial.
+func (st *State) GetCertificate(ctx context.Context, serial uint64) (sshca.Certificate, error) {
+	db, err := st.DB()
+	if err != nil {
+		return sshca.Certificate{}, errors.Capture(err)
+	}
+
+	var result userCertificate
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var err error
+		result, err = st.getCertificate(ctx, tx, serial)
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return sshca.Certificate{}, errors.Capture(err)
+	}
+	return result.toCertificate(), nil
+}
+
+// ListCertificates returns the certificates issued to the specified user, or
+// to all users if the user name is empty, in the order they were issued.
+func (st *State) ListCertificates(ctx context.Context, userName string) ([]sshca.Certificate, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := userIdent{UserName: userName}
+	stmt, err := st.Prepare(`
+SELECT &userCertificate.*
+FROM   ssh_user_certificate
+WHERE  $userIdent.user_name = '' OR user_name = $userIdent.user_name
+ORDER BY valid_after, serial
+`, ident, userCertificate{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []userCertificate
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing ssh user certificates: %w", err)
+	}
+
+	certs := make([]sshca.Certificate, len(rows))
+	for i, row := range rows {
+		certs[i] = row.toCertificate()
+	}
+	return certs, nil
+}
+
+// RevokeCertificate revokes the certificate with the specified serial.
+// The following errors can be returned:
+// - [sshcaerrors.CertificateNotFound] if no certificate has the serial.
+// - [sshcaerrors.CertificateRevoked] if the certificate has already been
+// revoked.
+func (st *State) RevokeCertificate(ctx context.Context, serial uint64, revokedBy string, revokedAt time.Time) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	arg := revocation{
+		Serial:    int64(serial),
+		RevokedBy: revokedBy,
+		RevokedAt: revokedAt.UTC(),
+	}
+	stmt, err := st.Prepare(`
+UPDATE ssh_user_certificate
+SET    revoked_by = $revocation.revoked_by,
+       revoked_at = $revocation.revoked_at
+WHERE  serial = $revocation.serial
+`, arg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		current, err := st.getCertificate(ctx, tx, serial)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if current.RevokedAt.Valid {
+			return errors.Errorf("ssh user certificate %d", serial).Add(sshcaerrors.CertificateRevoked)
+		}
+		if err := tx.Query(ctx, stmt, arg).Run(); err != nil {
+			return errors.Errorf("revoking ssh user certificate %d: %w", serial, err)
+		}
+		return nil
+	})
+}
+
+// RevokeUserCertificates revokes every certificate issued to the user which
+// has not been revoked and has not expired at the time of revocation. It
+// returns the number of certificates revoked.
+func (st *State) RevokeUserCertificates(ctx context.Context, userName, revokedBy string, revokedAt time.Time) (int, error) {
+	db, err := st.DB()
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	arg := revocation{
+		UserName:  userName,
+		RevokedBy: revokedBy,
+		RevokedAt: revokedAt.UTC(),
+	}
+	stmt, err := st.Prepare(`
+UPDATE ssh_user_certificate
+SET    revoked_by = $revocation.revoked_by,
+       revoked_at = $revocation.revoked_at
+WHERE  user_name = $revocation.user_name
+AND    revoked_at IS NULL
+AND    valid_before > $revocation.revoked_at
+`, arg)
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	var revoked int64
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, arg).Get(&outcome); err != nil {
+			return errors.Capture(err)
+		}
+		var err error
+		revoked, err = outcome.Result().RowsAffected()
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return 0, errors.Errorf("revoking ssh user certificates of %q: %w", userName, err)
+	}
+	return int(revoked), nil
+}
+
+func (st *State) getCA(ctx context.Context, tx *sqlair.TX) (userCA, error) {
+	stmt, err := st.Prepare(`
+SELECT &userCA.*
+FROM   ssh_user_ca
+`, userCA{})
+	if err != nil {
+		return userCA{}, errors.Capture(err)
+	}
+
+	var result userCA
+	err = tx.Query(ctx, stmt).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return userCA{}, errors.Capture(sshcaerrors.CANotFound)
+	} else if err != nil {
+		return userCA{}, errors.Errorf("getting ssh user certificate authority: %w", err)
+	}
+	return result, nil
+}
+
+func (st *State) getCertificate(ctx context.Context, tx *sqlair.TX, serial uint64) (userCertificate, error) {
+	ident := serialIdent{Serial: int64(serial)}
+	stmt, err := st.Prepare(`
+SELECT &userCertificate.*
+FROM   ssh_user_certificate
+WHERE  serial = $serialIdent.serial
+`, ident, userCertificate{})
+	if err != nil {
+		return userCertificate{}, errors.Capture(err)
+	}
+
+	var result userCertificate
+	err = tx.Query(ctx, stmt, ident).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return userCertificate{}, errors.Errorf("ssh user certificate %d", serial).Add(sshcaerrors.CertificateNotFound)
+	} else if err != nil {
+		return userCertificate{}, errors.Errorf("getting ssh user certificate %d: %w", serial, err)
+	}
+	return result, nil
+}
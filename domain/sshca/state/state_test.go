@@ -0,0 +1,159 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/domain/sshca"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+
+	state *State
+
+	now time.Time
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory())
+	s.now = time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+}
+
+func (s *stateSuite) TestGetCANotFound(c *gc.C) {
+	_, err := s.state.GetCA(context.Background())
+	c.Assert(err, jc.ErrorIs, sshcaerrors.CANotFound)
+}
+
+func (s *stateSuite) TestEnsureCA(c *gc.C) {
+	ca, err := s.state.EnsureCA(context.Background(), sshca.InsertCAArgs{
+		CA:        sshca.CA{PrivateKey: "private-1", PublicKey: "public-1"},
+		CreatedAt: s.now,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ca, jc.DeepEquals, sshca.CA{PrivateKey: "private-1", PublicKey: "public-1"})
+
+	// The first authority is kept.
+	ca, err = s.state.EnsureCA(context.Background(), sshca.InsertCAArgs{
+		CA:        sshca.CA{PrivateKey: "private-2", PublicKey: "public-2"},
+		CreatedAt: s.now.Add(time.Minute),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ca, jc.DeepEquals, sshca.CA{PrivateKey: "private-1", PublicKey: "public-1"})
+
+	ca, err = s.state.GetCA(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(ca, jc.DeepEquals, sshca.CA{PrivateKey: "private-1", PublicKey: "public-1"})
+}
+
+func (s *stateSuite) TestInsertAndGetCertificate(c *gc.C) {
+	cert := s.insertCertificate(c, 42, "bob", s.now)
+
+	got, err := s.state.GetCertificate(context.Background(), 42)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, cert)
+	c.Check(got.Revoked(), jc.IsFalse)
+}
+
+func (s *stateSuite) TestInsertCertificateLargeSerial(c *gc.C) {
+	cert := s.insertCertificate(c, 1<<62, "bob", s.now)
+
+	got, err := s.state.GetCertificate(context.Background(), 1<<62)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, cert)
+}
+
+func (s *stateSuite) TestGetCertificateNotFound(c *gc.C) {
+	_, err := s.state.GetCertificate(context.Background(), 42)
+	c.Assert(err, jc.ErrorIs, sshcaerrors.CertificateNotFound)
+}
+
+func (s *stateSuite) TestListCertificates(c *gc.C) {
+	bob1 := s.insertCertificate(c, 3, "bob", s.now)
+	alice := s.insertCertificate(c, 1, "alice", s.now.Add(time.Minute))
+	bob2 := s.insertCertificate(c, 2, "bob", s.now.Add(2*time.Minute))
+
+	certs, err := s.state.ListCertificates(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(certs, jc.DeepEquals, []sshca.Certificate{bob1, alice, bob2})
+
+	certs, err = s.state.ListCertificates(context.Background(), "bob")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(certs, jc.DeepEquals, []sshca.Certificate{bob1, bob2})
+
+	certs, err = s.state.ListCertificates(context.Background(), "mary")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(certs, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestRevokeCertificate(c *gc.C) {
+	s.insertCertificate(c, 42, "bob", s.now)
+
+	err := s.state.RevokeCertificate(context.Background(), 42, "admin", s.now.Add(time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := s.state.GetCertificate(context.Background(), 42)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got.Revoked(), jc.IsTrue)
+	c.Check(got.RevokedBy, gc.Equals, "admin")
+	c.Check(got.RevokedAt, gc.Equals, s.now.Add(time.Minute))
+
+	err = s.state.RevokeCertificate(context.Background(), 42, "admin", s.now.Add(time.Minute))
+	c.Assert(err, jc.ErrorIs, sshcaerrors.CertificateRevoked)
+}
+
+func (s *stateSuite) TestRevokeCertificateNotFound(c *gc.C) {
+	err := s.state.RevokeCertificate(context.Background(), 42, "admin", s.now)
+	c.Assert(err, jc.ErrorIs, sshcaerrors.CertificateNotFound)
+}
+
+func (s *stateSuite) TestRevokeUserCertificates(c *gc.C) {
+	// Expired before the revocation.
+	s.insertCertificate(c, 1, "bob", s.now.Add(-2*time.Hour))
+	s.insertCertificate(c, 2, "bob", s.now)
+	s.insertCertificate(c, 3, "bob", s.now)
+	s.insertCertificate(c, 4, "alice", s.now)
+	err := s.state.RevokeCertificate(context.Background(), 3, "bob", s.now)
+	c.Assert(err, jc.ErrorIsNil)
+
+	revoked, err := s.state.RevokeUserCertificates(context.Background(), "bob", "admin", s.now.Add(time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(revoked, gc.Equals, 1)
+
+	certs, err := s.state.ListCertificates(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	revokedBy := make(map[uint64]string)
+	for _, cert := range certs {
+		revokedBy[cert.Serial] = cert.RevokedBy
+	}
+	c.Check(revokedBy, jc.DeepEquals, map[uint64]string{
+		1: "",
+		2: "admin",
+		3: "bob",
+		4: "",
+	})
+}
+
+func (s *stateSuite) insertCertificate(c *gc.C, serial uint64, userName string, validAfter time.Time) sshca.Certificate {
+	cert := sshca.Certificate{
+		Serial:         serial,
+		UserName:       userName,
+		KeyFingerprint: "SHA256:fingerprint",
+		ValidAfter:     validAfter,
+		ValidBefore:    validAfter.Add(time.Hour),
+	}
+	err := s.state.InsertCertificate(context.Background(), cert)
+	c.Assert(err, jc.ErrorIsNil)
+	return cert
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/juju/juju/domain/sshca"
+)
+
+// userCA represents the row of the ssh_user_ca table.
+type userCA struct {
+	PrivateKey string    `db:"private_key"`
+	PublicKey  string    `db:"public_key"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// userCertificate represents a row of the ssh_user_certificate table.
+// Serials are generated within the range of a signed integer, so that they
+// can be stored in an INT column.
+type userCertificate struct {
+	Serial         int64          `db:"serial"`
+	UserName       string         `db:"user_name"`
+	KeyFingerprint string         `db:"key_fingerprint"`
+	ValidAfter     time.Time      `db:"valid_after"`
+	ValidBefore    time.Time      `db:"valid_before"`
+	RevokedBy      sql.NullString `db:"revoked_by"`
+	RevokedAt      sql.NullTime   `db:"revoked_at"`
+}
+
+// revocation represents the columns of the ssh_user_certificate table which
+// are set when certificates are revoked.
+type revocation struct {
+	Serial    int64     `db:"serial"`
+	UserName  string    `db:"user_name"`
+	RevokedBy string    `db:"revoked_by"`
+	RevokedAt time.Time `db:"revoked_at"`
+}
+
+// serialIdent identifies a certificate.
+type serialIdent struct {
+	Serial int64 `db:"serial"`
+}
+
+// userIdent identifies the certificates of a user.
+type userIdent struct {
+	UserName string `db:"user_name"`
+}
+
+func (c userCertificate) toCertificate() sshca.Certificate {
+	return sshca.Certificate{
+		Serial:         uint64(c.Serial),
+		UserName:       c.UserName,
+		KeyFingerprint: c.KeyFingerprint,
+		ValidAfter:     c.ValidAfter,
+		ValidBefore:    c.ValidBefore,
+		RevokedBy:      c.RevokedBy.String,
+		RevokedAt:      c.RevokedAt.Time,
+	}
+}
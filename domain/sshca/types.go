@@ -0,0 +1,59 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshca
+
+import (
+	"time"
+)
+
+// CA holds the keys of the ssh user certificate authority.
+type CA struct {
+	// PrivateKey is the PEM encoded private key of the authority.
+	PrivateKey string
+	// PublicKey is the public key of the authority, in authorized keys
+	// format.
+	PublicKey string
+}
+
+// Certificate describes a certificate issued by the ssh user certificate
+// authority.
+type Certificate struct {
+	// Serial is the serial number of the certificate, which uniquely
+	// identifies it.
+	Serial uint64
+	// UserName is the name of the user the certificate was issued to, and
+	// its only principal.
+	UserName string
+	// KeyFingerprint is the SHA256 fingerprint of the certified public key.
+	KeyFingerprint string
+	// ValidAfter and ValidBefore bound the time the certificate is valid.
+	ValidAfter  time.Time
+	ValidBefore time.Time
+	// RevokedBy is the name of the user who revoked the certificate, empty
+	// unless it has been revoked.
+	RevokedBy string
+	// RevokedAt is when the certificate was revoked, zero unless it has
+	// been revoked.
+	RevokedAt time.Time
+}
+
+// Revoked returns whether the certificate has been revoked.
+func (c Certificate) Revoked() bool {
+	return !c.RevokedAt.IsZero()
+}
+
+// IssuedCertificate holds a newly issued certificate.
+type IssuedCertificate struct {
+	Certificate
+	// AuthorizedCertificate is the signed certificate in authorized keys
+	// format, as written to the -cert.pub file of a key.
+	AuthorizedCertificate string
+}
+
+// InsertCAArgs holds the details of a certificate authority to insert into
+// the database.
+type InsertCAArgs struct {
+	CA
+	CreatedAt time.Time
+}
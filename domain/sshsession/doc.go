@@ -0,0 +1,9 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package sshsession provides the service for keeping the recordings of the
+// SSH sessions to units and machines proxied by the controller's embedded SSH
+// server. Recordings are held in the controller's object store, and the details
+// of each session in the controller database, so auditors can list the
+// sessions to a model and replay them.
+package sshsession
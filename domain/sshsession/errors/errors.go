@@ -0,0 +1,18 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// SessionNotFound describes an error that occurs when the SSH session
+	// being requested does not exist.
+	SessionNotFound = errors.ConstError("ssh session not found")
+
+	// ObjectNotFound describes an error that occurs when the recording of a
+	// session does not exist in the object store.
+	ObjectNotFound = errors.ConstError("ssh session recording not found")
+)
@@ -0,0 +1,156 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"io"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/sshsession"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// State describes retrieval and persistence methods for the details of SSH
+// sessions.
+type State interface {
+	// InsertSession records the details of a session whose recording is
+	// held in the object store.
+	InsertSession(context.Context, sshsession.InsertSessionArgs) error
+
+	// ListSessions returns the sessions to units and machines of the
+	// specified model, in the order they started.
+	ListSessions(ctx context.Context, modelUUID string) ([]sshsession.Session, error)
+
+	// GetSessionRecording returns the specified session to a unit or
+	// machine of the specified model, along with the path of its recording.
+	GetSessionRecording(ctx context.Context, modelUUID, uuid string) (sshsession.SessionRecording, error)
+}
+
+// Service provides the API for recording SSH sessions and playing them back.
+type Service struct {
+	st                State
+	logger            logger.Logger
+	objectStoreGetter objectstore.ModelObjectStoreGetter
+}
+
+// NewService returns a new Service for recording the SSH sessions proxied by
+// the controller. Recordings are kept in the object store returned by the
+// getter, which should be the controller's.
+func NewService(
+	st State,
+	logger logger.Logger,
+	objectStoreGetter objectstore.ModelObjectStoreGetter,
+) *Service {
+	return &Service{
+		st:                st,
+		logger:            logger,
+		objectStoreGetter: objectStoreGetter,
+	}
+}
+
+// recordingPath returns the path of the recording of the session in the
+// object store.
+func recordingPath(sessionUUID string) string {
+	return "ssh-sessions/" + sessionUUID
+}
+
+// RecordSession stores the recording of a session, read from r, in the object
+// store and records the details of the session. It returns the UUID of the
+// session.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the user, model or target of the session is not
+// specified.
+func (s *Service) RecordSession(
+	ctx context.Context,
+	args sshsession.RecordSessionArgs,
+	r io.Reader, size int64,
+) (string, error) {
+	if args.UserName == "" {
+		return "", errors.New("empty user name").Add(coreerrors.NotValid)
+	}
+	if args.ModelUUID == "" {
+		return "", errors.New("empty model UUID").Add(coreerrors.NotValid)
+	}
+	if args.Target == "" {
+		return "", errors.New("empty target").Add(coreerrors.NotValid)
+	}
+
+	sessionUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	objectStore, err := s.objectStoreGetter.GetObjectStore(ctx)
+	if err != nil {
+		return "", errors.Errorf("getting object store: %w", err)
+	}
+	path := recordingPath(sessionUUID.String())
+	objectUUID, err := objectStore.Put(ctx, path, r, size)
+	if err != nil {
+		return "", errors.Errorf("putting recording of ssh session in the object store: %w", err)
+	}
+
+	err = s.st.InsertSession(ctx, sshsession.InsertSessionArgs{
+		RecordSessionArgs: args,
+		UUID:              sessionUUID.String(),
+		ObjectStoreUUID:   objectUUID,
+	})
+	if err != nil {
+		if rErr := objectStore.Remove(ctx, path); rErr != nil {
+			s.logger.Warningf(ctx, "removing recording of ssh session %q: %v", sessionUUID, rErr)
+		}
+		return "", errors.Capture(err)
+	}
+	return sessionUUID.String(), nil
+}
+
+// ListSessions returns the recorded sessions to units and machines of the
+// specified model, in the order they started.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the model UUID is not valid.
+func (s *Service) ListSessions(ctx context.Context, modelUUID coremodel.UUID) ([]sshsession.Session, error) {
+	if err := modelUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	sessions, err := s.st.ListSessions(ctx, modelUUID.String())
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return sessions, nil
+}
+
+// GetSessionRecording returns the specified session to a unit or machine of
+// the specified model, along with a reader for its recording. The caller is
+// responsible for closing the reader.
+// The following errors can be returned:
+// - [coreerrors.NotValid] if the model UUID is not valid.
+// - [sshsessionerrors.SessionNotFound] if the session does not exist in the
+// model.
+func (s *Service) GetSessionRecording(
+	ctx context.Context,
+	modelUUID coremodel.UUID, sessionUUID string,
+) (sshsession.Session, io.ReadCloser, error) {
+	if err := modelUUID.Validate(); err != nil {
+		return sshsession.Session{}, nil, errors.Capture(err)
+	}
+	recording, err := s.st.GetSessionRecording(ctx, modelUUID.String(), sessionUUID)
+	if err != nil {
+		return sshsession.Session{}, nil, errors.Capture(err)
+	}
+
+	objectStore, err := s.objectStoreGetter.GetObjectStore(ctx)
+	if err != nil {
+		return sshsession.Session{}, nil, errors.Errorf("getting object store: %w", err)
+	}
+	reader, _, err := objectStore.Get(ctx, recording.Path)
+	if err != nil {
+		return sshsession.Session{}, nil, errors.Errorf("getting recording of ssh session %q: %w", sessionUUID, err)
+	}
+	return recording.Session, reader, nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coremodel "github.com/juju/juju/core/model"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/sshsession"
+	sshsessionerrors "github.com/juju/juju/domain/sshsession/errors"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type serviceSuite struct {
+	state             *MockState
+	objectStoreGetter *MockModelObjectStoreGetter
+	objectStore       *MockObjectStore
+
+	modelUUID coremodel.UUID
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.objectStoreGetter = NewMockModelObjectStoreGetter(ctrl)
+	s.objectStore = NewMockObjectStore(ctrl)
+	s.objectStoreGetter.EXPECT().GetObjectStore(gomock.Any()).Return(s.objectStore, nil).AnyTimes()
+	s.modelUUID = modeltesting.GenModelUUID(c)
+	return ctrl
+}
+
+func (s *serviceSuite) service(c *gc.C) *Service {
+	return NewService(s.state, loggertesting.WrapCheckLog(c), s.objectStoreGetter)
+}
+
+func (s *serviceSuite) recordSessionArgs() sshsession.RecordSessionArgs {
+	started := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	return sshsession.RecordSessionArgs{
+		UserName:  "admin",
+		ModelUUID: s.modelUUID.String(),
+		Target:    "0." + s.modelUUID.String() + ".juju.local",
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+	}
+}
+
+func (s *serviceSuite) TestRecordSession(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	args := s.recordSessionArgs()
+	r := strings.NewReader("recording")
+
+	var path string
+	s.objectStore.EXPECT().Put(gomock.Any(), gomock.Any(), r, int64(9)).DoAndReturn(
+		func(_ context.Context, p string, _ io.Reader, _ int64) (objectstore.UUID, error) {
+			path = p
+			return "object-uuid", nil
+		})
+	var inserted sshsession.InsertSessionArgs
+	s.state.EXPECT().InsertSession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg sshsession.InsertSessionArgs) error {
+			inserted = arg
+			return nil
+		})
+
+	sessionUUID, err := s.service(c).RecordSession(context.Background(), args, r, 9)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(path, gc.Equals, "ssh-sessions/"+sessionUUID)
+	c.Check(inserted, gc.DeepEquals, sshsession.InsertSessionArgs{
+		RecordSessionArgs: args,
+		UUID:              sessionUUID,
+		ObjectStoreUUID:   "object-uuid",
+	})
+}
+
+func (s *serviceSuite) TestRecordSessionRemovesRecordingOnFailure(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var path string
+	s.objectStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), int64(9)).DoAndReturn(
+		func(_ context.Context, p string, _ io.Reader, _ int64) (objectstore.UUID, error) {
+			path = p
+			return "object-uuid", nil
+		})
+	s.state.EXPECT().InsertSession(gomock.Any(), gomock.Any()).Return(errors.New("boom"))
+	s.objectStore.EXPECT().Remove(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, p string) error {
+			c.Check(p, gc.Equals, path)
+			return nil
+		})
+
+	_, err := s.service(c).RecordSession(context.Background(), s.recordSessionArgs(), strings.NewReader("recording"), 9)
+	c.Check(err, gc.ErrorMatches, "boom")
+}
+
+func (s *serviceSuite) TestRecordSessionNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	args := s.recordSessionArgs()
+	args.Target = ""
+	_, err := s.service(c).RecordSession(context.Background(), args, strings.NewReader(""), 0)
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+
+	args = s.recordSessionArgs()
+	args.UserName = ""
+	_, err = s.service(c).RecordSession(context.Background(), args, strings.NewReader(""), 0)
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestListSessions(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sessions := []sshsession.Session{{UUID: "session-1"}}
+	s.state.EXPECT().ListSessions(gomock.Any(), s.modelUUID.String()).Return(sessions, nil)
+
+	result, err := s.service(c).ListSessions(context.Background(), s.modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, sessions)
+}
+
+func (s *serviceSuite) TestListSessionsNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).ListSessions(context.Background(), "bad")
+	c.Check(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestGetSessionRecording(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	session := sshsession.Session{UUID: "session-1"}
+	s.state.EXPECT().GetSessionRecording(gomock.Any(), s.modelUUID.String(), "session-1").Return(sshsession.SessionRecording{
+		Session: session,
+		Path:    "ssh-sessions/session-1",
+	}, nil)
+	s.objectStore.EXPECT().Get(gomock.Any(), "ssh-sessions/session-1").Return(io.NopCloser(strings.NewReader("recording")), int64(9), nil)
+
+	result, reader, err := s.service(c).GetSessionRecording(context.Background(), s.modelUUID, "session-1")
+	c.Assert(err, jc.ErrorIsNil)
+	defer reader.Close()
+	c.Check(result, gc.DeepEquals, session)
+	data, err := io.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "recording")
+}
+
+func (s *serviceSuite) TestGetSessionRecordingNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetSessionRecording(gomock.Any(), s.modelUUID.String(), "session-1").Return(
+		sshsession.SessionRecording{}, sshsessionerrors.SessionNotFound)
+
+	_, _, err := s.service(c).GetSessionRecording(context.Background(), s.modelUUID, "session-1")
+	c.Check(err, jc.ErrorIs, sshsessionerrors.SessionNotFound)
+}
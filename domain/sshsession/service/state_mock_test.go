@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/sshsession/service (interfaces: State)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/sshsession/service State
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	sshsession "github.com/juju/juju/domain/sshsession"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// GetSessionRecording mocks base method.
+func (m *MockState) GetSessionRecording(arg0 context.Context, arg1, arg2 string) (sshsession.SessionRecording, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionRecording", arg0, arg1, arg2)
+	ret0, _ := ret[0].(sshsession.SessionRecording)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionRecording indicates an expected call of GetSessionRecording.
+func (mr *MockStateMockRecorder) GetSessionRecording(arg0, arg1, arg2 any) *MockStateGetSessionRecordingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionRecording", reflect.TypeOf((*MockState)(nil).GetSessionRecording), arg0, arg1, arg2)
+	return &MockStateGetSessionRecordingCall{Call: call}
+}
+
+// MockStateGetSessionRecordingCall wrap *gomock.Call
+type MockStateGetSessionRecordingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSessionRecordingCall) Return(arg0 sshsession.SessionRecording, arg1 error) *MockStateGetSessionRecordingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSessionRecordingCall) Do(f func(context.Context, string, string) (sshsession.SessionRecording, error)) *MockStateGetSessionRecordingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSessionRecordingCall) DoAndReturn(f func(context.Context, string, string) (sshsession.SessionRecording, error)) *MockStateGetSessionRecordingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InsertSession mocks base method.
+func (m *MockState) InsertSession(arg0 context.Context, arg1 sshsession.InsertSessionArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertSession", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertSession indicates an expected call of InsertSession.
+func (mr *MockStateMockRecorder) InsertSession(arg0, arg1 any) *MockStateInsertSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertSession", reflect.TypeOf((*MockState)(nil).InsertSession), arg0, arg1)
+	return &MockStateInsertSessionCall{Call: call}
+}
+
+// MockStateInsertSessionCall wrap *gomock.Call
+type MockStateInsertSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInsertSessionCall) Return(arg0 error) *MockStateInsertSessionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInsertSessionCall) Do(f func(context.Context, sshsession.InsertSessionArgs) error) *MockStateInsertSessionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInsertSessionCall) DoAndReturn(f func(context.Context, sshsession.InsertSessionArgs) error) *MockStateInsertSessionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSessions mocks base method.
+func (m *MockState) ListSessions(arg0 context.Context, arg1 string) ([]sshsession.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", arg0, arg1)
+	ret0, _ := ret[0].([]sshsession.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockStateMockRecorder) ListSessions(arg0, arg1 any) *MockStateListSessionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockState)(nil).ListSessions), arg0, arg1)
+	return &MockStateListSessionsCall{Call: call}
+}
+
+// MockStateListSessionsCall wrap *gomock.Call
+type MockStateListSessionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListSessionsCall) Return(arg0 []sshsession.Session, arg1 error) *MockStateListSessionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListSessionsCall) Do(f func(context.Context, string) ([]sshsession.Session, error)) *MockStateListSessionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListSessionsCall) DoAndReturn(f func(context.Context, string) ([]sshsession.Session, error)) *MockStateListSessionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/sshsession"
+	sshsessionerrors "github.com/juju/juju/domain/sshsession/errors"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// State is used to access the database.
+type State struct {
+	*domain.StateBase
+}
+
+// NewState creates a state to access the database.
+func NewState(factory coredatabase.TxnRunnerFactory) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// InsertSession records the details of a session whose recording is held in
+// the object store.
+// The following errors can be returned:
+// - [sshsessionerrors.ObjectNotFound] if the recording does not exist in the
+// object store.
+func (st *State) InsertSession(ctx context.Context, arg sshsession.InsertSessionArgs) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	session := sshSession{
+		UUID:      arg.UUID,
+		UserName:  arg.UserName,
+		ModelUUID: arg.ModelUUID,
+		Target:    arg.Target,
+		Command: sql.NullString{
+			String: arg.Command,
+			Valid:  arg.Command != "",
+		},
+		StartedAt:       arg.StartedAt.UTC(),
+		EndedAt:         arg.EndedAt.UTC(),
+		ExitCode:        arg.ExitCode,
+		ObjectStoreUUID: arg.ObjectStoreUUID.String(),
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO ssh_session (*)
+VALUES ($sshSession.*)
+`, session)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, session).Run()
+		if database.IsErrConstraintForeignKey(err) {
+			return errors.Errorf(
+				"object with id %q does not exist in store", arg.ObjectStoreUUID,
+			).Add(sshsessionerrors.ObjectNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return errors.Errorf("inserting ssh session %q: %w", arg.UUID, err)
+	}
+	return nil
+}
+
+// ListSessions returns the sessions to units and machines of the specified
+// model, in the order they started.
+func (st *State) ListSessions(ctx context.Context, modelUUID string) ([]sshsession.Session, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	ident := sessionIdent{ModelUUID: modelUUID}
+	stmt, err := st.Prepare(`
+SELECT &sshSessionRecording.*
+FROM   v_ssh_session
+WHERE  model_uuid = $sessionIdent.model_uuid
+ORDER BY started_at
+`, ident, sshSessionRecording{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var records []sshSessionRecording
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).GetAll(&records)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing ssh sessions: %w", err)
+	}
+
+	sessions := make([]sshsession.Session, len(records))
+	for i, record := range records {
+		sessions[i] = record.toSession()
+	}
+	return sessions, nil
+}
+
+// GetSessionRecording returns the specified session to a unit or machine of
+// the specified model, along with the path of its recording.
+// The following errors can be returned:
+// - [sshsessionerrors.SessionNotFound] if the session does not exist in the
+// model.
+func (st *State) GetSessionRecording(ctx context.Context, modelUUID, uuid string) (sshsession.SessionRecording, error) {
+	db, err := st.DB()
+	if err != nil {
+		return sshsession.SessionRecording{}, errors.Capture(err)
+	}
+
+	ident := sessionIdent{UUID: uuid, ModelUUID: modelUUID}
+	stmt, err := st.Prepare(`
+SELECT &sshSessionRecording.*
+FROM   v_ssh_session
+WHERE  uuid = $sessionIdent.uuid
+AND    model_uuid = $sessionIdent.model_uuid
+`, ident, sshSessionRecording{})
+	if err != nil {
+		return sshsession.SessionRecording{}, errors.Capture(err)
+	}
+
+	var record sshSessionRecording
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ident).Get(&record)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("ssh session %q", uuid).Add(sshsessionerrors.SessionNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return sshsession.SessionRecording{}, errors.Capture(err)
+	}
+	return sshsession.SessionRecording{
+		Session: record.toSession(),
+		Path:    record.Path,
+	}, nil
+}
+
+func (r sshSessionRecording) toSession() sshsession.Session {
+	return sshsession.Session{
+		UUID:      r.UUID,
+		UserName:  r.UserName,
+		ModelUUID: r.ModelUUID,
+		Target:    r.Target,
+		Command:   r.Command.String,
+		StartedAt: r.StartedAt,
+		EndedAt:   r.EndedAt,
+		ExitCode:  r.ExitCode,
+		Size:      r.Size,
+	}
+}
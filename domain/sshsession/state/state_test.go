@@ -0,0 +1,177 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/objectstore"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/domain/sshsession"
+	sshsessionerrors "github.com/juju/juju/domain/sshsession/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type stateSuite struct {
+	schematesting.ControllerSuite
+
+	state *State
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) SetUpTest(c *gc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.state = NewState(s.TxnRunnerFactory())
+}
+
+func (s *stateSuite) TestInsertAndListSessions(c *gc.C) {
+	modelUUID := uuid.MustNewUUID().String()
+	started := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+
+	objectUUID1 := s.addObject(c, "ssh-sessions/session-1", 100)
+	err := s.state.InsertSession(context.Background(), sshsession.InsertSessionArgs{
+		UUID:            "session-1",
+		ObjectStoreUUID: objectUUID1,
+		RecordSessionArgs: sshsession.RecordSessionArgs{
+			UserName:  "admin",
+			ModelUUID: modelUUID,
+			Target:    "1.postgresql." + modelUUID + ".juju.local",
+			StartedAt: started.Add(time.Hour),
+			EndedAt:   started.Add(2 * time.Hour),
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	objectUUID2 := s.addObject(c, "ssh-sessions/session-2", 200)
+	err = s.state.InsertSession(context.Background(), sshsession.InsertSessionArgs{
+		UUID:            "session-2",
+		ObjectStoreUUID: objectUUID2,
+		RecordSessionArgs: sshsession.RecordSessionArgs{
+			UserName:  "bob",
+			ModelUUID: modelUUID,
+			Target:    "0." + modelUUID + ".juju.local",
+			Command:   "ls",
+			StartedAt: started,
+			EndedAt:   started.Add(time.Minute),
+			ExitCode:  2,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Sessions of other models are not listed.
+	objectUUID3 := s.addObject(c, "ssh-sessions/session-3", 300)
+	err = s.state.InsertSession(context.Background(), sshsession.InsertSessionArgs{
+		UUID:            "session-3",
+		ObjectStoreUUID: objectUUID3,
+		RecordSessionArgs: sshsession.RecordSessionArgs{
+			UserName:  "admin",
+			ModelUUID: uuid.MustNewUUID().String(),
+			Target:    "0.other.juju.local",
+			StartedAt: started,
+			EndedAt:   started,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	sessions, err := s.state.ListSessions(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sessions, jc.DeepEquals, []sshsession.Session{{
+		UUID:      "session-2",
+		UserName:  "bob",
+		ModelUUID: modelUUID,
+		Target:    "0." + modelUUID + ".juju.local",
+		Command:   "ls",
+		StartedAt: started,
+		EndedAt:   started.Add(time.Minute),
+		ExitCode:  2,
+		Size:      200,
+	}, {
+		UUID:      "session-1",
+		UserName:  "admin",
+		ModelUUID: modelUUID,
+		Target:    "1.postgresql." + modelUUID + ".juju.local",
+		StartedAt: started.Add(time.Hour),
+		EndedAt:   started.Add(2 * time.Hour),
+		Size:      100,
+	}})
+}
+
+func (s *stateSuite) TestListSessionsNone(c *gc.C) {
+	sessions, err := s.state.ListSessions(context.Background(), uuid.MustNewUUID().String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(sessions, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestInsertSessionObjectNotFound(c *gc.C) {
+	err := s.state.InsertSession(context.Background(), sshsession.InsertSessionArgs{
+		UUID:            "session-1",
+		ObjectStoreUUID: objectstore.UUID(uuid.MustNewUUID().String()),
+		RecordSessionArgs: sshsession.RecordSessionArgs{
+			UserName:  "admin",
+			ModelUUID: uuid.MustNewUUID().String(),
+			Target:    "0.model.juju.local",
+		},
+	})
+	c.Check(err, jc.ErrorIs, sshsessionerrors.ObjectNotFound)
+}
+
+func (s *stateSuite) TestGetSessionRecording(c *gc.C) {
+	modelUUID := uuid.MustNewUUID().String()
+	started := time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC)
+	objectUUID := s.addObject(c, "ssh-sessions/session-1", 100)
+	err := s.state.InsertSession(context.Background(), sshsession.InsertSessionArgs{
+		UUID:            "session-1",
+		ObjectStoreUUID: objectUUID,
+		RecordSessionArgs: sshsession.RecordSessionArgs{
+			UserName:  "admin",
+			ModelUUID: modelUUID,
+			Target:    "0." + modelUUID + ".juju.local",
+			StartedAt: started,
+			EndedAt:   started,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	recording, err := s.state.GetSessionRecording(context.Background(), modelUUID, "session-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(recording, jc.DeepEquals, sshsession.SessionRecording{
+		Session: sshsession.Session{
+			UUID:      "session-1",
+			UserName:  "admin",
+			ModelUUID: modelUUID,
+			Target:    "0." + modelUUID + ".juju.local",
+			StartedAt: started,
+			EndedAt:   started,
+			Size:      100,
+		},
+		Path: "ssh-sessions/session-1",
+	})
+
+	// The session is not found through another model.
+	_, err = s.state.GetSessionRecording(context.Background(), uuid.MustNewUUID().String(), "session-1")
+	c.Check(err, jc.ErrorIs, sshsessionerrors.SessionNotFound)
+}
+
+func (s *stateSuite) TestGetSessionRecordingNotFound(c *gc.C) {
+	_, err := s.state.GetSessionRecording(context.Background(), uuid.MustNewUUID().String(), "session-1")
+	c.Check(err, jc.ErrorIs, sshsessionerrors.SessionNotFound)
+}
+
+func (s *stateSuite) addObject(c *gc.C, path string, size int) objectstore.UUID {
+	objectUUID := uuid.MustNewUUID().String()
+	_, err := s.DB().Exec(`
+INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size)
+VALUES (?, ?, ?, ?)`, objectUUID, objectUUID+"-256", objectUUID+"-384", size)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO object_store_metadata_path (path, metadata_uuid)
+VALUES (?, ?)`, path, objectUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	return objectstore.UUID(objectUUID)
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+)
+
+// sshSession represents a row of the ssh_session table.
+type sshSession struct {
+	UUID            string         `db:"uuid"`
+	UserName        string         `db:"user_name"`
+	ModelUUID       string         `db:"model_uuid"`
+	Target          string         `db:"target"`
+	Command         sql.NullString `db:"command"`
+	StartedAt       time.Time      `db:"started_at"`
+	EndedAt         time.Time      `db:"ended_at"`
+	ExitCode        int            `db:"exit_code"`
+	ObjectStoreUUID string         `db:"object_store_uuid"`
+}
+
+// sshSessionRecording represents a row of the v_ssh_session view.
+type sshSessionRecording struct {
+	UUID      string         `db:"uuid"`
+	UserName  string         `db:"user_name"`
+	ModelUUID string         `db:"model_uuid"`
+	Target    string         `db:"target"`
+	Command   sql.NullString `db:"command"`
+	StartedAt time.Time      `db:"started_at"`
+	EndedAt   time.Time      `db:"ended_at"`
+	ExitCode  int            `db:"exit_code"`
+	Size      int64          `db:"size"`
+	Path      string         `db:"path"`
+}
+
+// sessionIdent identifies a session of a model.
+type sessionIdent struct {
+	UUID      string `db:"uuid"`
+	ModelUUID string `db:"model_uuid"`
+}
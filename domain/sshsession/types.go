@@ -0,0 +1,60 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshsession
+
+import (
+	"time"
+
+	"github.com/juju/juju/core/objectstore"
+)
+
+// Session describes an SSH session proxied by the controller.
+type Session struct {
+	// UUID uniquely identifies the session.
+	UUID string
+	// UserName is the name of the user who opened the session.
+	UserName string
+	// ModelUUID is the UUID of the model of the target.
+	ModelUUID string
+	// Target is the virtual hostname of the unit or machine the session
+	// was proxied to.
+	Target string
+	// Command is the command run in the session, empty for an interactive
+	// shell.
+	Command string
+	// StartedAt is when the session started.
+	StartedAt time.Time
+	// EndedAt is when the session ended.
+	EndedAt time.Time
+	// ExitCode is the exit code of the session.
+	ExitCode int
+	// Size is the size of the recording of the session.
+	Size int64
+}
+
+// RecordSessionArgs holds the details of a session being recorded.
+type RecordSessionArgs struct {
+	UserName  string
+	ModelUUID string
+	Target    string
+	Command   string
+	StartedAt time.Time
+	EndedAt   time.Time
+	ExitCode  int
+}
+
+// InsertSessionArgs holds the details of a session to insert into the
+// database, once its recording is in the object store.
+type InsertSessionArgs struct {
+	RecordSessionArgs
+	UUID            string
+	ObjectStoreUUID objectstore.UUID
+}
+
+// SessionRecording is a session along with the location of its recording in
+// the object store.
+type SessionRecording struct {
+	Session
+	Path string
+}
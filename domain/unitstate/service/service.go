@@ -6,8 +6,10 @@ package service
 import (
 	"context"
 
+	coremachine "github.com/juju/juju/core/machine"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/unitstate"
+	"github.com/juju/juju/internal/errors"
 )
 
 // State defines an interface for interacting with the underlying state.
@@ -22,6 +24,10 @@ type State interface {
 	// If the units state is empty [unitstateerrors.EmptyUnitState] error is
 	// returned.
 	GetUnitState(context.Context, coreunit.Name) (unitstate.RetrievedUnitState, error)
+
+	// ResetMachineUnitStates removes the uniter, storage, secret and
+	// relation state of the units on the input machine.
+	ResetMachineUnitStates(context.Context, coremachine.Name) error
 }
 
 // Service defines a service for interacting with the underlying state.
@@ -50,3 +56,16 @@ func (s *Service) GetState(ctx context.Context, name coreunit.Name) (unitstate.R
 	}
 	return state, nil
 }
+
+// ResetMachineUnitStates removes the state of the units on the input
+// machine, other than their charm state, so that their agents start afresh
+// on a new instance.
+func (s *Service) ResetMachineUnitStates(ctx context.Context, machineName coremachine.Name) error {
+	if err := machineName.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.st.ResetMachineUnitStates(ctx, machineName); err != nil {
+		return errors.Errorf("resetting state of units on machine %q: %w", machineName, err)
+	}
+	return nil
+}
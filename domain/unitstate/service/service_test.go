@@ -10,6 +10,7 @@ import (
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	coremachine "github.com/juju/juju/core/machine"
 	unittesting "github.com/juju/juju/core/unit/testing"
 	"github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/unitstate"
@@ -80,6 +81,22 @@ func (s *serviceSuite) TestGetStateUnitNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, unitstateerrors.UnitNotFound)
 }
 
+func (s *serviceSuite) TestResetMachineUnitStates(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().ResetMachineUnitStates(gomock.Any(), coremachine.Name("0")).Return(nil)
+
+	err := NewService(s.st).ResetMachineUnitStates(context.Background(), "0")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestResetMachineUnitStatesInvalidName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := NewService(s.st).ResetMachineUnitStates(context.Background(), "")
+	c.Assert(err, gc.NotNil)
+}
+
 func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	machine "github.com/juju/juju/core/machine"
 	unit "github.com/juju/juju/core/unit"
 	unitstate "github.com/juju/juju/domain/unitstate"
 	gomock "go.uber.org/mock/gomock"
@@ -80,6 +81,44 @@ func (c *MockStateGetUnitStateCall) DoAndReturn(f func(context.Context, unit.Nam
 	return c
 }
 
+// ResetMachineUnitStates mocks base method.
+func (m *MockState) ResetMachineUnitStates(arg0 context.Context, arg1 machine.Name) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetMachineUnitStates", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetMachineUnitStates indicates an expected call of ResetMachineUnitStates.
+func (mr *MockStateMockRecorder) ResetMachineUnitStates(arg0, arg1 any) *MockStateResetMachineUnitStatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetMachineUnitStates", reflect.TypeOf((*MockState)(nil).ResetMachineUnitStates), arg0, arg1)
+	return &MockStateResetMachineUnitStatesCall{Call: call}
+}
+
+// MockStateResetMachineUnitStatesCall wrap *gomock.Call
+type MockStateResetMachineUnitStatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateResetMachineUnitStatesCall) Return(arg0 error) *MockStateResetMachineUnitStatesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateResetMachineUnitStatesCall) Do(f func(context.Context, machine.Name) error) *MockStateResetMachineUnitStatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateResetMachineUnitStatesCall) DoAndReturn(f func(context.Context, machine.Name) error) *MockStateResetMachineUnitStatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetUnitState mocks base method.
 func (m *MockState) SetUnitState(arg0 context.Context, arg1 unitstate.UnitState) error {
 	m.ctrl.T.Helper()
@@ -9,6 +9,7 @@ import (
 	"github.com/canonical/sqlair"
 
 	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/machine"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain"
 	"github.com/juju/juju/domain/unitstate"
@@ -160,6 +161,47 @@ func (st *State) SetUnitState(ctx context.Context, as unitstate.UnitState) error
 	})
 }
 
+// ResetMachineUnitStates removes the uniter, storage, secret and relation
+// state of the units on the input machine, so that their agents start
+// afresh. Charm state is kept, as charms rely on it outliving the machine.
+func (st *State) ResetMachineUnitStates(ctx context.Context, mName machine.Name) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	name := machineName{Name: mName}
+	machineUnits := `
+SELECT u.uuid
+FROM   unit AS u
+JOIN   machine AS m ON u.net_node_uuid = m.net_node_uuid
+WHERE  m.name = $machineName.name`
+
+	deleteStateStmt, err := st.Prepare(`
+DELETE FROM unit_state
+WHERE  unit_uuid IN (`+machineUnits+`)`, name)
+	if err != nil {
+		return errors.Errorf("preparing delete unit state statement: %w", err)
+	}
+
+	deleteRelationStmt, err := st.Prepare(`
+DELETE FROM unit_state_relation
+WHERE  unit_uuid IN (`+machineUnits+`)`, name)
+	if err != nil {
+		return errors.Errorf("preparing delete unit relation state statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, deleteStateStmt, name).Run(); err != nil {
+			return errors.Errorf("removing unit state on machine %q: %w", mName, err)
+		}
+		if err := tx.Query(ctx, deleteRelationStmt, name).Run(); err != nil {
+			return errors.Errorf("removing unit relation state on machine %q: %w", mName, err)
+		}
+		return nil
+	})
+}
+
 // ensureUnitStateRecord ensures that there is a row in the unit_state table
 // for the input unit UUID. This eliminates the need for upsert statements
 // when updating state for uniter, storage and secrets.
@@ -12,6 +12,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/machine"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	coreunit "github.com/juju/juju/core/unit"
 	unittesting "github.com/juju/juju/core/unit/testing"
@@ -354,6 +355,60 @@ func (s *stateSuite) TestUpdateUnitStateRelationEmptyMap(c *gc.C) {
 	c.Check(rowCount, gc.DeepEquals, 0)
 }
 
+func (s *stateSuite) TestResetMachineUnitStates(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	agentState := unitstate.UnitState{
+		Name:          s.unitName,
+		CharmState:    ptr(map[string]string{"one-key": "one-value"}),
+		UniterState:   ptr("some-uniter-state-yaml"),
+		RelationState: ptr(map[int]string{1: "one-value"}),
+		StorageState:  ptr("some-storage-state-yaml"),
+		SecretState:   ptr("some-secret-state-yaml"),
+	}
+	err := st.SetUnitState(context.Background(), agentState)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var mName machine.Name
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT m.name FROM machine AS m
+JOIN unit AS u ON u.net_node_uuid = m.net_node_uuid
+WHERE u.uuid = ?`, s.unitUUID).Scan(&mName)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.ResetMachineUnitStates(context.Background(), mName)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Only the charm state outlives the machine's instance.
+	state, err := st.GetUnitState(context.Background(), s.unitName)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(state, gc.DeepEquals, unitstate.RetrievedUnitState{
+		CharmState: *agentState.CharmState,
+	})
+}
+
+func (s *stateSuite) TestResetMachineUnitStatesOtherMachine(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	agentState := unitstate.UnitState{
+		Name:        s.unitName,
+		UniterState: ptr("some-uniter-state-yaml"),
+	}
+	err := st.SetUnitState(context.Background(), agentState)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.ResetMachineUnitStates(context.Background(), "666")
+	c.Assert(err, jc.ErrorIsNil)
+
+	state, err := st.GetUnitState(context.Background(), s.unitName)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(state, gc.DeepEquals, unitstate.RetrievedUnitState{
+		UniterState: *agentState.UniterState,
+	})
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
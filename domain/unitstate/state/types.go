@@ -3,7 +3,10 @@
 
 package state
 
-import "github.com/juju/juju/core/unit"
+import (
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/unit"
+)
 
 // unitUUID identifies a unit.
 type unitUUID struct {
@@ -18,6 +21,12 @@ type unitName struct {
 	Name unit.Name `db:"name"`
 }
 
+// machineName identifies a machine.
+type machineName struct {
+	// Name uniquely identifies a machine.
+	Name machine.Name `db:"name"`
+}
+
 // unitState contains a YAML string representing the
 // state for a unit's uniter, storage and secrets.
 type unitState struct {
@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -24,6 +25,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
 	coremodelconfig "github.com/juju/juju/core/modelconfig"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
@@ -205,6 +207,18 @@ const (
 	// StorageDefaultFilesystemSourceKey is the key for the default filesystem storage source.
 	StorageDefaultFilesystemSourceKey = "storage-default-filesystem-source"
 
+	// StorageUsageWarningThresholdKey is the key for the percentage of
+	// space used at which an attached filesystem is reported as nearly full.
+	StorageUsageWarningThresholdKey = "storage-usage-warning-threshold"
+
+	// StorageInodeWarningThresholdKey is the key for the percentage of
+	// inodes used at which an attached filesystem is reported as nearly full.
+	StorageInodeWarningThresholdKey = "storage-inode-warning-threshold"
+
+	// WarmPoolKey is the key for the machines kept started, with their
+	// agents installed, ready for units to be assigned to them.
+	WarmPoolKey = "warm-pool"
+
 	// ResourceTagsKey is an optional list or space-separated string
 	// of k=v pairs, defining the tags for ResourceTags.
 	ResourceTagsKey = "resource-tags"
@@ -247,6 +261,10 @@ const (
 	// UpdateStatusHookInterval is how often to run the update-status hook.
 	UpdateStatusHookInterval = "update-status-hook-interval"
 
+	// RightsizingWindowKey is the key for the period over which the CPU and
+	// memory utilisation of machines is kept to recommend instance types.
+	RightsizingWindowKey = "rightsizing-window"
+
 	// EgressSubnets are the source addresses from which traffic from this model
 	// originates if the model is deployed such that NAT or similar is in use.
 	EgressSubnets = "egress-subnets"
@@ -288,6 +306,11 @@ const (
 	// specifying what ingress can be applied to offers in this model
 	SAASIngressAllowKey = "saas-ingress-allow"
 
+	// EnableNetworkPoliciesKey determines whether the traffic reaching the
+	// units of applications is restricted to that from related applications
+	// and the sources the applications are exposed to.
+	EnableNetworkPoliciesKey = "enable-network-policies"
+
 	//
 	// Deprecated Settings Attributes
 	//
@@ -494,6 +517,9 @@ const (
 	// UpdateStatusHookInterval
 	DefaultUpdateStatusHookInterval = "5m"
 
+	// DefaultRightsizingWindow is the default value for RightsizingWindowKey.
+	DefaultRightsizingWindow = "168h" // 1 week
+
 	// DefaultActionResultsAge is the default for the age of the results for an
 	// action.
 	DefaultActionResultsAge = "336h" // 2 weeks
@@ -552,12 +578,19 @@ var defaultConfigValues = map[string]any{
 	DisableTelemetryKey:             false,
 	TransmitVendorMetricsKey:        true,
 	UpdateStatusHookInterval:        DefaultUpdateStatusHookInterval,
+	RightsizingWindowKey:            DefaultRightsizingWindow,
 	EgressSubnets:                   "",
 	CloudInitUserDataKey:            "",
 	ContainerInheritPropertiesKey:   "",
 	BackupDirKey:                    "",
 	LXDSnapChannel:                  DefaultLxdSnapChannel,
 
+	// Storage usage warning thresholds, as percentages.
+	StorageUsageWarningThresholdKey: DefaultStorageUsageWarningThreshold,
+	StorageInodeWarningThresholdKey: DefaultStorageUsageWarningThreshold,
+
+	WarmPoolKey: "",
+
 	CharmHubURLKey: charmhub.DefaultServerURL,
 
 	// Image and agent streams and URLs.
@@ -597,8 +630,9 @@ var defaultConfigValues = map[string]any{
 	MaxActionResultsSize: DefaultActionResultsSize,
 
 	// Model firewall settings
-	SSHAllowKey:         "0.0.0.0/0,::/0",
-	SAASIngressAllowKey: "0.0.0.0/0,::/0",
+	SSHAllowKey:              "0.0.0.0/0,::/0",
+	SAASIngressAllowKey:      "0.0.0.0/0,::/0",
+	EnableNetworkPoliciesKey: false,
 }
 
 // defaultLoggingConfig is the default value for logging-config if it is otherwise not set.
@@ -752,6 +786,16 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		}
 	}
 
+	if v, ok := cfg.defined[RightsizingWindowKey].(string); ok {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid rightsizing window in model configuration")
+		}
+		if duration < time.Hour {
+			return errors.Errorf("rightsizing window %v cannot be less than 1h", duration)
+		}
+	}
+
 	if v, ok := cfg.defined[EgressSubnets].(string); ok && v != "" {
 		cidrs := strings.Split(v, ",")
 		for _, cidr := range cidrs {
@@ -842,6 +886,16 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		return errors.Trace(err)
 	}
 
+	for _, key := range []string{StorageUsageWarningThresholdKey, StorageInodeWarningThresholdKey} {
+		if err := cfg.validatePercentage(key); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if _, err := ParseWarmPool(cfg.asString(WarmPoolKey)); err != nil {
+		return errors.Annotatef(err, "%s", WarmPoolKey)
+	}
+
 	if old != nil {
 		// Check the immutable config values.  These can't change
 		for _, attr := range immutableAttributes {
@@ -1537,6 +1591,14 @@ func (c *Config) SAASIngressAllow() []string {
 	return strings.Split(allowList, ",")
 }
 
+// EnableNetworkPolicies returns whether the traffic reaching the units of
+// applications in this model is restricted to that from related applications
+// and the sources the applications are exposed to.
+func (c *Config) EnableNetworkPolicies() bool {
+	val, _ := c.defined[EnableNetworkPoliciesKey].(bool)
+	return val
+}
+
 func (c *Config) validateCIDRs(cidrs []string, allowEmpty bool) error {
 	if len(cidrs) == 0 && !allowEmpty {
 		return errors.NotValidf("empty cidrs")
@@ -1577,6 +1639,92 @@ func (c *Config) StorageDefaultFilesystemSource() (string, bool) {
 	return bs, bs != ""
 }
 
+// DefaultStorageUsageWarningThreshold is the default percentage of space
+// or inodes used at which an attached filesystem is reported as nearly full.
+const DefaultStorageUsageWarningThreshold = 90
+
+// StorageUsageWarningThreshold returns the percentage of space used at
+// which an attached filesystem is reported as nearly full. Zero disables
+// the warning.
+func (c *Config) StorageUsageWarningThreshold() int {
+	value, ok := c.defined[StorageUsageWarningThresholdKey].(int)
+	if !ok {
+		return DefaultStorageUsageWarningThreshold
+	}
+	return value
+}
+
+// StorageInodeWarningThreshold returns the percentage of inodes used at
+// which an attached filesystem is reported as nearly full. Zero disables
+// the warning.
+func (c *Config) StorageInodeWarningThreshold() int {
+	value, ok := c.defined[StorageInodeWarningThresholdKey].(int)
+	if !ok {
+		return DefaultStorageUsageWarningThreshold
+	}
+	return value
+}
+
+// validatePercentage ensures the value of the given key, if set, is
+// between 0 and 100 inclusive.
+func (c *Config) validatePercentage(key string) error {
+	value, ok := c.defined[key].(int)
+	if ok && (value < 0 || value > 100) {
+		return errors.Errorf("%s: must be between 0 and 100", key)
+	}
+	return nil
+}
+
+// WarmPoolEntry describes a number of machines with the same constraints
+// that are kept started, ready for units to be assigned to them.
+type WarmPoolEntry struct {
+	// Size is the number of machines to keep available.
+	Size int
+
+	// Constraints are the constraints of the machines.
+	Constraints constraints.Value
+}
+
+// ParseWarmPool parses a warm pool specification: a semicolon separated
+// list of entries, each of which is a number of machines optionally
+// followed by a colon and the constraints of those machines, for example
+// "2; 3:mem=8G cores=4".
+func ParseWarmPool(value string) ([]WarmPoolEntry, error) {
+	var (
+		entries []WarmPoolEntry
+		seen    = set.NewStrings()
+	)
+	for _, raw := range strings.Split(value, ";") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		size, cons, _ := strings.Cut(raw, ":")
+		n, err := strconv.Atoi(strings.TrimSpace(size))
+		if err != nil || n < 1 {
+			return nil, errors.NotValidf("warm pool size %q", size)
+		}
+		parsed, err := constraints.Parse(cons)
+		if err != nil {
+			return nil, errors.Annotatef(err, "warm pool entry %q", raw)
+		}
+		if seen.Contains(parsed.String()) {
+			return nil, errors.NotValidf("duplicate warm pool constraints %q", parsed.String())
+		}
+		seen.Add(parsed.String())
+		entries = append(entries, WarmPoolEntry{Size: n, Constraints: parsed})
+	}
+	return entries, nil
+}
+
+// WarmPool returns the entries of the model's warm pool, which is empty
+// unless configured.
+func (c *Config) WarmPool() []WarmPoolEntry {
+	// The value is validated when the config is set.
+	entries, _ := ParseWarmPool(c.asString(WarmPoolKey))
+	return entries
+}
+
 // ResourceTags returns a set of tags to set on environment resources
 // that Juju creates and manages, if the provider supports them. These
 // tags have no special meaning to Juju, but may be used for existing
@@ -1622,6 +1770,17 @@ func (c *Config) UpdateStatusHookInterval() time.Duration {
 	return val
 }
 
+// RightsizingWindow is the period over which the CPU and memory
+// utilisation of machines is kept to recommend instance types.
+func (c *Config) RightsizingWindow() time.Duration {
+	// Value has already been validated.
+	val, err := time.ParseDuration(c.asString(RightsizingWindowKey))
+	if err != nil {
+		val, _ = time.ParseDuration(DefaultRightsizingWindow)
+	}
+	return val
+}
+
 // EgressSubnets are the source addresses from which traffic from this model
 // originates if the model is deployed such that NAT or similar is in use.
 func (c *Config) EgressSubnets() []string {
@@ -1736,10 +1895,14 @@ var alwaysOptional = schema.Defaults{
 	// Environ providers will specify their own defaults.
 	StorageDefaultBlockSourceKey:      schema.Omit,
 	StorageDefaultFilesystemSourceKey: schema.Omit,
+	StorageUsageWarningThresholdKey:   schema.Omit,
+	StorageInodeWarningThresholdKey:   schema.Omit,
+	WarmPoolKey:                       schema.Omit,
 
-	"firewall-mode":     schema.Omit,
-	SSHAllowKey:         schema.Omit,
-	SAASIngressAllowKey: schema.Omit,
+	"firewall-mode":          schema.Omit,
+	SSHAllowKey:              schema.Omit,
+	SAASIngressAllowKey:      schema.Omit,
+	EnableNetworkPoliciesKey: schema.Omit,
 
 	"logging-config":                schema.Omit,
 	ProvisionerHarvestModeKey:       schema.Omit,
@@ -1784,6 +1947,7 @@ var alwaysOptional = schema.Defaults{
 	MaxActionResultsAge:             schema.Omit,
 	MaxActionResultsSize:            schema.Omit,
 	UpdateStatusHookInterval:        schema.Omit,
+	RightsizingWindowKey:            schema.Omit,
 	EgressSubnets:                   schema.Omit,
 	CloudInitUserDataKey:            schema.Omit,
 	ContainerInheritPropertiesKey:   schema.Omit,
@@ -18,6 +18,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/semversion"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/environs/config"
@@ -391,6 +392,60 @@ var configTests = []configTest{
 			"num-container-provision-workers": 26,
 		}),
 		err: `num-container-provision-workers: must be less than 25`,
+	}, {
+		about:       "rightsizing-window: too short",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"rightsizing-window": "30m",
+		}),
+		err: `rightsizing window 30m0s cannot be less than 1h`,
+	}, {
+		about:       "storage-usage-warning-threshold: 80",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"storage-usage-warning-threshold": 80,
+		}),
+	}, {
+		about:       "storage-usage-warning-threshold: over max",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"storage-usage-warning-threshold": 101,
+		}),
+		err: `storage-usage-warning-threshold: must be between 0 and 100`,
+	}, {
+		about:       "storage-inode-warning-threshold: negative",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"storage-inode-warning-threshold": -1,
+		}),
+		err: `storage-inode-warning-threshold: must be between 0 and 100`,
+	}, {
+		about:       "warm-pool: sizes and constraints",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"warm-pool": "2; 3:mem=8G cores=4",
+		}),
+	}, {
+		about:       "warm-pool: invalid size",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"warm-pool": "0:mem=8G",
+		}),
+		err: `warm-pool: warm pool size "0" not valid`,
+	}, {
+		about:       "warm-pool: invalid constraints",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"warm-pool": "1:mem=lots",
+		}),
+		err: `warm-pool: warm pool entry "1:mem=lots": bad "mem" constraint: .*`,
+	}, {
+		about:       "warm-pool: duplicate constraints",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"warm-pool": "1:cores=2; 2: cores=2",
+		}),
+		err: `warm-pool: duplicate warm pool constraints "cores=2" not valid`,
 	}, {
 		about:       "default image stream",
 		useDefaults: config.UseDefaults,
@@ -1441,6 +1496,16 @@ func (s *ConfigSuite) TestUpdateStatusHookIntervalConfigValue(c *gc.C) {
 	c.Assert(cfg.UpdateStatusHookInterval(), gc.Equals, 30*time.Minute)
 }
 
+func (s *ConfigSuite) TestRightsizingWindow(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.RightsizingWindow(), gc.Equals, 7*24*time.Hour)
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"rightsizing-window": "72h",
+	})
+	c.Assert(cfg.RightsizingWindow(), gc.Equals, 72*time.Hour)
+}
+
 func (s *ConfigSuite) TestEgressSubnets(c *gc.C) {
 	cfg := newTestConfig(c, testing.Attrs{
 		"egress-subnets": "10.0.0.1/32, 192.168.1.1/16",
@@ -1534,6 +1599,21 @@ func (s *ConfigSuite) TestLXDSnapChannelConfig(c *gc.C) {
 	c.Assert(config.LXDSnapChannel(), gc.Equals, "latest/candidate")
 }
 
+func (s *ConfigSuite) TestWarmPool(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{
+		config.WarmPoolKey: "2; 3:mem=8G cores=4;",
+	})
+	c.Assert(cfg.WarmPool(), jc.DeepEquals, []config.WarmPoolEntry{
+		{Size: 2},
+		{Size: 3, Constraints: constraints.MustParse("mem=8G cores=4")},
+	})
+}
+
+func (s *ConfigSuite) TestWarmPoolDefault(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.WarmPool(), gc.HasLen, 0)
+}
+
 func (s *ConfigSuite) TestTelemetryConfig(c *gc.C) {
 	cfg := newTestConfig(c, testing.Attrs{})
 	c.Assert(cfg.Telemetry(), jc.IsTrue)
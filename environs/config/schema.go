@@ -477,6 +477,27 @@ Below, the harvest mode key for the current model is set to 'none':
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	StorageUsageWarningThresholdKey: {
+		Description: `The percentage of space used at which an attached filesystem
+is reported as nearly full. A value of 0 disables the warning.`,
+		Type:  configschema.Tint,
+		Group: configschema.EnvironGroup,
+	},
+	StorageInodeWarningThresholdKey: {
+		Description: `The percentage of inodes used at which an attached filesystem
+is reported as nearly full. A value of 0 disables the warning.`,
+		Type:  configschema.Tint,
+		Group: configschema.EnvironGroup,
+	},
+	WarmPoolKey: {
+		Description: `The machines to keep started, with their agents installed,
+ready for new units to be assigned to them. A semicolon separated list
+of entries, each a number of machines optionally followed by a colon
+and their constraints, for example "2; 3:mem=8G cores=4". A unit is
+assigned to a pool machine whose constraints and base match its own.`,
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
 	TestModeKey: {
 		Description: `Whether the model is intended for testing.
 If true, accessing the charm store does not affect statistical
@@ -511,6 +532,14 @@ CIDRs specifying what ingress can be applied to offers in this model.`,
 		Type:  configschema.Tstring,
 		Group: configschema.EnvironGroup,
 	},
+	EnableNetworkPoliciesKey: {
+		Description: `Whether network policies restrict the traffic reaching the units of
+applications to that from related applications, on the ports opened for the
+relation endpoints, and from the sources the applications are exposed to.
+Currently only the kubernetes provider supports enable-network-policies`,
+		Type:  configschema.Tbool,
+		Group: configschema.EnvironGroup,
+	},
 	TypeKey: {
 		Description: "Type of model, e.g. local, ec2",
 		Type:        configschema.Tstring,
@@ -578,6 +607,14 @@ manually using:
 		Type:        configschema.Tstring,
 		Group:       configschema.EnvironGroup,
 	},
+	RightsizingWindowKey: {
+		Description: `The period over which the CPU and memory utilisation reported by
+machine agents is kept, and from which instance types are recommended
+by the show-application command, in human-readable time format
+(default 168h, minimum 1h)`,
+		Type:  configschema.Tstring,
+		Group: configschema.EnvironGroup,
+	},
 	EgressSubnets: {
 		Description: "Source address(es) for traffic originating from this model",
 		Type:        configschema.Tstring,
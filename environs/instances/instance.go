@@ -42,3 +42,12 @@ type InstanceFirewaller interface {
 	// address rules for that port range.
 	IngressRules(ctx context.Context, machineId string) (firewall.IngressRules, error)
 }
+
+// InterruptibleInstance is implemented by instances which may run on
+// spot or preemptible capacity, and so may be reclaimed by the cloud at
+// short notice.
+type InterruptibleInstance interface {
+	// Interruptible returns true if the cloud may reclaim the
+	// instance at any time.
+	Interruptible() bool
+}
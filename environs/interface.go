@@ -20,6 +20,7 @@ import (
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/pricing"
 	"github.com/juju/juju/internal/configschema"
 	"github.com/juju/juju/internal/proxy"
 	"github.com/juju/juju/internal/storage"
@@ -541,6 +542,14 @@ type InstanceTypesFetcher interface {
 	InstanceTypes(context.Context, constraints.Value) (instances.InstanceTypesWithCostMetadata, error)
 }
 
+// PriceCatalogueFetcher is an interface that allows the prices of instance
+// types and volumes to be obtained from a provider's pricing API. It is
+// optionally implemented by an Environ, and the returned catalogue holds
+// the prices of the environ's region.
+type PriceCatalogueFetcher interface {
+	pricing.Source
+}
+
 // Upgrader is an interface that can be used for upgrading Environs. If an
 // Environ implements this interface, its UpgradeOperations method will be
 // invoked to identify operations that should be run on upgrade.
@@ -0,0 +1,102 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package manual
+
+import (
+	"os"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Inventory describes a set of hosts to be enlisted as manual machines.
+//
+// The user and keys given at the top level apply to every host that does
+// not specify its own:
+//
+//	user: admin
+//	private-key: ~/.ssh/fleet_ed25519
+//	hosts:
+//	  - address: 10.0.0.1
+//	  - address: 10.0.0.2
+//	    user: root
+type Inventory struct {
+	// User is the default login user for the hosts.
+	User string `yaml:"user,omitempty"`
+
+	// PrivateKey is the default path of the private key used to connect
+	// to the hosts.
+	PrivateKey string `yaml:"private-key,omitempty"`
+
+	// PublicKey is the default path of the public key added to the
+	// authorized keys of the hosts.
+	PublicKey string `yaml:"public-key,omitempty"`
+
+	// Hosts are the hosts to enlist.
+	Hosts []InventoryHost `yaml:"hosts"`
+}
+
+// InventoryHost describes a single host in an inventory.
+type InventoryHost struct {
+	// Address is the hostname or IP address of the host.
+	Address string `yaml:"address"`
+
+	// User is the login user for the host.
+	User string `yaml:"user,omitempty"`
+
+	// PrivateKey is the path of the private key used to connect to
+	// the host.
+	PrivateKey string `yaml:"private-key,omitempty"`
+
+	// PublicKey is the path of the public key added to the authorized
+	// keys of the host.
+	PublicKey string `yaml:"public-key,omitempty"`
+}
+
+// ReadInventory reads and parses the inventory file at the input path.
+func ReadInventory(path string) ([]InventoryHost, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading inventory")
+	}
+	hosts, err := ParseInventory(data)
+	return hosts, errors.Annotatef(err, "parsing inventory %q", path)
+}
+
+// ParseInventory parses the input inventory document, returning its hosts
+// with the inventory defaults applied.
+func ParseInventory(data []byte) ([]InventoryHost, error) {
+	var inventory Inventory
+	if err := yaml.UnmarshalStrict(data, &inventory); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(inventory.Hosts) == 0 {
+		return nil, errors.NotValidf("inventory with no hosts")
+	}
+
+	seen := set.NewStrings()
+	hosts := make([]InventoryHost, len(inventory.Hosts))
+	for i, host := range inventory.Hosts {
+		if host.Address == "" {
+			return nil, errors.NotValidf("host %d with no address", i+1)
+		}
+		if seen.Contains(host.Address) {
+			return nil, errors.NotValidf("duplicate host %q", host.Address)
+		}
+		seen.Add(host.Address)
+
+		if host.User == "" {
+			host.User = inventory.User
+		}
+		if host.PrivateKey == "" {
+			host.PrivateKey = inventory.PrivateKey
+		}
+		if host.PublicKey == "" {
+			host.PublicKey = inventory.PublicKey
+		}
+		hosts[i] = host
+	}
+	return hosts, nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package manual_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs/manual"
+	"github.com/juju/juju/internal/testing"
+)
+
+type inventorySuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&inventorySuite{})
+
+func (s *inventorySuite) TestParseInventory(c *gc.C) {
+	hosts, err := manual.ParseInventory([]byte(`
+user: admin
+private-key: /keys/fleet
+hosts:
+  - address: 10.0.0.1
+  - address: 10.0.0.2
+    user: root
+    public-key: /keys/root.pub
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(hosts, jc.DeepEquals, []manual.InventoryHost{{
+		Address:    "10.0.0.1",
+		User:       "admin",
+		PrivateKey: "/keys/fleet",
+	}, {
+		Address:    "10.0.0.2",
+		User:       "root",
+		PrivateKey: "/keys/fleet",
+		PublicKey:  "/keys/root.pub",
+	}})
+}
+
+func (s *inventorySuite) TestParseInventoryErrors(c *gc.C) {
+	for i, test := range []struct {
+		inventory string
+		err       string
+	}{{
+		inventory: `hosts: []`,
+		err:       "inventory with no hosts not valid",
+	}, {
+		inventory: "hosts:\n  - user: root",
+		err:       "host 1 with no address not valid",
+	}, {
+		inventory: "hosts:\n  - address: 10.0.0.1\n  - address: 10.0.0.1",
+		err:       `duplicate host "10.0.0.1" not valid`,
+	}, {
+		inventory: "hosts:\n  - address: 10.0.0.1\n    port: 22",
+		err:       `(?s).*field port not found.*`,
+	}} {
+		c.Logf("test %d", i)
+		_, err := manual.ParseInventory([]byte(test.inventory))
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *inventorySuite) TestReadInventory(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "hosts.yaml")
+	err := os.WriteFile(path, []byte("hosts:\n  - address: 10.0.0.1\n"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	hosts, err := manual.ReadInventory(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(hosts, jc.DeepEquals, []manual.InventoryHost{{Address: "10.0.0.1"}})
+}
+
+func (s *inventorySuite) TestReadInventoryInvalid(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "hosts.yaml")
+	err := os.WriteFile(path, []byte("hosts: []\n"), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = manual.ReadInventory(path)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+	c.Check(err, gc.ErrorMatches, `parsing inventory ".*hosts.yaml": inventory with no hosts not valid`)
+}
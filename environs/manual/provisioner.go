@@ -8,8 +8,11 @@ import (
 	"context"
 	"errors"
 	"io"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -65,3 +68,72 @@ type ProvisioningClientAPI interface {
 	DestroyMachinesWithParams(ctx context.Context, force, keep, dryRun bool, maxWait *time.Duration, machines ...string) ([]params.DestroyMachineResult, error)
 	ProvisioningScript(context.Context, params.ProvisioningScriptParams) (script string, err error)
 }
+
+// ProvisionMachineResult holds the result of provisioning a single host.
+type ProvisionMachineResult struct {
+	// Host is the host that was provisioned.
+	Host string
+
+	// MachineId is the ID of the machine created for the host, if
+	// provisioning succeeded.
+	MachineId string
+
+	// Error is the error provisioning the host, if any.
+	Error error
+}
+
+// ProvisionMachines provisions a machine for each of the input arguments
+// using the input func, running at most parallel provisioning operations at
+// once. The results are returned in the same order as the arguments.
+func ProvisionMachines(
+	ctx context.Context, provision ProvisionMachineFunc, args []ProvisionMachineArgs, parallel int,
+) []ProvisionMachineResult {
+	if parallel < 1 {
+		parallel = 1
+	}
+	results := make([]ProvisionMachineResult, len(args))
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, arg := range args {
+		wg.Add(1)
+		go func(i int, arg ProvisionMachineArgs) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			machineId, err := provision(ctx, arg)
+			results[i] = ProvisionMachineResult{
+				Host:      arg.Host,
+				MachineId: machineId,
+				Error:     err,
+			}
+		}(i, arg)
+	}
+	wg.Wait()
+	return results
+}
+
+// DecommissionMachineFunc that every provisioner should have to remove the
+// machine agent from a host previously provisioned by it.
+type DecommissionMachineFunc func(context.Context, DecommissionMachineArgs) error
+
+// DecommissionMachineArgs used for arguments for the decommission methods.
+type DecommissionMachineArgs struct {
+	// Host is the host to decommission.
+	Host string
+
+	// PrivateKey contains the path of the identity file containing the
+	// private key to be used to connect to the host.
+	PrivateKey string
+
+	// Stderr is used to present the progress of the decommission to the
+	// user.
+	Stderr io.Writer
+}
+
+// InstanceHost returns the host of a manually provisioned machine from its
+// instance ID, and whether the instance ID is that of a manual machine.
+func InstanceHost(id instance.Id) (string, bool) {
+	host, ok := strings.CutPrefix(string(id), ManualInstancePrefix)
+	return host, ok && host != ""
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package manual_test
+
+import (
+	"context"
+	"sync"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/manual"
+	"github.com/juju/juju/internal/testing"
+)
+
+type provisionerSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&provisionerSuite{})
+
+func (s *provisionerSuite) TestProvisionMachines(c *gc.C) {
+	var (
+		mu      sync.Mutex
+		running int
+		peak    int
+	)
+	provision := func(ctx context.Context, args manual.ProvisionMachineArgs) (string, error) {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+
+		if args.Host == "10.0.0.2" {
+			return "", errors.New("no route to host")
+		}
+		return "machine-for-" + args.Host, nil
+	}
+
+	args := []manual.ProvisionMachineArgs{
+		{Host: "10.0.0.1"},
+		{Host: "10.0.0.2"},
+		{Host: "10.0.0.3"},
+		{Host: "10.0.0.4"},
+	}
+	results := manual.ProvisionMachines(context.Background(), provision, args, 2)
+	c.Assert(results, gc.HasLen, 4)
+	for i, result := range results {
+		c.Check(result.Host, gc.Equals, args[i].Host)
+		if result.Host == "10.0.0.2" {
+			c.Check(result.Error, gc.ErrorMatches, "no route to host")
+			c.Check(result.MachineId, gc.Equals, "")
+		} else {
+			c.Check(result.Error, jc.ErrorIsNil)
+			c.Check(result.MachineId, gc.Equals, "machine-for-"+result.Host)
+		}
+	}
+	c.Check(peak <= 2, jc.IsTrue)
+}
+
+func (s *provisionerSuite) TestInstanceHost(c *gc.C) {
+	host, ok := manual.InstanceHost(instance.Id("manual:10.0.0.1"))
+	c.Check(ok, jc.IsTrue)
+	c.Check(host, gc.Equals, "10.0.0.1")
+
+	_, ok = manual.InstanceHost(instance.Id("manual:"))
+	c.Check(ok, jc.IsFalse)
+
+	_, ok = manual.InstanceHost(instance.Id("i-0123456789"))
+	c.Check(ok, jc.IsFalse)
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshprovisioner
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juju/utils/v4/ssh"
+
+	"github.com/juju/juju/environs/manual"
+)
+
+// decommissionScript stops and removes the machine agent, its services and
+// its data from a host provisioned by ProvisionMachine. Once it has run,
+// the host is no longer reported as provisioned and can be enlisted again.
+const decommissionScript = `#!/bin/bash
+for path_to_unit in /etc/systemd/system/juju*; do
+  [ -e "$path_to_unit" ] || continue
+  unit=$(basename "$path_to_unit")
+  case "$unit" in
+  *.service)
+    echo "stopping juju service: $unit"
+    systemctl stop "$unit"
+    systemctl disable "$unit"
+    ;;
+  esac
+  rm -rf "$path_to_unit"
+done
+systemctl daemon-reload
+
+# There might be no jujud at all, so don't require pkill to succeed.
+pkill -SIGKILL jujud || true
+
+echo "removing juju data directories"
+rm -rf /var/lib/juju /var/log/juju /var/run/juju /etc/juju
+rm -f /etc/juju-proxy.conf /etc/juju-proxy-systemd.conf \
+  /etc/profile.d/juju-proxy.sh /etc/profile.d/juju-introspection.sh \
+  /etc/apt/apt.conf.d/95-juju-proxy-settings \
+  /usr/bin/juju-exec /usr/bin/juju-introspect /usr/bin/juju-dumplogs \
+  /sbin/remove-juju-services
+exit 0
+`
+
+// DecommissionMachine stops and removes the machine agent, its services and
+// its data directories from a host previously provisioned with
+// ProvisionMachine, connecting over SSH as the ubuntu user.
+func DecommissionMachine(ctx context.Context, args manual.DecommissionMachineArgs) error {
+	logger.Infof(ctx, "decommissioning %q", args.Host)
+
+	var options ssh.Options
+	if args.PrivateKey != "" {
+		options.SetIdentities(args.PrivateKey)
+	}
+	cmd := ssh.Command("ubuntu@"+args.Host, []string{"sudo", "/bin/bash"}, &options)
+	var stderr bytes.Buffer
+	cmd.Stdin = strings.NewReader(decommissionScript)
+	cmd.Stdout = args.Stderr
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() != 0 {
+			err = fmt.Errorf("%v (%v)", err, strings.TrimSpace(stderr.String()))
+		}
+		return err
+	}
+	return nil
+}
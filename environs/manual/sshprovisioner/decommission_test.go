@@ -0,0 +1,43 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshprovisioner_test
+
+import (
+	"bytes"
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs/manual"
+	"github.com/juju/juju/environs/manual/sshprovisioner"
+	"github.com/juju/juju/internal/testing"
+)
+
+type decommissionSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&decommissionSuite{})
+
+func (s *decommissionSuite) TestDecommissionMachine(c *gc.C) {
+	defer installFakeSSH(c, sshprovisioner.DecommissionScript, "removing juju data directories", 0)()
+
+	var progress bytes.Buffer
+	err := sshprovisioner.DecommissionMachine(context.Background(), manual.DecommissionMachineArgs{
+		Host:   "10.0.0.1",
+		Stderr: &progress,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(progress.String(), gc.Equals, "removing juju data directories\n")
+}
+
+func (s *decommissionSuite) TestDecommissionMachineError(c *gc.C) {
+	defer installFakeSSH(c, sshprovisioner.DecommissionScript, []string{"", "sudo: a password is required"}, 1)()
+
+	err := sshprovisioner.DecommissionMachine(context.Background(), manual.DecommissionMachineArgs{
+		Host: "10.0.0.1",
+	})
+	c.Assert(err, gc.ErrorMatches, `subprocess encountered error code 1 \(sudo: a password is required\)`)
+}
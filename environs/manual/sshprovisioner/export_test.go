@@ -7,3 +7,7 @@ package sshprovisioner
 const (
 	DetectionScript = detectionScript
 )
+
+const (
+	DecommissionScript = decommissionScript
+)
@@ -0,0 +1,173 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package pricing provides a catalogue of cloud prices, and estimates of
+// what machines and volumes cost to run.
+package pricing
+
+import (
+	"context"
+	"os"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultRegion is the name of the catalogue region whose prices apply to
+// any region that does not have a price of its own.
+const DefaultRegion = "default"
+
+// Catalogue holds the prices of instance types and volumes, by cloud
+// region. A catalogue file looks like:
+//
+//	currency: USD
+//	regions:
+//	  us-east-1:
+//	    instance-types:
+//	      m5.large: 0.096
+//	    volumes:
+//	      ebs-ssd: 0.08
+//	  default:
+//	    volumes:
+//	      ebs: 0.045
+type Catalogue struct {
+	// Currency is the currency in which all of the prices are expressed.
+	Currency string `yaml:"currency"`
+
+	// Regions holds the prices for each cloud region, keyed by region
+	// name. The prices of DefaultRegion apply to every region.
+	Regions map[string]RegionPrices `yaml:"regions"`
+}
+
+// RegionPrices holds the prices in a single cloud region.
+type RegionPrices struct {
+	// InstanceTypes holds the hourly price of each instance type.
+	InstanceTypes map[string]float64 `yaml:"instance-types,omitempty"`
+
+	// Volumes holds the monthly price of a GiB of storage, keyed by
+	// storage pool name.
+	Volumes map[string]float64 `yaml:"volumes,omitempty"`
+}
+
+// Source is implemented by anything that can supply a price catalogue,
+// such as a local file or a cloud provider's pricing API.
+type Source interface {
+	// PriceCatalogue returns the prices known to the source.
+	PriceCatalogue(ctx context.Context) (*Catalogue, error)
+}
+
+// InstanceTypePrice returns the hourly price of the instance type in the
+// region, and whether the catalogue has a price for it.
+func (c *Catalogue) InstanceTypePrice(region, instanceType string) (float64, bool) {
+	return c.lookup(region, func(prices RegionPrices) map[string]float64 {
+		return prices.InstanceTypes
+	}, instanceType)
+}
+
+// VolumePrice returns the monthly price of a GiB of storage from the pool
+// in the region, and whether the catalogue has a price for it.
+func (c *Catalogue) VolumePrice(region, pool string) (float64, bool) {
+	return c.lookup(region, func(prices RegionPrices) map[string]float64 {
+		return prices.Volumes
+	}, pool)
+}
+
+func (c *Catalogue) lookup(region string, get func(RegionPrices) map[string]float64, key string) (float64, bool) {
+	if c == nil || key == "" {
+		return 0, false
+	}
+	for _, r := range []string{region, DefaultRegion} {
+		if price, ok := get(c.Regions[r])[key]; ok {
+			return price, true
+		}
+	}
+	return 0, false
+}
+
+// Validate returns an error if the catalogue has prices but no currency,
+// or has a negative price.
+func (c *Catalogue) Validate() error {
+	for region, prices := range c.Regions {
+		if c.Currency == "" && (len(prices.InstanceTypes) > 0 || len(prices.Volumes) > 0) {
+			return errors.NotValidf("catalogue with no currency")
+		}
+		for name, price := range prices.InstanceTypes {
+			if price < 0 {
+				return errors.NotValidf("negative price for instance type %q in region %q", name, region)
+			}
+		}
+		for pool, price := range prices.Volumes {
+			if price < 0 {
+				return errors.NotValidf("negative price for storage pool %q in region %q", pool, region)
+			}
+		}
+	}
+	return nil
+}
+
+// ReadFile reads and parses the catalogue file at the input path.
+func ReadFile(path string) (*Catalogue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading price catalogue")
+	}
+	catalogue, err := Parse(data)
+	return catalogue, errors.Annotatef(err, "parsing price catalogue %q", path)
+}
+
+// Parse parses the input catalogue document.
+func Parse(data []byte) (*Catalogue, error) {
+	var catalogue Catalogue
+	if err := yaml.UnmarshalStrict(data, &catalogue); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := catalogue.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &catalogue, nil
+}
+
+// FileSource is a Source that reads its catalogue from a local file.
+type FileSource string
+
+// PriceCatalogue implements Source.
+func (path FileSource) PriceCatalogue(context.Context) (*Catalogue, error) {
+	return ReadFile(string(path))
+}
+
+// Merge returns a catalogue with the prices of all the input catalogues.
+// Where more than one catalogue has a price for the same item, the price
+// from the earliest catalogue is used. Nil catalogues are ignored, and an
+// error is returned if the catalogues use different currencies.
+func Merge(catalogues ...*Catalogue) (*Catalogue, error) {
+	merged := &Catalogue{Regions: make(map[string]RegionPrices)}
+	for _, catalogue := range catalogues {
+		if catalogue == nil {
+			continue
+		}
+		if merged.Currency == "" {
+			merged.Currency = catalogue.Currency
+		} else if catalogue.Currency != "" && catalogue.Currency != merged.Currency {
+			return nil, errors.NotValidf("merging %s prices with %s prices", catalogue.Currency, merged.Currency)
+		}
+		for region, prices := range catalogue.Regions {
+			mergedPrices := merged.Regions[region]
+			mergedPrices.InstanceTypes = mergePrices(mergedPrices.InstanceTypes, prices.InstanceTypes)
+			mergedPrices.Volumes = mergePrices(mergedPrices.Volumes, prices.Volumes)
+			merged.Regions[region] = mergedPrices
+		}
+	}
+	return merged, nil
+}
+
+func mergePrices(to, from map[string]float64) map[string]float64 {
+	for key, price := range from {
+		if to == nil {
+			to = make(map[string]float64)
+		}
+		if _, ok := to[key]; !ok {
+			to[key] = price
+		}
+	}
+	return to
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pricing_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs/pricing"
+)
+
+type catalogueSuite struct{}
+
+var _ = gc.Suite(&catalogueSuite{})
+
+const catalogueYAML = `
+currency: USD
+regions:
+  us-east-1:
+    instance-types:
+      m5.large: 0.096
+    volumes:
+      ebs-ssd: 0.08
+  default:
+    instance-types:
+      m5.large: 0.1
+      m5.xlarge: 0.2
+    volumes:
+      ebs: 0.045
+`
+
+func (*catalogueSuite) TestParse(c *gc.C) {
+	catalogue, err := pricing.Parse([]byte(catalogueYAML))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(catalogue, jc.DeepEquals, &pricing.Catalogue{
+		Currency: "USD",
+		Regions: map[string]pricing.RegionPrices{
+			"us-east-1": {
+				InstanceTypes: map[string]float64{"m5.large": 0.096},
+				Volumes:       map[string]float64{"ebs-ssd": 0.08},
+			},
+			"default": {
+				InstanceTypes: map[string]float64{"m5.large": 0.1, "m5.xlarge": 0.2},
+				Volumes:       map[string]float64{"ebs": 0.045},
+			},
+		},
+	})
+}
+
+func (*catalogueSuite) TestParseInvalid(c *gc.C) {
+	for i, test := range []struct {
+		data string
+		err  string
+	}{{
+		data: "regions: {us-east-1: {instance-types: {m5.large: 1}}}",
+		err:  "catalogue with no currency not valid",
+	}, {
+		data: "currency: USD\nregions: {us-east-1: {instance-types: {m5.large: -1}}}",
+		err:  `negative price for instance type "m5.large" in region "us-east-1" not valid`,
+	}, {
+		data: "currency: USD\nregions: {us-east-1: {volumes: {ebs: -1}}}",
+		err:  `negative price for storage pool "ebs" in region "us-east-1" not valid`,
+	}, {
+		data: "currency: USD\nprices: {}",
+		err:  `(?s).*field prices not found.*`,
+	}} {
+		c.Logf("test %d", i)
+		_, err := pricing.Parse([]byte(test.data))
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (*catalogueSuite) TestPrices(c *gc.C) {
+	catalogue, err := pricing.Parse([]byte(catalogueYAML))
+	c.Assert(err, jc.ErrorIsNil)
+
+	price, ok := catalogue.InstanceTypePrice("us-east-1", "m5.large")
+	c.Check(ok, jc.IsTrue)
+	c.Check(price, gc.Equals, 0.096)
+
+	// The default region applies when the region has no price.
+	price, ok = catalogue.InstanceTypePrice("us-east-1", "m5.xlarge")
+	c.Check(ok, jc.IsTrue)
+	c.Check(price, gc.Equals, 0.2)
+	price, ok = catalogue.InstanceTypePrice("eu-west-1", "m5.large")
+	c.Check(ok, jc.IsTrue)
+	c.Check(price, gc.Equals, 0.1)
+
+	_, ok = catalogue.InstanceTypePrice("us-east-1", "t3.micro")
+	c.Check(ok, jc.IsFalse)
+
+	price, ok = catalogue.VolumePrice("us-east-1", "ebs-ssd")
+	c.Check(ok, jc.IsTrue)
+	c.Check(price, gc.Equals, 0.08)
+	_, ok = catalogue.VolumePrice("eu-west-1", "ebs-ssd")
+	c.Check(ok, jc.IsFalse)
+
+	var nilCatalogue *pricing.Catalogue
+	_, ok = nilCatalogue.VolumePrice("us-east-1", "ebs")
+	c.Check(ok, jc.IsFalse)
+}
+
+func (*catalogueSuite) TestFileSource(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "prices.yaml")
+	err := os.WriteFile(path, []byte(catalogueYAML), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	catalogue, err := pricing.FileSource(path).PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(catalogue.Currency, gc.Equals, "USD")
+
+	_, err = pricing.FileSource(filepath.Join(c.MkDir(), "missing.yaml")).PriceCatalogue(context.Background())
+	c.Check(err, gc.ErrorMatches, "reading price catalogue: .*no such file or directory")
+}
+
+func (*catalogueSuite) TestMerge(c *gc.C) {
+	first := &pricing.Catalogue{
+		Currency: "USD",
+		Regions: map[string]pricing.RegionPrices{
+			"us-east-1": {InstanceTypes: map[string]float64{"m5.large": 0.09}},
+		},
+	}
+	second := &pricing.Catalogue{
+		Currency: "USD",
+		Regions: map[string]pricing.RegionPrices{
+			"us-east-1": {
+				InstanceTypes: map[string]float64{"m5.large": 0.096, "m5.xlarge": 0.192},
+				Volumes:       map[string]float64{"ebs": 0.045},
+			},
+		},
+	}
+	merged, err := pricing.Merge(first, nil, second)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(merged, jc.DeepEquals, &pricing.Catalogue{
+		Currency: "USD",
+		Regions: map[string]pricing.RegionPrices{
+			"us-east-1": {
+				InstanceTypes: map[string]float64{"m5.large": 0.09, "m5.xlarge": 0.192},
+				Volumes:       map[string]float64{"ebs": 0.045},
+			},
+		},
+	})
+}
+
+func (*catalogueSuite) TestMergeCurrencyMismatch(c *gc.C) {
+	_, err := pricing.Merge(&pricing.Catalogue{Currency: "USD"}, &pricing.Catalogue{Currency: "EUR"})
+	c.Check(err, gc.ErrorMatches, "merging EUR prices with USD prices not valid")
+}
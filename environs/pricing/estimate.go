@@ -0,0 +1,120 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pricing
+
+import "math"
+
+// HoursPerMonth is the number of hours in an average month, used to turn
+// hourly instance type prices into monthly ones.
+const HoursPerMonth = 730
+
+// Estimate holds the estimated monthly cost of a set of machines and
+// volumes.
+type Estimate struct {
+	// Currency is the currency in which the costs are expressed.
+	Currency string `yaml:"currency,omitempty" json:"currency,omitempty"`
+
+	// Machines holds the cost of the machines.
+	Machines []MachineCost `yaml:"machines,omitempty" json:"machines,omitempty"`
+
+	// Volumes holds the cost of the volumes.
+	Volumes []VolumeCost `yaml:"volumes,omitempty" json:"volumes,omitempty"`
+
+	// Total is the monthly cost of all the priced machines and volumes.
+	Total float64 `yaml:"monthly-total" json:"monthly-total"`
+
+	// Unpriced holds the IDs of the machines and volumes that have no
+	// price in the catalogue, and so are not included in the total.
+	Unpriced []string `yaml:"unpriced,omitempty" json:"unpriced,omitempty"`
+}
+
+// MachineCost holds the cost of one or more machines of the same instance
+// type.
+type MachineCost struct {
+	Id           string  `yaml:"id" json:"id"`
+	InstanceType string  `yaml:"instance-type,omitempty" json:"instance-type,omitempty"`
+	Count        int     `yaml:"count" json:"count"`
+	Hourly       float64 `yaml:"hourly,omitempty" json:"hourly,omitempty"`
+	Monthly      float64 `yaml:"monthly,omitempty" json:"monthly,omitempty"`
+}
+
+// VolumeCost holds the cost of one or more volumes of the same size from
+// the same storage pool.
+type VolumeCost struct {
+	Id      string  `yaml:"id" json:"id"`
+	Pool    string  `yaml:"pool,omitempty" json:"pool,omitempty"`
+	Size    uint64  `yaml:"size" json:"size"`
+	Count   int     `yaml:"count" json:"count"`
+	Monthly float64 `yaml:"monthly,omitempty" json:"monthly,omitempty"`
+}
+
+// Estimator builds an Estimate using the prices of a single region of a
+// catalogue.
+type Estimator struct {
+	catalogue *Catalogue
+	region    string
+	estimate  Estimate
+	total     float64
+}
+
+// NewEstimator returns an Estimator using the prices of the region in the
+// input catalogue.
+func NewEstimator(catalogue *Catalogue, region string) *Estimator {
+	e := &Estimator{
+		catalogue: catalogue,
+		region:    region,
+	}
+	if catalogue != nil {
+		e.estimate.Currency = catalogue.Currency
+	}
+	return e
+}
+
+// AddMachines adds count machines of the instance type to the estimate.
+func (e *Estimator) AddMachines(id, instanceType string, count int) {
+	cost := MachineCost{
+		Id:           id,
+		InstanceType: instanceType,
+		Count:        count,
+	}
+	if hourly, ok := e.catalogue.InstanceTypePrice(e.region, instanceType); ok {
+		monthly := hourly * HoursPerMonth * float64(count)
+		cost.Hourly = hourly
+		cost.Monthly = roundCents(monthly)
+		e.total += monthly
+	} else {
+		e.estimate.Unpriced = append(e.estimate.Unpriced, id)
+	}
+	e.estimate.Machines = append(e.estimate.Machines, cost)
+}
+
+// AddVolumes adds count volumes of the input size, in MiB, from the
+// storage pool to the estimate.
+func (e *Estimator) AddVolumes(id, pool string, size uint64, count int) {
+	cost := VolumeCost{
+		Id:    id,
+		Pool:  pool,
+		Size:  size,
+		Count: count,
+	}
+	if perGiB, ok := e.catalogue.VolumePrice(e.region, pool); ok && size > 0 {
+		monthly := perGiB * float64(size) / 1024 * float64(count)
+		cost.Monthly = roundCents(monthly)
+		e.total += monthly
+	} else {
+		e.estimate.Unpriced = append(e.estimate.Unpriced, id)
+	}
+	e.estimate.Volumes = append(e.estimate.Volumes, cost)
+}
+
+// Estimate returns the estimate of everything added so far.
+func (e *Estimator) Estimate() Estimate {
+	estimate := e.estimate
+	estimate.Total = roundCents(e.total)
+	return estimate
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pricing_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/pricing"
+)
+
+type estimateSuite struct{}
+
+var _ = gc.Suite(&estimateSuite{})
+
+var testCatalogue = &pricing.Catalogue{
+	Currency: "USD",
+	Regions: map[string]pricing.RegionPrices{
+		"us-east-1": {
+			InstanceTypes: map[string]float64{"m5.large": 0.096, "c5.large": 0.085},
+			Volumes:       map[string]float64{"ebs": 0.1},
+		},
+	},
+}
+
+func (*estimateSuite) TestEstimate(c *gc.C) {
+	estimator := pricing.NewEstimator(testCatalogue, "us-east-1")
+	estimator.AddMachines("0", "m5.large", 1)
+	estimator.AddMachines("mysql", "c5.large", 3)
+	estimator.AddMachines("1", "t3.micro", 1)
+	estimator.AddVolumes("0", "ebs", 10240, 1)
+	estimator.AddVolumes("mysql/data", "ebs", 512, 3)
+	estimator.AddVolumes("1", "loop", 1024, 1)
+
+	c.Check(estimator.Estimate(), jc.DeepEquals, pricing.Estimate{
+		Currency: "USD",
+		Machines: []pricing.MachineCost{
+			{Id: "0", InstanceType: "m5.large", Count: 1, Hourly: 0.096, Monthly: 70.08},
+			{Id: "mysql", InstanceType: "c5.large", Count: 3, Hourly: 0.085, Monthly: 186.15},
+			{Id: "1", InstanceType: "t3.micro", Count: 1},
+		},
+		Volumes: []pricing.VolumeCost{
+			{Id: "0", Pool: "ebs", Size: 10240, Count: 1, Monthly: 1},
+			{Id: "mysql/data", Pool: "ebs", Size: 512, Count: 3, Monthly: 0.15},
+			{Id: "1", Pool: "loop", Size: 1024, Count: 1},
+		},
+		Total:    257.38,
+		Unpriced: []string{"1", "1"},
+	})
+}
+
+func (*estimateSuite) TestEstimateNoCatalogue(c *gc.C) {
+	estimator := pricing.NewEstimator(nil, "us-east-1")
+	estimator.AddMachines("0", "m5.large", 1)
+	c.Check(estimator.Estimate(), jc.DeepEquals, pricing.Estimate{
+		Machines: []pricing.MachineCost{{Id: "0", InstanceType: "m5.large", Count: 1}},
+		Unpriced: []string{"0"},
+	})
+}
+
+func (*estimateSuite) TestCheapestInstanceType(c *gc.C) {
+	itypes := []instances.InstanceType{
+		{Name: "t3.micro"}, {Name: "m5.large"}, {Name: "c5.large"},
+	}
+	itype, ok := testCatalogue.CheapestInstanceType("us-east-1", itypes)
+	c.Check(ok, jc.IsTrue)
+	c.Check(itype.Name, gc.Equals, "c5.large")
+
+	_, ok = testCatalogue.CheapestInstanceType("eu-west-1", itypes)
+	c.Check(ok, jc.IsFalse)
+}
+
+func (*estimateSuite) TestMatchingHardware(c *gc.C) {
+	itypes := []instances.InstanceType{
+		{Name: "m5.large", Arch: arch.AMD64, CpuCores: 2, Mem: 8192},
+		{Name: "c5.large", Arch: arch.AMD64, CpuCores: 2, Mem: 4096},
+		{Name: "m6g.large", Arch: arch.ARM64, CpuCores: 2, Mem: 8192},
+	}
+	amd64, cores, mem := arch.AMD64, uint64(2), uint64(8192)
+	matching := pricing.MatchingHardware(itypes, instance.HardwareCharacteristics{
+		Arch: &amd64, CpuCores: &cores, Mem: &mem,
+	})
+	c.Check(matching, jc.DeepEquals, itypes[:1])
+
+	matching = pricing.MatchingHardware(itypes, instance.HardwareCharacteristics{Mem: &mem})
+	c.Check(matching, jc.DeepEquals, []instances.InstanceType{itypes[0], itypes[2]})
+}
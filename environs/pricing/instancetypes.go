@@ -0,0 +1,47 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package pricing
+
+import (
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/instances"
+)
+
+// CheapestInstanceType returns the cheapest of the input instance types
+// that has a price in the region, and whether any of them has a price.
+func (c *Catalogue) CheapestInstanceType(region string, itypes []instances.InstanceType) (instances.InstanceType, bool) {
+	var (
+		cheapest instances.InstanceType
+		lowest   float64
+		found    bool
+	)
+	for _, itype := range itypes {
+		price, ok := c.InstanceTypePrice(region, itype.Name)
+		if !ok || (found && price >= lowest) {
+			continue
+		}
+		cheapest, lowest, found = itype, price, true
+	}
+	return cheapest, found
+}
+
+// MatchingHardware returns the instance types whose architecture, cores
+// and memory are exactly those of the hardware characteristics. The
+// characteristics that are not set match any instance type.
+func MatchingHardware(itypes []instances.InstanceType, hc instance.HardwareCharacteristics) []instances.InstanceType {
+	var matching []instances.InstanceType
+	for _, itype := range itypes {
+		if hc.Arch != nil && itype.Arch != *hc.Arch {
+			continue
+		}
+		if hc.CpuCores != nil && itype.CpuCores != *hc.CpuCores {
+			continue
+		}
+		if hc.Mem != nil && itype.Mem != *hc.Mem {
+			continue
+		}
+		matching = append(matching, itype)
+	}
+	return matching
+}
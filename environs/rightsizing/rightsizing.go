@@ -0,0 +1,151 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package rightsizing recommends instance types for the machines of an
+// application from the CPU and memory utilisation reported by their
+// agents.
+package rightsizing
+
+import (
+	"math"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/pricing"
+)
+
+const (
+	// MinSamples is the fewest utilisation samples of a machine from
+	// which its requirements are derived. With the default five minute
+	// sampling period this is an hour of data.
+	MinSamples = 12
+
+	// TargetCPU is the peak CPU utilisation, as a percentage, that a
+	// recommended instance type should run at.
+	TargetCPU = 70.0
+
+	// TargetMemory is the peak memory utilisation, as a percentage, that
+	// a recommended instance type should run at.
+	TargetMemory = 80.0
+)
+
+// Requirement is the capacity that an instance type needs to run a
+// workload at the target utilisation.
+type Requirement struct {
+	// CpuCores is the number of CPU cores needed.
+	CpuCores float64
+
+	// Mem is the memory needed, in MiB.
+	Mem float64
+}
+
+// RequirementOf returns the capacity needed to run the workload of a
+// machine with the given cores and memory (in MiB), at whose peak the
+// given percentages of CPU and memory were in use.
+func RequirementOf(cores, mem uint64, peakCPU, peakMemory float64) Requirement {
+	return Requirement{
+		CpuCores: float64(cores) * peakCPU / TargetCPU,
+		Mem:      float64(mem) * peakMemory / TargetMemory,
+	}
+}
+
+// Max returns the larger of each capacity of the two requirements.
+func (r Requirement) Max(other Requirement) Requirement {
+	return Requirement{
+		CpuCores: math.Max(r.CpuCores, other.CpuCores),
+		Mem:      math.Max(r.Mem, other.Mem),
+	}
+}
+
+// FitsIn reports whether the instance type has the capacity required.
+func (r Requirement) FitsIn(itype instances.InstanceType) bool {
+	return float64(itype.CpuCores) >= r.CpuCores && float64(itype.Mem) >= r.Mem
+}
+
+// Change describes how a recommended instance type differs from the
+// current one.
+type Change string
+
+const (
+	// Keep means that the current instance type is the best fit.
+	Keep Change = "keep"
+
+	// Downsize means that a cheaper instance type has the capacity
+	// required.
+	Downsize Change = "downsize"
+
+	// Upsize means that the current instance type does not have the
+	// capacity required.
+	Upsize Change = "upsize"
+)
+
+// Recommendation is an instance type recommended for a workload.
+type Recommendation struct {
+	InstanceType instances.InstanceType
+	Change       Change
+}
+
+// Recommend returns the cheapest of the candidate instance types that
+// fits the requirement, compared with the current instance type. The
+// current instance type is kept if it fits and no candidate is cheaper.
+// Instance types are compared by their price in the catalogue, which may
+// be nil, then by their cost, and then by their size. Candidates with a
+// different architecture from the current instance type are ignored. A
+// NotFound error is returned if no candidate fits.
+func Recommend(
+	current instances.InstanceType, req Requirement,
+	candidates []instances.InstanceType, catalogue *pricing.Catalogue, region string,
+) (Recommendation, error) {
+	cheaper := func(a, b instances.InstanceType) bool {
+		return cheaper(a, b, catalogue, region)
+	}
+
+	var (
+		best  instances.InstanceType
+		found bool
+	)
+	for _, candidate := range candidates {
+		if current.Arch != "" && candidate.Arch != current.Arch {
+			continue
+		}
+		if !req.FitsIn(candidate) {
+			continue
+		}
+		if !found || cheaper(candidate, best) {
+			best, found = candidate, true
+		}
+	}
+
+	fits := req.FitsIn(current)
+	switch {
+	case fits && (!found || best.Name == current.Name || !cheaper(best, current)):
+		return Recommendation{InstanceType: current, Change: Keep}, nil
+	case !found:
+		return Recommendation{}, errors.NotFoundf(
+			"instance type with %.1f cores and %.0fMiB of memory", req.CpuCores, req.Mem)
+	case fits:
+		return Recommendation{InstanceType: best, Change: Downsize}, nil
+	default:
+		return Recommendation{InstanceType: best, Change: Upsize}, nil
+	}
+}
+
+// cheaper reports whether instance type a is cheaper than b.
+func cheaper(a, b instances.InstanceType, catalogue *pricing.Catalogue, region string) bool {
+	priceA, okA := catalogue.InstanceTypePrice(region, a.Name)
+	priceB, okB := catalogue.InstanceTypePrice(region, b.Name)
+	if okA && okB && priceA != priceB {
+		return priceA < priceB
+	}
+	if a.Cost > 0 && b.Cost > 0 && a.Cost != b.Cost {
+		return a.Cost < b.Cost
+	}
+	if a.CpuCores != b.CpuCores {
+		return a.CpuCores < b.CpuCores
+	}
+	if a.Mem != b.Mem {
+		return a.Mem < b.Mem
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rightsizing_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/pricing"
+	"github.com/juju/juju/environs/rightsizing"
+)
+
+type rightsizingSuite struct{}
+
+var _ = gc.Suite(&rightsizingSuite{})
+
+var (
+	small  = instances.InstanceType{Name: "small", Arch: "amd64", CpuCores: 2, Mem: 4096, Cost: 100}
+	medium = instances.InstanceType{Name: "medium", Arch: "amd64", CpuCores: 4, Mem: 8192, Cost: 200}
+	large  = instances.InstanceType{Name: "large", Arch: "amd64", CpuCores: 8, Mem: 16384, Cost: 400}
+	arm    = instances.InstanceType{Name: "arm", Arch: "arm64", CpuCores: 4, Mem: 8192, Cost: 50}
+
+	candidates = []instances.InstanceType{large, arm, medium, small}
+)
+
+func (s *rightsizingSuite) TestRequirementOf(c *gc.C) {
+	req := rightsizing.RequirementOf(4, 8192, 35, 40)
+	c.Assert(req, jc.DeepEquals, rightsizing.Requirement{CpuCores: 2, Mem: 4096})
+	c.Assert(req.FitsIn(small), jc.IsTrue)
+	c.Assert(req.FitsIn(instances.InstanceType{CpuCores: 1, Mem: 8192}), jc.IsFalse)
+}
+
+func (s *rightsizingSuite) TestMax(c *gc.C) {
+	req := rightsizing.Requirement{CpuCores: 3, Mem: 1024}.Max(rightsizing.Requirement{CpuCores: 1, Mem: 2048})
+	c.Assert(req, jc.DeepEquals, rightsizing.Requirement{CpuCores: 3, Mem: 2048})
+}
+
+func (s *rightsizingSuite) TestRecommendDownsize(c *gc.C) {
+	rec, err := rightsizing.Recommend(large, rightsizing.Requirement{CpuCores: 1.5, Mem: 3000}, candidates, nil, "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rec, jc.DeepEquals, rightsizing.Recommendation{InstanceType: small, Change: rightsizing.Downsize})
+}
+
+func (s *rightsizingSuite) TestRecommendUpsize(c *gc.C) {
+	rec, err := rightsizing.Recommend(small, rightsizing.Requirement{CpuCores: 3, Mem: 3000}, candidates, nil, "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rec, jc.DeepEquals, rightsizing.Recommendation{InstanceType: medium, Change: rightsizing.Upsize})
+}
+
+func (s *rightsizingSuite) TestRecommendKeep(c *gc.C) {
+	rec, err := rightsizing.Recommend(medium, rightsizing.Requirement{CpuCores: 3, Mem: 6000}, candidates, nil, "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rec, jc.DeepEquals, rightsizing.Recommendation{InstanceType: medium, Change: rightsizing.Keep})
+}
+
+func (s *rightsizingSuite) TestRecommendUsesCatalogue(c *gc.C) {
+	// In this region the medium instance type is cheaper than the small one.
+	catalogue := &pricing.Catalogue{
+		Regions: map[string]pricing.RegionPrices{
+			"west": {InstanceTypes: map[string]float64{"small": 0.2, "medium": 0.1, "large": 0.4}},
+		},
+	}
+	rec, err := rightsizing.Recommend(large, rightsizing.Requirement{CpuCores: 1, Mem: 1024}, candidates, catalogue, "west")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rec, jc.DeepEquals, rightsizing.Recommendation{InstanceType: medium, Change: rightsizing.Downsize})
+}
+
+func (s *rightsizingSuite) TestRecommendUnknownCurrent(c *gc.C) {
+	// The current instance type is known only from the machine hardware.
+	current := instances.InstanceType{Arch: "amd64", CpuCores: 8, Mem: 16384}
+	rec, err := rightsizing.Recommend(current, rightsizing.Requirement{CpuCores: 1, Mem: 1024}, candidates, nil, "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rec, jc.DeepEquals, rightsizing.Recommendation{InstanceType: small, Change: rightsizing.Downsize})
+}
+
+func (s *rightsizingSuite) TestRecommendNoneFits(c *gc.C) {
+	_, err := rightsizing.Recommend(large, rightsizing.Requirement{CpuCores: 16, Mem: 1024}, candidates, nil, "")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+	c.Assert(err, gc.ErrorMatches, `instance type with 16.0 cores and 1024MiB of memory not found`)
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package admission provides the rules language of admission policies, which
+// the controller evaluates before model-changing operations such as deploying
+// an application or exposing it.
+//
+// A rule describes the requests that a policy denies, as one or more
+// conditions joined by "and". Each condition compares a field of the request
+// with a quoted string or a list of quoted strings:
+//
+//	operation == "deploy" and charm.risk != "stable"
+//	operation == "expose" and expose.cidrs not within ["10.0.0.0/8"]
+//	operation in ["deploy", "set-constraints"] and constraints.zones is empty
+//
+// Fields may have several values. The operators are:
+//
+//	field == "v"             a value of the field is v
+//	field != "v"             no value of the field is v
+//	field in [...]           every value of the field is in the list
+//	field not in [...]       a value of the field is not in the list
+//	field within [...]       every value of the field is a CIDR within one
+//	                         of the CIDRs of the list
+//	field not within [...]   a value of the field is not within the CIDRs
+//	field is empty           the field has no values
+//	field is set             the field has a value
+package admission
@@ -0,0 +1,169 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission
+
+import (
+	"sort"
+)
+
+// Operation is a model-changing operation which is subject to admission.
+type Operation string
+
+const (
+	// Deploy is the deployment of an application.
+	Deploy Operation = "deploy"
+	// AddUnit is the addition of units to an application.
+	AddUnit Operation = "add-unit"
+	// Expose is the exposure of the endpoints of an application.
+	Expose Operation = "expose"
+	// Integrate is the addition of a relation between two applications.
+	Integrate Operation = "integrate"
+	// SetConfig is a change to the configuration of an application.
+	SetConfig Operation = "set-config"
+	// SetConstraints is a change to the constraints of an application, or of
+	// the model.
+	SetConstraints Operation = "set-constraints"
+	// Refresh is a change to the charm of an application.
+	Refresh Operation = "refresh"
+	// SetModelConfig is a change to the configuration of the model.
+	SetModelConfig Operation = "set-model-config"
+	// AddMachine is the addition of machines to the model.
+	AddMachine Operation = "add-machine"
+)
+
+// operations holds every operation which is subject to admission.
+var operations = []Operation{
+	Deploy, AddUnit, Expose, Integrate, SetConfig, SetConstraints,
+	Refresh, SetModelConfig, AddMachine,
+}
+
+// Operations returns the operations which are subject to admission.
+func Operations() []Operation {
+	return append([]Operation(nil), operations...)
+}
+
+// IsValid reports whether the operation is subject to admission.
+func (op Operation) IsValid() bool {
+	for _, valid := range operations {
+		if op == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// The fields of requests which rules can refer to.
+const (
+	// FieldOperation is the operation of the request.
+	FieldOperation = "operation"
+	// FieldApplication is the name of the applications changed by the
+	// request. It is not set for changes to the model or its machines.
+	FieldApplication = "application"
+	// FieldCharm is the name of the charm being deployed, or refreshed to.
+	FieldCharm = "charm"
+	// FieldCharmSource is the source of the charm being deployed, such as
+	// "charm-hub" or "local".
+	FieldCharmSource = "charm.source"
+	// FieldCharmChannel is the channel of the charm being deployed, such as
+	// "latest/edge".
+	FieldCharmChannel = "charm.channel"
+	// FieldCharmRisk is the risk of the channel of the charm being
+	// deployed, such as "stable" or "edge".
+	FieldCharmRisk = "charm.risk"
+	// FieldConstraintsZones is the availability zones of the constraints of
+	// the application, machine or model.
+	FieldConstraintsZones = "constraints.zones"
+	// FieldExposeCIDRs is the CIDRs an application is exposed to. Exposing
+	// an application without CIDRs exposes it to every address.
+	FieldExposeCIDRs = "expose.cidrs"
+	// FieldExposeEndpoints is the endpoints exposed. Exposing an
+	// application without endpoints exposes every endpoint.
+	FieldExposeEndpoints = "expose.endpoints"
+	// FieldExposeSpaces is the spaces an application is exposed to.
+	FieldExposeSpaces = "expose.spaces"
+	// FieldEndpoints is the endpoints being integrated, as
+	// "application:endpoint".
+	FieldEndpoints = "endpoints"
+	// FieldConfigKeys is the configuration keys being set, of an
+	// application or the model.
+	FieldConfigKeys = "config.keys"
+)
+
+// fields holds every field that rules can refer to.
+var fields = map[string]bool{
+	FieldOperation:        true,
+	FieldApplication:      true,
+	FieldCharm:            true,
+	FieldCharmSource:      true,
+	FieldCharmChannel:     true,
+	FieldCharmRisk:        true,
+	FieldConstraintsZones: true,
+	FieldExposeCIDRs:      true,
+	FieldExposeEndpoints:  true,
+	FieldExposeSpaces:     true,
+	FieldEndpoints:        true,
+	FieldConfigKeys:       true,
+}
+
+// IsField reports whether rules can refer to the field.
+func IsField(name string) bool {
+	return fields[name]
+}
+
+// Fields returns the fields that rules can refer to, in order.
+func Fields() []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Request describes a model-changing operation to admit.
+type Request struct {
+	fields map[string][]string
+}
+
+// NewRequest returns a request for the operation.
+func NewRequest(op Operation) *Request {
+	return &Request{
+		fields: map[string][]string{
+			FieldOperation: {string(op)},
+		},
+	}
+}
+
+// Operation returns the operation of the request.
+func (r *Request) Operation() Operation {
+	if values := r.fields[FieldOperation]; len(values) > 0 {
+		return Operation(values[0])
+	}
+	return ""
+}
+
+// Set adds the values to the field of the request, and returns the request
+// so calls can be chained. Empty values are ignored.
+func (r *Request) Set(field string, values ...string) *Request {
+	for _, value := range values {
+		if value != "" {
+			r.fields[field] = append(r.fields[field], value)
+		}
+	}
+	return r
+}
+
+// Values returns the values of the field of the request.
+func (r *Request) Values(field string) []string {
+	return r.fields[field]
+}
+
+// Fields returns the values of every field of the request which is set.
+func (r *Request) Fields() map[string][]string {
+	result := make(map[string][]string, len(r.fields))
+	for field, values := range r.fields {
+		result[field] = append([]string(nil), values...)
+	}
+	return result
+}
@@ -0,0 +1,395 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// ErrRuleNotValid is returned when a rule cannot be parsed.
+	ErrRuleNotValid = errors.ConstError("admission rule not valid")
+)
+
+// operator is an operator of a condition.
+type operator string
+
+const (
+	opEqual     operator = "=="
+	opNotEqual  operator = "!="
+	opIn        operator = "in"
+	opNotIn     operator = "not in"
+	opWithin    operator = "within"
+	opNotWithin operator = "not within"
+	opIsEmpty   operator = "is empty"
+	opIsSet     operator = "is set"
+)
+
+// condition compares a field of a request with values.
+type condition struct {
+	field  string
+	op     operator
+	values []string
+	// cidrs holds the parsed values of within conditions.
+	cidrs []*net.IPNet
+}
+
+// Rule describes the requests that an admission policy denies.
+type Rule struct {
+	conditions []condition
+}
+
+// ParseRule parses the rule. If the rule is not valid an error satisfying
+// ErrRuleNotValid is returned.
+func ParseRule(rule string) (Rule, error) {
+	tokens, err := tokenize(rule)
+	if err != nil {
+		return Rule{}, errors.Errorf("%w: %w", ErrRuleNotValid, err)
+	}
+	p := &parser{tokens: tokens}
+	result, err := p.parseRule()
+	if err != nil {
+		return Rule{}, errors.Errorf("%w: %w", ErrRuleNotValid, err)
+	}
+	return result, nil
+}
+
+// Matches reports whether the request is described by the rule, and so is
+// denied by the policy of the rule.
+func (r Rule) Matches(req *Request) bool {
+	if len(r.conditions) == 0 {
+		return false
+	}
+	for _, cond := range r.conditions {
+		if !cond.matches(req.Values(cond.field)) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the rule in its canonical form.
+func (r Rule) String() string {
+	parts := make([]string, len(r.conditions))
+	for i, cond := range r.conditions {
+		parts[i] = cond.String()
+	}
+	return strings.Join(parts, " and ")
+}
+
+func (c condition) String() string {
+	switch c.op {
+	case opIsEmpty, opIsSet:
+		return c.field + " " + string(c.op)
+	case opEqual, opNotEqual:
+		return c.field + " " + string(c.op) + " " + strconv.Quote(c.values[0])
+	}
+	quoted := make([]string, len(c.values))
+	for i, value := range c.values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return c.field + " " + string(c.op) + " [" + strings.Join(quoted, ", ") + "]"
+}
+
+func (c condition) matches(values []string) bool {
+	switch c.op {
+	case opEqual:
+		return contains(values, c.values[0])
+	case opNotEqual:
+		return !contains(values, c.values[0])
+	case opIn:
+		return allIn(values, c.values)
+	case opNotIn:
+		return !allIn(values, c.values)
+	case opWithin:
+		return allWithin(values, c.cidrs)
+	case opNotWithin:
+		return !allWithin(values, c.cidrs)
+	case opIsEmpty:
+		return len(values) == 0
+	case opIsSet:
+		return len(values) > 0
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func allIn(values, list []string) bool {
+	for _, value := range values {
+		if !contains(list, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// allWithin reports whether every value is a CIDR or address within one of
+// the CIDRs. Values which are not addresses are never within the CIDRs.
+func allWithin(values []string, cidrs []*net.IPNet) bool {
+	for _, value := range values {
+		if !within(value, cidrs) {
+			return false
+		}
+	}
+	return true
+}
+
+func within(value string, cidrs []*net.IPNet) bool {
+	ip, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		if ip = net.ParseIP(value); ip == nil {
+			return false
+		}
+		bits := 8 * len(ip)
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 32
+		}
+		ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+	ones, bits := ipNet.Mask.Size()
+	for _, cidr := range cidrs {
+		cidrOnes, cidrBits := cidr.Mask.Size()
+		if cidrBits == bits && cidrOnes <= ones && cidr.Contains(ipNet.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenKind is the kind of a token of a rule.
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenOperator
+	tokenOpen
+	tokenClose
+	tokenComma
+)
+
+// token is a lexical token of a rule.
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+func tokenize(rule string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(rule); {
+		c := rule[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenOpen, value: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenClose, value: "]"})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ","})
+			i++
+		case strings.HasPrefix(rule[i:], "=="), strings.HasPrefix(rule[i:], "!="):
+			tokens = append(tokens, token{kind: tokenOperator, value: rule[i : i+2]})
+			i += 2
+		case c == '"':
+			end := i + 1
+			for ; end < len(rule) && rule[end] != '"'; end++ {
+				if rule[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(rule) {
+				return nil, errors.Errorf("unterminated string at offset %d", i)
+			}
+			value, err := strconv.Unquote(rule[i : end+1])
+			if err != nil {
+				return nil, errors.Errorf("invalid string at offset %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value})
+			i = end + 1
+		case isWordChar(rune(c)):
+			end := i
+			for end < len(rule) && isWordChar(rune(rule[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: rule[i:end]})
+			i = end
+		default:
+			return nil, errors.Errorf("unexpected character %q at offset %d", c, i)
+		}
+	}
+	return tokens, nil
+}
+
+func isWordChar(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' || r == '-' || r == '_')
+}
+
+// parser parses the tokens of a rule.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) parseRule() (Rule, error) {
+	var rule Rule
+	for {
+		cond, err := p.parseCondition()
+		if err != nil {
+			return Rule{}, err
+		}
+		rule.conditions = append(rule.conditions, cond)
+		if p.done() {
+			return rule, nil
+		}
+		if !p.acceptWord("and") {
+			return Rule{}, errors.Errorf("expected \"and\", got %q", p.peek().value)
+		}
+	}
+}
+
+func (p *parser) parseCondition() (condition, error) {
+	tok, ok := p.next()
+	if !ok {
+		return condition{}, errors.New("expected a field")
+	}
+	if tok.kind != tokenWord || !IsField(tok.value) {
+		return condition{}, errors.Errorf("unknown field %q", tok.value)
+	}
+	cond := condition{field: tok.value}
+
+	op, err := p.parseOperator()
+	if err != nil {
+		return condition{}, err
+	}
+	cond.op = op
+
+	switch op {
+	case opIsEmpty, opIsSet:
+		return cond, nil
+	case opEqual, opNotEqual:
+		tok, ok := p.next()
+		if !ok || tok.kind != tokenString {
+			return condition{}, errors.Errorf("expected a quoted string after %q", op)
+		}
+		cond.values = []string{tok.value}
+		return cond, nil
+	}
+
+	if cond.values, err = p.parseList(); err != nil {
+		return condition{}, errors.Errorf("%s: %w", op, err)
+	}
+	if op == opWithin || op == opNotWithin {
+		for _, value := range cond.values {
+			_, cidr, err := net.ParseCIDR(value)
+			if err != nil {
+				return condition{}, errors.Errorf("%q is not a valid CIDR", value)
+			}
+			cond.cidrs = append(cond.cidrs, cidr)
+		}
+	}
+	return cond, nil
+}
+
+func (p *parser) parseOperator() (operator, error) {
+	tok, ok := p.next()
+	if !ok {
+		return "", errors.New("expected an operator")
+	}
+	if tok.kind == tokenOperator {
+		return operator(tok.value), nil
+	}
+	if tok.kind == tokenWord {
+		switch tok.value {
+		case "in":
+			return opIn, nil
+		case "within":
+			return opWithin, nil
+		case "not":
+			switch {
+			case p.acceptWord("in"):
+				return opNotIn, nil
+			case p.acceptWord("within"):
+				return opNotWithin, nil
+			}
+		case "is":
+			switch {
+			case p.acceptWord("empty"):
+				return opIsEmpty, nil
+			case p.acceptWord("set"):
+				return opIsSet, nil
+			}
+		}
+	}
+	return "", errors.Errorf("unknown operator %q", tok.value)
+}
+
+func (p *parser) parseList() ([]string, error) {
+	if tok, ok := p.next(); !ok || tok.kind != tokenOpen {
+		return nil, errors.New("expected a list")
+	}
+	var values []string
+	for {
+		tok, ok := p.next()
+		if !ok || tok.kind != tokenString {
+			return nil, errors.New("expected a quoted string in list")
+		}
+		values = append(values, tok.value)
+
+		tok, ok = p.next()
+		if !ok {
+			return nil, errors.New("unterminated list")
+		}
+		switch tok.kind {
+		case tokenComma:
+		case tokenClose:
+			return values, nil
+		default:
+			return nil, errors.Errorf("unexpected %q in list", tok.value)
+		}
+	}
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() (token, bool) {
+	if p.done() {
+		return token{}, false
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	return tok, true
+}
+
+func (p *parser) acceptWord(word string) bool {
+	if tok := p.peek(); tok.kind == tokenWord && tok.value == word {
+		p.pos++
+		return true
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package admission
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type ruleSuite struct{}
+
+var _ = gc.Suite(&ruleSuite{})
+
+func (*ruleSuite) TestParseRuleString(c *gc.C) {
+	tests := []struct {
+		rule     string
+		expected string
+	}{{
+		rule:     `operation=="deploy"`,
+		expected: `operation == "deploy"`,
+	}, {
+		rule:     `operation == "deploy" and charm.risk != "stable"`,
+		expected: `operation == "deploy" and charm.risk != "stable"`,
+	}, {
+		rule:     `operation in ["deploy","set-constraints"]  and constraints.zones is empty`,
+		expected: `operation in ["deploy", "set-constraints"] and constraints.zones is empty`,
+	}, {
+		rule:     `expose.cidrs not within ["10.0.0.0/8", "192.168.0.0/16"]`,
+		expected: `expose.cidrs not within ["10.0.0.0/8", "192.168.0.0/16"]`,
+	}, {
+		rule:     `config.keys is set and application not in ["postgresql"]`,
+		expected: `config.keys is set and application not in ["postgresql"]`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.rule)
+		rule, err := ParseRule(test.rule)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(rule.String(), gc.Equals, test.expected)
+	}
+}
+
+func (*ruleSuite) TestParseRuleNotValid(c *gc.C) {
+	tests := []struct {
+		rule string
+		err  string
+	}{{
+		rule: ``,
+		err:  `admission rule not valid: expected a field`,
+	}, {
+		rule: `colour == "blue"`,
+		err:  `admission rule not valid: unknown field "colour"`,
+	}, {
+		rule: `operation = "deploy"`,
+		err:  `admission rule not valid: unexpected character '=' at offset 10`,
+	}, {
+		rule: `operation == deploy`,
+		err:  `admission rule not valid: expected a quoted string after "=="`,
+	}, {
+		rule: `operation == "deploy`,
+		err:  `admission rule not valid: unterminated string at offset 13`,
+	}, {
+		rule: `operation is blue`,
+		err:  `admission rule not valid: unknown operator "is"`,
+	}, {
+		rule: `operation in "deploy"`,
+		err:  `admission rule not valid: in: expected a list`,
+	}, {
+		rule: `operation in ["deploy"`,
+		err:  `admission rule not valid: in: unterminated list`,
+	}, {
+		rule: `expose.cidrs within ["10.0.0.0"]`,
+		err:  `admission rule not valid: "10.0.0.0" is not a valid CIDR`,
+	}, {
+		rule: `operation == "deploy" or charm.risk == "edge"`,
+		err:  `admission rule not valid: expected "and", got "or"`,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.rule)
+		_, err := ParseRule(test.rule)
+		c.Check(err, jc.ErrorIs, ErrRuleNotValid)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (*ruleSuite) TestMatches(c *gc.C) {
+	deployEdge := NewRequest(Deploy).
+		Set(FieldApplication, "postgresql").
+		Set(FieldCharmRisk, "edge")
+	deployStable := NewRequest(Deploy).
+		Set(FieldApplication, "postgresql").
+		Set(FieldCharmRisk, "stable").
+		Set(FieldConstraintsZones, "zone-a", "zone-b")
+	exposeAll := NewRequest(Expose).
+		Set(FieldExposeCIDRs, "0.0.0.0/0", "::/0")
+	exposePrivate := NewRequest(Expose).
+		Set(FieldExposeCIDRs, "10.1.0.0/16", "192.168.1.1")
+
+	tests := []struct {
+		rule    string
+		request *Request
+		matches bool
+	}{{
+		rule:    `operation == "deploy" and charm.risk != "stable"`,
+		request: deployEdge,
+		matches: true,
+	}, {
+		rule:    `operation == "deploy" and charm.risk != "stable"`,
+		request: deployStable,
+		matches: false,
+	}, {
+		rule:    `operation == "deploy" and charm.risk != "stable"`,
+		request: exposeAll,
+		matches: false,
+	}, {
+		rule:    `operation in ["deploy", "set-constraints"] and constraints.zones is empty`,
+		request: deployEdge,
+		matches: true,
+	}, {
+		rule:    `operation in ["deploy", "set-constraints"] and constraints.zones is empty`,
+		request: deployStable,
+		matches: false,
+	}, {
+		rule:    `constraints.zones not in ["zone-a"]`,
+		request: deployStable,
+		matches: true,
+	}, {
+		rule:    `constraints.zones in ["zone-a", "zone-b", "zone-c"]`,
+		request: deployStable,
+		matches: true,
+	}, {
+		rule:    `expose.cidrs not within ["10.0.0.0/8", "192.168.0.0/16"]`,
+		request: exposeAll,
+		matches: true,
+	}, {
+		rule:    `expose.cidrs not within ["10.0.0.0/8", "192.168.0.0/16"]`,
+		request: exposePrivate,
+		matches: false,
+	}, {
+		rule:    `expose.cidrs within ["10.0.0.0/8"]`,
+		request: exposePrivate,
+		matches: false,
+	}, {
+		rule:    `application == "postgresql" and config.keys is set`,
+		request: deployEdge,
+		matches: false,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %s", i, test.rule)
+		rule, err := ParseRule(test.rule)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(rule.Matches(test.request), gc.Equals, test.matches)
+	}
+}
+
+func (*ruleSuite) TestZeroRuleMatchesNothing(c *gc.C) {
+	c.Assert(Rule{}.Matches(NewRequest(Deploy)), jc.IsFalse)
+}
+
+func (*ruleSuite) TestRequest(c *gc.C) {
+	req := NewRequest(Integrate).Set(FieldEndpoints, "wordpress:db", "", "mysql:db")
+	c.Check(req.Operation(), gc.Equals, Integrate)
+	c.Check(req.Values(FieldEndpoints), jc.DeepEquals, []string{"wordpress:db", "mysql:db"})
+	c.Check(req.Fields(), jc.DeepEquals, map[string][]string{
+		FieldOperation: {"integrate"},
+		FieldEndpoints: {"wordpress:db", "mysql:db"},
+	})
+	c.Check(IsField(FieldEndpoints), jc.IsTrue)
+	c.Check(IsField("colour"), jc.IsFalse)
+}
+
+func (*ruleSuite) TestOperations(c *gc.C) {
+	for _, op := range Operations() {
+		c.Check(op.IsValid(), jc.IsTrue, gc.Commentf("operation %q", op))
+	}
+	c.Check(Operation("destroy").IsValid(), jc.IsFalse)
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auth
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy describes the complexity rules that passwords must meet in
+// addition to the validation performed by Password.Validate. The zero value
+// enforces no additional rules.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters in a password.
+	MinLength int
+
+	// MinCharacterClasses is the minimum number of character classes used by
+	// a password, of lower case letters, upper case letters, digits and
+	// other characters.
+	MinCharacterClasses int
+}
+
+// Check will check that the password meets the policy. All policy
+// violations will satisfy ErrPasswordNotValid. If the password has been
+// destroyed a error of type ErrPasswordDestroyed will be returned.
+func (pp PasswordPolicy) Check(p Password) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	if n := utf8.RuneCount(p.password); n < pp.MinLength {
+		return fmt.Errorf("%w, must be at least %d characters", ErrPasswordNotValid, pp.MinLength)
+	}
+
+	var lower, upper, digit, other bool
+	for _, r := range string(p.password) {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	classes := 0
+	for _, used := range []bool{lower, upper, digit, other} {
+		if used {
+			classes++
+		}
+	}
+	if classes < pp.MinCharacterClasses {
+		return fmt.Errorf(
+			"%w, must use at least %d of lower case letters, upper case letters, digits and symbols",
+			ErrPasswordNotValid, pp.MinCharacterClasses,
+		)
+	}
+	return nil
+}
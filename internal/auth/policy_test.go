@@ -0,0 +1,58 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auth
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type passwordPolicySuite struct{}
+
+var _ = gc.Suite(&passwordPolicySuite{})
+
+func (*passwordPolicySuite) TestZeroPolicy(c *gc.C) {
+	err := PasswordPolicy{}.Check(NewPassword("a"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = PasswordPolicy{}.Check(NewPassword(""))
+	c.Assert(err, jc.ErrorIs, ErrPasswordNotValid)
+}
+
+func (*passwordPolicySuite) TestMinLength(c *gc.C) {
+	policy := PasswordPolicy{MinLength: 8}
+
+	err := policy.Check(NewPassword("hunter2"))
+	c.Assert(err, jc.ErrorIs, ErrPasswordNotValid)
+	c.Assert(err, gc.ErrorMatches, "password not valid, must be at least 8 characters")
+
+	err = policy.Check(NewPassword("hunter22"))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Length is measured in characters, not bytes.
+	err = policy.Check(NewPassword("пароль12"))
+	c.Assert(err, jc.ErrorIsNil)
+	err = policy.Check(NewPassword("пароль1"))
+	c.Assert(err, jc.ErrorIs, ErrPasswordNotValid)
+}
+
+func (*passwordPolicySuite) TestMinCharacterClasses(c *gc.C) {
+	policy := PasswordPolicy{MinCharacterClasses: 3}
+
+	for _, password := range []string{"hunter", "hunter2", "HUNTER2"} {
+		err := policy.Check(NewPassword(password))
+		c.Check(err, jc.ErrorIs, ErrPasswordNotValid)
+	}
+	for _, password := range []string{"Hunter2", "hunter2!", "HUNTER2!"} {
+		err := policy.Check(NewPassword(password))
+		c.Check(err, jc.ErrorIsNil)
+	}
+}
+
+func (*passwordPolicySuite) TestDestroyed(c *gc.C) {
+	p := NewPassword("topsecret")
+	p.Destroy()
+	err := PasswordPolicy{}.Check(p)
+	c.Assert(err, jc.ErrorIs, ErrPasswordDestroyed)
+}
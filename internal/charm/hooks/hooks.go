@@ -94,6 +94,11 @@ const (
 	StorageAttached  Kind = "storage-attached"
 	StorageDetaching Kind = "storage-detaching"
 
+	// StorageResized is run after the underlying volume or filesystem of
+	// attached storage has been resized, so that the charm can grow any
+	// filesystem or application data within it.
+	StorageResized Kind = "storage-resized"
+
 	// These hooks require an associated workload/container, and the name of the workload/container
 	// whose change triggered the hook. The hook file names that these
 	// kinds represent will be prefixed by the workload/container name; for example,
@@ -141,6 +146,7 @@ func RelationHooks() []Kind {
 var storageHooks = []Kind{
 	StorageAttached,
 	StorageDetaching,
+	StorageResized,
 }
 
 // StorageHooks returns all known storage hook kinds.
@@ -176,7 +182,7 @@ func (kind Kind) IsRelation() bool {
 // IsStorage returns whether the Kind represents a storage hook.
 func (kind Kind) IsStorage() bool {
 	switch kind {
-	case StorageAttached, StorageDetaching:
+	case StorageAttached, StorageDetaching, StorageResized:
 		return true
 	}
 	return false
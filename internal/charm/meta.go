@@ -210,6 +210,17 @@ const (
 	RunAsNonRoot RunAs = "non-root"
 )
 
+// DeploymentType defines the kind of workload resource used
+// to run the units of a charm on Kubernetes.
+type DeploymentType string
+
+const (
+	DeploymentDefault   DeploymentType = ""
+	DeploymentStateful  DeploymentType = "stateful"
+	DeploymentStateless DeploymentType = "stateless"
+	DeploymentDaemon    DeploymentType = "daemon"
+)
+
 // Meta represents all the known content that may be defined
 // within a charm's metadata.yaml file.
 type Meta struct {
@@ -233,6 +244,8 @@ type Meta struct {
 	Containers map[string]Container    `json:"containers,omitempty" yaml:"containers,omitempty"`
 	Assumes    *assumes.ExpressionTree `json:"assumes,omitempty" yaml:"assumes,omitempty"`
 	CharmUser  RunAs                   `json:"charm-user,omitempty" yaml:"charm-user,omitempty"`
+
+	DeploymentType DeploymentType `json:"deployment-type,omitempty" yaml:"deployment-type,omitempty"`
 }
 
 // Container specifies the possible systems it supports and mounts it wants.
@@ -533,6 +546,10 @@ func parseMeta(m map[string]interface{}) (*Meta, error) {
 	if err != nil {
 		return nil, errors.Annotatef(err, "parsing charm-user")
 	}
+	meta.DeploymentType, err = parseDeploymentType(m["deployment-type"])
+	if err != nil {
+		return nil, errors.Annotatef(err, "parsing deployment-type")
+	}
 	return &meta, nil
 }
 
@@ -1070,6 +1087,20 @@ func parseCharmUser(value any) (RunAs, error) {
 	}
 }
 
+func parseDeploymentType(value any) (DeploymentType, error) {
+	if value == nil {
+		return DeploymentDefault, nil
+	}
+	v := DeploymentType(value.(string))
+	switch v {
+	case DeploymentStateful, DeploymentStateless, DeploymentDaemon:
+		return v, nil
+	default:
+		return DeploymentDefault, errors.Errorf("invalid deployment-type %q expected one of %s, %s or %s", v,
+			DeploymentStateful, DeploymentStateless, DeploymentDaemon)
+	}
+}
+
 var storageSchema = schema.FieldMap(
 	schema.Fields{
 		"type":      schema.OneOf(schema.Const(string(StorageBlock)), schema.Const(string(StorageFilesystem))),
@@ -1223,6 +1254,7 @@ var charmSchema = schema.FieldMap(
 		"assumes":          schema.List(schema.Any()),
 		"containers":       schema.StringMap(containerSchema),
 		"charm-user":       schema.String(),
+		"deployment-type":  schema.String(),
 	},
 	schema.Defaults{
 		"provides":         schema.Omit,
@@ -1242,6 +1274,7 @@ var charmSchema = schema.FieldMap(
 		"assumes":          schema.Omit,
 		"containers":       schema.Omit,
 		"charm-user":       schema.Omit,
+		"deployment-type":  schema.Omit,
 	},
 )
 
@@ -1265,7 +1298,7 @@ func ensureUnambiguousFormat(raw map[interface{}]interface{}) error {
 	for _, key := range keys {
 		detected := FormatUnknown
 		switch key {
-		case "containers", "assumes", "charm-user":
+		case "containers", "assumes", "charm-user", "deployment-type":
 			detected = FormatV2
 		case "series", "deployment", "min-juju-version":
 			detected = FormatV1
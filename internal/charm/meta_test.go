@@ -1777,3 +1777,43 @@ charm-user: barry
 `))
 	c.Assert(err, gc.ErrorMatches, `parsing charm-user: invalid charm-user "barry" expected one of root, sudoer or non-root`)
 }
+
+func (s *MetaSuite) TestDeploymentType(c *gc.C) {
+	for _, t := range []charm.DeploymentType{
+		charm.DeploymentStateful, charm.DeploymentStateless, charm.DeploymentDaemon,
+	} {
+		meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+deployment-type: ` + string(t) + `
+`))
+		c.Assert(err, gc.IsNil)
+		c.Assert(meta.DeploymentType, gc.Equals, t)
+	}
+
+	meta, err := charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+`))
+	c.Assert(err, gc.IsNil)
+	c.Assert(meta.DeploymentType, gc.Equals, charm.DeploymentDefault)
+
+	_, err = charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+deployment-type: replicaset
+`))
+	c.Assert(err, gc.ErrorMatches, `parsing deployment-type: invalid deployment-type "replicaset" expected one of stateful, stateless or daemon`)
+
+	_, err = charm.ReadMeta(strings.NewReader(`
+name: a
+summary: b
+description: c
+series: [focal]
+deployment-type: daemon
+`))
+	c.Assert(err, gc.ErrorMatches, `ambiguous metadata: keys "series" cannot be used with "deployment-type"`)
+}
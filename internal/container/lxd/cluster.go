@@ -3,7 +3,11 @@
 
 package lxd
 
-import "context"
+import (
+	"context"
+
+	"github.com/juju/errors"
+)
 
 func (s *Server) ClusterSupported() bool {
 	return s.clusterAPISupport
@@ -16,3 +20,21 @@ func (s *Server) UseTargetServer(name string) (*Server, error) {
 	logger.Debugf(context.TODO(), "creating LXD server for cluster node %q", name)
 	return NewServer(s.UseTarget(name))
 }
+
+// ClusterGroupMembers returns the names of the cluster members belonging to
+// the cluster group with the input name.
+// A NotFound error is returned if the group does not exist, and a
+// NotSupported error if the server does not support cluster groups.
+func (s *Server) ClusterGroupMembers(name string) ([]string, error) {
+	if !s.HasExtension("clustering_groups") {
+		return nil, errors.NotSupportedf("cluster groups")
+	}
+	group, _, err := s.GetClusterGroup(name)
+	if err != nil {
+		if IsLXDNotFound(err) {
+			return nil, errors.NotFoundf("cluster group %q", name)
+		}
+		return nil, errors.Trace(err)
+	}
+	return group.Members, nil
+}
@@ -4,8 +4,11 @@
 package lxd_test
 
 import (
-	"errors"
+	stderrors "errors"
+	"net/http"
 
+	"github.com/canonical/lxd/shared/api"
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
@@ -44,7 +47,7 @@ func (s *imageSuite) TestUseTargetBadNode(c *gc.C) {
 	c2Svr := lxdtesting.NewMockInstanceServer(ctrl)
 
 	c1Svr.EXPECT().UseTarget("cluster-2").Return(c2Svr)
-	c2Svr.EXPECT().GetServer().Return(nil, "", errors.New("not a cluster member"))
+	c2Svr.EXPECT().GetServer().Return(nil, "", stderrors.New("not a cluster member"))
 
 	jujuSvr, err := lxd.NewServer(c1Svr)
 	c.Assert(err, jc.ErrorIsNil)
@@ -52,3 +55,51 @@ func (s *imageSuite) TestUseTargetBadNode(c *gc.C) {
 	_, err = jujuSvr.UseTargetServer("cluster-2")
 	c.Assert(err, gc.ErrorMatches, "not a cluster member")
 }
+
+func (s *clusterSuite) TestClusterGroupMembers(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cSvr := s.NewMockServerClustered(ctrl, "cluster-1")
+	cSvr.EXPECT().HasExtension("clustering_groups").Return(true)
+	cSvr.EXPECT().GetClusterGroup("gpu").Return(&api.ClusterGroup{
+		Name:    "gpu",
+		Members: []string{"cluster-1", "cluster-3"},
+	}, lxdtesting.ETag, nil)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	members, err := jujuSvr.ClusterGroupMembers("gpu")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(members, jc.DeepEquals, []string{"cluster-1", "cluster-3"})
+}
+
+func (s *clusterSuite) TestClusterGroupMembersNotFound(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cSvr := s.NewMockServerClustered(ctrl, "cluster-1")
+	cSvr.EXPECT().HasExtension("clustering_groups").Return(true)
+	cSvr.EXPECT().GetClusterGroup("gpu").Return(nil, "", api.StatusErrorf(http.StatusNotFound, "Cluster group not found"))
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = jujuSvr.ClusterGroupMembers("gpu")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *clusterSuite) TestClusterGroupMembersNotSupported(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	cSvr := s.NewMockServerClustered(ctrl, "cluster-1")
+	cSvr.EXPECT().HasExtension("clustering_groups").Return(false)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = jujuSvr.ClusterGroupMembers("gpu")
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
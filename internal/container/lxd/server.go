@@ -169,6 +169,15 @@ func (s *Server) UseProject(project string) {
 	s.InstanceServer = s.InstanceServer.UseProject(project)
 }
 
+// UseProjectServer returns a copy of this server that uses the input project.
+// Unlike UseProject, the receiver is left untouched, so a server shared
+// between models can be scoped to the project of each one.
+func (s *Server) UseProjectServer(project string) *Server {
+	svr := *s
+	svr.InstanceServer = s.InstanceServer.UseProject(project)
+	return &svr
+}
+
 // ReplaceOrAddContainerProfile updates the profiles for the container with the
 // input name, using the input values.
 // TODO: HML 2-apr-2019
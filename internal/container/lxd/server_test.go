@@ -182,3 +182,20 @@ func (s *serverSuite) TestUseProject(c *gc.C) {
 
 	jujuSvr.UseProject("my-project")
 }
+
+func (s *serverSuite) TestUseProjectServer(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	cSvr := s.NewMockServer(ctrl)
+	pSvr := lxdtesting.NewMockInstanceServer(ctrl)
+
+	cSvr.EXPECT().UseProject("my-project").Return(pSvr)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	projectSvr := jujuSvr.UseProjectServer("my-project")
+	c.Check(projectSvr.InstanceServer, gc.Equals, pSvr)
+	c.Check(jujuSvr.InstanceServer, gc.Equals, cSvr)
+	c.Check(projectSvr.Name(), gc.Equals, jujuSvr.Name())
+}
@@ -32,6 +32,48 @@ func (s *Server) CreateVolume(pool, name string, cfg map[string]string) error {
 	return errors.Annotatef(s.CreateStoragePoolVolume(pool, req), "creating storage pool volume %q", name)
 }
 
+// CreateVolumeFromSnapshot creates a custom volume in the given pool,
+// initialised with the contents of the given snapshot. The snapshot is
+// identified as "<volume>/<snapshot>", and must be in the same pool.
+func (s *Server) CreateVolumeFromSnapshot(pool, name, snapshot string, cfg map[string]string) error {
+	req := api.StorageVolumesPost{
+		Name:             name,
+		Type:             "custom",
+		StorageVolumePut: api.StorageVolumePut{Config: cfg},
+		Source: api.StorageVolumeSource{
+			Type: "copy",
+			Pool: pool,
+			Name: snapshot,
+		},
+	}
+	return errors.Annotatef(
+		s.CreateStoragePoolVolume(pool, req),
+		"creating storage pool volume %q from snapshot %q", name, snapshot,
+	)
+}
+
+// CreateVolumeSnapshot creates a snapshot with the given name of a custom
+// volume in the given pool, and waits for the snapshot to complete.
+func (s *Server) CreateVolumeSnapshot(pool, volume, name string) error {
+	op, err := s.CreateStoragePoolVolumeSnapshot(pool, "custom", volume, api.StorageVolumeSnapshotsPost{
+		Name: name,
+	})
+	if err != nil {
+		return errors.Annotatef(err, "creating snapshot of storage pool volume %q", volume)
+	}
+	return errors.Annotatef(op.Wait(), "creating snapshot of storage pool volume %q", volume)
+}
+
+// DeleteVolumeSnapshot deletes the named snapshot of a custom volume in
+// the given pool, and waits for the deletion to complete.
+func (s *Server) DeleteVolumeSnapshot(pool, volume, name string) error {
+	op, err := s.DeleteStoragePoolVolumeSnapshot(pool, "custom", volume, name)
+	if err != nil {
+		return errors.Annotatef(err, "deleting snapshot %q of storage pool volume %q", name, volume)
+	}
+	return errors.Annotatef(op.Wait(), "deleting snapshot %q of storage pool volume %q", name, volume)
+}
+
 // EnsureDefaultStorage ensures that the input profile is configured with a
 // disk device, creating a new storage pool and a device if required.
 func (s *Server) EnsureDefaultStorage(profile *api.Profile, eTag string) error {
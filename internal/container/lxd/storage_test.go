@@ -5,6 +5,7 @@ package lxd_test
 
 import (
 	lxdapi "github.com/canonical/lxd/shared/api"
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
@@ -100,6 +101,68 @@ func (s *storageSuite) TestCreateVolume(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *storageSuite) TestCreateVolumeFromSnapshot(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	cSvr := s.NewMockServerWithExtensions(ctrl, "storage")
+
+	cfg := map[string]string{"size": "1024MB"}
+
+	req := lxdapi.StorageVolumesPost{
+		Name: "volume",
+		Type: "custom",
+		StorageVolumePut: lxdapi.StorageVolumePut{
+			Config: cfg,
+		},
+		Source: lxdapi.StorageVolumeSource{
+			Type: "copy",
+			Pool: "default-pool",
+			Name: "other/snap0",
+		},
+	}
+	cSvr.EXPECT().CreateStoragePoolVolume("default-pool", req).Return(nil)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = jujuSvr.CreateVolumeFromSnapshot("default-pool", "volume", "other/snap0", cfg)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *storageSuite) TestCreateVolumeSnapshot(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	cSvr := s.NewMockServerWithExtensions(ctrl, "storage")
+
+	op := lxdtesting.NewMockOperation(ctrl)
+	op.EXPECT().Wait().Return(nil)
+	cSvr.EXPECT().CreateStoragePoolVolumeSnapshot(
+		"default-pool", "custom", "volume", lxdapi.StorageVolumeSnapshotsPost{Name: "snap0"},
+	).Return(op, nil)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = jujuSvr.CreateVolumeSnapshot("default-pool", "volume", "snap0")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *storageSuite) TestDeleteVolumeSnapshot(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	cSvr := s.NewMockServerWithExtensions(ctrl, "storage")
+
+	op := lxdtesting.NewMockOperation(ctrl)
+	op.EXPECT().Wait().Return(errors.New("boom"))
+	cSvr.EXPECT().DeleteStoragePoolVolumeSnapshot("default-pool", "custom", "volume", "snap0").Return(op, nil)
+
+	jujuSvr, err := lxd.NewServer(cSvr)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = jujuSvr.DeleteVolumeSnapshot("default-pool", "volume", "snap0")
+	c.Assert(err, gc.ErrorMatches, `deleting snapshot "snap0" of storage pool volume "volume": boom`)
+}
+
 func (s *storageSuite) TestEnsureDefaultStorageDevicePresent(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
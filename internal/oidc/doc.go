@@ -0,0 +1,8 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package oidc holds the primitives for logging in to a controller with an
+// OpenID Connect identity provider: discovering the provider, obtaining
+// tokens with the device authorization flow, refreshing them, and verifying
+// the ID tokens the controller is presented with.
+package oidc
@@ -0,0 +1,181 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"golang.org/x/oauth2"
+)
+
+// discoveryPath is the path, relative to the issuer URL, of the document
+// describing the identity provider.
+const discoveryPath = "/.well-known/openid-configuration"
+
+// expiryDelta is how long before it expires a token is treated as expired,
+// so it is not used as it expires.
+const expiryDelta = 30 * time.Second
+
+// Scopes are the scopes requested when logging in.
+var Scopes = []string{"openid", "profile", "email", "offline_access"}
+
+// ProviderMetadata describes the endpoints of an identity provider.
+type ProviderMetadata struct {
+	Issuer                      string `json:"issuer"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint,omitempty"`
+	JWKSURI                     string `json:"jwks_uri"`
+}
+
+// Discover returns the metadata of the identity provider at the issuer URL.
+func Discover(ctx context.Context, client *http.Client, issuerURL string) (ProviderMetadata, error) {
+	issuerURL = strings.TrimSuffix(issuerURL, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuerURL+discoveryPath, nil)
+	if err != nil {
+		return ProviderMetadata{}, errors.Trace(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return ProviderMetadata{}, errors.Annotatef(err, "discovering identity provider %q", issuerURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ProviderMetadata{}, errors.Errorf("discovering identity provider %q: unexpected HTTP response %q", issuerURL, resp.Status)
+	}
+
+	var metadata ProviderMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return ProviderMetadata{}, errors.Annotatef(err, "decoding identity provider %q metadata", issuerURL)
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != issuerURL {
+		return ProviderMetadata{}, errors.Errorf("identity provider issuer %q does not match %q", metadata.Issuer, issuerURL)
+	}
+	if metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return ProviderMetadata{}, errors.NotValidf("identity provider %q metadata", issuerURL)
+	}
+	return metadata, nil
+}
+
+// Token holds the tokens issued to a user by an identity provider.
+type Token struct {
+	// IDToken is the ID token identifying the user to the controller.
+	IDToken string `yaml:"id-token"`
+
+	// RefreshToken, if set, is used to obtain a new ID token when the
+	// current one expires.
+	RefreshToken string `yaml:"refresh-token,omitempty"`
+
+	// Expiry is when the ID token expires.
+	Expiry time.Time `yaml:"expiry"`
+}
+
+// Expired returns whether the ID token is missing or has expired at the
+// time.
+func (t Token) Expired(now time.Time) bool {
+	return t.IDToken == "" || !now.Add(expiryDelta).Before(t.Expiry)
+}
+
+// DeviceLogin logs the user in with the device authorization flow, returning
+// the tokens issued to them. The prompt is called with the URI the user must
+// visit and the code they must enter there; the call then blocks until they
+// have logged in, or the code has expired.
+func DeviceLogin(
+	ctx context.Context,
+	client *http.Client,
+	metadata ProviderMetadata,
+	clientID string,
+	prompt func(verificationURI, userCode string) error,
+) (Token, error) {
+	if metadata.DeviceAuthorizationEndpoint == "" {
+		return Token{}, errors.NotSupportedf("device login with identity provider %q", metadata.Issuer)
+	}
+	config := oauthConfig(metadata, clientID)
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+
+	auth, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return Token{}, errors.Annotate(err, "starting device login")
+	}
+	verificationURI := auth.VerificationURIComplete
+	if verificationURI == "" {
+		verificationURI = auth.VerificationURI
+	}
+	if err := prompt(verificationURI, auth.UserCode); err != nil {
+		return Token{}, errors.Trace(err)
+	}
+	tok, err := config.DeviceAccessToken(ctx, auth)
+	if err != nil {
+		return Token{}, errors.Annotate(err, "waiting for device login")
+	}
+	return tokenFromOAuth(tok)
+}
+
+// Refresh returns new tokens for the user, obtained with the refresh token.
+// A NotFound error is returned if there is no refresh token.
+func Refresh(
+	ctx context.Context,
+	client *http.Client,
+	metadata ProviderMetadata,
+	clientID string,
+	token Token,
+) (Token, error) {
+	if token.RefreshToken == "" {
+		return Token{}, errors.NotFoundf("refresh token")
+	}
+	config := oauthConfig(metadata, clientID)
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+
+	// An expiry in the past forces the token source to refresh.
+	tok, err := config.TokenSource(ctx, &oauth2.Token{
+		RefreshToken: token.RefreshToken,
+		Expiry:       time.Unix(1, 0),
+	}).Token()
+	if err != nil {
+		return Token{}, errors.Annotate(err, "refreshing token")
+	}
+	return tokenFromOAuth(tok)
+}
+
+// Issuer returns the issuer of the ID token, without verifying it.
+func Issuer(idToken string) (string, error) {
+	tok, err := jwt.ParseInsecure([]byte(idToken))
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return tok.Issuer(), nil
+}
+
+func oauthConfig(metadata ProviderMetadata, clientID string) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID: clientID,
+		Endpoint: oauth2.Endpoint{
+			TokenURL:      metadata.TokenEndpoint,
+			DeviceAuthURL: metadata.DeviceAuthorizationEndpoint,
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+		Scopes: Scopes,
+	}
+}
+
+func tokenFromOAuth(tok *oauth2.Token) (Token, error) {
+	idToken, _ := tok.Extra("id_token").(string)
+	if idToken == "" {
+		return Token{}, errors.New("identity provider did not issue an ID token")
+	}
+	parsed, err := jwt.ParseInsecure([]byte(idToken))
+	if err != nil {
+		return Token{}, errors.Annotate(err, "parsing ID token")
+	}
+	return Token{
+		IDToken:      idToken,
+		RefreshToken: tok.RefreshToken,
+		Expiry:       parsed.Expiration(),
+	}, nil
+}
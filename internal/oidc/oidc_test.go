@@ -0,0 +1,97 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/oidc"
+	"github.com/juju/juju/internal/oidc/oidctest"
+)
+
+type oidcSuite struct {
+	jujutesting.IsolationSuite
+
+	idp *oidctest.IdentityProvider
+}
+
+var _ = gc.Suite(&oidcSuite{})
+
+func (s *oidcSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.idp = oidctest.NewIdentityProvider("juju")
+	s.AddCleanup(func(*gc.C) { s.idp.Close() })
+}
+
+func (s *oidcSuite) TestDiscover(c *gc.C) {
+	metadata, err := oidc.Discover(context.Background(), http.DefaultClient, s.idp.URL()+"/")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metadata, gc.DeepEquals, oidc.ProviderMetadata{
+		Issuer:                      s.idp.URL(),
+		TokenEndpoint:               s.idp.URL() + "/token",
+		DeviceAuthorizationEndpoint: s.idp.URL() + "/device/code",
+		JWKSURI:                     s.idp.URL() + "/keys",
+	})
+}
+
+func (s *oidcSuite) TestDiscoverNotFound(c *gc.C) {
+	_, err := oidc.Discover(context.Background(), http.DefaultClient, s.idp.URL()+"/other")
+	c.Assert(err, gc.ErrorMatches, `discovering identity provider ".*/other": unexpected HTTP response "404 Not Found"`)
+}
+
+func (s *oidcSuite) TestDeviceLoginAndRefresh(c *gc.C) {
+	s.idp.SetUser("alice", map[string]any{"email": "alice@example.com"})
+	metadata, err := oidc.Discover(context.Background(), http.DefaultClient, s.idp.URL())
+	c.Assert(err, jc.ErrorIsNil)
+
+	var uri, code string
+	token, err := oidc.DeviceLogin(context.Background(), http.DefaultClient, metadata, "juju", func(verificationURI, userCode string) error {
+		uri, code = verificationURI, userCode
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(uri, gc.Equals, s.idp.URL()+"/device")
+	c.Check(code, gc.Matches, "USER-.*")
+	c.Check(token.IDToken, gc.Not(gc.Equals), "")
+	c.Check(token.RefreshToken, gc.Not(gc.Equals), "")
+	c.Check(token.Expired(time.Now()), jc.IsFalse)
+	c.Check(token.Expired(time.Now().Add(2*time.Hour)), jc.IsTrue)
+	c.Check(s.idp.DeviceLogins(), gc.Equals, 1)
+
+	issuer, err := oidc.Issuer(token.IDToken)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(issuer, gc.Equals, s.idp.URL())
+
+	refreshed, err := oidc.Refresh(context.Background(), http.DefaultClient, metadata, "juju", token)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(refreshed.IDToken, gc.Not(gc.Equals), "")
+	c.Check(refreshed.RefreshToken, gc.Not(gc.Equals), token.RefreshToken)
+	c.Check(s.idp.Refreshes(), gc.Equals, 1)
+
+	// Refresh tokens are only good once.
+	_, err = oidc.Refresh(context.Background(), http.DefaultClient, metadata, "juju", token)
+	c.Check(err, gc.ErrorMatches, `refreshing token: .*invalid_grant.*`)
+}
+
+func (s *oidcSuite) TestRefreshWithoutRefreshToken(c *gc.C) {
+	_, err := oidc.Refresh(context.Background(), http.DefaultClient, oidc.ProviderMetadata{}, "juju", oidc.Token{})
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *oidcSuite) TestDeviceLoginPromptError(c *gc.C) {
+	metadata, err := oidc.Discover(context.Background(), http.DefaultClient, s.idp.URL())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = oidc.DeviceLogin(context.Background(), http.DefaultClient, metadata, "juju", func(string, string) error {
+		return errors.New("boom")
+	})
+	c.Assert(err, gc.ErrorMatches, "boom")
+	c.Check(s.idp.DeviceLogins(), gc.Equals, 0)
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package oidctest provides an OpenID Connect identity provider for tests,
+// supporting the device authorization flow and refresh tokens.
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+
+	"github.com/juju/juju/internal/oidc"
+)
+
+const keyID = "oidctest"
+
+// IdentityProvider is an identity provider served over HTTP. Device logins
+// are approved as soon as they are started, as the user set with SetUser.
+type IdentityProvider struct {
+	server   *httptest.Server
+	clientID string
+	key      jwk.Key
+	keys     jwk.Set
+
+	mu            sync.Mutex
+	subject       string
+	claims        map[string]any
+	tokenTTL      time.Duration
+	nextID        int
+	deviceCodes   map[string]string
+	refreshTokens map[string]string
+	deviceLogins  int
+	refreshes     int
+}
+
+// NewIdentityProvider starts an identity provider issuing tokens to the
+// client. It must be closed when done with.
+func NewIdentityProvider(clientID string) *IdentityProvider {
+	rawKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	key, err := jwk.FromRaw(rawKey)
+	if err != nil {
+		panic(err)
+	}
+	_ = key.Set(jwk.KeyIDKey, keyID)
+	_ = key.Set(jwk.AlgorithmKey, jwa.RS256)
+	publicKey, err := key.PublicKey()
+	if err != nil {
+		panic(err)
+	}
+	keys := jwk.NewSet()
+	_ = keys.AddKey(publicKey)
+
+	idp := &IdentityProvider{
+		clientID:      clientID,
+		key:           key,
+		keys:          keys,
+		subject:       "bob",
+		claims:        map[string]any{"email": "bob@example.com"},
+		tokenTTL:      time.Hour,
+		deviceCodes:   make(map[string]string),
+		refreshTokens: make(map[string]string),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", idp.serveMetadata)
+	mux.HandleFunc("GET /keys", idp.serveKeys)
+	mux.HandleFunc("POST /device/code", idp.serveDeviceCode)
+	mux.HandleFunc("POST /token", idp.serveToken)
+	idp.server = httptest.NewServer(mux)
+	return idp
+}
+
+// URL returns the issuer URL of the identity provider.
+func (idp *IdentityProvider) URL() string {
+	return idp.server.URL
+}
+
+// Close stops the identity provider.
+func (idp *IdentityProvider) Close() {
+	idp.server.Close()
+}
+
+// SetUser sets the subject and the claims, such as "email" and "groups", of
+// the ID tokens issued to the user completing device logins.
+func (idp *IdentityProvider) SetUser(subject string, claims map[string]any) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.subject = subject
+	idp.claims = claims
+}
+
+// SetTokenTTL sets how long the ID tokens issued are valid for.
+func (idp *IdentityProvider) SetTokenTTL(ttl time.Duration) {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.tokenTTL = ttl
+}
+
+// RevokeRefreshTokens makes every refresh token issued so far invalid.
+func (idp *IdentityProvider) RevokeRefreshTokens() {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.refreshTokens = make(map[string]string)
+}
+
+// DeviceLogins returns the number of device logins completed.
+func (idp *IdentityProvider) DeviceLogins() int {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	return idp.deviceLogins
+}
+
+// Refreshes returns the number of tokens refreshed.
+func (idp *IdentityProvider) Refreshes() int {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	return idp.refreshes
+}
+
+// IDToken returns an ID token for the current user, as issued by a login.
+func (idp *IdentityProvider) IDToken() string {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	return idp.idToken(idp.clientID, idp.tokenTTL)
+}
+
+// IDTokenFor returns an ID token for the current user issued to the client,
+// valid for the duration, which may be negative for an expired token.
+func (idp *IdentityProvider) IDTokenFor(clientID string, ttl time.Duration) string {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	return idp.idToken(clientID, ttl)
+}
+
+func (idp *IdentityProvider) idToken(clientID string, ttl time.Duration) string {
+	now := time.Now()
+	tok := jwt.New()
+	_ = tok.Set(jwt.IssuerKey, idp.server.URL)
+	_ = tok.Set(jwt.SubjectKey, idp.subject)
+	_ = tok.Set(jwt.AudienceKey, clientID)
+	_ = tok.Set(jwt.IssuedAtKey, now)
+	_ = tok.Set(jwt.ExpirationKey, now.Add(ttl))
+	for k, v := range idp.claims {
+		_ = tok.Set(k, v)
+	}
+	signed, err := jwt.Sign(tok, jwt.WithKey(jwa.RS256, idp.key))
+	if err != nil {
+		panic(err)
+	}
+	return string(signed)
+}
+
+func (idp *IdentityProvider) serveMetadata(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, oidc.ProviderMetadata{
+		Issuer:                      idp.server.URL,
+		TokenEndpoint:               idp.server.URL + "/token",
+		DeviceAuthorizationEndpoint: idp.server.URL + "/device/code",
+		JWKSURI:                     idp.server.URL + "/keys",
+	})
+}
+
+func (idp *IdentityProvider) serveKeys(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, idp.keys)
+}
+
+func (idp *IdentityProvider) serveDeviceCode(w http.ResponseWriter, req *http.Request) {
+	if req.PostFormValue("client_id") != idp.clientID {
+		writeError(w, "invalid_client")
+		return
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	deviceCode := idp.newID("device")
+	userCode := fmt.Sprintf("USER-%d", idp.nextID)
+	idp.deviceCodes[deviceCode] = idp.subject
+	writeJSON(w, http.StatusOK, map[string]any{
+		"device_code":      deviceCode,
+		"user_code":        userCode,
+		"verification_uri": idp.server.URL + "/device",
+		"expires_in":       600,
+		"interval":         1,
+	})
+}
+
+func (idp *IdentityProvider) serveToken(w http.ResponseWriter, req *http.Request) {
+	if req.PostFormValue("client_id") != idp.clientID {
+		writeError(w, "invalid_client")
+		return
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	switch req.PostFormValue("grant_type") {
+	case "urn:ietf:params:oauth:grant-type:device_code":
+		code := req.PostFormValue("device_code")
+		if _, ok := idp.deviceCodes[code]; !ok {
+			writeError(w, "expired_token")
+			return
+		}
+		delete(idp.deviceCodes, code)
+		idp.deviceLogins++
+	case "refresh_token":
+		token := req.PostFormValue("refresh_token")
+		if _, ok := idp.refreshTokens[token]; !ok {
+			writeError(w, "invalid_grant")
+			return
+		}
+		delete(idp.refreshTokens, token)
+		idp.refreshes++
+	default:
+		writeError(w, "unsupported_grant_type")
+		return
+	}
+
+	refreshToken := idp.newID("refresh")
+	idp.refreshTokens[refreshToken] = idp.subject
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token":  idp.newID("access"),
+		"token_type":    "Bearer",
+		"expires_in":    int(idp.tokenTTL.Seconds()),
+		"refresh_token": refreshToken,
+		"id_token":      idp.idToken(idp.clientID, idp.tokenTTL),
+	})
+}
+
+func (idp *IdentityProvider) newID(kind string) string {
+	idp.nextID++
+	return fmt.Sprintf("%s-%d", kind, idp.nextID)
+}
+
+func writeError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
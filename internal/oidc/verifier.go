@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// keysTTL is how long the signing keys of an identity provider are cached
+// for. The keys are fetched again before then if a token is signed with a key
+// which is not cached, as happens when the provider rotates its keys.
+const keysTTL = time.Hour
+
+// Verifier verifies the ID tokens issued by identity providers, caching
+// their signing keys.
+type Verifier struct {
+	client *http.Client
+	clock  clock.Clock
+
+	mu      sync.Mutex
+	issuers map[string]issuerKeys
+}
+
+type issuerKeys struct {
+	keys    jwk.Set
+	fetched time.Time
+}
+
+// NewVerifier returns a new Verifier, fetching the signing keys of identity
+// providers with the client.
+func NewVerifier(client *http.Client, clock clock.Clock) *Verifier {
+	return &Verifier{
+		client:  client,
+		clock:   clock,
+		issuers: make(map[string]issuerKeys),
+	}
+}
+
+// Verify returns the ID token if it was signed by the identity provider at
+// the issuer URL, was issued to the client, and has not expired.
+func (v *Verifier) Verify(ctx context.Context, issuerURL, clientID, idToken string) (jwt.Token, error) {
+	issuerURL = strings.TrimSuffix(issuerURL, "/")
+	keys, cached, err := v.keySet(ctx, issuerURL, false)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	tok, err := v.parse(idToken, keys, issuerURL, clientID)
+	if err != nil && cached && !jwt.IsValidationError(err) {
+		// The token may be signed with a key the provider has rotated in
+		// since the keys were cached.
+		if keys, _, err = v.keySet(ctx, issuerURL, true); err != nil {
+			return nil, errors.Trace(err)
+		}
+		tok, err = v.parse(idToken, keys, issuerURL, clientID)
+	}
+	if err != nil {
+		return nil, errors.Annotate(err, "verifying ID token")
+	}
+	return tok, nil
+}
+
+func (v *Verifier) parse(idToken string, keys jwk.Set, issuerURL, clientID string) (jwt.Token, error) {
+	return jwt.Parse(
+		[]byte(idToken),
+		jwt.WithKeySet(keys),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(issuerURL),
+		jwt.WithAudience(clientID),
+		jwt.WithClock(jwt.ClockFunc(v.clock.Now)),
+		jwt.WithAcceptableSkew(expiryDelta),
+	)
+}
+
+// keySet returns the signing keys of the identity provider at the issuer
+// URL, and whether they were cached.
+func (v *Verifier) keySet(ctx context.Context, issuerURL string, refresh bool) (jwk.Set, bool, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.clock.Now()
+	if cached, ok := v.issuers[issuerURL]; ok && !refresh && now.Sub(cached.fetched) < keysTTL {
+		return cached.keys, true, nil
+	}
+
+	metadata, err := Discover(ctx, v.client, issuerURL)
+	if err != nil {
+		return nil, false, errors.Trace(err)
+	}
+	keys, err := jwk.Fetch(ctx, metadata.JWKSURI, jwk.WithHTTPClient(v.client))
+	if err != nil {
+		return nil, false, errors.Annotatef(err, "fetching identity provider %q keys", issuerURL)
+	}
+	v.issuers[issuerURL] = issuerKeys{
+		keys:    keys,
+		fetched: now,
+	}
+	return keys, false, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/juju/clock"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/oidc"
+	"github.com/juju/juju/internal/oidc/oidctest"
+)
+
+type verifierSuite struct {
+	jujutesting.IsolationSuite
+
+	idp *oidctest.IdentityProvider
+}
+
+var _ = gc.Suite(&verifierSuite{})
+
+func (s *verifierSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.idp = oidctest.NewIdentityProvider("juju")
+	s.AddCleanup(func(*gc.C) { s.idp.Close() })
+}
+
+func (s *verifierSuite) TestVerify(c *gc.C) {
+	s.idp.SetUser("alice", map[string]any{
+		"email":  "alice@example.com",
+		"groups": []string{"devs"},
+	})
+	verifier := oidc.NewVerifier(http.DefaultClient, clock.WallClock)
+
+	tok, err := verifier.Verify(context.Background(), s.idp.URL(), "juju", s.idp.IDToken())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tok.Subject(), gc.Equals, "alice")
+	email, _ := tok.Get("email")
+	c.Check(email, gc.Equals, "alice@example.com")
+
+	// The keys are cached.
+	_, err = verifier.Verify(context.Background(), s.idp.URL()+"/", "juju", s.idp.IDToken())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *verifierSuite) TestVerifyWrongAudience(c *gc.C) {
+	verifier := oidc.NewVerifier(http.DefaultClient, clock.WallClock)
+	_, err := verifier.Verify(context.Background(), s.idp.URL(), "juju", s.idp.IDTokenFor("other", time.Hour))
+	c.Assert(err, gc.ErrorMatches, `verifying ID token: "aud" not satisfied`)
+}
+
+func (s *verifierSuite) TestVerifyExpired(c *gc.C) {
+	verifier := oidc.NewVerifier(http.DefaultClient, clock.WallClock)
+	_, err := verifier.Verify(context.Background(), s.idp.URL(), "juju", s.idp.IDTokenFor("juju", -time.Hour))
+	c.Assert(err, gc.ErrorMatches, `verifying ID token: "exp" not satisfied`)
+}
+
+func (s *verifierSuite) TestVerifyOtherIdentityProvider(c *gc.C) {
+	other := oidctest.NewIdentityProvider("juju")
+	defer other.Close()
+
+	verifier := oidc.NewVerifier(http.DefaultClient, clock.WallClock)
+	_, err := verifier.Verify(context.Background(), s.idp.URL(), "juju", other.IDToken())
+	c.Assert(err, gc.ErrorMatches, `verifying ID token: .*`)
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh
+
+import (
+	"bytes"
+	"crypto/rand"
+	"time"
+
+	"github.com/juju/errors"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+// userCertificateExtensions are the permissions granted to the holder of a
+// user certificate. Agent and X11 forwarding are not granted.
+var userCertificateExtensions = map[string]string{
+	"permit-pty":             "",
+	"permit-port-forwarding": "",
+}
+
+// UserCertificateParams describes a user certificate to be signed by an ssh
+// certificate authority.
+type UserCertificateParams struct {
+	// KeyID identifies the certificate in the logs of the servers it is
+	// presented to.
+	KeyID string
+
+	// Serial is the serial number of the certificate.
+	Serial uint64
+
+	// Principals are the user names that the certificate is valid for.
+	Principals []string
+
+	// ValidAfter and ValidBefore bound the time the certificate is valid.
+	ValidAfter  time.Time
+	ValidBefore time.Time
+}
+
+// Validate checks that the certificate parameters are usable.
+func (p UserCertificateParams) Validate() error {
+	if p.KeyID == "" {
+		return errors.NotValidf("empty key id")
+	}
+	if len(p.Principals) == 0 {
+		return errors.NotValidf("empty principals")
+	}
+	if !p.ValidBefore.After(p.ValidAfter) {
+		return errors.NotValidf("validity ending %v before it starts", p.ValidBefore)
+	}
+	return nil
+}
+
+// NewUserCertificate returns a user certificate for the public key, signed by
+// the certificate authority.
+func NewUserCertificate(ca cryptossh.Signer, key cryptossh.PublicKey, p UserCertificateParams) (*cryptossh.Certificate, error) {
+	if err := p.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, ok := key.(*cryptossh.Certificate); ok {
+		return nil, errors.NotValidf("signing a certificate")
+	}
+
+	extensions := make(map[string]string, len(userCertificateExtensions))
+	for k, v := range userCertificateExtensions {
+		extensions[k] = v
+	}
+	cert := &cryptossh.Certificate{
+		Key:             key,
+		Serial:          p.Serial,
+		CertType:        cryptossh.UserCert,
+		KeyId:           p.KeyID,
+		ValidPrincipals: p.Principals,
+		ValidAfter:      uint64(p.ValidAfter.Unix()),
+		ValidBefore:     uint64(p.ValidBefore.Unix()),
+		Permissions: cryptossh.Permissions{
+			Extensions: extensions,
+		},
+	}
+	if err := cert.SignCert(rand.Reader, ca); err != nil {
+		return nil, errors.Annotate(err, "signing user certificate")
+	}
+	return cert, nil
+}
+
+// CheckUserCertificate checks that the certificate is a user certificate
+// signed by the certificate authority, valid for the principal at the given
+// time.
+func CheckUserCertificate(ca cryptossh.PublicKey, cert *cryptossh.Certificate, principal string, now time.Time) error {
+	checker := cryptossh.CertChecker{
+		IsUserAuthority: func(auth cryptossh.PublicKey) bool {
+			return bytes.Equal(auth.Marshal(), ca.Marshal())
+		},
+		Clock: func() time.Time {
+			return now
+		},
+	}
+	if cert.CertType != cryptossh.UserCert {
+		return errors.NotValidf("certificate type %d", cert.CertType)
+	}
+	if !checker.IsUserAuthority(cert.SignatureKey) {
+		return errors.NotValidf("certificate signed by unknown authority")
+	}
+	if err := checker.CheckCert(principal, cert); err != nil {
+		return errors.NewNotValid(err, "user certificate")
+	}
+	return nil
+}
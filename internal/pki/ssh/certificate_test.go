@@ -0,0 +1,111 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ssh_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gossh "golang.org/x/crypto/ssh"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/pki/ssh"
+)
+
+type CertificateSuite struct {
+	ca   gossh.Signer
+	user gossh.PublicKey
+	now  time.Time
+}
+
+var _ = gc.Suite(&CertificateSuite{})
+
+func (s *CertificateSuite) SetUpTest(c *gc.C) {
+	s.ca = s.newSigner(c)
+	s.user = s.newSigner(c).PublicKey()
+	s.now = time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+}
+
+func (s *CertificateSuite) newSigner(c *gc.C) gossh.Signer {
+	key, err := ssh.ED25519()
+	c.Assert(err, jc.ErrorIsNil)
+	signer, err := gossh.NewSignerFromKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	return signer
+}
+
+func (s *CertificateSuite) params() ssh.UserCertificateParams {
+	return ssh.UserCertificateParams{
+		KeyID:       "juju:bob:42",
+		Serial:      42,
+		Principals:  []string{"bob"},
+		ValidAfter:  s.now.Add(-time.Minute),
+		ValidBefore: s.now.Add(time.Hour),
+	}
+}
+
+func (s *CertificateSuite) TestNewUserCertificate(c *gc.C) {
+	cert, err := ssh.NewUserCertificate(s.ca, s.user, s.params())
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(cert.CertType, gc.Equals, uint32(gossh.UserCert))
+	c.Check(cert.KeyId, gc.Equals, "juju:bob:42")
+	c.Check(cert.Serial, gc.Equals, uint64(42))
+	c.Check(cert.ValidPrincipals, jc.DeepEquals, []string{"bob"})
+	c.Check(cert.Key.Marshal(), jc.DeepEquals, s.user.Marshal())
+	c.Check(cert.SignatureKey.Marshal(), jc.DeepEquals, s.ca.PublicKey().Marshal())
+	c.Check(cert.Permissions.Extensions, jc.DeepEquals, map[string]string{
+		"permit-pty":             "",
+		"permit-port-forwarding": "",
+	})
+
+	err = ssh.CheckUserCertificate(s.ca.PublicKey(), cert, "bob", s.now)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *CertificateSuite) TestNewUserCertificateNotValid(c *gc.C) {
+	p := s.params()
+	p.Principals = nil
+	_, err := ssh.NewUserCertificate(s.ca, s.user, p)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+
+	p = s.params()
+	p.ValidBefore = p.ValidAfter
+	_, err = ssh.NewUserCertificate(s.ca, s.user, p)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *CertificateSuite) TestNewUserCertificateOfCertificate(c *gc.C) {
+	cert, err := ssh.NewUserCertificate(s.ca, s.user, s.params())
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = ssh.NewUserCertificate(s.ca, cert, s.params())
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *CertificateSuite) TestCheckUserCertificateWrongPrincipal(c *gc.C) {
+	cert, err := ssh.NewUserCertificate(s.ca, s.user, s.params())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = ssh.CheckUserCertificate(s.ca.PublicKey(), cert, "alice", s.now)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *CertificateSuite) TestCheckUserCertificateExpired(c *gc.C) {
+	cert, err := ssh.NewUserCertificate(s.ca, s.user, s.params())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = ssh.CheckUserCertificate(s.ca.PublicKey(), cert, "bob", s.now.Add(2*time.Hour))
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *CertificateSuite) TestCheckUserCertificateUnknownAuthority(c *gc.C) {
+	cert, err := ssh.NewUserCertificate(s.ca, s.user, s.params())
+	c.Assert(err, jc.ErrorIsNil)
+
+	other := s.newSigner(c)
+	err = ssh.CheckUserCertificate(other.PublicKey(), cert, "bob", s.now)
+	c.Assert(err, gc.ErrorMatches, "certificate signed by unknown authority not valid")
+}
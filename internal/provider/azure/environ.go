@@ -910,26 +910,30 @@ func (env *azureEnviron) createVirtualMachine(
 		})
 	}
 
+	vmProperties := &armcompute.VirtualMachineProperties{
+		HardwareProfile: &armcompute.HardwareProfile{
+			VMSize: to.Ptr(armcompute.VirtualMachineSizeTypes(
+				instanceSpec.InstanceType.Name,
+			)),
+		},
+		StorageProfile: storageProfile,
+		OSProfile:      osProfile,
+		NetworkProfile: &armcompute.NetworkProfile{
+			NetworkInterfaces: nics,
+		},
+		AvailabilitySet: availabilitySetSubResource,
+	}
+	if args.Constraints.HasSpot() {
+		setSpotOptions(vmProperties, args.Constraints)
+	}
 	vmTemplate := armtemplates.Resource{
 		APIVersion: computeAPIVersion,
 		Type:       "Microsoft.Compute/virtualMachines",
 		Name:       vmName,
 		Location:   env.location,
 		Tags:       vmTags,
-		Properties: &armcompute.VirtualMachineProperties{
-			HardwareProfile: &armcompute.HardwareProfile{
-				VMSize: to.Ptr(armcompute.VirtualMachineSizeTypes(
-					instanceSpec.InstanceType.Name,
-				)),
-			},
-			StorageProfile: storageProfile,
-			OSProfile:      osProfile,
-			NetworkProfile: &armcompute.NetworkProfile{
-				NetworkInterfaces: nics,
-			},
-			AvailabilitySet: availabilitySetSubResource,
-		},
-		DependsOn: vmDependsOn,
+		Properties: vmProperties,
+		DependsOn:  vmDependsOn,
 	}
 	// For controllers, check to see if we need to assign a managed identity resource to the vm.
 	if instanceConfig.IsController() {
@@ -1878,10 +1882,16 @@ func (env *azureEnviron) allProvisionedInstances(
 				vmName:            name,
 				provisioningState: provisioningState,
 				env:               env,
+				spot:              isSpotVM(vm),
 			}
 			azureInstances = append(azureInstances, inst)
 		}
 	}
+	if err := env.setSpotPowerStates(ctx, resourceGroup, azureInstances); err != nil {
+		// Not knowing whether a spot VM has been evicted
+		// should not prevent the instances being reported.
+		logger.Debugf(ctx, "cannot get power state of spot instances: %v", err)
+	}
 	return azureInstances, nil
 }
 
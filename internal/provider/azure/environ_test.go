@@ -918,6 +918,37 @@ func (s *environSuite) TestStartInstanceServiceAvailabilitySet(c *gc.C) {
 	})
 }
 
+func (s *environSuite) TestStartInstanceSpot(c *gc.C) {
+	s.assertStartInstanceSpot(c, nil, -1)
+}
+
+func (s *environSuite) TestStartInstanceSpotMaxPrice(c *gc.C) {
+	maxPrice := 0.05
+	s.assertStartInstanceSpot(c, &maxPrice, maxPrice)
+}
+
+func (s *environSuite) assertStartInstanceSpot(c *gc.C, maxPrice *float64, expectedMaxPrice float64) {
+	env := s.openEnviron(c)
+	s.sender = s.startInstanceSenders(c, startInstanceSenderParams{bootstrap: false})
+	s.requests = nil
+	params := makeStartInstanceParams(c, s.controllerUUID, corebase.MakeDefaultBase("ubuntu", "22.04"))
+	spot := true
+	params.Constraints.Spot = &spot
+	params.Constraints.SpotMaxPrice = maxPrice
+	params.InstanceConfig.AuthorizedKeys = s.authorizedKeyString(c)
+
+	_, err := env.StartInstance(context.Background(), params)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertStartInstanceRequests(c, s.requests, assertStartInstanceRequestsParams{
+		imageReference: &jammyImageReferenceGen2,
+		diskSizeGB:     32,
+		osProfile:      &s.linuxOsProfile,
+		instanceType:   "Standard_A1",
+		publicIP:       true,
+		spotMaxPrice:   &expectedMaxPrice,
+	})
+}
+
 func (s *environSuite) TestStartInstanceWithSpaceConstraints(c *gc.C) {
 	env := s.openEnviron(c)
 	s.sender = s.startInstanceSenders(c, startInstanceSenderParams{bootstrap: false, hasSpaceConstraints: true})
@@ -1074,6 +1105,7 @@ type assertStartInstanceRequestsParams struct {
 	withConflictRetry      bool
 	hasSpaceConstraints    bool
 	managedIdentity        string
+	spotMaxPrice           *float64
 }
 
 func (s *environSuite) assertStartInstanceRequests(
@@ -1369,6 +1401,12 @@ func (s *environSuite) assertStartInstanceRequests(
 		},
 		DependsOn: vmDependsOn,
 	}
+	if args.spotMaxPrice != nil {
+		vmProperties := vmTemplate.Properties.(*armcompute.VirtualMachineProperties)
+		vmProperties.Priority = to.Ptr(armcompute.VirtualMachinePriorityTypesSpot)
+		vmProperties.EvictionPolicy = to.Ptr(armcompute.VirtualMachineEvictionPolicyTypesDeallocate)
+		vmProperties.BillingProfile = &armcompute.BillingProfile{MaxPrice: args.spotMaxPrice}
+	}
 	if args.managedIdentity != "" {
 		vmTemplate.Identity = &armcompute.VirtualMachineIdentity{
 			Type: to.Ptr(armcompute.ResourceIdentityTypeUserAssigned),
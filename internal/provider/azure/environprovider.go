@@ -52,7 +52,8 @@ type ProviderConfig struct {
 	CreateTokenCredential func(appId, appPassword, tenantID string, opts azcore.ClientOptions) (azcore.TokenCredential, error)
 
 	// RetryClock is used for retrying some operations, like
-	// waiting for deployments to complete.
+	// waiting for deployments to complete, and for expiring
+	// cached prices.
 	//
 	// Retries due to rate-limiting are handled by the go-autorest
 	// package, which uses "time" directly. We cannot mock the
@@ -95,6 +96,9 @@ type azureEnvironProvider struct {
 	environProviderCredentials
 
 	config ProviderConfig
+
+	// prices caches the retail prices of VM sizes by location.
+	prices *priceCache
 }
 
 // NewEnvironProvider returns a new EnvironProvider for Azure.
@@ -109,6 +113,7 @@ func NewEnvironProvider(config ProviderConfig) (*azureEnvironProvider, error) {
 			transporter:             config.Sender,
 		},
 		config: config,
+		prices: &priceCache{clock: config.RetryClock},
 	}, nil
 }
 
@@ -5,3 +5,5 @@ package azure
 
 const ComputeAPIVersion = computeAPIVersion
 const NetworkAPIVersion = networkAPIVersion
+
+var PriceCatalogueTTL = priceCatalogueTTL
@@ -30,6 +30,12 @@ type azureInstance struct {
 	env               *azureEnviron
 	networkInterfaces []*armnetwork.Interface
 	publicIPAddresses []*armnetwork.PublicIPAddress
+
+	// spot is true if the instance is an Azure Spot VM.
+	spot bool
+	// powerState is the power state code of a spot instance,
+	// e.g. "PowerState/running".
+	powerState string
 }
 
 // Id is specified in the Instance interface.
@@ -53,6 +59,10 @@ func (inst *azureInstance) Status(ctx context.Context) instance.Status {
 		// be stopped.
 		instanceStatus = status.Running
 		message = ""
+		if inst.spot && evictedPowerStates[inst.powerState] {
+			instanceStatus = status.Interrupted
+			message = "evicted"
+		}
 	case armresources.ProvisioningStateDeleting, armresources.ProvisioningStateFailed:
 		instanceStatus = status.ProvisioningError
 		message = inst.provisioningError
@@ -68,6 +78,11 @@ func (inst *azureInstance) Status(ctx context.Context) instance.Status {
 	}
 }
 
+// Interruptible is specified in the InterruptibleInstance interface.
+func (inst *azureInstance) Interruptible() bool {
+	return inst.spot
+}
+
 // setInstanceAddresses queries Azure for the NICs and public IPs associated
 // with the given set of instances. This assumes that the instances'
 // VirtualMachines are up-to-date, and that there are no concurrent accesses
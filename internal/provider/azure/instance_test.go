@@ -240,6 +240,37 @@ func (s *instanceSuite) TestInstanceStatusUnsetProvisioningState(c *gc.C) {
 	assertInstanceStatus(c, inst.Status(context.Background()), status.Allocating, "")
 }
 
+func (s *instanceSuite) TestInstanceStatusSpotRunning(c *gc.C) {
+	inst := s.getSpotInstance(c, "PowerState/running")
+	assertInstanceStatus(c, inst.Status(context.Background()), status.Running, "")
+}
+
+func (s *instanceSuite) TestInstanceStatusSpotEvicted(c *gc.C) {
+	inst := s.getSpotInstance(c, "PowerState/deallocated")
+	assertInstanceStatus(c, inst.Status(context.Background()), status.Interrupted, "evicted")
+}
+
+// getSpotInstance returns machine-0 as an Azure Spot VM in the
+// specified power state.
+func (s *instanceSuite) getSpotInstance(c *gc.C, powerState string) instances.Instance {
+	s.vms[0].Properties.Priority = to.Ptr(armcompute.VirtualMachinePriorityTypesSpot)
+	instanceViewSender := azuretesting.NewSenderWithValue(&armcompute.VirtualMachineInstanceView{
+		Statuses: []*armcompute.InstanceViewStatus{
+			{Code: to.Ptr("ProvisioningState/succeeded")},
+			{Code: to.Ptr(powerState)},
+		},
+	})
+	instanceViewSender.PathPattern = ".*/virtualMachines/machine-0/instanceView"
+	senders := s.getInstancesSender()
+	s.sender = append(senders[:2], append(azuretesting.Senders{instanceViewSender}, senders[2:]...)...)
+	insts, err := s.env.Instances(context.Background(), []instance.Id{"machine-0"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(insts, gc.HasLen, 1)
+	s.sender = azuretesting.Senders{}
+	s.requests = nil
+	return insts[0]
+}
+
 func assertInstanceStatus(c *gc.C, actual instance.Status, status status.Status, message string) {
 	c.Assert(actual, jc.DeepEquals, instance.Status{
 		Status:  status,
@@ -0,0 +1,188 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/pricing"
+)
+
+// retailPricesURL is the endpoint of the Azure Retail Prices API, which
+// publishes the pay-as-you-go prices of Azure services and does not need
+// any credentials.
+var retailPricesURL = "https://prices.azure.com/api/retail/prices"
+
+// priceCatalogueTTL is how long the prices fetched for a location are
+// reused before the Retail Prices API is queried again. The catalogue runs
+// to several pages and the published prices change rarely.
+var priceCatalogueTTL = 6 * time.Hour
+
+// retailPricesPage is a page of results from the Azure Retail Prices API.
+type retailPricesPage struct {
+	Items        []retailPrice `json:"Items"`
+	NextPageLink string        `json:"NextPageLink"`
+}
+
+// retailPrice is a single price from the Azure Retail Prices API.
+type retailPrice struct {
+	CurrencyCode  string  `json:"currencyCode"`
+	RetailPrice   float64 `json:"retailPrice"`
+	ArmSkuName    string  `json:"armSkuName"`
+	ProductName   string  `json:"productName"`
+	SkuName       string  `json:"skuName"`
+	UnitOfMeasure string  `json:"unitOfMeasure"`
+
+	MeterID              string `json:"meterId"`
+	IsPrimaryMeterRegion bool   `json:"isPrimaryMeterRegion"`
+	EffectiveStartDate   string `json:"effectiveStartDate"`
+}
+
+// isLinuxOnDemand reports whether the price is the hourly price of a
+// Linux VM of a regular priority.
+func (p retailPrice) isLinuxOnDemand() bool {
+	return p.ArmSkuName != "" &&
+		p.UnitOfMeasure == "1 Hour" &&
+		!strings.Contains(p.ProductName, "Windows") &&
+		!strings.Contains(p.SkuName, "Spot") &&
+		!strings.Contains(p.SkuName, "Low Priority")
+}
+
+// preferredTo reports whether the price should be used instead of other,
+// when both are for the same VM size. The API can return several meters
+// for a size, in no particular order, so the primary meter is preferred,
+// then the most recent price, then the lowest meter ID.
+func (p retailPrice) preferredTo(other retailPrice) bool {
+	if p.IsPrimaryMeterRegion != other.IsPrimaryMeterRegion {
+		return p.IsPrimaryMeterRegion
+	}
+	if p.EffectiveStartDate != other.EffectiveStartDate {
+		return p.EffectiveStartDate > other.EffectiveStartDate
+	}
+	return p.MeterID < other.MeterID
+}
+
+// locationPrices holds the prices fetched for a location, and when they
+// should be fetched again.
+type locationPrices struct {
+	currency string
+	prices   map[string]float64
+	expiry   time.Time
+}
+
+var _ environs.PriceCatalogueFetcher = (*azureEnviron)(nil)
+
+// PriceCatalogue implements environs.PriceCatalogueFetcher. It returns
+// the pay-as-you-go prices of Linux VM sizes in the environ's location.
+// Managed disks are priced by performance tier rather than by size, so no
+// volume prices are returned.
+func (env *azureEnviron) PriceCatalogue(ctx context.Context) (*pricing.Catalogue, error) {
+	cached, err := env.provider.prices.get(env.location, func() (locationPrices, error) {
+		return env.fetchLocationPrices(ctx)
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	prices := make(map[string]float64, len(cached.prices))
+	for sku, price := range cached.prices {
+		prices[sku] = price
+	}
+	return &pricing.Catalogue{
+		Currency: cached.currency,
+		Regions: map[string]pricing.RegionPrices{
+			env.cloud.Region: {InstanceTypes: prices},
+		},
+	}, nil
+}
+
+// priceCache holds the prices fetched for each location. Environs are
+// opened afresh for each API call, so the cache is held by the provider.
+type priceCache struct {
+	clock clock.Clock
+
+	mu        sync.Mutex
+	locations map[string]locationPrices
+}
+
+// get returns the prices cached for the location, calling fetch to
+// refresh them if they are missing or have expired.
+func (c *priceCache) get(location string, fetch func() (locationPrices, error)) (locationPrices, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	if cached, ok := c.locations[location]; ok && now.Before(cached.expiry) {
+		return cached, nil
+	}
+	fetched, err := fetch()
+	if err != nil {
+		return locationPrices{}, errors.Trace(err)
+	}
+	fetched.expiry = now.Add(priceCatalogueTTL)
+	if c.locations == nil {
+		c.locations = make(map[string]locationPrices)
+	}
+	c.locations[location] = fetched
+	return fetched, nil
+}
+
+// fetchLocationPrices pages through the Retail Prices API for the prices
+// of Linux VM sizes in the environ's location.
+func (env *azureEnviron) fetchLocationPrices(ctx context.Context) (locationPrices, error) {
+	pipeline := runtime.NewPipeline("azure-pricing", "", runtime.PipelineOptions{}, &env.clientOptions)
+	query := url.Values{
+		"$filter": {fmt.Sprintf(
+			"serviceName eq 'Virtual Machines' and armRegionName eq '%s' and priceType eq 'Consumption'",
+			env.location,
+		)},
+	}
+
+	var currency string
+	chosen := make(map[string]retailPrice)
+	for next := retailPricesURL + "?" + query.Encode(); next != ""; {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, next)
+		if err != nil {
+			return locationPrices{}, errors.Trace(err)
+		}
+		resp, err := pipeline.Do(req)
+		if err != nil {
+			return locationPrices{}, errors.Annotate(err, "querying retail prices")
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return locationPrices{}, errors.Annotate(runtime.NewResponseError(resp), "querying retail prices")
+		}
+		var page retailPricesPage
+		if err := runtime.UnmarshalAsJSON(resp, &page); err != nil {
+			return locationPrices{}, errors.Annotate(err, "parsing retail prices")
+		}
+		for _, price := range page.Items {
+			if !price.isLinuxOnDemand() {
+				continue
+			}
+			currency = price.CurrencyCode
+			if existing, ok := chosen[price.ArmSkuName]; ok && !price.preferredTo(existing) {
+				continue
+			}
+			chosen[price.ArmSkuName] = price
+		}
+		next = page.NextPageLink
+	}
+
+	prices := make(map[string]float64, len(chosen))
+	for sku, price := range chosen {
+		prices[sku] = price.RetailPrice
+	}
+	return locationPrices{currency: currency, prices: prices}, nil
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package azure_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/pricing"
+	"github.com/juju/juju/internal/provider/azure"
+	"github.com/juju/juju/internal/provider/azure/internal/azuretesting"
+)
+
+func retailPricesPage(next string, items ...map[string]any) map[string]any {
+	return map[string]any{
+		"BillingCurrency": "USD",
+		"Items":           items,
+		"NextPageLink":    next,
+	}
+}
+
+func retailPrice(sku, product, skuName string, price float64) map[string]any {
+	return map[string]any{
+		"currencyCode":  "USD",
+		"retailPrice":   price,
+		"armSkuName":    sku,
+		"productName":   product,
+		"skuName":       skuName,
+		"unitOfMeasure": "1 Hour",
+		"armRegionName": "westus",
+	}
+}
+
+func (s *environSuite) TestPriceCatalogue(c *gc.C) {
+	env := s.openEnviron(c)
+	s.sender = azuretesting.Senders{
+		azuretesting.NewSenderWithValue(retailPricesPage(
+			"https://prices.azure.com/api/retail/prices?$skip=100",
+			retailPrice("Standard_D2s_v3", "Virtual Machines DSv3 Series", "D2s v3", 0.096),
+			retailPrice("Standard_D2s_v3", "Virtual Machines DSv3 Series Windows", "D2s v3", 0.188),
+			retailPrice("Standard_D2s_v3", "Virtual Machines DSv3 Series", "D2s v3 Spot", 0.019),
+		)),
+		azuretesting.NewSenderWithValue(retailPricesPage(
+			"",
+			retailPrice("Standard_A1", "Virtual Machines A Series", "A1", 0.06),
+			retailPrice("Standard_A1", "Virtual Machines A Series", "A1 Low Priority", 0.012),
+		)),
+	}
+
+	catalogue, err := env.(environs.PriceCatalogueFetcher).PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(catalogue, jc.DeepEquals, &pricing.Catalogue{
+		Currency: "USD",
+		Regions: map[string]pricing.RegionPrices{
+			"westus": {InstanceTypes: map[string]float64{
+				"Standard_D2s_v3": 0.096,
+				"Standard_A1":     0.06,
+			}},
+		},
+	})
+
+	c.Assert(s.requests, gc.HasLen, 2)
+	c.Check(s.requests[0].Method, gc.Equals, http.MethodGet)
+	c.Check(s.requests[0].URL.Host, gc.Equals, "prices.azure.com")
+	c.Check(s.requests[0].URL.Query().Get("$filter"), gc.Equals,
+		"serviceName eq 'Virtual Machines' and armRegionName eq 'westus' and priceType eq 'Consumption'")
+	c.Check(s.requests[1].URL.Query().Get("$skip"), gc.Equals, "100")
+}
+
+func (s *environSuite) TestPriceCatalogueChoosesMeter(c *gc.C) {
+	meter := func(id string, primary bool, start string, price float64) map[string]any {
+		item := retailPrice("Standard_D2s_v3", "Virtual Machines DSv3 Series", "D2s v3", price)
+		item["meterId"] = id
+		item["isPrimaryMeterRegion"] = primary
+		item["effectiveStartDate"] = start
+		return item
+	}
+	env := s.openEnviron(c)
+	s.sender = azuretesting.Senders{
+		azuretesting.NewSenderWithValue(retailPricesPage(
+			"",
+			meter("c", false, "2025-01-01T00:00:00Z", 0.5),
+			meter("b", true, "2023-01-01T00:00:00Z", 0.09),
+			meter("a", true, "2023-01-01T00:00:00Z", 0.095),
+			meter("d", true, "2024-01-01T00:00:00Z", 0.096),
+			meter("e", true, "2022-01-01T00:00:00Z", 0.08),
+		)),
+	}
+
+	catalogue, err := env.(environs.PriceCatalogueFetcher).PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	price, ok := catalogue.InstanceTypePrice("westus", "Standard_D2s_v3")
+	c.Assert(ok, jc.IsTrue)
+	c.Check(price, gc.Equals, 0.096)
+}
+
+func (s *environSuite) TestPriceCatalogueBreaksTiesOnMeterID(c *gc.C) {
+	meter := func(id string, price float64) map[string]any {
+		item := retailPrice("Standard_A1", "Virtual Machines A Series", "A1", price)
+		item["meterId"] = id
+		item["isPrimaryMeterRegion"] = true
+		item["effectiveStartDate"] = "2024-01-01T00:00:00Z"
+		return item
+	}
+	env := s.openEnviron(c)
+	s.sender = azuretesting.Senders{
+		azuretesting.NewSenderWithValue(retailPricesPage("", meter("b", 0.07), meter("a", 0.06), meter("c", 0.05))),
+	}
+
+	catalogue, err := env.(environs.PriceCatalogueFetcher).PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	price, ok := catalogue.InstanceTypePrice("westus", "Standard_A1")
+	c.Assert(ok, jc.IsTrue)
+	c.Check(price, gc.Equals, 0.06)
+}
+
+func (s *environSuite) TestPriceCatalogueCached(c *gc.C) {
+	env := s.openEnviron(c)
+	// An environ opened later for the same location uses the cached
+	// prices until they expire.
+	other := s.openEnviron(c)
+	s.sender = azuretesting.Senders{
+		azuretesting.NewSenderWithValue(retailPricesPage(
+			"", retailPrice("Standard_A1", "Virtual Machines A Series", "A1", 0.06),
+		)),
+	}
+	fetcher := env.(environs.PriceCatalogueFetcher)
+	_, err := fetcher.PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.requests, gc.HasLen, 1)
+
+	s.retryClock.Advance(azure.PriceCatalogueTTL - time.Minute)
+	catalogue, err := other.(environs.PriceCatalogueFetcher).PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.requests, gc.HasLen, 1)
+	price, _ := catalogue.InstanceTypePrice("westus", "Standard_A1")
+	c.Check(price, gc.Equals, 0.06)
+
+	s.sender = azuretesting.Senders{
+		azuretesting.NewSenderWithValue(retailPricesPage(
+			"", retailPrice("Standard_A1", "Virtual Machines A Series", "A1", 0.07),
+		)),
+	}
+	s.retryClock.Advance(time.Minute)
+	catalogue, err = fetcher.PriceCatalogue(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.requests, gc.HasLen, 2)
+	price, _ = catalogue.InstanceTypePrice("westus", "Standard_A1")
+	c.Check(price, gc.Equals, 0.07)
+}
+
+func (s *environSuite) TestPriceCatalogueError(c *gc.C) {
+	env := s.openEnviron(c)
+	sender := &azuretesting.MockSender{}
+	sender.AppendResponse(azuretesting.NewResponseWithStatus("not found", http.StatusNotFound))
+	s.sender = azuretesting.Senders{sender}
+
+	_, err := env.(environs.PriceCatalogueFetcher).PriceCatalogue(context.Background())
+	c.Assert(err, gc.ErrorMatches, "querying retail prices: (?s).*")
+}
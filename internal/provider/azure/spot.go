@@ -0,0 +1,69 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v2"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+// evictedPowerStates holds the power states in which an evicted Azure
+// Spot VM is found. Spot VMs are created with the "Deallocate" eviction
+// policy, so that an eviction can be observed rather than the VM simply
+// disappearing.
+var evictedPowerStates = map[string]bool{
+	"PowerState/deallocating": true,
+	"PowerState/deallocated":  true,
+}
+
+// setSpotOptions updates the VM properties so that the VM runs as an
+// Azure Spot VM. Without a max price, the VM is only evicted for lack
+// of capacity.
+func setSpotOptions(props *armcompute.VirtualMachineProperties, cons constraints.Value) {
+	maxPrice := float64(-1)
+	if cons.HasSpotMaxPrice() {
+		maxPrice = *cons.SpotMaxPrice
+	}
+	props.Priority = to.Ptr(armcompute.VirtualMachinePriorityTypesSpot)
+	props.EvictionPolicy = to.Ptr(armcompute.VirtualMachineEvictionPolicyTypesDeallocate)
+	props.BillingProfile = &armcompute.BillingProfile{MaxPrice: to.Ptr(maxPrice)}
+}
+
+// isSpotVM reports whether the VM runs as an Azure Spot VM.
+func isSpotVM(vm *armcompute.VirtualMachine) bool {
+	if vm.Properties == nil || vm.Properties.Priority == nil {
+		return false
+	}
+	return *vm.Properties.Priority == armcompute.VirtualMachinePriorityTypesSpot
+}
+
+// setSpotPowerStates queries the power state of each of the given spot
+// instances, so that evicted VMs can be reported as interrupted.
+func (env *azureEnviron) setSpotPowerStates(ctx context.Context, resourceGroup string, insts []*azureInstance) error {
+	compute, err := env.computeClient()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, inst := range insts {
+		if !inst.spot {
+			continue
+		}
+		view, err := compute.InstanceView(ctx, resourceGroup, inst.vmName, nil)
+		if err != nil {
+			return env.HandleCredentialError(ctx, errors.Annotatef(err, "getting instance view of %q", inst.vmName))
+		}
+		for _, s := range view.Statuses {
+			if code := toValue(s.Code); strings.HasPrefix(code, "PowerState/") {
+				inst.powerState = code
+			}
+		}
+	}
+	return nil
+}
@@ -139,6 +139,8 @@ type azureVolumeSource struct {
 	env *azureEnviron
 }
 
+var _ storage.VolumeResizer = (*azureVolumeSource)(nil)
+
 // CreateVolumes is specified on the storage.VolumeSource interface.
 func (v *azureVolumeSource) CreateVolumes(ctx context.Context, params []storage.VolumeParams) (_ []storage.CreateVolumesResult, err error) {
 	results := make([]storage.CreateVolumesResult, len(params))
@@ -305,6 +307,66 @@ func (v *azureVolumeSource) destroyManagedDiskVolumes(ctx context.Context, volum
 	}), nil
 }
 
+// ResizeVolumes is specified on the storage.VolumeResizer interface.
+func (v *azureVolumeSource) ResizeVolumes(ctx context.Context, params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(params))
+	var wg sync.WaitGroup
+	for i, p := range params {
+		wg.Add(1)
+		go func(i int, p storage.VolumeResizeParams) {
+			defer wg.Done()
+			size, err := v.resizeManagedDiskVolume(ctx, p)
+			if err != nil {
+				results[i].Error = v.env.HandleCredentialError(ctx, errors.Annotatef(err, "resizing disk %q", p.VolumeId))
+				return
+			}
+			results[i].Size = size
+		}(i, p)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// resizeManagedDiskVolume grows the managed disk to at least the requested
+// size, returning the resulting size in MiB. Disks are never shrunk.
+func (v *azureVolumeSource) resizeManagedDiskVolume(ctx context.Context, p storage.VolumeResizeParams) (uint64, error) {
+	disks, err := v.env.disksClient()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	disk, err := disks.Get(ctx, v.env.resourceGroup, p.VolumeId, nil)
+	if errorutils.IsNotFoundError(err) {
+		return 0, errors.NotFoundf("disk %s", p.VolumeId)
+	} else if err != nil {
+		return 0, errors.Trace(err)
+	}
+	var currentGib uint64
+	if disk.Properties != nil {
+		currentGib = uint64(toValue(disk.Properties.DiskSizeGB))
+	}
+	sizeInGib := mibToGib(p.Size)
+	if sizeInGib <= currentGib {
+		return gibToMib(currentGib), nil
+	}
+
+	var result armcompute.DisksClientUpdateResponse
+	poller, err := disks.BeginUpdate(ctx, v.env.resourceGroup, p.VolumeId, armcompute.DiskUpdate{
+		Properties: &armcompute.DiskUpdateProperties{
+			DiskSizeGB: to.Ptr(int32(sizeInGib)),
+		},
+	}, nil)
+	if err == nil {
+		result, err = poller.PollUntilDone(ctx, nil)
+	}
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if result.Properties != nil && result.Properties.DiskSizeGB != nil {
+		return gibToMib(uint64(toValue(result.Properties.DiskSizeGB))), nil
+	}
+	return gibToMib(sizeInGib), nil
+}
+
 func foreachVolume(volumeIds []string, f func(string) error) []error {
 	results := make([]error, len(volumeIds))
 	var wg sync.WaitGroup
@@ -370,6 +370,82 @@ func (s *storageSuite) TestDescribeVolumesNotFound(c *gc.C) {
 	c.Assert(results[0].Error, gc.ErrorMatches, `.*disk volume-42 not found`)
 }
 
+func (s *storageSuite) TestResizeVolumes(c *gc.C) {
+	volumeSource := s.volumeSource(c)
+	getSender := azuretesting.NewSenderWithValue(&armcompute.Disk{
+		Properties: &armcompute.DiskProperties{
+			DiskSizeGB: to.Ptr(int32(1)),
+		},
+	})
+	getSender.PathPattern = `.*/Microsoft\.Compute/disks/volume-0`
+	updateSender := azuretesting.NewSenderWithValue(&armcompute.Disk{
+		Properties: &armcompute.DiskProperties{
+			DiskSizeGB: to.Ptr(int32(4)),
+		},
+	})
+	updateSender.PathPattern = `.*/Microsoft\.Compute/disks/volume-0`
+	s.sender = azuretesting.Senders{getSender, updateSender}
+	s.requests = nil
+
+	results, err := volumeSource.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{
+		Size: 4 * 1024,
+	}})
+
+	c.Assert(s.requests, gc.HasLen, 2)
+	c.Assert(s.requests[0].Method, gc.Equals, "GET")
+	c.Assert(s.requests[1].Method, gc.Equals, "PATCH")
+	assertRequestBody(c, s.requests[1], &armcompute.DiskUpdate{
+		Properties: &armcompute.DiskUpdateProperties{
+			DiskSizeGB: to.Ptr(int32(4)),
+		},
+	})
+}
+
+func (s *storageSuite) TestResizeVolumesNoShrink(c *gc.C) {
+	volumeSource := s.volumeSource(c)
+	getSender := azuretesting.NewSenderWithValue(&armcompute.Disk{
+		Properties: &armcompute.DiskProperties{
+			DiskSizeGB: to.Ptr(int32(8)),
+		},
+	})
+	getSender.PathPattern = `.*/Microsoft\.Compute/disks/volume-0`
+	s.sender = azuretesting.Senders{getSender}
+	s.requests = nil
+
+	results, err := volumeSource.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{
+		Size: 8 * 1024,
+	}})
+	c.Assert(s.requests, gc.HasLen, 1)
+}
+
+func (s *storageSuite) TestResizeVolumesWithInvalidCredential(c *gc.C) {
+	volumeSource := s.volumeSource(c)
+	s.createSenderWithUnauthorisedStatusCode()
+
+	c.Assert(s.invalidatedCredential, jc.IsFalse)
+	results, err := volumeSource.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.NotNil)
+	c.Assert(s.invalidatedCredential, jc.IsTrue)
+}
+
 func (s *storageSuite) TestDestroyVolumes(c *gc.C) {
 	volumeSource := s.volumeSource(c)
 
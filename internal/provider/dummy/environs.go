@@ -553,6 +553,8 @@ var unsupportedConstraints = []string{
 	constraints.CpuPower,
 	constraints.VirtType,
 	constraints.ImageID,
+	constraints.Spot,
+	constraints.SpotMaxPrice,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -87,6 +87,7 @@ const (
 	deviceInUse        = "InvalidDevice.InUse"
 	attachmentNotFound = "InvalidAttachment.NotFound"
 	volumeNotFound     = "InvalidVolume.NotFound"
+	snapshotNotFound   = "InvalidSnapshot.NotFound"
 	incorrectState     = "IncorrectState"
 )
 
@@ -335,6 +336,8 @@ type ebsVolumeSource struct {
 }
 
 var _ storage.VolumeSource = (*ebsVolumeSource)(nil)
+var _ storage.VolumeResizer = (*ebsVolumeSource)(nil)
+var _ storage.VolumeSnapshotter = (*ebsVolumeSource)(nil)
 
 // parseVolumeOptions uses storage volume parameters to make a struct used to create volumes.
 func parseVolumeOptions(size uint64, attrs map[string]interface{}) (_ ec2.CreateVolumeInput, _ error) {
@@ -448,6 +451,9 @@ func (v *ebsVolumeSource) createVolume(ctx context.Context, p storage.VolumePara
 	if inst.Placement != nil {
 		vol.AvailabilityZone = inst.Placement.AvailabilityZone
 	}
+	if p.SnapshotId != "" {
+		vol.SnapshotId = aws.String(p.SnapshotId)
+	}
 
 	// Tag.
 	resourceTags := make(map[string]string)
@@ -1089,6 +1095,118 @@ func (v *ebsVolumeSource) ImportVolume(ctx context.Context, volumeId string, tag
 	}, nil
 }
 
+// ResizeVolumes is specified on the storage.VolumeResizer interface.
+func (v *ebsVolumeSource) ResizeVolumes(ctx context.Context, params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		size, err := v.resizeVolume(ctx, p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(v.env.HandleCredentialError(ctx, err), "resizing volume %s", p.VolumeId)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (v *ebsVolumeSource) resizeVolume(ctx context.Context, p storage.VolumeResizeParams) (uint64, error) {
+	vol, err := describeVolume(ctx, v.env.ec2Client, p.VolumeId)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	currentSize := uint64(aws.ToInt32(vol.Size))
+	requestedSize := mibToGib(p.Size)
+	if requestedSize <= currentSize {
+		return gibToMib(currentSize), nil
+	}
+	// EBS volumes are modified in place, while they remain attached and
+	// in use. The new size is available as soon as the modification is
+	// in the "optimizing" state, although the guest must still grow its
+	// partitions and filesystems to make use of it.
+	_, err = v.env.ec2Client.ModifyVolume(ctx, &ec2.ModifyVolumeInput{
+		VolumeId: aws.String(p.VolumeId),
+		Size:     aws.Int32(int32(requestedSize)),
+	})
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	return gibToMib(requestedSize), nil
+}
+
+// CreateVolumeSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *ebsVolumeSource) CreateVolumeSnapshots(ctx context.Context, params []storage.VolumeSnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(params))
+	for i, p := range params {
+		resourceTags := make(map[string]string)
+		for k, v := range p.ResourceTags {
+			resourceTags[k] = v
+		}
+		resourceTags[tagName] = resourceName(p.Tag, v.envName)
+		resp, err := v.env.ec2Client.CreateSnapshot(ctx, &ec2.CreateSnapshotInput{
+			VolumeId:    aws.String(p.VolumeId),
+			Description: aws.String(resourceName(p.Tag, v.envName)),
+			TagSpecifications: []types.TagSpecification{
+				CreateTagSpecification(types.ResourceTypeSnapshot, resourceTags),
+			},
+		})
+		if err != nil {
+			results[i].Error = errors.Annotatef(v.env.HandleCredentialError(ctx, err), "creating snapshot of volume %s", p.VolumeId)
+			continue
+		}
+		results[i].Snapshot = &storage.Snapshot{
+			SnapshotId: aws.ToString(resp.SnapshotId),
+			SourceId:   aws.ToString(resp.VolumeId),
+			Size:       gibToMib(uint64(aws.ToInt32(resp.VolumeSize))),
+			Created:    aws.ToTime(resp.StartTime),
+			Status:     string(resp.State),
+		}
+	}
+	return results, nil
+}
+
+// ListVolumeSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *ebsVolumeSource) ListVolumeSnapshots(ctx context.Context, volumeIds []string) ([]storage.ListSnapshotsResult, error) {
+	results := make([]storage.ListSnapshotsResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		resp, err := v.env.ec2Client.DescribeSnapshots(ctx, &ec2.DescribeSnapshotsInput{
+			Filters: []types.Filter{makeFilter("volume-id", volumeId)},
+		})
+		if err != nil {
+			results[i].Error = errors.Annotatef(v.env.HandleCredentialError(ctx, err), "listing snapshots of volume %s", volumeId)
+			continue
+		}
+		snapshots := make([]storage.Snapshot, len(resp.Snapshots))
+		for j, snapshot := range resp.Snapshots {
+			snapshots[j] = storage.Snapshot{
+				SnapshotId: aws.ToString(snapshot.SnapshotId),
+				SourceId:   aws.ToString(snapshot.VolumeId),
+				Size:       gibToMib(uint64(aws.ToInt32(snapshot.VolumeSize))),
+				Created:    aws.ToTime(snapshot.StartTime),
+				Status:     string(snapshot.State),
+			}
+		}
+		results[i].Snapshots = snapshots
+	}
+	return results, nil
+}
+
+// DeleteVolumeSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *ebsVolumeSource) DeleteVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		_, err := v.env.ec2Client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
+			SnapshotId: aws.String(snapshotId),
+		})
+		if ec2ErrCode(err) == snapshotNotFound {
+			err = nil
+		}
+		if err != nil {
+			results[i] = errors.Annotatef(v.env.HandleCredentialError(ctx, err), "deleting snapshot %s", snapshotId)
+		}
+	}
+	return results, nil
+}
+
 var errTooManyVolumes = errors.New("too many EBS volumes to attach")
 
 // blockDeviceNamer returns a function that cycles through block device names.
@@ -1102,6 +1102,190 @@ func (s *ebsSuite) TestImportVolumeInUse(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `cannot import volume with status "in-use"`)
 }
 
+func (s *ebsSuite) TestResizeVolumes(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	c.Assert(vs, gc.Implements, new(storage.VolumeResizer))
+
+	resp, err := s.srv.ec2srv.CreateVolume(context.Background(), &awsec2.CreateVolumeInput{
+		Size:             aws.Int32(10),
+		VolumeType:       "gp2",
+		AvailabilityZone: aws.String("us-east-1a"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	volID := aws.ToString(resp.VolumeId)
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: volID,
+		Size:     20*1024 + 1,
+	}, {
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "vol-missing",
+		Size:     20 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 2)
+	c.Check(results[0].Error, jc.ErrorIsNil)
+	c.Check(results[0].Size, gc.Equals, uint64(21*1024))
+	c.Check(results[1].Error, gc.ErrorMatches, `resizing volume vol-missing: .*`)
+
+	volumes, err := s.srv.ec2srv.DescribeVolumes(context.Background(), &awsec2.DescribeVolumesInput{
+		VolumeIds: []string{volID},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(volumes.Volumes, gc.HasLen, 1)
+	c.Check(aws.ToInt32(volumes.Volumes[0].Size), gc.Equals, int32(21))
+}
+
+func (s *ebsSuite) TestResizeVolumesNoShrink(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	resp, err := s.srv.ec2srv.CreateVolume(context.Background(), &awsec2.CreateVolumeInput{
+		Size:             aws.Int32(10),
+		VolumeType:       "gp2",
+		AvailabilityZone: aws.String("us-east-1a"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.srv.ec2srv.SetAPIError("ModifyVolume", &smithy.GenericAPIError{Code: "Unexpected"})
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: aws.ToString(resp.VolumeId),
+		Size:     5 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{Size: 10 * 1024}})
+}
+
+func (s *ebsSuite) TestResizeVolumesCredentialError(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	resp, err := s.srv.ec2srv.CreateVolume(context.Background(), &awsec2.CreateVolumeInput{
+		Size:             aws.Int32(10),
+		VolumeType:       "gp2",
+		AvailabilityZone: aws.String("us-east-1a"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.srv.ec2srv.SetAPIError("ModifyVolume", &smithy.GenericAPIError{Code: "Blocked"})
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: aws.ToString(resp.VolumeId),
+		Size:     20 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIs, common.ErrorCredentialNotValid)
+}
+
+func (s *ebsSuite) TestVolumeSnapshots(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	c.Assert(vs, gc.Implements, new(storage.VolumeSnapshotter))
+	snapshotter := vs.(storage.VolumeSnapshotter)
+
+	resp, err := s.srv.ec2srv.CreateVolume(context.Background(), &awsec2.CreateVolumeInput{
+		Size:             aws.Int32(10),
+		VolumeType:       "gp2",
+		AvailabilityZone: aws.String("us-east-1a"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	volID := aws.ToString(resp.VolumeId)
+
+	created, err := snapshotter.CreateVolumeSnapshots(context.Background(), []storage.VolumeSnapshotParams{{
+		Tag:          names.NewVolumeTag("0"),
+		VolumeId:     volID,
+		ResourceTags: map[string]string{"foo": "bar"},
+	}, {
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "vol-missing",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(created, gc.HasLen, 2)
+	c.Assert(created[0].Error, jc.ErrorIsNil)
+	c.Check(created[0].Snapshot.SnapshotId, gc.Equals, "snap-0")
+	c.Check(created[0].Snapshot.SourceId, gc.Equals, volID)
+	c.Check(created[0].Snapshot.Size, gc.Equals, uint64(10*1024))
+	c.Check(created[0].Snapshot.Status, gc.Equals, "completed")
+	c.Check(created[1].Error, gc.ErrorMatches, `creating snapshot of volume vol-missing: .*`)
+
+	snapshots, err := s.srv.ec2srv.DescribeSnapshots(context.Background(), &awsec2.DescribeSnapshotsInput{
+		SnapshotIds: []string{"snap-0"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshots.Snapshots, gc.HasLen, 1)
+	compareTags(c, snapshots.Snapshots[0].Tags, []tagInfo{
+		{"foo", "bar"},
+		{"Name", "juju-testmodel-volume-0"},
+	})
+
+	listed, err := snapshotter.ListVolumeSnapshots(context.Background(), []string{volID, "vol-other"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(listed, gc.HasLen, 2)
+	c.Assert(listed[0].Error, jc.ErrorIsNil)
+	c.Check(listed[0].Snapshots, jc.DeepEquals, []storage.Snapshot{*created[0].Snapshot})
+	c.Check(listed[1].Error, jc.ErrorIsNil)
+	c.Check(listed[1].Snapshots, gc.HasLen, 0)
+
+	deleted, err := snapshotter.DeleteVolumeSnapshots(context.Background(), []string{"snap-0", "snap-missing"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(deleted, jc.DeepEquals, []error{nil, nil})
+
+	listed, err = snapshotter.ListVolumeSnapshots(context.Background(), []string{volID})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(listed[0].Snapshots, gc.HasLen, 0)
+}
+
+func (s *ebsSuite) TestCreateVolumeSnapshotsCredentialError(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	s.srv.ec2srv.SetAPIError("CreateSnapshot", &smithy.GenericAPIError{Code: "Blocked"})
+
+	results, err := vs.(storage.VolumeSnapshotter).CreateVolumeSnapshots(context.Background(), []storage.VolumeSnapshotParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIs, common.ErrorCredentialNotValid)
+}
+
+func (s *ebsSuite) TestCreateVolumesFromSnapshot(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	resp, err := s.srv.ec2srv.CreateVolume(context.Background(), &awsec2.CreateVolumeInput{
+		Size:             aws.Int32(10),
+		VolumeType:       "gp2",
+		AvailabilityZone: aws.String("us-east-1a"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	snapshot, err := s.srv.ec2srv.CreateSnapshot(context.Background(), &awsec2.CreateSnapshotInput{
+		VolumeId: resp.VolumeId,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	inst, err := s.srv.ec2srv.NewInstances(1, "m1.medium", imageId, ec2test.Running, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	results, err := vs.CreateVolumes(context.Background(), []storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("0"),
+		Size:       10 * 1024,
+		Provider:   ec2.EBS_ProviderType,
+		SnapshotId: aws.ToString(snapshot.SnapshotId),
+		Attachment: &storage.VolumeAttachmentParams{
+			AttachmentParams: storage.AttachmentParams{
+				InstanceId: instance.Id(inst[0]),
+			},
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+
+	volumes, err := s.srv.ec2srv.DescribeVolumes(context.Background(), &awsec2.DescribeVolumesInput{
+		VolumeIds: []string{results[0].Volume.VolumeId},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(volumes.Volumes, gc.HasLen, 1)
+	c.Check(aws.ToString(volumes.Volumes[0].SnapshotId), gc.Equals, aws.ToString(snapshot.SnapshotId))
+}
+
 type blockDeviceMappingSuite struct {
 	testing.BaseSuite
 }
@@ -724,6 +724,9 @@ func (e *environ) StartInstance(
 		AvailabilityZone: aws.String(availabilityZone),
 	}
 	runArgs.SubnetId = subnet.SubnetId
+	if args.Constraints.HasSpot() {
+		runArgs.InstanceMarketOptions = spotMarketOptions(args.Constraints)
+	}
 
 	_ = callback(ctx, status.Allocating,
 		fmt.Sprintf("Trying to start instance in availability zone %q", availabilityZone), nil)
@@ -1303,6 +1306,7 @@ func (e *environ) Instances(ctx context.Context, ids []instance.Id) ([]instances
 	if errors.Is(err, environs.ErrPartialInstances) {
 		for _, inst := range insts {
 			if inst != nil {
+				e.setSpotRequestStatus(ctx, insts)
 				return insts, environs.ErrPartialInstances
 			}
 		}
@@ -1311,6 +1315,7 @@ func (e *environ) Instances(ctx context.Context, ids []instance.Id) ([]instances
 	if err != nil {
 		return nil, err
 	}
+	e.setSpotRequestStatus(ctx, insts)
 	return insts, nil
 }
 
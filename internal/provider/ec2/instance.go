@@ -23,9 +23,16 @@ import (
 type sdkInstance struct {
 	e *environ
 	i types.Instance
+
+	// spotStatus holds the status of the spot request which launched
+	// the instance, if it is a spot instance and the status is known.
+	spotStatus *types.SpotInstanceStatus
 }
 
-var _ instances.Instance = (*sdkInstance)(nil)
+var (
+	_ instances.Instance              = (*sdkInstance)(nil)
+	_ instances.InterruptibleInstance = (*sdkInstance)(nil)
+)
 
 // String returns a string representation of this instance (the ID).
 func (inst *sdkInstance) String() string {
@@ -52,6 +59,13 @@ func (inst *sdkInstance) Status(_ context.Context) instance.Status {
 		return instance.Status{Status: status.Empty}
 	}
 
+	if inst.spotStatus != nil && spotInterruptionCodes[aws.ToString(inst.spotStatus.Code)] {
+		return instance.Status{
+			Status:  status.Interrupted,
+			Message: aws.ToString(inst.spotStatus.Message),
+		}
+	}
+
 	// pending | running | shutting-down | terminated | stopping | stopped
 	var jujuStatus status.Status
 	switch inst.i.State.Name {
@@ -70,6 +84,12 @@ func (inst *sdkInstance) Status(_ context.Context) instance.Status {
 	}
 }
 
+// Interruptible implements instances.InterruptibleInstance, returning
+// true if this is a spot instance.
+func (inst *sdkInstance) Interruptible() bool {
+	return inst.i.InstanceLifecycle == types.InstanceLifecycleTypeSpot
+}
+
 // Addresses implements network.Addresses() returning generic address
 // details for the instance, and requerying the ec2 api if required.
 func (inst *sdkInstance) Addresses(_ context.Context) (network.ProviderAddresses, error) {
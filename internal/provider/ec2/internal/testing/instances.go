@@ -48,6 +48,8 @@ type Instance struct {
 	rootDeviceType      types.DeviceType
 	rootDeviceName      string
 	metadataOptions     *types.InstanceMetadataOptionsResponse
+	marketOptions       *types.InstanceMarketOptionsRequest
+	spotRequest         *types.SpotInstanceRequest
 
 	iamInstanceProfile *types.IamInstanceProfileSpecification
 }
@@ -190,6 +192,10 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 			srv.createBlockDeviceMappingsOnRun(in.BlockDeviceMappings)...,
 		)
 		inst.metadataOptions = metadataResponse
+		if in.InstanceMarketOptions != nil && in.InstanceMarketOptions.MarketType == types.MarketTypeSpot {
+			inst.marketOptions = in.InstanceMarketOptions
+			inst.spotRequest = srv.newSpotInstanceRequest(inst)
+		}
 		resp.Instances = append(resp.Instances, inst.ec2instance())
 	}
 	return resp, nil
@@ -418,6 +424,10 @@ func (inst *Instance) ec2instance() types.Instance {
 		MetadataOptions:     inst.metadataOptions,
 		NetworkInterfaces:   instanceNetworkInterfaces(inst.ifaces),
 	}
+	if inst.spotRequest != nil {
+		i.InstanceLifecycle = types.InstanceLifecycleTypeSpot
+		i.SpotInstanceRequestId = inst.spotRequest.SpotInstanceRequestId
+	}
 
 	// Set the ipv6 address on the instance to the first one we find.
 	for _, iface := range inst.ifaces {
@@ -0,0 +1,107 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/juju/collections/set"
+)
+
+// CreateSnapshot implements ec2.Client.
+func (srv *Server) CreateSnapshot(ctx context.Context, in *ec2.CreateSnapshotInput, opts ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error) {
+	srv.volumeMutatingCalls.next()
+
+	if err, ok := srv.apiCallErrors["CreateSnapshot"]; ok {
+		return nil, err
+	}
+
+	v, err := srv.volume(aws.ToString(in.VolumeId))
+	if err != nil {
+		return nil, err
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	snapshot := &types.Snapshot{
+		SnapshotId:  aws.String(fmt.Sprintf("snap-%d", srv.snapshotId.next())),
+		VolumeId:    v.VolumeId,
+		VolumeSize:  v.Size,
+		Description: in.Description,
+		Encrypted:   v.Encrypted,
+		StartTime:   aws.Time(time.Now()),
+		State:       types.SnapshotStateCompleted,
+		Tags:        tagSpecForType(types.ResourceTypeSnapshot, in.TagSpecifications).Tags,
+	}
+	srv.snapshots[aws.ToString(snapshot.SnapshotId)] = snapshot
+	return &ec2.CreateSnapshotOutput{
+		SnapshotId:  snapshot.SnapshotId,
+		VolumeId:    snapshot.VolumeId,
+		VolumeSize:  snapshot.VolumeSize,
+		Description: snapshot.Description,
+		Encrypted:   snapshot.Encrypted,
+		StartTime:   snapshot.StartTime,
+		State:       snapshot.State,
+		Tags:        snapshot.Tags,
+	}, nil
+}
+
+// DescribeSnapshots implements ec2.Client.
+func (srv *Server) DescribeSnapshots(ctx context.Context, in *ec2.DescribeSnapshotsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error) {
+	if err, ok := srv.apiCallErrors["DescribeSnapshots"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	// Only the "volume-id" filter is supported.
+	idSet := set.NewStrings()
+	volumeIds := set.NewStrings()
+	if in != nil {
+		idSet = set.NewStrings(in.SnapshotIds...)
+		for _, f := range in.Filters {
+			if aws.ToString(f.Name) != "volume-id" {
+				return nil, apiError("InvalidParameterValue", "describe Snapshots: %q filter is not implemented", aws.ToString(f.Name))
+			}
+			volumeIds = volumeIds.Union(set.NewStrings(f.Values...))
+		}
+	}
+
+	result := &ec2.DescribeSnapshotsOutput{}
+	for id, snapshot := range srv.snapshots {
+		if len(idSet) > 0 && !idSet.Contains(id) {
+			continue
+		}
+		if len(volumeIds) > 0 && !volumeIds.Contains(aws.ToString(snapshot.VolumeId)) {
+			continue
+		}
+		result.Snapshots = append(result.Snapshots, *snapshot)
+	}
+	return result, nil
+}
+
+// DeleteSnapshot implements ec2.Client.
+func (srv *Server) DeleteSnapshot(ctx context.Context, in *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error) {
+	srv.volumeMutatingCalls.next()
+
+	if err, ok := srv.apiCallErrors["DeleteSnapshot"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	id := aws.ToString(in.SnapshotId)
+	if _, ok := srv.snapshots[id]; !ok {
+		return nil, apiError("InvalidSnapshot.NotFound", "Snapshot %s not found", id)
+	}
+	delete(srv.snapshots, id)
+	return &ec2.DeleteSnapshotOutput{}, nil
+}
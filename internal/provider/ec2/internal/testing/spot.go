@@ -0,0 +1,82 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// newSpotInstanceRequest records a fulfilled one-time spot request for
+// the given instance.
+func (srv *Server) newSpotInstanceRequest(inst *Instance) *types.SpotInstanceRequest {
+	req := &types.SpotInstanceRequest{
+		SpotInstanceRequestId: aws.String(fmt.Sprintf("sir-%d", inst.seq)),
+		InstanceId:            aws.String(inst.id()),
+		State:                 types.SpotInstanceStateActive,
+		Status: &types.SpotInstanceStatus{
+			Code:    aws.String("fulfilled"),
+			Message: aws.String("Your spot request is fulfilled."),
+		},
+	}
+	if opts := inst.marketOptions.SpotOptions; opts != nil {
+		req.SpotPrice = opts.MaxPrice
+		req.Type = opts.SpotInstanceType
+	}
+	return req
+}
+
+// DescribeSpotInstanceRequests implements ec2.Client.
+func (srv *Server) DescribeSpotInstanceRequests(ctx context.Context, in *ec2.DescribeSpotInstanceRequestsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+	if err, ok := srv.apiCallErrors["DescribeSpotInstanceRequests"]; ok {
+		return nil, err
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	wanted := make(map[string]bool)
+	for _, id := range in.SpotInstanceRequestIds {
+		wanted[id] = true
+	}
+	resp := &ec2.DescribeSpotInstanceRequestsOutput{}
+	for _, inst := range srv.instances {
+		if inst.spotRequest == nil {
+			continue
+		}
+		if len(wanted) > 0 && !wanted[aws.ToString(inst.spotRequest.SpotInstanceRequestId)] {
+			continue
+		}
+		resp.SpotInstanceRequests = append(resp.SpotInstanceRequests, *inst.spotRequest)
+	}
+	return resp, nil
+}
+
+// SetSpotInstanceStatus sets the status code and message of the spot
+// request that launched the instance with the given id, as EC2 does
+// when it gives notice of an interruption.
+func (srv *Server) SetSpotInstanceStatus(instId, code, message string) error {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	inst, ok := srv.instances[instId]
+	if !ok || inst.spotRequest == nil {
+		return fmt.Errorf("no spot request for instance %q", instId)
+	}
+	inst.spotRequest.Status = &types.SpotInstanceStatus{
+		Code:    aws.String(code),
+		Message: aws.String(message),
+	}
+	return nil
+}
+
+// MarketOptions returns the market options the instance was run with,
+// or nil if it was run with on-demand capacity.
+func (inst *Instance) MarketOptions() *types.InstanceMarketOptionsRequest {
+	return inst.marketOptions
+}
@@ -26,11 +26,22 @@ func (srv *Server) CreateVolume(ctx context.Context, in *ec2.CreateVolumeInput,
 
 	srv.mu.Lock()
 	defer srv.mu.Unlock()
+	var snapshot *types.Snapshot
+	if in.SnapshotId != nil {
+		var ok bool
+		if snapshot, ok = srv.snapshots[aws.ToString(in.SnapshotId)]; !ok {
+			return nil, apiError("InvalidSnapshot.NotFound", "Snapshot %s not found", aws.ToString(in.SnapshotId))
+		}
+	}
 	volume := srv.newVolume("magnetic", 1, in.TagSpecifications)
 	volume.AvailabilityZone = in.AvailabilityZone
 	if in.VolumeType != "" {
 		volume.VolumeType = in.VolumeType
 	}
+	if snapshot != nil {
+		volume.SnapshotId = snapshot.SnapshotId
+		volume.Size = snapshot.VolumeSize
+	}
 	if in.Size != nil {
 		volume.Size = in.Size
 	}
@@ -51,6 +62,7 @@ func (srv *Server) CreateVolume(ctx context.Context, in *ec2.CreateVolumeInput,
 		VolumeType:       volume.VolumeType,
 		KmsKeyId:         volume.KmsKeyId,
 		Throughput:       volume.Throughput,
+		SnapshotId:       volume.SnapshotId,
 	}, nil
 }
 
@@ -116,6 +128,43 @@ func (srv *Server) DescribeVolumes(ctx context.Context, in *ec2.DescribeVolumesI
 	return result, nil
 }
 
+// ModifyVolume implements ec2.Client.
+func (srv *Server) ModifyVolume(ctx context.Context, in *ec2.ModifyVolumeInput, opts ...func(*ec2.Options)) (*ec2.ModifyVolumeOutput, error) {
+	srv.volumeMutatingCalls.next()
+
+	if err, ok := srv.apiCallErrors["ModifyVolume"]; ok {
+		return nil, err
+	}
+
+	v, err := srv.volume(aws.ToString(in.VolumeId))
+	if err != nil {
+		return nil, err
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	if in.Size != nil {
+		if aws.ToInt32(in.Size) < aws.ToInt32(v.Size) {
+			return nil, apiError("InvalidParameterValue", "New size cannot be smaller than existing size")
+		}
+		v.Size = in.Size
+	}
+	if in.VolumeType != "" {
+		v.VolumeType = in.VolumeType
+	}
+	if in.Iops != nil {
+		v.Iops = in.Iops
+	}
+	return &ec2.ModifyVolumeOutput{
+		VolumeModification: &types.VolumeModification{
+			VolumeId:          v.VolumeId,
+			ModificationState: types.VolumeModificationStateOptimizing,
+			TargetSize:        v.Size,
+			TargetVolumeType:  v.VolumeType,
+		},
+	}, nil
+}
+
 // SetCreateRootDisks records whether or not the server should create
 // root disks for each instance created. It defaults to false.
 func (srv *Server) SetCreateRootDisks(create bool) {
@@ -1703,6 +1703,66 @@ func (t *localServerSuite) TestStartInstanceWithImageID(c *gc.C) {
 	c.Assert(expectedImageID, gc.DeepEquals, instanceDesc.Reservations[0].Instances[0].ImageId)
 }
 
+func (t *localServerSuite) TestStartInstanceSpot(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("spot=true spot-max-price=0.045"),
+	}
+	result, err := testing.StartInstanceWithParams(env, "1", params)
+	c.Assert(err, jc.ErrorIsNil)
+
+	inst := t.srv.ec2srv.Instance(string(result.Instance.Id()))
+	c.Assert(inst, gc.NotNil)
+	c.Assert(inst.MarketOptions(), jc.DeepEquals, &types.InstanceMarketOptionsRequest{
+		MarketType: types.MarketTypeSpot,
+		SpotOptions: &types.SpotMarketOptions{
+			SpotInstanceType:             types.SpotInstanceTypeOneTime,
+			InstanceInterruptionBehavior: types.InstanceInterruptionBehaviorTerminate,
+			MaxPrice:                     aws.String("0.045"),
+		},
+	})
+}
+
+func (t *localServerSuite) TestStartInstanceOnDemand(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	result, err := testing.StartInstanceWithParams(env, "1", environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	inst := t.srv.ec2srv.Instance(string(result.Instance.Id()))
+	c.Assert(inst, gc.NotNil)
+	c.Assert(inst.MarketOptions(), gc.IsNil)
+}
+
+func (t *localServerSuite) TestSpotInstanceInterrupted(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	result, err := testing.StartInstanceWithParams(env, "1", environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("spot=true"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	id := result.Instance.Id()
+
+	insts, err := env.Instances(context.Background(), []instance.Id{id})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(insts[0].Status(context.Background()).Status, gc.Not(gc.Equals), status.Interrupted)
+
+	err = t.srv.ec2srv.SetSpotInstanceStatus(string(id), "marked-for-termination", "Spot capacity reclaimed.")
+	c.Assert(err, jc.ErrorIsNil)
+
+	insts, err = env.Instances(context.Background(), []instance.Id{id})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(insts[0].Status(context.Background()), jc.DeepEquals, instance.Status{
+		Status:  status.Interrupted,
+		Message: "Spot capacity reclaimed.",
+	})
+}
+
 func (t *localServerSuite) TestAddresses(c *gc.C) {
 	env := t.prepareAndBootstrap(c)
 	inst, _ := testing.AssertStartInstance(c, env, t.ControllerUUID, "1")
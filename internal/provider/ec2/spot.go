@@ -0,0 +1,85 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/instances"
+)
+
+// spotInterruptionCodes holds the spot request status codes which EC2
+// uses to give notice that it is reclaiming, or has reclaimed, a spot
+// instance. See
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-request-status.html
+var spotInterruptionCodes = map[string]bool{
+	"marked-for-termination":                      true,
+	"marked-for-stop":                             true,
+	"marked-for-hibernation":                      true,
+	"instance-terminated-by-price":                true,
+	"instance-terminated-no-capacity":             true,
+	"instance-terminated-capacity-oversubscribed": true,
+	"instance-terminated-launch-group-constraint": true,
+	"instance-stopped-by-price":                   true,
+	"instance-stopped-no-capacity":                true,
+	"instance-stopped-capacity-oversubscribed":    true,
+}
+
+// spotMarketOptions returns the market options requesting a one-time
+// spot instance for the given constraints. EC2 terminates the instance
+// when it reclaims the capacity; the provisioner then replaces it.
+func spotMarketOptions(cons constraints.Value) *types.InstanceMarketOptionsRequest {
+	opts := &types.SpotMarketOptions{
+		SpotInstanceType:             types.SpotInstanceTypeOneTime,
+		InstanceInterruptionBehavior: types.InstanceInterruptionBehaviorTerminate,
+	}
+	if cons.HasSpotMaxPrice() {
+		opts.MaxPrice = aws.String(strconv.FormatFloat(*cons.SpotMaxPrice, 'f', -1, 64))
+	}
+	return &types.InstanceMarketOptionsRequest{
+		MarketType:  types.MarketTypeSpot,
+		SpotOptions: opts,
+	}
+}
+
+// setSpotRequestStatus records, on each spot instance in insts, the
+// status of the spot request that launched it. The status is how EC2
+// gives notice of an interruption while the instance is still running.
+// Failing to read the requests is not fatal; the instances are left
+// without a spot status.
+func (e *environ) setSpotRequestStatus(ctx context.Context, insts []instances.Instance) {
+	byRequest := make(map[string]*sdkInstance)
+	var requestIds []string
+	for _, inst := range insts {
+		sdkInst, ok := inst.(*sdkInstance)
+		if !ok || sdkInst.i.SpotInstanceRequestId == nil {
+			continue
+		}
+		id := aws.ToString(sdkInst.i.SpotInstanceRequestId)
+		byRequest[id] = sdkInst
+		requestIds = append(requestIds, id)
+	}
+	if len(requestIds) == 0 {
+		return
+	}
+
+	resp, err := e.ec2Client.DescribeSpotInstanceRequests(ctx, &ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: requestIds,
+	})
+	if err != nil {
+		logger.Debugf(ctx, "cannot get spot instance requests %v: %v", requestIds, e.HandleCredentialError(ctx, err))
+		return
+	}
+	for _, req := range resp.SpotInstanceRequests {
+		if inst, ok := byRequest[aws.ToString(req.SpotInstanceRequestId)]; ok {
+			inst.spotStatus = req.Status
+		}
+	}
+}
@@ -83,6 +83,9 @@ type volumeSource struct {
 	modelUUID             string
 }
 
+var _ storage.VolumeResizer = (*volumeSource)(nil)
+var _ storage.VolumeSnapshotter = (*volumeSource)(nil)
+
 func (g *storageProvider) VolumeSource(cfg *storage.Config) (storage.VolumeSource, error) {
 	environConfig := g.env.Config()
 	source := &volumeSource{
@@ -181,6 +184,11 @@ func mibToGib(m uint64) uint64 {
 	return (m + 1023) / 1024
 }
 
+// gibToMib converts gibibytes to mebibytes.
+func gibToMib(g uint64) uint64 {
+	return g * 1024
+}
+
 func nameVolume(zone string) (string, error) {
 	volumeUUID, err := uuid.NewUUID()
 	if err != nil {
@@ -229,6 +237,7 @@ func (v *volumeSource) createOneVolume(ctx context.Context, p storage.VolumePara
 		Name:               volumeName,
 		PersistentDiskType: persistentType,
 		Labels:             resourceTagsToDiskLabels(p.ResourceTags),
+		SnapshotName:       p.SnapshotId,
 	}
 
 	gceDisks, err := v.gce.CreateDisks(zone, []google.DiskSpec{disk})
@@ -432,6 +441,117 @@ func (v *volumeSource) describeOneVolume(ctx context.Context, volName string) (s
 	return desc, nil
 }
 
+// ResizeVolumes is specified on the storage.VolumeResizer interface.
+func (v *volumeSource) ResizeVolumes(ctx context.Context, params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		size, err := v.resizeOneVolume(p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(
+				v.credentialInvalidator.HandleCredentialError(ctx, err), "cannot resize volume %q", p.VolumeId)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (v *volumeSource) resizeOneVolume(p storage.VolumeResizeParams) (uint64, error) {
+	zone, _, err := parseVolumeId(p.VolumeId)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	disk, err := v.gce.Disk(zone, p.VolumeId)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	sizeGb := mibToGib(p.Size)
+	if gibToMib(sizeGb) <= disk.Size {
+		return disk.Size, nil
+	}
+	// Persistent disks may be resized while they are attached to
+	// running instances.
+	if err := v.gce.ResizeDisk(zone, p.VolumeId, sizeGb); err != nil {
+		return 0, errors.Trace(err)
+	}
+	return gibToMib(sizeGb), nil
+}
+
+// CreateVolumeSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *volumeSource) CreateVolumeSnapshots(ctx context.Context, params []storage.VolumeSnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(params))
+	for i, p := range params {
+		snapshot, err := v.createOneSnapshot(p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(
+				v.credentialInvalidator.HandleCredentialError(ctx, err), "cannot snapshot volume %q", p.VolumeId)
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (v *volumeSource) createOneSnapshot(p storage.VolumeSnapshotParams) (*storage.Snapshot, error) {
+	zone, _, err := parseVolumeId(p.VolumeId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Snapshots are global resources, so unlike volumes,
+	// their names are not prefixed with the zone.
+	snapshotUUID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot generate uuid to name the snapshot")
+	}
+	name := "snap-" + snapshotUUID.String()
+	snapshot, err := v.gce.CreateSnapshot(zone, p.VolumeId, name, resourceTagsToDiskLabels(p.ResourceTags))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := gceToJujuSnapshot(snapshot)
+	return &result, nil
+}
+
+// ListVolumeSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *volumeSource) ListVolumeSnapshots(ctx context.Context, volNames []string) ([]storage.ListSnapshotsResult, error) {
+	results := make([]storage.ListSnapshotsResult, len(volNames))
+	for i, volName := range volNames {
+		snapshots, err := v.gce.Snapshots(volName)
+		if err != nil {
+			results[i].Error = errors.Annotatef(
+				v.credentialInvalidator.HandleCredentialError(ctx, err), "cannot list snapshots of volume %q", volName)
+			continue
+		}
+		results[i].Snapshots = make([]storage.Snapshot, len(snapshots))
+		for j, snapshot := range snapshots {
+			results[i].Snapshots[j] = gceToJujuSnapshot(snapshot)
+		}
+	}
+	return results, nil
+}
+
+// DeleteVolumeSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *volumeSource) DeleteVolumeSnapshots(ctx context.Context, snapshotNames []string) ([]error, error) {
+	results := make([]error, len(snapshotNames))
+	for i, name := range snapshotNames {
+		if err := v.gce.RemoveSnapshot(name); err != nil {
+			results[i] = errors.Annotatef(
+				v.credentialInvalidator.HandleCredentialError(ctx, err), "cannot remove snapshot %q", name)
+		}
+	}
+	return results, nil
+}
+
+func gceToJujuSnapshot(snapshot *google.Snapshot) storage.Snapshot {
+	return storage.Snapshot{
+		SnapshotId: snapshot.Name,
+		SourceId:   snapshot.SourceDisk,
+		Size:       snapshot.Size,
+		Created:    snapshot.Created,
+		Status:     snapshot.Status,
+	}
+}
+
 // TODO(perrito666) These rules are yet to be defined.
 func (v *volumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
 	return nil
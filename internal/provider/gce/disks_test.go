@@ -5,6 +5,7 @@ package gce_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
@@ -281,6 +282,161 @@ func (s *volumeSourceSuite) TestImportVolumeNotReady(c *gc.C) {
 	c.Check(called, jc.IsFalse)
 }
 
+func (s *volumeSourceSuite) TestResizeVolumes(c *gc.C) {
+	s.FakeConn.GoogleDisk = s.BaseDisk
+
+	c.Assert(s.source, gc.Implements, new(storage.VolumeResizer))
+	results, err := s.source.(storage.VolumeResizer).ResizeVolumes(
+		context.Background(),
+		[]storage.VolumeResizeParams{{
+			Tag:      names.NewVolumeTag("0"),
+			VolumeId: s.BaseDisk.Name,
+			Size:     4 * 1024,
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Check(results[0].Error, jc.ErrorIsNil)
+	c.Check(results[0].Size, gc.Equals, uint64(4*1024))
+
+	called, calls := s.FakeConn.WasCalled("ResizeDisk")
+	c.Check(called, jc.IsTrue)
+	c.Assert(calls, gc.HasLen, 1)
+	c.Check(calls[0].ZoneName, gc.Equals, "home-zone")
+	c.Check(calls[0].ID, gc.Equals, s.BaseDisk.Name)
+	c.Check(calls[0].SizeGb, gc.Equals, uint64(4))
+}
+
+func (s *volumeSourceSuite) TestResizeVolumesNoShrink(c *gc.C) {
+	s.FakeConn.GoogleDisk = s.BaseDisk
+
+	results, err := s.source.(storage.VolumeResizer).ResizeVolumes(
+		context.Background(),
+		[]storage.VolumeResizeParams{{
+			Tag:      names.NewVolumeTag("0"),
+			VolumeId: s.BaseDisk.Name,
+			Size:     512,
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Check(results[0].Error, jc.ErrorIsNil)
+	c.Check(results[0].Size, gc.Equals, uint64(1024))
+
+	called, _ := s.FakeConn.WasCalled("ResizeDisk")
+	c.Check(called, jc.IsFalse)
+}
+
+func (s *volumeSourceSuite) TestResizeVolumesInvalidCredentialError(c *gc.C) {
+	s.FakeConn.Err = gce.InvalidCredentialError
+	c.Assert(s.InvalidatedCredentials, jc.IsFalse)
+	results, err := s.source.(storage.VolumeResizer).ResizeVolumes(
+		context.Background(),
+		[]storage.VolumeResizeParams{{
+			Tag:      names.NewVolumeTag("0"),
+			VolumeId: s.BaseDisk.Name,
+			Size:     4 * 1024,
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Check(results[0].Error, gc.NotNil)
+	c.Assert(s.InvalidatedCredentials, jc.IsTrue)
+}
+
+func (s *volumeSourceSuite) TestCreateVolumeSnapshots(c *gc.C) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.FakeConn.Snapshot = &google.Snapshot{
+		Name:       "snap-0",
+		SourceDisk: s.BaseDisk.Name,
+		Size:       1024,
+		Status:     "CREATING",
+		Created:    created,
+	}
+
+	c.Assert(s.source, gc.Implements, new(storage.VolumeSnapshotter))
+	results, err := s.source.(storage.VolumeSnapshotter).CreateVolumeSnapshots(
+		context.Background(),
+		[]storage.VolumeSnapshotParams{{
+			Tag:          names.NewVolumeTag("0"),
+			VolumeId:     s.BaseDisk.Name,
+			ResourceTags: map[string]string{"juju-model-uuid": "foo"},
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateSnapshotsResult{{
+		Snapshot: &storage.Snapshot{
+			SnapshotId: "snap-0",
+			SourceId:   s.BaseDisk.Name,
+			Size:       1024,
+			Created:    created,
+			Status:     "CREATING",
+		},
+	}})
+
+	called, calls := s.FakeConn.WasCalled("CreateSnapshot")
+	c.Check(called, jc.IsTrue)
+	c.Assert(calls, gc.HasLen, 1)
+	c.Check(calls[0].ZoneName, gc.Equals, "home-zone")
+	c.Check(calls[0].ID, gc.Equals, s.BaseDisk.Name)
+	c.Check(calls[0].Name, jc.HasPrefix, "snap-")
+	c.Check(calls[0].Labels, jc.DeepEquals, map[string]string{"juju-model-uuid": "foo"})
+}
+
+func (s *volumeSourceSuite) TestListVolumeSnapshots(c *gc.C) {
+	s.FakeConn.Snapshots_ = []*google.Snapshot{{
+		Name:       "snap-0",
+		SourceDisk: s.BaseDisk.Name,
+		Size:       1024,
+		Status:     "READY",
+	}}
+	results, err := s.source.(storage.VolumeSnapshotter).ListVolumeSnapshots(
+		context.Background(), []string{s.BaseDisk.Name},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ListSnapshotsResult{{
+		Snapshots: []storage.Snapshot{{
+			SnapshotId: "snap-0",
+			SourceId:   s.BaseDisk.Name,
+			Size:       1024,
+			Status:     "READY",
+		}},
+	}})
+}
+
+func (s *volumeSourceSuite) TestDeleteVolumeSnapshots(c *gc.C) {
+	results, err := s.source.(storage.VolumeSnapshotter).DeleteVolumeSnapshots(
+		context.Background(), []string{"snap-0"},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []error{nil})
+
+	called, calls := s.FakeConn.WasCalled("RemoveSnapshot")
+	c.Check(called, jc.IsTrue)
+	c.Assert(calls, gc.HasLen, 1)
+	c.Check(calls[0].Name, gc.Equals, "snap-0")
+}
+
+func (s *volumeSourceSuite) TestCreateVolumesFromSnapshot(c *gc.C) {
+	s.FakeConn.Insts = []google.Instance{*s.BaseInstance}
+	s.FakeConn.GoogleDisks = []*google.Disk{s.BaseDisk}
+	s.FakeConn.GoogleDisk = s.BaseDisk
+	s.FakeConn.AttachedDisk = &google.AttachedDisk{
+		VolumeName: s.BaseDisk.Name,
+		DeviceName: "home-zone-1234567",
+		Mode:       "READ_WRITE",
+	}
+	s.params[0].SnapshotId = "snap-0"
+	res, err := s.source.CreateVolumes(context.Background(), s.params)
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(res, gc.HasLen, 1)
+	c.Assert(res[0].Error, jc.ErrorIsNil)
+
+	_, calls := s.FakeConn.WasCalled("CreateDisks")
+	c.Assert(calls, gc.HasLen, 1)
+	c.Check(calls[0].Disks[0].SnapshotName, gc.Equals, "snap-0")
+}
+
 func (s *volumeSourceSuite) TestListVolumesInvalidCredentialError(c *gc.C) {
 	s.FakeConn.Err = gce.InvalidCredentialError
 	c.Assert(s.InvalidatedCredentials, jc.IsFalse)
@@ -66,6 +66,17 @@ type gceConnection interface {
 	// SetDiskLabels sets the labels on a disk, ensuring that the disk's
 	// label fingerprint matches the one supplied.
 	SetDiskLabels(zone, id, labelFingerprint string, labels map[string]string) error
+	// ResizeDisk grows the disk identified by <name> in <zone> to
+	// <sizeGb> GiB.
+	ResizeDisk(zone, name string, sizeGb uint64) error
+	// CreateSnapshot creates a snapshot named <name> of the disk
+	// identified by <disk> in <zone>.
+	CreateSnapshot(zone, disk, name string, labels map[string]string) (*google.Snapshot, error)
+	// Snapshots returns the snapshots taken of the disk identified
+	// by <disk>.
+	Snapshots(disk string) ([]*google.Snapshot, error)
+	// RemoveSnapshot destroys the snapshot identified by <name>.
+	RemoveSnapshot(name string) error
 	// AttachDisk will attach the volume identified by <volumeName> into the instance
 	// <instanceId> and return an AttachedDisk representing it or error.
 	AttachDisk(zone, volumeName, instanceId string, mode google.DiskMode) (*google.AttachedDisk, error)
@@ -201,6 +201,7 @@ func (env *environ) newRawInstance(
 		Tags:              tags,
 		AvailabilityZone:  args.AvailabilityZone,
 		AllocatePublicIP:  allocatePublicIP,
+		Spot:              args.Constraints.HasSpot(),
 	})
 	if err != nil {
 		// We currently treat all AddInstance failures
@@ -5,6 +5,7 @@ package gce
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/juju/errors"
@@ -26,6 +27,15 @@ var instStatuses = []string{
 	google.StatusRunning,
 }
 
+// spotStatuses are the statuses, in addition to the "alive" ones, in
+// which a spot instance is still reported by Instances. A preempted
+// spot instance is stopped rather than deleted, and must remain visible
+// so that the interruption can be surfaced.
+var spotStatuses = []string{
+	google.StatusStopping,
+	google.StatusTerminated,
+}
+
 // Instances returns the available instances in the environment that
 // match the provided instance IDs. For IDs that did not match any
 // instances, the result at the corresponding index will be nil. In that
@@ -36,7 +46,8 @@ func (env *environ) Instances(ctx context.Context, ids []instance.Id) ([]instanc
 		return nil, environs.ErrNoInstances
 	}
 
-	all, err := getInstances(env, ctx)
+	statusFilters := append(append([]string{}, instStatuses...), spotStatuses...)
+	all, err := getInstances(env, ctx, statusFilters...)
 	if err != nil {
 		// We don't return the error since we need to pack one instance
 		// for each ID into the result. If there is a problem then we
@@ -51,6 +62,9 @@ func (env *environ) Instances(ctx context.Context, ids []instance.Id) ([]instanc
 	results := make([]instances.Instance, len(ids))
 	for i, id := range ids {
 		inst := findInst(id, all)
+		if inst != nil && !isAliveOrSpot(inst) {
+			inst = nil
+		}
 		if inst != nil {
 			numFound++
 		}
@@ -67,6 +81,16 @@ func (env *environ) Instances(ctx context.Context, ids []instance.Id) ([]instanc
 	return results, err
 }
 
+// isAliveOrSpot reports whether the instance is "alive", or is a spot
+// instance which may have been preempted.
+func isAliveOrSpot(inst instances.Instance) bool {
+	gceInst, ok := inst.(*environInstance)
+	if !ok || gceInst.base.Spot {
+		return true
+	}
+	return slices.Contains(instStatuses, gceInst.base.Status())
+}
+
 var getInstances = func(env *environ, ctx context.Context, statusFilters ...string) ([]instances.Instance, error) {
 	return env.instances(ctx, statusFilters...)
 }
@@ -49,6 +49,22 @@ func (s *environInstSuite) TestInstances(c *gc.C) {
 	c.Check(insts, jc.DeepEquals, []instances.Instance{spam, eggs, ham})
 }
 
+func (s *environInstSuite) TestInstancesStoppedSpot(c *gc.C) {
+	spam := s.NewBaseInstance(c, "spam")
+	spam.InstanceSummary.Status = google.StatusTerminated
+	spam.InstanceSummary.Spot = true
+	ham := s.NewBaseInstance(c, "ham")
+	ham.InstanceSummary.Status = google.StatusTerminated
+	spamInst := s.NewInstanceFromBase(spam)
+	s.FakeEnviron.Insts = []instances.Instance{spamInst, s.NewInstanceFromBase(ham)}
+
+	ids := []instance.Id{"spam", "ham"}
+	insts, err := s.Env.Instances(context.Background(), ids)
+
+	c.Check(insts, jc.DeepEquals, []instances.Instance{spamInst, nil})
+	c.Check(errors.Cause(err), gc.Equals, environs.ErrPartialInstances)
+}
+
 func (s *environInstSuite) TestInstancesEmptyArg(c *gc.C) {
 	_, err := s.Env.Instances(context.Background(), nil)
 
@@ -36,6 +36,9 @@ var unsupportedConstraints = []string{
 	constraints.Tags,
 	constraints.VirtType,
 	constraints.ImageID,
+	// GCE spot capacity has no bidding; a VM is charged the
+	// current spot price until it is preempted.
+	constraints.SpotMaxPrice,
 }
 
 // instanceTypeConstraints defines the fields defined on each of the
@@ -99,6 +99,22 @@ type service interface {
 	// label fingerprint matches the one supplied.
 	SetDiskLabels(project, zone, id, labelFingerprint string, labels map[string]string) error
 
+	// ResizeDisk grows the disk to the size, in GiB.
+	ResizeDisk(project, zone, id string, sizeGb int64) error
+
+	// CreateSnapshot creates a snapshot of the disk identified by id.
+	CreateSnapshot(project, zone, id string, snapshot *compute.Snapshot) error
+
+	// ListSnapshots returns a list of snapshots available for a given
+	// project.
+	ListSnapshots(project string) ([]*compute.Snapshot, error)
+
+	// GetSnapshot will return the snapshot with the given name.
+	GetSnapshot(project, name string) (*compute.Snapshot, error)
+
+	// RemoveSnapshot will delete the snapshot with the given name.
+	RemoveSnapshot(project, name string) error
+
 	// AttachDisk will attach the disk described in attachedDisks (if it exists) into
 	// the instance with id instanceId.
 	AttachDisk(project, zone, instanceId string, attachedDisk *compute.AttachedDisk) error
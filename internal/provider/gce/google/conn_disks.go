@@ -65,6 +65,53 @@ func (gce *Connection) SetDiskLabels(zone, name, labelFingerprint string, labels
 	return errors.Annotatef(err, "cannot update labels for disk %q in zone %q", name, zone)
 }
 
+// ResizeDisk implements storage section of gceConnection.
+func (gce *Connection) ResizeDisk(zone, name string, sizeGb uint64) error {
+	err := gce.service.ResizeDisk(gce.projectID, zone, name, int64(sizeGb))
+	return errors.Annotatef(err, "cannot resize disk %q in zone %q", name, zone)
+}
+
+// CreateSnapshot implements storage section of gceConnection.
+func (gce *Connection) CreateSnapshot(zone, disk, name string, labels map[string]string) (*Snapshot, error) {
+	err := gce.service.CreateSnapshot(gce.projectID, zone, disk, &compute.Snapshot{
+		Name:   name,
+		Labels: labels,
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot create snapshot of disk %q in zone %q", disk, zone)
+	}
+	cs, err := gce.service.GetSnapshot(gce.projectID, name)
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get snapshot %q", name)
+	}
+	return NewSnapshot(cs), nil
+}
+
+// Snapshots implements storage section of gceConnection.
+func (gce *Connection) Snapshots(disk string) ([]*Snapshot, error) {
+	computeSnapshots, err := gce.service.ListSnapshots(gce.projectID)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot list snapshots")
+	}
+	var snapshots []*Snapshot
+	for _, cs := range computeSnapshots {
+		snapshot := NewSnapshot(cs)
+		if snapshot.SourceDisk == disk {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	return snapshots, nil
+}
+
+// RemoveSnapshot implements storage section of gceConnection.
+func (gce *Connection) RemoveSnapshot(name string) error {
+	err := gce.service.RemoveSnapshot(gce.projectID, name)
+	if IsNotFound(err) {
+		return nil
+	}
+	return errors.Annotatef(err, "cannot remove snapshot %q", name)
+}
+
 // deviceName will generate a device name from the passed
 // <zone> and <diskId>, the device name must not be confused
 // with the volume name, as it is used mainly to name the
@@ -4,6 +4,9 @@
 package google_test
 
 import (
+	"time"
+
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"google.golang.org/api/compute/v1"
 	gc "gopkg.in/check.v1"
@@ -94,6 +97,83 @@ func (s *connSuite) TestConnectionSetDiskLabels(c *gc.C) {
 	c.Check(s.FakeConn.Calls[0].Labels, jc.DeepEquals, labels)
 }
 
+func (s *connSuite) TestConnectionResizeDisk(c *gc.C) {
+	err := s.Conn.ResizeDisk("home-zone", fakeVolName, 20)
+	c.Check(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "ResizeDisk")
+	c.Check(s.FakeConn.Calls[0].ProjectID, gc.Equals, "spam")
+	c.Check(s.FakeConn.Calls[0].ZoneName, gc.Equals, "home-zone")
+	c.Check(s.FakeConn.Calls[0].ID, gc.Equals, fakeVolName)
+	c.Check(s.FakeConn.Calls[0].SizeGb, gc.Equals, int64(20))
+}
+
+func (s *connSuite) TestConnectionCreateSnapshot(c *gc.C) {
+	s.FakeConn.Snapshots = []*compute.Snapshot{{
+		Name:              "snap-0",
+		SourceDisk:        "projects/spam/zones/home-zone/disks/" + fakeVolName,
+		DiskSizeGb:        10,
+		Status:            "READY",
+		CreationTimestamp: "2025-01-02T03:04:05Z",
+	}}
+	labels := map[string]string{"a": "b"}
+	snapshot, err := s.Conn.CreateSnapshot("home-zone", fakeVolName, "snap-0", labels)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(snapshot, jc.DeepEquals, &google.Snapshot{
+		Name:       "snap-0",
+		SourceDisk: fakeVolName,
+		Size:       10 * 1024,
+		Status:     "READY",
+		Created:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 2)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "CreateSnapshot")
+	c.Check(s.FakeConn.Calls[0].ZoneName, gc.Equals, "home-zone")
+	c.Check(s.FakeConn.Calls[0].ID, gc.Equals, fakeVolName)
+	c.Check(s.FakeConn.Calls[0].Snapshot, jc.DeepEquals, &compute.Snapshot{
+		Name:   "snap-0",
+		Labels: labels,
+	})
+	c.Check(s.FakeConn.Calls[1].FuncName, gc.Equals, "GetSnapshot")
+	c.Check(s.FakeConn.Calls[1].Name, gc.Equals, "snap-0")
+}
+
+func (s *connSuite) TestConnectionSnapshots(c *gc.C) {
+	s.FakeConn.Snapshots = []*compute.Snapshot{{
+		Name:       "snap-0",
+		SourceDisk: "projects/spam/zones/home-zone/disks/" + fakeVolName,
+	}, {
+		Name:       "snap-1",
+		SourceDisk: "projects/spam/zones/home-zone/disks/other",
+	}}
+	snapshots, err := s.Conn.Snapshots(fakeVolName)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(snapshots, gc.HasLen, 1)
+	c.Check(snapshots[0].Name, gc.Equals, "snap-0")
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "ListSnapshots")
+	c.Check(s.FakeConn.Calls[0].ProjectID, gc.Equals, "spam")
+}
+
+func (s *connSuite) TestConnectionRemoveSnapshot(c *gc.C) {
+	err := s.Conn.RemoveSnapshot("snap-0")
+	c.Check(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "RemoveSnapshot")
+	c.Check(s.FakeConn.Calls[0].ProjectID, gc.Equals, "spam")
+	c.Check(s.FakeConn.Calls[0].Name, gc.Equals, "snap-0")
+}
+
+func (s *connSuite) TestConnectionRemoveSnapshotNotFound(c *gc.C) {
+	s.FakeConn.Err = errors.NotFoundf("snapshot")
+	err := s.Conn.RemoveSnapshot("snap-0")
+	c.Check(err, jc.ErrorIsNil)
+}
+
 func (s *connSuite) TestConnectionAttachDisk(c *gc.C) {
 	_, fakeDisk, err := fakeDiskAndSpec()
 	c.Check(err, jc.ErrorIsNil)
@@ -5,6 +5,7 @@ package google
 
 import (
 	"path"
+	"time"
 
 	"github.com/juju/errors"
 	"google.golang.org/api/compute/v1"
@@ -88,6 +89,9 @@ type DiskSpec struct {
 	// Labels holds labels/metadata for the disk. Labels are used for
 	// storing volume resource tags.
 	Labels map[string]string
+	// SnapshotName is the name of the snapshot from which the disk
+	// should be restored, if any. (detached only)
+	SnapshotName string
 }
 
 // TooSmall checks the spec's size hint and indicates whether or not
@@ -149,13 +153,17 @@ func (ds *DiskSpec) newDetached() (*compute.Disk, error) {
 	if ds.PersistentDiskType == DiskLocalSSD {
 		return nil, errors.New("cannot create local ssd disks detached")
 	}
-	return &compute.Disk{
+	disk := &compute.Disk{
 		Name:        ds.Name,
 		SizeGb:      int64(ds.SizeGB()),
 		SourceImage: ds.ImageURL,
 		Type:        string(ds.PersistentDiskType),
 		Labels:      ds.Labels,
-	}, nil
+	}
+	if ds.SnapshotName != "" {
+		disk.SourceSnapshot = "global/snapshots/" + ds.SnapshotName
+	}
+	return disk, nil
 }
 
 // AttachedDisk represents a disk that is attached to an instance.
@@ -232,3 +240,38 @@ func NewDisk(cd *compute.Disk) *Disk {
 	}
 	return d
 }
+
+// Snapshot represents a gce disk snapshot.
+type Snapshot struct {
+	// Name is a unique identifier string for each snapshot.
+	Name string
+
+	// SourceDisk is the name of the disk from which the
+	// snapshot was taken.
+	SourceDisk string
+
+	// Size is the size of the source disk in MiB.
+	Size uint64
+
+	// Status holds the status of the snapshot.
+	Status string
+
+	// Created is the time at which the snapshot was created.
+	Created time.Time
+
+	// Labels holds labels/metadata for the snapshot.
+	Labels map[string]string
+}
+
+func NewSnapshot(cs *compute.Snapshot) *Snapshot {
+	// cs.SourceDisk is in form: project/zones/zone/disks/disk.
+	created, _ := time.Parse(time.RFC3339, cs.CreationTimestamp)
+	return &Snapshot{
+		Name:       cs.Name,
+		SourceDisk: path.Base(cs.SourceDisk),
+		Size:       gibToMib(cs.DiskSizeGb),
+		Status:     cs.Status,
+		Created:    created,
+		Labels:     cs.Labels,
+	}
+}
@@ -148,3 +148,16 @@ func (s *diskSuite) TestRootDiskSpec(c *gc.C) {
 		diskMode: "READ_WRITE",
 	})
 }
+
+func (s *diskSuite) TestDiskSpecNewDetachedFromSnapshot(c *gc.C) {
+	spec := google.DiskSpec{
+		SizeHintGB:         20,
+		Name:               "home-zone--disk",
+		PersistentDiskType: google.DiskPersistentStandard,
+		SnapshotName:       "snap-0",
+	}
+	disk, err := google.NewDetached(spec)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(disk.SourceSnapshot, gc.Equals, "global/snapshots/snap-0")
+	c.Check(disk.SizeGb, gc.Equals, int64(20))
+}
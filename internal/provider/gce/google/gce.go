@@ -21,6 +21,10 @@ const (
 	StatusUp           = "UP"
 )
 
+// ProvisioningModelSpot is the GCE provisioning model used for spot
+// instances.
+const ProvisioningModelSpot = "SPOT"
+
 var (
 	logger = internallogger.GetLogger("juju.provider.gce.gceapi")
 )
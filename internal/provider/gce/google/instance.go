@@ -56,6 +56,10 @@ type InstanceSpec struct {
 	// AllocatePublicIP is true if the instance should be assigned a public IP
 	// address, exposing it to access from outside the internal network.
 	AllocatePublicIP bool
+
+	// Spot is true if the instance should be provisioned on spot
+	// capacity, which may be preempted by GCE at any time.
+	Spot bool
 }
 
 func (is InstanceSpec) raw() *compute.Instance {
@@ -65,10 +69,27 @@ func (is InstanceSpec) raw() *compute.Instance {
 		NetworkInterfaces: is.networkInterfaces(),
 		Metadata:          packMetadata(is.Metadata),
 		Tags:              &compute.Tags{Items: is.Tags},
+		Scheduling:        is.scheduling(),
 		// MachineType is set in the addInstance call.
 	}
 }
 
+// scheduling returns the scheduling options for the instance. A
+// preempted spot instance is stopped rather than deleted, so that the
+// interruption can be observed.
+func (is InstanceSpec) scheduling() *compute.Scheduling {
+	if !is.Spot {
+		return nil
+	}
+	automaticRestart := false
+	return &compute.Scheduling{
+		ProvisioningModel:         ProvisioningModelSpot,
+		InstanceTerminationAction: "STOP",
+		AutomaticRestart:          &automaticRestart,
+		OnHostMaintenance:         "TERMINATE",
+	}
+}
+
 // Summary builds an InstanceSummary based on the spec and returns it.
 func (is InstanceSpec) Summary() InstanceSummary {
 	raw := is.raw()
@@ -115,6 +136,9 @@ type InstanceSummary struct {
 	// NetworkInterfaces are the network connections associated with
 	// the instance.
 	NetworkInterfaces []*compute.NetworkInterface
+	// Spot is true if the instance runs on spot or preemptible
+	// capacity.
+	Spot bool
 }
 
 func newInstanceSummary(raw *compute.Instance) InstanceSummary {
@@ -125,7 +149,15 @@ func newInstanceSummary(raw *compute.Instance) InstanceSummary {
 		Metadata:          unpackMetadata(raw.Metadata),
 		Addresses:         extractAddresses(raw.NetworkInterfaces...),
 		NetworkInterfaces: raw.NetworkInterfaces,
+		Spot:              isSpot(raw.Scheduling),
+	}
+}
+
+func isSpot(scheduling *compute.Scheduling) bool {
+	if scheduling == nil {
+		return false
 	}
+	return scheduling.Preemptible || scheduling.ProvisioningModel == ProvisioningModelSpot
 }
 
 // Instance represents a single realized GCE compute instance.
@@ -36,6 +36,26 @@ func (s *instanceSuite) TestNewInstanceNoSpec(c *gc.C) {
 	c.Check(spec, gc.IsNil)
 }
 
+func (s *instanceSuite) TestInstanceSpecSpot(c *gc.C) {
+	s.InstanceSpec.Spot = true
+	summary := s.InstanceSpec.Summary()
+
+	c.Check(summary.Spot, jc.IsTrue)
+}
+
+func (s *instanceSuite) TestNewInstancePreemptible(c *gc.C) {
+	s.RawInstanceFull.Scheduling = &compute.Scheduling{Preemptible: true}
+	inst := google.NewInstanceRaw(&s.RawInstanceFull, nil)
+
+	c.Check(inst.Spot, jc.IsTrue)
+}
+
+func (s *instanceSuite) TestNewInstanceOnDemand(c *gc.C) {
+	inst := google.NewInstanceRaw(&s.RawInstanceFull, nil)
+
+	c.Check(inst.Spot, jc.IsFalse)
+}
+
 func (s *instanceSuite) TestInstanceRootDiskGB(c *gc.C) {
 	size := s.Instance.RootDiskGB()
 
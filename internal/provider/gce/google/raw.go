@@ -293,6 +293,63 @@ func (rc *rawConn) SetDiskLabels(project, zone, id, labelFingerprint string, lab
 	return errors.Trace(err)
 }
 
+func (rc *rawConn) ResizeDisk(project, zone, id string, sizeGb int64) error {
+	ds := rc.Service.Disks
+	call := ds.Resize(project, zone, id, &compute.DisksResizeRequest{
+		SizeGb: sizeGb,
+	})
+	op, err := call.Do()
+	if err != nil {
+		return errors.Annotatef(err, "could not resize disk %q", id)
+	}
+	return errors.Trace(rc.waitOperation(project, op, longRetryStrategy, logOperationErrors))
+}
+
+func (rc *rawConn) CreateSnapshot(project, zone, id string, snapshot *compute.Snapshot) error {
+	ds := rc.Service.Disks
+	call := ds.CreateSnapshot(project, zone, id, snapshot)
+	op, err := call.Do()
+	if err != nil {
+		return errors.Annotatef(err, "could not create snapshot of disk %q", id)
+	}
+	return errors.Trace(rc.waitOperation(project, op, longRetryStrategy, logOperationErrors))
+}
+
+func (rc *rawConn) ListSnapshots(project string) ([]*compute.Snapshot, error) {
+	call := rc.Snapshots.List(project)
+	var results []*compute.Snapshot
+	for {
+		snapshotList, err := call.Do()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		results = append(results, snapshotList.Items...)
+		if snapshotList.NextPageToken == "" {
+			break
+		}
+		call = call.PageToken(snapshotList.NextPageToken)
+	}
+	return results, nil
+}
+
+func (rc *rawConn) GetSnapshot(project, name string) (*compute.Snapshot, error) {
+	call := rc.Snapshots.Get(project, name)
+	snapshot, err := call.Do()
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get snapshot %q in project %q", name, project)
+	}
+	return snapshot, nil
+}
+
+func (rc *rawConn) RemoveSnapshot(project, name string) error {
+	call := rc.Snapshots.Delete(project, name)
+	op, err := call.Do()
+	if err != nil {
+		return errors.Annotatef(err, "could not delete snapshot %q", name)
+	}
+	return errors.Trace(rc.waitOperation(project, op, longRetryStrategy, returnNotFoundOperationErrors))
+}
+
 func (rc *rawConn) AttachDisk(project, zone, instanceId string, disk *compute.AttachedDisk) error {
 	call := rc.Instances.AttachDisk(project, zone, instanceId, disk)
 	_, err := call.Do() // Perhaps return something from the Op
@@ -170,6 +170,8 @@ type fakeCall struct {
 	Metadata         *compute.Metadata
 	LabelFingerprint string
 	Labels           map[string]string
+	SizeGb           int64
+	Snapshot         *compute.Snapshot
 }
 
 type fakeConn struct {
@@ -184,6 +186,7 @@ type fakeConn struct {
 	FailOnCall    int
 	Disks         []*compute.Disk
 	Disk          *compute.Disk
+	Snapshots     []*compute.Snapshot
 	AttachedDisks []*compute.AttachedDisk
 	Networks      []*compute.Network
 	Subnetworks   []*compute.Subnetwork
@@ -424,6 +427,89 @@ func (rc *fakeConn) SetDiskLabels(project, zone, id, labelFingerprint string, la
 	return err
 }
 
+func (rc *fakeConn) ResizeDisk(project, zone, id string, sizeGb int64) error {
+	call := fakeCall{
+		FuncName:  "ResizeDisk",
+		ProjectID: project,
+		ZoneName:  zone,
+		ID:        id,
+		SizeGb:    sizeGb,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return err
+}
+
+func (rc *fakeConn) CreateSnapshot(project, zone, id string, snapshot *compute.Snapshot) error {
+	call := fakeCall{
+		FuncName:  "CreateSnapshot",
+		ProjectID: project,
+		ZoneName:  zone,
+		ID:        id,
+		Snapshot:  snapshot,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return err
+}
+
+func (rc *fakeConn) ListSnapshots(project string) ([]*compute.Snapshot, error) {
+	call := fakeCall{
+		FuncName:  "ListSnapshots",
+		ProjectID: project,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return rc.Snapshots, err
+}
+
+func (rc *fakeConn) GetSnapshot(project, name string) (*compute.Snapshot, error) {
+	call := fakeCall{
+		FuncName:  "GetSnapshot",
+		ProjectID: project,
+		Name:      name,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	for _, snapshot := range rc.Snapshots {
+		if snapshot.Name == name {
+			return snapshot, err
+		}
+	}
+	return nil, err
+}
+
+func (rc *fakeConn) RemoveSnapshot(project, name string) error {
+	call := fakeCall{
+		FuncName:  "RemoveSnapshot",
+		ProjectID: project,
+		Name:      name,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return err
+}
+
 func (rc *fakeConn) AttachDisk(project, zone, instanceId string, attachedDisk *compute.AttachedDisk) error {
 	call := fakeCall{
 		FuncName:     "AttachDisk",
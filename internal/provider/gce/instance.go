@@ -21,7 +21,10 @@ type environInstance struct {
 	env  *environ
 }
 
-var _ instances.Instance = (*environInstance)(nil)
+var (
+	_ instances.Instance              = (*environInstance)(nil)
+	_ instances.InterruptibleInstance = (*environInstance)(nil)
+)
 
 func newInstance(base *google.Instance, env *environ) *environInstance {
 	return &environInstance{
@@ -46,6 +49,9 @@ func (inst *environInstance) Status(ctx context.Context) instance.Status {
 		jujuStatus = status.Running
 	case "STOPPING", "TERMINATED":
 		jujuStatus = status.Empty
+		if inst.base.Spot {
+			jujuStatus = status.Interrupted
+		}
 	default:
 		jujuStatus = status.Empty
 	}
@@ -55,6 +61,11 @@ func (inst *environInstance) Status(ctx context.Context) instance.Status {
 	}
 }
 
+// Interruptible implements instances.InterruptibleInstance.
+func (inst *environInstance) Interruptible() bool {
+	return inst.base.Spot
+}
+
 // Addresses implements instances.Instance.
 func (inst *environInstance) Addresses(ctx context.Context) (corenetwork.ProviderAddresses, error) {
 	return inst.base.Addresses(), nil
@@ -10,6 +10,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/internal/provider/gce"
 	"github.com/juju/juju/internal/provider/gce/google"
 )
@@ -42,6 +43,23 @@ func (s *instanceSuite) TestStatus(c *gc.C) {
 	s.CheckNoAPI(c)
 }
 
+func (s *instanceSuite) TestStatusTerminated(c *gc.C) {
+	s.BaseInstance.InstanceSummary.Status = google.StatusTerminated
+	instStatus := s.Instance.Status(context.Background())
+
+	c.Check(instStatus.Status, gc.Equals, status.Empty)
+	c.Check(instStatus.Message, gc.Equals, google.StatusTerminated)
+}
+
+func (s *instanceSuite) TestStatusSpotInterrupted(c *gc.C) {
+	s.BaseInstance.InstanceSummary.Status = google.StatusTerminated
+	s.BaseInstance.InstanceSummary.Spot = true
+	instStatus := s.Instance.Status(context.Background())
+
+	c.Check(instStatus.Status, gc.Equals, status.Interrupted)
+	c.Check(instStatus.Message, gc.Equals, google.StatusTerminated)
+}
+
 func (s *instanceSuite) TestAddresses(c *gc.C) {
 	addresses, err := s.Instance.Addresses(context.Background())
 	c.Assert(err, jc.ErrorIsNil)
@@ -505,6 +505,8 @@ type fakeConnCall struct {
 	Value            string
 	LabelFingerprint string
 	Labels           map[string]string
+	SizeGb           uint64
+	Name             string
 }
 
 type fakeConn struct {
@@ -521,6 +523,8 @@ type fakeConn struct {
 	GoogleDisk    *google.Disk
 	AttachedDisk  *google.AttachedDisk
 	AttachedDisks []*google.AttachedDisk
+	Snapshot      *google.Snapshot
+	Snapshots_    []*google.Snapshot
 
 	Err        error
 	FailOnCall int
@@ -687,6 +691,43 @@ func (fc *fakeConn) SetDiskLabels(zone, id, labelFingerprint string, labels map[
 	return fc.err()
 }
 
+func (fc *fakeConn) ResizeDisk(zone, id string, sizeGb uint64) error {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName: "ResizeDisk",
+		ZoneName: zone,
+		ID:       id,
+		SizeGb:   sizeGb,
+	})
+	return fc.err()
+}
+
+func (fc *fakeConn) CreateSnapshot(zone, disk, name string, labels map[string]string) (*google.Snapshot, error) {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName: "CreateSnapshot",
+		ZoneName: zone,
+		ID:       disk,
+		Name:     name,
+		Labels:   labels,
+	})
+	return fc.Snapshot, fc.err()
+}
+
+func (fc *fakeConn) Snapshots(disk string) ([]*google.Snapshot, error) {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName: "Snapshots",
+		ID:       disk,
+	})
+	return fc.Snapshots_, fc.err()
+}
+
+func (fc *fakeConn) RemoveSnapshot(name string) error {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName: "RemoveSnapshot",
+		Name:     name,
+	})
+	return fc.err()
+}
+
 func (fc *fakeConn) AttachDisk(zone, volumeName, instanceId string, mode google.DiskMode) (*google.AttachedDisk, error) {
 	fc.Calls = append(fc.Calls, fakeConnCall{
 		FuncName:   "AttachDisk",
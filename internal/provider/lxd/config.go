@@ -13,15 +13,25 @@ import (
 	"github.com/juju/juju/internal/configschema"
 )
 
+const (
+	// projectKey is the config key for the LXD project used by a model.
+	projectKey = "project"
+
+	// defaultProject is the LXD project that is always present, and which
+	// is used when no other project is configured.
+	defaultProject = "default"
+)
+
 var configSchema = configschema.Fields{
-	"project": {
-		Description: "The LXD project name to use for Juju's resources.",
+	projectKey: {
+		Description: "The LXD project name to use for Juju's resources. The project must already exist and cannot be changed once the model is created.",
 		Type:        configschema.Tstring,
+		Immutable:   true,
 	},
 }
 
 var configDefaults = schema.Defaults{
-	"project": "default",
+	projectKey: defaultProject,
 }
 
 var configFields = func() schema.Fields {
@@ -77,9 +87,31 @@ func (c *environConfig) validate() error {
 }
 
 func (c *environConfig) project() string {
-	project := c.attrs["project"]
+	project := c.attrs[projectKey]
 	if project == nil {
 		return ""
 	}
 	return project.(string)
 }
+
+// validateChange checks that the LXD-specific attributes of the config
+// can be changed from those of the old config.
+// Instances, profiles and volumes live in the model's project, so moving
+// the model to another project would orphan them.
+func (c *environConfig) validateChange(old *config.Config) error {
+	oldProject, _ := old.UnknownAttrs()[projectKey].(string)
+	oldProject, newProject := projectOrDefault(oldProject), projectOrDefault(c.project())
+	if oldProject != newProject {
+		return errors.Errorf("cannot change %s from %q to %q", projectKey, oldProject, newProject)
+	}
+	return nil
+}
+
+// projectOrDefault returns the input project, or the default project if
+// none is set.
+func projectOrDefault(project string) string {
+	if project == "" {
+		return defaultProject
+	}
+	return project
+}
@@ -208,6 +208,14 @@ var changeConfigTests = []configTestSpec{{
 	info:   "can insert unknown field",
 	insert: testing.Attrs{"unknown": "ignoti"},
 	expect: testing.Attrs{"unknown": "ignoti"},
+}, {
+	info:   "can set the default project",
+	insert: testing.Attrs{"project": "default"},
+	expect: testing.Attrs{"project": "default"},
+}, {
+	info:   "cannot change project",
+	insert: testing.Attrs{"project": "my-project"},
+	err:    `cannot change project from "default" to "my-project"`,
 }}
 
 func (s *configSuite) TestValidateChange(c *gc.C) {
@@ -5,6 +5,7 @@ package lxd
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/url"
 	"runtime"
@@ -158,6 +159,9 @@ func (env *environ) SetConfig(ctx context.Context, cfg *config.Config) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if err := ecfg.validateChange(env.ecfgUnlocked.Config); err != nil {
+		return errors.Annotate(err, "invalid config change")
+	}
 	env.ecfgUnlocked = ecfg
 	return nil
 }
@@ -167,16 +171,39 @@ func (env *environ) SetCloudSpec(ctx context.Context, spec environscloudspec.Clo
 	env.lock.Lock()
 	defer env.lock.Unlock()
 
+	project := env.ecfgUnlocked.project()
 	serverFactory := env.provider.serverFactory
-	server, err := serverFactory.RemoteServer(CloudSpec{CloudSpec: spec, Project: env.ecfgUnlocked.project()})
+	server, err := serverFactory.RemoteServer(CloudSpec{CloudSpec: spec, Project: project})
 	if err != nil {
 		return errors.Trace(err)
 	}
+	if err := validateProject(server, project); err != nil {
+		return errors.Trace(err)
+	}
 
 	env.serverUnlocked = server
 	return env.initProfile(ctx)
 }
 
+// validateProject ensures that the input project exists on the server.
+// LXD does not create projects on demand, so without this check a missing
+// project would only surface as a failure of the first operation using it.
+func validateProject(server Server, project string) error {
+	if project == "" || project == defaultProject {
+		return nil
+	}
+	if !server.HasExtension("projects") {
+		return errors.NewNotSupported(nil, fmt.Sprintf("cannot use LXD project %q: server does not support projects", project))
+	}
+	if _, _, err := server.GetProject(project); err != nil {
+		if lxd.IsLXDNotFound(err) {
+			return errors.NotFoundf("LXD project %q", project)
+		}
+		return errors.Annotatef(err, "getting LXD project %q", project)
+	}
+	return nil
+}
+
 func (env *environ) server() Server {
 	env.lock.Lock()
 	defer env.lock.Unlock()
@@ -355,10 +382,11 @@ func (env *environ) DeriveAvailabilityZones(
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	if p.nodeName == "" {
-		return nil, nil
+	if p.nodeName != "" {
+		return []string{p.nodeName}, nil
 	}
-	return []string{p.nodeName}, nil
+	zones, err := env.clusterGroupTargets(ctx, p, args.Constraints)
+	return zones, errors.Trace(err)
 }
 
 // TODO: HML 2-apr-2019
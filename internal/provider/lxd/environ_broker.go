@@ -12,6 +12,8 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/constraints"
+	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs"
@@ -340,7 +342,9 @@ func (env *environ) assignContainerNICs(instStartParams environs.StartInstancePa
 
 // getTargetServer checks to see if a valid zone was passed as a placement
 // directive in the start-up start-up arguments. If so, a server for the
-// specific node is returned.
+// specific node is returned. If the placement or the tags constraint name
+// cluster groups, a server for one of the members of those groups is
+// returned.
 func (env *environ) getTargetServer(
 	ctx context.Context, args environs.StartInstanceParams,
 ) (Server, error) {
@@ -349,14 +353,35 @@ func (env *environ) getTargetServer(
 		return nil, errors.Trace(err)
 	}
 
-	if p.nodeName == "" {
+	if p.nodeName != "" {
+		return env.server().UseTargetServer(p.nodeName)
+	}
+
+	targets, err := env.clusterGroupTargets(ctx, p, args.Constraints)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(targets) == 0 {
 		return env.server(), nil
 	}
-	return env.server().UseTargetServer(p.nodeName)
+
+	// The provisioner chooses the availability zone from those derived
+	// from the cluster groups, so honour it in order to spread instances
+	// across the members of the groups.
+	target := targets[0]
+	if set.NewStrings(targets...).Contains(args.AvailabilityZone) {
+		target = args.AvailabilityZone
+	}
+	return env.server().UseTargetServer(target)
 }
 
 type lxdPlacement struct {
 	nodeName string
+
+	// groupName is the cluster group named by the placement directive,
+	// and groupMembers the names of its members.
+	groupName    string
+	groupMembers []string
 }
 
 func (env *environ) parsePlacement(ctx context.Context, placement string) (*lxdPlacement, error) {
@@ -384,11 +409,94 @@ func (env *environ) parsePlacement(ctx context.Context, placement string) (*lxdP
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	if err := zones.Validate(node); err != nil {
+	err = zones.Validate(node)
+	if err == nil {
+		return &lxdPlacement{nodeName: node}, nil
+	} else if !errors.Is(err, coreerrors.NotValid) {
 		return nil, errors.Trace(err)
 	}
 
-	return &lxdPlacement{nodeName: node}, nil
+	// The zone is not a cluster member, but it may name a cluster group.
+	members, groupErr := env.clusterGroupMembers(ctx, node)
+	if errors.Is(groupErr, errors.NotFound) {
+		return nil, errors.Trace(err)
+	} else if groupErr != nil {
+		return nil, errors.Trace(groupErr)
+	}
+	return &lxdPlacement{groupName: node, groupMembers: members}, nil
+}
+
+// clusterGroupMembers returns the names of the members of the cluster group
+// with the input name. A NotFound error is returned if the server is not
+// clustered, or has no such group.
+func (env *environ) clusterGroupMembers(ctx context.Context, name string) ([]string, error) {
+	server := env.server()
+	if !server.IsClustered() {
+		return nil, errors.NotFoundf("cluster group %q", name)
+	}
+	members, err := server.ClusterGroupMembers(name)
+	if errors.Is(err, errors.NotSupported) || errors.Is(err, errors.NotFound) {
+		return nil, errors.NotFoundf("cluster group %q", name)
+	} else if err != nil {
+		return nil, errors.Annotatef(env.HandleCredentialError(ctx, err), "getting cluster group %q", name)
+	}
+	return members, nil
+}
+
+// clusterGroupTargets returns the names of the available cluster members
+// that belong to the cluster group named by the placement, and to each of
+// the cluster groups named by the tags constraint. Members of the cluster
+// groups named by negative tags are excluded.
+// Nil is returned if neither the placement nor the constraints name a
+// cluster group.
+func (env *environ) clusterGroupTargets(
+	ctx context.Context, p *lxdPlacement, cons constraints.Value,
+) ([]string, error) {
+	var include []set.Strings
+	if p.groupName != "" {
+		include = append(include, set.NewStrings(p.groupMembers...))
+	}
+	exclude := set.NewStrings()
+	if cons.Tags != nil {
+		for _, tag := range *cons.Tags {
+			group := strings.TrimPrefix(tag, "^")
+			members, err := env.clusterGroupMembers(ctx, group)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if group != tag {
+				exclude = exclude.Union(set.NewStrings(members...))
+			} else {
+				include = append(include, set.NewStrings(members...))
+			}
+		}
+	}
+	if len(include) == 0 && exclude.IsEmpty() {
+		return nil, nil
+	}
+
+	zones, err := env.AvailabilityZones(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var targets []string
+	for _, zone := range zones {
+		name := zone.Name()
+		if !zone.Available() || exclude.Contains(name) {
+			continue
+		}
+		matches := true
+		for _, members := range include {
+			matches = matches && members.Contains(name)
+		}
+		if matches {
+			targets = append(targets, name)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, errors.NotFoundf("available cluster member in the requested cluster groups")
+	}
+	return targets, nil
 }
 
 // getHardwareCharacteristics compiles hardware-related details about
@@ -9,6 +9,7 @@ import (
 	"reflect"
 
 	"github.com/canonical/lxd/shared/api"
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
@@ -306,6 +307,8 @@ func (s *environBrokerSuite) TestStartInstanceWithPlacementNotPresent(c *gc.C) {
 		sExp.HostArch().Return(arch.AMD64),
 		sExp.IsClustered().Return(true),
 		sExp.GetClusterMembers().Return(members, nil),
+		sExp.IsClustered().Return(true),
+		sExp.ClusterGroupMembers("node03").Return(nil, errors.NotFoundf("cluster group %q", "node03")),
 	)
 
 	env := s.NewEnviron(c, svr, nil, environscloudspec.CloudSpec{}, invalidator)
@@ -317,6 +320,168 @@ func (s *environBrokerSuite) TestStartInstanceWithPlacementNotPresent(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `availability zone "node03" not valid`)
 }
 
+func (s *environBrokerSuite) TestStartInstanceWithPlacementClusterGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	svr := lxd.NewMockServer(ctrl)
+	invalidator := lxd.NewMockCredentialInvalidator(ctrl)
+
+	target, jujuTarget := s.newTargetServer(c, ctrl)
+
+	members := []api.ClusterMember{
+		{
+			ServerName: "node01",
+			Status:     "ONLINE",
+		},
+		{
+			ServerName: "node02",
+			Status:     "ONLINE",
+		},
+		{
+			ServerName: "node03",
+			Status:     "ONLINE",
+		},
+	}
+
+	sExp := svr.EXPECT()
+	gomock.InOrder(
+		sExp.HostArch().Return(arch.AMD64),
+		sExp.IsClustered().Return(true),
+		sExp.GetClusterMembers().Return(members, nil),
+		sExp.IsClustered().Return(true),
+		sExp.ClusterGroupMembers("gpu").Return([]string{"node02", "node03"}, nil),
+		sExp.IsClustered().Return(true),
+		sExp.GetClusterMembers().Return(members, nil),
+		sExp.UseTargetServer("node03").Return(jujuTarget, nil),
+		sExp.GetNICsFromProfile("default").Return(s.defaultProfile.Devices, nil),
+		sExp.HostArch().Return(arch.AMD64),
+	)
+	s.expectCreateInstance(ctrl, target)
+
+	env := s.NewEnviron(c, svr, nil, environscloudspec.CloudSpec{}, invalidator)
+
+	args := s.GetStartInstanceArgs(c)
+	args.Placement = "zone=gpu"
+	args.AvailabilityZone = "node03"
+
+	_, err := env.StartInstance(context.Background(), args)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *environBrokerSuite) TestStartInstanceWithClusterGroupConstraint(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	svr := lxd.NewMockServer(ctrl)
+	invalidator := lxd.NewMockCredentialInvalidator(ctrl)
+
+	target, jujuTarget := s.newTargetServer(c, ctrl)
+
+	members := []api.ClusterMember{
+		{
+			ServerName: "node01",
+			Status:     "ONLINE",
+		},
+		{
+			ServerName: "node02",
+			Status:     "OFFLINE",
+		},
+		{
+			ServerName: "node03",
+			Status:     "ONLINE",
+		},
+	}
+
+	sExp := svr.EXPECT()
+	gomock.InOrder(
+		sExp.HostArch().Return(arch.AMD64),
+		sExp.IsClustered().Return(true),
+		sExp.ClusterGroupMembers("gpu").Return([]string{"node01", "node02", "node03"}, nil),
+		sExp.IsClustered().Return(true),
+		sExp.ClusterGroupMembers("staging").Return([]string{"node01"}, nil),
+		sExp.IsClustered().Return(true),
+		sExp.GetClusterMembers().Return(members, nil),
+		sExp.UseTargetServer("node03").Return(jujuTarget, nil),
+		sExp.GetNICsFromProfile("default").Return(s.defaultProfile.Devices, nil),
+		sExp.HostArch().Return(arch.AMD64),
+	)
+	s.expectCreateInstance(ctrl, target)
+
+	env := s.NewEnviron(c, svr, nil, environscloudspec.CloudSpec{}, invalidator)
+
+	// The only available member in "gpu" and not in "staging" is node03.
+	args := s.GetStartInstanceArgs(c)
+	args.Constraints = constraints.MustParse("tags=gpu,^staging")
+
+	_, err := env.StartInstance(context.Background(), args)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *environBrokerSuite) TestStartInstanceWithClusterGroupConstraintNoMembers(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	svr := lxd.NewMockServer(ctrl)
+	invalidator := lxd.NewMockCredentialInvalidator(ctrl)
+
+	members := []api.ClusterMember{{
+		ServerName: "node01",
+		Status:     "OFFLINE",
+	}}
+
+	sExp := svr.EXPECT()
+	gomock.InOrder(
+		sExp.HostArch().Return(arch.AMD64),
+		sExp.IsClustered().Return(true),
+		sExp.ClusterGroupMembers("gpu").Return([]string{"node01"}, nil),
+		sExp.IsClustered().Return(true),
+		sExp.GetClusterMembers().Return(members, nil),
+	)
+
+	env := s.NewEnviron(c, svr, nil, environscloudspec.CloudSpec{}, invalidator)
+
+	args := s.GetStartInstanceArgs(c)
+	args.Constraints = constraints.MustParse("tags=gpu")
+
+	_, err := env.StartInstance(context.Background(), args)
+	c.Assert(err, gc.ErrorMatches, "available cluster member in the requested cluster groups not found")
+}
+
+// newTargetServer returns a mock LXD server, and the Juju server wrapping
+// it, for use as the cluster member targeted by StartInstance.
+func (s *environBrokerSuite) newTargetServer(
+	c *gc.C, ctrl *gomock.Controller,
+) (*lxdtesting.MockInstanceServer, *containerlxd.Server) {
+	target := lxdtesting.NewMockInstanceServer(ctrl)
+	tExp := target.EXPECT()
+	image := &api.Image{Filename: "container-image"}
+
+	tExp.GetServer().Return(&api.Server{}, lxdtesting.ETag, nil)
+	tExp.GetImageAlias("juju/ubuntu@24.04/amd64").Return(&api.ImageAliasesEntry{}, lxdtesting.ETag, nil)
+	tExp.GetImage("").Return(image, lxdtesting.ETag, nil)
+
+	jujuTarget, err := containerlxd.NewServer(target)
+	c.Assert(err, jc.ErrorIsNil)
+	return target, jujuTarget
+}
+
+// expectCreateInstance sets up the expectations for the creation and start
+// of an instance on the input target server.
+func (s *environBrokerSuite) expectCreateInstance(ctrl *gomock.Controller, target *lxdtesting.MockInstanceServer) {
+	createOp := lxdtesting.NewMockRemoteOperation(ctrl)
+	createOp.EXPECT().Wait().Return(nil)
+	createOp.EXPECT().GetTarget().Return(&api.Operation{StatusCode: api.Success}, nil)
+
+	startOp := lxdtesting.NewMockOperation(ctrl)
+	startOp.EXPECT().Wait().Return(nil)
+
+	tExp := target.EXPECT()
+	tExp.CreateInstanceFromImage(gomock.Any(), gomock.Any(), gomock.Any()).Return(createOp, nil)
+	tExp.UpdateInstanceState(gomock.Any(), gomock.Any(), "").Return(startOp, nil)
+	tExp.GetInstance(gomock.Any()).Return(&api.Instance{Type: "container"}, lxdtesting.ETag, nil)
+}
+
 func (s *environBrokerSuite) TestStartInstanceWithPlacementNotAvailable(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -17,16 +17,21 @@ import (
 // PrecheckInstance verifies that the provided series and constraints
 // are valid for use in creating an instance in this environment.
 func (env *environ) PrecheckInstance(ctx context.Context, args environs.PrecheckInstanceParams) error {
-	_, err := env.parsePlacement(ctx, args.Placement)
+	p, err := env.parsePlacement(ctx, args.Placement)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = env.clusterGroupTargets(ctx, p, args.Constraints)
 	return errors.Trace(err)
 }
 
 var unsupportedConstraints = []string{
 	constraints.CpuPower,
-	constraints.Tags,
 	constraints.Container,
 	constraints.AllocatePublicIP,
 	constraints.ImageID,
+	constraints.Spot,
+	constraints.SpotMaxPrice,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/canonical/lxd/shared/api"
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
@@ -84,6 +85,8 @@ func (s *environPolicySuite) TestPrecheckInstanceAvailZone(c *gc.C) {
 	gomock.InOrder(
 		exp.IsClustered().Return(true),
 		exp.GetClusterMembers().Return(members, nil),
+		exp.IsClustered().Return(true),
+		exp.ClusterGroupMembers("a-zone").Return(nil, errors.NotFoundf("cluster group %q", "a-zone")),
 	)
 
 	placement := "zone=a-zone"
@@ -93,6 +96,34 @@ func (s *environPolicySuite) TestPrecheckInstanceAvailZone(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, `availability zone "a-zone" not valid`)
 }
 
+func (s *environPolicySuite) TestPrecheckInstanceClusterGroupNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	exp := s.svr.EXPECT()
+	gomock.InOrder(
+		exp.IsClustered().Return(true),
+		exp.ClusterGroupMembers("gpu").Return(nil, errors.NotFoundf("cluster group %q", "gpu")),
+	)
+
+	cons := constraints.MustParse("tags=gpu")
+	err := s.env.PrecheckInstance(
+		context.Background(), environs.PrecheckInstanceParams{Base: version.DefaultSupportedLTSBase(), Constraints: cons})
+
+	c.Check(err, gc.ErrorMatches, `cluster group "gpu" not found`)
+}
+
+func (s *environPolicySuite) TestPrecheckInstanceClusterGroupNotClustered(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.svr.EXPECT().IsClustered().Return(false)
+
+	cons := constraints.MustParse("tags=gpu")
+	err := s.env.PrecheckInstance(
+		context.Background(), environs.PrecheckInstanceParams{Base: version.DefaultSupportedLTSBase(), Constraints: cons})
+
+	c.Check(err, gc.ErrorMatches, `cluster group "gpu" not found`)
+}
+
 func (s *environPolicySuite) TestConstraintsValidatorArch(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -188,7 +219,6 @@ func (s *environPolicySuite) TestConstraintsValidatorUnsupported(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	expected := []string{
-		"tags",
 		"cpu-power",
 	}
 	c.Check(unsupported, jc.SameContents, expected)
@@ -243,7 +273,7 @@ func (s *environPolicySuite) TestConstraintsValidatorConflicts(c *gc.C) {
 	merged, err := validator.Merge(consFallback, cons)
 	c.Assert(err, jc.ErrorIsNil)
 
-	// tags is not supported, but we're not validating here...
+	// cpu-power is not supported, but we're not validating here...
 	expected := constraints.MustParse("instance-type=n1-standard-1 tags=bar cores=2 cpu-power=1000 mem=10000")
 	c.Check(merged, jc.DeepEquals, expected)
 }
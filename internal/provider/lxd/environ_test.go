@@ -5,6 +5,7 @@ package lxd_test
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/canonical/lxd/shared/api"
 	"github.com/juju/errors"
@@ -421,6 +422,8 @@ func (s *environCloudProfileSuite) TestSetCloudSpecCreateProfileErrorSucceeds(c
 
 func (s *environCloudProfileSuite) TestSetCloudSpecUsesConfiguredProject(c *gc.C) {
 	defer s.setup(c, map[string]interface{}{"project": "my-project"}).Finish()
+	s.svr.EXPECT().HasExtension("projects").Return(true)
+	s.svr.EXPECT().GetProject("my-project").Return(&api.Project{Name: "my-project"}, "", nil)
 	s.expectHasProfileFalse("juju-controller")
 	s.expectCreateProfile("juju-controller", nil)
 
@@ -428,6 +431,24 @@ func (s *environCloudProfileSuite) TestSetCloudSpecUsesConfiguredProject(c *gc.C
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *environCloudProfileSuite) TestSetCloudSpecProjectNotFound(c *gc.C) {
+	defer s.setup(c, map[string]interface{}{"project": "my-project"}).Finish()
+	s.svr.EXPECT().HasExtension("projects").Return(true)
+	s.svr.EXPECT().GetProject("my-project").Return(nil, "", api.StatusErrorf(http.StatusNotFound, "Project not found"))
+
+	err := s.cloudSpecEnv.SetCloudSpec(context.Background(), lxdCloudSpec())
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+	c.Check(err, gc.ErrorMatches, `LXD project "my-project" not found`)
+}
+
+func (s *environCloudProfileSuite) TestSetCloudSpecProjectsNotSupported(c *gc.C) {
+	defer s.setup(c, map[string]interface{}{"project": "my-project"}).Finish()
+	s.svr.EXPECT().HasExtension("projects").Return(false)
+
+	err := s.cloudSpecEnv.SetCloudSpec(context.Background(), lxdCloudSpec())
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
 func (s *environCloudProfileSuite) setup(c *gc.C, cfgEdit map[string]interface{}) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.svr = lxd.NewMockServer(ctrl)
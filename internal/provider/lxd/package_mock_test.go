@@ -85,6 +85,45 @@ func (c *MockServerAliveContainersCall) DoAndReturn(f func(string) ([]lxd0.Conta
 	return c
 }
 
+// ClusterGroupMembers mocks base method.
+func (m *MockServer) ClusterGroupMembers(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterGroupMembers", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterGroupMembers indicates an expected call of ClusterGroupMembers.
+func (mr *MockServerMockRecorder) ClusterGroupMembers(arg0 any) *MockServerClusterGroupMembersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterGroupMembers", reflect.TypeOf((*MockServer)(nil).ClusterGroupMembers), arg0)
+	return &MockServerClusterGroupMembersCall{Call: call}
+}
+
+// MockServerClusterGroupMembersCall wrap *gomock.Call
+type MockServerClusterGroupMembersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerClusterGroupMembersCall) Return(arg0 []string, arg1 error) *MockServerClusterGroupMembersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerClusterGroupMembersCall) Do(f func(string) ([]string, error)) *MockServerClusterGroupMembersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerClusterGroupMembersCall) DoAndReturn(f func(string) ([]string, error)) *MockServerClusterGroupMembersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ContainerAddresses mocks base method.
 func (m *MockServer) ContainerAddresses(arg0 string) ([]network.ProviderAddress, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +430,82 @@ func (c *MockServerCreateVolumeCall) DoAndReturn(f func(string, string, map[stri
 	return c
 }
 
+// CreateVolumeFromSnapshot mocks base method.
+func (m *MockServer) CreateVolumeFromSnapshot(arg0, arg1, arg2 string, arg3 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeFromSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVolumeFromSnapshot indicates an expected call of CreateVolumeFromSnapshot.
+func (mr *MockServerMockRecorder) CreateVolumeFromSnapshot(arg0, arg1, arg2, arg3 any) *MockServerCreateVolumeFromSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeFromSnapshot", reflect.TypeOf((*MockServer)(nil).CreateVolumeFromSnapshot), arg0, arg1, arg2, arg3)
+	return &MockServerCreateVolumeFromSnapshotCall{Call: call}
+}
+
+// MockServerCreateVolumeFromSnapshotCall wrap *gomock.Call
+type MockServerCreateVolumeFromSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerCreateVolumeFromSnapshotCall) Return(arg0 error) *MockServerCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerCreateVolumeFromSnapshotCall) Do(f func(string, string, string, map[string]string) error) *MockServerCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerCreateVolumeFromSnapshotCall) DoAndReturn(f func(string, string, string, map[string]string) error) *MockServerCreateVolumeFromSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateVolumeSnapshot mocks base method.
+func (m *MockServer) CreateVolumeSnapshot(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVolumeSnapshot indicates an expected call of CreateVolumeSnapshot.
+func (mr *MockServerMockRecorder) CreateVolumeSnapshot(arg0, arg1, arg2 any) *MockServerCreateVolumeSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeSnapshot", reflect.TypeOf((*MockServer)(nil).CreateVolumeSnapshot), arg0, arg1, arg2)
+	return &MockServerCreateVolumeSnapshotCall{Call: call}
+}
+
+// MockServerCreateVolumeSnapshotCall wrap *gomock.Call
+type MockServerCreateVolumeSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerCreateVolumeSnapshotCall) Return(arg0 error) *MockServerCreateVolumeSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerCreateVolumeSnapshotCall) Do(f func(string, string, string) error) *MockServerCreateVolumeSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerCreateVolumeSnapshotCall) DoAndReturn(f func(string, string, string) error) *MockServerCreateVolumeSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteCertificate mocks base method.
 func (m *MockServer) DeleteCertificate(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -505,6 +620,44 @@ func (c *MockServerDeleteStoragePoolVolumeCall) DoAndReturn(f func(string, strin
 	return c
 }
 
+// DeleteVolumeSnapshot mocks base method.
+func (m *MockServer) DeleteVolumeSnapshot(arg0, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolumeSnapshot indicates an expected call of DeleteVolumeSnapshot.
+func (mr *MockServerMockRecorder) DeleteVolumeSnapshot(arg0, arg1, arg2 any) *MockServerDeleteVolumeSnapshotCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeSnapshot", reflect.TypeOf((*MockServer)(nil).DeleteVolumeSnapshot), arg0, arg1, arg2)
+	return &MockServerDeleteVolumeSnapshotCall{Call: call}
+}
+
+// MockServerDeleteVolumeSnapshotCall wrap *gomock.Call
+type MockServerDeleteVolumeSnapshotCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerDeleteVolumeSnapshotCall) Return(arg0 error) *MockServerDeleteVolumeSnapshotCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerDeleteVolumeSnapshotCall) Do(f func(string, string, string) error) *MockServerDeleteVolumeSnapshotCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerDeleteVolumeSnapshotCall) DoAndReturn(f func(string, string, string) error) *MockServerDeleteVolumeSnapshotCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // EnableHTTPSListener mocks base method.
 func (m *MockServer) EnableHTTPSListener() error {
 	m.ctrl.T.Helper()
@@ -1014,6 +1167,46 @@ func (c *MockServerGetProfileCall) DoAndReturn(f func(string) (*api.Profile, str
 	return c
 }
 
+// GetProject mocks base method.
+func (m *MockServer) GetProject(arg0 string) (*api.Project, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", arg0)
+	ret0, _ := ret[0].(*api.Project)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockServerMockRecorder) GetProject(arg0 any) *MockServerGetProjectCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockServer)(nil).GetProject), arg0)
+	return &MockServerGetProjectCall{Call: call}
+}
+
+// MockServerGetProjectCall wrap *gomock.Call
+type MockServerGetProjectCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerGetProjectCall) Return(arg0 *api.Project, arg1 string, arg2 error) *MockServerGetProjectCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerGetProjectCall) Do(f func(string) (*api.Project, string, error)) *MockServerGetProjectCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerGetProjectCall) DoAndReturn(f func(string) (*api.Project, string, error)) *MockServerGetProjectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetServer mocks base method.
 func (m *MockServer) GetServer() (*api.Server, string, error) {
 	m.ctrl.T.Helper()
@@ -1134,6 +1327,45 @@ func (c *MockServerGetStoragePoolVolumeCall) DoAndReturn(f func(string, string,
 	return c
 }
 
+// GetStoragePoolVolumeSnapshots mocks base method.
+func (m *MockServer) GetStoragePoolVolumeSnapshots(arg0, arg1, arg2 string) ([]api.StorageVolumeSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoragePoolVolumeSnapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]api.StorageVolumeSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoragePoolVolumeSnapshots indicates an expected call of GetStoragePoolVolumeSnapshots.
+func (mr *MockServerMockRecorder) GetStoragePoolVolumeSnapshots(arg0, arg1, arg2 any) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolVolumeSnapshots", reflect.TypeOf((*MockServer)(nil).GetStoragePoolVolumeSnapshots), arg0, arg1, arg2)
+	return &MockServerGetStoragePoolVolumeSnapshotsCall{Call: call}
+}
+
+// MockServerGetStoragePoolVolumeSnapshotsCall wrap *gomock.Call
+type MockServerGetStoragePoolVolumeSnapshotsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerGetStoragePoolVolumeSnapshotsCall) Return(arg0 []api.StorageVolumeSnapshot, arg1 error) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerGetStoragePoolVolumeSnapshotsCall) Do(f func(string, string, string) ([]api.StorageVolumeSnapshot, error)) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerGetStoragePoolVolumeSnapshotsCall) DoAndReturn(f func(string, string, string) ([]api.StorageVolumeSnapshot, error)) *MockServerGetStoragePoolVolumeSnapshotsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetStoragePoolVolumes mocks base method.
 func (m *MockServer) GetStoragePoolVolumes(arg0 string) ([]api.StorageVolume, error) {
 	m.ctrl.T.Helper()
@@ -1895,6 +2127,44 @@ func (c *MockServerUseProjectCall) DoAndReturn(f func(string)) *MockServerUsePro
 	return c
 }
 
+// UseProjectServer mocks base method.
+func (m *MockServer) UseProjectServer(arg0 string) *lxd0.Server {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseProjectServer", arg0)
+	ret0, _ := ret[0].(*lxd0.Server)
+	return ret0
+}
+
+// UseProjectServer indicates an expected call of UseProjectServer.
+func (mr *MockServerMockRecorder) UseProjectServer(arg0 any) *MockServerUseProjectServerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseProjectServer", reflect.TypeOf((*MockServer)(nil).UseProjectServer), arg0)
+	return &MockServerUseProjectServerCall{Call: call}
+}
+
+// MockServerUseProjectServerCall wrap *gomock.Call
+type MockServerUseProjectServerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockServerUseProjectServerCall) Return(arg0 *lxd0.Server) *MockServerUseProjectServerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockServerUseProjectServerCall) Do(f func(string) *lxd0.Server) *MockServerUseProjectServerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockServerUseProjectServerCall) DoAndReturn(f func(string) *lxd0.Server) *MockServerUseProjectServerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UseTargetServer mocks base method.
 func (m *MockServer) UseTargetServer(arg0 string) (*lxd0.Server, error) {
 	m.ctrl.T.Helper()
@@ -202,9 +202,15 @@ func (p *environProvider) ValidateCloud(ctx context.Context, spec environsclouds
 
 // Validate implements environs.EnvironProvider.
 func (*environProvider) Validate(ctx context.Context, cfg, old *config.Config) (valid *config.Config, err error) {
-	if _, err := newValidConfig(ctx, cfg); err != nil {
+	ecfg, err := newValidConfig(ctx, cfg)
+	if err != nil {
 		return nil, errors.Annotate(err, "invalid base config")
 	}
+	if old != nil {
+		if err := ecfg.validateChange(old); err != nil {
+			return nil, errors.Annotate(err, "invalid config change")
+		}
+	}
 	return cfg, nil
 }
 
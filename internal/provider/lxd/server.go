@@ -72,6 +72,10 @@ type Server interface {
 	CreateVolume(pool, name string, config map[string]string) error
 	UpdateStoragePoolVolume(pool string, volType string, name string, volume lxdapi.StorageVolumePut, ETag string) error
 	DeleteStoragePoolVolume(pool string, volType string, name string) (err error)
+	CreateVolumeFromSnapshot(pool, name, snapshot string, config map[string]string) error
+	CreateVolumeSnapshot(pool, volume, name string) error
+	GetStoragePoolVolumeSnapshots(pool string, volType string, volumeName string) ([]lxdapi.StorageVolumeSnapshot, error)
+	DeleteVolumeSnapshot(pool, volume, name string) error
 	ServerCertificate() string
 	HostArch() string
 	SupportedArches() []string
@@ -80,6 +84,8 @@ type Server interface {
 	IsClustered() bool
 	UseTargetServer(name string) (*lxd.Server, error)
 	GetClusterMembers() (members []lxdapi.ClusterMember, err error)
+	ClusterGroupMembers(name string) ([]string, error)
+	GetProject(name string) (*lxdapi.Project, string, error)
 	Name() string
 	HasExtension(extension string) (exists bool)
 	GetNetworks() ([]lxdapi.Network, error)
@@ -90,6 +96,10 @@ type Server interface {
 	// UseProject ensures that this server will use the input project.
 	// See: https://documentation.ubuntu.com/lxd/en/latest/projects.
 	UseProject(string)
+
+	// UseProjectServer returns a copy of this server that uses the input
+	// project, leaving this server untouched.
+	UseProjectServer(string) *lxd.Server
 }
 
 // CloudSpec describes the cloud configuration for use with the LXD provider.
@@ -210,7 +220,7 @@ func (s *serverFactory) LocalServerAddress() (string, error) {
 
 func (s *serverFactory) RemoteServer(spec CloudSpec) (Server, error) {
 	if spec.Endpoint == "" {
-		return s.LocalServer()
+		return s.localProjectServer(spec.Project)
 	}
 
 	cred := spec.Credential
@@ -241,7 +251,7 @@ func (s *serverFactory) RemoteServer(spec CloudSpec) (Server, error) {
 
 func (s *serverFactory) InsecureRemoteServer(spec CloudSpec) (Server, error) {
 	if spec.Endpoint == "" {
-		return s.LocalServer()
+		return s.localProjectServer(spec.Project)
 	}
 
 	cred := spec.Credential
@@ -260,11 +270,29 @@ func (s *serverFactory) InsecureRemoteServer(spec CloudSpec) (Server, error) {
 		WithHTTPClient(s.newHTTPClientFunc())
 
 	svr, err := s.newRemoteServerFunc(serverSpec)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	if spec.Project != "" {
 		svr.UseProject(spec.Project)
 	}
 
-	return svr, errors.Trace(err)
+	return svr, nil
+}
+
+// localProjectServer returns the local server, scoped to the input project.
+// The local server is shared by every model using it, so rather than
+// switching its project, a copy using the project is returned.
+func (s *serverFactory) localProjectServer(project string) (Server, error) {
+	svr, err := s.LocalServer()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if project == "" || project == defaultProject {
+		return svr, nil
+	}
+	return svr.UseProjectServer(project), nil
 }
 
 func (s *serverFactory) initLocalServer() (Server, error) {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/juju/juju/cloud"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	containerlxd "github.com/juju/juju/internal/container/lxd"
 	"github.com/juju/juju/internal/provider/lxd"
 )
 
@@ -353,6 +354,49 @@ func (s *serverIntegrationSuite) TestRemoteServerWithEmptyEndpointYieldsLocalSer
 	c.Assert(err, gc.IsNil)
 }
 
+func (s *serverIntegrationSuite) TestRemoteServerWithEmptyEndpointUsesProject(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	profile := &api.Profile{}
+	etag := "etag"
+	bridgeName := "lxdbr0"
+	hostAddress := "192.168.0.1"
+	connectionInfo := &client.ConnectionInfo{
+		Addresses: []string{
+			"https://192.168.0.1:8443",
+		},
+	}
+
+	factory, server, interfaceAddr := lxd.NewLocalServerFactory(ctrl)
+
+	projectServer := &containerlxd.Server{}
+
+	gomock.InOrder(
+		server.EXPECT().GetProfile("default").Return(profile, etag, nil),
+		server.EXPECT().VerifyNetworkDevice(profile, etag).Return(nil),
+		server.EXPECT().EnableHTTPSListener().Return(nil),
+		server.EXPECT().LocalBridgeName().Return(bridgeName),
+		interfaceAddr.EXPECT().InterfaceAddress(bridgeName).Return(hostAddress, nil),
+		server.EXPECT().GetConnectionInfo().Return(connectionInfo, nil),
+		server.EXPECT().StorageSupported().Return(true),
+		server.EXPECT().GetProfile("default").Return(profile, etag, nil),
+		server.EXPECT().EnsureDefaultStorage(profile, etag).Return(nil),
+		server.EXPECT().ServerVersion().Return("5.2"),
+		server.EXPECT().UseProjectServer("my-project").Return(projectServer),
+	)
+
+	// The shared local server is not switched to the project; a copy
+	// using the project is returned instead.
+	svr, err := factory.RemoteServer(lxd.CloudSpec{Project: "my-project"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svr, gc.Equals, projectServer)
+
+	svr, err = factory.LocalServer()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svr, gc.Equals, server)
+}
+
 func (s *serverIntegrationSuite) TestRemoteServer(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
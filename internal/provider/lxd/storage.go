@@ -20,6 +20,7 @@ import (
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/container/lxd"
 	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/uuid"
 )
 
 const (
@@ -234,6 +235,9 @@ type lxdFilesystemSource struct {
 	env *environ
 }
 
+var _ storage.FilesystemResizer = (*lxdFilesystemSource)(nil)
+var _ storage.FilesystemSnapshotter = (*lxdFilesystemSource)(nil)
+
 // CreateFilesystems is specified on the storage.FilesystemSource interface.
 func (s *lxdFilesystemSource) CreateFilesystems(ctx context.Context, args []storage.FilesystemParams) (_ []storage.CreateFilesystemsResult, err error) {
 	results := make([]storage.CreateFilesystemsResult, len(args))
@@ -283,7 +287,21 @@ func (s *lxdFilesystemSource) createFilesystem(
 		config["size"] = fmt.Sprintf("%dMiB", arg.Size)
 	}
 
-	if err := s.env.server().CreateVolume(cfg.lxdPool, volumeName, config); err != nil {
+	if arg.SnapshotId != "" {
+		snapshotPool, snapshot, err := parseFilesystemId(arg.SnapshotId)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if snapshotPool != cfg.lxdPool {
+			return nil, errors.NotSupportedf(
+				"restoring snapshot %q from LXD storage pool %q into pool %q",
+				arg.SnapshotId, snapshotPool, cfg.lxdPool,
+			)
+		}
+		if err := s.env.server().CreateVolumeFromSnapshot(cfg.lxdPool, volumeName, snapshot, config); err != nil {
+			return nil, errors.Annotate(err, "creating volume from snapshot")
+		}
+	} else if err := s.env.server().CreateVolume(cfg.lxdPool, volumeName, config); err != nil {
 		return nil, errors.Annotate(err, "creating volume")
 	}
 
@@ -414,6 +432,184 @@ func (s *lxdFilesystemSource) releaseFilesystem(filesystemId string) error {
 	return nil
 }
 
+// ResizeFilesystems is specified on the storage.FilesystemResizer interface.
+func (s *lxdFilesystemSource) ResizeFilesystems(ctx context.Context, args []storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		size, err := s.resizeFilesystem(arg)
+		if err != nil {
+			results[i].Error = s.env.HandleCredentialError(ctx, err)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (s *lxdFilesystemSource) resizeFilesystem(arg storage.FilesystemResizeParams) (uint64, error) {
+	poolName, volumeName, err := parseFilesystemId(arg.FilesystemId)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	server := s.env.server()
+	volume, eTag, err := server.GetStoragePoolVolume(poolName, storagePoolVolumeType, volumeName)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	// Volumes created with the "dir" driver have no size, and so
+	// cannot be resized.
+	sizeString := volume.Config["size"]
+	if sizeString == "" {
+		return 0, errors.NotSupportedf("resizing volume %q in pool %q without a size", volumeName, poolName)
+	}
+	n, err := units.ParseByteSizeString(sizeString)
+	if err != nil {
+		return 0, errors.Annotate(err, "parsing size")
+	}
+	// ParseByteSizeString returns bytes, we want MiB.
+	size := uint64(n / (1024 * 1024))
+	if arg.Size <= size {
+		return size, nil
+	}
+
+	volume.Config["size"] = fmt.Sprintf("%dMiB", arg.Size)
+	if err := server.UpdateStoragePoolVolume(
+		poolName, storagePoolVolumeType, volumeName, volume.Writable(), eTag,
+	); err != nil {
+		return 0, errors.Annotatef(err, "resizing volume %q in pool %q", volumeName, poolName)
+	}
+	return arg.Size, nil
+}
+
+// CreateFilesystemSnapshots is specified on the storage.FilesystemSnapshotter interface.
+func (s *lxdFilesystemSource) CreateFilesystemSnapshots(ctx context.Context, args []storage.FilesystemSnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := s.createFilesystemSnapshot(arg)
+		if err != nil {
+			results[i].Error = s.env.HandleCredentialError(ctx, err)
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (s *lxdFilesystemSource) createFilesystemSnapshot(arg storage.FilesystemSnapshotParams) (*storage.Snapshot, error) {
+	poolName, volumeName, err := parseFilesystemId(arg.FilesystemId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotUUID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, errors.Annotate(err, "generating snapshot name")
+	}
+	snapshotName := "snap-" + snapshotUUID.String()
+	server := s.env.server()
+	if err := server.CreateVolumeSnapshot(poolName, volumeName, snapshotName); err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Fetch the snapshot back, to report its size and creation time.
+	snapshots, err := server.GetStoragePoolVolumeSnapshots(poolName, storagePoolVolumeType, volumeName)
+	if err != nil {
+		return nil, errors.Annotatef(err, "listing snapshots of volume %q in pool %q", volumeName, poolName)
+	}
+	for _, snapshot := range snapshots {
+		if snapshotBaseName(snapshot.Name) == snapshotName {
+			result := lxdToJujuSnapshot(arg.FilesystemId, snapshot)
+			return &result, nil
+		}
+	}
+	return nil, errors.NotFoundf("snapshot %q of volume %q in pool %q", snapshotName, volumeName, poolName)
+}
+
+// ListFilesystemSnapshots is specified on the storage.FilesystemSnapshotter interface.
+func (s *lxdFilesystemSource) ListFilesystemSnapshots(ctx context.Context, filesystemIds []string) ([]storage.ListSnapshotsResult, error) {
+	results := make([]storage.ListSnapshotsResult, len(filesystemIds))
+	for i, filesystemId := range filesystemIds {
+		snapshots, err := s.listFilesystemSnapshots(filesystemId)
+		if err != nil {
+			results[i].Error = s.env.HandleCredentialError(ctx, err)
+			continue
+		}
+		results[i].Snapshots = snapshots
+	}
+	return results, nil
+}
+
+func (s *lxdFilesystemSource) listFilesystemSnapshots(filesystemId string) ([]storage.Snapshot, error) {
+	poolName, volumeName, err := parseFilesystemId(filesystemId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshots, err := s.env.server().GetStoragePoolVolumeSnapshots(poolName, storagePoolVolumeType, volumeName)
+	if err != nil {
+		return nil, errors.Annotatef(err, "listing snapshots of volume %q in pool %q", volumeName, poolName)
+	}
+	results := make([]storage.Snapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		results[i] = lxdToJujuSnapshot(filesystemId, snapshot)
+	}
+	return results, nil
+}
+
+// DeleteFilesystemSnapshots is specified on the storage.FilesystemSnapshotter interface.
+func (s *lxdFilesystemSource) DeleteFilesystemSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		err := s.deleteFilesystemSnapshot(snapshotId)
+		if err == nil {
+			continue
+		}
+		results[i] = s.env.HandleCredentialError(ctx, err)
+	}
+	return results, nil
+}
+
+func (s *lxdFilesystemSource) deleteFilesystemSnapshot(snapshotId string) error {
+	poolName, snapshot, err := parseFilesystemId(snapshotId)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	volumeName, snapshotName, ok := strings.Cut(snapshot, "/")
+	if !ok {
+		return errors.Errorf(
+			"invalid snapshot ID %q; expected ID in format <lxd-pool>:<volume-name>/<snapshot-name>", snapshotId,
+		)
+	}
+	err = s.env.server().DeleteVolumeSnapshot(poolName, volumeName, snapshotName)
+	if err != nil && !lxd.IsLXDNotFound(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// lxdToJujuSnapshot returns the snapshot, with an ID of the form
+// <lxd-pool>:<volume-name>/<snapshot-name>. The part after the pool
+// is how LXD refers to the snapshot when copying it to a new volume.
+func lxdToJujuSnapshot(filesystemId string, snapshot api.StorageVolumeSnapshot) storage.Snapshot {
+	var size uint64
+	if n, err := units.ParseByteSizeString(snapshot.Config["size"]); err == nil {
+		size = uint64(n / (1024 * 1024))
+	}
+	return storage.Snapshot{
+		SnapshotId: filesystemId + "/" + snapshotBaseName(snapshot.Name),
+		SourceId:   filesystemId,
+		Size:       size,
+		Created:    snapshot.CreatedAt,
+	}
+}
+
+// snapshotBaseName returns the name of the snapshot without the volume
+// name, which some LXD versions include.
+func snapshotBaseName(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
 // ValidateFilesystemParams is specified on the storage.FilesystemSource interface.
 func (s *lxdFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
 	// TODO(axw) sanity check params
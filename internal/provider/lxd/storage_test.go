@@ -5,6 +5,7 @@ package lxd_test
 
 import (
 	"context"
+	"strings"
 
 	"github.com/canonical/lxd/shared/api"
 	"github.com/juju/errors"
@@ -132,6 +133,41 @@ func (s *storageSuite) TestCreateFilesystems(c *gc.C) {
 	})
 }
 
+func (s *storageSuite) TestCreateFilesystemsFromSnapshot(c *gc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	source := s.filesystemSource(c, "source")
+	results, err := source.CreateFilesystems(context.Background(), []storage.FilesystemParams{{
+		Tag:        names.NewFilesystemTag("0"),
+		Provider:   "lxd",
+		Size:       1024,
+		SnapshotId: "radiance:other/snap0",
+		Attributes: map[string]interface{}{
+			"lxd-pool": "radiance",
+			"driver":   "btrfs",
+		},
+	}, {
+		Tag:        names.NewFilesystemTag("1"),
+		Provider:   "lxd",
+		Size:       1024,
+		SnapshotId: "elsewhere:other/snap0",
+		Attributes: map[string]interface{}{
+			"lxd-pool": "radiance",
+			"driver":   "btrfs",
+		},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 2)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].Filesystem.FilesystemId, gc.Equals, "radiance:juju-f75cba-filesystem-0")
+	c.Assert(results[1].Error, gc.ErrorMatches, `restoring snapshot "elsewhere:other/snap0" from LXD storage pool "elsewhere" into pool "radiance" not supported`)
+
+	s.Stub.CheckCallNames(c, "CreatePool", "CreateVolumeFromSnapshot", "CreatePool")
+	s.Stub.CheckCall(c, 1, "CreateVolumeFromSnapshot", "radiance", "juju-f75cba-filesystem-0", "other/snap0", map[string]string{
+		"size": "1024MiB",
+	})
+}
+
 func (s *storageSuite) TestCreateFilesystemsPoolExists(c *gc.C) {
 	defer s.SetupMocks(c).Finish()
 
@@ -649,6 +685,155 @@ func (s *storageSuite) TestImportFilesystemInvalidCredentialsUpdatePool(c *gc.C)
 	c.Assert(info, jc.DeepEquals, storage.FilesystemInfo{})
 }
 
+func (s *storageSuite) TestFilesystemSnapshots(c *gc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	source := s.filesystemSource(c, "pool")
+	c.Assert(source, gc.Implements, new(storage.FilesystemSnapshotter))
+	snapshotter := source.(storage.FilesystemSnapshotter)
+
+	created, err := snapshotter.CreateFilesystemSnapshots(context.Background(), []storage.FilesystemSnapshotParams{{
+		Tag:          names.NewFilesystemTag("0"),
+		FilesystemId: "foo:bar",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(created, gc.HasLen, 1)
+	c.Assert(created[0].Error, jc.ErrorIsNil)
+	c.Check(created[0].Snapshot.SnapshotId, gc.Matches, "foo:bar/snap-.*")
+	c.Check(created[0].Snapshot.SourceId, gc.Equals, "foo:bar")
+
+	listed, err := snapshotter.ListFilesystemSnapshots(context.Background(), []string{"foo:bar", "foo:baz"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(listed, jc.DeepEquals, []storage.ListSnapshotsResult{{
+		Snapshots: []storage.Snapshot{*created[0].Snapshot},
+	}, {
+		Snapshots: []storage.Snapshot{},
+	}})
+
+	snapshotName := strings.TrimPrefix(created[0].Snapshot.SnapshotId, "foo:bar/")
+	deleted, err := snapshotter.DeleteFilesystemSnapshots(context.Background(), []string{
+		created[0].Snapshot.SnapshotId, "foo:bar",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(deleted, gc.HasLen, 2)
+	c.Check(deleted[0], jc.ErrorIsNil)
+	c.Check(deleted[1], gc.ErrorMatches, `invalid snapshot ID "foo:bar"; .*`)
+
+	s.Stub.CheckCalls(c, []testing.StubCall{
+		{FuncName: "CreateVolumeSnapshot", Args: []interface{}{"foo", "bar", snapshotName}},
+		{FuncName: "GetStoragePoolVolumeSnapshots", Args: []interface{}{"foo", "custom", "bar"}},
+		{FuncName: "GetStoragePoolVolumeSnapshots", Args: []interface{}{"foo", "custom", "bar"}},
+		{FuncName: "GetStoragePoolVolumeSnapshots", Args: []interface{}{"foo", "custom", "baz"}},
+		{FuncName: "DeleteVolumeSnapshot", Args: []interface{}{"foo", "bar", snapshotName}},
+	})
+}
+
+func (s *storageSuite) TestResizeFilesystems(c *gc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	source := s.filesystemSource(c, "pool")
+	c.Assert(source, gc.Implements, new(storage.FilesystemResizer))
+	resizer := source.(storage.FilesystemResizer)
+
+	s.Client.Volumes = map[string][]api.StorageVolume{
+		"foo": {{
+			Name: "bar",
+			Config: map[string]string{
+				"size": "10GiB",
+			},
+		}},
+	}
+
+	results, err := resizer.ResizeFilesystems(context.Background(), []storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0"),
+		FilesystemId: "foo:bar",
+		Size:         20 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeFilesystemsResult{{
+		Size: 20 * 1024,
+	}})
+
+	update := api.StorageVolumePut{
+		Config: map[string]string{
+			"size": "20480MiB",
+		},
+	}
+	s.Stub.CheckCalls(c, []testing.StubCall{
+		{FuncName: "GetStoragePoolVolume", Args: []interface{}{"foo", "custom", "bar"}},
+		{FuncName: "UpdateStoragePoolVolume", Args: []interface{}{"foo", "custom", "bar", update, "eTag"}},
+	})
+}
+
+func (s *storageSuite) TestResizeFilesystemsNoShrink(c *gc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	source := s.filesystemSource(c, "pool")
+	resizer := source.(storage.FilesystemResizer)
+
+	s.Client.Volumes = map[string][]api.StorageVolume{
+		"foo": {{
+			Name: "bar",
+			Config: map[string]string{
+				"size": "10GiB",
+			},
+		}},
+	}
+
+	results, err := resizer.ResizeFilesystems(context.Background(), []storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0"),
+		FilesystemId: "foo:bar",
+		Size:         5 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeFilesystemsResult{{
+		Size: 10 * 1024,
+	}})
+	s.Stub.CheckCallNames(c, "GetStoragePoolVolume")
+}
+
+func (s *storageSuite) TestResizeFilesystemsNoSize(c *gc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	source := s.filesystemSource(c, "pool")
+	resizer := source.(storage.FilesystemResizer)
+
+	s.Client.Volumes = map[string][]api.StorageVolume{
+		"foo": {{
+			Name:   "bar",
+			Config: map[string]string{},
+		}},
+	}
+
+	results, err := resizer.ResizeFilesystems(context.Background(), []storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0"),
+		FilesystemId: "foo:bar",
+		Size:         5 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageSuite) TestResizeFilesystemsInvalidCredentials(c *gc.C) {
+	defer s.SetupMocks(c).Finish()
+
+	s.Invalidator.EXPECT().InvalidateCredentials(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.Client.Stub.SetErrors(errTestUnAuth)
+	source := s.filesystemSource(c, "pool")
+	resizer := source.(storage.FilesystemResizer)
+
+	results, err := resizer.ResizeFilesystems(context.Background(), []storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0"),
+		FilesystemId: "foo:bar",
+		Size:         5 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, ".*not authorized")
+}
+
 func (s *storageSuite) SetupMocks(c *gc.C) *gomock.Controller {
 	ctrl := s.BaseSuite.SetupMocks(c)
 
@@ -406,6 +406,7 @@ type StubClient struct {
 	Profile            *api.Profile
 	StorageIsSupported bool
 	Volumes            map[string][]api.StorageVolume
+	VolumeSnapshots    map[string][]api.StorageVolumeSnapshot
 	ServerCert         string
 	ServerHostArch     string
 	ServerVer          string
@@ -613,6 +614,39 @@ func (conn *StubClient) UpdateStoragePoolVolume(
 	return conn.NextErr()
 }
 
+func (conn *StubClient) CreateVolumeFromSnapshot(pool, volume, snapshot string, config map[string]string) error {
+	conn.AddCall("CreateVolumeFromSnapshot", pool, volume, snapshot, config)
+	return conn.NextErr()
+}
+
+func (conn *StubClient) CreateVolumeSnapshot(pool, volume, name string) error {
+	conn.AddCall("CreateVolumeSnapshot", pool, volume, name)
+	if err := conn.NextErr(); err != nil {
+		return err
+	}
+	if conn.VolumeSnapshots == nil {
+		conn.VolumeSnapshots = make(map[string][]api.StorageVolumeSnapshot)
+	}
+	key := pool + ":" + volume
+	conn.VolumeSnapshots[key] = append(conn.VolumeSnapshots[key], api.StorageVolumeSnapshot{Name: name})
+	return nil
+}
+
+func (conn *StubClient) GetStoragePoolVolumeSnapshots(
+	pool string, volType string, volume string,
+) ([]api.StorageVolumeSnapshot, error) {
+	conn.AddCall("GetStoragePoolVolumeSnapshots", pool, volType, volume)
+	if err := conn.NextErr(); err != nil {
+		return nil, err
+	}
+	return conn.VolumeSnapshots[pool+":"+volume], nil
+}
+
+func (conn *StubClient) DeleteVolumeSnapshot(pool, volume, name string) error {
+	conn.AddCall("DeleteVolumeSnapshot", pool, volume, name)
+	return conn.NextErr()
+}
+
 func (conn *StubClient) AliveContainers(prefix string) ([]lxd.Container, error) {
 	conn.AddCall("AliveContainers", prefix)
 	if err := conn.NextErr(); err != nil {
@@ -696,6 +730,18 @@ func (conn *StubClient) UseProject(string) {
 	panic("this stub is deprecated; use mocks instead")
 }
 
+func (conn *StubClient) UseProjectServer(string) *lxd.Server {
+	panic("this stub is deprecated; use mocks instead")
+}
+
+func (conn *StubClient) GetProject(string) (*api.Project, string, error) {
+	panic("this stub is deprecated; use mocks instead")
+}
+
+func (conn *StubClient) ClusterGroupMembers(string) ([]string, error) {
+	panic("this stub is deprecated; use mocks instead")
+}
+
 func (*StubClient) HasExtension(_ string) bool {
 	panic("this stub is deprecated; use mocks instead")
 }
@@ -20,6 +20,8 @@ var unsupportedConstraints = []string{
 	constraints.InstanceType,
 	constraints.VirtType,
 	constraints.AllocatePublicIP,
+	constraints.Spot,
+	constraints.SpotMaxPrice,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -332,6 +332,8 @@ var unsupportedConstraints = []string{
 	constraints.VirtType,
 	constraints.AllocatePublicIP,
 	constraints.ImageID,
+	constraints.Spot,
+	constraints.SpotMaxPrice,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -314,6 +314,8 @@ var unsupportedConstraints = []string{
 	constraints.VirtType,
 	constraints.Tags,
 	constraints.ImageID,
+	constraints.Spot,
+	constraints.SpotMaxPrice,
 }
 
 // ConstraintsValidator implements environs.Environ.
@@ -4,10 +4,15 @@
 package openstack
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math"
+	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
@@ -122,16 +127,18 @@ var newOpenstackStorage = func(env *Environ) (OpenstackStorage, error) {
 
 	// TODO (stickupkid): Move this to the ClientFactory.
 	// We shouldn't have another wrapper around an existing client.
-	cinderCl := cinderClient{cinder.Basic(env.volumeURL, client.TenantId(), client.Token)}
-
+	handleRequest := cinder.SetAuthHeaderFn(client.Token, http.DefaultClient.Do)
 	cloudSpec := env.cloudUnlocked
 	if len(cloudSpec.CACertificates) > 0 {
-		cinderCl = cinderClient{cinder.BasicTLSConfig(
-			env.volumeURL,
-			client.TenantId(),
+		handleRequest = cinder.AuthHeaderTSLConfigDoRequestFn(
 			client.Token,
-			tlsConfig(cloudSpec.CACertificates)),
-		}
+			tlsConfig(cloudSpec.CACertificates),
+		)
+	}
+	cinderCl := cinderClient{
+		Client:        cinder.NewClient(client.TenantId(), env.volumeURL, handleRequest),
+		endpoint:      env.volumeURL,
+		handleRequest: handleRequest,
 	}
 
 	return &openstackStorageAdaptor{
@@ -228,6 +235,8 @@ type cinderVolumeSource struct {
 }
 
 var _ storage.VolumeSource = (*cinderVolumeSource)(nil)
+var _ storage.VolumeResizer = (*cinderVolumeSource)(nil)
+var _ storage.VolumeSnapshotter = (*cinderVolumeSource)(nil)
 
 // CreateVolumes implements storage.VolumeSource.
 func (s *cinderVolumeSource) CreateVolumes(
@@ -273,6 +282,7 @@ func (s *cinderVolumeSource) createVolume(
 		VolumeType:       cinderConfig.volumeType,
 		AvailabilityZone: az,
 		Metadata:         metadata,
+		SnapshotId:       arg.SnapshotId,
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -608,6 +618,102 @@ func (s *cinderVolumeSource) ImportVolume(ctx context.Context, volumeId string,
 	return cinderToJujuVolumeInfo(volume), nil
 }
 
+// ResizeVolumes is part of the storage.VolumeResizer interface.
+func (s *cinderVolumeSource) ResizeVolumes(ctx context.Context, args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		size, err := s.resizeVolume(arg)
+		if err != nil {
+			err = s.credentialInvalidator.HandleCredentialError(ctx, err)
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.VolumeId)
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (s *cinderVolumeSource) resizeVolume(arg storage.VolumeResizeParams) (uint64, error) {
+	volume, err := s.storageAdaptor.GetVolume(arg.VolumeId)
+	if err != nil {
+		return 0, errors.Annotate(err, "getting volume")
+	}
+	// The Cinder API takes the size in GiB.
+	newSize := int(math.Ceil(float64(arg.Size) / 1024))
+	if newSize <= volume.Size {
+		return uint64(volume.Size * 1024), nil
+	}
+	if err := s.storageAdaptor.ExtendVolume(arg.VolumeId, newSize); err != nil {
+		return 0, errors.Trace(err)
+	}
+	volume, err = waitVolume(s.storageAdaptor, arg.VolumeId, func(v *cinder.Volume) (bool, error) {
+		switch v.Status {
+		case "error_extending":
+			return false, errors.New("volume could not be extended")
+		case "extending":
+			return false, nil
+		}
+		return v.Size >= newSize, nil
+	})
+	if err != nil {
+		return 0, errors.Annotate(err, "waiting for volume to be extended")
+	}
+	return uint64(volume.Size * 1024), nil
+}
+
+// CreateVolumeSnapshots is part of the storage.VolumeSnapshotter interface.
+func (s *cinderVolumeSource) CreateVolumeSnapshots(ctx context.Context, args []storage.VolumeSnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := s.storageAdaptor.CreateSnapshot(cinder.CreateSnapshotSnapshotParams{
+			VolumeId: arg.VolumeId,
+			Name:     resourceName(s.namespace, s.envName, arg.Tag.String()),
+			// Volumes are snapshotted while they are attached and in use,
+			// which Cinder refuses unless forced.
+			Force: true,
+		})
+		if err != nil {
+			err = s.credentialInvalidator.HandleCredentialError(ctx, err)
+			results[i].Error = errors.Annotatef(err, "creating snapshot of volume %s", arg.VolumeId)
+			continue
+		}
+		info := cinderToJujuSnapshot(snapshot)
+		results[i].Snapshot = &info
+	}
+	return results, nil
+}
+
+// ListVolumeSnapshots is part of the storage.VolumeSnapshotter interface.
+func (s *cinderVolumeSource) ListVolumeSnapshots(ctx context.Context, volumeIds []string) ([]storage.ListSnapshotsResult, error) {
+	snapshots, err := s.storageAdaptor.GetSnapshotsDetail()
+	if err != nil {
+		err = s.credentialInvalidator.HandleCredentialError(ctx, err)
+		return nil, errors.Annotate(err, "listing snapshots")
+	}
+	byVolume := make(map[string][]storage.Snapshot)
+	for _, snapshot := range snapshots {
+		byVolume[snapshot.VolumeID] = append(byVolume[snapshot.VolumeID], cinderToJujuSnapshot(&snapshot))
+	}
+	results := make([]storage.ListSnapshotsResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		results[i].Snapshots = byVolume[volumeId]
+	}
+	return results, nil
+}
+
+// DeleteVolumeSnapshots is part of the storage.VolumeSnapshotter interface.
+func (s *cinderVolumeSource) DeleteVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		err := s.storageAdaptor.DeleteSnapshot(snapshotId)
+		if err != nil && !errors.Is(err, errors.NotFound) {
+			err = s.credentialInvalidator.HandleCredentialError(ctx, err)
+			results[i] = errors.Annotatef(err, "deleting snapshot %s", snapshotId)
+		}
+	}
+	return results, nil
+}
+
 func waitVolume(
 	storageAdaptor OpenstackStorage,
 	volumeId string,
@@ -669,6 +775,30 @@ func cinderToJujuVolumeInfo(volume *cinder.Volume) storage.VolumeInfo {
 	}
 }
 
+// cinderSnapshotTimeLayouts are the layouts of the snapshot creation
+// times reported by the various Cinder API versions.
+var cinderSnapshotTimeLayouts = []string{
+	"2006-01-02T15:04:05.000000",
+	time.RFC3339,
+}
+
+func cinderToJujuSnapshot(snapshot *cinder.Snapshot) storage.Snapshot {
+	var created time.Time
+	for _, layout := range cinderSnapshotTimeLayouts {
+		if t, err := time.Parse(layout, snapshot.CreatedAt); err == nil {
+			created = t
+			break
+		}
+	}
+	return storage.Snapshot{
+		SnapshotId: snapshot.ID,
+		SourceId:   snapshot.VolumeID,
+		Size:       uint64(snapshot.Size * 1024),
+		Created:    created,
+		Status:     snapshot.Status,
+	}
+}
+
 func detachVolume(instanceId, volumeId string, storageAdaptor OpenstackStorage) error {
 	err := storageAdaptor.DetachVolume(instanceId, volumeId)
 	if err != nil && !isNotFoundError(err) {
@@ -697,7 +827,11 @@ type OpenstackStorage interface {
 	DetachVolume(serverId, attachmentId string) error
 	ListVolumeAttachments(serverId string) ([]nova.VolumeAttachment, error)
 	SetVolumeMetadata(volumeId string, metadata map[string]string) (map[string]string, error)
+	ExtendVolume(volumeId string, newSize int) error
 	ListVolumeAvailabilityZones() ([]cinder.AvailabilityZone, error)
+	CreateSnapshot(cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error)
+	GetSnapshotsDetail() ([]cinder.Snapshot, error)
+	DeleteSnapshot(snapshotId string) error
 }
 
 type endpointResolver interface {
@@ -741,6 +875,55 @@ type openstackStorageAdaptor struct {
 
 type cinderClient struct {
 	*cinder.Client
+
+	// endpoint and handleRequest are used for the volume actions
+	// which are not supported by the Cinder client.
+	endpoint      *url.URL
+	handleRequest cinder.RequestHandlerFn
+}
+
+// ExtendVolume grows the volume to the new size, in GiB, with the
+// "os-extend" volume action.
+func (c cinderClient) ExtendVolume(volumeId string, newSize int) error {
+	body, err := json.Marshal(map[string]any{
+		"os-extend": map[string]int{"new_size": newSize},
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	endpoint := *c.endpoint
+	if !strings.HasSuffix(endpoint.Path, "/") {
+		endpoint.Path += "/"
+	}
+	actionURL := endpoint.ResolveReference(&url.URL{
+		Path: fmt.Sprintf("volumes/%s/action", volumeId),
+	})
+	req, err := http.NewRequest("POST", actionURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// Volumes which are in use may only be extended from microversion
+	// 3.42. Older endpoints ignore the header, and reject the action
+	// for volumes which are in use.
+	req.Header.Set("OpenStack-API-Version", "volume 3.42")
+	resp, err := c.handleRequest(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	// The os-extend action is accepted asynchronously.
+	if resp.StatusCode == http.StatusAccepted {
+		return nil
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		return gooseerrors.NewUnauthorisedf(nil, volumeId, "extending volume: %s", respBody)
+	case http.StatusNotFound:
+		return gooseerrors.NewNotFoundf(nil, volumeId, "extending volume: %s", respBody)
+	}
+	return errors.Errorf("invalid status (%d): %s", resp.StatusCode, respBody)
 }
 
 type novaClient struct {
@@ -782,6 +965,11 @@ func (ga *openstackStorageAdaptor) SetVolumeMetadata(volumeId string, metadata m
 	return ga.cinderClient.SetVolumeMetadata(volumeId, metadata)
 }
 
+// ExtendVolume is part of the OpenstackStorage interface.
+func (ga *openstackStorageAdaptor) ExtendVolume(volumeId string, newSize int) error {
+	return ga.cinderClient.ExtendVolume(volumeId, newSize)
+}
+
 // DeleteVolume is part of the OpenstackStorage interface.
 func (ga *openstackStorageAdaptor) DeleteVolume(volumeId string) error {
 	if err := ga.cinderClient.DeleteVolume(volumeId); err != nil {
@@ -793,6 +981,35 @@ func (ga *openstackStorageAdaptor) DeleteVolume(volumeId string) error {
 	return nil
 }
 
+// CreateSnapshot is part of the OpenstackStorage interface.
+func (ga *openstackStorageAdaptor) CreateSnapshot(args cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error) {
+	resp, err := ga.cinderClient.CreateSnapshot(args)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Snapshot, nil
+}
+
+// GetSnapshotsDetail is part of the OpenstackStorage interface.
+func (ga *openstackStorageAdaptor) GetSnapshotsDetail() ([]cinder.Snapshot, error) {
+	resp, err := ga.cinderClient.GetSnapshotsDetail()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Snapshots, nil
+}
+
+// DeleteSnapshot is part of the OpenstackStorage interface.
+func (ga *openstackStorageAdaptor) DeleteSnapshot(snapshotId string) error {
+	if err := ga.cinderClient.DeleteSnapshot(snapshotId); err != nil {
+		if isNotFoundError(err) {
+			return errors.NotFoundf("snapshot %q", snapshotId)
+		}
+		return err
+	}
+	return nil
+}
+
 // DetachVolume is part of the OpenstackStorage interface.
 func (ga *openstackStorageAdaptor) DetachVolume(serverId, attachmentId string) error {
 	if err := ga.novaClient.DetachVolume(serverId, attachmentId); err != nil {
@@ -6,6 +6,7 @@ package openstack_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-goose/goose/v5/cinder"
 	gooseerrors "github.com/go-goose/goose/v5/errors"
@@ -833,6 +834,193 @@ func (s *cinderVolumeSourceSuite) TestImportVolumeInvalidCredential(c *gc.C) {
 	c.Assert(s.invalidCredential, jc.IsTrue)
 }
 
+func (s *cinderVolumeSourceSuite) TestResizeVolumes(c *gc.C) {
+	size := 1
+	mockAdaptor := &mockAdaptor{
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			return &cinder.Volume{
+				ID:     volumeId,
+				Size:   size,
+				Status: "in-use",
+			}, nil
+		},
+		extendVolume: func(volumeId string, newSize int) error {
+			size = newSize
+			return nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdaptor, s.env, s.invalidator)
+	c.Assert(volSource, gc.Implements, new(storage.VolumeResizer))
+
+	results, err := volSource.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      mockVolumeTag,
+		VolumeId: mockVolId,
+		Size:     2*1024 + 1,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{Size: 3 * 1024}})
+	mockAdaptor.CheckCalls(c, []jujutesting.StubCall{
+		{"GetVolume", []interface{}{mockVolId}},
+		{"ExtendVolume", []interface{}{mockVolId, 3}},
+		{"GetVolume", []interface{}{mockVolId}},
+	})
+}
+
+func (s *cinderVolumeSourceSuite) TestResizeVolumesAlreadyResized(c *gc.C) {
+	mockAdaptor := &mockAdaptor{
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			return &cinder.Volume{
+				ID:     volumeId,
+				Size:   5,
+				Status: "in-use",
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdaptor, s.env, s.invalidator)
+	results, err := volSource.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      mockVolumeTag,
+		VolumeId: mockVolId,
+		Size:     4 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{Size: 5 * 1024}})
+	mockAdaptor.CheckCalls(c, []jujutesting.StubCall{
+		{"GetVolume", []interface{}{mockVolId}},
+	})
+}
+
+func (s *cinderVolumeSourceSuite) TestResizeVolumesErrorExtending(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mockAdaptor := &mockAdaptor{
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			return &cinder.Volume{
+				ID:     volumeId,
+				Size:   1,
+				Status: "error_extending",
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdaptor, s.env, s.invalidator)
+	results, err := volSource.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      mockVolumeTag,
+		VolumeId: mockVolId,
+		Size:     2 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "resizing volume 0: waiting for volume to be extended: volume could not be extended")
+}
+
+func (s *cinderVolumeSourceSuite) TestResizeVolumesInvalidCredential(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Assert(s.invalidCredential, jc.IsFalse)
+	mockAdaptor := &mockAdaptor{
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			return &cinder.Volume{}, testUnauthorisedGooseError
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdaptor, s.env, s.invalidator)
+	results, err := volSource.(storage.VolumeResizer).ResizeVolumes(context.Background(), []storage.VolumeResizeParams{{
+		Tag:      mockVolumeTag,
+		VolumeId: mockVolId,
+		Size:     2 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, gc.ErrorMatches, "resizing volume 0: getting volume: invalid auth")
+	c.Assert(s.invalidCredential, jc.IsTrue)
+}
+
+func (s *cinderVolumeSourceSuite) TestCreateVolumeSnapshots(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mockAdaptor := &mockAdaptor{
+		createSnapshot: func(args cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error) {
+			return &cinder.Snapshot{
+				ID:        "snap-0",
+				VolumeID:  args.VolumeId,
+				Size:      2,
+				Status:    "creating",
+				CreatedAt: "2025-01-02T03:04:05.000000",
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdaptor, s.env, s.invalidator)
+	c.Assert(volSource, gc.Implements, new(storage.VolumeSnapshotter))
+
+	results, err := volSource.(storage.VolumeSnapshotter).CreateVolumeSnapshots(context.Background(), []storage.VolumeSnapshotParams{{
+		Tag:      mockVolumeTag,
+		VolumeId: mockVolId,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateSnapshotsResult{{
+		Snapshot: &storage.Snapshot{
+			SnapshotId: "snap-0",
+			SourceId:   mockVolId,
+			Size:       2 * 1024,
+			Created:    time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			Status:     "creating",
+		},
+	}})
+	mockAdaptor.CheckCalls(c, []jujutesting.StubCall{
+		{"CreateSnapshot", []interface{}{cinder.CreateSnapshotSnapshotParams{
+			VolumeId: mockVolId,
+			Name:     "juju-testmodel-volume-123",
+			Force:    true,
+		}}},
+	})
+}
+
+func (s *cinderVolumeSourceSuite) TestListVolumeSnapshots(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mockAdaptor := &mockAdaptor{
+		getSnapshotsDetail: func() ([]cinder.Snapshot, error) {
+			return []cinder.Snapshot{
+				{ID: "snap-0", VolumeID: mockVolId, Size: 1, Status: "available"},
+				{ID: "snap-1", VolumeID: "other", Size: 1, Status: "available"},
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdaptor, s.env, s.invalidator)
+	results, err := volSource.(storage.VolumeSnapshotter).ListVolumeSnapshots(context.Background(), []string{mockVolId, "missing"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ListSnapshotsResult{{
+		Snapshots: []storage.Snapshot{{
+			SnapshotId: "snap-0",
+			SourceId:   mockVolId,
+			Size:       1024,
+			Status:     "available",
+		}},
+	}, {}})
+}
+
+func (s *cinderVolumeSourceSuite) TestDeleteVolumeSnapshots(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mockAdaptor := &mockAdaptor{
+		deleteSnapshot: func(snapshotId string) error {
+			switch snapshotId {
+			case "snap-missing":
+				return errors.NotFoundf("snapshot %q", snapshotId)
+			case "snap-busy":
+				return errors.New("snapshot is busy")
+			}
+			return nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdaptor, s.env, s.invalidator)
+	results, err := volSource.(storage.VolumeSnapshotter).DeleteVolumeSnapshots(context.Background(), []string{
+		"snap-0", "snap-missing", "snap-busy",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 3)
+	c.Check(results[0], jc.ErrorIsNil)
+	c.Check(results[1], jc.ErrorIsNil)
+	c.Check(results[2], gc.ErrorMatches, "deleting snapshot snap-busy: snapshot is busy")
+}
+
 type mockAdaptor struct {
 	jujutesting.Stub
 	getVolume             func(string) (*cinder.Volume, error)
@@ -843,7 +1031,11 @@ type mockAdaptor struct {
 	detachVolume          func(string, string) error
 	listVolumeAttachments func(string) ([]nova.VolumeAttachment, error)
 	setVolumeMetadata     func(string, map[string]string) (map[string]string, error)
+	extendVolume          func(string, int) error
 	listAvailabilityZones func() ([]cinder.AvailabilityZone, error)
+	createSnapshot        func(cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error)
+	getSnapshotsDetail    func() ([]cinder.Snapshot, error)
+	deleteSnapshot        func(string) error
 }
 
 func (ma *mockAdaptor) GetVolume(volumeId string) (*cinder.Volume, error) {
@@ -913,6 +1105,14 @@ func (ma *mockAdaptor) SetVolumeMetadata(volumeId string, metadata map[string]st
 	return nil, nil
 }
 
+func (ma *mockAdaptor) ExtendVolume(volumeId string, newSize int) error {
+	ma.MethodCall(ma, "ExtendVolume", volumeId, newSize)
+	if ma.extendVolume != nil {
+		return ma.extendVolume(volumeId, newSize)
+	}
+	return nil
+}
+
 func (ma *mockAdaptor) ListVolumeAvailabilityZones() ([]cinder.AvailabilityZone, error) {
 	ma.MethodCall(ma, "ListAvailabilityZones")
 	if ma.listAvailabilityZones != nil {
@@ -921,6 +1121,30 @@ func (ma *mockAdaptor) ListVolumeAvailabilityZones() ([]cinder.AvailabilityZone,
 	return nil, gooseerrors.NewNotImplementedf(nil, nil, "ListAvailabilityZones")
 }
 
+func (ma *mockAdaptor) CreateSnapshot(args cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error) {
+	ma.MethodCall(ma, "CreateSnapshot", args)
+	if ma.createSnapshot != nil {
+		return ma.createSnapshot(args)
+	}
+	return nil, errors.NotImplementedf("CreateSnapshot")
+}
+
+func (ma *mockAdaptor) GetSnapshotsDetail() ([]cinder.Snapshot, error) {
+	ma.MethodCall(ma, "GetSnapshotsDetail")
+	if ma.getSnapshotsDetail != nil {
+		return ma.getSnapshotsDetail()
+	}
+	return nil, nil
+}
+
+func (ma *mockAdaptor) DeleteSnapshot(snapshotId string) error {
+	ma.MethodCall(ma, "DeleteSnapshot", snapshotId)
+	if ma.deleteSnapshot != nil {
+		return ma.deleteSnapshot(snapshotId)
+	}
+	return nil
+}
+
 type testEndpointResolver struct {
 	authenticated   bool
 	regionEndpoints map[string]identity.ServiceURLs
@@ -81,6 +81,8 @@ var unsupportedConstraints = []string{
 	constraints.VirtType,
 	constraints.AllocatePublicIP,
 	constraints.ImageID,
+	constraints.Spot,
+	constraints.SpotMaxPrice,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
@@ -64,6 +64,7 @@ type ProvisionerTask interface {
 type MachinesAPI interface {
 	Machines(context.Context, ...names.MachineTag) ([]apiprovisioner.MachineResult, error)
 	MachinesWithTransientErrors(context.Context) ([]apiprovisioner.MachineStatusResult, error)
+	MachinesWithInterruptedInstances(context.Context) ([]apiprovisioner.MachineStatusResult, error)
 	WatchMachineErrorRetry(context.Context) (watcher.NotifyWatcher, error)
 	WatchModelMachines(context.Context) (watcher.StringsWatcher, error)
 	ProvisioningInfo(_ context.Context, machineTags []names.MachineTag) (params.ProvisioningInfoResults, error)
@@ -131,6 +132,7 @@ type TaskConfig struct {
 	ToolsFinder                  ToolsFinder
 	MachineWatcher               watcher.StringsWatcher
 	RetryWatcher                 watcher.NotifyWatcher
+	InterruptionWatcher          watcher.NotifyWatcher
 	Broker                       environs.InstanceBroker
 	ImageStream                  string
 	RetryStartInstanceStrategy   RetryStrategy
@@ -146,6 +148,11 @@ func NewProvisionerTask(cfg TaskConfig) (ProvisionerTask, error) {
 		retryChanges = cfg.RetryWatcher.Changes()
 		workers = append(workers, cfg.RetryWatcher)
 	}
+	var interruptionChanges watcher.NotifyChannel
+	if cfg.InterruptionWatcher != nil {
+		interruptionChanges = cfg.InterruptionWatcher.Changes()
+		workers = append(workers, cfg.InterruptionWatcher)
+	}
 	task := &provisionerTask{
 		controllerUUID:               cfg.ControllerUUID,
 		hostTag:                      cfg.HostTag,
@@ -157,6 +164,7 @@ func NewProvisionerTask(cfg TaskConfig) (ProvisionerTask, error) {
 		toolsFinder:                  cfg.ToolsFinder,
 		machineChanges:               machineChanges,
 		retryChanges:                 retryChanges,
+		interruptionChanges:          interruptionChanges,
 		broker:                       cfg.Broker,
 		harvestMode:                  cfg.HarvestMode,
 		harvestModeChan:              make(chan config.HarvestMode, 1),
@@ -187,6 +195,7 @@ func NewProvisionerTask(cfg TaskConfig) (ProvisionerTask, error) {
 const (
 	eventTypeProcessedMachines         = "processed-machines"
 	eventTypeRetriedMachinesWithErrors = "retried-machines-with-errors"
+	eventTypeReplacedInterruptedInsts  = "replaced-interrupted-instances"
 	eventTypeResizedWorkerPool         = "resized-worker-pool"
 	eventTypeHarvestModeChanged        = "harvest-mode-changed"
 )
@@ -202,6 +211,7 @@ type provisionerTask struct {
 	toolsFinder                  ToolsFinder
 	machineChanges               watcher.StringsChannel
 	retryChanges                 watcher.NotifyChannel
+	interruptionChanges          watcher.NotifyChannel
 	broker                       environs.InstanceBroker
 	catacomb                     catacomb.Catacomb
 	imageStream                  string
@@ -307,6 +317,11 @@ func (task *provisionerTask) loop() (taskErr error) {
 				return errors.Annotate(err, "processing machines with transient errors")
 			}
 			task.notifyEventProcessedCallback(eventTypeRetriedMachinesWithErrors)
+		case <-task.interruptionChanges:
+			if err := task.processMachinesWithInterruptedInstances(ctx); err != nil {
+				return errors.Annotate(err, "processing machines with interrupted instances")
+			}
+			task.notifyEventProcessedCallback(eventTypeReplacedInterruptedInsts)
 		case <-task.wp.Done():
 			// The worker pool has detected one or more errors and
 			// is in the process of shutting down. Collect and
@@ -374,6 +389,63 @@ func (task *provisionerTask) processMachinesWithTransientErrors(ctx context.Cont
 	return task.queueStartMachines(ctx, pending)
 }
 
+// processMachinesWithInterruptedInstances replaces the spot or preemptible
+// instances which the cloud has reclaimed, or is about to reclaim. The old
+// instance is stopped and forgotten, and the machine is provisioned again.
+func (task *provisionerTask) processMachinesWithInterruptedInstances(ctx context.Context) error {
+	results, err := task.machinesAPI.MachinesWithInterruptedInstances(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	} else if len(results) == 0 {
+		return nil
+	}
+	task.logger.Tracef(ctx, "processMachinesWithInterruptedInstances(%v)", results)
+	var pending []apiprovisioner.MachineProvisioner
+	for _, result := range results {
+		if result.Status.Error != nil {
+			task.logger.Errorf(ctx, "cannot replace interrupted instance of machine %q: %v", result.Machine.Id(), result.Status.Error)
+			continue
+		}
+		machine := result.Machine
+		task.machinesMutex.RLock()
+		busy := task.machinesStarting[machine.Id()] || task.machinesStopping[machine.Id()]
+		task.machinesMutex.RUnlock()
+		if busy {
+			continue
+		}
+
+		instId, err := machine.InstanceId(ctx)
+		if err == nil {
+			if err := task.broker.StopInstances(ctx, instId); err != nil {
+				task.logger.Errorf(ctx, "cannot stop interrupted instance %q of machine %q: %v", instId, machine.Id(), err)
+				continue
+			}
+		} else if !params.IsCodeNotProvisioned(err) {
+			task.logger.Errorf(ctx, "cannot get instance of machine %q: %v", machine.Id(), err)
+			continue
+		}
+		if err := machine.ResetInterruptedInstance(ctx); err != nil {
+			task.logger.Errorf(ctx, "cannot reset interrupted instance of machine %q: %v", machine.Id(), err)
+			continue
+		}
+		task.logger.Infof(ctx, "replacing interrupted instance %q of machine %q", instId, machine.Id())
+		if err := machine.SetStatus(ctx, status.Pending, "replacing interrupted instance", nil); err != nil {
+			task.logger.Errorf(ctx, "cannot reset status of machine %q: %v", machine.Id(), err)
+			continue
+		}
+		if err := machine.SetInstanceStatus(ctx, status.Provisioning, "", nil); err != nil {
+			task.logger.Errorf(ctx, "cannot reset instance status of machine %q: %v", machine.Id(), err)
+			continue
+		}
+		task.machinesMutex.Lock()
+		task.machines[machine.Id()] = machine
+		delete(task.instances, instId)
+		task.machinesMutex.Unlock()
+		pending = append(pending, machine)
+	}
+	return task.queueStartMachines(ctx, pending)
+}
+
 func (task *provisionerTask) processMachines(ctx context.Context, ids []string) error {
 	task.logger.Debugf(context.TODO(), "processing machines %v", ids)
 
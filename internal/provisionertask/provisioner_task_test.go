@@ -78,6 +78,9 @@ type ProvisionerTaskSuite struct {
 	machineErrorRetryChanges chan struct{}
 	machineErrorRetryWatcher watcher.NotifyWatcher
 
+	interruptionChanges chan struct{}
+	interruptionWatcher watcher.NotifyWatcher
+
 	controllerAPI *MockControllerAPI
 	machinesAPI   *MockMachinesAPI
 
@@ -97,6 +100,9 @@ func (s *ProvisionerTaskSuite) SetUpTest(c *gc.C) {
 	s.machineErrorRetryChanges = make(chan struct{})
 	s.machineErrorRetryWatcher = watchertest.NewMockNotifyWatcher(s.machineErrorRetryChanges)
 
+	s.interruptionChanges = make(chan struct{})
+	s.interruptionWatcher = watchertest.NewMockNotifyWatcher(s.interruptionChanges)
+
 	s.instances = []instances.Instance{}
 	s.instanceBroker = &testInstanceBroker{
 		Stub:      &testing.Stub{},
@@ -126,6 +132,8 @@ func (s *ProvisionerTaskSuite) TestStartStop(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	err = workertest.CheckKilled(c, s.machineErrorRetryWatcher)
 	c.Assert(err, jc.ErrorIsNil)
+	err = workertest.CheckKilled(c, s.interruptionWatcher)
+	c.Assert(err, jc.ErrorIsNil)
 	s.instanceBroker.CheckNoCalls(c)
 }
 
@@ -212,6 +220,59 @@ func (s *ProvisionerTaskSuite) TestProvisionerRetries(c *gc.C) {
 	s.instanceBroker.CheckCallNames(c, "StartInstance", "StartInstance")
 }
 
+func (s *ProvisionerTaskSuite) TestProvisionerReplacesInterruptedInstances(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	m0 := &testMachine{
+		id:         "0",
+		instance:   &testInstance{id: "i-spot"},
+		instStatus: status.Interrupted,
+	}
+	s.machinesAPI.EXPECT().MachinesWithInterruptedInstances(gomock.Any()).Return(
+		[]apiprovisioner.MachineStatusResult{{Machine: m0, Status: params.StatusResult{}}}, nil)
+	s.expectProvisioningInfo(m0)
+
+	// Fail the replacement so that the test does not depend on the
+	// details of recording a started instance.
+	s.instanceBroker.SetErrors(nil, errors.New("no spot capacity"))
+
+	task := s.newProvisionerTask(c,
+		config.HarvestAll,
+		&mockDistributionGroupFinder{},
+		mockToolsFinder{},
+		numProvisionWorkersForTesting,
+	)
+
+	s.sendInterruptionChange(c)
+
+	s.waitForTask(c, []string{"StopInstances", "StartInstance"})
+
+	workertest.CleanKill(c, task)
+	close(s.instanceBroker.callsChan)
+	s.instanceBroker.CheckCallNames(c, "StopInstances", "StartInstance")
+	s.instanceBroker.CheckCall(c, 0, "StopInstances", []instance.Id{"i-spot"})
+	c.Assert(m0.GetResetInterruptedInstance(), jc.IsTrue)
+}
+
+func (s *ProvisionerTaskSuite) TestProvisionerInterruptedInstancesError(c *gc.C) {
+	defer s.setUpMocks(c).Finish()
+
+	s.machinesAPI.EXPECT().MachinesWithInterruptedInstances(gomock.Any()).Return(nil, errors.New("boom"))
+
+	task := s.newProvisionerTask(c,
+		config.HarvestAll,
+		&mockDistributionGroupFinder{},
+		mockToolsFinder{},
+		numProvisionWorkersForTesting,
+	)
+
+	s.sendInterruptionChange(c)
+
+	err := workertest.CheckKilled(c, task)
+	c.Assert(err, gc.ErrorMatches, "processing machines with interrupted instances: boom")
+	s.instanceBroker.CheckNoCalls(c)
+}
+
 func (s *ProvisionerTaskSuite) waitForProvisioned(c *gc.C, m *testMachine) {
 	for attempt := coretesting.LongAttempt.Start(); attempt.Next(); {
 		_, err := m.InstanceId(context.Background())
@@ -1424,6 +1485,14 @@ func (s *ProvisionerTaskSuite) sendMachineErrorRetryChange(c *gc.C) {
 	}
 }
 
+func (s *ProvisionerTaskSuite) sendInterruptionChange(c *gc.C) {
+	select {
+	case s.interruptionChanges <- struct{}{}:
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out sending interruption change")
+	}
+}
+
 func (s *ProvisionerTaskSuite) newProvisionerTask(
 	c *gc.C,
 	harvestingMethod config.HarvestMode,
@@ -1462,6 +1531,7 @@ func (s *ProvisionerTaskSuite) newProvisionerTaskWithRetry(
 		ToolsFinder:                  toolsFinder,
 		MachineWatcher:               s.modelMachinesWatcher,
 		RetryWatcher:                 s.machineErrorRetryWatcher,
+		InterruptionWatcher:          s.interruptionWatcher,
 		Broker:                       s.instanceBroker,
 		ImageStream:                  imagemetadata.ReleasedStream,
 		RetryStartInstanceStrategy:   retryStrategy,
@@ -1501,6 +1571,7 @@ func (s *ProvisionerTaskSuite) newProvisionerTaskWithBrokerAndEventCb(
 		ToolsFinder:             mockToolsFinder{},
 		MachineWatcher:          s.modelMachinesWatcher,
 		RetryWatcher:            s.machineErrorRetryWatcher,
+		InterruptionWatcher:     s.interruptionWatcher,
 		Broker:                  broker,
 		ImageStream:             imagemetadata.ReleasedStream,
 		RetryStartInstanceStrategy: provisionertask.RetryStrategy{
@@ -1672,6 +1743,7 @@ type testMachine struct {
 	instance       *testInstance
 	keepInstance   bool
 	markForRemoval bool
+	resetInstance  bool
 	constraints    string
 	machineStatus  status.Status
 	instStatus     status.Status
@@ -1746,6 +1818,20 @@ func (m *testMachine) GetMarkForRemoval() bool {
 	return m.markForRemoval
 }
 
+func (m *testMachine) ResetInterruptedInstance(context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.instance = nil
+	m.resetInstance = true
+	return nil
+}
+
+func (m *testMachine) GetResetInterruptedInstance() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.resetInstance
+}
+
 func (m *testMachine) Tag() names.Tag {
 	return m.MachineTag()
 }
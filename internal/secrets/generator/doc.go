@@ -0,0 +1,7 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package generator provides the server side generators used to
+// produce new content when a user secret with a rotate policy is
+// due to be rotated.
+package generator
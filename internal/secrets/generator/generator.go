@@ -0,0 +1,96 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package generator
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/pki"
+)
+
+// Generator produces new secret content.
+type Generator interface {
+	// Generate returns newly generated content for a secret.
+	Generate(ctx context.Context) (secrets.SecretData, error)
+}
+
+// paramSchema holds the params understood by each kind of generator.
+var paramSchema = map[secrets.GeneratorKind][]string{
+	secrets.GeneratorPassword:       {PasswordLengthParam},
+	secrets.GeneratorSSHKeyPair:     {SSHKeyTypeParam},
+	secrets.GeneratorTLSCertificate: {TLSCommonNameParam, TLSSANsParam},
+}
+
+// Validate returns an error satisfying [coreerrors.NotValid] if the
+// specified config has an unknown kind, a param not understood by the
+// kind of generator, or a param value the generator cannot use.
+func Validate(cfg secrets.GeneratorConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	known := paramSchema[cfg.Kind]
+	var unknown []string
+	for k := range cfg.Params {
+		if !slices.Contains(known, k) {
+			unknown = append(unknown, k)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errors.Errorf(
+			"secret generator %q params %q (expected %q) %w", cfg.Kind, unknown, known, coreerrors.NotValid)
+	}
+	_, err := newGenerator(cfg, nil)
+	return errors.Capture(err)
+}
+
+// New returns a generator for the specified config. The authority is
+// used to sign TLS certificates and may be nil if the generator kind
+// does not require it.
+func New(cfg secrets.GeneratorConfig, authority pki.Authority) (Generator, error) {
+	if err := Validate(cfg); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if cfg.Kind == secrets.GeneratorTLSCertificate && authority == nil {
+		return nil, errors.Errorf("secret generator %q requires a certificate authority", cfg.Kind)
+	}
+	return newGenerator(cfg, authority)
+}
+
+func newGenerator(cfg secrets.GeneratorConfig, authority pki.Authority) (Generator, error) {
+	switch cfg.Kind {
+	case secrets.GeneratorPassword:
+		return newPasswordGenerator(cfg.Params)
+	case secrets.GeneratorSSHKeyPair:
+		return newSSHKeyPairGenerator(cfg.Params)
+	case secrets.GeneratorTLSCertificate:
+		return newTLSCertificateGenerator(cfg.Params, authority)
+	}
+	return nil, errors.Errorf("secret generator %q %w", cfg.Kind, coreerrors.NotSupported)
+}
+
+func intParam(params map[string]string, key string, defaultValue int) (int, error) {
+	v, ok := params[key]
+	if !ok || v == "" {
+		return defaultValue, nil
+	}
+	result, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, errors.Errorf("generator param %q value %q %w", key, v, coreerrors.NotValid)
+	}
+	return result, nil
+}
+
+func stringParam(params map[string]string, key, defaultValue string) string {
+	if v, ok := params[key]; ok && v != "" {
+		return v
+	}
+	return defaultValue
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package generator_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+
+	jc "github.com/juju/testing/checkers"
+	gossh "golang.org/x/crypto/ssh"
+	gc "gopkg.in/check.v1"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/pki"
+	pkitest "github.com/juju/juju/internal/pki/test"
+	"github.com/juju/juju/internal/secrets/generator"
+)
+
+type generatorSuite struct{}
+
+var _ = gc.Suite(&generatorSuite{})
+
+func (s *generatorSuite) TestNewInvalidKind(c *gc.C) {
+	_, err := generator.New(secrets.GeneratorConfig{Kind: "foo"}, nil)
+	c.Assert(err, gc.ErrorMatches, `secret generator "foo" not valid`)
+}
+
+func (s *generatorSuite) TestPassword(c *gc.C) {
+	g, err := generator.New(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorPassword,
+		Params: map[string]string{"length": "32"},
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := g.Generate(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data[generator.PasswordKey], gc.HasLen, 32)
+
+	data2, err := g.Generate(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data2[generator.PasswordKey], gc.Not(gc.Equals), data[generator.PasswordKey])
+}
+
+func (s *generatorSuite) TestPasswordDefaultLength(c *gc.C) {
+	g, err := generator.New(secrets.GeneratorConfig{Kind: secrets.GeneratorPassword}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := g.Generate(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(data[generator.PasswordKey], gc.HasLen, 24)
+}
+
+func (s *generatorSuite) TestPasswordInvalidLength(c *gc.C) {
+	_, err := generator.New(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorPassword,
+		Params: map[string]string{"length": "4"},
+	}, nil)
+	c.Assert(err, gc.ErrorMatches, `password length 4 must be between 8 and 4096 not valid`)
+
+	_, err = generator.New(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorPassword,
+		Params: map[string]string{"length": "foo"},
+	}, nil)
+	c.Assert(err, gc.ErrorMatches, `generator param "length" value "foo" not valid`)
+}
+
+func (s *generatorSuite) TestSSHKeyPair(c *gc.C) {
+	g, err := generator.New(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorSSHKeyPair,
+		Params: map[string]string{"key-type": "ecdsa"},
+	}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := g.Generate(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	signer, err := gossh.ParsePrivateKey([]byte(data[generator.SSHPrivateKeyKey]))
+	c.Assert(err, jc.ErrorIsNil)
+	pub, _, _, _, err := gossh.ParseAuthorizedKey([]byte(data[generator.SSHPublicKeyKey]))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pub.Type(), gc.Equals, gossh.KeyAlgoECDSA256)
+	c.Assert(pub.Marshal(), jc.DeepEquals, signer.PublicKey().Marshal())
+}
+
+func (s *generatorSuite) TestSSHKeyPairInvalidType(c *gc.C) {
+	_, err := generator.New(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorSSHKeyPair,
+		Params: map[string]string{"key-type": "dsa"},
+	}, nil)
+	c.Assert(err, gc.ErrorMatches, `ssh key type "dsa" not valid`)
+}
+
+func (s *generatorSuite) TestTLSCertificateRequiresAuthority(c *gc.C) {
+	_, err := generator.New(secrets.GeneratorConfig{Kind: secrets.GeneratorTLSCertificate}, nil)
+	c.Assert(err, gc.ErrorMatches, `secret generator "tls-certificate" requires a certificate authority`)
+}
+
+func (s *generatorSuite) TestTLSCertificate(c *gc.C) {
+	authority, err := pkitest.NewTestAuthority()
+	c.Assert(err, jc.ErrorIsNil)
+
+	g, err := generator.New(secrets.GeneratorConfig{
+		Kind: secrets.GeneratorTLSCertificate,
+		Params: map[string]string{
+			"common-name": "db.example.com",
+			"sans":        "db.example.com, 10.0.0.1",
+		},
+	}, authority)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, err := g.Generate(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	certs, signers, err := pki.UnmarshalPemData([]byte(data[generator.TLSCertificateKey] + data[generator.TLSPrivateKeyKey]))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(signers, gc.HasLen, 1)
+	c.Assert(certs, gc.Not(gc.HasLen), 0)
+	cert := certs[0]
+	c.Assert(cert.Subject.CommonName, gc.Equals, "db.example.com")
+	c.Assert(cert.DNSNames, jc.DeepEquals, []string{"db.example.com"})
+	c.Assert(cert.IPAddresses, gc.HasLen, 1)
+	c.Assert(cert.IPAddresses[0].String(), gc.Equals, "10.0.0.1")
+	c.Assert(pki.PublicKeysEqual(signers[0].Public(), cert.PublicKey), jc.IsTrue)
+
+	block, _ := pem.Decode([]byte(data[generator.TLSCACertificateKey]))
+	c.Assert(block, gc.NotNil)
+	ca, err := x509.ParseCertificate(block.Bytes)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ca.Equal(authority.Certificate()), jc.IsTrue)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca)
+	_, err = cert.Verify(x509.VerifyOptions{Roots: pool, DNSName: "db.example.com"})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *generatorSuite) TestValidate(c *gc.C) {
+	err := generator.Validate(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorTLSCertificate,
+		Params: map[string]string{"common-name": "db.example.com", "sans": "10.0.0.1"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *generatorSuite) TestValidateUnknownParams(c *gc.C) {
+	err := generator.Validate(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorPassword,
+		Params: map[string]string{"length": "32", "lenght": "16", "chars": "abc"},
+	})
+	c.Assert(err, gc.ErrorMatches, `secret generator "password" params \["chars" "lenght"\] \(expected \["length"\]\) not valid`)
+	c.Assert(err, jc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *generatorSuite) TestValidateInvalidValue(c *gc.C) {
+	err := generator.Validate(secrets.GeneratorConfig{
+		Kind:   secrets.GeneratorSSHKeyPair,
+		Params: map[string]string{"key-type": "dsa"},
+	})
+	c.Assert(err, gc.ErrorMatches, `ssh key type "dsa" not valid`)
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package generator
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// PasswordKey is the secret content key holding a generated password.
+	PasswordKey = "password"
+
+	// PasswordLengthParam is the generator param used to set the
+	// length of the password.
+	PasswordLengthParam = "length"
+
+	defaultPasswordLength = 24
+	minPasswordLength     = 8
+	maxPasswordLength     = 4096
+
+	passwordChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+)
+
+type passwordGenerator struct {
+	length int
+}
+
+func newPasswordGenerator(params map[string]string) (*passwordGenerator, error) {
+	length, err := intParam(params, PasswordLengthParam, defaultPasswordLength)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if length < minPasswordLength || length > maxPasswordLength {
+		return nil, errors.Errorf(
+			"password length %d must be between %d and %d %w",
+			length, minPasswordLength, maxPasswordLength, coreerrors.NotValid)
+	}
+	return &passwordGenerator{length: length}, nil
+}
+
+// Generate is part of the Generator interface.
+func (g *passwordGenerator) Generate(_ context.Context) (secrets.SecretData, error) {
+	max := big.NewInt(int64(len(passwordChars)))
+	buf := make([]byte, g.length)
+	for i := range buf {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return nil, errors.Errorf("generating password: %w", err)
+		}
+		buf[i] = passwordChars[n.Int64()]
+	}
+	return secrets.SecretData{PasswordKey: string(buf)}, nil
+}
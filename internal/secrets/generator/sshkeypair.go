@@ -0,0 +1,72 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package generator
+
+import (
+	"context"
+
+	gossh "golang.org/x/crypto/ssh"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/pki/ssh"
+)
+
+const (
+	// SSHPrivateKeyKey is the secret content key holding
+	// a generated PEM encoded SSH private key.
+	SSHPrivateKeyKey = "private-key"
+	// SSHPublicKeyKey is the secret content key holding
+	// a generated SSH public key in authorized_keys format.
+	SSHPublicKeyKey = "public-key"
+
+	// SSHKeyTypeParam is the generator param used to set the
+	// type of SSH key to generate.
+	SSHKeyTypeParam = "key-type"
+)
+
+var sshKeyProfiles = map[string]ssh.KeyProfile{
+	"ed25519":  ssh.ED25519,
+	"rsa":      ssh.RSA3072,
+	"rsa2048":  ssh.RSA2048,
+	"rsa3072":  ssh.RSA3072,
+	"ecdsa":    ssh.ECDSAP256,
+	"ecdsa256": ssh.ECDSAP256,
+	"ecdsa384": ssh.ECDSAP384,
+	"ecdsa521": ssh.ECDSAP521,
+}
+
+type sshKeyPairGenerator struct {
+	profile ssh.KeyProfile
+}
+
+func newSSHKeyPairGenerator(params map[string]string) (*sshKeyPairGenerator, error) {
+	keyType := stringParam(params, SSHKeyTypeParam, "ed25519")
+	profile, ok := sshKeyProfiles[keyType]
+	if !ok {
+		return nil, errors.Errorf("ssh key type %q %w", keyType, coreerrors.NotValid)
+	}
+	return &sshKeyPairGenerator{profile: profile}, nil
+}
+
+// Generate is part of the Generator interface.
+func (g *sshKeyPairGenerator) Generate(_ context.Context) (secrets.SecretData, error) {
+	privateKey, err := g.profile()
+	if err != nil {
+		return nil, errors.Errorf("generating ssh private key: %w", err)
+	}
+	privateKeyPEM, err := ssh.MarshalPrivateKey(privateKey)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	signer, err := gossh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return nil, errors.Errorf("creating ssh signer: %w", err)
+	}
+	return secrets.SecretData{
+		SSHPrivateKeyKey: string(privateKeyPEM),
+		SSHPublicKeyKey:  string(gossh.MarshalAuthorizedKey(signer.PublicKey())),
+	}, nil
+}
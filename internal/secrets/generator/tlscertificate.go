@@ -0,0 +1,96 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package generator
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"strings"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/pki"
+)
+
+const (
+	// TLSCertificateKey is the secret content key holding
+	// a generated PEM encoded certificate and its chain.
+	TLSCertificateKey = "certificate"
+	// TLSPrivateKeyKey is the secret content key holding
+	// the PEM encoded private key for the certificate.
+	TLSPrivateKeyKey = "private-key"
+	// TLSCACertificateKey is the secret content key holding
+	// the PEM encoded CA certificate which signed the certificate.
+	TLSCACertificateKey = "ca-certificate"
+
+	// TLSCommonNameParam is the generator param used to set
+	// the common name of the certificate.
+	TLSCommonNameParam = "common-name"
+	// TLSSANsParam is the generator param used to set the comma
+	// separated DNS names and IP addresses of the certificate.
+	TLSSANsParam = "sans"
+)
+
+type tlsCertificateGenerator struct {
+	authority  pki.Authority
+	commonName string
+	dnsNames   []string
+	ips        []net.IP
+}
+
+func newTLSCertificateGenerator(params map[string]string, authority pki.Authority) (*tlsCertificateGenerator, error) {
+	g := &tlsCertificateGenerator{
+		authority:  authority,
+		commonName: stringParam(params, TLSCommonNameParam, pki.LeafSubjectTemplate.CommonName),
+	}
+	for _, san := range strings.Split(params[TLSSANsParam], ",") {
+		san = strings.TrimSpace(san)
+		if san == "" {
+			continue
+		}
+		if ip := net.ParseIP(san); ip != nil {
+			g.ips = append(g.ips, ip)
+		} else {
+			g.dnsNames = append(g.dnsNames, san)
+		}
+	}
+	return g, nil
+}
+
+// Generate is part of the Generator interface.
+func (g *tlsCertificateGenerator) Generate(_ context.Context) (secrets.SecretData, error) {
+	subject := pki.MakeX509NameFromDefaults(&pki.LeafSubjectTemplate, &pkix.Name{
+		CommonName: g.commonName,
+	})
+	// We don't use the authority's leaf groups as the generated
+	// certificates are not to be cached by the controller.
+	request := pki.NewDefaultLeafRequest(subject,
+		pki.NewDefaultRequestSigner(g.authority.Certificate(), g.authority.Chain(), g.authority.Signer()),
+		func(cert *x509.Certificate, chain []*x509.Certificate, signer crypto.Signer) (pki.Leaf, error) {
+			return pki.NewDefaultLeaf(g.commonName, cert, chain, signer), nil
+		},
+	)
+	request.AddDNSNames(g.dnsNames...)
+	request.AddIPAddresses(g.ips...)
+	leaf, err := request.Commit()
+	if err != nil {
+		return nil, errors.Errorf("generating tls certificate: %w", err)
+	}
+	certPEM, keyPEM, err := leaf.ToPemParts()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	caPEM, err := pki.CertificateToPemString(nil, g.authority.Certificate())
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return secrets.SecretData{
+		TLSCertificateKey:   string(certPEM),
+		TLSPrivateKeyKey:    string(keyPEM),
+		TLSCACertificateKey: caPEM,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/juju/core/model"
 	accessservice "github.com/juju/juju/domain/access/service"
+	admissionservice "github.com/juju/juju/domain/admission/service"
 	agentbinaryservice "github.com/juju/juju/domain/agentbinary/service"
 	agentpasswordservice "github.com/juju/juju/domain/agentpassword/service"
 	agentprovisionerservice "github.com/juju/juju/domain/agentprovisioner/service"
@@ -44,6 +45,9 @@ import (
 	resourceservice "github.com/juju/juju/domain/resource/service"
 	secretservice "github.com/juju/juju/domain/secret/service"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
+	sshaccessservice "github.com/juju/juju/domain/sshaccess/service"
+	sshcaservice "github.com/juju/juju/domain/sshca/service"
+	sshsessionservice "github.com/juju/juju/domain/sshsession/service"
 	statusservice "github.com/juju/juju/domain/status/service"
 	storageservice "github.com/juju/juju/domain/storage/service"
 	stubservice "github.com/juju/juju/domain/stub"
@@ -86,6 +90,18 @@ type ControllerDomainServices interface {
 	SecretBackend() *secretbackendservice.WatchableService
 	// Macaroon returns the macaroon bakery backend service
 	Macaroon() *macaroonservice.Service
+	// SSHSession returns the service for recording the SSH sessions proxied
+	// by the controller.
+	SSHSession() *sshsessionservice.Service
+	// SSHAccess returns the service for granting users time-bounded ssh
+	// access to units and machines.
+	SSHAccess() *sshaccessservice.Service
+	// SSHCA returns the service for the controller's ssh user certificate
+	// authority.
+	SSHCA() *sshcaservice.Service
+	// Admission returns the service for the admission policies which are
+	// evaluated before model-changing operations.
+	Admission() *admissionservice.Service
 }
 
 // ModelDomainServices provides access to the services required by the
@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package recording provides the means to record the terminal output of an SSH
+// session and to replay it later.
+//
+// Recordings use the asciicast v2 format, so they can also be played with
+// asciinema. The header of a recording holds the terminal size and the
+// command run in the session, if any. Each following line holds an event: the
+// output written to the terminal, a change of the terminal size, or the exit
+// code of the session. The exit event is borrowed from asciicast v3 and is
+// ignored by players which do not understand it.
+//
+// Input is deliberately not recorded, as it may contain passwords typed by the
+// user. Anything echoed by the terminal is part of the output.
+package recording
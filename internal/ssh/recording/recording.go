@@ -0,0 +1,290 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+)
+
+// Version is the version of the asciicast format written by a Recorder.
+const Version = 2
+
+// EventType is the type of an event in a recording.
+type EventType string
+
+const (
+	// Output is the type of an event holding output written to the terminal.
+	Output EventType = "o"
+	// Resize is the type of an event holding the new size of the terminal,
+	// formatted as "{width}x{height}".
+	Resize EventType = "r"
+	// Exit is the type of an event holding the exit code of the session.
+	Exit EventType = "x"
+)
+
+// Header is the first line of a recording.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Command   string            `json:"command,omitempty"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Event is a single event in a recording.
+type Event struct {
+	// Time is the time since the start of the recording.
+	Time time.Duration
+	Type EventType
+	Data string
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{e.Time.Seconds(), e.Type, e.Data})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var fields []json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return errors.Trace(err)
+	}
+	if len(fields) != 3 {
+		return errors.NotValidf("event with %d fields", len(fields))
+	}
+	var seconds float64
+	if err := json.Unmarshal(fields[0], &seconds); err != nil {
+		return errors.Annotate(err, "event time")
+	}
+	if err := json.Unmarshal(fields[1], &e.Type); err != nil {
+		return errors.Annotate(err, "event type")
+	}
+	if err := json.Unmarshal(fields[2], &e.Data); err != nil {
+		return errors.Annotate(err, "event data")
+	}
+	e.Time = time.Duration(seconds * float64(time.Second))
+	return nil
+}
+
+// Recorder writes a recording of a session. The output of the session is
+// recorded by writing to the Recorder.
+type Recorder struct {
+	clock clock.Clock
+	start time.Time
+
+	mu  sync.Mutex
+	w   io.Writer
+	err error
+	// partial holds the start of a multi-byte character split across
+	// writes, which is held back until the character is complete.
+	partial []byte
+}
+
+// NewRecorder returns a Recorder writing a recording with the specified
+// header to w. The version and timestamp of the header are filled in.
+func NewRecorder(w io.Writer, clock clock.Clock, header Header) (*Recorder, error) {
+	r := &Recorder{
+		clock: clock,
+		start: clock.Now(),
+		w:     w,
+	}
+	header.Version = Version
+	header.Timestamp = r.start.Unix()
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return nil, errors.Annotate(err, "writing recording header")
+	}
+	return r, nil
+}
+
+// Write records output written to the terminal. It never fails, so that a
+// problem with the recording does not disrupt the session; any error is
+// reported by Err instead.
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data := append(r.partial, p...)
+	complete := completeLen(data)
+	r.partial = append([]byte(nil), data[complete:]...)
+	if complete > 0 {
+		r.writeEvent(Output, string(data[:complete]))
+	}
+	return len(p), nil
+}
+
+// Resize records a change in the size of the terminal.
+func (r *Recorder) Resize(width, height int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.writeEvent(Resize, fmt.Sprintf("%dx%d", width, height))
+}
+
+// Exit records the exit code of the session, along with any output held back
+// waiting for the rest of a character.
+func (r *Recorder) Exit(code int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.partial) > 0 {
+		r.writeEvent(Output, string(r.partial))
+		r.partial = nil
+	}
+	r.writeEvent(Exit, strconv.Itoa(code))
+}
+
+// Err returns the first error encountered writing the recording.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Recorder) writeEvent(eventType EventType, data string) {
+	if r.err != nil {
+		return
+	}
+	event, err := json.Marshal(Event{
+		Time: r.clock.Now().Sub(r.start),
+		Type: eventType,
+		Data: data,
+	})
+	if err != nil {
+		r.err = errors.Trace(err)
+		return
+	}
+	if _, err := r.w.Write(append(event, '\n')); err != nil {
+		r.err = errors.Annotate(err, "writing recording event")
+	}
+}
+
+// completeLen returns the length of the prefix of p which does not end with
+// an incomplete UTF-8 encoded character.
+func completeLen(p []byte) int {
+	for i := len(p) - 1; i >= 0 && i >= len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if utf8.FullRune(p[i:]) {
+				return len(p)
+			}
+			return i
+		}
+	}
+	return len(p)
+}
+
+// Reader reads the events of a recording.
+type Reader struct {
+	r      *bufio.Reader
+	header Header
+}
+
+// NewReader returns a Reader for the recording read from r, having read its
+// header.
+func NewReader(r io.Reader) (*Reader, error) {
+	reader := &Reader{r: bufio.NewReader(r)}
+	line, err := reader.readLine()
+	if err == io.EOF {
+		return nil, errors.NotValidf("empty recording")
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := json.Unmarshal(line, &reader.header); err != nil {
+		return nil, errors.Annotate(err, "reading recording header")
+	}
+	if reader.header.Version != Version {
+		return nil, errors.NotSupportedf("recording version %d", reader.header.Version)
+	}
+	return reader, nil
+}
+
+// Header returns the header of the recording.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next event of the recording, or io.EOF if there are no
+// more events.
+func (r *Reader) Next() (Event, error) {
+	line, err := r.readLine()
+	if err != nil {
+		return Event{}, err
+	}
+	var event Event
+	if err := json.Unmarshal(line, &event); err != nil {
+		return Event{}, errors.Annotate(err, "reading recording event")
+	}
+	return event, nil
+}
+
+// readLine returns the next non-empty line.
+func (r *Reader) readLine() ([]byte, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			return line, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Play writes the output of the recording to w, pausing between events as
+// long as the session did, but never for longer than maxIdle if it is
+// positive. It returns the exit code of the session, or -1 if the recording
+// does not hold one.
+func Play(ctx context.Context, r *Reader, w io.Writer, clock clock.Clock, maxIdle time.Duration) (int, error) {
+	exitCode := -1
+	var last time.Duration
+	for {
+		event, err := r.Next()
+		if err == io.EOF {
+			return exitCode, nil
+		} else if err != nil {
+			return exitCode, errors.Trace(err)
+		}
+
+		delay := event.Time - last
+		if maxIdle > 0 && delay > maxIdle {
+			delay = maxIdle
+		}
+		last = event.Time
+		if delay > 0 {
+			select {
+			case <-ctx.Done():
+				return exitCode, ctx.Err()
+			case <-clock.After(delay):
+			}
+		}
+
+		switch event.Type {
+		case Output:
+			if _, err := io.WriteString(w, event.Data); err != nil {
+				return exitCode, errors.Trace(err)
+			}
+		case Exit:
+			if exitCode, err = strconv.Atoi(event.Data); err != nil {
+				return -1, errors.NotValidf("exit code %q", event.Data)
+			}
+		}
+	}
+}
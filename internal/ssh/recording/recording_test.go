@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package recording
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type recordingSuite struct{}
+
+var _ = gc.Suite(&recordingSuite{})
+
+func (s *recordingSuite) TestRecord(c *gc.C) {
+	clk := testclock.NewClock(time.Unix(1700000000, 0))
+	var buf bytes.Buffer
+	r, err := NewRecorder(&buf, clk, Header{Width: 80, Height: 24, Command: "ls"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = r.Write([]byte("hello "))
+	c.Assert(err, jc.ErrorIsNil)
+	clk.Advance(1500 * time.Millisecond)
+	r.Resize(100, 40)
+	// A character split across writes is recorded whole.
+	_, err = r.Write([]byte("w\xc3"))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = r.Write([]byte("\xb6rld\n"))
+	c.Assert(err, jc.ErrorIsNil)
+	clk.Advance(time.Second)
+	r.Exit(2)
+	c.Assert(r.Err(), jc.ErrorIsNil)
+
+	c.Check(buf.String(), gc.Equals, `{"version":2,"width":80,"height":24,"timestamp":1700000000,"command":"ls"}
+[0,"o","hello "]
+[1.5,"r","100x40"]
+[1.5,"o","w"]
+[1.5,"o","örld\n"]
+[2.5,"x","2"]
+`)
+}
+
+func (s *recordingSuite) TestRead(c *gc.C) {
+	reader, err := NewReader(strings.NewReader(`{"version":2,"width":80,"height":24,"command":"ls"}
+[0,"o","hello "]
+
+[1.5,"x","0"]
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(reader.Header(), gc.DeepEquals, Header{Version: 2, Width: 80, Height: 24, Command: "ls"})
+
+	event, err := reader.Next()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(event, gc.DeepEquals, Event{Type: Output, Data: "hello "})
+	event, err = reader.Next()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(event, gc.DeepEquals, Event{Time: 1500 * time.Millisecond, Type: Exit, Data: "0"})
+	_, err = reader.Next()
+	c.Check(err, gc.Equals, io.EOF)
+}
+
+func (s *recordingSuite) TestReadNotValid(c *gc.C) {
+	_, err := NewReader(strings.NewReader(""))
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+
+	_, err = NewReader(strings.NewReader(`{"version":1}`))
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+
+	reader, err := NewReader(strings.NewReader(`{"version":2}
+[0,"o"]
+`))
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = reader.Next()
+	c.Check(err, gc.ErrorMatches, `reading recording event: event with 2 fields not valid`)
+}
+
+func (s *recordingSuite) TestPlay(c *gc.C) {
+	reader, err := NewReader(strings.NewReader(`{"version":2,"width":80,"height":24}
+[0,"o","hello "]
+[0.5,"r","100x40"]
+[60,"o","world"]
+[60,"x","3"]
+`))
+	c.Assert(err, jc.ErrorIsNil)
+
+	clk := testclock.NewDilatedWallClock(time.Millisecond)
+	var buf bytes.Buffer
+	code, err := Play(context.Background(), reader, &buf, clk, time.Second)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(code, gc.Equals, 3)
+	c.Check(buf.String(), gc.Equals, "hello world")
+}
+
+func (s *recordingSuite) TestPlayCancelled(c *gc.C) {
+	reader, err := NewReader(strings.NewReader(`{"version":2,"width":80,"height":24}
+[60,"o","world"]
+`))
+	c.Assert(err, jc.ErrorIsNil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	code, err := Play(ctx, reader, io.Discard, testclock.NewClock(time.Time{}), 0)
+	c.Check(err, jc.ErrorIs, context.Canceled)
+	c.Check(code, gc.Equals, -1)
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshtunneler
+
+import (
+	"net"
+
+	"github.com/juju/errors"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// NewSSHDial returns an SSHDial that establishes SSH connections to
+// machines over the tunnels they pushed.
+func NewSSHDial() SSHDial {
+	return sshDial{}
+}
+
+type sshDial struct{}
+
+// Dial establishes an SSH connection over the provided connection,
+// authenticating with the private key and checking the machine's host key
+// with the callback.
+func (sshDial) Dial(conn net.Conn, username string, privateKey gossh.Signer, hostKeyCallback gossh.HostKeyCallback) (*gossh.Client, error) {
+	config := &gossh.ClientConfig{
+		User:            username,
+		Auth:            []gossh.AuthMethod{gossh.PublicKeys(privateKey)},
+		HostKeyCallback: hostKeyCallback,
+	}
+	c, chans, reqs, err := gossh.NewClientConn(conn, conn.RemoteAddr().String(), config)
+	if err != nil {
+		return nil, errors.Annotate(err, "establishing ssh connection over tunnel")
+	}
+	return gossh.NewClient(c, chans, reqs), nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshtunneler
+
+import (
+	"bytes"
+	"errors"
+	"net"
+
+	jc "github.com/juju/testing/checkers"
+	gossh "golang.org/x/crypto/ssh"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/pki/ssh"
+)
+
+type sshDialSuite struct{}
+
+var _ = gc.Suite(&sshDialSuite{})
+
+func newSigner(c *gc.C) gossh.Signer {
+	key, err := ssh.ED25519()
+	c.Assert(err, jc.ErrorIsNil)
+	signer, err := gossh.NewSignerFromKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	return signer
+}
+
+// serveSSH runs the server side of an SSH handshake on the connection,
+// accepting only the client key.
+func serveSSH(c *gc.C, conn net.Conn, hostKey gossh.Signer, clientKey gossh.PublicKey) <-chan error {
+	config := &gossh.ServerConfig{
+		PublicKeyCallback: func(meta gossh.ConnMetadata, key gossh.PublicKey) (*gossh.Permissions, error) {
+			if meta.User() != "ubuntu" || !bytes.Equal(key.Marshal(), clientKey.Marshal()) {
+				return nil, errors.New("unknown key")
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostKey)
+
+	done := make(chan error, 1)
+	go func() {
+		sConn, chans, reqs, err := gossh.NewServerConn(conn, config)
+		if err != nil {
+			done <- err
+			return
+		}
+		go gossh.DiscardRequests(reqs)
+		go func() {
+			for ch := range chans {
+				_ = ch.Reject(gossh.Prohibited, "no channels")
+			}
+		}()
+		done <- sConn.Wait()
+	}()
+	return done
+}
+
+// connPair returns the two ends of a TCP connection. A net.Pipe can't be
+// used, as both sides of the SSH handshake write before reading.
+func connPair(c *gc.C) (net.Conn, net.Conn) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, jc.ErrorIsNil)
+	defer l.Close()
+
+	clientConn, err := net.Dial("tcp", l.Addr().String())
+	c.Assert(err, jc.ErrorIsNil)
+	serverConn, err := l.Accept()
+	c.Assert(err, jc.ErrorIsNil)
+	return clientConn, serverConn
+}
+
+func (s *sshDialSuite) TestDial(c *gc.C) {
+	hostKey := newSigner(c)
+	clientKey := newSigner(c)
+
+	clientConn, serverConn := connPair(c)
+	done := serveSSH(c, serverConn, hostKey, clientKey.PublicKey())
+
+	client, err := NewSSHDial().Dial(clientConn, "ubuntu", clientKey, useFixedHostKeys([]gossh.PublicKey{hostKey.PublicKey()}))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(client.User(), gc.Equals, "ubuntu")
+
+	c.Assert(client.Close(), jc.ErrorIsNil)
+	<-done
+}
+
+func (s *sshDialSuite) TestDialHostKeyMismatch(c *gc.C) {
+	hostKey := newSigner(c)
+	clientKey := newSigner(c)
+
+	clientConn, serverConn := connPair(c)
+	done := serveSSH(c, serverConn, hostKey, clientKey.PublicKey())
+
+	_, err := NewSSHDial().Dial(clientConn, "ubuntu", clientKey, useFixedHostKeys([]gossh.PublicKey{newSigner(c).PublicKey()}))
+	c.Assert(err, gc.ErrorMatches, ".*host key mismatch")
+
+	_ = clientConn.Close()
+	<-done
+}
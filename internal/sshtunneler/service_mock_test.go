@@ -42,7 +42,7 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 }
 
 // InsertSSHConnRequest mocks base method.
-func (m *MockState) InsertSSHConnRequest(arg0 sshRequestArgs) error {
+func (m *MockState) InsertSSHConnRequest(arg0 SSHConnRequestArgs) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InsertSSHConnRequest", arg0)
 	ret0, _ := ret[0].(error)
@@ -68,13 +68,13 @@ func (c *MockStateInsertSSHConnRequestCall) Return(arg0 error) *MockStateInsertS
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateInsertSSHConnRequestCall) Do(f func(sshRequestArgs) error) *MockStateInsertSSHConnRequestCall {
+func (c *MockStateInsertSSHConnRequestCall) Do(f func(SSHConnRequestArgs) error) *MockStateInsertSSHConnRequestCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateInsertSSHConnRequestCall) DoAndReturn(f func(sshRequestArgs) error) *MockStateInsertSSHConnRequestCall {
+func (c *MockStateInsertSSHConnRequestCall) DoAndReturn(f func(SSHConnRequestArgs) error) *MockStateInsertSSHConnRequestCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -32,7 +32,9 @@ const (
 	defaultUser       = "ubuntu"
 )
 
-type sshRequestArgs struct {
+// SSHConnRequestArgs holds the arguments of a request for a machine to
+// open a reverse SSH tunnel to the controller.
+type SSHConnRequestArgs struct {
 	TunnelID            string
 	ModelUUID           string
 	MachineId           string
@@ -46,8 +48,7 @@ type sshRequestArgs struct {
 
 // State defines an interface to write requests for tunnels to state.
 type State interface {
-	// TODO(JUJU-7916) - Create adapter to convert sshRequestArgs to state type.
-	InsertSSHConnRequest(args sshRequestArgs) error
+	InsertSSHConnRequest(args SSHConnRequestArgs) error
 	MachineHostKeys(modelUUID, machineID string) ([]string, error)
 }
 
@@ -202,7 +203,7 @@ func (tt *Tracker) RequestTunnel(ctx context.Context, req RequestArgs) (*gossh.C
 	tt.add(tunnelID.String(), connRecv)
 	defer tt.delete(tunnelID.String())
 
-	args := sshRequestArgs{
+	args := SSHConnRequestArgs{
 		TunnelID:            tunnelID.String(),
 		ModelUUID:           req.ModelUUID,
 		MachineId:           req.MachineID,
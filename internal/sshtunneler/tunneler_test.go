@@ -58,7 +58,7 @@ func (s *sshTunnelerSuite) TestTunneler(c *gc.C) {
 
 	tunnelTracker := s.newTracker(c)
 
-	sshConnArgs := sshRequestArgs{}
+	sshConnArgs := SSHConnRequestArgs{}
 
 	// use a channel to wait for the tunnel request to be processed
 	tunnelRequested := make(chan struct{})
@@ -76,7 +76,7 @@ func (s *sshTunnelerSuite) TestTunneler(c *gc.C) {
 		{MachineAddress: network.NewMachineAddress("1.2.3.4")},
 	}, nil)
 	s.state.EXPECT().InsertSSHConnRequest(gomock.Any()).DoAndReturn(
-		func(sra sshRequestArgs) error {
+		func(sra SSHConnRequestArgs) error {
 			sshConnArgs = sra
 			close(tunnelRequested)
 			return nil
@@ -156,7 +156,7 @@ func (s *sshTunnelerSuite) TestTunnelIsClosedWhenDialFails(c *gc.C) {
 
 	tunnelTracker := s.newTracker(c)
 
-	sshConnArgs := sshRequestArgs{}
+	sshConnArgs := SSHConnRequestArgs{}
 
 	// use a channel to wait for the tunnel request to be processed
 	tunnelRequested := make(chan struct{})
@@ -167,7 +167,7 @@ func (s *sshTunnelerSuite) TestTunnelIsClosedWhenDialFails(c *gc.C) {
 		{MachineAddress: network.NewMachineAddress("1.2.3.4")},
 	}, nil)
 	s.state.EXPECT().InsertSSHConnRequest(gomock.Any()).DoAndReturn(
-		func(sra sshRequestArgs) error {
+		func(sra SSHConnRequestArgs) error {
 			sshConnArgs = sra
 			close(tunnelRequested)
 			return nil
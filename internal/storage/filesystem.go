@@ -52,3 +52,20 @@ type FilesystemAttachmentInfo struct {
 	// ReadOnly indicates that the filesystem is mounted read-only.
 	ReadOnly bool
 }
+
+// FilesystemUsage describes the space and inode usage of a filesystem
+// mounted on a machine.
+type FilesystemUsage struct {
+	// Path is the path at which the filesystem is mounted.
+	Path string
+
+	// UsedBytes and AvailableBytes are the number of bytes used on
+	// the filesystem, and available to unprivileged users.
+	UsedBytes      uint64
+	AvailableBytes uint64
+
+	// UsedInodes and AvailableInodes are the number of inodes used on
+	// the filesystem, and available to unprivileged users.
+	UsedInodes      uint64
+	AvailableInodes uint64
+}
@@ -5,6 +5,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/names/v6"
 
@@ -221,6 +222,72 @@ type VolumeImporter interface {
 	) (VolumeInfo, error)
 }
 
+// VolumeResizer provides an interface for growing volumes. A VolumeSource
+// may implement VolumeResizer if its volumes can be grown in place.
+type VolumeResizer interface {
+	// ResizeVolumes grows the volumes with the specified parameters to
+	// at least the requested size. Volumes must not be shrunk; a request
+	// for a size no larger than the current size of a volume must
+	// succeed without changing the volume.
+	//
+	// ResizeVolumes must be idempotent; it may be called again for a
+	// volume which has already been grown, e.g. if the result could not
+	// be recorded in the model.
+	ResizeVolumes(ctx context.Context, params []VolumeResizeParams) ([]ResizeVolumesResult, error)
+}
+
+// FilesystemResizer provides an interface for growing filesystems. A
+// FilesystemSource may implement FilesystemResizer if its filesystems can
+// be grown in place.
+type FilesystemResizer interface {
+	// ResizeFilesystems grows the filesystems with the specified
+	// parameters to at least the requested size. Filesystems must not
+	// be shrunk; a request for a size no larger than the current size
+	// of a filesystem must succeed without changing the filesystem.
+	//
+	// ResizeFilesystems must be idempotent; it may be called again for
+	// a filesystem which has already been grown.
+	ResizeFilesystems(ctx context.Context, params []FilesystemResizeParams) ([]ResizeFilesystemsResult, error)
+}
+
+// VolumeSnapshotter provides an interface for taking point-in-time
+// snapshots of volumes. A VolumeSource may implement VolumeSnapshotter if
+// it supports snapshots, in which case its CreateVolumes method must also
+// support creating volumes from a snapshot, as specified by
+// VolumeParams.SnapshotId.
+type VolumeSnapshotter interface {
+	// CreateVolumeSnapshots takes a snapshot of each of the volumes with
+	// the specified parameters.
+	CreateVolumeSnapshots(ctx context.Context, params []VolumeSnapshotParams) ([]CreateSnapshotsResult, error)
+
+	// ListVolumeSnapshots lists the snapshots of each of the volumes with
+	// the specified provider IDs.
+	ListVolumeSnapshots(ctx context.Context, volumeIds []string) ([]ListSnapshotsResult, error)
+
+	// DeleteVolumeSnapshots deletes the volume snapshots with the
+	// specified provider IDs.
+	DeleteVolumeSnapshots(ctx context.Context, snapshotIds []string) ([]error, error)
+}
+
+// FilesystemSnapshotter provides an interface for taking point-in-time
+// snapshots of filesystems. A FilesystemSource may implement
+// FilesystemSnapshotter if it supports snapshots, in which case its
+// CreateFilesystems method must also support creating filesystems from a
+// snapshot, as specified by FilesystemParams.SnapshotId.
+type FilesystemSnapshotter interface {
+	// CreateFilesystemSnapshots takes a snapshot of each of the
+	// filesystems with the specified parameters.
+	CreateFilesystemSnapshots(ctx context.Context, params []FilesystemSnapshotParams) ([]CreateSnapshotsResult, error)
+
+	// ListFilesystemSnapshots lists the snapshots of each of the
+	// filesystems with the specified provider IDs.
+	ListFilesystemSnapshots(ctx context.Context, filesystemIds []string) ([]ListSnapshotsResult, error)
+
+	// DeleteFilesystemSnapshots deletes the filesystem snapshots with the
+	// specified provider IDs.
+	DeleteFilesystemSnapshots(ctx context.Context, snapshotIds []string) ([]error, error)
+}
+
 // VolumeParams is a fully specified set of parameters for volume creation,
 // derived from one or more of user-specified storage directives, a
 // storage pool definition, and charm storage metadata.
@@ -244,6 +311,11 @@ type VolumeParams struct {
 	// storage provider supports tags.
 	ResourceTags map[string]string
 
+	// SnapshotId is the provider-supplied ID of the snapshot from which
+	// the volume is to be created, if any. Only volume sources which
+	// implement VolumeSnapshotter support creating volumes from snapshots.
+	SnapshotId string
+
 	// Attachment identifies the machine that the volume should be attached
 	// to initially, or nil if the volume should not be attached to any
 	// machine. Some providers, such as MAAS, do not support dynamic
@@ -320,6 +392,12 @@ type FilesystemParams struct {
 	// storage provider supports tags.
 	ResourceTags map[string]string
 
+	// SnapshotId is the provider-supplied ID of the snapshot from which
+	// the filesystem is to be created, if any. For a filesystem backed by
+	// a volume, the snapshot is of the volume, and the filesystem already
+	// exists on the volume once it has been created.
+	SnapshotId string
+
 	// Attachment identifies the machine that the filesystem should be attached
 	// to initially, or nil if the filesystem should not be attached to any
 	// machine.
@@ -344,6 +422,106 @@ type FilesystemAttachmentParams struct {
 	Path string
 }
 
+// VolumeResizeParams is a set of parameters for growing a volume.
+type VolumeResizeParams struct {
+	// Tag is the unique tag assigned by Juju for the volume.
+	Tag names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// Size is the minimum size of the volume in MiB once resized.
+	Size uint64
+
+	// Provider is the name of the storage provider that manages the
+	// volume.
+	Provider ProviderType
+}
+
+// FilesystemResizeParams is a set of parameters for growing a filesystem.
+type FilesystemResizeParams struct {
+	// Tag is the unique tag assigned by Juju for the filesystem.
+	Tag names.FilesystemTag
+
+	// FilesystemId is the unique provider-supplied ID for the
+	// filesystem.
+	FilesystemId string
+
+	// Size is the minimum size of the filesystem in MiB once resized.
+	Size uint64
+
+	// Provider is the name of the storage provider that manages the
+	// filesystem.
+	Provider ProviderType
+
+	// Volume is the tag of the volume that backs the filesystem, if any.
+	Volume names.VolumeTag
+}
+
+// VolumeSnapshotParams is a set of parameters for taking a snapshot of a
+// volume.
+type VolumeSnapshotParams struct {
+	// Tag is the unique tag assigned by Juju for the volume.
+	Tag names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// ResourceTags is a set of tags to set on the created snapshot, if
+	// the storage provider supports tags.
+	ResourceTags map[string]string
+}
+
+// FilesystemSnapshotParams is a set of parameters for taking a snapshot of
+// a filesystem.
+type FilesystemSnapshotParams struct {
+	// Tag is the unique tag assigned by Juju for the filesystem.
+	Tag names.FilesystemTag
+
+	// FilesystemId is the unique provider-supplied ID for the
+	// filesystem.
+	FilesystemId string
+
+	// ResourceTags is a set of tags to set on the created snapshot, if
+	// the storage provider supports tags.
+	ResourceTags map[string]string
+}
+
+// Snapshot describes a point-in-time snapshot of a volume or filesystem.
+type Snapshot struct {
+	// SnapshotId is the unique provider-supplied ID for the snapshot.
+	SnapshotId string
+
+	// SourceId is the provider-supplied ID of the volume or filesystem
+	// that the snapshot was taken of.
+	SourceId string
+
+	// Size is the size of the snapshotted volume or filesystem in MiB,
+	// if known.
+	Size uint64
+
+	// Created is the time at which the snapshot was taken, if known.
+	Created time.Time
+
+	// Status is the provider-specific status of the snapshot, e.g.
+	// "pending" or "completed", if known.
+	Status string
+}
+
+// CreateSnapshotsResult contains the result of taking a snapshot of one
+// volume or filesystem. Snapshot should only be used if Error is nil.
+type CreateSnapshotsResult struct {
+	Snapshot *Snapshot
+	Error    error
+}
+
+// ListSnapshotsResult contains the snapshots of one volume or filesystem.
+// Snapshots should only be used if Error is nil.
+type ListSnapshotsResult struct {
+	Snapshots []Snapshot
+	Error     error
+}
+
 // CreateVolumesResult contains the result of a VolumeSource.CreateVolumes call
 // for one volume. Volume and VolumeAttachment should only be used if Error is
 // nil.
@@ -380,3 +558,20 @@ type AttachFilesystemsResult struct {
 	FilesystemAttachment *FilesystemAttachment
 	Error                error
 }
+
+// ResizeVolumesResult contains the result of a VolumeResizer.ResizeVolumes
+// call for one volume. Size is the size of the volume in MiB once resized,
+// and should only be used if Error is nil.
+type ResizeVolumesResult struct {
+	Size  uint64
+	Error error
+}
+
+// ResizeFilesystemsResult contains the result of a
+// FilesystemResizer.ResizeFilesystems call for one filesystem. Size is the
+// size of the filesystem in MiB once resized, and should only be used if
+// Error is nil.
+type ResizeFilesystemsResult struct {
+	Size  uint64
+	Error error
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -93,18 +94,22 @@ func (s *managedFilesystemSource) createFilesystem(arg storage.FilesystemParams)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	devicePath := devicePath(blockDevice)
-	if isDiskDevice(devicePath) {
-		if err := destroyPartitions(s.run, devicePath); err != nil {
-			return nil, errors.Trace(err)
+	// A volume created from a snapshot already has its partition and
+	// filesystem; recreating them would destroy the restored data.
+	if arg.SnapshotId == "" {
+		devicePath := devicePath(blockDevice)
+		if isDiskDevice(devicePath) {
+			if err := destroyPartitions(s.run, devicePath); err != nil {
+				return nil, errors.Trace(err)
+			}
+			if err := createPartition(s.run, devicePath); err != nil {
+				return nil, errors.Trace(err)
+			}
+			devicePath = partitionDevicePath(devicePath)
 		}
-		if err := createPartition(s.run, devicePath); err != nil {
+		if err := createFilesystem(s.run, devicePath); err != nil {
 			return nil, errors.Trace(err)
 		}
-		devicePath = partitionDevicePath(devicePath)
-	}
-	if err := createFilesystem(s.run, devicePath); err != nil {
-		return nil, errors.Trace(err)
 	}
 	return &storage.Filesystem{
 		arg.Tag,
@@ -180,6 +185,105 @@ func (s *managedFilesystemSource) DetachFilesystems(ctx context.Context, args []
 	return results, nil
 }
 
+// ResizeFilesystems is defined on storage.FilesystemResizer.
+func (s *managedFilesystemSource) ResizeFilesystems(ctx context.Context, args []storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		size, err := s.resizeFilesystem(arg)
+		if err != nil {
+			results[i].Error = err
+			continue
+		}
+		results[i].Size = size
+	}
+	return results, nil
+}
+
+func (s *managedFilesystemSource) resizeFilesystem(arg storage.FilesystemResizeParams) (uint64, error) {
+	volumeTag := arg.Volume
+	if filesystem, ok := s.filesystems[arg.Tag]; ok {
+		volumeTag = filesystem.Volume
+	}
+	blockDevice, err := s.backingVolumeBlockDevice(volumeTag)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	devicePath := devicePath(blockDevice)
+	if isDiskDevice(devicePath) {
+		if err := growPartition(s.run, devicePath); err != nil {
+			return 0, errors.Trace(err)
+		}
+		devicePath = partitionDevicePath(devicePath)
+	}
+	if err := growFilesystem(s.run, devicePath); err != nil {
+		return 0, errors.Trace(err)
+	}
+	size, err := deviceSizeMiB(s.run, devicePath)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+	if size < arg.Size {
+		return 0, errors.Errorf(
+			"filesystem %s is %dMiB after growing, need at least %dMiB",
+			arg.Tag.Id(), size, arg.Size,
+		)
+	}
+	return size, nil
+}
+
+// growPartition grows the first (and only) partition on the disk with
+// the specified device path to fill the disk.
+func growPartition(run runCommandFunc, devicePath string) error {
+	logger.Debugf(context.TODO(), "growing partition on %q", devicePath)
+	if output, err := run("growpart", devicePath, "1"); err != nil {
+		// growpart exits non-zero when the partition already
+		// fills the disk.
+		if strings.Contains(output, "NOCHANGE") || strings.Contains(err.Error(), "NOCHANGE") {
+			return nil
+		}
+		return errors.Annotate(err, "growpart failed")
+	}
+	return nil
+}
+
+// growFilesystem grows the filesystem on the specified device to fill
+// the device.
+func growFilesystem(run runCommandFunc, devicePath string) error {
+	logger.Debugf(context.TODO(), "growing filesystem on %q", devicePath)
+	fsType, err := run("blkid", "-o", "value", "-s", "TYPE", devicePath)
+	if err != nil {
+		return errors.Annotate(err, "blkid failed")
+	}
+	if strings.TrimSpace(fsType) != "xfs" {
+		if _, err := run("resize2fs", devicePath); err != nil {
+			return errors.Annotate(err, "resize2fs failed")
+		}
+		return nil
+	}
+	// xfs_growfs operates on the mount point, not the device.
+	mountPoint, err := run("findmnt", "-n", "-o", "TARGET", "--source", devicePath)
+	if err != nil {
+		return errors.Annotate(err, "findmnt failed")
+	}
+	if _, err := run("xfs_growfs", strings.TrimSpace(mountPoint)); err != nil {
+		return errors.Annotate(err, "xfs_growfs failed")
+	}
+	return nil
+}
+
+// deviceSizeMiB returns the size of the specified device in MiB.
+func deviceSizeMiB(run runCommandFunc, devicePath string) (uint64, error) {
+	output, err := run("blockdev", "--getsize64", devicePath)
+	if err != nil {
+		return 0, errors.Annotate(err, "blockdev failed")
+	}
+	size, err := strconv.ParseUint(strings.TrimSpace(output), 10, 64)
+	if err != nil {
+		return 0, errors.Annotatef(err, "parsing size of %q", devicePath)
+	}
+	return size / (1024 * 1024), nil
+}
+
 func destroyPartitions(run runCommandFunc, devicePath string) error {
 	logger.Debugf(context.TODO(), "destroying partitions on %q", devicePath)
 	if _, err := run("sgdisk", "--zap-all", devicePath); err != nil {
@@ -5,6 +5,7 @@ package provider_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -109,6 +110,34 @@ func (s *managedfsSuite) TestCreateFilesystems(c *gc.C) {
 	}})
 }
 
+func (s *managedfsSuite) TestCreateFilesystemsFromSnapshot(c *gc.C) {
+	source := s.initSource(c)
+	// No commands are expected; the volume restored from the
+	// snapshot already has a partition and filesystem.
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{
+		DeviceName: "sda",
+		HardwareId: "capncrunch",
+		SizeMiB:    2,
+	}
+	results, err := source.CreateFilesystems(context.Background(), []storage.FilesystemParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		Volume:     names.NewVolumeTag("0"),
+		Size:       2,
+		SnapshotId: "snap-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateFilesystemsResult{{
+		Filesystem: &storage.Filesystem{
+			names.NewFilesystemTag("0/0"),
+			names.NewVolumeTag("0"),
+			storage.FilesystemInfo{
+				FilesystemId: "filesystem-0-0",
+				Size:         2,
+			},
+		},
+	}})
+}
+
 func (s *managedfsSuite) TestCreateFilesystemsNoBlockDevice(c *gc.C) {
 	source := s.initSource(c)
 	results, err := source.CreateFilesystems(context.Background(), []storage.FilesystemParams{{
@@ -120,6 +149,69 @@ func (s *managedfsSuite) TestCreateFilesystemsNoBlockDevice(c *gc.C) {
 	c.Assert(results[0].Error, gc.ErrorMatches, "backing-volume 0 is not yet attached")
 }
 
+func (s *managedfsSuite) TestResizeFilesystems(c *gc.C) {
+	source := s.initSource(c)
+	// sda has its partition grown before the filesystem.
+	s.commands.expect("growpart", "/dev/sda", "1")
+	s.commands.expect("blkid", "-o", "value", "-s", "TYPE", "/dev/sda1").respond("ext4\n", nil)
+	s.commands.expect("resize2fs", "/dev/sda1")
+	s.commands.expect("blockdev", "--getsize64", "/dev/sda1").respond("4194304\n", nil)
+	// xfs filesystems are grown through their mount point.
+	s.commands.expect("blkid", "-o", "value", "-s", "TYPE", "/dev/xvdf1").respond("xfs\n", nil)
+	s.commands.expect("findmnt", "-n", "-o", "TARGET", "--source", "/dev/xvdf1").respond("/srv/data\n", nil)
+	s.commands.expect("xfs_growfs", "/srv/data")
+	s.commands.expect("blockdev", "--getsize64", "/dev/xvdf1").respond("6291456\n", nil)
+
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{DeviceName: "sda"}
+	s.blockDevices[names.NewVolumeTag("1")] = blockdevice.BlockDevice{DeviceName: "xvdf1"}
+	s.filesystems[names.NewFilesystemTag("0/1")] = storage.Filesystem{
+		Tag:    names.NewFilesystemTag("0/1"),
+		Volume: names.NewVolumeTag("1"),
+	}
+	resizer, ok := source.(storage.FilesystemResizer)
+	c.Assert(ok, jc.IsTrue)
+	results, err := resizer.ResizeFilesystems(context.Background(), []storage.FilesystemResizeParams{{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+		Size:   4,
+	}, {
+		Tag:  names.NewFilesystemTag("0/1"),
+		Size: 6,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeFilesystemsResult{{Size: 4}, {Size: 6}})
+}
+
+func (s *managedfsSuite) TestResizeFilesystemsPartitionUnchanged(c *gc.C) {
+	source := s.initSource(c)
+	s.commands.expect("growpart", "/dev/sda", "1").respond(
+		"NOCHANGE: partition 1 could only be grown by 0", errors.New("exit status 1"),
+	)
+	s.commands.expect("blkid", "-o", "value", "-s", "TYPE", "/dev/sda1").respond("ext4\n", nil)
+	s.commands.expect("resize2fs", "/dev/sda1")
+	s.commands.expect("blockdev", "--getsize64", "/dev/sda1").respond("2097152\n", nil)
+
+	s.blockDevices[names.NewVolumeTag("0")] = blockdevice.BlockDevice{DeviceName: "sda"}
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(context.Background(), []storage.FilesystemResizeParams{{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+		Size:   4,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, gc.ErrorMatches, "filesystem 0/0 is 2MiB after growing, need at least 4MiB")
+}
+
+func (s *managedfsSuite) TestResizeFilesystemsNoBlockDevice(c *gc.C) {
+	source := s.initSource(c)
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems(context.Background(), []storage.FilesystemResizeParams{{
+		Tag:    names.NewFilesystemTag("0/0"),
+		Volume: names.NewVolumeTag("0"),
+		Size:   4,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results[0].Error, gc.ErrorMatches, "backing-volume 0 is not yet attached")
+}
+
 const testMountPoint = "/in/the/place"
 
 func mountInfoLine(id, parent int, root, mountPoint, source string) string {
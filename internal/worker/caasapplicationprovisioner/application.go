@@ -109,9 +109,6 @@ func (a *appWorker) loop() error {
 	ctx, cancel := a.scopedContext()
 	defer cancel()
 
-	// TODO(sidecar): support more than statefulset
-	app := a.broker.Application(a.name, caas.DeploymentStateful)
-
 	// If the application no longer exists, return immediately. If it's in
 	// Dead state, ensure it's deleted and terminated.
 	appLife, err := a.facade.Life(ctx, a.name)
@@ -122,6 +119,15 @@ func (a *appWorker) loop() error {
 		return errors.Annotatef(err, "fetching life status for application %q", a.name)
 	}
 	a.life = appLife
+
+	deploymentType, err := a.ops.DeploymentType(ctx, a.name, a.facade)
+	if errors.Is(err, errors.NotFound) {
+		a.logger.Debugf(ctx, "application %q no longer exists", a.name)
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	app := a.broker.Application(a.name, deploymentType)
 	if appLife == life.Dead {
 		if !a.statusOnly {
 			err = a.ops.AppDying(ctx, a.name, app, a.life, a.facade, a.unitFacade, a.logger)
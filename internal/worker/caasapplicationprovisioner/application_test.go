@@ -88,13 +88,11 @@ func (s *ApplicationWorkerSuite) TestLifeNotFound(c *gc.C) {
 	defer ctrl.Finish()
 
 	broker := mocks.NewMockCAASBroker(ctrl)
-	brokerApp := caasmocks.NewMockApplication(ctrl)
 	facade := mocks.NewMockCAASProvisionerFacade(ctrl)
 	ops := mocks.NewMockApplicationOps(ctrl)
 	done := make(chan struct{})
 
 	gomock.InOrder(
-		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(brokerApp),
 		facade.EXPECT().Life(gomock.Any(), "test").DoAndReturn(func(ctx context.Context, appName string) (life.Value, error) {
 			close(done)
 			return "", errors.NotFoundf("test charm")
@@ -120,8 +118,9 @@ func (s *ApplicationWorkerSuite) TestLifeDead(c *gc.C) {
 	done := make(chan struct{})
 
 	gomock.InOrder(
-		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 		facade.EXPECT().Life(gomock.Any(), "test").Return(life.Dead, nil),
+		ops.EXPECT().DeploymentType(gomock.Any(), "test", facade).Return(caas.DeploymentStateful, nil),
+		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 		ops.EXPECT().AppDying(gomock.Any(), "test", app, life.Dead, facade, unitFacade, s.logger).Return(nil),
 		ops.EXPECT().AppDead(gomock.Any(), "test", app, broker, facade, unitFacade, clk, s.logger).
 			DoAndReturn(func(_ context.Context, _ string, _ caas.Application, _ caasapplicationprovisioner.CAASBroker,
@@ -160,8 +159,9 @@ func (s *ApplicationWorkerSuite) TestWorker(c *gc.C) {
 	ops.EXPECT().RefreshApplicationStatus(gomock.Any(), "test", app, gomock.Any(), facade, s.logger).Return(nil).AnyTimes()
 
 	gomock.InOrder(
-		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 		facade.EXPECT().Life(gomock.Any(), "test").Return(life.Alive, nil),
+		ops.EXPECT().DeploymentType(gomock.Any(), "test", facade).Return(caas.DeploymentStateful, nil),
+		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 
 		ops.EXPECT().CheckCharmFormat(gomock.Any(), "test", gomock.Any(), gomock.Any()).Return(true, nil),
 
@@ -263,8 +263,9 @@ func (s *ApplicationWorkerSuite) TestWorkerStatusOnly(c *gc.C) {
 	ops.EXPECT().RefreshApplicationStatus(gomock.Any(), "test", app, gomock.Any(), facade, s.logger).Return(nil).AnyTimes()
 
 	gomock.InOrder(
-		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 		facade.EXPECT().Life(gomock.Any(), "test").Return(life.Alive, nil),
+		ops.EXPECT().DeploymentType(gomock.Any(), "test", facade).Return(caas.DeploymentStateful, nil),
+		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 
 		ops.EXPECT().CheckCharmFormat(gomock.Any(), "test", gomock.Any(), gomock.Any()).Return(true, nil),
 
@@ -338,8 +339,9 @@ func (s *ApplicationWorkerSuite) TestNotProvisionedRetry(c *gc.C) {
 	ops.EXPECT().RefreshApplicationStatus(gomock.Any(), "test", app, gomock.Any(), facade, s.logger).Return(nil).AnyTimes()
 
 	gomock.InOrder(
-		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 		facade.EXPECT().Life(gomock.Any(), "test").Return(life.Alive, nil),
+		ops.EXPECT().DeploymentType(gomock.Any(), "test", facade).Return(caas.DeploymentStateful, nil),
+		broker.EXPECT().Application("test", caas.DeploymentStateful).Return(app),
 
 		ops.EXPECT().CheckCharmFormat(gomock.Any(), "test", gomock.Any(), gomock.Any()).Return(true, nil),
 
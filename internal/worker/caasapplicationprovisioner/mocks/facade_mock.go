@@ -19,6 +19,7 @@ import (
 	resource "github.com/juju/juju/core/resource"
 	status "github.com/juju/juju/core/status"
 	watcher "github.com/juju/juju/core/watcher"
+	charm "github.com/juju/juju/internal/charm"
 	params "github.com/juju/juju/rpc/params"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -85,6 +86,45 @@ func (c *MockCAASProvisionerFacadeApplicationCharmInfoCall) DoAndReturn(f func(c
 	return c
 }
 
+// ApplicationDeploymentType mocks base method.
+func (m *MockCAASProvisionerFacade) ApplicationDeploymentType(arg0 context.Context, arg1 string) (charm.DeploymentType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationDeploymentType", arg0, arg1)
+	ret0, _ := ret[0].(charm.DeploymentType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationDeploymentType indicates an expected call of ApplicationDeploymentType.
+func (mr *MockCAASProvisionerFacadeMockRecorder) ApplicationDeploymentType(arg0, arg1 any) *MockCAASProvisionerFacadeApplicationDeploymentTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationDeploymentType", reflect.TypeOf((*MockCAASProvisionerFacade)(nil).ApplicationDeploymentType), arg0, arg1)
+	return &MockCAASProvisionerFacadeApplicationDeploymentTypeCall{Call: call}
+}
+
+// MockCAASProvisionerFacadeApplicationDeploymentTypeCall wrap *gomock.Call
+type MockCAASProvisionerFacadeApplicationDeploymentTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCAASProvisionerFacadeApplicationDeploymentTypeCall) Return(arg0 charm.DeploymentType, arg1 error) *MockCAASProvisionerFacadeApplicationDeploymentTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCAASProvisionerFacadeApplicationDeploymentTypeCall) Do(f func(context.Context, string) (charm.DeploymentType, error)) *MockCAASProvisionerFacadeApplicationDeploymentTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCAASProvisionerFacadeApplicationDeploymentTypeCall) DoAndReturn(f func(context.Context, string) (charm.DeploymentType, error)) *MockCAASProvisionerFacadeApplicationDeploymentTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ApplicationOCIResources mocks base method.
 func (m *MockCAASProvisionerFacade) ApplicationOCIResources(arg0 context.Context, arg1 string) (map[string]resource.DockerImageDetails, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +198,45 @@ func (c *MockApplicationOpsCheckCharmFormatCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// DeploymentType mocks base method.
+func (m *MockApplicationOps) DeploymentType(arg0 context.Context, arg1 string, arg2 caasapplicationprovisioner.CAASProvisionerFacade) (caas.DeploymentType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeploymentType", arg0, arg1, arg2)
+	ret0, _ := ret[0].(caas.DeploymentType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeploymentType indicates an expected call of DeploymentType.
+func (mr *MockApplicationOpsMockRecorder) DeploymentType(arg0, arg1, arg2 any) *MockApplicationOpsDeploymentTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeploymentType", reflect.TypeOf((*MockApplicationOps)(nil).DeploymentType), arg0, arg1, arg2)
+	return &MockApplicationOpsDeploymentTypeCall{Call: call}
+}
+
+// MockApplicationOpsDeploymentTypeCall wrap *gomock.Call
+type MockApplicationOpsDeploymentTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationOpsDeploymentTypeCall) Return(arg0 caas.DeploymentType, arg1 error) *MockApplicationOpsDeploymentTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationOpsDeploymentTypeCall) Do(f func(context.Context, string, caasapplicationprovisioner.CAASProvisionerFacade) (caas.DeploymentType, error)) *MockApplicationOpsDeploymentTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationOpsDeploymentTypeCall) DoAndReturn(f func(context.Context, string, caasapplicationprovisioner.CAASProvisionerFacade) (caas.DeploymentType, error)) *MockApplicationOpsDeploymentTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // EnsureScale mocks base method.
 func (m *MockApplicationOps) EnsureScale(arg0 context.Context, arg1 string, arg2 caas.Application, arg3 life.Value, arg4 caasapplicationprovisioner.CAASProvisionerFacade, arg5 caasapplicationprovisioner.CAASUnitProvisionerFacade, arg6 logger.Logger) error {
 	m.ctrl.T.Helper()
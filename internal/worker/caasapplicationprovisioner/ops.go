@@ -41,6 +41,9 @@ type ApplicationOps interface {
 	CheckCharmFormat(ctx context.Context, appName string,
 		facade CAASProvisionerFacade, logger logger.Logger) (isOk bool, err error)
 
+	DeploymentType(ctx context.Context, appName string,
+		facade CAASProvisionerFacade) (caas.DeploymentType, error)
+
 	EnsureTrust(ctx context.Context, appName string, app caas.Application,
 		unitFacade CAASUnitProvisionerFacade, logger logger.Logger) error
 
@@ -94,6 +97,12 @@ func (applicationOps) CheckCharmFormat(
 	return checkCharmFormat(ctx, appName, facade, logger)
 }
 
+func (applicationOps) DeploymentType(
+	ctx context.Context, appName string,
+	facade CAASProvisionerFacade) (caas.DeploymentType, error) {
+	return deploymentType(ctx, appName, facade)
+}
+
 func (applicationOps) EnsureTrust(
 	ctx context.Context,
 	appName string, app caas.Application,
@@ -340,6 +349,30 @@ func checkCharmFormat(
 	return false, nil
 }
 
+// deploymentType returns the kind of workload resource used to run the
+// application's units, as set in the application config or declared by the
+// charm. Applications without a deployment type are run as a stateful set.
+func deploymentType(
+	ctx context.Context,
+	appName string,
+	facade CAASProvisionerFacade,
+) (caas.DeploymentType, error) {
+	dt, err := facade.ApplicationDeploymentType(ctx, appName)
+	if err != nil {
+		return "", errors.Annotatef(err, "failed to get deployment type for application %q", appName)
+	}
+	switch dt {
+	case charm.DeploymentDefault, charm.DeploymentStateful:
+		return caas.DeploymentStateful, nil
+	case charm.DeploymentStateless:
+		return caas.DeploymentStateless, nil
+	case charm.DeploymentDaemon:
+		return caas.DeploymentDaemon, nil
+	default:
+		return "", errors.NotValidf("deployment type %q for application %q", dt, appName)
+	}
+}
+
 // ensureTrust updates the applications Trust status on the CAAS broker, giving it
 // access to the k8s api via a service account.
 func ensureTrust(
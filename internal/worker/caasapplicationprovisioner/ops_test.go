@@ -103,6 +103,37 @@ func (s *OpsSuite) TestCheckCharmFormatNotFound(c *gc.C) {
 	c.Assert(isOk, jc.IsFalse)
 }
 
+func (s *OpsSuite) TestDeploymentType(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	facade := mocks.NewMockCAASProvisionerFacade(ctrl)
+
+	for charmType, expected := range map[charm.DeploymentType]caas.DeploymentType{
+		charm.DeploymentDefault:   caas.DeploymentStateful,
+		charm.DeploymentStateful:  caas.DeploymentStateful,
+		charm.DeploymentStateless: caas.DeploymentStateless,
+		charm.DeploymentDaemon:    caas.DeploymentDaemon,
+	} {
+		facade.EXPECT().ApplicationDeploymentType(gomock.Any(), "test").Return(charmType, nil)
+
+		deploymentType, err := caasapplicationprovisioner.AppOps.DeploymentType(context.Background(), "test", facade)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(deploymentType, gc.Equals, expected)
+	}
+}
+
+func (s *OpsSuite) TestDeploymentTypeNotFound(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	facade := mocks.NewMockCAASProvisionerFacade(ctrl)
+	facade.EXPECT().ApplicationDeploymentType(gomock.Any(), "test").Return("", errors.NotFoundf("application test"))
+
+	_, err := caasapplicationprovisioner.AppOps.DeploymentType(context.Background(), "test", facade)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
 func (s *OpsSuite) TestEnsureTrust(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -30,6 +30,7 @@ import (
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/internal/charm"
 	internalworker "github.com/juju/juju/internal/worker"
 	"github.com/juju/juju/rpc/params"
 )
@@ -50,6 +51,7 @@ type CAASProvisionerFacade interface {
 	Life(context.Context, string) (life.Value, error)
 	CharmInfo(context.Context, string) (*charmscommon.CharmInfo, error)
 	ApplicationCharmInfo(context.Context, string) (*charmscommon.CharmInfo, error)
+	ApplicationDeploymentType(context.Context, string) (charm.DeploymentType, error)
 	SetOperatorStatus(ctx context.Context, appName string, status status.Status, message string, data map[string]interface{}) error
 	Units(ctx context.Context, appName string) ([]params.CAASUnit, error)
 	ApplicationOCIResources(ctx context.Context, appName string) (map[string]resource.DockerImageDetails, error)
@@ -0,0 +1,19 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package caasautoscaler provides a worker which scales kubernetes
+// applications according to their autoscale policies.
+//
+// At a regular interval the worker reads the average CPU, memory and custom
+// metric utilisation of the units of each application with a policy, and
+// computes the number of units required to bring each metric back to its
+// target, in the same way as the kubernetes horizontal pod autoscaler:
+//
+//	desired = ceil(units * current / target)
+//
+// The largest result across the metrics is clamped to the policy's minimum and
+// maximum units. Changes within a small tolerance of the target are ignored,
+// and scaling down only happens once the higher recommendations made during
+// the stabilisation window have expired, which stops flapping when the load
+// fluctuates.
+package caasautoscaler
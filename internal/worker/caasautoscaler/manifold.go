@@ -0,0 +1,88 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasautoscaler
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/services"
+)
+
+// ManifoldConfig describes the resources used by the caasautoscaler worker.
+type ManifoldConfig struct {
+	BrokerName         string
+	DomainServicesName string
+
+	Clock     clock.Clock
+	Logger    logger.Logger
+	NewWorker func(Config) (worker.Worker, error)
+}
+
+// Manifold returns a Manifold that encapsulates the caasautoscaler worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.BrokerName,
+			config.DomainServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+// Validate is called by start to check for bad configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.BrokerName == "" {
+		return errors.NotValidf("empty BrokerName")
+	}
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// start is a StartFunc for a Worker manifold.
+func (config ManifoldConfig) start(context context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var broker caas.Broker
+	if err := getter.Get(config.BrokerName, &broker); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var domainServices services.ModelDomainServices
+	if err := getter.Get(config.DomainServicesName, &domainServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := config.NewWorker(Config{
+		ApplicationService:  domainServices.Application(),
+		Broker:              broker,
+		Clock:               config.Clock,
+		Logger:              config.Logger,
+		Interval:            DefaultInterval,
+		StabilisationWindow: DefaultStabilisationWindow,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
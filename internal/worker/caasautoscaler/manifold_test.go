@@ -0,0 +1,162 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasautoscaler_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+	dt "github.com/juju/worker/v4/dependency/testing"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	caasmocks "github.com/juju/juju/caas/mocks"
+	"github.com/juju/juju/core/logger"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/worker/caasautoscaler"
+	"github.com/juju/juju/internal/worker/caasautoscaler/mocks"
+)
+
+type manifoldSuite struct {
+	testing.IsolationSuite
+	testing.Stub
+
+	manifold dependency.Manifold
+	getter   dependency.Getter
+
+	broker         *caasmocks.MockBroker
+	domainServices *mocks.MockModelDomainServices
+	clock          *testclock.Clock
+	logger         logger.Logger
+}
+
+var _ = gc.Suite(&manifoldSuite{})
+
+func (s *manifoldSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.ResetCalls()
+
+	s.clock = testclock.NewClock(time.Time{})
+	s.logger = loggertesting.WrapCheckLog(c)
+}
+
+func (s *manifoldSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.broker = caasmocks.NewMockBroker(ctrl)
+	s.domainServices = mocks.NewMockModelDomainServices(ctrl)
+	s.domainServices.EXPECT().Application().Return(&applicationservice.WatchableService{}).AnyTimes()
+
+	s.getter = s.newGetter(nil)
+	s.manifold = caasautoscaler.Manifold(s.validConfig())
+
+	return ctrl
+}
+
+func (s *manifoldSuite) validConfig() caasautoscaler.ManifoldConfig {
+	return caasautoscaler.ManifoldConfig{
+		BrokerName:         "broker",
+		DomainServicesName: "domain-services",
+		Clock:              s.clock,
+		Logger:             s.logger,
+		NewWorker:          s.newWorker,
+	}
+}
+
+func (s *manifoldSuite) newWorker(config caasautoscaler.Config) (worker.Worker, error) {
+	s.MethodCall(s, "NewWorker", config)
+	if err := s.NextErr(); err != nil {
+		return nil, err
+	}
+	w := worker.NewRunner(worker.RunnerParams{})
+	s.AddCleanup(func(c *gc.C) { workertest.DirtyKill(c, w) })
+	return w, nil
+}
+
+func (s *manifoldSuite) newGetter(overlay map[string]interface{}) dependency.Getter {
+	resources := map[string]interface{}{
+		"broker":          s.broker,
+		"domain-services": s.domainServices,
+	}
+	for k, v := range overlay {
+		resources[k] = v
+	}
+	return dt.StubGetter(resources)
+}
+
+func (s *manifoldSuite) TestValidateConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	config := s.validConfig()
+	config.BrokerName = ""
+	s.checkConfigInvalid(c, config, "empty BrokerName not valid")
+
+	config = s.validConfig()
+	config.DomainServicesName = ""
+	s.checkConfigInvalid(c, config, "empty DomainServicesName not valid")
+
+	config = s.validConfig()
+	config.Clock = nil
+	s.checkConfigInvalid(c, config, "nil Clock not valid")
+
+	config = s.validConfig()
+	config.Logger = nil
+	s.checkConfigInvalid(c, config, "nil Logger not valid")
+
+	config = s.validConfig()
+	config.NewWorker = nil
+	s.checkConfigInvalid(c, config, "nil NewWorker not valid")
+}
+
+func (s *manifoldSuite) checkConfigInvalid(c *gc.C, config caasautoscaler.ManifoldConfig, expect string) {
+	err := config.Validate()
+	c.Check(err, gc.ErrorMatches, expect)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+var expectedInputs = []string{"broker", "domain-services"}
+
+func (s *manifoldSuite) TestInputs(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Assert(s.manifold.Inputs, jc.SameContents, expectedInputs)
+}
+
+func (s *manifoldSuite) TestMissingInputs(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	for _, input := range expectedInputs {
+		getter := s.newGetter(map[string]interface{}{
+			input: dependency.ErrMissing,
+		})
+		_, err := s.manifold.Start(context.Background(), getter)
+		c.Assert(errors.Cause(err), gc.Equals, dependency.ErrMissing)
+	}
+}
+
+func (s *manifoldSuite) TestStart(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	w, err := s.manifold.Start(context.Background(), s.getter)
+	c.Assert(err, jc.ErrorIsNil)
+	workertest.CleanKill(c, w)
+
+	s.CheckCallNames(c, "NewWorker")
+	args := s.Calls()[0].Args
+	c.Assert(args, gc.HasLen, 1)
+	config := args[0].(caasautoscaler.Config)
+	c.Check(config.ApplicationService, gc.NotNil)
+	c.Check(config.Broker, gc.Equals, s.broker)
+	c.Check(config.Clock, gc.Equals, s.clock)
+	c.Check(config.Interval, gc.Equals, caasautoscaler.DefaultInterval)
+	c.Check(config.StabilisationWindow, gc.Equals, caasautoscaler.DefaultStabilisationWindow)
+}
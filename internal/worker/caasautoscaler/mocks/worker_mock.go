@@ -0,0 +1,182 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/caasautoscaler (interfaces: ApplicationService,Broker)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package mocks -destination mocks/worker_mock.go github.com/juju/juju/internal/worker/caasautoscaler ApplicationService,Broker
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	caas "github.com/juju/juju/caas"
+	application "github.com/juju/juju/domain/application"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// ChangeApplicationScale mocks base method.
+func (m *MockApplicationService) ChangeApplicationScale(arg0 context.Context, arg1 string, arg2 int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeApplicationScale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeApplicationScale indicates an expected call of ChangeApplicationScale.
+func (mr *MockApplicationServiceMockRecorder) ChangeApplicationScale(arg0, arg1, arg2 any) *MockApplicationServiceChangeApplicationScaleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeApplicationScale", reflect.TypeOf((*MockApplicationService)(nil).ChangeApplicationScale), arg0, arg1, arg2)
+	return &MockApplicationServiceChangeApplicationScaleCall{Call: call}
+}
+
+// MockApplicationServiceChangeApplicationScaleCall wrap *gomock.Call
+type MockApplicationServiceChangeApplicationScaleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceChangeApplicationScaleCall) Return(arg0 int, arg1 error) *MockApplicationServiceChangeApplicationScaleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceChangeApplicationScaleCall) Do(f func(context.Context, string, int) (int, error)) *MockApplicationServiceChangeApplicationScaleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceChangeApplicationScaleCall) DoAndReturn(f func(context.Context, string, int) (int, error)) *MockApplicationServiceChangeApplicationScaleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAutoscalePolicies mocks base method.
+func (m *MockApplicationService) GetAutoscalePolicies(arg0 context.Context) ([]application.ApplicationAutoscalePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutoscalePolicies", arg0)
+	ret0, _ := ret[0].([]application.ApplicationAutoscalePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutoscalePolicies indicates an expected call of GetAutoscalePolicies.
+func (mr *MockApplicationServiceMockRecorder) GetAutoscalePolicies(arg0 any) *MockApplicationServiceGetAutoscalePoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoscalePolicies", reflect.TypeOf((*MockApplicationService)(nil).GetAutoscalePolicies), arg0)
+	return &MockApplicationServiceGetAutoscalePoliciesCall{Call: call}
+}
+
+// MockApplicationServiceGetAutoscalePoliciesCall wrap *gomock.Call
+type MockApplicationServiceGetAutoscalePoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetAutoscalePoliciesCall) Return(arg0 []application.ApplicationAutoscalePolicy, arg1 error) *MockApplicationServiceGetAutoscalePoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetAutoscalePoliciesCall) Do(f func(context.Context) ([]application.ApplicationAutoscalePolicy, error)) *MockApplicationServiceGetAutoscalePoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetAutoscalePoliciesCall) DoAndReturn(f func(context.Context) ([]application.ApplicationAutoscalePolicy, error)) *MockApplicationServiceGetAutoscalePoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockBroker is a mock of Broker interface.
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker.
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance.
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// ApplicationUtilisation mocks base method.
+func (m *MockBroker) ApplicationUtilisation(arg0 context.Context, arg1, arg2 string) (caas.Utilisation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationUtilisation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(caas.Utilisation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationUtilisation indicates an expected call of ApplicationUtilisation.
+func (mr *MockBrokerMockRecorder) ApplicationUtilisation(arg0, arg1, arg2 any) *MockBrokerApplicationUtilisationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationUtilisation", reflect.TypeOf((*MockBroker)(nil).ApplicationUtilisation), arg0, arg1, arg2)
+	return &MockBrokerApplicationUtilisationCall{Call: call}
+}
+
+// MockBrokerApplicationUtilisationCall wrap *gomock.Call
+type MockBrokerApplicationUtilisationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBrokerApplicationUtilisationCall) Return(arg0 caas.Utilisation, arg1 error) *MockBrokerApplicationUtilisationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBrokerApplicationUtilisationCall) Do(f func(context.Context, string, string) (caas.Utilisation, error)) *MockBrokerApplicationUtilisationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBrokerApplicationUtilisationCall) DoAndReturn(f func(context.Context, string, string) (caas.Utilisation, error)) *MockBrokerApplicationUtilisationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
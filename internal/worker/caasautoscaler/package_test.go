@@ -0,0 +1,17 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasautoscaler_test
+
+import (
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/worker_mock.go github.com/juju/juju/internal/worker/caasautoscaler ApplicationService,Broker
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/services_mock.go github.com/juju/juju/internal/services ModelDomainServices
+
+func TestPackage(t *stdtesting.T) {
+	gc.TestingT(t)
+}
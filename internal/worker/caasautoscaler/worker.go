@@ -0,0 +1,271 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasautoscaler
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+)
+
+const (
+	// DefaultInterval is how often the utilisation of the applications is
+	// checked.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultStabilisationWindow is how long an application must have
+	// consistently needed fewer units before it is scaled down.
+	DefaultStabilisationWindow = 5 * time.Minute
+
+	// tolerance is the fraction by which a metric may differ from its target
+	// without causing a scale change.
+	tolerance = 0.1
+)
+
+// ApplicationService provides access to the autoscale policies and scale of
+// applications.
+type ApplicationService interface {
+	// GetAutoscalePolicies returns the autoscale policies of all alive
+	// applications in the model, along with their current scale.
+	GetAutoscalePolicies(ctx context.Context) ([]application.ApplicationAutoscalePolicy, error)
+
+	// ChangeApplicationScale alters the existing scale by the provided change
+	// amount, returning the new amount.
+	ChangeApplicationScale(ctx context.Context, appName string, scaleChange int) (int, error)
+}
+
+// Broker provides the utilisation of the units of an application.
+type Broker interface {
+	// ApplicationUtilisation returns the average resource utilisation of the
+	// running units of the specified application.
+	ApplicationUtilisation(ctx context.Context, appName string, customMetric string) (caas.Utilisation, error)
+}
+
+// Config defines the operation of the Worker.
+type Config struct {
+	ApplicationService  ApplicationService
+	Broker              Broker
+	Clock               clock.Clock
+	Logger              logger.Logger
+	Interval            time.Duration
+	StabilisationWindow time.Duration
+}
+
+// Validate returns an error if config cannot drive the Worker.
+func (config Config) Validate() error {
+	if config.ApplicationService == nil {
+		return errors.NotValidf("nil ApplicationService")
+	}
+	if config.Broker == nil {
+		return errors.NotValidf("nil Broker")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.Interval <= 0 {
+		return errors.NotValidf("non-positive Interval")
+	}
+	if config.StabilisationWindow < 0 {
+		return errors.NotValidf("negative StabilisationWindow")
+	}
+	return nil
+}
+
+// recommendation is a scale computed for an application at a point in time.
+type recommendation struct {
+	at    time.Time
+	scale int
+}
+
+// Worker scales applications according to their autoscale policies.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+
+	recommendations map[string][]recommendation
+}
+
+// NewWorker returns a caasautoscaler Worker backed by config, or an error.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &Worker{
+		config:          config,
+		recommendations: make(map[string][]recommendation),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() error {
+	ctx, cancel := w.scopedContext()
+	defer cancel()
+
+	timer := w.config.Clock.NewTimer(w.config.Interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.autoscale(ctx); err != nil {
+				return errors.Trace(err)
+			}
+			timer.Reset(w.config.Interval)
+		}
+	}
+}
+
+// autoscale applies the autoscale policy of every application which has one.
+// Failing to scale one application doesn't stop the others from being scaled.
+func (w *Worker) autoscale(ctx context.Context) error {
+	policies, err := w.config.ApplicationService.GetAutoscalePolicies(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting autoscale policies")
+	}
+
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		seen[policy.ApplicationName] = true
+		if err := w.scaleApplication(ctx, policy); err != nil {
+			w.config.Logger.Errorf(ctx, "autoscaling application %q: %v", policy.ApplicationName, err)
+		}
+	}
+	// Forget about applications which no longer have a policy.
+	for appName := range w.recommendations {
+		if !seen[appName] {
+			delete(w.recommendations, appName)
+		}
+	}
+	return nil
+}
+
+func (w *Worker) scaleApplication(ctx context.Context, app application.ApplicationAutoscalePolicy) error {
+	var utilisation caas.Utilisation
+	if app.Scale > 0 {
+		var err error
+		utilisation, err = w.config.Broker.ApplicationUtilisation(ctx, app.ApplicationName, app.Policy.CustomMetric)
+		if errors.Is(err, errors.NotSupported) {
+			// Without metrics the application can still be kept within the
+			// bounds of its policy.
+			w.config.Logger.Debugf(ctx, "metrics for application %q not available: %v", app.ApplicationName, err)
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	now := w.config.Clock.Now()
+	desired := desiredScale(app.Policy, app.Scale, utilisation)
+	scale := w.stabilise(app.ApplicationName, app.Scale, desired, now)
+	if scale == app.Scale {
+		return nil
+	}
+
+	w.config.Logger.Infof(ctx, "scaling application %q from %d to %d units", app.ApplicationName, app.Scale, scale)
+	_, err := w.config.ApplicationService.ChangeApplicationScale(ctx, app.ApplicationName, scale-app.Scale)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		delete(w.recommendations, app.ApplicationName)
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// stabilise records the desired scale of the application and returns the
+// scale to apply. Scaling up happens straight away, but scaling down only
+// goes as low as the highest recommendation made within the stabilisation
+// window.
+func (w *Worker) stabilise(appName string, current, desired int, now time.Time) int {
+	history, ok := w.recommendations[appName]
+	if !ok {
+		// Treat the current scale as recommended now, so that applications
+		// aren't scaled down as soon as the worker starts.
+		history = []recommendation{{at: now, scale: current}}
+	}
+
+	cutoff := now.Add(-w.config.StabilisationWindow)
+	recent := []recommendation{{at: now, scale: desired}}
+	for _, r := range history {
+		if r.at.After(cutoff) {
+			recent = append(recent, r)
+		}
+	}
+	w.recommendations[appName] = recent
+
+	if desired >= current {
+		return desired
+	}
+	scale := desired
+	for _, r := range recent {
+		scale = max(scale, r.scale)
+	}
+	return min(scale, current)
+}
+
+// desiredScale returns the number of units needed for each metric of the
+// policy to meet its target, clamped to the bounds of the policy. If no
+// metric needs a change, the current scale is returned within the bounds.
+func desiredScale(policy application.AutoscalePolicy, current int, utilisation caas.Utilisation) int {
+	desired := current
+	if utilisation.Units > 0 {
+		var proposals []int
+		for _, m := range []struct {
+			value  *float64
+			target float64
+		}{
+			{utilisation.CPUPercent, float64(policy.TargetCPUPercent)},
+			{utilisation.MemoryPercent, float64(policy.TargetMemoryPercent)},
+			{utilisation.CustomMetric, policy.CustomMetricTarget},
+		} {
+			if m.value == nil || m.target <= 0 {
+				continue
+			}
+			ratio := *m.value / m.target
+			if math.Abs(ratio-1) <= tolerance {
+				proposals = append(proposals, current)
+				continue
+			}
+			proposals = append(proposals, int(math.Ceil(float64(utilisation.Units)*ratio)))
+		}
+		if len(proposals) > 0 {
+			desired = proposals[0]
+			for _, p := range proposals[1:] {
+				desired = max(desired, p)
+			}
+		}
+	}
+	return max(policy.MinUnits, min(desired, policy.MaxUnits))
+}
+
+func (w *Worker) scopedContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(w.catacomb.Context(context.Background()))
+}
@@ -0,0 +1,276 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasautoscaler_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/domain/application"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/caasautoscaler"
+	"github.com/juju/juju/internal/worker/caasautoscaler/mocks"
+)
+
+const interval = 30 * time.Second
+
+type workerSuite struct {
+	testing.BaseSuite
+
+	clock              *testclock.Clock
+	applicationService *mocks.MockApplicationService
+	broker             *mocks.MockBroker
+}
+
+var _ = gc.Suite(&workerSuite{})
+
+func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.clock = testclock.NewClock(time.Now())
+	s.applicationService = mocks.NewMockApplicationService(ctrl)
+	s.broker = mocks.NewMockBroker(ctrl)
+	return ctrl
+}
+
+func (s *workerSuite) config(c *gc.C) caasautoscaler.Config {
+	return caasautoscaler.Config{
+		ApplicationService:  s.applicationService,
+		Broker:              s.broker,
+		Clock:               s.clock,
+		Logger:              loggertesting.WrapCheckLog(c),
+		Interval:            interval,
+		StabilisationWindow: time.Minute,
+	}
+}
+
+func (s *workerSuite) TestValidateConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.testValidateConfig(c, func(config *caasautoscaler.Config) {
+		config.ApplicationService = nil
+	}, `nil ApplicationService not valid`)
+
+	s.testValidateConfig(c, func(config *caasautoscaler.Config) {
+		config.Broker = nil
+	}, `nil Broker not valid`)
+
+	s.testValidateConfig(c, func(config *caasautoscaler.Config) {
+		config.Clock = nil
+	}, `nil Clock not valid`)
+
+	s.testValidateConfig(c, func(config *caasautoscaler.Config) {
+		config.Logger = nil
+	}, `nil Logger not valid`)
+
+	s.testValidateConfig(c, func(config *caasautoscaler.Config) {
+		config.Interval = 0
+	}, `non-positive Interval not valid`)
+
+	s.testValidateConfig(c, func(config *caasautoscaler.Config) {
+		config.StabilisationWindow = -time.Second
+	}, `negative StabilisationWindow not valid`)
+}
+
+func (s *workerSuite) testValidateConfig(c *gc.C, f func(*caasautoscaler.Config), expect string) {
+	config := s.config(c)
+	f(&config)
+	c.Check(config.Validate(), gc.ErrorMatches, expect)
+}
+
+func (s *workerSuite) TestScaleUp(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	s.applicationService.EXPECT().GetAutoscalePolicies(gomock.Any()).Return([]application.ApplicationAutoscalePolicy{{
+		ApplicationName: "foo",
+		Scale:           2,
+		Policy: application.AutoscalePolicy{
+			MinUnits:         1,
+			MaxUnits:         5,
+			TargetCPUPercent: 50,
+		},
+	}}, nil)
+	s.broker.EXPECT().ApplicationUtilisation(gomock.Any(), "foo", "").Return(caas.Utilisation{
+		Units:      2,
+		CPUPercent: ptr(100),
+	}, nil)
+	s.applicationService.EXPECT().ChangeApplicationScale(gomock.Any(), "foo", 2).DoAndReturn(
+		func(context.Context, string, int) (int, error) {
+			close(done)
+			return 0, nil
+		})
+
+	w, err := caasautoscaler.NewWorker(s.config(c))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+	s.waitDone(c, done)
+}
+
+func (s *workerSuite) TestScaleDownStabilised(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	policies := []application.ApplicationAutoscalePolicy{{
+		ApplicationName: "foo",
+		Scale:           4,
+		Policy: application.AutoscalePolicy{
+			MinUnits:            1,
+			MaxUnits:            5,
+			TargetMemoryPercent: 50,
+		},
+	}}
+	s.applicationService.EXPECT().GetAutoscalePolicies(gomock.Any()).Return(policies, nil).Times(3)
+	s.broker.EXPECT().ApplicationUtilisation(gomock.Any(), "foo", "").Return(caas.Utilisation{
+		Units:         4,
+		MemoryPercent: ptr(20),
+	}, nil).Times(3)
+
+	done := make(chan struct{})
+	s.applicationService.EXPECT().ChangeApplicationScale(gomock.Any(), "foo", -2).DoAndReturn(
+		func(context.Context, string, int) (int, error) {
+			close(done)
+			return 0, nil
+		})
+
+	w, err := caasautoscaler.NewWorker(s.config(c))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// The application is only scaled down once the current scale has left
+	// the stabilisation window.
+	s.advance(c)
+	s.advance(c)
+	s.advance(c)
+	s.waitDone(c, done)
+}
+
+func (s *workerSuite) TestMetricsNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	s.applicationService.EXPECT().GetAutoscalePolicies(gomock.Any()).Return([]application.ApplicationAutoscalePolicy{{
+		ApplicationName: "foo",
+		Scale:           1,
+		Policy: application.AutoscalePolicy{
+			MinUnits:         2,
+			MaxUnits:         5,
+			TargetCPUPercent: 50,
+		},
+	}}, nil)
+	s.broker.EXPECT().ApplicationUtilisation(gomock.Any(), "foo", "").Return(
+		caas.Utilisation{}, errors.NotSupportedf("metrics API"))
+	// The application is still kept within the bounds of its policy.
+	s.applicationService.EXPECT().ChangeApplicationScale(gomock.Any(), "foo", 1).DoAndReturn(
+		func(context.Context, string, int) (int, error) {
+			close(done)
+			return 0, nil
+		})
+
+	w, err := caasautoscaler.NewWorker(s.config(c))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+	s.waitDone(c, done)
+}
+
+func (s *workerSuite) TestGetPoliciesError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.applicationService.EXPECT().GetAutoscalePolicies(gomock.Any()).Return(nil, errors.New("boom"))
+
+	w, err := caasautoscaler.NewWorker(s.config(c))
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.advance(c)
+	err = workertest.CheckKilled(c, w)
+	c.Assert(err, gc.ErrorMatches, "getting autoscale policies: boom")
+}
+
+func (s *workerSuite) advance(c *gc.C) {
+	err := s.clock.WaitAdvance(interval, testing.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *workerSuite) waitDone(c *gc.C, done <-chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for scale change")
+	}
+}
+
+type desiredScaleSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&desiredScaleSuite{})
+
+func (s *desiredScaleSuite) TestDesiredScale(c *gc.C) {
+	policy := application.AutoscalePolicy{
+		MinUnits:            1,
+		MaxUnits:            10,
+		TargetCPUPercent:    50,
+		TargetMemoryPercent: 80,
+		CustomMetric:        "rps",
+		CustomMetricTarget:  100,
+	}
+	for i, t := range []struct {
+		about       string
+		current     int
+		utilisation caas.Utilisation
+		expected    int
+	}{{
+		about:    "no metrics keeps the current scale",
+		current:  3,
+		expected: 3,
+	}, {
+		about:    "no units scales to the minimum",
+		current:  0,
+		expected: 1,
+	}, {
+		about:       "within tolerance",
+		current:     3,
+		utilisation: caas.Utilisation{Units: 3, CPUPercent: ptr(54)},
+		expected:    3,
+	}, {
+		about:       "cpu above target",
+		current:     3,
+		utilisation: caas.Utilisation{Units: 3, CPUPercent: ptr(75)},
+		expected:    5,
+	}, {
+		about:       "largest proposal wins",
+		current:     2,
+		utilisation: caas.Utilisation{Units: 2, CPUPercent: ptr(25), CustomMetric: ptr(300)},
+		expected:    6,
+	}, {
+		about:       "clamped to maximum",
+		current:     4,
+		utilisation: caas.Utilisation{Units: 4, MemoryPercent: ptr(400)},
+		expected:    10,
+	}, {
+		about:       "below target",
+		current:     4,
+		utilisation: caas.Utilisation{Units: 4, CPUPercent: ptr(10), MemoryPercent: ptr(20)},
+		expected:    1,
+	}} {
+		c.Logf("test %d: %s", i, t.about)
+		c.Check(caasautoscaler.DesiredScale(policy, t.current, t.utilisation), gc.Equals, t.expected)
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -5,6 +5,8 @@ package caasfirewaller
 
 import (
 	"context"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/juju/errors"
@@ -13,9 +15,13 @@ import (
 
 	"github.com/juju/juju/caas"
 	"github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/charm"
 )
 
 type applicationWorker struct {
@@ -25,15 +31,22 @@ type applicationWorker struct {
 	appName        string
 	appUUID        application.ID
 
-	firewallerAPI CAASFirewallerAPI
-	portService   PortService
+	firewallerAPI      CAASFirewallerAPI
+	portService        PortService
+	applicationService ApplicationService
+	relationService    RelationService
+	modelConfigService ModelConfigService
 
-	broker         CAASBroker
-	portMutator    PortMutator
-	serviceUpdater ServiceUpdater
+	broker               CAASBroker
+	portMutator          PortMutator
+	serviceUpdater       ServiceUpdater
+	networkPolicyUpdater NetworkPolicyUpdater
 
-	appWatcher   watcher.NotifyWatcher
-	portsWatcher watcher.NotifyWatcher
+	appWatcher       watcher.NotifyWatcher
+	portsWatcher     watcher.NotifyWatcher
+	exposedWatcher   watcher.NotifyWatcher
+	relationsWatcher watcher.NotifyWatcher
+	configWatcher    watcher.StringsWatcher
 
 	lifeGetter LifeGetter
 
@@ -42,6 +55,11 @@ type applicationWorker struct {
 
 	currentPorts network.GroupedPortRanges
 
+	// currentPolicy is the network policy last applied to the
+	// application, which is nil when there is no restriction.
+	currentPolicy *caas.NetworkPolicy
+	policyApplied bool
+
 	logger logger.Logger
 }
 
@@ -52,21 +70,27 @@ func newApplicationWorker(
 	appUUID application.ID,
 	firewallerAPI CAASFirewallerAPI,
 	portService PortService,
+	applicationService ApplicationService,
+	relationService RelationService,
+	modelConfigService ModelConfigService,
 	broker CAASBroker,
 	lifeGetter LifeGetter,
 	logger logger.Logger,
 ) (worker.Worker, error) {
 	w := &applicationWorker{
-		controllerUUID: controllerUUID,
-		modelUUID:      modelUUID,
-		appName:        appName,
-		appUUID:        appUUID,
-		firewallerAPI:  firewallerAPI,
-		portService:    portService,
-		broker:         broker,
-		lifeGetter:     lifeGetter,
-		initial:        true,
-		logger:         logger,
+		controllerUUID:     controllerUUID,
+		modelUUID:          modelUUID,
+		appName:            appName,
+		appUUID:            appUUID,
+		firewallerAPI:      firewallerAPI,
+		portService:        portService,
+		applicationService: applicationService,
+		relationService:    relationService,
+		modelConfigService: modelConfigService,
+		broker:             broker,
+		lifeGetter:         lifeGetter,
+		initial:            true,
+		logger:             logger,
 	}
 	if err := catacomb.Invoke(catacomb.Plan{
 		Site: &w.catacomb,
@@ -77,6 +101,26 @@ func newApplicationWorker(
 	return w, nil
 }
 
+// deploymentType returns the kind of workload resource the application is
+// deployed with, as set in the application config or declared by the charm,
+// defaulting to a stateful set.
+func (w *applicationWorker) deploymentType(ctx context.Context) (caas.DeploymentType, error) {
+	dt, err := w.firewallerAPI.ApplicationDeploymentType(ctx, w.appName)
+	if err != nil {
+		return "", errors.Annotatef(err, "failed to get deployment type for application %q", w.appName)
+	}
+	switch dt {
+	case charm.DeploymentDefault, charm.DeploymentStateful:
+		return caas.DeploymentStateful, nil
+	case charm.DeploymentStateless:
+		return caas.DeploymentStateless, nil
+	case charm.DeploymentDaemon:
+		return caas.DeploymentDaemon, nil
+	default:
+		return "", errors.NotValidf("deployment type %q for application %q", dt, w.appName)
+	}
+}
+
 // Kill is part of the worker.Worker interface.
 func (w *applicationWorker) Kill() {
 	w.catacomb.Kill(nil)
@@ -104,10 +148,38 @@ func (w *applicationWorker) setUp(ctx context.Context) (err error) {
 		return errors.Trace(err)
 	}
 
-	// TODO(sidecar): support deployment other than statefulset
-	app := w.broker.Application(w.appName, caas.DeploymentStateful)
+	w.exposedWatcher, err = w.applicationService.WatchApplicationExposed(ctx, w.appName)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(w.exposedWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	w.relationsWatcher, err = w.relationService.WatchRelations(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(w.relationsWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	w.configWatcher, err = w.modelConfigService.Watch()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(w.configWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	deploymentType, err := w.deploymentType(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	app := w.broker.Application(w.appName, deploymentType)
 	w.portMutator = app
 	w.serviceUpdater = app
+	w.networkPolicyUpdater = app
 
 	if w.currentPorts, err = w.portService.GetApplicationOpenedPortsByEndpoint(ctx, w.appUUID); err != nil {
 		return errors.Annotatef(err, "failed to get initial openned ports for application")
@@ -155,6 +227,33 @@ func (w *applicationWorker) loop() (err error) {
 			if err := w.onPortChanged(ctx); err != nil {
 				return errors.Trace(err)
 			}
+			if err := w.updateNetworkPolicy(ctx); err != nil {
+				return errors.Trace(err)
+			}
+		case _, ok := <-w.exposedWatcher.Changes():
+			if !ok {
+				return errors.New("exposed watcher closed")
+			}
+			if err := w.updateNetworkPolicy(ctx); err != nil {
+				return errors.Trace(err)
+			}
+		case _, ok := <-w.relationsWatcher.Changes():
+			if !ok {
+				return errors.New("relations watcher closed")
+			}
+			if err := w.updateNetworkPolicy(ctx); err != nil {
+				return errors.Trace(err)
+			}
+		case keys, ok := <-w.configWatcher.Changes():
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+			if w.policyApplied && !networkPolicyConfigChanged(keys) {
+				continue
+			}
+			if err := w.updateNetworkPolicy(ctx); err != nil {
+				return errors.Trace(err)
+			}
 		}
 	}
 }
@@ -231,6 +330,136 @@ func (w *applicationWorker) onApplicationChanged(ctx context.Context) (err error
 	return errors.Trace(unExposeService(w.serviceUpdater))
 }
 
+// networkPolicyConfigChanged returns true if any of the changed model config
+// keys affect the network policy of the application.
+func networkPolicyConfigChanged(keys []string) bool {
+	for _, key := range keys {
+		if key == config.EnableNetworkPoliciesKey || key == config.SAASIngressAllowKey {
+			return true
+		}
+	}
+	return false
+}
+
+// updateNetworkPolicy applies the network policy computed from the relations
+// and expose settings of the application, if it has changed since it was
+// last applied.
+func (w *applicationWorker) updateNetworkPolicy(ctx context.Context) error {
+	policy, err := w.networkPolicy(ctx)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		// The application is being removed, the policy goes with it.
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	if w.policyApplied && reflect.DeepEqual(policy, w.currentPolicy) {
+		return nil
+	}
+
+	w.logger.Debugf(ctx, "updating network policy for app %q, %+v", w.appName, policy)
+	err = w.networkPolicyUpdater.UpdateNetworkPolicy(policy)
+	if errors.Is(err, errors.NotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Annotatef(err, "cannot update network policy for application %q", w.appName)
+	}
+	w.currentPolicy = policy
+	w.policyApplied = true
+	return nil
+}
+
+// networkPolicy returns the network policy for the application. Units of
+// related applications may reach the ports opened on the endpoints of their
+// relations, and the CIDRs the application is exposed to may reach the ports
+// opened on the exposed endpoints. Applications related across models are only
+// reachable from the saas-ingress-allow CIDRs. Spaces have no meaning on
+// Kubernetes, so any spaces the application is exposed to are ignored.
+//
+// A nil policy is returned when network policies are not enabled for the
+// model.
+func (w *applicationWorker) networkPolicy(ctx context.Context) (*caas.NetworkPolicy, error) {
+	cfg, err := w.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !cfg.EnableNetworkPolicies() {
+		return nil, nil
+	}
+
+	policy := &caas.NetworkPolicy{}
+	relations, err := w.relationService.GetAllRelationDetails(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, rel := range relations {
+		if rel.Life == life.Dead || len(rel.Endpoints) != 2 {
+			continue
+		}
+		local, remote := rel.Endpoints[0], rel.Endpoints[1]
+		if remote.ApplicationName == w.appName {
+			local, remote = remote, local
+		}
+		if local.ApplicationName != w.appName {
+			continue
+		}
+		// Most charms don't open ports for the endpoints of their
+		// relations, so without any opened ports the related units may
+		// reach all the ports of the application's containers.
+		ingress := caas.NetworkPolicyIngress{
+			Ports: endpointPorts(w.currentPorts, local.Name),
+		}
+		_, err := w.applicationService.GetApplicationIDByName(ctx, remote.ApplicationName)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			ingress.CIDRs = cfg.SAASIngressAllow()
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		} else {
+			ingress.Applications = []string{remote.ApplicationName}
+		}
+		policy.Ingress = append(policy.Ingress, ingress)
+	}
+
+	exposedEndpoints, err := w.applicationService.GetExposedEndpoints(ctx, w.appName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	endpoints := make([]string, 0, len(exposedEndpoints))
+	for endpoint := range exposedEndpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	for _, endpoint := range endpoints {
+		cidrs := exposedEndpoints[endpoint].ExposeToCIDRs
+		if cidrs.IsEmpty() {
+			continue
+		}
+		ports := endpointPorts(w.currentPorts, endpoint)
+		if len(ports) == 0 {
+			continue
+		}
+		policy.Ingress = append(policy.Ingress, caas.NetworkPolicyIngress{
+			CIDRs: cidrs.SortedValues(),
+			Ports: ports,
+		})
+	}
+	return policy, nil
+}
+
+// endpointPorts returns the port ranges opened for the specified endpoint,
+// including those opened for all endpoints. The wildcard endpoint selects the
+// port ranges opened for any endpoint.
+func endpointPorts(openedPorts network.GroupedPortRanges, endpoint string) []network.PortRange {
+	var ports []network.PortRange
+	if endpoint == network.WildcardEndpoint {
+		ports = openedPorts.UniquePortRanges()
+	} else {
+		ports = append(ports, openedPorts[endpoint]...)
+		ports = append(ports, openedPorts[network.WildcardEndpoint]...)
+	}
+	return network.CombinePortRanges(network.UniquePortRanges(ports)...)
+}
+
 func (w *applicationWorker) scopedContext() (context.Context, context.CancelFunc) {
 	return context.WithCancel(w.catacomb.Context(context.Background()))
 }
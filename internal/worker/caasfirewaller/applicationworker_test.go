@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/juju/collections/set"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/workertest"
@@ -17,9 +18,14 @@ import (
 	caasmocks "github.com/juju/juju/caas/mocks"
 	coreapplication "github.com/juju/juju/core/application"
 	applicationtesting "github.com/juju/juju/core/application/testing"
+	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
+	domainapplication "github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/relation"
+	"github.com/juju/juju/internal/charm"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/caasfirewaller"
@@ -32,17 +38,26 @@ type appWorkerSuite struct {
 	appName string
 	appUUID coreapplication.ID
 
-	firewallerAPI *mocks.MockCAASFirewallerAPI
-	portService   *mocks.MockPortService
-	lifeGetter    *mocks.MockLifeGetter
-	broker        *mocks.MockCAASBroker
-	brokerApp     *caasmocks.MockApplication
+	firewallerAPI      *mocks.MockCAASFirewallerAPI
+	portService        *mocks.MockPortService
+	applicationService *mocks.MockApplicationService
+	relationService    *mocks.MockRelationService
+	modelConfigService *mocks.MockModelConfigService
+	lifeGetter         *mocks.MockLifeGetter
+	broker             *mocks.MockCAASBroker
+	brokerApp          *caasmocks.MockApplication
 
 	applicationChanges chan struct{}
 	portsChanges       chan struct{}
-
-	appsWatcher  watcher.NotifyWatcher
-	portsWatcher watcher.NotifyWatcher
+	exposedChanges     chan struct{}
+	relationsChanges   chan struct{}
+	configChanges      chan []string
+
+	appsWatcher      watcher.NotifyWatcher
+	portsWatcher     watcher.NotifyWatcher
+	exposedWatcher   watcher.NotifyWatcher
+	relationsWatcher watcher.NotifyWatcher
+	configWatcher    watcher.StringsWatcher
 }
 
 var _ = gc.Suite(&appWorkerSuite{})
@@ -54,6 +69,9 @@ func (s *appWorkerSuite) SetUpTest(c *gc.C) {
 	s.appUUID = applicationtesting.GenApplicationUUID(c)
 	s.applicationChanges = make(chan struct{})
 	s.portsChanges = make(chan struct{})
+	s.exposedChanges = make(chan struct{})
+	s.relationsChanges = make(chan struct{})
+	s.configChanges = make(chan []string)
 }
 
 func (s *appWorkerSuite) getController(c *gc.C) *gomock.Controller {
@@ -61,9 +79,15 @@ func (s *appWorkerSuite) getController(c *gc.C) *gomock.Controller {
 
 	s.appsWatcher = watchertest.NewMockNotifyWatcher(s.applicationChanges)
 	s.portsWatcher = watchertest.NewMockNotifyWatcher(s.portsChanges)
+	s.exposedWatcher = watchertest.NewMockNotifyWatcher(s.exposedChanges)
+	s.relationsWatcher = watchertest.NewMockNotifyWatcher(s.relationsChanges)
+	s.configWatcher = watchertest.NewMockStringsWatcher(s.configChanges)
 
 	s.firewallerAPI = mocks.NewMockCAASFirewallerAPI(ctrl)
 	s.portService = mocks.NewMockPortService(ctrl)
+	s.applicationService = mocks.NewMockApplicationService(ctrl)
+	s.relationService = mocks.NewMockRelationService(ctrl)
+	s.modelConfigService = mocks.NewMockModelConfigService(ctrl)
 
 	s.lifeGetter = mocks.NewMockLifeGetter(ctrl)
 	s.broker = mocks.NewMockCAASBroker(ctrl)
@@ -80,6 +104,9 @@ func (s *appWorkerSuite) getWorker(c *gc.C) worker.Worker {
 		s.appUUID,
 		s.firewallerAPI,
 		s.portService,
+		s.applicationService,
+		s.relationService,
+		s.modelConfigService,
 		s.broker,
 		s.lifeGetter,
 		loggertesting.WrapCheckLog(c),
@@ -123,7 +150,11 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 	gomock.InOrder(
 		s.firewallerAPI.EXPECT().WatchApplication(gomock.Any(), s.appName).Return(s.appsWatcher, nil),
 		s.portService.EXPECT().WatchOpenedPortsForApplication(gomock.Any(), s.appUUID).Return(s.portsWatcher, nil),
-		s.broker.EXPECT().Application(s.appName, caas.DeploymentStateful).Return(s.brokerApp),
+		s.applicationService.EXPECT().WatchApplicationExposed(gomock.Any(), s.appName).Return(s.exposedWatcher, nil),
+		s.relationService.EXPECT().WatchRelations(gomock.Any()).Return(s.relationsWatcher, nil),
+		s.modelConfigService.EXPECT().Watch().Return(s.configWatcher, nil),
+		s.firewallerAPI.EXPECT().ApplicationDeploymentType(gomock.Any(), s.appName).Return(charm.DeploymentDaemon, nil),
+		s.broker.EXPECT().Application(s.appName, caas.DeploymentDaemon).Return(s.brokerApp),
 
 		// initial fetch.
 		s.portService.EXPECT().GetApplicationOpenedPortsByEndpoint(gomock.Any(), s.appUUID).Return(network.GroupedPortRanges{}, nil),
@@ -138,6 +169,8 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 				Protocol:   "tcp",
 			},
 		}, false).Return(nil),
+		// Network policies are not enabled, so any policy is removed once.
+		s.brokerApp.EXPECT().UpdateNetworkPolicy(nil).Return(nil),
 
 		// 2nd triggered by port change event, no UpdatePorts because no diff on the portchanges.
 		s.portService.EXPECT().GetApplicationOpenedPortsByEndpoint(gomock.Any(), s.appUUID).Return(gpr1, nil),
@@ -165,6 +198,115 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 		}),
 	)
 
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(testing.ModelConfig(c), nil).AnyTimes()
+
+	w := s.getWorker(c)
+
+	select {
+	case <-done:
+	case <-time.After(testing.ShortWait):
+		c.Errorf("timed out waiting for worker")
+	}
+	workertest.CleanKill(c, w)
+}
+
+func (s *appWorkerSuite) TestNetworkPolicy(c *gc.C) {
+	ctrl := s.getController(c)
+	defer ctrl.Finish()
+
+	done := make(chan struct{})
+	go func() {
+		s.relationsChanges <- struct{}{}
+	}()
+
+	cfg := testing.CustomModelConfig(c, testing.Attrs{
+		"enable-network-policies": true,
+		"saas-ingress-allow":      "10.1.0.0/16",
+	})
+	ports := network.GroupedPortRanges{
+		"": []network.PortRange{
+			network.MustParsePortRange("80/tcp"),
+		},
+		"db": []network.PortRange{
+			network.MustParsePortRange("5432/tcp"),
+		},
+		"admin": []network.PortRange{
+			network.MustParsePortRange("9000/tcp"),
+			network.MustParsePortRange("9001/tcp"),
+		},
+	}
+	relations := []relation.RelationDetailsResult{{
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "postgresql", Relation: charm.Relation{Name: "db", Role: charm.RoleProvider}},
+			{ApplicationName: s.appName, Relation: charm.Relation{Name: "db", Role: charm.RoleRequirer}},
+		},
+	}, {
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: s.appName, Relation: charm.Relation{Name: "db", Role: charm.RoleRequirer}},
+			{ApplicationName: "remote-db", Relation: charm.Relation{Name: "db", Role: charm.RoleProvider}},
+		},
+	}, {
+		// Peer relations allow nothing more than the units already reach.
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: s.appName, Relation: charm.Relation{Name: "peers", Role: charm.RolePeer}},
+		},
+	}, {
+		// Relations between other applications are ignored.
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "postgresql", Relation: charm.Relation{Name: "db", Role: charm.RoleProvider}},
+			{ApplicationName: "app2", Relation: charm.Relation{Name: "db", Role: charm.RoleRequirer}},
+		},
+	}}
+	exposed := map[string]domainapplication.ExposedEndpoint{
+		"admin": {ExposeToCIDRs: set.NewStrings("192.168.0.0/24")},
+		// Spaces have no meaning on Kubernetes.
+		"": {ExposeToSpaceIDs: set.NewStrings("space-1")},
+	}
+
+	gomock.InOrder(
+		s.firewallerAPI.EXPECT().WatchApplication(gomock.Any(), s.appName).Return(s.appsWatcher, nil),
+		s.portService.EXPECT().WatchOpenedPortsForApplication(gomock.Any(), s.appUUID).Return(s.portsWatcher, nil),
+		s.applicationService.EXPECT().WatchApplicationExposed(gomock.Any(), s.appName).Return(s.exposedWatcher, nil),
+		s.relationService.EXPECT().WatchRelations(gomock.Any()).Return(s.relationsWatcher, nil),
+		s.modelConfigService.EXPECT().Watch().Return(s.configWatcher, nil),
+		s.firewallerAPI.EXPECT().ApplicationDeploymentType(gomock.Any(), s.appName).Return(charm.DeploymentStateless, nil),
+		s.broker.EXPECT().Application(s.appName, caas.DeploymentStateless).Return(s.brokerApp),
+		s.portService.EXPECT().GetApplicationOpenedPortsByEndpoint(gomock.Any(), s.appUUID).Return(ports, nil),
+	)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil).Times(2)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(relations, nil).Times(2)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "postgresql").Return(applicationtesting.GenApplicationUUID(c), nil).Times(2)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "remote-db").Return("", applicationerrors.ApplicationNotFound).Times(2)
+	s.applicationService.EXPECT().GetExposedEndpoints(gomock.Any(), s.appName).Return(exposed, nil).Times(2)
+	s.brokerApp.EXPECT().UpdateNetworkPolicy(&caas.NetworkPolicy{
+		Ingress: []caas.NetworkPolicyIngress{{
+			Applications: []string{"postgresql"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("80/tcp"),
+				network.MustParsePortRange("5432/tcp"),
+			},
+		}, {
+			CIDRs: []string{"10.1.0.0/16"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("80/tcp"),
+				network.MustParsePortRange("5432/tcp"),
+			},
+		}, {
+			CIDRs: []string{"192.168.0.0/24"},
+			Ports: []network.PortRange{
+				network.MustParsePortRange("80/tcp"),
+				network.MustParsePortRange("9000-9001/tcp"),
+			},
+		}},
+	}).DoAndReturn(func(*caas.NetworkPolicy) error {
+		close(done)
+		return nil
+	})
+
 	w := s.getWorker(c)
 
 	select {
@@ -172,5 +314,69 @@ func (s *appWorkerSuite) TestWorker(c *gc.C) {
 	case <-time.After(testing.ShortWait):
 		c.Errorf("timed out waiting for worker")
 	}
+	// Nothing has changed, so the policy is not updated again.
+	select {
+	case s.relationsChanges <- struct{}{}:
+	case <-time.After(testing.LongWait):
+		c.Errorf("timed out waiting for worker")
+	}
+	workertest.CleanKill(c, w)
+}
+
+func (s *appWorkerSuite) TestNetworkPolicyRelationWithoutOpenedPorts(c *gc.C) {
+	ctrl := s.getController(c)
+	defer ctrl.Finish()
+
+	done := make(chan struct{})
+
+	cfg := testing.CustomModelConfig(c, testing.Attrs{
+		"enable-network-policies": true,
+	})
+	relations := []relation.RelationDetailsResult{{
+		Life: life.Alive,
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "postgresql", Relation: charm.Relation{Name: "db", Role: charm.RoleProvider}},
+			{ApplicationName: s.appName, Relation: charm.Relation{Name: "db", Role: charm.RoleRequirer}},
+		},
+	}}
+	exposed := map[string]domainapplication.ExposedEndpoint{
+		"": {ExposeToCIDRs: set.NewStrings("0.0.0.0/0")},
+	}
+
+	gomock.InOrder(
+		s.firewallerAPI.EXPECT().WatchApplication(gomock.Any(), s.appName).Return(s.appsWatcher, nil),
+		s.portService.EXPECT().WatchOpenedPortsForApplication(gomock.Any(), s.appUUID).Return(s.portsWatcher, nil),
+		s.applicationService.EXPECT().WatchApplicationExposed(gomock.Any(), s.appName).Return(s.exposedWatcher, nil),
+		s.relationService.EXPECT().WatchRelations(gomock.Any()).Return(s.relationsWatcher, nil),
+		s.modelConfigService.EXPECT().Watch().Return(s.configWatcher, nil),
+		s.firewallerAPI.EXPECT().ApplicationDeploymentType(gomock.Any(), s.appName).Return(charm.DeploymentStateless, nil),
+		s.broker.EXPECT().Application(s.appName, caas.DeploymentStateless).Return(s.brokerApp),
+		s.portService.EXPECT().GetApplicationOpenedPortsByEndpoint(gomock.Any(), s.appUUID).Return(network.GroupedPortRanges{}, nil),
+	)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(relations, nil)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "postgresql").Return(applicationtesting.GenApplicationUUID(c), nil)
+	s.applicationService.EXPECT().GetExposedEndpoints(gomock.Any(), s.appName).Return(exposed, nil)
+	// The related application may reach all the ports of the units, but
+	// exposing the application opens nothing without opened ports.
+	s.brokerApp.EXPECT().UpdateNetworkPolicy(&caas.NetworkPolicy{
+		Ingress: []caas.NetworkPolicyIngress{{
+			Applications: []string{"postgresql"},
+		}},
+	}).DoAndReturn(func(*caas.NetworkPolicy) error {
+		close(done)
+		return nil
+	})
+
+	w := s.getWorker(c)
+	go func() {
+		s.relationsChanges <- struct{}{}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Errorf("timed out waiting for worker")
+	}
 	workertest.CleanKill(c, w)
 }
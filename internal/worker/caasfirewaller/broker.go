@@ -21,3 +21,8 @@ type PortMutator interface {
 type ServiceUpdater interface {
 	UpdateService(caas.ServiceParam) error
 }
+
+// NetworkPolicyUpdater exposes CAAS application functionality to a worker.
+type NetworkPolicyUpdater interface {
+	UpdateNetworkPolicy(*caas.NetworkPolicy) error
+}
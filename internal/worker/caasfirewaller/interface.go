@@ -12,6 +12,10 @@ import (
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/watcher"
+	domainapplication "github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/relation"
+	environsconfig "github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/charm"
 )
 
 // Client provides an interface for interacting with the
@@ -34,6 +38,7 @@ type CAASFirewallerAPI interface {
 	ApplicationConfig(context.Context, string) (config.ConfigAttributes, error)
 
 	ApplicationCharmInfo(ctx context.Context, appName string) (*charmscommon.CharmInfo, error)
+	ApplicationDeploymentType(ctx context.Context, appName string) (charm.DeploymentType, error)
 }
 
 // LifeGetter provides an interface for getting the
@@ -62,4 +67,35 @@ type ApplicationService interface {
 	// GetApplicationIDByName returns a application ID by application name. It
 	// returns an error if the application can not be found by the name.
 	GetApplicationIDByName(ctx context.Context, name string) (application.ID, error)
+
+	// GetExposedEndpoints returns map where keys are endpoint names (or the ""
+	// value which represents all endpoints) and values are ExposedEndpoint
+	// instances that specify which sources (spaces or CIDRs) can access the
+	// opened ports for each endpoint once the application is exposed.
+	GetExposedEndpoints(ctx context.Context, appName string) (map[string]domainapplication.ExposedEndpoint, error)
+
+	// WatchApplicationExposed watches for changes to the specified application's
+	// exposed endpoints.
+	WatchApplicationExposed(ctx context.Context, name string) (watcher.NotifyWatcher, error)
+}
+
+// RelationService provides access to the relation service.
+type RelationService interface {
+	// GetAllRelationDetails return RelationDetailResults for all relations
+	// for the current model.
+	GetAllRelationDetails(ctx context.Context) ([]relation.RelationDetailsResult, error)
+
+	// WatchRelations returns a notify watcher that will signal whenever a
+	// relation in the model is added, removed, or changes life.
+	WatchRelations(ctx context.Context) (watcher.NotifyWatcher, error)
+}
+
+// ModelConfigService provides access to the model configuration.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(ctx context.Context) (*environsconfig.Config, error)
+
+	// Watch returns a watcher that returns keys for any changes to model
+	// config.
+	Watch() (watcher.StringsWatcher, error)
 }
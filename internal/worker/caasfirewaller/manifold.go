@@ -99,6 +99,8 @@ func (config ManifoldConfig) start(context context.Context, getter dependency.Ge
 		FirewallerAPI:      client,
 		PortService:        domainServices.Port(),
 		ApplicationService: domainServices.Application(),
+		RelationService:    domainServices.Relation(),
+		ModelConfigService: domainServices.Config(),
 		LifeGetter:         client,
 		Broker:             broker,
 		Logger:             config.Logger,
@@ -20,7 +20,9 @@ import (
 	caasmocks "github.com/juju/juju/caas/mocks"
 	"github.com/juju/juju/core/logger"
 	applicationservice "github.com/juju/juju/domain/application/service"
+	modelconfigservice "github.com/juju/juju/domain/modelconfig/service"
 	portservice "github.com/juju/juju/domain/port/service"
+	relationservice "github.com/juju/juju/domain/relation/service"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/caasfirewaller"
@@ -60,6 +62,8 @@ func (s *manifoldSuite) setupMocks(c *gc.C) *gomock.Controller {
 	s.domainServices = mocks.NewMockModelDomainServices(ctrl)
 	s.domainServices.EXPECT().Port().Return(nil).AnyTimes()
 	s.domainServices.EXPECT().Application().Return(nil).AnyTimes()
+	s.domainServices.EXPECT().Relation().Return(nil).AnyTimes()
+	s.domainServices.EXPECT().Config().Return(nil).AnyTimes()
 
 	s.getter = s.newGetter(nil)
 	s.manifold = caasfirewaller.Manifold(s.validConfig())
@@ -200,5 +204,7 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 		Logger:             s.logger,
 		PortService:        (*portservice.WatchableService)(nil),
 		ApplicationService: (*applicationservice.WatchableService)(nil),
+		RelationService:    (*relationservice.WatchableService)(nil),
+		ModelConfigService: (*modelconfigservice.WatchableService)(nil),
 	})
 }
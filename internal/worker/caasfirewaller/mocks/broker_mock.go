@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/caasfirewaller (interfaces: CAASBroker,PortMutator,ServiceUpdater)
+// Source: github.com/juju/juju/internal/worker/caasfirewaller (interfaces: CAASBroker,PortMutator,ServiceUpdater,NetworkPolicyUpdater)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/broker_mock.go github.com/juju/juju/internal/worker/caasfirewaller CAASBroker,PortMutator,ServiceUpdater
+//	mockgen -typed -package mocks -destination mocks/broker_mock.go github.com/juju/juju/internal/worker/caasfirewaller CAASBroker,PortMutator,ServiceUpdater,NetworkPolicyUpdater
 //
 
 // Package mocks is a generated GoMock package.
@@ -198,3 +198,64 @@ func (c *MockServiceUpdaterUpdateServiceCall) DoAndReturn(f func(caas.ServicePar
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockNetworkPolicyUpdater is a mock of NetworkPolicyUpdater interface.
+type MockNetworkPolicyUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkPolicyUpdaterMockRecorder
+}
+
+// MockNetworkPolicyUpdaterMockRecorder is the mock recorder for MockNetworkPolicyUpdater.
+type MockNetworkPolicyUpdaterMockRecorder struct {
+	mock *MockNetworkPolicyUpdater
+}
+
+// NewMockNetworkPolicyUpdater creates a new mock instance.
+func NewMockNetworkPolicyUpdater(ctrl *gomock.Controller) *MockNetworkPolicyUpdater {
+	mock := &MockNetworkPolicyUpdater{ctrl: ctrl}
+	mock.recorder = &MockNetworkPolicyUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkPolicyUpdater) EXPECT() *MockNetworkPolicyUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateNetworkPolicy mocks base method.
+func (m *MockNetworkPolicyUpdater) UpdateNetworkPolicy(arg0 *caas.NetworkPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkPolicy", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkPolicy indicates an expected call of UpdateNetworkPolicy.
+func (mr *MockNetworkPolicyUpdaterMockRecorder) UpdateNetworkPolicy(arg0 any) *MockNetworkPolicyUpdaterUpdateNetworkPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkPolicy", reflect.TypeOf((*MockNetworkPolicyUpdater)(nil).UpdateNetworkPolicy), arg0)
+	return &MockNetworkPolicyUpdaterUpdateNetworkPolicyCall{Call: call}
+}
+
+// MockNetworkPolicyUpdaterUpdateNetworkPolicyCall wrap *gomock.Call
+type MockNetworkPolicyUpdaterUpdateNetworkPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkPolicyUpdaterUpdateNetworkPolicyCall) Return(arg0 error) *MockNetworkPolicyUpdaterUpdateNetworkPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkPolicyUpdaterUpdateNetworkPolicyCall) Do(f func(*caas.NetworkPolicy) error) *MockNetworkPolicyUpdaterUpdateNetworkPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkPolicyUpdaterUpdateNetworkPolicyCall) DoAndReturn(f func(*caas.NetworkPolicy) error) *MockNetworkPolicyUpdaterUpdateNetworkPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -17,6 +17,7 @@ import (
 	config "github.com/juju/juju/core/config"
 	life "github.com/juju/juju/core/life"
 	watcher "github.com/juju/juju/core/watcher"
+	charm "github.com/juju/juju/internal/charm"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -121,6 +122,45 @@ func (c *MockClientApplicationConfigCall) DoAndReturn(f func(context.Context, st
 	return c
 }
 
+// ApplicationDeploymentType mocks base method.
+func (m *MockClient) ApplicationDeploymentType(arg0 context.Context, arg1 string) (charm.DeploymentType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationDeploymentType", arg0, arg1)
+	ret0, _ := ret[0].(charm.DeploymentType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationDeploymentType indicates an expected call of ApplicationDeploymentType.
+func (mr *MockClientMockRecorder) ApplicationDeploymentType(arg0, arg1 any) *MockClientApplicationDeploymentTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationDeploymentType", reflect.TypeOf((*MockClient)(nil).ApplicationDeploymentType), arg0, arg1)
+	return &MockClientApplicationDeploymentTypeCall{Call: call}
+}
+
+// MockClientApplicationDeploymentTypeCall wrap *gomock.Call
+type MockClientApplicationDeploymentTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClientApplicationDeploymentTypeCall) Return(arg0 charm.DeploymentType, arg1 error) *MockClientApplicationDeploymentTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClientApplicationDeploymentTypeCall) Do(f func(context.Context, string) (charm.DeploymentType, error)) *MockClientApplicationDeploymentTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClientApplicationDeploymentTypeCall) DoAndReturn(f func(context.Context, string) (charm.DeploymentType, error)) *MockClientApplicationDeploymentTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsExposed mocks base method.
 func (m *MockClient) IsExposed(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -378,6 +418,45 @@ func (c *MockCAASFirewallerAPIApplicationConfigCall) DoAndReturn(f func(context.
 	return c
 }
 
+// ApplicationDeploymentType mocks base method.
+func (m *MockCAASFirewallerAPI) ApplicationDeploymentType(arg0 context.Context, arg1 string) (charm.DeploymentType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationDeploymentType", arg0, arg1)
+	ret0, _ := ret[0].(charm.DeploymentType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationDeploymentType indicates an expected call of ApplicationDeploymentType.
+func (mr *MockCAASFirewallerAPIMockRecorder) ApplicationDeploymentType(arg0, arg1 any) *MockCAASFirewallerAPIApplicationDeploymentTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationDeploymentType", reflect.TypeOf((*MockCAASFirewallerAPI)(nil).ApplicationDeploymentType), arg0, arg1)
+	return &MockCAASFirewallerAPIApplicationDeploymentTypeCall{Call: call}
+}
+
+// MockCAASFirewallerAPIApplicationDeploymentTypeCall wrap *gomock.Call
+type MockCAASFirewallerAPIApplicationDeploymentTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCAASFirewallerAPIApplicationDeploymentTypeCall) Return(arg0 charm.DeploymentType, arg1 error) *MockCAASFirewallerAPIApplicationDeploymentTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCAASFirewallerAPIApplicationDeploymentTypeCall) Do(f func(context.Context, string) (charm.DeploymentType, error)) *MockCAASFirewallerAPIApplicationDeploymentTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCAASFirewallerAPIApplicationDeploymentTypeCall) DoAndReturn(f func(context.Context, string) (charm.DeploymentType, error)) *MockCAASFirewallerAPIApplicationDeploymentTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsExposed mocks base method.
 func (m *MockCAASFirewallerAPI) IsExposed(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/caasfirewaller (interfaces: ApplicationService,PortService)
+// Source: github.com/juju/juju/internal/worker/caasfirewaller (interfaces: ApplicationService,PortService,RelationService,ModelConfigService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/domain_mocks.go github.com/juju/juju/internal/worker/caasfirewaller ApplicationService,PortService
+//	mockgen -typed -package mocks -destination mocks/domain_mocks.go github.com/juju/juju/internal/worker/caasfirewaller ApplicationService,PortService,RelationService,ModelConfigService
 //
 
 // Package mocks is a generated GoMock package.
@@ -16,6 +16,9 @@ import (
 	application "github.com/juju/juju/core/application"
 	network "github.com/juju/juju/core/network"
 	watcher "github.com/juju/juju/core/watcher"
+	application0 "github.com/juju/juju/domain/application"
+	relation "github.com/juju/juju/domain/relation"
+	config "github.com/juju/juju/environs/config"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -81,6 +84,84 @@ func (c *MockApplicationServiceGetApplicationIDByNameCall) DoAndReturn(f func(co
 	return c
 }
 
+// GetExposedEndpoints mocks base method.
+func (m *MockApplicationService) GetExposedEndpoints(arg0 context.Context, arg1 string) (map[string]application0.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExposedEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(map[string]application0.ExposedEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExposedEndpoints indicates an expected call of GetExposedEndpoints.
+func (mr *MockApplicationServiceMockRecorder) GetExposedEndpoints(arg0, arg1 any) *MockApplicationServiceGetExposedEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedEndpoints", reflect.TypeOf((*MockApplicationService)(nil).GetExposedEndpoints), arg0, arg1)
+	return &MockApplicationServiceGetExposedEndpointsCall{Call: call}
+}
+
+// MockApplicationServiceGetExposedEndpointsCall wrap *gomock.Call
+type MockApplicationServiceGetExposedEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetExposedEndpointsCall) Return(arg0 map[string]application0.ExposedEndpoint, arg1 error) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetExposedEndpointsCall) Do(f func(context.Context, string) (map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetExposedEndpointsCall) DoAndReturn(f func(context.Context, string) (map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchApplicationExposed mocks base method.
+func (m *MockApplicationService) WatchApplicationExposed(arg0 context.Context, arg1 string) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchApplicationExposed", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchApplicationExposed indicates an expected call of WatchApplicationExposed.
+func (mr *MockApplicationServiceMockRecorder) WatchApplicationExposed(arg0, arg1 any) *MockApplicationServiceWatchApplicationExposedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchApplicationExposed", reflect.TypeOf((*MockApplicationService)(nil).WatchApplicationExposed), arg0, arg1)
+	return &MockApplicationServiceWatchApplicationExposedCall{Call: call}
+}
+
+// MockApplicationServiceWatchApplicationExposedCall wrap *gomock.Call
+type MockApplicationServiceWatchApplicationExposedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceWatchApplicationExposedCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockApplicationServiceWatchApplicationExposedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceWatchApplicationExposedCall) Do(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchApplicationExposedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceWatchApplicationExposedCall) DoAndReturn(f func(context.Context, string) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchApplicationExposedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockPortService is a mock of PortService interface.
 type MockPortService struct {
 	ctrl     *gomock.Controller
@@ -181,3 +262,205 @@ func (c *MockPortServiceWatchOpenedPortsForApplicationCall) DoAndReturn(f func(c
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockRelationService is a mock of RelationService interface.
+type MockRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelationServiceMockRecorder
+}
+
+// MockRelationServiceMockRecorder is the mock recorder for MockRelationService.
+type MockRelationServiceMockRecorder struct {
+	mock *MockRelationService
+}
+
+// NewMockRelationService creates a new mock instance.
+func NewMockRelationService(ctrl *gomock.Controller) *MockRelationService {
+	mock := &MockRelationService{ctrl: ctrl}
+	mock.recorder = &MockRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelationService) EXPECT() *MockRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllRelationDetails mocks base method.
+func (m *MockRelationService) GetAllRelationDetails(arg0 context.Context) ([]relation.RelationDetailsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRelationDetails", arg0)
+	ret0, _ := ret[0].([]relation.RelationDetailsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRelationDetails indicates an expected call of GetAllRelationDetails.
+func (mr *MockRelationServiceMockRecorder) GetAllRelationDetails(arg0 any) *MockRelationServiceGetAllRelationDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRelationDetails", reflect.TypeOf((*MockRelationService)(nil).GetAllRelationDetails), arg0)
+	return &MockRelationServiceGetAllRelationDetailsCall{Call: call}
+}
+
+// MockRelationServiceGetAllRelationDetailsCall wrap *gomock.Call
+type MockRelationServiceGetAllRelationDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetAllRelationDetailsCall) Return(arg0 []relation.RelationDetailsResult, arg1 error) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetAllRelationDetailsCall) Do(f func(context.Context) ([]relation.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetAllRelationDetailsCall) DoAndReturn(f func(context.Context) ([]relation.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchRelations mocks base method.
+func (m *MockRelationService) WatchRelations(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRelations", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchRelations indicates an expected call of WatchRelations.
+func (mr *MockRelationServiceMockRecorder) WatchRelations(arg0 any) *MockRelationServiceWatchRelationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRelations", reflect.TypeOf((*MockRelationService)(nil).WatchRelations), arg0)
+	return &MockRelationServiceWatchRelationsCall{Call: call}
+}
+
+// MockRelationServiceWatchRelationsCall wrap *gomock.Call
+type MockRelationServiceWatchRelationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceWatchRelationsCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockRelationServiceWatchRelationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceWatchRelationsCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockRelationServiceWatchRelationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceWatchRelationsCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockRelationServiceWatchRelationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Watch mocks base method.
+func (m *MockModelConfigService) Watch() (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch")
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockModelConfigServiceMockRecorder) Watch() *MockModelConfigServiceWatchCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockModelConfigService)(nil).Watch))
+	return &MockModelConfigServiceWatchCall{Call: call}
+}
+
+// MockModelConfigServiceWatchCall wrap *gomock.Call
+type MockModelConfigServiceWatchCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceWatchCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceWatchCall) Do(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceWatchCall) DoAndReturn(f func() (watcher.Watcher[[]string], error)) *MockModelConfigServiceWatchCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
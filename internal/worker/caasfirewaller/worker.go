@@ -24,6 +24,8 @@ type Config struct {
 	LifeGetter         LifeGetter
 	PortService        PortService
 	ApplicationService ApplicationService
+	RelationService    RelationService
+	ModelConfigService ModelConfigService
 	Broker             CAASBroker
 	Logger             logger.Logger
 }
@@ -48,6 +50,12 @@ func (config Config) Validate() error {
 	if config.ApplicationService == nil {
 		return errors.NotValidf("missing ApplicationService")
 	}
+	if config.RelationService == nil {
+		return errors.NotValidf("missing RelationService")
+	}
+	if config.ModelConfigService == nil {
+		return errors.NotValidf("missing ModelConfigService")
+	}
 	if config.LifeGetter == nil {
 		return errors.NotValidf("missing LifeGetter")
 	}
@@ -85,6 +93,9 @@ type applicationWorkerCreator func(
 	appUUID application.ID,
 	firewallerAPI CAASFirewallerAPI,
 	portService PortService,
+	applicationService ApplicationService,
+	relationService RelationService,
+	modelConfigService ModelConfigService,
 	broker CAASBroker,
 	lifeGetter LifeGetter,
 	logger logger.Logger,
@@ -174,6 +185,9 @@ func (p *firewaller) loop() error {
 					appUUID,
 					p.config.FirewallerAPI,
 					p.config.PortService,
+					p.config.ApplicationService,
+					p.config.RelationService,
+					p.config.ModelConfigService,
 					p.config.Broker,
 					p.config.LifeGetter,
 					logger,
@@ -36,6 +36,8 @@ type workerSuite struct {
 	firewallerAPI      *mocks.MockCAASFirewallerAPI
 	applicationService *mocks.MockApplicationService
 	portService        *mocks.MockPortService
+	relationService    *mocks.MockRelationService
+	modelConfigService *mocks.MockModelConfigService
 	lifeGetter         *mocks.MockLifeGetter
 	broker             *mocks.MockCAASBroker
 
@@ -64,6 +66,14 @@ func (s *workerSuite) TestValidateConfig(c *gc.C) {
 		config.Broker = nil
 	}, `missing Broker not valid`)
 
+	s.testValidateConfig(c, func(config *caasfirewaller.Config) {
+		config.RelationService = nil
+	}, `missing RelationService not valid`)
+
+	s.testValidateConfig(c, func(config *caasfirewaller.Config) {
+		config.ModelConfigService = nil
+	}, `missing ModelConfigService not valid`)
+
 	s.testValidateConfig(c, func(config *caasfirewaller.Config) {
 		config.LifeGetter = nil
 	}, `missing LifeGetter not valid`)
@@ -111,6 +121,9 @@ func (s *workerSuite) TestStartStop(c *gc.C) {
 		appUUID coreapplication.ID,
 		firewallerAPI caasfirewaller.CAASFirewallerAPI,
 		portService caasfirewaller.PortService,
+		applicationService caasfirewaller.ApplicationService,
+		relationService caasfirewaller.RelationService,
+		modelConfigService caasfirewaller.ModelConfigService,
 		broker caasfirewaller.CAASBroker,
 		lifeGetter caasfirewaller.LifeGetter,
 		logger logger.Logger,
@@ -279,6 +292,8 @@ func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	s.applicationService = mocks.NewMockApplicationService(ctrl)
 	s.portService = mocks.NewMockPortService(ctrl)
+	s.relationService = mocks.NewMockRelationService(ctrl)
+	s.modelConfigService = mocks.NewMockModelConfigService(ctrl)
 
 	s.lifeGetter = mocks.NewMockLifeGetter(ctrl)
 	s.broker = mocks.NewMockCAASBroker(ctrl)
@@ -289,6 +304,8 @@ func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
 		FirewallerAPI:      s.firewallerAPI,
 		ApplicationService: s.applicationService,
 		PortService:        s.portService,
+		RelationService:    s.relationService,
+		ModelConfigService: s.modelConfigService,
 		Broker:             s.broker,
 		LifeGetter:         s.lifeGetter,
 		Logger:             loggertesting.WrapCheckLog(c),
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/worker/v4"
@@ -35,6 +36,10 @@ var _ Provisioner = (*environProvisioner)(nil)
 var (
 	retryStrategyDelay = 10 * time.Second
 	retryStrategyCount = 10
+
+	// warmPoolRefillInterval is how often the model's warm pool is
+	// topped up while one is configured.
+	warmPoolRefillInterval = time.Minute
 )
 
 // Provisioner represents a running provisioner worker.
@@ -58,9 +63,12 @@ type ControllerAPI interface {
 type MachinesAPI interface {
 	Machines(context.Context, ...names.MachineTag) ([]apiprovisioner.MachineResult, error)
 	MachinesWithTransientErrors(context.Context) ([]apiprovisioner.MachineStatusResult, error)
+	MachinesWithInterruptedInstances(context.Context) ([]apiprovisioner.MachineStatusResult, error)
 	WatchMachineErrorRetry(context.Context) (watcher.NotifyWatcher, error)
+	WatchInterruptedInstances(context.Context) (watcher.NotifyWatcher, error)
 	WatchModelMachines(context.Context) (watcher.StringsWatcher, error)
 	ProvisioningInfo(_ context.Context, machineTags []names.MachineTag) (params.ProvisioningInfoResults, error)
+	EnsureWarmPool(context.Context) (params.WarmPoolResult, error)
 }
 
 // Environ describes the methods for provisioning instances.
@@ -93,6 +101,7 @@ type environProvisioner struct {
 	machinesAPI             MachinesAPI
 	agentConfig             agent.Config
 	logger                  logger.Logger
+	clock                   clock.Clock
 	broker                  environs.InstanceBroker
 	distributionGroupFinder DistributionGroupFinder
 	toolsFinder             ToolsFinder
@@ -144,6 +153,11 @@ func (p *environProvisioner) getStartTask(ctx context.Context, harvestMode confi
 	if err != nil && !errors.Is(err, errors.NotImplemented) {
 		return nil, err
 	}
+	// Older controllers cannot replace interrupted instances.
+	interruptionWatcher, err := p.machinesAPI.WatchInterruptedInstances(ctx)
+	if err != nil && !errors.Is(err, errors.NotSupported) {
+		return nil, err
+	}
 	hostTag := p.agentConfig.Tag()
 	if kind := hostTag.Kind(); kind != names.ControllerAgentTagKind && kind != names.MachineTagKind {
 		return nil, errors.Errorf("agent's tag is not a machine or controller agent tag, got %T", hostTag)
@@ -171,6 +185,7 @@ func (p *environProvisioner) getStartTask(ctx context.Context, harvestMode confi
 		ToolsFinder:                  p.toolsFinder,
 		MachineWatcher:               machineWatcher,
 		RetryWatcher:                 retryWatcher,
+		InterruptionWatcher:          interruptionWatcher,
 		Broker:                       p.broker,
 		ImageStream:                  modelCfg.ImageStream(),
 		RetryStartInstanceStrategy: provisionertask.RetryStrategy{
@@ -261,6 +276,7 @@ func NewEnvironProvisioner(
 	distributionGroupFinder DistributionGroupFinder,
 	agentConfig agent.Config,
 	logger logger.Logger,
+	clock clock.Clock,
 	environ Environ,
 ) (Provisioner, error) {
 	if logger == nil {
@@ -269,6 +285,7 @@ func NewEnvironProvisioner(
 	p := &environProvisioner{
 		agentConfig:             agentConfig,
 		logger:                  logger,
+		clock:                   clock,
 		controllerAPI:           controllerAPI,
 		machineService:          machineService,
 		machinesAPI:             machinesAPI,
@@ -322,10 +339,22 @@ func (p *environProvisioner) loop() error {
 		return errors.Trace(err)
 	}
 
+	// The warm pool is only maintained while configured, and once more
+	// after it is no longer configured so that its machines are removed.
+	var warmPoolRefill <-chan time.Time
+	warmPool := len(modelConfig.WarmPool()) > 0
+	if warmPool {
+		p.ensureWarmPool(ctx)
+		warmPoolRefill = p.clock.After(warmPoolRefillInterval)
+	}
+
 	for {
 		select {
 		case <-p.catacomb.Dying():
 			return p.catacomb.ErrDying()
+		case <-warmPoolRefill:
+			p.ensureWarmPool(ctx)
+			warmPoolRefill = p.clock.After(warmPoolRefillInterval)
 		case _, ok := <-modelConfigChanges:
 			if !ok {
 				return errors.New("model configuration watcher closed")
@@ -338,12 +367,43 @@ func (p *environProvisioner) loop() error {
 			if err := p.setConfig(ctx, modelConfig); err != nil {
 				return errors.Annotate(err, "loaded invalid model configuration")
 			}
+
+			wasWarmPool := warmPool
+			warmPool = len(modelConfig.WarmPool()) > 0
+			if warmPool || wasWarmPool {
+				p.ensureWarmPool(ctx)
+			}
+			warmPoolRefill = nil
+			if warmPool {
+				warmPoolRefill = p.clock.After(warmPoolRefillInterval)
+			}
+
 			task.SetHarvestMode(modelConfig.ProvisionerHarvestMode())
 			task.SetNumProvisionWorkers(modelConfig.NumProvisionWorkers())
 		}
 	}
 }
 
+// ensureWarmPool asks the controller to bring the model's warm pool in
+// line with the model config. Failures are logged rather than stopping
+// the provisioner, as they are retried at the next refill.
+func (p *environProvisioner) ensureWarmPool(ctx context.Context) {
+	result, err := p.machinesAPI.EnsureWarmPool(ctx)
+	if errors.Is(err, errors.NotSupported) {
+		p.logger.Warningf(ctx, "cannot maintain warm pool: %v", err)
+		return
+	} else if err != nil {
+		p.logger.Errorf(ctx, "cannot maintain warm pool: %v", err)
+		return
+	}
+	if len(result.Added) > 0 {
+		p.logger.Infof(ctx, "added machines %v to the warm pool", result.Added)
+	}
+	if len(result.Removed) > 0 {
+		p.logger.Infof(ctx, "removed surplus machines %v from the warm pool", result.Removed)
+	}
+}
+
 func (p *environProvisioner) getMachineWatcher(ctx context.Context) (watcher.StringsWatcher, error) {
 	return p.machinesAPI.WatchModelMachines(ctx)
 }
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	jujutesting "github.com/juju/testing"
@@ -53,6 +54,7 @@ type CommonProvisionerSuite struct {
 	machineService *MockMachineService
 	machinesAPI    *MockMachinesAPI
 	broker         *environmocks.MockEnviron
+	clock          *testclock.Clock
 
 	modelConfigCh chan struct{}
 	machinesCh    chan []string
@@ -66,6 +68,7 @@ func (s *CommonProvisionerSuite) setUpMocks(c *gc.C) *gomock.Controller {
 	s.machinesAPI = NewMockMachinesAPI(ctrl)
 	s.machineService = NewMockMachineService(ctrl)
 	s.broker = environmocks.NewMockEnviron(ctrl)
+	s.clock = testclock.NewClock(time.Now())
 	s.expectAuth()
 	s.expectStartup(c)
 	return ctrl
@@ -229,6 +232,9 @@ func (s *CommonProvisionerSuite) expectMachinesWatcher() {
 
 	rw := watchertest.NewMockNotifyWatcher(make(chan struct{}))
 	s.machinesAPI.EXPECT().WatchMachineErrorRetry(gomock.Any()).Return(rw, nil)
+
+	iw := watchertest.NewMockNotifyWatcher(make(chan struct{}))
+	s.machinesAPI.EXPECT().WatchInterruptedInstances(gomock.Any()).Return(iw, nil)
 }
 
 func (s *CommonProvisionerSuite) newEnvironProvisioner(c *gc.C) computeprovisioner.Provisioner {
@@ -258,6 +264,7 @@ func (s *CommonProvisionerSuite) newEnvironProvisioner(c *gc.C) computeprovision
 		&mockDistributionGroupFinder{},
 		agentConfig,
 		loggertesting.WrapCheckLog(c),
+		s.clock,
 		s.broker)
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -384,6 +391,76 @@ func (s *ProvisionerSuite) TestMachineStartedAndStopped(c *gc.C) {
 	s.waitForRemovalMark(c, m666)
 }
 
+func (s *ProvisionerSuite) sendWarmPoolConfig(c *gc.C, warmPool string) {
+	attrs := coretesting.FakeConfig().Merge(coretesting.Attrs{
+		config.ProvisionerHarvestModeKey: config.HarvestDestroyed.String(),
+		config.WarmPoolKey:               warmPool,
+	})
+	modelCfg, err := config.New(config.UseDefaults, attrs)
+	c.Assert(err, jc.ErrorIsNil)
+	s.controllerAPI.EXPECT().ModelConfig(gomock.Any()).Return(modelCfg, nil)
+	s.broker.EXPECT().SetConfig(gomock.Any(), modelCfg).Return(nil)
+	s.sendModelConfigChange(c)
+}
+
+func (s *ProvisionerSuite) expectEnsureWarmPool(ensured chan<- struct{}) *MockMachinesAPIEnsureWarmPoolCall {
+	return s.machinesAPI.EXPECT().EnsureWarmPool(gomock.Any()).DoAndReturn(func(context.Context) (params.WarmPoolResult, error) {
+		select {
+		case ensured <- struct{}{}:
+		default:
+		}
+		return params.WarmPoolResult{Added: []string{"1"}}, nil
+	})
+}
+
+func (s *ProvisionerSuite) waitForEnsureWarmPool(c *gc.C, ensured <-chan struct{}) {
+	select {
+	case <-ensured:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for the warm pool to be ensured")
+	}
+}
+
+func (s *ProvisionerSuite) TestWarmPoolEnsuredOnConfigChange(c *gc.C) {
+	ctrl := s.setUpMocks(c)
+	defer ctrl.Finish()
+
+	p := s.newEnvironProvisioner(c)
+	defer workertest.CleanKill(c, p)
+
+	ensured := make(chan struct{}, 1)
+	s.expectEnsureWarmPool(ensured).Times(2)
+
+	// The pool is filled once configured...
+	s.sendWarmPoolConfig(c, "2:mem=4G")
+	s.waitForEnsureWarmPool(c, ensured)
+
+	// ...and drained once it is no longer configured.
+	s.sendWarmPoolConfig(c, "")
+	s.waitForEnsureWarmPool(c, ensured)
+}
+
+func (s *ProvisionerSuite) TestWarmPoolRefilled(c *gc.C) {
+	ctrl := s.setUpMocks(c)
+	defer ctrl.Finish()
+
+	p := s.newEnvironProvisioner(c)
+	defer workertest.CleanKill(c, p)
+
+	ensured := make(chan struct{}, 1)
+	s.expectEnsureWarmPool(ensured).Times(3)
+
+	s.sendWarmPoolConfig(c, "2")
+	s.waitForEnsureWarmPool(c, ensured)
+
+	// The pool is topped up each refill interval.
+	for i := 0; i < 2; i++ {
+		err := s.clock.WaitAdvance(computeprovisioner.WarmPoolRefillInterval, coretesting.LongWait, 1)
+		c.Assert(err, jc.ErrorIsNil)
+		s.waitForEnsureWarmPool(c, ensured)
+	}
+}
+
 func (s *ProvisionerSuite) TestEnvironProvisionerObservesConfigChanges(c *gc.C) {
 	ctrl := s.setUpMocks(c)
 	defer ctrl.Finish()
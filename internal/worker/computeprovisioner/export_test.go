@@ -16,3 +16,5 @@ func SetObserver(p Provisioner, observer chan<- *config.Config) {
 	configObserver.observer = observer
 	configObserver.Unlock()
 }
+
+var WarmPoolRefillInterval = warmPoolRefillInterval
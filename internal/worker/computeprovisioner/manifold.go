@@ -6,6 +6,7 @@ package computeprovisioner
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
@@ -54,8 +55,9 @@ type ManifoldConfig struct {
 	DomainServicesName string
 	GetMachineService  GetMachineServiceFunc
 	Logger             logger.Logger
+	Clock              clock.Clock
 
-	NewProvisionerFunc func(ControllerAPI, MachineService, MachinesAPI, ToolsFinder, DistributionGroupFinder, agent.Config, logger.Logger, Environ) (Provisioner, error)
+	NewProvisionerFunc func(ControllerAPI, MachineService, MachinesAPI, ToolsFinder, DistributionGroupFinder, agent.Config, logger.Logger, clock.Clock, Environ) (Provisioner, error)
 }
 
 // Manifold creates a manifold that runs an environment provisioner. See the
@@ -95,7 +97,7 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				return nil, errors.Trace(err)
 			}
 
-			w, err := config.NewProvisionerFunc(api, machineService, api, api, api, agentConfig, config.Logger, environ)
+			w, err := config.NewProvisionerFunc(api, machineService, api, api, api, agentConfig, config.Logger, config.Clock, environ)
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
@@ -124,6 +126,9 @@ func (config ManifoldConfig) Validate() error {
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
 	if config.NewProvisionerFunc == nil {
 		return errors.NotValidf("nil NewProvisionerFunc")
 	}
@@ -6,6 +6,7 @@ package computeprovisioner_test
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -31,7 +32,7 @@ var _ = gc.Suite(&ManifoldSuite{})
 
 func (s *ManifoldSuite) makeManifold(c *gc.C) dependency.Manifold {
 	fakeNewProvFunc := func(computeprovisioner.ControllerAPI, computeprovisioner.MachineService, computeprovisioner.MachinesAPI, computeprovisioner.ToolsFinder,
-		computeprovisioner.DistributionGroupFinder, agent.Config, logger.Logger, computeprovisioner.Environ,
+		computeprovisioner.DistributionGroupFinder, agent.Config, logger.Logger, clock.Clock, computeprovisioner.Environ,
 	) (computeprovisioner.Provisioner, error) {
 		s.stub.AddCall("NewProvisionerFunc")
 		return struct{ computeprovisioner.Provisioner }{}, nil
@@ -46,6 +47,7 @@ func (s *ManifoldSuite) makeManifold(c *gc.C) dependency.Manifold {
 		AgentName:          "agent",
 		APICallerName:      "api-caller",
 		Logger:             loggertesting.WrapCheckLog(c),
+		Clock:              clock.WallClock,
 		EnvironName:        "environ",
 		DomainServicesName: "fake-domain-services",
 		GetMachineService:  fakeGetMachineServiceFunc,
@@ -304,6 +304,45 @@ func (m *MockMachinesAPI) EXPECT() *MockMachinesAPIMockRecorder {
 	return m.recorder
 }
 
+// EnsureWarmPool mocks base method.
+func (m *MockMachinesAPI) EnsureWarmPool(arg0 context.Context) (params.WarmPoolResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureWarmPool", arg0)
+	ret0, _ := ret[0].(params.WarmPoolResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureWarmPool indicates an expected call of EnsureWarmPool.
+func (mr *MockMachinesAPIMockRecorder) EnsureWarmPool(arg0 any) *MockMachinesAPIEnsureWarmPoolCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureWarmPool", reflect.TypeOf((*MockMachinesAPI)(nil).EnsureWarmPool), arg0)
+	return &MockMachinesAPIEnsureWarmPoolCall{Call: call}
+}
+
+// MockMachinesAPIEnsureWarmPoolCall wrap *gomock.Call
+type MockMachinesAPIEnsureWarmPoolCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachinesAPIEnsureWarmPoolCall) Return(arg0 params.WarmPoolResult, arg1 error) *MockMachinesAPIEnsureWarmPoolCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachinesAPIEnsureWarmPoolCall) Do(f func(context.Context) (params.WarmPoolResult, error)) *MockMachinesAPIEnsureWarmPoolCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachinesAPIEnsureWarmPoolCall) DoAndReturn(f func(context.Context) (params.WarmPoolResult, error)) *MockMachinesAPIEnsureWarmPoolCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Machines mocks base method.
 func (m *MockMachinesAPI) Machines(arg0 context.Context, arg1 ...names.MachineTag) ([]provisioner.MachineResult, error) {
 	m.ctrl.T.Helper()
@@ -348,6 +387,45 @@ func (c *MockMachinesAPIMachinesCall) DoAndReturn(f func(context.Context, ...nam
 	return c
 }
 
+// MachinesWithInterruptedInstances mocks base method.
+func (m *MockMachinesAPI) MachinesWithInterruptedInstances(arg0 context.Context) ([]provisioner.MachineStatusResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MachinesWithInterruptedInstances", arg0)
+	ret0, _ := ret[0].([]provisioner.MachineStatusResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MachinesWithInterruptedInstances indicates an expected call of MachinesWithInterruptedInstances.
+func (mr *MockMachinesAPIMockRecorder) MachinesWithInterruptedInstances(arg0 any) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachinesWithInterruptedInstances", reflect.TypeOf((*MockMachinesAPI)(nil).MachinesWithInterruptedInstances), arg0)
+	return &MockMachinesAPIMachinesWithInterruptedInstancesCall{Call: call}
+}
+
+// MockMachinesAPIMachinesWithInterruptedInstancesCall wrap *gomock.Call
+type MockMachinesAPIMachinesWithInterruptedInstancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachinesAPIMachinesWithInterruptedInstancesCall) Return(arg0 []provisioner.MachineStatusResult, arg1 error) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachinesAPIMachinesWithInterruptedInstancesCall) Do(f func(context.Context) ([]provisioner.MachineStatusResult, error)) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachinesAPIMachinesWithInterruptedInstancesCall) DoAndReturn(f func(context.Context) ([]provisioner.MachineStatusResult, error)) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MachinesWithTransientErrors mocks base method.
 func (m *MockMachinesAPI) MachinesWithTransientErrors(arg0 context.Context) ([]provisioner.MachineStatusResult, error) {
 	m.ctrl.T.Helper()
@@ -426,6 +504,45 @@ func (c *MockMachinesAPIProvisioningInfoCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// WatchInterruptedInstances mocks base method.
+func (m *MockMachinesAPI) WatchInterruptedInstances(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchInterruptedInstances", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchInterruptedInstances indicates an expected call of WatchInterruptedInstances.
+func (mr *MockMachinesAPIMockRecorder) WatchInterruptedInstances(arg0 any) *MockMachinesAPIWatchInterruptedInstancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchInterruptedInstances", reflect.TypeOf((*MockMachinesAPI)(nil).WatchInterruptedInstances), arg0)
+	return &MockMachinesAPIWatchInterruptedInstancesCall{Call: call}
+}
+
+// MockMachinesAPIWatchInterruptedInstancesCall wrap *gomock.Call
+type MockMachinesAPIWatchInterruptedInstancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachinesAPIWatchInterruptedInstancesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockMachinesAPIWatchInterruptedInstancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachinesAPIWatchInterruptedInstancesCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockMachinesAPIWatchInterruptedInstancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachinesAPIWatchInterruptedInstancesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockMachinesAPIWatchInterruptedInstancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatchMachineErrorRetry mocks base method.
 func (m *MockMachinesAPI) WatchMachineErrorRetry(arg0 context.Context) (watcher.Watcher[struct{}], error) {
 	m.ctrl.T.Helper()
@@ -547,6 +547,44 @@ func (c *MockMachineProvisionerRemoveCall) DoAndReturn(f func(context.Context) e
 	return c
 }
 
+// ResetInterruptedInstance mocks base method.
+func (m *MockMachineProvisioner) ResetInterruptedInstance(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetInterruptedInstance", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetInterruptedInstance indicates an expected call of ResetInterruptedInstance.
+func (mr *MockMachineProvisionerMockRecorder) ResetInterruptedInstance(arg0 any) *MockMachineProvisionerResetInterruptedInstanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetInterruptedInstance", reflect.TypeOf((*MockMachineProvisioner)(nil).ResetInterruptedInstance), arg0)
+	return &MockMachineProvisionerResetInterruptedInstanceCall{Call: call}
+}
+
+// MockMachineProvisionerResetInterruptedInstanceCall wrap *gomock.Call
+type MockMachineProvisionerResetInterruptedInstanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineProvisionerResetInterruptedInstanceCall) Return(arg0 error) *MockMachineProvisionerResetInterruptedInstanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineProvisionerResetInterruptedInstanceCall) Do(f func(context.Context) error) *MockMachineProvisionerResetInterruptedInstanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineProvisionerResetInterruptedInstanceCall) DoAndReturn(f func(context.Context) error) *MockMachineProvisionerResetInterruptedInstanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetCharmProfiles mocks base method.
 func (m *MockMachineProvisioner) SetCharmProfiles(arg0 context.Context, arg1 []string) error {
 	m.ctrl.T.Helper()
@@ -54,6 +54,7 @@ type ControllerAPI interface {
 type MachinesAPI interface {
 	Machines(context.Context, ...names.MachineTag) ([]apiprovisioner.MachineResult, error)
 	MachinesWithTransientErrors(context.Context) ([]apiprovisioner.MachineStatusResult, error)
+	MachinesWithInterruptedInstances(context.Context) ([]apiprovisioner.MachineStatusResult, error)
 	WatchMachineErrorRetry(context.Context) (watcher.NotifyWatcher, error)
 	WatchModelMachines(context.Context) (watcher.StringsWatcher, error)
 	ProvisioningInfo(_ context.Context, machineTags []names.MachineTag) (params.ProvisioningInfoResults, error)
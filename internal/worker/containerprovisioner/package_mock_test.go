@@ -891,6 +891,45 @@ func (c *MockMachinesAPIMachinesCall) DoAndReturn(f func(context.Context, ...nam
 	return c
 }
 
+// MachinesWithInterruptedInstances mocks base method.
+func (m *MockMachinesAPI) MachinesWithInterruptedInstances(arg0 context.Context) ([]provisioner.MachineStatusResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MachinesWithInterruptedInstances", arg0)
+	ret0, _ := ret[0].([]provisioner.MachineStatusResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MachinesWithInterruptedInstances indicates an expected call of MachinesWithInterruptedInstances.
+func (mr *MockMachinesAPIMockRecorder) MachinesWithInterruptedInstances(arg0 any) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachinesWithInterruptedInstances", reflect.TypeOf((*MockMachinesAPI)(nil).MachinesWithInterruptedInstances), arg0)
+	return &MockMachinesAPIMachinesWithInterruptedInstancesCall{Call: call}
+}
+
+// MockMachinesAPIMachinesWithInterruptedInstancesCall wrap *gomock.Call
+type MockMachinesAPIMachinesWithInterruptedInstancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachinesAPIMachinesWithInterruptedInstancesCall) Return(arg0 []provisioner.MachineStatusResult, arg1 error) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachinesAPIMachinesWithInterruptedInstancesCall) Do(f func(context.Context) ([]provisioner.MachineStatusResult, error)) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachinesAPIMachinesWithInterruptedInstancesCall) DoAndReturn(f func(context.Context) ([]provisioner.MachineStatusResult, error)) *MockMachinesAPIMachinesWithInterruptedInstancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MachinesWithTransientErrors mocks base method.
 func (m *MockMachinesAPI) MachinesWithTransientErrors(arg0 context.Context) ([]provisioner.MachineStatusResult, error) {
 	m.ctrl.T.Helper()
@@ -9,10 +9,13 @@ import (
 	"sort"
 	"time"
 
+	"github.com/juju/clock"
+	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 
 	"github.com/juju/juju/core/blockdevice"
 	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/storage"
 	jworker "github.com/juju/juju/internal/worker"
 )
 
@@ -24,6 +27,10 @@ const (
 	// polling it is.
 	listBlockDevicesPeriod = time.Second * 30
 
+	// reportFilesystemUsagePeriod is the time period between reports of
+	// the usage of attached filesystems.
+	reportFilesystemUsagePeriod = time.Minute * 5
+
 	// bytesInMiB is the number of bytes in a MiB.
 	bytesInMiB = 1024 * 1024
 )
@@ -42,12 +49,47 @@ type ListBlockDevicesFunc func(context.Context) ([]blockdevice.BlockDevice, erro
 // devices for the operating system of the local host.
 var DefaultListBlockDevices ListBlockDevicesFunc
 
+// FilesystemUsageSetter is an interface that is supplied to NewWorker
+// for reporting the usage of the filesystems attached to the local host.
+type FilesystemUsageSetter interface {
+	FilesystemMountPoints(context.Context) ([]string, error)
+	SetFilesystemUsage(context.Context, []storage.FilesystemUsage) error
+}
+
+// FilesystemUsageFunc is the type of a function that is supplied to
+// NewWorker for measuring the usage of the filesystem mounted at a path.
+type FilesystemUsageFunc func(path string) (storage.FilesystemUsage, error)
+
+// DefaultFilesystemUsage is the default function for measuring
+// filesystem usage for the operating system of the local host.
+var DefaultFilesystemUsage FilesystemUsageFunc
+
 // NewWorker returns a worker that lists block devices
 // attached to the machine, and records them in state.
-var NewWorker = func(l ListBlockDevicesFunc, b BlockDeviceSetter) worker.Worker {
+// The worker also periodically reports the usage of the
+// filesystems attached to the machine; failing to do so
+// is logged and retried at the next report period.
+var NewWorker = func(l ListBlockDevicesFunc, b BlockDeviceSetter, u FilesystemUsageFunc, s FilesystemUsageSetter, clk clock.Clock) worker.Worker {
 	var old []blockdevice.BlockDevice
+	var lastUsageReport time.Time
 	f := func(ctx context.Context) error {
-		return doWork(ctx, l, b, &old)
+		if err := doWork(ctx, l, b, &old); err != nil {
+			return err
+		}
+		now := clk.Now()
+		if u == nil || now.Sub(lastUsageReport) < reportFilesystemUsagePeriod {
+			return nil
+		}
+		err := doUsageWork(ctx, u, s)
+		if errors.Is(err, errors.NotSupported) {
+			logger.Debugf(ctx, "not reporting filesystem usage: %v", err)
+			u = nil
+			return nil
+		} else if err != nil {
+			logger.Warningf(ctx, "cannot report filesystem usage: %v", err)
+		}
+		lastUsageReport = now
+		return nil
 	}
 	return jworker.NewPeriodicWorker(f, listBlockDevicesPeriod, jworker.NewTimer)
 }
@@ -72,3 +114,26 @@ func doWork(ctx context.Context, listf ListBlockDevicesFunc, b BlockDeviceSetter
 	*old = blockDevices
 	return nil
 }
+
+func doUsageWork(ctx context.Context, usagef FilesystemUsageFunc, s FilesystemUsageSetter) error {
+	mountPoints, err := s.FilesystemMountPoints(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(mountPoints) == 0 {
+		return nil
+	}
+	sort.Strings(mountPoints)
+	usage := make([]storage.FilesystemUsage, 0, len(mountPoints))
+	for _, mountPoint := range mountPoints {
+		u, err := usagef(mountPoint)
+		if err != nil {
+			// The filesystem may not have been mounted yet.
+			logger.Debugf(ctx, "cannot get usage of filesystem at %q: %v", mountPoint, err)
+			continue
+		}
+		usage = append(usage, u)
+	}
+	logger.Tracef(ctx, "filesystem usage: %#v", usage)
+	return errors.Trace(s.SetFilesystemUsage(ctx, usage))
+}
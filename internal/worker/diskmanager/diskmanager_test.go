@@ -5,12 +5,17 @@ package diskmanager_test
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/juju/clock"
+	"github.com/juju/clock/testclock"
 	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/blockdevice"
+	"github.com/juju/juju/internal/storage"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/diskmanager"
 )
@@ -39,7 +44,7 @@ func (s *DiskManagerWorkerSuite) TestWorker(c *gc.C) {
 		return []blockdevice.BlockDevice{{DeviceName: "whatever"}}, nil
 	}
 
-	w := diskmanager.NewWorker(listDevices, setDevices)
+	w := diskmanager.NewWorker(listDevices, setDevices, nil, nil, clock.WallClock)
 	defer w.Wait()
 	defer w.Kill()
 
@@ -117,6 +122,118 @@ func (s *DiskManagerWorkerSuite) TestBlockDevicesSorted(c *gc.C) {
 	}}})
 }
 
+func (s *DiskManagerWorkerSuite) TestWorkerReportsFilesystemUsage(c *gc.C) {
+	var setDevices BlockDeviceSetterFunc = func(context.Context, []blockdevice.BlockDevice) error {
+		return nil
+	}
+	var listDevices diskmanager.ListBlockDevicesFunc = func(context.Context) ([]blockdevice.BlockDevice, error) {
+		return nil, nil
+	}
+	var filesystemUsage diskmanager.FilesystemUsageFunc = func(path string) (storage.FilesystemUsage, error) {
+		return storage.FilesystemUsage{Path: path, UsedBytes: 1}, nil
+	}
+	done := make(chan []storage.FilesystemUsage, 1)
+	setter := &mockFilesystemUsageSetter{
+		mountPoints: []string{"/srv/data"},
+		set: func(usage []storage.FilesystemUsage) error {
+			done <- usage
+			return nil
+		},
+	}
+
+	w := diskmanager.NewWorker(listDevices, setDevices, filesystemUsage, setter, testclock.NewClock(time.Now()))
+	defer w.Wait()
+	defer w.Kill()
+
+	select {
+	case usage := <-done:
+		c.Assert(usage, jc.DeepEquals, []storage.FilesystemUsage{{Path: "/srv/data", UsedBytes: 1}})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for diskmanager to report filesystem usage")
+	}
+}
+
+func (s *DiskManagerWorkerSuite) TestWorkerFilesystemUsageErrorNotFatal(c *gc.C) {
+	var setDevices BlockDeviceSetterFunc = func(context.Context, []blockdevice.BlockDevice) error {
+		return nil
+	}
+	var listDevices diskmanager.ListBlockDevicesFunc = func(context.Context) ([]blockdevice.BlockDevice, error) {
+		return nil, nil
+	}
+	var filesystemUsage diskmanager.FilesystemUsageFunc = func(path string) (storage.FilesystemUsage, error) {
+		return storage.FilesystemUsage{Path: path}, nil
+	}
+	done := make(chan struct{})
+	setter := &mockFilesystemUsageSetter{
+		mountPoints: []string{"/srv/data"},
+		set: func([]storage.FilesystemUsage) error {
+			close(done)
+			return errors.New("boom")
+		},
+	}
+
+	w := diskmanager.NewWorker(listDevices, setDevices, filesystemUsage, setter, testclock.NewClock(time.Now()))
+
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for diskmanager to report filesystem usage")
+	}
+	// The failure to report usage must not stop the worker.
+	workertest.CleanKill(c, w)
+}
+
+func (s *DiskManagerWorkerSuite) TestFilesystemUsageSkipsUnmounted(c *gc.C) {
+	var filesystemUsage diskmanager.FilesystemUsageFunc = func(path string) (storage.FilesystemUsage, error) {
+		if path == "/srv/unmounted" {
+			return storage.FilesystemUsage{}, errors.New("no such file or directory")
+		}
+		return storage.FilesystemUsage{Path: path, AvailableBytes: 1}, nil
+	}
+	var usageSet []storage.FilesystemUsage
+	setter := &mockFilesystemUsageSetter{
+		mountPoints: []string{"/srv/unmounted", "/srv/data"},
+		set: func(usage []storage.FilesystemUsage) error {
+			usageSet = usage
+			return nil
+		},
+	}
+
+	err := diskmanager.DoUsageWork(context.Background(), filesystemUsage, setter)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(usageSet, jc.DeepEquals, []storage.FilesystemUsage{{Path: "/srv/data", AvailableBytes: 1}})
+}
+
+func (s *DiskManagerWorkerSuite) TestFilesystemUsageNoMountPoints(c *gc.C) {
+	setter := &mockFilesystemUsageSetter{
+		set: func([]storage.FilesystemUsage) error {
+			c.Fatalf("unexpected call to SetFilesystemUsage")
+			return nil
+		},
+	}
+	err := diskmanager.DoUsageWork(context.Background(), diskmanager.FilesystemUsage, setter)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *DiskManagerWorkerSuite) TestFilesystemUsageStatfs(c *gc.C) {
+	usage, err := diskmanager.FilesystemUsage(c.MkDir())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(usage.UsedBytes+usage.AvailableBytes, jc.GreaterThan, uint64(0))
+}
+
+type mockFilesystemUsageSetter struct {
+	mountPoints []string
+	set         func([]storage.FilesystemUsage) error
+}
+
+func (m *mockFilesystemUsageSetter) FilesystemMountPoints(context.Context) ([]string, error) {
+	return m.mountPoints, nil
+}
+
+func (m *mockFilesystemUsageSetter) SetFilesystemUsage(_ context.Context, usage []storage.FilesystemUsage) error {
+	return m.set(usage)
+}
+
 type BlockDeviceSetterFunc func(context.Context, []blockdevice.BlockDevice) error
 
 func (f BlockDeviceSetterFunc) SetMachineBlockDevices(ctx context.Context, devices []blockdevice.BlockDevice) error {
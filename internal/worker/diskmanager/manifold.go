@@ -6,6 +6,7 @@ package diskmanager
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"github.com/juju/worker/v4"
@@ -37,5 +38,5 @@ func newWorker(_ context.Context, a agent.Agent, apiCaller base.APICaller) (work
 
 	api := apidiskmanager.NewState(apiCaller, tag)
 
-	return NewWorker(DefaultListBlockDevices, api), nil
+	return NewWorker(DefaultListBlockDevices, api, DefaultFilesystemUsage, api, clock.WallClock), nil
 }
@@ -6,6 +6,7 @@ package diskmanager_test
 import (
 	"context"
 
+	"github.com/juju/clock"
 	"github.com/juju/names/v6"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4"
@@ -41,15 +42,24 @@ func (s *manifoldSuite) TestMachineDiskmanager(c *gc.C) {
 			return nil
 		})
 
-	s.PatchValue(&diskmanager.NewWorker, func(l diskmanager.ListBlockDevicesFunc, b diskmanager.BlockDeviceSetter) worker.Worker {
+	s.PatchValue(&diskmanager.NewWorker, func(
+		l diskmanager.ListBlockDevicesFunc,
+		b diskmanager.BlockDeviceSetter,
+		u diskmanager.FilesystemUsageFunc,
+		f diskmanager.FilesystemUsageSetter,
+		clk clock.Clock,
+	) worker.Worker {
 		called = true
 
 		c.Assert(l, gc.FitsTypeOf, diskmanager.DefaultListBlockDevices)
 		c.Assert(b, gc.NotNil)
+		c.Assert(u, gc.FitsTypeOf, diskmanager.DefaultFilesystemUsage)
 
 		api, ok := b.(*apidiskmanager.State)
 		c.Assert(ok, jc.IsTrue)
 		c.Assert(api, gc.NotNil)
+		c.Assert(f, gc.Equals, api)
+		c.Assert(clk, gc.Equals, clock.WallClock)
 
 		return nil
 	})
@@ -0,0 +1,35 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+//go:build linux
+
+package diskmanager
+
+import (
+	"syscall"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+func init() {
+	DefaultFilesystemUsage = filesystemUsage
+}
+
+// filesystemUsage returns the usage of the filesystem mounted at the
+// given path, as reported by statfs(2).
+func filesystemUsage(path string) (storage.FilesystemUsage, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return storage.FilesystemUsage{}, errors.Annotatef(err, "cannot stat filesystem at %q", path)
+	}
+	blockSize := uint64(st.Bsize)
+	return storage.FilesystemUsage{
+		Path:            path,
+		UsedBytes:       (st.Blocks - st.Bfree) * blockSize,
+		AvailableBytes:  st.Bavail * blockSize,
+		UsedInodes:      st.Files - st.Ffree,
+		AvailableInodes: st.Ffree,
+	}, nil
+}
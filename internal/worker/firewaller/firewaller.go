@@ -30,6 +30,7 @@ import (
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/instances"
@@ -123,6 +124,7 @@ type Firewaller struct {
 	machinesWatcher      watcher.StringsWatcher
 	portsWatcher         watcher.StringsWatcher
 	subnetWatcher        watcher.StringsWatcher
+	firewallRulesWatcher watcher.NotifyWatcher
 	modelFirewallWatcher watcher.NotifyWatcher
 	machineds            map[names.MachineTag]*machineData
 	unitsChange          chan *unitsChange
@@ -253,6 +255,14 @@ func (fw *Firewaller) setUp(ctx context.Context) error {
 		return errors.Trace(err)
 	}
 
+	fw.firewallRulesWatcher, err = fw.applicationService.WatchFirewallRules(ctx)
+	if err != nil {
+		return errors.Annotatef(err, "failed to start firewall rules watcher")
+	}
+	if err := fw.catacomb.Add(fw.firewallRulesWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
 	if fw.environModelFirewaller != nil {
 		fw.modelFirewallWatcher, err = fw.firewallerApi.WatchModelFirewallRules(ctx)
 		if err != nil {
@@ -365,6 +375,14 @@ func (fw *Firewaller) loop() error {
 			if err := fw.subnetsChanged(ctx); err != nil {
 				return errors.Trace(err)
 			}
+		case _, ok := <-fw.firewallRulesWatcher.Changes():
+			if !ok {
+				return errors.New("firewall rules watcher closed")
+			}
+
+			if err := fw.firewallRulesChanged(ctx); err != nil {
+				return errors.Trace(err)
+			}
 		case change := <-fw.localRelationsChange:
 			// We have a notification that the remote (consuming) model
 			// has changed egress networks so need to update the local
@@ -429,6 +447,36 @@ func (fw *Firewaller) subnetsChanged(ctx context.Context) error {
 	return nil
 }
 
+func (fw *Firewaller) firewallRulesChanged(ctx context.Context) error {
+	// Refresh the firewall rules of the known applications. Only units of
+	// applications that had, or now have, rules need their ingress rules
+	// re-evaluated.
+	var unitds []*unitData
+	for _, appd := range fw.applicationids {
+		rules, err := fw.applicationService.GetFirewallRules(ctx, appd.applicationTag.Name)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			fw.logger.Debugf(ctx, "firewall rules for application %q, app not found: %v", appd.applicationTag.Name, err)
+			continue
+		} else if err != nil {
+			return internalerrors.Capture(err)
+		}
+
+		if len(rules) == 0 && len(appd.firewallRules) == 0 {
+			continue
+		}
+		appd.firewallRules = rules
+
+		for _, unitd := range appd.unitds {
+			unitds = append(unitds, unitd)
+		}
+	}
+
+	if err := fw.flushUnits(ctx, unitds); err != nil {
+		return errors.Annotate(err, "cannot update firewall rules")
+	}
+	return nil
+}
+
 func (fw *Firewaller) relationIngressChanged(ctx context.Context, change *remoteRelationNetworkChange) error {
 	fw.logger.Debugf(ctx, "process remote relation ingress change for %v", change.relationTag)
 	relData, ok := fw.relationIngress[change.relationTag]
@@ -584,12 +632,17 @@ func (fw *Firewaller) startApplication(ctx context.Context, app Application) err
 	if err != nil {
 		return internalerrors.Capture(err)
 	}
+	firewallRules, err := fw.applicationService.GetFirewallRules(ctx, app.Name())
+	if err != nil {
+		return internalerrors.Capture(err)
+	}
 	applicationd := &applicationData{
 		fw:                 fw,
 		applicationTag:     app.Tag(),
 		applicationService: fw.applicationService,
 		exposed:            isExposed,
 		exposedEndpoints:   exposedEndpoints,
+		firewallRules:      firewallRules,
 		unitds:             make(map[coreunit.Name]*unitData),
 	}
 	fw.applicationids[app.Tag()] = applicationd
@@ -885,15 +938,14 @@ func (fw *Firewaller) gatherIngressRules(ctx context.Context, machines ...*machi
 
 func (fw *Firewaller) ingressRulesForMachineUnit(ctx context.Context, machine *machineData, unit *unitData) (firewall.IngressRules, error) {
 	unitPortRanges := machine.openedPortRangesByEndpoint[unit.name]
-	if len(unitPortRanges) == 0 {
-		return nil, nil // no ports opened by the charm
-	}
 
+	// If no ports are opened by the charm, only firewall rules with an
+	// explicit port range can apply.
 	var rules firewall.IngressRules
 	var err error
-	if unit.applicationd.exposed {
+	if len(unitPortRanges) != 0 && unit.applicationd.exposed {
 		rules = fw.ingressRulesForExposedMachineUnit(ctx, unit, unitPortRanges)
-	} else {
+	} else if len(unitPortRanges) != 0 {
 		if rules, err = fw.ingressRulesForNonExposedMachineUnit(
 			ctx,
 			unit.applicationd.applicationTag,
@@ -903,6 +955,14 @@ func (fw *Firewaller) ingressRulesForMachineUnit(ctx context.Context, machine *m
 		}
 	}
 
+	// Firewall rules apply whether or not the application is exposed.
+	for _, rule := range unit.applicationd.firewallRules {
+		rules = append(rules, rule.IngressRules(unitPortRanges)...)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
 	// De-dup and sort rules before returning them back.
 	rules = rules.UniqueRules()
 	sort.Slice(rules, func(i, j int) bool { return rules[i].LessThan(rules[j]) })
@@ -1352,6 +1412,7 @@ type applicationData struct {
 	applicationService ApplicationService
 	exposed            bool
 	exposedEndpoints   map[string]application.ExposedEndpoint
+	firewallRules      []application.FirewallRule
 	unitds             map[coreunit.Name]*unitData
 }
 
@@ -34,6 +34,7 @@ import (
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/instances"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -67,6 +68,7 @@ type firewallerBaseSuite struct {
 	openedPortsCh  chan []string
 	remoteRelCh    chan []string
 	subnetsCh      chan []string
+	appFwRulesCh   chan struct{}
 	modelFwRulesCh chan struct{}
 
 	clock testclock.AdvanceableClock
@@ -82,6 +84,8 @@ type firewallerBaseSuite struct {
 
 	modelIngressRules firewall.IngressRules
 	envModelPorts     firewall.IngressRules
+	appFirewallRules  map[string][]application.FirewallRule
+	removedApps       set.Strings
 
 	nextMachineId int
 	nextUnitId    map[string]int
@@ -105,6 +109,8 @@ func (s *firewallerBaseSuite) SetUpTest(c *gc.C) {
 	s.nextMachineId = 0
 	s.nextUnitId = make(map[string]int)
 	s.deadMachines = set.NewStrings()
+	s.appFirewallRules = make(map[string][]application.FirewallRule)
+	s.removedApps = set.NewStrings()
 
 	s.unitPortRanges = newUnitPortRanges()
 	s.instancePorts = make(map[string]firewall.IngressRules)
@@ -139,6 +145,7 @@ func (s *firewallerBaseSuite) ensureMocks(c *gc.C, ctrl *gomock.Controller) {
 	s.openedPortsCh = make(chan []string, 10)
 	s.remoteRelCh = make(chan []string, 5)
 	s.subnetsCh = make(chan []string, 5)
+	s.appFwRulesCh = make(chan struct{}, 5)
 	s.modelFwRulesCh = make(chan struct{}, 5)
 
 	// This is the controller machine.
@@ -306,6 +313,16 @@ func (s *firewallerBaseSuite) addApplication(ctrl *gomock.Controller, appName st
 	s.applicationService.EXPECT().IsApplicationExposed(gomock.Any(), appName).Return(exposed, nil)
 	s.applicationService.EXPECT().GetExposedEndpoints(gomock.Any(), appName).Return(map[string]application.ExposedEndpoint{
 		allEndpoints: {ExposeToCIDRs: set.NewStrings(firewall.AllNetworksIPV4CIDR)}}, nil)
+	s.applicationService.EXPECT().GetFirewallRules(gomock.Any(), appName).DoAndReturn(
+		func(context.Context, string) ([]application.FirewallRule, error) {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			if s.removedApps.Contains(appName) {
+				return nil, applicationerrors.ApplicationNotFound
+			}
+			return s.appFirewallRules[appName], nil
+		},
+	).AnyTimes()
 	app.EXPECT().Name().Return(appName).AnyTimes()
 	app.EXPECT().Tag().Return(names.NewApplicationTag(appName)).AnyTimes()
 	return app
@@ -399,6 +416,9 @@ func (s *firewallerBaseSuite) newFirewaller(c *gc.C, ctrl *gomock.Controller) wo
 	subnetsWatcher := watchertest.NewMockStringsWatcher(s.subnetsCh)
 	s.firewaller.EXPECT().WatchSubnets(gomock.Any()).Return(subnetsWatcher, nil)
 
+	appFwRulesWatcher := watchertest.NewMockNotifyWatcher(s.appFwRulesCh)
+	s.applicationService.EXPECT().WatchFirewallRules(gomock.Any()).Return(appFwRulesWatcher, nil)
+
 	if s.withModelFirewaller {
 		fwRulesWatcher := watchertest.NewMockNotifyWatcher(s.modelFwRulesCh)
 		s.firewaller.EXPECT().WatchModelFirewallRules(gomock.Any()).Return(fwRulesWatcher, nil)
@@ -2381,3 +2401,120 @@ func (s *firewallerBaseSuite) mustClosePortRanges(c *gc.C, u *mocks.MockUnit, en
 		s.openedPortsCh <- []string{m.Id()}
 	}
 }
+
+func (s *InstanceModeSuite) TestFirewallRuleOnUnexposedApplication(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	fw := s.newFirewaller(c, ctrl)
+	defer workertest.CleanKill(c, fw)
+
+	app := s.addApplication(ctrl, "wordpress", false)
+	u, m, _ := s.addUnit(c, ctrl, app)
+	s.startInstance(c, ctrl, m)
+
+	s.mustOpenPortRanges(c, u, allEndpoints, []network.PortRange{
+		network.MustParsePortRange("80/tcp"),
+	})
+	s.mustOpenPortRanges(c, u, "url", []network.PortRange{
+		network.MustParsePortRange("1337/tcp"),
+	})
+
+	// The application is not exposed, so no ports are reachable until
+	// a firewall rule is set for it.
+	s.assertIngressRules(c, m.Tag().Id(), nil)
+
+	s.setFirewallRules("wordpress", application.FirewallRule{
+		Endpoint:    "url",
+		SourceCIDRs: set.NewStrings("10.0.0.0/8", "192.168.0.0/16"),
+	})
+
+	// The rule for the "url" endpoint covers the ports opened for that
+	// endpoint as well as those opened for all endpoints.
+	s.assertIngressRules(c, m.Tag().Id(), firewall.IngressRules{
+		firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), "10.0.0.0/8", "192.168.0.0/16"),
+		firewall.NewIngressRule(network.MustParsePortRange("1337/tcp"), "10.0.0.0/8", "192.168.0.0/16"),
+	})
+
+	s.setFirewallRules("wordpress")
+
+	s.assertIngressRules(c, m.Tag().Id(), nil)
+}
+
+func (s *InstanceModeSuite) TestFirewallRuleWithPortRange(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	fw := s.newFirewaller(c, ctrl)
+	defer workertest.CleanKill(c, fw)
+
+	app := s.addApplication(ctrl, "wordpress", true)
+	u, m, _ := s.addUnit(c, ctrl, app)
+	s.startInstance(c, ctrl, m)
+
+	s.mustOpenPortRanges(c, u, allEndpoints, []network.PortRange{
+		network.MustParsePortRange("80/tcp"),
+	})
+
+	s.assertIngressRules(c, m.Tag().Id(), firewall.IngressRules{
+		firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), firewall.AllNetworksIPV4CIDR),
+	})
+
+	// A rule with an explicit port range opens that range regardless of
+	// the ports opened by the charm, and is merged with the expose rules.
+	portRange := network.MustParsePortRange("5432/tcp")
+	s.setFirewallRules("wordpress", application.FirewallRule{
+		PortRange:   &portRange,
+		SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+	})
+
+	s.assertIngressRules(c, m.Tag().Id(), firewall.IngressRules{
+		firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), firewall.AllNetworksIPV4CIDR),
+		firewall.NewIngressRule(network.MustParsePortRange("5432/tcp"), "10.0.0.0/8"),
+	})
+}
+
+func (s *InstanceModeSuite) TestFirewallRulesChangedForRemovedApplication(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	fw := s.newFirewaller(c, ctrl)
+	defer workertest.CleanKill(c, fw)
+
+	app := s.addApplication(ctrl, "wordpress", false)
+	u, m, _ := s.addUnit(c, ctrl, app)
+	s.startInstance(c, ctrl, m)
+
+	removed := s.addApplication(ctrl, "mysql", false)
+	_, removedMachine, _ := s.addUnit(c, ctrl, removed)
+	s.startInstance(c, ctrl, removedMachine)
+
+	s.mustOpenPortRanges(c, u, allEndpoints, []network.PortRange{
+		network.MustParsePortRange("80/tcp"),
+	})
+
+	// The application has been removed from the model before the
+	// firewaller saw it go, which must not stop the rules of the other
+	// applications from being applied.
+	s.mu.Lock()
+	s.removedApps.Add("mysql")
+	s.mu.Unlock()
+
+	s.setFirewallRules("wordpress", application.FirewallRule{
+		SourceCIDRs: set.NewStrings("10.0.0.0/8"),
+	})
+
+	s.assertIngressRules(c, m.Tag().Id(), firewall.IngressRules{
+		firewall.NewIngressRule(network.MustParsePortRange("80/tcp"), "10.0.0.0/8"),
+	})
+	workertest.CheckAlive(c, fw)
+}
+
+// setFirewallRules replaces the firewall rules for the given application
+// and notifies the firewaller of the change.
+func (s *firewallerBaseSuite) setFirewallRules(appName string, rules ...application.FirewallRule) {
+	s.mu.Lock()
+	s.appFirewallRules[appName] = rules
+	s.mu.Unlock()
+	s.appFwRulesCh <- struct{}{}
+}
@@ -114,6 +114,18 @@ type ApplicationService interface {
 	// If no application is found, an error satisfying
 	// [applicationerrors.ApplicationNotFound] is returned.
 	GetExposedEndpoints(ctx context.Context, appName string) (map[string]application.ExposedEndpoint, error)
+
+	// WatchFirewallRules returns a watcher that notifies when the firewall
+	// rules of any application in the model change.
+	WatchFirewallRules(ctx context.Context) (watcher.NotifyWatcher, error)
+
+	// GetFirewallRules returns the firewall rules of the provided
+	// application. Firewall rules apply whether or not the application is
+	// exposed.
+	//
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetFirewallRules(ctx context.Context, appName string) ([]application.FirewallRule, error)
 }
 
 // EnvironFirewaller defines methods to allow the worker to perform
@@ -246,6 +246,45 @@ func (c *MockApplicationServiceGetExposedEndpointsCall) DoAndReturn(f func(conte
 	return c
 }
 
+// GetFirewallRules mocks base method.
+func (m *MockApplicationService) GetFirewallRules(arg0 context.Context, arg1 string) ([]application.FirewallRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFirewallRules", arg0, arg1)
+	ret0, _ := ret[0].([]application.FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFirewallRules indicates an expected call of GetFirewallRules.
+func (mr *MockApplicationServiceMockRecorder) GetFirewallRules(arg0, arg1 any) *MockApplicationServiceGetFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRules", reflect.TypeOf((*MockApplicationService)(nil).GetFirewallRules), arg0, arg1)
+	return &MockApplicationServiceGetFirewallRulesCall{Call: call}
+}
+
+// MockApplicationServiceGetFirewallRulesCall wrap *gomock.Call
+type MockApplicationServiceGetFirewallRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetFirewallRulesCall) Return(arg0 []application.FirewallRule, arg1 error) *MockApplicationServiceGetFirewallRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetFirewallRulesCall) Do(f func(context.Context, string) ([]application.FirewallRule, error)) *MockApplicationServiceGetFirewallRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetFirewallRulesCall) DoAndReturn(f func(context.Context, string) ([]application.FirewallRule, error)) *MockApplicationServiceGetFirewallRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsApplicationExposed mocks base method.
 func (m *MockApplicationService) IsApplicationExposed(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -323,3 +362,42 @@ func (c *MockApplicationServiceWatchApplicationExposedCall) DoAndReturn(f func(c
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// WatchFirewallRules mocks base method.
+func (m *MockApplicationService) WatchFirewallRules(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchFirewallRules", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchFirewallRules indicates an expected call of WatchFirewallRules.
+func (mr *MockApplicationServiceMockRecorder) WatchFirewallRules(arg0 any) *MockApplicationServiceWatchFirewallRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchFirewallRules", reflect.TypeOf((*MockApplicationService)(nil).WatchFirewallRules), arg0)
+	return &MockApplicationServiceWatchFirewallRulesCall{Call: call}
+}
+
+// MockApplicationServiceWatchFirewallRulesCall wrap *gomock.Call
+type MockApplicationServiceWatchFirewallRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceWatchFirewallRulesCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockApplicationServiceWatchFirewallRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceWatchFirewallRulesCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchFirewallRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceWatchFirewallRulesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockApplicationServiceWatchFirewallRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -49,8 +49,9 @@ type AgentPasswordServiceGetter interface {
 // AccessService defines a interface for interacting the users and permissions
 // of a controller.
 type AccessService interface {
-	// GetUserByAuth returns the user with the given name and password.
-	GetUserByAuth(ctx context.Context, name coreuser.Name, password auth.Password) (coreuser.User, error)
+	// AuthenticateUser returns the user with the given name and password,
+	// recording the login attempt from the address.
+	AuthenticateUser(ctx context.Context, name coreuser.Name, password auth.Password, address string) (coreuser.User, error)
 
 	// GetUserByName returns the user with the given name.
 	GetUserByName(ctx context.Context, name coreuser.Name) (coreuser.User, error)
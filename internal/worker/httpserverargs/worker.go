@@ -171,9 +171,9 @@ func (b *managedServices) ControllerConfig(ctx context.Context) (controller.Conf
 	return b.controllerConfigService.ControllerConfig(b.tomb.Context(ctx))
 }
 
-// GetUserByAuth is part of the AccessService interface.
-func (b *managedServices) GetUserByAuth(ctx context.Context, name coreuser.Name, password auth.Password) (coreuser.User, error) {
-	return b.accessService.GetUserByAuth(b.tomb.Context(ctx), name, password)
+// AuthenticateUser is part of the AccessService interface.
+func (b *managedServices) AuthenticateUser(ctx context.Context, name coreuser.Name, password auth.Password, address string) (coreuser.User, error) {
+	return b.accessService.AuthenticateUser(b.tomb.Context(ctx), name, password, address)
 }
 
 // GetUserByName is part of the AccessService interface.
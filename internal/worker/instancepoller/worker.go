@@ -31,7 +31,9 @@ import (
 // reached.
 //
 // When a machine has an address and is started LongPoll will be used to
-// check that the instance address or status has not changed.
+// check that the instance address or status has not changed. Machines on
+// spot or preemptible instances are never moved to the long poll group,
+// so that interruption notices are noticed promptly.
 var (
 	ShortPoll        = 3 * time.Second
 	ShortPollBackoff = 2.0
@@ -107,6 +109,10 @@ type pollGroupEntry struct {
 	tag        names.MachineTag
 	instanceID instance.Id
 
+	// interruptible is true if the machine's instance may be reclaimed
+	// by the cloud at any time.
+	interruptible bool
+
 	shortPollInterval time.Duration
 	shortPollAt       time.Time
 }
@@ -422,11 +428,22 @@ func (u *updaterWorker) processOneInstance(
 		return nil
 	}
 
+	if interruptible, ok := info.(instances.InterruptibleInstance); ok {
+		entry.interruptible = interruptible.Interruptible()
+	}
+
 	providerStatus, providerAddrCount, err := u.processProviderInfo(ctx, entry, info, nics)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
+	// An interrupted instance will be replaced by the provisioner, so
+	// forget its ID; it will be resolved again at the next poll.
+	if providerStatus == status.Interrupted {
+		delete(u.instanceIDToGroupEntry, entry.instanceID)
+		entry.instanceID = ""
+	}
+
 	machineStatus, err := entry.m.Status(ctx)
 	if err != nil {
 		return errors.Trace(err)
@@ -554,8 +571,8 @@ func (u *updaterWorker) maybeSwitchPollGroup(
 	}
 
 	// The machine has started and we have at least one address; move to
-	// the long poll group
-	if providerAddrCount > 0 && curMachineStatus == status.Started {
+	// the long poll group, unless its instance may be interrupted.
+	if providerAddrCount > 0 && curMachineStatus == status.Started && !entry.interruptible {
 		u.moveEntryToPollGroup(longPollGroup, entry)
 		if curGroup != longPollGroup {
 			u.config.Logger.Debugf(ctx, "moving machine %q (instance ID %q) to long poll group", entry.m, entry.instanceID)
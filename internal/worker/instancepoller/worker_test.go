@@ -239,6 +239,68 @@ func (s *workerSuite) TestStartedMachineWithNetAddressesMovesToLongPollGroup(c *
 	c.Assert(updWorker.pollGroup[longPollGroup], gc.HasLen, 1)
 }
 
+func (s *workerSuite) TestStartedInterruptibleMachineStaysInShortPollGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, _ := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	// Start with machine "0", on a spot instance, in the short poll group.
+	machineTag := names.NewMachineTag("0")
+	machine := mocks.NewMockMachine(ctrl)
+
+	updWorker.appendToShortPollGroup(machineTag, machine)
+	entry, _ := updWorker.lookupPolledMachine(machineTag)
+	entry.interruptible = true
+
+	// The provider reports an instance status of "running"; the machine
+	// reports it's machine status as "started". As the instance may be
+	// interrupted, it must keep being polled frequently.
+	updWorker.maybeSwitchPollGroup(context.Background(), shortPollGroup, entry, status.Running, status.Started, 1)
+
+	c.Assert(updWorker.pollGroup[shortPollGroup], gc.HasLen, 1)
+	c.Assert(updWorker.pollGroup[longPollGroup], gc.HasLen, 0)
+	c.Assert(entry.shortPollInterval, gc.Equals, time.Duration(float64(ShortPoll)*ShortPollBackoff))
+}
+
+func (s *workerSuite) TestInterruptedInstanceIDIsForgotten(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, _ := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	machineTag := names.NewMachineTag("0")
+	machine := mocks.NewMockMachine(ctrl)
+	updWorker.appendToShortPollGroup(machineTag, machine)
+	entry, _ := updWorker.lookupPolledMachine(machineTag)
+	entry.instanceID = "b4dc0ffee"
+	updWorker.instanceIDToGroupEntry["b4dc0ffee"] = entry
+
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	machine.EXPECT().String().Return("machine-0").AnyTimes()
+	machine.EXPECT().Life().Return(life.Alive)
+	machine.EXPECT().InstanceStatus(gomock.Any()).Return(params.StatusResult{Status: string(status.Running)}, nil)
+	machine.EXPECT().Status(gomock.Any()).Return(params.StatusResult{Status: string(status.Started)}, nil)
+
+	// The provider reports that the instance has been interrupted.
+	instInfo := mocks.NewMockInstance(ctrl)
+	instInfo.EXPECT().Status(gomock.Any()).Return(instance.Status{Status: status.Interrupted, Message: "marked for termination"})
+	machine.EXPECT().SetInstanceStatus(gomock.Any(), status.Interrupted, "marked for termination", nil).Return(nil)
+	machine.EXPECT().SetProviderNetworkConfig(gomock.Any(), testNetIfs).Return(testAddrs, false, nil)
+
+	err := updWorker.processOneInstance(context.Background(), "b4dc0ffee", instInfo, testNetIfs, shortPollGroup)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The instance ID is resolved again at the next poll, so that the
+	// replacement instance is picked up.
+	c.Assert(entry.instanceID, gc.Equals, instance.Id(""))
+	c.Assert(updWorker.instanceIDToGroupEntry, gc.HasLen, 0)
+}
+
 func (s *workerSuite) TestNonStartedMachinesGetBumpedPollInterval(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -0,0 +1,78 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/virtualhostname"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+)
+
+// grantRecheckInterval is how often the grant of a session is checked, so
+// that sessions using a revoked grant are terminated.
+const grantRecheckInterval = time.Minute
+
+// SSHAccessGrantService is the interface that the server uses to check that
+// users hold a grant for ssh access to units and machines.
+type SSHAccessGrantService interface {
+	// GrantExpiry returns when the user's ssh access to the target expires.
+	// If the user holds no active grant for the target an error satisfying
+	// [sshaccesserrors.NoActiveGrant] is returned.
+	GrantExpiry(ctx context.Context, userName, modelUUID, target string) (time.Time, error)
+}
+
+// checkAccessGrant returns when the user's grant for ssh access to the target
+// expires.
+func (s *ServerWorker) checkAccessGrant(ctx context.Context, userName string, info virtualhostname.Info) (time.Time, error) {
+	expiry, err := s.config.SSHAccessGrantService.GrantExpiry(ctx, userName, info.ModelUUID(), info.String())
+	if err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	return expiry, nil
+}
+
+// terminateAtGrantExpiry closes the channel once the user's grant for ssh
+// access to the target expires or is revoked. The grant is rechecked
+// periodically, and whenever it is due to expire, in case it has been
+// revoked or another grant extends the user's access. It returns once done
+// is closed.
+func (s *ServerWorker) terminateAtGrantExpiry(
+	ch io.Closer, done <-chan struct{},
+	userName string, info virtualhostname.Info, expiry time.Time,
+) {
+	ctx := s.tomb.Context(context.Background())
+	for {
+		wait := expiry.Sub(s.clock.Now())
+		if wait > grantRecheckInterval {
+			wait = grantRecheckInterval
+		}
+		select {
+		case <-done:
+			return
+		case <-s.tomb.Dying():
+			return
+		case <-s.clock.After(wait):
+		}
+
+		next, err := s.checkAccessGrant(ctx, userName, info)
+		if errors.Is(err, sshaccesserrors.NoActiveGrant) {
+			s.config.Logger.Infof(ctx, "terminating ssh session of %q to %q: access grant expired or revoked", userName, info.String())
+			_ = ch.Close()
+			return
+		} else if err != nil {
+			s.config.Logger.Errorf(ctx, "checking ssh access grant of %q to %q: %v", userName, info.String(), err)
+			if !expiry.After(s.clock.Now()) {
+				_ = ch.Close()
+				return
+			}
+			continue
+		}
+		expiry = next
+	}
+}
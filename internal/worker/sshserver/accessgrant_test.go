@@ -0,0 +1,220 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gossh "golang.org/x/crypto/ssh"
+	"google.golang.org/grpc/test/bufconn"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	jujutesting "github.com/juju/juju/internal/testing"
+)
+
+type accessGrantSuite struct {
+	sessionHandler         *MockSessionHandler
+	sshAccessGrantService  *MockSSHAccessGrantService
+	userCertificateService *MockUserCertificateService
+	userAccessService      *MockUserAccessService
+
+	clock    *testclock.Clock
+	listener *bufconn.Listener
+}
+
+var _ = gc.Suite(&accessGrantSuite{})
+
+const testModelUUID = "8419cd78-4993-4c3a-928e-c646226beeee"
+
+func (s *accessGrantSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.sessionHandler = NewMockSessionHandler(ctrl)
+	s.sshAccessGrantService = NewMockSSHAccessGrantService(ctrl)
+	s.userCertificateService = NewMockUserCertificateService(ctrl)
+	s.userAccessService = NewMockUserAccessService(ctrl)
+	s.clock = testclock.NewClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.listener = bufconn.Listen(1024)
+	return ctrl
+}
+
+func (s *accessGrantSuite) newServer(c *gc.C) *ServerWorker {
+	server, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Listener:                 s.listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		NewSSHServerListener:     newTestingSSHServerListener,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		SessionHandler:           s.sessionHandler,
+		SSHAccessGrantService:    s.sshAccessGrantService,
+		UserCertificateService:   s.userCertificateService,
+		UserAccessService:        s.userAccessService,
+		ControllerUUID:           testControllerUUID,
+		Clock:                    s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return server.(*ServerWorker)
+}
+
+// dialJumpServer dials the jump server as alice, authenticating with a
+// certificate which grants her read access to the model.
+func (s *accessGrantSuite) dialJumpServer(c *gc.C) *gossh.Client {
+	s.userCertificateService.EXPECT().CheckUserCertificate(gomock.Any(), gomock.Any(), "alice").Return(nil).AnyTimes()
+	name, err := user.NewName("alice")
+	c.Assert(err, jc.ErrorIsNil)
+	s.userAccessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), name, permission.ID{
+		ObjectType: permission.Controller,
+		Key:        testControllerUUID,
+	}).Return(permission.LoginAccess, nil).AnyTimes()
+	s.userAccessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), name, permission.ID{
+		ObjectType: permission.Model,
+		Key:        testModelUUID,
+	}).Return(permission.ReadAccess, nil).AnyTimes()
+
+	return inMemoryDial(c, s.listener, &gossh.ClientConfig{
+		User:            "alice",
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+		Auth: []gossh.AuthMethod{
+			gossh.PublicKeys(newCertSigner(c, "alice")),
+		},
+	})
+}
+
+func (s *accessGrantSuite) TestConfigRequiresUserCertificates(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Listener:                 s.listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		NewSSHServerListener:     newTestingSSHServerListener,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		SessionHandler:           s.sessionHandler,
+		SSHAccessGrantService:    s.sshAccessGrantService,
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *accessGrantSuite) TestRejectsWithoutGrant(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	s.sshAccessGrantService.EXPECT().GrantExpiry(gomock.Any(), "alice", testModelUUID, testVirtualHostname).
+		Return(time.Time{}, errors.Annotate(sshaccesserrors.NoActiveGrant, "alice"))
+
+	client := s.dialJumpServer(c)
+	defer client.Close()
+	_, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, gc.ErrorMatches, ".*No active ssh access grant.*")
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *accessGrantSuite) TestRejectsWhenGrantCheckFails(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	s.sshAccessGrantService.EXPECT().GrantExpiry(gomock.Any(), "alice", testModelUUID, testVirtualHostname).
+		Return(time.Time{}, errors.New("boom"))
+
+	client := s.dialJumpServer(c)
+	defer client.Close()
+	_, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, gc.ErrorMatches, ".*Failed to check ssh access grant.*")
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *accessGrantSuite) TestTerminatesAtGrantExpiry(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	expiry := s.clock.Now().Add(30 * time.Second)
+	gomock.InOrder(
+		s.sshAccessGrantService.EXPECT().GrantExpiry(gomock.Any(), "alice", testModelUUID, testVirtualHostname).
+			Return(expiry, nil),
+		s.sshAccessGrantService.EXPECT().GrantExpiry(gomock.Any(), "alice", testModelUUID, testVirtualHostname).
+			Return(time.Time{}, sshaccesserrors.NoActiveGrant),
+	)
+
+	client := s.dialJumpServer(c)
+	defer client.Close()
+	tunnel, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, jc.ErrorIsNil)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_, _ = io.Copy(io.Discard, tunnel)
+	}()
+
+	err = s.clock.WaitAdvance(30*time.Second, jujutesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case <-closed:
+	case <-time.After(jujutesting.LongWait):
+		c.Fatalf("timed out waiting for the session to be terminated")
+	}
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *accessGrantSuite) TestRechecksGrantBeforeExpiry(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	// The grant expires in an hour, but is revoked before then.
+	expiry := s.clock.Now().Add(time.Hour)
+	gomock.InOrder(
+		s.sshAccessGrantService.EXPECT().GrantExpiry(gomock.Any(), "alice", testModelUUID, testVirtualHostname).
+			Return(expiry, nil),
+		s.sshAccessGrantService.EXPECT().GrantExpiry(gomock.Any(), "alice", testModelUUID, testVirtualHostname).
+			Return(expiry, nil),
+		s.sshAccessGrantService.EXPECT().GrantExpiry(gomock.Any(), "alice", testModelUUID, testVirtualHostname).
+			Return(time.Time{}, sshaccesserrors.NoActiveGrant),
+	)
+
+	client := s.dialJumpServer(c)
+	defer client.Close()
+	tunnel, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, jc.ErrorIsNil)
+
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		_, _ = io.Copy(io.Discard, tunnel)
+	}()
+
+	for range 2 {
+		err = s.clock.WaitAdvance(grantRecheckInterval, jujutesting.LongWait, 1)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	select {
+	case <-closed:
+	case <-time.After(jujutesting.LongWait):
+		c.Fatalf("timed out waiting for the session to be terminated")
+	}
+
+	workertest.CleanKill(c, server)
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/juju/errors"
+	gossh "golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	"github.com/juju/juju/core/virtualhostname"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+)
+
+type authenticatedViaCertificate struct{}
+
+// UserCertificateService is the interface that the server uses to check the
+// certificates users authenticate with.
+type UserCertificateService interface {
+	// CheckUserCertificate checks that the certificate presented by the user
+	// was issued to them by the controller, has not expired and has not been
+	// revoked.
+	CheckUserCertificate(ctx context.Context, cert *gossh.Certificate, userName string) error
+}
+
+// UserAccessService is the interface that the server uses to map the
+// principal of a certificate to the user's permissions.
+type UserAccessService interface {
+	// ReadUserAccessLevelForTarget returns the user's access level for the
+	// given target.
+	ReadUserAccessLevelForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.Access, error)
+}
+
+// authenticateCertificate reports whether the key presented by the user is a
+// certificate issued to them by the controller.
+func (s *ServerWorker) authenticateCertificate(ctx ssh.Context, key ssh.PublicKey) bool {
+	cert, ok := key.(*gossh.Certificate)
+	if !ok {
+		s.config.Logger.Debugf(ctx, "refusing public key of %q without a certificate", ctx.User())
+		return false
+	}
+	if err := s.config.UserCertificateService.CheckUserCertificate(ctx, cert, ctx.User()); err != nil {
+		s.config.Logger.Infof(ctx, "refusing ssh user certificate %d of %q: %v", cert.Serial, ctx.User(), err)
+		return false
+	}
+	ctx.SetValue(authenticatedViaCertificate{}, cert.Serial)
+	return true
+}
+
+// certificateUser returns the name of the user the connection authenticated
+// as with a certificate issued to them by the controller. It returns false
+// when the connection did not authenticate with a certificate, in which case
+// the claimed user name has not been verified.
+func certificateUser(ctx ssh.Context) (string, bool) {
+	if _, ok := ctx.Value(authenticatedViaCertificate{}).(uint64); !ok {
+		return "", false
+	}
+	return ctx.User(), true
+}
+
+// checkTargetAccess checks that the user authenticated by certificate is
+// permitted to ssh to units and machines of the target's model. Controller
+// superusers and model admins are permitted. When access grants are required
+// read access to the model is enough, as the grant is checked separately.
+func (s *ServerWorker) checkTargetAccess(ctx context.Context, userName string, info virtualhostname.Info) (bool, error) {
+	name, err := user.NewName(userName)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	access, err := s.config.UserAccessService.ReadUserAccessLevelForTarget(ctx, name, permission.ID{
+		ObjectType: permission.Controller,
+		Key:        s.config.ControllerUUID,
+	})
+	if err != nil && !errors.Is(err, accesserrors.AccessNotFound) {
+		return false, errors.Trace(err)
+	} else if err == nil && access == permission.SuperuserAccess {
+		return true, nil
+	}
+
+	access, err = s.config.UserAccessService.ReadUserAccessLevelForTarget(ctx, name, permission.ID{
+		ObjectType: permission.Model,
+		Key:        info.ModelUUID(),
+	})
+	if errors.Is(err, accesserrors.AccessNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	required := permission.AdminAccess
+	if s.config.SSHAccessGrantService != nil {
+		required = permission.ReadAccess
+	}
+	return access.EqualOrGreaterModelAccessThan(required), nil
+}
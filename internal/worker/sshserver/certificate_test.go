@@ -0,0 +1,231 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gossh "golang.org/x/crypto/ssh"
+	"google.golang.org/grpc/test/bufconn"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/user"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	sshcaerrors "github.com/juju/juju/domain/sshca/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	pkissh "github.com/juju/juju/internal/pki/ssh"
+	jujutesting "github.com/juju/juju/internal/testing"
+)
+
+const testControllerUUID = "deadbeef-0bad-400d-8000-4b1d0d06f00d"
+
+type certificateSuite struct {
+	sessionHandler         *MockSessionHandler
+	userCertificateService *MockUserCertificateService
+	userAccessService      *MockUserAccessService
+
+	listener *bufconn.Listener
+}
+
+var _ = gc.Suite(&certificateSuite{})
+
+func (s *certificateSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.sessionHandler = NewMockSessionHandler(ctrl)
+	s.userCertificateService = NewMockUserCertificateService(ctrl)
+	s.userAccessService = NewMockUserAccessService(ctrl)
+	s.listener = bufconn.Listen(1024)
+	return ctrl
+}
+
+func (s *certificateSuite) newServer(c *gc.C) *ServerWorker {
+	server, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Listener:                 s.listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		NewSSHServerListener:     newTestingSSHServerListener,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		SessionHandler:           s.sessionHandler,
+		UserCertificateService:   s.userCertificateService,
+		UserAccessService:        s.userAccessService,
+		ControllerUUID:           testControllerUUID,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return server.(*ServerWorker)
+}
+
+func newTestSigner(c *gc.C) gossh.Signer {
+	key, err := pkissh.ED25519()
+	c.Assert(err, jc.ErrorIsNil)
+	signer, err := gossh.NewSignerFromKey(key)
+	c.Assert(err, jc.ErrorIsNil)
+	return signer
+}
+
+// newCertSigner returns a signer authenticating with a certificate for the
+// user's key, signed by a new certificate authority.
+func newCertSigner(c *gc.C, userName string) gossh.Signer {
+	userSigner := newTestSigner(c)
+	cert, err := pkissh.NewUserCertificate(newTestSigner(c), userSigner.PublicKey(), pkissh.UserCertificateParams{
+		KeyID:       "juju:" + userName,
+		Serial:      42,
+		Principals:  []string{userName},
+		ValidAfter:  time.Now().Add(-time.Minute),
+		ValidBefore: time.Now().Add(time.Hour),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	certSigner, err := gossh.NewCertSigner(cert, userSigner)
+	c.Assert(err, jc.ErrorIsNil)
+	return certSigner
+}
+
+func (s *certificateSuite) dial(signer gossh.Signer) (*gossh.Client, error) {
+	conn, err := s.listener.Dial()
+	if err != nil {
+		return nil, err
+	}
+	sshConn, newChan, reqs, err := gossh.NewClientConn(conn, "", &gossh.ClientConfig{
+		User:            "alice",
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+		Auth: []gossh.AuthMethod{
+			gossh.PublicKeys(signer),
+		},
+	})
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return gossh.NewClient(sshConn, newChan, reqs), nil
+}
+
+func (s *certificateSuite) expectAccess(c *gc.C, controllerAccess, modelAccess permission.Access, modelErr error) {
+	name, err := user.NewName("alice")
+	c.Assert(err, jc.ErrorIsNil)
+	s.userAccessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), name, permission.ID{
+		ObjectType: permission.Controller,
+		Key:        testControllerUUID,
+	}).Return(controllerAccess, nil)
+	if controllerAccess == permission.SuperuserAccess {
+		return
+	}
+	s.userAccessService.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), name, permission.ID{
+		ObjectType: permission.Model,
+		Key:        testModelUUID,
+	}).Return(modelAccess, modelErr)
+}
+
+func (s *certificateSuite) TestRejectsPublicKeyWithoutCertificate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	_, err := s.dial(newTestSigner(c))
+	c.Assert(err, gc.ErrorMatches, ".*unable to authenticate.*")
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *certificateSuite) TestRejectsInvalidCertificate(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	s.userCertificateService.EXPECT().CheckUserCertificate(gomock.Any(), gomock.Any(), "alice").
+		Return(errors.Annotate(sshcaerrors.CertificateRevoked, "certificate 42")).MinTimes(1)
+
+	_, err := s.dial(newCertSigner(c, "alice"))
+	c.Assert(err, gc.ErrorMatches, ".*unable to authenticate.*")
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *certificateSuite) TestModelAdminPermitted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	s.userCertificateService.EXPECT().CheckUserCertificate(gomock.Any(), gomock.Any(), "alice").
+		DoAndReturn(func(_ context.Context, cert *gossh.Certificate, _ string) error {
+			c.Check(cert.Serial, gc.Equals, uint64(42))
+			return nil
+		}).MinTimes(1)
+	s.expectAccess(c, permission.LoginAccess, permission.AdminAccess, nil)
+
+	client, err := s.dial(newCertSigner(c, "alice"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+	tunnel, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, jc.ErrorIsNil)
+	_ = tunnel.Close()
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *certificateSuite) TestSuperuserPermitted(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	s.userCertificateService.EXPECT().CheckUserCertificate(gomock.Any(), gomock.Any(), "alice").
+		Return(nil).MinTimes(1)
+	s.expectAccess(c, permission.SuperuserAccess, "", nil)
+
+	client, err := s.dial(newCertSigner(c, "alice"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+	tunnel, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, jc.ErrorIsNil)
+	_ = tunnel.Close()
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *certificateSuite) TestModelReaderDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	s.userCertificateService.EXPECT().CheckUserCertificate(gomock.Any(), gomock.Any(), "alice").
+		Return(nil).MinTimes(1)
+	s.expectAccess(c, permission.LoginAccess, permission.ReadAccess, nil)
+
+	client, err := s.dial(newCertSigner(c, "alice"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+	_, err = client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, gc.ErrorMatches, ".*Permission denied.*")
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *certificateSuite) TestNoModelAccessDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c)
+	defer workertest.DirtyKill(c, server)
+
+	s.userCertificateService.EXPECT().CheckUserCertificate(gomock.Any(), gomock.Any(), "alice").
+		Return(nil).MinTimes(1)
+	s.expectAccess(c, permission.LoginAccess, "", accesserrors.AccessNotFound)
+
+	client, err := s.dial(newCertSigner(c, "alice"))
+	c.Assert(err, jc.ErrorIsNil)
+	defer client.Close()
+	_, err = client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, gc.ErrorMatches, ".*Permission denied.*")
+
+	workertest.CleanKill(c, server)
+}
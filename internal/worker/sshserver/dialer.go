@@ -0,0 +1,223 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	gossh "golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/caas"
+	"github.com/juju/juju/core/machine"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/providertracker"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/internal/sshtunneler"
+	"github.com/juju/juju/state"
+)
+
+// TunnelTracker tracks the reverse SSH tunnels machines open to the
+// controller. Tunnels are requested by the connector, and authenticated
+// and pushed by the server when the machines connect.
+type TunnelTracker interface {
+	// RequestTunnel requests a tunnel to the machine, returning an SSH
+	// client connected to the machine's SSH server once it is opened.
+	RequestTunnel(ctx context.Context, req sshtunneler.RequestArgs) (*gossh.Client, error)
+	// AuthenticateTunnel authenticates a machine connecting to open a
+	// tunnel, returning the ID of the requested tunnel.
+	AuthenticateTunnel(username, password string) (string, error)
+	// PushTunnel passes the tunnel opened by a machine to the requester.
+	PushTunnel(ctx context.Context, tunnelID string, conn net.Conn) error
+}
+
+// ApplicationService is the interface that the connector uses to find the
+// machines units are on.
+type ApplicationService interface {
+	// GetUnitMachineName returns the name of the machine the unit is on.
+	GetUnitMachineName(ctx context.Context, unitName coreunit.Name) (machine.Name, error)
+}
+
+// ApplicationServiceGetter returns the application service of a model.
+type ApplicationServiceGetter func(ctx context.Context, modelUUID coremodel.UUID) (ApplicationService, error)
+
+// tunnelConnector connects to the SSH server of machines through the
+// reverse tunnels they open to the controller.
+type tunnelConnector struct {
+	tunnels                  TunnelTracker
+	applicationServiceGetter ApplicationServiceGetter
+}
+
+// Connect connects to the SSH server of the machine the destination is, or
+// the unit is on.
+func (c *tunnelConnector) Connect(ctx context.Context, destination virtualhostname.Info) (*gossh.Client, error) {
+	machineID, err := c.machineID(ctx, destination)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	client, err := c.tunnels.RequestTunnel(ctx, sshtunneler.RequestArgs{
+		MachineID: machineID,
+		ModelUUID: destination.ModelUUID(),
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "opening tunnel to machine %q", machineID)
+	}
+	return client, nil
+}
+
+func (c *tunnelConnector) machineID(ctx context.Context, destination virtualhostname.Info) (string, error) {
+	switch destination.Target() {
+	case virtualhostname.MachineTarget:
+		number, _ := destination.Machine()
+		return strconv.Itoa(number), nil
+	case virtualhostname.UnitTarget:
+		unitName, _ := destination.Unit()
+		applicationService, err := c.applicationServiceGetter(ctx, coremodel.UUID(destination.ModelUUID()))
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		machineName, err := applicationService.GetUnitMachineName(ctx, coreunit.Name(unitName))
+		if err != nil {
+			return "", errors.Annotatef(err, "getting machine of unit %q", unitName)
+		}
+		return machineName.String(), nil
+	default:
+		return "", errors.NotValidf("container destination %q in machine model", destination.String())
+	}
+}
+
+// providerPodDialer dials the pods of k8s units through the provider of
+// their model.
+type providerPodDialer struct {
+	providerFactory providertracker.ProviderFactory
+}
+
+// DialPod dials the port of the named pod in the model.
+func (d *providerPodDialer) DialPod(ctx context.Context, modelUUID coremodel.UUID, podName string, port int) (net.Conn, error) {
+	provider, err := d.providerFactory.ProviderForModel(ctx, modelUUID.String())
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting provider of model %q", modelUUID)
+	}
+	dialer, ok := provider.(caas.PodDialer)
+	if !ok {
+		return nil, errors.NotSupportedf("dialing pods of model %q", modelUUID)
+	}
+	conn, err := dialer.DialPod(ctx, podName, port)
+	if err != nil {
+		return nil, errors.Annotatef(err, "dialing port %d of pod %q", port, podName)
+	}
+	return conn, nil
+}
+
+// providerPodExecer runs commands in the pods of k8s units through the
+// provider of their model.
+type providerPodExecer struct {
+	providerFactory providertracker.ProviderFactory
+}
+
+// ExecPod runs a command in a container of a pod in the model.
+func (e *providerPodExecer) ExecPod(ctx context.Context, modelUUID coremodel.UUID, args caas.ExecPodArgs) error {
+	provider, err := e.providerFactory.ProviderForModel(ctx, modelUUID.String())
+	if err != nil {
+		return errors.Annotatef(err, "getting provider of model %q", modelUUID)
+	}
+	execer, ok := provider.(caas.PodExecer)
+	if !ok {
+		return errors.NotSupportedf("running commands in pods of model %q", modelUUID)
+	}
+	// The error is returned as is, so that the exit status of the
+	// command is passed on.
+	return execer.ExecPod(ctx, args)
+}
+
+// NewTunnelTracker returns a tracker of the reverse SSH tunnels machines
+// open to the controller. Requests for tunnels are written to the state of
+// the machine's model, for the machines to act on.
+func NewTunnelTracker(pool *state.StatePool, controllerConfigService ControllerConfigService, clock clock.Clock) (TunnelTracker, error) {
+	tracker, err := sshtunneler.NewTracker(sshtunneler.TrackerArgs{
+		State:          &tunnelState{pool: pool},
+		ControllerInfo: &controllerInfo{pool: pool, controllerConfigService: controllerConfigService},
+		Dialer:         sshtunneler.NewSSHDial(),
+		Clock:          clock,
+	})
+	return tracker, errors.Trace(err)
+}
+
+// tunnelState adapts the state pool to the state of the tunnel tracker.
+type tunnelState struct {
+	pool *state.StatePool
+}
+
+// InsertSSHConnRequest writes the request for a tunnel to the state of the
+// machine's model.
+func (s *tunnelState) InsertSSHConnRequest(args sshtunneler.SSHConnRequestArgs) error {
+	st, err := s.pool.Get(args.ModelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer st.Release()
+
+	return st.InsertSSHConnRequest(state.SSHConnRequestArg{
+		TunnelID:            args.TunnelID,
+		MachineId:           args.MachineId,
+		Expires:             args.Expires,
+		Username:            args.Username,
+		Password:            args.Password,
+		ControllerAddresses: args.ControllerAddresses,
+		UnitPort:            args.UnitPort,
+		EphemeralPublicKey:  args.EphemeralPublicKey,
+	})
+}
+
+// MachineHostKeys returns the SSH host keys of the machine.
+func (s *tunnelState) MachineHostKeys(modelUUID, machineID string) ([]string, error) {
+	st, err := s.pool.Get(modelUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer st.Release()
+
+	keys, err := st.GetSSHHostKeys(names.NewMachineTag(machineID))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return keys, nil
+}
+
+// controllerInfo provides the addresses machines connect to the controller
+// on to open tunnels.
+type controllerInfo struct {
+	pool                    *state.StatePool
+	controllerConfigService ControllerConfigService
+}
+
+// Addresses returns the addresses of the controller agents connect to.
+func (c *controllerInfo) Addresses() (network.SpaceAddresses, error) {
+	config, err := c.controllerConfigService.ControllerConfig(context.Background())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	st, err := c.pool.SystemState()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	hostPorts, err := st.APIHostPortsForAgents(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var addrs network.SpaceAddresses
+	for _, server := range hostPorts {
+		for _, hp := range server {
+			addrs = append(addrs, hp.SpaceAddress)
+		}
+	}
+	return addrs, nil
+}
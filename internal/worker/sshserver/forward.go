@@ -0,0 +1,99 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/gliderlabs/ssh"
+	gossh "golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/core/virtualhostname"
+)
+
+// TCPForwarder is the interface that the server uses to tunnel TCP
+// connections forwarded by users to the unit, machine or container they
+// connected to.
+type TCPForwarder interface {
+	// DialTarget dials the address from the destination, so that ports
+	// listening only on the destination's loopback interface are reachable.
+	DialTarget(ctx context.Context, destination virtualhostname.Info, addr string) (net.Conn, error)
+}
+
+// localForwardChannelData is the extra data of a direct-tcpip channel, as
+// specified in RFC 4254, section 7.2.
+type localForwardChannelData struct {
+	DestAddr string
+	DestPort uint32
+
+	OriginAddr string
+	OriginPort uint32
+}
+
+// forwardTCPIPHandler returns the direct-tcpip channel handler of the
+// embedded server for the destination. Rather than dialing from the
+// controller, as the default handler does, the forwarded connections are
+// tunnelled to the destination and dialed from there.
+func (s *ServerWorker) forwardTCPIPHandler(destination virtualhostname.Info) ssh.ChannelHandler {
+	return func(srv *ssh.Server, conn *gossh.ServerConn, newChan gossh.NewChannel, ctx ssh.Context) {
+		d := localForwardChannelData{}
+		if err := gossh.Unmarshal(newChan.ExtraData(), &d); err != nil {
+			s.rejectChannel(ctx, newChan, "Failed to parse forward data")
+			return
+		}
+		if s.config.TCPForwarder == nil {
+			err := newChan.Reject(gossh.Prohibited, "port forwarding is disabled")
+			if err != nil {
+				s.config.Logger.Errorf(ctx, "failed to reject channel: %v", err)
+			}
+			return
+		}
+
+		addr := net.JoinHostPort(d.DestAddr, strconv.FormatUint(uint64(d.DestPort), 10))
+		targetConn, err := s.config.TCPForwarder.DialTarget(ctx, destination, addr)
+		if err != nil {
+			s.config.Logger.Infof(ctx, "failed to forward %q to %s on %q: %v", ctx.User(), addr, destination.String(), err)
+			s.rejectChannel(ctx, newChan, err.Error())
+			return
+		}
+
+		ch, reqs, err := newChan.Accept()
+		if err != nil {
+			_ = targetConn.Close()
+			return
+		}
+		go gossh.DiscardRequests(reqs)
+
+		s.config.Logger.Debugf(ctx, "forwarding %q to %s on %q", ctx.User(), addr, destination.String())
+		pipe(ch, targetConn)
+	}
+}
+
+// pipe copies data in both directions between the channel and the
+// connection until either is closed, then closes both.
+func pipe(ch gossh.Channel, conn net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		_ = ch.Close()
+		_ = conn.Close()
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer once.Do(closeBoth)
+		_, _ = io.Copy(ch, conn)
+	}()
+	go func() {
+		defer wg.Done()
+		defer once.Do(closeBoth)
+		_, _ = io.Copy(conn, ch)
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gossh "golang.org/x/crypto/ssh"
+	"google.golang.org/grpc/test/bufconn"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/virtualhostname"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	jujutesting "github.com/juju/juju/internal/testing"
+)
+
+type forwardSuite struct {
+	sessionHandler *MockSessionHandler
+	tcpForwarder   *MockTCPForwarder
+
+	listener *bufconn.Listener
+}
+
+var _ = gc.Suite(&forwardSuite{})
+
+func (s *forwardSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.sessionHandler = NewMockSessionHandler(ctrl)
+	s.tcpForwarder = NewMockTCPForwarder(ctrl)
+	s.listener = bufconn.Listen(1024)
+	return ctrl
+}
+
+func (s *forwardSuite) newServer(c *gc.C, forwarder TCPForwarder) *ServerWorker {
+	server, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Listener:                 s.listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		NewSSHServerListener:     newTestingSSHServerListener,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		disableAuth:              true,
+		SessionHandler:           s.sessionHandler,
+		TCPForwarder:             forwarder,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return server.(*ServerWorker)
+}
+
+// dialTarget connects to the embedded server of the test virtual hostname
+// through the jump server.
+func (s *forwardSuite) dialTarget(c *gc.C) *gossh.Client {
+	client := inMemoryDial(c, s.listener, &gossh.ClientConfig{
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+		Auth: []gossh.AuthMethod{
+			gossh.Password(""),
+		},
+	})
+	tunnel, err := client.Dial("tcp", fmt.Sprintf("%s:0", testVirtualHostname))
+	c.Assert(err, jc.ErrorIsNil)
+
+	userKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	c.Assert(err, jc.ErrorIsNil)
+	userSigner, err := gossh.NewSignerFromKey(userKey)
+	c.Assert(err, jc.ErrorIsNil)
+
+	conn, chans, reqs, err := gossh.NewClientConn(tunnel, "", &gossh.ClientConfig{
+		User:            "ubuntu",
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+		Auth: []gossh.AuthMethod{
+			gossh.PublicKeys(userSigner),
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return gossh.NewClient(conn, chans, reqs)
+}
+
+func (s *forwardSuite) TestForwardToTarget(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c, s.tcpForwarder)
+	defer workertest.DirtyKill(c, server)
+
+	workloadConn, forwardedConn := net.Pipe()
+	s.tcpForwarder.EXPECT().DialTarget(gomock.Any(), gomock.Any(), "localhost:8080").DoAndReturn(
+		func(_ context.Context, destination virtualhostname.Info, _ string) (net.Conn, error) {
+			c.Check(destination.String(), gc.Equals, testVirtualHostname)
+			return forwardedConn, nil
+		},
+	)
+	go func() {
+		defer workloadConn.Close()
+		buf := make([]byte, 5)
+		if _, err := io.ReadFull(workloadConn, buf); err != nil {
+			return
+		}
+		_, _ = workloadConn.Write(append([]byte("echo "), buf...))
+	}()
+
+	client := s.dialTarget(c)
+	defer client.Close()
+
+	conn, err := client.Dial("tcp", "localhost:8080")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = conn.Write([]byte("hello"))
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := io.ReadAll(conn)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "echo hello")
+	_ = conn.Close()
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *forwardSuite) TestForwardDialError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c, s.tcpForwarder)
+	defer workertest.DirtyKill(c, server)
+
+	s.tcpForwarder.EXPECT().DialTarget(gomock.Any(), gomock.Any(), "localhost:8080").
+		Return(nil, errors.New("connection refused"))
+
+	client := s.dialTarget(c)
+	defer client.Close()
+
+	_, err := client.Dial("tcp", "localhost:8080")
+	c.Assert(err, gc.ErrorMatches, ".*connection refused.*")
+
+	workertest.CleanKill(c, server)
+}
+
+func (s *forwardSuite) TestForwardingDisabled(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	server := s.newServer(c, nil)
+	defer workertest.DirtyKill(c, server)
+
+	client := s.dialTarget(c)
+	defer client.Close()
+
+	_, err := client.Dial("tcp", "localhost:8080")
+	c.Assert(err, gc.ErrorMatches, ".*port forwarding is disabled.*")
+
+	workertest.CleanKill(c, server)
+}
@@ -8,14 +8,20 @@ import (
 	"net"
 	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
 
 	coredependency "github.com/juju/juju/core/dependency"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/internal/featureflag"
 	"github.com/juju/juju/internal/services"
+	"github.com/juju/juju/internal/worker/common"
+	workerstate "github.com/juju/juju/internal/worker/state"
+	"github.com/juju/juju/state"
 )
 
 // GetControllerConfigServiceFunc is a helper function that gets
@@ -30,11 +36,95 @@ func GetControllerConfigService(getter dependency.Getter, name string) (Controll
 	})
 }
 
+// GetSessionRecordingServiceFunc is a helper function that gets
+// a session recording service from the manifold.
+type GetSessionRecordingServiceFunc = func(getter dependency.Getter, name string) (SessionRecordingService, error)
+
+// GetSessionRecordingService is a helper function that gets a service from the
+// manifold.
+func GetSessionRecordingService(getter dependency.Getter, name string) (SessionRecordingService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) SessionRecordingService {
+		return factory.SSHSession()
+	})
+}
+
+// GetSSHAccessGrantServiceFunc is a helper function that gets an ssh access
+// grant service from the manifold.
+type GetSSHAccessGrantServiceFunc = func(getter dependency.Getter, name string) (SSHAccessGrantService, error)
+
+// GetSSHAccessGrantService is a helper function that gets a service from the
+// manifold.
+func GetSSHAccessGrantService(getter dependency.Getter, name string) (SSHAccessGrantService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) SSHAccessGrantService {
+		return factory.SSHAccess()
+	})
+}
+
+// GetUserCertificateServiceFunc is a helper function that gets an ssh user
+// certificate service from the manifold.
+type GetUserCertificateServiceFunc = func(getter dependency.Getter, name string) (UserCertificateService, error)
+
+// GetUserCertificateService is a helper function that gets a service from the
+// manifold.
+func GetUserCertificateService(getter dependency.Getter, name string) (UserCertificateService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) UserCertificateService {
+		return factory.SSHCA()
+	})
+}
+
+// GetUserAccessServiceFunc is a helper function that gets a user access
+// service from the manifold.
+type GetUserAccessServiceFunc = func(getter dependency.Getter, name string) (UserAccessService, error)
+
+// GetUserAccessService is a helper function that gets a service from the
+// manifold.
+func GetUserAccessService(getter dependency.Getter, name string) (UserAccessService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) UserAccessService {
+		return factory.Access()
+	})
+}
+
+// GetModelServiceFunc is a helper function that gets a model service from
+// the manifold.
+type GetModelServiceFunc = func(getter dependency.Getter, name string) (ModelService, error)
+
+// GetModelService is a helper function that gets a service from the
+// manifold.
+func GetModelService(getter dependency.Getter, name string) (ModelService, error) {
+	return coredependency.GetDependencyByName(getter, name, func(factory services.ControllerDomainServices) ModelService {
+		return factory.Model()
+	})
+}
+
+// GetApplicationServiceGetterFunc is a helper function that gets a getter
+// of the application services of models from the manifold.
+type GetApplicationServiceGetterFunc = func(getter dependency.Getter, name string) (ApplicationServiceGetter, error)
+
+// GetApplicationServiceGetter is a helper function that gets a getter of
+// the application services of models from the manifold.
+func GetApplicationServiceGetter(getter dependency.Getter, name string) (ApplicationServiceGetter, error) {
+	return coredependency.GetDependencyByName(getter, name, func(servicesGetter services.DomainServicesGetter) ApplicationServiceGetter {
+		return func(ctx context.Context, modelUUID model.UUID) (ApplicationService, error) {
+			modelServices, err := servicesGetter.ServicesForModel(ctx, modelUUID)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return modelServices.Application(), nil
+		}
+	})
+}
+
 // ManifoldConfig holds the information necessary to run an embedded SSH server
 // worker in a dependency.Engine.
 type ManifoldConfig struct {
 	// DomainServicesName is the name of the domain services worker.
 	DomainServicesName string
+	// StateName is the name of the state worker, whose state pool holds
+	// the requests for tunnels to machines.
+	StateName string
+	// ProviderFactoryName is the name of the provider tracker worker, used
+	// to reach the pods of k8s units.
+	ProviderFactoryName string
 	// NewServerWrapperWorker is the function that creates the embedded SSH server worker.
 	NewServerWrapperWorker func(ServerWrapperWorkerConfig) (worker.Worker, error)
 	// NewServerWorker is the function that creates a worker that has a catacomb
@@ -42,6 +132,24 @@ type ManifoldConfig struct {
 	NewServerWorker func(ServerWorkerConfig) (worker.Worker, error)
 	// GetControllerConfigService is used to get a service from the manifold.
 	GetControllerConfigService GetControllerConfigServiceFunc
+	// GetSessionRecordingService is used to get a service from the manifold.
+	GetSessionRecordingService GetSessionRecordingServiceFunc
+	// GetSSHAccessGrantService is used to get a service from the manifold.
+	GetSSHAccessGrantService GetSSHAccessGrantServiceFunc
+	// GetUserCertificateService is used to get a service from the manifold.
+	GetUserCertificateService GetUserCertificateServiceFunc
+	// GetUserAccessService is used to get a service from the manifold.
+	GetUserAccessService GetUserAccessServiceFunc
+	// GetModelService is used to get a service from the manifold.
+	GetModelService GetModelServiceFunc
+	// GetApplicationServiceGetter is used to get a getter of the
+	// application services of models from the manifold.
+	GetApplicationServiceGetter GetApplicationServiceGetterFunc
+	// NewTunnelTracker is the function that creates the tracker of the
+	// reverse tunnels machines open to the controller.
+	NewTunnelTracker func(*state.StatePool, ControllerConfigService, clock.Clock) (TunnelTracker, error)
+	// Clock is used by the tunnel tracker.
+	Clock clock.Clock
 	// NewSSHServerListener is the function that creates a listener, based on
 	// an existing listener for the server worker.
 	NewSSHServerListener func(net.Listener, time.Duration) net.Listener
@@ -54,6 +162,12 @@ func (config ManifoldConfig) Validate() error {
 	if config.DomainServicesName == "" {
 		return errors.NotValidf("empty DomainServicesName")
 	}
+	if config.StateName == "" {
+		return errors.NotValidf("empty StateName")
+	}
+	if config.ProviderFactoryName == "" {
+		return errors.NotValidf("empty ProviderFactoryName")
+	}
 	if config.NewServerWrapperWorker == nil {
 		return errors.NotValidf("nil NewServerWrapperWorker")
 	}
@@ -63,6 +177,30 @@ func (config ManifoldConfig) Validate() error {
 	if config.GetControllerConfigService == nil {
 		return errors.NotValidf("nil GetControllerConfigService")
 	}
+	if config.GetSessionRecordingService == nil {
+		return errors.NotValidf("nil GetSessionRecordingService")
+	}
+	if config.GetSSHAccessGrantService == nil {
+		return errors.NotValidf("nil GetSSHAccessGrantService")
+	}
+	if config.GetUserCertificateService == nil {
+		return errors.NotValidf("nil GetUserCertificateService")
+	}
+	if config.GetUserAccessService == nil {
+		return errors.NotValidf("nil GetUserAccessService")
+	}
+	if config.GetModelService == nil {
+		return errors.NotValidf("nil GetModelService")
+	}
+	if config.GetApplicationServiceGetter == nil {
+		return errors.NotValidf("nil GetApplicationServiceGetter")
+	}
+	if config.NewTunnelTracker == nil {
+		return errors.NotValidf("nil NewTunnelTracker")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
@@ -78,6 +216,8 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 	return dependency.Manifold{
 		Inputs: []string{
 			config.DomainServicesName,
+			config.StateName,
+			config.ProviderFactoryName,
 		},
 		Start: config.startWrapperWorker,
 	}
@@ -100,13 +240,82 @@ func (config ManifoldConfig) startWrapperWorker(_ context.Context, getter depend
 		return nil, errors.Trace(err)
 	}
 
-	return config.NewServerWrapperWorker(ServerWrapperWorkerConfig{
+	sessionRecordingService, err := config.GetSessionRecordingService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	sshAccessGrantService, err := config.GetSSHAccessGrantService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	userCertificateService, err := config.GetUserCertificateService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	userAccessService, err := config.GetUserAccessService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	modelService, err := config.GetModelService(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	applicationServiceGetter, err := config.GetApplicationServiceGetter(getter, config.DomainServicesName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var providerFactory providertracker.ProviderFactory
+	if err := getter.Get(config.ProviderFactoryName, &providerFactory); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var stTracker workerstate.StateTracker
+	if err := getter.Get(config.StateName, &stTracker); err != nil {
+		return nil, errors.Trace(err)
+	}
+	statePool, _, err := stTracker.Use()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	tunnelTracker, err := config.NewTunnelTracker(statePool, controllerConfigService, config.Clock)
+	if err != nil {
+		_ = stTracker.Done()
+		return nil, errors.Trace(err)
+	}
+
+	connector := &tunnelConnector{
+		tunnels:                  tunnelTracker,
+		applicationServiceGetter: applicationServiceGetter,
+	}
+	podDialer := &providerPodDialer{providerFactory: providerFactory}
+	podExecer := &providerPodExecer{providerFactory: providerFactory}
+	sessionHandler := newSessionHandler(connector, podDialer, podExecer, modelService, config.Logger)
+
+	w, err := config.NewServerWrapperWorker(ServerWrapperWorkerConfig{
 		ControllerConfigService: controllerConfigService,
 		NewServerWorker:         config.NewServerWorker,
 		Logger:                  config.Logger,
 		NewSSHServerListener:    config.NewSSHServerListener,
-		SessionHandler:          &stubSessionHandler{},
+		SessionHandler:          sessionHandler,
+		SessionRecordingService: sessionRecordingService,
+		SSHAccessGrantService:   sshAccessGrantService,
+		UserCertificateService:  userCertificateService,
+		UserAccessService:       userAccessService,
+		TCPForwarder:            sessionHandler,
+		TunnelTracker:           tunnelTracker,
 	})
+	if err != nil {
+		_ = stTracker.Done()
+		return nil, errors.Trace(err)
+	}
+	return common.NewCleanupWorker(w, func() { _ = stTracker.Done() }), nil
 }
 
 // NewSSHServerListener returns a listener based on the given listener.
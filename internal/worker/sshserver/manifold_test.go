@@ -5,8 +5,11 @@ package sshserver
 
 import (
 	"context"
+	"io"
+	"net"
 	"os"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -17,17 +20,36 @@ import (
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/caas"
+	coremachine "github.com/juju/juju/core/machine"
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/providertracker"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/virtualhostname"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/internal/featureflag"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/sshtunneler"
+	workerstate "github.com/juju/juju/internal/worker/state"
 	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/state"
 )
 
 type manifoldSuite struct {
 	testing.IsolationSuite
 
 	controllerConfigService *MockControllerConfigService
+	sessionRecordingService *MockSessionRecordingService
+	sshAccessGrantService   *MockSSHAccessGrantService
+	userCertificateService  *MockUserCertificateService
+	userAccessService       *MockUserAccessService
+	modelService            *MockModelService
+	applicationService      *MockApplicationService
+	tunnelTracker           *MockTunnelTracker
+	providerFactory         *MockProviderFactory
+
+	applicationServiceGetter ApplicationServiceGetter
 }
 
 var _ = gc.Suite(&manifoldSuite{})
@@ -52,6 +74,10 @@ func (s *manifoldSuite) TestConfigValidate(c *gc.C) {
 		cfg.NewServerWrapperWorker = nil
 		cfg.NewServerWorker = nil
 		cfg.GetControllerConfigService = nil
+		cfg.GetSessionRecordingService = nil
+		cfg.GetSSHAccessGrantService = nil
+		cfg.GetUserCertificateService = nil
+		cfg.GetUserAccessService = nil
 		cfg.Logger = nil
 	})
 	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
@@ -80,6 +106,66 @@ func (s *manifoldSuite) TestConfigValidate(c *gc.C) {
 	})
 	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
 
+	// Missing GetSessionRecordingService.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.GetSessionRecordingService = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing GetSSHAccessGrantService.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.GetSSHAccessGrantService = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing GetUserCertificateService.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.GetUserCertificateService = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing GetUserAccessService.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.GetUserAccessService = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing StateName.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.StateName = ""
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing ProviderFactoryName.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.ProviderFactoryName = ""
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing GetModelService.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.GetModelService = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing GetApplicationServiceGetter.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.GetApplicationServiceGetter = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing NewTunnelTracker.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.NewTunnelTracker = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
+	// Missing Clock.
+	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.Clock = nil
+	})
+	c.Check(errors.Is(cfg.Validate(), errors.NotValid), jc.IsTrue)
+
 	// Missing Logger.
 	cfg = s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
 		cfg.Logger = nil
@@ -94,6 +180,8 @@ func (s *manifoldSuite) TestManifoldStart(c *gc.C) {
 	// Setup the manifold
 	manifold := Manifold(ManifoldConfig{
 		DomainServicesName:     "domain-services",
+		StateName:              "state",
+		ProviderFactoryName:    "provider-tracker",
 		NewServerWrapperWorker: NewServerWrapperWorker,
 		NewServerWorker: func(ServerWorkerConfig) (worker.Worker, error) {
 			return workertest.NewErrorWorker(nil), nil
@@ -101,29 +189,192 @@ func (s *manifoldSuite) TestManifoldStart(c *gc.C) {
 		GetControllerConfigService: func(getter dependency.Getter, name string) (ControllerConfigService, error) {
 			return s.controllerConfigService, nil
 		},
+		GetSessionRecordingService: func(getter dependency.Getter, name string) (SessionRecordingService, error) {
+			return s.sessionRecordingService, nil
+		},
+		GetSSHAccessGrantService: func(getter dependency.Getter, name string) (SSHAccessGrantService, error) {
+			return s.sshAccessGrantService, nil
+		},
+		GetUserCertificateService: func(getter dependency.Getter, name string) (UserCertificateService, error) {
+			return s.userCertificateService, nil
+		},
+		GetUserAccessService: func(getter dependency.Getter, name string) (UserAccessService, error) {
+			return s.userAccessService, nil
+		},
+		GetModelService: func(getter dependency.Getter, name string) (ModelService, error) {
+			return s.modelService, nil
+		},
+		GetApplicationServiceGetter: func(getter dependency.Getter, name string) (ApplicationServiceGetter, error) {
+			return s.applicationServiceGetter, nil
+		},
+		NewTunnelTracker: func(*state.StatePool, ControllerConfigService, clock.Clock) (TunnelTracker, error) {
+			return s.tunnelTracker, nil
+		},
+		Clock:                clock.WallClock,
 		Logger:               loggertesting.WrapCheckLog(c),
 		NewSSHServerListener: newTestingSSHServerListener,
 	})
 
 	// Check the inputs are as expected
-	c.Assert(manifold.Inputs, gc.DeepEquals, []string{"domain-services"})
+	c.Assert(manifold.Inputs, gc.DeepEquals, []string{"domain-services", "state", "provider-tracker"})
 
 	// Start the worker
+	stTracker := &stubStateTracker{}
 	result, err := manifold.Start(
 		context.Background(),
-		dt.StubGetter(map[string]interface{}{}),
+		s.newGetter(stTracker),
 	)
 	c.Assert(err, jc.ErrorIsNil)
 	defer workertest.DirtyKill(c, result)
 
 	c.Check(result, gc.NotNil)
 	workertest.CleanKill(c, result)
+
+	// The state pool is released with the worker.
+	c.Check(stTracker.used, gc.Equals, 1)
+	c.Check(stTracker.done, gc.Equals, 1)
+}
+
+func (s *manifoldSuite) TestManifoldWiresTunnelDialer(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	var wrapperConfig ServerWrapperWorkerConfig
+	cfg := s.newManifoldConfig(c, func(cfg *ManifoldConfig) {
+		cfg.NewServerWrapperWorker = func(config ServerWrapperWorkerConfig) (worker.Worker, error) {
+			wrapperConfig = config
+			return workertest.NewErrorWorker(nil), nil
+		}
+	})
+	result, err := Manifold(*cfg).Start(context.Background(), s.newGetter(&stubStateTracker{}))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, result)
+
+	c.Check(wrapperConfig.TunnelTracker, gc.Equals, s.tunnelTracker)
+	c.Check(wrapperConfig.SessionHandler, gc.Equals, wrapperConfig.TCPForwarder)
+
+	ctx := context.Background()
+	modelUUID := "8419cd78-4993-4c3a-928e-c646226beeee"
+
+	// Machines are reached through reverse tunnels.
+	s.modelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID(modelUUID)).Return(coremodel.IAAS, nil).Times(2)
+	s.tunnelTracker.EXPECT().RequestTunnel(gomock.Any(), sshtunneler.RequestArgs{
+		MachineID: "0",
+		ModelUUID: modelUUID,
+	}).Return(nil, errors.New("no tunnel"))
+	machine, err := virtualhostname.NewInfoMachineTarget(modelUUID, "0")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = wrapperConfig.TCPForwarder.DialTarget(ctx, machine, "localhost:8080")
+	c.Check(err, gc.ErrorMatches, `opening tunnel to machine "0": no tunnel`)
+
+	// Units of machine models are reached through the tunnel to their
+	// machine.
+	s.applicationService.EXPECT().GetUnitMachineName(gomock.Any(), coreunit.Name("postgresql/1")).Return(coremachine.Name("3"), nil)
+	s.tunnelTracker.EXPECT().RequestTunnel(gomock.Any(), sshtunneler.RequestArgs{
+		MachineID: "3",
+		ModelUUID: modelUUID,
+	}).Return(nil, errors.New("no tunnel"))
+	unit, err := virtualhostname.NewInfoUnitTarget(modelUUID, "postgresql/1")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = wrapperConfig.TCPForwarder.DialTarget(ctx, unit, "localhost:8080")
+	c.Check(err, gc.ErrorMatches, `opening tunnel to machine "3": no tunnel`)
+
+	// The pods of k8s units are reached through the model's provider.
+	podConn, otherEnd := net.Pipe()
+	defer otherEnd.Close()
+	s.modelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID(modelUUID)).Return(coremodel.CAAS, nil)
+	s.providerFactory.EXPECT().ProviderForModel(gomock.Any(), modelUUID).Return(&podDialingProvider{
+		c:       c,
+		podName: "postgresql-1",
+		port:    5432,
+		conn:    podConn,
+	}, nil)
+	conn, err := wrapperConfig.TCPForwarder.DialTarget(ctx, unit, "127.0.0.1:5432")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(conn, gc.Equals, podConn)
+	_ = conn.Close()
+
+	// Sessions to k8s units are run in the unit's pod through the
+	// model's provider.
+	s.modelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID(modelUUID)).Return(coremodel.CAAS, nil)
+	s.providerFactory.EXPECT().ProviderForModel(gomock.Any(), modelUUID).Return(&podExecingProvider{
+		c:       c,
+		podName: "postgresql-1",
+	}, nil)
+	session := &userSession{clientCommand: "hostname"}
+	wrapperConfig.SessionHandler.Handle(session, unit)
+	c.Check(session.stdout.String(), gc.Equals, "postgresql-1\n")
+	c.Check(session.exitCode, gc.Equals, 0)
+}
+
+func (s *manifoldSuite) newGetter(stTracker workerstate.StateTracker) dependency.Getter {
+	return dt.StubGetter(map[string]interface{}{
+		"state":            stTracker,
+		"provider-tracker": s.providerFactory,
+	})
+}
+
+// stubStateTracker is a state tracker counting the uses of the state pool.
+type stubStateTracker struct {
+	workerstate.StateTracker
+	used, done int
+}
+
+func (t *stubStateTracker) Use() (*state.StatePool, *state.State, error) {
+	t.used++
+	return nil, nil, nil
+}
+
+func (t *stubStateTracker) Done() error {
+	t.done++
+	return nil
+}
+
+// podDialingProvider is a provider of a k8s model, dialing the pod of a
+// unit.
+type podDialingProvider struct {
+	providertracker.Provider
+	c       *gc.C
+	podName string
+	port    int
+	conn    net.Conn
+}
+
+func (p *podDialingProvider) DialPod(ctx context.Context, podName string, port int) (net.Conn, error) {
+	p.c.Check(podName, gc.Equals, p.podName)
+	p.c.Check(port, gc.Equals, p.port)
+	return p.conn, nil
+}
+
+// podExecingProvider is a provider of a k8s model, running commands in the
+// pod of a unit.
+type podExecingProvider struct {
+	providertracker.Provider
+	c       *gc.C
+	podName string
+}
+
+func (p *podExecingProvider) ExecPod(ctx context.Context, args caas.ExecPodArgs) error {
+	p.c.Check(args.PodName, gc.Equals, p.podName)
+	p.c.Check(args.Command, gc.DeepEquals, []string{"sh", "-c", "hostname"})
+	_, err := io.WriteString(args.Stdout, p.podName+"\n")
+	return err
 }
 
 func (s *manifoldSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.sessionRecordingService = NewMockSessionRecordingService(ctrl)
+	s.sshAccessGrantService = NewMockSSHAccessGrantService(ctrl)
+	s.userCertificateService = NewMockUserCertificateService(ctrl)
+	s.userAccessService = NewMockUserAccessService(ctrl)
+	s.modelService = NewMockModelService(ctrl)
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.tunnelTracker = NewMockTunnelTracker(ctrl)
+	s.providerFactory = NewMockProviderFactory(ctrl)
+	s.applicationServiceGetter = func(context.Context, coremodel.UUID) (ApplicationService, error) {
+		return s.applicationService, nil
+	}
 
 	s.controllerConfigService.EXPECT().WatchControllerConfig().DoAndReturn(func() (watcher.Watcher[[]string], error) {
 		return watchertest.NewMockStringsWatcher(make(<-chan []string)), nil
@@ -133,7 +384,9 @@ func (s *manifoldSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 func (s *manifoldSuite) newManifoldConfig(c *gc.C, modifier func(cfg *ManifoldConfig)) *ManifoldConfig {
 	cfg := &ManifoldConfig{
-		DomainServicesName: "domain-services",
+		DomainServicesName:  "domain-services",
+		StateName:           "state",
+		ProviderFactoryName: "provider-tracker",
 		NewServerWrapperWorker: func(ServerWrapperWorkerConfig) (worker.Worker, error) {
 			return nil, nil
 		},
@@ -143,6 +396,28 @@ func (s *manifoldSuite) newManifoldConfig(c *gc.C, modifier func(cfg *ManifoldCo
 		GetControllerConfigService: func(getter dependency.Getter, name string) (ControllerConfigService, error) {
 			return s.controllerConfigService, nil
 		},
+		GetSessionRecordingService: func(getter dependency.Getter, name string) (SessionRecordingService, error) {
+			return s.sessionRecordingService, nil
+		},
+		GetSSHAccessGrantService: func(getter dependency.Getter, name string) (SSHAccessGrantService, error) {
+			return s.sshAccessGrantService, nil
+		},
+		GetUserCertificateService: func(getter dependency.Getter, name string) (UserCertificateService, error) {
+			return s.userCertificateService, nil
+		},
+		GetUserAccessService: func(getter dependency.Getter, name string) (UserAccessService, error) {
+			return s.userAccessService, nil
+		},
+		GetModelService: func(getter dependency.Getter, name string) (ModelService, error) {
+			return s.modelService, nil
+		},
+		GetApplicationServiceGetter: func(getter dependency.Getter, name string) (ApplicationServiceGetter, error) {
+			return s.applicationServiceGetter, nil
+		},
+		NewTunnelTracker: func(*state.StatePool, ControllerConfigService, clock.Clock) (TunnelTracker, error) {
+			return s.tunnelTracker, nil
+		},
+		Clock:                clock.WallClock,
 		Logger:               loggertesting.WrapCheckLog(c),
 		NewSSHServerListener: newTestingSSHServerListener,
 	}
@@ -162,6 +437,8 @@ func (s *manifoldSuite) TestManifoldUninstall(c *gc.C) {
 	// Setup the manifold
 	manifold := Manifold(ManifoldConfig{
 		DomainServicesName:     "domain-services",
+		StateName:              "state",
+		ProviderFactoryName:    "provider-tracker",
 		NewServerWrapperWorker: NewServerWrapperWorker,
 		NewServerWorker: func(ServerWorkerConfig) (worker.Worker, error) {
 			return workertest.NewErrorWorker(nil), nil
@@ -169,12 +446,34 @@ func (s *manifoldSuite) TestManifoldUninstall(c *gc.C) {
 		GetControllerConfigService: func(getter dependency.Getter, name string) (ControllerConfigService, error) {
 			return s.controllerConfigService, nil
 		},
+		GetSessionRecordingService: func(getter dependency.Getter, name string) (SessionRecordingService, error) {
+			return s.sessionRecordingService, nil
+		},
+		GetSSHAccessGrantService: func(getter dependency.Getter, name string) (SSHAccessGrantService, error) {
+			return s.sshAccessGrantService, nil
+		},
+		GetUserCertificateService: func(getter dependency.Getter, name string) (UserCertificateService, error) {
+			return s.userCertificateService, nil
+		},
+		GetUserAccessService: func(getter dependency.Getter, name string) (UserAccessService, error) {
+			return s.userAccessService, nil
+		},
+		GetModelService: func(getter dependency.Getter, name string) (ModelService, error) {
+			return s.modelService, nil
+		},
+		GetApplicationServiceGetter: func(getter dependency.Getter, name string) (ApplicationServiceGetter, error) {
+			return s.applicationServiceGetter, nil
+		},
+		NewTunnelTracker: func(*state.StatePool, ControllerConfigService, clock.Clock) (TunnelTracker, error) {
+			return s.tunnelTracker, nil
+		},
+		Clock:                clock.WallClock,
 		Logger:               loggertesting.WrapCheckLog(c),
 		NewSSHServerListener: newTestingSSHServerListener,
 	})
 
 	// Check the inputs are as expected
-	c.Assert(manifold.Inputs, gc.DeepEquals, []string{"domain-services"})
+	c.Assert(manifold.Inputs, gc.DeepEquals, []string{"domain-services", "state", "provider-tracker"})
 
 	// Start the worker
 	_, err := manifold.Start(
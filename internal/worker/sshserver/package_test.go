@@ -10,9 +10,10 @@ import (
 	gc "gopkg.in/check.v1"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler,SessionRecordingService,SSHAccessGrantService,UserCertificateService,UserAccessService,TCPForwarder,ModelService,ApplicationService,TunnelTracker
 //go:generate go run go.uber.org/mock/mockgen -package sshserver -destination listener_mock_test.go net Listener
-//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination session_mock_test.go github.com/juju/juju/internal/worker/sshserver SSHConnector
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination session_mock_test.go github.com/juju/juju/internal/worker/sshserver SSHConnector,PodDialer,PodExecer
+//go:generate go run go.uber.org/mock/mockgen -typed -package sshserver -destination provider_mock_test.go github.com/juju/juju/core/providertracker ProviderFactory
 
 func TestPackage(t *stdtesting.T) {
 	defer goleak.VerifyNone(t)
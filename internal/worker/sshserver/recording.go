@@ -0,0 +1,207 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/domain/sshsession"
+	"github.com/juju/juju/internal/ssh/recording"
+)
+
+const (
+	// defaultRecordingWidth and defaultRecordingHeight are the dimensions
+	// of the terminal recorded for sessions without a pty.
+	defaultRecordingWidth  = 80
+	defaultRecordingHeight = 24
+)
+
+// SessionRecordingService is the interface that the server uses to store the
+// recordings of the sessions it proxies.
+type SessionRecordingService interface {
+	// RecordSession stores the recording of a session read from r, along
+	// with the details of the session.
+	RecordSession(ctx context.Context, args sshsession.RecordSessionArgs, r io.Reader, size int64) (string, error)
+}
+
+// handleRecordedSession proxies the session with the session handler,
+// recording its output and storing the recording once the session ends.
+// If the recording cannot be started the session is refused, so that no
+// session goes unrecorded.
+func (s *ServerWorker) handleRecordedSession(userName string, session ssh.Session, info virtualhostname.Info) {
+	ctx := s.tomb.Context(context.Background())
+
+	f, err := os.CreateTemp("", "ssh-session-*.cast")
+	if err != nil {
+		s.refuseSession(ctx, session, errors.Annotate(err, "creating session recording"))
+		return
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	header := recording.Header{
+		Width:   defaultRecordingWidth,
+		Height:  defaultRecordingHeight,
+		Command: session.RawCommand(),
+		Title:   userName + "@" + info.String(),
+	}
+	if pty, _, isPty := session.Pty(); isPty {
+		header.Width = pty.Window.Width
+		header.Height = pty.Window.Height
+		header.Env = map[string]string{"TERM": pty.Term}
+	}
+
+	startedAt := s.clock.Now()
+	recorder, err := recording.NewRecorder(f, s.clock, header)
+	if err != nil {
+		s.refuseSession(ctx, session, errors.Annotate(err, "starting session recording"))
+		return
+	}
+
+	recorded := newRecordedSession(session, recorder)
+	s.config.SessionHandler.Handle(recorded, info)
+
+	exitCode := recorded.exitCode()
+	recorder.Exit(exitCode)
+	if err := recorder.Err(); err != nil {
+		s.config.Logger.Errorf(ctx, "recording ssh session of %q to %q: %v", userName, info.String(), err)
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		s.config.Logger.Errorf(ctx, "reading ssh session recording of %q to %q: %v", userName, info.String(), err)
+		return
+	}
+
+	_, err = s.config.SessionRecordingService.RecordSession(ctx, sshsession.RecordSessionArgs{
+		UserName:  userName,
+		ModelUUID: info.ModelUUID(),
+		Target:    info.String(),
+		Command:   session.RawCommand(),
+		StartedAt: startedAt,
+		EndedAt:   s.clock.Now(),
+		ExitCode:  exitCode,
+	}, f, size)
+	if err != nil {
+		s.config.Logger.Errorf(ctx, "storing ssh session recording of %q to %q: %v", userName, info.String(), err)
+	}
+}
+
+// refuseSession reports the error to the user and ends the session.
+func (s *ServerWorker) refuseSession(ctx context.Context, session ssh.Session, err error) {
+	s.config.Logger.Errorf(ctx, "refusing ssh session: %v", err)
+	_, _ = session.Stderr().Write([]byte("session recording unavailable\n"))
+	_ = session.Exit(1)
+}
+
+// recordedSession is an ssh.Session whose output, window size changes and
+// exit code are recorded.
+type recordedSession struct {
+	ssh.Session
+
+	recorder *recording.Recorder
+
+	ptyOnce sync.Once
+	pty     ssh.Pty
+	winCh   <-chan ssh.Window
+	isPty   bool
+
+	mu     sync.Mutex
+	exited bool
+	code   int
+}
+
+func newRecordedSession(session ssh.Session, recorder *recording.Recorder) *recordedSession {
+	return &recordedSession{
+		Session:  session,
+		recorder: recorder,
+	}
+}
+
+// Write writes the data to the session and records it.
+func (s *recordedSession) Write(p []byte) (int, error) {
+	n, err := s.Session.Write(p)
+	if n > 0 {
+		_, _ = s.recorder.Write(p[:n])
+	}
+	return n, err
+}
+
+// Stderr returns a ReadWriter for the session's stderr whose writes are
+// recorded along with the session's output.
+func (s *recordedSession) Stderr() io.ReadWriter {
+	return recordedStderr{
+		ReadWriter: s.Session.Stderr(),
+		recorder:   s.recorder,
+	}
+}
+
+// Pty returns the pty of the session, recording any window size changes.
+func (s *recordedSession) Pty() (ssh.Pty, <-chan ssh.Window, bool) {
+	s.ptyOnce.Do(func() {
+		pty, winCh, isPty := s.Session.Pty()
+		s.pty, s.isPty = pty, isPty
+		if !isPty {
+			s.winCh = winCh
+			return
+		}
+		out := make(chan ssh.Window, 1)
+		go func() {
+			defer close(out)
+			for w := range winCh {
+				s.recorder.Resize(w.Width, w.Height)
+				out <- w
+			}
+		}()
+		s.winCh = out
+	})
+	return s.pty, s.winCh, s.isPty
+}
+
+// Exit records the exit code and sends it to the client.
+func (s *recordedSession) Exit(code int) error {
+	s.mu.Lock()
+	if !s.exited {
+		s.exited = true
+		s.code = code
+	}
+	s.mu.Unlock()
+	return s.Session.Exit(code)
+}
+
+// exitCode returns the exit code of the session. A session which ended
+// without an explicit exit code exited successfully.
+func (s *recordedSession) exitCode() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.code
+}
+
+type recordedStderr struct {
+	io.ReadWriter
+	recorder *recording.Recorder
+}
+
+// Write writes the data to stderr and records it.
+func (s recordedStderr) Write(p []byte) (int, error) {
+	n, err := s.ReadWriter.Write(p)
+	if n > 0 {
+		_, _ = s.recorder.Write(p[:n])
+	}
+	return n, err
+}
+
+var _ ssh.Session = (*recordedSession)(nil)
@@ -0,0 +1,121 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sshserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock/testclock"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/virtualhostname"
+	"github.com/juju/juju/domain/sshsession"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/ssh/recording"
+)
+
+type recordingSuite struct {
+	sessionHandler          *MockSessionHandler
+	sessionRecordingService *MockSessionRecordingService
+}
+
+var _ = gc.Suite(&recordingSuite{})
+
+func (s *recordingSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.sessionHandler = NewMockSessionHandler(ctrl)
+	s.sessionRecordingService = NewMockSessionRecordingService(ctrl)
+	return ctrl
+}
+
+func (s *recordingSuite) TestHandleRecordedSession(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	info, err := virtualhostname.Parse(testVirtualHostname)
+	c.Assert(err, jc.ErrorIsNil)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := &ServerWorker{
+		config: ServerWorkerConfig{
+			Logger:                  loggertesting.WrapCheckLog(c),
+			SessionHandler:          s.sessionHandler,
+			SessionRecordingService: s.sessionRecordingService,
+		},
+		clock: testclock.NewClock(now),
+	}
+
+	session := &userSession{clientCommand: "ls"}
+	s.sessionHandler.EXPECT().Handle(gomock.Any(), info).DoAndReturn(
+		func(session ssh.Session, _ virtualhostname.Info) {
+			_, _ = io.WriteString(session, "file\n")
+			_, _ = io.WriteString(session.Stderr(), "oops\n")
+			_ = session.Exit(2)
+		},
+	)
+
+	var recorded bytes.Buffer
+	s.sessionRecordingService.EXPECT().RecordSession(gomock.Any(), sshsession.RecordSessionArgs{
+		UserName:  "alice",
+		ModelUUID: info.ModelUUID(),
+		Target:    testVirtualHostname,
+		Command:   "ls",
+		StartedAt: now,
+		EndedAt:   now,
+		ExitCode:  2,
+	}, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sshsession.RecordSessionArgs, r io.Reader, size int64) (string, error) {
+			n, err := io.Copy(&recorded, r)
+			c.Check(err, jc.ErrorIsNil)
+			c.Check(n, gc.Equals, size)
+			return "session-uuid", nil
+		},
+	)
+
+	srv.handleRecordedSession("alice", session, info)
+
+	// The session itself is unaffected by the recording.
+	c.Check(session.stdout.String(), gc.Equals, "file\n")
+	c.Check(session.stderr.String(), gc.Equals, "oops\n")
+	c.Check(session.exitCode, gc.Equals, 2)
+
+	reader, err := recording.NewReader(&recorded)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(reader.Header().Command, gc.Equals, "ls")
+	c.Check(reader.Header().Title, gc.Equals, "alice@"+testVirtualHostname)
+	c.Check(reader.Header().Width, gc.Equals, defaultRecordingWidth)
+
+	var events []recording.Event
+	for {
+		event, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		events = append(events, event)
+	}
+	c.Check(events, jc.DeepEquals, []recording.Event{
+		{Type: recording.Output, Data: "file\n"},
+		{Type: recording.Output, Data: "oops\n"},
+		{Type: recording.Exit, Data: "2"},
+	})
+}
+
+func (s *recordingSuite) TestRecordedSessionDefaultsToSuccess(c *gc.C) {
+	var buf bytes.Buffer
+	recorder, err := recording.NewRecorder(&buf, testclock.NewClock(time.Now()), recording.Header{})
+	c.Assert(err, jc.ErrorIsNil)
+
+	session := newRecordedSession(&userSession{}, recorder)
+	c.Check(session.exitCode(), gc.Equals, 0)
+
+	_ = session.Exit(1)
+	_ = session.Exit(0)
+	c.Check(session.exitCode(), gc.Equals, 1)
+}
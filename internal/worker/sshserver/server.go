@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/gliderlabs/ssh"
+	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	gossh "golang.org/x/crypto/ssh"
@@ -20,10 +21,23 @@ import (
 
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/virtualhostname"
+	sshaccesserrors "github.com/juju/juju/domain/sshaccess/errors"
 )
 
 type authenticatedViaPublicKey struct{}
 
+// reverseTunnelID holds the ID of the tunnel a machine authenticated to
+// open.
+type reverseTunnelID struct{}
+
+// reverseTunnelChannelType is the type of the channel machines open to
+// pass a reverse tunnel to their SSH server to the controller.
+const reverseTunnelChannelType = "juju-reverse-tunnel"
+
+// reverseTunnelPushTimeout is how long a tunnel opened by a machine waits
+// for its requester, who may have given up waiting.
+const reverseTunnelPushTimeout = 10 * time.Second
+
 // SessionHandler is an interface that proxies SSH sessions to a target unit/machine.
 type SessionHandler interface {
 	Handle(s ssh.Session, destination virtualhostname.Info)
@@ -58,6 +72,47 @@ type ServerWorkerConfig struct {
 
 	// SessionHandler handles proxying SSH sessions to the target machine.
 	SessionHandler SessionHandler
+
+	// SessionRecordingService stores the recordings of the sessions
+	// proxied by the server. Sessions are not recorded when it is nil.
+	SessionRecordingService SessionRecordingService
+
+	// SSHAccessGrantService checks that users hold a grant for ssh access
+	// to the unit or machine they connect to. Sessions are terminated when
+	// the grant expires. Standing model permissions are relied on when it
+	// is nil. It requires UserCertificateService, as grants are checked for
+	// the user the certificate was issued to.
+	SSHAccessGrantService SSHAccessGrantService
+
+	// UserCertificateService checks the certificates users authenticate
+	// with. When it is set, users must authenticate with a certificate
+	// issued by the controller, and only reach the units and machines of
+	// models they have sufficient access to. Any public key is accepted
+	// when it is nil.
+	UserCertificateService UserCertificateService
+
+	// UserAccessService reads the access of users authenticated by
+	// certificate. It is required when UserCertificateService is set.
+	UserAccessService UserAccessService
+
+	// ControllerUUID is the UUID of the controller, used to check whether
+	// users authenticated by certificate are controller superusers. It is
+	// required when UserCertificateService is set.
+	ControllerUUID string
+
+	// TCPForwarder tunnels the TCP connections users forward through their
+	// session to the unit, machine or container they connected to. Port
+	// forwarding is refused when it is nil.
+	TCPForwarder TCPForwarder
+
+	// TunnelTracker authenticates machines connecting to open the reverse
+	// tunnels requested to reach them, and passes the tunnels on. Reverse
+	// tunnels are refused when it is nil.
+	TunnelTracker TunnelTracker
+
+	// Clock is used to time recorded sessions and the expiry of access
+	// grants. The wall clock is used when it is nil.
+	Clock clock.Clock
 }
 
 // Validate validates the workers configuration is as expected.
@@ -74,6 +129,19 @@ func (c ServerWorkerConfig) Validate() error {
 	if c.SessionHandler == nil {
 		return errors.NotValidf("missing SessionHandler")
 	}
+	if c.SSHAccessGrantService != nil && c.UserCertificateService == nil {
+		// Grants are held by users, so they can only be enforced once the
+		// user's identity has been verified with a certificate.
+		return errors.NotValidf("SSHAccessGrantService without UserCertificateService")
+	}
+	if c.UserCertificateService != nil {
+		if c.UserAccessService == nil {
+			return errors.NotValidf("missing UserAccessService")
+		}
+		if c.ControllerUUID == "" {
+			return errors.NotValidf("empty ControllerUUID")
+		}
+	}
 	return nil
 }
 
@@ -89,6 +157,10 @@ type ServerWorker struct {
 
 	// concurrentConnections holds the number of concurrent connections.
 	concurrentConnections atomic.Int32
+
+	// clock is used to time recorded sessions and the expiry of access
+	// grants.
+	clock clock.Clock
 }
 
 // NewServerWorker returns a running embedded SSH server.
@@ -97,7 +169,10 @@ func NewServerWorker(config ServerWorkerConfig) (worker.Worker, error) {
 		return nil, errors.Trace(err)
 	}
 
-	s := &ServerWorker{config: config}
+	s := &ServerWorker{config: config, clock: config.Clock}
+	if s.clock == nil {
+		s.clock = clock.WallClock
+	}
 
 	s.Server = s.NewJumpServer()
 
@@ -153,14 +228,27 @@ func (s *ServerWorker) NewJumpServer() *ssh.Server {
 	server := ssh.Server{
 		ConnCallback: s.connCallback(),
 		PublicKeyHandler: func(ctx ssh.Context, key ssh.PublicKey) bool {
+			if s.config.UserCertificateService != nil && !s.authenticateCertificate(ctx, key) {
+				return false
+			}
 			ctx.SetValue(authenticatedViaPublicKey{}, true)
 			return true
 		},
 		PasswordHandler: func(ctx ssh.Context, password string) bool {
-			return false
+			// Passwords are only accepted from machines opening tunnels.
+			if s.config.TunnelTracker == nil {
+				return false
+			}
+			tunnelID, err := s.config.TunnelTracker.AuthenticateTunnel(ctx.User(), password)
+			if err != nil {
+				return false
+			}
+			ctx.SetValue(reverseTunnelID{}, tunnelID)
+			return true
 		},
 		ChannelHandlers: map[string]ssh.ChannelHandler{
-			"direct-tcpip": s.directTCPIPHandler,
+			"direct-tcpip":           s.directTCPIPHandler,
+			reverseTunnelChannelType: s.reverseTunnelHandler,
 		},
 	}
 
@@ -207,12 +295,49 @@ func (s *ServerWorker) directTCPIPHandler(srv *ssh.Server, conn *gossh.ServerCon
 		}
 		return
 	}
+	if _, ok := ctx.Value(reverseTunnelID{}).(string); ok {
+		// Machines opening tunnels can't jump to other machines.
+		s.rejectChannel(ctx, newChan, "Permission denied")
+		return
+	}
 	info, err := virtualhostname.Parse(d.DestAddr)
 	if err != nil {
 		s.rejectChannel(ctx, newChan, "Failed to parse destination address")
 		return
 	}
 
+	userName := ctx.User()
+	if s.config.UserCertificateService != nil {
+		var verified bool
+		userName, verified = certificateUser(ctx)
+		if !verified {
+			s.rejectChannel(ctx, newChan, "Permission denied")
+			return
+		}
+		permitted, err := s.checkTargetAccess(ctx, userName, info)
+		if err != nil {
+			s.config.Logger.Errorf(ctx, "failed to check access of %q to %q: %v", userName, info.String(), err)
+			s.rejectChannel(ctx, newChan, "Failed to check access")
+			return
+		} else if !permitted {
+			s.rejectChannel(ctx, newChan, "Permission denied")
+			return
+		}
+	}
+
+	var grantExpiry time.Time
+	if s.config.SSHAccessGrantService != nil {
+		grantExpiry, err = s.checkAccessGrant(ctx, userName, info)
+		if errors.Is(err, sshaccesserrors.NoActiveGrant) {
+			s.rejectChannel(ctx, newChan, "No active ssh access grant")
+			return
+		} else if err != nil {
+			s.config.Logger.Errorf(ctx, "failed to check ssh access grant: %v", err)
+			s.rejectChannel(ctx, newChan, "Failed to check ssh access grant")
+			return
+		}
+	}
+
 	ch, reqs, err := newChan.Accept()
 	if err != nil {
 		return
@@ -242,9 +367,42 @@ func (s *ServerWorker) directTCPIPHandler(srv *ssh.Server, conn *gossh.ServerCon
 	}
 
 	server.AddHostKey(signer)
+
+	if s.config.SSHAccessGrantService != nil {
+		done := make(chan struct{})
+		defer close(done)
+		go s.terminateAtGrantExpiry(ch, done, userName, info, grantExpiry)
+	}
 	server.HandleConn(newChannelConn(ch))
 }
 
+// reverseTunnelHandler handles the channels machines open to pass the
+// tunnels to their SSH server requested by the controller. The channel is
+// passed to the requester, who closes it when done.
+func (s *ServerWorker) reverseTunnelHandler(srv *ssh.Server, conn *gossh.ServerConn, newChan gossh.NewChannel, ctx ssh.Context) {
+	tunnelID, ok := ctx.Value(reverseTunnelID{}).(string)
+	if !ok {
+		err := newChan.Reject(gossh.Prohibited, "Permission denied")
+		if err != nil {
+			s.config.Logger.Errorf(ctx, "failed to reject channel: %v", err)
+		}
+		return
+	}
+
+	ch, reqs, err := newChan.Accept()
+	if err != nil {
+		return
+	}
+	go gossh.DiscardRequests(reqs)
+
+	pushCtx, cancel := context.WithTimeout(ctx, reverseTunnelPushTimeout)
+	defer cancel()
+	if err := s.config.TunnelTracker.PushTunnel(pushCtx, tunnelID, newChannelConn(ch)); err != nil {
+		s.config.Logger.Infof(ctx, "failed to pass on tunnel %q: %v", tunnelID, err)
+		_ = ch.Close()
+	}
+}
+
 // connCallback returns a connCallback function that limits the number of concurrent connections.
 func (s *ServerWorker) connCallback() ssh.ConnCallback {
 	return func(ctx ssh.Context, conn net.Conn) net.Conn {
@@ -281,23 +439,24 @@ func (s *ServerWorker) newEmbeddedSSHServer(ctx ssh.Context, info virtualhostnam
 		PublicKeyHandler: func(ctx ssh.Context, keyPresented ssh.PublicKey) bool {
 			return true
 		},
-		LocalPortForwardingCallback: ssh.LocalPortForwardingCallback(func(ctx ssh.Context, dhost string, dport uint32) bool {
-			return true
-		}),
 		// ReversePortForwarding will not be supported.
 		ReversePortForwardingCallback: ssh.ReversePortForwardingCallback(func(ctx ssh.Context, host string, port uint32) bool {
 			return false
 		}),
 		ChannelHandlers: map[string]ssh.ChannelHandler{
 			"session":      ssh.DefaultSessionHandler,
-			"direct-tcpip": ssh.DirectTCPIPHandler,
+			"direct-tcpip": s.forwardTCPIPHandler(info),
 		},
 		RequestHandlers: map[string]ssh.RequestHandler{
 			"tcpip-forward":        forwardHandler.HandleSSHRequest,
 			"cancel-tcpip-forward": forwardHandler.HandleSSHRequest,
 		},
 		Handler: func(session ssh.Session) {
-			s.config.SessionHandler.Handle(session, info)
+			if s.config.SessionRecordingService == nil {
+				s.config.SessionHandler.Handle(session, info)
+				return
+			}
+			s.handleRecordedSession(ctx.User(), session, info)
 		},
 	}
 
@@ -4,9 +4,13 @@
 package sshserver
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"fmt"
+	"io"
+	"net"
+	"time"
 
 	"github.com/gliderlabs/ssh"
 	"github.com/juju/errors"
@@ -262,3 +266,94 @@ func (s *sshServerSuite) TestSSHWorkerReport(c *gc.C) {
 		"concurrent_connections": int32(1),
 	})
 }
+
+func (s *sshServerSuite) TestReverseTunnel(c *gc.C) {
+	ctrl := s.SetUpMocks(c)
+	defer ctrl.Finish()
+
+	tunnelTracker := NewMockTunnelTracker(ctrl)
+	tunnelTracker.EXPECT().AuthenticateTunnel("juju-reverse-tunnel", "tunnel-password").Return("tunnel-id", nil)
+
+	pushed := make(chan net.Conn, 1)
+	tunnelTracker.EXPECT().PushTunnel(gomock.Any(), "tunnel-id", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, conn net.Conn) error {
+			pushed <- conn
+			return nil
+		},
+	)
+
+	listener := bufconn.Listen(1024)
+	server, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Listener:                 listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		NewSSHServerListener:     newTestingSSHServerListener,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		SessionHandler:           s.sessionHandler,
+		TunnelTracker:            tunnelTracker,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, server)
+
+	// The machine authenticates with the password of the requested tunnel.
+	client := inMemoryDial(c, listener, &gossh.ClientConfig{
+		User:            "juju-reverse-tunnel",
+		Auth:            []gossh.AuthMethod{gossh.Password("tunnel-password")},
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+	})
+	defer client.Close()
+
+	// The machine can't jump to other machines.
+	_, err = client.Dial("tcp", testVirtualHostname+":22")
+	c.Check(err, gc.ErrorMatches, ".*Permission denied.*")
+
+	// The channel the machine opens is passed on as the tunnel.
+	ch, reqs, err := client.OpenChannel(reverseTunnelChannelType, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	go gossh.DiscardRequests(reqs)
+
+	var tunnel net.Conn
+	select {
+	case tunnel = <-pushed:
+	case <-time.After(jujutesting.LongWait):
+		c.Fatalf("timed out waiting for tunnel")
+	}
+	_, err = ch.Write([]byte("SSH-2.0-machine\r\n"))
+	c.Assert(err, jc.ErrorIsNil)
+	buf := make([]byte, 17)
+	_, err = io.ReadFull(tunnel, buf)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(buf), gc.Equals, "SSH-2.0-machine\r\n")
+
+	_ = tunnel.Close()
+	workertest.CleanKill(c, server)
+}
+
+func (s *sshServerSuite) TestReverseTunnelRefusedForUsers(c *gc.C) {
+	defer s.SetUpMocks(c).Finish()
+
+	listener := bufconn.Listen(1024)
+	server, err := NewServerWorker(ServerWorkerConfig{
+		Logger:                   loggertesting.WrapCheckLog(c),
+		Listener:                 listener,
+		JumpHostKey:              jujutesting.SSHServerHostKey,
+		NewSSHServerListener:     newTestingSSHServerListener,
+		MaxConcurrentConnections: maxConcurrentConnections,
+		SessionHandler:           s.sessionHandler,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, server)
+
+	// Users authenticated by public key can't pass tunnels on.
+	client := inMemoryDial(c, listener, &gossh.ClientConfig{
+		User:            "alice",
+		Auth:            []gossh.AuthMethod{gossh.PublicKeys(s.userSigner)},
+		HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+	})
+	defer client.Close()
+
+	_, _, err = client.OpenChannel(reverseTunnelChannelType, nil)
+	c.Check(err, gc.ErrorMatches, ".*Permission denied.*")
+
+	workertest.CleanKill(c, server)
+}
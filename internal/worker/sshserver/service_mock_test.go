@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: ControllerConfigService,SessionHandler)
+// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: ControllerConfigService,SessionHandler,SessionRecordingService,SSHAccessGrantService,UserCertificateService,UserAccessService,TCPForwarder,ModelService,ApplicationService,TunnelTracker)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler
+//	mockgen -typed -package sshserver -destination service_mock_test.go github.com/juju/juju/internal/worker/sshserver ControllerConfigService,SessionHandler,SessionRecordingService,SSHAccessGrantService,UserCertificateService,UserAccessService,TCPForwarder,ModelService,ApplicationService,TunnelTracker
 //
 
 // Package sshserver is a generated GoMock package.
@@ -11,13 +11,24 @@ package sshserver
 
 import (
 	context "context"
+	io "io"
+	net "net"
 	reflect "reflect"
+	time "time"
 
 	ssh "github.com/gliderlabs/ssh"
 	controller "github.com/juju/juju/controller"
+	machine "github.com/juju/juju/core/machine"
+	model "github.com/juju/juju/core/model"
+	permission "github.com/juju/juju/core/permission"
+	unit "github.com/juju/juju/core/unit"
+	user "github.com/juju/juju/core/user"
 	virtualhostname "github.com/juju/juju/core/virtualhostname"
 	watcher "github.com/juju/juju/core/watcher"
+	sshsession "github.com/juju/juju/domain/sshsession"
+	sshtunneler "github.com/juju/juju/internal/sshtunneler"
 	gomock "go.uber.org/mock/gomock"
+	ssh0 "golang.org/x/crypto/ssh"
 )
 
 // MockControllerConfigService is a mock of ControllerConfigService interface.
@@ -179,3 +190,575 @@ func (c *MockSessionHandlerHandleCall) DoAndReturn(f func(ssh.Session, virtualho
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSessionRecordingService is a mock of SessionRecordingService interface.
+type MockSessionRecordingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRecordingServiceMockRecorder
+}
+
+// MockSessionRecordingServiceMockRecorder is the mock recorder for MockSessionRecordingService.
+type MockSessionRecordingServiceMockRecorder struct {
+	mock *MockSessionRecordingService
+}
+
+// NewMockSessionRecordingService creates a new mock instance.
+func NewMockSessionRecordingService(ctrl *gomock.Controller) *MockSessionRecordingService {
+	mock := &MockSessionRecordingService{ctrl: ctrl}
+	mock.recorder = &MockSessionRecordingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRecordingService) EXPECT() *MockSessionRecordingServiceMockRecorder {
+	return m.recorder
+}
+
+// RecordSession mocks base method.
+func (m *MockSessionRecordingService) RecordSession(arg0 context.Context, arg1 sshsession.RecordSessionArgs, arg2 io.Reader, arg3 int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSession", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordSession indicates an expected call of RecordSession.
+func (mr *MockSessionRecordingServiceMockRecorder) RecordSession(arg0, arg1, arg2, arg3 any) *MockSessionRecordingServiceRecordSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSession", reflect.TypeOf((*MockSessionRecordingService)(nil).RecordSession), arg0, arg1, arg2, arg3)
+	return &MockSessionRecordingServiceRecordSessionCall{Call: call}
+}
+
+// MockSessionRecordingServiceRecordSessionCall wrap *gomock.Call
+type MockSessionRecordingServiceRecordSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSessionRecordingServiceRecordSessionCall) Return(arg0 string, arg1 error) *MockSessionRecordingServiceRecordSessionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSessionRecordingServiceRecordSessionCall) Do(f func(context.Context, sshsession.RecordSessionArgs, io.Reader, int64) (string, error)) *MockSessionRecordingServiceRecordSessionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSessionRecordingServiceRecordSessionCall) DoAndReturn(f func(context.Context, sshsession.RecordSessionArgs, io.Reader, int64) (string, error)) *MockSessionRecordingServiceRecordSessionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSSHAccessGrantService is a mock of SSHAccessGrantService interface.
+type MockSSHAccessGrantService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHAccessGrantServiceMockRecorder
+}
+
+// MockSSHAccessGrantServiceMockRecorder is the mock recorder for MockSSHAccessGrantService.
+type MockSSHAccessGrantServiceMockRecorder struct {
+	mock *MockSSHAccessGrantService
+}
+
+// NewMockSSHAccessGrantService creates a new mock instance.
+func NewMockSSHAccessGrantService(ctrl *gomock.Controller) *MockSSHAccessGrantService {
+	mock := &MockSSHAccessGrantService{ctrl: ctrl}
+	mock.recorder = &MockSSHAccessGrantServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHAccessGrantService) EXPECT() *MockSSHAccessGrantServiceMockRecorder {
+	return m.recorder
+}
+
+// GrantExpiry mocks base method.
+func (m *MockSSHAccessGrantService) GrantExpiry(arg0 context.Context, arg1, arg2, arg3 string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantExpiry", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantExpiry indicates an expected call of GrantExpiry.
+func (mr *MockSSHAccessGrantServiceMockRecorder) GrantExpiry(arg0, arg1, arg2, arg3 any) *MockSSHAccessGrantServiceGrantExpiryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantExpiry", reflect.TypeOf((*MockSSHAccessGrantService)(nil).GrantExpiry), arg0, arg1, arg2, arg3)
+	return &MockSSHAccessGrantServiceGrantExpiryCall{Call: call}
+}
+
+// MockSSHAccessGrantServiceGrantExpiryCall wrap *gomock.Call
+type MockSSHAccessGrantServiceGrantExpiryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSSHAccessGrantServiceGrantExpiryCall) Return(arg0 time.Time, arg1 error) *MockSSHAccessGrantServiceGrantExpiryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSSHAccessGrantServiceGrantExpiryCall) Do(f func(context.Context, string, string, string) (time.Time, error)) *MockSSHAccessGrantServiceGrantExpiryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSSHAccessGrantServiceGrantExpiryCall) DoAndReturn(f func(context.Context, string, string, string) (time.Time, error)) *MockSSHAccessGrantServiceGrantExpiryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockUserCertificateService is a mock of UserCertificateService interface.
+type MockUserCertificateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserCertificateServiceMockRecorder
+}
+
+// MockUserCertificateServiceMockRecorder is the mock recorder for MockUserCertificateService.
+type MockUserCertificateServiceMockRecorder struct {
+	mock *MockUserCertificateService
+}
+
+// NewMockUserCertificateService creates a new mock instance.
+func NewMockUserCertificateService(ctrl *gomock.Controller) *MockUserCertificateService {
+	mock := &MockUserCertificateService{ctrl: ctrl}
+	mock.recorder = &MockUserCertificateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserCertificateService) EXPECT() *MockUserCertificateServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckUserCertificate mocks base method.
+func (m *MockUserCertificateService) CheckUserCertificate(arg0 context.Context, arg1 *ssh0.Certificate, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserCertificate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUserCertificate indicates an expected call of CheckUserCertificate.
+func (mr *MockUserCertificateServiceMockRecorder) CheckUserCertificate(arg0, arg1, arg2 any) *MockUserCertificateServiceCheckUserCertificateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserCertificate", reflect.TypeOf((*MockUserCertificateService)(nil).CheckUserCertificate), arg0, arg1, arg2)
+	return &MockUserCertificateServiceCheckUserCertificateCall{Call: call}
+}
+
+// MockUserCertificateServiceCheckUserCertificateCall wrap *gomock.Call
+type MockUserCertificateServiceCheckUserCertificateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserCertificateServiceCheckUserCertificateCall) Return(arg0 error) *MockUserCertificateServiceCheckUserCertificateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserCertificateServiceCheckUserCertificateCall) Do(f func(context.Context, *ssh0.Certificate, string) error) *MockUserCertificateServiceCheckUserCertificateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserCertificateServiceCheckUserCertificateCall) DoAndReturn(f func(context.Context, *ssh0.Certificate, string) error) *MockUserCertificateServiceCheckUserCertificateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockUserAccessService is a mock of UserAccessService interface.
+type MockUserAccessService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserAccessServiceMockRecorder
+}
+
+// MockUserAccessServiceMockRecorder is the mock recorder for MockUserAccessService.
+type MockUserAccessServiceMockRecorder struct {
+	mock *MockUserAccessService
+}
+
+// NewMockUserAccessService creates a new mock instance.
+func NewMockUserAccessService(ctrl *gomock.Controller) *MockUserAccessService {
+	mock := &MockUserAccessService{ctrl: ctrl}
+	mock.recorder = &MockUserAccessServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserAccessService) EXPECT() *MockUserAccessServiceMockRecorder {
+	return m.recorder
+}
+
+// ReadUserAccessLevelForTarget mocks base method.
+func (m *MockUserAccessService) ReadUserAccessLevelForTarget(arg0 context.Context, arg1 user.Name, arg2 permission.ID) (permission.Access, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUserAccessLevelForTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(permission.Access)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUserAccessLevelForTarget indicates an expected call of ReadUserAccessLevelForTarget.
+func (mr *MockUserAccessServiceMockRecorder) ReadUserAccessLevelForTarget(arg0, arg1, arg2 any) *MockUserAccessServiceReadUserAccessLevelForTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUserAccessLevelForTarget", reflect.TypeOf((*MockUserAccessService)(nil).ReadUserAccessLevelForTarget), arg0, arg1, arg2)
+	return &MockUserAccessServiceReadUserAccessLevelForTargetCall{Call: call}
+}
+
+// MockUserAccessServiceReadUserAccessLevelForTargetCall wrap *gomock.Call
+type MockUserAccessServiceReadUserAccessLevelForTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserAccessServiceReadUserAccessLevelForTargetCall) Return(arg0 permission.Access, arg1 error) *MockUserAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserAccessServiceReadUserAccessLevelForTargetCall) Do(f func(context.Context, user.Name, permission.ID) (permission.Access, error)) *MockUserAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserAccessServiceReadUserAccessLevelForTargetCall) DoAndReturn(f func(context.Context, user.Name, permission.ID) (permission.Access, error)) *MockUserAccessServiceReadUserAccessLevelForTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockTCPForwarder is a mock of TCPForwarder interface.
+type MockTCPForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTCPForwarderMockRecorder
+}
+
+// MockTCPForwarderMockRecorder is the mock recorder for MockTCPForwarder.
+type MockTCPForwarderMockRecorder struct {
+	mock *MockTCPForwarder
+}
+
+// NewMockTCPForwarder creates a new mock instance.
+func NewMockTCPForwarder(ctrl *gomock.Controller) *MockTCPForwarder {
+	mock := &MockTCPForwarder{ctrl: ctrl}
+	mock.recorder = &MockTCPForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTCPForwarder) EXPECT() *MockTCPForwarderMockRecorder {
+	return m.recorder
+}
+
+// DialTarget mocks base method.
+func (m *MockTCPForwarder) DialTarget(arg0 context.Context, arg1 virtualhostname.Info, arg2 string) (net.Conn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DialTarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(net.Conn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DialTarget indicates an expected call of DialTarget.
+func (mr *MockTCPForwarderMockRecorder) DialTarget(arg0, arg1, arg2 any) *MockTCPForwarderDialTargetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DialTarget", reflect.TypeOf((*MockTCPForwarder)(nil).DialTarget), arg0, arg1, arg2)
+	return &MockTCPForwarderDialTargetCall{Call: call}
+}
+
+// MockTCPForwarderDialTargetCall wrap *gomock.Call
+type MockTCPForwarderDialTargetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTCPForwarderDialTargetCall) Return(arg0 net.Conn, arg1 error) *MockTCPForwarderDialTargetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTCPForwarderDialTargetCall) Do(f func(context.Context, virtualhostname.Info, string) (net.Conn, error)) *MockTCPForwarderDialTargetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTCPForwarderDialTargetCall) DoAndReturn(f func(context.Context, virtualhostname.Info, string) (net.Conn, error)) *MockTCPForwarderDialTargetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock *MockModelService
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelType mocks base method.
+func (m *MockModelService) ModelType(arg0 context.Context, arg1 model.UUID) (model.ModelType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelType", arg0, arg1)
+	ret0, _ := ret[0].(model.ModelType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelType indicates an expected call of ModelType.
+func (mr *MockModelServiceMockRecorder) ModelType(arg0, arg1 any) *MockModelServiceModelTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelType", reflect.TypeOf((*MockModelService)(nil).ModelType), arg0, arg1)
+	return &MockModelServiceModelTypeCall{Call: call}
+}
+
+// MockModelServiceModelTypeCall wrap *gomock.Call
+type MockModelServiceModelTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelServiceModelTypeCall) Return(arg0 model.ModelType, arg1 error) *MockModelServiceModelTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelServiceModelTypeCall) Do(f func(context.Context, model.UUID) (model.ModelType, error)) *MockModelServiceModelTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelServiceModelTypeCall) DoAndReturn(f func(context.Context, model.UUID) (model.ModelType, error)) *MockModelServiceModelTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetUnitMachineName mocks base method.
+func (m *MockApplicationService) GetUnitMachineName(arg0 context.Context, arg1 unit.Name) (machine.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitMachineName", arg0, arg1)
+	ret0, _ := ret[0].(machine.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitMachineName indicates an expected call of GetUnitMachineName.
+func (mr *MockApplicationServiceMockRecorder) GetUnitMachineName(arg0, arg1 any) *MockApplicationServiceGetUnitMachineNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitMachineName", reflect.TypeOf((*MockApplicationService)(nil).GetUnitMachineName), arg0, arg1)
+	return &MockApplicationServiceGetUnitMachineNameCall{Call: call}
+}
+
+// MockApplicationServiceGetUnitMachineNameCall wrap *gomock.Call
+type MockApplicationServiceGetUnitMachineNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetUnitMachineNameCall) Return(arg0 machine.Name, arg1 error) *MockApplicationServiceGetUnitMachineNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetUnitMachineNameCall) Do(f func(context.Context, unit.Name) (machine.Name, error)) *MockApplicationServiceGetUnitMachineNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetUnitMachineNameCall) DoAndReturn(f func(context.Context, unit.Name) (machine.Name, error)) *MockApplicationServiceGetUnitMachineNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockTunnelTracker is a mock of TunnelTracker interface.
+type MockTunnelTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockTunnelTrackerMockRecorder
+}
+
+// MockTunnelTrackerMockRecorder is the mock recorder for MockTunnelTracker.
+type MockTunnelTrackerMockRecorder struct {
+	mock *MockTunnelTracker
+}
+
+// NewMockTunnelTracker creates a new mock instance.
+func NewMockTunnelTracker(ctrl *gomock.Controller) *MockTunnelTracker {
+	mock := &MockTunnelTracker{ctrl: ctrl}
+	mock.recorder = &MockTunnelTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTunnelTracker) EXPECT() *MockTunnelTrackerMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateTunnel mocks base method.
+func (m *MockTunnelTracker) AuthenticateTunnel(arg0, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateTunnel", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateTunnel indicates an expected call of AuthenticateTunnel.
+func (mr *MockTunnelTrackerMockRecorder) AuthenticateTunnel(arg0, arg1 any) *MockTunnelTrackerAuthenticateTunnelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateTunnel", reflect.TypeOf((*MockTunnelTracker)(nil).AuthenticateTunnel), arg0, arg1)
+	return &MockTunnelTrackerAuthenticateTunnelCall{Call: call}
+}
+
+// MockTunnelTrackerAuthenticateTunnelCall wrap *gomock.Call
+type MockTunnelTrackerAuthenticateTunnelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTunnelTrackerAuthenticateTunnelCall) Return(arg0 string, arg1 error) *MockTunnelTrackerAuthenticateTunnelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTunnelTrackerAuthenticateTunnelCall) Do(f func(string, string) (string, error)) *MockTunnelTrackerAuthenticateTunnelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTunnelTrackerAuthenticateTunnelCall) DoAndReturn(f func(string, string) (string, error)) *MockTunnelTrackerAuthenticateTunnelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PushTunnel mocks base method.
+func (m *MockTunnelTracker) PushTunnel(arg0 context.Context, arg1 string, arg2 net.Conn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushTunnel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushTunnel indicates an expected call of PushTunnel.
+func (mr *MockTunnelTrackerMockRecorder) PushTunnel(arg0, arg1, arg2 any) *MockTunnelTrackerPushTunnelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushTunnel", reflect.TypeOf((*MockTunnelTracker)(nil).PushTunnel), arg0, arg1, arg2)
+	return &MockTunnelTrackerPushTunnelCall{Call: call}
+}
+
+// MockTunnelTrackerPushTunnelCall wrap *gomock.Call
+type MockTunnelTrackerPushTunnelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTunnelTrackerPushTunnelCall) Return(arg0 error) *MockTunnelTrackerPushTunnelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTunnelTrackerPushTunnelCall) Do(f func(context.Context, string, net.Conn) error) *MockTunnelTrackerPushTunnelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTunnelTrackerPushTunnelCall) DoAndReturn(f func(context.Context, string, net.Conn) error) *MockTunnelTrackerPushTunnelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequestTunnel mocks base method.
+func (m *MockTunnelTracker) RequestTunnel(arg0 context.Context, arg1 sshtunneler.RequestArgs) (*ssh0.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestTunnel", arg0, arg1)
+	ret0, _ := ret[0].(*ssh0.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestTunnel indicates an expected call of RequestTunnel.
+func (mr *MockTunnelTrackerMockRecorder) RequestTunnel(arg0, arg1 any) *MockTunnelTrackerRequestTunnelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestTunnel", reflect.TypeOf((*MockTunnelTracker)(nil).RequestTunnel), arg0, arg1)
+	return &MockTunnelTrackerRequestTunnelCall{Call: call}
+}
+
+// MockTunnelTrackerRequestTunnelCall wrap *gomock.Call
+type MockTunnelTrackerRequestTunnelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTunnelTrackerRequestTunnelCall) Return(arg0 *ssh0.Client, arg1 error) *MockTunnelTrackerRequestTunnelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTunnelTrackerRequestTunnelCall) Do(f func(context.Context, sshtunneler.RequestArgs) (*ssh0.Client, error)) *MockTunnelTrackerRequestTunnelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTunnelTrackerRequestTunnelCall) DoAndReturn(f func(context.Context, sshtunneler.RequestArgs) (*ssh0.Client, error)) *MockTunnelTrackerRequestTunnelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
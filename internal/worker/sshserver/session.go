@@ -5,34 +5,77 @@ package sshserver
 
 import (
 	"context"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/gliderlabs/ssh"
 	"github.com/juju/errors"
+	"github.com/kballard/go-shellquote"
 	gossh "golang.org/x/crypto/ssh"
 
+	"github.com/juju/juju/caas"
+	k8sconstants "github.com/juju/juju/caas/kubernetes/provider/constants"
 	"github.com/juju/juju/core/logger"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/virtualhostname"
-	"github.com/juju/juju/state"
 )
 
-type stubSessionHandler struct{}
+// SSHConnector is an interface that defines the methods required to
+// connect to the SSH server of the machine a destination is on.
+type SSHConnector interface {
+	Connect(ctx context.Context, destination virtualhostname.Info) (*gossh.Client, error)
+}
 
-// Handle is a stub implementation of the SessionHandler interface.
-// It currently does nothing but will be replaced with a real implementation
-// that proxies user's requests to the target unit or machine.
-func (s *stubSessionHandler) Handle(session ssh.Session, destination virtualhostname.Info) {
+// PodDialer is an interface that defines the methods required to connect
+// to the ports of the pods of k8s units.
+type PodDialer interface {
+	// DialPod dials the port of the named pod in the model.
+	DialPod(ctx context.Context, modelUUID coremodel.UUID, podName string, port int) (net.Conn, error)
 }
 
-// SSHConnector is an interface that defines the methods required to
-// connect to a remote SSH server.
-type SSHConnector interface {
-	Connect(destination virtualhostname.Info) (*gossh.Client, error)
+// PodExecer is an interface that defines the methods required to run
+// commands in the containers of the pods of k8s units.
+type PodExecer interface {
+	// ExecPod runs a command in a container of a pod in the model.
+	ExecPod(ctx context.Context, modelUUID coremodel.UUID, args caas.ExecPodArgs) error
+}
+
+// ModelService is the interface that the handler uses to find the type of
+// the model a destination is in.
+type ModelService interface {
+	// ModelType returns the type of the model.
+	ModelType(ctx context.Context, uuid coremodel.UUID) (coremodel.ModelType, error)
 }
 
 type sessionHandler struct {
-	connector SSHConnector
-	modelType state.ModelType
-	logger    logger.Logger
+	connector    SSHConnector
+	podDialer    PodDialer
+	podExecer    PodExecer
+	modelService ModelService
+	logger       logger.Logger
+}
+
+// newSessionHandler returns a handler proxying sessions and forwarded
+// connections to machines through the connector, and to k8s units through
+// the pod execer and dialer.
+func newSessionHandler(connector SSHConnector, podDialer PodDialer, podExecer PodExecer, modelService ModelService, logger logger.Logger) *sessionHandler {
+	return &sessionHandler{
+		connector:    connector,
+		podDialer:    podDialer,
+		podExecer:    podExecer,
+		modelService: modelService,
+		logger:       logger,
+	}
+}
+
+// modelType returns the type of the destination's model.
+func (s *sessionHandler) modelType(ctx context.Context, destination virtualhostname.Info) (coremodel.ModelType, error) {
+	modelType, err := s.modelService.ModelType(ctx, coremodel.UUID(destination.ModelUUID()))
+	if err != nil {
+		return "", errors.Annotatef(err, "getting type of model %q", destination.ModelUUID())
+	}
+	return modelType, nil
 }
 
 // Handle proxies a user's SSH session to a target unit or machines.
@@ -45,28 +88,173 @@ func (s *sessionHandler) Handle(session ssh.Session, destination virtualhostname
 		_ = session.Exit(1)
 	}
 
-	switch s.modelType {
-	case state.ModelTypeCAAS:
-		if err := s.k8sSessionProxy(session); err != nil {
+	modelType, err := s.modelType(session.Context(), destination)
+	if err != nil {
+		handleError(err)
+		return
+	}
+
+	switch modelType {
+	case coremodel.CAAS:
+		err := s.k8sSessionProxy(session, destination)
+		// As for machines, the exit status of a command which failed in
+		// the container is passed on to the user.
+		var exitErr exitStatusError
+		if errors.As(err, &exitErr) {
+			_ = session.Exit(exitErr.ExitStatus())
+		} else if err != nil {
 			err = errors.Annotate(err, "failed to proxy k8s session")
 			handleError(err)
 		}
-	case state.ModelTypeIAAS:
-		if err := s.machineSessionProxy(session, destination); err != nil {
+	case coremodel.IAAS:
+		err := s.machineSessionProxy(session, destination)
+		// A command which failed on the machine is not a proxy failure,
+		// its exit status is passed on to the user.
+		var exitErr *gossh.ExitError
+		if errors.As(err, &exitErr) {
+			_ = session.Exit(exitErr.ExitStatus())
+		} else if err != nil {
 			err = errors.Annotate(err, "failed to proxy machine session")
 			handleError(err)
 		}
 	default:
-		handleError(errors.Errorf("unknown model type %s", s.modelType))
+		handleError(errors.Errorf("unknown model type %s", modelType))
+	}
+}
+
+// DialTarget dials the address from the target unit or machine. Connections
+// from machines are tunnelled through the machine's SSH server, connections
+// to k8s units are forwarded to the unit's pod by the k8s API server.
+func (s *sessionHandler) DialTarget(ctx context.Context, destination virtualhostname.Info, addr string) (net.Conn, error) {
+	modelType, err := s.modelType(ctx, destination)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	switch modelType {
+	case coremodel.CAAS:
+		return s.dialPod(ctx, destination, addr)
+	case coremodel.IAAS:
+		client, err := s.connector.Connect(ctx, destination)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		conn, err := client.Dial("tcp", addr)
+		if err != nil {
+			_ = client.Close()
+			return nil, errors.Trace(err)
+		}
+		return &clientConn{Conn: conn, client: client}, nil
+	default:
+		return nil, errors.Errorf("unknown model type %s", modelType)
+	}
+}
+
+// dialPod dials the port of the pod of the k8s unit. The k8s API server
+// only forwards ports of the pod itself, so only loopback addresses can be
+// dialed; they are reached whichever container of the pod listens on them.
+func (s *sessionHandler) dialPod(ctx context.Context, destination virtualhostname.Info, addr string) (net.Conn, error) {
+	unitName, ok := destination.Unit()
+	if !ok {
+		return nil, errors.NotValidf("machine destination %q in k8s model", destination.String())
 	}
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.NotValidf("address %q", addr)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, errors.NotSupportedf("forwarding to %q from k8s units", host)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return nil, errors.NotValidf("port %q", portStr)
+	}
+
+	conn, err := s.podDialer.DialPod(ctx, coremodel.UUID(destination.ModelUUID()), unitPodName(unitName), port)
+	return conn, errors.Trace(err)
+}
+
+// clientConn is a connection tunnelled through an SSH client, which closes
+// the client with the connection.
+type clientConn struct {
+	net.Conn
+	client *gossh.Client
 }
 
-func (s *sessionHandler) k8sSessionProxy(_ ssh.Session) error {
-	return errors.New("k8s session proxy not implemented")
+// Close closes the connection and the client it was tunnelled through.
+func (c *clientConn) Close() error {
+	err := c.Conn.Close()
+	_ = c.client.Close()
+	return err
+}
+
+// unitPodName returns the name of the pod of the k8s unit, which pods are
+// named after.
+func unitPodName(unitName string) string {
+	return strings.ReplaceAll(unitName, "/", "-")
+}
+
+// exitStatusError is the error returned for commands which exit with a
+// non-zero status in the containers of k8s units.
+type exitStatusError interface {
+	error
+	ExitStatus() int
+}
+
+// k8sSessionProxy runs the user's shell or command in the container of the
+// k8s unit's pod, or the unit's charm container if no container is given,
+// through the exec API of the k8s API server.
+func (s *sessionHandler) k8sSessionProxy(userSession ssh.Session, destination virtualhostname.Info) error {
+	unitName, ok := destination.Unit()
+	if !ok {
+		return errors.NotValidf("machine destination %q in k8s model", destination.String())
+	}
+	containerName, ok := destination.Container()
+	if !ok {
+		containerName = k8sconstants.ApplicationCharmContainer
+	}
+
+	args := caas.ExecPodArgs{
+		PodName:       unitPodName(unitName),
+		ContainerName: containerName,
+		Stdin:         userSession,
+		Stdout:        userSession,
+		Stderr:        userSession.Stderr(),
+	}
+	pty, windowChan, isPty := userSession.Pty()
+	if isPty {
+		// As for machines, a shell is started on the terminal whether or
+		// not a command was given.
+		args.TTY = true
+		args.Command = []string{"sh"}
+		if pty.Term != "" {
+			args.Command = []string{"sh", "-c", "TERM=" + shellquote.Join(pty.Term) + " exec sh"}
+		}
+
+		terminalSizes := make(chan caas.TerminalSize, 1)
+		terminalSizes <- caas.TerminalSize{Width: uint16(pty.Window.Width), Height: uint16(pty.Window.Height)}
+		args.TerminalSizes = terminalSizes
+		go func() {
+			defer close(terminalSizes)
+			for w := range windowChan {
+				select {
+				case terminalSizes <- caas.TerminalSize{Width: uint16(w.Width), Height: uint16(w.Height)}:
+				case <-userSession.Context().Done():
+					return
+				}
+			}
+		}()
+	} else if command := userSession.RawCommand(); command != "" {
+		args.Command = []string{"sh", "-c", command}
+	} else {
+		args.Command = []string{"sh"}
+	}
+
+	return s.podExecer.ExecPod(userSession.Context(), coremodel.UUID(destination.ModelUUID()), args)
 }
 
 func (s *sessionHandler) machineSessionProxy(userSession ssh.Session, destination virtualhostname.Info) error {
-	client, err := s.connector.Connect(destination)
+	client, err := s.connector.Connect(userSession.Context(), destination)
 	if err != nil {
 		return err
 	}
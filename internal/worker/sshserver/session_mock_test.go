@@ -1,17 +1,21 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: SSHConnector)
+// Source: github.com/juju/juju/internal/worker/sshserver (interfaces: SSHConnector,PodDialer,PodExecer)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package sshserver -destination session_mock_test.go github.com/juju/juju/internal/worker/sshserver SSHConnector
+//	mockgen -typed -package sshserver -destination session_mock_test.go github.com/juju/juju/internal/worker/sshserver SSHConnector,PodDialer,PodExecer
 //
 
 // Package sshserver is a generated GoMock package.
 package sshserver
 
 import (
+	context "context"
+	net "net"
 	reflect "reflect"
 
+	caas "github.com/juju/juju/caas"
+	model "github.com/juju/juju/core/model"
 	virtualhostname "github.com/juju/juju/core/virtualhostname"
 	gomock "go.uber.org/mock/gomock"
 	ssh "golang.org/x/crypto/ssh"
@@ -41,18 +45,18 @@ func (m *MockSSHConnector) EXPECT() *MockSSHConnectorMockRecorder {
 }
 
 // Connect mocks base method.
-func (m *MockSSHConnector) Connect(arg0 virtualhostname.Info) (*ssh.Client, error) {
+func (m *MockSSHConnector) Connect(arg0 context.Context, arg1 virtualhostname.Info) (*ssh.Client, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Connect", arg0)
+	ret := m.ctrl.Call(m, "Connect", arg0, arg1)
 	ret0, _ := ret[0].(*ssh.Client)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Connect indicates an expected call of Connect.
-func (mr *MockSSHConnectorMockRecorder) Connect(arg0 any) *MockSSHConnectorConnectCall {
+func (mr *MockSSHConnectorMockRecorder) Connect(arg0, arg1 any) *MockSSHConnectorConnectCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockSSHConnector)(nil).Connect), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockSSHConnector)(nil).Connect), arg0, arg1)
 	return &MockSSHConnectorConnectCall{Call: call}
 }
 
@@ -68,13 +72,136 @@ func (c *MockSSHConnectorConnectCall) Return(arg0 *ssh.Client, arg1 error) *Mock
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSSHConnectorConnectCall) Do(f func(virtualhostname.Info) (*ssh.Client, error)) *MockSSHConnectorConnectCall {
+func (c *MockSSHConnectorConnectCall) Do(f func(context.Context, virtualhostname.Info) (*ssh.Client, error)) *MockSSHConnectorConnectCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSSHConnectorConnectCall) DoAndReturn(f func(virtualhostname.Info) (*ssh.Client, error)) *MockSSHConnectorConnectCall {
+func (c *MockSSHConnectorConnectCall) DoAndReturn(f func(context.Context, virtualhostname.Info) (*ssh.Client, error)) *MockSSHConnectorConnectCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPodDialer is a mock of PodDialer interface.
+type MockPodDialer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPodDialerMockRecorder
+}
+
+// MockPodDialerMockRecorder is the mock recorder for MockPodDialer.
+type MockPodDialerMockRecorder struct {
+	mock *MockPodDialer
+}
+
+// NewMockPodDialer creates a new mock instance.
+func NewMockPodDialer(ctrl *gomock.Controller) *MockPodDialer {
+	mock := &MockPodDialer{ctrl: ctrl}
+	mock.recorder = &MockPodDialerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPodDialer) EXPECT() *MockPodDialerMockRecorder {
+	return m.recorder
+}
+
+// DialPod mocks base method.
+func (m *MockPodDialer) DialPod(arg0 context.Context, arg1 model.UUID, arg2 string, arg3 int) (net.Conn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DialPod", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(net.Conn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DialPod indicates an expected call of DialPod.
+func (mr *MockPodDialerMockRecorder) DialPod(arg0, arg1, arg2, arg3 any) *MockPodDialerDialPodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DialPod", reflect.TypeOf((*MockPodDialer)(nil).DialPod), arg0, arg1, arg2, arg3)
+	return &MockPodDialerDialPodCall{Call: call}
+}
+
+// MockPodDialerDialPodCall wrap *gomock.Call
+type MockPodDialerDialPodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPodDialerDialPodCall) Return(arg0 net.Conn, arg1 error) *MockPodDialerDialPodCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPodDialerDialPodCall) Do(f func(context.Context, model.UUID, string, int) (net.Conn, error)) *MockPodDialerDialPodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPodDialerDialPodCall) DoAndReturn(f func(context.Context, model.UUID, string, int) (net.Conn, error)) *MockPodDialerDialPodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPodExecer is a mock of PodExecer interface.
+type MockPodExecer struct {
+	ctrl     *gomock.Controller
+	recorder *MockPodExecerMockRecorder
+}
+
+// MockPodExecerMockRecorder is the mock recorder for MockPodExecer.
+type MockPodExecerMockRecorder struct {
+	mock *MockPodExecer
+}
+
+// NewMockPodExecer creates a new mock instance.
+func NewMockPodExecer(ctrl *gomock.Controller) *MockPodExecer {
+	mock := &MockPodExecer{ctrl: ctrl}
+	mock.recorder = &MockPodExecerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPodExecer) EXPECT() *MockPodExecerMockRecorder {
+	return m.recorder
+}
+
+// ExecPod mocks base method.
+func (m *MockPodExecer) ExecPod(arg0 context.Context, arg1 model.UUID, arg2 caas.ExecPodArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecPod", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecPod indicates an expected call of ExecPod.
+func (mr *MockPodExecerMockRecorder) ExecPod(arg0, arg1, arg2 any) *MockPodExecerExecPodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecPod", reflect.TypeOf((*MockPodExecer)(nil).ExecPod), arg0, arg1, arg2)
+	return &MockPodExecerExecPodCall{Call: call}
+}
+
+// MockPodExecerExecPodCall wrap *gomock.Call
+type MockPodExecerExecPodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPodExecerExecPodCall) Return(arg0 error) *MockPodExecerExecPodCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPodExecerExecPodCall) Do(f func(context.Context, model.UUID, caas.ExecPodArgs) error) *MockPodExecerExecPodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPodExecerExecPodCall) DoAndReturn(f func(context.Context, model.UUID, caas.ExecPodArgs) error) *MockPodExecerExecPodCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
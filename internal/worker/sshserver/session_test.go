@@ -5,9 +5,11 @@ package sshserver
 
 import (
 	"bytes"
+	"context"
 	"io"
 	net "net"
 	"sync/atomic"
+	"time"
 
 	"github.com/gliderlabs/ssh"
 	"github.com/juju/errors"
@@ -17,14 +19,18 @@ import (
 	"google.golang.org/grpc/test/bufconn"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/caas"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/virtualhostname"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
-	"github.com/juju/juju/state"
 )
 
 type machineSessionSuite struct {
-	userSession   *userSession
-	mockConnector *MockSSHConnector
+	userSession      *userSession
+	mockConnector    *MockSSHConnector
+	mockPodDialer    *MockPodDialer
+	mockPodExecer    *MockPodExecer
+	mockModelService *MockModelService
 }
 
 var _ = gc.Suite(&machineSessionSuite{})
@@ -51,6 +57,9 @@ func startTestServer(_ *gc.C) *testServer {
 				ts.serverRx = []byte(session.RawCommand())
 				_, _ = io.WriteString(session, "No PTY requested.\n")
 				_, _ = io.WriteString(session.Stderr(), "An error from the server!\n")
+				if session.RawCommand() == "false" {
+					_ = session.Exit(3)
+				}
 			}
 		},
 	}
@@ -67,6 +76,7 @@ type userSession struct {
 	stdout        bytes.Buffer
 	stderr        bytes.Buffer
 	isPty         bool
+	pty           ssh.Pty
 	clientCommand string
 	exitCode      int
 }
@@ -87,7 +97,7 @@ func (u *userSession) Pty() (ssh.Pty, <-chan ssh.Window, bool) {
 	windowChanges := make(chan ssh.Window)
 	// close immediately to avoid a leaked go routine.
 	close(windowChanges)
-	return ssh.Pty{}, windowChanges, u.isPty
+	return u.pty, windowChanges, u.isPty
 }
 
 func (u *userSession) RawCommand() string {
@@ -99,6 +109,33 @@ func (u *userSession) Exit(code int) error {
 	return nil
 }
 
+func (u *userSession) Context() ssh.Context {
+	return sessionContext{ctx: context.Background()}
+}
+
+// sessionContext is the context of a user session, of which only the
+// context.Context methods are used by the handler.
+type sessionContext struct {
+	ssh.Context
+	ctx context.Context
+}
+
+func (c sessionContext) Deadline() (time.Time, bool) {
+	return c.ctx.Deadline()
+}
+
+func (c sessionContext) Done() <-chan struct{} {
+	return c.ctx.Done()
+}
+
+func (c sessionContext) Err() error {
+	return c.ctx.Err()
+}
+
+func (c sessionContext) Value(key any) any {
+	return c.ctx.Value(key)
+}
+
 func (s *machineSessionSuite) setupUserSession(_ *gc.C, withPty bool, clientMessage string) {
 	s.userSession = &userSession{
 		isPty: withPty,
@@ -113,6 +150,9 @@ func (s *machineSessionSuite) setupUserSession(_ *gc.C, withPty bool, clientMess
 func (s *machineSessionSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.mockConnector = NewMockSSHConnector(ctrl)
+	s.mockPodDialer = NewMockPodDialer(ctrl)
+	s.mockPodExecer = NewMockPodExecer(ctrl)
+	s.mockModelService = NewMockModelService(ctrl)
 	return ctrl
 }
 
@@ -140,8 +180,8 @@ func (s *machineSessionSuite) TestMachineSessionProxy(c *gc.C) {
 	machineConn = &closeChecker{Conn: machineConn}
 	defer machineConn.Close()
 
-	s.mockConnector.EXPECT().Connect(gomock.Any()).DoAndReturn(
-		func(destination virtualhostname.Info) (*gossh.Client, error) {
+	s.mockConnector.EXPECT().Connect(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, destination virtualhostname.Info) (*gossh.Client, error) {
 			sshConn, newChan, reqs, err := gossh.NewClientConn(machineConn, "", &gossh.ClientConfig{
 				HostKeyCallback: gossh.InsecureIgnoreHostKey(),
 			})
@@ -153,8 +193,8 @@ func (s *machineSessionSuite) TestMachineSessionProxy(c *gc.C) {
 	)
 
 	sessionHandler := sessionHandler{
-		connector: s.mockConnector,
-		modelType: state.ModelTypeIAAS,
+		connector:    s.mockConnector,
+		modelService: s.mockModelService,
 	}
 
 	err = sessionHandler.machineSessionProxy(s.userSession, virtualhostname.Info{})
@@ -181,8 +221,8 @@ func (s *machineSessionSuite) TestMachineCommandProxy(c *gc.C) {
 	conn, err := testServer.listener.Dial()
 	c.Assert(err, jc.ErrorIsNil)
 
-	s.mockConnector.EXPECT().Connect(gomock.Any()).DoAndReturn(
-		func(destination virtualhostname.Info) (*gossh.Client, error) {
+	s.mockConnector.EXPECT().Connect(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, destination virtualhostname.Info) (*gossh.Client, error) {
 			sshConn, newChan, reqs, err := gossh.NewClientConn(conn, "", &gossh.ClientConfig{
 				HostKeyCallback: gossh.InsecureIgnoreHostKey(),
 			})
@@ -194,8 +234,8 @@ func (s *machineSessionSuite) TestMachineCommandProxy(c *gc.C) {
 	)
 
 	sessionHandler := sessionHandler{
-		connector: s.mockConnector,
-		modelType: state.ModelTypeIAAS,
+		connector:    s.mockConnector,
+		modelService: s.mockModelService,
 	}
 
 	err = sessionHandler.machineSessionProxy(s.userSession, virtualhostname.Info{})
@@ -205,22 +245,63 @@ func (s *machineSessionSuite) TestMachineCommandProxy(c *gc.C) {
 	c.Check(string(testServer.serverRx), gc.Equals, "neovim")
 }
 
+func (s *machineSessionSuite) TestMachineCommandExitStatus(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	isPty := false
+	s.setupUserSession(c, isPty, "false")
+
+	testServer := startTestServer(c)
+	defer testServer.listener.Close()
+
+	conn, err := testServer.listener.Dial()
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.mockConnector.EXPECT().Connect(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, destination virtualhostname.Info) (*gossh.Client, error) {
+			sshConn, newChan, reqs, err := gossh.NewClientConn(conn, "", &gossh.ClientConfig{
+				HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return gossh.NewClient(sshConn, newChan, reqs), nil
+		},
+	)
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID("")).Return(coremodel.IAAS, nil)
+
+	sessionHandler := sessionHandler{
+		connector:    s.mockConnector,
+		modelService: s.mockModelService,
+		logger:       loggertesting.WrapCheckLog(c),
+	}
+
+	// The exit status of the command is passed on, rather than being
+	// reported as a proxy failure.
+	sessionHandler.Handle(s.userSession, virtualhostname.Info{})
+	c.Check(s.userSession.exitCode, gc.Equals, 3)
+	c.Check(s.userSession.stderr.String(), gc.Equals, "An error from the server!\n")
+}
+
 func (s *machineSessionSuite) TestConnectToMachineError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	isPty := false
 	s.setupUserSession(c, isPty, "neovim")
 
-	s.mockConnector.EXPECT().Connect(gomock.Any()).DoAndReturn(
-		func(destination virtualhostname.Info) (*gossh.Client, error) {
+	s.mockConnector.EXPECT().Connect(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, destination virtualhostname.Info) (*gossh.Client, error) {
 			return nil, errors.New("fake-connection-error")
 		},
 	)
 
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID("")).Return(coremodel.IAAS, nil)
+
 	sessionHandler := sessionHandler{
-		connector: s.mockConnector,
-		modelType: state.ModelTypeIAAS,
-		logger:    loggertesting.WrapCheckLog(c),
+		connector:    s.mockConnector,
+		modelService: s.mockModelService,
+		logger:       loggertesting.WrapCheckLog(c),
 	}
 
 	sessionHandler.Handle(s.userSession, virtualhostname.Info{})
@@ -228,3 +309,278 @@ func (s *machineSessionSuite) TestConnectToMachineError(c *gc.C) {
 	c.Check(s.userSession.stdout.String(), gc.Equals, "")
 	c.Check(s.userSession.stderr.String(), gc.Equals, "failed to proxy machine session: fake-connection-error\n")
 }
+
+func (s *machineSessionSuite) TestDialTarget(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The workload listens on the machine's loopback interface.
+	workload, err := net.Listen("tcp", "127.0.0.1:0")
+	c.Assert(err, jc.ErrorIsNil)
+	defer workload.Close()
+	go func() {
+		conn, err := workload.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.WriteString(conn, "Hello from the workload!\n")
+	}()
+
+	machine := &ssh.Server{
+		LocalPortForwardingCallback: func(ssh.Context, string, uint32) bool {
+			return true
+		},
+		ChannelHandlers: map[string]ssh.ChannelHandler{
+			"direct-tcpip": ssh.DirectTCPIPHandler,
+		},
+	}
+	listener := bufconn.Listen(1024)
+	defer machine.Close()
+	go func() {
+		_ = machine.Serve(listener)
+	}()
+
+	machineConn, err := listener.Dial()
+	c.Assert(err, jc.ErrorIsNil)
+	machineConn = &closeChecker{Conn: machineConn}
+
+	s.mockConnector.EXPECT().Connect(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, destination virtualhostname.Info) (*gossh.Client, error) {
+			sshConn, newChan, reqs, err := gossh.NewClientConn(machineConn, "", &gossh.ClientConfig{
+				HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return gossh.NewClient(sshConn, newChan, reqs), nil
+		},
+	)
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID("")).Return(coremodel.IAAS, nil)
+
+	sessionHandler := sessionHandler{
+		connector:    s.mockConnector,
+		modelService: s.mockModelService,
+	}
+
+	conn, err := sessionHandler.DialTarget(context.Background(), virtualhostname.Info{}, workload.Addr().String())
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := io.ReadAll(conn)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "Hello from the workload!\n")
+
+	// Closing the forwarded connection closes the connection to the machine.
+	_ = conn.Close()
+	closeCheck, _ := machineConn.(*closeChecker)
+	c.Check(closeCheck.closed.Load(), gc.Equals, true)
+}
+
+func (s *machineSessionSuite) TestDialTargetConnectError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mockConnector.EXPECT().Connect(gomock.Any(), gomock.Any()).Return(nil, errors.New("fake-connection-error"))
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID("")).Return(coremodel.IAAS, nil)
+
+	sessionHandler := sessionHandler{
+		connector:    s.mockConnector,
+		modelService: s.mockModelService,
+	}
+
+	_, err := sessionHandler.DialTarget(context.Background(), virtualhostname.Info{}, "localhost:8080")
+	c.Check(err, gc.ErrorMatches, "fake-connection-error")
+}
+
+func (s *machineSessionSuite) TestDialTargetK8sUnit(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	destination, err := virtualhostname.NewInfoUnitTarget("8419cd78-4993-4c3a-928e-c646226beeee", "postgresql/1")
+	c.Assert(err, jc.ErrorIsNil)
+
+	podConn, otherEnd := net.Pipe()
+	defer otherEnd.Close()
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID("8419cd78-4993-4c3a-928e-c646226beeee")).Return(coremodel.CAAS, nil)
+	s.mockPodDialer.EXPECT().DialPod(gomock.Any(), coremodel.UUID("8419cd78-4993-4c3a-928e-c646226beeee"), "postgresql-1", 5432).Return(podConn, nil)
+
+	sessionHandler := sessionHandler{
+		podDialer:    s.mockPodDialer,
+		modelService: s.mockModelService,
+	}
+
+	conn, err := sessionHandler.DialTarget(context.Background(), destination, "localhost:5432")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(conn, gc.Equals, podConn)
+	_ = conn.Close()
+}
+
+func (s *machineSessionSuite) TestDialTargetK8sNonLoopbackAddress(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	destination, err := virtualhostname.NewInfoUnitTarget("8419cd78-4993-4c3a-928e-c646226beeee", "postgresql/1")
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), gomock.Any()).Return(coremodel.CAAS, nil)
+
+	sessionHandler := sessionHandler{
+		podDialer:    s.mockPodDialer,
+		modelService: s.mockModelService,
+	}
+
+	// Only the ports of the unit's pod can be reached.
+	_, err = sessionHandler.DialTarget(context.Background(), destination, "10.0.0.1:5432")
+	c.Check(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *machineSessionSuite) TestDialTargetModelTypeError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), gomock.Any()).Return("", errors.New("boom"))
+
+	sessionHandler := sessionHandler{
+		modelService: s.mockModelService,
+	}
+
+	_, err := sessionHandler.DialTarget(context.Background(), virtualhostname.Info{}, "localhost:8080")
+	c.Check(err, gc.ErrorMatches, `getting type of model "": boom`)
+}
+
+func (s *machineSessionSuite) TestK8sCommandProxy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	destination, err := virtualhostname.NewInfoUnitTarget("8419cd78-4993-4c3a-928e-c646226beeee", "postgresql/1")
+	c.Assert(err, jc.ErrorIsNil)
+	s.setupUserSession(c, false, "hostname")
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), coremodel.UUID("8419cd78-4993-4c3a-928e-c646226beeee")).Return(coremodel.CAAS, nil)
+	s.mockPodExecer.EXPECT().ExecPod(gomock.Any(), coremodel.UUID("8419cd78-4993-4c3a-928e-c646226beeee"), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coremodel.UUID, args caas.ExecPodArgs) error {
+			c.Check(args.PodName, gc.Equals, "postgresql-1")
+			c.Check(args.ContainerName, gc.Equals, "charm")
+			c.Check(args.Command, gc.DeepEquals, []string{"sh", "-c", "hostname"})
+			c.Check(args.TTY, jc.IsFalse)
+			_, _ = io.WriteString(args.Stdout, "postgresql-1\n")
+			_, _ = io.WriteString(args.Stderr, "An error from the pod!\n")
+			return nil
+		})
+
+	sessionHandler := sessionHandler{
+		podExecer:    s.mockPodExecer,
+		modelService: s.mockModelService,
+		logger:       loggertesting.WrapCheckLog(c),
+	}
+	sessionHandler.Handle(s.userSession, destination)
+
+	c.Check(s.userSession.stdout.String(), gc.Equals, "postgresql-1\n")
+	c.Check(s.userSession.stderr.String(), gc.Equals, "An error from the pod!\n")
+	c.Check(s.userSession.exitCode, gc.Equals, 0)
+}
+
+func (s *machineSessionSuite) TestK8sSessionProxy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	destination, err := virtualhostname.NewInfoContainerTarget("8419cd78-4993-4c3a-928e-c646226beeee", "postgresql/1", "postgresql")
+	c.Assert(err, jc.ErrorIsNil)
+	s.setupUserSession(c, true, "echo hello\n")
+	s.userSession.pty = ssh.Pty{Term: "xterm", Window: ssh.Window{Width: 120, Height: 40}}
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), gomock.Any()).Return(coremodel.CAAS, nil)
+	s.mockPodExecer.EXPECT().ExecPod(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coremodel.UUID, args caas.ExecPodArgs) error {
+			c.Check(args.PodName, gc.Equals, "postgresql-1")
+			c.Check(args.ContainerName, gc.Equals, "postgresql")
+			c.Check(args.Command, gc.DeepEquals, []string{"sh", "-c", "TERM=xterm exec sh"})
+			c.Check(args.TTY, jc.IsTrue)
+			// The terminal starts at the size of the user's terminal.
+			c.Check(<-args.TerminalSizes, gc.Equals, caas.TerminalSize{Width: 120, Height: 40})
+			input, err := io.ReadAll(args.Stdin)
+			c.Check(err, jc.ErrorIsNil)
+			_, _ = args.Stdout.Write(input)
+			return nil
+		})
+
+	sessionHandler := sessionHandler{
+		podExecer:    s.mockPodExecer,
+		modelService: s.mockModelService,
+		logger:       loggertesting.WrapCheckLog(c),
+	}
+	sessionHandler.Handle(s.userSession, destination)
+
+	c.Check(s.userSession.stdout.String(), gc.Equals, "echo hello\n")
+	c.Check(s.userSession.exitCode, gc.Equals, 0)
+}
+
+// exitError is the error returned by the k8s API server for commands which
+// exit with a non-zero status.
+type exitError int
+
+func (e exitError) Error() string {
+	return "command terminated with non-zero exit code"
+}
+
+func (e exitError) ExitStatus() int {
+	return int(e)
+}
+
+func (s *machineSessionSuite) TestK8sCommandExitStatus(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	destination, err := virtualhostname.NewInfoUnitTarget("8419cd78-4993-4c3a-928e-c646226beeee", "postgresql/1")
+	c.Assert(err, jc.ErrorIsNil)
+	s.setupUserSession(c, false, "false")
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), gomock.Any()).Return(coremodel.CAAS, nil)
+	s.mockPodExecer.EXPECT().ExecPod(gomock.Any(), gomock.Any(), gomock.Any()).Return(exitError(3))
+
+	sessionHandler := sessionHandler{
+		podExecer:    s.mockPodExecer,
+		modelService: s.mockModelService,
+		logger:       loggertesting.WrapCheckLog(c),
+	}
+	sessionHandler.Handle(s.userSession, destination)
+
+	// A failed command is not a proxy failure.
+	c.Check(s.userSession.stderr.String(), gc.Equals, "")
+	c.Check(s.userSession.exitCode, gc.Equals, 3)
+}
+
+func (s *machineSessionSuite) TestK8sExecError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	destination, err := virtualhostname.NewInfoUnitTarget("8419cd78-4993-4c3a-928e-c646226beeee", "postgresql/1")
+	c.Assert(err, jc.ErrorIsNil)
+	s.setupUserSession(c, false, "hostname")
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), gomock.Any()).Return(coremodel.CAAS, nil)
+	s.mockPodExecer.EXPECT().ExecPod(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("pod not found"))
+
+	sessionHandler := sessionHandler{
+		podExecer:    s.mockPodExecer,
+		modelService: s.mockModelService,
+		logger:       loggertesting.WrapCheckLog(c),
+	}
+	sessionHandler.Handle(s.userSession, destination)
+
+	c.Check(s.userSession.stderr.String(), gc.Equals, "failed to proxy k8s session: pod not found\n")
+	c.Check(s.userSession.exitCode, gc.Equals, 1)
+}
+
+func (s *machineSessionSuite) TestK8sSessionMachineDestination(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	destination, err := virtualhostname.NewInfoMachineTarget("8419cd78-4993-4c3a-928e-c646226beeee", "0")
+	c.Assert(err, jc.ErrorIsNil)
+	s.setupUserSession(c, false, "hostname")
+
+	s.mockModelService.EXPECT().ModelType(gomock.Any(), gomock.Any()).Return(coremodel.CAAS, nil)
+
+	sessionHandler := sessionHandler{
+		podExecer:    s.mockPodExecer,
+		modelService: s.mockModelService,
+		logger:       loggertesting.WrapCheckLog(c),
+	}
+	sessionHandler.Handle(s.userSession, destination)
+
+	c.Check(s.userSession.stderr.String(), gc.Matches, `failed to proxy k8s session: machine destination .* in k8s model not valid\n`)
+	c.Check(s.userSession.exitCode, gc.Equals, 1)
+}
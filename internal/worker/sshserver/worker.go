@@ -51,6 +51,12 @@ type ServerWrapperWorkerConfig struct {
 	Logger                  logger.Logger
 	NewSSHServerListener    func(net.Listener, time.Duration) net.Listener
 	SessionHandler          SessionHandler
+	SessionRecordingService SessionRecordingService
+	SSHAccessGrantService   SSHAccessGrantService
+	UserCertificateService  UserCertificateService
+	UserAccessService       UserAccessService
+	TCPForwarder            TCPForwarder
+	TunnelTracker           TunnelTracker
 }
 
 // Validate validates the workers configuration is as expected.
@@ -70,6 +76,24 @@ func (c ServerWrapperWorkerConfig) Validate() error {
 	if c.SessionHandler == nil {
 		return errors.NotValidf("SessionHandler is required")
 	}
+	if c.SessionRecordingService == nil {
+		return errors.NotValidf("SessionRecordingService is required")
+	}
+	if c.SSHAccessGrantService == nil {
+		return errors.NotValidf("SSHAccessGrantService is required")
+	}
+	if c.UserCertificateService == nil {
+		return errors.NotValidf("UserCertificateService is required")
+	}
+	if c.UserAccessService == nil {
+		return errors.NotValidf("UserAccessService is required")
+	}
+	if c.TCPForwarder == nil {
+		return errors.NotValidf("TCPForwarder is required")
+	}
+	if c.TunnelTracker == nil {
+		return errors.NotValidf("TunnelTracker is required")
+	}
 	return nil
 }
 
@@ -91,7 +115,8 @@ type serverWrapperWorker struct {
 
 // NewServerWrapperWorker returns a new worker that runs an ssh server worker internally.
 // This worker will listen for changes in the controller configuration and restart the
-// server worker when the port or max concurrent connections changes.
+// server worker when the port, max concurrent connections, session recording,
+// access grant, user certificate or port forwarding settings change.
 func NewServerWrapperWorker(config ServerWrapperWorkerConfig) (worker.Worker, error) {
 	if err := config.Validate(); err != nil {
 		return nil, errors.Trace(err)
@@ -158,15 +183,38 @@ func (ssw *serverWrapperWorker) loop() error {
 
 	port := config.SSHServerPort()
 	maxConns := config.SSHMaxConcurrentConnections()
+	recordSessions := config.SSHSessionRecording()
+	grantsRequired := config.SSHAccessGrantsRequired()
+	certificatesRequired := config.SSHUserCertificatesRequired()
+	portForwarding := config.SSHPortForwarding()
+	if grantsRequired && !certificatesRequired {
+		return errors.NotValidf("%s without %s", controller.SSHAccessGrantsRequired, controller.SSHUserCertificatesRequired)
+	}
 
-	srv, err := ssw.config.NewServerWorker(ServerWorkerConfig{
+	serverConfig := ServerWorkerConfig{
 		Logger:                   ssw.config.Logger,
 		JumpHostKey:              temporaryJumpHostKey,
 		Port:                     port,
 		MaxConcurrentConnections: maxConns,
 		NewSSHServerListener:     ssw.config.NewSSHServerListener,
 		SessionHandler:           ssw.config.SessionHandler,
-	})
+		TunnelTracker:            ssw.config.TunnelTracker,
+	}
+	if recordSessions {
+		serverConfig.SessionRecordingService = ssw.config.SessionRecordingService
+	}
+	if grantsRequired {
+		serverConfig.SSHAccessGrantService = ssw.config.SSHAccessGrantService
+	}
+	if certificatesRequired {
+		serverConfig.UserCertificateService = ssw.config.UserCertificateService
+		serverConfig.UserAccessService = ssw.config.UserAccessService
+		serverConfig.ControllerUUID = config.ControllerUUID()
+	}
+	if portForwarding {
+		serverConfig.TCPForwarder = ssw.config.TCPForwarder
+	}
+	srv, err := ssw.config.NewServerWorker(serverConfig)
 	ssw.addWorkerReporter("ssh-server", srv)
 	if err != nil {
 		return errors.Trace(err)
@@ -185,7 +233,11 @@ func (ssw *serverWrapperWorker) loop() error {
 			if err != nil {
 				return errors.Trace(err)
 			}
-			if maxConns == config.SSHMaxConcurrentConnections() {
+			if maxConns == config.SSHMaxConcurrentConnections() &&
+				recordSessions == config.SSHSessionRecording() &&
+				grantsRequired == config.SSHAccessGrantsRequired() &&
+				certificatesRequired == config.SSHUserCertificatesRequired() &&
+				portForwarding == config.SSHPortForwarding() {
 				ssw.config.Logger.Debugf(context.Background(), "controller configuration changed, but nothing changed for the ssh server.")
 				continue
 			}
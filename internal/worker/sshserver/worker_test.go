@@ -33,6 +33,12 @@ func newServerWrapperWorkerConfig(
 		Logger:                  loggertesting.WrapCheckLog(c),
 		NewSSHServerListener:    newTestingSSHServerListener,
 		SessionHandler:          &MockSessionHandler{},
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
 	}
 
 	modifier(cfg)
@@ -86,6 +92,66 @@ func (s *workerSuite) TestValidate(c *gc.C) {
 		},
 	)
 	c.Assert(cfg.Validate(), gc.ErrorMatches, ".*is required.*")
+
+	// Test no SessionRecordingService.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.SessionRecordingService = nil
+		},
+	)
+	c.Assert(cfg.Validate(), gc.ErrorMatches, ".*is required.*")
+
+	// Test no SSHAccessGrantService.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.SSHAccessGrantService = nil
+		},
+	)
+	c.Assert(cfg.Validate(), gc.ErrorMatches, ".*is required.*")
+
+	// Test no UserCertificateService.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.UserCertificateService = nil
+		},
+	)
+	c.Assert(cfg.Validate(), gc.ErrorMatches, ".*is required.*")
+
+	// Test no UserAccessService.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.UserAccessService = nil
+		},
+	)
+	c.Assert(cfg.Validate(), gc.ErrorMatches, ".*is required.*")
+
+	// Test no TCPForwarder.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.TCPForwarder = nil
+		},
+	)
+	c.Assert(cfg.Validate(), gc.ErrorMatches, ".*is required.*")
+
+	// Test no TunnelTracker.
+	cfg = newServerWrapperWorkerConfig(
+		c,
+		ctrl,
+		func(cfg *ServerWrapperWorkerConfig) {
+			cfg.TunnelTracker = nil
+		},
+	)
+	c.Assert(cfg.Validate(), gc.ErrorMatches, ".*is required.*")
 }
 
 func (s *workerSuite) TestSSHServerWrapperWorkerCanBeKilled(c *gc.C) {
@@ -115,8 +181,14 @@ func (s *workerSuite) TestSSHServerWrapperWorkerCanBeKilled(c *gc.C) {
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			return serverWorker, nil
 		},
-		NewSSHServerListener: newTestingSSHServerListener,
-		SessionHandler:       &stubSessionHandler{},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, jc.ErrorIsNil)
@@ -194,8 +266,14 @@ func (s *workerSuite) TestSSHServerWrapperWorkerRestartsServerWorker(c *gc.C) {
 			c.Check(swc.Port, gc.Equals, 22)
 			return serverWorker, nil
 		},
-		NewSSHServerListener: newTestingSSHServerListener,
-		SessionHandler:       &stubSessionHandler{},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, jc.ErrorIsNil)
@@ -225,6 +303,75 @@ func (s *workerSuite) TestSSHServerWrapperWorkerRestartsServerWorker(c *gc.C) {
 	c.Check(workertest.CheckKilled(c, controllerConfigWatcher), jc.ErrorIsNil)
 }
 
+func (s *workerSuite) TestSSHServerWrapperWorkerRestartsOnSessionRecording(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	serverWorker := workertest.NewErrorWorker(nil)
+	defer workertest.DirtyKill(c, serverWorker)
+
+	ch := make(chan []string)
+	controllerConfigWatcher := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.DirtyKill(c, controllerConfigWatcher)
+
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().WatchControllerConfig().Return(controllerConfigWatcher, nil)
+
+	// Sessions are recorded when the worker starts.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHSessionRecording:         true,
+			},
+			nil,
+		).
+		Times(1)
+	// Turning off recording should restart the worker.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHSessionRecording:         false,
+			},
+			nil,
+		).
+		Times(1)
+
+	sessionRecordingService := NewMockSessionRecordingService(ctrl)
+
+	cfg := ServerWrapperWorkerConfig{
+		ControllerConfigService: controllerConfigService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
+			c.Check(swc.SessionRecordingService, gc.Equals, sessionRecordingService)
+			return serverWorker, nil
+		},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: sessionRecordingService,
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
+	}
+	w, err := NewServerWrapperWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	workertest.CheckAlive(c, w)
+
+	ch <- nil
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "changes detected, stopping SSH server worker")
+}
+
 func (s *workerSuite) TestWrapperWorkerReport(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -257,8 +404,14 @@ func (s *workerSuite) TestWrapperWorkerReport(c *gc.C) {
 		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
 			return &reportWorker{serverWorker}, nil
 		},
-		NewSSHServerListener: newTestingSSHServerListener,
-		SessionHandler:       &stubSessionHandler{},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
 	}
 	w, err := NewServerWrapperWorker(cfg)
 	c.Assert(err, jc.ErrorIsNil)
@@ -292,3 +445,265 @@ func (r *reportWorker) Report() map[string]interface{} {
 		"test": "test",
 	}
 }
+
+func (s *workerSuite) TestSSHServerWrapperWorkerRejectsAccessGrantsWithoutCertificates(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	ch := make(chan []string)
+	controllerConfigWatcher := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.DirtyKill(c, controllerConfigWatcher)
+
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().WatchControllerConfig().Return(controllerConfigWatcher, nil)
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHAccessGrantsRequired:     true,
+			},
+			nil,
+		)
+
+	cfg := ServerWrapperWorkerConfig{
+		ControllerConfigService: controllerConfigService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
+			c.Fatalf("server started with access grants but no certificates")
+			return nil, nil
+		},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
+	}
+	w, err := NewServerWrapperWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "ssh-access-grants-required without ssh-user-certificates-required not valid")
+}
+
+func (s *workerSuite) TestSSHServerWrapperWorkerRestartsOnAccessGrantsRequired(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	serverWorker := workertest.NewErrorWorker(nil)
+	defer workertest.DirtyKill(c, serverWorker)
+
+	ch := make(chan []string)
+	controllerConfigWatcher := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.DirtyKill(c, controllerConfigWatcher)
+
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().WatchControllerConfig().Return(controllerConfigWatcher, nil)
+
+	// Access grants are required when the worker starts.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHAccessGrantsRequired:     true,
+				controller.SSHUserCertificatesRequired: true,
+				controller.ControllerUUIDKey:           testControllerUUID,
+			},
+			nil,
+		).
+		Times(1)
+	// No longer requiring grants should restart the worker.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHAccessGrantsRequired:     false,
+				controller.SSHUserCertificatesRequired: true,
+				controller.ControllerUUIDKey:           testControllerUUID,
+			},
+			nil,
+		).
+		Times(1)
+
+	sshAccessGrantService := NewMockSSHAccessGrantService(ctrl)
+
+	cfg := ServerWrapperWorkerConfig{
+		ControllerConfigService: controllerConfigService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
+			c.Check(swc.SSHAccessGrantService, gc.Equals, sshAccessGrantService)
+			c.Check(swc.SessionRecordingService, gc.IsNil)
+			return serverWorker, nil
+		},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   sshAccessGrantService,
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
+	}
+	w, err := NewServerWrapperWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	workertest.CheckAlive(c, w)
+
+	ch <- nil
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "changes detected, stopping SSH server worker")
+}
+
+func (s *workerSuite) TestSSHServerWrapperWorkerRestartsOnUserCertificatesRequired(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	serverWorker := workertest.NewErrorWorker(nil)
+	defer workertest.DirtyKill(c, serverWorker)
+
+	ch := make(chan []string)
+	controllerConfigWatcher := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.DirtyKill(c, controllerConfigWatcher)
+
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().WatchControllerConfig().Return(controllerConfigWatcher, nil)
+
+	// User certificates are required when the worker starts.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.ControllerUUIDKey:           testControllerUUID,
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHUserCertificatesRequired: true,
+			},
+			nil,
+		).
+		Times(1)
+	// No longer requiring certificates should restart the worker.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.ControllerUUIDKey:           testControllerUUID,
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHUserCertificatesRequired: false,
+			},
+			nil,
+		).
+		Times(1)
+
+	userCertificateService := NewMockUserCertificateService(ctrl)
+	userAccessService := NewMockUserAccessService(ctrl)
+
+	cfg := ServerWrapperWorkerConfig{
+		ControllerConfigService: controllerConfigService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
+			c.Check(swc.UserCertificateService, gc.Equals, userCertificateService)
+			c.Check(swc.UserAccessService, gc.Equals, userAccessService)
+			c.Check(swc.ControllerUUID, gc.Equals, testControllerUUID)
+			c.Check(swc.SSHAccessGrantService, gc.IsNil)
+			return serverWorker, nil
+		},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  userCertificateService,
+		UserAccessService:       userAccessService,
+		TCPForwarder:            NewMockTCPForwarder(ctrl),
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
+	}
+	w, err := NewServerWrapperWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	workertest.CheckAlive(c, w)
+
+	ch <- nil
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "changes detected, stopping SSH server worker")
+}
+
+func (s *workerSuite) TestSSHServerWrapperWorkerRestartsOnPortForwarding(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	serverWorker := workertest.NewErrorWorker(nil)
+	defer workertest.DirtyKill(c, serverWorker)
+
+	ch := make(chan []string)
+	controllerConfigWatcher := watchertest.NewMockStringsWatcher(ch)
+	defer workertest.DirtyKill(c, controllerConfigWatcher)
+
+	controllerConfigService := NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().WatchControllerConfig().Return(controllerConfigWatcher, nil)
+
+	// Port forwarding is enabled by default when the worker starts.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+			},
+			nil,
+		).
+		Times(1)
+	// Disabling port forwarding should restart the worker.
+	controllerConfigService.EXPECT().
+		ControllerConfig(gomock.Any()).
+		Return(
+			controller.Config{
+				controller.SSHServerPort:               22,
+				controller.SSHMaxConcurrentConnections: 10,
+				controller.SSHPortForwarding:           false,
+			},
+			nil,
+		).
+		Times(1)
+
+	tcpForwarder := NewMockTCPForwarder(ctrl)
+
+	cfg := ServerWrapperWorkerConfig{
+		ControllerConfigService: controllerConfigService,
+		Logger:                  loggertesting.WrapCheckLog(c),
+		NewServerWorker: func(swc ServerWorkerConfig) (worker.Worker, error) {
+			c.Check(swc.TCPForwarder, gc.Equals, tcpForwarder)
+			return serverWorker, nil
+		},
+		NewSSHServerListener:    newTestingSSHServerListener,
+		SessionHandler:          NewMockSessionHandler(ctrl),
+		SessionRecordingService: NewMockSessionRecordingService(ctrl),
+		SSHAccessGrantService:   NewMockSSHAccessGrantService(ctrl),
+		UserCertificateService:  NewMockUserCertificateService(ctrl),
+		UserAccessService:       NewMockUserAccessService(ctrl),
+		TCPForwarder:            tcpForwarder,
+		TunnelTracker:           NewMockTunnelTracker(ctrl),
+	}
+	w, err := NewServerWrapperWorker(cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	workertest.CheckAlive(c, w)
+
+	ch <- nil
+
+	err = workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "changes detected, stopping SSH server worker")
+}
@@ -269,6 +269,169 @@ func removeFilesystems(ctx context.Context, deps *dependencies, ops map[names.Fi
 	return nil
 }
 
+// managedFilesystemSourceName is the key under which volume-backed
+// filesystems, grown by the managed filesystem source, are grouped. It
+// is not a valid provider type, so cannot clash with one.
+const managedFilesystemSourceName = "<managed>"
+
+// resizeFilesystems grows the filesystems with the specified IDs which have
+// a resize requested, and records their new sizes.
+func resizeFilesystems(ctx context.Context, deps *dependencies, ids []string) error {
+	tags := make([]names.FilesystemTag, len(ids))
+	for i, id := range ids {
+		tags[i] = names.NewFilesystemTag(id)
+	}
+	resizeResults, err := deps.config.Filesystems.FilesystemResizeParams(ctx, tags)
+	if err != nil {
+		return errors.Annotate(err, "getting filesystem resize params")
+	}
+	resizeParamsBySource := make(map[string][]storage.FilesystemResizeParams)
+	for i, result := range resizeResults {
+		if result.Error != nil {
+			if params.IsCodeNotFound(result.Error) {
+				// No resize requested.
+				continue
+			}
+			return errors.Annotatef(
+				result.Error, "getting resize params for %s",
+				names.ReadableString(tags[i]),
+			)
+		}
+		providerType := storage.ProviderType(result.Result.Provider)
+		sourceName := string(providerType)
+		resizeParams := storage.FilesystemResizeParams{
+			Tag:          tags[i],
+			FilesystemId: result.Result.FilesystemId,
+			Size:         result.Result.Size,
+			Provider:     providerType,
+		}
+		if result.Result.VolumeTag != "" {
+			volumeTag, err := names.ParseVolumeTag(result.Result.VolumeTag)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			// Filesystems on volumes are grown by the managed
+			// filesystem source of the machine.
+			resizeParams.Volume = volumeTag
+			sourceName = managedFilesystemSourceName
+		}
+		resizeParamsBySource[sourceName] = append(resizeParamsBySource[sourceName], resizeParams)
+	}
+	if len(resizeParamsBySource) == 0 {
+		return nil
+	}
+
+	var statuses []params.EntityStatusArgs
+	resized := make(map[names.FilesystemTag]uint64)
+	for sourceName, resizeParams := range resizeParamsBySource {
+		deps.config.Logger.Debugf(ctx, "resizing filesystems from %q: %v", sourceName, resizeParams)
+		var source storage.FilesystemSource
+		if sourceName == managedFilesystemSourceName {
+			source = deps.managedFilesystemSource
+		} else {
+			source, err = filesystemSource(
+				deps.config.StorageDir, sourceName, resizeParams[0].Provider, deps.config.Registry,
+			)
+			if err != nil && !errors.Is(err, errors.NotSupported) {
+				return errors.Annotate(err, "getting filesystem source")
+			}
+		}
+		resizer, ok := source.(storage.FilesystemResizer)
+		if !ok {
+			for _, p := range resizeParams {
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    p.Tag.String(),
+					Status: status.Error.String(),
+					Info: errors.Annotate(
+						errors.NotSupportedf("resizing %q filesystems", sourceName),
+						"resizing filesystem",
+					).Error(),
+				})
+			}
+			continue
+		}
+		results, err := resizer.ResizeFilesystems(ctx, resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing filesystems from source %q", sourceName)
+		}
+		for i, result := range results {
+			tag := resizeParams[i].Tag
+			if result.Error != nil {
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    tag.String(),
+					Status: status.Error.String(),
+					Info:   errors.Annotate(result.Error, "resizing filesystem").Error(),
+				})
+				deps.config.Logger.Debugf(ctx,
+					"failed to resize %s: %v",
+					names.ReadableString(tag), result.Error,
+				)
+				continue
+			}
+			resized[tag] = result.Size
+			statuses = append(statuses, params.EntityStatusArgs{
+				Tag:    tag.String(),
+				Status: filesystemAttachedStatus(deps, tag).String(),
+			})
+		}
+	}
+	defer setStatus(ctx, deps, statuses)
+	if len(resized) == 0 {
+		return nil
+	}
+
+	// Record the new sizes, leaving the remaining filesystem info intact.
+	resizedTags := make([]names.FilesystemTag, 0, len(resized))
+	for tag := range resized {
+		resizedTags = append(resizedTags, tag)
+	}
+	filesystemResults, err := deps.config.Filesystems.Filesystems(ctx, resizedTags)
+	if err != nil {
+		return errors.Annotate(err, "getting filesystem information")
+	}
+	filesystems := make([]params.Filesystem, 0, len(filesystemResults))
+	for i, result := range filesystemResults {
+		if result.Error != nil {
+			return errors.Annotatef(
+				result.Error, "getting information for %s",
+				names.ReadableString(resizedTags[i]),
+			)
+		}
+		f := result.Result
+		f.Info.Size = resized[resizedTags[i]]
+		filesystems = append(filesystems, f)
+	}
+	errorResults, err := deps.config.Filesystems.SetFilesystemInfo(ctx, filesystems)
+	if err != nil {
+		return errors.Annotate(err, "publishing resized filesystems to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			return errors.Annotatef(
+				result.Error, "publishing resized %s to state",
+				names.ReadableString(resizedTags[i]),
+			)
+		}
+		if f, ok := deps.filesystems[resizedTags[i]]; ok {
+			f.Size = filesystems[i].Info.Size
+			deps.filesystems[resizedTags[i]] = f
+		}
+	}
+	return nil
+}
+
+// filesystemAttachedStatus returns the status of a filesystem which is no
+// longer being operated upon, based on whether or not it is known to be
+// attached.
+func filesystemAttachedStatus(deps *dependencies, tag names.FilesystemTag) status.Status {
+	for id := range deps.filesystemAttachments {
+		if id.AttachmentTag == tag.String() {
+			return status.Attached
+		}
+	}
+	return status.Detached
+}
+
 func partitionRemoveFilesystemParams(removeTags []names.FilesystemTag, removeParams []params.RemoveFilesystemParams) (
 	destroyTags []names.FilesystemTag, destroyIds []string,
 	releaseTags []names.FilesystemTag, releaseIds []string,
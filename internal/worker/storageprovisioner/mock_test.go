@@ -140,10 +140,12 @@ type mockVolumeAccessor struct {
 	attachmentsWatcher     *mockAttachmentsWatcher
 	attachmentPlansWatcher *mockAttachmentPlansWatcher
 	blockDevicesWatcher    *mockNotifyWatcher
+	resizesWatcher         *mockStringsWatcher
 	provisionedMachines    map[string]instance.Id
 	provisionedVolumes     map[string]params.Volume
 	provisionedAttachments map[params.MachineStorageId]params.VolumeAttachment
 	blockDevices           map[params.MachineStorageId]params.BlockDevice
+	resizeRequests         map[string]uint64
 
 	setVolumeInfo               func([]params.Volume) ([]params.ErrorResult, error)
 	setVolumeAttachmentInfo     func([]params.VolumeAttachment) ([]params.ErrorResult, error)
@@ -177,6 +179,28 @@ func (w *mockVolumeAccessor) WatchVolumeAttachmentPlans(context.Context, names.T
 	return w.attachmentPlansWatcher, nil
 }
 
+func (w *mockVolumeAccessor) WatchVolumeResizes(context.Context, names.Tag) (watcher.StringsWatcher, error) {
+	return w.resizesWatcher, nil
+}
+
+func (v *mockVolumeAccessor) VolumeResizeParams(_ context.Context, volumes []names.VolumeTag) ([]params.VolumeResizeParamsResult, error) {
+	results := make([]params.VolumeResizeParamsResult, len(volumes))
+	for i, tag := range volumes {
+		size, ok := v.resizeRequests[tag.String()]
+		if !ok {
+			results[i].Error = apiservererrors.ServerError(errors.NotFoundf("resize of %s", names.ReadableString(tag)))
+			continue
+		}
+		results[i].Result = params.VolumeResizeParams{
+			VolumeTag: tag.String(),
+			Provider:  "dummy",
+			VolumeId:  v.provisionedVolumes[tag.String()].Info.VolumeId,
+			Size:      size,
+		}
+	}
+	return results, nil
+}
+
 func (v *mockVolumeAccessor) Volumes(_ context.Context, volumes []names.VolumeTag) ([]params.VolumeResult, error) {
 	var result []params.VolumeResult
 	for _, tag := range volumes {
@@ -323,10 +347,12 @@ func newMockVolumeAccessor() *mockVolumeAccessor {
 		attachmentsWatcher:     newMockAttachmentsWatcher(),
 		attachmentPlansWatcher: newMockAttachmentPlansWatcher(),
 		blockDevicesWatcher:    newMockNotifyWatcher(),
+		resizesWatcher:         newMockStringsWatcher(),
 		provisionedMachines:    make(map[string]instance.Id),
 		provisionedVolumes:     make(map[string]params.Volume),
 		provisionedAttachments: make(map[params.MachineStorageId]params.VolumeAttachment),
 		blockDevices:           make(map[params.MachineStorageId]params.BlockDevice),
+		resizeRequests:         make(map[string]uint64),
 	}
 }
 
@@ -334,10 +360,14 @@ type mockFilesystemAccessor struct {
 	testing.Stub
 	filesystemsWatcher             *mockStringsWatcher
 	attachmentsWatcher             *mockAttachmentsWatcher
+	resizesWatcher                 *mockStringsWatcher
 	provisionedMachines            map[string]instance.Id
 	provisionedMachinesFilesystems map[string]params.Filesystem
 	provisionedFilesystems         map[string]params.Filesystem
 	provisionedAttachments         map[params.MachineStorageId]params.FilesystemAttachment
+	resizeRequests                 map[string]uint64
+	resizeVolumes                  map[string]string
+	resizesErr                     error
 
 	setFilesystemInfo           func([]params.Filesystem) ([]params.ErrorResult, error)
 	setFilesystemAttachmentInfo func([]params.FilesystemAttachment) ([]params.ErrorResult, error)
@@ -363,6 +393,32 @@ func (w *mockFilesystemAccessor) WatchFilesystemAttachments(context.Context, nam
 	return w.attachmentsWatcher, nil
 }
 
+func (w *mockFilesystemAccessor) WatchFilesystemResizes(context.Context, names.Tag) (watcher.StringsWatcher, error) {
+	if w.resizesErr != nil {
+		return nil, w.resizesErr
+	}
+	return w.resizesWatcher, nil
+}
+
+func (v *mockFilesystemAccessor) FilesystemResizeParams(_ context.Context, filesystems []names.FilesystemTag) ([]params.FilesystemResizeParamsResult, error) {
+	results := make([]params.FilesystemResizeParamsResult, len(filesystems))
+	for i, tag := range filesystems {
+		size, ok := v.resizeRequests[tag.String()]
+		if !ok {
+			results[i].Error = apiservererrors.ServerError(errors.NotFoundf("resize of %s", names.ReadableString(tag)))
+			continue
+		}
+		results[i].Result = params.FilesystemResizeParams{
+			FilesystemTag: tag.String(),
+			Provider:      "dummy",
+			FilesystemId:  v.provisionedFilesystems[tag.String()].Info.FilesystemId,
+			Size:          size,
+			VolumeTag:     v.resizeVolumes[tag.String()],
+		}
+	}
+	return results, nil
+}
+
 func (v *mockFilesystemAccessor) Filesystems(_ context.Context, filesystems []names.FilesystemTag) ([]params.FilesystemResult, error) {
 	var result []params.FilesystemResult
 	for _, tag := range filesystems {
@@ -469,10 +525,13 @@ func newMockFilesystemAccessor() *mockFilesystemAccessor {
 	return &mockFilesystemAccessor{
 		filesystemsWatcher:             newMockStringsWatcher(),
 		attachmentsWatcher:             newMockAttachmentsWatcher(),
+		resizesWatcher:                 newMockStringsWatcher(),
 		provisionedMachines:            make(map[string]instance.Id),
 		provisionedFilesystems:         make(map[string]params.Filesystem),
 		provisionedMachinesFilesystems: make(map[string]params.Filesystem),
 		provisionedAttachments:         make(map[params.MachineStorageId]params.FilesystemAttachment),
+		resizeRequests:                 make(map[string]uint64),
+		resizeVolumes:                  make(map[string]string),
 	}
 }
 
@@ -559,6 +618,8 @@ type dummyProvider struct {
 	releaseVolumesFunc           func([]string) ([]error, error)
 	destroyFilesystemsFunc       func([]string) ([]error, error)
 	releaseFilesystemsFunc       func([]string) ([]error, error)
+	resizeVolumesFunc            func([]storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error)
+	resizeFilesystemsFunc        func([]storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error)
 	validateVolumeParamsFunc     func(storage.VolumeParams) error
 	validateFilesystemParamsFunc func(storage.FilesystemParams) error
 }
@@ -642,6 +703,18 @@ func (s *dummyVolumeSource) ReleaseVolumes(ctx context.Context, volumeIds []stri
 	return make([]error, len(volumeIds)), nil
 }
 
+// ResizeVolumes grows volumes to the requested size.
+func (s *dummyVolumeSource) ResizeVolumes(ctx context.Context, params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	if s.provider.resizeVolumesFunc != nil {
+		return s.provider.resizeVolumesFunc(params)
+	}
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		results[i].Size = p.Size
+	}
+	return results, nil
+}
+
 // AttachVolumes attaches volumes to machines.
 func (s *dummyVolumeSource) AttachVolumes(ctx context.Context, params []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
 	if s.provider != nil && s.provider.attachVolumesFunc != nil {
@@ -706,6 +779,18 @@ func (s *dummyFilesystemSource) CreateFilesystems(ctx context.Context, params []
 	return results, nil
 }
 
+// ResizeFilesystems grows filesystems to the requested size.
+func (s *dummyFilesystemSource) ResizeFilesystems(ctx context.Context, params []storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error) {
+	if s.provider.resizeFilesystemsFunc != nil {
+		return s.provider.resizeFilesystemsFunc(params)
+	}
+	results := make([]storage.ResizeFilesystemsResult, len(params))
+	for i, p := range params {
+		results[i].Size = p.Size
+	}
+	return results, nil
+}
+
 // DestroyFilesystems destroys filesystems.
 func (s *dummyFilesystemSource) DestroyFilesystems(ctx context.Context, filesystemIds []string) ([]error, error) {
 	if s.provider.destroyFilesystemsFunc != nil {
@@ -759,6 +844,7 @@ type mockManagedFilesystemSource struct {
 	blockDevices        map[names.VolumeTag]blockdevice.BlockDevice
 	filesystems         map[names.FilesystemTag]storage.Filesystem
 	attachedFilesystems chan interface{}
+	resizedFilesystems  chan interface{}
 }
 
 func (s *mockManagedFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
@@ -820,6 +906,17 @@ func (s *mockManagedFilesystemSource) AttachFilesystems(ctx context.Context, arg
 	return results, nil
 }
 
+func (s *mockManagedFilesystemSource) ResizeFilesystems(ctx context.Context, args []storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		results[i].Size = arg.Size
+	}
+	if s.resizedFilesystems != nil {
+		s.resizedFilesystems <- args
+	}
+	return results, nil
+}
+
 func (s *mockManagedFilesystemSource) DetachFilesystems(ctx context.Context, params []storage.FilesystemAttachmentParams) ([]error, error) {
 	return nil, errors.NotImplementedf("DetachFilesystems")
 }
@@ -70,6 +70,14 @@ type VolumeAccessor interface {
 	// releasing the volumes with the specified tags.
 	RemoveVolumeParams(context.Context, []names.VolumeTag) ([]params.RemoveVolumeParamsResult, error)
 
+	// WatchVolumeResizes watches for changes to volumes which may have
+	// resizes requested.
+	WatchVolumeResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error)
+
+	// VolumeResizeParams returns the parameters for resizing the volumes
+	// with the specified tags.
+	VolumeResizeParams(context.Context, []names.VolumeTag) ([]params.VolumeResizeParamsResult, error)
+
 	// VolumeAttachmentParams returns the parameters for creating the
 	// volume attachments with the specified tags.
 	VolumeAttachmentParams(context.Context, []params.MachineStorageId) ([]params.VolumeAttachmentParamsResult, error)
@@ -112,6 +120,14 @@ type FilesystemAccessor interface {
 	// releasing the filesystems with the specified tags.
 	RemoveFilesystemParams(context.Context, []names.FilesystemTag) ([]params.RemoveFilesystemParamsResult, error)
 
+	// WatchFilesystemResizes watches for changes to filesystems which may
+	// have resizes requested.
+	WatchFilesystemResizes(ctx context.Context, scope names.Tag) (watcher.StringsWatcher, error)
+
+	// FilesystemResizeParams returns the parameters for resizing the
+	// filesystems with the specified tags.
+	FilesystemResizeParams(context.Context, []names.FilesystemTag) ([]params.FilesystemResizeParamsResult, error)
+
 	// FilesystemAttachmentParams returns the parameters for creating the
 	// filesystem attachments with the specified tags.
 	FilesystemAttachmentParams(context.Context, []params.MachineStorageId) ([]params.FilesystemAttachmentParamsResult, error)
@@ -209,6 +225,8 @@ func (w *storageProvisioner) loop() error {
 		volumeAttachmentsChanges     watcher.MachineStorageIDsChannel
 		volumeAttachmentPlansChanges watcher.MachineStorageIDsChannel
 		filesystemAttachmentsChanges watcher.MachineStorageIDsChannel
+		volumeResizesChanges         watcher.StringsChannel
+		filesystemResizesChanges     watcher.StringsChannel
 		machineBlockDevicesChanges   <-chan struct{}
 	)
 	machineChanges := make(chan names.MachineTag)
@@ -302,6 +320,38 @@ func (w *storageProvisioner) loop() error {
 	}
 	filesystemAttachmentsChanges = filesystemAttachmentsWatcher.Changes()
 
+	// Model-scoped volumes and filesystems are resized by the model, and
+	// a machine grows the filesystems on the volumes attached to it once
+	// the volumes have been resized.
+	watchResizes := func(
+		watch func(context.Context, names.Tag) (watcher.StringsWatcher, error), kind string,
+	) (watcher.StringsChannel, error) {
+		resizesWatcher, err := watch(ctx, w.config.Scope)
+		if errors.Is(err, errors.NotSupported) {
+			w.config.Logger.Infof(ctx, "not watching %s resizes: %v", kind, err)
+			return nil, nil
+		} else if err != nil {
+			return nil, errors.Annotatef(err, "watching %s resizes", kind)
+		}
+		if err := w.catacomb.Add(resizesWatcher); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return resizesWatcher.Changes(), nil
+	}
+	switch w.config.Scope.(type) {
+	case names.ModelTag:
+		if volumeResizesChanges, err = watchResizes(w.config.Volumes.WatchVolumeResizes, "volume"); err != nil {
+			return errors.Trace(err)
+		}
+		if filesystemResizesChanges, err = watchResizes(w.config.Filesystems.WatchFilesystemResizes, "filesystem"); err != nil {
+			return errors.Trace(err)
+		}
+	case names.MachineTag:
+		if filesystemResizesChanges, err = watchResizes(w.config.Filesystems.WatchFilesystemResizes, "filesystem"); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	for {
 
 		// Check if block devices need to be refreshed.
@@ -363,6 +413,20 @@ func (w *storageProvisioner) loop() error {
 			if err := filesystemAttachmentsChanged(ctx, &deps, changes); err != nil {
 				return errors.Trace(err)
 			}
+		case changes, ok := <-volumeResizesChanges:
+			if !ok {
+				return errors.New("volume resizes watcher closed")
+			}
+			if err := resizeVolumes(ctx, &deps, changes); err != nil {
+				return errors.Annotate(err, "resizing volumes")
+			}
+		case changes, ok := <-filesystemResizesChanges:
+			if !ok {
+				return errors.New("filesystem resizes watcher closed")
+			}
+			if err := resizeFilesystems(ctx, &deps, changes); err != nil {
+				return errors.Annotate(err, "resizing filesystems")
+			}
 		case _, ok := <-machineBlockDevicesChanges:
 			if !ok {
 				return errors.New("machine block devices watcher closed")
@@ -29,6 +29,7 @@ type storageProvisionerSuite struct {
 	provider                *dummyProvider
 	registry                storage.ProviderRegistry
 	managedFilesystemSource *mockManagedFilesystemSource
+	resizedFilesystems      chan interface{}
 }
 
 var _ = gc.Suite(&storageProvisionerSuite{})
@@ -43,6 +44,7 @@ func (s *storageProvisionerSuite) SetUpTest(c *gc.C) {
 	}
 
 	s.managedFilesystemSource = nil
+	s.resizedFilesystems = nil
 	s.PatchValue(
 		storageprovisioner.NewManagedFilesystemSource,
 		func(
@@ -50,8 +52,9 @@ func (s *storageProvisionerSuite) SetUpTest(c *gc.C) {
 			filesystems map[names.FilesystemTag]storage.Filesystem,
 		) storage.FilesystemSource {
 			s.managedFilesystemSource = &mockManagedFilesystemSource{
-				blockDevices: blockDevices,
-				filesystems:  filesystems,
+				blockDevices:       blockDevices,
+				filesystems:        filesystems,
+				resizedFilesystems: s.resizedFilesystems,
 			}
 			return s.managedFilesystemSource
 		},
@@ -2285,6 +2288,191 @@ func (s *caasStorageProvisionerSuite) TestRemoveFilesystems(c *gc.C) {
 	waitChannel(c, removed, "waiting for filesystem to be removed")
 }
 
+func (s *storageProvisionerSuite) TestResizeVolumes(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+	volumeAccessor.provisionVolume(names.NewVolumeTag("2"))
+	volumeAccessor.resizeRequests["volume-1"] = 2048
+
+	resizedChan := make(chan interface{}, 1)
+	s.provider.resizeVolumesFunc = func(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+		resizedChan <- args
+		results := make([]storage.ResizeVolumesResult, len(args))
+		for i, arg := range args {
+			results[i].Size = arg.Size + 1
+		}
+		return results, nil
+	}
+
+	volumeInfoSet := make(chan interface{}, 1)
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		volumeInfoSet <- volumes
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.resizesWatcher.changes <- []string{"1", "2"}
+
+	resized := waitChannel(c, resizedChan, "waiting for volume to be resized")
+	c.Assert(resized, jc.DeepEquals, []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "vol-1",
+		Size:     2048,
+		Provider: "dummy",
+	}})
+	volumes := waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(volumes, jc.DeepEquals, []params.Volume{{
+		VolumeTag: "volume-1",
+		Info: params.VolumeInfo{
+			VolumeId: "vol-1",
+			Size:     2049,
+		},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumesError(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+	volumeAccessor.resizeRequests["volume-1"] = 2048
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		c.Fatalf("unexpected call to SetVolumeInfo")
+		return nil, nil
+	}
+
+	s.provider.resizeVolumesFunc = func(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+		return []storage.ResizeVolumesResult{{Error: errors.New("quota exceeded")}}, nil
+	}
+
+	statusSet := make(chan interface{}, 1)
+	statusSetter := &mockStatusSetter{
+		setStatus: func(args []params.EntityStatusArgs) error {
+			statusSet <- args
+			return nil
+		},
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry, statusSetter: statusSetter}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.resizesWatcher.changes <- []string{"1"}
+
+	statuses := waitChannel(c, statusSet, "waiting for status to be set")
+	c.Assert(statuses, jc.DeepEquals, []params.EntityStatusArgs{{
+		Tag:    "volume-1",
+		Status: "error",
+		Info:   "resizing volume: quota exceeded",
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeFilesystems(c *gc.C) {
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.provisionFilesystem(names.NewFilesystemTag("1"))
+	filesystemAccessor.resizeRequests["filesystem-1"] = 2048
+
+	resizedChan := make(chan interface{}, 1)
+	s.provider.resizeFilesystemsFunc = func(args []storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error) {
+		resizedChan <- args
+		results := make([]storage.ResizeFilesystemsResult, len(args))
+		for i, arg := range args {
+			results[i].Size = arg.Size
+		}
+		return results, nil
+	}
+
+	filesystemInfoSet := make(chan interface{}, 1)
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		filesystemInfoSet <- filesystems
+		return make([]params.ErrorResult, len(filesystems)), nil
+	}
+
+	args := &workerArgs{filesystems: filesystemAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.resizesWatcher.changes <- []string{"1"}
+
+	resized := waitChannel(c, resizedChan, "waiting for filesystem to be resized")
+	c.Assert(resized, jc.DeepEquals, []storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("1"),
+		FilesystemId: "fs-1",
+		Size:         2048,
+		Provider:     "dummy",
+	}})
+	filesystems := waitChannel(c, filesystemInfoSet, "waiting for filesystem info to be set")
+	c.Assert(filesystems, jc.DeepEquals, []params.Filesystem{{
+		FilesystemTag: "filesystem-1",
+		Info: params.FilesystemInfo{
+			FilesystemId: "fs-1",
+			Size:         2048,
+		},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeFilesystemsOnVolume(c *gc.C) {
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.provisionFilesystem(names.NewFilesystemTag("0/1"))
+	filesystemAccessor.resizeRequests["filesystem-0-1"] = 2048
+	filesystemAccessor.resizeVolumes["filesystem-0-1"] = "volume-0-1"
+
+	filesystemInfoSet := make(chan interface{}, 1)
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		filesystemInfoSet <- filesystems
+		return make([]params.ErrorResult, len(filesystems)), nil
+	}
+
+	resizedChan := make(chan interface{}, 1)
+	s.resizedFilesystems = resizedChan
+
+	args := &workerArgs{
+		scope:       names.NewMachineTag("0"),
+		filesystems: filesystemAccessor,
+		registry:    s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.resizesWatcher.changes <- []string{"0/1"}
+
+	// Filesystems on volumes are grown on the machine, by the
+	// managed filesystem source.
+	resized := waitChannel(c, resizedChan, "waiting for filesystem to be resized")
+	c.Assert(resized, jc.DeepEquals, []storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0/1"),
+		FilesystemId: "fs-0/1",
+		Size:         2048,
+		Provider:     "dummy",
+		Volume:       names.NewVolumeTag("0/1"),
+	}})
+	filesystems := waitChannel(c, filesystemInfoSet, "waiting for filesystem info to be set")
+	c.Assert(filesystems, jc.DeepEquals, []params.Filesystem{{
+		FilesystemTag: "filesystem-0-1",
+		Info: params.FilesystemInfo{
+			FilesystemId: "fs-0/1",
+			Size:         2048,
+		},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeFilesystemsNotSupported(c *gc.C) {
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.resizesErr = errors.NotSupportedf("filesystem resizes on this controller")
+
+	// An older controller which cannot report resizes must not
+	// stop the worker.
+	args := &workerArgs{filesystems: filesystemAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	workertest.CheckAlive(c, worker)
+	workertest.CleanKill(c, worker)
+}
+
 func newStorageProvisioner(c *gc.C, args *workerArgs) worker.Worker {
 	if args == nil {
 		args = &workerArgs{}
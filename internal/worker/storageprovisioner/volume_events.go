@@ -695,6 +695,7 @@ func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error
 		Attributes:   in.Attributes,
 		ResourceTags: in.Tags,
 		Attachment:   attachment,
+		SnapshotId:   in.SnapshotId,
 	}, nil
 }
 
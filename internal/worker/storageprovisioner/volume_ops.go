@@ -330,6 +330,150 @@ func removeVolumes(ctx context.Context, deps *dependencies, ops map[names.Volume
 	return nil
 }
 
+// resizeVolumes grows the volumes with the specified IDs which have a
+// resize requested, and records their new sizes.
+func resizeVolumes(ctx context.Context, deps *dependencies, ids []string) error {
+	tags := make([]names.VolumeTag, len(ids))
+	for i, id := range ids {
+		tags[i] = names.NewVolumeTag(id)
+	}
+	resizeResults, err := deps.config.Volumes.VolumeResizeParams(ctx, tags)
+	if err != nil {
+		return errors.Annotate(err, "getting volume resize params")
+	}
+	resizeParamsBySource := make(map[string][]storage.VolumeResizeParams)
+	for i, result := range resizeResults {
+		if result.Error != nil {
+			if params.IsCodeNotFound(result.Error) {
+				// No resize requested.
+				continue
+			}
+			return errors.Annotatef(
+				result.Error, "getting resize params for %s",
+				names.ReadableString(tags[i]),
+			)
+		}
+		providerType := storage.ProviderType(result.Result.Provider)
+		sourceName := string(providerType)
+		resizeParamsBySource[sourceName] = append(resizeParamsBySource[sourceName], storage.VolumeResizeParams{
+			Tag:      tags[i],
+			VolumeId: result.Result.VolumeId,
+			Size:     result.Result.Size,
+			Provider: providerType,
+		})
+	}
+	if len(resizeParamsBySource) == 0 {
+		return nil
+	}
+
+	var statuses []params.EntityStatusArgs
+	resized := make(map[names.VolumeTag]uint64)
+	for sourceName, resizeParams := range resizeParamsBySource {
+		deps.config.Logger.Debugf(ctx, "resizing volumes from %q: %v", sourceName, resizeParams)
+		setErrors := func(err error) {
+			for _, p := range resizeParams {
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    p.Tag.String(),
+					Status: status.Error.String(),
+					Info:   errors.Annotate(err, "resizing volume").Error(),
+				})
+			}
+		}
+		source, err := volumeSource(
+			deps.config.StorageDir, sourceName, resizeParams[0].Provider, deps.config.Registry,
+		)
+		if errors.Cause(err) == errNonDynamic {
+			setErrors(errors.NotSupportedf("resizing non-dynamic storage"))
+			continue
+		} else if err != nil {
+			return errors.Annotate(err, "getting volume source")
+		}
+		resizer, ok := source.(storage.VolumeResizer)
+		if !ok {
+			setErrors(errors.NotSupportedf("resizing %q volumes", sourceName))
+			continue
+		}
+		results, err := resizer.ResizeVolumes(ctx, resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing volumes from source %q", sourceName)
+		}
+		for i, result := range results {
+			tag := resizeParams[i].Tag
+			if result.Error != nil {
+				statuses = append(statuses, params.EntityStatusArgs{
+					Tag:    tag.String(),
+					Status: status.Error.String(),
+					Info:   errors.Annotate(result.Error, "resizing volume").Error(),
+				})
+				deps.config.Logger.Debugf(ctx,
+					"failed to resize %s: %v",
+					names.ReadableString(tag), result.Error,
+				)
+				continue
+			}
+			resized[tag] = result.Size
+			statuses = append(statuses, params.EntityStatusArgs{
+				Tag:    tag.String(),
+				Status: volumeAttachedStatus(deps, tag).String(),
+			})
+		}
+	}
+	defer setStatus(ctx, deps, statuses)
+	if len(resized) == 0 {
+		return nil
+	}
+
+	// Record the new sizes, leaving the remaining volume info intact.
+	resizedTags := make([]names.VolumeTag, 0, len(resized))
+	for tag := range resized {
+		resizedTags = append(resizedTags, tag)
+	}
+	volumeResults, err := deps.config.Volumes.Volumes(ctx, resizedTags)
+	if err != nil {
+		return errors.Annotate(err, "getting volume information")
+	}
+	volumes := make([]params.Volume, 0, len(volumeResults))
+	for i, result := range volumeResults {
+		if result.Error != nil {
+			return errors.Annotatef(
+				result.Error, "getting information for %s",
+				names.ReadableString(resizedTags[i]),
+			)
+		}
+		v := result.Result
+		v.Info.Size = resized[resizedTags[i]]
+		volumes = append(volumes, v)
+	}
+	errorResults, err := deps.config.Volumes.SetVolumeInfo(ctx, volumes)
+	if err != nil {
+		return errors.Annotate(err, "publishing resized volumes to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			return errors.Annotatef(
+				result.Error, "publishing resized %s to state",
+				names.ReadableString(resizedTags[i]),
+			)
+		}
+		if v, ok := deps.volumes[resizedTags[i]]; ok {
+			v.Size = volumes[i].Info.Size
+			deps.volumes[resizedTags[i]] = v
+		}
+	}
+	return nil
+}
+
+// volumeAttachedStatus returns the status of a volume which is no longer
+// being operated upon, based on whether or not it is known to be attached.
+func volumeAttachedStatus(deps *dependencies, tag names.VolumeTag) status.Status {
+	for id := range deps.volumeAttachments {
+		if id.AttachmentTag == tag.String() {
+			return status.Attached
+		}
+	}
+	return status.Detached
+}
+
 func partitionRemoveVolumeParams(removeTags []names.VolumeTag, removeParams []params.RemoveVolumeParams) (
 	destroyTags []names.VolumeTag, destroyIds []string,
 	releaseTags []names.VolumeTag, releaseIds []string,
@@ -172,4 +172,5 @@ type StorageAccessor interface {
 	UnitStorageAttachments(context.Context, names.UnitTag) ([]params.StorageAttachmentId, error)
 	DestroyUnitStorageAttachments(context.Context, names.UnitTag) error
 	RemoveStorageAttachment(context.Context, names.StorageTag, names.UnitTag) error
+	ClearStorageAttachmentResized(context.Context, names.StorageTag, names.UnitTag, uint64) error
 }
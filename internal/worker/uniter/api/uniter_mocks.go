@@ -319,6 +319,44 @@ func (c *MockUniterClientCharmCall) DoAndReturn(f func(string) (Charm, error)) *
 	return c
 }
 
+// ClearStorageAttachmentResized mocks base method.
+func (m *MockUniterClient) ClearStorageAttachmentResized(arg0 context.Context, arg1 names.StorageTag, arg2 names.UnitTag, arg3 uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearStorageAttachmentResized", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearStorageAttachmentResized indicates an expected call of ClearStorageAttachmentResized.
+func (mr *MockUniterClientMockRecorder) ClearStorageAttachmentResized(arg0, arg1, arg2, arg3 any) *MockUniterClientClearStorageAttachmentResizedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearStorageAttachmentResized", reflect.TypeOf((*MockUniterClient)(nil).ClearStorageAttachmentResized), arg0, arg1, arg2, arg3)
+	return &MockUniterClientClearStorageAttachmentResizedCall{Call: call}
+}
+
+// MockUniterClientClearStorageAttachmentResizedCall wrap *gomock.Call
+type MockUniterClientClearStorageAttachmentResizedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUniterClientClearStorageAttachmentResizedCall) Return(arg0 error) *MockUniterClientClearStorageAttachmentResizedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUniterClientClearStorageAttachmentResizedCall) Do(f func(context.Context, names.StorageTag, names.UnitTag, uint64) error) *MockUniterClientClearStorageAttachmentResizedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUniterClientClearStorageAttachmentResizedCall) DoAndReturn(f func(context.Context, names.StorageTag, names.UnitTag, uint64) error) *MockUniterClientClearStorageAttachmentResizedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CloudAPIVersion mocks base method.
 func (m *MockUniterClient) CloudAPIVersion(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
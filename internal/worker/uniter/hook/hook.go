@@ -123,7 +123,7 @@ func (hi Info) Validate() error {
 		return nil
 	case hooks.Action:
 		return errors.Errorf("hooks.Kind Action is deprecated")
-	case hooks.StorageAttached, hooks.StorageDetaching:
+	case hooks.StorageAttached, hooks.StorageDetaching, hooks.StorageResized:
 		if !names.IsValidStorage(hi.StorageId) {
 			return errors.Errorf("invalid storage ID %q", hi.StorageId)
 		}
@@ -139,4 +139,12 @@ type StorageSnapshot struct {
 	Life     life.Value
 	Attached bool
 	Location string
+
+	// Resized is true if the storage has been resized, and the
+	// storage-resized hook has not yet been committed.
+	Resized bool
+
+	// ResizeGeneration is the number of resizes of the storage that
+	// have completed while the unit was attached to it.
+	ResizeGeneration uint64
 }
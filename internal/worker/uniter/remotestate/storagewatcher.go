@@ -81,10 +81,12 @@ func getStorageSnapshot(
 		return StorageSnapshot{}, errors.Annotate(err, "refreshing storage details")
 	}
 	snapshot := StorageSnapshot{
-		Life:     attachment.Life,
-		Kind:     attachment.Kind,
-		Attached: true,
-		Location: attachment.Location,
+		Life:             attachment.Life,
+		Kind:             attachment.Kind,
+		Attached:         true,
+		Location:         attachment.Location,
+		Resized:          attachment.Resized,
+		ResizeGeneration: attachment.ResizeGeneration,
 	}
 	return snapshot, nil
 }
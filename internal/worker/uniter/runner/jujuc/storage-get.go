@@ -48,7 +48,7 @@ be overridden by specifying the name of the storage as reported
 by storage-list, and must be specified for non-storage hooks.
 
 storage-get can be used to identify the storage location during
-storage-attached and storage-detaching hooks. The exception to
+storage-attached, storage-detaching and storage-resized hooks. The exception to
 this is when the charm specifies a static location for
 singleton stores.
 `
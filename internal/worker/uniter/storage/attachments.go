@@ -12,6 +12,7 @@ import (
 	"github.com/juju/juju/internal/charm/hooks"
 	"github.com/juju/juju/internal/worker/uniter/api"
 	"github.com/juju/juju/internal/worker/uniter/hook"
+	"github.com/juju/juju/internal/worker/uniter/remotestate"
 )
 
 // Attachments generates storage hooks in response to changes to
@@ -25,6 +26,12 @@ type Attachments struct {
 	// for which no hooks have been run.
 	pending names.Set
 
+	// resizing records the resize generations of the storage
+	// attachments for which a storage-resized hook is to be run, and
+	// resized the generations for which the hook has been committed.
+	resizing map[names.StorageTag]uint64
+	resized  map[names.StorageTag]uint64
+
 	stateOps *stateOps
 
 	// TODO: hml
@@ -46,6 +53,8 @@ func NewAttachments(
 		abort:    abort,
 		stateOps: NewStateOps(rw),
 		pending:  names.NewSet(),
+		resizing: make(map[names.StorageTag]uint64),
+		resized:  make(map[names.StorageTag]uint64),
 	}
 	if err := a.init(ctx); err != nil {
 		return nil, err
@@ -125,6 +134,19 @@ func (a *Attachments) CommitHook(ctx context.Context, hi hook.Info) error {
 	if !hi.Kind.IsStorage() {
 		return errors.Errorf("not a storage hook: %#v", hi)
 	}
+	if hi.Kind == hooks.StorageResized {
+		// A resize does not change the state of the attachment. Only
+		// the generation the hook was run for is cleared, so that the
+		// hook is run again for any resize completed since.
+		storageTag := names.NewStorageTag(hi.StorageId)
+		generation := a.resizing[storageTag]
+		if err := a.client.ClearStorageAttachmentResized(ctx, storageTag, a.unitTag, generation); err != nil {
+			return errors.Annotate(err, "clearing storage attachment resized")
+		}
+		delete(a.resizing, storageTag)
+		a.resized[storageTag] = generation
+		return nil
+	}
 	if hi.Kind == hooks.StorageDetaching {
 		err := a.storageState.Detach(hi.StorageId)
 		if err != nil {
@@ -149,6 +171,22 @@ func (a *Attachments) CommitHook(ctx context.Context, hi hook.Info) error {
 	return nil
 }
 
+// resizePending reports whether a storage-resized hook needs to be run for
+// the storage attachment, and records the resize generation the hook is to
+// be run for. The hook is run once for each generation which the unit has
+// not yet committed a hook for.
+func (a *Attachments) resizePending(tag names.StorageTag, snap remotestate.StorageSnapshot) bool {
+	if !snap.Resized {
+		delete(a.resized, tag)
+		return false
+	}
+	if snap.ResizeGeneration <= a.resized[tag] {
+		return false
+	}
+	a.resizing[tag] = snap.ResizeGeneration
+	return true
+}
+
 func (a *Attachments) removeStorageAttachment(ctx context.Context, tag names.StorageTag) error {
 	if err := a.client.RemoveStorageAttachment(ctx, tag, a.unitTag); err != nil {
 		return errors.Annotate(err, "removing storage attachment")
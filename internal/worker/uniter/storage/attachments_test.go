@@ -349,6 +349,88 @@ func (s *attachmentsSuite) TestAttachmentsCommitHook(c *gc.C) {
 	c.Assert(removed, jc.IsTrue)
 }
 
+func (s *attachmentsSuite) TestAttachmentsResized(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	unitTag := names.NewUnitTag("mysql/0")
+	abort := make(chan struct{})
+
+	var cleared []uint64
+	storageTag := names.NewStorageTag("data/0")
+	st := &mockStorageAccessor{
+		unitStorageAttachments: func(u names.UnitTag) ([]params.StorageAttachmentId, error) {
+			return nil, nil
+		},
+		clearResized: func(s names.StorageTag, u names.UnitTag, generation uint64) error {
+			c.Assert(s, gc.Equals, storageTag)
+			c.Assert(u, gc.Equals, unitTag)
+			cleared = append(cleared, generation)
+			return nil
+		},
+	}
+
+	att, err := storage.NewAttachments(context.Background(), st, unitTag, s.mockStateOps, abort)
+	c.Assert(err, jc.ErrorIsNil)
+	r := storage.NewResolver(loggertesting.WrapCheckLog(c), att, s.modelType)
+
+	s.storSt.Attach(storageTag.Id())
+	s.expectSetState(c, "")
+	err = att.CommitHook(context.Background(), hook.Info{
+		Kind:      hooks.StorageAttached,
+		StorageId: storageTag.Id(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	localState := resolver.LocalState{State: operation.State{
+		Kind: operation.Continue,
+	}}
+	nextOp := func(resized bool, generation uint64) (operation.Operation, error) {
+		return r.NextOp(context.Background(), localState, remotestate.Snapshot{
+			Life: life.Alive,
+			Storage: map[names.StorageTag]remotestate.StorageSnapshot{
+				storageTag: {
+					Kind:             params.StorageKindBlock,
+					Life:             life.Alive,
+					Location:         "/dev/sdb",
+					Attached:         true,
+					Resized:          resized,
+					ResizeGeneration: generation,
+				},
+			},
+		}, &mockOperations{})
+	}
+	hi := hook.Info{
+		Kind:      hooks.StorageResized,
+		StorageId: storageTag.Id(),
+	}
+
+	// No hook is run until the storage is resized.
+	_, err = nextOp(false, 0)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+
+	op, err := nextOp(true, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run hook storage-resized")
+	c.Assert(att.ValidateHook(hi), jc.ErrorIsNil)
+	err = att.CommitHook(context.Background(), hi)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The hook is not run again for the same generation, before the
+	// clearing is observed. A resize completed in the meantime runs the
+	// hook again, and is cleared with its own generation.
+	_, err = nextOp(true, 1)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+	op, err = nextOp(true, 2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run hook storage-resized")
+	err = att.CommitHook(context.Background(), hi)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cleared, jc.DeepEquals, []uint64{1, 2})
+
+	_, err = nextOp(false, 2)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
 func (s *attachmentsSuite) TestAttachmentsSetDying(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -20,6 +20,7 @@ type mockStorageAccessor struct {
 	unitStorageAttachments        func(names.UnitTag) ([]params.StorageAttachmentId, error)
 	destroyUnitStorageAttachments func(names.UnitTag) error
 	remove                        func(names.StorageTag, names.UnitTag) error
+	clearResized                  func(names.StorageTag, names.UnitTag, uint64) error
 }
 
 func (m *mockStorageAccessor) StorageAttachment(ctx context.Context, s names.StorageTag, u names.UnitTag) (params.StorageAttachment, error) {
@@ -38,6 +39,10 @@ func (m *mockStorageAccessor) RemoveStorageAttachment(ctx context.Context, s nam
 	return m.remove(s, u)
 }
 
+func (m *mockStorageAccessor) ClearStorageAttachmentResized(ctx context.Context, s names.StorageTag, u names.UnitTag, generation uint64) error {
+	return m.clearResized(s, u, generation)
+}
+
 type mockOperations struct {
 	operation.Factory
 }
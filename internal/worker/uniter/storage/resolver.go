@@ -176,8 +176,12 @@ func (s *storageResolver) nextHookOp(
 		attached, ok := s.storage.storageState.Attached(tag.Id())
 		if ok && attached {
 			// Once the storage is attached, we only care about
-			// lifecycle State changes.
-			return nil, resolver.ErrNoOperation
+			// lifecycle State changes and resizes.
+			if !s.storage.resizePending(tag, snap) {
+				return nil, resolver.ErrNoOperation
+			}
+			hookInfo.Kind = hooks.StorageResized
+			break
 		}
 		// The storage-attached hook has not been committed, so add the
 		// storage to the pending set.
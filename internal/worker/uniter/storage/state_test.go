@@ -87,6 +87,19 @@ func (s *stateSuite) TestValidateHookStorageDetachingError(c *gc.C) {
 
 }
 
+func (s *stateSuite) TestValidateHookStorageResized(c *gc.C) {
+	s.st.Attach(s.tag1.Id())
+	hi := hook.Info{Kind: hooks.StorageResized, StorageId: s.tag1.Id()}
+	err := s.st.ValidateHook(hi)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestValidateHookStorageResizedNotAttached(c *gc.C) {
+	hi := hook.Info{Kind: hooks.StorageResized, StorageId: s.tag1.Id()}
+	err := s.st.ValidateHook(hi)
+	c.Assert(err, gc.ErrorMatches, `inappropriate "storage-resized" hook for storage "test/1": storage not attached`)
+}
+
 func (s *stateSuite) TestValidateHookStorageAttached(c *gc.C) {
 	hi := hook.Info{Kind: hooks.StorageAttached, StorageId: s.tag1.Id()}
 	err := s.st.ValidateHook(hi)
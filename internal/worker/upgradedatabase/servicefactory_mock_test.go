@@ -13,20 +13,24 @@ import (
 	reflect "reflect"
 
 	service "github.com/juju/juju/domain/access/service"
-	service0 "github.com/juju/juju/domain/agentbinary/service"
-	service1 "github.com/juju/juju/domain/autocert/service"
-	service2 "github.com/juju/juju/domain/cloud/service"
-	service3 "github.com/juju/juju/domain/controller/service"
-	service4 "github.com/juju/juju/domain/controllerconfig/service"
-	service5 "github.com/juju/juju/domain/controllernode/service"
-	service6 "github.com/juju/juju/domain/credential/service"
-	service7 "github.com/juju/juju/domain/externalcontroller/service"
-	service8 "github.com/juju/juju/domain/flag/service"
-	service9 "github.com/juju/juju/domain/macaroon/service"
-	service10 "github.com/juju/juju/domain/model/service"
-	service11 "github.com/juju/juju/domain/modeldefaults/service"
-	service12 "github.com/juju/juju/domain/secretbackend/service"
-	service13 "github.com/juju/juju/domain/upgrade/service"
+	service0 "github.com/juju/juju/domain/admission/service"
+	service1 "github.com/juju/juju/domain/agentbinary/service"
+	service2 "github.com/juju/juju/domain/autocert/service"
+	service3 "github.com/juju/juju/domain/cloud/service"
+	service4 "github.com/juju/juju/domain/controller/service"
+	service5 "github.com/juju/juju/domain/controllerconfig/service"
+	service6 "github.com/juju/juju/domain/controllernode/service"
+	service7 "github.com/juju/juju/domain/credential/service"
+	service8 "github.com/juju/juju/domain/externalcontroller/service"
+	service9 "github.com/juju/juju/domain/flag/service"
+	service10 "github.com/juju/juju/domain/macaroon/service"
+	service11 "github.com/juju/juju/domain/model/service"
+	service12 "github.com/juju/juju/domain/modeldefaults/service"
+	service13 "github.com/juju/juju/domain/secretbackend/service"
+	service14 "github.com/juju/juju/domain/sshaccess/service"
+	service15 "github.com/juju/juju/domain/sshca/service"
+	service16 "github.com/juju/juju/domain/sshsession/service"
+	service17 "github.com/juju/juju/domain/upgrade/service"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -91,11 +95,49 @@ func (c *MockControllerDomainServicesAccessCall) DoAndReturn(f func() *service.S
 	return c
 }
 
+// Admission mocks base method.
+func (m *MockControllerDomainServices) Admission() *service0.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Admission")
+	ret0, _ := ret[0].(*service0.Service)
+	return ret0
+}
+
+// Admission indicates an expected call of Admission.
+func (mr *MockControllerDomainServicesMockRecorder) Admission() *MockControllerDomainServicesAdmissionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Admission", reflect.TypeOf((*MockControllerDomainServices)(nil).Admission))
+	return &MockControllerDomainServicesAdmissionCall{Call: call}
+}
+
+// MockControllerDomainServicesAdmissionCall wrap *gomock.Call
+type MockControllerDomainServicesAdmissionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerDomainServicesAdmissionCall) Return(arg0 *service0.Service) *MockControllerDomainServicesAdmissionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerDomainServicesAdmissionCall) Do(f func() *service0.Service) *MockControllerDomainServicesAdmissionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerDomainServicesAdmissionCall) DoAndReturn(f func() *service0.Service) *MockControllerDomainServicesAdmissionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AutocertCache mocks base method.
-func (m *MockControllerDomainServices) AutocertCache() *service1.Service {
+func (m *MockControllerDomainServices) AutocertCache() *service2.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AutocertCache")
-	ret0, _ := ret[0].(*service1.Service)
+	ret0, _ := ret[0].(*service2.Service)
 	return ret0
 }
 
@@ -112,28 +154,28 @@ type MockControllerDomainServicesAutocertCacheCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesAutocertCacheCall) Return(arg0 *service1.Service) *MockControllerDomainServicesAutocertCacheCall {
+func (c *MockControllerDomainServicesAutocertCacheCall) Return(arg0 *service2.Service) *MockControllerDomainServicesAutocertCacheCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesAutocertCacheCall) Do(f func() *service1.Service) *MockControllerDomainServicesAutocertCacheCall {
+func (c *MockControllerDomainServicesAutocertCacheCall) Do(f func() *service2.Service) *MockControllerDomainServicesAutocertCacheCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesAutocertCacheCall) DoAndReturn(f func() *service1.Service) *MockControllerDomainServicesAutocertCacheCall {
+func (c *MockControllerDomainServicesAutocertCacheCall) DoAndReturn(f func() *service2.Service) *MockControllerDomainServicesAutocertCacheCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Cloud mocks base method.
-func (m *MockControllerDomainServices) Cloud() *service2.WatchableService {
+func (m *MockControllerDomainServices) Cloud() *service3.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Cloud")
-	ret0, _ := ret[0].(*service2.WatchableService)
+	ret0, _ := ret[0].(*service3.WatchableService)
 	return ret0
 }
 
@@ -150,28 +192,28 @@ type MockControllerDomainServicesCloudCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesCloudCall) Return(arg0 *service2.WatchableService) *MockControllerDomainServicesCloudCall {
+func (c *MockControllerDomainServicesCloudCall) Return(arg0 *service3.WatchableService) *MockControllerDomainServicesCloudCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesCloudCall) Do(f func() *service2.WatchableService) *MockControllerDomainServicesCloudCall {
+func (c *MockControllerDomainServicesCloudCall) Do(f func() *service3.WatchableService) *MockControllerDomainServicesCloudCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesCloudCall) DoAndReturn(f func() *service2.WatchableService) *MockControllerDomainServicesCloudCall {
+func (c *MockControllerDomainServicesCloudCall) DoAndReturn(f func() *service3.WatchableService) *MockControllerDomainServicesCloudCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Controller mocks base method.
-func (m *MockControllerDomainServices) Controller() *service3.Service {
+func (m *MockControllerDomainServices) Controller() *service4.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Controller")
-	ret0, _ := ret[0].(*service3.Service)
+	ret0, _ := ret[0].(*service4.Service)
 	return ret0
 }
 
@@ -188,28 +230,28 @@ type MockControllerDomainServicesControllerCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesControllerCall) Return(arg0 *service3.Service) *MockControllerDomainServicesControllerCall {
+func (c *MockControllerDomainServicesControllerCall) Return(arg0 *service4.Service) *MockControllerDomainServicesControllerCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesControllerCall) Do(f func() *service3.Service) *MockControllerDomainServicesControllerCall {
+func (c *MockControllerDomainServicesControllerCall) Do(f func() *service4.Service) *MockControllerDomainServicesControllerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesControllerCall) DoAndReturn(f func() *service3.Service) *MockControllerDomainServicesControllerCall {
+func (c *MockControllerDomainServicesControllerCall) DoAndReturn(f func() *service4.Service) *MockControllerDomainServicesControllerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ControllerAgentBinaryStore mocks base method.
-func (m *MockControllerDomainServices) ControllerAgentBinaryStore() *service0.AgentBinaryStore {
+func (m *MockControllerDomainServices) ControllerAgentBinaryStore() *service1.AgentBinaryStore {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ControllerAgentBinaryStore")
-	ret0, _ := ret[0].(*service0.AgentBinaryStore)
+	ret0, _ := ret[0].(*service1.AgentBinaryStore)
 	return ret0
 }
 
@@ -226,28 +268,28 @@ type MockControllerDomainServicesControllerAgentBinaryStoreCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesControllerAgentBinaryStoreCall) Return(arg0 *service0.AgentBinaryStore) *MockControllerDomainServicesControllerAgentBinaryStoreCall {
+func (c *MockControllerDomainServicesControllerAgentBinaryStoreCall) Return(arg0 *service1.AgentBinaryStore) *MockControllerDomainServicesControllerAgentBinaryStoreCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesControllerAgentBinaryStoreCall) Do(f func() *service0.AgentBinaryStore) *MockControllerDomainServicesControllerAgentBinaryStoreCall {
+func (c *MockControllerDomainServicesControllerAgentBinaryStoreCall) Do(f func() *service1.AgentBinaryStore) *MockControllerDomainServicesControllerAgentBinaryStoreCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesControllerAgentBinaryStoreCall) DoAndReturn(f func() *service0.AgentBinaryStore) *MockControllerDomainServicesControllerAgentBinaryStoreCall {
+func (c *MockControllerDomainServicesControllerAgentBinaryStoreCall) DoAndReturn(f func() *service1.AgentBinaryStore) *MockControllerDomainServicesControllerAgentBinaryStoreCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ControllerConfig mocks base method.
-func (m *MockControllerDomainServices) ControllerConfig() *service4.WatchableService {
+func (m *MockControllerDomainServices) ControllerConfig() *service5.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ControllerConfig")
-	ret0, _ := ret[0].(*service4.WatchableService)
+	ret0, _ := ret[0].(*service5.WatchableService)
 	return ret0
 }
 
@@ -264,28 +306,28 @@ type MockControllerDomainServicesControllerConfigCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesControllerConfigCall) Return(arg0 *service4.WatchableService) *MockControllerDomainServicesControllerConfigCall {
+func (c *MockControllerDomainServicesControllerConfigCall) Return(arg0 *service5.WatchableService) *MockControllerDomainServicesControllerConfigCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesControllerConfigCall) Do(f func() *service4.WatchableService) *MockControllerDomainServicesControllerConfigCall {
+func (c *MockControllerDomainServicesControllerConfigCall) Do(f func() *service5.WatchableService) *MockControllerDomainServicesControllerConfigCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesControllerConfigCall) DoAndReturn(f func() *service4.WatchableService) *MockControllerDomainServicesControllerConfigCall {
+func (c *MockControllerDomainServicesControllerConfigCall) DoAndReturn(f func() *service5.WatchableService) *MockControllerDomainServicesControllerConfigCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ControllerNode mocks base method.
-func (m *MockControllerDomainServices) ControllerNode() *service5.Service {
+func (m *MockControllerDomainServices) ControllerNode() *service6.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ControllerNode")
-	ret0, _ := ret[0].(*service5.Service)
+	ret0, _ := ret[0].(*service6.Service)
 	return ret0
 }
 
@@ -302,28 +344,28 @@ type MockControllerDomainServicesControllerNodeCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesControllerNodeCall) Return(arg0 *service5.Service) *MockControllerDomainServicesControllerNodeCall {
+func (c *MockControllerDomainServicesControllerNodeCall) Return(arg0 *service6.Service) *MockControllerDomainServicesControllerNodeCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesControllerNodeCall) Do(f func() *service5.Service) *MockControllerDomainServicesControllerNodeCall {
+func (c *MockControllerDomainServicesControllerNodeCall) Do(f func() *service6.Service) *MockControllerDomainServicesControllerNodeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesControllerNodeCall) DoAndReturn(f func() *service5.Service) *MockControllerDomainServicesControllerNodeCall {
+func (c *MockControllerDomainServicesControllerNodeCall) DoAndReturn(f func() *service6.Service) *MockControllerDomainServicesControllerNodeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Credential mocks base method.
-func (m *MockControllerDomainServices) Credential() *service6.WatchableService {
+func (m *MockControllerDomainServices) Credential() *service7.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Credential")
-	ret0, _ := ret[0].(*service6.WatchableService)
+	ret0, _ := ret[0].(*service7.WatchableService)
 	return ret0
 }
 
@@ -340,28 +382,28 @@ type MockControllerDomainServicesCredentialCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesCredentialCall) Return(arg0 *service6.WatchableService) *MockControllerDomainServicesCredentialCall {
+func (c *MockControllerDomainServicesCredentialCall) Return(arg0 *service7.WatchableService) *MockControllerDomainServicesCredentialCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesCredentialCall) Do(f func() *service6.WatchableService) *MockControllerDomainServicesCredentialCall {
+func (c *MockControllerDomainServicesCredentialCall) Do(f func() *service7.WatchableService) *MockControllerDomainServicesCredentialCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesCredentialCall) DoAndReturn(f func() *service6.WatchableService) *MockControllerDomainServicesCredentialCall {
+func (c *MockControllerDomainServicesCredentialCall) DoAndReturn(f func() *service7.WatchableService) *MockControllerDomainServicesCredentialCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ExternalController mocks base method.
-func (m *MockControllerDomainServices) ExternalController() *service7.WatchableService {
+func (m *MockControllerDomainServices) ExternalController() *service8.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ExternalController")
-	ret0, _ := ret[0].(*service7.WatchableService)
+	ret0, _ := ret[0].(*service8.WatchableService)
 	return ret0
 }
 
@@ -378,28 +420,28 @@ type MockControllerDomainServicesExternalControllerCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesExternalControllerCall) Return(arg0 *service7.WatchableService) *MockControllerDomainServicesExternalControllerCall {
+func (c *MockControllerDomainServicesExternalControllerCall) Return(arg0 *service8.WatchableService) *MockControllerDomainServicesExternalControllerCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesExternalControllerCall) Do(f func() *service7.WatchableService) *MockControllerDomainServicesExternalControllerCall {
+func (c *MockControllerDomainServicesExternalControllerCall) Do(f func() *service8.WatchableService) *MockControllerDomainServicesExternalControllerCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesExternalControllerCall) DoAndReturn(f func() *service7.WatchableService) *MockControllerDomainServicesExternalControllerCall {
+func (c *MockControllerDomainServicesExternalControllerCall) DoAndReturn(f func() *service8.WatchableService) *MockControllerDomainServicesExternalControllerCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Flag mocks base method.
-func (m *MockControllerDomainServices) Flag() *service8.Service {
+func (m *MockControllerDomainServices) Flag() *service9.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Flag")
-	ret0, _ := ret[0].(*service8.Service)
+	ret0, _ := ret[0].(*service9.Service)
 	return ret0
 }
 
@@ -416,28 +458,28 @@ type MockControllerDomainServicesFlagCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesFlagCall) Return(arg0 *service8.Service) *MockControllerDomainServicesFlagCall {
+func (c *MockControllerDomainServicesFlagCall) Return(arg0 *service9.Service) *MockControllerDomainServicesFlagCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesFlagCall) Do(f func() *service8.Service) *MockControllerDomainServicesFlagCall {
+func (c *MockControllerDomainServicesFlagCall) Do(f func() *service9.Service) *MockControllerDomainServicesFlagCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesFlagCall) DoAndReturn(f func() *service8.Service) *MockControllerDomainServicesFlagCall {
+func (c *MockControllerDomainServicesFlagCall) DoAndReturn(f func() *service9.Service) *MockControllerDomainServicesFlagCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Macaroon mocks base method.
-func (m *MockControllerDomainServices) Macaroon() *service9.Service {
+func (m *MockControllerDomainServices) Macaroon() *service10.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Macaroon")
-	ret0, _ := ret[0].(*service9.Service)
+	ret0, _ := ret[0].(*service10.Service)
 	return ret0
 }
 
@@ -454,28 +496,28 @@ type MockControllerDomainServicesMacaroonCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesMacaroonCall) Return(arg0 *service9.Service) *MockControllerDomainServicesMacaroonCall {
+func (c *MockControllerDomainServicesMacaroonCall) Return(arg0 *service10.Service) *MockControllerDomainServicesMacaroonCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesMacaroonCall) Do(f func() *service9.Service) *MockControllerDomainServicesMacaroonCall {
+func (c *MockControllerDomainServicesMacaroonCall) Do(f func() *service10.Service) *MockControllerDomainServicesMacaroonCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesMacaroonCall) DoAndReturn(f func() *service9.Service) *MockControllerDomainServicesMacaroonCall {
+func (c *MockControllerDomainServicesMacaroonCall) DoAndReturn(f func() *service10.Service) *MockControllerDomainServicesMacaroonCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Model mocks base method.
-func (m *MockControllerDomainServices) Model() *service10.WatchableService {
+func (m *MockControllerDomainServices) Model() *service11.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Model")
-	ret0, _ := ret[0].(*service10.WatchableService)
+	ret0, _ := ret[0].(*service11.WatchableService)
 	return ret0
 }
 
@@ -492,28 +534,28 @@ type MockControllerDomainServicesModelCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesModelCall) Return(arg0 *service10.WatchableService) *MockControllerDomainServicesModelCall {
+func (c *MockControllerDomainServicesModelCall) Return(arg0 *service11.WatchableService) *MockControllerDomainServicesModelCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesModelCall) Do(f func() *service10.WatchableService) *MockControllerDomainServicesModelCall {
+func (c *MockControllerDomainServicesModelCall) Do(f func() *service11.WatchableService) *MockControllerDomainServicesModelCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesModelCall) DoAndReturn(f func() *service10.WatchableService) *MockControllerDomainServicesModelCall {
+func (c *MockControllerDomainServicesModelCall) DoAndReturn(f func() *service11.WatchableService) *MockControllerDomainServicesModelCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ModelDefaults mocks base method.
-func (m *MockControllerDomainServices) ModelDefaults() *service11.Service {
+func (m *MockControllerDomainServices) ModelDefaults() *service12.Service {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ModelDefaults")
-	ret0, _ := ret[0].(*service11.Service)
+	ret0, _ := ret[0].(*service12.Service)
 	return ret0
 }
 
@@ -530,28 +572,142 @@ type MockControllerDomainServicesModelDefaultsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesModelDefaultsCall) Return(arg0 *service11.Service) *MockControllerDomainServicesModelDefaultsCall {
+func (c *MockControllerDomainServicesModelDefaultsCall) Return(arg0 *service12.Service) *MockControllerDomainServicesModelDefaultsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerDomainServicesModelDefaultsCall) Do(f func() *service12.Service) *MockControllerDomainServicesModelDefaultsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerDomainServicesModelDefaultsCall) DoAndReturn(f func() *service12.Service) *MockControllerDomainServicesModelDefaultsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHAccess mocks base method.
+func (m *MockControllerDomainServices) SSHAccess() *service14.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHAccess")
+	ret0, _ := ret[0].(*service14.Service)
+	return ret0
+}
+
+// SSHAccess indicates an expected call of SSHAccess.
+func (mr *MockControllerDomainServicesMockRecorder) SSHAccess() *MockControllerDomainServicesSSHAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHAccess", reflect.TypeOf((*MockControllerDomainServices)(nil).SSHAccess))
+	return &MockControllerDomainServicesSSHAccessCall{Call: call}
+}
+
+// MockControllerDomainServicesSSHAccessCall wrap *gomock.Call
+type MockControllerDomainServicesSSHAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerDomainServicesSSHAccessCall) Return(arg0 *service14.Service) *MockControllerDomainServicesSSHAccessCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesModelDefaultsCall) Do(f func() *service11.Service) *MockControllerDomainServicesModelDefaultsCall {
+func (c *MockControllerDomainServicesSSHAccessCall) Do(f func() *service14.Service) *MockControllerDomainServicesSSHAccessCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesModelDefaultsCall) DoAndReturn(f func() *service11.Service) *MockControllerDomainServicesModelDefaultsCall {
+func (c *MockControllerDomainServicesSSHAccessCall) DoAndReturn(f func() *service14.Service) *MockControllerDomainServicesSSHAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHCA mocks base method.
+func (m *MockControllerDomainServices) SSHCA() *service15.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHCA")
+	ret0, _ := ret[0].(*service15.Service)
+	return ret0
+}
+
+// SSHCA indicates an expected call of SSHCA.
+func (mr *MockControllerDomainServicesMockRecorder) SSHCA() *MockControllerDomainServicesSSHCACall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHCA", reflect.TypeOf((*MockControllerDomainServices)(nil).SSHCA))
+	return &MockControllerDomainServicesSSHCACall{Call: call}
+}
+
+// MockControllerDomainServicesSSHCACall wrap *gomock.Call
+type MockControllerDomainServicesSSHCACall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerDomainServicesSSHCACall) Return(arg0 *service15.Service) *MockControllerDomainServicesSSHCACall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerDomainServicesSSHCACall) Do(f func() *service15.Service) *MockControllerDomainServicesSSHCACall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerDomainServicesSSHCACall) DoAndReturn(f func() *service15.Service) *MockControllerDomainServicesSSHCACall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SSHSession mocks base method.
+func (m *MockControllerDomainServices) SSHSession() *service16.Service {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSHSession")
+	ret0, _ := ret[0].(*service16.Service)
+	return ret0
+}
+
+// SSHSession indicates an expected call of SSHSession.
+func (mr *MockControllerDomainServicesMockRecorder) SSHSession() *MockControllerDomainServicesSSHSessionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSHSession", reflect.TypeOf((*MockControllerDomainServices)(nil).SSHSession))
+	return &MockControllerDomainServicesSSHSessionCall{Call: call}
+}
+
+// MockControllerDomainServicesSSHSessionCall wrap *gomock.Call
+type MockControllerDomainServicesSSHSessionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerDomainServicesSSHSessionCall) Return(arg0 *service16.Service) *MockControllerDomainServicesSSHSessionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerDomainServicesSSHSessionCall) Do(f func() *service16.Service) *MockControllerDomainServicesSSHSessionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerDomainServicesSSHSessionCall) DoAndReturn(f func() *service16.Service) *MockControllerDomainServicesSSHSessionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // SecretBackend mocks base method.
-func (m *MockControllerDomainServices) SecretBackend() *service12.WatchableService {
+func (m *MockControllerDomainServices) SecretBackend() *service13.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SecretBackend")
-	ret0, _ := ret[0].(*service12.WatchableService)
+	ret0, _ := ret[0].(*service13.WatchableService)
 	return ret0
 }
 
@@ -568,28 +724,28 @@ type MockControllerDomainServicesSecretBackendCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesSecretBackendCall) Return(arg0 *service12.WatchableService) *MockControllerDomainServicesSecretBackendCall {
+func (c *MockControllerDomainServicesSecretBackendCall) Return(arg0 *service13.WatchableService) *MockControllerDomainServicesSecretBackendCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesSecretBackendCall) Do(f func() *service12.WatchableService) *MockControllerDomainServicesSecretBackendCall {
+func (c *MockControllerDomainServicesSecretBackendCall) Do(f func() *service13.WatchableService) *MockControllerDomainServicesSecretBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesSecretBackendCall) DoAndReturn(f func() *service12.WatchableService) *MockControllerDomainServicesSecretBackendCall {
+func (c *MockControllerDomainServicesSecretBackendCall) DoAndReturn(f func() *service13.WatchableService) *MockControllerDomainServicesSecretBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // Upgrade mocks base method.
-func (m *MockControllerDomainServices) Upgrade() *service13.WatchableService {
+func (m *MockControllerDomainServices) Upgrade() *service17.WatchableService {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Upgrade")
-	ret0, _ := ret[0].(*service13.WatchableService)
+	ret0, _ := ret[0].(*service17.WatchableService)
 	return ret0
 }
 
@@ -606,19 +762,19 @@ type MockControllerDomainServicesUpgradeCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockControllerDomainServicesUpgradeCall) Return(arg0 *service13.WatchableService) *MockControllerDomainServicesUpgradeCall {
+func (c *MockControllerDomainServicesUpgradeCall) Return(arg0 *service17.WatchableService) *MockControllerDomainServicesUpgradeCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockControllerDomainServicesUpgradeCall) Do(f func() *service13.WatchableService) *MockControllerDomainServicesUpgradeCall {
+func (c *MockControllerDomainServicesUpgradeCall) Do(f func() *service17.WatchableService) *MockControllerDomainServicesUpgradeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockControllerDomainServicesUpgradeCall) DoAndReturn(f func() *service13.WatchableService) *MockControllerDomainServicesUpgradeCall {
+func (c *MockControllerDomainServicesUpgradeCall) DoAndReturn(f func() *service17.WatchableService) *MockControllerDomainServicesUpgradeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
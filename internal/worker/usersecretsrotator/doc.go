@@ -0,0 +1,7 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package usersecretsrotator provides a worker which rotates user secrets
+// configured with a server side generator. When a secret is due to be
+// rotated, new content is generated and saved as a new revision.
+package usersecretsrotator
@@ -0,0 +1,95 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotator
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/controller/usersecrets"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/pki"
+)
+
+// ManifoldConfig describes the resources used by the usersecretsrotator worker.
+type ManifoldConfig struct {
+	APICallerName string
+	Clock         clock.Clock
+	Logger        logger.Logger
+
+	// Authority is passed to the worker to sign tls-certificate
+	// secrets, and may be nil.
+	Authority pki.Authority
+
+	NewUserSecretsFacade func(base.APICaller) SecretsFacade
+	NewGenerator         NewGeneratorFunc
+	NewWorker            func(Config) (worker.Worker, error)
+}
+
+// NewUserSecretsFacade returns a new SecretsFacade.
+func NewUserSecretsFacade(caller base.APICaller) SecretsFacade {
+	return usersecrets.NewClient(caller)
+}
+
+// Manifold returns a Manifold that encapsulates the usersecretsrotator worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.APICallerName,
+		},
+		Start: config.start,
+	}
+}
+
+// Validate is called by start to check for bad configuration.
+func (cfg ManifoldConfig) Validate() error {
+	if cfg.APICallerName == "" {
+		return errors.NotValidf("empty APICallerName")
+	}
+	if cfg.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if cfg.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if cfg.NewUserSecretsFacade == nil {
+		return errors.NotValidf("nil NewUserSecretsFacade")
+	}
+	if cfg.NewGenerator == nil {
+		return errors.NotValidf("nil NewGenerator")
+	}
+	if cfg.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// start is a StartFunc for a Worker manifold.
+func (cfg ManifoldConfig) start(context context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var apiCaller base.APICaller
+	if err := getter.Get(cfg.APICallerName, &apiCaller); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	worker, err := cfg.NewWorker(Config{
+		SecretsFacade: cfg.NewUserSecretsFacade(apiCaller),
+		Authority:     cfg.Authority,
+		NewGenerator:  cfg.NewGenerator,
+		Clock:         cfg.Clock,
+		Logger:        cfg.Logger,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return worker, nil
+}
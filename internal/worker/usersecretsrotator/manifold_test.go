@@ -0,0 +1,133 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotator_test
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
+	dt "github.com/juju/worker/v4/dependency/testing"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	pkitest "github.com/juju/juju/internal/pki/test"
+	"github.com/juju/juju/internal/secrets/generator"
+	"github.com/juju/juju/internal/worker/usersecretsrotator"
+	"github.com/juju/juju/internal/worker/usersecretsrotator/mocks"
+)
+
+type manifoldSuite struct {
+	testing.IsolationSuite
+	config usersecretsrotator.ManifoldConfig
+}
+
+var _ = gc.Suite(&manifoldSuite{})
+
+func (s *manifoldSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.config = s.validConfig(c)
+}
+
+func (s *manifoldSuite) validConfig(c *gc.C) usersecretsrotator.ManifoldConfig {
+	authority, err := pkitest.NewTestAuthority()
+	c.Assert(err, jc.ErrorIsNil)
+	return usersecretsrotator.ManifoldConfig{
+		APICallerName: "api-caller",
+		Authority:     authority,
+		Clock:         clock.WallClock,
+		Logger:        loggertesting.WrapCheckLog(c),
+		NewWorker: func(config usersecretsrotator.Config) (worker.Worker, error) {
+			return nil, nil
+		},
+		NewGenerator:         generator.New,
+		NewUserSecretsFacade: func(base.APICaller) usersecretsrotator.SecretsFacade { return nil },
+	}
+}
+
+func (s *manifoldSuite) TestValid(c *gc.C) {
+	c.Check(s.config.Validate(), jc.ErrorIsNil)
+}
+
+func (s *manifoldSuite) TestMissingAPICallerName(c *gc.C) {
+	s.config.APICallerName = ""
+	s.checkNotValid(c, "empty APICallerName not valid")
+}
+
+func (s *manifoldSuite) TestMissingAuthority(c *gc.C) {
+	s.config.Authority = nil
+	c.Check(s.config.Validate(), jc.ErrorIsNil)
+}
+
+func (s *manifoldSuite) TestMissingClock(c *gc.C) {
+	s.config.Clock = nil
+	s.checkNotValid(c, "nil Clock not valid")
+}
+
+func (s *manifoldSuite) TestMissingLogger(c *gc.C) {
+	s.config.Logger = nil
+	s.checkNotValid(c, "nil Logger not valid")
+}
+
+func (s *manifoldSuite) TestMissingNewWorker(c *gc.C) {
+	s.config.NewWorker = nil
+	s.checkNotValid(c, "nil NewWorker not valid")
+}
+
+func (s *manifoldSuite) TestMissingNewGenerator(c *gc.C) {
+	s.config.NewGenerator = nil
+	s.checkNotValid(c, "nil NewGenerator not valid")
+}
+
+func (s *manifoldSuite) TestMissingNewFacade(c *gc.C) {
+	s.config.NewUserSecretsFacade = nil
+	s.checkNotValid(c, "nil NewUserSecretsFacade not valid")
+}
+
+func (s *manifoldSuite) checkNotValid(c *gc.C, expect string) {
+	err := s.config.Validate()
+	c.Check(err, gc.ErrorMatches, expect)
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *manifoldSuite) TestStart(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	facade := mocks.NewMockSecretsFacade(ctrl)
+	s.config.NewUserSecretsFacade = func(base.APICaller) usersecretsrotator.SecretsFacade {
+		return facade
+	}
+
+	called := false
+	s.config.NewWorker = func(config usersecretsrotator.Config) (worker.Worker, error) {
+		called = true
+		c.Check(config.SecretsFacade, gc.Equals, facade)
+		c.Check(config.Authority, gc.Equals, s.config.Authority)
+		c.Check(config.NewGenerator, gc.NotNil)
+		c.Check(config.Clock, gc.NotNil)
+		c.Check(config.Logger, gc.NotNil)
+		return nil, nil
+	}
+	manifold := usersecretsrotator.Manifold(s.config)
+	w, err := manifold.Start(context.Background(), dt.StubGetter(map[string]interface{}{
+		"api-caller": struct{ base.APICaller }{&mockAPICaller{}},
+	}))
+	c.Assert(w, gc.IsNil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+type mockAPICaller struct {
+	base.APICaller
+}
+
+func (*mockAPICaller) BestFacadeVersion(facade string) int {
+	return 2
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/secrets/generator (interfaces: Generator)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package mocks -destination mocks/generator_mock.go github.com/juju/juju/internal/secrets/generator Generator
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	secrets "github.com/juju/juju/core/secrets"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGenerator is a mock of Generator interface.
+type MockGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockGeneratorMockRecorder
+}
+
+// MockGeneratorMockRecorder is the mock recorder for MockGenerator.
+type MockGeneratorMockRecorder struct {
+	mock *MockGenerator
+}
+
+// NewMockGenerator creates a new mock instance.
+func NewMockGenerator(ctrl *gomock.Controller) *MockGenerator {
+	mock := &MockGenerator{ctrl: ctrl}
+	mock.recorder = &MockGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerator) EXPECT() *MockGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockGenerator) Generate(arg0 context.Context) (secrets.SecretData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", arg0)
+	ret0, _ := ret[0].(secrets.SecretData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockGeneratorMockRecorder) Generate(arg0 any) *MockGeneratorGenerateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockGenerator)(nil).Generate), arg0)
+	return &MockGeneratorGenerateCall{Call: call}
+}
+
+// MockGeneratorGenerateCall wrap *gomock.Call
+type MockGeneratorGenerateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockGeneratorGenerateCall) Return(arg0 secrets.SecretData, arg1 error) *MockGeneratorGenerateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockGeneratorGenerateCall) Do(f func(context.Context) (secrets.SecretData, error)) *MockGeneratorGenerateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockGeneratorGenerateCall) DoAndReturn(f func(context.Context) (secrets.SecretData, error)) *MockGeneratorGenerateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
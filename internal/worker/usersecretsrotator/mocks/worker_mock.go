@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/usersecretsrotator (interfaces: SecretsFacade)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package mocks -destination mocks/worker_mock.go github.com/juju/juju/internal/worker/usersecretsrotator SecretsFacade
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	secrets "github.com/juju/juju/core/secrets"
+	watcher "github.com/juju/juju/core/watcher"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretsFacade is a mock of SecretsFacade interface.
+type MockSecretsFacade struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretsFacadeMockRecorder
+}
+
+// MockSecretsFacadeMockRecorder is the mock recorder for MockSecretsFacade.
+type MockSecretsFacadeMockRecorder struct {
+	mock *MockSecretsFacade
+}
+
+// NewMockSecretsFacade creates a new mock instance.
+func NewMockSecretsFacade(ctrl *gomock.Controller) *MockSecretsFacade {
+	mock := &MockSecretsFacade{ctrl: ctrl}
+	mock.recorder = &MockSecretsFacadeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretsFacade) EXPECT() *MockSecretsFacadeMockRecorder {
+	return m.recorder
+}
+
+// GetUserSecretGenerator mocks base method.
+func (m *MockSecretsFacade) GetUserSecretGenerator(arg0 context.Context, arg1 *secrets.URI) (*secrets.GeneratorConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSecretGenerator", arg0, arg1)
+	ret0, _ := ret[0].(*secrets.GeneratorConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSecretGenerator indicates an expected call of GetUserSecretGenerator.
+func (mr *MockSecretsFacadeMockRecorder) GetUserSecretGenerator(arg0, arg1 any) *MockSecretsFacadeGetUserSecretGeneratorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSecretGenerator", reflect.TypeOf((*MockSecretsFacade)(nil).GetUserSecretGenerator), arg0, arg1)
+	return &MockSecretsFacadeGetUserSecretGeneratorCall{Call: call}
+}
+
+// MockSecretsFacadeGetUserSecretGeneratorCall wrap *gomock.Call
+type MockSecretsFacadeGetUserSecretGeneratorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretsFacadeGetUserSecretGeneratorCall) Return(arg0 *secrets.GeneratorConfig, arg1 error) *MockSecretsFacadeGetUserSecretGeneratorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretsFacadeGetUserSecretGeneratorCall) Do(f func(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error)) *MockSecretsFacadeGetUserSecretGeneratorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretsFacadeGetUserSecretGeneratorCall) DoAndReturn(f func(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error)) *MockSecretsFacadeGetUserSecretGeneratorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RotateUserSecret mocks base method.
+func (m *MockSecretsFacade) RotateUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateUserSecret", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateUserSecret indicates an expected call of RotateUserSecret.
+func (mr *MockSecretsFacadeMockRecorder) RotateUserSecret(arg0, arg1, arg2 any) *MockSecretsFacadeRotateUserSecretCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateUserSecret", reflect.TypeOf((*MockSecretsFacade)(nil).RotateUserSecret), arg0, arg1, arg2)
+	return &MockSecretsFacadeRotateUserSecretCall{Call: call}
+}
+
+// MockSecretsFacadeRotateUserSecretCall wrap *gomock.Call
+type MockSecretsFacadeRotateUserSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretsFacadeRotateUserSecretCall) Return(arg0 error) *MockSecretsFacadeRotateUserSecretCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretsFacadeRotateUserSecretCall) Do(f func(context.Context, *secrets.URI, secrets.SecretData) error) *MockSecretsFacadeRotateUserSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretsFacadeRotateUserSecretCall) DoAndReturn(f func(context.Context, *secrets.URI, secrets.SecretData) error) *MockSecretsFacadeRotateUserSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchUserSecretsRotationChanges mocks base method.
+func (m *MockSecretsFacade) WatchUserSecretsRotationChanges(arg0 context.Context) (watcher.Watcher[[]watcher.SecretTriggerChange], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchUserSecretsRotationChanges", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]watcher.SecretTriggerChange])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchUserSecretsRotationChanges indicates an expected call of WatchUserSecretsRotationChanges.
+func (mr *MockSecretsFacadeMockRecorder) WatchUserSecretsRotationChanges(arg0 any) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchUserSecretsRotationChanges", reflect.TypeOf((*MockSecretsFacade)(nil).WatchUserSecretsRotationChanges), arg0)
+	return &MockSecretsFacadeWatchUserSecretsRotationChangesCall{Call: call}
+}
+
+// MockSecretsFacadeWatchUserSecretsRotationChangesCall wrap *gomock.Call
+type MockSecretsFacadeWatchUserSecretsRotationChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretsFacadeWatchUserSecretsRotationChangesCall) Return(arg0 watcher.Watcher[[]watcher.SecretTriggerChange], arg1 error) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretsFacadeWatchUserSecretsRotationChangesCall) Do(f func(context.Context) (watcher.Watcher[[]watcher.SecretTriggerChange], error)) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretsFacadeWatchUserSecretsRotationChangesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]watcher.SecretTriggerChange], error)) *MockSecretsFacadeWatchUserSecretsRotationChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
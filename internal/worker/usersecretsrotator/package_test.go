@@ -0,0 +1,18 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotator
+
+import (
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/worker_mock.go github.com/juju/juju/internal/worker/usersecretsrotator SecretsFacade
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/generator_mock.go github.com/juju/juju/internal/secrets/generator Generator
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/watcher_mock.go github.com/juju/juju/core/watcher SecretTriggerWatcher
+
+func TestPackage(t *stdtesting.T) {
+	gc.TestingT(t)
+}
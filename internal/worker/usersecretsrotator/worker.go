@@ -0,0 +1,258 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotator
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/watcher"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/pki"
+	"github.com/juju/juju/internal/secrets/generator"
+)
+
+// retryDelay is how long to wait before trying again to
+// rotate a secret for which the previous attempt failed.
+const retryDelay = time.Minute
+
+// SecretsFacade instances provide a set of API for the worker to
+// rotate user secrets.
+type SecretsFacade interface {
+	WatchUserSecretsRotationChanges(context.Context) (watcher.SecretTriggerWatcher, error)
+	GetUserSecretGenerator(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error)
+	RotateUserSecret(context.Context, *secrets.URI, secrets.SecretData) error
+}
+
+// NewGeneratorFunc returns a generator for the supplied config.
+type NewGeneratorFunc func(secrets.GeneratorConfig, pki.Authority) (generator.Generator, error)
+
+// Config defines the operation of the Worker.
+type Config struct {
+	SecretsFacade SecretsFacade
+	NewGenerator  NewGeneratorFunc
+	Clock         clock.Clock
+	Logger        logger.Logger
+
+	// Authority signs the certificates of tls-certificate generators.
+	// It may be nil, in which case only secrets with other kinds of
+	// generator can be rotated.
+	Authority pki.Authority
+}
+
+// Validate returns an error if config cannot drive the Worker.
+func (config Config) Validate() error {
+	if config.SecretsFacade == nil {
+		return errors.NotValidf("nil SecretsFacade")
+	}
+	if config.NewGenerator == nil {
+		return errors.NotValidf("nil NewGenerator")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// NewWorker returns a usersecretsrotator Worker backed by config, or an error.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &Worker{
+		config:  config,
+		secrets: make(map[string]secretRotateInfo),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+type secretRotateInfo struct {
+	URI        *secrets.URI
+	rotateTime time.Time
+}
+
+// Worker rotates user secrets which have a generator configured.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+
+	secrets map[string]secretRotateInfo
+
+	timer       clock.Timer
+	nextTrigger time.Time
+}
+
+// Kill is defined on worker.Worker.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() (err error) {
+	ctx, cancel := w.scopedContext()
+	defer cancel()
+
+	changes, err := w.config.SecretsFacade.WatchUserSecretsRotationChanges(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(changes); err != nil {
+		return errors.Trace(err)
+	}
+	for {
+		var timeToRotate <-chan time.Time
+		if w.timer != nil {
+			timeToRotate = w.timer.Chan()
+		}
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case ch, ok := <-changes.Changes():
+			if !ok {
+				return errors.New("user secret rotation change channel closed")
+			}
+			w.handleSecretRotateChanges(ctx, ch)
+		case now := <-timeToRotate:
+			w.rotate(ctx, now)
+		}
+	}
+}
+
+func (w *Worker) rotate(ctx context.Context, now time.Time) {
+	for id, info := range w.secrets {
+		// A one minute granularity is acceptable for secret rotation.
+		if !info.rotateTime.Truncate(time.Minute).Before(now) {
+			continue
+		}
+		w.config.Logger.Debugf(ctx, "rotating user secret %s", info.URI)
+		err := w.rotateSecret(ctx, info.URI)
+		if errors.Is(err, errors.NotFound) || errors.Is(err, secreterrors.SecretNotFound) {
+			// The secret or its generator has been removed since the
+			// rotation was scheduled, so there's nothing to rotate.
+			w.config.Logger.Debugf(ctx, "user secret %s no longer rotated: %v", info.URI, err)
+			delete(w.secrets, id)
+			continue
+		}
+		if err != nil {
+			w.config.Logger.Warningf(ctx, "cannot rotate user secret %s: %v", info.URI, err)
+			info.rotateTime = now.Add(retryDelay)
+			w.secrets[id] = info
+			continue
+		}
+		// Once the secret has been rotated, delete it here since it will
+		// re-appear via the watcher with its next rotation time.
+		delete(w.secrets, id)
+	}
+	w.computeNextRotateTime(ctx)
+}
+
+func (w *Worker) rotateSecret(ctx context.Context, uri *secrets.URI) error {
+	cfg, err := w.config.SecretsFacade.GetUserSecretGenerator(ctx, uri)
+	if err != nil {
+		return errors.Annotate(err, "getting secret generator")
+	}
+	gen, err := w.config.NewGenerator(*cfg, w.config.Authority)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	content, err := gen.Generate(ctx)
+	if err != nil {
+		return errors.Annotatef(err, "generating %s content", cfg.Kind)
+	}
+	// Secret values are stored base64 encoded.
+	data := make(secrets.SecretData, len(content))
+	for k, v := range content {
+		data[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	return errors.Trace(w.config.SecretsFacade.RotateUserSecret(ctx, uri, data))
+}
+
+func (w *Worker) handleSecretRotateChanges(ctx context.Context, changes []watcher.SecretTriggerChange) {
+	w.config.Logger.Debugf(ctx, "got user secret rotate changes: %#v", changes)
+	if len(changes) == 0 {
+		return
+	}
+
+	for _, ch := range changes {
+		// Next rotate time of 0 means the rotation has been deleted.
+		if ch.NextTriggerTime.IsZero() {
+			w.config.Logger.Debugf(ctx, "user secret no longer rotated: %v", ch.URI.String())
+			delete(w.secrets, ch.URI.ID)
+			continue
+		}
+		w.secrets[ch.URI.ID] = secretRotateInfo{
+			URI:        ch.URI,
+			rotateTime: ch.NextTriggerTime,
+		}
+	}
+	w.computeNextRotateTime(ctx)
+}
+
+func (w *Worker) computeNextRotateTime(ctx context.Context) {
+	if len(w.secrets) == 0 {
+		w.timer = nil
+		w.nextTrigger = time.Time{}
+		return
+	}
+
+	// Find the minimum (next) rotateTime from all the secrets.
+	var soonestRotateTime time.Time
+	for _, info := range w.secrets {
+		if !soonestRotateTime.IsZero() && info.rotateTime.After(soonestRotateTime) {
+			continue
+		}
+		soonestRotateTime = info.rotateTime
+	}
+	// There's no need to start or reset the timer if there's no changes to make.
+	if soonestRotateTime.IsZero() || w.nextTrigger == soonestRotateTime {
+		return
+	}
+
+	// Account for the worker not running when a secret
+	// should have been rotated.
+	now := w.config.Clock.Now()
+	if soonestRotateTime.Before(now) {
+		soonestRotateTime = now
+	}
+
+	nextDuration := soonestRotateTime.Sub(now)
+	w.config.Logger.Debugf(ctx, "next user secret will rotate in %v at %s", nextDuration, soonestRotateTime)
+
+	w.nextTrigger = soonestRotateTime
+	if w.timer == nil {
+		w.timer = w.config.Clock.NewTimer(nextDuration)
+	} else {
+		if !w.timer.Stop() {
+			select {
+			case <-w.timer.Chan():
+			default:
+			}
+		}
+		w.timer.Reset(nextDuration)
+	}
+}
+
+func (w *Worker) scopedContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(w.catacomb.Context(context.Background()))
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package usersecretsrotator_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/secrets"
+	corewatcher "github.com/juju/juju/core/watcher"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/pki"
+	pkitest "github.com/juju/juju/internal/pki/test"
+	"github.com/juju/juju/internal/secrets/generator"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/usersecretsrotator"
+	"github.com/juju/juju/internal/worker/usersecretsrotator/mocks"
+)
+
+type workerSuite struct {
+	testing.BaseSuite
+
+	clock  testclock.AdvanceableClock
+	config usersecretsrotator.Config
+
+	facade        *mocks.MockSecretsFacade
+	generator     *mocks.MockGenerator
+	rotateWatcher *mocks.MockSecretTriggerWatcher
+	rotateChanges chan []corewatcher.SecretTriggerChange
+}
+
+var _ = gc.Suite(&workerSuite{})
+
+func (s *workerSuite) setup(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	authority, err := pkitest.NewTestAuthority()
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.clock = testclock.NewDilatedWallClock(100 * time.Millisecond)
+	s.facade = mocks.NewMockSecretsFacade(ctrl)
+	s.generator = mocks.NewMockGenerator(ctrl)
+	s.rotateWatcher = mocks.NewMockSecretTriggerWatcher(ctrl)
+	s.rotateChanges = make(chan []corewatcher.SecretTriggerChange)
+	s.config = usersecretsrotator.Config{
+		SecretsFacade: s.facade,
+		Authority:     authority,
+		NewGenerator: func(cfg secrets.GeneratorConfig, _ pki.Authority) (generator.Generator, error) {
+			c.Check(cfg.Kind, gc.Equals, secrets.GeneratorPassword)
+			return s.generator, nil
+		},
+		Clock:  s.clock,
+		Logger: loggertesting.WrapCheckLog(c),
+	}
+	return ctrl
+}
+
+func (s *workerSuite) TestValidateConfig(c *gc.C) {
+	_ = s.setup(c)
+
+	s.testValidateConfig(c, func(config *usersecretsrotator.Config) {
+		config.SecretsFacade = nil
+	}, `nil SecretsFacade not valid`)
+
+	s.testValidateConfig(c, func(config *usersecretsrotator.Config) {
+		config.NewGenerator = nil
+	}, `nil NewGenerator not valid`)
+
+	s.testValidateConfig(c, func(config *usersecretsrotator.Config) {
+		config.Clock = nil
+	}, `nil Clock not valid`)
+
+	s.testValidateConfig(c, func(config *usersecretsrotator.Config) {
+		config.Logger = nil
+	}, `nil Logger not valid`)
+}
+
+func (s *workerSuite) TestValidateConfigWithoutAuthority(c *gc.C) {
+	_ = s.setup(c)
+
+	// Only tls-certificate generators need an authority, and those are
+	// rejected by the generator itself.
+	config := s.config
+	config.Authority = nil
+	c.Check(config.Validate(), jc.ErrorIsNil)
+}
+
+func (s *workerSuite) testValidateConfig(c *gc.C, f func(*usersecretsrotator.Config), expect string) {
+	config := s.config
+	f(&config)
+	c.Check(config.Validate(), gc.ErrorMatches, expect)
+}
+
+func (s *workerSuite) expectWorker() {
+	s.facade.EXPECT().WatchUserSecretsRotationChanges(gomock.Any()).Return(s.rotateWatcher, nil)
+	s.rotateWatcher.EXPECT().Changes().AnyTimes().Return(s.rotateChanges)
+	s.rotateWatcher.EXPECT().Kill().MaxTimes(1)
+	s.rotateWatcher.EXPECT().Wait().Return(nil).MinTimes(1)
+}
+
+func (s *workerSuite) TestStartStop(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectWorker()
+
+	w, err := usersecretsrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+
+	workertest.CheckAlive(c, w)
+	workertest.CleanKill(c, w)
+}
+
+func (s *workerSuite) TestRotate(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectWorker()
+
+	uri := secrets.NewURI()
+	rotated := make(chan struct{})
+	s.facade.EXPECT().GetUserSecretGenerator(gomock.Any(), uri).Return(&secrets.GeneratorConfig{
+		Kind: secrets.GeneratorPassword,
+	}, nil)
+	s.generator.EXPECT().Generate(gomock.Any()).Return(secrets.SecretData{"password": "secret"}, nil)
+	s.facade.EXPECT().RotateUserSecret(gomock.Any(), uri, secrets.SecretData{
+		"password": "c2VjcmV0",
+	}).DoAndReturn(func(_ context.Context, _ *secrets.URI, _ secrets.SecretData) error {
+		close(rotated)
+		return nil
+	})
+
+	w, err := usersecretsrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.rotateChanges <- []corewatcher.SecretTriggerChange{{
+		URI:             uri,
+		NextTriggerTime: s.clock.Now().Add(time.Minute),
+	}}
+	s.clock.Advance(2 * time.Minute)
+
+	select {
+	case <-rotated:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for secret to be rotated")
+	}
+}
+
+func (s *workerSuite) TestRotateRetriesOnError(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectWorker()
+
+	uri := secrets.NewURI()
+	rotated := make(chan struct{})
+	gomock.InOrder(
+		s.facade.EXPECT().GetUserSecretGenerator(gomock.Any(), uri).Return(nil, errors.New("boom")),
+		s.facade.EXPECT().GetUserSecretGenerator(gomock.Any(), uri).Return(&secrets.GeneratorConfig{
+			Kind: secrets.GeneratorPassword,
+		}, nil),
+	)
+	s.generator.EXPECT().Generate(gomock.Any()).Return(secrets.SecretData{"password": "secret"}, nil)
+	s.facade.EXPECT().RotateUserSecret(gomock.Any(), uri, gomock.Any()).DoAndReturn(func(_ context.Context, _ *secrets.URI, _ secrets.SecretData) error {
+		close(rotated)
+		return nil
+	})
+
+	w, err := usersecretsrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.rotateChanges <- []corewatcher.SecretTriggerChange{{
+		URI:             uri,
+		NextTriggerTime: s.clock.Now(),
+	}}
+	s.clock.Advance(5 * time.Minute)
+
+	select {
+	case <-rotated:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for secret to be rotated")
+	}
+}
+
+func (s *workerSuite) TestRotationRemoved(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectWorker()
+
+	w, err := usersecretsrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	uri := secrets.NewURI()
+	s.rotateChanges <- []corewatcher.SecretTriggerChange{{
+		URI:             uri,
+		NextTriggerTime: s.clock.Now().Add(time.Hour),
+	}}
+	s.rotateChanges <- []corewatcher.SecretTriggerChange{{
+		URI: uri,
+	}}
+	s.clock.Advance(2 * time.Hour)
+
+	// No facade calls are expected.
+	time.Sleep(testing.ShortWait)
+	workertest.CheckAlive(c, w)
+}
+
+func (s *workerSuite) TestRotateSecretRemoved(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectWorker()
+
+	uri := secrets.NewURI()
+	called := make(chan struct{})
+	// The secret is only looked up once; it is not retried
+	// once it's known to have been removed.
+	s.facade.EXPECT().GetUserSecretGenerator(gomock.Any(), uri).DoAndReturn(
+		func(context.Context, *secrets.URI) (*secrets.GeneratorConfig, error) {
+			close(called)
+			return nil, fmt.Errorf("secret %q not found%w", uri.ID, errors.Hide(secreterrors.SecretNotFound))
+		})
+
+	w, err := usersecretsrotator.NewWorker(s.config)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	s.rotateChanges <- []corewatcher.SecretTriggerChange{{
+		URI:             uri,
+		NextTriggerTime: s.clock.Now(),
+	}}
+	s.clock.Advance(time.Minute)
+
+	select {
+	case <-called:
+	case <-time.After(testing.LongWait):
+		c.Fatal("timed out waiting for secret generator")
+	}
+	s.clock.Advance(5 * time.Minute)
+	time.Sleep(testing.ShortWait)
+	workertest.CheckAlive(c, w)
+}
@@ -0,0 +1,9 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package utilisationreporter defines a worker that periodically samples
+// the CPU and memory utilisation of the machine it runs on, and reports
+// it to the controller. The samples are used to recommend instance types
+// for applications. This worker will be run on all Juju-managed machines
+// (one per machine agent).
+package utilisationreporter
@@ -0,0 +1,11 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package utilisationreporter
+
+var (
+	DoWork           = doWork
+	ParseProcStat    = parseProcStat
+	ParseProcMeminfo = parseProcMeminfo
+	NewWorkerFunc    = newWorker
+)
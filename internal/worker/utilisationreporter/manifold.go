@@ -0,0 +1,42 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package utilisationreporter
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/agent/engine"
+	apimachiner "github.com/juju/juju/api/agent/machiner"
+	"github.com/juju/juju/api/base"
+)
+
+// ManifoldConfig defines the names of the manifolds on which a Manifold will depend.
+type ManifoldConfig engine.AgentAPIManifoldConfig
+
+// Manifold returns a dependency manifold that runs a utilisation reporter
+// worker, using the resource names defined in the supplied config.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	typedConfig := engine.AgentAPIManifoldConfig(config)
+	return engine.AgentAPIManifold(typedConfig, newWorker)
+}
+
+// newWorker trivially wraps NewWorker for use in a engine.AgentAPIManifold.
+func newWorker(_ context.Context, a agent.Agent, apiCaller base.APICaller) (worker.Worker, error) {
+	t := a.CurrentConfig().Tag()
+	tag, ok := t.(names.MachineTag)
+	if !ok {
+		return nil, errors.Errorf("expected MachineTag, got %#v", t)
+	}
+
+	api := apimachiner.NewClient(apiCaller)
+
+	return NewWorker(tag, api, DefaultReadCPUTimes, DefaultReadMemoryUsage, clock.WallClock), nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package utilisationreporter_test
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/agent"
+	apimachiner "github.com/juju/juju/api/agent/machiner"
+	basetesting "github.com/juju/juju/api/base/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/utilisationreporter"
+)
+
+type manifoldSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&manifoldSuite{})
+
+func (s *manifoldSuite) TestNewWorker(c *gc.C) {
+	called := false
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string, version int, id, request string, a, response interface{}) error {
+			// We don't test the api call. We test that NewWorker is
+			// passed the expected arguments.
+			return nil
+		})
+
+	s.PatchValue(&utilisationreporter.NewWorker, func(
+		tag names.MachineTag,
+		r utilisationreporter.UtilisationRecorder,
+		cpuf utilisationreporter.ReadCPUTimesFunc,
+		memf utilisationreporter.ReadMemoryUsageFunc,
+		clk clock.Clock,
+	) worker.Worker {
+		called = true
+		c.Assert(tag, gc.Equals, names.NewMachineTag("1"))
+		c.Assert(r, gc.FitsTypeOf, &apimachiner.Client{})
+		c.Assert(cpuf, gc.NotNil)
+		c.Assert(memf, gc.NotNil)
+		c.Assert(clk, gc.Equals, clock.WallClock)
+		return nil
+	})
+
+	a := &dummyAgent{tag: names.NewMachineTag("1")}
+	_, err := utilisationreporter.NewWorkerFunc(context.Background(), a, apiCaller)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *manifoldSuite) TestNewWorkerNotMachine(c *gc.C) {
+	a := &dummyAgent{tag: names.NewUnitTag("app/0")}
+	_, err := utilisationreporter.NewWorkerFunc(context.Background(), a, nil)
+	c.Assert(err, gc.ErrorMatches, "expected MachineTag, got .*")
+}
+
+type dummyAgent struct {
+	agent.Agent
+	tag names.Tag
+}
+
+func (a dummyAgent) CurrentConfig() agent.Config {
+	return dummyCfg{tag: a.tag}
+}
+
+type dummyCfg struct {
+	agent.Config
+	tag names.Tag
+}
+
+func (c dummyCfg) Tag() names.Tag {
+	return c.tag
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+//go:build linux
+
+package utilisationreporter
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+func init() {
+	DefaultReadCPUTimes = func() (CPUTimes, error) {
+		return readProcFile("/proc/stat", parseProcStat)
+	}
+	DefaultReadMemoryUsage = func() (float64, error) {
+		return readProcFile("/proc/meminfo", parseProcMeminfo)
+	}
+}
+
+func readProcFile[T any](path string, parse func(io.Reader) (T, error)) (T, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		var zero T
+		return zero, errors.Trace(err)
+	}
+	defer func() { _ = f.Close() }()
+	return parse(f)
+}
+
+// parseProcStat returns the CPU times from the aggregate "cpu" line of
+// /proc/stat. The idle and iowait columns are counted as not busy; guest
+// time is already included in the user and nice columns.
+func parseProcStat(r io.Reader) (CPUTimes, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+		// user nice system idle iowait irq softirq steal [guest guest_nice]
+		columns := fields[1:]
+		if len(columns) > 8 {
+			columns = columns[:8]
+		}
+		var times CPUTimes
+		for i, column := range columns {
+			v, err := strconv.ParseUint(column, 10, 64)
+			if err != nil {
+				return CPUTimes{}, errors.Annotatef(err, "parsing cpu times %q", scanner.Text())
+			}
+			times.Total += v
+			if i != 3 && i != 4 {
+				times.Busy += v
+			}
+		}
+		return times, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return CPUTimes{}, errors.Trace(err)
+	}
+	return CPUTimes{}, errors.NotFoundf("aggregate cpu times")
+}
+
+// parseProcMeminfo returns the percentage of memory in use, that is, the
+// memory that is not available for starting new applications without
+// swapping.
+func parseProcMeminfo(r io.Reader) (float64, error) {
+	var total, available uint64
+	var haveTotal, haveAvailable bool
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		var dest *uint64
+		switch fields[0] {
+		case "MemTotal:":
+			dest, haveTotal = &total, true
+		case "MemAvailable:":
+			dest, haveAvailable = &available, true
+		default:
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, errors.Annotatef(err, "parsing %q", scanner.Text())
+		}
+		*dest = v
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, errors.Trace(err)
+	}
+	if !haveTotal || !haveAvailable || total == 0 {
+		return 0, errors.NotFoundf("total and available memory")
+	}
+	if available > total {
+		available = total
+	}
+	return 100 * float64(total-available) / float64(total), nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package utilisationreporter
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/worker/v4"
+
+	internallogger "github.com/juju/juju/internal/logger"
+	jworker "github.com/juju/juju/internal/worker"
+)
+
+var logger = internallogger.GetLogger("juju.worker.utilisationreporter")
+
+// samplePeriod is the time period between utilisation samples.
+const samplePeriod = time.Minute * 5
+
+// UtilisationRecorder is an interface that is supplied to NewWorker
+// for reporting the utilisation of the local host.
+type UtilisationRecorder interface {
+	RecordMachineUtilisation(
+		ctx context.Context, tag names.MachineTag, sampledAt time.Time, cpuPercent, memoryPercent float64,
+	) error
+}
+
+// CPUTimes holds the cumulative time spent by all of the CPUs of the
+// local host, in clock ticks.
+type CPUTimes struct {
+	// Busy is the time spent doing anything but idling or waiting
+	// for I/O.
+	Busy uint64
+
+	// Total is the total time.
+	Total uint64
+}
+
+// ReadCPUTimesFunc is the type of a function that is supplied to
+// NewWorker for reading the cumulative CPU times of the local host.
+type ReadCPUTimesFunc func() (CPUTimes, error)
+
+// ReadMemoryUsageFunc is the type of a function that is supplied to
+// NewWorker for reading the percentage of memory in use on the local host.
+type ReadMemoryUsageFunc func() (float64, error)
+
+// DefaultReadCPUTimes is the default function for reading CPU times
+// for the operating system of the local host.
+var DefaultReadCPUTimes ReadCPUTimesFunc
+
+// DefaultReadMemoryUsage is the default function for reading memory
+// usage for the operating system of the local host.
+var DefaultReadMemoryUsage ReadMemoryUsageFunc
+
+// NewWorker returns a worker that periodically samples the CPU and
+// memory utilisation of the machine with the given tag, and reports
+// it to the controller. If either read function is nil, or the
+// controller does not support utilisation reporting, the worker
+// does nothing.
+var NewWorker = func(
+	tag names.MachineTag, r UtilisationRecorder, cpuf ReadCPUTimesFunc, memf ReadMemoryUsageFunc, clock clock.Clock,
+) worker.Worker {
+	var last *CPUTimes
+	f := func(ctx context.Context) error {
+		if cpuf == nil || memf == nil {
+			return nil
+		}
+		err := doWork(ctx, tag, r, cpuf, memf, clock, &last)
+		if errors.Is(err, errors.NotSupported) {
+			logger.Debugf(ctx, "not reporting machine utilisation: %v", err)
+			cpuf = nil
+			return nil
+		}
+		return err
+	}
+	return jworker.NewPeriodicWorker(f, samplePeriod, jworker.NewTimer)
+}
+
+// doWork reads the CPU times and memory usage of the local host and,
+// when there is an earlier reading to compare the CPU times with,
+// reports the utilisation since that reading.
+func doWork(
+	ctx context.Context,
+	tag names.MachineTag,
+	r UtilisationRecorder,
+	cpuf ReadCPUTimesFunc,
+	memf ReadMemoryUsageFunc,
+	clock clock.Clock,
+	last **CPUTimes,
+) error {
+	times, err := cpuf()
+	if err != nil {
+		return errors.Annotate(err, "reading CPU times")
+	}
+	previous := *last
+	*last = &times
+	if previous == nil || times.Total <= previous.Total || times.Busy < previous.Busy {
+		// Nothing to compare with yet, or the counters were reset.
+		return nil
+	}
+	cpuPercent := 100 * float64(times.Busy-previous.Busy) / float64(times.Total-previous.Total)
+
+	memoryPercent, err := memf()
+	if err != nil {
+		return errors.Annotate(err, "reading memory usage")
+	}
+	logger.Tracef(ctx, "machine utilisation: cpu %.1f%%, memory %.1f%%", cpuPercent, memoryPercent)
+	return errors.Trace(r.RecordMachineUtilisation(ctx, tag, clock.Now(), cpuPercent, memoryPercent))
+}
@@ -0,0 +1,145 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package utilisationreporter_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/utilisationreporter"
+)
+
+type reporterSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&reporterSuite{})
+
+type recordedSample struct {
+	tag           names.MachineTag
+	sampledAt     time.Time
+	cpuPercent    float64
+	memoryPercent float64
+}
+
+type mockRecorder struct {
+	samples []recordedSample
+	err     error
+}
+
+func (r *mockRecorder) RecordMachineUtilisation(
+	_ context.Context, tag names.MachineTag, sampledAt time.Time, cpuPercent, memoryPercent float64,
+) error {
+	r.samples = append(r.samples, recordedSample{tag, sampledAt, cpuPercent, memoryPercent})
+	return r.err
+}
+
+func (s *reporterSuite) TestDoWork(c *gc.C) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := testclock.NewClock(now)
+	tag := names.NewMachineTag("3")
+	readings := []utilisationreporter.CPUTimes{
+		{Busy: 100, Total: 1000},
+		{Busy: 350, Total: 2000},
+	}
+	cpuf := func() (utilisationreporter.CPUTimes, error) {
+		times := readings[0]
+		readings = readings[1:]
+		return times, nil
+	}
+	memf := func() (float64, error) {
+		return 42.5, nil
+	}
+	recorder := &mockRecorder{}
+	var last *utilisationreporter.CPUTimes
+
+	// The first reading only provides a baseline.
+	err := utilisationreporter.DoWork(context.Background(), tag, recorder, cpuf, memf, clock, &last)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(recorder.samples, gc.HasLen, 0)
+
+	err = utilisationreporter.DoWork(context.Background(), tag, recorder, cpuf, memf, clock, &last)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(recorder.samples, jc.DeepEquals, []recordedSample{{
+		tag:           tag,
+		sampledAt:     now,
+		cpuPercent:    25,
+		memoryPercent: 42.5,
+	}})
+}
+
+func (s *reporterSuite) TestDoWorkCountersReset(c *gc.C) {
+	cpuf := func() (utilisationreporter.CPUTimes, error) {
+		return utilisationreporter.CPUTimes{Busy: 10, Total: 100}, nil
+	}
+	memf := func() (float64, error) {
+		c.Fatalf("unexpected memory read")
+		return 0, nil
+	}
+	recorder := &mockRecorder{}
+	last := &utilisationreporter.CPUTimes{Busy: 500, Total: 5000}
+
+	err := utilisationreporter.DoWork(context.Background(), names.NewMachineTag("0"), recorder, cpuf, memf, testclock.NewClock(time.Now()), &last)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(recorder.samples, gc.HasLen, 0)
+	c.Assert(*last, jc.DeepEquals, utilisationreporter.CPUTimes{Busy: 10, Total: 100})
+}
+
+func (s *reporterSuite) TestDoWorkRecordError(c *gc.C) {
+	cpuf := func() (utilisationreporter.CPUTimes, error) {
+		return utilisationreporter.CPUTimes{Busy: 20, Total: 200}, nil
+	}
+	memf := func() (float64, error) {
+		return 10, nil
+	}
+	recorder := &mockRecorder{err: errors.NotSupportedf("recording machine utilisation")}
+	last := &utilisationreporter.CPUTimes{Busy: 10, Total: 100}
+
+	err := utilisationreporter.DoWork(context.Background(), names.NewMachineTag("0"), recorder, cpuf, memf, testclock.NewClock(time.Now()), &last)
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *reporterSuite) TestParseProcStat(c *gc.C) {
+	stat := `
+cpu  100 20 30 800 50 5 5 10 7 0
+cpu0 50 10 15 400 25 2 3 5 3 0
+intr 12345
+`[1:]
+	times, err := utilisationreporter.ParseProcStat(strings.NewReader(stat))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(times, jc.DeepEquals, utilisationreporter.CPUTimes{
+		Busy:  170,
+		Total: 1020,
+	})
+}
+
+func (s *reporterSuite) TestParseProcStatMissing(c *gc.C) {
+	_, err := utilisationreporter.ParseProcStat(strings.NewReader("intr 12345\n"))
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *reporterSuite) TestParseProcMeminfo(c *gc.C) {
+	meminfo := `
+MemTotal:        8000000 kB
+MemFree:         1000000 kB
+MemAvailable:    6000000 kB
+Buffers:          200000 kB
+`[1:]
+	percent, err := utilisationreporter.ParseProcMeminfo(strings.NewReader(meminfo))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(percent, gc.Equals, 25.0)
+}
+
+func (s *reporterSuite) TestParseProcMeminfoMissing(c *gc.C) {
+	_, err := utilisationreporter.ParseProcMeminfo(strings.NewReader("MemTotal: 8000000 kB\n"))
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
@@ -5,11 +5,13 @@ package jujuclient_test
 
 import (
 	"os"
+	"time"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/internal/oidc"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/jujuclient"
 )
@@ -71,6 +73,22 @@ func (s *AccountsSuite) TestUpdateAccountNewController(c *gc.C) {
 	c.Assert(*details, jc.DeepEquals, testAccountDetails)
 }
 
+func (s *AccountsSuite) TestUpdateAccountOIDCToken(c *gc.C) {
+	testAccountDetails := jujuclient.AccountDetails{
+		User: "bob@example.com",
+		OIDCToken: &oidc.Token{
+			IDToken:      "id-token",
+			RefreshToken: "refresh-token",
+			Expiry:       time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	err := s.store.UpdateAccount("oidc-controller", testAccountDetails)
+	c.Assert(err, jc.ErrorIsNil)
+	details, err := s.store.AccountDetails("oidc-controller")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(*details, jc.DeepEquals, testAccountDetails)
+}
+
 func (s *AccountsSuite) TestUpdateAccountOverwrites(c *gc.C) {
 	testAccountDetails := jujuclient.AccountDetails{
 		User:            "admin",
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/juju/cloud"
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/internal/oidc"
 )
 
 // ControllerDetails holds the details needed to connect to a controller.
@@ -105,6 +106,11 @@ type AccountDetails struct {
 
 	// SessionToken, if set, is used for login.
 	SessionToken string `yaml:"access-token,omitempty"`
+
+	// OIDCToken, if set, holds the tokens issued to the user by the OpenID
+	// Connect identity provider of the controller, which are used for
+	// login.
+	OIDCToken *oidc.Token `yaml:"oidc-token,omitempty"`
 }
 
 // BootstrapConfig holds the configuration used to bootstrap a controller.
@@ -0,0 +1,66 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// AdmissionPolicy is a rule which denies the model-changing operations it
+// describes, such as deploying charms from non-stable channels.
+type AdmissionPolicy struct {
+	Name string `json:"name"`
+	// ModelTag is the model the policy applies to. Policies without a
+	// model apply to every model of the controller.
+	ModelTag string `json:"model-tag,omitempty"`
+	Rule     string `json:"rule"`
+	Message  string `json:"message,omitempty"`
+	// CreatedBy is the user who added the policy. It is set by the
+	// controller, and ignored when policies are added.
+	CreatedBy string `json:"created-by,omitempty"`
+}
+
+// AdmissionPolicies holds admission policies.
+type AdmissionPolicies struct {
+	Policies []AdmissionPolicy `json:"policies"`
+}
+
+// AdmissionPolicyID identifies an admission policy by its name and the
+// model it applies to, if any.
+type AdmissionPolicyID struct {
+	Name     string `json:"name"`
+	ModelTag string `json:"model-tag,omitempty"`
+}
+
+// AdmissionPolicyIDs holds admission policy identifiers.
+type AdmissionPolicyIDs struct {
+	Policies []AdmissionPolicyID `json:"policies"`
+}
+
+// AdmissionPoliciesFilter selects the policies returned by the
+// AdmissionPolicy.ListAdmissionPolicies API. The policies of the controller
+// are returned when the model is empty.
+type AdmissionPoliciesFilter struct {
+	ModelTag string `json:"model-tag,omitempty"`
+}
+
+// AdmissionPoliciesResult is used to return admission policies.
+type AdmissionPoliciesResult struct {
+	Error    *Error            `json:"error,omitempty"`
+	Policies []AdmissionPolicy `json:"policies"`
+}
+
+// AdmissionTest describes an operation to evaluate against the admission
+// policies of a model, without the operation being made.
+type AdmissionTest struct {
+	ModelTag  string              `json:"model-tag"`
+	Operation string              `json:"operation"`
+	Fields    map[string][]string `json:"fields,omitempty"`
+	// Policies are evaluated along with the stored policies, without
+	// being stored, so that they can be tried before they are added.
+	Policies []AdmissionPolicy `json:"policies,omitempty"`
+}
+
+// AdmissionTestResult holds the policies which deny the operation of an
+// admission test. The operation is admitted if there are none.
+type AdmissionTestResult struct {
+	Error  *Error            `json:"error,omitempty"`
+	Denied []AdmissionPolicy `json:"denied"`
+}
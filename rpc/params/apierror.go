@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/errors"
@@ -139,6 +140,19 @@ func (e RedirectErrorInfo) AsMap() map[string]interface{} {
 	return serializeToMap(e)
 }
 
+// RateLimitExceededErrorInfo provides additional information for
+// RateLimitExceeded errors.
+type RateLimitExceededErrorInfo struct {
+	// RetryAfter is how long the client should wait before retrying the
+	// request.
+	RetryAfter time.Duration `json:"retry-after"`
+}
+
+// AsMap encodes the error info as a map that can be attached to an Error.
+func (e RateLimitExceededErrorInfo) AsMap() map[string]interface{} {
+	return serializeToMap(e)
+}
+
 // serializeToMap is a convenience function for marshaling v into a
 // map[string]interface{}. It works by marshalling v into json and then
 // unmarshaling back to a map.
@@ -216,6 +230,7 @@ const (
 	CodeSecretBackendNotValid      = "secret backend not valid"
 	CodeAccessRequired             = "access required"
 	CodeAppShouldNotHaveUnits      = "application should not have units"
+	CodeRateLimitExceeded          = "rate limit exceeded"
 
 	//
 	// Tag based error
@@ -565,3 +580,7 @@ func IsCodeDeadlineExceeded(err error) bool {
 func IsCodeAppShouldNotHaveUnits(err error) bool {
 	return ErrCode(err) == CodeAppShouldNotHaveUnits
 }
+
+func IsCodeRateLimitExceeded(err error) bool {
+	return ErrCode(err) == CodeRateLimitExceeded
+}
@@ -62,6 +62,7 @@ type ApplicationDeploy struct {
 	Storage          map[string]storage.Directive   `json:"storage,omitempty"`
 	Devices          map[string]devices.Constraints `json:"devices,omitempty"`
 	AttachStorage    []string                       `json:"attach-storage,omitempty"`
+	StorageSnapshots map[string]string              `json:"storage-snapshots,omitempty"`
 	EndpointBindings map[string]string              `json:"endpoint-bindings,omitempty"`
 	Resources        map[string]string              `json:"resources,omitempty"`
 	Force            bool
@@ -447,6 +448,55 @@ type ScaleApplicationInfo struct {
 	Scale int `json:"num-units"`
 }
 
+// AutoscalePolicy holds the autoscaling policy of a k8s application.
+type AutoscalePolicy struct {
+	// MinUnits is the lowest number of units the application is scaled to.
+	MinUnits int `json:"min-units"`
+
+	// MaxUnits is the highest number of units the application is scaled to.
+	MaxUnits int `json:"max-units"`
+
+	// TargetCPUPercent is the target average CPU utilisation of the units,
+	// as a percentage of the CPU they request.
+	TargetCPUPercent int `json:"target-cpu-percent,omitempty"`
+
+	// TargetMemoryPercent is the target average memory utilisation of the
+	// units, as a percentage of the memory they request.
+	TargetMemoryPercent int `json:"target-memory-percent,omitempty"`
+
+	// CustomMetric is the name of a pod metric served by the custom metrics
+	// API.
+	CustomMetric string `json:"custom-metric,omitempty"`
+
+	// CustomMetricTarget is the target average value of the custom metric.
+	CustomMetricTarget float64 `json:"custom-metric-target,omitempty"`
+}
+
+// SetAutoscalePolicyArg holds the autoscaling policy to set on an
+// application.
+type SetAutoscalePolicyArg struct {
+	ApplicationTag string          `json:"application-tag"`
+	Policy         AutoscalePolicy `json:"policy"`
+}
+
+// SetAutoscalePoliciesArgs holds the arguments for a SetAutoscalePolicies
+// API request.
+type SetAutoscalePoliciesArgs struct {
+	Args []SetAutoscalePolicyArg `json:"args"`
+}
+
+// AutoscalePolicyResult holds the autoscaling policy of an application.
+type AutoscalePolicyResult struct {
+	Policy *AutoscalePolicy `json:"policy,omitempty"`
+	Error  *Error           `json:"error,omitempty"`
+}
+
+// AutoscalePolicyResults holds the results of a GetAutoscalePolicies API
+// request.
+type AutoscalePolicyResults struct {
+	Results []AutoscalePolicyResult `json:"results"`
+}
+
 // ApplicationResult holds an application info.
 // NOTE: we should look to combine ApplicationResult and ApplicationInfo.
 type ApplicationResult struct {
@@ -558,6 +608,12 @@ type DeployFromRepositoryArg struct {
 	// may be non-empty only if NumUnits is 1.
 	AttachStorage []string
 
+	// StorageSnapshots maps storage names to the provider IDs of
+	// snapshots from which the new storage of the application unit
+	// that will be deployed is restored. This may be non-empty only
+	// if NumUnits is 1.
+	StorageSnapshots map[string]string `json:"storage-snapshots,omitempty"`
+
 	// Base describes the OS base intended to be used by the charm.
 	Base *Base `json:"base,omitempty"`
 
@@ -118,6 +118,7 @@ type CharmMeta struct {
 	Containers     map[string]CharmContainer    `json:"containers,omitempty"`
 	AssumesExpr    *assumes.ExpressionTree      `json:"assumes-expr,omitempty"`
 	CharmUser      string                       `json:"charm-user,omitempty"`
+	DeploymentType string                       `json:"deployment-type,omitempty"`
 }
 
 // Charm holds all the charm data that the client needs.
@@ -0,0 +1,95 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+// CIDRSet is a named set of CIDRs which application firewall rules may
+// allow ingress from.
+type CIDRSet struct {
+	Name  string   `json:"name"`
+	CIDRs []string `json:"cidrs"`
+}
+
+// CIDRSets holds CIDR sets.
+type CIDRSets struct {
+	Sets []CIDRSet `json:"sets"`
+}
+
+// CIDRSetNames holds the names of CIDR sets.
+type CIDRSetNames struct {
+	Names []string `json:"names"`
+}
+
+// CIDRSetsResult is used to return the CIDR sets of a model.
+type CIDRSetsResult struct {
+	Error *Error    `json:"error,omitempty"`
+	Sets  []CIDRSet `json:"sets"`
+}
+
+// ApplicationFirewallRule allows ingress to the ports of an application
+// endpoint from a set of CIDRs and CIDR sets, whether or not the
+// application is exposed.
+type ApplicationFirewallRule struct {
+	ApplicationTag string `json:"application-tag"`
+	// Endpoint is the endpoint the rule applies to. Rules without an
+	// endpoint apply to all the endpoints of the application.
+	Endpoint string `json:"endpoint,omitempty"`
+	// PortRange restricts the rule to the port range. Rules without a
+	// port range apply to the ports opened by the application's units.
+	PortRange *PortRange `json:"port-range,omitempty"`
+	CIDRs     []string   `json:"cidrs,omitempty"`
+	CIDRSets  []string   `json:"cidr-sets,omitempty"`
+	// SourceCIDRs is the effective list of CIDRs allowed ingress by the
+	// rule. It is ignored when setting rules.
+	SourceCIDRs []string `json:"source-cidrs,omitempty"`
+}
+
+// ApplicationFirewallRules holds application firewall rules.
+type ApplicationFirewallRules struct {
+	Rules []ApplicationFirewallRule `json:"rules"`
+}
+
+// ApplicationFirewallRuleID identifies an application firewall rule by its
+// application, endpoint and port range.
+type ApplicationFirewallRuleID struct {
+	ApplicationTag string     `json:"application-tag"`
+	Endpoint       string     `json:"endpoint,omitempty"`
+	PortRange      *PortRange `json:"port-range,omitempty"`
+}
+
+// ApplicationFirewallRuleIDs holds application firewall rule identifiers.
+type ApplicationFirewallRuleIDs struct {
+	Rules []ApplicationFirewallRuleID `json:"rules"`
+}
+
+// ApplicationFirewallRulesResult is used to return the application
+// firewall rules of a model.
+type ApplicationFirewallRulesResult struct {
+	Error *Error                    `json:"error,omitempty"`
+	Rules []ApplicationFirewallRule `json:"rules"`
+}
+
+// MachineIngressRule is an ingress rule applied to a machine, along with
+// the application and the source of the rule.
+type MachineIngressRule struct {
+	Application string `json:"application"`
+	// Source is either "expose", for rules from exposing the
+	// application, or "firewall-rule", for application firewall rules.
+	Source      string    `json:"source"`
+	PortRange   PortRange `json:"port-range"`
+	SourceCIDRs []string  `json:"source-cidrs"`
+}
+
+// MachineFirewallRulesResult holds the effective ingress rules of a
+// machine.
+type MachineFirewallRulesResult struct {
+	Error      *Error               `json:"error,omitempty"`
+	MachineTag string               `json:"machine-tag"`
+	Rules      []MachineIngressRule `json:"rules"`
+}
+
+// MachineFirewallRulesResults holds the effective ingress rules of
+// machines.
+type MachineFirewallRulesResults struct {
+	Results []MachineFirewallRulesResult `json:"results"`
+}
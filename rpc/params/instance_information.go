@@ -71,3 +71,25 @@ type InstanceType struct {
 	VirtType     string   `json:"virt-type,omitempty"`
 	Cost         int      `json:"cost,omitempty"`
 }
+
+// PriceCatalogueResult contains the result of prompting a cloud for the
+// prices of its instance types and volumes.
+type PriceCatalogueResult struct {
+	Result *PriceCatalogue `json:"result,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+// PriceCatalogue holds the prices of instance types and volumes, by cloud
+// region.
+type PriceCatalogue struct {
+	Currency string                  `json:"currency"`
+	Regions  map[string]RegionPrices `json:"regions"`
+}
+
+// RegionPrices holds the prices in a single cloud region. Instance type
+// prices are hourly, and volume prices are per GiB per month, keyed by
+// storage pool name.
+type RegionPrices struct {
+	InstanceTypes map[string]float64 `json:"instance-types,omitempty"`
+	Volumes       map[string]float64 `json:"volumes,omitempty"`
+}
@@ -875,6 +875,15 @@ type ProvisioningInfoResults struct {
 	Results []ProvisioningInfoResult `json:"results"`
 }
 
+// WarmPoolResult holds the changes made to a model's warm pool.
+type WarmPoolResult struct {
+	// Added holds the ids of the machines added to the pool.
+	Added []string `json:"added,omitempty"`
+
+	// Removed holds the ids of the surplus machines removed from the pool.
+	Removed []string `json:"removed,omitempty"`
+}
+
 // SingularClaim represents a request for exclusive administrative access
 // to an entity (model or controller) on the part of the claimant.
 type SingularClaim struct {
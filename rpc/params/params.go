@@ -298,6 +298,46 @@ type RecordAgentStartInformationArg struct {
 	Hostname string `json:"hostname,omitempty"`
 }
 
+// MachineUtilisationSamples holds samples of the CPU and memory
+// utilisation of one or more machines, reported by their agents.
+type MachineUtilisationSamples struct {
+	Samples []MachineUtilisationSample `json:"samples"`
+}
+
+// MachineUtilisationSample holds a sample of the CPU and memory utilisation
+// of a machine, as percentages.
+type MachineUtilisationSample struct {
+	Tag           string    `json:"tag"`
+	SampledAt     time.Time `json:"sampled-at"`
+	CPUPercent    float64   `json:"cpu-percent"`
+	MemoryPercent float64   `json:"memory-percent"`
+}
+
+// MachineUtilisationResults holds the utilisation of a set of machines.
+type MachineUtilisationResults struct {
+	Results []MachineUtilisationResult `json:"results"`
+}
+
+// MachineUtilisationResult holds the utilisation of a machine, or an error.
+type MachineUtilisationResult struct {
+	Result *MachineUtilisation `json:"result,omitempty"`
+	Error  *Error              `json:"error,omitempty"`
+}
+
+// MachineUtilisation summarises the samples of the CPU and memory
+// utilisation of a machine taken over the model's rightsizing window.
+// The means and peaks are percentages, the peaks being 95th percentiles.
+type MachineUtilisation struct {
+	Samples       int       `json:"samples"`
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	MeanCPU       float64   `json:"mean-cpu"`
+	PeakCPU       float64   `json:"peak-cpu"`
+	MeanMemory    float64   `json:"mean-memory"`
+	PeakMemory    float64   `json:"peak-memory"`
+	WindowSeconds int64     `json:"window-seconds"`
+}
+
 // UpdateChannelArg holds the parameters for updating the series for the
 // specified application or machine. For Application, only known by facade
 // version 5 and greater. For MachineManger, only known by facade version
@@ -850,6 +890,16 @@ type FacadeVersions struct {
 	Versions []int  `json:"versions"`
 }
 
+// OIDCConfigResult holds the result of an OIDCConfig call.
+type OIDCConfigResult struct {
+	// IssuerURL is the URL of the identity provider users log in with.
+	IssuerURL string `json:"issuer-url"`
+
+	// ClientID is the client ID the controller is registered with the
+	// identity provider as, which clients log in with.
+	ClientID string `json:"client-id"`
+}
+
 // RedirectInfoResult holds the result of a RedirectInfo call.
 type RedirectInfoResult struct {
 	// Servers holds an entry for each server that holds the
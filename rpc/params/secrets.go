@@ -152,7 +152,7 @@ func (arg UpdateUserSecretArg) Validate() error {
 // HasUpdate returns true if arg contains at least one attribute to update.
 func (arg UpdateUserSecretArg) HasUpdate() bool {
 	return arg.AutoPrune != nil || arg.Description != nil || arg.Label != nil ||
-		arg.RotatePolicy != nil || arg.ExpireTime != nil ||
+		arg.RotatePolicy != nil || arg.ExpireTime != nil || len(arg.Params) != 0 ||
 		len(arg.Content.Data) != 0 || arg.Content.ValueRef != nil
 }
 
@@ -334,6 +334,29 @@ type SecretTriggerWatchResult struct {
 	Error     *Error                `json:"error,omitempty"`
 }
 
+// SecretGeneratorResults holds the generators used to rotate user secrets.
+type SecretGeneratorResults struct {
+	Results []SecretGeneratorResult `json:"results"`
+}
+
+// SecretGeneratorResult holds the generator used to rotate a user secret.
+type SecretGeneratorResult struct {
+	Kind   string            `json:"kind,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+	Error  *Error            `json:"error,omitempty"`
+}
+
+// RotateUserSecretArgs holds the args for rotating user secrets.
+type RotateUserSecretArgs struct {
+	Args []RotateUserSecretArg `json:"args"`
+}
+
+// RotateUserSecretArg holds the newly generated content for a user secret.
+type RotateUserSecretArg struct {
+	URI     string              `json:"uri"`
+	Content SecretContentParams `json:"content"`
+}
+
 // SecretRotatedArgs holds the args for updating rotated secret info.
 type SecretRotatedArgs struct {
 	Args []SecretRotatedArg `json:"args"`
@@ -3,6 +3,8 @@
 
 package params
 
+import "time"
+
 // SSHHostKeySet defines SSH host keys for one or more entities
 // (typically machines).
 type SSHHostKeySet struct {
@@ -58,3 +60,121 @@ type SSHPublicKeysResult struct {
 	Error      *Error   `json:"error,omitempty"`
 	PublicKeys []string `json:"public-keys,omitempty"`
 }
+
+// SSHSession describes a recorded SSH session proxied by the controller.
+type SSHSession struct {
+	UUID      string    `json:"uuid"`
+	User      string    `json:"user"`
+	Target    string    `json:"target"`
+	Command   string    `json:"command,omitempty"`
+	StartedAt time.Time `json:"started-at"`
+	EndedAt   time.Time `json:"ended-at"`
+	ExitCode  int       `json:"exit-code"`
+	Size      int64     `json:"size"`
+}
+
+// SSHSessionsResult is used to return the recorded SSH sessions of a model
+// for the SSHClient.ListSSHSessions API.
+type SSHSessionsResult struct {
+	Error    *Error       `json:"error,omitempty"`
+	Sessions []SSHSession `json:"sessions"`
+}
+
+// SSHAccessRequestArg is used to request time-bounded SSH access to a unit
+// or machine with the SSHClient.RequestSSHAccess API.
+type SSHAccessRequestArg struct {
+	Tag       string        `json:"tag"`
+	Container *string       `json:"container,omitempty"`
+	Reason    string        `json:"reason,omitempty"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// SSHAccessGrant describes a grant of time-bounded SSH access to a unit or
+// machine.
+type SSHAccessGrant struct {
+	UUID        string        `json:"uuid"`
+	User        string        `json:"user"`
+	Target      string        `json:"target"`
+	Reason      string        `json:"reason,omitempty"`
+	Duration    time.Duration `json:"duration"`
+	Status      string        `json:"status"`
+	RequestedAt time.Time     `json:"requested-at"`
+	DecidedBy   string        `json:"decided-by,omitempty"`
+	DecidedAt   *time.Time    `json:"decided-at,omitempty"`
+	ExpiresAt   *time.Time    `json:"expires-at,omitempty"`
+}
+
+// SSHAccessGrantResult is used to return an SSH access grant.
+type SSHAccessGrantResult struct {
+	Error *Error         `json:"error,omitempty"`
+	Grant SSHAccessGrant `json:"grant"`
+}
+
+// SSHAccessGrantsResult is used to return the SSH access grants of a model
+// for the SSHClient.ListSSHAccessGrants API.
+type SSHAccessGrantsResult struct {
+	Error  *Error           `json:"error,omitempty"`
+	Grants []SSHAccessGrant `json:"grants"`
+}
+
+// SSHAccessGrantArg identifies the SSH access grant to approve, reject or
+// revoke.
+type SSHAccessGrantArg struct {
+	UUID string `json:"uuid"`
+}
+
+// SSHCertificateRequest is used to request an SSH user certificate for a
+// public key with the SSHCertificates.IssueSSHCertificate API.
+type SSHCertificateRequest struct {
+	PublicKey string `json:"public-key"`
+}
+
+// SSHCertificate describes an SSH user certificate issued by the controller.
+type SSHCertificate struct {
+	Serial         uint64     `json:"serial"`
+	User           string     `json:"user"`
+	KeyFingerprint string     `json:"key-fingerprint"`
+	ValidAfter     time.Time  `json:"valid-after"`
+	ValidBefore    time.Time  `json:"valid-before"`
+	RevokedBy      string     `json:"revoked-by,omitempty"`
+	RevokedAt      *time.Time `json:"revoked-at,omitempty"`
+}
+
+// SSHCertificateResult is used to return a newly issued SSH user
+// certificate, along with the public key of the certificate authority which
+// signed it.
+type SSHCertificateResult struct {
+	Error       *Error         `json:"error,omitempty"`
+	Certificate SSHCertificate `json:"certificate"`
+	// SignedCertificate is the certificate in authorized keys format.
+	SignedCertificate string `json:"signed-certificate"`
+	CAPublicKey       string `json:"ca-public-key"`
+}
+
+// SSHCertificatesFilter selects the SSH user certificates returned by the
+// SSHCertificates.ListSSHCertificates API. The authenticated user's
+// certificates are returned when it is empty.
+type SSHCertificatesFilter struct {
+	User string `json:"user,omitempty"`
+	All  bool   `json:"all,omitempty"`
+}
+
+// SSHCertificatesResult is used to return SSH user certificates.
+type SSHCertificatesResult struct {
+	Error        *Error           `json:"error,omitempty"`
+	Certificates []SSHCertificate `json:"certificates"`
+}
+
+// RevokeSSHCertificatesArg identifies the SSH user certificates to revoke,
+// either by serial or all those issued to a user.
+type RevokeSSHCertificatesArg struct {
+	Serial uint64 `json:"serial,omitempty"`
+	User   string `json:"user,omitempty"`
+}
+
+// RevokeSSHCertificatesResult is used to return the number of SSH user
+// certificates revoked.
+type RevokeSSHCertificatesResult struct {
+	Error   *Error `json:"error,omitempty"`
+	Revoked int    `json:"revoked"`
+}
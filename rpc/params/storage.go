@@ -42,6 +42,34 @@ type SetMachineBlockDevices struct {
 	MachineBlockDevices []MachineBlockDevices `json:"machine-block-devices"`
 }
 
+// FilesystemUsage describes the space and inode usage of a filesystem.
+type FilesystemUsage struct {
+	UsedBytes       uint64 `json:"used-bytes"`
+	AvailableBytes  uint64 `json:"available-bytes"`
+	UsedInodes      uint64 `json:"used-inodes"`
+	AvailableInodes uint64 `json:"available-inodes"`
+}
+
+// MountedFilesystemUsage holds the usage of the filesystem mounted at
+// a mount point.
+type MountedFilesystemUsage struct {
+	MountPoint string          `json:"mount-point"`
+	Usage      FilesystemUsage `json:"usage"`
+}
+
+// MachineFilesystemUsage holds the usage of the filesystems mounted
+// on a machine.
+type MachineFilesystemUsage struct {
+	Machine     string                   `json:"machine"`
+	Filesystems []MountedFilesystemUsage `json:"filesystems,omitempty"`
+}
+
+// SetMachineFilesystemUsage holds the arguments for recording the usage
+// of the filesystems mounted on a set of machines.
+type SetMachineFilesystemUsage struct {
+	MachineFilesystemUsage []MachineFilesystemUsage `json:"machine-filesystem-usage"`
+}
+
 // BlockDeviceResult holds the result of an API call to retrieve details
 // of a block device.
 type BlockDeviceResult struct {
@@ -118,6 +146,28 @@ type StorageAttachment struct {
 	Kind     StorageKind `json:"kind"`
 	Location string      `json:"location"`
 	Life     life.Value  `json:"life"`
+
+	// Resized is true if the storage has been resized since the unit
+	// last ran its storage-resized hook.
+	Resized bool `json:"resized,omitempty"`
+
+	// ResizeGeneration is the number of resizes of the storage that have
+	// completed while the unit was attached to it.
+	ResizeGeneration uint64 `json:"resize-generation,omitempty"`
+}
+
+// StorageAttachmentsResized holds the resize generations of storage
+// attachments for which the storage-resized hook has been run.
+type StorageAttachmentsResized struct {
+	Attachments []StorageAttachmentResized `json:"attachments"`
+}
+
+// StorageAttachmentResized identifies a storage attachment, and the resize
+// generation for which its unit has run the storage-resized hook.
+type StorageAttachmentResized struct {
+	StorageTag string `json:"storage-tag"`
+	UnitTag    string `json:"unit-tag"`
+	Generation uint64 `json:"generation"`
 }
 
 // StorageAttachmentId identifies a storage attachment by the tags of the
@@ -278,6 +328,7 @@ type VolumeParams struct {
 	Attributes map[string]interface{}  `json:"attributes,omitempty"`
 	Tags       map[string]string       `json:"tags,omitempty"`
 	Attachment *VolumeAttachmentParams `json:"attachment,omitempty"`
+	SnapshotId string                  `json:"snapshot-id,omitempty"`
 }
 
 // RemoveVolumeParams holds the parameters for destroying or releasing a
@@ -375,6 +426,33 @@ type RemoveVolumeParamsResults struct {
 	Results []RemoveVolumeParamsResult `json:"results,omitempty"`
 }
 
+// VolumeResizeParams holds the parameters for resizing a volume.
+type VolumeResizeParams struct {
+	// VolumeTag is the tag of the volume.
+	VolumeTag string `json:"volume-tag"`
+
+	// Provider is the storage provider that manages the volume.
+	Provider string `json:"provider"`
+
+	// VolumeId is the storage provider's unique ID for the volume.
+	VolumeId string `json:"volume-id"`
+
+	// Size is the size in MiB that the volume is to be resized to.
+	Size uint64 `json:"size"`
+}
+
+// VolumeResizeParamsResult holds the parameters for resizing a volume,
+// or an error.
+type VolumeResizeParamsResult struct {
+	Result VolumeResizeParams `json:"result"`
+	Error  *Error             `json:"error,omitempty"`
+}
+
+// VolumeResizeParamsResults holds parameters for resizing multiple volumes.
+type VolumeResizeParamsResults struct {
+	Results []VolumeResizeParamsResult `json:"results,omitempty"`
+}
+
 // VolumeAttachmentParamsResult holds provisioning parameters for a volume
 // attachment.
 type VolumeAttachmentParamsResult struct {
@@ -439,6 +517,7 @@ type FilesystemParams struct {
 	Attributes    map[string]interface{}      `json:"attributes,omitempty"`
 	Tags          map[string]string           `json:"tags,omitempty"`
 	Attachment    *FilesystemAttachmentParams `json:"attachment,omitempty"`
+	SnapshotId    string                      `json:"snapshot-id,omitempty"`
 }
 
 // RemoveFilesystemParams holds the parameters for destroying or releasing
@@ -514,6 +593,39 @@ type RemoveFilesystemParamsResults struct {
 	Results []RemoveFilesystemParamsResult `json:"results,omitempty"`
 }
 
+// FilesystemResizeParams holds the parameters for resizing a filesystem.
+type FilesystemResizeParams struct {
+	// FilesystemTag is the tag of the filesystem.
+	FilesystemTag string `json:"filesystem-tag"`
+
+	// Provider is the storage provider that manages the filesystem.
+	Provider string `json:"provider"`
+
+	// FilesystemId is the storage provider's unique ID for the filesystem.
+	FilesystemId string `json:"filesystem-id"`
+
+	// Size is the size in MiB that the filesystem is to be resized to.
+	Size uint64 `json:"size"`
+
+	// VolumeTag is the tag of the volume backing the filesystem, if any.
+	// A filesystem on a volume is grown by the machine the volume is
+	// attached to, once the volume has been resized.
+	VolumeTag string `json:"volume-tag,omitempty"`
+}
+
+// FilesystemResizeParamsResult holds the parameters for resizing a
+// filesystem, or an error.
+type FilesystemResizeParamsResult struct {
+	Result FilesystemResizeParams `json:"result"`
+	Error  *Error                 `json:"error,omitempty"`
+}
+
+// FilesystemResizeParamsResults holds parameters for resizing multiple
+// filesystems.
+type FilesystemResizeParamsResults struct {
+	Results []FilesystemResizeParamsResult `json:"results,omitempty"`
+}
+
 // FilesystemAttachmentParamsResult holds provisioning parameters for a filesystem
 // attachment.
 type FilesystemAttachmentParamsResult struct {
@@ -825,6 +937,10 @@ type FilesystemAttachmentDetails struct {
 	// Juju controllers older than 2.2 do not populate this
 	// field, so it may be omitted.
 	Life life.Value `json:"life,omitempty"`
+
+	// Usage contains the most recently reported usage of the attached
+	// filesystem, if any has been reported.
+	Usage *FilesystemUsage `json:"usage,omitempty"`
 }
 
 // FilesystemDetailsResult contains details about a filesystem, its attachments or
@@ -874,6 +990,10 @@ type StorageAddParams struct {
 
 	// Directives are specified storage directives.
 	Directives StorageDirectives `json:"storage"`
+
+	// SnapshotId, if non-empty, is the provider ID of a snapshot
+	// from which to restore the new storage instances.
+	SnapshotId string `json:"snapshot-id,omitempty"`
 }
 
 // StoragesAddParams holds storage details to add to units dynamically.
@@ -911,6 +1031,86 @@ type RemoveStorageInstance struct {
 	MaxWait *time.Duration `json:"max-wait,omitempty"`
 }
 
+// ResizeStorage holds the parameters for resizing storage instances.
+type ResizeStorage struct {
+	Storage []ResizeStorageInstance `json:"storage"`
+}
+
+// ResizeStorageInstance holds the parameters for resizing a storage
+// instance.
+type ResizeStorageInstance struct {
+	// Tag is the tag of the storage instance to be resized.
+	Tag string `json:"tag"`
+
+	// Size is the requested size of the storage instance in MiB. The
+	// size must be larger than the current size.
+	Size uint64 `json:"size"`
+}
+
+// StorageSnapshot describes a provider snapshot of the volume or
+// filesystem backing a storage instance.
+type StorageSnapshot struct {
+	// StorageTag is the tag of the storage instance that was snapshotted.
+	StorageTag string `json:"storage-tag"`
+
+	// SnapshotId is the provider ID of the snapshot.
+	SnapshotId string `json:"snapshot-id"`
+
+	// SourceId is the provider ID of the volume or filesystem that
+	// the snapshot was taken from.
+	SourceId string `json:"source-id"`
+
+	// Size is the size of the snapshotted volume or filesystem in MiB.
+	Size uint64 `json:"size,omitempty"`
+
+	// Created is the time at which the snapshot was taken.
+	Created time.Time `json:"created"`
+
+	// Status is the provider status of the snapshot.
+	Status string `json:"status,omitempty"`
+}
+
+// StorageSnapshotResult holds the result of creating a snapshot of a
+// storage instance.
+type StorageSnapshotResult struct {
+	Result *StorageSnapshot `json:"result,omitempty"`
+	Error  *Error           `json:"error,omitempty"`
+}
+
+// StorageSnapshotResults holds the results of creating snapshots of
+// storage instances.
+type StorageSnapshotResults struct {
+	Results []StorageSnapshotResult `json:"results"`
+}
+
+// StorageSnapshotsResult holds the snapshots of a storage instance.
+type StorageSnapshotsResult struct {
+	Result []StorageSnapshot `json:"result,omitempty"`
+	Error  *Error            `json:"error,omitempty"`
+}
+
+// StorageSnapshotsResults holds the snapshots of a collection of storage
+// instances.
+type StorageSnapshotsResults struct {
+	Results []StorageSnapshotsResult `json:"results"`
+}
+
+// RemoveStorageSnapshots holds the parameters for removing storage
+// snapshots.
+type RemoveStorageSnapshots struct {
+	Snapshots []RemoveStorageSnapshot `json:"snapshots"`
+}
+
+// RemoveStorageSnapshot identifies a snapshot to remove.
+type RemoveStorageSnapshot struct {
+	// StorageTag is the tag of the storage instance that the snapshot
+	// was taken from. It identifies the provider holding the snapshot.
+	StorageTag string `json:"storage-tag"`
+
+	// SnapshotId is the provider ID of the snapshot to remove.
+	SnapshotId string `json:"snapshot-id"`
+}
+
 // BulkImportStorageParams contains the parameters for importing a collection
 // of storage entities.
 type BulkImportStorageParams struct {
@@ -36,6 +36,25 @@ type UserInfoRequest struct {
 	IncludeDisabled bool     `json:"include-disabled"`
 }
 
+// LoginAttempt describes one attempt by a user to log in with a password.
+type LoginAttempt struct {
+	Time    time.Time `json:"time"`
+	Success bool      `json:"success"`
+	Address string    `json:"address,omitempty"`
+}
+
+// LoginHistoryResult holds the recent login attempts of a user, the most
+// recent first.
+type LoginHistoryResult struct {
+	Attempts []LoginAttempt `json:"attempts,omitempty"`
+	Error    *Error         `json:"error,omitempty"`
+}
+
+// LoginHistoryResults holds the results of the bulk LoginHistory API call.
+type LoginHistoryResults struct {
+	Results []LoginHistoryResult `json:"results"`
+}
+
 // AddUsers holds the parameters for adding new users.
 type AddUsers struct {
 	Users []AddUser `json:"users"`
@@ -67,3 +86,130 @@ type AddUserResult struct {
 	SecretKey []byte `json:"secret-key,omitempty"`
 	Error     *Error `json:"error,omitempty"`
 }
+
+// Role is a named set of API methods, given as "Facade.Method" or
+// "Facade.*", which may be granted to users on models or the controller.
+type Role struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Methods     []string `json:"methods"`
+}
+
+// AddRoles holds the roles to add with the RoleManager.AddRoles API.
+type AddRoles struct {
+	Roles []Role `json:"roles"`
+}
+
+// RoleNames holds the names of roles.
+type RoleNames struct {
+	Names []string `json:"names"`
+}
+
+// RolesResult is used to return roles.
+type RolesResult struct {
+	Error *Error `json:"error,omitempty"`
+	Roles []Role `json:"roles"`
+}
+
+// RoleGrant describes a role granted to a user on a model or the
+// controller, which are identified by their tags.
+type RoleGrant struct {
+	Role      string `json:"role"`
+	UserTag   string `json:"user-tag"`
+	TargetTag string `json:"target-tag"`
+}
+
+// RoleGrants holds role grants.
+type RoleGrants struct {
+	Grants []RoleGrant `json:"grants"`
+}
+
+// RoleGrantsFilter selects the grants returned by the
+// RoleManager.ListRoleGrants API. The grants of every role are returned
+// when the role is empty.
+type RoleGrantsFilter struct {
+	Role string `json:"role,omitempty"`
+}
+
+// RoleGrantsResult is used to return role grants.
+type RoleGrantsResult struct {
+	Error  *Error      `json:"error,omitempty"`
+	Grants []RoleGrant `json:"grants"`
+}
+
+// GroupGrant describes access granted to a group of users of the OpenID
+// Connect identity provider on a model or the controller, which are
+// identified by their tags.
+type GroupGrant struct {
+	Group     string `json:"group"`
+	Access    string `json:"access"`
+	TargetTag string `json:"target-tag"`
+}
+
+// GroupGrants holds group grants.
+type GroupGrants struct {
+	Grants []GroupGrant `json:"grants"`
+}
+
+// GroupGrantsFilter selects the grants returned by the
+// GroupManager.ListGroupGrants API. The grants of every group are returned
+// when the group is empty.
+type GroupGrantsFilter struct {
+	Group string `json:"group,omitempty"`
+}
+
+// GroupGrantsResult is used to return group grants.
+type GroupGrantsResult struct {
+	Error  *Error       `json:"error,omitempty"`
+	Grants []GroupGrant `json:"grants"`
+}
+
+// ServiceAccountTokenScope describes the access an API token grants on a
+// model or the controller, which is identified by its tag.
+type ServiceAccountTokenScope struct {
+	TargetTag string `json:"target-tag"`
+	Access    string `json:"access"`
+}
+
+// IssueServiceAccountToken holds the parameters to issue one named API
+// token to a service account.
+type IssueServiceAccountToken struct {
+	ServiceAccountTag string                     `json:"service-account-tag"`
+	Name              string                     `json:"name"`
+	Scopes            []ServiceAccountTokenScope `json:"scopes"`
+
+	// ExpiresIn is how long the token remains valid for. The token never
+	// expires when it is zero.
+	ExpiresIn time.Duration `json:"expires-in"`
+}
+
+// IssueServiceAccountTokens holds the tokens to issue with the
+// ServiceAccountManager.IssueTokens API.
+type IssueServiceAccountTokens struct {
+	Tokens []IssueServiceAccountToken `json:"tokens"`
+}
+
+// IssueServiceAccountTokenResult returns an issued API token or an error.
+// The token is only ever returned when it is issued.
+type IssueServiceAccountTokenResult struct {
+	Token string `json:"token,omitempty"`
+	Error *Error `json:"error,omitempty"`
+}
+
+// IssueServiceAccountTokenResults holds the results of the
+// ServiceAccountManager.IssueTokens API.
+type IssueServiceAccountTokenResults struct {
+	Results []IssueServiceAccountTokenResult `json:"results"`
+}
+
+// ServiceAccountTokenName identifies an API token of a service account.
+type ServiceAccountTokenName struct {
+	ServiceAccountTag string `json:"service-account-tag"`
+	Name              string `json:"name"`
+}
+
+// RevokeServiceAccountTokens holds the tokens to revoke with the
+// ServiceAccountManager.RevokeTokens API.
+type RevokeServiceAccountTokens struct {
+	Tokens []ServiceAccountTokenName `json:"tokens"`
+}
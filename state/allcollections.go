@@ -4,6 +4,8 @@
 package state
 
 import (
+	"time"
+
 	"github.com/juju/mgo/v3"
 )
 
@@ -214,6 +216,18 @@ func allCollections() CollectionSchema {
 		},
 		sshHostKeysC: {},
 
+		// This collection holds the requests for machines to open reverse
+		// SSH tunnels to the controller. Requests are removed once they
+		// expire.
+		sshConnRequestsC: {
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "machine-id"},
+			}, {
+				Key:         []string{"expires"},
+				ExpireAfter: time.Second,
+			}},
+		},
+
 		// This collection contains information from removed machines
 		// that needs to be cleaned up in the provider.
 		machineRemovalsC: {
@@ -399,6 +413,7 @@ const (
 	settingsC                = "settings"
 	refcountsC               = "refcounts"
 	sshHostKeysC             = "sshhostkeys"
+	sshConnRequestsC         = "sshconnrequests"
 	statusesC                = "statuses"
 	storageAttachmentsC      = "storageattachments"
 	storageConstraintsC      = "storageconstraints"
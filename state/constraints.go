@@ -32,6 +32,8 @@ type constraintsDoc struct {
 	Zones            *[]string
 	AllocatePublicIP *bool
 	ImageID          *string
+	Spot             *bool
+	SpotMaxPrice     *float64
 }
 
 func newConstraintsDoc(cons constraints.Value, id string) constraintsDoc {
@@ -52,6 +54,8 @@ func newConstraintsDoc(cons constraints.Value, id string) constraintsDoc {
 		Zones:            cons.Zones,
 		AllocatePublicIP: cons.AllocatePublicIP,
 		ImageID:          cons.ImageID,
+		Spot:             cons.Spot,
+		SpotMaxPrice:     cons.SpotMaxPrice,
 	}
 	return result
 }
@@ -73,6 +77,8 @@ func (doc constraintsDoc) value() constraints.Value {
 		Zones:            doc.Zones,
 		AllocatePublicIP: doc.AllocatePublicIP,
 		ImageID:          doc.ImageID,
+		Spot:             doc.Spot,
+		SpotMaxPrice:     doc.SpotMaxPrice,
 	}
 	return result
 }
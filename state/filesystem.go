@@ -9,6 +9,7 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/mgo/v3"
@@ -68,6 +69,11 @@ type Filesystem interface {
 	// Releasing reports whether or not the filesystem is to be released
 	// from the model when it is Dying/Dead.
 	Releasing() bool
+
+	// ResizeRequested returns the size in MiB that the filesystem is to be
+	// resized to, and true if a resize has been requested and not yet
+	// completed.
+	ResizeRequested() (uint64, bool)
 }
 
 // FilesystemAttachment describes an attachment of a filesystem to a machine.
@@ -93,6 +99,10 @@ type FilesystemAttachment interface {
 	// if it has not already been made. Params returns true if the returned
 	// parameters are usable for creating an attachment, otherwise false.
 	Params() (FilesystemAttachmentParams, bool)
+
+	// Usage returns the most recently reported usage of the attached
+	// filesystem, and true if usage has been reported.
+	Usage() (FilesystemUsage, bool)
 }
 
 type filesystem struct {
@@ -117,6 +127,10 @@ type filesystemDoc struct {
 	Info            *FilesystemInfo   `bson:"info,omitempty"`
 	Params          *FilesystemParams `bson:"params,omitempty"`
 
+	// ResizeSize is the size in MiB that the filesystem is to be
+	// resized to, if a resize has been requested.
+	ResizeSize uint64 `bson:"resizesize,omitempty"`
+
 	// HostId is the ID of the host that a non-detachable
 	// volume is initially attached to. We use this to identify
 	// the filesystem as being non-detachable, and to determine
@@ -135,6 +149,7 @@ type filesystemAttachmentDoc struct {
 	Life   Life                        `bson:"life"`
 	Info   *FilesystemAttachmentInfo   `bson:"info,omitempty"`
 	Params *FilesystemAttachmentParams `bson:"params,omitempty"`
+	Usage  *FilesystemUsage            `bson:"usage,omitempty"`
 }
 
 // FilesystemParams records parameters for provisioning a new filesystem.
@@ -156,6 +171,11 @@ type FilesystemParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// SnapshotId, if non-empty, is the provider ID of the snapshot
+	// from which the filesystem, or its backing volume, is to be
+	// created.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 // FilesystemInfo describes information about a filesystem.
@@ -178,6 +198,16 @@ type FilesystemAttachmentInfo struct {
 	ReadOnly   bool   `bson:"read-only"`
 }
 
+// FilesystemUsage describes the space and inode usage of an attached
+// filesystem, as reported by the machine it is attached to.
+type FilesystemUsage struct {
+	UsedBytes       uint64    `bson:"used-bytes"`
+	AvailableBytes  uint64    `bson:"available-bytes"`
+	UsedInodes      uint64    `bson:"used-inodes"`
+	AvailableInodes uint64    `bson:"available-inodes"`
+	Updated         time.Time `bson:"updated"`
+}
+
 // FilesystemAttachmentParams records parameters for attaching a filesystem to a
 // machine.
 type FilesystemAttachmentParams struct {
@@ -258,6 +288,11 @@ func (f *filesystem) Releasing() bool {
 	return f.doc.Releasing
 }
 
+// ResizeRequested is required to implement Filesystem.
+func (f *filesystem) ResizeRequested() (uint64, bool) {
+	return f.doc.ResizeSize, f.doc.ResizeSize > 0
+}
+
 // Status is required to implement StatusGetter.
 func (f *filesystem) Status() (status.StatusInfo, error) {
 	return getStatus(f.mb.db(), filesystemGlobalKey(f.FilesystemTag().Id()), "filesystem")
@@ -267,7 +302,7 @@ func (f *filesystem) Status() (status.StatusInfo, error) {
 func (f *filesystem) SetStatus(fsStatus status.StatusInfo) error {
 	switch fsStatus.Status {
 	case status.Attaching, status.Attached, status.Detaching, status.Detached, status.Destroying:
-	case status.Error:
+	case status.Error, status.Warning:
 		if fsStatus.Message == "" {
 			return errors.Errorf("cannot set status %q without info", fsStatus.Status)
 		}
@@ -339,6 +374,14 @@ func (f *filesystemAttachment) Params() (FilesystemAttachmentParams, bool) {
 	return *f.doc.Params, true
 }
 
+// Usage is required to implement FilesystemAttachment.
+func (f *filesystemAttachment) Usage() (FilesystemUsage, bool) {
+	if f.doc.Usage == nil {
+		return FilesystemUsage{}, false
+	}
+	return *f.doc.Usage, true
+}
+
 // Filesystem returns the Filesystem with the specified name.
 func (sb *storageBackend) Filesystem(tag names.FilesystemTag) (Filesystem, error) {
 	f, err := getFilesystemByTag(sb.mb, tag)
@@ -1109,10 +1152,11 @@ func (sb *storageConfigBackend) addFilesystemOps(params FilesystemParams, hostId
 			params.filesystemId = filesystemTag.String()
 		}
 		volumeParams := VolumeParams{
-			params.storage,
-			params.volumeInfo,
-			params.Pool,
-			params.Size,
+			storage:    params.storage,
+			volumeInfo: params.volumeInfo,
+			Pool:       params.Pool,
+			Size:       params.Size,
+			SnapshotId: params.SnapshotId,
 		}
 		volumeOps, volumeTag, err = sb.addVolumeOps(volumeParams, hostId)
 		if err != nil {
@@ -1291,11 +1335,38 @@ func (sb *storageBackend) SetFilesystemInfo(tag names.FilesystemTag, info Filesy
 			}
 		}
 		ops := setFilesystemInfoOps(tag, info, unsetParams)
+		if size, ok := fs.ResizeRequested(); ok && info.Size >= size {
+			resizeOps, err := sb.completeFilesystemResizeOps(fs.(*filesystem), size)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			ops = append(ops, resizeOps...)
+		}
 		return ops, nil
 	}
 	return sb.mb.db().Run(buildTxn)
 }
 
+// completeFilesystemResizeOps returns the operations to record that the
+// requested resize of the filesystem has completed, notifying the units
+// attached to the filesystem's storage instance.
+func (sb *storageBackend) completeFilesystemResizeOps(f *filesystem, size uint64) ([]txn.Op, error) {
+	ops := []txn.Op{{
+		C:      filesystemsC,
+		Id:     f.doc.FilesystemId,
+		Assert: bson.D{{"resizesize", size}},
+		Update: bson.D{{"$unset", bson.D{{"resizesize", nil}}}},
+	}}
+	if f.doc.StorageId == "" {
+		return ops, nil
+	}
+	resizedOps, err := sb.storageResizedOps(names.NewStorageTag(f.doc.StorageId))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append(ops, resizedOps...), nil
+}
+
 func validateFilesystemInfoChange(newInfo, oldInfo FilesystemInfo) error {
 	if newInfo.Pool != oldInfo.Pool {
 		return errors.Errorf(
@@ -1386,6 +1457,31 @@ func setFilesystemAttachmentInfoOps(
 	}}
 }
 
+// SetFilesystemAttachmentUsage records the usage of the filesystem
+// attachment with the specified host and filesystem tags.
+func (sb *storageBackend) SetFilesystemAttachmentUsage(
+	hostTag names.Tag,
+	filesystemTag names.FilesystemTag,
+	usage FilesystemUsage,
+) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot set usage for filesystem attachment %s:%s", filesystemTag.Id(), hostTag.Id())
+	ops := []txn.Op{{
+		C:      filesystemAttachmentsC,
+		Id:     filesystemAttachmentId(hostTag.Id(), filesystemTag.Id()),
+		Assert: append(isAliveDoc, bson.DocElem{"info", bson.D{{"$exists", true}}}),
+		Update: bson.D{{"$set", bson.D{{"usage", &usage}}}},
+	}}
+	if err := sb.mb.db().RunTransaction(ops); err == txn.ErrAborted {
+		if _, err := sb.FilesystemAttachment(hostTag, filesystemTag); err != nil {
+			return errors.Trace(err)
+		}
+		return errors.New("filesystem attachment is not alive or not provisioned")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 // FilesystemMountPoint returns a mount point to use for the given charm
 // storage. For stores with potentially multiple instances, the instance
 // name is appended to the location.
@@ -1079,6 +1079,27 @@ func (m *Machine) SetProvisioned(
 	return fmt.Errorf("already set")
 }
 
+// ResetProvisioned clears the nonce recorded when the machine was
+// provisioned, so that it can be provisioned again on a new instance.
+// It is used when the cloud has reclaimed the machine's instance.
+func (m *Machine) ResetProvisioned() (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot reset provisioning of machine %q", m)
+
+	ops := []txn.Op{{
+		C:      machinesC,
+		Id:     m.doc.DocID,
+		Assert: isAliveDoc,
+		Update: bson.D{{"$set", bson.D{{"nonce", ""}}}},
+	}}
+	if err := m.st.db().RunTransaction(ops); err == txn.ErrAborted {
+		return machineNotAliveErr
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	m.doc.Nonce = ""
+	return nil
+}
+
 // SetInstanceInfo is used to provision a machine and in one step sets its
 // instance ID, nonce, hardware characteristics, add link-layer devices and set
 // their addresses as needed.  After, set charm profiles if needed.
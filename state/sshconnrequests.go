@@ -0,0 +1,80 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/mgo/v3/txn"
+
+	"github.com/juju/juju/core/network"
+)
+
+// SSHConnRequestArg holds the arguments of a request for a machine to open
+// a reverse SSH tunnel to the controller.
+type SSHConnRequestArg struct {
+	TunnelID            string
+	MachineId           string
+	Expires             time.Time
+	Username            string
+	Password            string
+	ControllerAddresses network.SpaceAddresses
+	UnitPort            int
+	EphemeralPublicKey  []byte
+}
+
+// sshConnRequestDoc represents the MongoDB document that stores a request
+// for a machine to open a reverse SSH tunnel to the controller.
+type sshConnRequestDoc struct {
+	DocID               string    `bson:"_id"`
+	ModelUUID           string    `bson:"model-uuid"`
+	TunnelID            string    `bson:"tunnel-id"`
+	MachineId           string    `bson:"machine-id"`
+	Expires             time.Time `bson:"expires"`
+	Username            string    `bson:"username"`
+	Password            string    `bson:"password"`
+	ControllerAddresses []address `bson:"controller-addresses"`
+	UnitPort            int       `bson:"unit-port"`
+	EphemeralPublicKey  []byte    `bson:"ephemeral-public-key"`
+}
+
+// sshConnRequestKey returns the key of the request for the tunnel to the
+// machine.
+func sshConnRequestKey(machineId, tunnelID string) string {
+	return machineGlobalKey(machineId) + "#" + tunnelID
+}
+
+// InsertSSHConnRequest records a request for the machine to open a reverse
+// SSH tunnel to the controller.
+func (st *State) InsertSSHConnRequest(arg SSHConnRequestArg) error {
+	if arg.TunnelID == "" {
+		return errors.NotValidf("empty tunnel ID")
+	}
+	if arg.MachineId == "" {
+		return errors.NotValidf("empty machine ID")
+	}
+	doc := &sshConnRequestDoc{
+		DocID:               st.docID(sshConnRequestKey(arg.MachineId, arg.TunnelID)),
+		ModelUUID:           st.ModelUUID(),
+		TunnelID:            arg.TunnelID,
+		MachineId:           arg.MachineId,
+		Expires:             arg.Expires,
+		Username:            arg.Username,
+		Password:            arg.Password,
+		ControllerAddresses: fromNetworkAddresses(arg.ControllerAddresses, network.OriginProvider),
+		UnitPort:            arg.UnitPort,
+		EphemeralPublicKey:  arg.EphemeralPublicKey,
+	}
+	ops := []txn.Op{{
+		C:      sshConnRequestsC,
+		Id:     doc.DocID,
+		Assert: txn.DocMissing,
+		Insert: doc,
+	}}
+	if err := st.db().RunTransaction(ops); err != nil {
+		return errors.Annotatef(err, "inserting ssh connection request for machine %q", arg.MachineId)
+	}
+	return nil
+}
@@ -788,6 +788,11 @@ type AddApplicationArgs struct {
 	Placement         []*instance.Placement
 	Constraints       constraints.Value
 	Resources         map[string]string
+
+	// StorageSnapshots maps storage names to the provider IDs of
+	// snapshots from which the unit's new storage is restored. It
+	// may be non-empty only if NumUnits is 1.
+	StorageSnapshots map[string]string
 }
 
 // AddApplication creates a new application, running the supplied charm, with the
@@ -843,6 +848,9 @@ func (st *State) AddApplication(
 	if len(args.AttachStorage) > 0 && args.NumUnits != 1 {
 		return nil, errors.Errorf("AttachStorage is non-empty but NumUnits is %d, must be 1", args.NumUnits)
 	}
+	if len(args.StorageSnapshots) > 0 && args.NumUnits != 1 {
+		return nil, errors.Errorf("StorageSnapshots is non-empty but NumUnits is %d, must be 1", args.NumUnits)
+	}
 
 	if err := jujuversion.CheckJujuMinVersion(args.Charm.Meta().MinJujuVersion, jujuversion.Current); err != nil {
 		return nil, errors.Trace(err)
@@ -868,6 +876,10 @@ func (st *State) AddApplication(
 	if err := addDefaultStorageConstraints(sb, args.Storage, args.Charm.Meta()); err != nil {
 		return nil, errors.Trace(err)
 	}
+	unitStorage, err := storageConstraintsWithSnapshots(args.Storage, args.StorageSnapshots)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	if err := validateStorageConstraints(sb.storageBackend, args.Storage, args.Charm.Meta()); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -1016,7 +1028,7 @@ func (st *State) AddApplication(
 			unitName, unitOps, err := app.addUnitOpsWithCons(
 				applicationAddUnitOpsArgs{
 					cons:          args.Constraints,
-					storageCons:   args.Storage,
+					storageCons:   unitStorage,
 					attachStorage: args.AttachStorage,
 					charmMeta:     args.Charm.Meta(),
 				},
@@ -1189,10 +1201,12 @@ func assignUnitOps(unitName string, placement instance.Placement) []txn.Op {
 }
 
 // AssignStagedUnits gets called by the UnitAssigner worker, and runs the given
-// assignments.
+// assignments. Units without a placement directive are given a machine from
+// the warm pool, if any, before a new machine.
 func (st *State) AssignStagedUnits(
 	allSpaces network.SpaceInfos,
 	ids []string,
+	pool WarmPool,
 ) ([]UnitAssignmentResult, error) {
 	query := bson.D{{"_id", bson.D{{"$in", ids}}}}
 	unitAssignments, err := st.unitAssignments(query)
@@ -1201,7 +1215,7 @@ func (st *State) AssignStagedUnits(
 	}
 	results := make([]UnitAssignmentResult, len(unitAssignments))
 	for i, a := range unitAssignments {
-		err := st.assignStagedUnit(a, allSpaces)
+		err := st.assignStagedUnit(a, allSpaces, pool)
 		results[i].Unit = a.Unit
 		results[i].Error = err
 	}
@@ -1243,13 +1257,14 @@ func removeStagedAssignmentOp(id string) txn.Op {
 func (st *State) assignStagedUnit(
 	a UnitAssignment,
 	allSpaces network.SpaceInfos,
+	pool WarmPool,
 ) error {
 	u, err := st.Unit(a.Unit)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	if a.Scope == "" && a.Directive == "" {
-		return errors.Trace(st.AssignUnit(u))
+		return errors.Trace(st.AssignUnit(u, pool))
 	}
 
 	placement := &instance.Placement{Scope: a.Scope, Directive: a.Directive}
@@ -1507,16 +1522,25 @@ func (st *State) UnitsFor(machineId string) ([]*Unit, error) {
 	return m.Units()
 }
 
-// AssignUnit places the unit on a machine. Depending on the policy, and the
-// state of the model, this may lead to new instances being launched
-// within the model.
+// AssignUnit places the unit on a machine from the warm pool, if the pool
+// is not nil and has a machine for the unit, or on a new machine. Depending
+// on the policy, and the state of the model, this may lead to new instances
+// being launched within the model.
 func (st *State) AssignUnit(
 	u *Unit,
+	pool WarmPool,
 ) (err error) {
 	if !u.IsPrincipal() {
 		return errors.Errorf("subordinate unit %q cannot be assigned directly to a machine", u)
 	}
 	defer errors.DeferredAnnotatef(&err, "cannot assign unit %q to machine", u)
+	if pool != nil {
+		if assigned, err := u.assignToWarmPoolMachine(pool); err != nil {
+			return errors.Trace(err)
+		} else if assigned {
+			return nil
+		}
+	}
 	return errors.Trace(u.AssignToNewMachine())
 }
 
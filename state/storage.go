@@ -6,6 +6,7 @@ package state
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -72,6 +73,14 @@ type StorageAttachment interface {
 
 	// Life reports whether the storage attachment is Alive, Dying or Dead.
 	Life() Life
+
+	// Resized reports whether the storage instance has been resized since
+	// the unit last ran its storage-resized hook.
+	Resized() bool
+
+	// ResizeGeneration returns the number of resizes of the storage
+	// instance that have completed while the unit was attached to it.
+	ResizeGeneration() uint64
 }
 
 // StorageKind defines the type of a store: whether it is a block device
@@ -243,6 +252,10 @@ type storageInstanceDoc struct {
 	StorageName     string                     `bson:"storagename"`
 	AttachmentCount int                        `bson:"attachmentcount"`
 	Constraints     storageInstanceConstraints `bson:"constraints"`
+
+	// SnapshotId, if non-empty, is the provider ID of the snapshot
+	// that the storage instance's volume or filesystem is restored from.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 // storageInstanceConstraints contains a subset of StorageConstraints,
@@ -268,6 +281,14 @@ func (s *storageAttachment) Life() Life {
 	return s.doc.Life
 }
 
+func (s *storageAttachment) Resized() bool {
+	return s.doc.ResizeGeneration > s.doc.ResizeHandled
+}
+
+func (s *storageAttachment) ResizeGeneration() uint64 {
+	return s.doc.ResizeGeneration
+}
+
 // storageAttachmentDoc describes a unit's attachment to a charm storage
 // instance.
 type storageAttachmentDoc struct {
@@ -277,6 +298,12 @@ type storageAttachmentDoc struct {
 	Unit            string `bson:"unitid"`
 	StorageInstance string `bson:"storageid"`
 	Life            Life   `bson:"life"`
+
+	// ResizeGeneration is incremented each time a resize of the storage
+	// instance completes, and ResizeHandled records the generation for
+	// which the unit last ran its storage-resized hook.
+	ResizeGeneration uint64 `bson:"resizegeneration,omitempty"`
+	ResizeHandled    uint64 `bson:"resizehandled,omitempty"`
 }
 
 // newStorageInstanceId returns a unique storage instance name. The name
@@ -822,6 +849,7 @@ func createStorageOps(
 					Pool: cons.Pool,
 					Size: cons.Size,
 				},
+				SnapshotId: cons.SnapshotId,
 			}
 			var hostStorageOps []txn.Op
 			if unitTag, ok := entityTag.(names.UnitTag); ok {
@@ -1679,6 +1707,10 @@ type StorageConstraints struct {
 
 	// Count is the required number of storage instances.
 	Count uint64 `bson:"count"`
+
+	// SnapshotId, if non-empty, is the provider ID of a snapshot
+	// from which to restore the storage instances.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 func createStorageConstraintsOp(key string, cons map[string]StorageConstraints) txn.Op {
@@ -1955,6 +1987,34 @@ func addDefaultStorageConstraints(sb *storageConfigBackend, allCons map[string]S
 	return nil
 }
 
+// storageConstraintsWithSnapshots returns a copy of allCons in which the
+// constraints for each storage name in snapshots restore the storage from
+// the corresponding snapshot.
+func storageConstraintsWithSnapshots(allCons map[string]StorageConstraints, snapshots map[string]string) (map[string]StorageConstraints, error) {
+	if len(snapshots) == 0 {
+		return allCons, nil
+	}
+	result := make(map[string]StorageConstraints, len(allCons))
+	for name, cons := range allCons {
+		result[name] = cons
+	}
+	for name, snapshotId := range snapshots {
+		cons, ok := result[name]
+		if !ok {
+			return nil, errors.NotFoundf("charm storage %q", name)
+		}
+		if snapshotId == "" {
+			return nil, errors.NotValidf("empty snapshot ID for storage %q", name)
+		}
+		if cons.Count == 0 {
+			return nil, errors.NotValidf("restoring storage %q from a snapshot with a count of 0", name)
+		}
+		cons.SnapshotId = snapshotId
+		result[name] = cons
+	}
+	return result, nil
+}
+
 // storageConstraintsWithDefaults returns a constraints
 // derived from cons, with any defaults filled in.
 func storageConstraintsWithDefaults(
@@ -2382,3 +2442,152 @@ func addMachineStorageAttachmentsOps(
 		Update: update,
 	}}, nil
 }
+
+// ResizeStorageInstance requests that the volume or filesystem of the
+// storage instance be grown to the given size in MiB. Filesystems backed
+// by volumes are resized by resizing their volume. The storage must be
+// provisioned, and only model-scoped storage may be resized.
+func (sb *storageBackend) ResizeStorageInstance(tag names.StorageTag, size uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot resize storage %q", tag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		si, err := sb.storageInstance(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if si.Life() != Alive {
+			return nil, errors.Errorf("storage is %s", si.Life())
+		}
+		switch si.Kind() {
+		case StorageKindBlock:
+			v, err := sb.storageInstanceVolume(tag)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return resizeVolumeOps(v, size)
+		case StorageKindFilesystem:
+			f, err := sb.storageInstanceFilesystem(tag)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			volumeTag, err := f.Volume()
+			if err == nil {
+				v, err := getVolumeByTag(sb.mb, volumeTag)
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				return resizeVolumeOps(v, size)
+			} else if errors.Cause(err) != ErrNoBackingVolume {
+				return nil, errors.Trace(err)
+			}
+			return resizeFilesystemOps(f, size)
+		}
+		return nil, errors.Errorf("invalid storage kind %v", si.Kind())
+	}
+	return sb.mb.db().Run(buildTxn)
+}
+
+func resizeVolumeOps(v *volume, size uint64) ([]txn.Op, error) {
+	if v.doc.Life != Alive {
+		return nil, errors.Errorf("volume %q is %s", v.doc.Name, v.doc.Life)
+	}
+	if strings.Contains(v.doc.Name, "/") {
+		return nil, errors.NotSupportedf("resizing machine-scoped volume %q", v.doc.Name)
+	}
+	info, err := v.Info()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if size <= info.Size {
+		return nil, errors.NotValidf(
+			"size %dMiB for volume %q of %dMiB, storage can only be grown",
+			size, v.doc.Name, info.Size,
+		)
+	}
+	return []txn.Op{{
+		C:      volumesC,
+		Id:     v.doc.Name,
+		Assert: bson.D{{"life", Alive}, {"info.size", info.Size}},
+		Update: bson.D{{"$set", bson.D{{"resizesize", size}}}},
+	}}, nil
+}
+
+func resizeFilesystemOps(f *filesystem, size uint64) ([]txn.Op, error) {
+	if f.doc.Life != Alive {
+		return nil, errors.Errorf("filesystem %q is %s", f.doc.FilesystemId, f.doc.Life)
+	}
+	if strings.Contains(f.doc.FilesystemId, "/") {
+		return nil, errors.NotSupportedf("resizing machine-scoped filesystem %q", f.doc.FilesystemId)
+	}
+	info, err := f.Info()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if size <= info.Size {
+		return nil, errors.NotValidf(
+			"size %dMiB for filesystem %q of %dMiB, storage can only be grown",
+			size, f.doc.FilesystemId, info.Size,
+		)
+	}
+	return []txn.Op{{
+		C:      filesystemsC,
+		Id:     f.doc.FilesystemId,
+		Assert: bson.D{{"life", Alive}, {"info.size", info.Size}},
+		Update: bson.D{{"$set", bson.D{{"resizesize", size}}}},
+	}}, nil
+}
+
+// storageResizedOps returns the operations to advance the resize generation
+// of the alive attachments of the storage instance, so that their units run
+// the storage-resized hook.
+func (sb *storageBackend) storageResizedOps(tag names.StorageTag) ([]txn.Op, error) {
+	coll, closer := sb.mb.db().GetCollection(storageAttachmentsC)
+	defer closer()
+
+	var docs []storageAttachmentDoc
+	query := bson.D{{"storageid", tag.Id()}, {"life", Alive}}
+	if err := coll.Find(query).All(&docs); err != nil {
+		return nil, errors.Annotatef(err, "cannot get storage attachments for storage %s", tag.Id())
+	}
+	ops := make([]txn.Op, len(docs))
+	for i, doc := range docs {
+		ops[i] = txn.Op{
+			C:      storageAttachmentsC,
+			Id:     storageAttachmentId(doc.Unit, doc.StorageInstance),
+			Assert: txn.DocExists,
+			Update: bson.D{{"$inc", bson.D{{"resizegeneration", 1}}}},
+		}
+	}
+	return ops, nil
+}
+
+// ClearStorageAttachmentResized records that the unit has run its
+// storage-resized hook for the given resize generation of the storage
+// attachment. Resizes completed after that generation remain pending, so
+// the hook is run again for them.
+func (sb *storageBackend) ClearStorageAttachmentResized(storage names.StorageTag, unit names.UnitTag, generation uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot clear resized flag of storage attachment %s:%s", storage.Id(), unit.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		s, err := sb.storageAttachment(storage, unit)
+		if errors.Is(err, errors.NotFound) {
+			return nil, jujutxn.ErrNoOperations
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if generation > s.doc.ResizeGeneration {
+			return nil, errors.NotValidf(
+				"resize generation %d, storage attachment is at generation %d",
+				generation, s.doc.ResizeGeneration,
+			)
+		}
+		if generation <= s.doc.ResizeHandled {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:      storageAttachmentsC,
+			Id:     storageAttachmentId(unit.Id(), storage.Id()),
+			Assert: bson.D{{"resizegeneration", bson.D{{"$gte", generation}}}},
+			Update: bson.D{{"$max", bson.D{{"resizehandled", generation}}}},
+		}}, nil
+	}
+	return sb.mb.db().Run(buildTxn)
+}
@@ -52,6 +52,11 @@ type Volume interface {
 	// Releasing reports whether or not the volume is to be released
 	// from the model when it is Dying/Dead.
 	Releasing() bool
+
+	// ResizeRequested returns the size in MiB that the volume is to be
+	// resized to, and true if a resize has been requested and not yet
+	// completed.
+	ResizeRequested() (uint64, bool)
 }
 
 // VolumeAttachment describes an attachment of a volume to a machine.
@@ -127,6 +132,10 @@ type volumeDoc struct {
 	Info            *VolumeInfo   `bson:"info,omitempty"`
 	Params          *VolumeParams `bson:"params,omitempty"`
 
+	// ResizeSize is the size in MiB that the volume is to be
+	// resized to, if a resize has been requested.
+	ResizeSize uint64 `bson:"resizesize,omitempty"`
+
 	// HostId is the ID of the host that a non-detachable
 	// volume is initially attached to. We use this to identify
 	// the volume as being non-detachable, and to determine
@@ -192,6 +201,10 @@ type VolumeParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// SnapshotId, if non-empty, is the provider ID of the snapshot
+	// from which the volume is to be created.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 // VolumeInfo describes information about a volume.
@@ -299,6 +312,11 @@ func (v *volume) Releasing() bool {
 	return v.doc.Releasing
 }
 
+// ResizeRequested is required to implement Volume.
+func (v *volume) ResizeRequested() (uint64, bool) {
+	return v.doc.ResizeSize, v.doc.ResizeSize > 0
+}
+
 // Status is required to implement StatusGetter.
 func (v *volume) Status() (status.StatusInfo, error) {
 	return getStatus(v.mb.db(), volumeGlobalKey(v.VolumeTag().Id()), "volume")
@@ -1474,11 +1492,51 @@ func (sb *storageBackend) SetVolumeInfo(tag names.VolumeTag, info VolumeInfo) (e
 			}
 		}
 		ops = append(ops, setVolumeInfoOps(tag, info, unsetParams)...)
+		if size, ok := v.ResizeRequested(); ok && info.Size >= size {
+			resizeOps, err := sb.completeVolumeResizeOps(v.(*volume), size, info.Size)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			ops = append(ops, resizeOps...)
+		}
 		return ops, nil
 	}
 	return sb.mb.db().Run(buildTxn)
 }
 
+// completeVolumeResizeOps returns the operations to record that the
+// requested resize of the volume has completed, notifying the units
+// attached to the volume's storage instance. A filesystem on the volume
+// must be grown on the machine before its units are notified, so a resize
+// of the filesystem to the new size of the volume is requested instead.
+func (sb *storageBackend) completeVolumeResizeOps(v *volume, size, newSize uint64) ([]txn.Op, error) {
+	ops := []txn.Op{{
+		C:      volumesC,
+		Id:     v.doc.Name,
+		Assert: bson.D{{"resizesize", size}},
+		Update: bson.D{{"$unset", bson.D{{"resizesize", nil}}}},
+	}}
+	if v.doc.StorageId == "" {
+		f, err := sb.volumeFilesystem(v.VolumeTag())
+		if errors.Is(err, errors.NotFound) {
+			return ops, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return append(ops, txn.Op{
+			C:      filesystemsC,
+			Id:     f.doc.FilesystemId,
+			Assert: isAliveDoc,
+			Update: bson.D{{"$set", bson.D{{"resizesize", newSize}}}},
+		}), nil
+	}
+	resizedOps, err := sb.storageResizedOps(names.NewStorageTag(v.doc.StorageId))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return append(ops, resizedOps...), nil
+}
+
 func validateVolumeInfoChange(newInfo, oldInfo VolumeInfo) error {
 	if newInfo.Pool != oldInfo.Pool {
 		return errors.Errorf(
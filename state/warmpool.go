@@ -0,0 +1,52 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+// WarmPool gives the machines of a model's warm pool to the units
+// assigned without a placement directive.
+type WarmPool interface {
+	// ClaimMachine takes a machine for a unit with the input constraints
+	// and base out of the warm pool, and returns its id. It returns false
+	// if the pool has no machine for the unit.
+	ClaimMachine(cons constraints.Value, base Base) (string, bool, error)
+
+	// ReturnMachine puts a claimed machine that the unit could not be
+	// assigned to back in the warm pool.
+	ReturnMachine(id string, cons constraints.Value, base Base) error
+}
+
+// assignToWarmPoolMachine assigns the unit to a machine claimed from the
+// warm pool. It returns false if the pool has no machine for the unit.
+func (u *Unit) assignToWarmPoolMachine(pool WarmPool) (bool, error) {
+	cons, err := u.Constraints()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	id, ok, err := pool.ClaimMachine(*cons, u.doc.Base)
+	if err != nil || !ok {
+		return false, errors.Trace(err)
+	}
+	m, err := u.st.Machine(id)
+	if err == nil {
+		err = u.assignToMachine(m, true)
+	}
+	if err != nil {
+		// The machine may have gone away, or be unable to host the
+		// unit's storage; the unit is given a new machine instead.
+		logger.Debugf(context.TODO(), "cannot assign unit %q to warm pool machine %s: %v", u.Name(), id, err)
+		if err := pool.ReturnMachine(id, *cons, u.doc.Base); err != nil {
+			logger.Warningf(context.TODO(), "cannot return machine %s to the warm pool: %v", id, err)
+		}
+		return false, nil
+	}
+	return true, nil
+}
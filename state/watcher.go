@@ -222,6 +222,34 @@ func (sb *storageBackend) WatchModelFilesystems() StringsWatcher {
 
 var machineOrUnitSnippet = "(" + names.NumberSnippet + "|" + names.UnitSnippet + ")"
 
+// WatchModelVolumeResizes returns a StringsWatcher that notifies of changes
+// to model-scoped volumes, which may have resizes requested. The watcher
+// reports every model-scoped volume initially.
+func (sb *storageBackend) WatchModelVolumeResizes() StringsWatcher {
+	return sb.watchModelHostStorageResizes(volumesC)
+}
+
+// WatchModelFilesystemResizes returns a StringsWatcher that notifies of
+// changes to model-scoped filesystems, which may have resizes requested.
+// The watcher reports every model-scoped filesystem initially.
+func (sb *storageBackend) WatchModelFilesystemResizes() StringsWatcher {
+	return sb.watchModelHostStorageResizes(filesystemsC)
+}
+
+func (sb *storageBackend) watchModelHostStorageResizes(collection string) StringsWatcher {
+	mb := sb.mb
+	return newCollectionWatcher(mb, colWCfg{
+		col: collection,
+		filter: func(id interface{}) bool {
+			k, err := mb.strictLocalID(id.(string))
+			if err != nil {
+				return false
+			}
+			return !strings.Contains(k, "/")
+		},
+	})
+}
+
 func (sb *storageBackend) watchModelHostStorage(collection string) StringsWatcher {
 	mb := sb.mb
 	pattern := fmt.Sprintf("^%s$", mb.docID(machineOrUnitSnippet))
@@ -2489,6 +2517,19 @@ func (st *State) WatchMachineRemovals() NotifyWatcher {
 	return newNotifyCollWatcher(st, machineRemovalsC, isLocalID(st))
 }
 
+// WatchMachineInstanceStatuses returns a NotifyWatcher which triggers
+// whenever the status of a machine's instance changes.
+func (st *State) WatchMachineInstanceStatuses() NotifyWatcher {
+	isLocal := isLocalID(st)
+	return newNotifyCollWatcher(st, statusesC, func(id interface{}) bool {
+		if !isLocal(id) {
+			return false
+		}
+		localID, _ := st.strictLocalID(id.(string))
+		return strings.HasPrefix(localID, machineGlobalKeyPrefix) && strings.HasSuffix(localID, "#instance")
+	})
+}
+
 // notifyCollWatcher implements NotifyWatcher, triggering when a
 // change is seen in a specific collection matching the provided
 // filter function.